@@ -109,7 +109,13 @@ fpm -v $VERSION -n ivpn-service -s pleaserun -t dir --deb-no-default-config-file
 OBFSPXY_BIN=$DAEMON_REPO_ABS_PATH/References/Linux/_deps/obfs4proxy_inst/obfs4proxy
 WG_QUICK_BIN=$DAEMON_REPO_ABS_PATH/References/Linux/_deps/wireguard-tools_inst/wg-quick
 WG_BIN=$DAEMON_REPO_ABS_PATH/References/Linux/_deps/wireguard-tools_inst/wg
+AWG_QUICK_BIN=$DAEMON_REPO_ABS_PATH/References/Linux/_deps/amneziawg-tools_inst/awg-quick
+AWG_BIN=$DAEMON_REPO_ABS_PATH/References/Linux/_deps/amneziawg-tools_inst/awg
+AWG_GO_BIN=$DAEMON_REPO_ABS_PATH/References/Linux/_deps/amneziawg-tools_inst/amneziawg-go
 DNSCRYPT_PROXY_BIN=$DAEMON_REPO_ABS_PATH/References/Linux/_deps/dnscryptproxy_inst/dnscrypt-proxy
+V2RAY_BIN=$DAEMON_REPO_ABS_PATH/References/Linux/_deps/v2ray_inst/v2ray
+SSLOCAL_BIN=$DAEMON_REPO_ABS_PATH/References/Linux/_deps/shadowsocks_inst/sslocal
+KEM_HELPER_BIN=$DAEMON_REPO_ABS_PATH/References/Linux/_deps/kem-helper_inst/kem-helper
 
 #if [ "$(find ${DNSCRYPT_PROXY_BIN} -perm 755)" != "${DNSCRYPT_PROXY_BIN}" ] || [ "$(find ${OBFSPXY_BIN} -perm 755)" != "${OBFSPXY_BIN}" ] || [ "$(find ${WG_QUICK_BIN} -perm 755)" != "${WG_QUICK_BIN}" ] || [ "$(find ${WG_BIN} -perm 755)" != "${WG_BIN}" ]
 #then
@@ -212,12 +218,19 @@ CreatePackage()
     --after-remove "$SCRIPT_DIR/package_scripts/after-remove.sh" \
     $DAEMON_REPO_ABS_PATH/References/Linux/etc=/opt/ivpn/ \
     $DAEMON_REPO_ABS_PATH/References/common/etc=/opt/ivpn/ \
+    $DAEMON_REPO_ABS_PATH/References/Linux/dbus/net.ivpn.Daemon.conf=/usr/share/dbus-1/system.d/ \
     $DAEMON_REPO_ABS_PATH/References/Linux/scripts/_out_bin/ivpn-service=/usr/bin/ \
     $OUT_DIR/ivpn=/usr/bin/ \
     $OBFSPXY_BIN=/opt/ivpn/obfsproxy/obfs4proxy \
     $WG_QUICK_BIN=/opt/ivpn/wireguard-tools/wg-quick \
     $WG_BIN=/opt/ivpn/wireguard-tools/wg \
+    $AWG_QUICK_BIN=/opt/ivpn/amneziawg-tools/awg-quick \
+    $AWG_BIN=/opt/ivpn/amneziawg-tools/awg \
+    $AWG_GO_BIN=/opt/ivpn/amneziawg-tools/amneziawg-go \
     ${DNSCRYPT_PROXY_BIN}=/opt/ivpn/dnscrypt-proxy/dnscrypt-proxy \
+    $V2RAY_BIN=/opt/ivpn/v2ray/v2ray \
+    $SSLOCAL_BIN=/opt/ivpn/shadowsocks/sslocal \
+    $KEM_HELPER_BIN=/opt/ivpn/kem/kem-helper \
     $TMPDIRSRVC/ivpn-service.dir/usr/share/pleaserun/=/usr/share/pleaserun
 }
 
@@ -25,13 +25,13 @@ package cliplatform
 import "runtime"
 
 func IsSplitTunSupported() bool {
-	return runtime.GOOS == "windows" || runtime.GOOS == "linux"
+	return runtime.GOOS == "windows" || runtime.GOOS == "linux" || runtime.GOOS == "darwin"
 }
 func IsSplitTunRunsApp() bool {
 	if !IsSplitTunSupported() {
 		return false
 	}
-	return runtime.GOOS == "linux"
+	return runtime.GOOS == "linux" || runtime.GOOS == "darwin"
 }
 func IsDnsOverHttpsSupported() bool {
 	return true
@@ -39,3 +39,6 @@ func IsDnsOverHttpsSupported() bool {
 func IsDnsOverTlsSupported() bool {
 	return false
 }
+func IsDnsSearchDomainsSupported() bool {
+	return runtime.GOOS == "windows"
+}
@@ -24,7 +24,9 @@ package commands
 
 import (
 	"bufio"
+	"encoding/base64"
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"syscall"
@@ -59,26 +61,30 @@ type CmdLogin struct {
 	flags.CmdInfo
 	accountID string
 	force     bool
+	add       bool
 }
 
 func (c *CmdLogin) Init() {
 	c.Initialize("login", "Login operation (register ACCOUNT_ID on this device)")
 	c.DefaultStringVar(&c.accountID, "ACCOUNT_ID")
 	c.BoolVar(&c.force, "force", false, "Log out from all other devices (applicable only with 'login' option)")
+	c.BoolVar(&c.add, "add", false, "Login to another account and keep the current account logged-in (use 'accounts' command to switch between them)")
 }
 
 func (c *CmdLogin) Run() error {
-	return doLogin(c.accountID, c.force)
+	return doLogin(c.accountID, c.force, c.add)
 }
 
-func doLogin(accountID string, force bool) error {
+func doLogin(accountID string, force bool, keepCurrentAccount bool) error {
 	// checking if we are logged-in
 	_proto.SessionStatus() // do not check error response (could be received 'not logged in' errors)
 	helloResp := _proto.GetHelloResponse()
 	if len(helloResp.Session.Session) != 0 {
-		fmt.Println("Already logged in")
-		PrintTips([]TipType{TipLogout})
-		return fmt.Errorf("unable login (please, log out first)")
+		if !keepCurrentAccount {
+			fmt.Println("Already logged in")
+			PrintTips([]TipType{TipLogout, TipLoginAdd})
+			return fmt.Errorf("unable login (please, log out first)")
+		}
 	}
 
 	// login
@@ -92,35 +98,116 @@ func doLogin(accountID string, force bool) error {
 		accountID = string(data)
 	}
 
-	apiStatus, err := _proto.SessionNew(accountID, force, "")
-	if err != nil {
-		if apiStatus == types.The2FARequired {
-			fmt.Println("Account has two-factor authentication enabled.")
-			fmt.Print("Please enter TOTP token to login: ")
-			reader := bufio.NewReader(os.Stdin)
-			topt, _ := reader.ReadString('\n')
-
-			topt = strings.TrimSuffix(topt, "\n")
-			topt = strings.TrimSuffix(topt, "\r")
-
-			apiStatus, err = _proto.SessionNew(accountID, force, topt)
+	// The login can require additional confirmation (2FA token and/or captcha): repeating the request with the required data
+	const maxAttempts = 5
+	topt, captchaID, captcha := "", "", ""
+	isDevicesLimitChecked := false
+	for attempt := 1; ; attempt++ {
+		resp, err := _proto.SessionNew(accountID, force, keepCurrentAccount, topt, captchaID, captcha)
+		if err == nil {
+			break
 		}
-
-		if apiStatus == types.CodeSessionsLimitReached {
-			PrintTips([]TipType{TipForceLogin})
+		if attempt >= maxAttempts {
+			return err
 		}
 
-		if err != nil {
+		switch {
+		case resp.IsCaptchaRequired:
+			if resp.APIStatus == types.CaptchaInvalid {
+				fmt.Println("The captcha is not valid.")
+			}
+			file, e := saveCaptchaImage(resp.Captcha.CaptchaImage)
+			if e != nil {
+				return fmt.Errorf("captcha required (failed to save captcha image: %w)", e)
+			}
+			fmt.Printf("Captcha required. Open the image and enter the text from it: %s\n", file)
+			captchaID = resp.Captcha.CaptchaID
+			captcha = readInput("Captcha: ")
+			os.Remove(file)
+			if len(topt) > 0 {
+				// the previous TOTP token could expire while solving the captcha
+				topt = readInput("Please enter TOTP token to login: ")
+			}
+
+		case resp.Is2FARequired:
+			if resp.APIStatus == types.The2FAInvalidToken {
+				fmt.Println("The TOTP token is not valid.")
+			} else {
+				fmt.Println("Account has two-factor authentication enabled.")
+			}
+			topt = readInput("Please enter TOTP token to login: ")
+
+		case resp.APIStatus == types.CodeSessionsLimitReached && !isDevicesLimitChecked:
+			isDevicesLimitChecked = true
+			// offer to log out one of the account devices (instead of logging out all of them)
+			isResolved, e := resolveDevicesLimit(accountID, topt)
+			if e != nil {
+				fmt.Println(e)
+			}
+			if !isResolved {
+				PrintTips([]TipType{TipForceLogin, TipDevices})
+				return err
+			}
+
+		default:
+			if resp.APIStatus == types.CodeSessionsLimitReached {
+				PrintTips([]TipType{TipForceLogin, TipDevices})
+			}
 			return err
 		}
 	}
 
 	fmt.Println("Logged in")
-	PrintTips([]TipType{TipServers, TipConnectHelp})
+	if keepCurrentAccount {
+		PrintTips([]TipType{TipAccounts, TipServers, TipConnectHelp})
+	} else {
+		PrintTips([]TipType{TipServers, TipConnectHelp})
+	}
 
 	return nil
 }
 
+// readInput reads the line (e.g. 2FA token) from stdin
+func readInput(prompt string) string {
+	fmt.Print(prompt)
+	reader := bufio.NewReader(os.Stdin)
+	str, _ := reader.ReadString('\n')
+
+	str = strings.TrimSuffix(str, "\n")
+	str = strings.TrimSuffix(str, "\r")
+	return str
+}
+
+// saveCaptchaImage saves the captcha image (base64-encoded; can be in 'data URL' format) to the temporary file
+func saveCaptchaImage(image string) (filePath string, err error) {
+	if idx := strings.Index(image, ";base64,"); strings.HasPrefix(image, "data:") && idx > 0 {
+		image = image[idx+len(";base64,"):]
+	}
+	data, err := base64.StdEncoding.DecodeString(image)
+	if err != nil {
+		return "", err
+	}
+
+	ext := ".png"
+	switch http.DetectContentType(data) {
+	case "image/jpeg":
+		ext = ".jpg"
+	case "image/gif":
+		ext = ".gif"
+	}
+
+	f, err := os.CreateTemp("", "ivpn-captcha-*"+ext)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	if _, err := f.Write(data); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
 //----------------------------------------------------------------------------------------
 
 type CmdAccount struct {
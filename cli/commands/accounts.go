@@ -0,0 +1,103 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+)
+
+type CmdAccounts struct {
+	flags.CmdInfo
+	list       bool
+	switchTo   string
+	removeAcct string
+}
+
+func (c *CmdAccounts) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("accounts", "Manage the logged-in accounts (switch between accounts without logout/login)\nUse 'login -add' to login to one more account")
+	c.BoolVar(&c.list, "list", false, "(default) Show the logged-in accounts")
+	c.StringVar(&c.switchTo, "switch", "", "ACCOUNT_ID", "Make the account active\n  (active VPN connection is re-established with the credentials of the account)")
+	c.StringVar(&c.removeAcct, "remove", "", "ACCOUNT_ID", "Log out the account (only not active account; use 'logout' command for the active one)")
+}
+
+func (c *CmdAccounts) Run() error {
+	if len(c.switchTo) > 0 && len(c.removeAcct) > 0 {
+		return flags.BadParameter{Message: "'switch' and 'remove' options can not be used together"}
+	}
+
+	if len(c.switchTo) > 0 {
+		if err := _proto.AccountSwitch(c.switchTo); err != nil {
+			return err
+		}
+		fmt.Println("Account switched")
+	}
+
+	if len(c.removeAcct) > 0 {
+		if err := _proto.AccountRemove(c.removeAcct); err != nil {
+			return err
+		}
+		fmt.Println("Account logged out")
+	}
+
+	// -list
+	accounts, err := _proto.AccountsGet()
+	if err != nil {
+		return err
+	}
+	printAccounts(accounts)
+
+	if len(accounts) <= 1 {
+		PrintTips([]TipType{TipLoginAdd})
+	}
+	return nil
+}
+
+func printAccounts(accounts []types.AccountInfo) {
+	if len(accounts) == 0 {
+		fmt.Println("Not logged in")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "ACCOUNT ID\tPLAN\tACTIVE UNTIL\t\t\n")
+	for _, a := range accounts {
+		activeUntil := ""
+		if a.Account.ActiveUntil > 0 {
+			activeUntil = time.Unix(a.Account.ActiveUntil, 0).Format("2006-01-02")
+		}
+		state := ""
+		if a.IsActive {
+			state = "(active)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t\n", a.AccountID, a.Account.CurrentPlan, activeUntil, state)
+	}
+	w.Flush()
+}
@@ -0,0 +1,94 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/daemon/awg"
+)
+
+type CmdAmneziaWG struct {
+	flags.CmdInfo
+	status bool
+	set    string
+	off    bool
+}
+
+func (c *CmdAmneziaWG) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("awg", "Manage AmneziaWG obfuscation for WireGuard connections\n(for networks where the WireGuard handshakes are blocked; the server must support AmneziaWG)")
+	c.BoolVar(&c.status, "status", false, "(default) Show settings")
+	c.StringVar(&c.set, "set", "", "PARAMS", "Use AmneziaWG with the obfuscation parameters (comma-separated list)\n  S1, S2 and H1-H4 must be the same as on the server side\n  Example: -set \"Jc=4,Jmin=40,Jmax=70,S1=15,S2=20,H1=1234567,H2=2345678,H3=3456789,H4=4567890\"")
+	c.BoolVar(&c.off, "off", false, "Do not use AmneziaWG (vanilla WireGuard)")
+}
+
+func (c *CmdAmneziaWG) Run() error {
+	if len(c.set) > 0 && c.off {
+		return flags.BadParameter{Message: "'set' and 'off' flags can not be used together"}
+	}
+
+	if len(c.set) > 0 {
+		cfg, err := awg.ParseConfig(c.set)
+		if err != nil {
+			return flags.BadParameter{Message: err.Error()}
+		}
+		if !cfg.IsEnabled() {
+			return flags.BadParameter{Message: "AmneziaWG parameters not defined"}
+		}
+		if err := _proto.SetAmneziaWG(cfg); err != nil {
+			return err
+		}
+	} else if c.off {
+		if err := _proto.SetAmneziaWG(awg.Config{}); err != nil {
+			return err
+		}
+	}
+
+	// -status
+
+	// request updated daemon settings
+	resp, err := _proto.SendHello()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	cfg := resp.DaemonSettings.AmneziaWG
+	if cfg.IsEnabled() {
+		fmt.Fprintf(w, "AmneziaWG\t:\tEnabled\n")
+		fmt.Fprintf(w, "Parameters\t:\t%s\n", cfg.String())
+	} else {
+		fmt.Fprintf(w, "AmneziaWG\t:\tDisabled\n")
+	}
+	if len(resp.DisabledFunctions.AmneziaWGError) > 0 {
+		fmt.Fprintf(w, "Not available\t:\t%s\n", resp.DisabledFunctions.AmneziaWGError)
+	}
+	w.Flush()
+
+	return nil
+}
@@ -0,0 +1,98 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	apitypes "github.com/ivpn/desktop-app/daemon/api/types"
+)
+
+type CmdApiHost struct {
+	flags.CmdInfo
+	status bool
+	set    string
+	pin    string
+	off    bool
+}
+
+func (c *CmdApiHost) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("api_host", "Manage alternative API server for the daemon API requests\n(for testing or self-hosted/mirrored infrastructure)\nWARNING! The alternative server is fully trusted: it defines the VPN servers to connect to.\n  Use only the servers under your control.")
+	c.BoolVar(&c.status, "status", false, "(default) Show settings")
+	c.StringVar(&c.set, "set", "", "URL", "Use alternative API server (must be used together with '-pin')\n  URL format: https://HOST[:PORT][/PATH]\n  Example: -set https://api.staging.example.com -pin <HASH>")
+	c.StringVar(&c.pin, "pin", "", "HASHES", "Comma-separated list of base64-encoded SHA256 hashes of the server certificate public keys\n  (the server is authenticated by the pinned keys only)")
+	c.BoolVar(&c.off, "off", false, "Use the default IVPN API server")
+}
+
+func (c *CmdApiHost) Run() error {
+	if len(c.set) > 0 && c.off {
+		return flags.BadParameter{Message: "'set' and 'off' flags can not be used together"}
+	}
+	if len(c.pin) > 0 && len(c.set) == 0 {
+		return flags.BadParameter{Message: "'pin' flag can be used only together with 'set'"}
+	}
+
+	if len(c.set) > 0 {
+		cfg := apitypes.APIHostOverride{BaseURL: c.set}
+		for _, h := range strings.Split(c.pin, ",") {
+			if h = strings.TrimSpace(h); len(h) > 0 {
+				cfg.CertHashes = append(cfg.CertHashes, h)
+			}
+		}
+		if err := cfg.Validate(); err != nil {
+			return flags.BadParameter{Message: err.Error()}
+		}
+		if err := _proto.SetApiHostOverride(cfg); err != nil {
+			return err
+		}
+	} else if c.off {
+		if err := _proto.SetApiHostOverride(apitypes.APIHostOverride{}); err != nil {
+			return err
+		}
+	}
+
+	// -status
+
+	// request updated daemon settings
+	if _, err := _proto.SendHello(); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	cfg := _proto.GetHelloResponse().DaemonSettings.ApiHostOverride
+	if cfg.IsEnabled() {
+		fmt.Fprintf(w, "API server\t:\t%s\n", cfg.BaseURL)
+		fmt.Fprintf(w, "Pinned keys\t:\t%s\n", strings.Join(cfg.CertHashes, ", "))
+	} else {
+		fmt.Fprintf(w, "API server\t:\tDefault\n")
+	}
+	w.Flush()
+
+	return nil
+}
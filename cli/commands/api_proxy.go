@@ -0,0 +1,108 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	apitypes "github.com/ivpn/desktop-app/daemon/api/types"
+)
+
+type CmdApiProxy struct {
+	flags.CmdInfo
+	status bool
+	set    string
+	off    bool
+}
+
+func (c *CmdApiProxy) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("api_proxy", "Manage proxy server for the daemon API requests\n(for networks where direct access to the IVPN API server is blocked)")
+	c.BoolVar(&c.status, "status", false, "(default) Show settings")
+	c.StringVar(&c.set, "set", "", "URL", fmt.Sprintf("Use proxy server for API requests\n  URL format: TYPE://[USERNAME:PASSWORD@]HOST:PORT\n  Supported types: '%s', '%s'\n  Example: %s://user:pass@10.0.0.1:3128", apitypes.ProxyHTTP, apitypes.ProxySOCKS5, apitypes.ProxyHTTP))
+	c.BoolVar(&c.off, "off", false, "Do not use proxy server for API requests")
+}
+
+func (c *CmdApiProxy) Run() error {
+	if len(c.set) > 0 && c.off {
+		return flags.BadParameter{Message: "'set' and 'off' flags can not be used together"}
+	}
+
+	if len(c.set) > 0 {
+		cfg, err := parseApiProxyURL(c.set)
+		if err != nil {
+			return flags.BadParameter{Message: err.Error()}
+		}
+		if err := _proto.SetApiProxy(cfg); err != nil {
+			return err
+		}
+	} else if c.off {
+		if err := _proto.SetApiProxy(apitypes.ProxyConfig{}); err != nil {
+			return err
+		}
+	}
+
+	// -status
+
+	// request updated daemon settings
+	if _, err := _proto.SendHello(); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	proxy := _proto.GetHelloResponse().DaemonSettings.ApiProxy
+	if proxy.IsEnabled() {
+		fmt.Fprintf(w, "API proxy\t:\t%s://%s\n", proxy.Type, proxy.Address)
+		if len(proxy.Username) > 0 {
+			fmt.Fprintf(w, "API proxy user\t:\t%s\n", proxy.Username)
+		}
+	} else {
+		fmt.Fprintf(w, "API proxy\t:\tDisabled\n")
+	}
+	w.Flush()
+
+	return nil
+}
+
+func parseApiProxyURL(proxyURL string) (apitypes.ProxyConfig, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil || len(u.Scheme) == 0 || len(u.Host) == 0 {
+		return apitypes.ProxyConfig{}, fmt.Errorf("bad proxy URL '%s' (expected format: TYPE://[USERNAME:PASSWORD@]HOST:PORT)", proxyURL)
+	}
+
+	cfg := apitypes.ProxyConfig{Type: strings.ToLower(u.Scheme), Address: u.Host}
+	if u.User != nil {
+		cfg.Username = u.User.Username()
+		cfg.Password, _ = u.User.Password()
+	}
+	if err := cfg.Validate(); err != nil {
+		return apitypes.ProxyConfig{}, err
+	}
+	return cfg, nil
+}
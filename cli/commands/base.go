@@ -36,6 +36,7 @@ import (
 	apitypes "github.com/ivpn/desktop-app/daemon/api/types"
 	"github.com/ivpn/desktop-app/daemon/protocol/types"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
 	"github.com/ivpn/desktop-app/daemon/splittun"
 	"github.com/ivpn/desktop-app/daemon/vpn"
 )
@@ -87,6 +88,15 @@ func printState(w *tabwriter.Writer, state vpn.State, connected types.ConnectedR
 			protocol += fmt.Sprintf(" (Obfsproxy: %s)", obfsCfg.ToString())
 		}
 	}
+	if connected.V2RayProxy.IsEnabled() {
+		protocol += fmt.Sprintf(" (V2Ray: %s)", connected.V2RayProxy)
+	}
+	if connected.IsShadowsocks {
+		protocol += " (Shadowsocks)"
+	}
+	if connected.IsCustomConfig {
+		protocol += " (custom configuration)"
+	}
 	fmt.Fprintf(w, "    Protocol\t:\t%v\n", protocol)
 	fmt.Fprintf(w, "    Local IP\t:\t%v\n", connected.ClientIP)
 	if len(connected.ClientIPv6) > 0 {
@@ -139,7 +149,7 @@ func printDNSState(w *tabwriter.Writer, dnsCfg dns.DnsSettings, servers *apitype
 	return w
 }
 
-func printFirewallState(w *tabwriter.Writer, isEnabled, isPersistent, isAllowLAN, isAllowMulticast, isAllowApiServers bool, userExceptions string, vpnState *vpn.State) *tabwriter.Writer {
+func printFirewallState(w *tabwriter.Writer, isEnabled, isPersistent, isAllowLAN, isAllowMulticast, isAllowApiServers bool, userExceptions string, lanServices lansvc.Services, lanHosts string, lanInboundPorts []lansvc.InboundPort, vpnState *vpn.State) *tabwriter.Writer {
 	if w == nil {
 		w = tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
 	}
@@ -155,6 +165,15 @@ func printFirewallState(w *tabwriter.Writer, isEnabled, isPersistent, isAllowLAN
 	}
 	fmt.Fprintf(w, "Firewall\t:\t%v%s\n", fwState, extraFwInfo)
 	fmt.Fprintf(w, "    Allow LAN\t:\t%v\n", isAllowLAN)
+	if !isAllowLAN && !lanServices.IsEmpty() {
+		fmt.Fprintf(w, "    Allow LAN services\t:\t%v\n", lanServices)
+	}
+	if !isAllowLAN && len(lanHosts) > 0 {
+		fmt.Fprintf(w, "    Allow LAN hosts\t:\t%v\n", lanHosts)
+	}
+	if !isAllowLAN && len(lanInboundPorts) > 0 {
+		fmt.Fprintf(w, "    Allow LAN inbound ports\t:\t%v\n", lansvc.InboundPortsString(lanInboundPorts))
+	}
 	if isPersistent {
 		fmt.Fprintf(w, "    Persistent\t:\t%v\n", isPersistent)
 	}
@@ -268,6 +287,69 @@ func printSplitTunState(w *tabwriter.Writer, isShortPrint bool, isFullPrint bool
 	return w
 }
 
+func printSplitTunDestinations(w *tabwriter.Writer, destinations []splittun.Destination) *tabwriter.Writer {
+	if w == nil {
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	}
+
+	isFirstLineShown := false
+	for _, d := range destinations {
+		mode := "exclude"
+		if d.IsInclude {
+			mode = "include"
+		}
+		if !isFirstLineShown {
+			isFirstLineShown = true
+			fmt.Fprintf(w, "Split Tunnel destinations\t:\t[%s] %s\n", mode, d.Address)
+		} else {
+			fmt.Fprintf(w, "\t\t[%s] %s\n", mode, d.Address)
+		}
+	}
+	return w
+}
+
+func printSplitTunContainers(w *tabwriter.Writer, containers []splittun.Container) *tabwriter.Writer {
+	if w == nil {
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	}
+
+	isFirstLineShown := false
+	for _, c := range containers {
+		mode := "exclude"
+		if c.IsInclude {
+			mode = "include"
+		}
+		if !isFirstLineShown {
+			isFirstLineShown = true
+			fmt.Fprintf(w, "Split Tunnel containers\t:\t[%s] %s\n", mode, c.Name)
+		} else {
+			fmt.Fprintf(w, "\t\t[%s] %s\n", mode, c.Name)
+		}
+	}
+	return w
+}
+
+func printSplitTunUsers(w *tabwriter.Writer, users []splittun.User) *tabwriter.Writer {
+	if w == nil {
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	}
+
+	isFirstLineShown := false
+	for _, u := range users {
+		mode := "exclude"
+		if u.IsInclude {
+			mode = "include"
+		}
+		if !isFirstLineShown {
+			isFirstLineShown = true
+			fmt.Fprintf(w, "Split Tunnel users\t:\t[%s] %s\n", mode, u.Name)
+		} else {
+			fmt.Fprintf(w, "\t\t[%s] %s\n", mode, u.Name)
+		}
+	}
+	return w
+}
+
 func printParanoidModeState(w *tabwriter.Writer, helloResp types.HelloResp) *tabwriter.Writer {
 	if w == nil {
 		w = tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
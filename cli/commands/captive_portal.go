@@ -0,0 +1,117 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/cli/helpers"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/captiveportal"
+)
+
+type CmdCaptivePortal struct {
+	flags.CmdInfo
+	check      bool
+	allowLogin bool
+	duration   int
+	relock     bool
+	auto       string // [on/off]
+}
+
+func (c *CmdCaptivePortal) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("captive_portal", "Check if the internet access is restricted by a captive portal (e.g. hotel or airport Wi-Fi login page)")
+	c.BoolVar(&c.check, "check", false, "(default) Check for captive portal")
+	c.BoolVar(&c.allowLogin, "allow_login", false, "Disable the firewall temporarily to log in to the captive portal (VPN must be disconnected)")
+	c.IntVar(&c.duration, "time", 0, "SECONDS", "Time to keep the firewall disabled for the 'allow_login' (default: 5 minutes; max: 15 minutes)")
+	c.BoolVar(&c.relock, "relock", false, "Enable the firewall back after the captive portal login")
+	c.StringVar(&c.auto, "auto", "", "[on/off]", "Enable/disable automatic check on connection attempts\n(the check request is sent to a third-party server: "+captiveportal.ProbeURL+")")
+}
+
+func (c *CmdCaptivePortal) Run() error {
+	if c.allowLogin && c.relock {
+		return flags.BadParameter{Message: "'allow_login' and 'relock' flags can not be used together"}
+	}
+	if c.duration != 0 && !c.allowLogin {
+		return flags.BadParameter{Message: "'time' flag can be used only with 'allow_login'"}
+	}
+
+	if len(c.auto) > 0 {
+		val, err := helpers.BoolParameterParse(c.auto)
+		if err != nil {
+			return err
+		}
+		if err := _proto.SetPreferences(string(types.Prefs_IsCaptivePortalCheck), fmt.Sprint(val)); err != nil {
+			return err
+		}
+		if !c.check && !c.allowLogin && !c.relock {
+			return nil
+		}
+	}
+
+	var (
+		status captiveportal.Status
+		err    error
+	)
+
+	switch {
+	case c.allowLogin:
+		status, err = _proto.CaptivePortalAllowLogin(c.duration)
+	case c.relock:
+		status, err = _proto.CaptivePortalReLock()
+	default:
+		status, err = _proto.CaptivePortalCheck()
+	}
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	switch {
+	case len(status.Error) > 0:
+		fmt.Fprintf(w, "Captive portal\t:\tUnknown (%s)\n", status.Error)
+	case status.IsDetected:
+		fmt.Fprintf(w, "Captive portal\t:\tDetected\n")
+		if len(status.PortalURL) > 0 {
+			fmt.Fprintf(w, "Login page\t:\t%s\n", status.PortalURL)
+		}
+	case status.CheckedAt > 0:
+		fmt.Fprintf(w, "Captive portal\t:\tNot detected\n")
+	}
+	if status.LoginAllowedUntil > 0 {
+		fmt.Fprintf(w, "Firewall\t:\tDisabled for the portal login until %v\n", time.Unix(status.LoginAllowedUntil, 0).Format(time.RFC1123))
+	}
+	w.Flush()
+
+	if status.IsDetected && status.LoginAllowedUntil == 0 && !c.relock {
+		fmt.Println("\nTo log in to the captive portal with the IVPN Firewall enabled, use: ivpn captive_portal -allow_login")
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+)
+
+type CmdClientTokens struct {
+	flags.CmdInfo
+	list   bool
+	add    string
+	scope  string
+	remove string
+}
+
+func (c *CmdClientTokens) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("client_tokens", "Manage access tokens for the clients of the daemon\n(the client authenticated by a token gets the access level of the token; e.g. read-only monitoring clients)\nThe tokens are accepted by the daemon protocol ('AccessToken' field of the 'Hello' request) and by the REST API.")
+	c.BoolVar(&c.list, "list", false, "(default) Show all tokens")
+	c.StringVar(&c.add, "add", "", "NAME", "Create new token")
+	c.StringVar(&c.scope, "scope", string(preferences.ClientScopeReadOnly), "SCOPE", fmt.Sprintf("Access scope of the new token (applicable with '-add'):\n  %s - read-only access (status, statistics)\n  %s - full control", preferences.ClientScopeReadOnly, preferences.ClientScopeFull))
+	c.StringVar(&c.remove, "remove", "", "NAME", "Remove the token (the clients which are using it are disconnected)")
+}
+
+func (c *CmdClientTokens) Run() error {
+	if len(c.add) > 0 && len(c.remove) > 0 {
+		return flags.BadParameter{Message: "'add' and 'remove' flags can not be used together"}
+	}
+	if !preferences.ClientAccessScope(c.scope).IsValid() {
+		return flags.BadParameter{Message: fmt.Sprintf("unknown scope '%s'", c.scope)}
+	}
+
+	if len(c.add) > 0 {
+		token, err := _proto.ClientTokenAdd(c.add, preferences.ClientAccessScope(c.scope))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Token '%s' created (scope: %s):\n", token.Name, token.Scope)
+		fmt.Println(token.Token)
+		return nil
+	}
+
+	if len(c.remove) > 0 {
+		if err := _proto.ClientTokenRemove(c.remove); err != nil {
+			return err
+		}
+		fmt.Printf("Token '%s' removed\n", c.remove)
+		return nil
+	}
+
+	// -list
+	tokens, err := _proto.ClientTokensGet()
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 0 {
+		fmt.Println("No client tokens defined")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "NAME\tSCOPE\tTOKEN\n")
+	for _, t := range tokens {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", t.Name, t.Scope, t.Token)
+	}
+	w.Flush()
+	return nil
+}
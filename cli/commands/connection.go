@@ -27,6 +27,8 @@ import (
 	"fmt"
 	"math/big"
 	"net"
+	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -37,6 +39,8 @@ import (
 	"github.com/ivpn/desktop-app/daemon/service/dns"
 	"github.com/ivpn/desktop-app/daemon/service/srverrors"
 	service_types "github.com/ivpn/desktop-app/daemon/service/types"
+	"github.com/ivpn/desktop-app/daemon/shadowsocks"
+	"github.com/ivpn/desktop-app/daemon/v2r"
 	"github.com/ivpn/desktop-app/daemon/vpn"
 )
 
@@ -104,6 +108,88 @@ func parseObfsproxyParam(param string) (obfsproxy.Config, error) {
 	return obfsproxy.Config{}, fmt.Errorf("unsupported obfsproxy value '%s' (acceptable values: %s)", param, AllowedObfsproxyValues)
 }
 
+// -----------------------------------------------
+const AllowedV2RayValues = "'vmess', 'vless'"
+
+func parseV2RayParam(param string) (v2r.V2RayTransportType, error) {
+	switch strings.ToLower(param) {
+	case "":
+		return v2r.None, nil
+	case "vmess":
+		return v2r.VMess, nil
+	case "vless":
+		return v2r.VLESS, nil
+	}
+
+	return v2r.None, fmt.Errorf("unsupported V2Ray value '%s' (acceptable values: %s)", param, AllowedV2RayValues)
+}
+
+// -----------------------------------------------
+func parseShadowsocksParam(server, method, password string) (shadowsocks.Config, error) {
+	if len(server) == 0 {
+		if len(method) > 0 || len(password) > 0 {
+			return shadowsocks.Config{}, fmt.Errorf("Shadowsocks server not defined")
+		}
+		return shadowsocks.Config{}, nil
+	}
+
+	host, portStr, err := net.SplitHostPort(server)
+	if err != nil {
+		return shadowsocks.Config{}, fmt.Errorf("bad Shadowsocks server address '%s' (expected format IP:PORT)", server)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return shadowsocks.Config{}, fmt.Errorf("bad Shadowsocks server port '%s'", portStr)
+	}
+
+	cfg := shadowsocks.Config{Server: host, Port: port, Method: strings.ToLower(method), Password: password}
+	if err := cfg.Validate(); err != nil {
+		return shadowsocks.Config{}, err
+	}
+	return cfg, nil
+}
+
+// -----------------------------------------------
+func parseSocks5Param(server, username, password string) (service_types.OpenVpnProxy, error) {
+	if len(server) == 0 {
+		if len(username) > 0 || len(password) > 0 {
+			return service_types.OpenVpnProxy{}, fmt.Errorf("SOCKS5 proxy server not defined")
+		}
+		return service_types.OpenVpnProxy{}, nil
+	}
+
+	host, portStr, err := net.SplitHostPort(server)
+	if err != nil {
+		return service_types.OpenVpnProxy{}, fmt.Errorf("bad SOCKS5 proxy address '%s' (expected format IP:PORT)", server)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil {
+		return service_types.OpenVpnProxy{}, fmt.Errorf("bad SOCKS5 proxy port '%s'", portStr)
+	}
+
+	proxy := service_types.OpenVpnProxy{Type: "socks", Address: host, Port: port, Username: username, Password: password}
+	if err := proxy.Validate(false); err != nil {
+		return service_types.OpenVpnProxy{}, err
+	}
+	return proxy, nil
+}
+
+// -----------------------------------------------
+const AllowedTunnelIPValues = "'auto' (default), 'ipv4', 'ipv6'"
+
+func parseTunnelIPParam(param string) (vpn.TunnelIPMode, error) {
+	switch strings.ToLower(param) {
+	case "", "auto":
+		return vpn.TunnelIPAuto, nil
+	case "ipv4":
+		return vpn.TunnelIPv4Only, nil
+	case "ipv6":
+		return vpn.TunnelIPv6Only, nil
+	}
+
+	return vpn.TunnelIPAuto, fmt.Errorf("unsupported tunnel IP mode '%s' (acceptable values: %s)", param, AllowedTunnelIPValues)
+}
+
 type CmdConnect struct {
 	flags.CmdInfo
 	last            bool
@@ -117,8 +203,25 @@ type CmdConnect struct {
 	antitracker     bool
 	antitrackerHard bool
 	isIPv6Tunnel    bool
+	tunnelIP        string // 'auto' (default), 'ipv4', 'ipv6'
+	v2ray           string // 'vmess', 'vless'
 
-	mtu int // MTU value (applicable only for WireGuard)
+	// user-defined Shadowsocks server to chain the connection through
+	shadowsocks string // IP:PORT
+	ssMethod    string
+	ssPassword  string
+
+	// user-defined obfs4 bridge parameters
+	obfs4Cert string
+	obfs4Port int
+
+	// upstream SOCKS5 proxy (applicable only for OpenVPN)
+	socks5     string // IP:PORT
+	socks5User string
+	socks5Pass string
+
+	mtu       int  // MTU value (applicable only for WireGuard)
+	wgOverTcp bool // WireGuard over TCP (applicable only for WireGuard Single-Hop)
 
 	filter_proto       string
 	filter_location    bool
@@ -127,9 +230,17 @@ type CmdConnect struct {
 	filter_countryCode bool
 	filter_invert      bool
 
-	multihopExitSvr string
+	multihopExitSvr  string
+	multihopExitPort int
+
+	// user-defined VPN configuration file (.conf - WireGuard; .ovpn - OpenVPN)
+	configFile string
+	configUser string
+	configPass string
 
-	fastest bool
+	fastest        bool
+	fastestDaemon  bool
+	fastestLowLoad bool
 }
 
 func (c *CmdConnect) Init() {
@@ -146,8 +257,21 @@ func (c *CmdConnect) Init() {
 	obfsproxyUsage := fmt.Sprintf("Use obfsproxy (OpenVPN only)\n  Acceptable values: %s", AllowedObfsproxyValues)
 	c.StringVar(&c.obfsproxy, "o", "", "TYPE", obfsproxyUsage)
 	c.StringVar(&c.obfsproxy, "obfsproxy", "", "TYPE", obfsproxyUsage)
+	c.StringVar(&c.obfs4Cert, "obfs4_cert", "", "CERT", "Public certificate of custom obfs4 bridge (obfs4 only)\n  (the 'cert=...' value of the bridge line; by default, the value from the servers list is in use)")
+	c.IntVar(&c.obfs4Port, "obfs4_port", 0, "PORT", "Port of custom obfs4 bridge (obfs4 only; Single-Hop connections only)\n  (by default, the value from the servers list is in use)")
+
+	c.StringVar(&c.v2ray, "v2ray", "", "TYPE", fmt.Sprintf("Use V2Ray transport to wrap the VPN traffic\n  Acceptable values: %s", AllowedV2RayValues))
+
+	c.StringVar(&c.shadowsocks, "shadowsocks", "", "IP:PORT", "Chain the VPN connection through the Shadowsocks server\n  (requires '-ss_method' and '-ss_password')")
+	c.StringVar(&c.ssMethod, "ss_method", "", "METHOD", fmt.Sprintf("Shadowsocks encryption method\n  Acceptable values: %s", strings.Join(shadowsocks.SupportedMethods, ", ")))
+	c.StringVar(&c.ssPassword, "ss_password", "", "PASSWORD", "Shadowsocks password")
+
+	c.StringVar(&c.socks5, "socks5", "", "IP:PORT", "Connect through the upstream SOCKS5 proxy (OpenVPN only)\n  (for networks where direct connection to the VPN server is restricted)")
+	c.StringVar(&c.socks5User, "socks5_user", "", "USERNAME", "SOCKS5 proxy username (optional; requires '-socks5_pass')")
+	c.StringVar(&c.socks5Pass, "socks5_pass", "", "PASSWORD", "SOCKS5 proxy password")
 
-	c.StringVar(&c.multihopExitSvr, "exit_svr", "", "LOCATION", "Exit-server for Multi-Hop connection\n  (use full serverID as a parameter, servers filtering not applicable for it)")
+	c.StringVar(&c.multihopExitSvr, "exit_svr", "", "LOCATION", "Exit-server for Multi-Hop connection\n  (use full serverID as a parameter, servers filtering not applicable for it)\n  Tip: use exit host name (e.g. 'us-tx1.wg.ivpn.net') to connect to the specific exit host")
+	c.IntVar(&c.multihopExitPort, "exit_port", 0, "PORT", "Multi-Hop port of the exit host (optional; only the exit hosts with this port are in use)")
 
 	c.BoolVar(&c.firewallOff, "fw_off", false, "Do not enable firewall for this connection\n  (has effect only if Firewall not enabled before)")
 
@@ -156,6 +280,7 @@ func (c *CmdConnect) Init() {
 	c.BoolVar(&c.antitracker, "antitracker", false, "Enable AntiTracker for this connection")
 	c.BoolVar(&c.antitrackerHard, "antitracker_hard", false, "Enable 'Hard Core' AntiTracker for this connection")
 	c.BoolVar(&c.isIPv6Tunnel, "ipv6tunnel", false, "Enable IPv6 in VPN tunnel (WireGuard connections only)\n  (IPv6 addresses are preferred when a host has a dual stack IPv6/IPv4; IPv4-only hosts are unaffected)")
+	c.StringVar(&c.tunnelIP, "tunnel_ip", "", "MODE", fmt.Sprintf("IP protocols to tunnel (WireGuard connections only)\n  Acceptable values: %s\n  'ipv4' - tunnel only IPv4 (IPv6 is blocked)\n  'ipv6' - tunnel only IPv6 (IPv4 is blocked by the firewall; IPv6 DNS required)", AllowedTunnelIPValues))
 
 	// filters
 	c.StringVar(&c.filter_proto, "p", "", "PROTOCOL", "Protocol type (OpenVPN|ovpn|WireGuard|wg)")
@@ -175,10 +300,17 @@ func (c *CmdConnect) Init() {
 	c.BoolVar(&c.filter_invert, "filter_invert", false, "Invert filtering")
 
 	c.BoolVar(&c.fastest, "fastest", false, "Connect to fastest server")
+	c.BoolVar(&c.fastestDaemon, "fastest_daemon", false, "(with '-fastest') The fastest server is chosen by the daemon on each connection\n  (the servers found by LOCATION and filters are used as candidates)")
+	c.BoolVar(&c.fastestLowLoad, "fastest_low_load", false, "(with '-fastest_daemon') Prefer servers with lower load")
 
 	c.BoolVar(&c.last, "last", false, "Connect with the last used connection parameters")
 
+	c.StringVar(&c.configFile, "config", "", "FILE", "Connect using the custom VPN configuration file (e.g. for self-hosted servers)\n  Supported files: WireGuard ('.conf') and OpenVPN ('.ovpn')\n  Note: the server location arguments are not applicable")
+	c.StringVar(&c.configUser, "config_user", "", "USERNAME", "Username for the custom OpenVPN configuration (if required by configuration)")
+	c.StringVar(&c.configPass, "config_pass", "", "PASSWORD", "Password for the custom OpenVPN configuration (if required by configuration)")
+
 	c.IntVar(&c.mtu, "mtu", 0, "MTU", "Maximum transmission unit (applicable only for WireGuard connections)")
+	c.BoolVar(&c.wgOverTcp, "wg_tcp", false, "Encapsulate WireGuard traffic into TCP (for networks where UDP is blocked)\n  (applicable only for WireGuard Single-Hop connections; port definition is ignored)")
 }
 
 func (c *CmdConnect) preParse(arguments []string) ([]string, error) {
@@ -223,13 +355,21 @@ func (c *CmdConnect) preParse(arguments []string) ([]string, error) {
 
 // Run executes command
 func (c *CmdConnect) Run() (retError error) {
+	if len(c.configFile) > 0 {
+		return c.connectCustomConfig()
+	}
 
 	if len(c.gateway) == 0 && c.fastest == false && c.any == false && c.last == false && c.portsShow == false {
 		return flags.BadParameter{}
 	}
+	if (c.fastestDaemon && !c.fastest) || (c.fastestLowLoad && !c.fastestDaemon) {
+		return flags.BadParameter{Message: "'fastest_daemon' flag requires '-fastest'; 'fastest_low_load' flag requires '-fastest_daemon'"}
+	}
 
 	// connection request
 	req := types.Connect{}
+	// (only for '-fastest_daemon') gateway IDs of servers allowed for the fastest server selection
+	var fastestLocations []string
 
 	// get servers list from daemon
 	serverFound := false
@@ -245,6 +385,49 @@ func (c *CmdConnect) Run() (retError error) {
 	if err != nil {
 		return flags.BadParameter{Message: err.Error()}
 	}
+	if len(c.obfs4Cert) > 0 || c.obfs4Port != 0 {
+		if obfsproxyCfg.Version != obfsproxy.OBFS4 {
+			return flags.BadParameter{Message: "obfs4 bridge parameters are applicable only for obfs4 connections"}
+		}
+		obfsproxyCfg.Obfs4Bridge = obfsproxy.Obfs4Bridge{Cert: c.obfs4Cert, Port: c.obfs4Port}
+		if err := obfsproxyCfg.Validate(); err != nil {
+			return flags.BadParameter{Message: err.Error()}
+		}
+	}
+
+	tunnelIPMode, err := parseTunnelIPParam(c.tunnelIP)
+	if err != nil {
+		return flags.BadParameter{Message: err.Error()}
+	}
+
+	v2rayType, err := parseV2RayParam(c.v2ray)
+	if err != nil {
+		return flags.BadParameter{Message: err.Error()}
+	}
+	if v2rayType.IsEnabled() && obfsproxyCfg.IsObfsproxy() {
+		return flags.BadParameter{Message: "V2Ray can not be used together with obfsproxy"}
+	}
+
+	shadowsocksCfg, err := parseShadowsocksParam(c.shadowsocks, c.ssMethod, c.ssPassword)
+	if err != nil {
+		return flags.BadParameter{Message: err.Error()}
+	}
+	if shadowsocksCfg.IsEnabled() {
+		if obfsproxyCfg.IsObfsproxy() || v2rayType.IsEnabled() {
+			return flags.BadParameter{Message: "Shadowsocks can not be used together with obfsproxy or V2Ray"}
+		}
+		if c.wgOverTcp {
+			return flags.BadParameter{Message: "Shadowsocks can not be used together with WireGuard-over-TCP"}
+		}
+	}
+
+	socks5Proxy, err := parseSocks5Param(c.socks5, c.socks5User, c.socks5Pass)
+	if err != nil {
+		return flags.BadParameter{Message: err.Error()}
+	}
+	if socks5Proxy.IsDefined() && (obfsproxyCfg.IsObfsproxy() || v2rayType.IsEnabled() || shadowsocksCfg.IsEnabled()) {
+		return flags.BadParameter{Message: "SOCKS5 proxy can not be used together with obfsproxy, V2Ray or Shadowsocks"}
+	}
 
 	// check is logged-in
 	helloResp := _proto.GetHelloResponse()
@@ -313,6 +496,10 @@ func (c *CmdConnect) Run() (retError error) {
 				return err
 			}
 
+			if c.multihopExitPort < 0 || c.multihopExitPort > 65535 {
+				return flags.BadParameter{Message: "invalid Multi-Hop exit port [exit_port]"}
+			}
+
 			if c.fastest {
 				return flags.BadParameter{Message: "'fastest' flag is not applicable for Multi-Hop connection [exit_svr]"}
 			}
@@ -349,7 +536,16 @@ func (c *CmdConnect) Run() (retError error) {
 			srvID := ""
 
 			// Fastest server
-			if c.fastest && len(svrs) > 1 {
+			if c.fastest && c.fastestDaemon && len(svrs) > 0 {
+				// the fastest server will be chosen by the daemon: only the list of candidates is required
+				// (the first found server is just used to define the connection parameters)
+				if len(c.gateway) > 0 {
+					for _, s := range svrs {
+						fastestLocations = append(fastestLocations, strings.Split(s.gateway, ".")[0])
+					}
+				}
+				srvID = svrs[0].gateway
+			} else if c.fastest && len(svrs) > 1 {
 				var vpnType *vpn.Type = nil
 				if len(c.filter_proto) > 0 {
 					if p, err := getVpnTypeByFlag(c.filter_proto); err == nil {
@@ -423,6 +619,32 @@ func (c *CmdConnect) Run() (retError error) {
 			}
 		}
 
+		// V2Ray
+		if v2rayType.IsEnabled() && len(helloResp.DisabledFunctions.V2RayError) > 0 {
+			return fmt.Errorf(helloResp.DisabledFunctions.V2RayError)
+		}
+		if helloResp.DaemonSettings.V2RayProxy != v2rayType {
+			if err = _proto.SetV2RayProxy(v2rayType); err != nil {
+				return err
+			}
+		}
+		if v2rayType.IsEnabled() {
+			fmt.Println("V2Ray: " + v2rayType.String())
+		}
+
+		// Shadowsocks
+		if shadowsocksCfg.IsEnabled() && len(helloResp.DisabledFunctions.ShadowsocksError) > 0 {
+			return fmt.Errorf(helloResp.DisabledFunctions.ShadowsocksError)
+		}
+		if !helloResp.DaemonSettings.ShadowsocksProxy.Equals(shadowsocksCfg) {
+			if err = _proto.SetShadowsocksProxy(shadowsocksCfg); err != nil {
+				return err
+			}
+		}
+		if shadowsocksCfg.IsEnabled() {
+			fmt.Println("Shadowsocks: " + net.JoinHostPort(shadowsocksCfg.Server, strconv.Itoa(shadowsocksCfg.Port)))
+		}
+
 		// Looking for connection server
 
 		// WireGuard
@@ -452,10 +674,15 @@ func (c *CmdConnect) Run() (retError error) {
 				if s.Gateway == c.gateway {
 					entrySvrWg = &servers.WireguardServers[i]
 
+					if socks5Proxy.IsDefined() {
+						return flags.BadParameter{Message: "SOCKS5 proxy is applicable only for OpenVPN connections"}
+					}
+
 					serverFound = true
 					req.Params.VpnType = vpn.WireGuard
 					req.Params.WireGuardParameters.EntryVpnServer.Hosts = funcApplyCustomHost(s.Hosts, customHostEntryServer)
 					req.Params.IPv6 = c.isIPv6Tunnel
+					req.Params.TunnelIPMode = tunnelIPMode
 
 					if c.mtu > 0 {
 						fmt.Printf("[!] Using custom MTU: %d\n", c.mtu)
@@ -471,8 +698,16 @@ func (c *CmdConnect) Run() (retError error) {
 						}
 						req.Params.WireGuardParameters.Port.Port = p.port
 
-						fmt.Printf("[WireGuard] Connecting to: %s, %s (%s) %s %s...\n", s.City, s.CountryCode, s.Country, s.Gateway, p.String())
+						if c.wgOverTcp {
+							req.Params.WireGuardParameters.TcpEncapsulation = true
+							fmt.Printf("[WireGuard over TCP] Connecting to: %s, %s (%s) %s...\n", s.City, s.CountryCode, s.Country, s.Gateway)
+						} else {
+							fmt.Printf("[WireGuard] Connecting to: %s, %s (%s) %s %s...\n", s.City, s.CountryCode, s.Country, s.Gateway, p.String())
+						}
 					} else {
+						if c.wgOverTcp {
+							return flags.BadParameter{Message: "'wg_tcp' flag is not applicable for Multi-Hop connections"}
+						}
 						if exitSvrWg == nil {
 							return fmt.Errorf("serverID not found in servers list (%s)", c.multihopExitSvr)
 						}
@@ -485,6 +720,7 @@ func (c *CmdConnect) Run() (retError error) {
 
 						req.Params.WireGuardParameters.MultihopExitServer.ExitSrvID = strings.Split(exitSvrWg.Gateway, ".")[0]
 						req.Params.WireGuardParameters.MultihopExitServer.Hosts = funcApplyCustomHost(exitSvrWg.Hosts, customHostExitServer)
+						req.Params.WireGuardParameters.MultihopExitServer.Port = c.multihopExitPort
 
 						fmt.Printf("[WireGuard] Connecting Multi-Hop...\n")
 						fmt.Printf("\tentry server: %s, %s (%s) %s\n", entrySvrWg.City, entrySvrWg.CountryCode, entrySvrWg.Country, entrySvrWg.Gateway)
@@ -539,6 +775,10 @@ func (c *CmdConnect) Run() (retError error) {
 						fmt.Println("obfsproxy configuration: " + obfsproxyCfg.ToString())
 					}
 
+					if tunnelIPMode == vpn.TunnelIPv6Only {
+						return fmt.Errorf("IPv6-only tunnel is applicable only for WireGuard connections")
+					}
+
 					serverFound = true
 					req.Params.VpnType = vpn.OpenVPN
 					req.Params.OpenVpnParameters.EntryVpnServer.Hosts = funcApplyCustomHost(s.Hosts, customHostEntryServer)
@@ -562,12 +802,18 @@ func (c *CmdConnect) Run() (retError error) {
 						// get Multi-Hop ID
 						req.Params.OpenVpnParameters.MultihopExitServer.ExitSrvID = strings.Split(c.multihopExitSvr, ".")[0]
 						req.Params.OpenVpnParameters.MultihopExitServer.Hosts = funcApplyCustomHost(exitSvrOvpn.Hosts, customHostExitServer)
+						req.Params.OpenVpnParameters.MultihopExitServer.Port = c.multihopExitPort
 						destPort.port = 0 // do not use port number (port-based multihop)
 					}
 
 					req.Params.OpenVpnParameters.Port.Port = destPort.port
 					req.Params.OpenVpnParameters.Port.Protocol = destPort.IsTCP()
 
+					if socks5Proxy.IsDefined() {
+						req.Params.OpenVpnParameters.Proxy = socks5Proxy
+						fmt.Println("SOCKS5 proxy: " + net.JoinHostPort(socks5Proxy.Address, strconv.Itoa(socks5Proxy.Port)))
+					}
+
 					break
 				}
 			}
@@ -641,6 +887,13 @@ func (c *CmdConnect) Run() (retError error) {
 		// metadata
 		if c.fastest {
 			req.Params.Metadata.ServerSelectionEntry = service_types.Fastest
+			if c.fastestDaemon {
+				req.Params.Metadata.FastestCriteria = service_types.FastestServerCriteria{
+					ResolveOnConnect: true,
+					Locations:        fastestLocations,
+					PreferLowLoad:    c.fastestLowLoad,
+				}
+			}
 		} else if c.any {
 			req.Params.Metadata.ServerSelectionEntry = service_types.Random
 		}
@@ -660,6 +913,60 @@ func (c *CmdConnect) Run() (retError error) {
 	return nil
 }
 
+// connectCustomConfig establishes the VPN connection using the user-defined configuration file
+func (c *CmdConnect) connectCustomConfig() error {
+	if len(c.gateway) > 0 || c.fastest || c.any || c.last || len(c.multihopExitSvr) > 0 {
+		return flags.BadParameter{Message: "server location arguments are not applicable for custom VPN configuration [config]"}
+	}
+	if c.antitracker || c.antitrackerHard {
+		return flags.BadParameter{Message: "AntiTracker is not applicable for custom VPN configuration [config]"}
+	}
+
+	req := types.Connect{}
+	switch strings.ToLower(filepath.Ext(c.configFile)) {
+	case ".conf":
+		req.Params.VpnType = vpn.WireGuard
+	case ".ovpn":
+		req.Params.VpnType = vpn.OpenVPN
+	default:
+		return flags.BadParameter{Message: "unsupported configuration file type (expected '.conf' or '.ovpn') [config]"}
+	}
+
+	data, err := os.ReadFile(c.configFile)
+	if err != nil {
+		return fmt.Errorf("failed to read configuration file: %w", err)
+	}
+	req.Params.CustomConfig = service_types.CustomConfig{Text: string(data), Username: c.configUser, Password: c.configPass}
+
+	if len(c.dns) > 0 {
+		dnsIp := net.ParseIP(c.dns)
+		if dnsIp == nil {
+			return flags.BadParameter{}
+		}
+		req.Params.ManualDNS = dns.DnsSettings{DnsHost: dnsIp.String(), Encryption: dns.EncryptionNone}
+	}
+
+	// Firewall for current connection
+	req.Params.FirewallOnDuringConnection = true
+	if c.firewallOff {
+		state, err := _proto.FirewallStatus()
+		if err != nil {
+			return fmt.Errorf("unable to check Firewall state: %w", err)
+		}
+		if !state.IsEnabled {
+			req.Params.FirewallOnDuringConnection = false
+		} else {
+			fmt.Println("WARNING! Firewall option ignored (Firewall already enabled manually)")
+		}
+	}
+
+	fmt.Printf("[%s] Connecting using custom configuration '%s'...\n", req.Params.VpnType, c.configFile)
+	if _, err = _proto.ConnectVPN(req); err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	return nil
+}
+
 func getPort(portInfo string, allowedPorts []apitypes.PortInfo) (port, error) {
 	var err error
 	var portPtr *int
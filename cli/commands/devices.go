@@ -0,0 +1,147 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	apitypes "github.com/ivpn/desktop-app/daemon/api/types"
+)
+
+type CmdDevices struct {
+	flags.CmdInfo
+	list      bool
+	logout    string
+	accountID string
+}
+
+func (c *CmdDevices) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("devices", "Manage the devices logged in to the account")
+	c.BoolVar(&c.list, "list", false, "(default) Show the devices logged in to the account")
+	c.StringVar(&c.logout, "logout", "", "DEVICE_ID", "Log out the device")
+	c.StringVar(&c.accountID, "account", "", "ACCOUNT_ID", "Use the Account ID for authentication (when not logged in)\n  Useful to resolve the devices limit error during login")
+}
+
+func (c *CmdDevices) Run() error {
+	if len(c.accountID) == 0 {
+		_proto.SessionStatus() // do not check error response (could be received 'not logged in' errors)
+		if len(_proto.GetHelloResponse().Session.Session) == 0 {
+			return flags.BadParameter{Message: "not logged in (use '-account' flag to authenticate by the Account ID)"}
+		}
+	}
+
+	topt := ""
+	if len(c.logout) > 0 {
+		apiStatus, err := _proto.DeviceLogout(c.accountID, topt, c.logout)
+		if apiStatus == apitypes.The2FARequired {
+			topt = readInput("Account has two-factor authentication enabled. Please enter TOTP token: ")
+			_, err = _proto.DeviceLogout(c.accountID, topt, c.logout)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Println("Device logged out")
+	}
+
+	// -list
+	apiStatus, devices, err := _proto.DevicesList(c.accountID, topt)
+	if apiStatus == apitypes.The2FARequired {
+		topt = readInput("Account has two-factor authentication enabled. Please enter TOTP token: ")
+		_, devices, err = _proto.DevicesList(c.accountID, topt)
+	}
+	if err != nil {
+		return err
+	}
+
+	printDevices(devices)
+	if len(c.logout) == 0 && len(devices) > 0 {
+		PrintTips([]TipType{TipDevicesLogout})
+	}
+	return nil
+}
+
+func printDevices(devices []apitypes.DeviceInfo) {
+	if len(devices) == 0 {
+		fmt.Println("No devices")
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(w, "#\tDEVICE ID\tNAME\tPLATFORM\tLOGGED IN\t\n")
+	for i, d := range devices {
+		name := d.Name
+		if d.IsCurrent {
+			name += " (this device)"
+		}
+		created := ""
+		if d.CreatedAt > 0 {
+			created = time.Unix(d.CreatedAt, 0).Format("2006-01-02 15:04")
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t\n", i+1, d.ID, name, d.Platform, created)
+	}
+	w.Flush()
+}
+
+// resolveDevicesLimit shows the devices of the account and offers to log out one of them
+// (used on the devices limit error during login).
+// Returns 'true' when the device was logged out.
+func resolveDevicesLimit(accountID string, topt string) (bool, error) {
+	_, devices, err := _proto.DevicesList(accountID, topt)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the account devices: %w", err)
+	}
+	if len(devices) == 0 {
+		return false, nil
+	}
+
+	fmt.Println("You have reached the maximum number of devices logged in to your account:")
+	printDevices(devices)
+	fmt.Print("Enter the number of the device to log out (empty to cancel): ")
+
+	reader := bufio.NewReader(os.Stdin)
+	answer, _ := reader.ReadString('\n')
+	answer = strings.TrimSpace(answer)
+	if len(answer) == 0 {
+		return false, nil
+	}
+
+	idx, err := strconv.Atoi(answer)
+	if err != nil || idx < 1 || idx > len(devices) {
+		return false, fmt.Errorf("wrong device number '%s'", answer)
+	}
+
+	if _, err := _proto.DeviceLogout(accountID, topt, devices[idx-1].ID); err != nil {
+		return false, fmt.Errorf("failed to log out the device: %w", err)
+	}
+	fmt.Printf("Device '%s' logged out\n", devices[idx-1].Name)
+	return true, nil
+}
@@ -43,6 +43,7 @@ type CmdDns struct {
 	dns                  string
 	dohTemplate          string
 	dotTemplate          string
+	searchDomains        string
 	linuxManagementStyle string // LinuxDnsMgmt
 }
 
@@ -57,6 +58,7 @@ const (
 	ArgName_DoH        = "doh"
 	ArgName_DoT        = "dot"
 	ArgName_Management = "management"
+	ArgName_Search     = "search"
 )
 
 func IsParamApplicable_LinuxForceModifyResolvconf() (bool, error) {
@@ -91,6 +93,9 @@ func (c *CmdDns) Init() {
 	if cliplatform.IsDnsOverTlsSupported() {
 		c.StringVar(&c.dotTemplate, ArgName_DoT, "", "URI", "DNS-over-TLS URI template")
 	}
+	if cliplatform.IsDnsSearchDomainsSupported() {
+		c.StringVar(&c.searchDomains, ArgName_Search, "", "DOMAINS", "Comma-separated list of DNS search domains (suffixes) for the VPN interface\n(the first domain is used as the connection-specific DNS suffix)\n  Example: ivpn dns -search corp.example.com,example.com 10.0.0.53")
+	}
 
 	// "force_use_resolvconf" is applicable only for linux AND only if both types of DNS management can be applied
 	if runtime.GOOS == "linux" {
@@ -121,6 +126,10 @@ func (c *CmdDns) Run() error {
 		return flags.BadParameter{}
 	}
 
+	if len(c.searchDomains) > 0 && len(c.dns) == 0 {
+		return flags.BadParameter{Message: fmt.Sprintf("option '-%s' is applicable only together with DNS_IP", ArgName_Search)}
+	}
+
 	hr := _proto.GetHelloResponse()
 	uPrefs := hr.DaemonSettings.UserPrefs
 
@@ -174,6 +183,9 @@ func (c *CmdDns) Run() error {
 				defManualDns.Encryption = dns.EncryptionDnsOverTls
 				defManualDns.DohTemplate = c.dotTemplate
 			}
+			if defManualDns.SearchDomains, err = dns.ParseSearchDomains(c.searchDomains); err != nil {
+				return flags.BadParameter{Message: err.Error()}
+			}
 		}
 
 		if err := _proto.SetManualDNS(defManualDns, service_types.AntiTrackerMetadata{}); err != nil {
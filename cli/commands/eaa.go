@@ -115,6 +115,10 @@ func (c *CmdParanoidMode) Run() error {
 			}
 		}
 
+		if daemonSettings.Schedule.IsEnabled {
+			fmt.Print("Warning! 'Connection scheduler' will not be applied\n         (until the EAA password is entered in Graphical User Interface application)\n\n")
+		}
+
 		fmt.Print("\tEnter new password: ")
 		data, err := term.ReadPassword(int(syscall.Stdin))
 		if err != nil {
@@ -0,0 +1,192 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+type CmdExec struct {
+	flags.CmdInfo
+	command string // this parameter is not in use. We need it just for help info. (using commandArgs after special parsing)
+	profile string
+	fw      bool
+
+	commandArgs []string
+}
+
+func (c *CmdExec) Init() {
+	c.SetPreParseFunc(c.preParse)
+
+	c.Initialize("exec", "Connect VPN, run command and disconnect VPN when the command finished\n(returns the exit code of the command; the initial VPN and Firewall states are restored)\nIf VPN is already connected - the command runs using the active connection\nExamples:\n    ivpn exec -- curl https://api.ivpn.net/v4/geo-lookup\n    ivpn exec -profile work -fw -- rsync -a ./data backup:/data")
+	c.DefaultStringVar(&c.command, "-- COMMAND")
+	c.StringVar(&c.profile, "profile", "", "NAME", "Connect using connection profile NAME\n  (by default, the last used connection parameters are in use)")
+	c.BoolVar(&c.fw, "fw", false, "Enable Firewall while the command is running\n  (the command has access only to the VPN tunnel)")
+}
+
+// preParse - all arguments after '--' are the command to execute
+func (c *CmdExec) preParse(arguments []string) ([]string, error) {
+	for idx, arg := range arguments {
+		if arg == "--" {
+			c.commandArgs = arguments[idx+1:]
+			return arguments[:idx], nil
+		}
+	}
+	return arguments, nil
+}
+
+func (c *CmdExec) Run() error {
+	if len(c.commandArgs) == 0 {
+		return flags.BadParameter{Message: "command not defined (use '--' to separate the command from the options)"}
+	}
+	if len(c.command) > 0 {
+		return flags.BadParameter{Message: fmt.Sprintf("unexpected argument '%s' (use '--' to separate the command from the options)", c.command)}
+	}
+
+	binary, err := exec.LookPath(c.commandArgs[0])
+	if err != nil {
+		return err
+	}
+
+	// save initial state
+	state, _, err := _proto.GetVPNState()
+	if err != nil {
+		return err
+	}
+	fwState, err := _proto.FirewallStatus()
+	if err != nil {
+		return err
+	}
+	isConnectedInitially := state == vpn.CONNECTED
+
+	// exit code of the command
+	exitCode := 0
+
+	// restore initial state on exit
+	defer func() {
+		if !isConnectedInitially {
+			fmt.Fprintln(os.Stderr, "[ivpn] Disconnecting...")
+			if err := _proto.DisconnectVPN(); err != nil {
+				fmt.Fprintf(os.Stderr, "[ivpn] Failed to disconnect: %v\n", err)
+			}
+		}
+		if c.fw && !fwState.IsEnabled {
+			if err := _proto.FirewallSet(false); err != nil {
+				fmt.Fprintf(os.Stderr, "[ivpn] Failed to disable Firewall: %v\n", err)
+			}
+		}
+		if exitCode != 0 {
+			os.Exit(exitCode)
+		}
+	}()
+
+	if c.fw && !fwState.IsEnabled {
+		fmt.Fprintln(os.Stderr, "[ivpn] Enabling Firewall...")
+		if err := _proto.FirewallSet(true); err != nil {
+			return err
+		}
+	}
+
+	if isConnectedInitially {
+		if len(c.profile) > 0 {
+			fmt.Fprintln(os.Stderr, "[ivpn] Note: VPN is already connected; the '-profile' argument is ignored")
+		}
+	} else {
+		if err := c.connect(); err != nil {
+			return err
+		}
+	}
+
+	// ensure the connection is established
+	if state, _, err = _proto.GetVPNState(); err != nil {
+		return err
+	}
+	if state != vpn.CONNECTED {
+		return fmt.Errorf("VPN is not connected (state: %s)", state)
+	}
+
+	// (the deferred function exits with the command's exit code after restoring the initial state)
+	exitCode, err = runCommand(binary, c.commandArgs)
+	return err
+}
+
+func (c *CmdExec) connect() error {
+	fmt.Fprintln(os.Stderr, "[ivpn] Connecting...")
+
+	var err error
+	if len(c.profile) > 0 {
+		_, err = _proto.ConnectionProfileConnect(c.profile)
+	} else {
+		var settings types.ConnectSettings
+		if settings, err = _proto.GetDefConnectionParams(); err != nil {
+			return err
+		}
+		if err := settings.Params.CheckIsDefined(); err != nil {
+			return fmt.Errorf("no connection parameters (please, connect first or use '-profile'): %w", err)
+		}
+		req := types.Connect{Params: settings.Params}
+		req.Params.FirewallOnDuringConnection = true
+		_, err = _proto.ConnectVPN(req)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to connect: %w", err)
+	}
+	fmt.Fprintln(os.Stderr, "[ivpn] Connected")
+	return nil
+}
+
+// runCommand runs the command and waits until it finished. Returns the exit code of the command.
+func runCommand(binary string, args []string) (exitCode int, err error) {
+	cmd := exec.Command(binary, args[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	// The interrupt signal (Ctrl+C) is received by the command (the same process group).
+	// Do not stop here: the initial VPN state must be restored after the command finished.
+	// Note: signal.Ignore() is not in use because the ignored signals are inherited by the child process.
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt)
+	defer signal.Stop(sigChan)
+
+	if err := cmd.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			if code := exitErr.ExitCode(); code > 0 {
+				return code, nil
+			}
+			return 1, nil // terminated by signal
+		}
+		return 0, err
+	}
+	return 0, nil
+}
@@ -24,8 +24,12 @@ package commands
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
 )
 
 type CmdFirewall struct {
@@ -40,6 +44,10 @@ type CmdFirewall struct {
 	persistentOn       bool
 	persistentOff      bool
 	exceptions         string
+	mssClamp           int
+	lanServices        string
+	lanHosts           string
+	lanInboundPorts    string
 	//allowLanMulticast bool
 	//blockLanMulticast bool
 }
@@ -58,6 +66,10 @@ func (c *CmdFirewall) Init() {
 	c.BoolVar(&c.persistentOff, "persistent_off", false, "Persistent firewall (Always-on firewall): disable")
 	c.BoolVar(&c.persistentOn, "persistent_on", false, "Persistent firewall (Always-on firewall): enable. When the option is enabled the IVPN Firewall is started during system boot")
 	c.StringVar(&c.exceptions, "exceptions", StringValueNoData, "EXCEPTIONS", "Set configuration: comma-separated list of IP addresses or subnets (using CIDR notation)\nthat will be allowed through the firewall when enabled\nExamples:\n\tivpn firewall -exceptions '192.0.2.0/24, 198.51.100.1'\n\tivpn firewall -exceptions ''")
+	c.StringVar(&c.lanServices, "lan_services", StringValueNoData, "SERVICES", "Set configuration: comma-separated list of LAN services allowed when LAN communication is blocked\n(supported: "+strings.Join(lansvc.AllNames(), ", ")+")\nExamples:\n\tivpn firewall -lan_services 'mdns,printing'\n\tivpn firewall -lan_services ''")
+	c.StringVar(&c.lanHosts, "lan_hosts", StringValueNoData, "HOSTS", "Set configuration: comma-separated list of LAN hosts (local IP addresses) allowed when LAN communication is blocked\nExamples:\n\tivpn firewall -lan_hosts '192.168.1.10, 192.168.1.20'\n\tivpn firewall -lan_hosts ''")
+	c.StringVar(&c.lanInboundPorts, "lan_inbound_ports", StringValueNoData, "PORTS", "Set configuration: comma-separated list of local ports which accept incoming connections from LAN when LAN communication is blocked\nFormat: PORT[/tcp|/udp] (default protocol: tcp)\nExamples:\n\tivpn firewall -lan_inbound_ports '22/tcp, 3000'\n\tivpn firewall -lan_inbound_ports ''")
+	c.IntVar(&c.mssClamp, "mss_clamp", -1, "MSS", "Set configuration: clamp TCP MSS of connections through the VPN tunnel to this value (0 - disable)\nFixes hanging TLS connections when the path MTU is lower than the MTU of the tunnel (e.g. PPPoE links)\nApplicable for Linux and Windows; takes effect regardless of the firewall state\nExample:\n\tivpn firewall -mss_clamp 1360")
 	//c.BoolVar(&c.allowLanMulticast, "lan_multicast_allow", false, "Same as 'lan_allow' + allow multicast communication ")
 	//c.BoolVar(&c.blockLanMulticast, "lan_multicast_block", false, "Same as 'lan_block' + block multicast communication")
 }
@@ -118,6 +130,38 @@ func (c *CmdFirewall) Run() error {
 		}
 	}
 
+	if c.lanServices != StringValueNoData {
+		services, err := lansvc.Parse(c.lanServices)
+		if err != nil {
+			return err
+		}
+		if err := _proto.FirewallAllowLanServices(services); err != nil {
+			return err
+		}
+	}
+
+	if c.lanHosts != StringValueNoData {
+		if err := _proto.FirewallSetAllowedLanHosts(c.lanHosts); err != nil {
+			return err
+		}
+	}
+
+	if c.lanInboundPorts != StringValueNoData {
+		ports, err := lansvc.ParseInboundPorts(c.lanInboundPorts)
+		if err != nil {
+			return err
+		}
+		if err := _proto.FirewallSetLanInboundPorts(ports); err != nil {
+			return err
+		}
+	}
+
+	if c.mssClamp >= 0 {
+		if err := _proto.SetPreferences(string(types.Prefs_FwMssClamp), strconv.Itoa(c.mssClamp)); err != nil {
+			return err
+		}
+	}
+
 	if c.persistentOn {
 		if err := _proto.FirewallPersistentSet(true); err != nil {
 			return err
@@ -153,7 +197,7 @@ func (c *CmdFirewall) Run() error {
 		return err
 	}
 
-	w := printFirewallState(nil, state.IsEnabled, state.IsPersistent, state.IsAllowLAN, state.IsAllowMulticast, state.IsAllowApiServers, state.UserExceptions, nil)
+	w := printFirewallState(nil, state.IsEnabled, state.IsPersistent, state.IsAllowLAN, state.IsAllowMulticast, state.IsAllowApiServers, state.UserExceptions, state.AllowLANServices, state.AllowedLANHosts, state.LANInboundPorts, nil)
 	w.Flush()
 
 	// TIPS
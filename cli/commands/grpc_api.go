@@ -0,0 +1,99 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+)
+
+type CmdGrpcApi struct {
+	flags.CmdInfo
+	status     bool
+	on         bool
+	off        bool
+	port       int
+	resetToken bool
+}
+
+func (c *CmdGrpcApi) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("grpc_api", "Manage local gRPC API of the daemon\n(typed interface on 127.0.0.1 for third-party integrations; service definition: daemon/protocol/grpcapi/ivpn.proto)")
+	c.BoolVar(&c.status, "status", false, "(default) Show settings (including the access token)")
+	c.BoolVar(&c.on, "on", false, "Enable gRPC API")
+	c.BoolVar(&c.off, "off", false, "Disable gRPC API")
+	c.IntVar(&c.port, "port", 0, "PORT", "TCP port of the gRPC API (applicable with '-on')")
+	c.BoolVar(&c.resetToken, "reset_token", false, "Generate new access token (the old token is not valid anymore)")
+}
+
+func (c *CmdGrpcApi) Run() error {
+	if c.on && c.off {
+		return flags.BadParameter{Message: "'on' and 'off' flags can not be used together"}
+	}
+	if c.port != 0 && !c.on {
+		return flags.BadParameter{Message: "'port' flag is applicable only with 'on'"}
+	}
+	if c.port != 0 && (c.port < 1024 || c.port > 65535) {
+		return flags.BadParameter{Message: "port must be in range 1024-65535"}
+	}
+
+	params, err := _proto.GrpcApiGet()
+	if err != nil {
+		return err
+	}
+
+	if c.on || c.off || c.resetToken {
+		isEnabled := params.IsEnabled
+		if c.on {
+			isEnabled = true
+		} else if c.off {
+			isEnabled = false
+		}
+		if params, err = _proto.SetGrpcApi(isEnabled, c.port, c.resetToken); err != nil {
+			return err
+		}
+	}
+
+	// -status
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	if !params.IsEnabled {
+		fmt.Fprintf(w, "gRPC API\t:\tDisabled\n")
+		w.Flush()
+		return nil
+	}
+
+	fmt.Fprintf(w, "gRPC API\t:\tEnabled\n")
+	fmt.Fprintf(w, "Address\t:\t127.0.0.1:%d (plaintext)\n", params.Port)
+	fmt.Fprintf(w, "Access token\t:\t%s\n", params.Token)
+	w.Flush()
+
+	fmt.Println()
+	fmt.Println("Example:")
+	fmt.Printf("  grpcurl -plaintext -H \"authorization: Bearer %s\" 127.0.0.1:%d ivpn.daemon.v1.Daemon/KillSwitchGetStatus\n", params.Token, params.Port)
+
+	return nil
+}
@@ -0,0 +1,132 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/cli/helpers"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+type CmdHistory struct {
+	flags.CmdInfo
+	list    bool
+	connect int
+	clear   bool
+	enabled string // [on/off]
+}
+
+func (c *CmdHistory) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("history", "Recently used connection parameters")
+	c.BoolVar(&c.list, "list", false, "(default) Show connection history")
+	c.IntVar(&c.connect, "connect", -1, "INDEX", "Connect using parameters from connection history (INDEX - number of item in the list)")
+	c.BoolVar(&c.clear, "clear", false, "Erase connection history")
+	c.StringVar(&c.enabled, "enabled", "", "[on/off]", "Enable/disable keeping connection history\n(disabling also erases the history)")
+}
+
+func (c *CmdHistory) Run() (retError error) {
+	if len(c.enabled) > 0 {
+		val, err := helpers.BoolParameterParse(c.enabled)
+		if err != nil {
+			return err
+		}
+		if err := _proto.SetPreferences(string(types.Prefs_IsConnectionHistoryDisabled), fmt.Sprint(!val)); err != nil {
+			return err
+		}
+	}
+
+	if c.clear {
+		if err := _proto.ConnectionHistoryClear(); err != nil {
+			return err
+		}
+	}
+
+	if c.connect >= 0 {
+		// show current state after on finished
+		defer func() {
+			if retError == nil {
+				showState()
+			}
+		}()
+
+		fmt.Println("Connecting...")
+		if _, err := _proto.ConnectionHistoryConnect(c.connect); err != nil {
+			err = fmt.Errorf("failed to connect: %w", err)
+			fmt.Printf("Disconnecting...\n")
+			if err2 := _proto.DisconnectVPN(); err2 != nil {
+				fmt.Printf("Failed to disconnect: %v\n", err2)
+			}
+			return err
+		}
+		return nil
+	}
+
+	// -list
+	history, err := _proto.ConnectionHistory()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	if history.IsDisabled {
+		fmt.Fprintln(w, "Connection history is disabled")
+	} else if len(history.Items) == 0 {
+		fmt.Fprintln(w, "Connection history is empty")
+	}
+	for i, item := range history.Items {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", i, time.Unix(item.Time, 0).Format("2006-01-02 15:04:05"), item.Params.VpnType, historyItemServers(item))
+	}
+	w.Flush()
+
+	return nil
+}
+
+func historyItemServers(item preferences.ConnectionHistoryItem) string {
+	var entry, exit string
+	p := item.Params
+	if p.VpnType == vpn.WireGuard {
+		if hosts := p.WireGuardParameters.EntryVpnServer.Hosts; len(hosts) > 0 {
+			entry = hosts[0].Hostname
+		}
+		exit = p.WireGuardParameters.MultihopExitServer.ExitSrvID
+	} else {
+		if hosts := p.OpenVpnParameters.EntryVpnServer.Hosts; len(hosts) > 0 {
+			entry = hosts[0].Hostname
+		}
+		exit = p.OpenVpnParameters.MultihopExitServer.ExitSrvID
+	}
+
+	if len(exit) == 0 {
+		return entry
+	}
+	return fmt.Sprintf("%s (multi-hop exit: %s)", entry, exit)
+}
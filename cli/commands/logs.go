@@ -23,12 +23,19 @@
 package commands
 
 import (
+	"bufio"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/cli/helpers"
+	"github.com/ivpn/desktop-app/daemon/logger"
 	service_types "github.com/ivpn/desktop-app/daemon/protocol/types"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
 )
@@ -36,15 +43,65 @@ import (
 type CmdLogs struct {
 	flags.CmdInfo
 	show    bool
+	audit   int
+	cmds    int
+	subsys  bool
 	enable  bool
 	disable bool
+	json    string // on/off
+	output  string // file/system/both
+	privacy string // on/off
+
+	// live log stream
+	live        bool
+	liveLevel   string
+	liveModules string
+
+	// crash reports (recovered panics)
+	crashes      bool
+	crashesClear bool
+
+	// connection sessions
+	sessions bool
+	session  string
+
+	// log files rotation
+	rotation bool
+	maxSize  int
+	maxFiles int
+	maxAge   int
+	compress string // on/off
+
+	// runtime log levels
+	levels bool
+	level  string // [MODULE=]LEVEL
 }
 
 func (c *CmdLogs) Init() {
 	c.Initialize("logs", "Logging management")
 	c.BoolVar(&c.show, "show", false, "(default) Show logs")
+	c.IntVar(&c.audit, "audit", -1, "COUNT", "Show the last COUNT records of the audit log (security-relevant actions)\n(0 - show all records)")
+	c.IntVar(&c.cmds, "commands", -1, "COUNT", "Show the last COUNT external commands executed by the daemon (binary, arguments, duration, exit code)\n(0 - show all records)")
+	c.BoolVar(&c.subsys, "subsystems", false, "Show initialization status of the daemon subsystems")
+	c.BoolVar(&c.live, "live", false, "Show the daemon log messages in real time (until Ctrl+C is pressed)")
+	c.StringVar(&c.liveLevel, "live_level", "", "LEVEL", "(optional; '-live' only) Min level of the messages: debug, info, warning, error")
+	c.StringVar(&c.liveModules, "live_modules", "", "MODULES", "(optional; '-live' only) Comma-separated names of the logger modules (e.g. 'dns,wg_out')\n(see '-levels' for the list of modules)")
+	c.BoolVar(&c.sessions, "sessions", false, "Show the connection sessions found in the log files\n(each connection attempt, including reconnections, is a separate session)")
+	c.StringVar(&c.session, "session", "", "ID", "Show the log records of the connection session only (see '-sessions' for the list of sessions)")
+	c.BoolVar(&c.crashes, "crashes", false, "Show crash reports of the daemon (recovered panics with stack traces)")
+	c.BoolVar(&c.crashesClear, "crashes_clear", false, "Remove all crash reports of the daemon")
 	c.BoolVar(&c.enable, "on", false, "Enable logging")
 	c.BoolVar(&c.disable, "off", false, "Disable logging")
+	c.StringVar(&c.json, "json", "", "[on/off]", "Write the daemon log records in structured JSON format (one JSON object per line)")
+	c.StringVar(&c.privacy, "privacy", "", "[on/off]", "Redact account IDs, public IP addresses and WireGuard keys from the logs and diagnostics reports")
+	c.StringVar(&c.output, "output", "", "DESTINATION", fmt.Sprintf("Destination of the daemon log records (Linux only):\n'%s' - log file (default); '%s' - system log (journald/syslog); '%s' - log file and system log", logger.OutputFile, logger.OutputSystem, logger.OutputFileAndSystem))
+	c.BoolVar(&c.rotation, "rotation", false, "Show configuration of the log files rotation")
+	c.IntVar(&c.maxSize, "max_size", 0, "MB", fmt.Sprintf("Rotate the log file when it reaches the size (default: %d MB)", logger.RotationDefaultMaxSizeMB))
+	c.IntVar(&c.maxFiles, "max_files", 0, "COUNT", fmt.Sprintf("Number of rotated log files to keep (default: %d)", logger.RotationDefaultMaxFiles))
+	c.IntVar(&c.maxAge, "max_age", -1, "DAYS", "Remove rotated log files older than DAYS (0 - do not remove)")
+	c.StringVar(&c.compress, "compress", "", "[on/off]", "Compress the rotated log files (except the newest one)")
+	c.BoolVar(&c.levels, "levels", false, "Show runtime log levels of the daemon modules")
+	c.StringVar(&c.level, "level", "", "[MODULE=]LEVEL", "Change log level at runtime (not saved over the daemon restart)\nLEVEL: debug, info, warning, error; 'MODULE=default' - reset the module-specific level\n(e.g. '-level info' - for all modules; '-level dns=debug' - for 'dns' module only)")
 }
 func (c *CmdLogs) Run() error {
 	if c.enable && c.disable {
@@ -57,13 +114,263 @@ func (c *CmdLogs) Run() error {
 	} else if c.disable {
 		err = c.setSetLogging(false)
 	}
+	if err == nil && len(c.json) > 0 {
+		var isJSON bool
+		if isJSON, err = helpers.BoolParameterParse(c.json); err == nil {
+			err = _proto.SetPreferences(string(service_types.Prefs_IsLogJSONFormat), fmt.Sprint(isJSON))
+		}
+	}
 
-	if err != nil || c.enable || c.disable {
+	if err == nil && len(c.privacy) > 0 {
+		var isPrivacy bool
+		if isPrivacy, err = helpers.BoolParameterParse(c.privacy); err == nil {
+			err = _proto.SetPreferences(string(service_types.Prefs_IsLogPrivacyMode), fmt.Sprint(isPrivacy))
+		}
+	}
+	if err == nil && len(c.output) > 0 {
+		out := logger.Output(strings.ToLower(strings.TrimSpace(c.output)))
+		if out == "" {
+			return flags.BadParameter{Message: "log output not defined"}
+		}
+		err = _proto.SetPreferences(string(service_types.Prefs_LogOutput), string(out))
+	}
+
+	if err != nil || c.enable || c.disable || len(c.json) > 0 || len(c.privacy) > 0 || len(c.output) > 0 {
 		return err
 	}
+	if c.rotation || c.maxSize != 0 || c.maxFiles != 0 || c.maxAge >= 0 || len(c.compress) > 0 {
+		return c.doRotation()
+	}
+	if c.levels || len(c.level) > 0 {
+		return c.doLevels()
+	}
+	if c.audit >= 0 {
+		return c.doShowAudit()
+	}
+	if c.cmds >= 0 {
+		return c.doShowCommands()
+	}
+	if c.subsys {
+		return c.doShowSubsystems()
+	}
+	if c.live {
+		return c.doLive()
+	} else if len(c.liveLevel) > 0 || len(c.liveModules) > 0 {
+		return flags.BadParameter{Message: "'-live_level' and '-live_modules' are applicable only with '-live'"}
+	}
+	if c.sessions {
+		return c.doShowSessions()
+	}
+	if len(c.session) > 0 {
+		return c.doShowSession()
+	}
+	if c.crashesClear {
+		return _proto.CrashReportsClear()
+	}
+	if c.crashes {
+		return c.doShowCrashes()
+	}
 	return c.doShow()
 }
 
+func (c *CmdLogs) doRotation() error {
+	cfg := _proto.GetHelloResponse().DaemonSettings.LogRotation
+
+	isChanged := false
+	if c.maxSize != 0 {
+		cfg.MaxSizeMB, isChanged = c.maxSize, true
+	}
+	if c.maxFiles != 0 {
+		cfg.MaxFiles, isChanged = c.maxFiles, true
+	}
+	if c.maxAge >= 0 {
+		cfg.MaxAgeDays, isChanged = c.maxAge, true
+	}
+	if len(c.compress) > 0 {
+		val, err := helpers.BoolParameterParse(c.compress)
+		if err != nil {
+			return err
+		}
+		cfg.Compress, isChanged = val, true
+	}
+
+	if isChanged {
+		if err := cfg.Validate(); err != nil {
+			return flags.BadParameter{Message: err.Error()}
+		}
+		if err := _proto.SetLogRotation(cfg); err != nil {
+			return err
+		}
+	}
+
+	cfg = cfg.Normalized()
+	maxAge := "not limited"
+	if cfg.MaxAgeDays > 0 {
+		maxAge = fmt.Sprintf("%d days", cfg.MaxAgeDays)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "Max log file size\t:\t%d MB\n", cfg.MaxSizeMB)
+	fmt.Fprintf(w, "Rotated files to keep\t:\t%d\n", cfg.MaxFiles)
+	fmt.Fprintf(w, "Max age of rotated files\t:\t%s\n", maxAge)
+	fmt.Fprintf(w, "Compression\t:\t%t\n", cfg.Compress)
+	w.Flush()
+	return nil
+}
+
+func (c *CmdLogs) doLevels() error {
+	var (
+		resp service_types.LogLevelsResp
+		err  error
+	)
+
+	if len(c.level) > 0 {
+		module, level := "", c.level
+		if idx := strings.Index(c.level, "="); idx >= 0 {
+			module, level = strings.TrimSpace(c.level[:idx]), strings.TrimSpace(c.level[idx+1:])
+			if len(module) == 0 {
+				return flags.BadParameter{Message: "module name not defined"}
+			}
+			if strings.ToLower(level) == "default" {
+				level = ""
+			}
+		} else if _, err := logger.ParseLevel(level); err != nil {
+			return flags.BadParameter{Message: err.Error()}
+		}
+		resp, err = _proto.SetLogLevel(module, level)
+	} else {
+		resp, err = _proto.LogLevelsGet()
+	}
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "Default level\t:\t%s\n", resp.DefaultLevel)
+	for _, m := range resp.KnownModules {
+		if l, ok := resp.Modules[m]; ok {
+			fmt.Fprintf(w, "  %s\t:\t%s\n", m, l)
+		} else {
+			fmt.Fprintf(w, "  %s\t:\t%s (default)\n", m, resp.DefaultLevel)
+		}
+	}
+	w.Flush()
+	return nil
+}
+
+func (c *CmdLogs) doShowAudit() error {
+	resp, err := _proto.AuditLog(c.audit)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Events) == 0 {
+		fmt.Println("Audit log is empty")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	for _, e := range resp.Events {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", e.Time.Format("2006-01-02 15:04:05"), e.Actor, e.Event, e.Details)
+	}
+	w.Flush()
+	return nil
+}
+
+func (c *CmdLogs) doLive() error {
+	if len(c.liveLevel) > 0 {
+		if _, err := logger.ParseLevel(c.liveLevel); err != nil {
+			return flags.BadParameter{Message: err.Error()}
+		}
+	}
+	var modules []string
+	for _, m := range strings.Split(c.liveModules, ",") {
+		if m = strings.TrimSpace(m); len(m) > 0 {
+			modules = append(modules, m)
+		}
+	}
+
+	err := _proto.LogStreamStart(c.liveLevel, modules, func(m service_types.LogMessageResp) {
+		fmt.Println(m.Message)
+	})
+	if err != nil {
+		return err
+	}
+	defer _proto.LogStreamStop()
+
+	fmt.Println("Streaming the daemon log messages (press Ctrl+C to stop)...")
+	fmt.Println("Note: the messages are available only when the logging is enabled ('-on')")
+
+	sig := make(chan os.Signal, 1)
+	signal.Notify(sig, os.Interrupt)
+	defer signal.Stop(sig)
+	<-sig
+	return nil
+}
+
+func (c *CmdLogs) doShowCrashes() error {
+	resp, err := _proto.CrashReports(true)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Reports) == 0 {
+		fmt.Println("No crash reports")
+		return nil
+	}
+
+	for _, r := range resp.Reports {
+		fmt.Printf("[%s] (v%s) PANIC in '%s': %s\n", r.Time.Format("2006-01-02 15:04:05"), r.Version, r.Source, r.Panic)
+		fmt.Println(r.Stack)
+	}
+	return nil
+}
+
+func (c *CmdLogs) doShowCommands() error {
+	resp, err := _proto.ShellCommands(c.cmds)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Commands) == 0 {
+		fmt.Println("No external commands executed")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	for _, r := range resp.Commands {
+		result := fmt.Sprintf("exit code %d", r.ExitCode)
+		if len(r.Error) > 0 {
+			result += " (" + r.Error + ")"
+		}
+		fmt.Fprintf(w, "%s\t%dms\t%s\t%s %s\n", r.Time.Format("2006-01-02 15:04:05"), r.DurationMs, result, r.Binary, strings.Join(r.Args, " "))
+	}
+	w.Flush()
+	return nil
+}
+
+func (c *CmdLogs) doShowSubsystems() error {
+	resp, err := _proto.SubsystemStatus()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	for _, s := range resp.Subsystems {
+		details := s.Error
+		if len(s.Warnings) > 0 {
+			details = strings.Join(append([]string{details}, s.Warnings...), "; ")
+			details = strings.TrimPrefix(details, "; ")
+		}
+		critical := ""
+		if s.Critical {
+			critical = "(critical)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", s.Name, critical, s.State, details)
+	}
+	w.Flush()
+	return nil
+}
+
 func (c *CmdLogs) setSetLogging(enable bool) error {
 	if enable {
 		return _proto.SetPreferences(string(service_types.Prefs_IsEnableLogging), "true")
@@ -121,3 +428,125 @@ func (c *CmdLogs) doShow() error {
 
 	return nil
 }
+
+func (c *CmdLogs) doShowSessions() error {
+	type sessionInfo struct {
+		id          string
+		description string
+		lines       int
+		isFinished  bool
+	}
+	var sessions []*sessionInfo
+	byID := make(map[string]*sessionInfo)
+
+	err := readLogFiles(func(line string) {
+		id := logger.ParseSessionID(line)
+		if len(id) == 0 {
+			return
+		}
+		s, ok := byID[id]
+		if !ok {
+			s = &sessionInfo{id: id}
+			byID[id] = s
+			sessions = append(sessions, s)
+		}
+		s.lines++
+
+		// delimiter records: "... Connection session <ID> started (<description>) ..." / "... Connection session <ID> finished (duration: <d>) ..."
+		if _, after, ok := strings.Cut(line, "Connection session "+id+" started ("); ok {
+			s.description, _, _ = strings.Cut(after, ")")
+		} else if _, after, ok := strings.Cut(line, "Connection session "+id+" finished ("); ok {
+			s.isFinished = true
+			if d, _, ok := strings.Cut(after, ")"); ok {
+				s.description = strings.TrimSpace(strings.Join([]string{s.description, d}, "; "))
+			}
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if len(sessions) == 0 {
+		fmt.Println("No connection sessions found in the log files")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "SESSION\tRECORDS\tDETAILS")
+	for _, s := range sessions {
+		details := s.description
+		if !s.isFinished {
+			details += " (not finished)"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\n", s.id, s.lines, details)
+	}
+	w.Flush()
+	return nil
+}
+
+func (c *CmdLogs) doShowSession() error {
+	isFound := false
+	err := readLogFiles(func(line string) {
+		if logger.ParseSessionID(line) == c.session {
+			fmt.Println(line)
+			isFound = true
+		}
+	})
+	if err != nil {
+		return err
+	}
+	if !isFound {
+		return fmt.Errorf("no log records of the connection session '%s' found (see '-sessions' for the list of sessions)", c.session)
+	}
+	return nil
+}
+
+// readLogFiles passes all lines of the daemon log files to 'onLine' (the oldest first): the rotated log files and then the active log file
+func readLogFiles(onLine func(line string)) error {
+	fname := platform.LogFile()
+
+	var files []string
+	for i := logger.RotationMaxFilesLimit - 1; i >= 0; i-- {
+		for _, name := range []string{fmt.Sprintf("%s.%d", fname, i), fmt.Sprintf("%s.%d.gz", fname, i)} {
+			if _, err := os.Stat(name); err == nil {
+				files = append(files, name)
+			}
+		}
+	}
+	files = append(files, fname)
+
+	for _, name := range files {
+		if err := readLogFile(name, onLine); err != nil {
+			if name == fname && len(files) == 1 {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "Failed to read log file '%s': %v\n", name, err)
+		}
+	}
+	return nil
+}
+
+func readLogFile(name string, onLine func(line string)) error {
+	file, err := os.Open(filepath.Clean(name))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var r io.Reader = file
+	if strings.HasSuffix(name, ".gz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		onLine(scanner.Text())
+	}
+	return scanner.Err()
+}
@@ -0,0 +1,178 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/cli/helpers"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+type CmdOpenVpnParams struct {
+	flags.CmdInfo
+	status bool
+	set    string
+	off    bool
+	dco    string // [on/off]
+
+	tlsMin      string
+	ciphers     string
+	certProfile string
+}
+
+func (c *CmdOpenVpnParams) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("openvpn_params", "Manage OpenVPN connection parameters (for advanced users)\nThe parameters are applied on the next OpenVPN connection.\nDirectives which can execute external commands, load plugins or access files are not allowed.")
+	c.BoolVar(&c.status, "status", false, "(default) Show settings")
+	c.StringVar(&c.set, "set", "", "DIRECTIVES", "Use additional OpenVPN directives (separated by ';')\n  Example: -set \"tun-mtu 1400; mssfix 1360\"")
+	c.BoolVar(&c.off, "off", false, "Do not use additional OpenVPN directives")
+	c.StringVar(&c.dco, "dco", "", "[on/off]", "Use data channel offload (DCO) when it is available (default: on)\n  Requires OpenVPN 2.6+ and the 'ovpn-dco' kernel module (Linux) or driver (Windows)")
+	c.StringVar(&c.tlsMin, "tls_min", "", "VERSION", fmt.Sprintf("Minimum TLS version ('default' - reset)\n  Supported values: %s", strings.Join(vpn.OpenVpnTlsVersions, ", ")))
+	c.StringVar(&c.ciphers, "ciphers", "", "LIST", fmt.Sprintf("Comma-separated list of data channel ciphers in order of preference ('default' - reset)\n  Supported values: %s", strings.Join(vpn.OpenVpnDataCiphers, ", ")))
+	c.StringVar(&c.certProfile, "cert_profile", "", "PROFILE", fmt.Sprintf("TLS certificate profile ('default' - reset)\n  Supported values: %s", strings.Join(vpn.OpenVpnTlsCertProfiles, ", ")))
+}
+
+func (c *CmdOpenVpnParams) Run() error {
+	if len(c.set) > 0 && c.off {
+		return flags.BadParameter{Message: "'set' and 'off' flags can not be used together"}
+	}
+
+	if len(c.set) > 0 {
+		var lines []string
+		for _, l := range strings.Split(c.set, ";") {
+			if l = strings.TrimSpace(l); len(l) > 0 {
+				lines = append(lines, l)
+			}
+		}
+		if err := _proto.SetOpenVpnExtraParameters(strings.Join(lines, "\n")); err != nil {
+			return err
+		}
+	} else if c.off {
+		if err := _proto.SetOpenVpnExtraParameters(""); err != nil {
+			return err
+		}
+	}
+
+	if len(c.dco) > 0 {
+		val, err := helpers.BoolParameterParse(c.dco)
+		if err != nil {
+			return err
+		}
+		if err := _proto.SetPreferences(string(types.Prefs_IsOpenVpnDcoDisabled), fmt.Sprint(!val)); err != nil {
+			return err
+		}
+	}
+
+	if len(c.tlsMin) > 0 || len(c.ciphers) > 0 || len(c.certProfile) > 0 {
+		if err := c.setCryptoPolicy(); err != nil {
+			return err
+		}
+	}
+
+	// -status
+
+	// request updated daemon settings
+	if _, err := _proto.SendHello(); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	settings := _proto.GetHelloResponse().DaemonSettings
+	dco := "Enabled (when available)"
+	if settings.IsOpenVpnDcoDisabled {
+		dco = "Disabled"
+	}
+	fmt.Fprintf(w, "Data channel offload\t:\t%s\n", dco)
+
+	valueOrDefault := func(v string) string {
+		if len(v) == 0 {
+			return "Default"
+		}
+		return v
+	}
+	policy := settings.OpenVpnCryptoPolicy
+	fmt.Fprintf(w, "Minimum TLS version\t:\t%s\n", valueOrDefault(policy.TlsVersionMin))
+	fmt.Fprintf(w, "Data ciphers\t:\t%s\n", valueOrDefault(strings.Join(policy.DataCiphers, ", ")))
+	fmt.Fprintf(w, "TLS certificate profile\t:\t%s\n", valueOrDefault(policy.TlsCertProfile))
+
+	params := settings.OpenVpnExtraParameters
+	if len(params) > 0 {
+		for i, l := range strings.Split(params, "\n") {
+			title := ""
+			if i == 0 {
+				title = "OpenVPN directives"
+			}
+			fmt.Fprintf(w, "%s\t:\t%s\n", title, l)
+		}
+	} else {
+		fmt.Fprintf(w, "OpenVPN directives\t:\tNot defined\n")
+	}
+	w.Flush()
+
+	return nil
+}
+
+func (c *CmdOpenVpnParams) setCryptoPolicy() error {
+	// update the current policy
+	if _, err := _proto.SendHello(); err != nil {
+		return err
+	}
+	policy := _proto.GetHelloResponse().DaemonSettings.OpenVpnCryptoPolicy
+
+	isDefault := func(v string) bool { return strings.EqualFold(v, "default") }
+
+	if len(c.tlsMin) > 0 {
+		policy.TlsVersionMin = c.tlsMin
+		if isDefault(c.tlsMin) {
+			policy.TlsVersionMin = ""
+		}
+	}
+	if len(c.ciphers) > 0 {
+		policy.DataCiphers = nil
+		if !isDefault(c.ciphers) {
+			for _, cipher := range strings.Split(c.ciphers, ",") {
+				if cipher = strings.TrimSpace(cipher); len(cipher) > 0 {
+					policy.DataCiphers = append(policy.DataCiphers, strings.ToUpper(cipher))
+				}
+			}
+		}
+	}
+	if len(c.certProfile) > 0 {
+		policy.TlsCertProfile = strings.ToLower(c.certProfile)
+		if isDefault(c.certProfile) {
+			policy.TlsCertProfile = ""
+		}
+	}
+
+	if err := policy.Validate(); err != nil {
+		return flags.BadParameter{Message: err.Error()}
+	}
+	return _proto.SetOpenVpnCryptoPolicy(policy)
+}
@@ -0,0 +1,88 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/daemon/service/portforwarding"
+)
+
+type CmdPortForwarding struct {
+	flags.CmdInfo
+	status  bool
+	request bool
+	release bool
+}
+
+func (c *CmdPortForwarding) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("port_forwarding", "Manage the port forwarded by the VPN server to the current VPN connection\n(the port is renewed automatically until it is released or VPN disconnected)")
+	c.BoolVar(&c.status, "status", false, "(default) Show forwarded port info")
+	c.BoolVar(&c.request, "request", false, "Request forwarded port (VPN must be connected)")
+	c.BoolVar(&c.release, "release", false, "Release forwarded port")
+}
+
+func (c *CmdPortForwarding) Run() error {
+	if c.request && c.release {
+		return flags.BadParameter{Message: "'request' and 'release' flags can not be used together"}
+	}
+
+	var (
+		state portforwarding.State
+		err   error
+	)
+
+	switch {
+	case c.request:
+		state, err = _proto.PortForwardingRequest()
+	case c.release:
+		if err = _proto.PortForwardingRelease(); err == nil {
+			state, err = _proto.PortForwardingStatus()
+		}
+	default:
+		state, err = _proto.PortForwardingStatus()
+	}
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	if state.IsActive {
+		fmt.Fprintf(w, "Forwarded port\t:\t%d\n", state.Port)
+		fmt.Fprintf(w, "Expires\t:\t%v (renewed automatically)\n", time.Unix(state.ExpiresAt, 0).Format(time.RFC1123))
+	} else {
+		fmt.Fprintf(w, "Forwarded port\t:\tNone\n")
+	}
+	if len(state.Error) > 0 {
+		fmt.Fprintf(w, "Last error\t:\t%s\n", state.Error)
+	}
+	w.Flush()
+
+	return nil
+}
@@ -0,0 +1,162 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+type CmdProfile struct {
+	flags.CmdInfo
+	list    bool
+	save    string
+	connect string
+	remove  string
+}
+
+func (c *CmdProfile) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("profile", "Named connection profiles (saved in the daemon and shared with the UI)")
+	c.BoolVar(&c.list, "list", false, "(default) Show connection profiles")
+	c.StringVar(&c.save, "save", "", "NAME", "Save last used connection parameters as profile NAME\n  (the existing profile with the same name will be replaced)")
+	c.StringVar(&c.connect, "connect", "", "NAME", "Connect using connection profile NAME")
+	c.StringVar(&c.remove, "remove", "", "NAME", "Remove connection profile NAME")
+}
+
+func (c *CmdProfile) Run() (retError error) {
+	if len(c.remove) > 0 {
+		if err := _proto.ConnectionProfileRemove(c.remove); err != nil {
+			return err
+		}
+	}
+
+	if len(c.save) > 0 {
+		settings, err := _proto.GetDefConnectionParams()
+		if err != nil {
+			return err
+		}
+		if err := settings.Params.CheckIsDefined(); err != nil {
+			return fmt.Errorf("no connection parameters to save (please, connect first): %w", err)
+		}
+
+		profile := preferences.ConnectionProfile{
+			Name:       c.save,
+			Params:     settings.Params,
+			Obfs4proxy: _proto.GetHelloResponse().DaemonSettings.ObfsproxyConfig,
+		}
+		if err := _proto.ConnectionProfileSave(profile); err != nil {
+			return err
+		}
+	}
+
+	if len(c.connect) > 0 {
+		// show current state after on finished
+		defer func() {
+			if retError == nil {
+				showState()
+			}
+		}()
+
+		fmt.Printf("Connecting (profile '%s')...\n", c.connect)
+		if _, err := _proto.ConnectionProfileConnect(c.connect); err != nil {
+			err = fmt.Errorf("failed to connect: %w", err)
+			fmt.Printf("Disconnecting...\n")
+			if err2 := _proto.DisconnectVPN(); err2 != nil {
+				fmt.Printf("Failed to disconnect: %v\n", err2)
+			}
+			return err
+		}
+		return nil
+	}
+
+	// -list
+	profiles, err := _proto.ConnectionProfiles()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	if len(profiles) == 0 {
+		fmt.Fprintln(w, "No connection profiles")
+	}
+	for _, p := range profiles {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", p.Name, p.Params.VpnType, profileServers(p), profileOptions(p))
+	}
+	w.Flush()
+
+	return nil
+}
+
+func profileServers(p preferences.ConnectionProfile) string {
+	return historyItemServers(preferences.ConnectionHistoryItem{Params: p.Params})
+}
+
+func profileOptions(p preferences.ConnectionProfile) string {
+	ret := ""
+	add := func(s string) {
+		if len(ret) > 0 {
+			ret += ", "
+		}
+		ret += s
+	}
+
+	params := p.Params
+	if params.VpnType == vpn.WireGuard {
+		if port := params.WireGuardParameters.Port.Port; port > 0 && !params.IsMultiHop() {
+			add(fmt.Sprintf("port UDP:%d", port))
+		}
+	} else {
+		if port := params.OpenVpnParameters.Port.Port; port > 0 && !params.IsMultiHop() {
+			protocol := "UDP"
+			if params.OpenVpnParameters.Port.Protocol > 0 {
+				protocol = "TCP"
+			}
+			add(fmt.Sprintf("port %s:%d", protocol, port))
+		}
+		if p.Obfs4proxy.IsObfsproxy() {
+			add("obfsproxy " + p.Obfs4proxy.ToString())
+		}
+	}
+	if params.Metadata.AntiTracker.IsEnabled() {
+		if params.Metadata.AntiTracker.Hardcore {
+			add("AntiTracker (hardcore)")
+		} else {
+			add("AntiTracker")
+		}
+	} else if !params.ManualDNS.IsEmpty() {
+		add("DNS " + params.ManualDNS.InfoString())
+	}
+	if params.FirewallOn {
+		add("firewall on")
+	} else if params.FirewallOnDuringConnection {
+		add("firewall during connection")
+	}
+	return ret
+}
@@ -0,0 +1,116 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/cli/helpers"
+)
+
+type CmdRestApi struct {
+	flags.CmdInfo
+	status     bool
+	on         bool
+	off        bool
+	port       int
+	resetToken bool
+	metrics    string // on/off
+}
+
+func (c *CmdRestApi) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("rest_api", "Manage local REST API of the daemon\n(HTTP interface on 127.0.0.1 which mirrors the daemon requests; intended for scripting and home-automation)")
+	c.BoolVar(&c.status, "status", false, "(default) Show settings (including the access token)")
+	c.BoolVar(&c.on, "on", false, "Enable REST API")
+	c.BoolVar(&c.off, "off", false, "Disable REST API")
+	c.IntVar(&c.port, "port", 0, "PORT", "TCP port of the REST API (applicable with '-on')")
+	c.BoolVar(&c.resetToken, "reset_token", false, "Generate new access token (the old token is not valid anymore)")
+	c.StringVar(&c.metrics, "metrics", "", "[on/off]", "Enable/disable metrics in Prometheus format ('/metrics' endpoint of the REST API)")
+}
+
+func (c *CmdRestApi) Run() error {
+	if c.on && c.off {
+		return flags.BadParameter{Message: "'on' and 'off' flags can not be used together"}
+	}
+	if c.port != 0 && !c.on {
+		return flags.BadParameter{Message: "'port' flag is applicable only with 'on'"}
+	}
+	if c.port != 0 && (c.port < 1024 || c.port > 65535) {
+		return flags.BadParameter{Message: "port must be in range 1024-65535"}
+	}
+
+	params, err := _proto.RestApiGet()
+	if err != nil {
+		return err
+	}
+
+	isMetricsEnabled := params.IsMetricsEnabled
+	if len(c.metrics) > 0 {
+		if isMetricsEnabled, err = helpers.BoolParameterParse(c.metrics); err != nil {
+			return err
+		}
+	}
+
+	if c.on || c.off || c.resetToken || isMetricsEnabled != params.IsMetricsEnabled {
+		isEnabled := params.IsEnabled
+		if c.on {
+			isEnabled = true
+		} else if c.off {
+			isEnabled = false
+		}
+		if params, err = _proto.SetRestApi(isEnabled, c.port, c.resetToken, isMetricsEnabled); err != nil {
+			return err
+		}
+	}
+
+	// -status
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	if !params.IsEnabled {
+		fmt.Fprintf(w, "REST API\t:\tDisabled\n")
+		w.Flush()
+		return nil
+	}
+
+	url := fmt.Sprintf("http://127.0.0.1:%d/api/v1/", params.Port)
+	fmt.Fprintf(w, "REST API\t:\tEnabled\n")
+	fmt.Fprintf(w, "URL\t:\t%s<Command>\n", url)
+	fmt.Fprintf(w, "Events (WebSocket)\t:\tws://127.0.0.1:%d/api/v1/events[?logs=1]\n", params.Port)
+	if params.IsMetricsEnabled {
+		fmt.Fprintf(w, "Metrics (Prometheus)\t:\thttp://127.0.0.1:%d/metrics\n", params.Port)
+	} else {
+		fmt.Fprintf(w, "Metrics (Prometheus)\t:\tDisabled\n")
+	}
+	fmt.Fprintf(w, "Access token\t:\t%s\n", params.Token)
+	w.Flush()
+
+	fmt.Println()
+	fmt.Println("Example:")
+	fmt.Printf("  curl -X POST -H \"Authorization: Bearer %s\" %sKillSwitchGetStatus\n", params.Token, url)
+
+	return nil
+}
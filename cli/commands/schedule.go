@@ -0,0 +1,224 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/cli/helpers"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+)
+
+var weekDays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+type CmdSchedule struct {
+	flags.CmdInfo
+	status         bool
+	enabled        string // [on/off]
+	add            string // "<ACTION> <HH:MM> [DAYS]"
+	add_inactivity int    // minutes
+	remove         int    // index of the rule
+	clear          bool
+}
+
+func (c *CmdSchedule) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("schedule", "Connection scheduler\nConnect/disconnect VPN (or enable/disable firewall) at defined time or after inactivity\nThe scheduler works in background (even when the IVPN app (The GUI) is not running)")
+	c.BoolVar(&c.status, "status", false, "(default) Show scheduler settings")
+	c.StringVar(&c.enabled, "enabled", "", "[on/off]", "Enable/disable connection scheduler")
+	c.StringVar(&c.add, "add", "", "RULE",
+		`Add rule
+		RULE parameter format: '<ACTION> <HH:MM> [DAYS]'
+			ACTION: connect, disconnect, firewall_on, firewall_off
+			HH:MM:  local time
+			DAYS:   comma separated days of the week (sun,mon,tue,wed,thu,fri,sat)
+			        if not defined - the rule is applicable for every day
+		Example:
+				ivpn schedule -add 'connect 08:30 mon,tue,wed,thu,fri'
+				ivpn schedule -add 'disconnect 23:00'`)
+	c.IntVar(&c.add_inactivity, "add_inactivity", 0, "MINUTES", "Add rule: disconnect VPN when there is no traffic through the VPN tunnel during defined period")
+	c.IntVar(&c.remove, "remove", 0, "INDEX", "Remove rule (INDEX - number of rule in the list)")
+	c.BoolVar(&c.clear, "clear", false, "Remove all rules")
+}
+
+func (c *CmdSchedule) Run() error {
+	helloResp := _proto.GetHelloResponse()
+	schedule := helloResp.DaemonSettings.Schedule
+
+	isSettingsChanged := false
+
+	if len(c.enabled) > 0 {
+		val, err := helpers.BoolParameterParse(c.enabled) // [on/off]
+		if err != nil {
+			return err
+		}
+		if val && helloResp.ParanoidMode.IsEnabled {
+			return EaaEnabledOptionNotApplicable{}
+		}
+		schedule.IsEnabled = val
+		isSettingsChanged = true
+	}
+
+	if c.clear {
+		schedule.Rules = nil
+		isSettingsChanged = true
+	}
+
+	if c.remove > 0 {
+		if c.remove > len(schedule.Rules) {
+			return flags.BadParameter{Message: fmt.Sprintf("rule with index %d not exists", c.remove)}
+		}
+		schedule.Rules = append(schedule.Rules[:c.remove-1], schedule.Rules[c.remove:]...)
+		isSettingsChanged = true
+	}
+
+	if len(c.add) > 0 {
+		rule, err := parseScheduleRule(c.add)
+		if err != nil {
+			return err
+		}
+		schedule.Rules = append(schedule.Rules, rule)
+		isSettingsChanged = true
+	}
+
+	if c.add_inactivity > 0 {
+		schedule.Rules = append(schedule.Rules, preferences.ScheduleRule{Action: preferences.ScheduleDisconnect, InactivityMinutes: c.add_inactivity})
+		isSettingsChanged = true
+	}
+
+	// send updated settings
+	if isSettingsChanged {
+		fmt.Print("Applying changes... ")
+		if err := _proto.SetScheduleSettings(schedule); err != nil {
+			fmt.Println()
+			return err
+		}
+		fmt.Println("Done")
+	}
+
+	// Status
+	if c.status || !isSettingsChanged {
+		w := printSchedule(nil, schedule)
+		w.Flush()
+		if !isSettingsChanged {
+			PrintTips([]TipType{TipScheduleHelp})
+		}
+	}
+	return nil
+}
+
+// parseScheduleRule parses rule in format: '<ACTION> <HH:MM> [DAYS]'
+func parseScheduleRule(str string) (preferences.ScheduleRule, error) {
+	ret := preferences.ScheduleRule{}
+
+	fields := strings.Fields(helpers.TrimSpacesAndRemoveQuotes(str))
+	if len(fields) < 2 || len(fields) > 3 {
+		return ret, flags.BadParameter{Message: fmt.Sprintf("bad rule '%s' (expected format: '<ACTION> <HH:MM> [DAYS]')", str)}
+	}
+
+	switch strings.ToLower(fields[0]) {
+	case "connect":
+		ret.Action = preferences.ScheduleConnect
+	case "disconnect":
+		ret.Action = preferences.ScheduleDisconnect
+	case "firewall_on":
+		ret.Action = preferences.ScheduleFirewallOn
+	case "firewall_off":
+		ret.Action = preferences.ScheduleFirewallOff
+	default:
+		return ret, flags.BadParameter{Message: fmt.Sprintf("not supported action '%s' (acceptable actions: connect, disconnect, firewall_on, firewall_off)", fields[0])}
+	}
+
+	ret.Time = fields[1]
+
+	if len(fields) > 2 {
+		for _, d := range strings.Split(strings.ToLower(fields[2]), ",") {
+			idx := -1
+			for i, wd := range weekDays {
+				if wd == strings.TrimSpace(d) {
+					idx = i
+					break
+				}
+			}
+			if idx < 0 {
+				return ret, flags.BadParameter{Message: fmt.Sprintf("bad day of the week '%s' (acceptable values: %s)", d, strings.Join(weekDays, ","))}
+			}
+			ret.Days = append(ret.Days, time.Weekday(idx))
+		}
+	}
+
+	if err := ret.Validate(); err != nil {
+		return ret, flags.BadParameter{Message: err.Error()}
+	}
+	return ret, nil
+}
+
+func printSchedule(w *tabwriter.Writer, schedule preferences.ScheduleParams) *tabwriter.Writer {
+	if w == nil {
+		w = tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	}
+
+	enabled := "Disabled"
+	if schedule.IsEnabled {
+		enabled = "Enabled"
+	}
+	fmt.Fprintf(w, "Connection scheduler\t:\t%v\n", enabled)
+
+	if len(schedule.Rules) == 0 {
+		fmt.Fprintf(w, "Rules\t:\tnot defined\n")
+		return w
+	}
+
+	fmt.Fprintf(w, "Rules:\t\n")
+	for i, r := range schedule.Rules {
+		when := ""
+		if r.IsInactivityRule() {
+			when = fmt.Sprintf("after %d minutes of inactivity", r.InactivityMinutes)
+		} else {
+			when = "at " + r.Time
+		}
+
+		days := "every day"
+		if len(r.Days) > 0 {
+			names := make([]string, 0, len(r.Days))
+			for _, d := range r.Days {
+				if d >= 0 && int(d) < len(weekDays) {
+					names = append(names, weekDays[d])
+				} else {
+					names = append(names, strconv.Itoa(int(d)))
+				}
+			}
+			days = strings.Join(names, ",")
+		}
+
+		fmt.Fprintf(w, "    %d\t:\t%s %s (%s)\n", i+1, r.Action, when, days)
+	}
+	return w
+}
@@ -52,6 +52,7 @@ type CmdServers struct {
 	hosts        bool
 	load         bool
 	filterInvert bool
+	health       bool
 }
 
 func (c *CmdServers) Init() {
@@ -78,6 +79,8 @@ func (c *CmdServers) Init() {
 	c.BoolVar(&c.load, "load", false, "Show load info for each host")
 
 	c.BoolVar(&c.filterInvert, "filter_invert", false, "Invert filtering result")
+
+	c.BoolVar(&c.health, "health", false, "Show hosts which had connection failures\n  (temporarily blacklisted hosts are not in use when other hosts are available)")
 }
 func (c *CmdServers) Run() error {
 	var servers apitypes.ServersInfoResponse
@@ -104,6 +107,10 @@ func (c *CmdServers) Run() error {
 
 	slist := serversList(servers)
 
+	if c.health {
+		return printHostsHealth(slist)
+	}
+
 	if c.ping {
 		var vpnType *vpn.Type = nil
 		if len(c.proto) > 0 {
@@ -204,6 +211,40 @@ func (c *CmdServers) Run() error {
 
 // ---------------------
 
+func printHostsHealth(servers []serverDesc) error {
+	hosts, err := _proto.GetHostsHealth()
+	if err != nil {
+		return err
+	}
+	if len(hosts) == 0 {
+		fmt.Println("No connection failures registered")
+		return nil
+	}
+
+	hostnames := make(map[string]string)
+	for _, s := range servers {
+		for _, h := range s.hosts {
+			hostnames[h.host] = h.hostname
+		}
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', tabwriter.AlignRight|tabwriter.Debug)
+	fmt.Fprintln(w, "HOST	SCORE	FAILURES	LAST FAILURE	STATUS	")
+	for _, h := range hosts {
+		name := h.Host
+		if hostname, ok := hostnames[h.Host]; ok && len(hostname) > 0 {
+			name = fmt.Sprintf("%s (%s)", hostname, h.Host)
+		}
+		status := "OK"
+		if h.IsBlacklisted() {
+			status = "blacklisted until " + h.BlacklistedUntil.Local().Format("15:04:05")
+		}
+		fmt.Fprintf(w, "%s	%.2f	%d	%s %s	%s	\n", name, h.Score, h.Failures, h.LastFailure, h.LastFailureTime.Local().Format("2006-01-02 15:04:05"), status)
+	}
+	w.Flush()
+	return nil
+}
+
 func getVpnTypeByFlag(proto string) (t vpn.Type, err error) {
 	proto = strings.ToLower(proto)
 
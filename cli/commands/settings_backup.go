@@ -0,0 +1,116 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+)
+
+type CmdSettingsBackup struct {
+	flags.CmdInfo
+	exportFile     string
+	includeSecrets bool
+	importFile     string
+}
+
+func (c *CmdSettingsBackup) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("settings_backup", "Export the daemon configuration to a file or import it (e.g. on another machine)\nExported: firewall settings and exceptions, connection parameters (including DNS), connection profiles, trusted WiFi networks, obfuscation and API proxy settings.\nAccount data is never exported.")
+	c.StringVar(&c.exportFile, "export", "", "FILE", "Export the configuration to the file")
+	c.BoolVar(&c.includeSecrets, "secrets", false, "Include secrets into the exported file: passwords and user-defined VPN configurations\n  (by default, secrets are not exported; keep the file in a safe place when this option is in use)")
+	c.StringVar(&c.importFile, "import", "", "FILE", "Import the configuration from the file")
+}
+
+func (c *CmdSettingsBackup) Run() error {
+	if len(c.exportFile) > 0 && len(c.importFile) > 0 {
+		return flags.BadParameter{Message: "'export' and 'import' options can not be used together"}
+	}
+	if c.includeSecrets && len(c.exportFile) == 0 {
+		return flags.BadParameter{Message: "'secrets' option is applicable only for 'export'"}
+	}
+
+	if len(c.exportFile) > 0 {
+		return c.doExport()
+	}
+	if len(c.importFile) > 0 {
+		return c.doImport()
+	}
+	return flags.BadParameter{}
+}
+
+func (c *CmdSettingsBackup) doExport() error {
+	settings, err := _proto.SettingsExport(c.includeSecrets)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	// the file can contain secrets: make it readable only for the current user
+	if err := os.WriteFile(filepath.Clean(c.exportFile), data, 0600); err != nil {
+		return fmt.Errorf("failed to save the configuration: %w", err)
+	}
+
+	fmt.Printf("Configuration exported to '%s'\n", c.exportFile)
+	if !settings.IsSecretsIncluded {
+		fmt.Println("Secrets (passwords, user-defined VPN configurations) are not included.")
+	}
+	return nil
+}
+
+func (c *CmdSettingsBackup) doImport() error {
+	data, err := os.ReadFile(filepath.Clean(c.importFile))
+	if err != nil {
+		return fmt.Errorf("failed to read the configuration file: %w", err)
+	}
+
+	var settings preferences.ExportedSettings
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return fmt.Errorf("failed to parse the configuration file: %w", err)
+	}
+
+	warnings, err := _proto.SettingsImport(settings)
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) > 0 {
+		fmt.Println("Configuration imported with warnings:")
+		for _, w := range warnings {
+			fmt.Printf("  - %s\n", w)
+		}
+	} else {
+		fmt.Println("Configuration imported")
+	}
+	return nil
+}
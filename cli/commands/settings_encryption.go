@@ -0,0 +1,77 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+)
+
+type CmdSettingsEncryption struct {
+	flags.CmdInfo
+	status bool
+	on     bool
+	off    bool
+}
+
+func (c *CmdSettingsEncryption) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("settings_encryption", "Encryption of the daemon settings file (account and connection data)\nThe encryption key is protected by the machine secret (OS secret store or TPM/Secure Enclave)")
+	c.BoolVar(&c.status, "status", false, "(default) Show settings")
+	c.BoolVar(&c.on, "on", false, "Encrypt the settings file")
+	c.BoolVar(&c.off, "off", false, "Do not encrypt the settings file")
+}
+
+func (c *CmdSettingsEncryption) Run() error {
+	if c.on && c.off {
+		return flags.BadParameter{Message: "'on' and 'off' flags can not be used together"}
+	}
+
+	if c.on || c.off {
+		if err := _proto.SetPreferences(string(types.Prefs_IsSettingsEncryption), fmt.Sprint(c.on)); err != nil {
+			return err
+		}
+	}
+
+	// -status
+
+	// request updated daemon settings
+	if _, err := _proto.SendHello(); err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	if _proto.GetHelloResponse().DaemonSettings.IsSettingsEncryption {
+		fmt.Fprintf(w, "Settings encryption\t:\tEnabled\n")
+	} else {
+		fmt.Fprintf(w, "Settings encryption\t:\tDisabled\n")
+	}
+	w.Flush()
+
+	return nil
+}
@@ -0,0 +1,131 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/daemon/operations"
+	"github.com/ivpn/desktop-app/daemon/service/speedtest"
+)
+
+type CmdSpeedTest struct {
+	flags.CmdInfo
+	download string
+	upload   string
+	duration int
+	noUpload bool
+}
+
+func (c *CmdSpeedTest) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("speedtest", "Measure download/upload throughput through the active VPN connection")
+	c.StringVar(&c.download, "download", "", "URL", "(optional) Download endpoint (HTTP/HTTPS; it must return a large amount of data)\n(default: IVPN-hosted endpoint)")
+	c.StringVar(&c.upload, "upload", "", "URL", "(optional) Upload endpoint (HTTP/HTTPS; it must accept POST requests)\n(default: IVPN-hosted endpoint)")
+	c.IntVar(&c.duration, "duration", 0, "SECONDS", fmt.Sprintf("(optional) Duration of each phase (download, upload) of the test (default: %d; max: %d)", speedtest.DefaultDurationSec, speedtest.MaxDurationSec))
+	c.BoolVar(&c.noUpload, "no_upload", false, "(optional) Measure only the download throughput")
+}
+
+func (c *CmdSpeedTest) Run() error {
+	params := speedtest.Params{
+		DownloadURL:  c.download,
+		UploadURL:    c.upload,
+		DurationSec:  c.duration,
+		IsSkipUpload: c.noUpload,
+	}
+	if err := params.Validate(); err != nil {
+		return flags.BadParameter{Message: err.Error()}
+	}
+
+	// cancel the test on Ctrl+C
+	sigChan := make(chan os.Signal, 1)
+	signal.Notify(sigChan, os.Interrupt)
+	go func() {
+		if _, ok := <-sigChan; !ok {
+			return
+		}
+		if ops, err := _proto.OperationsRunning(); err == nil {
+			for _, op := range ops {
+				if op.Type == operations.TypeSpeedTest {
+					_proto.OperationCancel(op.Id)
+				}
+			}
+		}
+	}()
+
+	fmt.Println("Running speed test (press Ctrl+C to cancel)...")
+	status, err := _proto.SpeedTest(params, func(s operations.Status) {
+		if len(s.Info) > 0 {
+			fmt.Printf("\r[%3d%%] %-40s", s.Progress, s.Info)
+		}
+	})
+	signal.Stop(sigChan)
+	close(sigChan)
+	fmt.Println()
+	if err != nil {
+		return err
+	}
+
+	switch status.State {
+	case operations.Cancelled:
+		return fmt.Errorf("speed test cancelled")
+	case operations.Failed:
+		return fmt.Errorf("speed test failed: %s", status.Error)
+	}
+
+	// the result is received as a generic JSON object
+	var result speedtest.Result
+	data, err := json.Marshal(status.Result)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return fmt.Errorf("failed to parse the speed test result: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 1, ' ', 0)
+	fmt.Fprintf(w, "Server\t:\t%s (%s)\n", result.Server, result.VpnType)
+	fmt.Fprintf(w, "Latency\t:\t%d ms\n", result.LatencyMs)
+	fmt.Fprintf(w, "Download\t:\t%s\n", speedTestPhase(result.Download))
+	if result.Upload != nil {
+		fmt.Fprintf(w, "Upload\t:\t%s\n", speedTestPhase(*result.Upload))
+	}
+	w.Flush()
+
+	return nil
+}
+
+func speedTestPhase(r speedtest.PhaseResult) string {
+	ret := fmt.Sprintf("%.2f Mbps (%s in %s)", r.Mbps(), statsBytes(r.Bytes), r.Duration.Round(time.Millisecond))
+	if len(r.Error) > 0 {
+		ret += " ERROR: " + r.Error
+	}
+	return ret
+}
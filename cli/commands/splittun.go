@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"runtime"
 	"strconv"
 	"strings"
 	"syscall"
@@ -33,6 +34,7 @@ import (
 	"github.com/ivpn/desktop-app/cli/cliplatform"
 	"github.com/ivpn/desktop-app/cli/flags"
 	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/splittun"
 )
 
 type Exclude struct {
@@ -99,16 +101,21 @@ func doAddApp(args []string, eaaPass string, isHashedPass bool) error {
 	//	[client]					          [daemon]
 	//	SplitTunnelAddApp		    ->
 	//							            <-	windows:	types.EmptyResp (success)
+	//							            <-	macOS:		types.EmptyResp (success; the application started by daemon)
 	//							            <-	linux:		types.SplitTunnelAddAppCmdResp (some operations required on client side)
-	//	<windows: done>
+	//	<windows, macOS: done>
 	// 	<execute shell command: types.SplitTunnelAddAppCmdResp.CmdToExecute and get PID>
 	//  SplitTunnelAddedPidInfo	->
 	// 							            <-	types.EmptyResp (success)
 
 	binary := args[0]
 
-	binary, err := exec.LookPath(binary)
-	if err != nil {
+	if runtime.GOOS == "darwin" && strings.HasSuffix(strings.TrimRight(binary, "/"), ".app") {
+		// macOS: application bundle (the daemon will start the bundle executable)
+		if _, err := os.Stat(binary); err != nil {
+			return err
+		}
+	} else if _, err := exec.LookPath(binary); err != nil {
 		return err
 	}
 
@@ -125,7 +132,7 @@ func doAddApp(args []string, eaaPass string, isHashedPass bool) error {
 	}
 
 	if !isRequiredToExecuteCommand {
-		// (Windows) Success. No other operations required
+		// (Windows, macOS) Success. No other operations required
 		return nil
 	}
 
@@ -168,6 +175,21 @@ type SplitTun struct {
 	appremove  string
 	appadd     string // this parameter is not in use. We need it just for help info (using 'appaddArgs' parsed with specific logic)
 	appaddArgs []string
+
+	destExclude string
+	destInclude string
+	destRemove  string
+	destClear   bool
+
+	contExclude string
+	contInclude string
+	contRemove  string
+	contClear   bool
+
+	userExclude string
+	userInclude string
+	userRemove  string
+	userClear   bool
 }
 
 func (c *SplitTun) Init() {
@@ -183,13 +205,28 @@ func (c *SplitTun) Init() {
 		c.StringVar(&c.appadd, "appadd", "", "PATH", "Add application to configuration (use full path to binary)")
 		c.StringVar(&c.appremove, "appremove", "", "PATH", "Delete application from configuration (use full path to binary)")
 	} else {
-		// Linux
+		// Linux, macOS
 		c.BoolVar(&c.statusFull, "status_full", false, "(extended status info) Show detailed Split Tunnel status")
 		c.BoolVar(&c.reset, "clean", false, "Erase configuration (delete all applications from configuration and disable)")
 		c.StringVar(&c.appadd, "appadd", "", "COMMAND", "Execute command (binary) in Split Tunnel environment (exclude it's traffic from the VPN tunnel)\nInfo: short version of this command is 'ivpn exclude <command>'\nExamples:\n    ivpn splittun -appadd firefox\n    ivpn splittun -appadd ping 1.1.1.1\n    ivpn splittun -appadd /usr/bin/google-chrome")
 		c.StringVar(&c.appremove, "appremove", "", "PID", "Remove application from Split Tunnel environment\n(argument: Process ID)")
 	}
 
+	c.StringVarEx(&c.destExclude, "destexclude", "", "ADDRESS", "Exclude destination from the VPN tunnel\n(argument: IP address, network in CIDR notation or domain name)\nExamples:\n    ivpn splittun -destexclude 192.168.10.0/24\n    ivpn splittun -destexclude example.com", isSplitTunDestinationsSupported)
+	c.StringVarEx(&c.destInclude, "destinclude", "", "ADDRESS", "Force traffic to the destination into the VPN tunnel\n(even for applications in Split Tunnel environment)\n(argument: IP address, network in CIDR notation or domain name)", isSplitTunDestinationsSupported)
+	c.StringVarEx(&c.destRemove, "destremove", "", "ADDRESS", "Delete destination from configuration", isSplitTunDestinationsSupported)
+	c.BoolVarEx(&c.destClear, "destclear", false, "Delete all destinations from configuration", isSplitTunDestinationsSupported)
+
+	c.StringVarEx(&c.contExclude, "contexclude", "", "CONTAINER", "Exclude container traffic from the VPN tunnel (requires cgroup-v2)\n(argument: Docker container name/ID or cgroup-v2 path)\nExamples:\n    ivpn splittun -contexclude my_container\n    ivpn splittun -contexclude /system.slice/docker-<ID>.scope", isSplitTunContainersSupported)
+	c.StringVarEx(&c.contInclude, "continclude", "", "CONTAINER", "Force container traffic into the VPN tunnel\n(even to the excluded destinations)\n(argument: Docker container name/ID or cgroup-v2 path)", isSplitTunContainersSupported)
+	c.StringVarEx(&c.contRemove, "contremove", "", "CONTAINER", "Delete container from configuration", isSplitTunContainersSupported)
+	c.BoolVarEx(&c.contClear, "contclear", false, "Delete all containers from configuration", isSplitTunContainersSupported)
+
+	c.StringVarEx(&c.userExclude, "userexclude", "", "USER", "Exclude all traffic of the user from the VPN tunnel\n(argument: user name or UID; the 'root' user can not be excluded)\nExamples:\n    ivpn splittun -userexclude transmission\n    ivpn splittun -userexclude 1001", isSplitTunUsersSupported)
+	c.StringVarEx(&c.userInclude, "userinclude", "", "USER", "Force all traffic of the user into the VPN tunnel\n(even to the excluded destinations)\n(argument: user name or UID)", isSplitTunUsersSupported)
+	c.StringVarEx(&c.userRemove, "userremove", "", "USER", "Delete user from configuration", isSplitTunUsersSupported)
+	c.BoolVarEx(&c.userClear, "userclear", false, "Delete all users from configuration", isSplitTunUsersSupported)
+
 	c.BoolVar(&c.on, "on", false, "Enable")
 	c.BoolVar(&c.off, "off", false, "Disable")
 }
@@ -240,6 +277,96 @@ func (c *SplitTun) Run() error {
 		return c.doShowStatusShort(cfg)
 	}
 
+	if len(c.destExclude) > 0 || len(c.destInclude) > 0 || len(c.destRemove) > 0 || c.destClear {
+		if !cfg.IsCanSplitDestinations {
+			return fmt.Errorf("the Split Tunneling by destinations is not applicable for this platform")
+		}
+
+		dests := make([]splittun.Destination, 0, len(cfg.Destinations)+1)
+		if !c.destClear {
+			for _, d := range cfg.Destinations {
+				if strings.EqualFold(d.Address, c.destRemove) || strings.EqualFold(d.Address, c.destExclude) || strings.EqualFold(d.Address, c.destInclude) {
+					continue
+				}
+				dests = append(dests, d)
+			}
+			if len(c.destExclude) > 0 {
+				dests = append(dests, splittun.Destination{Address: c.destExclude})
+			}
+			if len(c.destInclude) > 0 {
+				dests = append(dests, splittun.Destination{Address: c.destInclude, IsInclude: true})
+			}
+		}
+
+		if err = _proto.SetSplitTunnelDestinations(dests); err != nil {
+			return err
+		}
+		cfg, err = _proto.GetSplitTunnelStatus()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(c.contExclude) > 0 || len(c.contInclude) > 0 || len(c.contRemove) > 0 || c.contClear {
+		if !cfg.IsCanSplitContainers {
+			return fmt.Errorf("the Split Tunneling for containers is not applicable for this system (Linux with cgroup-v2 required)")
+		}
+
+		conts := make([]splittun.Container, 0, len(cfg.Containers)+1)
+		if !c.contClear {
+			for _, cont := range cfg.Containers {
+				if cont.Name == c.contRemove || cont.Name == c.contExclude || cont.Name == c.contInclude {
+					continue
+				}
+				conts = append(conts, cont)
+			}
+			if len(c.contExclude) > 0 {
+				conts = append(conts, splittun.Container{Name: c.contExclude})
+			}
+			if len(c.contInclude) > 0 {
+				conts = append(conts, splittun.Container{Name: c.contInclude, IsInclude: true})
+			}
+		}
+
+		if err = _proto.SetSplitTunnelContainers(conts); err != nil {
+			return err
+		}
+		cfg, err = _proto.GetSplitTunnelStatus()
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(c.userExclude) > 0 || len(c.userInclude) > 0 || len(c.userRemove) > 0 || c.userClear {
+		if !cfg.IsCanSplitUsers {
+			return fmt.Errorf("the Split Tunneling for users is not applicable for this system")
+		}
+
+		users := make([]splittun.User, 0, len(cfg.Users)+1)
+		if !c.userClear {
+			for _, u := range cfg.Users {
+				if u.Name == c.userRemove || u.Name == c.userExclude || u.Name == c.userInclude {
+					continue
+				}
+				users = append(users, u)
+			}
+			if len(c.userExclude) > 0 {
+				users = append(users, splittun.User{Name: c.userExclude})
+			}
+			if len(c.userInclude) > 0 {
+				users = append(users, splittun.User{Name: c.userInclude, IsInclude: true})
+			}
+		}
+
+		if err = _proto.SetSplitTunnelUsers(users); err != nil {
+			return err
+		}
+		cfg, err = _proto.GetSplitTunnelStatus()
+		if err != nil {
+			return err
+		}
+	}
+
 	if len(c.appaddArgs) > 0 || len(c.appremove) > 0 {
 		if len(c.appaddArgs) > 0 {
 			if err = doAddApp(c.appaddArgs, "", false); err != nil {
@@ -262,6 +389,9 @@ func (c *SplitTun) Run() error {
 
 func (c *SplitTun) doShowStatus(cfg types.SplitTunnelStatus, isFull bool) error {
 	w := printSplitTunState(nil, false, isFull, cfg.IsEnabled, cfg.SplitTunnelApps, cfg.RunningApps)
+	printSplitTunDestinations(w, cfg.Destinations)
+	printSplitTunContainers(w, cfg.Containers)
+	printSplitTunUsers(w, cfg.Users)
 	w.Flush()
 	return nil
 }
@@ -279,3 +409,15 @@ func (c *SplitTun) specialParse(arguments []string) bool {
 	}
 	return false
 }
+
+func isSplitTunDestinationsSupported() bool {
+	return runtime.GOOS != "windows"
+}
+
+func isSplitTunContainersSupported() bool {
+	return runtime.GOOS == "linux"
+}
+
+func isSplitTunUsersSupported() bool {
+	return runtime.GOOS == "linux"
+}
@@ -0,0 +1,196 @@
+//
+//  IVPN command line interface (CLI)
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the IVPN command line interface.
+//
+//  The IVPN command line interface is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The IVPN command line interface is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the IVPN command line interface. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/cli/helpers"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/connstats"
+)
+
+type CmdStats struct {
+	flags.CmdInfo
+	sessions     int
+	days         int
+	clear        bool
+	enabled      string // [on/off]
+	alertSession int
+	alertDay     int
+	quality      bool
+	latencyMode  string // [tunnel/direct]
+}
+
+func (c *CmdStats) Init() {
+	c.KeepArgsOrderInHelp = true
+
+	c.Initialize("stats", "Connection statistics history (traffic and duration of the connections)")
+	c.IntVar(&c.sessions, "sessions", 10, "COUNT", "Number of the recent connection sessions to show (0 - all)")
+	c.IntVar(&c.days, "days", 7, "COUNT", "Number of the recent days to show (0 - all)")
+	c.BoolVar(&c.quality, "quality", false, "Show quality of the active connection: packet loss, round-trip time and jitter\n(measured by the probes to the VPN server; the most recent last)")
+	c.StringVar(&c.latencyMode, "latency_mode", "", "[tunnel/direct]", "Measure the round-trip time to the VPN server through the tunnel (default) or outside the tunnel")
+	c.BoolVar(&c.clear, "clear", false, "Erase connection statistics history")
+	c.StringVar(&c.enabled, "enabled", "", "[on/off]", "Enable/disable keeping connection statistics history\n(disabling also erases the history)")
+	c.IntVar(&c.alertSession, "alert_session", -1, "MB", "Notify when the traffic of the current connection exceeds the threshold (0 - disable the alert)")
+	c.IntVar(&c.alertDay, "alert_day", -1, "MB", "Notify when the traffic of all connections during the day exceeds the threshold (0 - disable the alert)")
+}
+
+func (c *CmdStats) Run() error {
+	if c.sessions < 0 || c.days < 0 {
+		return flags.BadParameter{Message: "the number of records can not be negative"}
+	}
+
+	if len(c.enabled) > 0 {
+		val, err := helpers.BoolParameterParse(c.enabled)
+		if err != nil {
+			return err
+		}
+		if err := _proto.SetPreferences(string(types.Prefs_IsConnectionStatsDisabled), fmt.Sprint(!val)); err != nil {
+			return err
+		}
+	}
+
+	if c.alertSession >= 0 {
+		if err := _proto.SetPreferences(string(types.Prefs_DataUsageAlertSessionMB), fmt.Sprint(c.alertSession)); err != nil {
+			return err
+		}
+	}
+	if c.alertDay >= 0 {
+		if err := _proto.SetPreferences(string(types.Prefs_DataUsageAlertDayMB), fmt.Sprint(c.alertDay)); err != nil {
+			return err
+		}
+	}
+
+	if len(c.latencyMode) > 0 {
+		mode := connstats.LatencyProbeMode(strings.ToLower(c.latencyMode))
+		if err := mode.Validate(); err != nil {
+			return flags.BadParameter{Message: err.Error()}
+		}
+		if err := _proto.SetPreferences(string(types.Prefs_LatencyProbeMode), string(mode)); err != nil {
+			return err
+		}
+	}
+
+	if c.clear {
+		if err := _proto.ConnectionStatsHistoryClear(); err != nil {
+			return err
+		}
+	}
+
+	if c.quality {
+		return c.doShowQuality()
+	}
+
+	stats, err := _proto.ConnectionStatsHistory(c.sessions, c.days)
+	if err != nil {
+		return err
+	}
+
+	if stats.IsDisabled {
+		fmt.Println("Connection statistics history is disabled")
+		return nil
+	}
+	if len(stats.Sessions) == 0 && len(stats.Days) == 0 {
+		fmt.Println("Connection statistics history is empty")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(w, "DAY\tSESSIONS\tDURATION\tDOWNLOADED\tUPLOADED")
+	for _, d := range stats.Days {
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", d.Date, d.Sessions, statsDuration(d.Duration), statsBytes(d.RxBytes), statsBytes(d.TxBytes))
+	}
+	fmt.Fprintln(w)
+
+	fmt.Fprintln(w, "STARTED\tSERVER\tVPN\tDURATION\tDOWNLOADED\tUPLOADED")
+	for _, s := range stats.Sessions {
+		started := s.Started.Local().Format("2006-01-02 15:04:05")
+		if s.IsActive {
+			started += " (active)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", started, statsSessionServer(s), statsSessionVpn(s), statsDuration(s.Duration), statsBytes(s.RxBytes), statsBytes(s.TxBytes))
+	}
+	w.Flush()
+
+	return nil
+}
+
+func (c *CmdStats) doShowQuality() error {
+	reports, err := _proto.ConnectionQualityHistory(0)
+	if err != nil {
+		return err
+	}
+	if len(reports) == 0 {
+		fmt.Println("No connection quality info (VPN is not connected or the connection was just established)")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	last := reports[len(reports)-1]
+	fmt.Fprintf(w, "Probe target: %s (%s)\n", last.Target, last.ProbeMode)
+	fmt.Fprintln(w, "TIME\tLOSS\tRTT (min/avg/max)\tJITTER")
+	for _, r := range reports {
+		fmt.Fprintf(w, "%s\t%.0f%% (%d/%d)\t%.1f / %.1f / %.1f ms\t%.1f ms\n",
+			time.Unix(r.Time, 0).Format("15:04:05"), r.LossPercent, r.ProbesLost, r.ProbesSent, r.RttMinMs, r.RttAvgMs, r.RttMaxMs, r.JitterMs)
+	}
+	w.Flush()
+	return nil
+}
+
+func statsSessionServer(s connstats.SessionRecord) string {
+	if len(s.ExitServer) == 0 {
+		return s.Server
+	}
+	return fmt.Sprintf("%s -> %s", s.Server, s.ExitServer)
+}
+
+func statsSessionVpn(s connstats.SessionRecord) string {
+	if len(s.Protocol) == 0 {
+		return s.VpnType
+	}
+	return fmt.Sprintf("%s/%s", s.VpnType, s.Protocol)
+}
+
+func statsDuration(d time.Duration) string {
+	return d.Truncate(time.Second).String()
+}
+
+func statsBytes(b uint64) string {
+	const unit = 1024
+	if b < unit {
+		return fmt.Sprintf("%d B", b)
+	}
+	div, exp := uint64(unit), 0
+	for n := b / unit; n >= unit && exp < 4; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.2f %ciB", float64(b)/float64(div), "KMGTP"[exp])
+}
@@ -39,6 +39,10 @@ const (
 	TipLogout                    TipType = iota
 	TipLogin                     TipType = iota
 	TipForceLogin                TipType = iota
+	TipDevices                   TipType = iota
+	TipDevicesLogout             TipType = iota
+	TipAccounts                  TipType = iota
+	TipLoginAdd                  TipType = iota
 	TipServers                   TipType = iota
 	TipConnectHelp               TipType = iota
 	TipDisconnect                TipType = iota
@@ -50,6 +54,7 @@ const (
 	TipWiFiStatus                TipType = iota
 	TipWiFiHelp                  TipType = iota
 	TipAutoconnectHelp           TipType = iota
+	TipScheduleHelp              TipType = iota
 )
 
 func PrintTips(tips []TipType) {
@@ -85,6 +90,14 @@ func PrintTip(w *tabwriter.Writer, tip TipType) {
 		str = newTip("login ACCOUNT_ID", "Log in with your Account ID")
 	case TipForceLogin:
 		str = newTip("login -force ACCOUNT_ID", "Log in with your Account ID and logout from all other devices")
+	case TipDevices:
+		str = newTip("devices -account ACCOUNT_ID", "Show the devices logged in to your account (and log out one of them)")
+	case TipDevicesLogout:
+		str = newTip("devices -logout DEVICE_ID", "Log out the device from your account")
+	case TipAccounts:
+		str = newTip("accounts", "Show logged-in accounts (switch between them)")
+	case TipLoginAdd:
+		str = newTip("login -add", "Login to another account (keep the current account logged-in)")
 	case TipServers:
 		str = newTip("servers", "Show servers list")
 	case TipConnectHelp:
@@ -111,6 +124,8 @@ func PrintTip(w *tabwriter.Writer, tip TipType) {
 		str = newTip("wifi -h", "Show usage of 'wifi' command")
 	case TipAutoconnectHelp:
 		str = newTip("autoconnect -h", "Show usage of 'autoconnect' command")
+	case TipScheduleHelp:
+		str = newTip("schedule -h", "Show usage of 'schedule' command")
 	}
 
 	if len(str) > 0 {
@@ -24,6 +24,7 @@ package commands
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"runtime"
 	"strings"
@@ -59,6 +60,8 @@ type CmdWiFi struct {
 	default_trust_status string //[none/trusted/untrusted]
 	set_trusted_action   string // [action:value] // actions: 'trusted_vpn_off:[true/false]', 'trusted_firewall_off', 'untrusted_vpn_on', 'untrusted_firewall_on'
 	set_trusted_network  string // [network:status] (status: none/trusted/untrusted; e.g. 'my_home_wifi':trusted)
+	network              string // network name for '-set_trusted_action' (actions for the specific network)
+	bssid                string // access point MAC address for '-set_trusted_network' and '-set_trusted_action'
 	reset_settings       bool
 }
 
@@ -104,6 +107,20 @@ func (c *CmdWiFi) Init() {
 					ivpn wifi -set_trusted_network 'my home network':trusted
 					Define current WiFi network as 'untrusted':
 						ivpn wifi -set_trusted_network untrusted`)
+	c.StringVar(&c.network, "network", "", "NETWORK_NAME",
+		`Apply '-set_trusted_action' only for the specific WiFi network
+		(the network must be defined by '-set_trusted_network')
+		Use ACTION 'default' to apply the default actions for the network
+			Example:
+					ivpn wifi -network work -set_trusted_action untrusted_enable_firewall:off
+					ivpn wifi -network work -set_trusted_action default`)
+	c.StringVar(&c.bssid, "bssid", "", "BSSID",
+		`Access point MAC address (BSSID) for '-set_trusted_network' or '-network'
+		The configuration will be applicable only for the specific access point
+		Use value 'current' for currently connected access point
+			Example:
+					ivpn wifi -set_trusted_network work:trusted -bssid aa:bb:cc:dd:ee:ff
+					ivpn wifi -set_trusted_network untrusted -bssid current`)
 
 	c.BoolVar(&c.reset_settings, "reset_settings", false, "Reset WiFi settings to defaults")
 }
@@ -114,6 +131,26 @@ func (c *CmdWiFi) Run() error {
 
 	isSettingsChanged := false
 
+	// access point (BSSID) of the network
+	bssid := ""
+	if len(c.bssid) > 0 {
+		if strings.ToLower(c.bssid) == "current" {
+			curNet, err := _proto.GetWiFiCurrentNetwork()
+			if err != nil {
+				return fmt.Errorf("failed to obtain info about the currently connected WiFi network: %w", err)
+			}
+			if len(curNet.BSSID) == 0 {
+				return fmt.Errorf("Unable to obtain BSSID of currently connected WiFi network. Please, specify BSSID")
+			}
+			c.bssid = curNet.BSSID
+		}
+		mac, err := net.ParseMAC(c.bssid)
+		if err != nil || len(mac) != 6 {
+			return flags.BadParameter{Message: fmt.Sprintf("bad BSSID '%s' (expected format: aa:bb:cc:dd:ee:ff)", c.bssid)}
+		}
+		bssid = mac.String()
+	}
+
 	if len(c.connect_on_insecure) > 0 {
 		val, err := helpers.BoolParameterParse(c.connect_on_insecure) // [on/off]
 		if err != nil {
@@ -156,43 +193,6 @@ func (c *CmdWiFi) Run() error {
 		isSettingsChanged = true
 	}
 
-	if len(c.set_trusted_action) > 0 {
-		// [action:value(on/off)]; (Example: 'trusted_disconnect_vpn:on')
-
-		c.set_trusted_action = strings.ToLower(c.set_trusted_action)
-
-		actionParams := strings.Split(c.set_trusted_action, ":")
-		if len(actionParams) != 2 {
-			return flags.BadParameter{Message: "action"}
-		}
-		actionTypeStr := actionParams[0]
-		actionValStr := actionParams[1]
-
-		val, err := helpers.BoolParameterParse(actionValStr) // [on/off]
-		if err != nil {
-			return err
-		}
-
-		switch actionType(actionTypeStr) {
-		case action_trusted_vpn_off:
-			wifiSettings.Actions.TrustedDisconnectVpn = val
-		case action_trusted_firewall_off:
-			wifiSettings.Actions.TrustedDisableFirewall = val
-		case action_untrusted_vpn_on:
-			wifiSettings.Actions.UnTrustedConnectVpn = val
-		case action_untrusted_firewall_on:
-			wifiSettings.Actions.UnTrustedEnableFirewall = val
-		default:
-			return flags.BadParameter{
-				Message: fmt.Sprintf("not supported action name '%s' (acceptable actions: %s, %s, %s, %s)", actionTypeStr,
-					string(action_trusted_vpn_off),
-					string(action_trusted_firewall_off),
-					string(action_untrusted_vpn_on),
-					string(action_untrusted_firewall_on))}
-		}
-		isSettingsChanged = true
-	}
-
 	if len(c.set_trusted_network) > 0 {
 		//c.set_trusted_network = helpers.TrimSpacesAndRemoveQuotes(c.set_trusted_network)
 		dividerIdx := strings.LastIndex(c.set_trusted_network, ":")
@@ -238,19 +238,76 @@ func (c *CmdWiFi) Run() error {
 		}
 
 		// check if network already exists
-		for i, n := range wifiSettings.Networks {
-			if n.SSID == netName {
-				if isUndefined {
-					wifiSettings.Networks = append(wifiSettings.Networks[:i], wifiSettings.Networks[i+1:]...)
-				} else {
-					wifiSettings.Networks[i].IsTrusted = isTrusted
-				}
-				isSettingsChanged = true
-				break
+		if i := findWiFiNetwork(wifiSettings.Networks, netName, bssid); i >= 0 {
+			if isUndefined {
+				wifiSettings.Networks = append(wifiSettings.Networks[:i], wifiSettings.Networks[i+1:]...)
+			} else {
+				wifiSettings.Networks[i].IsTrusted = isTrusted
 			}
+		} else if !isUndefined {
+			wifiSettings.Networks = append(wifiSettings.Networks, preferences.WiFiNetwork{SSID: netName, BSSID: bssid, IsTrusted: isTrusted})
 		}
-		if !isSettingsChanged {
-			wifiSettings.Networks = append(wifiSettings.Networks, preferences.WiFiNetwork{SSID: netName, IsTrusted: isTrusted})
+		if len(c.network) == 0 {
+			c.network = netName // '-set_trusted_action' (if defined) will be applied to this network
+		}
+		isSettingsChanged = true
+	}
+
+	if len(c.set_trusted_action) > 0 {
+		// [action:value(on/off)]; (Example: 'trusted_disconnect_vpn:on')
+
+		c.set_trusted_action = strings.ToLower(c.set_trusted_action)
+
+		actions := &wifiSettings.Actions
+		if len(c.network) > 0 || len(c.bssid) > 0 {
+			// actions for the specific network
+			idx := findWiFiNetwork(wifiSettings.Networks, c.network, bssid)
+			if idx < 0 {
+				return fmt.Errorf("the network '%s' is not defined (use '-set_trusted_network' to define the network)", wifiNetworkDescription(c.network, bssid))
+			}
+			network := &wifiSettings.Networks[idx]
+			if c.set_trusted_action == "default" {
+				network.Actions = nil // use default actions
+				actions = nil
+			} else if network.Actions == nil {
+				networkActions := wifiSettings.Actions // initialize by default actions
+				network.Actions = &networkActions
+			}
+			if actions != nil {
+				actions = network.Actions
+			}
+		}
+
+		if actions != nil {
+			actionParams := strings.Split(c.set_trusted_action, ":")
+			if len(actionParams) != 2 {
+				return flags.BadParameter{Message: "action"}
+			}
+			actionTypeStr := actionParams[0]
+			actionValStr := actionParams[1]
+
+			val, err := helpers.BoolParameterParse(actionValStr) // [on/off]
+			if err != nil {
+				return err
+			}
+
+			switch actionType(actionTypeStr) {
+			case action_trusted_vpn_off:
+				actions.TrustedDisconnectVpn = val
+			case action_trusted_firewall_off:
+				actions.TrustedDisableFirewall = val
+			case action_untrusted_vpn_on:
+				actions.UnTrustedConnectVpn = val
+			case action_untrusted_firewall_on:
+				actions.UnTrustedEnableFirewall = val
+			default:
+				return flags.BadParameter{
+					Message: fmt.Sprintf("not supported action name '%s' (acceptable actions: %s, %s, %s, %s)", actionTypeStr,
+						string(action_trusted_vpn_off),
+						string(action_trusted_firewall_off),
+						string(action_untrusted_vpn_on),
+						string(action_untrusted_firewall_on))}
+			}
 		}
 		isSettingsChanged = true
 	}
@@ -283,6 +340,23 @@ func (c *CmdWiFi) Run() error {
 	return nil
 }
 
+// findWiFiNetwork returns index of network configuration (-1 if not found)
+func findWiFiNetwork(networks []preferences.WiFiNetwork, ssid, bssid string) int {
+	for i, n := range networks {
+		if n.SSID == ssid && preferences.NormalizeBSSID(n.BSSID) == preferences.NormalizeBSSID(bssid) {
+			return i
+		}
+	}
+	return -1
+}
+
+func wifiNetworkDescription(ssid, bssid string) string {
+	if len(bssid) == 0 {
+		return ssid
+	}
+	return fmt.Sprintf("%s [%s]", ssid, bssid)
+}
+
 func isInsecureNetworksSuppported() bool {
 	return runtime.GOOS != "linux"
 }
@@ -311,7 +385,7 @@ func (c *CmdWiFi) printStatus(w *tabwriter.Writer) *tabwriter.Writer {
 	if err != nil {
 		fmt.Println(err)
 	} else {
-		curNetworkName = fmt.Sprintf("%s", curNet.SSID)
+		curNetworkName = wifiNetworkDescription(curNet.SSID, curNet.BSSID)
 		if curNet.IsInsecureNetwork {
 			curNetworkInfo = fmt.Sprintf(" (no encryption)")
 		}
@@ -339,7 +413,16 @@ func (c *CmdWiFi) printStatus(w *tabwriter.Writer) *tabwriter.Writer {
 	} else {
 		fmt.Fprintf(w, "Networks:\t\n")
 		for _, n := range wifiSettings.Networks {
-			fmt.Fprintf(w, "        %s\t:\t%v\n", n.SSID, boolToStrEx(&n.IsTrusted, "Trusted", "Untrusted", "No status"))
+			fmt.Fprintf(w, "        %s\t:\t%v\n", wifiNetworkDescription(n.SSID, n.BSSID), boolToStrEx(&n.IsTrusted, "Trusted", "Untrusted", "No status"))
+			if n.Actions != nil {
+				if n.IsTrusted {
+					fmt.Fprintf(w, "            Disconnect from VPN\t:\t%v\n", boolToStr(n.Actions.TrustedDisconnectVpn))
+					fmt.Fprintf(w, "            Disable firewall\t:\t%v\n", boolToStr(n.Actions.TrustedDisableFirewall))
+				} else {
+					fmt.Fprintf(w, "            Connect to VPN\t:\t%v\n", boolToStr(n.Actions.UnTrustedConnectVpn))
+					fmt.Fprintf(w, "            Enable firewall\t:\t%v\n", boolToStr(n.Actions.UnTrustedEnableFirewall))
+				}
+			}
 		}
 	}
 	return w
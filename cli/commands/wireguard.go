@@ -25,10 +25,14 @@ package commands
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 	"time"
 
 	"github.com/ivpn/desktop-app/cli/flags"
+	"github.com/ivpn/desktop-app/cli/helpers"
+	"github.com/ivpn/desktop-app/daemon/kem"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
 	"github.com/ivpn/desktop-app/daemon/service/srverrors"
 )
 
@@ -37,6 +41,13 @@ type CmdWireGuard struct {
 	state            bool
 	regenerate       bool
 	rotationInterval int
+	hwProtection     string // [on/off]
+	ovpnFallback     string // [on/off]
+	lanBypass        string // [on/off]
+	peerFailover     string // [on/off]
+	portHopping      int
+	quantumResist    string // [on/off]
+	kemAlgorithm     string
 }
 
 func (c *CmdWireGuard) Init() {
@@ -44,6 +55,13 @@ func (c *CmdWireGuard) Init() {
 	c.BoolVar(&c.state, "status", false, "(default) Show WireGuard configuration")
 	c.IntVar(&c.rotationInterval, "rotation_interval", 0, "DAYS", "Set WireGuard keys rotation interval. [1-30] days")
 	c.BoolVar(&c.regenerate, "regenerate", false, "Regenerate WireGuard keys")
+	c.StringVar(&c.hwProtection, "hw_protection", "", "[on/off]", "Protect stored WireGuard private key by hardware-bound key\n(TPM 2.0 on Windows and Linux; Secure Enclave on macOS)")
+	c.StringVar(&c.ovpnFallback, "ovpn_fallback", "", "[on/off]", "Fall back to WireGuard over TCP (if supported) or to OpenVPN (TCP)\nwhen there is no handshake with the WireGuard server (e.g. UDP traffic is blocked by the network)")
+	c.StringVar(&c.lanBypass, "lan_bypass", "", "[on/off]", "Exclude local networks (private and link-local ranges) from the WireGuard tunnel\n(local traffic never enters the tunnel interface; applied on the next connection)")
+	c.StringVar(&c.peerFailover, "peer_failover", "", "[on/off]", "Switch the connection to another host of the same server (without reconnection)\nwhen there is no handshake with the active host")
+	c.IntVar(&c.portHopping, "port_hopping", -1, "MINUTES", "Periodically move the connection to another port of the server (without reconnection).\n[1-1440] minutes; 0 - disable")
+	c.StringVar(&c.quantumResist, "quantum_resistance", "", "[on/off]", "Negotiate an additional preshared key using post-quantum key encapsulation\nwhen generating WireGuard keys (the keys are regenerated immediately)")
+	c.StringVar(&c.kemAlgorithm, "kem_algorithm", "", "ALGORITHM", fmt.Sprintf("Post-quantum key encapsulation algorithm [%s]\n(default: %s; the keys are regenerated immediately if quantum resistance is enabled)", strings.Join(kem.Algorithms(), "/"), kem.DefaultAlgorithm))
 }
 func (c *CmdWireGuard) Run() error {
 	if c.rotationInterval < 0 || c.rotationInterval > 30 {
@@ -83,6 +101,77 @@ func (c *CmdWireGuard) Run() error {
 		}
 	}
 
+	if len(c.hwProtection) > 0 {
+		val, err := helpers.BoolParameterParse(c.hwProtection)
+		if err != nil {
+			return err
+		}
+		if val && len(resp.DisabledFunctions.WGKeyHwProtectionError) > 0 {
+			return fmt.Errorf("hardware-backed key protection is not available:\n\t%s", resp.DisabledFunctions.WGKeyHwProtectionError)
+		}
+		if err := _proto.SetPreferences(string(types.Prefs_IsWGKeyHwProtection), fmt.Sprint(val)); err != nil {
+			return err
+		}
+	}
+
+	if len(c.ovpnFallback) > 0 {
+		val, err := helpers.BoolParameterParse(c.ovpnFallback)
+		if err != nil {
+			return err
+		}
+		if err := _proto.SetPreferences(string(types.Prefs_IsWgFallbackToOpenVPN), fmt.Sprint(val)); err != nil {
+			return err
+		}
+	}
+
+	if len(c.lanBypass) > 0 {
+		val, err := helpers.BoolParameterParse(c.lanBypass)
+		if err != nil {
+			return err
+		}
+		if err := _proto.SetPreferences(string(types.Prefs_IsWgLanBypass), fmt.Sprint(val)); err != nil {
+			return err
+		}
+	}
+
+	if len(c.peerFailover) > 0 {
+		val, err := helpers.BoolParameterParse(c.peerFailover)
+		if err != nil {
+			return err
+		}
+		if err := _proto.SetPreferences(string(types.Prefs_IsWgPeerFailover), fmt.Sprint(val)); err != nil {
+			return err
+		}
+	}
+
+	if len(c.quantumResist) > 0 {
+		val, err := helpers.BoolParameterParse(c.quantumResist)
+		if err != nil {
+			return err
+		}
+		if val && len(resp.DisabledFunctions.WgQuantumResistanceError) > 0 {
+			return fmt.Errorf("quantum resistance is not available:\n\t%s", resp.DisabledFunctions.WgQuantumResistanceError)
+		}
+		if err := _proto.SetPreferences(string(types.Prefs_IsWgQuantumResistance), fmt.Sprint(val)); err != nil {
+			return err
+		}
+	}
+
+	if len(c.kemAlgorithm) > 0 {
+		if err := kem.ValidateAlgorithm(c.kemAlgorithm); err != nil {
+			return err
+		}
+		if err := _proto.SetPreferences(string(types.Prefs_WgKemAlgorithm), c.kemAlgorithm); err != nil {
+			return err
+		}
+	}
+
+	if c.portHopping >= 0 {
+		if err := _proto.SetPreferences(string(types.Prefs_WgPortHoppingMinutes), fmt.Sprint(c.portHopping)); err != nil {
+			return err
+		}
+	}
+
 	if err := c.getState(); err != nil {
 		return err
 	}
@@ -113,6 +202,44 @@ func (c *CmdWireGuard) getState() error {
 	fmt.Fprintln(w, fmt.Sprintf("Public KEY:\t%v", resp.Session.WgPublicKey))
 	fmt.Fprintln(w, fmt.Sprintf("Generated:\t%v", time.Unix(resp.Session.WgKeyGenerated, 0)))
 	fmt.Fprintln(w, fmt.Sprintf("Rotation interval:\t%v", time.Duration(time.Second*time.Duration(resp.Session.WgKeysRegenInerval))))
+	hwProtection := "Disabled"
+	if len(resp.DisabledFunctions.WGKeyHwProtectionError) > 0 {
+		hwProtection = "Not available"
+	} else if resp.DaemonSettings.IsWGKeyHwProtection {
+		hwProtection = "Enabled"
+	}
+	fmt.Fprintln(w, fmt.Sprintf("Hardware key protection:\t%v", hwProtection))
+	ovpnFallback := "Disabled"
+	if resp.DaemonSettings.IsWgFallbackToOpenVPN {
+		ovpnFallback = "Enabled"
+	}
+	fmt.Fprintln(w, fmt.Sprintf("Fallback to OpenVPN:\t%v", ovpnFallback))
+	lanBypass := "Disabled"
+	if resp.DaemonSettings.IsWgLanBypass {
+		lanBypass = "Enabled"
+	}
+	fmt.Fprintln(w, fmt.Sprintf("LAN bypass:\t%v", lanBypass))
+	peerFailover := "Disabled"
+	if resp.DaemonSettings.IsWgPeerFailover {
+		peerFailover = "Enabled"
+	}
+	fmt.Fprintln(w, fmt.Sprintf("Host failover:\t%v", peerFailover))
+	portHopping := "Disabled"
+	if resp.DaemonSettings.WgPortHoppingMinutes > 0 {
+		portHopping = fmt.Sprint(time.Duration(resp.DaemonSettings.WgPortHoppingMinutes) * time.Minute)
+	}
+	fmt.Fprintln(w, fmt.Sprintf("Port hopping:\t%v", portHopping))
+	quantumResist := "Disabled"
+	if len(resp.DisabledFunctions.WgQuantumResistanceError) > 0 {
+		quantumResist = "Not available"
+	} else if resp.DaemonSettings.IsWgQuantumResistance {
+		algorithm := resp.DaemonSettings.WgKemAlgorithm
+		if len(algorithm) == 0 {
+			algorithm = kem.DefaultAlgorithm
+		}
+		quantumResist = fmt.Sprintf("Enabled (%s)", algorithm)
+	}
+	fmt.Fprintln(w, fmt.Sprintf("Quantum resistance:\t%v", quantumResist))
 	w.Flush()
 
 	return nil
@@ -3,6 +3,7 @@ module github.com/ivpn/desktop-app/cli
 go 1.18
 
 require (
+	github.com/Microsoft/go-winio v0.5.2
 	github.com/ivpn/desktop-app/daemon v0.0.0
 	golang.org/x/crypto v0.7.0
 	golang.org/x/sys v0.6.0
@@ -19,6 +20,7 @@ require (
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/rivo/uniseg v0.4.4 // indirect
 	github.com/stretchr/testify v1.8.2 // indirect
+	golang.org/x/net v0.8.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
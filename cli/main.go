@@ -98,10 +98,17 @@ func main() {
 	addCommand(&stateCmd)
 	addCommand(&commands.CmdConnect{})
 	addCommand(&commands.CmdDisconnect{})
+	addCommand(&commands.CmdExec{})
+	addCommand(&commands.CmdHistory{})
+	addCommand(&commands.CmdStats{})
+	addCommand(&commands.CmdSpeedTest{})
+	addCommand(&commands.CmdProfile{})
 	addCommand(&commands.CmdServers{})
 	addCommand(&commands.CmdFirewall{})
+	addCommand(&commands.CmdPortForwarding{})
+	addCommand(&commands.CmdCaptivePortal{})
 	if cliplatform.IsSplitTunSupported() {
-		// Split tunnel functionality is currently only available on Windows
+		// Split tunnel functionality is currently available on Windows, Linux and macOS
 		addCommand(&commands.SplitTun{})
 		if cliplatform.IsSplitTunRunsApp() {
 			addCommand(&commands.Exclude{})
@@ -117,6 +124,18 @@ func main() {
 	addCommand(&commands.CmdParanoidMode{})
 	addCommand(&commands.CmdAutoConnect{})
 	addCommand(&commands.CmdWiFi{})
+	addCommand(&commands.CmdSchedule{})
+	addCommand(&commands.CmdApiProxy{})
+	addCommand(&commands.CmdApiHost{})
+	addCommand(&commands.CmdOpenVpnParams{})
+	addCommand(&commands.CmdAmneziaWG{})
+	addCommand(&commands.CmdDevices{})
+	addCommand(&commands.CmdAccounts{})
+	addCommand(&commands.CmdSettingsEncryption{})
+	addCommand(&commands.CmdSettingsBackup{})
+	addCommand(&commands.CmdRestApi{})
+	addCommand(&commands.CmdGrpcApi{})
+	addCommand(&commands.CmdClientTokens{})
 
 	if len(os.Args) >= 2 {
 		arg1 := strings.TrimLeft(strings.ToLower(os.Args[1]), "-")
@@ -141,20 +160,9 @@ func main() {
 	}
 
 	// initialize command handler
-	port, secret, err := readDaemonPort()
+	proto, err := connectToDaemon()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "ERROR: Unable to connect to service: %s\n", err)
-		printServStartInstructions()
-		os.Exit(1)
-	}
-
-	proto := protocol.CreateClient(port, secret)
-
-	proto.SetParanoidModeSecretRequestFunc(RequestParanoidModePassword)
-	proto.SetPrintFunc(PrintToConsoleFunc)
-
-	if err := proto.Connect(); err != nil {
-		fmt.Fprintf(os.Stderr, "ERROR: Failed to connect to service : %s\n", err)
+		fmt.Fprintf(os.Stderr, "ERROR: %s\n", err)
 		printServStartInstructions()
 		os.Exit(1)
 	}
@@ -236,6 +244,35 @@ func runCommand(c ICommand, args []string) {
 	}
 }
 
+// connectToDaemon connects to the daemon over the local socket: unix domain socket or named pipe (if available and accessible)
+// or over the TCP port defined in the port file
+func connectToDaemon() (*protocol.Client, error) {
+	initClient := func(c *protocol.Client) *protocol.Client {
+		c.SetParanoidModeSecretRequestFunc(RequestParanoidModePassword)
+		c.SetPrintFunc(PrintToConsoleFunc)
+		return c
+	}
+
+	if socketFile := platform.ServiceSocketFile(); len(socketFile) > 0 {
+		proto := initClient(protocol.CreateClientLocalSocket(socketFile))
+		if err := proto.Connect(); err == nil {
+			return proto, nil
+		}
+		// e.g. the socket does not exist or no access to it: try to connect over TCP
+	}
+
+	port, secret, err := readDaemonPort()
+	if err != nil {
+		return nil, fmt.Errorf("Unable to connect to service: %w", err)
+	}
+
+	proto := initClient(protocol.CreateClient(port, secret))
+	if err := proto.Connect(); err != nil {
+		return nil, fmt.Errorf("Failed to connect to service : %w", err)
+	}
+	return proto, nil
+}
+
 // read port+secret to be able to connect to a daemon
 func readDaemonPort() (port int, secret uint64, err error) {
 	file := platform.ServicePortFile()
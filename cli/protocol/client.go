@@ -36,12 +36,23 @@ import (
 	"time"
 
 	apitypes "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/awg"
 	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/obfsproxy"
+	"github.com/ivpn/desktop-app/daemon/operations"
 	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/captiveportal"
+	"github.com/ivpn/desktop-app/daemon/service/connstats"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
+	"github.com/ivpn/desktop-app/daemon/service/hostshealth"
+	"github.com/ivpn/desktop-app/daemon/service/portforwarding"
 	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"github.com/ivpn/desktop-app/daemon/service/speedtest"
 	service_types "github.com/ivpn/desktop-app/daemon/service/types"
+	"github.com/ivpn/desktop-app/daemon/shadowsocks"
+	"github.com/ivpn/desktop-app/daemon/splittun"
+	"github.com/ivpn/desktop-app/daemon/v2r"
 	"github.com/ivpn/desktop-app/daemon/version"
 	"github.com/ivpn/desktop-app/daemon/vpn"
 	"golang.org/x/crypto/pbkdf2"
@@ -52,6 +63,8 @@ type Client struct {
 	_port   int
 	_secret uint64
 	_conn   net.Conn
+	// local socket of the daemon: unix domain socket or named pipe (if defined - it is in use instead of TCP port)
+	_socketFile string
 
 	_requestIdx int
 
@@ -65,6 +78,12 @@ type Client struct {
 	_paranoidModeSecretRequestFunc func(*Client) (string, error)
 
 	_printFunc func(string)
+
+	// receiver of the daemon log messages (see LogStreamStart)
+	_logMessageFunc func(types.LogMessageResp)
+
+	// receiver of the status notifications of the long-running operations (see SpeedTest)
+	_operationStatusFunc func(operations.Status)
 }
 
 // ResponseTimeout error
@@ -84,6 +103,15 @@ func CreateClient(port int, secret uint64) *Client {
 		_receivers:      make(map[*receiverChannel]struct{})}
 }
 
+// CreateClientLocalSocket initialising new client for IVPN daemon which is connecting over the local socket:
+// unix domain socket (Linux, macOS) or named pipe (Windows)
+// (the secret is not required: the access is controlled by the socket permissions)
+func CreateClientLocalSocket(socketFile string) *Client {
+	c := CreateClient(0, 0)
+	c._socketFile = socketFile
+	return c
+}
+
 // Connect is connecting to daemon
 func (c *Client) Connect() (err error) {
 	if c._conn != nil {
@@ -92,7 +120,11 @@ func (c *Client) Connect() (err error) {
 
 	logger.Info("Connecting...")
 
-	c._conn, err = net.Dial("tcp", fmt.Sprintf(":%d", c._port))
+	if len(c._socketFile) > 0 {
+		c._conn, err = dialLocalSocket(c._socketFile)
+	} else {
+		c._conn, err = net.Dial("tcp", fmt.Sprintf(":%d", c._port))
+	}
 	if err != nil {
 		return fmt.Errorf("failed to connect to IVPN daemon (does IVPN daemon/service running?): %w", err)
 	}
@@ -151,6 +183,7 @@ func (c *Client) SendHelloEx(isSendResponseToAllClients bool) (helloResponse typ
 		ClientType:               types.ClientCli,
 		GetStatus:                true,
 		Version:                  ver + ": CLI",
+		ProtocolVersion:          types.ProtocolVersion,
 		SendResponseToAllClients: isSendResponseToAllClients,
 	}
 
@@ -168,20 +201,137 @@ func (c *Client) GetHelloResponse() types.HelloResp {
 	return c._helloResponse
 }
 
+// IsRequestSupported returns 'true' when the connected daemon supports the request type.
+// Daemons which are not reporting the list of supported requests (older versions) are considered to support everything.
+func (c *Client) IsRequestSupported(requestName string) bool {
+	supported := c._helloResponse.SupportedRequests
+	if len(supported) == 0 {
+		return true
+	}
+	for _, r := range supported {
+		if r == requestName {
+			return true
+		}
+	}
+	return false
+}
+
+// SetNotificationsFilter subscribes the client only to specific notification types (empty list - all notifications)
+func (c *Client) SetNotificationsFilter(notifications []string) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.SetNotificationsFilter{Notifications: notifications}
+	var resp types.EmptyResp
+
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+	return nil
+}
+
 // SessionNew creates new session
-func (c *Client) SessionNew(accountID string, forceLogin bool, the2FA string) (apiStatus int, err error) {
+// (if the login requires additional confirmation, the response contains the details: see resp.Is2FARequired, resp.IsCaptchaRequired)
+func (c *Client) SessionNew(accountID string, forceLogin bool, keepCurrentAccount bool, the2FA string, captchaID string, captcha string) (resp types.SessionNewResp, err error) {
+	if err := c.ensureConnected(); err != nil {
+		return resp, err
+	}
+
+	req := types.SessionNew{AccountID: accountID, ForceLogin: forceLogin, KeepCurrentAccount: keepCurrentAccount, Confirmation2FA: the2FA, CaptchaID: captchaID, Captcha: captcha}
+
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return resp, err
+	}
+
+	if len(resp.Session.Session) <= 0 {
+		return resp, fmt.Errorf("[%d] %s", resp.APIStatus, resp.APIErrorMessage)
+	}
+
+	return resp, nil
+}
+
+// AccountsGet returns the list of logged-in accounts (the active account first)
+func (c *Client) AccountsGet() ([]types.AccountInfo, error) {
+	if err := c.ensureConnected(); err != nil {
+		return nil, err
+	}
+
+	req := types.AccountsGet{}
+	var resp types.AccountsResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Accounts, nil
+}
+
+// AccountSwitch makes the logged-in (but not active) account active
+func (c *Client) AccountSwitch(accountID string) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.AccountSwitch{AccountID: accountID}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AccountRemove logs out the logged-in account which is not active
+func (c *Client) AccountRemove(accountID string) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.AccountRemove{AccountID: accountID}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// DevicesList returns the devices (active sessions) of the account
+// If accountID is empty - the current session is used for authentication
+func (c *Client) DevicesList(accountID string, the2FA string) (apiStatus int, devices []apitypes.DeviceInfo, err error) {
+	if err := c.ensureConnected(); err != nil {
+		return 0, nil, err
+	}
+
+	req := types.DevicesList{AccountID: accountID, Confirmation2FA: the2FA}
+	var resp types.DevicesResp
+
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return 0, nil, err
+	}
+
+	if resp.APIStatus != 0 {
+		return resp.APIStatus, nil, fmt.Errorf("[%d] %s", resp.APIStatus, resp.APIErrorMessage)
+	}
+
+	return resp.APIStatus, resp.Devices, nil
+}
+
+// DeviceLogout logs out the device of the account
+// If accountID is empty - the current session is used for authentication
+func (c *Client) DeviceLogout(accountID string, the2FA string, deviceID string) (apiStatus int, err error) {
 	if err := c.ensureConnected(); err != nil {
 		return 0, err
 	}
 
-	req := types.SessionNew{AccountID: accountID, ForceLogin: forceLogin, Confirmation2FA: the2FA}
-	var resp types.SessionNewResp
+	req := types.DeviceLogout{AccountID: accountID, Confirmation2FA: the2FA, DeviceID: deviceID}
+	var resp types.DevicesResp
 
 	if err := c.sendRecv(&req, &resp); err != nil {
 		return 0, err
 	}
 
-	if len(resp.Session.Session) <= 0 {
+	if resp.APIStatus != 0 {
 		return resp.APIStatus, fmt.Errorf("[%d] %s", resp.APIStatus, resp.APIErrorMessage)
 	}
 
@@ -255,66 +405,58 @@ func (c *Client) SetObfsProxy(cfg obfsproxy.Config) error {
 	return nil
 }
 
-// FirewallSet change firewall state
-func (c *Client) FirewallSet(isOn bool) error {
+// SetV2RayProxy sets V2Ray transport for VPN connections (v2r.None - disable V2Ray)
+func (c *Client) SetV2RayProxy(t v2r.V2RayTransportType) error {
 	if err := c.ensureConnected(); err != nil {
 		return err
 	}
 
-	// changing killswitch state
-	req := types.KillSwitchSetEnabled{IsEnabled: isOn}
+	req := types.SetV2RayProxy{V2RayProxy: t}
 	var resp types.EmptyResp
 	if err := c.sendRecv(&req, &resp); err != nil {
 		return err
 	}
 
-	// requesting status
-	state, err := c.FirewallStatus()
-	if err != nil {
-		return err
-	}
-
-	if state.IsEnabled != isOn {
-		return fmt.Errorf("firewall state did not change [isEnabled=%v]", state.IsEnabled)
-	}
-
 	return nil
 }
 
-// FirewallSet change firewall Persistent state
-func (c *Client) FirewallPersistentSet(isOn bool) error {
+// SetApiProxy sets proxy server for the daemon API requests (empty configuration - direct connection)
+func (c *Client) SetApiProxy(cfg apitypes.ProxyConfig) error {
 	if err := c.ensureConnected(); err != nil {
 		return err
 	}
 
-	// changing killswitch Persistent state
-	req := types.KillSwitchSetIsPersistent{IsPersistent: isOn}
+	req := types.SetApiProxy{Config: cfg}
 	var resp types.EmptyResp
 	if err := c.sendRecv(&req, &resp); err != nil {
 		return err
 	}
 
-	// requesting status
-	state, err := c.FirewallStatus()
-	if err != nil {
+	return nil
+}
+
+// SetApiHostOverride sets the alternative API server for the daemon API requests (empty configuration - the default IVPN API server)
+func (c *Client) SetApiHostOverride(cfg apitypes.APIHostOverride) error {
+	if err := c.ensureConnected(); err != nil {
 		return err
 	}
 
-	if state.IsPersistent != isOn || (isOn == true && state.IsEnabled != true) {
-		return fmt.Errorf("firewall 'persistent' state did not change [isEnabled=%v; IsPersistent=%v]", state.IsEnabled, state.IsPersistent)
+	req := types.SetApiHostOverride{Config: cfg}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
 	}
 
 	return nil
 }
 
-// FirewallAllowLan set configuration 'allow LAN'
-func (c *Client) FirewallAllowLan(allow bool) error {
+// SetOpenVpnExtraParameters sets the additional OpenVPN directives, one per line (empty string - no additional directives)
+func (c *Client) SetOpenVpnExtraParameters(params string) error {
 	if err := c.ensureConnected(); err != nil {
 		return err
 	}
 
-	// changing killswitch configuration
-	req := types.KillSwitchSetAllowLAN{AllowLAN: allow}
+	req := types.SetOpenVpnExtraParameters{Params: params}
 	var resp types.EmptyResp
 	if err := c.sendRecv(&req, &resp); err != nil {
 		return err
@@ -323,14 +465,13 @@ func (c *Client) FirewallAllowLan(allow bool) error {
 	return nil
 }
 
-// FirewallAllowLan set configuration 'firewall exceptions' (comma separated list of IP addresses/masks in format: x.x.x.x[/xx])
-func (c *Client) FirewallSetUserExceptions(exceptions string) error {
+// SetOpenVpnCryptoPolicy sets the TLS/cipher policy for OpenVPN connections (empty policy - default parameters)
+func (c *Client) SetOpenVpnCryptoPolicy(policy vpn.OpenVpnCryptoPolicy) error {
 	if err := c.ensureConnected(); err != nil {
 		return err
 	}
 
-	// changing killswitch configuration
-	req := types.KillSwitchSetUserExceptions{UserExceptions: exceptions, FailOnParsingError: true}
+	req := types.SetOpenVpnCryptoPolicy{Policy: policy}
 	var resp types.EmptyResp
 	if err := c.sendRecv(&req, &resp); err != nil {
 		return err
@@ -339,167 +480,151 @@ func (c *Client) FirewallSetUserExceptions(exceptions string) error {
 	return nil
 }
 
-// FirewallAllowApiServers set configuration 'Allow access to IVPN servers when Firewall is enabled'
-func (c *Client) FirewallAllowApiServers(allow bool) error {
+// RestApiGet returns the configuration of the local REST API of the daemon (including the access token)
+func (c *Client) RestApiGet() (preferences.RestApiParams, error) {
 	if err := c.ensureConnected(); err != nil {
-		return err
+		return preferences.RestApiParams{}, err
 	}
 
-	// changing killswitch configuration
-	req := types.KillSwitchSetAllowApiServers{IsAllowApiServers: allow}
-	var resp types.EmptyResp
+	req := types.RestApiGet{}
+	var resp types.RestApiResp
 	if err := c.sendRecv(&req, &resp); err != nil {
-		return err
+		return preferences.RestApiParams{}, err
 	}
 
-	return nil
+	return resp.Params, nil
 }
 
-// FirewallAllowLanMulticast set configuration 'allow LAN multicast'
-func (c *Client) FirewallAllowLanMulticast(allow bool) error {
+// SetRestApi enables/disables the local REST API of the daemon ('port' = 0 - keep the current port; 'resetToken' - generate new access token)
+func (c *Client) SetRestApi(isEnabled bool, port int, resetToken bool, isMetricsEnabled bool) (preferences.RestApiParams, error) {
 	if err := c.ensureConnected(); err != nil {
-		return err
+		return preferences.RestApiParams{}, err
 	}
 
-	// changing killswitch configuration
-	req := types.KillSwitchSetAllowLANMulticast{AllowLANMulticast: allow}
-	var resp types.EmptyResp
+	req := types.SetRestApi{IsEnabled: isEnabled, Port: port, ResetToken: resetToken, IsMetricsEnabled: isMetricsEnabled}
+	var resp types.RestApiResp
 	if err := c.sendRecv(&req, &resp); err != nil {
-		return err
+		return preferences.RestApiParams{}, err
 	}
 
-	return nil
+	return resp.Params, nil
 }
 
-// FirewallStatus get firewall state
-func (c *Client) FirewallStatus() (state types.KillSwitchStatusResp, err error) {
+// GrpcApiGet returns the configuration of the local gRPC API of the daemon (including the access token)
+func (c *Client) GrpcApiGet() (preferences.GrpcApiParams, error) {
 	if err := c.ensureConnected(); err != nil {
-		return state, err
+		return preferences.GrpcApiParams{}, err
 	}
 
-	// requesting status
-	statReq := types.KillSwitchGetStatus{}
-	if err := c.sendRecv(&statReq, &state); err != nil {
-		return state, err
+	req := types.GrpcApiGet{}
+	var resp types.GrpcApiResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return preferences.GrpcApiParams{}, err
 	}
 
-	return state, nil
+	return resp.Params, nil
 }
 
-// GetSplitTunnelStatus requests the Split-Tunnelling configuration
-func (c *Client) GetSplitTunnelStatus() (cfg types.SplitTunnelStatus, err error) {
+// SetGrpcApi enables/disables the local gRPC API of the daemon ('port' = 0 - keep the current port; 'resetToken' - generate new access token)
+func (c *Client) SetGrpcApi(isEnabled bool, port int, resetToken bool) (preferences.GrpcApiParams, error) {
 	if err := c.ensureConnected(); err != nil {
-		return cfg, err
+		return preferences.GrpcApiParams{}, err
 	}
 
-	// requesting status
-	req := types.SplitTunnelGetStatus{}
-	if err := c.sendRecv(&req, &cfg); err != nil {
-		return cfg, err
+	req := types.SetGrpcApi{IsEnabled: isEnabled, Port: port, ResetToken: resetToken}
+	var resp types.GrpcApiResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return preferences.GrpcApiParams{}, err
 	}
 
-	return cfg, nil
+	return resp.Params, nil
 }
 
-// SetSplitTunnelConfig sets the split-tunnelling configuration
-func (c *Client) SetSplitTunnelConfig(isEnable, reset bool) (err error) {
+// SetLogRotation sets the configuration of the daemon log files rotation
+func (c *Client) SetLogRotation(cfg logger.RotationConfig) error {
 	if err := c.ensureConnected(); err != nil {
 		return err
 	}
 
-	req := types.SplitTunnelSetConfig{IsEnabled: isEnable, Reset: reset}
-	resp := types.SplitTunnelStatus{}
-	if _, _, err := c.sendRecvAny(&req, &resp); err != nil {
+	req := types.SetLogRotation{Config: cfg}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (c *Client) SplitTunnelAddApp(execCmd string) (isRequiredToExecuteCommand bool, retErr error) {
+// LogLevelsGet returns the current runtime log levels of the daemon
+func (c *Client) LogLevelsGet() (types.LogLevelsResp, error) {
 	if err := c.ensureConnected(); err != nil {
-		return false, err
+		return types.LogLevelsResp{}, err
 	}
 
-	// Description of Split Tunneling commands sequence to run the application:
-	//	[client]					          [daemon]
-	//	SplitTunnelAddApp		    ->
-	//							            <-	windows:	types.EmptyResp (success)
-	//							            <-	linux:		types.SplitTunnelAddAppCmdResp (some operations required on client side)
-	//	<windows: done>
-	// 	<execute shell command: types.SplitTunnelAddAppCmdResp.CmdToExecute and get PID>
-	//  SplitTunnelAddedPidInfo	->
-	// 							            <-	types.EmptyResp (success)
-
-	var respEmpty types.EmptyResp
-	var respAppCmdResp types.SplitTunnelAddAppCmdResp
-	if val, ok := os.LookupEnv("IVPN_STARTED_BY_PARENT"); !ok || val != "IVPN_UI" {
-		// If the CLI was started by IVPN UI - skip sending 'SplitTunnelAddApp'
-		// It is already done by IVPN UI
+	req := types.LogLevelsGet{}
+	var resp types.LogLevelsResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return types.LogLevelsResp{}, err
+	}
 
-		req := types.SplitTunnelAddApp{Exec: execCmd}
-		_, _, err := c.sendRecvAnyEx(&req, false, &respEmpty, &respAppCmdResp)
-		if err != nil {
-			return false, err
-		}
+	return resp, nil
+}
 
-		if len(respEmpty.Command) > 0 {
-			// success. No additional operations required
-			return false, nil
-		}
+// SetLogLevel changes the runtime log level of the daemon module ('module' = "" - default level for all modules; 'level' = "" - reset the module-specific level)
+func (c *Client) SetLogLevel(module, level string) (types.LogLevelsResp, error) {
+	if err := c.ensureConnected(); err != nil {
+		return types.LogLevelsResp{}, err
+	}
 
-		if len(respAppCmdResp.Command) <= 0 {
-			return false, fmt.Errorf("unexpected response from the daemon")
-		}
+	req := types.SetLogLevel{Module: module, Level: level}
+	var resp types.LogLevelsResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return types.LogLevelsResp{}, err
 	}
 
-	if respAppCmdResp.IsAlreadyRunning {
-		warningMes := respAppCmdResp.IsAlreadyRunningMessage
-		if len(warningMes) <= 0 {
-			// Note! Normally, this message will be never used. The text will come from daemon in 'IsAlreadyRunningMessage'
-			warningMes = "It appears the application is already running.\nSome applications must be closed before launching them in the Split Tunneling environment or they may not be excluded from the VPN tunnel."
-		}
-		fmt.Println("WARNING! " + warningMes)
+	return resp, nil
+}
 
-		fmt.Print("Do you really want to launch the command? [y/n]: ")
-		reader := bufio.NewReader(os.Stdin)
-		yn, _ := reader.ReadString('\n')
-		yn = strings.TrimSuffix(yn, "\n")
-		yn = strings.TrimSuffix(yn, "\r")
-		if yn == "" {
-			yn = "yes"
-			fmt.Println(yn)
-		}
-		yn = strings.ToUpper(yn)
-		if yn != "Y" && yn != "YES" {
-			return false, fmt.Errorf("canceled")
-		}
+// ClientTokensGet returns the list of the client access tokens
+func (c *Client) ClientTokensGet() ([]preferences.ClientToken, error) {
+	if err := c.ensureConnected(); err != nil {
+		return nil, err
 	}
 
-	// register new PID and inform that command must be executed
-	reqAddedePid := types.SplitTunnelAddedPidInfo{Pid: os.Getpid(), Exec: execCmd, CmdToExecute: strings.Join(os.Args[:], " ")}
-	if err := c.sendRecv(&reqAddedePid, &respEmpty); err != nil {
-		return false, err
+	req := types.ClientTokensGet{}
+	var resp types.ClientTokensResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return nil, err
 	}
 
-	return true, nil
+	return resp.Tokens, nil
 }
 
-func (c *Client) SplitTunnelRemoveApp(cmdOrPid string) error {
+// ClientTokenAdd creates new client access token with the specified access scope
+func (c *Client) ClientTokenAdd(name string, scope preferences.ClientAccessScope) (preferences.ClientToken, error) {
 	if err := c.ensureConnected(); err != nil {
-		return err
+		return preferences.ClientToken{}, err
 	}
 
-	pid := 0
-	cmd := ""
+	req := types.ClientTokenAdd{Name: name, Scope: scope}
+	var resp types.ClientTokensResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return preferences.ClientToken{}, err
+	}
+	if len(resp.Tokens) == 0 {
+		return preferences.ClientToken{}, fmt.Errorf("unexpected response from the daemon")
+	}
 
-	if p, err := strconv.Atoi(cmdOrPid); err == nil {
-		pid = p
-	} else {
-		cmd = cmdOrPid
+	return resp.Tokens[0], nil
+}
+
+// ClientTokenRemove removes the client access token by name
+func (c *Client) ClientTokenRemove(name string) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
 	}
 
-	req := types.SplitTunnelRemoveApp{Exec: cmd, Pid: pid}
+	req := types.ClientTokenRemove{Name: name}
 	var resp types.EmptyResp
 	if err := c.sendRecv(&req, &resp); err != nil {
 		return err
@@ -508,96 +633,1011 @@ func (c *Client) SplitTunnelRemoveApp(cmdOrPid string) error {
 	return nil
 }
 
-// GetServers gets servers list
-func (c *Client) GetServers() (apitypes.ServersInfoResponse, error) {
+// SettingsExport requests the daemon configuration which can be imported on another machine
+func (c *Client) SettingsExport(includeSecrets bool) (preferences.ExportedSettings, error) {
 	if err := c.ensureConnected(); err != nil {
-		return apitypes.ServersInfoResponse{}, err
+		return preferences.ExportedSettings{}, err
 	}
 
-	req := types.GetServers{}
-	var resp types.ServerListResp
-
+	req := types.SettingsExport{IncludeSecrets: includeSecrets}
+	var resp types.SettingsExportResp
 	if err := c.sendRecv(&req, &resp); err != nil {
-		return resp.VpnServers, err
+		return preferences.ExportedSettings{}, err
 	}
 
-	return resp.VpnServers, nil
+	return resp.Settings, nil
 }
 
-// GetServersForceUpdate gets servers list (skip cache; load data from backend)
-func (c *Client) GetServersForceUpdate() (apitypes.ServersInfoResponse, error) {
+// SettingsImport applies the exported daemon configuration.
+// Returns the descriptions of the settings which were skipped.
+func (c *Client) SettingsImport(settings preferences.ExportedSettings) (warnings []string, err error) {
 	if err := c.ensureConnected(); err != nil {
-		return apitypes.ServersInfoResponse{}, err
+		return nil, err
 	}
 
-	req := types.GetServers{
-		RequestServersUpdate: true,
+	req := types.SettingsImport{Settings: settings}
+	var resp types.SettingsImportResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return nil, err
 	}
-	var resp types.ServerListResp
 
+	return resp.Warnings, nil
+}
+
+// SetShadowsocksProxy sets user-defined Shadowsocks server to chain VPN connections through (empty configuration - disable Shadowsocks)
+func (c *Client) SetShadowsocksProxy(cfg shadowsocks.Config) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.SetShadowsocksProxy{Config: cfg}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetAmneziaWG sets AmneziaWG obfuscation parameters for WireGuard connections (empty configuration - vanilla WireGuard)
+func (c *Client) SetAmneziaWG(cfg awg.Config) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.SetAmneziaWG{Config: cfg}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FirewallSet change firewall state
+func (c *Client) FirewallSet(isOn bool) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	// changing killswitch state
+	req := types.KillSwitchSetEnabled{IsEnabled: isOn}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	// requesting status
+	state, err := c.FirewallStatus()
+	if err != nil {
+		return err
+	}
+
+	if state.IsEnabled != isOn {
+		return fmt.Errorf("firewall state did not change [isEnabled=%v]", state.IsEnabled)
+	}
+
+	return nil
+}
+
+// FirewallSet change firewall Persistent state
+func (c *Client) FirewallPersistentSet(isOn bool) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	// changing killswitch Persistent state
+	req := types.KillSwitchSetIsPersistent{IsPersistent: isOn}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	// requesting status
+	state, err := c.FirewallStatus()
+	if err != nil {
+		return err
+	}
+
+	if state.IsPersistent != isOn || (isOn == true && state.IsEnabled != true) {
+		return fmt.Errorf("firewall 'persistent' state did not change [isEnabled=%v; IsPersistent=%v]", state.IsEnabled, state.IsPersistent)
+	}
+
+	return nil
+}
+
+// FirewallAllowLan set configuration 'allow LAN'
+func (c *Client) FirewallAllowLan(allow bool) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	// changing killswitch configuration
+	req := types.KillSwitchSetAllowLAN{AllowLAN: allow}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FirewallAllowLanServices set configuration 'LAN services allowed individually' (when LAN communication is blocked)
+func (c *Client) FirewallAllowLanServices(services lansvc.Services) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.KillSwitchSetAllowLANServices{Services: services}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FirewallSetAllowedLanHosts set configuration 'allowed LAN hosts' (comma separated list of local IP addresses)
+func (c *Client) FirewallSetAllowedLanHosts(hosts string) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.KillSwitchSetAllowedLANHosts{Hosts: hosts}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FirewallSetLanInboundPorts set configuration 'local ports which accept incoming connections from LAN'
+func (c *Client) FirewallSetLanInboundPorts(ports []lansvc.InboundPort) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.KillSwitchSetLANInboundPorts{Ports: ports}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FirewallAllowLan set configuration 'firewall exceptions' (comma separated list of IP addresses/masks in format: x.x.x.x[/xx])
+func (c *Client) FirewallSetUserExceptions(exceptions string) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	// changing killswitch configuration
+	req := types.KillSwitchSetUserExceptions{UserExceptions: exceptions, FailOnParsingError: true}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FirewallAllowApiServers set configuration 'Allow access to IVPN servers when Firewall is enabled'
+func (c *Client) FirewallAllowApiServers(allow bool) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	// changing killswitch configuration
+	req := types.KillSwitchSetAllowApiServers{IsAllowApiServers: allow}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FirewallAllowLanMulticast set configuration 'allow LAN multicast'
+func (c *Client) FirewallAllowLanMulticast(allow bool) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	// changing killswitch configuration
+	req := types.KillSwitchSetAllowLANMulticast{AllowLANMulticast: allow}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FirewallStatus get firewall state
+func (c *Client) FirewallStatus() (state types.KillSwitchStatusResp, err error) {
+	if err := c.ensureConnected(); err != nil {
+		return state, err
+	}
+
+	// requesting status
+	statReq := types.KillSwitchGetStatus{}
+	if err := c.sendRecv(&statReq, &state); err != nil {
+		return state, err
+	}
+
+	return state, nil
+}
+
+// GetSplitTunnelStatus requests the Split-Tunnelling configuration
+func (c *Client) GetSplitTunnelStatus() (cfg types.SplitTunnelStatus, err error) {
+	if err := c.ensureConnected(); err != nil {
+		return cfg, err
+	}
+
+	// requesting status
+	req := types.SplitTunnelGetStatus{}
+	if err := c.sendRecv(&req, &cfg); err != nil {
+		return cfg, err
+	}
+
+	return cfg, nil
+}
+
+// SetSplitTunnelConfig sets the split-tunnelling configuration
+func (c *Client) SetSplitTunnelConfig(isEnable, reset bool) (err error) {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.SplitTunnelSetConfig{IsEnabled: isEnable, Reset: reset}
+	resp := types.SplitTunnelStatus{}
+	if _, _, err := c.sendRecvAny(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetSplitTunnelDestinations sets destinations (IP addresses, networks, domain names)
+// to be excluded from (or included into) the VPN tunnel
+func (c *Client) SetSplitTunnelDestinations(destinations []splittun.Destination) (err error) {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.SplitTunnelSetDestinations{Destinations: destinations}
+	resp := types.SplitTunnelStatus{}
+	if _, _, err := c.sendRecvAny(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetSplitTunnelContainers sets containers (Docker container names/IDs or cgroup-v2 paths)
+// to be excluded from (or included into) the VPN tunnel. The previous containers are replaced.
+func (c *Client) SetSplitTunnelContainers(containers []splittun.Container) (err error) {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.SplitTunnelSetContainers{Containers: containers}
+	resp := types.SplitTunnelStatus{}
+	if _, _, err := c.sendRecvAny(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SetSplitTunnelUsers sets users (user names or UIDs) which traffic to be excluded from
+// (or included into) the VPN tunnel. The previous users are replaced.
+func (c *Client) SetSplitTunnelUsers(users []splittun.User) (err error) {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.SplitTunnelSetUsers{Users: users}
+	resp := types.SplitTunnelStatus{}
+	if _, _, err := c.sendRecvAny(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *Client) SplitTunnelAddApp(execCmd string) (isRequiredToExecuteCommand bool, retErr error) {
+	if err := c.ensureConnected(); err != nil {
+		return false, err
+	}
+
+	// Description of Split Tunneling commands sequence to run the application:
+	//	[client]					          [daemon]
+	//	SplitTunnelAddApp		    ->
+	//							            <-	windows:	types.EmptyResp (success)
+	//							            <-	macOS:		types.EmptyResp (success; the application started by daemon)
+	//							            <-	linux:		types.SplitTunnelAddAppCmdResp (some operations required on client side)
+	//	<windows, macOS: done>
+	// 	<execute shell command: types.SplitTunnelAddAppCmdResp.CmdToExecute and get PID>
+	//  SplitTunnelAddedPidInfo	->
+	// 							            <-	types.EmptyResp (success)
+
+	var respEmpty types.EmptyResp
+	var respAppCmdResp types.SplitTunnelAddAppCmdResp
+	if val, ok := os.LookupEnv("IVPN_STARTED_BY_PARENT"); !ok || val != "IVPN_UI" {
+		// If the CLI was started by IVPN UI - skip sending 'SplitTunnelAddApp'
+		// It is already done by IVPN UI
+
+		req := types.SplitTunnelAddApp{Exec: execCmd}
+		_, _, err := c.sendRecvAnyEx(&req, false, &respEmpty, &respAppCmdResp)
+		if err != nil {
+			return false, err
+		}
+
+		if len(respEmpty.Command) > 0 {
+			// success. No additional operations required
+			return false, nil
+		}
+
+		if len(respAppCmdResp.Command) <= 0 {
+			return false, fmt.Errorf("unexpected response from the daemon")
+		}
+	}
+
+	if respAppCmdResp.IsAlreadyRunning {
+		warningMes := respAppCmdResp.IsAlreadyRunningMessage
+		if len(warningMes) <= 0 {
+			// Note! Normally, this message will be never used. The text will come from daemon in 'IsAlreadyRunningMessage'
+			warningMes = "It appears the application is already running.\nSome applications must be closed before launching them in the Split Tunneling environment or they may not be excluded from the VPN tunnel."
+		}
+		fmt.Println("WARNING! " + warningMes)
+
+		fmt.Print("Do you really want to launch the command? [y/n]: ")
+		reader := bufio.NewReader(os.Stdin)
+		yn, _ := reader.ReadString('\n')
+		yn = strings.TrimSuffix(yn, "\n")
+		yn = strings.TrimSuffix(yn, "\r")
+		if yn == "" {
+			yn = "yes"
+			fmt.Println(yn)
+		}
+		yn = strings.ToUpper(yn)
+		if yn != "Y" && yn != "YES" {
+			return false, fmt.Errorf("canceled")
+		}
+	}
+
+	// register new PID and inform that command must be executed
+	reqAddedePid := types.SplitTunnelAddedPidInfo{Pid: os.Getpid(), Exec: execCmd, CmdToExecute: strings.Join(os.Args[:], " ")}
+	if err := c.sendRecv(&reqAddedePid, &respEmpty); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (c *Client) SplitTunnelRemoveApp(cmdOrPid string) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	pid := 0
+	cmd := ""
+
+	if p, err := strconv.Atoi(cmdOrPid); err == nil {
+		pid = p
+	} else {
+		cmd = cmdOrPid
+	}
+
+	req := types.SplitTunnelRemoveApp{Exec: cmd, Pid: pid}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetServers gets servers list
+func (c *Client) GetServers() (apitypes.ServersInfoResponse, error) {
+	if err := c.ensureConnected(); err != nil {
+		return apitypes.ServersInfoResponse{}, err
+	}
+
+	req := types.GetServers{}
+	var resp types.ServerListResp
+
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return resp.VpnServers, err
+	}
+
+	return resp.VpnServers, nil
+}
+
+// GetServersForceUpdate gets servers list (skip cache; load data from backend)
+func (c *Client) GetServersForceUpdate() (apitypes.ServersInfoResponse, error) {
+	if err := c.ensureConnected(); err != nil {
+		return apitypes.ServersInfoResponse{}, err
+	}
+
+	req := types.GetServers{
+		RequestServersUpdate: true,
+	}
+	var resp types.ServerListResp
+
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return resp.VpnServers, err
+	}
+
+	return resp.VpnServers, nil
+}
+
+// GetVPNState returns current VPN connection state
+func (c *Client) GetVPNState() (vpn.State, types.ConnectedResp, error) {
+	respConnected := types.ConnectedResp{}
+	respDisconnected := types.DisconnectedResp{}
+	respState := types.VpnStateResp{}
+
+	if err := c.ensureConnected(); err != nil {
+		return vpn.DISCONNECTED, respConnected, err
+	}
+
+	req := types.GetVPNState{}
+
+	_, _, err := c.sendRecvAny(&req, &respConnected, &respDisconnected, &respState)
+	if err != nil {
+		return vpn.DISCONNECTED, respConnected, err
+	}
+
+	if len(respConnected.Command) > 0 {
+		return vpn.CONNECTED, respConnected, nil
+	}
+
+	if len(respDisconnected.Command) > 0 {
+		return vpn.DISCONNECTED, respConnected, nil
+	}
+
+	if len(respState.Command) > 0 {
+		return respState.StateVal, respConnected, nil
+	}
+
+	return vpn.DISCONNECTED, respConnected, fmt.Errorf("failed to receive VPN state (not expected return type)")
+}
+
+// DisconnectVPN disconnect active VPN connection
+func (c *Client) DisconnectVPN() error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.Disconnect{}
+	respEmpty := types.EmptyResp{}
+	respDisconnected := types.DisconnectedResp{}
+
+	_, _, err := c.sendRecvAny(&req, &respDisconnected, &respEmpty)
+	if err != nil {
+		return err
+	}
+
+	if len(respDisconnected.Command) == 0 && len(respEmpty.Command) == 0 {
+		return fmt.Errorf("disconnect request failed (not expected return type)")
+	}
+
+	return nil
+}
+
+// ConnectVPN - establish new VPN connection
+func (c *Client) ConnectVPN(req types.Connect) (types.ConnectedResp, error) {
+	respConnected := types.ConnectedResp{}
+	respDisconnected := types.DisconnectedResp{}
+
+	if err := c.ensureConnected(); err != nil {
+		return respConnected, err
+	}
+
+	_, _, err := c.sendRecvAny(&req, &respConnected, &respDisconnected)
+	if err != nil {
+		return respConnected, err
+	}
+
+	if len(respConnected.Command) > 0 {
+		return respConnected, nil
+	}
+
+	if len(respDisconnected.Command) > 0 {
+		return respConnected, fmt.Errorf("%s", respDisconnected.ReasonDescription)
+	}
+
+	return respConnected, fmt.Errorf("connect request failed (not expected return type)")
+}
+
+// ConnectionHistory returns recently used connection parameters (the most recent first)
+func (c *Client) ConnectionHistory() (types.ConnectionHistoryResp, error) {
+	var resp types.ConnectionHistoryResp
+	if err := c.ensureConnected(); err != nil {
+		return resp, err
+	}
+
+	req := types.ConnectionHistoryGet{}
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// AuditLog returns the most recent records of the daemon audit log (maxCount = 0 - all records)
+func (c *Client) AuditLog(maxCount int) (types.AuditLogResp, error) {
+	var resp types.AuditLogResp
+	if err := c.ensureConnected(); err != nil {
+		return resp, err
+	}
+
+	req := types.AuditLogGet{MaxCount: maxCount}
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// LogStreamStart starts streaming of the daemon log messages: 'onMessage' is called for each received message.
+// 'level' - min level of the messages (empty - all messages); 'modules' - names of the logger modules (empty - all modules)
+func (c *Client) LogStreamStart(level string, modules []string, onMessage func(types.LogMessageResp)) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	c._receiversLocker.Lock()
+	c._logMessageFunc = onMessage
+	c._receiversLocker.Unlock()
+
+	req := types.LogStreamStart{Level: level, Modules: modules}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// LogStreamStop stops streaming of the daemon log messages
+func (c *Client) LogStreamStop() error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.LogStreamStop{}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	c._receiversLocker.Lock()
+	c._logMessageFunc = nil
+	c._receiversLocker.Unlock()
+
+	return nil
+}
+
+// ShellCommands returns the most recent external commands executed by the daemon (maxCount = 0 - all records)
+func (c *Client) ShellCommands(maxCount int) (types.ShellCommandsResp, error) {
+	var resp types.ShellCommandsResp
+	if err := c.ensureConnected(); err != nil {
+		return resp, err
+	}
+
+	req := types.ShellCommandsGet{MaxCount: maxCount}
 	if err := c.sendRecv(&req, &resp); err != nil {
-		return resp.VpnServers, err
+		return resp, err
 	}
 
-	return resp.VpnServers, nil
+	return resp, nil
 }
 
-// GetVPNState returns current VPN connection state
-func (c *Client) GetVPNState() (vpn.State, types.ConnectedResp, error) {
+// CrashReports returns the saved crash reports of the daemon (recovered panics)
+func (c *Client) CrashReports(withStack bool) (types.CrashReportsResp, error) {
+	var resp types.CrashReportsResp
+	if err := c.ensureConnected(); err != nil {
+		return resp, err
+	}
+
+	req := types.CrashReportsGet{WithStack: withStack}
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// CrashReportsClear removes all the saved crash reports of the daemon
+func (c *Client) CrashReportsClear() error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.CrashReportsClear{}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SubsystemStatus returns the initialization status of the daemon subsystems
+func (c *Client) SubsystemStatus() (types.SubsystemStatusResp, error) {
+	var resp types.SubsystemStatusResp
+	if err := c.ensureConnected(); err != nil {
+		return resp, err
+	}
+
+	req := types.GetSubsystemStatus{}
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// PortForwardingStatus returns the state of the port forwarded to the current VPN connection
+func (c *Client) PortForwardingStatus() (portforwarding.State, error) {
+	if err := c.ensureConnected(); err != nil {
+		return portforwarding.State{}, err
+	}
+
+	req := types.PortForwardingGetStatus{}
+	var resp types.PortForwardingStatusResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return portforwarding.State{}, err
+	}
+
+	return resp.State, nil
+}
+
+// PortForwardingRequest requests the forwarded port for the current VPN connection
+func (c *Client) PortForwardingRequest() (portforwarding.State, error) {
+	if err := c.ensureConnected(); err != nil {
+		return portforwarding.State{}, err
+	}
+
+	req := types.PortForwardingRequest{}
+	var resp types.PortForwardingStatusResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return portforwarding.State{}, err
+	}
+
+	return resp.State, nil
+}
+
+// PortForwardingRelease releases the forwarded port
+func (c *Client) PortForwardingRelease() error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.PortForwardingRelease{}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CaptivePortalCheck checks if the internet access is restricted by a captive portal
+func (c *Client) CaptivePortalCheck() (captiveportal.Status, error) {
+	if err := c.ensureConnected(); err != nil {
+		return captiveportal.Status{}, err
+	}
+
+	req := types.CaptivePortalCheck{}
+	var resp types.CaptivePortalStatusResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return captiveportal.Status{}, err
+	}
+
+	return resp.Status, nil
+}
+
+// CaptivePortalAllowLogin disables the firewall temporarily for the captive portal login (durationSec = 0 - default time)
+func (c *Client) CaptivePortalAllowLogin(durationSec int) (captiveportal.Status, error) {
+	if err := c.ensureConnected(); err != nil {
+		return captiveportal.Status{}, err
+	}
+
+	req := types.CaptivePortalAllowLogin{DurationSec: durationSec}
+	var resp types.CaptivePortalStatusResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return captiveportal.Status{}, err
+	}
+
+	return resp.Status, nil
+}
+
+// CaptivePortalReLock enables the firewall back after the captive portal login
+func (c *Client) CaptivePortalReLock() (captiveportal.Status, error) {
+	if err := c.ensureConnected(); err != nil {
+		return captiveportal.Status{}, err
+	}
+
+	req := types.CaptivePortalReLock{}
+	var resp types.CaptivePortalStatusResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return captiveportal.Status{}, err
+	}
+
+	return resp.Status, nil
+}
+
+// OperationStart starts the long-running operation (e.g. operations.TypeDiagnostics) on the daemon side.
+// Returns initial status of the operation (contains the operation ID).
+// The daemon notifies all clients about the progress and the result of the operation (OperationStatusResp).
+func (c *Client) OperationStart(opType string) (operations.Status, error) {
+	if err := c.ensureConnected(); err != nil {
+		return operations.Status{}, err
+	}
+
+	req := types.OperationStart{Type: opType}
+	var resp types.OperationStatusResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return operations.Status{}, err
+	}
+
+	return resp.Operation, nil
+}
+
+// SpeedTest runs the speed test through the active VPN tunnel and waits until it is finished.
+// 'onProgress' is called for each progress notification of the test.
+// Returns the final status of the operation (the result of the test is speedtest.Result in 'Result' field).
+func (c *Client) SpeedTest(params speedtest.Params, onProgress func(operations.Status)) (operations.Status, error) {
+	if err := c.ensureConnected(); err != nil {
+		return operations.Status{}, err
+	}
+
+	statusChan := make(chan operations.Status, 64)
+	c._receiversLocker.Lock()
+	c._operationStatusFunc = func(s operations.Status) {
+		if s.Type != operations.TypeSpeedTest {
+			return
+		}
+		// must not block: the function is called by the receiver routine
+		select {
+		case statusChan <- s:
+		default:
+		}
+	}
+	c._receiversLocker.Unlock()
+	defer func() {
+		c._receiversLocker.Lock()
+		c._operationStatusFunc = nil
+		c._receiversLocker.Unlock()
+	}()
+
+	req := types.SpeedTestStart{Params: params}
+	var resp types.OperationStatusResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return operations.Status{}, err
+	}
+	id := resp.Operation.Id
+
+	durationSec := params.Normalized().DurationSec
+	timeout := time.Duration(durationSec*2)*time.Second + time.Minute
+	for {
+		select {
+		case s := <-statusChan:
+			if s.Id != id {
+				continue
+			}
+			if s.IsDone() {
+				return s, nil
+			}
+			if onProgress != nil {
+				onProgress(s)
+			}
+		case <-time.After(timeout):
+			return operations.Status{}, ResponseTimeout{}
+		}
+	}
+}
+
+// OperationCancel cancels the running operation
+func (c *Client) OperationCancel(id uint64) (operations.Status, error) {
+	if err := c.ensureConnected(); err != nil {
+		return operations.Status{}, err
+	}
+
+	req := types.OperationCancel{OperationId: id}
+	var resp types.OperationStatusResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return operations.Status{}, err
+	}
+
+	return resp.Operation, nil
+}
+
+// OperationsRunning returns status of all running operations
+func (c *Client) OperationsRunning() ([]operations.Status, error) {
+	if err := c.ensureConnected(); err != nil {
+		return nil, err
+	}
+
+	req := types.OperationsGet{}
+	var resp types.OperationsListResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Operations, nil
+}
+
+// GetHostsHealth returns health information for the VPN hosts which had connection failures
+func (c *Client) GetHostsHealth() ([]hostshealth.HostScore, error) {
+	if err := c.ensureConnected(); err != nil {
+		return nil, err
+	}
+
+	req := types.HostsHealthGet{}
+	var resp types.HostsHealthResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Hosts, nil
+}
+
+// ConnectionStatsHistory returns the statistics of the recent connection sessions and the daily aggregates
+// (sessionsLimit, daysLimit = 0 - all records)
+func (c *Client) ConnectionStatsHistory(sessionsLimit, daysLimit int) (types.ConnectionStatsHistoryResp, error) {
+	var resp types.ConnectionStatsHistoryResp
+	if err := c.ensureConnected(); err != nil {
+		return resp, err
+	}
+
+	req := types.ConnectionStatsHistoryGet{SessionsLimit: sessionsLimit, DaysLimit: daysLimit}
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// ThroughputHistory returns the recent traffic rates of the active connection (maxItems = 0 - all samples)
+func (c *Client) ThroughputHistory(maxItems int) (types.ThroughputHistoryResp, error) {
+	var resp types.ThroughputHistoryResp
+	if err := c.ensureConnected(); err != nil {
+		return resp, err
+	}
+
+	req := types.ThroughputHistoryGet{MaxItems: maxItems}
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return resp, err
+	}
+
+	return resp, nil
+}
+
+// ConnectionQualityHistory returns the recent quality reports of the active connection (maxItems = 0 - all reports)
+func (c *Client) ConnectionQualityHistory(maxItems int) ([]connstats.QualityReport, error) {
+	if err := c.ensureConnected(); err != nil {
+		return nil, err
+	}
+
+	req := types.ConnectionQualityHistoryGet{MaxItems: maxItems}
+	var resp types.ConnectionQualityHistoryResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Reports, nil
+}
+
+// ConnectionStatsHistoryClear erases the connection statistics history
+func (c *Client) ConnectionStatsHistoryClear() error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.ConnectionStatsHistoryClear{}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConnectionHistoryClear erases the connection history
+func (c *Client) ConnectionHistoryClear() error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.ConnectionHistoryClear{}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ConnectionHistoryConnect - establish new VPN connection using parameters from connection history
+func (c *Client) ConnectionHistoryConnect(index int) (types.ConnectedResp, error) {
 	respConnected := types.ConnectedResp{}
 	respDisconnected := types.DisconnectedResp{}
-	respState := types.VpnStateResp{}
 
 	if err := c.ensureConnected(); err != nil {
-		return vpn.DISCONNECTED, respConnected, err
+		return respConnected, err
 	}
 
-	req := types.GetVPNState{}
-
-	_, _, err := c.sendRecvAny(&req, &respConnected, &respDisconnected, &respState)
+	req := types.ConnectionHistoryConnect{Index: index}
+	_, _, err := c.sendRecvAny(&req, &respConnected, &respDisconnected)
 	if err != nil {
-		return vpn.DISCONNECTED, respConnected, err
+		return respConnected, err
 	}
 
 	if len(respConnected.Command) > 0 {
-		return vpn.CONNECTED, respConnected, nil
+		return respConnected, nil
 	}
 
 	if len(respDisconnected.Command) > 0 {
-		return vpn.DISCONNECTED, respConnected, nil
+		return respConnected, fmt.Errorf("%s", respDisconnected.ReasonDescription)
 	}
 
-	if len(respState.Command) > 0 {
-		return respState.StateVal, respConnected, nil
+	return respConnected, fmt.Errorf("connect request failed (not expected return type)")
+}
+
+// ConnectionProfiles returns the list of saved connection profiles
+func (c *Client) ConnectionProfiles() ([]preferences.ConnectionProfile, error) {
+	if err := c.ensureConnected(); err != nil {
+		return nil, err
 	}
 
-	return vpn.DISCONNECTED, respConnected, fmt.Errorf("failed to receive VPN state (not expected return type)")
+	req := types.ConnectionProfilesGet{}
+	var resp types.ConnectionProfilesResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return nil, err
+	}
+
+	return resp.Profiles, nil
 }
 
-// DisconnectVPN disconnect active VPN connection
-func (c *Client) DisconnectVPN() error {
+// ConnectionProfileSave saves the connection profile (the profile with the same name is replaced)
+func (c *Client) ConnectionProfileSave(profile preferences.ConnectionProfile) error {
 	if err := c.ensureConnected(); err != nil {
 		return err
 	}
 
-	req := types.Disconnect{}
-	respEmpty := types.EmptyResp{}
-	respDisconnected := types.DisconnectedResp{}
+	req := types.ConnectionProfileSave{Profile: profile}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
+	}
 
-	_, _, err := c.sendRecvAny(&req, &respDisconnected, &respEmpty)
-	if err != nil {
+	return nil
+}
+
+// ConnectionProfileRemove removes the connection profile
+func (c *Client) ConnectionProfileRemove(name string) error {
+	if err := c.ensureConnected(); err != nil {
 		return err
 	}
 
-	if len(respDisconnected.Command) == 0 && len(respEmpty.Command) == 0 {
-		return fmt.Errorf("disconnect request failed (not expected return type)")
+	req := types.ConnectionProfileRemove{Name: name}
+	var resp types.EmptyResp
+	if err := c.sendRecv(&req, &resp); err != nil {
+		return err
 	}
 
 	return nil
 }
 
-// ConnectVPN - establish new VPN connection
-func (c *Client) ConnectVPN(req types.Connect) (types.ConnectedResp, error) {
+// ConnectionProfileConnect - establish new VPN connection using the connection profile
+func (c *Client) ConnectionProfileConnect(name string) (types.ConnectedResp, error) {
 	respConnected := types.ConnectedResp{}
 	respDisconnected := types.DisconnectedResp{}
 
@@ -605,6 +1645,7 @@ func (c *Client) ConnectVPN(req types.Connect) (types.ConnectedResp, error) {
 		return respConnected, err
 	}
 
+	req := types.ConnectionProfileConnect{Name: name}
 	_, _, err := c.sendRecvAny(&req, &respConnected, &respDisconnected)
 	if err != nil {
 		return respConnected, err
@@ -757,6 +1798,20 @@ func (c *Client) SetWiFiSettings(params preferences.WiFiParams) error {
 	return nil
 }
 
+// SetScheduleSettings sets configuration of the connection scheduler
+func (c *Client) SetScheduleSettings(params preferences.ScheduleParams) error {
+	if err := c.ensureConnected(); err != nil {
+		return err
+	}
+
+	req := types.ScheduleSettings{Params: params}
+	var resp types.EmptyResp
+	if _, _, err := c.sendRecvAny(&req, &resp); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (c *Client) SetDefConnectionParams(params types.ConnectSettings) error {
 	if err := c.ensureConnected(); err != nil {
 		return err
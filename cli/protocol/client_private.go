@@ -182,6 +182,10 @@ func (c *Client) send(cmd interface{}, requestIdx int) error {
 
 	logger.Info("--> ", cmdName)
 
+	if !c.IsRequestSupported(cmdName) {
+		return fmt.Errorf("the command '%s' is not supported by the IVPN daemon (version %s); please update the IVPN daemon", cmdName, c._helloResponse.Version)
+	}
+
 	if err := c.initRequestFields(cmd); err != nil {
 		return err
 	}
@@ -278,6 +282,28 @@ func (c *Client) receiverRoutine() {
 				}
 			}
 
+			if cmd.Command == types.GetTypeName(types.OperationStatusResp{}) {
+				// status of the long-running operation (see SpeedTest)
+				if f := c._operationStatusFunc; f != nil {
+					var s types.OperationStatusResp
+					if err := json.Unmarshal(messageData, &s); err == nil {
+						f(s.Operation)
+					}
+				}
+			}
+
+			if cmd.Command == types.GetTypeName(types.LogMessageResp{}) {
+				// streamed log message (see LogStreamStart)
+				if f := c._logMessageFunc; f != nil {
+					var m types.LogMessageResp
+					if err := json.Unmarshal(messageData, &m); err == nil {
+						f(m)
+					}
+				}
+				isProcessed = true
+				return
+			}
+
 			for receiver := range c._receivers {
 				if receiver.IsExpectedResponse(cmd) {
 					isProcessed = true
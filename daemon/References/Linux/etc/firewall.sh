@@ -57,6 +57,15 @@ OUT_IVPN_STAT_USER_EXP=IVPN-OUT-STAT-USER-EXP
 # chain for non-VPN depended exceptios: only for ICMP protocol (ping)
 IN_IVPN_ICMP_EXP=IVPN-IN-ICMP-EXP
 OUT_IVPN_ICMP_EXP=IVPN-OUT-ICMP-EXP
+# chains for individual LAN services (mDNS, SSDP, DHCP, printing) allowed when LAN communication is blocked
+IN_IVPN_LAN_SVC=IVPN-IN-LAN-SVC
+OUT_IVPN_LAN_SVC=IVPN-OUT-LAN-SVC
+# local networks (the LAN services are allowed only for these addresses)
+LAN_NETS_IPv4="10.0.0.0/8,172.16.0.0/12,192.168.0.0/16,169.254.0.0/16"
+LAN_NETS_IPv6="fe80::/10,fc00::/7"
+# chains for TCP MSS clamping rules ('mangle' table; applicable when VPN connected, do not depend on firewall state)
+IN_IVPN_MSS=IVPN-IN-MSS
+OUT_IVPN_MSS=IVPN-OUT-MSS
 
 # ### Split Tunnel ###
 # Info: The 'mark' value for packets coming from the Split-Tunneling environment.
@@ -127,6 +136,9 @@ function enable_firewall {
       create_chain ${IPv6BIN} ${IN_IVPN_STAT_USER_EXP}
       create_chain ${IPv6BIN} ${OUT_IVPN_STAT_USER_EXP}
 
+      create_chain ${IPv6BIN} ${IN_IVPN_LAN_SVC}
+      create_chain ${IPv6BIN} ${OUT_IVPN_LAN_SVC}
+
       # block DNS for IPv6
       #
       # Important: Block DNS before allowing link-local and unique-localaddresses!
@@ -172,6 +184,8 @@ function enable_firewall {
       ${IPv6BIN} -w ${LOCKWAITTIME} -A ${FORWARD_IVPN} -j ${FORWARD_IVPN_IF}
       ${IPv6BIN} -w ${LOCKWAITTIME} -A ${OUT_IVPN} -j ${OUT_IVPN_STAT_USER_EXP}
       ${IPv6BIN} -w ${LOCKWAITTIME} -A ${IN_IVPN} -j ${IN_IVPN_STAT_USER_EXP}
+      ${IPv6BIN} -w ${LOCKWAITTIME} -A ${OUT_IVPN} -j ${OUT_IVPN_LAN_SVC}
+      ${IPv6BIN} -w ${LOCKWAITTIME} -A ${IN_IVPN} -j ${IN_IVPN_LAN_SVC}
 
       # IPv6: block everything by default
       ${IPv6BIN} -w ${LOCKWAITTIME} -P INPUT DROP
@@ -215,6 +229,9 @@ function enable_firewall {
     create_chain ${IPv4BIN} ${IN_IVPN_ICMP_EXP}
     create_chain ${IPv4BIN} ${OUT_IVPN_ICMP_EXP}
 
+    create_chain ${IPv4BIN} ${IN_IVPN_LAN_SVC}
+    create_chain ${IPv4BIN} ${OUT_IVPN_LAN_SVC}
+
     # allow  local (lo) interface
     ${IPv4BIN} -w ${LOCKWAITTIME} -A ${OUT_IVPN} -o lo -j ACCEPT
     ${IPv4BIN} -w ${LOCKWAITTIME} -A ${IN_IVPN} -i lo -j ACCEPT
@@ -260,6 +277,8 @@ function enable_firewall {
     ${IPv4BIN} -w ${LOCKWAITTIME} -A ${IN_IVPN} -j ${IN_IVPN_STAT_USER_EXP}
     ${IPv4BIN} -w ${LOCKWAITTIME} -A ${OUT_IVPN} -j ${OUT_IVPN_ICMP_EXP}
     ${IPv4BIN} -w ${LOCKWAITTIME} -A ${IN_IVPN} -j ${IN_IVPN_ICMP_EXP}
+    ${IPv4BIN} -w ${LOCKWAITTIME} -A ${OUT_IVPN} -j ${OUT_IVPN_LAN_SVC}
+    ${IPv4BIN} -w ${LOCKWAITTIME} -A ${IN_IVPN} -j ${IN_IVPN_LAN_SVC}
 
     # block everything by default
     ${IPv4BIN} -w ${LOCKWAITTIME} -P INPUT DROP
@@ -309,6 +328,8 @@ function disable_firewall {
     ${IPv4BIN} -w ${LOCKWAITTIME} -D ${IN_IVPN} -j ${IN_IVPN_STAT_USER_EXP}
     ${IPv4BIN} -w ${LOCKWAITTIME} -D ${OUT_IVPN} -j ${OUT_IVPN_ICMP_EXP}
     ${IPv4BIN} -w ${LOCKWAITTIME} -D ${IN_IVPN} -j ${IN_IVPN_ICMP_EXP}
+    ${IPv4BIN} -w ${LOCKWAITTIME} -D ${OUT_IVPN} -j ${OUT_IVPN_LAN_SVC}
+    ${IPv4BIN} -w ${LOCKWAITTIME} -D ${IN_IVPN} -j ${IN_IVPN_LAN_SVC}
 
     # '-F' Delete all rules in  chain or all chains
     ${IPv4BIN} -w ${LOCKWAITTIME} -F ${OUT_IVPN_IF0}
@@ -326,6 +347,8 @@ function disable_firewall {
     ${IPv4BIN} -w ${LOCKWAITTIME} -F ${IN_IVPN_STAT_USER_EXP}
     ${IPv4BIN} -w ${LOCKWAITTIME} -F ${OUT_IVPN_ICMP_EXP}
     ${IPv4BIN} -w ${LOCKWAITTIME} -F ${IN_IVPN_ICMP_EXP}
+    ${IPv4BIN} -w ${LOCKWAITTIME} -F ${OUT_IVPN_LAN_SVC}
+    ${IPv4BIN} -w ${LOCKWAITTIME} -F ${IN_IVPN_LAN_SVC}
     # '-X' Delete a user-defined chain
     ${IPv4BIN} -w ${LOCKWAITTIME} -X ${OUT_IVPN_IF0}
     ${IPv4BIN} -w ${LOCKWAITTIME} -X ${IN_IVPN_IF0}    
@@ -342,6 +365,8 @@ function disable_firewall {
     ${IPv4BIN} -w ${LOCKWAITTIME} -X ${IN_IVPN_STAT_USER_EXP}
     ${IPv4BIN} -w ${LOCKWAITTIME} -X ${OUT_IVPN_ICMP_EXP}
     ${IPv4BIN} -w ${LOCKWAITTIME} -X ${IN_IVPN_ICMP_EXP}
+    ${IPv4BIN} -w ${LOCKWAITTIME} -X ${OUT_IVPN_LAN_SVC}
+    ${IPv4BIN} -w ${LOCKWAITTIME} -X ${IN_IVPN_LAN_SVC}
 
     ### IPv6 ###
     ${IPv6BIN} -w ${LOCKWAITTIME} -D OUTPUT -j ${OUT_IVPN}
@@ -357,6 +382,8 @@ function disable_firewall {
     ${IPv6BIN} -w ${LOCKWAITTIME} -D ${IN_IVPN} -j ${IN_IVPN_STAT_EXP}
     ${IPv6BIN} -w ${LOCKWAITTIME} -D ${OUT_IVPN} -j ${OUT_IVPN_STAT_USER_EXP}
     ${IPv6BIN} -w ${LOCKWAITTIME} -D ${IN_IVPN} -j ${IN_IVPN_STAT_USER_EXP}
+    ${IPv6BIN} -w ${LOCKWAITTIME} -D ${OUT_IVPN} -j ${OUT_IVPN_LAN_SVC}
+    ${IPv6BIN} -w ${LOCKWAITTIME} -D ${IN_IVPN} -j ${IN_IVPN_LAN_SVC}
 
     ${IPv6BIN} -w ${LOCKWAITTIME} -F ${OUT_IVPN_IF0}
     ${IPv6BIN} -w ${LOCKWAITTIME} -F ${IN_IVPN_IF0}    
@@ -371,6 +398,8 @@ function disable_firewall {
     ${IPv6BIN} -w ${LOCKWAITTIME} -F ${IN_IVPN_STAT_EXP}
     ${IPv6BIN} -w ${LOCKWAITTIME} -F ${OUT_IVPN_STAT_USER_EXP}
     ${IPv6BIN} -w ${LOCKWAITTIME} -F ${IN_IVPN_STAT_USER_EXP}
+    ${IPv6BIN} -w ${LOCKWAITTIME} -F ${OUT_IVPN_LAN_SVC}
+    ${IPv6BIN} -w ${LOCKWAITTIME} -F ${IN_IVPN_LAN_SVC}
 
     ${IPv6BIN} -w ${LOCKWAITTIME} -X ${OUT_IVPN_IF0}
     ${IPv6BIN} -w ${LOCKWAITTIME} -X ${IN_IVPN_IF0}    
@@ -385,6 +414,8 @@ function disable_firewall {
     ${IPv6BIN} -w ${LOCKWAITTIME} -X ${IN_IVPN_STAT_EXP}
     ${IPv6BIN} -w ${LOCKWAITTIME} -X ${OUT_IVPN_STAT_USER_EXP}
     ${IPv6BIN} -w ${LOCKWAITTIME} -X ${IN_IVPN_STAT_USER_EXP}
+    ${IPv6BIN} -w ${LOCKWAITTIME} -X ${OUT_IVPN_LAN_SVC}
+    ${IPv6BIN} -w ${LOCKWAITTIME} -X ${IN_IVPN_LAN_SVC}
     echo "IVPN Firewall disabled"
 }
 
@@ -421,6 +452,102 @@ function client_disconnected {
   fi
 }
 
+# allow communication with the service in LAN
+# (outgoing requests to the service port, replies from it and, for UDP, requests/announcements to the local service port)
+function allow_lan_service {
+  BIN=$1
+  PROTOCOL=$2
+  PORT=$3
+  NETS=$4
+
+  ${BIN} -w ${LOCKWAITTIME} -A ${OUT_IVPN_LAN_SVC} -p ${PROTOCOL} -d ${NETS} --dport ${PORT} -j ACCEPT
+  ${BIN} -w ${LOCKWAITTIME} -A ${IN_IVPN_LAN_SVC} -p ${PROTOCOL} -s ${NETS} --sport ${PORT} -j ACCEPT
+  if [[ ${PROTOCOL} = "udp" ]]; then
+    ${BIN} -w ${LOCKWAITTIME} -A ${IN_IVPN_LAN_SVC} -p ${PROTOCOL} -s ${NETS} --dport ${PORT} -j ACCEPT
+  fi
+}
+
+# allow incoming connections from LAN to the local port (and replies from it)
+function allow_lan_inbound_port {
+  BIN=$1
+  PROTOCOL=$2
+  PORT=$3
+  NETS=$4
+
+  ${BIN} -w ${LOCKWAITTIME} -A ${IN_IVPN_LAN_SVC} -p ${PROTOCOL} -s ${NETS} --dport ${PORT} -j ACCEPT
+  ${BIN} -w ${LOCKWAITTIME} -A ${OUT_IVPN_LAN_SVC} -p ${PROTOCOL} -d ${NETS} --sport ${PORT} -j ACCEPT
+}
+
+# apply rules for the LAN services
+# Arguments: list of the allowed services (mdns, ssdp, dhcp, printing)
+#            and local ports allowed for incoming connections from LAN in format: in:PORT/PROTOCOL (e.g. in:22/tcp)
+function set_lan_services {
+  clean_chain ${IPv4BIN} ${IN_IVPN_LAN_SVC}
+  clean_chain ${IPv4BIN} ${OUT_IVPN_LAN_SVC}
+  if [ -f /proc/net/if_inet6 ]; then
+    clean_chain ${IPv6BIN} ${IN_IVPN_LAN_SVC}
+    clean_chain ${IPv6BIN} ${OUT_IVPN_LAN_SVC}
+  fi
+
+  for SVC in $@; do
+    if [[ ${SVC} = "mdns" ]]; then
+      allow_lan_service ${IPv4BIN} udp 5353 ${LAN_NETS_IPv4},224.0.0.251
+      [ -f /proc/net/if_inet6 ] && allow_lan_service ${IPv6BIN} udp 5353 ${LAN_NETS_IPv6},ff02::fb
+    elif [[ ${SVC} = "ssdp" ]]; then
+      allow_lan_service ${IPv4BIN} udp 1900 ${LAN_NETS_IPv4},239.255.255.250
+      [ -f /proc/net/if_inet6 ] && allow_lan_service ${IPv6BIN} udp 1900 ${LAN_NETS_IPv6},ff02::c
+    elif [[ ${SVC} = "dhcp" ]]; then
+      # DHCP broadcasts (67out 68in) are allowed by default; here allowing unicast communication with DHCP servers in LAN
+      allow_lan_service ${IPv4BIN} udp 67 ${LAN_NETS_IPv4}
+      [ -f /proc/net/if_inet6 ] && allow_lan_service ${IPv6BIN} udp 547 ${LAN_NETS_IPv6},ff02::1:2
+    elif [[ ${SVC} = "printing" ]]; then
+      for PORT in 631 515 9100; do
+        allow_lan_service ${IPv4BIN} tcp ${PORT} ${LAN_NETS_IPv4}
+        [ -f /proc/net/if_inet6 ] && allow_lan_service ${IPv6BIN} tcp ${PORT} ${LAN_NETS_IPv6}
+      done
+    elif [[ ${SVC} = in:* ]]; then
+      PORT_DEF=${SVC#in:}
+      PORT=${PORT_DEF%/*}
+      PROTOCOL=${PORT_DEF#*/}
+      allow_lan_inbound_port ${IPv4BIN} ${PROTOCOL} ${PORT} ${LAN_NETS_IPv4}
+      [ -f /proc/net/if_inet6 ] && allow_lan_inbound_port ${IPv6BIN} ${PROTOCOL} ${PORT} ${LAN_NETS_IPv6}
+    else
+      echo "Unknown LAN service: ${SVC}" >&2
+    fi
+  done
+  return 0
+}
+
+function mss_clamp_enable {
+  BIN=$1
+  IFACE=$2
+  MSS=$3
+
+  chain_exists "${BIN} -t mangle" ${IN_IVPN_MSS} || ${BIN} -w ${LOCKWAITTIME} -t mangle -N ${IN_IVPN_MSS}
+  chain_exists "${BIN} -t mangle" ${OUT_IVPN_MSS} || ${BIN} -w ${LOCKWAITTIME} -t mangle -N ${OUT_IVPN_MSS}
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -C PREROUTING -j ${IN_IVPN_MSS} || ${BIN} -w ${LOCKWAITTIME} -t mangle -A PREROUTING -j ${IN_IVPN_MSS}
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -C POSTROUTING -j ${OUT_IVPN_MSS} || ${BIN} -w ${LOCKWAITTIME} -t mangle -A POSTROUTING -j ${OUT_IVPN_MSS}
+
+  # only one clamping rule per direction
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -F ${IN_IVPN_MSS}
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -F ${OUT_IVPN_MSS}
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -A ${IN_IVPN_MSS} -i ${IFACE} -p tcp --tcp-flags SYN,RST SYN -m tcpmss --mss $((MSS+1)):65535 -j TCPMSS --set-mss ${MSS}
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -A ${OUT_IVPN_MSS} -o ${IFACE} -p tcp --tcp-flags SYN,RST SYN -m tcpmss --mss $((MSS+1)):65535 -j TCPMSS --set-mss ${MSS}
+}
+
+function mss_clamp_disable {
+  BIN=$1
+
+  chain_exists "${BIN} -t mangle" ${OUT_IVPN_MSS} || return 0
+
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -D PREROUTING -j ${IN_IVPN_MSS}
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -D POSTROUTING -j ${OUT_IVPN_MSS}
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -F ${IN_IVPN_MSS}
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -F ${OUT_IVPN_MSS}
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -X ${IN_IVPN_MSS}
+  ${BIN} -w ${LOCKWAITTIME} -t mangle -X ${OUT_IVPN_MSS}
+}
+
 function add_exceptions {
   BIN=$1
   IN_CH=$2
@@ -457,13 +584,19 @@ function add_direction_exception {
   DST_PORT=$5
   PROTOCOL=$6
 
-  create_chain ${IPv4BIN} ${IN_CH}
-  create_chain ${IPv4BIN} ${OUT_CH}
+  BIN=${IPv4BIN}
+  if [[ ${DST_ADDR} == *:* ]]; then
+    # IPv6 server address (IPv6-only network)
+    BIN=${IPv6BIN}
+  fi
+
+  create_chain ${BIN} ${IN_CH}
+  create_chain ${BIN} ${OUT_CH}
 
   #add new rule
   # '-C' option is checking if the rule already exists (needed to avoid duplicates)
-  ${IPv4BIN} -w ${LOCKWAITTIME} -C ${IN_CH}  -s ${DST_ADDR} -p ${PROTOCOL} --sport ${DST_PORT} -j ACCEPT || ${IPv4BIN} -w ${LOCKWAITTIME} -A ${IN_CH}  -s ${DST_ADDR} -p ${PROTOCOL} --sport ${DST_PORT} -j ACCEPT
-  ${IPv4BIN} -w ${LOCKWAITTIME} -C ${OUT_CH} -d ${DST_ADDR} -p ${PROTOCOL} --dport ${DST_PORT} -j ACCEPT || ${IPv4BIN} -w ${LOCKWAITTIME} -A ${OUT_CH} -d ${DST_ADDR} -p ${PROTOCOL} --dport ${DST_PORT} -j ACCEPT
+  ${BIN} -w ${LOCKWAITTIME} -C ${IN_CH}  -s ${DST_ADDR} -p ${PROTOCOL} --sport ${DST_PORT} -j ACCEPT || ${BIN} -w ${LOCKWAITTIME} -A ${IN_CH}  -s ${DST_ADDR} -p ${PROTOCOL} --sport ${DST_PORT} -j ACCEPT
+  ${BIN} -w ${LOCKWAITTIME} -C ${OUT_CH} -d ${DST_ADDR} -p ${PROTOCOL} --dport ${DST_PORT} -j ACCEPT || ${BIN} -w ${LOCKWAITTIME} -A ${OUT_CH} -d ${DST_ADDR} -p ${PROTOCOL} --dport ${DST_PORT} -j ACCEPT
 }
 
 function remove_exceptions_icmp {
@@ -476,6 +609,28 @@ function remove_exceptions_icmp {
   ${IPv4BIN} -w ${LOCKWAITTIME} -D ${OUT_CH} -p icmp --icmp-type 8 -d $@ -m state --state NEW,ESTABLISHED,RELATED -j ACCEPT
 }
 
+function add_exceptions_icmp_ipv6 {
+  IN_CH=$1
+  OUT_CH=$2
+  shift 2
+
+  create_chain ${IPv6BIN} ${IN_CH}
+  create_chain ${IPv6BIN} ${OUT_CH}
+
+  # '-C' option is checking if the rule already exists (needed to avoid duplicates)
+  ${IPv6BIN} -w ${LOCKWAITTIME} -C ${IN_CH} -p icmpv6 --icmpv6-type echo-reply -s $@ -j ACCEPT || ${IPv6BIN} -w ${LOCKWAITTIME} -A ${IN_CH} -p icmpv6 --icmpv6-type echo-reply -s $@ -j ACCEPT
+  ${IPv6BIN} -w ${LOCKWAITTIME} -C ${OUT_CH} -p icmpv6 --icmpv6-type echo-request -d $@ -j ACCEPT || ${IPv6BIN} -w ${LOCKWAITTIME} -A ${OUT_CH} -p icmpv6 --icmpv6-type echo-request -d $@ -j ACCEPT
+}
+
+function remove_exceptions_icmp_ipv6 {
+  IN_CH=$1
+  OUT_CH=$2
+  shift 2
+
+  ${IPv6BIN} -w ${LOCKWAITTIME} -D ${IN_CH} -p icmpv6 --icmpv6-type echo-reply -s $@ -j ACCEPT
+  ${IPv6BIN} -w ${LOCKWAITTIME} -D ${OUT_CH} -p icmpv6 --icmpv6-type echo-request -d $@ -j ACCEPT
+}
+
 function add_exceptions_icmp {
   IN_CH=$1
   OUT_CH=$2
@@ -526,6 +681,34 @@ function main {
       shift
       remove_exceptions ${IPv4BIN} ${IN_IVPN_IF0} ${OUT_IVPN_IF0} $@
 
+    elif [[ $1 = "-add_exceptions_ipv6" ]]; then
+      get_firewall_enabled || return 0
+
+      if [ -f /proc/net/if_inet6 ]; then
+        shift
+        add_exceptions ${IPv6BIN} ${IN_IVPN_IF0} ${OUT_IVPN_IF0} $@
+      fi
+
+    elif [[ $1 = "-remove_exceptions_ipv6" ]]; then
+      if [ -f /proc/net/if_inet6 ]; then
+        shift
+        remove_exceptions ${IPv6BIN} ${IN_IVPN_IF0} ${OUT_IVPN_IF0} $@
+      fi
+
+    elif [[ $1 = "-add_exceptions_icmp_ipv6" ]]; then
+      get_firewall_enabled || return 0
+
+      if [ -f /proc/net/if_inet6 ]; then
+        shift
+        add_exceptions_icmp_ipv6 ${IN_IVPN_IF0} ${OUT_IVPN_IF0} $@
+      fi
+
+    elif [[ $1 = "-remove_exceptions_icmp_ipv6" ]]; then
+      if [ -f /proc/net/if_inet6 ]; then
+        shift
+        remove_exceptions_icmp_ipv6 ${IN_IVPN_IF0} ${OUT_IVPN_IF0} $@
+      fi
+
     elif [[ $1 = "-add_exceptions_static" ]]; then
 
       shift
@@ -603,6 +786,32 @@ function main {
 
         # allow communication with host only srcPort <=> host.dstsPort
         add_direction_exception ${IN_IVPN_IF0} ${OUT_IVPN_IF0} ${SRC_PORT} ${DST_ADDR} ${DST_PORT} ${PROTOCOL}
+    elif [[ $1 = "-set_lan_services" ]]; then
+
+        get_firewall_enabled || return 0
+
+        shift
+        set_lan_services $@
+
+    # TCP MSS clamping (does not depend on firewall state)
+    elif [[ $1 = "-mss_clamp_enable" ]]; then
+
+        IFACE=$2
+        MSS=$3
+        MSS_IPv6=$4
+
+        mss_clamp_enable ${IPv4BIN} ${IFACE} ${MSS}
+        if [ -f /proc/net/if_inet6 ]; then
+          mss_clamp_enable ${IPv6BIN} ${IFACE} ${MSS_IPv6}
+        fi
+
+    elif [[ $1 = "-mss_clamp_disable" ]]; then
+
+        mss_clamp_disable ${IPv4BIN}
+        if [ -f /proc/net/if_inet6 ]; then
+          mss_clamp_disable ${IPv6BIN}
+        fi
+
     elif [[ $1 = "-disconnected" ]]; then
         get_firewall_enabled || return 0
 
@@ -611,6 +820,10 @@ function main {
 
         clean_chain ${IPv4BIN} ${OUT_IVPN_IF0}
         clean_chain ${IPv4BIN} ${IN_IVPN_IF0}
+        if [ -f /proc/net/if_inet6 ]; then
+          clean_chain ${IPv6BIN} ${OUT_IVPN_IF0}
+          clean_chain ${IPv6BIN} ${IN_IVPN_IF0}
+        fi
     else
         echo "Unknown command"
         return 2
@@ -54,6 +54,20 @@ _packets_fwmark_value=0xca6c        # Anything from 1 to 2147483647
 # iptables rules comment
 _comment="IVPN Split Tunneling"
 
+# iptables chain for Split Tunneling destinations (IP addresses/networks)
+# (the chain with the same name is created in tables 'mangle', 'nat' and 'filter')
+_chain_dest=IVPN-ST-DEST
+# iptables chains for Split Tunneling containers
+# (the chain with the same name is created in tables 'mangle', 'nat' and 'filter')
+#   _chain_cont     - rules for locally generated packets (cgroup-v2 paths); in use from OUTPUT and POSTROUTING
+#   _chain_cont_fwd - rules for forwarded packets (IP addresses of containers); in use from PREROUTING and FORWARD
+#                     (the 'cgroup' match is not allowed in PREROUTING and FORWARD)
+_chain_cont=IVPN-ST-CONT
+_chain_cont_fwd=IVPN-ST-CONT-FWD
+# iptables chain for Split Tunneling users (rules based on UID of the packet owner)
+# (the chain with the same name is created in tables 'mangle', 'nat' and 'filter')
+_chain_user=IVPN-ST-USER
+
 # Paths to standard binaries
 _bin_iptables=iptables
 _bin_ip6tables=ip6tables
@@ -185,6 +199,12 @@ function init()
     ${_bin_iptables} -w ${_iptables_locktime} -I INPUT -m mark --mark ${_packets_fwmark_value} -m comment --comment  "${_comment}" -j ACCEPT
     # Restore packets mark for incoming packets
     ${_bin_iptables} -w ${_iptables_locktime} -t mangle -I PREROUTING -m comment --comment  "${_comment}" -j CONNMARK --restore-mark
+    # Rules for destinations (must be processed before all other rules)
+    initDestChains ${_bin_iptables}
+    # Rules for containers (must be processed before destinations rules)
+    initContChains ${_bin_iptables}
+    # Rules for users (must be processed before containers rules)
+    initUserChains ${_bin_iptables}
 
     if [ -f /proc/net/if_inet6 ]; then
         # Save packets mark (to be able to restore mark for incoming packets of the same connection)
@@ -202,6 +222,12 @@ function init()
         ${_bin_ip6tables} -w ${_iptables_locktime} -I INPUT -m mark --mark ${_packets_fwmark_value} -m comment --comment  "${_comment}" -j ACCEPT
         # Restore packets mark for incoming packets
         ${_bin_ip6tables} -w ${_iptables_locktime} -t mangle -I PREROUTING -m comment --comment  "${_comment}" -j CONNMARK --restore-mark
+        # Rules for destinations (must be processed before all other rules)
+        initDestChains ${_bin_ip6tables}
+        # Rules for containers (must be processed before destinations rules)
+        initContChains ${_bin_ip6tables}
+        # Rules for users (must be processed before containers rules)
+        initUserChains ${_bin_ip6tables}
     fi
 
     ##############################################
@@ -252,6 +278,303 @@ function init()
     echo "IVPN Split Tunneling enabled"
 }
 
+# Create chains for destinations rules
+# (parameter: iptables or ip6tables binary)
+function initDestChains()
+{
+    local _bin=$1
+    ${_bin} -w ${_iptables_locktime} -t mangle -N ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -t mangle -I OUTPUT -m comment --comment  "${_comment}" -j ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -t nat -N ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -t nat -I POSTROUTING -m comment --comment  "${_comment}" -j ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -N ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -I OUTPUT -m comment --comment  "${_comment}" -j ${_chain_dest}
+}
+
+# Remove chains for destinations rules
+# (parameter: iptables or ip6tables binary)
+function cleanDestChains()
+{
+    local _bin=$1
+    ${_bin} -w ${_iptables_locktime} -t mangle -D OUTPUT -m comment --comment "${_comment}" -j ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -t mangle -F ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -t mangle -X ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -t nat -D POSTROUTING -m comment --comment "${_comment}" -j ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -t nat -F ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -t nat -X ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -D OUTPUT -m comment --comment "${_comment}" -j ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -F ${_chain_dest}
+    ${_bin} -w ${_iptables_locktime} -X ${_chain_dest}
+}
+
+# Create chains for containers rules
+# (parameter: iptables or ip6tables binary)
+function initContChains()
+{
+    local _bin=$1
+    ${_bin} -w ${_iptables_locktime} -t mangle -N ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -t mangle -I OUTPUT -m comment --comment  "${_comment}" -j ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -t nat -N ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -t nat -I POSTROUTING -m comment --comment  "${_comment}" -j ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -N ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -I OUTPUT -m comment --comment  "${_comment}" -j ${_chain_cont}
+
+    ${_bin} -w ${_iptables_locktime} -t mangle -N ${_chain_cont_fwd}
+    # the rules must be processed after restoring packets mark, so the chain is appended
+    ${_bin} -w ${_iptables_locktime} -t mangle -A PREROUTING -m comment --comment  "${_comment}" -j ${_chain_cont_fwd}
+    ${_bin} -w ${_iptables_locktime} -N ${_chain_cont_fwd}
+    ${_bin} -w ${_iptables_locktime} -I FORWARD -m comment --comment  "${_comment}" -j ${_chain_cont_fwd}
+}
+
+# Remove chains for containers rules
+# (parameter: iptables or ip6tables binary)
+function cleanContChains()
+{
+    local _bin=$1
+    ${_bin} -w ${_iptables_locktime} -t mangle -D OUTPUT -m comment --comment "${_comment}" -j ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -t mangle -F ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -t mangle -X ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -t nat -D POSTROUTING -m comment --comment "${_comment}" -j ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -t nat -F ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -t nat -X ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -D OUTPUT -m comment --comment "${_comment}" -j ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -F ${_chain_cont}
+    ${_bin} -w ${_iptables_locktime} -X ${_chain_cont}
+
+    ${_bin} -w ${_iptables_locktime} -t mangle -D PREROUTING -m comment --comment "${_comment}" -j ${_chain_cont_fwd}
+    ${_bin} -w ${_iptables_locktime} -t mangle -F ${_chain_cont_fwd}
+    ${_bin} -w ${_iptables_locktime} -t mangle -X ${_chain_cont_fwd}
+    ${_bin} -w ${_iptables_locktime} -D FORWARD -m comment --comment "${_comment}" -j ${_chain_cont_fwd}
+    ${_bin} -w ${_iptables_locktime} -F ${_chain_cont_fwd}
+    ${_bin} -w ${_iptables_locktime} -X ${_chain_cont_fwd}
+}
+
+# Set containers rules (all previous containers rules are erased)
+#   -e <cgroup>  - traffic of processes from the cgroup (cgroup-v2 path) bypasses the VPN tunnel
+#   -i <cgroup>  - traffic of processes from the cgroup (cgroup-v2 path) goes through the VPN tunnel
+#                   (even to the excluded destinations)
+#   -E <address> - traffic of the container which has own network namespace (e.g. Docker bridge network)
+#                   bypasses the VPN tunnel (address - IP address of the container)
+function setContainers()
+{
+    local _exclude=()
+    local _include=()
+    local _excludeAddr=()
+    OPTIND=1
+    while getopts ":e:i:E:" opt; do
+        case $opt in
+            e) _exclude+=("$OPTARG")       ;;
+            i) _include+=("$OPTARG")       ;;
+            E) _excludeAddr+=("$OPTARG")   ;;
+        esac
+    done
+
+    # Check if split tunneling enabled
+    status > /dev/null 2>&1
+    if [ $? != 0 ]; then
+        echo "ERROR: split tunneling DISABLED. Please call 'start' command first" 1>&2
+        return 1
+    fi
+
+    local _bins=(${_bin_iptables})
+    if [ -f /proc/net/if_inet6 ]; then
+        _bins+=(${_bin_ip6tables})
+    fi
+    for _bin in "${_bins[@]}"; do
+        ${_bin} -w ${_iptables_locktime} -t mangle -F ${_chain_cont}
+        ${_bin} -w ${_iptables_locktime} -t nat -F ${_chain_cont}
+        ${_bin} -w ${_iptables_locktime} -F ${_chain_cont}
+        ${_bin} -w ${_iptables_locktime} -t mangle -F ${_chain_cont_fwd}
+        ${_bin} -w ${_iptables_locktime} -F ${_chain_cont_fwd}
+    done
+
+    local _ret=0
+    # 'include' rules have to be processed before 'exclude' rules
+    for _cgroup in "${_include[@]}"; do
+        for _bin in "${_bins[@]}"; do
+            ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_cont} -m cgroup --path ${_cgroup} -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+        done
+    done
+    for _cgroup in "${_exclude[@]}"; do
+        for _bin in "${_bins[@]}"; do
+            # Important! allow DNS request before setting mark rule (DNS request should not be marked)
+            ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_cont} -m cgroup --path ${_cgroup} -p tcp --dport 53 -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+            ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_cont} -m cgroup --path ${_cgroup} -p udp --dport 53 -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+            # Add mark on packets (the same as for packets coming from net_cls cgroup)
+            ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_cont} -m cgroup --path ${_cgroup} -m comment --comment  "${_comment}" -j MARK --set-mark ${_packets_fwmark_value} || _ret=1
+            # Force the packets to exit through default interface with NAT
+            ${_bin} -w ${_iptables_locktime} -t nat -A ${_chain_cont} -m cgroup --path ${_cgroup} -m mark --mark ${_packets_fwmark_value} -m comment --comment  "${_comment}" -j MASQUERADE || _ret=1
+            # Allow packets (bypass IVPN firewall)
+            ${_bin} -w ${_iptables_locktime} -A ${_chain_cont} -m cgroup --path ${_cgroup} -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+        done
+    done
+    # The traffic of containers in separate network namespace is forwarded by host (the cgroup match is not applicable),
+    # so the rules are based on the container IP address
+    for _addr in "${_excludeAddr[@]}"; do
+        local _bin=${_bin_iptables}
+        if [[ ${_addr} == *:* ]]; then _bin=${_bin_ip6tables}; fi
+
+        # Add mark on forwarded packets from the container (the routing decision is made after PREROUTING)
+        ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_cont_fwd} -s ${_addr} -m conntrack --ctdir ORIGINAL -m comment --comment  "${_comment}" -j MARK --set-mark ${_packets_fwmark_value} || _ret=1
+        # Remove the mark restored for incoming packets to the container (they have to be routed by main routing table)
+        ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_cont_fwd} -m conntrack --ctorigsrc ${_addr} --ctdir REPLY -m comment --comment  "${_comment}" -j MARK --set-mark 0 || _ret=1
+        # Force the packets to exit through default interface with NAT
+        ${_bin} -w ${_iptables_locktime} -t nat -A ${_chain_cont} -s ${_addr} -m mark --mark ${_packets_fwmark_value} -m comment --comment  "${_comment}" -j MASQUERADE || _ret=1
+        # Allow forwarding (bypass IVPN firewall)
+        ${_bin} -w ${_iptables_locktime} -A ${_chain_cont_fwd} -s ${_addr} -m mark --mark ${_packets_fwmark_value} -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+        ${_bin} -w ${_iptables_locktime} -A ${_chain_cont_fwd} -d ${_addr} -m conntrack --ctstate RELATED,ESTABLISHED -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+    done
+
+    echo "[+] Split Tunneling containers: excluded ${#_exclude[@]} (addresses ${#_excludeAddr[@]}); included ${#_include[@]}"
+    return ${_ret}
+}
+
+# Create chains for users rules
+# (parameter: iptables or ip6tables binary)
+function initUserChains()
+{
+    local _bin=$1
+    ${_bin} -w ${_iptables_locktime} -t mangle -N ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -t mangle -I OUTPUT -m comment --comment  "${_comment}" -j ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -t nat -N ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -t nat -I POSTROUTING -m comment --comment  "${_comment}" -j ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -N ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -I OUTPUT -m comment --comment  "${_comment}" -j ${_chain_user}
+}
+
+# Remove chains for users rules
+# (parameter: iptables or ip6tables binary)
+function cleanUserChains()
+{
+    local _bin=$1
+    ${_bin} -w ${_iptables_locktime} -t mangle -D OUTPUT -m comment --comment "${_comment}" -j ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -t mangle -F ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -t mangle -X ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -t nat -D POSTROUTING -m comment --comment "${_comment}" -j ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -t nat -F ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -t nat -X ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -D OUTPUT -m comment --comment "${_comment}" -j ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -F ${_chain_user}
+    ${_bin} -w ${_iptables_locktime} -X ${_chain_user}
+}
+
+# Set users rules (all previous users rules are erased)
+#   -e <uid> - traffic of processes running under the user bypasses the VPN tunnel
+#   -i <uid> - traffic of processes running under the user goes through the VPN tunnel
+#               (even for the processes from Split Tunneling environment or to the excluded destinations)
+function setUsers()
+{
+    local _exclude=()
+    local _include=()
+    OPTIND=1
+    while getopts ":e:i:" opt; do
+        case $opt in
+            e) _exclude+=("$OPTARG")   ;;
+            i) _include+=("$OPTARG")   ;;
+        esac
+    done
+
+    # Check if split tunneling enabled
+    status > /dev/null 2>&1
+    if [ $? != 0 ]; then
+        echo "ERROR: split tunneling DISABLED. Please call 'start' command first" 1>&2
+        return 1
+    fi
+
+    local _bins=(${_bin_iptables})
+    if [ -f /proc/net/if_inet6 ]; then
+        _bins+=(${_bin_ip6tables})
+    fi
+    for _bin in "${_bins[@]}"; do
+        ${_bin} -w ${_iptables_locktime} -t mangle -F ${_chain_user}
+        ${_bin} -w ${_iptables_locktime} -t nat -F ${_chain_user}
+        ${_bin} -w ${_iptables_locktime} -F ${_chain_user}
+    done
+
+    local _ret=0
+    # 'include' rules have to be processed before 'exclude' rules
+    for _uid in "${_include[@]}"; do
+        for _bin in "${_bins[@]}"; do
+            ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_user} -m owner --uid-owner ${_uid} -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+        done
+    done
+    for _uid in "${_exclude[@]}"; do
+        for _bin in "${_bins[@]}"; do
+            # Important! allow DNS request before setting mark rule (DNS request should not be marked)
+            ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_user} -m owner --uid-owner ${_uid} -p tcp --dport 53 -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+            ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_user} -m owner --uid-owner ${_uid} -p udp --dport 53 -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+            # Add mark on packets (the same as for packets coming from net_cls cgroup)
+            ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_user} -m owner --uid-owner ${_uid} -m comment --comment  "${_comment}" -j MARK --set-mark ${_packets_fwmark_value} || _ret=1
+            # Force the packets to exit through default interface with NAT
+            ${_bin} -w ${_iptables_locktime} -t nat -A ${_chain_user} -m owner --uid-owner ${_uid} -m mark --mark ${_packets_fwmark_value} -m comment --comment  "${_comment}" -j MASQUERADE || _ret=1
+            # Allow packets (bypass IVPN firewall)
+            ${_bin} -w ${_iptables_locktime} -A ${_chain_user} -m owner --uid-owner ${_uid} -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+        done
+    done
+
+    echo "[+] Split Tunneling users: excluded ${#_exclude[@]}; included ${#_include[@]}"
+    return ${_ret}
+}
+
+# Set destinations rules (all previous destinations rules are erased)
+#   -e <network> - traffic to the network bypasses the VPN tunnel
+#                   (packets are marked the same way as packets coming from cgroup)
+#   -i <network> - traffic from cgroup to the network goes through the VPN tunnel
+#                   (packets are not marked)
+function setDestinations()
+{
+    local _exclude=()
+    local _include=()
+    OPTIND=1
+    while getopts ":e:i:" opt; do
+        case $opt in
+            e) _exclude+=("$OPTARG")   ;;
+            i) _include+=("$OPTARG")   ;;
+        esac
+    done
+
+    # Check if split tunneling enabled
+    status > /dev/null 2>&1
+    if [ $? != 0 ]; then
+        echo "ERROR: split tunneling DISABLED. Please call 'start' command first" 1>&2
+        return 1
+    fi
+
+    local _bins=(${_bin_iptables})
+    if [ -f /proc/net/if_inet6 ]; then
+        _bins+=(${_bin_ip6tables})
+    fi
+    for _bin in "${_bins[@]}"; do
+        ${_bin} -w ${_iptables_locktime} -t mangle -F ${_chain_dest}
+        ${_bin} -w ${_iptables_locktime} -t nat -F ${_chain_dest}
+        ${_bin} -w ${_iptables_locktime} -F ${_chain_dest}
+    done
+
+    local _ret=0
+    local _bin
+    # 'include' rules have to be processed before 'exclude' rules
+    for _net in "${_include[@]}"; do
+        _bin=${_bin_iptables}
+        if [[ ${_net} == *:* ]]; then _bin=${_bin_ip6tables}; fi
+
+        ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_dest} -m cgroup --cgroup ${_cgroup_classid} -d ${_net} -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+    done
+    for _net in "${_exclude[@]}"; do
+        _bin=${_bin_iptables}
+        if [[ ${_net} == *:* ]]; then _bin=${_bin_ip6tables}; fi
+
+        # Add mark on packets (the same as for packets coming from cgroup)
+        ${_bin} -w ${_iptables_locktime} -t mangle -A ${_chain_dest} -d ${_net} -m comment --comment  "${_comment}" -j MARK --set-mark ${_packets_fwmark_value} || _ret=1
+        # Force the packets to exit through default interface with NAT
+        ${_bin} -w ${_iptables_locktime} -t nat -A ${_chain_dest} -d ${_net} -m mark --mark ${_packets_fwmark_value} -m comment --comment  "${_comment}" -j MASQUERADE || _ret=1
+        # Allow packets (bypass IVPN firewall)
+        ${_bin} -w ${_iptables_locktime} -A ${_chain_dest} -d ${_net} -m comment --comment  "${_comment}" -j ACCEPT || _ret=1
+    done
+
+    echo "[+] Split Tunneling destinations: excluded ${#_exclude[@]}; included ${#_include[@]}"
+    return ${_ret}
+}
+
 function updateRoutes() 
 { 
     # simple check if ST enabled
@@ -316,6 +639,9 @@ function clean()
     if [ ! -z ${_def_interface_name} ]; then
         ${_bin_iptables} -w ${_iptables_locktime} -t nat -D POSTROUTING -m cgroup --cgroup ${_cgroup_classid} -o ${_def_interface_name} -m comment --comment "${_comment}" -j MASQUERADE
     fi
+    cleanDestChains ${_bin_iptables}
+    cleanContChains ${_bin_iptables}
+    cleanUserChains ${_bin_iptables}
 
     if [ -f /proc/net/if_inet6 ]; then
         ${_bin_ip6tables} -w ${_iptables_locktime} -t mangle -D PREROUTING -m comment --comment "${_comment}" -j CONNMARK --restore-mark
@@ -329,6 +655,9 @@ function clean()
         if [ ! -z ${_def_interface_name} ]; then
             ${_bin_ip6tables} -w ${_iptables_locktime} -t nat -D POSTROUTING -m cgroup --cgroup ${_cgroup_classid} -o ${_def_interface_name} -m comment --comment "${_comment}" -j MASQUERADE
         fi
+        cleanDestChains ${_bin_ip6tables}
+        cleanContChains ${_bin_ip6tables}
+        cleanUserChains ${_bin_ip6tables}
     fi
 
     ##############################################
@@ -519,6 +848,21 @@ function info()
     ${_bin_iptables} -t nat -S
     echo 
 
+    echo "[*] iptables -S ${_chain_dest}:"
+    ${_bin_iptables} -S ${_chain_dest}
+    echo 
+
+    echo "[*] iptables -S ${_chain_cont}:"
+    ${_bin_iptables} -S ${_chain_cont}
+    echo 
+    echo "[*] iptables -S ${_chain_cont_fwd}:"
+    ${_bin_iptables} -S ${_chain_cont_fwd}
+    echo 
+
+    echo "[*] iptables -S ${_chain_user}:"
+    ${_bin_iptables} -S ${_chain_user}
+    echo 
+
     echo "[*] ip -6 rule:"
     ${_bin_ip} -6 rule
     echo 
@@ -579,6 +923,18 @@ elif [[ $1 = "run" ]] ; then
     _command=$@
     execute "${_user}" "${_command}"     
 
+elif [[ $1 = "dest-set" ]] ; then
+    shift
+    setDestinations "$@"
+
+elif [[ $1 = "cont-set" ]] ; then
+    shift
+    setContainers "$@"
+
+elif [[ $1 = "user-set" ]] ; then
+    shift
+    setUsers "$@"
+
 elif [[ $1 = "update-routes" ]] ; then
     # Linux is erasing ST routing rules when disable/enable default network interface, so we need to restore them back
     shift 
@@ -632,6 +988,23 @@ else
     echo "        - PID             - process ID"
     echo "    reset"
     echo "        Remove all processes from Split Tunneling environment"
+    echo "    dest-set [-e <network>]... [-i <network>]..."
+    echo "        Set destinations rules (previous destinations rules are erased)"
+    echo "        - network         - IP address or network in CIDR notation"
+    echo "        -e                - the traffic to the network bypasses the VPN tunnel"
+    echo "        -i                - the traffic from Split Tunneling environment to the network goes through the VPN tunnel"
+    echo "    cont-set [-e <cgroup>]... [-i <cgroup>]... [-E <address>]..."
+    echo "        Set containers rules (previous containers rules are erased)"
+    echo "        - cgroup          - cgroup-v2 path of the container (e.g. 'system.slice/docker-<ID>.scope')"
+    echo "        - address         - IP address of the container which has own network namespace"
+    echo "        -e                - the traffic of the cgroup bypasses the VPN tunnel"
+    echo "        -i                - the traffic of the cgroup goes through the VPN tunnel"
+    echo "        -E                - the traffic of the container (forwarded by host) bypasses the VPN tunnel"
+    echo "    user-set [-e <uid>]... [-i <uid>]..."
+    echo "        Set users rules (previous users rules are erased)"
+    echo "        - uid             - user ID (UID)"
+    echo "        -e                - the traffic of the user's processes bypasses the VPN tunnel"
+    echo "        -i                - the traffic of the user's processes goes through the VPN tunnel"
     echo "    status"
     echo "        Check split-tunneling status"
     echo "Examples:"
@@ -45,6 +45,18 @@ else
   echo "wireguard-tools already compiled. Skipping build."
 fi
 
+# check if we need to compile amneziawg-tools
+if [[ ! -f "../_deps/amneziawg-tools_inst/awg-quick" ]] || [[ ! -f "../_deps/amneziawg-tools_inst/awg" ]] || [[ ! -f "../_deps/amneziawg-tools_inst/amneziawg-go" ]]
+then
+  echo "======================================================"
+  echo "========== Compiling amneziawg-tools ================="
+  echo "======================================================"
+  cd $SCRIPT_DIR
+  ./build-amneziawg-tools.sh
+else
+  echo "amneziawg-tools already compiled. Skipping build."
+fi
+
 # check if we need to compile dnscrypt-proxy
 if [[ ! -f "../_deps/dnscryptproxy_inst/dnscrypt-proxy" ]] 
 then
@@ -57,6 +69,42 @@ else
   echo "dnscrypt-proxy already compiled. Skipping build."
 fi
 
+# check if we need to compile v2ray
+if [[ ! -f "../_deps/v2ray_inst/v2ray" ]]
+then
+  echo "======================================================"
+  echo "========== Compiling v2ray ==========================="
+  echo "======================================================"
+  cd $SCRIPT_DIR
+  ./build-v2ray.sh
+else
+  echo "v2ray already compiled. Skipping build."
+fi
+
+# check if we need to compile shadowsocks (sslocal)
+if [[ ! -f "../_deps/shadowsocks_inst/sslocal" ]]
+then
+  echo "======================================================"
+  echo "========== Compiling shadowsocks ====================="
+  echo "======================================================"
+  cd $SCRIPT_DIR
+  ./build-shadowsocks.sh
+else
+  echo "shadowsocks already compiled. Skipping build."
+fi
+
+# check if we need to compile kem-helper
+if [[ ! -f "../_deps/kem-helper_inst/kem-helper" ]]
+then
+  echo "======================================================"
+  echo "========== Compiling kem-helper ======================"
+  echo "======================================================"
+  cd $SCRIPT_DIR
+  ./build-kem-helper.sh
+else
+  echo "kem-helper already compiled. Skipping build."
+fi
+
 echo "======================================================"
 echo "============ Compiling IVPN service =================="
 echo "======================================================"
@@ -0,0 +1,50 @@
+#!/bin/sh
+
+AWG_TOOLS_VER=v1.0.20241018 # https://github.com/amnezia-vpn/amneziawg-tools
+AWG_GO_VER=v0.2.12          # https://github.com/amnezia-vpn/amneziawg-go (userspace implementation: in use when the AmneziaWG kernel module is not available)
+
+# Exit immediately if a command exits with a non-zero status.
+set -e
+
+cd "$(dirname "$0")"
+BASE_DIR="$(pwd)" #set base folder of script location
+
+BUILD_DIR=${BASE_DIR}/../_deps/amneziawg-tools_build # work directory
+INSTALL_DIR=${BASE_DIR}/../_deps/amneziawg-tools_inst
+
+echo "******** Creating work-folder (${BUILD_DIR})..."
+rm -rf ${BUILD_DIR}
+rm -rf ${INSTALL_DIR}
+mkdir -pv ${BUILD_DIR}
+mkdir -pv ${INSTALL_DIR}
+
+echo "******** Cloning amneziawg-tools sources..."
+cd ${BUILD_DIR}
+git clone https://github.com/amnezia-vpn/amneziawg-tools.git
+cd amneziawg-tools
+
+echo "******** Checkout amneziawg-tools version (${AWG_TOOLS_VER})..."
+git checkout ${AWG_TOOLS_VER}
+cd src
+
+echo "******** Compiling 'amneziawg-tools'..."
+make
+
+echo "******** Cloning amneziawg-go sources..."
+cd ${BUILD_DIR}
+git clone https://github.com/amnezia-vpn/amneziawg-go.git
+cd amneziawg-go
+
+echo "******** Checkout amneziawg-go version (${AWG_GO_VER})..."
+git checkout ${AWG_GO_VER}
+
+echo "******** Compiling 'amneziawg-go'..."
+go build -o ${INSTALL_DIR}/amneziawg-go -trimpath -ldflags "-s -w"
+
+echo "******** Copying 'amneziawg-tools' binaries..."
+cp ${BUILD_DIR}/amneziawg-tools/src/wg ${INSTALL_DIR}/awg
+cp ${BUILD_DIR}/amneziawg-tools/src/wg-quick/linux.bash ${INSTALL_DIR}/awg-quick
+
+echo "********************************"
+echo "******** BUILD COMPLETE ********"
+echo "********************************"
@@ -38,12 +38,16 @@ IF %ERRORLEVEL% NEQ 0 (
 
 if "%GITHUB_ACTIONS%" == "true" (
 	  echo "! GITHUB_ACTIONS detected ! It is just a build test."
-	  echo "! Skipped compilation of Native projects and third-party dependencies: WireGuard, obfs4proxy, dnscrypt_proxy !"
+	  echo "! Skipped compilation of Native projects and third-party dependencies: WireGuard, AmneziaWG, obfs4proxy, dnscrypt_proxy, v2ray, shadowsocks, kem-helper !"
 ) else (
 	call :build_native_libs || goto :error
 	call :build_obfs4proxy || goto :error
 	call :build_wireguard || goto :error
+	call :build_amneziawg || goto :error
 	call :build_dnscrypt_proxy || goto :error
+	call :build_v2ray || goto :error
+	call :build_shadowsocks || goto :error
+	call :build_kem_helper || goto :error
 )
 
 call :update_servers_info || goto :error
@@ -140,6 +144,72 @@ goto :success
 
 	goto :eof
 
+:build_v2ray
+	if exist "%SCRIPTDIR%..\v2ray\v2ray.exe" (
+		echo [ ] v2ray binaries already available. Compilation skipped.
+		goto :eof
+	)
+
+	echo ### v2ray binary not found ###
+	echo ### Buildind v2ray         ###
+	call "%SCRIPTDIR%\build-v2ray.bat" || goto error
+
+	if NOT "%CERT_SHA1%" == "" (
+		echo.
+		echo Signing 'v2ray.exe' binary [certificate:  %CERT_SHA1% timestamp: %TIMESTAMP_SERVER%]
+		echo.
+		signtool.exe sign /tr %TIMESTAMP_SERVER% /td sha256 /fd sha256 /sha1 %CERT_SHA1% /v "%SCRIPTDIR%..\v2ray\v2ray.exe" || goto :eof
+		echo.
+		echo Signing SUCCES
+		echo.
+	)
+
+	goto :eof
+
+:build_shadowsocks
+	if exist "%SCRIPTDIR%..\shadowsocks\sslocal.exe" (
+		echo [ ] shadowsocks binaries already available. Compilation skipped.
+		goto :eof
+	)
+
+	echo ### shadowsocks binary not found ###
+	echo ### Buildind shadowsocks         ###
+	call "%SCRIPTDIR%\build-shadowsocks.bat" || goto error
+
+	if NOT "%CERT_SHA1%" == "" (
+		echo.
+		echo Signing 'sslocal.exe' binary [certificate:  %CERT_SHA1% timestamp: %TIMESTAMP_SERVER%]
+		echo.
+		signtool.exe sign /tr %TIMESTAMP_SERVER% /td sha256 /fd sha256 /sha1 %CERT_SHA1% /v "%SCRIPTDIR%..\shadowsocks\sslocal.exe" || goto :eof
+		echo.
+		echo Signing SUCCES
+		echo.
+	)
+
+	goto :eof
+
+:build_kem_helper
+	if exist "%SCRIPTDIR%..\kem\kem-helper.exe" (
+		echo [ ] kem-helper binaries already available. Compilation skipped.
+		goto :eof
+	)
+
+	echo ### kem-helper binary not found ###
+	echo ### Buildind kem-helper         ###
+	call "%SCRIPTDIR%\build-kem-helper.bat" || goto error
+
+	if NOT "%CERT_SHA1%" == "" (
+		echo.
+		echo Signing 'kem-helper.exe' binary [certificate:  %CERT_SHA1% timestamp: %TIMESTAMP_SERVER%]
+		echo.
+		signtool.exe sign /tr %TIMESTAMP_SERVER% /td sha256 /fd sha256 /sha1 %CERT_SHA1% /v "%SCRIPTDIR%..\kem\kem-helper.exe" || goto :eof
+		echo.
+		echo Signing SUCCES
+		echo.
+	)
+
+	goto :eof
+
 :build_wireguard
 	if exist "%SCRIPTDIR%..\WireGuard\x86_64\wg.exe" (
  		if exist "%SCRIPTDIR%..\WireGuard\x86_64\wireguard.exe" (
@@ -164,6 +234,30 @@ goto :success
 
 	goto :eof
 
+:build_amneziawg
+	if exist "%SCRIPTDIR%..\AmneziaWG\x86_64\awg.exe" (
+ 		if exist "%SCRIPTDIR%..\AmneziaWG\x86_64\amneziawg.exe" (
+			echo [ ] AmneziaWG binaries already available. Compilation skipped.
+			goto :eof
+		)
+	)
+
+	echo ### AmneziaWG binaries not found ###
+	call "%SCRIPTDIR%\build-amneziawg.bat" || goto error
+
+	if NOT "%CERT_SHA1%" == "" (
+		echo.
+		echo Signing binaries ['awg.exe', 'amneziawg.exe'] [certificate:  %CERT_SHA1% timestamp: %TIMESTAMP_SERVER%]
+		echo.
+		signtool.exe sign /tr %TIMESTAMP_SERVER% /td sha256 /fd sha256 /sha1 %CERT_SHA1% /v "%SCRIPTDIR%..\AmneziaWG\x86_64\awg.exe" || goto :eof
+		signtool.exe sign /tr %TIMESTAMP_SERVER% /td sha256 /fd sha256 /sha1 %CERT_SHA1% /v "%SCRIPTDIR%..\AmneziaWG\x86_64\amneziawg.exe" || goto :eof
+		echo.
+		echo Signing SUCCES
+		echo.
+	)
+
+	goto :eof
+
 :success
 	echo [*] Success.
 	go version
@@ -0,0 +1,58 @@
+@ECHO OFF
+
+setlocal
+set SCRIPTDIR=%~dp0
+rem https://github.com/amnezia-vpn/amneziawg-windows
+set AWGVER=v0.1.4
+
+echo ### Buildind AmneziaWG binaries  ###
+
+if exist "%SCRIPTDIR%..\AmneziaWG\x86_64" (
+  echo [*] Erasing AmneziaWG\x86_64\* ...
+  del /f /q /s "%SCRIPTDIR%..\AmneziaWG\x86_64\*" >nul 2>&1 || exit /b 1
+)
+
+if not exist "%SCRIPTDIR%..\.deps\amneziawg-windows\.deps\prepared" (
+  if not exist "%SCRIPTDIR%..\.deps" (
+    echo [*] Creating .deps ...
+    mkdir "%SCRIPTDIR%..\.deps" || exit /b 1
+    cd "%SCRIPTDIR%..\.deps" 	|| exit /b 1
+  )
+
+  if exist "%SCRIPTDIR%..\.deps\amneziawg-windows" (
+    echo [*] Erasing .deps ...
+    rd /s /q "%SCRIPTDIR%..\.deps\amneziawg-windows" || exit /b 1
+    sleep 2
+  )
+
+  cd "%SCRIPTDIR%..\.deps"
+
+  echo [*] Cloning amneziawg-windows...
+  git clone https://github.com/amnezia-vpn/amneziawg-windows.git || exit /b 1
+  cd amneziawg-windows || exit /b 1
+
+  echo [*] Checking out amneziawg-windows version [%AWGVER%]...
+  git checkout %AWGVER% >nul 2>&1 || exit /b 1
+    echo [*] Building amneziawg-windows from NEW sources...
+) else (
+  echo [*] Building amneziawg-windows from ALREADY DOWNLOADED sources...
+  cd "%SCRIPTDIR%..\.deps\amneziawg-windows" 	|| exit /b 1
+)
+
+call build.bat
+if not %errorlevel%==0 (
+    echo [!] ERROR: Building AmneziaWG from official sources
+    echo [ ]        You can skip building AmneziaWG binaries.
+    echo [ ]        To skip build, copy correspond precompiled official AmneziaWG binaries to locations:
+    echo [ ]        	%SCRIPTDIR%..\AmneziaWG\x86_64\awg.exe
+    echo [ ]        	%SCRIPTDIR%..\AmneziaWG\x86_64\amneziawg.exe
+    exit /b 1
+)
+
+echo [*] AmneziaWG build DONE. Copying compiled binaries ...
+
+if not exist "%SCRIPTDIR%..\AmneziaWG" 			mkdir "%SCRIPTDIR%..\AmneziaWG" 		|| exit /b 1
+if not exist "%SCRIPTDIR%..\AmneziaWG\x86_64" 	mkdir "%SCRIPTDIR%..\AmneziaWG\x86_64"	|| exit /b 1
+
+copy /y "%SCRIPTDIR%..\.deps\amneziawg-windows\amd64\awg.exe" 		"%SCRIPTDIR%..\AmneziaWG\x86_64\awg.exe" 		>nul 2>&1 || exit /b 1
+copy /y "%SCRIPTDIR%..\.deps\amneziawg-windows\amd64\amneziawg.exe" "%SCRIPTDIR%..\AmneziaWG\x86_64\amneziawg.exe" 	>nul 2>&1 || exit /b 1
@@ -0,0 +1,46 @@
+@ECHO OFF
+
+setlocal
+
+rem TODO: define here liboqs version to build (requires: cmake; run from Developer Command Prompt for Visual Studio)
+set _VERSION=0.10.1
+
+set SCRIPTDIR=%~dp0
+
+if exist "%SCRIPTDIR%..\kem" (
+  echo [*] Erasing kem\*.exe ...
+  del /f /q /s "%SCRIPTDIR%..\kem\*.exe"  >nul 2>&1 || exit /b 1
+) else (
+  mkdir "%SCRIPTDIR%..\kem" || exit /b 1
+)
+
+if exist "%SCRIPTDIR%..\.deps\kem-helper" (
+  echo [*] Erasing '"%SCRIPTDIR%..\.deps\kem-helper' ...
+  rmdir /s /q "%SCRIPTDIR%..\.deps\kem-helper" || exit /b 1
+)
+
+echo [*] Creating .deps\kem-helper ...
+mkdir "%SCRIPTDIR%..\.deps\kem-helper" || exit /b 1
+
+echo [*] Cloning liboqs sources...
+cd "%SCRIPTDIR%..\.deps\kem-helper"
+git clone https://github.com/open-quantum-safe/liboqs.git || exit /b 1
+cd liboqs
+
+echo [*] Checkout version '%_VERSION%' of 'liboqs'..."
+git checkout tags/%_VERSION%
+
+echo [*] Compiling liboqs ...
+mkdir build
+cd build
+cmake -G "NMake Makefiles" -DCMAKE_BUILD_TYPE=Release -DBUILD_SHARED_LIBS=OFF -DOQS_BUILD_ONLY_LIB=ON -DOQS_USE_OPENSSL=OFF -DOQS_MINIMAL_BUILD="KEM_kyber_1024" -DCMAKE_INSTALL_PREFIX="%SCRIPTDIR%..\.deps\kem-helper\liboqs_inst" .. || exit /b 1
+nmake || exit /b 1
+nmake install || exit /b 1
+
+echo [*] Compiling kem-helper ...
+cd "%SCRIPTDIR%..\.deps\kem-helper"
+cl /nologo /O2 /MD "%SCRIPTDIR%..\..\common\kem-helper\kem-helper.c" /I "%SCRIPTDIR%..\.deps\kem-helper\liboqs_inst\include" /Fe"%SCRIPTDIR%..\kem\kem-helper.exe" /link "%SCRIPTDIR%..\.deps\kem-helper\liboqs_inst\lib\oqs.lib" advapi32.lib bcrypt.lib || exit /b 1
+
+echo [ ] SUCCESS
+echo [ ] The compiled 'kem-helper.exe' binary located at:
+echo [ ] "%SCRIPTDIR%..\kem\kem-helper.exe"
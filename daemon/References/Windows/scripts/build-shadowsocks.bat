@@ -0,0 +1,40 @@
+@ECHO OFF
+
+setlocal
+
+rem TODO: define here shadowsocks-rust version to build (requires Rust toolchain: 'cargo')
+set _VERSION=v1.20.4
+
+set SCRIPTDIR=%~dp0
+
+if exist "%SCRIPTDIR%..\shadowsocks" (
+  echo [*] Erasing shadowsocks\*.exe ...
+  del /f /q /s "%SCRIPTDIR%..\shadowsocks\*.exe"  >nul 2>&1 || exit /b 1
+) else (
+  mkdir "%SCRIPTDIR%..\shadowsocks" || exit /b 1
+)
+
+if exist "%SCRIPTDIR%..\.deps\shadowsocks" (
+  echo [*] Erasing '"%SCRIPTDIR%..\.deps\shadowsocks' ...
+  rmdir /s /q "%SCRIPTDIR%..\.deps\shadowsocks" || exit /b 1
+)
+
+echo [*] Creating .deps\shadowsocks ...
+mkdir "%SCRIPTDIR%..\.deps\shadowsocks" || exit /b 1
+
+echo [*] Cloning shadowsocks-rust sources...
+cd "%SCRIPTDIR%..\.deps\shadowsocks"
+git clone https://github.com/shadowsocks/shadowsocks-rust.git || exit /b 1
+cd shadowsocks-rust
+
+echo [*] Checkout version '%_VERSION%' of 'shadowsocks-rust'..."
+git checkout tags/%_VERSION%
+
+echo [*] Compiling sslocal ...
+
+cargo build --release --bin sslocal || exit /b 1
+copy /y "target\release\sslocal.exe" "%SCRIPTDIR%..\shadowsocks\sslocal.exe" >nul 2>&1 || exit /b 1
+
+echo [ ] SUCCESS
+echo [ ] The compiled 'sslocal.exe' binary located at:
+echo [ ] "%SCRIPTDIR%..\shadowsocks\sslocal.exe"
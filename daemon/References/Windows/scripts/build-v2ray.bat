@@ -0,0 +1,40 @@
+@ECHO OFF
+
+setlocal
+
+rem TODO: define here v2ray version to build
+set _VERSION=v5.16.1
+
+set SCRIPTDIR=%~dp0
+
+if exist "%SCRIPTDIR%..\v2ray" (
+  echo [*] Erasing v2ray\*.exe ...
+  del /f /q /s "%SCRIPTDIR%..\v2ray\*.exe"  >nul 2>&1 || exit /b 1
+) else (
+  mkdir "%SCRIPTDIR%..\v2ray" || exit /b 1
+)
+
+if exist "%SCRIPTDIR%..\.deps\v2ray" (
+  echo [*] Erasing '"%SCRIPTDIR%..\.deps\v2ray' ...
+  rmdir /s /q "%SCRIPTDIR%..\.deps\v2ray" || exit /b 1
+)
+
+echo [*] Creating .deps\v2ray ...
+mkdir "%SCRIPTDIR%..\.deps\v2ray" || exit /b 1
+
+echo [*] Cloning v2ray-core sources...
+cd "%SCRIPTDIR%..\.deps\v2ray"
+git clone https://github.com/v2fly/v2ray-core.git || exit /b 1
+cd v2ray-core
+
+echo [*] Checkout version '%_VERSION%' of 'v2ray-core'..."
+git checkout tags/%_VERSION%
+
+echo [*] Compiling v2ray ...
+
+set CGO_ENABLED=0
+go build -o "%SCRIPTDIR%..\v2ray\v2ray.exe" -trimpath -ldflags "-s -w -buildid=" ./main >nul 2>&1 || exit /b 1
+
+echo [ ] SUCCESS
+echo [ ] The compiled 'v2ray.exe' binary located at:
+echo [ ] "%SCRIPTDIR%..\v2ray\v2ray.exe"
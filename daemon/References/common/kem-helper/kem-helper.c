@@ -0,0 +1,242 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// kem-helper - post-quantum key encapsulation (KEM) operations for the IVPN daemon (based on liboqs).
+// The daemon calls it to negotiate the WireGuard preshared key (see 'daemon/kem' package).
+//
+// Usage:
+//   kem-helper generate <algorithm>
+//     output:
+//       public:<base64>
+//       private:<base64>
+//   kem-helper decapsulate <algorithm>
+//     input (stdin; the private key is not passed as argument: the command line of the process is visible for all users):
+//       <private key base64>
+//       <cipher base64>
+//     output:
+//       secret:<base64>
+//
+// Example of the algorithm name: "Kyber1024" (the liboqs algorithm name).
+
+#include <stdio.h>
+#include <stdlib.h>
+#include <string.h>
+
+#include <oqs/oqs.h>
+
+#define MAX_INPUT_LINE_LEN (1024 * 1024)
+
+static const char b64chars[] = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/";
+
+// base64Encode returns the base64 string (must be released by free())
+static char *base64Encode(const uint8_t *data, size_t len)
+{
+    char *out = malloc(((len + 2) / 3) * 4 + 1);
+    if (out == NULL)
+        return NULL;
+
+    char *p = out;
+    for (size_t i = 0; i < len; i += 3)
+    {
+        uint32_t v = (uint32_t)data[i] << 16;
+        if (i + 1 < len)
+            v |= (uint32_t)data[i + 1] << 8;
+        if (i + 2 < len)
+            v |= (uint32_t)data[i + 2];
+
+        *p++ = b64chars[(v >> 18) & 0x3F];
+        *p++ = b64chars[(v >> 12) & 0x3F];
+        *p++ = (i + 1 < len) ? b64chars[(v >> 6) & 0x3F] : '=';
+        *p++ = (i + 2 < len) ? b64chars[v & 0x3F] : '=';
+    }
+    *p = 0;
+    return out;
+}
+
+static int base64Value(char c)
+{
+    const char *p = strchr(b64chars, c);
+    if (c == 0 || p == NULL)
+        return -1;
+    return (int)(p - b64chars);
+}
+
+// base64Decode decodes the base64 string into 'out' buffer of size 'expectedLen'.
+// Returns 0 on success (the decoded data size must be equal to 'expectedLen').
+static int base64Decode(const char *in, uint8_t *out, size_t expectedLen)
+{
+    size_t inLen = strlen(in);
+    if (inLen % 4 != 0)
+        return -1;
+
+    size_t outLen = 0;
+    for (size_t i = 0; i < inLen; i += 4)
+    {
+        int v[4];
+        int padding = 0;
+        for (int j = 0; j < 4; j++)
+        {
+            if (in[i + j] == '=' && i + 4 == inLen && j >= 2)
+            {
+                v[j] = 0;
+                padding++;
+                continue;
+            }
+            if (padding > 0 || (v[j] = base64Value(in[i + j])) < 0)
+                return -1;
+        }
+
+        uint32_t triple = ((uint32_t)v[0] << 18) | ((uint32_t)v[1] << 12) | ((uint32_t)v[2] << 6) | (uint32_t)v[3];
+        for (int j = 0; j < 3 - padding; j++)
+        {
+            if (outLen >= expectedLen)
+                return -1;
+            out[outLen++] = (uint8_t)(triple >> (16 - 8 * j));
+        }
+    }
+    return outLen == expectedLen ? 0 : -1;
+}
+
+// readLine reads the line from stdin (the trailing new-line characters are removed)
+static int readLine(char *buf, size_t bufSize)
+{
+    if (fgets(buf, (int)bufSize, stdin) == NULL)
+        return -1;
+    buf[strcspn(buf, "\r\n")] = 0;
+    return 0;
+}
+
+static int generate(OQS_KEM *kem)
+{
+    int ret = EXIT_FAILURE;
+    uint8_t *pub = malloc(kem->length_public_key);
+    uint8_t *priv = malloc(kem->length_secret_key);
+    char *pubB64 = NULL;
+    char *privB64 = NULL;
+
+    if (pub == NULL || priv == NULL)
+        goto cleanup;
+
+    if (OQS_KEM_keypair(kem, pub, priv) != OQS_SUCCESS)
+    {
+        fprintf(stderr, "failed to generate keys\n");
+        goto cleanup;
+    }
+
+    pubB64 = base64Encode(pub, kem->length_public_key);
+    privB64 = base64Encode(priv, kem->length_secret_key);
+    if (pubB64 == NULL || privB64 == NULL)
+        goto cleanup;
+
+    printf("public:%s\n", pubB64);
+    printf("private:%s\n", privB64);
+    ret = EXIT_SUCCESS;
+
+cleanup:
+    if (priv != NULL)
+        OQS_MEM_secure_free(priv, kem->length_secret_key);
+    if (privB64 != NULL)
+        OQS_MEM_secure_free(privB64, strlen(privB64));
+    free(pub);
+    free(pubB64);
+    return ret;
+}
+
+static int decapsulate(OQS_KEM *kem)
+{
+    int ret = EXIT_FAILURE;
+    char *line = malloc(MAX_INPUT_LINE_LEN);
+    uint8_t *priv = malloc(kem->length_secret_key);
+    uint8_t *cipher = malloc(kem->length_ciphertext);
+    uint8_t *secret = malloc(kem->length_shared_secret);
+    char *secretB64 = NULL;
+
+    if (line == NULL || priv == NULL || cipher == NULL || secret == NULL)
+        goto cleanup;
+
+    if (readLine(line, MAX_INPUT_LINE_LEN) != 0 || base64Decode(line, priv, kem->length_secret_key) != 0)
+    {
+        fprintf(stderr, "bad private key\n");
+        goto cleanup;
+    }
+    if (readLine(line, MAX_INPUT_LINE_LEN) != 0 || base64Decode(line, cipher, kem->length_ciphertext) != 0)
+    {
+        fprintf(stderr, "bad cipher\n");
+        goto cleanup;
+    }
+
+    if (OQS_KEM_decaps(kem, secret, cipher, priv) != OQS_SUCCESS)
+    {
+        fprintf(stderr, "failed to decapsulate secret\n");
+        goto cleanup;
+    }
+
+    secretB64 = base64Encode(secret, kem->length_shared_secret);
+    if (secretB64 == NULL)
+        goto cleanup;
+
+    printf("secret:%s\n", secretB64);
+    ret = EXIT_SUCCESS;
+
+cleanup:
+    if (line != NULL)
+        OQS_MEM_secure_free(line, MAX_INPUT_LINE_LEN);
+    if (priv != NULL)
+        OQS_MEM_secure_free(priv, kem->length_secret_key);
+    if (secret != NULL)
+        OQS_MEM_secure_free(secret, kem->length_shared_secret);
+    if (secretB64 != NULL)
+        OQS_MEM_secure_free(secretB64, strlen(secretB64));
+    free(cipher);
+    return ret;
+}
+
+int main(int argc, char **argv)
+{
+    if (argc != 3)
+    {
+        fprintf(stderr, "usage: %s <generate|decapsulate> <algorithm>\n", argv[0]);
+        return EXIT_FAILURE;
+    }
+
+    OQS_init();
+
+    OQS_KEM *kem = OQS_KEM_new(argv[2]);
+    if (kem == NULL)
+    {
+        fprintf(stderr, "algorithm '%s' is not supported\n", argv[2]);
+        OQS_destroy();
+        return EXIT_FAILURE;
+    }
+
+    int ret = EXIT_FAILURE;
+    if (strcmp(argv[1], "generate") == 0)
+        ret = generate(kem);
+    else if (strcmp(argv[1], "decapsulate") == 0)
+        ret = decapsulate(kem);
+    else
+        fprintf(stderr, "unknown command '%s'\n", argv[1]);
+
+    OQS_KEM_free(kem);
+    OQS_destroy();
+    return ret;
+}
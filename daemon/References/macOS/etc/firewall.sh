@@ -7,6 +7,7 @@
 #   sudo pfctl -a "ivpn_firewall" -s rules
 #   sudo pfctl -a "ivpn_firewall/tunnel" -s rules
 #   sudo pfctl -a "ivpn_firewall/dns" -s rules
+#   sudo pfctl -a "ivpn_firewall/lan_services" -s rules
 # Show table
 #   sudo pfctl -a "ivpn_firewall" -t ivpn_servers -T show
 #   sudo pfctl -a "ivpn_firewall" -t ivpn_exceptions -T show
@@ -17,6 +18,9 @@ ANCHOR_NAME="ivpn_firewall"
 EXCEPTIONS_TABLE="ivpn_servers"
 USER_EXCEPTIONS_TABLE="ivpn_exceptions"
 
+# local networks (the LAN services are allowed only for these addresses)
+LAN_NETS="10.0.0.0/8, 172.16.0.0/12, 192.168.0.0/16, 169.254.0.0/16, fe80::/10, fc00::/7"
+
 # Checks whether anchor is present in the system
 # 0 - if anchor is present
 # 1 - if not present
@@ -97,6 +101,7 @@ function enable_firewall {
 
       anchor tunnel all
       anchor dns all
+      anchor lan_services all
 _EOF
 
     local TOKEN=`pfctl -E 2>&1 | grep -i token | sed -e 's/.*oken.*://' | tr -d ' \n'`
@@ -126,6 +131,8 @@ function disable_firewall {
     pfctl -a ${ANCHOR_NAME}/tunnel -Fr
     # remove all rules in dns anchor
     pfctl -a ${ANCHOR_NAME}/dns -Fr
+    # remove all rules in lan_services anchor
+    pfctl -a ${ANCHOR_NAME}/lan_services -Fr
 
     # remove all the rules in anchor
     pfctl -a ${ANCHOR_NAME} -Fr 
@@ -179,6 +186,43 @@ _EOF
 _EOF
 }
 
+# apply rules for the LAN services
+# Arguments: list of the allowed services (mdns, ssdp, dhcp, printing)
+#            and local ports allowed for incoming connections from LAN in format: in:PORT/PROTOCOL (e.g. in:22/tcp)
+function set_lan_services {
+  local RULES=""
+  for SVC in $@; do
+    if [[ ${SVC} = "mdns" ]]; then
+      RULES+="pass out proto udp from any to { ${LAN_NETS}, 224.0.0.251, ff02::fb } port = 5353"$'\n'
+      RULES+="pass in proto udp from { ${LAN_NETS} } to any port = 5353"$'\n'
+      RULES+="pass in proto udp from { ${LAN_NETS} } port = 5353 to any"$'\n'
+    elif [[ ${SVC} = "ssdp" ]]; then
+      RULES+="pass out proto udp from any to { ${LAN_NETS}, 239.255.255.250, ff02::c } port = 1900"$'\n'
+      RULES+="pass in proto udp from { ${LAN_NETS} } to any port = 1900"$'\n'
+      RULES+="pass in proto udp from { ${LAN_NETS} } port = 1900 to any"$'\n'
+    elif [[ ${SVC} = "dhcp" ]]; then
+      # DHCP broadcasts are allowed by default; here allowing unicast communication with DHCP servers in LAN (e.g. lease renewals)
+      RULES+="pass out proto udp from any to { ${LAN_NETS} } port = 67"$'\n'
+      RULES+="pass out proto udp from any to { ${LAN_NETS}, ff02::1:2 } port = 547"$'\n'
+      RULES+="pass in proto udp from { ${LAN_NETS} } to any port = 546"$'\n'
+    elif [[ ${SVC} = "printing" ]]; then
+      RULES+="pass out proto tcp from any to { ${LAN_NETS} } port { 631, 515, 9100 }"$'\n'
+    elif [[ ${SVC} = in:* ]]; then
+      # incoming connections from LAN to the local port (format: in:PORT/PROTOCOL)
+      local PORT_DEF=${SVC#in:}
+      RULES+="pass in proto ${PORT_DEF#*/} from { ${LAN_NETS} } to any port = ${PORT_DEF%/*}"$'\n'
+    else
+      echo "Unknown LAN service: ${SVC}" >&2
+    fi
+  done
+
+  # remove all rules in lan_services anchor
+  pfctl -a ${ANCHOR_NAME}/lan_services -Fr
+  if [[ -n "${RULES}" ]] ; then
+    echo "${RULES}" | pfctl -a ${ANCHOR_NAME}/lan_services -f -
+  fi
+}
+
 function main {
 
     if [[ $1 = "-enable" ]] ; then
@@ -237,6 +281,12 @@ function main {
         get_firewall_enabled || return 0
 
         set_dns $2
+    elif [[ $1 = "-set_lan_services" ]]; then
+
+        get_firewall_enabled || return 0
+
+        shift
+        set_lan_services $@
     else
         echo "Unknown command"
         return 2
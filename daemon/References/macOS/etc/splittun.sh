@@ -0,0 +1,427 @@
+#!/bin/bash
+
+#
+#  Script to control the Split-Tunneling functionality for macOS.
+#  It is a part of Daemon for IVPN Client Desktop.
+#  https://github.com/ivpn/desktop-app/daemon
+#
+#  Created by Stelnykovych Alexandr.
+#  Copyright (c) 2021 Privatus Limited.
+#
+#  This file is part of the Daemon for IVPN Client Desktop.
+#
+#  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+#  modify it under the terms of the GNU General Public License as published by the Free
+#  Software Foundation, either version 3 of the License, or (at your option) any later version.
+#
+#  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+#  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+#  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+#  details.
+#
+#  You should have received a copy of the GNU General Public License
+#  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+#
+
+# Useful commands:
+# Show all rules for "ivpn_splittun" anchor
+#   sudo pfctl -a "ivpn_splittun" -s rules
+#   sudo pfctl -a "ivpn_splittun" -s nat
+#
+# How it works:
+#   All processes started in the Split-Tunneling environment have the real group ID 'ivpn-exclude'.
+#   PF is routing packets from sockets which belongs to this group directly to the default
+#   (non-VPN) interface ('route-to'). The source address of such packets is translated to
+#   the address of the default interface ('nat').
+#   Destinations (IP addresses/networks) are kept in PF tables:
+#     - the traffic to destinations from the table '<ivpn_st_exclude>' is routed the same way (bypassing VPN);
+#     - the traffic from the Split-Tunneling environment to destinations from the table '<ivpn_st_include>'
+#       is not redirected (it goes through the VPN).
+
+PATH=/sbin:/usr/sbin:/usr/bin:/bin:$PATH
+
+# Split Tunneling group parameters
+_group_name=ivpn-exclude
+_group_id_default=4950          # the first GID to try when creating the group
+
+# PF anchor name
+_anchor_name=ivpn_splittun
+# Tag for packets coming from Split-Tunneling environment
+_pf_tag=IVPN_SPLITTUN
+# PF tables for destinations
+_table_exclude=ivpn_st_exclude
+_table_include=ivpn_st_include
+
+# Path to dynamic store key which keeps the runtime information
+_scutil_key=State:/Network/IVPN/SplitTunnel
+
+#Variables vill be initialized later:
+_def_interface_name=""
+_def_gateway=""
+
+function test()
+{
+    if ! command -v pfctl &>/dev/null; then
+        echo "ERROR: 'pfctl' not found" >&2
+        return 1
+    fi
+    if ! command -v dscl &>/dev/null; then
+        echo "ERROR: 'dscl' not found" >&2
+        return 1
+    fi
+    return 0
+}
+
+# Print GID of the Split Tunneling group (if group exists)
+function getGroupId()
+{
+    dscl . -read /Groups/${_group_name} PrimaryGroupID 2>/dev/null | awk '{print $2}'
+}
+
+# Create the Split Tunneling group (if not exists)
+function ensureGroup()
+{
+    local _gid=$(getGroupId)
+    if [ ! -z ${_gid} ]; then
+        return 0
+    fi
+
+    _gid=${_group_id_default}
+    while dscl . -list /Groups PrimaryGroupID | awk '{print $2}' | grep -qx "${_gid}" ; do
+        _gid=$((_gid+1))
+    done
+
+    echo "Creating group '${_group_name}' (GID: ${_gid}) ..."
+    dscl . -create /Groups/${_group_name} || return 1
+    dscl . -create /Groups/${_group_name} PrimaryGroupID ${_gid} || return 1
+    dscl . -create /Groups/${_group_name} RealName "IVPN Split Tunneling" || return 1
+    dscl . -create /Groups/${_group_name} Password "*" || return 1
+}
+
+function initDefGatewayVars()
+{
+    if [ -z ${_def_interface_name} ]; then
+        _def_interface_name=`echo 'show State:/Network/Global/IPv4' | scutil | grep PrimaryInterface | sed -e 's/.*PrimaryInterface : //'`
+    fi
+    if [ -z ${_def_gateway} ]; then
+        _def_gateway=`echo 'show State:/Network/Global/IPv4' | scutil | grep Router | sed -e 's/.*Router : //'`
+    fi
+}
+
+# Install anchors into the main ruleset (if not installed)
+function installAnchors()
+{
+    if pfctl -sr 2> /dev/null | grep -q "anchor.*${_anchor_name}" ; then
+        return 0
+    fi
+
+    # Translation rules must be specified before filter rules
+    cat \
+      <(pfctl -sn 2> /dev/null) \
+      <(echo "nat-anchor ${_anchor_name} all") \
+      <(pfctl -sr 2> /dev/null) \
+      <(echo "anchor ${_anchor_name} all") \
+       | pfctl -f -
+}
+
+# Load rules into the anchor
+function loadRules()
+{
+    local _gid=$(getGroupId)
+    if [ -z ${_gid} ]; then
+        echo "ERROR: group '${_group_name}' not exists" >&2
+        return 1
+    fi
+
+    initDefGatewayVars
+    if [ -z ${_def_interface_name} ] || [ -z ${_def_gateway} ]; then
+        # No default network interface (e.g. no network connection)
+        # Nothing to route. The rules will be loaded on 'update-routes'
+        echo "Default interface or gateway not defined. Skipping rules initialization"
+        pfctl -a ${_anchor_name} -Fa &>/dev/null
+        return 0
+    fi
+
+    pfctl -a ${_anchor_name} -f - <<_EOF
+      table <${_table_exclude}> persist
+      table <${_table_include}> persist
+
+      nat on ${_def_interface_name} inet from ! (${_def_interface_name}) to any tagged ${_pf_tag} -> (${_def_interface_name})
+
+      pass out quick on ! ${_def_interface_name} inet from any to <${_table_include}> group ${_gid} keep state
+      pass out quick on ! ${_def_interface_name} route-to (${_def_interface_name} ${_def_gateway}) inet from any to <${_table_exclude}> tag ${_pf_tag} keep state
+      pass out quick on ! ${_def_interface_name} route-to (${_def_interface_name} ${_def_gateway}) inet from any to ! ${_def_interface_name}:network group ${_gid} tag ${_pf_tag} keep state
+      pass out quick on ${_def_interface_name} inet tagged ${_pf_tag} keep state
+      pass out quick on ${_def_interface_name} inet group ${_gid} keep state
+_EOF
+
+    scutil <<_EOF
+      d.init
+      d.add Interface "${_def_interface_name}"
+      d.add Gateway "${_def_gateway}"
+      d.add Token "$(getToken)"
+      set ${_scutil_key}
+      quit
+_EOF
+}
+
+# Set destinations (all previous destinations are erased)
+#   -e <network> - traffic to the network bypasses the VPN tunnel
+#   -i <network> - traffic from the Split-Tunneling environment to the network goes through the VPN tunnel
+# Note: the tables are erased when rules are reloaded (e.g. on 'update-routes'), so they must be set again
+function setDestinations()
+{
+    local _exclude=()
+    local _include=()
+    OPTIND=1
+    while getopts ":e:i:" opt; do
+        case $opt in
+            e) _exclude+=("$OPTARG")   ;;
+            i) _include+=("$OPTARG")   ;;
+        esac
+    done
+
+    status &>/dev/null
+    if [ $? != 0 ]; then
+        echo "ERROR: split tunneling DISABLED. Please call 'start' command first" >&2
+        return 1
+    fi
+
+    if ! pfctl -a ${_anchor_name} -sr 2>/dev/null | grep -q "${_table_exclude}" ; then
+        # rules are not loaded yet (no default route): destinations will be set after rules loaded
+        return 0
+    fi
+
+    pfctl -a ${_anchor_name} -t ${_table_exclude} -T replace "${_exclude[@]}" &>/dev/null || return 1
+    pfctl -a ${_anchor_name} -t ${_table_include} -T replace "${_include[@]}" &>/dev/null || return 1
+    echo "Split Tunneling destinations: excluded ${#_exclude[@]}; included ${#_include[@]}"
+}
+
+function getToken()
+{
+    echo "show ${_scutil_key}" | scutil | grep Token | sed -e 's/.*: //' | tr -d ' \n'
+}
+
+function init()
+{
+    test || return 1
+    ensureGroup || return 1
+    installAnchors || return 1
+
+    # enable PF (keeping the reference token)
+    local _token=`pfctl -E 2>&1 | grep -i token | sed -e 's/.*oken.*://' | tr -d ' \n'`
+    scutil <<_EOF
+      d.init
+      d.add Token "${_token}"
+      set ${_scutil_key}
+      quit
+_EOF
+
+    loadRules || return 1
+    echo "Split Tunneling initialized"
+}
+
+function updateRoutes()
+{
+    status &>/dev/null || return 0
+
+    local _oldIface=`echo "show ${_scutil_key}" | scutil | grep Interface | sed -e 's/.*: //' | tr -d ' \n'`
+    local _oldGw=`echo "show ${_scutil_key}" | scutil | grep Gateway | sed -e 's/.*: //' | tr -d ' \n'`
+
+    initDefGatewayVars
+    if [ "${_oldIface}" == "${_def_interface_name}" ] && [ "${_oldGw}" == "${_def_gateway}" ]; then
+        return 0
+    fi
+
+    echo "Default route changed ('${_oldIface}' ${_oldGw} -> '${_def_interface_name}' ${_def_gateway}). Updating rules..."
+    loadRules
+}
+
+function clean()
+{
+    pfctl -a ${_anchor_name} -Fa &>/dev/null
+
+    local _token=$(getToken)
+    if [ ! -z ${_token} ]; then
+        pfctl -X "${_token}" &>/dev/null
+    fi
+    echo "remove ${_scutil_key}" | scutil
+
+    echo "Split Tunneling uninitialized"
+}
+
+function execute()
+{
+    _user=$1
+    _command=$2
+
+    local _gid=$(getGroupId)
+    if [ -z ${_gid} ]; then
+        echo "ERROR: group '${_group_name}' not exists" >&2
+        return 1
+    fi
+
+    if [ -z "${_user}" ]; then
+        _user=`stat -f %Su /dev/console`
+    fi
+    local _uid=`id -u ${_user}`
+
+    echo "Starting '${_command}' (user: ${_user}; group: ${_group_name})"
+    launchctl asuser ${_uid} sudo -u ${_user} -g ${_group_name} ${_command}
+}
+
+function status()
+{
+    if pfctl -a ${_anchor_name} -sr 2>/dev/null | grep -q "group" ; then
+        echo "Split Tunneling: ENABLED"
+        return 0
+    fi
+    if [ ! -z "$(getToken)" ]; then
+        # enabled but rules not loaded yet (no default route)
+        echo "Split Tunneling: ENABLED"
+        return 0
+    fi
+    echo "Split Tunneling: DISABLED"
+    return 1
+}
+
+function info()
+{
+    echo "[*] Group '${_group_name}':"
+    dscl . -read /Groups/${_group_name}
+    echo
+
+    echo "[*] ${_scutil_key}:"
+    echo "show ${_scutil_key}" | scutil
+    echo
+
+    echo "[*] pfctl -a ${_anchor_name} -s nat:"
+    pfctl -a ${_anchor_name} -s nat
+    echo
+    echo "[*] pfctl -a ${_anchor_name} -s rules:"
+    pfctl -a ${_anchor_name} -s rules
+    echo
+
+    echo "[*] pfctl -a ${_anchor_name} -t ${_table_exclude} -T show:"
+    pfctl -a ${_anchor_name} -t ${_table_exclude} -T show
+    echo
+    echo "[*] pfctl -a ${_anchor_name} -t ${_table_include} -T show:"
+    pfctl -a ${_anchor_name} -t ${_table_include} -T show
+    echo
+
+    echo "[*] Processes (GID: $(getGroupId)):"
+    ps -ax -o pid=,ppid=,rgid=,args= | awk -v gid="$(getGroupId)" '$3 == gid'
+    echo
+}
+
+if [[ $1 = "start" ]] ; then
+    _def_interface_name=""
+    _def_gateway=""
+    shift
+    while getopts ":i:g:" opt; do
+        case $opt in
+            i) _def_interface_name="$OPTARG"   ;;
+            g) _def_gateway="$OPTARG"    ;;
+        esac
+    done
+    init
+
+elif [[ $1 = "stop" ]] ; then
+    clean
+
+elif [[ $1 = "reset" ]] ; then
+    # It is not possible to change the group of the running process.
+    # Processes stay running but their traffic goes through the VPN after ST is disabled.
+    clean
+
+elif [[ $1 = "groupid" ]] ; then
+    ensureGroup >/dev/null || exit 1
+    getGroupId
+
+elif [[ $1 = "run" ]] ; then
+    _command=""
+    _user=""
+    shift
+    while getopts ":u:" opt; do
+        case $opt in
+            u) _user="$OPTARG"   ;;
+        esac
+    done
+    if [ ! -z ${_user} ]; then
+        shift
+        shift
+    fi
+    _command=$@
+    execute "${_user}" "${_command}"
+
+elif [[ $1 = "dest-set" ]] ; then
+    shift
+    setDestinations "$@"
+
+elif [[ $1 = "update-routes" ]] ; then
+    # The default interface/gateway can be changed (e.g. switching WiFi network), so we need to update rules
+    shift
+    updateRoutes $@
+
+elif [[ $1 = "info" ]] ; then
+    shift
+    info $@
+
+elif [[ $1 = "status" ]] ; then
+    shift
+    status $@
+
+elif [[ $1 = "test" ]] ; then
+    shift
+    test $@
+
+elif [[ $1 = "manual" ]] ; then
+    _FUNCNAME=$2
+    shift
+    shift
+    echo "Running manual command: ${_FUNCNAME}($@) "
+    ${_FUNCNAME} $@
+else
+    echo "Script to control the Split-Tunneling functionality for macOS."
+    echo "It is a part of Daemon for IVPN Client Desktop."
+    echo "https://github.com/ivpn/desktop-app/daemon"
+    echo "Created by Stelnykovych Alexandr."
+    echo "Copyright (c) 2021 Privatus Limited."
+    echo ""
+    echo "Usage:"
+    echo "Note! The script have to be started under privilaged user (sudo $0 ...)"
+    echo "    $0 <command> [parameters]"
+    echo "Parameters:"
+    echo "    start [-i <interface_name>] [-g <gateway_ip>]"
+    echo "        Initialize split-tunneling functionality"
+    echo "        - interface_name - (optional) name of network interface to be used for ST environment"
+    echo "        - gateway_ip     - (optional) gateway IP to be used for ST environment"
+    echo "    stop"
+    echo "        Uninitialize split-tunneling functionality"
+    echo "    run [-u <username>] <command>"
+    echo "        Start commands in split-tunneling environment"
+    echo "        - command        - the command or path to binary to be executed"
+    echo "        - username       - (optional) the account under which the command have to be executed"
+    echo "    groupid"
+    echo "        Print GID of the split-tunneling group (the group will be created if not exists)"
+    echo "    dest-set [-e <network>]... [-i <network>]..."
+    echo "        Set destinations (previous destinations are erased)"
+    echo "        - network        - IP address or network in CIDR notation"
+    echo "        -e               - the traffic to the network bypasses the VPN tunnel"
+    echo "        -i               - the traffic from split-tunneling environment to the network goes through the VPN tunnel"
+    echo "    update-routes"
+    echo "        Update rules according to the current default interface and gateway"
+    echo "    reset"
+    echo "        Uninitialize split-tunneling functionality"
+    echo "    status"
+    echo "        Check split-tunneling status"
+    echo "    info"
+    echo "        Print diagnostic information"
+    echo "Examples:"
+    echo "    Initialize split-tunneling functionality:"
+    echo "        $0 start"
+    echo "        $0 start -i en0 -g 192.168.1.1"
+    echo "    Start command in split-tunneling environment:"
+    echo "        $0 run /Applications/Firefox.app/Contents/MacOS/firefox"
+    echo "        $0 run -u tom /Applications/Firefox.app/Contents/MacOS/firefox"
+fi
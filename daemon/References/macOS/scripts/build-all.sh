@@ -64,6 +64,14 @@ function BuildWireGuard
   ./build-wireguard.sh
 }
 
+function BuildAmneziaWG
+{
+  echo "############################################"
+  echo "### AmneziaWG"
+  echo "############################################"
+  ./build-amneziawg.sh
+}
+
 function BuildObfs4proxy
 {
   echo "############################################"
@@ -80,9 +88,33 @@ function BuildDnscryptProxy
   ./build-dnscrypt-proxy.sh
 }
 
+function BuildV2Ray
+{
+  echo "############################################"
+  echo "### v2ray"
+  echo "############################################"
+  ./build-v2ray.sh
+}
+
+function BuildShadowsocks
+{
+  echo "############################################"
+  echo "### shadowsocks"
+  echo "############################################"
+  ./build-shadowsocks.sh
+}
+
+function BuildKemHelper
+{
+  echo "############################################"
+  echo "### kem-helper"
+  echo "############################################"
+  ./build-kem-helper.sh
+}
+
 if [ ! -z "$GITHUB_ACTIONS" ]; then
   echo "! GITHUB_ACTIONS detected ! It is just a build test."
-  echo "! Skipped compilation of third-party dependencies: OpenVPN, WireGuard, obfs4proxy, dnscrypt-proxy !"
+  echo "! Skipped compilation of third-party dependencies: OpenVPN, WireGuard, AmneziaWG, obfs4proxy, dnscrypt-proxy, v2ray, shadowsocks, kem-helper !"
 else
   if [[ "$@" == *"-norebuild"* ]]
   then
@@ -104,6 +136,15 @@ else
         echo "WireGuard already compiled. Skipping build."
       fi
 
+      # check if we need to compile AmneziaWG
+      if [[ ! -f "../_deps/awg_inst/awg" ]] || [[ ! -f "../_deps/awg_inst/amneziawg-go" ]]
+      then
+        echo "AmneziaWG not compiled"
+        BuildAmneziaWG
+      else
+        echo "AmneziaWG already compiled. Skipping build."
+      fi
+
       # check if we need to compile obfs4proxy
       if [[ ! -f "../_deps/obfs4proxy_inst/obfs4proxy" ]]
       then
@@ -122,12 +163,43 @@ else
         echo "dnscrypt-proxy already compiled. Skipping build."
       fi
 
+      # check if we need to compile v2ray
+      if [[ ! -f "../_deps/v2ray_inst/v2ray" ]]
+      then
+        echo "v2ray not compiled"
+        BuildV2Ray
+      else
+        echo "v2ray already compiled. Skipping build."
+      fi
+
+      # check if we need to compile shadowsocks
+      if [[ ! -f "../_deps/shadowsocks_inst/sslocal" ]]
+      then
+        echo "shadowsocks not compiled"
+        BuildShadowsocks
+      else
+        echo "shadowsocks already compiled. Skipping build."
+      fi
+
+      # check if we need to compile kem-helper
+      if [[ ! -f "../_deps/kem-helper_inst/kem-helper" ]]
+      then
+        echo "kem-helper not compiled"
+        BuildKemHelper
+      else
+        echo "kem-helper already compiled. Skipping build."
+      fi
+
   else
-    # recompile openvpn, WireGuard, obfs4proxy, dnscrypt-proxy
+    # recompile openvpn, WireGuard, AmneziaWG, obfs4proxy, dnscrypt-proxy, v2ray, shadowsocks, kem-helper
     BuildOpenVPN
     BuildWireGuard
+    BuildAmneziaWG
     BuildObfs4proxy
     BuildDnscryptProxy
+    BuildV2Ray
+    BuildShadowsocks
+    BuildKemHelper
   fi
 fi
 # updating servers.json
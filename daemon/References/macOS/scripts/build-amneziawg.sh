@@ -0,0 +1,50 @@
+#!/bin/sh
+
+# ##############################################################################
+# Define here AmneziaWG versions
+# ##############################################################################
+AWG_GO_VER=v0.2.12            # https://github.com/amnezia-vpn/amneziawg-go
+AWG_TOOLS_VER=v1.0.20241018   # https://github.com/amnezia-vpn/amneziawg-tools
+
+# Exit immediately if a command exits with a non-zero status.
+set -e
+
+cd "$(dirname "$0")"
+BASE_DIR="$(pwd)" #set base folder of script location
+
+BUILD_DIR=${BASE_DIR}/../_deps/awg_build # work directory
+INSTALL_DIR=${BUILD_DIR}/../awg_inst
+
+echo "******** Creating work-folder (${BUILD_DIR})..."
+rm -rf ${BUILD_DIR}
+rm -rf ${INSTALL_DIR}
+mkdir -pv ${BUILD_DIR}
+mkdir -pv ${INSTALL_DIR}
+
+
+echo "******** Cloning amneziawg-go sources..."
+cd ${BUILD_DIR}
+git clone https://github.com/amnezia-vpn/amneziawg-go.git
+cd amneziawg-go
+echo "******** Checkout amneziawg-go version (${AWG_GO_VER})..."
+git checkout ${AWG_GO_VER}
+echo "******** Compiling 'amneziawg-go'..."
+CGO_CFLAGS=-mmacosx-version-min=10.10 CGO_LDFLAGS=-mmacosx-version-min=10.10 make
+
+echo "******** Cloning amneziawg-tools sources..."
+cd ${BUILD_DIR}
+git clone https://github.com/amnezia-vpn/amneziawg-tools.git
+cd amneziawg-tools/src
+echo "******** Checkout amneziawg-tools version (${AWG_TOOLS_VER})..."
+git checkout ${AWG_TOOLS_VER}
+echo "******** Compiling 'amneziawg-tools'..."
+CFLAGS=-mmacosx-version-min=10.10 LDFLAGS=-mmacosx-version-min=10.10 make
+
+echo "********************************"
+echo "******** BUILD COMPLETE ********"
+echo "********************************"
+
+echo "******** Copying compiled binaries to '$INSTALL_DIR"
+cd ${BUILD_DIR}
+cp ./amneziawg-go/amneziawg-go $INSTALL_DIR
+cp ./amneziawg-tools/src/wg $INSTALL_DIR/awg
@@ -0,0 +1,41 @@
+#!/bin/sh
+
+LIBOQS_VER=0.10.1 # https://github.com/open-quantum-safe/liboqs (requires: cmake)
+
+# Exit immediately if a command exits with a non-zero status.
+set -e
+
+cd "$(dirname "$0")"
+BASE_DIR="$(pwd)" #set base folder of script location
+
+BUILD_DIR=${BASE_DIR}/../_deps/kem-helper_build # work directory
+INSTALL_DIR=${BUILD_DIR}/../kem-helper_inst
+SRC_DIR=${BASE_DIR}/../../common/kem-helper
+
+echo "******** Creating work-folder (${BUILD_DIR})..."
+rm -rf ${BUILD_DIR}
+rm -rf ${INSTALL_DIR}
+mkdir -pv ${BUILD_DIR}
+mkdir -pv ${INSTALL_DIR}
+
+echo "******** Cloning liboqs sources..."
+cd ${BUILD_DIR}
+git clone https://github.com/open-quantum-safe/liboqs.git
+cd liboqs
+
+echo "******** Checkout liboqs version (${LIBOQS_VER})..."
+git checkout tags/${LIBOQS_VER}
+
+echo "******** Compiling 'liboqs'..."
+mkdir build
+cd build
+cmake -DCMAKE_OSX_DEPLOYMENT_TARGET=10.10 -DCMAKE_BUILD_TYPE=Release -DBUILD_SHARED_LIBS=OFF -DOQS_BUILD_ONLY_LIB=ON -DOQS_USE_OPENSSL=OFF -DOQS_MINIMAL_BUILD="KEM_kyber_1024" -DCMAKE_INSTALL_PREFIX=${BUILD_DIR}/liboqs_inst ..
+make
+make install
+
+echo "******** Compiling 'kem-helper'..."
+cc -O2 -mmacosx-version-min=10.10 -o ${INSTALL_DIR}/kem-helper ${SRC_DIR}/kem-helper.c -I${BUILD_DIR}/liboqs_inst/include ${BUILD_DIR}/liboqs_inst/lib/liboqs.a
+
+echo "********************************"
+echo "******** BUILD COMPLETE ********"
+echo "********************************"
@@ -0,0 +1,36 @@
+#!/bin/sh
+
+SS_VER=v1.20.4 # https://github.com/shadowsocks/shadowsocks-rust (requires Rust toolchain: 'cargo')
+
+# Exit immediately if a command exits with a non-zero status.
+set -e
+
+cd "$(dirname "$0")"
+BASE_DIR="$(pwd)" #set base folder of script location
+
+BUILD_DIR=${BASE_DIR}/../_deps/shadowsocks_build # work directory
+INSTALL_DIR=${BUILD_DIR}/../shadowsocks_inst
+
+echo "******** Creating work-folder (${BUILD_DIR})..."
+rm -rf ${BUILD_DIR}
+rm -rf ${INSTALL_DIR}
+mkdir -pv ${BUILD_DIR}
+mkdir -pv ${INSTALL_DIR}
+
+echo "******** Cloning shadowsocks-rust sources..."
+cd ${BUILD_DIR}
+git clone https://github.com/shadowsocks/shadowsocks-rust.git
+cd shadowsocks-rust
+
+echo "******** Checkout shadowsocks-rust version (${SS_VER})..."
+git checkout tags/${SS_VER}
+
+echo "******** Compiling 'sslocal'..."
+MACOSX_DEPLOYMENT_TARGET=10.12 cargo build --release --bin sslocal
+
+echo "******** Copying 'sslocal' binary..."
+cp ${BUILD_DIR}/shadowsocks-rust/target/release/sslocal ${INSTALL_DIR}
+
+echo "********************************"
+echo "******** BUILD COMPLETE ********"
+echo "********************************"
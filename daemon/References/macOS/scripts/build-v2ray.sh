@@ -0,0 +1,33 @@
+#!/bin/sh
+
+V2RAY_VER=v5.16.1 # https://github.com/v2fly/v2ray-core
+
+# Exit immediately if a command exits with a non-zero status.
+set -e
+
+cd "$(dirname "$0")"
+BASE_DIR="$(pwd)" #set base folder of script location
+
+BUILD_DIR=${BASE_DIR}/../_deps/v2ray_build # work directory
+INSTALL_DIR=${BUILD_DIR}/../v2ray_inst
+
+echo "******** Creating work-folder (${BUILD_DIR})..."
+rm -rf ${BUILD_DIR}
+rm -rf ${INSTALL_DIR}
+mkdir -pv ${BUILD_DIR}
+mkdir -pv ${INSTALL_DIR}
+
+echo "******** Cloning v2ray-core sources..."
+cd ${BUILD_DIR}
+git clone https://github.com/v2fly/v2ray-core.git
+cd v2ray-core
+
+echo "******** Checkout v2ray-core version (${V2RAY_VER})..."
+git checkout tags/${V2RAY_VER}
+
+echo "******** Compiling 'v2ray'..."
+CGO_ENABLED=0 go build -o ${INSTALL_DIR}/v2ray -trimpath -ldflags "-s -w -buildid=" ./main
+
+echo "********************************"
+echo "******** BUILD COMPLETE ********"
+echo "********************************"
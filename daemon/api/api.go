@@ -26,6 +26,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"net"
+	"net/http"
 	"runtime"
 	"strings"
 	"sync"
@@ -49,6 +50,20 @@ const (
 	_sessionDeletePath     = _apiPathPrefix + "/session/delete"
 	_wgKeySetPath          = _apiPathPrefix + "/session/wg/set"
 	_geoLookupPath         = _apiPathPrefix + "/geo-lookup"
+	_devicesPath           = _apiPathPrefix + "/session/devices"
+	_deviceDeletePath      = _apiPathPrefix + "/session/devices/delete"
+
+	_portForwardingRequestPath = _apiPathPrefix + "/session/port-forwarding/request"
+	_portForwardingReleasePath = _apiPathPrefix + "/session/port-forwarding/release"
+)
+
+// The functionality which is not provided by the IVPN API yet (the API contract is not defined).
+// The corresponding daemon functionality is disabled until the backend supports it.
+const (
+	// Port forwarding: the API does not provide the port forwarding endpoints.
+	IsPortForwardingSupported = false
+	// Devices management: the API does not provide the endpoints to list/log out the devices (sessions) of the account.
+	IsDevicesManagementSupported = false
 )
 
 // Alias - alias description of API request (can be requested by UI client)
@@ -60,8 +75,13 @@ type Alias struct {
 	// Example:
 	//		The "updateInfo_macOS" on arm64 platform will use file "/macos/update_arm64.json" (NOT A "/macos/update.json")
 	isArcIndependent bool
+	// If isCached==true, the last successful response is kept in memory and returned (marked as stale)
+	// when the API is not reachable (see 'DoRequestByAlias()' for details)
+	isCached bool
 }
 
+const _geoLookupAlias = "geo-lookup"
+
 // APIAliases - aliases of API requests (can be requested by UI client)
 // NOTE: the aliases bellow are only for amd64 architecture!!!
 // If isArcIndependent!=true: Filename construction for non-amd64 architectures: filename_<architecture>.<extensions>
@@ -70,7 +90,7 @@ type Alias struct {
 //		The "updateInfo_macOS" on arm64 platform will use file "/macos/update_arm64.json" (NOT A "/macos/update.json")
 
 var APIAliases = map[string]Alias{
-	"geo-lookup": {host: _apiHost, path: _geoLookupPath},
+	_geoLookupAlias: {host: _apiHost, path: _geoLookupPath, isCached: true},
 
 	"updateInfo_Linux":   {host: _updateHost, path: "/stable/_update_info/update.json"},
 	"updateSign_Linux":   {host: _updateHost, path: "/stable/_update_info/update.json.sign.sha256.base64"},
@@ -114,6 +134,26 @@ type API struct {
 	alternateIPsV6        []net.IP
 	lastGoodAlternateIPv6 net.IP
 	connectivityChecker   IConnectivityInfo
+
+	// difference between the local time and the API server time
+	clock clockInfo
+
+	// proxy server for API requests (not enabled - direct connection)
+	proxy types.ProxyConfig
+
+	// alternative API server (not enabled - the default IVPN API server)
+	hostOverride types.APIHostOverride
+
+	// statistics of the API requests (total number of requests and number of failed requests)
+	statsMutex          sync.Mutex
+	statsRequestsCount  uint64
+	statsRequestsFailed uint64
+
+	// health of the API endpoints (the API hostname and alternate IPs); defines the order of the endpoints usage
+	endpointsHealth endpointsHealth
+
+	// last successful responses of the cacheable requests (used when the API is not reachable)
+	cache responseCache
 }
 
 // CreateAPI creates new API object
@@ -121,6 +161,22 @@ func CreateAPI() (*API, error) {
 	return &API{}, nil
 }
 
+// RequestsStats returns the total number of API requests and the number of failed requests (since the daemon start)
+func (a *API) RequestsStats() (total, failed uint64) {
+	a.statsMutex.Lock()
+	defer a.statsMutex.Unlock()
+	return a.statsRequestsCount, a.statsRequestsFailed
+}
+
+func (a *API) updateRequestsStats(isFailed bool) {
+	a.statsMutex.Lock()
+	defer a.statsMutex.Unlock()
+	a.statsRequestsCount++
+	if isFailed {
+		a.statsRequestsFailed++
+	}
+}
+
 func (a *API) SetConnectivityChecker(connectivityChecker IConnectivityInfo) {
 	a.mutex.Lock()
 	defer a.mutex.Unlock()
@@ -152,8 +208,9 @@ func (a *API) SetLastGoodAlternateIP(IPv6 bool, ip net.IP) {
 
 	if IPv6 {
 		a.lastGoodAlternateIPv6 = ip
+	} else {
+		a.lastGoodAlternateIPv4 = ip
 	}
-	a.lastGoodAlternateIPv4 = ip
 }
 
 func (a *API) getAlternateIPs(IPv6 bool) []net.IP {
@@ -217,23 +274,107 @@ func (a *API) doSetAlternateIPs(IPv6 bool, IPs []string) error {
 	return nil
 }
 
+// CacheValidators - HTTP cache validators of the response (used to make the conditional requests)
+type CacheValidators struct {
+	ETag         string `json:"etag,omitempty"`
+	LastModified string `json:"last_modified,omitempty"`
+}
+
+// IsEmpty returns true when no validators defined (the conditional request is not possible)
+func (v CacheValidators) IsEmpty() bool {
+	return len(v.ETag) == 0 && len(v.LastModified) == 0
+}
+
 // DownloadServersList - download servers list form API IVPN server
 func (a *API) DownloadServersList() (*types.ServersInfoResponse, error) {
-	servers := new(types.ServersInfoResponse)
-	if err := a.request("", _serversPath, "GET", "", nil, servers); err != nil {
-		return nil, err
+	servers, _, _, err := a.DownloadServersListIfModified(CacheValidators{}, nil)
+	return servers, err
+}
+
+// DownloadServersListIfModified - download servers list form API IVPN server, if it was changed.
+// 'validators' - the cache validators of the servers list which is already available locally (empty - download unconditionally).
+// 'baseData' - raw data of the locally available servers list (corresponds to 'validators').
+// If defined, the delta update is requested (see 'api_delta.go'); when the delta can not be applied - the full servers list is downloaded.
+// The gzip transfer encoding is negotiated (and decoded) by the HTTP transport.
+// The signature of the servers list is verified when the signing keys are defined (see 'verifyServersListSignature()').
+//
+// Returns nil servers (and no error) when the servers list was not modified (HTTP 304).
+// 'data' - raw data of the new servers list; 'newValidators' - its cache validators.
+func (a *API) DownloadServersListIfModified(validators CacheValidators, baseData []byte) (servers *types.ServersInfoResponse, data []byte, newValidators CacheValidators, err error) {
+	isDeltaRequested := len(baseData) > 0 && len(validators.ETag) > 0
+
+	headers := http.Header{}
+	if len(validators.ETag) > 0 {
+		headers.Set("If-None-Match", validators.ETag)
+	}
+	if len(validators.LastModified) > 0 {
+		headers.Set("If-Modified-Since", validators.LastModified)
+	}
+	if isDeltaRequested {
+		headers.Set("A-IM", _deltaFormat)
+	}
+
+	body, resp, err := a.requestRawEx(protocolTypes.IPvAny, "", _serversPath, "GET", "", headers, nil, 0, 0)
+	if err != nil {
+		return nil, nil, CacheValidators{}, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		if validators.IsEmpty() {
+			return nil, nil, CacheValidators{}, fmt.Errorf("unexpected API response (not modified)")
+		}
+		return nil, nil, validators, nil
+
+	case _statusDeltaIMUsed:
+		var deltaErr error
+		if !isDeltaRequested || !strings.Contains(resp.Header.Get("IM"), _deltaFormat) {
+			deltaErr = fmt.Errorf("unexpected delta format '%s'", resp.Header.Get("IM"))
+		} else if data, deltaErr = applyJSONPatch(baseData, body); deltaErr == nil {
+			log.Info(fmt.Sprintf("Servers list delta update received (%d bytes)", len(body)))
+		}
+		if deltaErr != nil {
+			// the delta chain is broken: download full servers list
+			log.Warning("Failed to apply servers list delta update (downloading full servers list): ", deltaErr)
+			return a.DownloadServersListIfModified(CacheValidators{}, nil)
+		}
+
+	default:
+		data = body
+	}
+
+	// do not accept the servers list which is not signed by IVPN (e.g. MITM or compromised CDN)
+	if err := verifyServersListSignature(data, resp.Header.Get(_serversSignatureHeader)); err != nil {
+		if resp.StatusCode == _statusDeltaIMUsed {
+			log.Warning(err, " (downloading full servers list)")
+			return a.DownloadServersListIfModified(CacheValidators{}, nil)
+		}
+		return nil, nil, CacheValidators{}, err
+	}
+
+	servers = new(types.ServersInfoResponse)
+	if err := json.Unmarshal(data, servers); err != nil {
+		if resp.StatusCode == _statusDeltaIMUsed {
+			log.Warning("Failed to parse servers list after delta update (downloading full servers list): ", err)
+			return a.DownloadServersListIfModified(CacheValidators{}, nil)
+		}
+		return nil, nil, CacheValidators{}, fmt.Errorf("failed to deserialize API response: %w", err)
 	}
 
 	// save info about alternate API hosts
 	a.SetAlternateIPs(servers.Config.API.IPAddresses, servers.Config.API.IPv6Addresses)
-	return servers, nil
+
+	newValidators = CacheValidators{ETag: resp.Header.Get("ETag"), LastModified: resp.Header.Get("Last-Modified")}
+	return servers, data, newValidators, nil
 }
 
 // DoRequestByAlias do API request (by API endpoint alias). Returns raw data of response
-func (a *API) DoRequestByAlias(apiAlias string, ipTypeRequired protocolTypes.RequiredIPProtocol) (responseData []byte, err error) {
+// For the cached aliases (e.g. "geo-lookup"), when the request fails, the last successful response is returned;
+// in this case 'cachedAt' contains the time when the cached response was received (zero value - the response is up to date)
+func (a *API) DoRequestByAlias(apiAlias string, ipTypeRequired protocolTypes.RequiredIPProtocol) (responseData []byte, cachedAt time.Time, err error) {
 	alias, ok := APIAliases[apiAlias]
 	if !ok {
-		return nil, fmt.Errorf("unexpected request alias")
+		return nil, time.Time{}, fmt.Errorf("unexpected request alias")
 	}
 
 	if !alias.isArcIndependent {
@@ -251,19 +392,30 @@ func (a *API) DoRequestByAlias(apiAlias string, ipTypeRequired protocolTypes.Req
 
 	retData, retErr := a.requestRaw(ipTypeRequired, alias.host, alias.path, "", "", nil, 0, 0)
 
-	return retData, retErr
+	if alias.isCached {
+		if retErr == nil {
+			a.cache.save(apiAlias, ipTypeRequired, retData)
+		} else if data, timestamp, ok := a.cache.get(apiAlias, ipTypeRequired); ok {
+			log.Info(fmt.Sprintf("API request '%s' failed; using the cached response (received %v ago): %v", apiAlias, time.Since(timestamp).Round(time.Second), retErr))
+			return data, timestamp, nil
+		}
+	}
+
+	return retData, time.Time{}, retErr
 }
 
 // SessionNew - try to register new session
 func (a *API) SessionNew(accountID string, wgPublicKey string, forceLogin bool, captchaID string, captcha string, confirmation2FA string) (
 	*types.SessionNewResponse,
 	*types.SessionNewErrorLimitResponse,
+	*types.SessionNewErrorCaptchaResponse,
 	*types.APIErrorResponse,
 	string, // RAW response
 	error) {
 
 	var successResp types.SessionNewResponse
 	var errorLimitResp types.SessionNewErrorLimitResponse
+	var errorCaptchaResp types.SessionNewErrorCaptchaResponse
 	var apiErr types.APIErrorResponse
 
 	rawResponse := ""
@@ -278,33 +430,83 @@ func (a *API) SessionNew(accountID string, wgPublicKey string, forceLogin bool,
 
 	data, err := a.requestRaw(protocolTypes.IPvAny, "", _sessionNewPath, "POST", "application/json", request, 0, 0)
 	if err != nil {
-		return nil, nil, nil, rawResponse, err
+		return nil, nil, nil, nil, rawResponse, err
 	}
 
 	rawResponse = string(data)
 
 	// Check is it API error
 	if err := json.Unmarshal(data, &apiErr); err != nil {
-		return nil, nil, nil, rawResponse, fmt.Errorf("failed to deserialize API response: %w", err)
+		return nil, nil, nil, nil, rawResponse, fmt.Errorf("failed to deserialize API response: %w", err)
 	}
 
 	// success
 	if apiErr.Status == types.CodeSuccess {
 		if err := json.Unmarshal(data, &successResp); err != nil {
-			return nil, nil, nil, rawResponse, fmt.Errorf("failed to deserialize API response: %w", err)
+			return nil, nil, nil, nil, rawResponse, fmt.Errorf("failed to deserialize API response: %w", err)
 		}
-		return &successResp, nil, &apiErr, rawResponse, nil
+		return &successResp, nil, nil, &apiErr, rawResponse, nil
 	}
 
 	// Session limit check
 	if apiErr.Status == types.CodeSessionsLimitReached {
 		if err := json.Unmarshal(data, &errorLimitResp); err != nil {
-			return nil, nil, nil, rawResponse, fmt.Errorf("failed to deserialize API response: %w", err)
+			return nil, nil, nil, nil, rawResponse, fmt.Errorf("failed to deserialize API response: %w", err)
 		}
-		return nil, &errorLimitResp, &apiErr, rawResponse, types.CreateAPIError(apiErr.Status, apiErr.Message)
+		return nil, &errorLimitResp, nil, &apiErr, rawResponse, types.CreateAPIError(apiErr.Status, apiErr.Message)
 	}
 
-	return nil, nil, &apiErr, rawResponse, types.CreateAPIError(apiErr.Status, apiErr.Message)
+	// Captcha check (captcha can be required in addition to the 2FA token)
+	if apiErr.Status == types.CaptchaRequired || apiErr.Status == types.CaptchaInvalid {
+		if err := json.Unmarshal(data, &errorCaptchaResp); err != nil {
+			return nil, nil, nil, nil, rawResponse, fmt.Errorf("failed to deserialize API response: %w", err)
+		}
+		return nil, nil, &errorCaptchaResp, &apiErr, rawResponse, types.CreateAPIError(apiErr.Status, apiErr.Message)
+	}
+
+	return nil, nil, nil, &apiErr, rawResponse, types.CreateAPIError(apiErr.Status, apiErr.Message)
+}
+
+// DevicesList - get the devices (active sessions) of the account.
+// Authentication: by the session token or (if 'session' is empty) by the account ID and 2FA token (if enabled for the account)
+func (a *API) DevicesList(session string, accountID string, confirmation2FA string) ([]types.DeviceInfo, error) {
+	if !IsDevicesManagementSupported {
+		return nil, fmt.Errorf("devices management is not supported by the API")
+	}
+
+	request := &types.DevicesRequest{Session: session, AccountID: accountID, Confirmation2FA: confirmation2FA}
+	resp := &types.DevicesResponse{}
+
+	if err := a.request("", _devicesPath, "POST", "application/json", request, resp); err != nil {
+		return nil, err
+	}
+	if resp.Status != types.CodeSuccess {
+		return nil, types.CreateAPIError(resp.Status, resp.Message)
+	}
+
+	return resp.Devices, nil
+}
+
+// DeviceDelete - log out the device (delete the session) of the account.
+// Authentication: the same as for DevicesList()
+func (a *API) DeviceDelete(session string, accountID string, confirmation2FA string, deviceID string) error {
+	if !IsDevicesManagementSupported {
+		return fmt.Errorf("devices management is not supported by the API")
+	}
+
+	request := &types.DeviceDeleteRequest{
+		DevicesRequest: types.DevicesRequest{Session: session, AccountID: accountID, Confirmation2FA: confirmation2FA},
+		DeviceID:       deviceID}
+	resp := &types.APIErrorResponse{}
+
+	if err := a.request("", _deviceDeletePath, "POST", "application/json", request, resp); err != nil {
+		return err
+	}
+	if resp.Status != types.CodeSuccess {
+		return types.CreateAPIError(resp.Status, resp.Message)
+	}
+
+	return nil
 }
 
 // SessionStatus - get session status
@@ -353,37 +555,95 @@ func (a *API) SessionDelete(session string) error {
 }
 
 // WireGuardKeySet - update WG key
-func (a *API) WireGuardKeySet(session string, newPublicWgKey string, activePublicWgKey string) (localIP net.IP, err error) {
+// 'kemAlgorithm', 'kemPublicKey' - (optional) post-quantum KEM public key to negotiate the WireGuard preshared key;
+// the cipher text of the secret is returned in 'kemCipher' (empty - not supported by the server)
+func (a *API) WireGuardKeySet(session string, newPublicWgKey string, activePublicWgKey string, kemAlgorithm string, kemPublicKey string) (localIP net.IP, kemCipher string, err error) {
 	request := &types.SessionWireGuardKeySetRequest{
 		Session:            session,
 		PublicKey:          newPublicWgKey,
-		ConnectedPublicKey: activePublicWgKey}
+		ConnectedPublicKey: activePublicWgKey,
+		KemPublicKey1:      kemPublicKey,
+		KemLibraryName1:    kemAlgorithm}
 
 	resp := &types.SessionsWireGuardResponse{}
 
 	if err := a.request("", _wgKeySetPath, "POST", "application/json", request, resp); err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if resp.Status != types.CodeSuccess {
-		return nil, types.CreateAPIError(resp.Status, resp.Message)
+		return nil, "", types.CreateAPIError(resp.Status, resp.Message)
 	}
 
 	localIP = net.ParseIP(resp.IPAddress)
 	if localIP == nil {
-		return nil, fmt.Errorf("failed to set WG key (failed to parse local IP in API response)")
+		return nil, "", fmt.Errorf("failed to set WG key (failed to parse local IP in API response)")
 	}
 
-	return localIP, nil
+	return localIP, resp.KemCipher1, nil
+}
+
+// PortForwardingRequest - request forwarded port for the current VPN connection of the session.
+// 'port' - the port to renew (0 - request new port).
+// Returns the forwarded port number and its lifetime.
+func (a *API) PortForwardingRequest(session string, port int) (forwardedPort int, lifetime time.Duration, err error) {
+	if !IsPortForwardingSupported {
+		return 0, 0, fmt.Errorf("port forwarding is not supported by the API")
+	}
+
+	request := &types.PortForwardingRequest{Session: session, Port: port}
+	resp := &types.PortForwardingResponse{}
+
+	if err := a.request("", _portForwardingRequestPath, "POST", "application/json", request, resp); err != nil {
+		return 0, 0, err
+	}
+
+	if resp.Status != types.CodeSuccess {
+		return 0, 0, types.CreateAPIError(resp.Status, resp.Message)
+	}
+	if resp.Port <= 0 || resp.Port > 65535 || resp.ExpiresIn <= 0 {
+		return 0, 0, fmt.Errorf("failed to request forwarded port (bad API response)")
+	}
+
+	return resp.Port, time.Duration(resp.ExpiresIn) * time.Second, nil
+}
+
+// PortForwardingRelease - release the forwarded port
+func (a *API) PortForwardingRelease(session string, port int) error {
+	if !IsPortForwardingSupported {
+		return fmt.Errorf("port forwarding is not supported by the API")
+	}
+
+	request := &types.PortForwardingRequest{Session: session, Port: port}
+	resp := &types.APIErrorResponse{}
+	if err := a.request("", _portForwardingReleasePath, "POST", "application/json", request, resp); err != nil {
+		return err
+	}
+	if resp.Status != types.CodeSuccess {
+		return types.CreateAPIError(resp.Status, resp.Message)
+	}
+	return nil
 }
 
 // GeoLookup get geolocation
-func (a *API) GeoLookup(timeoutMs int) (location *types.GeoLookupResponse, err error) {
-	resp := &types.GeoLookupResponse{}
+// When the API is not reachable, the last successful response is returned;
+// in this case 'cachedAt' contains the time when the cached response was received (zero value - the response is up to date)
+func (a *API) GeoLookup(timeoutMs int) (location *types.GeoLookupResponse, cachedAt time.Time, err error) {
+	data, err := a.requestRaw(protocolTypes.IPvAny, "", _geoLookupPath, "GET", "", nil, timeoutMs, 0)
+	if err == nil {
+		a.cache.save(_geoLookupAlias, protocolTypes.IPvAny, data)
+	} else {
+		cached, timestamp, ok := a.cache.get(_geoLookupAlias, protocolTypes.IPvAny)
+		if !ok {
+			return nil, time.Time{}, err
+		}
+		data, cachedAt = cached, timestamp
+	}
 
-	if err := a.requestEx("", _geoLookupPath, "GET", "", nil, resp, timeoutMs, 0); err != nil {
-		return nil, err
+	resp := &types.GeoLookupResponse{}
+	if err := json.Unmarshal(data, resp); err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to deserialize API response: %w", err)
 	}
 
-	return resp, nil
+	return resp, cachedAt, nil
 }
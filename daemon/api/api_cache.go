@@ -0,0 +1,89 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package api
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	protocolTypes "github.com/ivpn/desktop-app/daemon/protocol/types"
+)
+
+type responseCacheKey struct {
+	alias  string
+	ipType protocolTypes.RequiredIPProtocol
+}
+
+type responseCacheEntry struct {
+	data      []byte
+	timestamp time.Time
+}
+
+// responseCache keeps the last successful responses of the cacheable API requests (see 'Alias.isCached').
+// The cached data is returned (as stale) when the API is not reachable.
+// The cache is in memory only: no location info is stored on disk.
+type responseCache struct {
+	mutex   sync.Mutex
+	entries map[responseCacheKey]responseCacheEntry
+}
+
+// save stores the response. Only valid JSON data is cached (error pages can not be a valid API response)
+func (c *responseCache) save(alias string, ipType protocolTypes.RequiredIPProtocol, data []byte) {
+	if !json.Valid(data) {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[responseCacheKey]responseCacheEntry)
+	}
+	c.entries[responseCacheKey{alias: alias, ipType: ipType}] = responseCacheEntry{data: append([]byte(nil), data...), timestamp: time.Now()}
+}
+
+// get returns the cached response and the time when it was received.
+// If there is no response for the required IP protocol and any protocol is acceptable (IPvAny) - the most recent response is returned.
+func (c *responseCache) get(alias string, ipType protocolTypes.RequiredIPProtocol) (data []byte, timestamp time.Time, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if e, exists := c.entries[responseCacheKey{alias: alias, ipType: ipType}]; exists {
+		return append([]byte(nil), e.data...), e.timestamp, true
+	}
+
+	if ipType != protocolTypes.IPvAny {
+		return nil, time.Time{}, false
+	}
+
+	for k, e := range c.entries {
+		if k.alias == alias && e.timestamp.After(timestamp) {
+			data, timestamp, ok = e.data, e.timestamp, true
+		}
+	}
+	if ok {
+		data = append([]byte(nil), data...)
+	}
+	return data, timestamp, ok
+}
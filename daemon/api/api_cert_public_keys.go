@@ -13,3 +13,8 @@ var UpdateIvpnHashes = []string{
 	"gaUpWQCarWURTpjKyaJQxqDAM72o5VZlfZDo3Z+rD18=",
 	"zyOrzSZfJFKg4w7z3/H9KR5bEnFDaXi6L1x3isu3F64=",
 	"mV/Je5ISwk8ryOI/V1HvGAIHVXhkAz5iDC7f+mIJYpI="}
+
+// ServersListSigningKeys - base64-encoded Ed25519 public keys of the servers list (servers.json) signature (see 'verifyServersListSignature()').
+// Any of the keys is accepted (key rotation).
+// When the list is empty - the signature is not verified (the IVPN API does not sign the servers list yet).
+var ServersListSigningKeys = []string{}
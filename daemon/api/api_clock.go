@@ -0,0 +1,200 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ClockSkewThreshold - the difference between the local time and the API server time which is considered as significant.
+// Large clock skew breaks the TLS certificate validation (API requests) and WireGuard handshakes on some servers.
+const ClockSkewThreshold = time.Minute * 2
+
+// IClockSkewNotifier receives notifications about detected clock skew
+type IClockSkewNotifier interface {
+	// OnClockSkewDetected is called when the local clock differs from the API server time more than ClockSkewThreshold
+	// 'offset' - API server time minus local time
+	OnClockSkewDetected(offset time.Duration)
+}
+
+// clockInfo - info about the difference between the local time and the API server time
+type clockInfo struct {
+	offset     time.Duration // API server time minus local time
+	measuredAt time.Time     // zero value - offset not measured yet
+	// when true: in case of large clock skew the API server time is in use for TLS certificate validation
+	isTimeHintAllowed bool
+	isSkewNotified    bool
+	notifier          IClockSkewNotifier
+}
+
+// SetClockSkewNotifier sets the receiver of notifications about the detected clock skew
+func (a *API) SetClockSkewNotifier(notifier IClockSkewNotifier) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.clock.notifier = notifier
+}
+
+// SetTimeHintAllowed enables/disables use of the API server time for TLS certificate validation
+// (applicable only when large clock skew detected)
+func (a *API) SetTimeHintAllowed(allowed bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.clock.isTimeHintAllowed = allowed
+}
+
+// ClockOffset returns the last measured difference between the API server time and the local time
+// ('isMeasured' is false when there were no successful API requests yet)
+func (a *API) ClockOffset() (offset time.Duration, measuredAt time.Time, isMeasured bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.clock.offset, a.clock.measuredAt, !a.clock.measuredAt.IsZero()
+}
+
+// IsClockSkewed returns true when the measured clock offset exceeds ClockSkewThreshold
+func IsClockSkewed(offset time.Duration) bool {
+	return offset > ClockSkewThreshold || offset < -ClockSkewThreshold
+}
+
+// tlsTimeFunc returns the function to be used as the current time for TLS certificate validation
+// (nil - use local time)
+func (a *API) tlsTimeFunc() func() time.Time {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if !a.clock.isTimeHintAllowed || a.clock.measuredAt.IsZero() || !IsClockSkewed(a.clock.offset) {
+		return nil
+	}
+	offset := a.clock.offset
+	return func() time.Time { return time.Now().Add(offset) }
+}
+
+// updateClockOffset measures the clock offset using the 'Date' header of the API server response.
+// 'requestStarted' - local time when the request was started.
+// Note: the response must be received over the connection authenticated by the pinned certificate key.
+func (a *API) updateClockOffset(resp *http.Response, requestStarted time.Time) {
+	if resp == nil {
+		return
+	}
+	serverTime, err := http.ParseTime(resp.Header.Get("Date"))
+	if err != nil {
+		return
+	}
+
+	// the server time corresponds (approximately) to the middle of the request
+	now := time.Now()
+	localTime := requestStarted.Add(now.Sub(requestStarted) / 2)
+	offset := serverTime.Sub(localTime).Round(time.Second)
+
+	a.mutex.Lock()
+	a.clock.offset = offset
+	a.clock.measuredAt = now
+	notifier := a.clock.notifier
+	isNotifyRequired := false
+	if !IsClockSkewed(offset) {
+		a.clock.isSkewNotified = false
+	} else if !a.clock.isSkewNotified {
+		a.clock.isSkewNotified = true
+		isNotifyRequired = true
+	}
+	a.mutex.Unlock()
+
+	if isNotifyRequired {
+		log.Warning(fmt.Sprintf("Clock skew detected: the local time differs from the API server time by %v", offset))
+		if notifier != nil {
+			go notifier.OnClockSkewDetected(offset)
+		}
+	}
+}
+
+// measureClockOffset requests the current time from the API server.
+// The TLS certificate validity period is not checked (it can fail because of wrong local time),
+// but the server is authenticated by the pinned certificate key.
+func (a *API) measureClockOffset() error {
+	if checker := a.connectivityChecker; checker != nil {
+		if err := checker.IsConnectivityBlocked(); err != nil {
+			return err
+		}
+	}
+
+	serverName, certHashes := _apiHost, APIIvpnHashes
+	urls := []string{getURL(_apiHost, "")}
+	if cfg := a.hostOverrideConfig(); cfg.IsEnabled() {
+		u, name, err := overrideHostURL(cfg, "")
+		if err != nil {
+			return err
+		}
+		serverName, certHashes = name, cfg.CertHashes
+		urls = []string{u}
+	} else {
+		for _, ip := range a.getAlternateIPs(false) {
+			urls = append(urls, getURL_IPHost(ip, false, ""))
+		}
+	}
+
+	transCfg := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ServerName: serverName,
+		},
+		// only pinned key verification
+		DialTLS: makeDialer(certHashes, true, serverName, _defaultDialTimeout, nil, a.proxyConfig()),
+	}
+	client := &http.Client{Transport: transCfg, Timeout: _defaultRequestTimeout}
+
+	var retErr error
+	for _, u := range urls {
+		req, err := newRequest(u, "HEAD", "", nil, nil)
+		if err != nil {
+			return err
+		}
+		started := time.Now()
+		resp, err := client.Do(req)
+		if err != nil {
+			retErr = err
+			continue
+		}
+		resp.Body.Close()
+		if len(resp.Header.Get("Date")) == 0 {
+			retErr = fmt.Errorf("no time info in the API server response")
+			continue
+		}
+		a.updateClockOffset(resp, started)
+		return nil
+	}
+	return fmt.Errorf("unable to get the API server time: %w", retErr)
+}
+
+// isCertificateTimeError returns true when the error caused by the certificate validity period check
+// (e.g. the local clock is wrong)
+func isCertificateTimeError(err error) bool {
+	var certErr x509.CertificateInvalidError
+	return errors.As(err, &certErr) && certErr.Reason == x509.Expired
+}
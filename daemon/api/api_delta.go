@@ -0,0 +1,218 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Delta updates (RFC 3229 "Delta encoding in HTTP"):
+// the client sends 'A-IM: json-patch' header together with 'If-None-Match: <ETag of the local copy>'.
+// If the server is able to produce the delta from the local copy, it responds '226 IM Used' with
+// 'IM: json-patch' header and the JSON Patch document (RFC 6902) as a body.
+// Otherwise, the full document is sent (or '304 Not Modified').
+const (
+	_deltaFormat       = "json-patch"
+	_statusDeltaIMUsed = 226
+)
+
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// applyJSONPatch applies the JSON Patch (RFC 6902) to the document.
+// Supported operations: "add", "remove", "replace" and "test".
+func applyJSONPatch(document []byte, patch []byte) ([]byte, error) {
+	var ops []jsonPatchOperation
+	if err := json.Unmarshal(patch, &ops); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON patch: %w", err)
+	}
+
+	doc, err := decodeJSON(document)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the document: %w", err)
+	}
+
+	for i, op := range ops {
+		switch op.Op {
+		case "add", "remove", "replace", "test":
+		default:
+			return nil, fmt.Errorf("JSON patch operation #%d: unsupported operation '%s'", i, op.Op)
+		}
+
+		var value interface{}
+		if op.Op != "remove" {
+			if len(op.Value) == 0 {
+				return nil, fmt.Errorf("JSON patch operation #%d: no value", i)
+			}
+			if value, err = decodeJSON(op.Value); err != nil {
+				return nil, fmt.Errorf("JSON patch operation #%d: %w", i, err)
+			}
+		}
+		if doc, err = applyJSONPatchOperation(doc, op.Op, op.Path, value); err != nil {
+			return nil, fmt.Errorf("JSON patch operation #%d (%s '%s'): %w", i, op.Op, op.Path, err)
+		}
+	}
+
+	return json.Marshal(doc)
+}
+
+func decodeJSON(data []byte) (interface{}, error) {
+	var v interface{}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber() // keep the numbers as is
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// parseJSONPointer splits the JSON Pointer (RFC 6901) into the reference tokens
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("bad JSON pointer")
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, t := range tokens {
+		tokens[i] = strings.ReplaceAll(strings.ReplaceAll(t, "~1", "/"), "~0", "~")
+	}
+	return tokens, nil
+}
+
+// applyJSONPatchOperation applies the operation to the node and returns the updated node
+func applyJSONPatchOperation(doc interface{}, op string, path string, value interface{}) (interface{}, error) {
+	tokens, err := parseJSONPointer(path)
+	if err != nil {
+		return nil, err
+	}
+	return applyToNode(doc, tokens, op, value)
+}
+
+func applyToNode(node interface{}, tokens []string, op string, value interface{}) (interface{}, error) {
+	if len(tokens) == 0 {
+		// the operation on the whole node
+		switch op {
+		case "add", "replace":
+			return value, nil
+		case "test":
+			if !jsonEqual(node, value) {
+				return nil, fmt.Errorf("test failed")
+			}
+			return node, nil
+		}
+		return nil, fmt.Errorf("unsupported operation")
+	}
+
+	token, isLast := tokens[0], len(tokens) == 1
+
+	switch n := node.(type) {
+	case map[string]interface{}:
+		child, exists := n[token]
+		if isLast {
+			switch op {
+			case "add":
+				n[token] = value
+				return n, nil
+			case "remove":
+				if !exists {
+					return nil, fmt.Errorf("path not found")
+				}
+				delete(n, token)
+				return n, nil
+			case "replace":
+				if !exists {
+					return nil, fmt.Errorf("path not found")
+				}
+				n[token] = value
+				return n, nil
+			}
+		}
+		if !exists {
+			return nil, fmt.Errorf("path not found")
+		}
+		updated, err := applyToNode(child, tokens[1:], op, value)
+		if err != nil {
+			return nil, err
+		}
+		n[token] = updated
+		return n, nil
+
+	case []interface{}:
+		if isLast && op == "add" && token == "-" {
+			return append(n, value), nil
+		}
+		idx, err := strconv.Atoi(token)
+		if err != nil || idx < 0 {
+			return nil, fmt.Errorf("bad array index")
+		}
+		if isLast {
+			switch op {
+			case "add":
+				if idx > len(n) {
+					return nil, fmt.Errorf("array index out of range")
+				}
+				n = append(n, nil)
+				copy(n[idx+1:], n[idx:])
+				n[idx] = value
+				return n, nil
+			case "remove":
+				if idx >= len(n) {
+					return nil, fmt.Errorf("array index out of range")
+				}
+				return append(n[:idx], n[idx+1:]...), nil
+			case "replace":
+				if idx >= len(n) {
+					return nil, fmt.Errorf("array index out of range")
+				}
+				n[idx] = value
+				return n, nil
+			}
+		}
+		if idx >= len(n) {
+			return nil, fmt.Errorf("array index out of range")
+		}
+		updated, err := applyToNode(n[idx], tokens[1:], op, value)
+		if err != nil {
+			return nil, err
+		}
+		n[idx] = updated
+		return n, nil
+	}
+
+	return nil, fmt.Errorf("path not found")
+}
+
+func jsonEqual(a, b interface{}) bool {
+	da, err1 := json.Marshal(a)
+	db, err2 := json.Marshal(b)
+	return err1 == nil && err2 == nil && bytes.Equal(da, db)
+}
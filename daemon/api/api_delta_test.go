@@ -0,0 +1,75 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package api
+
+import "testing"
+
+func TestApplyJSONPatch(t *testing.T) {
+	const doc = `{"a":{"b":1,"c":[1,2,3]},"x/y":"v","m~n":2.50}`
+
+	tests := []struct {
+		name    string
+		patch   string
+		want    string
+		wantErr bool
+	}{
+		{name: "empty patch", patch: `[]`, want: `{"a":{"b":1,"c":[1,2,3]},"m~n":2.50,"x/y":"v"}`},
+		{name: "add field", patch: `[{"op":"add","path":"/a/d","value":{"e":true}}]`, want: `{"a":{"b":1,"c":[1,2,3],"d":{"e":true}},"m~n":2.50,"x/y":"v"}`},
+		{name: "insert into array", patch: `[{"op":"add","path":"/a/c/1","value":9}]`, want: `{"a":{"b":1,"c":[1,9,2,3]},"m~n":2.50,"x/y":"v"}`},
+		{name: "append to array", patch: `[{"op":"add","path":"/a/c/-","value":4}]`, want: `{"a":{"b":1,"c":[1,2,3,4]},"m~n":2.50,"x/y":"v"}`},
+		{name: "remove field", patch: `[{"op":"remove","path":"/a/b"}]`, want: `{"a":{"c":[1,2,3]},"m~n":2.50,"x/y":"v"}`},
+		{name: "remove array item", patch: `[{"op":"remove","path":"/a/c/0"}]`, want: `{"a":{"b":1,"c":[2,3]},"m~n":2.50,"x/y":"v"}`},
+		{name: "replace", patch: `[{"op":"replace","path":"/a/b","value":"s"}]`, want: `{"a":{"b":"s","c":[1,2,3]},"m~n":2.50,"x/y":"v"}`},
+		{name: "replace document", patch: `[{"op":"replace","path":"","value":{"z":0}}]`, want: `{"z":0}`},
+		{name: "escaped pointer", patch: `[{"op":"replace","path":"/x~1y","value":"w"},{"op":"remove","path":"/m~0n"}]`, want: `{"a":{"b":1,"c":[1,2,3]},"x/y":"w"}`},
+		{name: "test and remove", patch: `[{"op":"test","path":"/a/c/2","value":3},{"op":"remove","path":"/a"}]`, want: `{"m~n":2.50,"x/y":"v"}`},
+		{name: "numbers are not reformatted", patch: `[{"op":"test","path":"/m~0n","value":2.50}]`, want: `{"a":{"b":1,"c":[1,2,3]},"m~n":2.50,"x/y":"v"}`},
+
+		{name: "test failed", patch: `[{"op":"test","path":"/a/b","value":2}]`, wantErr: true},
+		{name: "unsupported operation", patch: `[{"op":"move","path":"/a/b"}]`, wantErr: true},
+		{name: "no value", patch: `[{"op":"add","path":"/a/d"}]`, wantErr: true},
+		{name: "bad pointer", patch: `[{"op":"remove","path":"a/b"}]`, wantErr: true},
+		{name: "remove missing field", patch: `[{"op":"remove","path":"/a/z"}]`, wantErr: true},
+		{name: "replace missing field", patch: `[{"op":"replace","path":"/z","value":1}]`, wantErr: true},
+		{name: "missing parent", patch: `[{"op":"add","path":"/z/y","value":1}]`, wantErr: true},
+		{name: "array index out of range", patch: `[{"op":"replace","path":"/a/c/3","value":1}]`, wantErr: true},
+		{name: "negative array index", patch: `[{"op":"remove","path":"/a/c/-1"}]`, wantErr: true},
+		{name: "patch is not an array", patch: `{"op":"add"}`, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := applyJSONPatch([]byte(doc), []byte(tt.patch))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(got) != tt.want {
+				t.Errorf("got  %s\nwant %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := applyJSONPatch([]byte(`{"a":`), []byte(`[]`)); err == nil {
+		t.Errorf("expected error for malformed document")
+	}
+}
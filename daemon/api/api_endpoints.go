@@ -0,0 +1,153 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package api
+
+import (
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// an endpoint which failed is not preferred during the backoff period
+	// (the period is doubled for each consecutive failure, up to the maximum value)
+	_endpointBackoffMin = 30 * time.Second
+	_endpointBackoffMax = 30 * time.Minute
+	// weight of the latest latency value in the moving average
+	_endpointLatencyWeight = 0.3
+)
+
+// apiEndpoint - the API server address: the API hostname (resolved by DNS) or one of the alternate IPs
+type apiEndpoint struct {
+	ip net.IP // nil - use the API hostname
+}
+
+func (e apiEndpoint) String() string {
+	if e.ip == nil {
+		return _apiHost
+	}
+	return e.ip.String()
+}
+
+func (e apiEndpoint) url(isIPv6 bool, urlPath string) string {
+	if e.ip == nil {
+		return getURL(_apiHost, urlPath)
+	}
+	return getURL_IPHost(e.ip, isIPv6, urlPath)
+}
+
+// endpointHealth - results of the requests to the API endpoint
+type endpointHealth struct {
+	latency             time.Duration // moving average latency of the successful requests (0 - unknown)
+	consecutiveFailures int
+	lastFailure         time.Time
+}
+
+func (h endpointHealth) retryTime() time.Time {
+	if h.consecutiveFailures <= 0 {
+		return time.Time{}
+	}
+	backoff := _endpointBackoffMin
+	for i := 1; i < h.consecutiveFailures && backoff < _endpointBackoffMax; i++ {
+		backoff *= 2
+	}
+	if backoff > _endpointBackoffMax {
+		backoff = _endpointBackoffMax
+	}
+	return h.lastFailure.Add(backoff)
+}
+
+// endpointsHealth keeps the health info of the API endpoints and
+// defines the order in which the endpoints have to be used
+type endpointsHealth struct {
+	mutex  sync.Mutex
+	health map[string]endpointHealth
+}
+
+func (eh *endpointsHealth) onSuccess(e apiEndpoint, latency time.Duration) {
+	eh.mutex.Lock()
+	defer eh.mutex.Unlock()
+
+	if eh.health == nil {
+		eh.health = make(map[string]endpointHealth)
+	}
+	h := eh.health[e.String()]
+	if h.latency <= 0 {
+		h.latency = latency
+	} else {
+		h.latency = time.Duration(_endpointLatencyWeight*float64(latency) + (1-_endpointLatencyWeight)*float64(h.latency))
+	}
+	h.consecutiveFailures = 0
+	eh.health[e.String()] = h
+}
+
+func (eh *endpointsHealth) onFailure(e apiEndpoint) {
+	eh.mutex.Lock()
+	defer eh.mutex.Unlock()
+
+	if eh.health == nil {
+		eh.health = make(map[string]endpointHealth)
+	}
+	h := eh.health[e.String()]
+	h.consecutiveFailures++
+	h.lastFailure = time.Now()
+	eh.health[e.String()] = h
+}
+
+// sort orders the endpoints (the original order is kept for the endpoints with the same rank):
+//  1. healthy endpoints with known latency (the fastest first);
+//  2. endpoints which were not used yet or which backoff period is over;
+//  3. endpoints which failed recently (the earliest retry time first). They are still used, as the last resort.
+func (eh *endpointsHealth) sort(endpoints []apiEndpoint) []apiEndpoint {
+	eh.mutex.Lock()
+	defer eh.mutex.Unlock()
+
+	now := time.Now()
+	rank := func(h endpointHealth) int {
+		if h.consecutiveFailures == 0 && h.latency > 0 {
+			return 1
+		}
+		if h.consecutiveFailures == 0 || !now.Before(h.retryTime()) {
+			return 2
+		}
+		return 3
+	}
+
+	ret := append([]apiEndpoint(nil), endpoints...)
+	sort.SliceStable(ret, func(i, j int) bool {
+		hi, hj := eh.health[ret[i].String()], eh.health[ret[j].String()]
+		ri, rj := rank(hi), rank(hj)
+		if ri != rj {
+			return ri < rj
+		}
+		switch ri {
+		case 1:
+			return hi.latency < hj.latency
+		case 3:
+			return hi.retryTime().Before(hj.retryTime())
+		}
+		return false
+	})
+	return ret
+}
@@ -0,0 +1,149 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package api
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/api/types"
+)
+
+// SetHostOverride sets the alternative API server (e.g. staging or mirrored infrastructure).
+// Empty configuration - the default IVPN API server.
+//
+// NOTE: the alternative server is fully trusted. The server is authenticated by the pinned certificate keys only and,
+// while there are no servers list signing keys defined (see 'ServersListSigningKeys'), the servers list received from it
+// is not verified: the server defines the VPN servers (and their keys) the daemon connects to.
+func (a *API) SetHostOverride(cfg types.APIHostOverride) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if cfg.IsEnabled() && !cfg.Equals(a.hostOverride) {
+		if len(ServersListSigningKeys) == 0 {
+			log.Warning(fmt.Sprintf("Using alternative API server: %s (the servers list signature is not verified)", cfg.BaseURL))
+		} else {
+			log.Warning(fmt.Sprintf("Using alternative API server: %s", cfg.BaseURL))
+		}
+	}
+	a.hostOverride = cfg
+	return nil
+}
+
+func (a *API) hostOverrideConfig() types.APIHostOverride {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.hostOverride
+}
+
+// HostOverrideIPs returns the IP addresses of the alternative API server (nil - the default IVPN API server is in use).
+// The addresses have to be allowed by the firewall.
+func (a *API) HostOverrideIPs() ([]net.IP, error) {
+	cfg := a.hostOverrideConfig()
+	if !cfg.IsEnabled() {
+		return nil, nil
+	}
+	u, err := cfg.URL()
+	if err != nil {
+		return nil, err
+	}
+	if ip := net.ParseIP(u.Hostname()); ip != nil {
+		return []net.IP{ip}, nil
+	}
+	ips, err := net.LookupIP(u.Hostname())
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve alternative API server '%s': %w", u.Hostname(), err)
+	}
+	return ips, nil
+}
+
+// overrideHostURL returns the URL of the resource on the alternative API server
+func overrideHostURL(cfg types.APIHostOverride, urlPath string) (url string, serverName string, err error) {
+	u, err := cfg.URL()
+	if err != nil {
+		return "", "", err
+	}
+	return getURL(path.Join(u.Host, u.Path), urlPath), u.Hostname(), nil
+}
+
+// doRequestOverrideHost - request to the alternative API server (see SetHostOverride())
+func (a *API) doRequestOverrideHost(cfg types.APIHostOverride, urlPath string, method string, contentType string, headers http.Header, request interface{}, timeoutMs int, timeoutDialMs int) (resp *http.Response, err error) {
+	reqURL, serverName, err := overrideHostURL(cfg, urlPath)
+	if err != nil {
+		return nil, err
+	}
+
+	// timeout time for full request
+	timeout := _defaultRequestTimeout
+	if timeoutMs > 0 {
+		timeout = time.Millisecond * time.Duration(timeoutMs)
+	}
+	// timeout for the dial
+	timeoutDial := _defaultDialTimeout
+	if timeoutDialMs > 0 {
+		timeoutDial = time.Millisecond * time.Duration(timeoutDialMs)
+	}
+	if timeoutDial > timeout {
+		timeoutDial = 0
+	}
+
+	transCfg := &http.Transport{
+		TLSClientConfig: &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ServerName: serverName,
+		},
+		// the server is authenticated by the pinned certificate key only (CA verification skipped)
+		DialTLS: makeDialer(cfg.CertHashes, true, serverName, timeoutDial, a.tlsTimeFunc(), a.proxyConfig()),
+	}
+	client := &http.Client{Transport: transCfg, Timeout: timeout}
+
+	data := []byte{}
+	if request != nil {
+		data, err = json.Marshal(request)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, err := newRequest(reqURL, method, contentType, headers, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err = client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to access API server '%s': %w", cfg.BaseURL, err)
+	}
+	return resp, nil
+}
@@ -39,6 +39,8 @@ import (
 	"path"
 	"time"
 
+	apitypes "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/crashreport"
 	"github.com/ivpn/desktop-app/daemon/netinfo"
 	"github.com/ivpn/desktop-app/daemon/protocol/types"
 )
@@ -54,7 +56,7 @@ func getURL_IPHost(ip net.IP, isIPv6 bool, urlpath string) string {
 	return "https://" + path.Join(ip.String(), urlpath)
 }
 
-func newRequest(urlPath string, method string, contentType string, body io.Reader) (*http.Request, error) {
+func newRequest(urlPath string, method string, contentType string, headers http.Header, body io.Reader) (*http.Request, error) {
 	if len(method) == 0 {
 		method = "GET"
 	}
@@ -67,6 +69,11 @@ func newRequest(urlPath string, method string, contentType string, body io.Reade
 	if len(contentType) > 0 {
 		req.Header.Add("Content-type", contentType)
 	}
+	for k, values := range headers {
+		for _, v := range values {
+			req.Header.Add(k, v)
+		}
+	}
 
 	return req, nil
 }
@@ -82,7 +89,10 @@ func findPinnedKey(certHashes []string, certBase64hash256 string) bool {
 
 type dialer func(network, addr string) (net.Conn, error)
 
-func makeDialer(certHashes []string, skipCAVerification bool, serverName string, dialTimeout time.Duration) dialer {
+// makeDialer creates TLS dialer with certificate key pinning.
+// 'timeFunc' - (optional) the current time for certificate validation (nil - local time)
+// 'proxyCfg' - proxy server to connect through (not enabled - direct connection)
+func makeDialer(certHashes []string, skipCAVerification bool, serverName string, dialTimeout time.Duration, timeFunc func() time.Time, proxyCfg apitypes.ProxyConfig) dialer {
 	if len(certHashes) == 0 {
 		log.Warning("No pinned certificates for ", _apiHost)
 		return nil
@@ -91,10 +101,7 @@ func makeDialer(certHashes []string, skipCAVerification bool, serverName string,
 	return func(network, addr string) (net.Conn, error) {
 		defer func() {
 			if r := recover(); r != nil {
-				log.Error("PANIC (API request): ", r)
-				if err, ok := r.(error); ok {
-					log.ErrorTrace(err)
-				}
+				crashreport.Save("API request", r)
 			}
 		}()
 
@@ -105,12 +112,30 @@ func makeDialer(certHashes []string, skipCAVerification bool, serverName string,
 			MinVersion:         tls.VersionTLS12,
 			InsecureSkipVerify: skipCAVerification,
 			ServerName:         serverName, // only have sense when skipCAVerification == false
+			Time:               timeFunc,
 		}
 
-		c, err := tls.DialWithDialer(&net.Dialer{Timeout: dialTimeout}, network, addr, tlsConfig)
-
-		if err != nil {
-			return c, err
+		var c *tls.Conn
+		if proxyCfg.IsEnabled() {
+			rawConn, err := dialProxy(proxyCfg, network, addr, dialTimeout)
+			if err != nil {
+				return nil, err
+			}
+			c = tls.Client(rawConn, tlsConfig)
+			if dialTimeout > 0 {
+				c.SetDeadline(time.Now().Add(dialTimeout))
+			}
+			if err := c.Handshake(); err != nil {
+				rawConn.Close()
+				return nil, err
+			}
+			c.SetDeadline(time.Time{})
+		} else {
+			var err error
+			c, err = tls.DialWithDialer(&net.Dialer{Timeout: dialTimeout}, network, addr, tlsConfig)
+			if err != nil {
+				return c, err
+			}
 		}
 		connstate := c.ConnectionState()
 		var lastErr error = nil
@@ -140,7 +165,7 @@ func makeDialer(certHashes []string, skipCAVerification bool, serverName string,
 	}
 }
 
-func (a *API) doRequest(ipTypeRequired types.RequiredIPProtocol, host string, urlPath string, method string, contentType string, request interface{}, timeoutMs int, timeoutDialMs int) (resp *http.Response, err error) {
+func (a *API) doRequest(ipTypeRequired types.RequiredIPProtocol, host string, urlPath string, method string, contentType string, headers http.Header, request interface{}, timeoutMs int, timeoutDialMs int) (resp *http.Response, err error) {
 	connectivityChecker := a.connectivityChecker
 	if connectivityChecker != nil {
 		if err := connectivityChecker.IsConnectivityBlocked(); err != nil {
@@ -149,14 +174,18 @@ func (a *API) doRequest(ipTypeRequired types.RequiredIPProtocol, host string, ur
 	}
 
 	if len(host) == 0 || host == _apiHost {
+		if cfg := a.hostOverrideConfig(); cfg.IsEnabled() {
+			return a.doRequestOverrideHost(cfg, urlPath, method, contentType, headers, request, timeoutMs, timeoutDialMs)
+		}
+
 		if ipTypeRequired != types.IPvAny {
 			// The specific IP version required to use
-			return a.doRequestAPIHost(ipTypeRequired, false, urlPath, method, contentType, request, timeoutMs, timeoutDialMs)
+			return a.doRequestAPIHost(ipTypeRequired, false, urlPath, method, contentType, headers, request, timeoutMs, timeoutDialMs)
 		} else {
 			// No specific IP version required to use
 			// Trying first to use IPv4, as fallback - try to use IPv6
 			canUseDNS := true
-			resp4, err4 := a.doRequestAPIHost(types.IPv4, canUseDNS, urlPath, method, contentType, request, timeoutMs, timeoutDialMs)
+			resp4, err4 := a.doRequestAPIHost(types.IPv4, canUseDNS, urlPath, method, contentType, headers, request, timeoutMs, timeoutDialMs)
 			if err4 != nil {
 				// checking if IPv6 connectivity exists
 				_, errIPv6 := netinfo.GetOutboundIP(true)
@@ -176,7 +205,7 @@ func (a *API) doRequest(ipTypeRequired types.RequiredIPProtocol, host string, ur
 					log.Info("Failed to access API server using IPv4. Trying IPv6 ...")
 					// we already tried to access using DNS. No sense to try it again
 					canUseDNS = false
-					resp6, err6 := a.doRequestAPIHost(types.IPv6, canUseDNS, urlPath, method, contentType, request, timeoutMs, timeoutDialMs)
+					resp6, err6 := a.doRequestAPIHost(types.IPv6, canUseDNS, urlPath, method, contentType, headers, request, timeoutMs, timeoutDialMs)
 					if err6 == nil {
 						return resp6, err6
 					}
@@ -186,12 +215,12 @@ func (a *API) doRequest(ipTypeRequired types.RequiredIPProtocol, host string, ur
 		}
 
 	} else if host == _updateHost {
-		return a.doRequestUpdateHost(urlPath, method, contentType, request, timeoutMs)
+		return a.doRequestUpdateHost(urlPath, method, contentType, headers, request, timeoutMs)
 	}
 	return nil, fmt.Errorf("unknown host type")
 }
 
-func (a *API) doRequestUpdateHost(urlPath string, method string, contentType string, request interface{}, timeoutMs int) (resp *http.Response, err error) {
+func (a *API) doRequestUpdateHost(urlPath string, method string, contentType string, headers http.Header, request interface{}, timeoutMs int) (resp *http.Response, err error) {
 	transCfg := &http.Transport{
 		TLSClientConfig: &tls.Config{
 			MinVersion: tls.VersionTLS12, // seems, it is redundant (since we use custom DialTLS)
@@ -199,7 +228,7 @@ func (a *API) doRequestUpdateHost(urlPath string, method string, contentType str
 		},
 
 		// using certificate key pinning
-		DialTLS: makeDialer(UpdateIvpnHashes, false, _updateHost, 0),
+		DialTLS: makeDialer(UpdateIvpnHashes, false, _updateHost, 0, a.tlsTimeFunc(), a.proxyConfig()),
 	}
 
 	// configure http-client with preconfigured TLS transport
@@ -220,7 +249,7 @@ func (a *API) doRequestUpdateHost(urlPath string, method string, contentType str
 	bodyBuffer := bytes.NewBuffer(data)
 
 	// try to access API server by host DNS
-	req, err := newRequest(getURL(_updateHost, urlPath), method, contentType, bodyBuffer)
+	req, err := newRequest(getURL(_updateHost, urlPath), method, contentType, headers, bodyBuffer)
 	if err != nil {
 		return nil, err
 	}
@@ -234,7 +263,7 @@ func (a *API) doRequestUpdateHost(urlPath string, method string, contentType str
 	return resp, nil
 }
 
-func (a *API) doRequestAPIHost(ipTypeRequired types.RequiredIPProtocol, isCanUseDNS bool, urlPath string, method string, contentType string, request interface{}, timeoutMs int, timeoutDialMs int) (resp *http.Response, err error) {
+func (a *API) doRequestAPIHost(ipTypeRequired types.RequiredIPProtocol, isCanUseDNS bool, urlPath string, method string, contentType string, headers http.Header, request interface{}, timeoutMs int, timeoutDialMs int) (resp *http.Response, err error) {
 	isIPv6 := ipTypeRequired == types.IPv6
 
 	// timeout time for full request
@@ -261,7 +290,7 @@ func (a *API) doRequestAPIHost(ipTypeRequired types.RequiredIPProtocol, isCanUse
 		},
 
 		// using certificate key pinning
-		DialTLS: makeDialer(APIIvpnHashes, false, _apiHost, timeoutDial),
+		DialTLS: makeDialer(APIIvpnHashes, false, _apiHost, timeoutDial, a.tlsTimeFunc(), a.proxyConfig()),
 	}
 
 	// configure http-client with preconfigured TLS transport
@@ -275,90 +304,98 @@ func (a *API) doRequestAPIHost(ipTypeRequired types.RequiredIPProtocol, isCanUse
 		}
 	}
 
-	bodyBuffer := bytes.NewBuffer(data)
-
-	// access API by last good IP (if defined)
+	// endpoints to try: the API hostname (if DNS can be used) and the alternate IPs; the healthiest first
 	lastGoodIP := a.GetLastGoodAlternateIP(isIPv6)
+	endpoints := make([]apiEndpoint, 0)
 	if lastGoodIP != nil {
-		req, err := newRequest(getURL_IPHost(lastGoodIP, isIPv6, urlPath), method, contentType, bodyBuffer)
-		if err != nil {
-			return nil, err
-		}
-
-		resp, err := client.Do(req)
-		if err == nil {
-			return resp, nil
-		}
+		endpoints = append(endpoints, apiEndpoint{ip: lastGoodIP})
 	}
-
-	// try to access API server by host DNS
-	var firstResp *http.Response
-	var firstErr error
 	if isCanUseDNS {
-		req, err := newRequest(getURL(_apiHost, urlPath), method, contentType, bodyBuffer)
-		if err != nil {
-			return nil, err
-		}
-		firstResp, firstErr = client.Do(req)
-		if firstErr == nil {
-			return firstResp, firstErr
+		endpoints = append(endpoints, apiEndpoint{})
+	}
+	for _, ip := range a.getAlternateIPs(isIPv6) {
+		if !ip.Equal(lastGoodIP) {
+			endpoints = append(endpoints, apiEndpoint{ip: ip})
 		}
-		log.Warning("Failed to access " + _apiHost)
 	}
+	endpoints = a.endpointsHealth.sort(endpoints)
 
-	isLogNotificationPrinted := false
-
-	// try to access API server by alternate IP
-	ips := a.getAlternateIPs(isIPv6)
-	for _, ip := range ips {
-		if ip.Equal(lastGoodIP) {
-			continue
-		}
-		if firstErr != nil && !isLogNotificationPrinted {
-			isLogNotificationPrinted = true
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("unable to access IVPN API server: no API endpoints available")
+	}
 
-			ipVerStr := ""
-			if ipTypeRequired == types.IPv6 {
-				ipVerStr = "(IPv6)"
-			}
-			log.Info(fmt.Sprintf("Trying to use alternate API IPs %s...", ipVerStr))
+	var firstErr error
+	for _, e := range endpoints {
+		if firstErr != nil {
+			log.Info(fmt.Sprintf("Trying to use API endpoint %s ...", e))
 		}
 
-		req, err := newRequest(getURL_IPHost(ip, isIPv6, urlPath), method, contentType, bodyBuffer)
+		req, err := newRequest(e.url(isIPv6, urlPath), method, contentType, headers, bytes.NewReader(data))
 		if err != nil {
 			return nil, err
 		}
-		resp, err := client.Do(req)
 
+		started := time.Now()
+		resp, err := client.Do(req)
 		if err != nil {
+			a.endpointsHealth.onFailure(e)
+			log.Warning(fmt.Sprintf("Failed to access API endpoint %s", e))
 			if firstErr == nil {
 				firstErr = err
 			}
 			continue
 		}
+		a.endpointsHealth.onSuccess(e, time.Since(started))
 
-		// save last good IP
-		a.SetLastGoodAlternateIP(isIPv6, ip)
-
-		log.Info("Success!")
-		return resp, err
+		if e.ip != nil {
+			// save last good IP
+			a.SetLastGoodAlternateIP(isIPv6, e.ip)
+		}
+		if firstErr != nil {
+			log.Info("Success!")
+		}
+		return resp, nil
 	}
 
 	return nil, fmt.Errorf("unable to access IVPN API server: %w", firstErr)
 }
 
 func (a *API) requestRaw(ipTypeRequired types.RequiredIPProtocol, host string, urlPath string, method string, contentType string, requestObject interface{}, timeoutMs int, timeoutDialMs int) (responseData []byte, err error) {
-	resp, err := a.doRequest(ipTypeRequired, host, urlPath, method, contentType, requestObject, timeoutMs, timeoutDialMs)
+	body, _, err := a.requestRawEx(ipTypeRequired, host, urlPath, method, contentType, nil, requestObject, timeoutMs, timeoutDialMs)
+	return body, err
+}
+
+// requestRawEx - same as requestRaw(), but allows to define additional request headers.
+// Returns the response body and the response object (its body is already read and closed)
+func (a *API) requestRawEx(ipTypeRequired types.RequiredIPProtocol, host string, urlPath string, method string, contentType string, headers http.Header, requestObject interface{}, timeoutMs int, timeoutDialMs int) (responseData []byte, response *http.Response, err error) {
+	started := time.Now()
+	resp, err := a.doRequest(ipTypeRequired, host, urlPath, method, contentType, headers, requestObject, timeoutMs, timeoutDialMs)
+	if err != nil && isCertificateTimeError(err) {
+		log.Warning("Certificate validity period check failed (probably, the local time is wrong)")
+		if a.tlsTimeFunc() == nil {
+			// measure the clock offset: if the time hint is allowed - it will be used for the next requests
+			if e := a.measureClockOffset(); e != nil {
+				log.Warning(e)
+			} else if a.tlsTimeFunc() != nil {
+				log.Info("Retrying the request using the API server time for certificate validation...")
+				started = time.Now()
+				resp, err = a.doRequest(ipTypeRequired, host, urlPath, method, contentType, headers, requestObject, timeoutMs, timeoutDialMs)
+			}
+		}
+	}
+	a.updateRequestsStats(err != nil)
 	if err != nil {
-		return nil, fmt.Errorf("API request failed: %w", err)
+		return nil, nil, fmt.Errorf("API request failed: %w", err)
 	}
+	defer resp.Body.Close()
+	a.updateClockOffset(resp, started)
 
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get API HTTP response body: %w", err)
+		return nil, nil, fmt.Errorf("failed to get API HTTP response body: %w", err)
 	}
 
-	return body, nil
+	return body, resp, nil
 }
 
 func (a *API) request(host string, urlPath string, method string, contentType string, requestObject interface{}, responseObject interface{}) error {
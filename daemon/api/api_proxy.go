@@ -0,0 +1,121 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package api
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/api/types"
+	"golang.org/x/net/proxy"
+)
+
+// SetProxy sets the proxy server for API requests (empty configuration - direct connection)
+func (a *API) SetProxy(cfg types.ProxyConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.proxy = cfg
+	return nil
+}
+
+func (a *API) proxyConfig() types.ProxyConfig {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return a.proxy
+}
+
+// dialProxy establishes TCP connection to 'addr' through the proxy server.
+// The TLS session is established by the caller over the returned connection
+// (so the certificate key pinning is still applied).
+func dialProxy(cfg types.ProxyConfig, network, addr string, timeout time.Duration) (net.Conn, error) {
+	dialer := &net.Dialer{Timeout: timeout}
+
+	switch cfg.Type {
+	case types.ProxySOCKS5:
+		var auth *proxy.Auth
+		if len(cfg.Username) > 0 {
+			auth = &proxy.Auth{User: cfg.Username, Password: cfg.Password}
+		}
+		socksDialer, err := proxy.SOCKS5("tcp", cfg.Address, auth, dialer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialize SOCKS5 proxy dialer: %w", err)
+		}
+		conn, err := socksDialer.Dial(network, addr)
+		if err != nil {
+			return nil, fmt.Errorf("SOCKS5 proxy error: %w", err)
+		}
+		return conn, nil
+
+	case types.ProxyHTTP:
+		conn, err := dialer.Dial("tcp", cfg.Address)
+		if err != nil {
+			return nil, fmt.Errorf("unable to connect to HTTP proxy: %w", err)
+		}
+		if timeout > 0 {
+			conn.SetDeadline(time.Now().Add(timeout))
+		}
+
+		req, err := http.NewRequest(http.MethodConnect, "http://"+addr, nil)
+		if err != nil {
+			conn.Close()
+			return nil, err
+		}
+		req.Host = addr
+		if len(cfg.Username) > 0 {
+			credentials := base64.StdEncoding.EncodeToString([]byte(cfg.Username + ":" + cfg.Password))
+			req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+		}
+		if err := req.Write(conn); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("HTTP proxy error: %w", err)
+		}
+
+		// NOTE: the server does not send any data after the response to CONNECT request (until the client starts TLS handshake),
+		// so there is no risk to lose the buffered data
+		resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+		if err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("HTTP proxy error: %w", err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			conn.Close()
+			return nil, fmt.Errorf("HTTP proxy error: %s", resp.Status)
+		}
+
+		conn.SetDeadline(time.Time{})
+		return conn, nil
+	}
+
+	return nil, fmt.Errorf("unsupported proxy type '%s'", cfg.Type)
+}
@@ -0,0 +1,90 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package api
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// _serversSignatureHeader - HTTP response header which contains the base64-encoded Ed25519 signature of the servers list.
+// The signature is calculated over the canonical form of the full servers list document (see 'canonicalJSON()'),
+// so the documents obtained by delta updates can be verified as well.
+// NOTE: the header is not provided by the IVPN API yet (the name has to be confirmed when the backend implements the signing).
+const _serversSignatureHeader = "X-Signature-Ed25519"
+
+// verifyServersListSignature checks the signature of the servers list document.
+// Returns error when the signature is missing or is not valid.
+// The verification is skipped when there are no signing keys defined (see 'ServersListSigningKeys').
+func verifyServersListSignature(data []byte, signatureBase64 string) error {
+	if len(ServersListSigningKeys) == 0 {
+		return nil
+	}
+
+	if len(signatureBase64) == 0 {
+		return fmt.Errorf("servers list signature verification failed: the servers list is not signed")
+	}
+	signature, err := base64.StdEncoding.DecodeString(signatureBase64)
+	if err != nil || len(signature) != ed25519.SignatureSize {
+		return fmt.Errorf("servers list signature verification failed: bad signature format")
+	}
+
+	canonical, err := canonicalJSON(data)
+	if err != nil {
+		return fmt.Errorf("servers list signature verification failed: %w", err)
+	}
+
+	for _, k := range ServersListSigningKeys {
+		key, err := base64.StdEncoding.DecodeString(k)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			log.Error("Bad servers list signing key: ", k)
+			continue
+		}
+		if ed25519.Verify(ed25519.PublicKey(key), canonical, signature) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("servers list signature verification failed: bad signature")
+}
+
+// canonicalJSON re-encodes the JSON document into the canonical form:
+// no insignificant whitespace, object keys are sorted, numbers are kept as is,
+// the strings are not HTML-escaped ('<', '>' and '&' are kept as is).
+func canonicalJSON(data []byte) ([]byte, error) {
+	doc, err := decodeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
@@ -0,0 +1,81 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package api
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+)
+
+func TestVerifyServersListSignature(t *testing.T) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keysBackup := ServersListSigningKeys
+	defer func() { ServersListSigningKeys = keysBackup }()
+	ServersListSigningKeys = []string{base64.StdEncoding.EncodeToString(pub)}
+
+	// the signature is calculated over the canonical form of the document
+	signed := []byte(`{"config":{"api":{"ips":["1.2.3.4"]}},"wireguard":[{"gateway":"a<b>&c","port":2049}]}`)
+	signature := base64.StdEncoding.EncodeToString(ed25519.Sign(priv, signed))
+
+	tests := []struct {
+		name      string
+		data      string
+		signature string
+		isValid   bool
+	}{
+		{"valid", string(signed), signature, true},
+		{"valid (another formatting and keys order)", `{ "wireguard": [ {"port": 2049, "gateway": "a<b>&c"} ],
+			"config": {"api": {"ips": ["1.2.3.4"]}} }`, signature, true},
+		{"tampered value", `{"config":{"api":{"ips":["6.6.6.6"]}},"wireguard":[{"gateway":"a<b>&c","port":2049}]}`, signature, false},
+		{"tampered number", `{"config":{"api":{"ips":["1.2.3.4"]}},"wireguard":[{"gateway":"a<b>&c","port":2050}]}`, signature, false},
+		{"added field", `{"config":{"api":{"ips":["1.2.3.4"]}},"wireguard":[{"gateway":"a<b>&c","port":2049}],"x":1}`, signature, false},
+		{"not signed", string(signed), "", false},
+		{"bad signature format", string(signed), "not-a-signature", false},
+		{"bad JSON", `{"config":`, signature, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyServersListSignature([]byte(tt.data), tt.signature)
+			if tt.isValid && err != nil {
+				t.Errorf("expected valid signature; got error: %v", err)
+			}
+			if !tt.isValid && err == nil {
+				t.Errorf("expected signature verification error")
+			}
+		})
+	}
+
+	t.Run("no signing keys", func(t *testing.T) {
+		ServersListSigningKeys = []string{}
+		if err := verifyServersListSignature(signed, ""); err != nil {
+			t.Errorf("expected verification to be skipped when no signing keys defined; got error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,96 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package types
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// APIHostOverride - alternative API server for the daemon API requests
+// (e.g. staging or mirrored infrastructure) instead of the default IVPN API server
+type APIHostOverride struct {
+	// base URL of the API server in format "https://HOST[:PORT][/PATH]" (empty - the default IVPN API server)
+	BaseURL string
+	// base64-encoded SHA256 hashes of the server certificate public keys (certificate key pinning).
+	// At least one hash is required: the server is authenticated by the pinned key only
+	// (so staging servers with self-signed or private CA certificates can be used)
+	CertHashes []string
+}
+
+// IsEnabled returns 'true' when the alternative API server is in use
+func (o APIHostOverride) IsEnabled() bool {
+	return len(o.BaseURL) > 0
+}
+
+// Equals returns 'true' when configurations are the same
+func (o APIHostOverride) Equals(b APIHostOverride) bool {
+	if o.BaseURL != b.BaseURL || len(o.CertHashes) != len(b.CertHashes) {
+		return false
+	}
+	for i := range o.CertHashes {
+		if o.CertHashes[i] != b.CertHashes[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// URL returns parsed base URL
+func (o APIHostOverride) URL() (*url.URL, error) {
+	u, err := url.Parse(o.BaseURL)
+	if err != nil || len(u.Host) == 0 {
+		return nil, fmt.Errorf("bad API base URL '%s' (expected format: https://HOST[:PORT][/PATH])", o.BaseURL)
+	}
+	if !strings.EqualFold(u.Scheme, "https") {
+		return nil, fmt.Errorf("bad API base URL '%s' (only 'https' is supported)", o.BaseURL)
+	}
+	if len(u.RawQuery) > 0 || len(u.Fragment) > 0 || u.User != nil {
+		return nil, fmt.Errorf("bad API base URL '%s' (user info, query and fragment are not allowed)", o.BaseURL)
+	}
+	return u, nil
+}
+
+// Validate checks the configuration consistency
+func (o APIHostOverride) Validate() error {
+	if !o.IsEnabled() {
+		if len(o.CertHashes) > 0 {
+			return fmt.Errorf("API base URL not defined")
+		}
+		return nil
+	}
+	if _, err := o.URL(); err != nil {
+		return err
+	}
+	if len(o.CertHashes) == 0 {
+		return fmt.Errorf("no pinned certificate key hashes defined for the API server")
+	}
+	for _, h := range o.CertHashes {
+		if hash, err := base64.StdEncoding.DecodeString(h); err != nil || len(hash) != 32 {
+			return fmt.Errorf("bad certificate key hash '%s' (expected base64-encoded SHA256 hash)", h)
+		}
+	}
+	return nil
+}
@@ -22,7 +22,10 @@
 
 package types
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
 
 const (
 	// CodeSuccess - success
@@ -43,6 +46,7 @@ const (
 	// AccountNotActive - account should be purchased
 	AccountNotActive int = 702
 
+	// Too many login attempts. Captcha required (CaptchaRequired) or specified captcha is not valid (CaptchaInvalid)
 	CaptchaRequired int = 70001
 	CaptchaInvalid  int = 70002
 
@@ -68,3 +72,10 @@ func CreateAPIError(errorCode int, message string) APIError {
 func (e APIError) Error() string {
 	return fmt.Sprintf("API error: [%d] %s", e.ErrorCode, e.Message)
 }
+
+// IsSessionNotFound returns true when the error is the API error 'Session not found'
+// (the session does not exist on the backend anymore: e.g. expired or logged out from another device)
+func IsSessionNotFound(err error) bool {
+	var e APIError
+	return errors.As(err, &e) && e.ErrorCode == SessionNotFound
+}
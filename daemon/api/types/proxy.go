@@ -0,0 +1,79 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package types
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Supported types of the proxy for API requests
+const (
+	ProxyHTTP   = "http"
+	ProxySOCKS5 = "socks5"
+)
+
+// ProxyConfig - proxy server for the daemon API requests
+// (for networks where direct access to the API server is blocked, e.g. corporate networks)
+type ProxyConfig struct {
+	Type     string // ProxyHTTP or ProxySOCKS5 (empty - direct connection)
+	Address  string // proxy server address in format "host:port"
+	Username string // (optional) proxy authentication
+	Password string
+}
+
+// IsEnabled returns 'true' when the proxy is in use
+func (p ProxyConfig) IsEnabled() bool {
+	return len(p.Type) > 0
+}
+
+// Equals returns 'true' when configurations are the same
+func (p ProxyConfig) Equals(b ProxyConfig) bool {
+	return p == b
+}
+
+// Validate checks the proxy configuration consistency
+func (p ProxyConfig) Validate() error {
+	if !p.IsEnabled() {
+		return nil
+	}
+	if p.Type != ProxyHTTP && p.Type != ProxySOCKS5 {
+		return fmt.Errorf("unsupported proxy type '%s' (supported: '%s', '%s')", p.Type, ProxyHTTP, ProxySOCKS5)
+	}
+	host, portStr, err := net.SplitHostPort(p.Address)
+	if err != nil || len(host) == 0 {
+		return fmt.Errorf("bad proxy address '%s' (expected format HOST:PORT)", p.Address)
+	}
+	if port, err := strconv.Atoi(portStr); err != nil || port <= 0 || port > 65535 {
+		return fmt.Errorf("bad proxy port '%s'", portStr)
+	}
+	if len(p.Password) > 0 && len(p.Username) == 0 {
+		return fmt.Errorf("proxy username not defined")
+	}
+	if strings.ContainsAny(p.Username+p.Password, "\r\n") {
+		return fmt.Errorf("proxy credentials must not contain line breaks")
+	}
+	return nil
+}
@@ -38,6 +38,20 @@ type SessionDeleteRequest struct {
 	Session string `json:"session_token"`
 }
 
+// DevicesRequest request to get the devices (active sessions) of the account.
+// Authentication: by the session token or (if the session is empty) by the account ID and 2FA token (if enabled for the account)
+type DevicesRequest struct {
+	Session         string `json:"session_token,omitempty"`
+	AccountID       string `json:"username,omitempty"`
+	Confirmation2FA string `json:"confirmation,omitempty"`
+}
+
+// DeviceDeleteRequest request to log out the device (delete the session) of the account
+type DeviceDeleteRequest struct {
+	DevicesRequest
+	DeviceID string `json:"device_id"`
+}
+
 // SessionStatusRequest request to get session status
 type SessionStatusRequest struct {
 	Session string `json:"session_token"`
@@ -48,4 +62,13 @@ type SessionWireGuardKeySetRequest struct {
 	Session            string `json:"session_token"`
 	PublicKey          string `json:"public_key"`
 	ConnectedPublicKey string `json:"connected_public_key"`
+	// Post-quantum KEM public key (optional): the server returns the cipher text of the secret for the WireGuard preshared key
+	KemPublicKey1   string `json:"kem_public_key1,omitempty"`
+	KemLibraryName1 string `json:"kem_library_name1,omitempty"`
+}
+
+// PortForwardingRequest request to get (renew) or release forwarded port
+type PortForwardingRequest struct {
+	Session string `json:"session_token"`
+	Port    int    `json:"port,omitempty"` // 0 - request new port
 }
@@ -74,10 +74,40 @@ type SessionNewErrorLimitResponse struct {
 	SessionLimitData ServiceStatusAPIResp `json:"data"`
 }
 
+// CaptchaInfo - the captcha to be solved by the user (login requires captcha)
+type CaptchaInfo struct {
+	CaptchaID    string `json:"captcha_id"`
+	CaptchaImage string `json:"captcha_image"` // base64-encoded image (can be in 'data URL' format)
+}
+
+// SessionNewErrorCaptchaResponse information about 'captcha required' (or 'captcha invalid') error
+type SessionNewErrorCaptchaResponse struct {
+	APIErrorResponse
+	CaptchaInfo
+}
+
 // SessionsWireGuardResponse Sessions WireGuard response
 type SessionsWireGuardResponse struct {
 	APIErrorResponse
 	IPAddress string `json:"ip_address,omitempty"`
+	// Post-quantum KEM cipher text (the response to 'kem_public_key1')
+	KemCipher1 string `json:"kem_cipher1,omitempty"`
+}
+
+// DeviceInfo - device (active session) of the account
+type DeviceInfo struct {
+	ID        string `json:"id"`
+	Name      string `json:"device_name"`
+	Platform  string `json:"platform"`
+	CreatedAt int64  `json:"created_at"` // Unix time
+	LastSeen  int64  `json:"last_seen"`  // Unix time
+	IsCurrent bool   `json:"is_current"` // the session of the requestor (only when authenticated by the session token)
+}
+
+// DevicesResponse - devices (active sessions) of the account
+type DevicesResponse struct {
+	APIErrorResponse
+	Devices []DeviceInfo `json:"devices"`
 }
 
 // SessionStatusResponse session status response
@@ -100,3 +130,10 @@ type GeoLookupResponse struct {
 
 	//isIvpnServer bool
 }
+
+// PortForwardingResponse - forwarded port info
+type PortForwardingResponse struct {
+	APIErrorResponse
+	Port      int   `json:"port"`
+	ExpiresIn int64 `json:"expires_in"` // seconds
+}
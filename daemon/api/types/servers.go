@@ -35,6 +35,8 @@ type HostInfoBase struct {
 	DnsName      string  `json:"dns_name"`
 	MultihopPort int     `json:"multihop_port"`
 	Load         float32 `json:"load"`
+	// IP address of the V2Ray server (used when the connection is wrapped by V2Ray)
+	V2RayHost string `json:"v2ray"`
 }
 
 func (h HostInfoBase) GetHostInfoBase() HostInfoBase {
@@ -78,6 +80,11 @@ type WireGuardServerInfo struct {
 	Hosts []WireGuardServerHostInfo `json:"hosts"`
 }
 
+// GetIPv6Host returns the public IPv6 address of the host (empty if not supported)
+func (h WireGuardServerHostInfo) GetIPv6Host() string {
+	return h.IPv6.Host
+}
+
 func (s WireGuardServerInfo) GetHostsInfoBase() []HostInfoBase {
 	ret := []HostInfoBase{}
 	for _, host := range s.Hosts {
@@ -94,10 +101,15 @@ type ObfsParams struct {
 	Obfs4Key          string `json:"obfs4_key"`
 }
 
+type OpenVPNServerHostInfoIPv6 struct {
+	Host string `json:"host"`
+}
+
 // OpenVPNServerHostInfo contains info about OpenVPN server host
 type OpenVPNServerHostInfo struct {
 	HostInfoBase
-	Obfs ObfsParams `json:"obfs"`
+	Obfs ObfsParams                `json:"obfs"`
+	IPv6 OpenVPNServerHostInfoIPv6 `json:"ipv6"`
 }
 
 // OpenvpnServerInfo contains all info about OpenVPN server
@@ -106,6 +118,11 @@ type OpenvpnServerInfo struct {
 	Hosts []OpenVPNServerHostInfo `json:"hosts"`
 }
 
+// GetIPv6Host returns the public IPv6 address of the host (empty if not supported)
+func (h OpenVPNServerHostInfo) GetIPv6Host() string {
+	return h.IPv6.Host
+}
+
 func (s OpenvpnServerInfo) GetHostsInfoBase() []HostInfoBase {
 	ret := []HostInfoBase{}
 	for _, host := range s.Hosts {
@@ -171,6 +188,13 @@ type PortsInfo struct {
 	WireGuard []PortInfo   `json:"wireguard"`
 	Obfs3     ObfsPortInfo `json:"obfs3"`
 	Obfs4     ObfsPortInfo `json:"obfs4"`
+	V2Ray     []PortInfo   `json:"v2ray"`
+	Udp2Tcp   []PortInfo   `json:"udp2tcp"` // UDP-over-TCP servers (WireGuard over TCP)
+}
+
+// V2RayInfo contains the V2Ray servers configuration
+type V2RayInfo struct {
+	ID string `json:"id"` // user ID for VMess/VLESS protocols
 }
 
 // ConfigInfo contains different configuration info (Antitracker, API ...)
@@ -178,6 +202,7 @@ type ConfigInfo struct {
 	Antitracker AntitrackerInfo `json:"antitracker"`
 	API         InfoAPI         `json:"api"`
 	Ports       PortsInfo       `json:"ports"`
+	V2Ray       V2RayInfo       `json:"v2ray"`
 }
 
 // ServersInfoResponse all info from servers.json
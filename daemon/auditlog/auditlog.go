@@ -0,0 +1,289 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package auditlog keeps the history of security-relevant daemon actions
+// (e.g. firewall changes, EAA changes, login/logout).
+// The audit log is independent from the debug log: it is always enabled and it is not affected
+// by enabling/disabling of the debug logging or by debug log rotation.
+// The log is append-only; the size of the log is limited by keeping only one archived file.
+package auditlog
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/logger"
+	"github.com/ivpn/desktop-app/daemon/service/platform/filerights"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("audit")
+}
+
+// Max size of the audit log file. When the size is reached - the file is archived to '<filename>.0' (previous archive is overwritten)
+const maxFileSize int64 = 512 * 1024
+
+// Actor types
+const (
+	ActorDaemon = "daemon"
+	ActorUI     = "UI"
+	ActorCLI    = "CLI"
+	ActorDBus   = "D-Bus"
+	ActorRest   = "REST"
+	ActorGrpc   = "gRPC"
+)
+
+// Events
+const (
+	EventConnect                     = "Connect"
+	EventDisconnect                  = "Disconnect"
+	EventKillSwitch                  = "KillSwitch"
+	EventKillSwitchPersistent        = "KillSwitchPersistent"
+	EventKillSwitchAllowLAN          = "KillSwitchAllowLAN"
+	EventKillSwitchAllowLANMulticast = "KillSwitchAllowLANMulticast"
+	EventKillSwitchAllowLANServices  = "KillSwitchAllowLANServices"
+	EventKillSwitchAllowedLANHosts   = "KillSwitchAllowedLANHosts"
+	EventKillSwitchLANInboundPorts   = "KillSwitchLANInboundPorts"
+	EventKillSwitchAllowApiServers   = "KillSwitchAllowApiServers"
+	EventKillSwitchExceptions        = "KillSwitchExceptions"
+	EventEaa                         = "EAA"
+	EventSplitTunnel                 = "SplitTunnel"
+	EventSplitTunnelDestinations     = "SplitTunnelDestinations"
+	EventSplitTunnelContainers       = "SplitTunnelContainers"
+	EventSplitTunnelUsers            = "SplitTunnelUsers"
+	EventSplitTunnelAppAdded         = "SplitTunnelAppAdded"
+	EventSplitTunnelAppRemoved       = "SplitTunnelAppRemoved"
+	EventSchedule                    = "Schedule"
+	EventLogin                       = "Login"
+	EventLogout                      = "Logout"
+	EventDeviceLogout                = "DeviceLogout"
+	EventAccountSwitch               = "AccountSwitch"
+	EventApiProxy                    = "ApiProxy"
+	EventApiHostOverride             = "ApiHostOverride"
+	EventOpenVpnExtraParameters      = "OpenVpnExtraParameters"
+	EventOpenVpnCryptoPolicy         = "OpenVpnCryptoPolicy"
+	EventRestApi                     = "RestApi"
+	EventGrpcApi                     = "GrpcApi"
+	EventClientTokens                = "ClientTokens"
+	EventSettingsExport              = "SettingsExport"
+	EventSettingsImport              = "SettingsImport"
+)
+
+// Actor - information about the initiator of an action
+type Actor struct {
+	// Type of the initiator: ActorDaemon, ActorUI, ActorCLI, ActorDBus, ActorRest or ActorGrpc
+	Type string
+	// Process ID of the client (0 - unknown)
+	Pid int `json:",omitempty"`
+	// User ID of the client process (-1 - unknown; not available on Windows)
+	Uid int
+}
+
+func (a Actor) String() string {
+	if a.Type == ActorDaemon {
+		return a.Type
+	}
+
+	pid := "?"
+	if a.Pid > 0 {
+		pid = fmt.Sprint(a.Pid)
+	}
+	uid := "?"
+	if a.Uid >= 0 {
+		uid = fmt.Sprint(a.Uid)
+	}
+	return fmt.Sprintf("%s (PID:%s UID:%s)", a.Type, pid, uid)
+}
+
+// Event - audit log record
+type Event struct {
+	Time    time.Time
+	Event   string
+	Details string `json:",omitempty"`
+	Actor   Actor
+}
+
+var (
+	mutex    sync.Mutex
+	filePath string
+)
+
+// Init - initialize audit log file path
+func Init(logfile string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	filePath = logfile
+}
+
+// DaemonActor returns actor info for actions initiated by the daemon itself
+func DaemonActor() Actor {
+	return Actor{Type: ActorDaemon, Pid: os.Getpid(), Uid: os.Getuid()}
+}
+
+// ConnectionActor returns actor info for the client connected to the daemon by 'conn'
+func ConnectionActor(actorType string, conn net.Conn) Actor {
+	ret := Actor{Type: actorType, Uid: -1}
+	if conn == nil {
+		return ret
+	}
+
+	if unixConn, ok := conn.(*net.UnixConn); ok {
+		pid, uid, err := implGetUnixConnectionOwner(unixConn)
+		if err != nil {
+			log.Warning(fmt.Sprintf("unable to detect owner of the unix socket connection: %v", err))
+			return ret
+		}
+		ret.Pid = pid
+		ret.Uid = uid
+		return ret
+	}
+
+	if addr := conn.LocalAddr(); addr != nil && addr.Network() == "pipe" {
+		pid, uid, err := implGetPipeConnectionOwner(conn)
+		if err != nil {
+			log.Warning(fmt.Sprintf("unable to detect owner of the named pipe connection: %v", err))
+			return ret
+		}
+		ret.Pid = pid
+		ret.Uid = uid
+		return ret
+	}
+
+	local, lok := conn.LocalAddr().(*net.TCPAddr)
+	remote, rok := conn.RemoteAddr().(*net.TCPAddr)
+	if !lok || !rok {
+		return ret
+	}
+
+	// Note: the client's local port is the daemon's remote port (and vice versa)
+	pid, uid, err := implGetTcpConnectionOwner(remote.Port, local.Port)
+	if err != nil {
+		log.Warning(fmt.Sprintf("unable to detect owner of the connection %s: %v", remote, err))
+		return ret
+	}
+	ret.Pid = pid
+	ret.Uid = uid
+	return ret
+}
+
+// Write - save event to the audit log
+func Write(actor Actor, event string, details string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	e := Event{Time: time.Now(), Event: event, Details: details, Actor: actor}
+	log.Info(fmt.Sprintf("%s: %s %s", actor, event, details))
+
+	if err := write(e); err != nil {
+		log.Error(err)
+	}
+}
+
+// GetEvents returns the last 'maxCount' events from the audit log (0 - return all events)
+func GetEvents(maxCount int) ([]Event, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if len(filePath) <= 0 {
+		return nil, fmt.Errorf("audit log file not initialized")
+	}
+
+	events, err := readEvents(filePath + ".0")
+	if err != nil {
+		return nil, err
+	}
+	curEvents, err := readEvents(filePath)
+	if err != nil {
+		return nil, err
+	}
+	events = append(events, curEvents...)
+
+	if maxCount > 0 && len(events) > maxCount {
+		events = events[len(events)-maxCount:]
+	}
+	return events, nil
+}
+
+func write(e Event) error {
+	if len(filePath) <= 0 {
+		return fmt.Errorf("audit log file not initialized")
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("failed to serialize audit event: %w", err)
+	}
+
+	if stat, err := os.Stat(filePath); err == nil && stat.Size()+int64(len(data)) >= maxFileSize {
+		if err := os.Rename(filePath, filePath+".0"); err != nil {
+			return fmt.Errorf("failed to archive audit log: %w", err)
+		}
+	}
+
+	file, err := os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0600) // read\write only for privileged user
+	if err != nil {
+		return fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	// only for Windows: Golang is not able to change file permissins in Windows style
+	if err := filerights.WindowsChmod(filePath, 0600); err != nil { // read\write only for privileged user
+		return fmt.Errorf("failed to change audit log permissions: %w", err)
+	}
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("failed to write audit log: %w", err)
+	}
+	return nil
+}
+
+func readEvents(fname string) ([]Event, error) {
+	file, err := os.Open(fname)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open audit log: %w", err)
+	}
+	defer file.Close()
+
+	var ret []Event
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var e Event
+		if err := json.Unmarshal(scanner.Bytes(), &e); err != nil {
+			continue // skip broken records
+		}
+		ret = append(ret, e)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read audit log: %w", err)
+	}
+	return ret, nil
+}
@@ -0,0 +1,99 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package auditlog
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ivpn/desktop-app/daemon/shell"
+	"golang.org/x/sys/unix"
+)
+
+// implGetPipeConnectionOwner is not supported on this platform (the daemon does not listen the named pipe)
+func implGetPipeConnectionOwner(conn net.Conn) (pid int, uid int, err error) {
+	return 0, -1, fmt.Errorf("not supported")
+}
+
+// implGetUnixConnectionOwner returns PID and UID of the peer process of the unix domain socket connection
+func implGetUnixConnectionOwner(conn *net.UnixConn) (pid int, uid int, err error) {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return 0, -1, err
+	}
+
+	var cred *unix.Xucred
+	var credErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		if cred, credErr = unix.GetsockoptXucred(int(fd), unix.SOL_LOCAL, unix.LOCAL_PEERCRED); credErr == nil {
+			pid, credErr = unix.GetsockoptInt(int(fd), unix.SOL_LOCAL, unix.LOCAL_PEERPID)
+		}
+	}); err != nil {
+		return 0, -1, err
+	}
+	if credErr != nil {
+		return 0, -1, credErr
+	}
+	return pid, int(cred.Uid), nil
+}
+
+// implGetTcpConnectionOwner returns PID and UID of the process which owns the local TCP connection
+// (srcPort - local port of the connection; dstPort - remote port of the connection)
+func implGetTcpConnectionOwner(srcPort, dstPort int) (pid int, uid int, err error) {
+	// The filter matches both ends of the connection (the daemon's socket too), so the daemon's PID is skipped
+	outText, _, _, _, err := shell.ExecAndGetOutput(nil, 1024*5, "", "/usr/sbin/lsof", "-nP", "-a", fmt.Sprintf("-iTCP@127.0.0.1:%d", srcPort), "-sTCP:ESTABLISHED", "-Fpu")
+	if err != nil {
+		return 0, -1, fmt.Errorf("lsof failed: %w", err)
+	}
+
+	myPid := os.Getpid()
+	pid, uid = 0, -1
+	// Output format (one field per line): 'p<PID>', 'u<UID>', 'f<FD>' ...
+	for _, line := range strings.Split(outText, "\n") {
+		line = strings.TrimSpace(line)
+		if len(line) < 2 {
+			continue
+		}
+		val, err := strconv.Atoi(line[1:])
+		if err != nil {
+			continue
+		}
+		switch line[0] {
+		case 'p':
+			if pid > 0 && pid != myPid {
+				return pid, uid, nil
+			}
+			pid, uid = val, -1
+		case 'u':
+			uid = val
+		}
+	}
+
+	if pid > 0 && pid != myPid {
+		return pid, uid, nil
+	}
+	return 0, -1, fmt.Errorf("connection not found")
+}
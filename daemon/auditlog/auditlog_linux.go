@@ -0,0 +1,127 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package auditlog
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// implGetPipeConnectionOwner is not supported on this platform (the daemon does not listen the named pipe)
+func implGetPipeConnectionOwner(conn net.Conn) (pid int, uid int, err error) {
+	return 0, -1, fmt.Errorf("not supported")
+}
+
+// implGetUnixConnectionOwner returns PID and UID of the peer process of the unix domain socket connection
+func implGetUnixConnectionOwner(conn *net.UnixConn) (pid int, uid int, err error) {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return 0, -1, err
+	}
+
+	var cred *syscall.Ucred
+	var credErr error
+	if err := rawConn.Control(func(fd uintptr) {
+		cred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	}); err != nil {
+		return 0, -1, err
+	}
+	if credErr != nil {
+		return 0, -1, credErr
+	}
+	return int(cred.Pid), int(cred.Uid), nil
+}
+
+// implGetTcpConnectionOwner returns PID and UID of the process which owns the local TCP connection
+// (srcPort - local port of the connection; dstPort - remote port of the connection)
+func implGetTcpConnectionOwner(srcPort, dstPort int) (pid int, uid int, err error) {
+	uid, inode, err := findTcpSocket("/proc/net/tcp", srcPort, dstPort)
+	if err != nil {
+		return 0, -1, err
+	}
+
+	// looking for a process which has opened the socket
+	socketLink := fmt.Sprintf("socket:[%s]", inode)
+	fdLinks, _ := filepath.Glob("/proc/[0-9]*/fd/[0-9]*")
+	for _, fdLink := range fdLinks {
+		if link, err := os.Readlink(fdLink); err != nil || link != socketLink {
+			continue
+		}
+		// "/proc/<PID>/fd/<FD>"
+		pidStr := filepath.Base(filepath.Dir(filepath.Dir(fdLink)))
+		if pid, err := strconv.Atoi(pidStr); err == nil {
+			return pid, uid, nil
+		}
+	}
+
+	return 0, uid, nil
+}
+
+func findTcpSocket(procFile string, srcPort, dstPort int) (uid int, inode string, err error) {
+	file, err := os.Open(procFile)
+	if err != nil {
+		return -1, "", err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	scanner.Scan() // skip header
+	for scanner.Scan() {
+		// sl  local_address rem_address   st tx_queue:rx_queue tr:tm->when retrnsmt   uid  timeout inode
+		// 0: 0100007F:A0B1 0100007F:C3D4 01 00000000:00000000 00:00000000 00000000  1000        0 123456
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 10 {
+			continue
+		}
+		if tcpEntryPort(fields[1]) != srcPort || tcpEntryPort(fields[2]) != dstPort {
+			continue
+		}
+		uid, err := strconv.Atoi(fields[7])
+		if err != nil {
+			return -1, "", fmt.Errorf("failed to parse UID: %w", err)
+		}
+		return uid, fields[9], nil
+	}
+	if err := scanner.Err(); err != nil {
+		return -1, "", err
+	}
+	return -1, "", fmt.Errorf("connection not found")
+}
+
+func tcpEntryPort(addr string) int {
+	idx := strings.LastIndex(addr, ":")
+	if idx < 0 {
+		return -1
+	}
+	port, err := strconv.ParseUint(addr[idx+1:], 16, 16)
+	if err != nil {
+		return -1
+	}
+	return int(port)
+}
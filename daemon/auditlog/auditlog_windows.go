@@ -0,0 +1,97 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package auditlog
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"syscall"
+	"unsafe"
+
+	"github.com/ivpn/desktop-app/daemon/oshelpers/windows/iphlpapi"
+	"github.com/ivpn/desktop-app/daemon/oshelpers/windows/kernel32"
+)
+
+// implGetUnixConnectionOwner is not supported on Windows (the daemon does not listen the unix domain socket)
+func implGetUnixConnectionOwner(conn *net.UnixConn) (pid int, uid int, err error) {
+	return 0, -1, fmt.Errorf("not supported")
+}
+
+// implGetPipeConnectionOwner returns PID of the client process of the named pipe connection
+// UID is not available on Windows
+func implGetPipeConnectionOwner(conn net.Conn) (pid int, uid int, err error) {
+	pipe, ok := conn.(interface{ Fd() uintptr })
+	if !ok {
+		return 0, -1, fmt.Errorf("unable to get handle of the named pipe")
+	}
+
+	var clientPid uint32
+	if err := kernel32.GetNamedPipeClientProcessId(syscall.Handle(pipe.Fd()), &clientPid); err != nil {
+		return 0, -1, fmt.Errorf("GetNamedPipeClientProcessId failed: %w", err)
+	}
+	return int(clientPid), -1, nil
+}
+
+// implGetTcpConnectionOwner returns PID of the process which owns the local TCP connection
+// (srcPort - local port of the connection; dstPort - remote port of the connection)
+// UID is not available on Windows
+func implGetTcpConnectionOwner(srcPort, dstPort int) (pid int, uid int, err error) {
+	const AF_INET = 2
+	const ERROR_INSUFFICIENT_BUFFER syscall.Errno = 122
+
+	size := uint32(16 * 1024)
+	buf := make([]byte, size)
+	retVal, err := iphlpapi.GetExtendedTCPTable(buf, &size, false, AF_INET, iphlpapi.TCPTableOwnerPidConnections)
+	if err == nil && retVal == ERROR_INSUFFICIENT_BUFFER {
+		// 'size' now contains required buffer size
+		buf = make([]byte, size)
+		retVal, err = iphlpapi.GetExtendedTCPTable(buf, &size, false, AF_INET, iphlpapi.TCPTableOwnerPidConnections)
+	}
+	if err != nil {
+		return 0, -1, fmt.Errorf("GetExtendedTcpTable failed: %w", err)
+	}
+	if retVal != 0 {
+		return 0, -1, fmt.Errorf("GetExtendedTcpTable failed: %w", retVal)
+	}
+
+	// MIB_TCPTABLE_OWNER_PID: DWORD dwNumEntries; MIB_TCPROW_OWNER_PID table[ANY_SIZE];
+	if len(buf) < 4 {
+		return 0, -1, fmt.Errorf("unexpected TCP table size")
+	}
+	count := int(binary.LittleEndian.Uint32(buf[:4]))
+	rowSize := int(unsafe.Sizeof(iphlpapi.MibTCPRowOwnerPid{}))
+	for i := 0; i < count && 4+(i+1)*rowSize <= len(buf); i++ {
+		row := (*iphlpapi.MibTCPRowOwnerPid)(unsafe.Pointer(&buf[4+i*rowSize]))
+		// ports are in network byte order
+		if tcpRowPort(row.DwLocalPort) == srcPort && tcpRowPort(row.DwRemotePort) == dstPort {
+			return int(row.DwOwningPid), -1, nil
+		}
+	}
+
+	return 0, -1, fmt.Errorf("connection not found")
+}
+
+func tcpRowPort(port [4]byte) int {
+	return int(port[0])<<8 | int(port[1])
+}
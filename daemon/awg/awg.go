@@ -0,0 +1,191 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package awg contains the parameters of the AmneziaWG protocol: WireGuard with obfuscated handshake
+// (junk packets, padding of the handshake messages and custom message headers).
+// It makes the WireGuard traffic unrecognizable by DPI systems which block the vanilla WireGuard handshakes.
+// The AmneziaWG binaries (amneziawg-go / awg-quick, awg) are in use instead of the WireGuard ones.
+package awg
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Limits of the parameters (according to the AmneziaWG specification)
+const (
+	MaxJunkPacketCount = 128
+	MaxJunkPacketSize  = 1280
+	MaxInitPaddingSize = 1132 // S1: 1280 - size of the handshake initiation message (148)
+	MaxRespPaddingSize = 1188 // S2: 1280 - size of the handshake response message (92)
+)
+
+// Config - AmneziaWG obfuscation parameters.
+// Jc, Jmin, Jmax are applied to the client side only.
+// S1, S2 and H1-H4 must be the same as on the server side.
+// Empty configuration - AmneziaWG is not in use (vanilla WireGuard).
+type Config struct {
+	Jc   int // number of junk packets sent before the handshake
+	Jmin int // min size of the junk packet
+	Jmax int // max size of the junk packet
+	S1   int // size of the random padding of the handshake initiation message
+	S2   int // size of the random padding of the handshake response message
+	// Headers of the handshake initiation, handshake response, cookie and transport messages (0 - default header)
+	H1, H2, H3, H4 uint32
+}
+
+// IsEnabled returns 'true' when AmneziaWG parameters are defined
+func (c Config) IsEnabled() bool {
+	return c != Config{}
+}
+
+// Equals returns 'true' when configurations are the same
+func (c Config) Equals(b Config) bool {
+	return c == b
+}
+
+// Validate checks the configuration consistency
+func (c Config) Validate() error {
+	if !c.IsEnabled() {
+		return nil
+	}
+
+	if c.Jc < 0 || c.Jc > MaxJunkPacketCount {
+		return fmt.Errorf("bad junk packet count Jc=%d (allowed range: 0-%d)", c.Jc, MaxJunkPacketCount)
+	}
+	if c.Jc > 0 {
+		if c.Jmin < 0 || c.Jmax > MaxJunkPacketSize || c.Jmin >= c.Jmax {
+			return fmt.Errorf("bad junk packet size range Jmin=%d Jmax=%d (expected: 0 <= Jmin < Jmax <= %d)", c.Jmin, c.Jmax, MaxJunkPacketSize)
+		}
+	} else if c.Jmin != 0 || c.Jmax != 0 {
+		return fmt.Errorf("junk packet size defined but junk packet count Jc is 0")
+	}
+	if c.S1 < 0 || c.S1 > MaxInitPaddingSize {
+		return fmt.Errorf("bad padding size S1=%d (allowed range: 0-%d)", c.S1, MaxInitPaddingSize)
+	}
+	if c.S2 < 0 || c.S2 > MaxRespPaddingSize {
+		return fmt.Errorf("bad padding size S2=%d (allowed range: 0-%d)", c.S2, MaxRespPaddingSize)
+	}
+	if c.S1+56 == c.S2 {
+		// otherwise the padded handshake initiation and response messages have the same size
+		return fmt.Errorf("bad padding sizes: S1+56 must not be equal to S2")
+	}
+
+	headers := []uint32{c.H1, c.H2, c.H3, c.H4}
+	if c.H1 != 0 || c.H2 != 0 || c.H3 != 0 || c.H4 != 0 {
+		for i, h := range headers {
+			if h <= 4 {
+				// 1-4 are the message types of the vanilla WireGuard
+				return fmt.Errorf("bad message header H%d=%d (must be greater than 4)", i+1, h)
+			}
+			for j := 0; j < i; j++ {
+				if headers[j] == h {
+					return fmt.Errorf("message headers H%d and H%d must be different", j+1, i+1)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// InterfaceConfig returns the parameters for the [Interface] section of the AmneziaWG configuration
+func (c Config) InterfaceConfig() []string {
+	if !c.IsEnabled() {
+		return nil
+	}
+	ret := []string{
+		"Jc = " + strconv.Itoa(c.Jc),
+		"Jmin = " + strconv.Itoa(c.Jmin),
+		"Jmax = " + strconv.Itoa(c.Jmax),
+		"S1 = " + strconv.Itoa(c.S1),
+		"S2 = " + strconv.Itoa(c.S2),
+	}
+	if c.H1 != 0 {
+		ret = append(ret,
+			"H1 = "+strconv.FormatUint(uint64(c.H1), 10),
+			"H2 = "+strconv.FormatUint(uint64(c.H2), 10),
+			"H3 = "+strconv.FormatUint(uint64(c.H3), 10),
+			"H4 = "+strconv.FormatUint(uint64(c.H4), 10))
+	}
+	return ret
+}
+
+// String returns the configuration in format "Jc=4,Jmin=40,Jmax=70,S1=0,S2=0,H1=...,H2=...,H3=...,H4=..." (see ParseConfig())
+func (c Config) String() string {
+	if !c.IsEnabled() {
+		return ""
+	}
+	var ret []string
+	for _, l := range c.InterfaceConfig() {
+		ret = append(ret, strings.ReplaceAll(l, " = ", "="))
+	}
+	return strings.Join(ret, ",")
+}
+
+// ParseConfig parses the configuration in format "Jc=4,Jmin=40,Jmax=70,S1=0,S2=0,H1=...,H2=...,H3=...,H4=..."
+// (parameter names are case-insensitive; the parameters which are not defined are 0)
+func ParseConfig(text string) (Config, error) {
+	var ret Config
+
+	intFields := map[string]*int{"jc": &ret.Jc, "jmin": &ret.Jmin, "jmax": &ret.Jmax, "s1": &ret.S1, "s2": &ret.S2}
+	headerFields := map[string]*uint32{"h1": &ret.H1, "h2": &ret.H2, "h3": &ret.H3, "h4": &ret.H4}
+
+	for _, p := range strings.FieldsFunc(text, func(r rune) bool { return r == ',' || r == ';' || r == ' ' }) {
+		kv := strings.SplitN(p, "=", 2)
+		if len(kv) != 2 {
+			return Config{}, fmt.Errorf("bad parameter '%s' (expected format NAME=VALUE)", p)
+		}
+		name := strings.ToLower(strings.TrimSpace(kv[0]))
+		value := strings.TrimSpace(kv[1])
+
+		if f, ok := intFields[name]; ok {
+			v, err := strconv.Atoi(value)
+			if err != nil {
+				return Config{}, fmt.Errorf("bad value of parameter '%s': %w", kv[0], err)
+			}
+			*f = v
+		} else if f, ok := headerFields[name]; ok {
+			v, err := strconv.ParseUint(value, 10, 32)
+			if err != nil {
+				return Config{}, fmt.Errorf("bad value of parameter '%s': %w", kv[0], err)
+			}
+			*f = uint32(v)
+		} else {
+			names := make([]string, 0, len(intFields)+len(headerFields))
+			for n := range intFields {
+				names = append(names, n)
+			}
+			for n := range headerFields {
+				names = append(names, n)
+			}
+			sort.Strings(names)
+			return Config{}, fmt.Errorf("unknown parameter '%s' (supported parameters: %s)", kv[0], strings.Join(names, ", "))
+		}
+	}
+
+	if err := ret.Validate(); err != nil {
+		return Config{}, err
+	}
+	return ret, nil
+}
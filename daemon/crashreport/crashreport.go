@@ -0,0 +1,238 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package crashreport provides the central panic recovery for the daemon goroutines.
+// Each recovered panic is persisted as a crash report (panic value and stack trace) in the crash reports directory,
+// and the clients are notified that the daemon recovered from a crash.
+package crashreport
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/logger"
+	"github.com/ivpn/desktop-app/daemon/service/platform/filerights"
+	"github.com/ivpn/desktop-app/daemon/version"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("crash")
+}
+
+// Max number of the crash reports to keep (the oldest reports are removed)
+const maxReports = 20
+
+const (
+	reportFilePrefix = "crash_"
+	reportFileExt    = ".json"
+)
+
+// Report - information about the recovered panic
+type Report struct {
+	Time time.Time
+	// Name of the daemon routine where the panic happened (e.g. "processRequest")
+	Source  string
+	Panic   string
+	Stack   string `json:",omitempty"`
+	Version string
+}
+
+var (
+	mutex    sync.Mutex
+	dirPath  string
+	notifier func(r Report)
+)
+
+// Init - initialize the directory for the crash reports
+func Init(dir string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	dirPath = dir
+}
+
+// SetNotifier sets the function which is called after each recovered panic (e.g. to notify the clients)
+func SetNotifier(f func(r Report)) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	notifier = f
+}
+
+// Recover recovers the panic (if any) and saves the crash report.
+// Must be called directly by 'defer' at the beginning of a goroutine:
+//
+//	defer crashreport.Recover("routine name")
+func Recover(source string) {
+	if r := recover(); r != nil {
+		Save(source, r)
+	}
+}
+
+// Go starts the function in a new goroutine which is protected by Recover()
+func Go(source string, f func()) {
+	go func() {
+		defer Recover(source)
+		f()
+	}()
+}
+
+// Save persists the crash report for the recovered panic value 'r' and notifies the clients.
+// It is intended to be called from the existing 'recover()' handlers (the stack trace of the panic is still available there).
+func Save(source string, r interface{}) Report {
+	report := Report{
+		Time:    time.Now(),
+		Source:  source,
+		Panic:   fmt.Sprint(r),
+		Stack:   string(debug.Stack()),
+		Version: version.Version(),
+	}
+	if logger.IsPrivacyMode() {
+		report.Panic = logger.Scrub(report.Panic)
+	}
+
+	log.Error(fmt.Sprintf("PANIC (recovered) in '%s': %s\n%s", source, report.Panic, report.Stack))
+
+	mutex.Lock()
+	if err := write(report); err != nil {
+		log.Error(err)
+	}
+	n := notifier
+	mutex.Unlock()
+
+	if n != nil {
+		n(report)
+	}
+	return report
+}
+
+// Reports returns the saved crash reports (the most recent last).
+// The stack traces are included only when 'withStack' is true.
+func Reports(withStack bool) ([]Report, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	files, err := reportFiles()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]Report, 0, len(files))
+	for _, f := range files {
+		data, err := os.ReadFile(f)
+		if err != nil {
+			log.Warning(err)
+			continue
+		}
+		var r Report
+		if err := json.Unmarshal(data, &r); err != nil {
+			log.Warning(fmt.Sprintf("failed to parse crash report '%s': %v", f, err))
+			continue
+		}
+		if !withStack {
+			r.Stack = ""
+		}
+		ret = append(ret, r)
+	}
+	return ret, nil
+}
+
+// Clear removes all the saved crash reports
+func Clear() error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	files, err := reportFiles()
+	if err != nil {
+		return err
+	}
+	for _, f := range files {
+		if err := os.Remove(f); err != nil {
+			return fmt.Errorf("failed to remove crash report: %w", err)
+		}
+	}
+	return nil
+}
+
+// reportFiles returns the paths of the crash report files (the oldest first)
+func reportFiles() ([]string, error) {
+	if len(dirPath) <= 0 {
+		return nil, fmt.Errorf("crash reports directory not initialized")
+	}
+
+	files, err := filepath.Glob(filepath.Join(dirPath, reportFilePrefix+"*"+reportFileExt))
+	if err != nil {
+		return nil, err
+	}
+	// the file names contain the timestamp
+	sort.Strings(files)
+	return files, nil
+}
+
+func write(r Report) error {
+	if len(dirPath) <= 0 {
+		return fmt.Errorf("crash reports directory not initialized")
+	}
+
+	if err := os.MkdirAll(dirPath, 0700); err != nil { // read\write only for privileged user
+		return fmt.Errorf("failed to create crash reports directory: %w", err)
+	}
+
+	data, err := json.MarshalIndent(r, "", " ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize crash report: %w", err)
+	}
+
+	source := strings.Map(func(c rune) rune {
+		if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') {
+			return c
+		}
+		return '_'
+	}, r.Source)
+	fname := filepath.Join(dirPath, fmt.Sprintf("%s%s_%s%s", reportFilePrefix, r.Time.UTC().Format("20060102T150405.000000000"), source, reportFileExt))
+
+	if err := os.WriteFile(fname, data, 0600); err != nil { // read\write only for privileged user
+		return fmt.Errorf("failed to write crash report: %w", err)
+	}
+	// only for Windows: Golang is not able to change file permissins in Windows style
+	if err := filerights.WindowsChmod(fname, 0600); err != nil {
+		return fmt.Errorf("failed to change crash report permissions: %w", err)
+	}
+
+	// remove the oldest reports
+	files, err := reportFiles()
+	if err != nil {
+		return err
+	}
+	for len(files) > maxReports {
+		os.Remove(files[0])
+		files = files[1:]
+	}
+	return nil
+}
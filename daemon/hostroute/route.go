@@ -0,0 +1,103 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package hostroute configures routes to the specific hosts via the default gateway.
+// It is in use by the VPN transports (e.g. V2Ray) which communicate with the remote server directly,
+// so this communication must not go through the VPN tunnel.
+package hostroute
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ivpn/desktop-app/daemon/logger"
+	"github.com/ivpn/desktop-app/daemon/netinfo"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("hroute")
+}
+
+// Route - route to the host via default gateway (outside the VPN tunnel)
+type Route struct {
+	host    net.IP
+	gateway net.IP
+	// interface of the default route (in use for IPv6 routes: the IPv6 gateway is usually a link-local address)
+	ifaceName  string
+	ifaceIndex int
+}
+
+// Add creates the route to the host via default gateway
+func Add(host net.IP) (*Route, error) {
+	if host.To4() == nil {
+		return addIPv6(host)
+	}
+
+	gw, err := netinfo.DefaultGatewayIP()
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine default gateway: %w", err)
+	}
+	if gw == nil {
+		return nil, fmt.Errorf("default gateway not defined")
+	}
+	r := &Route{host: host, gateway: gw}
+	if err := r.add(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func addIPv6(host net.IP) (*Route, error) {
+	defRoute, err := netinfo.PrimaryDefaultRoute(true)
+	if err != nil {
+		return nil, fmt.Errorf("unable to determine default IPv6 route: %w", err)
+	}
+	if defRoute.Gateway == nil && len(defRoute.InterfaceName) == 0 {
+		return nil, fmt.Errorf("default IPv6 gateway not defined")
+	}
+	r := &Route{host: host, gateway: defRoute.Gateway, ifaceName: defRoute.InterfaceName, ifaceIndex: defRoute.InterfaceIndex}
+	if err := r.add(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *Route) isIPv6() bool {
+	return r.host.To4() == nil
+}
+
+func (r *Route) add() error {
+	if r.isIPv6() {
+		return implAddRouteIPv6(r.host, r.gateway, r.ifaceName, r.ifaceIndex)
+	}
+	return implAddRoute(r.host, r.gateway)
+}
+
+// Remove deletes the route
+func (r *Route) Remove() error {
+	if r.isIPv6() {
+		return implRemoveRouteIPv6(r.host, r.gateway, r.ifaceName, r.ifaceIndex)
+	}
+	return implRemoveRoute(r.host, r.gateway)
+}
@@ -0,0 +1,58 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package hostroute
+
+import (
+	"net"
+
+	"github.com/ivpn/desktop-app/daemon/shell"
+)
+
+func implAddRoute(host net.IP, gateway net.IP) error {
+	// example command: route -n add -inet -net 145.239.239.55 192.168.1.1 255.255.255.255
+	return shell.Exec(log, "/sbin/route", "-n", "add", "-inet", "-net", host.String(), gateway.String(), "255.255.255.255")
+}
+
+func implRemoveRoute(host net.IP, gateway net.IP) error {
+	return shell.Exec(log, "/sbin/route", "-n", "delete", "-inet", "-net", host.String(), gateway.String())
+}
+
+// The IPv6 gateway is usually a link-local address: the interface scope is required (e.g. "fe80::1%en0")
+func ipv6GatewayArgs(gateway net.IP, ifaceName string) []string {
+	if gateway == nil {
+		return []string{"-interface", ifaceName}
+	}
+	if gateway.IsLinkLocalUnicast() && len(ifaceName) > 0 {
+		return []string{gateway.String() + "%" + ifaceName}
+	}
+	return []string{gateway.String()}
+}
+
+func implAddRouteIPv6(host net.IP, gateway net.IP, ifaceName string, ifaceIndex int) error {
+	// example command: route -n add -inet6 -host 2001:db8::1 fe80::1%en0
+	return shell.Exec(log, "/sbin/route", append([]string{"-n", "add", "-inet6", "-host", host.String()}, ipv6GatewayArgs(gateway, ifaceName)...)...)
+}
+
+func implRemoveRouteIPv6(host net.IP, gateway net.IP, ifaceName string, ifaceIndex int) error {
+	return shell.Exec(log, "/sbin/route", append([]string{"-n", "delete", "-inet6", "-host", host.String()}, ipv6GatewayArgs(gateway, ifaceName)...)...)
+}
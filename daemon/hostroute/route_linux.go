@@ -0,0 +1,58 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package hostroute
+
+import (
+	"net"
+
+	"github.com/ivpn/desktop-app/daemon/shell"
+)
+
+func implAddRoute(host net.IP, gateway net.IP) error {
+	// example command: ip route add 145.239.239.55/32 via 192.168.1.1
+	return shell.Exec(log, "/sbin/ip", "route", "add", host.String()+"/32", "via", gateway.String())
+}
+
+func implRemoveRoute(host net.IP, gateway net.IP) error {
+	return shell.Exec(log, "/sbin/ip", "route", "del", host.String()+"/32", "via", gateway.String())
+}
+
+func ipv6RouteArgs(host net.IP, gateway net.IP, ifaceName string) []string {
+	args := []string{host.String() + "/128"}
+	if gateway != nil {
+		args = append(args, "via", gateway.String())
+	}
+	if len(ifaceName) > 0 {
+		args = append(args, "dev", ifaceName)
+	}
+	return args
+}
+
+func implAddRouteIPv6(host net.IP, gateway net.IP, ifaceName string, ifaceIndex int) error {
+	// example command: ip -6 route add 2001:db8::1/128 via fe80::1 dev eth0
+	return shell.Exec(log, "/sbin/ip", append([]string{"-6", "route", "add"}, ipv6RouteArgs(host, gateway, ifaceName)...)...)
+}
+
+func implRemoveRouteIPv6(host net.IP, gateway net.IP, ifaceName string, ifaceIndex int) error {
+	return shell.Exec(log, "/sbin/ip", append([]string{"-6", "route", "del"}, ipv6RouteArgs(host, gateway, ifaceName)...)...)
+}
@@ -0,0 +1,83 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package hostroute
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/ivpn/desktop-app/daemon/service/platform"
+	"github.com/ivpn/desktop-app/daemon/shell"
+)
+
+func routeBinary() (string, error) {
+	// Example: "C:\Windows\System32\ROUTE.EXE"
+	if cmd := platform.RouteCommand(); len(cmd) > 0 {
+		return cmd, nil
+	}
+	return "", fmt.Errorf("route command not available")
+}
+
+func implAddRoute(host net.IP, gateway net.IP) error {
+	// example command: route add 145.239.239.55 mask 255.255.255.255 192.168.1.1
+	route, err := routeBinary()
+	if err != nil {
+		return err
+	}
+	return shell.Exec(log, route, "add", host.String(), "mask", "255.255.255.255", gateway.String())
+}
+
+func implRemoveRoute(host net.IP, gateway net.IP) error {
+	route, err := routeBinary()
+	if err != nil {
+		return err
+	}
+	return shell.Exec(log, route, "delete", host.String(), "mask", "255.255.255.255", gateway.String())
+}
+
+func ipv6RouteArgs(host net.IP, gateway net.IP, ifaceIndex int) []string {
+	// "::" is in use for the on-link routes
+	gw := "::"
+	if gateway != nil {
+		gw = gateway.String()
+	}
+	return []string{host.String() + "/128", gw, "IF", strconv.Itoa(ifaceIndex)}
+}
+
+func implAddRouteIPv6(host net.IP, gateway net.IP, ifaceName string, ifaceIndex int) error {
+	// example command: route -6 add 2001:db8::1/128 fe80::1 IF 12
+	route, err := routeBinary()
+	if err != nil {
+		return err
+	}
+	return shell.Exec(log, route, append([]string{"-6", "add"}, ipv6RouteArgs(host, gateway, ifaceIndex)...)...)
+}
+
+func implRemoveRouteIPv6(host net.IP, gateway net.IP, ifaceName string, ifaceIndex int) error {
+	route, err := routeBinary()
+	if err != nil {
+		return err
+	}
+	return shell.Exec(log, route, append([]string{"-6", "delete"}, ipv6RouteArgs(host, gateway, ifaceIndex)...)...)
+}
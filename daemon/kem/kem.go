@@ -0,0 +1,153 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package kem implements the post-quantum key encapsulation (KEM) which is in use to negotiate
+// the per-session preshared key of the WireGuard connection ("harvest-now-decrypt-later" protection).
+// The KEM operations are performed by the external helper binary ('kem-helper'; sources: References/common/kem-helper).
+//
+// The negotiation:
+//   - the client generates the KEM key pair and sends the public key to the server (together with the WireGuard public key);
+//   - the server encapsulates the random secret by the public key and returns the cipher text;
+//   - the client decapsulates the secret by the private key; the secret is in use as the WireGuard preshared key.
+package kem
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"github.com/ivpn/desktop-app/daemon/shell"
+)
+
+// Supported KEM algorithms
+// (the name is passed to the API as 'kem_library_name1' and to the KEM helper as is)
+const (
+	AlgorithmKyber1024 = "Kyber1024"
+	AlgorithmMLKEM1024 = "ML-KEM-1024"
+)
+
+// DefaultAlgorithm - the algorithm in use when no algorithm defined by the user
+const DefaultAlgorithm = AlgorithmKyber1024
+
+// presharedKeyLength - the size of WireGuard preshared key (the size of the secret of all supported algorithms)
+const presharedKeyLength = 32
+
+// maxHelperOutputLength - max size of the KEM helper output (the keys of the supported algorithms are much smaller)
+const maxHelperOutputLength = 64 * 1024
+
+// Algorithms returns the list of supported KEM algorithms
+func Algorithms() []string {
+	return []string{AlgorithmKyber1024, AlgorithmMLKEM1024}
+}
+
+// ValidateAlgorithm returns an error when the algorithm is not supported
+func ValidateAlgorithm(algorithm string) error {
+	for _, a := range Algorithms() {
+		if a == algorithm {
+			return nil
+		}
+	}
+	return fmt.Errorf("unsupported KEM algorithm '%s' (supported: %s)", algorithm, strings.Join(Algorithms(), ", "))
+}
+
+// KeyPair - the KEM key pair
+type KeyPair struct {
+	Algorithm  string
+	PublicKey  string // base64
+	privateKey string // base64
+}
+
+// GenerateKeys generates new KEM key pair
+func GenerateKeys(helperBinaryPath string, algorithm string) (*KeyPair, error) {
+	if err := ValidateAlgorithm(algorithm); err != nil {
+		return nil, err
+	}
+
+	// example command: kem-helper generate Kyber1024
+	// output:
+	//	public:<base64>
+	//	private:<base64>
+	out, err := run(helperBinaryPath, nil, "generate", algorithm)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &KeyPair{Algorithm: algorithm, PublicKey: out["public"], privateKey: out["private"]}
+	if len(ret.PublicKey) == 0 || len(ret.privateKey) == 0 {
+		return nil, fmt.Errorf("KEM keys not generated (unexpected output of the KEM helper)")
+	}
+	return ret, nil
+}
+
+// PresharedKey decapsulates the secret from the cipher text (received from the server)
+// and returns it as the WireGuard preshared key (base64).
+// The secret is in use as is: the server configures the same secret as the preshared key of the peer.
+func (k *KeyPair) PresharedKey(helperBinaryPath string, cipher string) (string, error) {
+	if len(cipher) == 0 {
+		return "", fmt.Errorf("KEM cipher not defined")
+	}
+
+	// example command: kem-helper decapsulate Kyber1024
+	// input (the private key is not passed as argument: the command line of the process is visible for all users):
+	//	<private key base64>
+	//	<cipher base64>
+	// output:
+	//	secret:<base64>
+	out, err := run(helperBinaryPath, []byte(k.privateKey+"\n"+cipher+"\n"), "decapsulate", k.Algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	secret, err := base64.StdEncoding.DecodeString(out["secret"])
+	if err != nil {
+		return "", fmt.Errorf("KEM secret not decapsulated (unexpected output of the KEM helper)")
+	}
+	if len(secret) != presharedKeyLength {
+		return "", fmt.Errorf("unexpected size of KEM secret (%d bytes; expected %d bytes)", len(secret), presharedKeyLength)
+	}
+
+	return base64.StdEncoding.EncodeToString(secret), nil
+}
+
+// run executes the KEM helper and returns its output ("<name>:<value>" lines).
+// 'stdin' - the data for the standard input of the helper (nil - no input)
+func run(helperBinaryPath string, stdin []byte, args ...string) (map[string]string, error) {
+	// the output contains the private key: it is not logged (logger is nil)
+	outText, outErrText, _, isBufferTooSmall, err := shell.ExecAndGetOutputWithInput(nil, maxHelperOutputLength, stdin, "", helperBinaryPath, args...)
+	if err != nil {
+		if msg := strings.TrimSpace(outErrText); len(msg) > 0 {
+			return nil, fmt.Errorf("KEM helper error: %w (%s)", err, msg)
+		}
+		return nil, fmt.Errorf("KEM helper error: %w", err)
+	}
+	if isBufferTooSmall {
+		return nil, fmt.Errorf("KEM helper error: unexpected size of the output")
+	}
+
+	ret := map[string]string{}
+	for _, line := range strings.Split(outText, "\n") {
+		if name, value, ok := strings.Cut(strings.TrimSpace(line), ":"); ok {
+			ret[strings.TrimSpace(name)] = strings.TrimSpace(value)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,114 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package keyprotect allows to protect (wrap) small secrets (e.g. WireGuard private key)
+// by a hardware-bound key: TPM 2.0 (Windows, Linux) or Secure Enclave (macOS).
+// The protected data can be restored only on the same machine.
+package keyprotect
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ivpn/desktop-app/daemon/logger"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("keyprt")
+}
+
+var (
+	mutex sync.Mutex
+
+	isAvailabilityChecked bool
+	availabilityErr       error
+)
+
+// GetFuncNotAvailableError returns non-nil error object if hardware-backed key protection is not available on this machine
+func GetFuncNotAvailableError() error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return funcNotAvailableError()
+}
+
+// Protect wraps the secret by a hardware-bound key.
+// Returns the protected data in text format, which can be stored on a disk.
+func Protect(secret string) (string, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if err := funcNotAvailableError(); err != nil {
+		return "", err
+	}
+
+	data, err := implProtect([]byte(secret))
+	if err != nil {
+		return "", fmt.Errorf("failed to protect data (%s): %w", implName(), err)
+	}
+
+	return implName() + ":" + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// Unprotect restores the secret which was protected by Protect()
+func Unprotect(protected string) (string, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	name, dataB64, found := strings.Cut(protected, ":")
+	if !found || name != implName() {
+		return "", fmt.Errorf("unsupported format of protected data (expected '%s')", implName())
+	}
+
+	data, err := base64.StdEncoding.DecodeString(dataB64)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode protected data: %w", err)
+	}
+
+	if err := funcNotAvailableError(); err != nil {
+		return "", err
+	}
+
+	secret, err := implUnprotect(data)
+	if err != nil {
+		return "", fmt.Errorf("failed to unprotect data (%s): %w", implName(), err)
+	}
+
+	return string(secret), nil
+}
+
+func funcNotAvailableError() error {
+	if !isAvailabilityChecked {
+		isAvailabilityChecked = true
+		availabilityErr = implCheckIsAvailable()
+		if availabilityErr != nil {
+			log.Info(fmt.Sprintf("Hardware-backed key protection is not available: %s", availabilityErr))
+		} else {
+			log.Info(fmt.Sprintf("Hardware-backed key protection is available (%s)", implName()))
+		}
+	}
+	return availabilityErr
+}
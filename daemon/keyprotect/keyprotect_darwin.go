@@ -0,0 +1,211 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+//go:build darwin && cgo
+// +build darwin,cgo
+
+package keyprotect
+
+/*
+#cgo LDFLAGS: -framework Security -framework CoreFoundation
+
+#include <stdlib.h>
+#include <string.h>
+#include <CoreFoundation/CoreFoundation.h>
+#include <Security/Security.h>
+
+// Secure Enclave key is not permanent (it is not stored in keychain: keychain access requires entitlements for the app).
+// Instead, the key is restored from its token object ID (the key data encrypted by Secure Enclave, it is usable only on this machine).
+// NOTE: kSecAttrTokenOID is not declared in public headers (it is in use by CryptoKit: 'SecureEnclave.P256.*.PrivateKey.dataRepresentation')
+extern const CFStringRef kSecAttrTokenOID;
+
+#define SE_ALGORITHM kSecKeyAlgorithmECIESEncryptionCofactorVariableIVX963SHA256AESGCM
+
+#define SE_ERR_KEY_CREATE -1
+#define SE_ERR_KEY_OID -2
+#define SE_ERR_ENCRYPT -3
+#define SE_ERR_DECRYPT -4
+
+static inline SecKeyRef se_key(CFDataRef tokenOID) {
+	int keySize = 256;
+	CFNumberRef cfKeySize = CFNumberCreate(kCFAllocatorDefault, kCFNumberIntType, &keySize);
+	CFMutableDictionaryRef attrs = CFDictionaryCreateMutable(kCFAllocatorDefault, 0, &kCFTypeDictionaryKeyCallBacks, &kCFTypeDictionaryValueCallBacks);
+	CFDictionarySetValue(attrs, kSecAttrKeyType, kSecAttrKeyTypeECSECPrimeRandom);
+	CFDictionarySetValue(attrs, kSecAttrKeySizeInBits, cfKeySize);
+	CFDictionarySetValue(attrs, kSecAttrTokenID, kSecAttrTokenIDSecureEnclave);
+	if (tokenOID != NULL) CFDictionarySetValue(attrs, kSecAttrTokenOID, tokenOID);
+
+	SecKeyRef key = SecKeyCreateRandomKey(attrs, NULL);
+
+	CFRelease(attrs);
+	CFRelease(cfKeySize);
+	return key;
+}
+
+static inline void cfdata_copy(CFDataRef data, void **out, int *outLen) {
+	*outLen = (int)CFDataGetLength(data);
+	*out = malloc(*outLen);
+	memcpy(*out, CFDataGetBytePtr(data), *outLen);
+}
+
+static inline int se_is_available() {
+	SecKeyRef key = se_key(NULL);
+	if (key == NULL) return SE_ERR_KEY_CREATE;
+	CFRelease(key);
+	return 0;
+}
+
+// On success, the caller is responsible to free 'oid' and 'out' buffers
+static inline int se_protect(const void *in, int inLen, void **oid, int *oidLen, void **out, int *outLen) {
+	SecKeyRef key = se_key(NULL);
+	if (key == NULL) return SE_ERR_KEY_CREATE;
+
+	int ret = 0;
+	CFDataRef tokenOID = NULL;
+	CFDictionaryRef keyAttrs = SecKeyCopyAttributes(key);
+	if (keyAttrs != NULL) {
+		tokenOID = (CFDataRef)CFDictionaryGetValue(keyAttrs, kSecAttrTokenOID);
+	}
+	if (tokenOID == NULL) {
+		ret = SE_ERR_KEY_OID;
+	} else {
+		SecKeyRef pubKey = SecKeyCopyPublicKey(key);
+		CFDataRef plain = CFDataCreate(kCFAllocatorDefault, in, inLen);
+		CFDataRef encrypted = (pubKey == NULL) ? NULL : SecKeyCreateEncryptedData(pubKey, SE_ALGORITHM, plain, NULL);
+		if (encrypted == NULL) {
+			ret = SE_ERR_ENCRYPT;
+		} else {
+			cfdata_copy(tokenOID, oid, oidLen);
+			cfdata_copy(encrypted, out, outLen);
+			CFRelease(encrypted);
+		}
+		CFRelease(plain);
+		if (pubKey != NULL) CFRelease(pubKey);
+	}
+
+	if (keyAttrs != NULL) CFRelease(keyAttrs);
+	CFRelease(key);
+	return ret;
+}
+
+// On success, the caller is responsible to free 'out' buffer
+static inline int se_unprotect(const void *oid, int oidLen, const void *in, int inLen, void **out, int *outLen) {
+	CFDataRef tokenOID = CFDataCreate(kCFAllocatorDefault, oid, oidLen);
+	SecKeyRef key = se_key(tokenOID);
+	CFRelease(tokenOID);
+	if (key == NULL) return SE_ERR_KEY_CREATE;
+
+	int ret = 0;
+	CFDataRef encrypted = CFDataCreate(kCFAllocatorDefault, in, inLen);
+	CFDataRef plain = SecKeyCreateDecryptedData(key, SE_ALGORITHM, encrypted, NULL);
+	if (plain == NULL) {
+		ret = SE_ERR_DECRYPT;
+	} else {
+		cfdata_copy(plain, out, outLen);
+		CFRelease(plain);
+	}
+
+	CFRelease(encrypted);
+	CFRelease(key);
+	return ret;
+}
+*/
+import "C"
+
+import (
+	"encoding/json"
+	"fmt"
+	"unsafe"
+)
+
+// Secure Enclave
+// The secret is encrypted (ECIES) by the P-256 key which is generated inside Secure Enclave.
+// The private part of the key never leaves Secure Enclave.
+type seProtectedObject struct {
+	TokenOID []byte
+	Data     []byte
+}
+
+func implName() string {
+	return "se"
+}
+
+func implCheckIsAvailable() error {
+	if ret := C.se_is_available(); ret != 0 {
+		return fmt.Errorf("Secure Enclave not available")
+	}
+	return nil
+}
+
+func implProtect(secret []byte) ([]byte, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("no data")
+	}
+
+	var oid, out unsafe.Pointer
+	var oidLen, outLen C.int
+
+	if ret := C.se_protect(unsafe.Pointer(&secret[0]), C.int(len(secret)), &oid, &oidLen, &out, &outLen); ret != 0 {
+		return nil, seError(ret)
+	}
+	defer C.free(oid)
+	defer C.free(out)
+
+	return json.Marshal(seProtectedObject{
+		TokenOID: C.GoBytes(oid, oidLen),
+		Data:     C.GoBytes(out, outLen)})
+}
+
+func implUnprotect(data []byte) ([]byte, error) {
+	var obj seProtectedObject
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	if len(obj.TokenOID) == 0 || len(obj.Data) == 0 {
+		return nil, fmt.Errorf("no data")
+	}
+
+	var out unsafe.Pointer
+	var outLen C.int
+
+	if ret := C.se_unprotect(unsafe.Pointer(&obj.TokenOID[0]), C.int(len(obj.TokenOID)), unsafe.Pointer(&obj.Data[0]), C.int(len(obj.Data)), &out, &outLen); ret != 0 {
+		return nil, seError(ret)
+	}
+	defer C.free(out)
+
+	return C.GoBytes(out, outLen), nil
+}
+
+func seError(code C.int) error {
+	switch code {
+	case C.SE_ERR_KEY_CREATE:
+		return fmt.Errorf("unable to create Secure Enclave key")
+	case C.SE_ERR_KEY_OID:
+		return fmt.Errorf("unable to get Secure Enclave key data")
+	case C.SE_ERR_ENCRYPT:
+		return fmt.Errorf("encryption failed")
+	case C.SE_ERR_DECRYPT:
+		return fmt.Errorf("decryption failed")
+	default:
+		return fmt.Errorf("unexpected error (%d)", code)
+	}
+}
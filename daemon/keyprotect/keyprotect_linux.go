@@ -0,0 +1,171 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package keyprotect
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/ivpn/desktop-app/daemon/service/platform/filerights"
+)
+
+// TPM 2.0 (in-kernel resource manager)
+// The secret is sealed by a key which is a child of the primary key of TPM 'owner' hierarchy.
+// The primary key is deterministic (it is re-created from the TPM seed every time), so only the sealed object is stored.
+// Operations are performed using 'tpm2-tools' binaries.
+const tpmDevice = "/dev/tpmrm0"
+
+var tpmTools = []string{"tpm2_createprimary", "tpm2_create", "tpm2_load", "tpm2_unseal"}
+
+// The 'tpm2-tools' binaries are executed with root privileges: they are searched only in the system directories
+// (not in $PATH), and they must be owned by root and not writable by other users
+var tpmToolsDirs = []string{"/usr/bin", "/usr/sbin", "/bin", "/sbin"}
+
+type tpmSealedObject struct {
+	Pub  []byte
+	Priv []byte
+}
+
+func implName() string {
+	return "tpm2"
+}
+
+func implCheckIsAvailable() error {
+	if _, err := os.Stat(tpmDevice); err != nil {
+		return fmt.Errorf("TPM 2.0 device not found: %w", err)
+	}
+	for _, bin := range tpmTools {
+		if _, err := tpmToolPath(bin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// tpmToolPath returns the absolute path to the 'tpm2-tools' binary
+func tpmToolPath(name string) (string, error) {
+	for _, dir := range tpmToolsDirs {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		if err := filerights.CheckFileAccessRightsExecutable(path); err != nil {
+			return "", fmt.Errorf("'%s' can not be used: %w", path, err)
+		}
+		return path, nil
+	}
+	return "", fmt.Errorf("'%s' not found. Please install 'tpm2-tools'", name)
+}
+
+func implProtect(secret []byte) ([]byte, error) {
+	dir, err := os.MkdirTemp("", "ivpn-tpm")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := tpmCreatePrimary(dir); err != nil {
+		return nil, err
+	}
+
+	// the secret is passed via STDIN (it is never saved to a file)
+	if _, err := tpmExec(dir, secret, "tpm2_create", "-Q", "-C", "primary.ctx", "-g", "sha256", "-u", "seal.pub", "-r", "seal.priv", "-i", "-"); err != nil {
+		return nil, err
+	}
+
+	var obj tpmSealedObject
+	if obj.Pub, err = os.ReadFile(filepath.Join(dir, "seal.pub")); err != nil {
+		return nil, err
+	}
+	if obj.Priv, err = os.ReadFile(filepath.Join(dir, "seal.priv")); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(obj)
+}
+
+func implUnprotect(data []byte) ([]byte, error) {
+	var obj tpmSealedObject
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "ivpn-tpm")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := os.WriteFile(filepath.Join(dir, "seal.pub"), obj.Pub, 0600); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filepath.Join(dir, "seal.priv"), obj.Priv, 0600); err != nil {
+		return nil, err
+	}
+
+	if err := tpmCreatePrimary(dir); err != nil {
+		return nil, err
+	}
+	if _, err := tpmExec(dir, nil, "tpm2_load", "-Q", "-C", "primary.ctx", "-u", "seal.pub", "-r", "seal.priv", "-c", "seal.ctx"); err != nil {
+		return nil, err
+	}
+
+	// the secret is received from STDOUT (it is never saved to a file)
+	return tpmExec(dir, nil, "tpm2_unseal", "-Q", "-c", "seal.ctx")
+}
+
+func tpmCreatePrimary(dir string) error {
+	_, err := tpmExec(dir, nil, "tpm2_createprimary", "-Q", "-C", "o", "-g", "sha256", "-G", "ecc", "-c", "primary.ctx")
+	return err
+}
+
+func tpmExec(dir string, stdin []byte, name string, args ...string) ([]byte, error) {
+	var outBuf, errBuf bytes.Buffer
+
+	binPath, err := tpmToolPath(name)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(binPath, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "TPM2TOOLS_TCTI=device:"+tpmDevice)
+	cmd.Stdout = &outBuf
+	cmd.Stderr = &errBuf
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+
+	if err := cmd.Run(); err != nil {
+		if errText := strings.TrimSpace(errBuf.String()); len(errText) > 0 {
+			return nil, fmt.Errorf("%s: %w (%s)", name, err, errText)
+		}
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return outBuf.Bytes(), nil
+}
@@ -0,0 +1,199 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package keyprotect
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// TPM 2.0 ("Microsoft Platform Crypto Provider")
+// The secret is encrypted by the RSA key which is persisted in TPM (machine key).
+// The private part of the RSA key never leaves the TPM.
+const (
+	tpmProviderName = "Microsoft Platform Crypto Provider"
+	tpmKeyName      = "IVPN WireGuard key protection"
+
+	_NCRYPT_MACHINE_KEY_FLAG = 0x00000020
+	_NCRYPT_PAD_OAEP_FLAG    = 0x00000004
+	_NTE_BAD_KEYSET          = 0x80090016
+)
+
+var (
+	_ncrypt                            = windows.NewLazySystemDLL("ncrypt.dll")
+	_fNCryptOpenStorageProvider        = _ncrypt.NewProc("NCryptOpenStorageProvider")
+	_fNCryptOpenKey                    = _ncrypt.NewProc("NCryptOpenKey")
+	_fNCryptCreatePersistedKey         = _ncrypt.NewProc("NCryptCreatePersistedKey")
+	_fNCryptSetProperty                = _ncrypt.NewProc("NCryptSetProperty")
+	_fNCryptFinalizeKey                = _ncrypt.NewProc("NCryptFinalizeKey")
+	_fNCryptEncrypt                    = _ncrypt.NewProc("NCryptEncrypt")
+	_fNCryptDecrypt                    = _ncrypt.NewProc("NCryptDecrypt")
+	_fNCryptFreeObject                 = _ncrypt.NewProc("NCryptFreeObject")
+	_oaepAlgorithm, _                  = windows.UTF16PtrFromString("SHA1")
+	_rsaAlgorithm, _                   = windows.UTF16PtrFromString("RSA")
+	_lengthProperty, _                 = windows.UTF16PtrFromString("Length")
+	_tpmProviderNamePtr, _             = windows.UTF16PtrFromString(tpmProviderName)
+	_tpmKeyNamePtr, _                  = windows.UTF16PtrFromString(tpmKeyName)
+	_rsaKeyLength               uint32 = 2048
+)
+
+// BCRYPT_OAEP_PADDING_INFO
+type oaepPaddingInfo struct {
+	pszAlgId *uint16
+	pbLabel  *byte
+	cbLabel  uint32
+}
+
+type ncryptHandle uintptr
+
+func implName() string {
+	return "tpm2"
+}
+
+// implCheckIsAvailable checks if the TPM provider is available
+// (the persisted key is not created here: it is created on the first use)
+func implCheckIsAvailable() error {
+	provider, err := tpmOpenProvider()
+	if err != nil {
+		return err
+	}
+	defer ncryptFreeObject(provider)
+
+	var key ncryptHandle
+	err = ncryptCall(_fNCryptOpenKey, uintptr(provider), uintptr(unsafe.Pointer(&key)), uintptr(unsafe.Pointer(_tpmKeyNamePtr)), 0, _NCRYPT_MACHINE_KEY_FLAG)
+	if err == nil {
+		ncryptFreeObject(key)
+		return nil
+	}
+	if err != windows.Errno(_NTE_BAD_KEYSET) {
+		return fmt.Errorf("unable to open TPM key: %w", err)
+	}
+	return nil // the key does not exist yet
+}
+
+func implProtect(secret []byte) ([]byte, error) {
+	key, err := tpmOpenKey()
+	if err != nil {
+		return nil, err
+	}
+	defer ncryptFreeObject(key)
+
+	return ncryptCrypt(_fNCryptEncrypt, key, secret)
+}
+
+func implUnprotect(data []byte) ([]byte, error) {
+	key, err := tpmOpenKey()
+	if err != nil {
+		return nil, err
+	}
+	defer ncryptFreeObject(key)
+
+	return ncryptCrypt(_fNCryptDecrypt, key, data)
+}
+
+// tpmOpenProvider opens the TPM key storage provider
+func tpmOpenProvider() (ncryptHandle, error) {
+	if err := _ncrypt.Load(); err != nil {
+		return 0, err
+	}
+
+	var provider ncryptHandle
+	if err := ncryptCall(_fNCryptOpenStorageProvider, uintptr(unsafe.Pointer(&provider)), uintptr(unsafe.Pointer(_tpmProviderNamePtr)), 0); err != nil {
+		return 0, fmt.Errorf("unable to open TPM provider: %w", err)
+	}
+	return provider, nil
+}
+
+// tpmOpenKey opens the persisted TPM key (the key will be created if it does not exist)
+func tpmOpenKey() (ncryptHandle, error) {
+	provider, err := tpmOpenProvider()
+	if err != nil {
+		return 0, err
+	}
+	defer ncryptFreeObject(provider)
+
+	var key ncryptHandle
+	err = ncryptCall(_fNCryptOpenKey, uintptr(provider), uintptr(unsafe.Pointer(&key)), uintptr(unsafe.Pointer(_tpmKeyNamePtr)), 0, _NCRYPT_MACHINE_KEY_FLAG)
+	if err == nil {
+		return key, nil
+	}
+	if err != windows.Errno(_NTE_BAD_KEYSET) {
+		return 0, fmt.Errorf("unable to open TPM key: %w", err)
+	}
+
+	// the key does not exist: create new one
+	if err := ncryptCall(_fNCryptCreatePersistedKey, uintptr(provider), uintptr(unsafe.Pointer(&key)), uintptr(unsafe.Pointer(_rsaAlgorithm)), uintptr(unsafe.Pointer(_tpmKeyNamePtr)), 0, _NCRYPT_MACHINE_KEY_FLAG); err != nil {
+		return 0, fmt.Errorf("unable to create TPM key: %w", err)
+	}
+	if err := ncryptCall(_fNCryptSetProperty, uintptr(key), uintptr(unsafe.Pointer(_lengthProperty)), uintptr(unsafe.Pointer(&_rsaKeyLength)), unsafe.Sizeof(_rsaKeyLength), 0); err != nil {
+		ncryptFreeObject(key)
+		return 0, fmt.Errorf("unable to set TPM key length: %w", err)
+	}
+	if err := ncryptCall(_fNCryptFinalizeKey, uintptr(key), 0); err != nil {
+		ncryptFreeObject(key)
+		return 0, fmt.Errorf("unable to finalize TPM key: %w", err)
+	}
+
+	log.Info("TPM key created")
+	return key, nil
+}
+
+// ncryptCrypt calls NCryptEncrypt or NCryptDecrypt (they have the same signature)
+func ncryptCrypt(proc *windows.LazyProc, key ncryptHandle, in []byte) ([]byte, error) {
+	if len(in) == 0 {
+		return nil, fmt.Errorf("no data")
+	}
+
+	padding := oaepPaddingInfo{pszAlgId: _oaepAlgorithm}
+
+	var size uint32
+	// get required buffer size
+	if err := ncryptCall(proc, uintptr(key), uintptr(unsafe.Pointer(&in[0])), uintptr(len(in)), uintptr(unsafe.Pointer(&padding)), 0, 0, uintptr(unsafe.Pointer(&size)), _NCRYPT_PAD_OAEP_FLAG); err != nil {
+		return nil, err
+	}
+	if size == 0 {
+		return nil, fmt.Errorf("unexpected buffer size")
+	}
+
+	out := make([]byte, size)
+	if err := ncryptCall(proc, uintptr(key), uintptr(unsafe.Pointer(&in[0])), uintptr(len(in)), uintptr(unsafe.Pointer(&padding)), uintptr(unsafe.Pointer(&out[0])), uintptr(len(out)), uintptr(unsafe.Pointer(&size)), _NCRYPT_PAD_OAEP_FLAG); err != nil {
+		return nil, err
+	}
+
+	return out[:size], nil
+}
+
+func ncryptFreeObject(h ncryptHandle) {
+	ncryptCall(_fNCryptFreeObject, uintptr(h))
+}
+
+// ncryptCall calls NCrypt function and converts returned SECURITY_STATUS to error
+func ncryptCall(proc *windows.LazyProc, args ...uintptr) error {
+	ret, _, _ := proc.Call(args...)
+	if ret != 0 {
+		return windows.Errno(ret)
+	}
+	return nil
+}
@@ -35,6 +35,8 @@ import (
 	"time"
 
 	"github.com/ivpn/desktop-app/daemon/api"
+	"github.com/ivpn/desktop-app/daemon/auditlog"
+	"github.com/ivpn/desktop-app/daemon/crashreport"
 	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/netchange"
 	"github.com/ivpn/desktop-app/daemon/protocol"
@@ -42,6 +44,7 @@ import (
 	"github.com/ivpn/desktop-app/daemon/service/firewall"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
 	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"github.com/ivpn/desktop-app/daemon/service/subsystems"
 	"github.com/ivpn/desktop-app/daemon/service/wgkeys"
 	"github.com/ivpn/desktop-app/daemon/version"
 )
@@ -68,6 +71,8 @@ type IProtocol interface {
 func Launch() {
 	warnings, errors, logInfo := platform.Init()
 	logger.Init(platform.LogFile())
+	auditlog.Init(platform.AuditLogFile())
+	crashreport.Init(platform.CrashReportsDir())
 
 	// Logging enabled from command line argument ('-logging').
 	// Logging can be enabled from command line or from previously saved daemon preferences
@@ -89,15 +94,25 @@ func Launch() {
 		}
 	}
 
+	// initialize logging according to service preferences
+	var prefs preferences.Preferences
+	isPrefsLoaded := prefs.LoadPreferences() == nil
+	if isPrefsLoaded {
+		logger.SetJSONFormat(prefs.IsLogJSONFormat)
+		logger.SetPrivacyMode(prefs.IsLogPrivacyMode)
+		if err := logger.SetRotation(prefs.LogRotation); err != nil {
+			logger.Error(err)
+		}
+		if err := logger.SetOutput(prefs.LogOutput); err != nil {
+			logger.Error(err)
+		}
+	}
+
 	if isLoggingEnabledArgument {
 		logger.Enable(true)
 		logger.Info("Logging enabled (forced by command line argument)")
-	} else {
-		// initialize logging according to service preferences
-		var prefs preferences.Preferences
-		if err := prefs.LoadPreferences(); err == nil {
-			logger.Enable(prefs.IsLogging)
-		}
+	} else if isPrefsLoaded {
+		logger.Enable(prefs.IsLogging)
 	}
 
 	// Log full version
@@ -114,16 +129,24 @@ func Launch() {
 	for _, platformInitLogItem := range logInfo {
 		logger.Info(fmt.Sprintf("INIT: %s", platformInitLogItem))
 	}
-	// Logging platform initialization warnings
-	for _, w := range warnings {
-		logger.Warning(w)
-	}
-	// Logging platform initialization errors
-	if len(errors) > 0 {
-		for _, e := range errors {
-			logger.Error(e)
-		}
-
+	// Register the status of the base subsystems.
+	// (the platform and the logger have to be initialized before the logging enabled, so here we only keep the results)
+	err := subsystems.Initialize(
+		subsystems.Subsystem{Name: subsystems.Platform, Critical: true, Init: func() ([]string, error) {
+			for _, e := range errors {
+				logger.Error(e)
+			}
+			if len(errors) > 0 {
+				return warnings, fmt.Errorf("%d initialization errors (first error: %w)", len(errors), errors[0])
+			}
+			return warnings, nil
+		}},
+		subsystems.Subsystem{Name: subsystems.Logger, DependsOn: []subsystems.Name{subsystems.Platform}, Init: func() ([]string, error) {
+			return nil, nil
+		}},
+	)
+	if err != nil {
+		logger.Error(err)
 		logger.Info("Daemon failed to start due to initialization errors")
 		os.Exit(1)
 		return
@@ -159,6 +182,7 @@ func Launch() {
 	go func() {
 		// waiting for port number info
 		openedPort := <-startedOnPortChan
+		subsystems.SetStatus(subsystems.Protocol, nil, nil)
 
 		// save port info into a file (UI clients is able to read it)
 		if isNeedToSavePortInFile() == true {
@@ -255,7 +279,11 @@ func doCleanup() (osExitCode int) {
 // initialize and start service
 func launchService(secret uint64, startedOnPort chan<- int) {
 	// API object
-	apiObj, err := api.CreateAPI()
+	var apiObj *api.API
+	err := subsystems.Initialize(subsystems.Subsystem{Name: subsystems.Api, DependsOn: []subsystems.Name{subsystems.Platform}, Critical: true, Init: func() (w []string, err error) {
+		apiObj, err = api.CreateAPI()
+		return nil, err
+	}})
 	if err != nil {
 		log.Panic("API object initialization failed: ", err)
 	}
@@ -270,7 +298,7 @@ func launchService(secret uint64, startedOnPort chan<- int) {
 	netDetector := netchange.Create()
 
 	// WireGuard keys manager
-	wgKeysMgr := wgkeys.CreateKeysManager(apiObj, platform.WgToolBinaryPath())
+	wgKeysMgr := wgkeys.CreateKeysManager(apiObj, platform.WgToolBinaryPath(), platform.KemHelperBinaryPath())
 
 	// communication protocol
 	protocol, err := protocol.CreateProtocol()
@@ -299,8 +327,15 @@ func launchService(secret uint64, startedOnPort chan<- int) {
 		protocol.Stop()
 	}()
 
+	// communication protocol server: the status will be updated as soon as the server started listening
+	// (the service subsystems are already initialized by service.CreateService())
+	if err := subsystems.Initialize(subsystems.Subsystem{Name: subsystems.Protocol, DependsOn: []subsystems.Name{subsystems.Api, subsystems.Firewall}, Critical: true}); err != nil {
+		log.Panic("Protocol initialization failed: ", err)
+	}
+
 	// start receiving requests from client (synchronous)
 	if err := protocol.Start(secret, startedOnPort, serv); err != nil {
 		log.Error("Protocol stopped with error:", err)
+		subsystems.SetStatus(subsystems.Protocol, nil, err)
 	}
 }
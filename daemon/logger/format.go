@@ -0,0 +1,155 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+const levelInfo = "INFO"
+
+// Fields - structured data of the log message (key/value pairs).
+// It can be passed as an argument to any log function, e.g.:
+//
+//	log.Info("Connected", logger.Fields{"server": host, "port": port})
+//
+// In text format, the fields are appended to the message as 'key=value'.
+// In JSON format, the fields are written as a separate 'fields' object.
+type Fields map[string]interface{}
+
+// record - single log record
+type record struct {
+	time     time.Time
+	module   string // name of the logger (e.g. "[vpn   ]")
+	level    string
+	caller   string // source code location: "<file>:<line>:"
+	location string // source code location which is shown in text format (empty for info messages)
+	message  string
+	fields   Fields
+	session  string // ID of the connection session (empty - no active session)
+}
+
+// splitFields returns the log message and the structured fields (if any) passed to the log function
+func splitFields(v []interface{}) (message string, fields Fields) {
+	args := make([]interface{}, 0, len(v))
+	for _, a := range v {
+		f, ok := a.(Fields)
+		if !ok {
+			args = append(args, a)
+			continue
+		}
+		if fields == nil {
+			fields = make(Fields, len(f))
+		}
+		for k, val := range f {
+			fields[k] = val
+		}
+	}
+	return fmt.Sprint(args...), fields
+}
+
+// sortedKeys returns the field names in alphabetical order
+func (f Fields) sortedKeys() []string {
+	keys := make([]string, 0, len(f))
+	for k := range f {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// text returns the log record in plain text format
+func (r record) text() string {
+	if len(r.session) > 0 {
+		return r.time.Format(time.StampMilli) + " " + SessionTag(r.session) + " " + r.body()
+	}
+	return r.time.Format(time.StampMilli) + " " + r.body()
+}
+
+// body returns the log record in plain text format without timestamp
+func (r record) body() string {
+	message := r.message
+	if len(r.fields) > 0 {
+		var b strings.Builder
+		b.WriteString(message)
+		for _, k := range r.fields.sortedKeys() {
+			fmt.Fprintf(&b, " %s=%v", k, r.fields[k])
+		}
+		message = b.String()
+	}
+
+	if r.level == levelInfo {
+		return strings.TrimRight(fmt.Sprintln(r.module, message), "\n")
+	}
+	return strings.TrimRight(fmt.Sprintln(r.module, r.level, r.location, message), "\n")
+}
+
+// json returns the log record in JSON format (single line)
+func (r record) json() string {
+	type jsonRecord struct {
+		Time    string `json:"time"`
+		Module  string `json:"module,omitempty"`
+		Level   string `json:"level"`
+		Caller  string `json:"caller,omitempty"`
+		Session string `json:"session,omitempty"`
+		Message string `json:"message"`
+		Fields  Fields `json:"fields,omitempty"`
+	}
+
+	jr := jsonRecord{
+		Time:    r.time.Format(time.RFC3339Nano),
+		Module:  strings.Trim(r.module, "[] "),
+		Level:   r.level,
+		Caller:  strings.TrimSuffix(r.caller, ":"),
+		Session: r.session,
+		Message: r.message,
+	}
+
+	if len(r.fields) > 0 {
+		jr.Fields = make(Fields, len(r.fields))
+		for k, v := range r.fields {
+			if err, ok := v.(error); ok {
+				// the error objects are not serializable
+				v = err.Error()
+			}
+			jr.Fields[k] = v
+		}
+	}
+
+	data, err := json.Marshal(jr)
+	if err != nil && jr.Fields != nil {
+		// some of the fields are not serializable: use the text representation of the values
+		for k, v := range jr.Fields {
+			jr.Fields[k] = fmt.Sprint(v)
+		}
+		data, err = json.Marshal(jr)
+	}
+	if err != nil {
+		return fmt.Sprintf(`{"time":%q,"level":"ERROR","message":"failed to serialize log record"}`, jr.Time)
+	}
+	return string(data)
+}
@@ -0,0 +1,159 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package logger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Level - verbosity level of the log messages
+type Level int
+
+const (
+	LevelDebug   Level = iota // all messages (including 'Debug' and 'Trace')
+	LevelInfo                 // 'Info', 'Warning' and 'Error' messages
+	LevelWarning              // 'Warning' and 'Error' messages
+	LevelError                // only 'Error' messages
+)
+
+var levelNames = map[Level]string{
+	LevelDebug:   "debug",
+	LevelInfo:    "info",
+	LevelWarning: "warning",
+	LevelError:   "error",
+}
+
+func (l Level) String() string {
+	if n, ok := levelNames[l]; ok {
+		return n
+	}
+	return fmt.Sprintf("<unknown level %d>", l)
+}
+
+// logLevel returns the verbosity level of the record
+func (r record) logLevel() Level {
+	switch r.level {
+	case levelInfo:
+		return LevelInfo
+	case "WARNING":
+		return LevelWarning
+	case "ERROR", "PANIC":
+		return LevelError
+	default: // "DEBUG", "TRACE"
+		return LevelDebug
+	}
+}
+
+// ParseLevel converts the level name ("debug", "info", "warning", "error") to Level
+func ParseLevel(name string) (Level, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for l, n := range levelNames {
+		if n == name {
+			return l, nil
+		}
+	}
+	return LevelDebug, fmt.Errorf("unknown log level '%s' (expected: debug, info, warning, error)", name)
+}
+
+// The log levels can be changed at runtime (they are not saved: after the daemon restart all the messages are logged).
+var levelsMutex sync.RWMutex
+
+// default level (for all modules without specific level)
+var defaultLevel = LevelDebug
+
+// levels of specific modules
+var moduleLevels = make(map[string]Level)
+
+// names of all created loggers (modules)
+var knownModules = make(map[string]struct{})
+
+// moduleName returns the name of the module by the logger prefix (e.g. "[dns   ]" -> "dns")
+func moduleName(prefix string) string {
+	return strings.Trim(prefix, "[] ")
+}
+
+func registerModule(name string) {
+	if len(name) == 0 {
+		return
+	}
+	levelsMutex.Lock()
+	defer levelsMutex.Unlock()
+	knownModules[name] = struct{}{}
+}
+
+func isLevelEnabled(module string, l Level) bool {
+	levelsMutex.RLock()
+	defer levelsMutex.RUnlock()
+
+	if ml, ok := moduleLevels[module]; ok {
+		return l >= ml
+	}
+	return l >= defaultLevel
+}
+
+// SetDefaultLevel sets the log level for all the modules which have no specific level
+func SetDefaultLevel(l Level) {
+	levelsMutex.Lock()
+	defer levelsMutex.Unlock()
+	defaultLevel = l
+}
+
+// SetModuleLevel sets the log level of the specific module (e.g. "dns")
+func SetModuleLevel(module string, l Level) error {
+	module = strings.TrimSpace(module)
+
+	levelsMutex.Lock()
+	defer levelsMutex.Unlock()
+
+	if _, ok := knownModules[module]; !ok {
+		return fmt.Errorf("unknown log module '%s'", module)
+	}
+	moduleLevels[module] = l
+	return nil
+}
+
+// ResetModuleLevel removes the specific log level of the module (the default level is in use)
+func ResetModuleLevel(module string) {
+	levelsMutex.Lock()
+	defer levelsMutex.Unlock()
+	delete(moduleLevels, strings.TrimSpace(module))
+}
+
+// Levels returns the default log level, the specific levels of the modules and the names of all known modules (sorted)
+func Levels() (defLevel Level, modules map[string]Level, allModules []string) {
+	levelsMutex.RLock()
+	defer levelsMutex.RUnlock()
+
+	modules = make(map[string]Level, len(moduleLevels))
+	for m, l := range moduleLevels {
+		modules[m] = l
+	}
+	for m := range knownModules {
+		allModules = append(allModules, m)
+	}
+	sort.Strings(allModules)
+	return defaultLevel, modules, allModules
+}
@@ -41,9 +41,23 @@ var isLoggingEnabled bool
 var filePath string
 var writeMutex sync.Mutex
 var globalLogFile *os.File
+var isJSONFormat bool
 
 var log *Logger
 
+// log listeners: functions which receive all the log messages (when logging is enabled)
+var listenersMutex sync.Mutex
+var listeners = make(map[*func(m Message)]struct{})
+
+// Message - the log message which is passed to the listeners
+type Message struct {
+	Time    time.Time
+	Module  string // name of the logger module (e.g. "dns"); empty for the messages of the global logger
+	Level   Level
+	Session string // ID of the connection session (empty - no active session)
+	Line    string // formatted log record (as it is written to the log file)
+}
+
 func init() {
 	log = NewLogger("log")
 }
@@ -99,6 +113,20 @@ func IsEnabled() bool {
 	return isLoggingEnabled
 }
 
+// IsJSONFormat returns true if the log records are written in structured JSON format
+func IsJSONFormat() bool {
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+	return isJSONFormat
+}
+
+// SetJSONFormat switching on\off structured JSON format of the log records (one JSON object per line)
+func SetJSONFormat(isJSON bool) {
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+	isJSONFormat = isJSON
+}
+
 // CanPrintToConsole define if logger can print to console
 //func CanPrintToConsole(isCanPrint bool) {
 //	isCanPrintToConsole = isCanPrint
@@ -133,22 +161,46 @@ func Enable(isEnabled bool) {
 }
 
 // Info - Log info message
-func Info(v ...interface{}) { _info("", v...) }
+func Info(v ...interface{}) {
+	if isLevelEnabled("", LevelInfo) {
+		_info("", v...)
+	}
+}
 
 // Debug - Log Debug message
-func Debug(v ...interface{}) { _debug("", v...) }
+func Debug(v ...interface{}) {
+	if isLevelEnabled("", LevelDebug) {
+		_debug("", v...)
+	}
+}
 
 // Warning - Log Warning message
-func Warning(v ...interface{}) { _warning("", v...) }
+func Warning(v ...interface{}) {
+	if isLevelEnabled("", LevelWarning) {
+		_warning("", v...)
+	}
+}
 
 // Trace - Log Trace message
-func Trace(v ...interface{}) { _trace("", v...) }
+func Trace(v ...interface{}) {
+	if isLevelEnabled("", LevelDebug) {
+		_trace("", v...)
+	}
+}
 
 // Error - Log Error message
-func Error(v ...interface{}) { _error("", 0, v...) }
+func Error(v ...interface{}) {
+	if isLevelEnabled("", LevelError) {
+		_error("", 0, v...)
+	}
+}
 
 // ErrorTrace - Log error with trace
-func ErrorTrace(e error) { _errorTrace("", e) }
+func ErrorTrace(e error) {
+	if isLevelEnabled("", LevelError) {
+		_errorTrace("", e)
+	}
+}
 
 // Panic - Log Error message and call panic()
 func Panic(v ...interface{}) { _panic("", v...) }
@@ -156,6 +208,7 @@ func Panic(v ...interface{}) { _panic("", v...) }
 // Logger - standalone logger object
 type Logger struct {
 	pref       string
+	module     string
 	isDisabled bool
 }
 
@@ -177,13 +230,16 @@ func NewLogger(prefix string) *Logger {
 		}
 	}
 
+	module := moduleName(prefix)
+	registerModule(module)
+
 	prefix = "[" + prefix + "]"
-	return &Logger{pref: prefix}
+	return &Logger{pref: prefix, module: module}
 }
 
 // Info - Log info message
 func (l *Logger) Info(v ...interface{}) {
-	if l.isDisabled {
+	if l.isDisabled || !isLevelEnabled(l.module, LevelInfo) {
 		return
 	}
 	_info(l.pref, v...)
@@ -191,7 +247,7 @@ func (l *Logger) Info(v ...interface{}) {
 
 // Debug - Log Debug message
 func (l *Logger) Debug(v ...interface{}) {
-	if l.isDisabled {
+	if l.isDisabled || !isLevelEnabled(l.module, LevelDebug) {
 		return
 	}
 	_debug(l.pref, v...)
@@ -199,7 +255,7 @@ func (l *Logger) Debug(v ...interface{}) {
 
 // Warning - Log Warning message
 func (l *Logger) Warning(v ...interface{}) {
-	if l.isDisabled {
+	if l.isDisabled || !isLevelEnabled(l.module, LevelWarning) {
 		return
 	}
 	_warning(l.pref, v...)
@@ -207,7 +263,7 @@ func (l *Logger) Warning(v ...interface{}) {
 
 // Trace - Log Trace message
 func (l *Logger) Trace(v ...interface{}) {
-	if l.isDisabled {
+	if l.isDisabled || !isLevelEnabled(l.module, LevelDebug) {
 		return
 	}
 	_trace(l.pref, v...)
@@ -215,7 +271,7 @@ func (l *Logger) Trace(v ...interface{}) {
 
 // Error - Log Error message
 func (l *Logger) Error(v ...interface{}) {
-	if l.isDisabled {
+	if l.isDisabled || !isLevelEnabled(l.module, LevelError) {
 		return
 	}
 	_error(l.pref, 0, v...)
@@ -224,7 +280,7 @@ func (l *Logger) Error(v ...interface{}) {
 // ErrorE - Log Error and return same error object
 // (useful in constrictions: " return log.ErrorE(err) " )
 func (l *Logger) ErrorE(err error, callerStackOffset int) error {
-	if l.isDisabled {
+	if l.isDisabled || !isLevelEnabled(l.module, LevelError) {
 		return err
 	}
 	_error(l.pref, callerStackOffset, err)
@@ -233,7 +289,7 @@ func (l *Logger) ErrorE(err error, callerStackOffset int) error {
 
 // ErrorTrace - Log error with trace
 func (l *Logger) ErrorTrace(e error) {
-	if l.isDisabled {
+	if l.isDisabled || !isLevelEnabled(l.module, LevelError) {
 		return
 	}
 	_errorTrace(l.pref, e)
@@ -251,40 +307,46 @@ func (l *Logger) Panic(v ...interface{}) {
 func (l *Logger) Enable(enable bool) { l.isDisabled = !enable }
 
 func _info(name string, v ...interface{}) {
-	mes, timeStr, _, _ := getLogPrefixes(fmt.Sprint(v...), 0)
-	write(timeStr, name, mes)
+	message, fields := splitFields(v)
+	mes, t, runtimeInfo, _ := getLogPrefixes(message, 0)
+	write(record{time: t, module: name, level: levelInfo, caller: runtimeInfo, message: mes, fields: fields})
 }
 
 func _debug(name string, v ...interface{}) {
-	mes, timeStr, runtimeInfo, _ := getLogPrefixes(fmt.Sprint(v...), 0)
-	write(timeStr, name, "DEBUG", runtimeInfo, mes)
+	message, fields := splitFields(v)
+	mes, t, runtimeInfo, _ := getLogPrefixes(message, 0)
+	write(record{time: t, module: name, level: "DEBUG", caller: runtimeInfo, location: runtimeInfo, message: mes, fields: fields})
 }
 
 func _warning(name string, v ...interface{}) {
-	mes, timeStr, runtimeInfo, _ := getLogPrefixes(fmt.Sprint(v...), 0)
-	write(timeStr, name, "WARNING", runtimeInfo, mes)
+	message, fields := splitFields(v)
+	mes, t, runtimeInfo, _ := getLogPrefixes(message, 0)
+	write(record{time: t, module: name, level: "WARNING", caller: runtimeInfo, location: runtimeInfo, message: mes, fields: fields})
 }
 
 func _trace(name string, v ...interface{}) {
-	mes, timeStr, runtimeInfo, methodInfo := getLogPrefixes(fmt.Sprint(v...), 0)
-	write(timeStr, name, "TRACE", runtimeInfo+methodInfo, mes)
+	message, fields := splitFields(v)
+	mes, t, runtimeInfo, methodInfo := getLogPrefixes(message, 0)
+	write(record{time: t, module: name, level: "TRACE", caller: runtimeInfo, location: runtimeInfo + methodInfo, message: mes, fields: fields})
 }
 
 func _error(name string, callerStackOffset int, v ...interface{}) {
-	mes, timeStr, runtimeInfo, methodInfo := getLogPrefixes(fmt.Sprint(v...), callerStackOffset)
-	write(timeStr, name, "ERROR", runtimeInfo+methodInfo, mes)
+	message, fields := splitFields(v)
+	mes, t, runtimeInfo, methodInfo := getLogPrefixes(message, callerStackOffset)
+	write(record{time: t, module: name, level: "ERROR", caller: runtimeInfo, location: runtimeInfo + methodInfo, message: mes, fields: fields})
 }
 
 func _errorTrace(name string, err error) {
-	mes, timeStr, runtimeInfo, methodInfo := getLogPrefixes(getErrorDetails(err), 0)
-	write(timeStr, name, "ERROR", runtimeInfo+methodInfo, mes)
+	mes, t, runtimeInfo, methodInfo := getLogPrefixes(getErrorDetails(err), 0)
+	write(record{time: t, module: name, level: "ERROR", caller: runtimeInfo, location: runtimeInfo + methodInfo, message: mes})
 }
 
 func _panic(name string, v ...interface{}) {
-	mes, timeStr, runtimeInfo, methodInfo := getLogPrefixes(fmt.Sprint(v...), 0)
+	message, fields := splitFields(v)
+	mes, t, runtimeInfo, methodInfo := getLogPrefixes(message, 0)
 
 	//fmt.Println(timeStr, "PANIC", runtimeInfo+methodInfo, mes)
-	write(timeStr, name, "PANIC", runtimeInfo+methodInfo, mes)
+	write(record{time: t, module: name, level: "PANIC", caller: runtimeInfo, location: runtimeInfo + methodInfo, message: mes, fields: fields})
 
 	panic(runtimeInfo + methodInfo + ": " + mes)
 }
@@ -309,8 +371,8 @@ func getCallerMethodName(callerStackOffset int) (string, error) {
 	return caller.Name(), nil
 }
 
-func getLogPrefixes(message string, callerStackOffset int) (retMes string, timeStr string, runtimeInfo string, methodInfo string) {
-	t := time.Now()
+func getLogPrefixes(message string, callerStackOffset int) (retMes string, t time.Time, runtimeInfo string, methodInfo string) {
+	t = time.Now()
 
 	if _, filename, line, isRuntimeInfoOk := runtime.Caller(3 + callerStackOffset); isRuntimeInfoOk {
 		runtimeInfo = filepath.Base(filename) + ":" + strconv.Itoa(line) + ":"
@@ -320,20 +382,71 @@ func getLogPrefixes(message string, callerStackOffset int) (retMes string, timeS
 		}
 	}
 
-	timeStr = t.Format(time.StampMilli)
 	retMes = strings.TrimRight(message, "\n")
 
-	return retMes, timeStr, runtimeInfo, methodInfo
+	return retMes, t, runtimeInfo, methodInfo
 }
 
-func write(fields ...interface{}) {
+// AddListener registers the function which receives all the log messages (only when logging is enabled).
+// The function must not block and must not write to the log.
+// Returns the function to unregister the listener.
+func AddListener(listener func(m Message)) (remove func()) {
+	listenersMutex.Lock()
+	defer listenersMutex.Unlock()
+
+	key := &listener
+	listeners[key] = struct{}{}
+	return func() {
+		listenersMutex.Lock()
+		defer listenersMutex.Unlock()
+		delete(listeners, key)
+	}
+}
+
+func notifyListeners(r record, line string) {
+	listenersMutex.Lock()
+	defer listenersMutex.Unlock()
+
+	if len(listeners) == 0 {
+		return
+	}
+
+	m := Message{Time: r.time, Module: moduleName(r.module), Level: r.logLevel(), Session: r.session, Line: line}
+	for l := range listeners {
+		(*l)(m)
+	}
+}
+
+func write(r record) {
 	writeMutex.Lock()
 	defer writeMutex.Unlock()
 
 	if isLoggingEnabled {
+		r.session = sessionID
+		if isPrivacyMode {
+			r = r.scrubbed()
+		}
+
+		var line string
+		if isJSONFormat {
+			line = r.json()
+		} else {
+			line = r.text()
+		}
+
+		notifyListeners(r, line)
+
 		if isCanPrintToConsole {
 			// printing into console
-			fmt.Println(fields...)
+			fmt.Println(line)
+		}
+
+		if output.isSystem() {
+			writeToSystemLog(r)
+		}
+
+		if !output.isFile() {
+			return
 		}
 
 		if globalLogFile == nil {
@@ -342,7 +455,14 @@ func write(fields ...interface{}) {
 
 		if globalLogFile != nil {
 			// writting into log-file
-			globalLogFile.WriteString(fmt.Sprintln(fields...))
+			n, _ := globalLogFile.WriteString(line + "\n")
+			logFileSize += int64(n)
+
+			if logFileSize >= int64(rotation.MaxSizeMB)*1024*1024 {
+				// the next record will be written to the new file
+				globalLogFile.Close()
+				globalLogFile = nil
+			}
 		}
 	}
 }
@@ -358,7 +478,7 @@ func deleteLogFile() {
 
 	if len(filePath) > 0 {
 		os.Remove(filePath)
-		os.Remove(filePath + ".0")
+		removeRotatedLogFiles()
 	}
 }
 
@@ -369,9 +489,8 @@ func createLogFile() error {
 	}
 
 	if len(filePath) > 0 {
-		if _, err := os.Stat(filePath); err == nil {
-			os.Rename(filePath, filePath+".0")
-		}
+		rotateLogFiles()
+		logFileSize = 0
 
 		var err error
 		globalLogFile, err = os.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600) // read\write only for privileged user
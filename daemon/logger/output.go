@@ -0,0 +1,147 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package logger
+
+import (
+	"fmt"
+)
+
+// Output - destination of the daemon log records
+type Output string
+
+const (
+	// OutputFile - the log records are written to the log file only (default)
+	OutputFile Output = "file"
+	// OutputSystem - the log records are written to the system log only (journald/syslog; Linux only)
+	OutputSystem Output = "system"
+	// OutputFileAndSystem - the log records are written both to the log file and to the system log
+	OutputFileAndSystem Output = "both"
+)
+
+// system log priorities (RFC 5424 severity)
+const (
+	priorityCrit    = 2
+	priorityErr     = 3
+	priorityWarning = 4
+	priorityInfo    = 6
+	priorityDebug   = 7
+)
+
+// identifier of the daemon records in the system log
+const systemLogIdentifier = "ivpn-service"
+
+// systemLogWriter - writer of the log records to the system log
+type systemLogWriter interface {
+	writeRecord(r record) error
+	close()
+}
+
+var output = OutputFile
+var sysLog systemLogWriter
+
+// IsValid returns nil if the output value is supported on the current platform
+func (o Output) IsValid() error {
+	switch o {
+	case "", OutputFile:
+		return nil
+	case OutputSystem, OutputFileAndSystem:
+		if !isSystemLogSupported() {
+			return fmt.Errorf("system log output is not supported on this platform")
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown log output '%s' (expected: %s, %s, %s)", o, OutputFile, OutputSystem, OutputFileAndSystem)
+}
+
+func (o Output) isFile() bool {
+	return o != OutputSystem
+}
+
+func (o Output) isSystem() bool {
+	return o == OutputSystem || o == OutputFileAndSystem
+}
+
+// GetOutput returns the current destination of the log records
+func GetOutput() Output {
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+	return output
+}
+
+// SetOutput sets the destination of the log records: log file, system log (journald/syslog) or both.
+// Note: when the log file is not in use, the logs are not included into the diagnostic reports.
+func SetOutput(o Output) error {
+	if err := o.IsValid(); err != nil {
+		return err
+	}
+	if o == "" {
+		o = OutputFile
+	}
+
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+
+	if !o.isSystem() && sysLog != nil {
+		sysLog.close()
+		sysLog = nil
+	}
+	output = o
+	return nil
+}
+
+// priority returns the system log priority of the record
+func (r record) priority() int {
+	switch r.level {
+	case levelInfo:
+		return priorityInfo
+	case "WARNING":
+		return priorityWarning
+	case "ERROR":
+		return priorityErr
+	case "PANIC":
+		return priorityCrit
+	default: // "DEBUG", "TRACE"
+		return priorityDebug
+	}
+}
+
+// writeToSystemLog writes the record to the system log (writeMutex must be locked)
+func writeToSystemLog(r record) {
+	if sysLog == nil {
+		w, err := newSystemLogWriter()
+		if err != nil {
+			// do not try to write this error into the system log (avoid recursion)
+			if isCanPrintToConsole {
+				fmt.Println("Failed to open system log:", err)
+			}
+			return
+		}
+		sysLog = w
+	}
+
+	if err := sysLog.writeRecord(r); err != nil {
+		// the connection is broken: reconnect on the next record
+		sysLog.close()
+		sysLog = nil
+	}
+}
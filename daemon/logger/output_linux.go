@@ -0,0 +1,152 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+//go:build linux
+// +build linux
+
+package logger
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"log/syslog"
+	"net"
+	"strings"
+	"unicode"
+)
+
+// socket of the systemd journal (native protocol)
+const journalSocket = "/run/systemd/journal/socket"
+
+func isSystemLogSupported() bool { return true }
+
+// newSystemLogWriter connects to the systemd journal; if journald is not available - to the syslog
+func newSystemLogWriter() (systemLogWriter, error) {
+	if w, err := newJournalWriter(); err == nil {
+		return w, nil
+	}
+
+	sw, err := syslog.New(syslog.LOG_DAEMON|syslog.LOG_INFO, systemLogIdentifier)
+	if err != nil {
+		return nil, err
+	}
+	return &syslogWriter{w: sw}, nil
+}
+
+// ----------------------------------------------------------------------
+// journald (native protocol: https://systemd.io/JOURNAL_NATIVE_PROTOCOL/)
+
+type journalWriter struct {
+	conn *net.UnixConn
+}
+
+func newJournalWriter() (*journalWriter, error) {
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: journalSocket, Net: "unixgram"})
+	if err != nil {
+		return nil, err
+	}
+	return &journalWriter{conn: conn}, nil
+}
+
+func (w *journalWriter) writeRecord(r record) error {
+	var b bytes.Buffer
+	journalField(&b, "MESSAGE", r.message)
+	journalField(&b, "PRIORITY", fmt.Sprint(r.priority()))
+	journalField(&b, "SYSLOG_IDENTIFIER", systemLogIdentifier)
+	if module := strings.Trim(r.module, "[] "); len(module) > 0 {
+		journalField(&b, "IVPN_MODULE", module)
+	}
+	if len(r.session) > 0 {
+		journalField(&b, "IVPN_SESSION", r.session)
+	}
+	if file, line, ok := strings.Cut(strings.TrimSuffix(r.caller, ":"), ":"); ok {
+		journalField(&b, "CODE_FILE", file)
+		journalField(&b, "CODE_LINE", line)
+	}
+	for _, k := range r.fields.sortedKeys() {
+		journalField(&b, "IVPN_"+journalFieldName(k), fmt.Sprint(r.fields[k]))
+	}
+
+	_, err := w.conn.Write(b.Bytes())
+	return err
+}
+
+func (w *journalWriter) close() {
+	w.conn.Close()
+}
+
+// journalField appends the field to the journal datagram
+func journalField(b *bytes.Buffer, name, value string) {
+	if !strings.Contains(value, "\n") {
+		fmt.Fprintf(b, "%s=%s\n", name, value)
+		return
+	}
+	// multi-line values: binary-safe format (name, '\n', little-endian 64-bit size, value, '\n')
+	b.WriteString(name)
+	b.WriteByte('\n')
+	binary.Write(b, binary.LittleEndian, uint64(len(value)))
+	b.WriteString(value)
+	b.WriteByte('\n')
+}
+
+// journalFieldName converts the field name to the journal format (uppercase letters, digits and underscores)
+func journalFieldName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r <= unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, name)
+}
+
+// ----------------------------------------------------------------------
+// syslog
+
+type syslogWriter struct {
+	w *syslog.Writer
+}
+
+func (w *syslogWriter) writeRecord(r record) error {
+	// the timestamp is added by syslog
+	message := strings.TrimSpace(r.body())
+	if len(r.session) > 0 {
+		message = SessionTag(r.session) + " " + message
+	}
+
+	switch r.priority() {
+	case priorityCrit:
+		return w.w.Crit(message)
+	case priorityErr:
+		return w.w.Err(message)
+	case priorityWarning:
+		return w.w.Warning(message)
+	case priorityInfo:
+		return w.w.Info(message)
+	default:
+		return w.w.Debug(message)
+	}
+}
+
+func (w *syslogWriter) close() {
+	w.w.Close()
+}
@@ -0,0 +1,99 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package logger
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+)
+
+// Privacy mode: the account IDs, public IP addresses and WireGuard keys are redacted from the log records
+// (so the logs can be shared without manual editing)
+var isPrivacyMode bool
+
+const (
+	redactedAccount = "[account-redacted]"
+	redactedIP      = "[ip-redacted]"
+	redactedKey     = "[key-redacted]"
+)
+
+var (
+	// account ID: "i-XXXX-XXXX-XXXX" or "ivpnXXXXXXXX"
+	accountIDRegexp = regexp.MustCompile(`\b(i-[a-zA-Z0-9]{4}-[a-zA-Z0-9]{4}-[a-zA-Z0-9]{4}|ivpn[a-zA-Z0-9]{7,8})\b`)
+	// WireGuard key (base64 of 32 bytes)
+	wgKeyRegexp = regexp.MustCompile(`(^|[^A-Za-z0-9+/])[A-Za-z0-9+/]{42}[AEIMQUYcgkosw048]=`)
+	// candidates for IP addresses (validated by net.ParseIP)
+	ipv4Regexp = regexp.MustCompile(`\b([0-9]{1,3}\.){3}[0-9]{1,3}\b`)
+	ipv6Regexp = regexp.MustCompile(`(?i)([0-9a-f]{1,4}|:)?(:[0-9a-f]{0,4}){2,7}`)
+)
+
+// IsPrivacyMode returns true if the sensitive data is redacted from the log records
+func IsPrivacyMode() bool {
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+	return isPrivacyMode
+}
+
+// SetPrivacyMode switching on\off redacting of the sensitive data (account IDs, public IPs, WireGuard keys) from the log records
+func SetPrivacyMode(isEnabled bool) {
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+	isPrivacyMode = isEnabled
+}
+
+// Scrub returns the text with redacted sensitive data (account IDs, public IP addresses, WireGuard keys)
+func Scrub(text string) string {
+	text = accountIDRegexp.ReplaceAllString(text, redactedAccount)
+	text = wgKeyRegexp.ReplaceAllString(text, "${1}"+redactedKey)
+	text = ipv4Regexp.ReplaceAllStringFunc(text, scrubIP)
+	text = ipv6Regexp.ReplaceAllStringFunc(text, scrubIP)
+	return text
+}
+
+// scrubIP redacts the public IP addresses (the local, private and special addresses are kept for troubleshooting)
+func scrubIP(s string) string {
+	ip := net.ParseIP(s)
+	if ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return s
+	}
+	return redactedIP
+}
+
+// scrubbed returns the copy of the record with redacted sensitive data
+func (r record) scrubbed() record {
+	r.message = Scrub(r.message)
+	if len(r.fields) > 0 {
+		fields := make(Fields, len(r.fields))
+		for k, v := range r.fields {
+			// keep the original value (and type) if there is nothing to redact
+			s := fmt.Sprint(v)
+			if redacted := Scrub(s); redacted != s {
+				v = redacted
+			}
+			fields[k] = v
+		}
+		r.fields = fields
+	}
+	return r
+}
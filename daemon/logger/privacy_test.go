@@ -0,0 +1,65 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package logger_test
+
+import (
+	"testing"
+
+	"github.com/ivpn/desktop-app/daemon/logger"
+)
+
+func TestScrub(t *testing.T) {
+	const wgKey = "gB6jV5h1cFvUR/EN8pXbs7hNdBqkH0qM6+TJkfBOJ3o="
+
+	// input -> expected output
+	tests := [][2]string{
+		// nothing to redact
+		{"Connected (port 2049) in 1.5s", "Connected (port 2049) in 1.5s"},
+		{"i-AB12-cd34", "i-AB12-cd34"},
+		{"version 1.2.3.456", "version 1.2.3.456"},
+		{"12:34:56 aa:bb:cc:dd:ee:ff", "12:34:56 aa:bb:cc:dd:ee:ff"},
+
+		// account IDs
+		{"Logged in: i-AB12-cd34-EF56.", "Logged in: [account-redacted]."},
+		{"account=ivpnAbC1234d", "account=[account-redacted]"},
+
+		// WireGuard keys
+		{"public key: " + wgKey, "public key: [key-redacted]"},
+		{`{"PublicKey":"` + wgKey + `"}`, `{"PublicKey":"[key-redacted]"}`},
+
+		// IP addresses: only the public ones are redacted
+		{"Remote: 185.159.157.1:2049", "Remote: [ip-redacted]:2049"},
+		{"Gateway 192.168.1.1; local 10.0.12.3; 172.16.0.1", "Gateway 192.168.1.1; local 10.0.12.3; 172.16.0.1"},
+		{"127.0.0.1 0.0.0.0 255.255.255.255 224.0.0.1 169.254.1.1", "127.0.0.1 0.0.0.0 255.255.255.255 224.0.0.1 169.254.1.1"},
+		{"IPv6: 2a07:b944::2:1 connected", "IPv6: [ip-redacted] connected"},
+		{"fd00:4956:504e:ffff::2 fe80::1 ::1", "fd00:4956:504e:ffff::2 fe80::1 ::1"},
+
+		{"i-AB12-cd34-EF56 8.8.8.8 " + wgKey, "[account-redacted] [ip-redacted] [key-redacted]"},
+	}
+
+	for _, tt := range tests {
+		if got := logger.Scrub(tt[0]); got != tt[1] {
+			t.Errorf("Scrub(%q) = %q; expected %q", tt[0], got, tt[1])
+		}
+	}
+}
@@ -0,0 +1,211 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package logger
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/service/platform/filerights"
+)
+
+const (
+	// RotationDefaultMaxSizeMB - default max size of the log file (MB) before rotation
+	RotationDefaultMaxSizeMB = 20
+	// RotationDefaultMaxFiles - default number of rotated log files to keep
+	RotationDefaultMaxFiles = 1
+
+	RotationMaxSizeMBLimit = 1024
+	RotationMaxFilesLimit  = 100
+)
+
+// RotationConfig - configuration of the log files rotation.
+// The active log file is rotated when it reaches 'MaxSizeMB' (and on each daemon start).
+// Rotated files: '<log>.0' (the newest one; never compressed), '<log>.1[.gz]' ... '<log>.<MaxFiles-1>[.gz]'.
+type RotationConfig struct {
+	// Max size of the active log file (MB); 0 - default (RotationDefaultMaxSizeMB)
+	MaxSizeMB int
+	// Number of rotated log files to keep; 0 - default (RotationDefaultMaxFiles)
+	MaxFiles int
+	// Max age of the rotated log files (days); the older files are removed. 0 - not limited
+	MaxAgeDays int
+	// If true - the rotated log files (except the newest one) are compressed (gzip)
+	Compress bool
+}
+
+// Validate checks the rotation configuration
+func (c RotationConfig) Validate() error {
+	if c.MaxSizeMB < 0 || c.MaxSizeMB > RotationMaxSizeMBLimit {
+		return fmt.Errorf("max size of the log file must be in range 1-%d MB", RotationMaxSizeMBLimit)
+	}
+	if c.MaxFiles < 0 || c.MaxFiles > RotationMaxFilesLimit {
+		return fmt.Errorf("number of rotated log files must be in range 1-%d", RotationMaxFilesLimit)
+	}
+	if c.MaxAgeDays < 0 {
+		return fmt.Errorf("max age of the log files can not be negative")
+	}
+	return nil
+}
+
+// Normalized returns the configuration with the default values applied
+func (c RotationConfig) Normalized() RotationConfig {
+	if c.MaxSizeMB <= 0 {
+		c.MaxSizeMB = RotationDefaultMaxSizeMB
+	}
+	if c.MaxFiles <= 0 {
+		c.MaxFiles = RotationDefaultMaxFiles
+	}
+	return c
+}
+
+var rotation = RotationConfig{}.Normalized()
+
+// size of the active log file
+var logFileSize int64
+
+// SetRotation sets the configuration of the log files rotation
+func SetRotation(cfg RotationConfig) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+
+	rotation = cfg.Normalized()
+	if globalLogFile != nil {
+		removeOutdatedLogFiles()
+	}
+	return nil
+}
+
+// rotatedFileName returns the name of the rotated log file with the specified index
+func rotatedFileName(idx int, isCompressed bool) string {
+	name := fmt.Sprintf("%s.%d", filePath, idx)
+	if isCompressed {
+		name += ".gz"
+	}
+	return name
+}
+
+// rotateLogFiles shifts the rotated log files and renames the active log file to '<log>.0'.
+// Note: the active log file must be closed before the call.
+func rotateLogFiles() {
+	if len(filePath) == 0 {
+		return
+	}
+	if _, err := os.Stat(filePath); err != nil {
+		return
+	}
+
+	cfg := rotation
+	// remove the oldest files (including the files which are out of the range after decreasing 'MaxFiles')
+	for idx := cfg.MaxFiles - 1; idx < RotationMaxFilesLimit; idx++ {
+		if idx > 0 {
+			os.Remove(rotatedFileName(idx, false))
+			os.Remove(rotatedFileName(idx, true))
+		}
+	}
+	// shift the files: <log>.N-2 -> <log>.N-1 ... <log>.1 -> <log>.2
+	for idx := cfg.MaxFiles - 2; idx >= 1; idx-- {
+		os.Rename(rotatedFileName(idx, false), rotatedFileName(idx+1, false))
+		os.Rename(rotatedFileName(idx, true), rotatedFileName(idx+1, true))
+	}
+	// <log>.0 -> <log>.1
+	if cfg.MaxFiles >= 2 {
+		if err := os.Rename(rotatedFileName(0, false), rotatedFileName(1, false)); err == nil && cfg.Compress {
+			if err := compressFile(rotatedFileName(1, false), rotatedFileName(1, true)); err == nil {
+				os.Remove(rotatedFileName(1, false))
+			}
+		}
+	}
+	// <log> -> <log>.0
+	os.Rename(filePath, rotatedFileName(0, false))
+
+	removeOutdatedLogFiles()
+}
+
+// removeOutdatedLogFiles removes the rotated log files which are older than 'MaxAgeDays'
+func removeOutdatedLogFiles() {
+	if rotation.MaxAgeDays <= 0 || len(filePath) == 0 {
+		return
+	}
+	maxAge := time.Duration(rotation.MaxAgeDays) * 24 * time.Hour
+	for idx := 0; idx < rotation.MaxFiles; idx++ {
+		for _, name := range []string{rotatedFileName(idx, false), rotatedFileName(idx, true)} {
+			if stat, err := os.Stat(name); err == nil && time.Since(stat.ModTime()) > maxAge {
+				os.Remove(name)
+			}
+		}
+	}
+}
+
+// removeRotatedLogFiles removes all the rotated log files
+func removeRotatedLogFiles() {
+	for idx := 0; idx < RotationMaxFilesLimit; idx++ {
+		os.Remove(rotatedFileName(idx, false))
+		os.Remove(rotatedFileName(idx, true))
+	}
+}
+
+func compressFile(src, dst string) (retErr error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600) // read\write only for privileged user
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := out.Close(); err != nil && retErr == nil {
+			retErr = err
+		}
+		if retErr != nil {
+			os.Remove(dst)
+		}
+	}()
+	// only for Windows: Golang is not able to change file permissins in Windows style
+	if err := filerights.WindowsChmod(dst, 0600); err != nil {
+		return err
+	}
+
+	zw := gzip.NewWriter(out)
+	if _, err := io.Copy(zw, in); err != nil {
+		return err
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	// keep the modification time of the original file (it is in use to detect outdated files)
+	if stat, err := in.Stat(); err == nil {
+		os.Chtimes(dst, stat.ModTime(), stat.ModTime())
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const sessionDelimiter = "===================="
+
+// ID of the active connection session (protected by writeMutex); empty - no active session
+var sessionID string
+
+// time when the active connection session started
+var sessionStarted time.Time
+
+// StartSession marks the beginning of a new connection session in the log.
+// All the following log records are tagged with the session ID (until EndSession is called):
+// in text format - '[s:<ID>]' after the timestamp; in JSON format - the 'session' field.
+// Returns the session ID.
+func StartSession(description string) string {
+	EndSession()
+
+	now := time.Now()
+	id := now.Format("0102-150405.000")
+
+	writeMutex.Lock()
+	sessionID, sessionStarted = id, now
+	writeMutex.Unlock()
+
+	log.Info(fmt.Sprintf("%s Connection session %s started (%s) %s", sessionDelimiter, id, description, sessionDelimiter))
+	return id
+}
+
+// EndSession marks the end of the active connection session in the log (if any)
+func EndSession() {
+	writeMutex.Lock()
+	id, started := sessionID, sessionStarted
+	writeMutex.Unlock()
+
+	if len(id) == 0 {
+		return
+	}
+
+	log.Info(fmt.Sprintf("%s Connection session %s finished (duration: %s) %s", sessionDelimiter, id, time.Since(started).Round(time.Second), sessionDelimiter))
+
+	writeMutex.Lock()
+	if sessionID == id {
+		sessionID = ""
+	}
+	writeMutex.Unlock()
+}
+
+// SessionID returns the ID of the active connection session (empty if there is no active session)
+func SessionID() string {
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+	return sessionID
+}
+
+// SessionTag returns the tag of the log records of the connection session in text format
+func SessionTag(id string) string {
+	return "[s:" + id + "]"
+}
+
+// ParseSessionID returns the ID of the connection session of the log record (text or JSON format).
+// Returns empty string if the record does not belong to any session.
+func ParseSessionID(line string) string {
+	line = strings.TrimSpace(line)
+	if strings.HasPrefix(line, "{") {
+		var r struct {
+			Session string `json:"session"`
+		}
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			return ""
+		}
+		return r.Session
+	}
+
+	// text format: "<timestamp> [s:<ID>] ..."
+	_, after, ok := strings.Cut(line, " [s:")
+	if !ok {
+		return ""
+	}
+	id, _, ok := strings.Cut(after, "]")
+	if !ok || strings.Contains(id, " ") {
+		return ""
+	}
+	return id
+}
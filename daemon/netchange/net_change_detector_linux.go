@@ -22,18 +22,117 @@
 
 package netchange
 
-// structure contains properties required for for macOS implementation
+import (
+	"sync"
+	"syscall"
+
+	"github.com/ivpn/desktop-app/daemon/netinfo"
+	"golang.org/x/sys/unix"
+)
+
+// structure contains properties required for for Linux implementation
 type osSpecificProperties struct {
+	mutex  sync.Mutex
+	socket int
 }
 
 func (d *Detector) isRoutingChanged() (bool, error) {
-	// TODO: not implemented
-	return false, nil
+	if d.interfaceToProtect == nil {
+		log.Error("failed to check route change. Initial interface not defined")
+		return false, nil
+	}
+
+	isDefaultRoute, err := netinfo.IsDefaultRoutingInterface(d.interfaceToProtect.Name)
+	if err != nil {
+		log.Error("Failed to check route change:", err)
+		return false, err
+	}
+
+	return !isDefaultRoute, nil
 }
 
+// doStart subscribes to the routing changes (RTNETLINK) and waits for the notifications
 func (d *Detector) doStart() {
-	// TODO: not implemented
+	sock, err := syscall.Socket(syscall.AF_NETLINK, syscall.SOCK_RAW|syscall.SOCK_CLOEXEC, syscall.NETLINK_ROUTE)
+	if err != nil {
+		log.Error("Failed to start route change detector:", err)
+		return
+	}
+
+	addr := &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK, Groups: unix.RTMGRP_IPV4_ROUTE | unix.RTMGRP_IPV6_ROUTE}
+	if err := syscall.Bind(sock, addr); err != nil {
+		syscall.Close(sock)
+		log.Error("Failed to start route change detector (bind):", err)
+		return
+	}
+
+	// closing the socket does not interrupt the blocking read on Linux:
+	// the read timeout is in use to check periodically if the detector is stopped
+	timeout := syscall.Timeval{Sec: 1}
+	if err := syscall.SetsockoptTimeval(sock, syscall.SOL_SOCKET, syscall.SO_RCVTIMEO, &timeout); err != nil {
+		syscall.Close(sock)
+		log.Error("Failed to start route change detector (socket timeout):", err)
+		return
+	}
+
+	d.props.mutex.Lock()
+	d.props.socket = sock
+	d.props.mutex.Unlock()
+
+	log.Info("Route change detector started")
+	defer func() {
+		log.Info("Route change detector stopped")
+		d.doStop()
+	}()
+
+	b := make([]byte, 64*1024)
+	for {
+		nr, _, err := syscall.Recvfrom(sock, b, 0)
+
+		if !d.isSocketActive(sock) {
+			break
+		}
+
+		if err != nil {
+			switch err {
+			case syscall.EAGAIN, syscall.EINTR:
+				continue
+			case syscall.ENOBUFS:
+				// some notifications were lost (too many changes): check the routing anyway
+				d.routingChangeDetected()
+				continue
+			}
+			log.Error("Route change detector (error on socket read):", err)
+			return
+		}
+
+		messages, err := syscall.ParseNetlinkMessage(b[:nr])
+		if err != nil {
+			continue
+		}
+
+		for _, msg := range messages {
+			switch msg.Header.Type {
+			case syscall.RTM_NEWROUTE, syscall.RTM_DELROUTE:
+				d.routingChangeDetected()
+			}
+		}
+	}
+}
+
+func (d *Detector) isSocketActive(sock int) bool {
+	d.props.mutex.Lock()
+	defer d.props.mutex.Unlock()
+	return d.props.socket == sock
 }
 
 func (d *Detector) doStop() {
+	d.props.mutex.Lock()
+	defer d.props.mutex.Unlock()
+
+	s := d.props.socket
+	d.props.socket = 0
+	if s != 0 {
+		syscall.Close(s)
+	}
 }
@@ -26,17 +26,17 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sync"
 	"syscall"
 
 	"github.com/ivpn/desktop-app/daemon/netinfo"
 	"github.com/ivpn/desktop-app/daemon/oshelpers/windows/iphlpapi"
-	"github.com/ivpn/desktop-app/daemon/oshelpers/windows/kernel32"
-	"github.com/ivpn/desktop-app/daemon/oshelpers/windows/winsock2"
 )
 
-// structure contains properties required for for macOS implementation
+// structure contains properties required for for Windows implementation
 type osSpecificProperties struct {
-	overlapped syscall.Overlapped
+	mutex   sync.Mutex
+	handles []syscall.Handle // notification handles (route and IP interface changes)
 }
 
 func (d *Detector) isRoutingChanged() (bool, error) {
@@ -76,71 +76,49 @@ func (d *Detector) isRoutingChanged() (bool, error) {
 	return false, nil
 }
 
+// doStart subscribes to the route table and IP interface change notifications (IPv4 and IPv6).
+// Notifications are forwarded to routingChangeDetected(): the routing is checked after 'DelayBeforeNotify'
+// (an adapter state change usually produces a lot of notifications)
 func (d *Detector) doStart() {
+	d.props.mutex.Lock()
+	defer d.props.mutex.Unlock()
 
-	log.Info("Route change detector started")
-	defer func() {
-		log.Info("Route change detector stopped")
-	}()
-
-	var handle syscall.Handle
-
-	d.props.overlapped = syscall.Overlapped{}
-	d.props.overlapped.HEvent, _ = winsock2.WSACreateEvent()
-
-	for {
-		if d.props.overlapped.HEvent == 0 {
-			break
-		}
-
-		// register route change handler
-		err := iphlpapi.APINotifyRouteChange(&handle, &d.props.overlapped)
-		if err != nil {
-			log.Error(err)
-			return
-		}
-
-		evtHandle := d.props.overlapped.HEvent
-		if evtHandle == 0 {
-			return
-		}
-
-		_, err = syscall.WaitForSingleObject(evtHandle, syscall.INFINITE)
-		if err != nil {
-			log.Error(err)
-			return
-		}
-
-		if d.props.overlapped.HEvent == 0 {
-			break
-		}
+	if len(d.props.handles) > 0 {
+		return // already started
+	}
 
-		// notify about routing change
-		d.notifyRoutingChange()
+	routeHandle, err := iphlpapi.APINotifyRouteChange2(d.routingChangeDetected)
+	if err != nil {
+		log.Error("Failed to start route change detector:", err)
+		return
 	}
+	d.props.handles = append(d.props.handles, routeHandle)
+
+	ifHandle, err := iphlpapi.APINotifyIPInterfaceChange(d.routingChangeDetected)
+	if err != nil {
+		// route changes are still monitored
+		log.Error("Failed to subscribe to IP interface change notifications:", err)
+	} else {
+		d.props.handles = append(d.props.handles, ifHandle)
+	}
+
+	log.Info("Route change detector started")
 }
 
 func (d *Detector) doStop() {
-	overlapped := d.props.overlapped
-
-	if overlapped.HEvent != 0 {
-		// do not start new route change
-		d.props.overlapped.HEvent = 0
-
-		_, err := kernel32.SetEvent(overlapped.HEvent)
-		if err != nil {
-			log.Error("Failed to stop route change detection (SetEvent 1): ", err)
-		}
+	d.props.mutex.Lock()
+	defer d.props.mutex.Unlock()
 
-		// stop route change detection
-		err = iphlpapi.CancelIPChangeNotify(&overlapped)
-		if err != nil {
-			log.Error("Failed to stop route change detection (CancelIPChangeNotify):", err)
-		}
+	if len(d.props.handles) == 0 {
+		return
+	}
 
-		_, err = kernel32.SetEvent(overlapped.HEvent)
-		if err != nil {
-			log.Error("Failed to stop route change detection (SetEvent 2):", err)
+	for _, h := range d.props.handles {
+		if err := iphlpapi.APICancelMibChangeNotify2(h); err != nil {
+			log.Error("Failed to stop route change detection (CancelMibChangeNotify2):", err)
 		}
 	}
+	d.props.handles = nil
+
+	log.Info("Route change detector stopped")
 }
@@ -0,0 +1,108 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package netinfo
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+)
+
+// The name which has only IPv4 addresses (RFC 7050).
+// The DNS64 resolver of the NAT64 network returns the synthesized IPv6 addresses for it.
+const nat64DiscoveryName = "ipv4only.arpa"
+
+const nat64DiscoveryTimeout = 3 * time.Second
+
+// the well-known IPv4 addresses of 'ipv4only.arpa'
+var nat64DiscoveryIPs = []net.IP{net.IPv4(192, 0, 0, 170), net.IPv4(192, 0, 0, 171)}
+
+// IsIPv6OnlyNetwork returns 'true' when the system has the IPv6 default route but has no IPv4 default route
+// (e.g. mobile carriers with NAT64/464XLAT).
+// Note: when the 464XLAT client (CLAT) is active on the host, the IPv4 default route exists and the network is not treated as IPv6-only.
+func IsIPv6OnlyNetwork() bool {
+	routes, err := DefaultRoutes()
+	if err != nil {
+		return false
+	}
+
+	hasIPv6 := false
+	for _, r := range routes {
+		if r.IsScoped {
+			continue
+		}
+		if !r.IsIPv6 {
+			return false
+		}
+		hasIPv6 = true
+	}
+	return hasIPv6
+}
+
+// NAT64Prefix detects the NAT64 prefix of the current network using DNS64 (RFC 7050).
+// Only the /96 prefixes are supported (the well-known prefix 64:ff9b::/96 and the most of network-specific prefixes).
+// Returns error when the network has no DNS64 resolver.
+func NAT64Prefix() (*net.IPNet, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), nat64DiscoveryTimeout)
+	defer cancel()
+
+	addrs, err := net.DefaultResolver.LookupIP(ctx, "ip6", nat64DiscoveryName)
+	if err != nil {
+		return nil, fmt.Errorf("NAT64 prefix discovery failed: %w", err)
+	}
+
+	for _, ip := range addrs {
+		if ip.To4() != nil || len(ip) != net.IPv6len {
+			continue
+		}
+		embedded := net.IP(ip[12:16])
+		for _, wk := range nat64DiscoveryIPs {
+			if embedded.Equal(wk) {
+				prefix := make(net.IP, net.IPv6len)
+				copy(prefix, ip[:12])
+				return &net.IPNet{IP: prefix, Mask: net.CIDRMask(96, 128)}, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("NAT64 prefix not detected (no suitable addresses for '%s')", nat64DiscoveryName)
+}
+
+// SynthesizeNAT64 returns the IPv6 address which is translated by NAT64 to the IPv4 address 'ip' (RFC 6052, /96 prefix)
+func SynthesizeNAT64(prefix *net.IPNet, ip net.IP) (net.IP, error) {
+	ip4 := ip.To4()
+	if ip4 == nil {
+		return nil, fmt.Errorf("not an IPv4 address: %v", ip)
+	}
+	if prefix == nil || len(prefix.IP) != net.IPv6len {
+		return nil, fmt.Errorf("NAT64 prefix is not defined")
+	}
+	if ones, bits := prefix.Mask.Size(); ones != 96 || bits != 128 {
+		return nil, fmt.Errorf("unsupported NAT64 prefix length: %s", prefix)
+	}
+
+	ret := make(net.IP, net.IPv6len)
+	copy(ret, prefix.IP[:12])
+	copy(ret[12:], ip4)
+	return ret, nil
+}
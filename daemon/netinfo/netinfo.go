@@ -26,6 +26,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"sort"
 
 	"github.com/ivpn/desktop-app/daemon/logger"
 )
@@ -42,6 +43,72 @@ func DefaultGatewayIP() (defGatewayIP net.IP, err error) {
 	return doDefaultGatewayIP()
 }
 
+// DefaultRoute - information about the default route (0.0.0.0/0 or ::/0) of the network interface
+type DefaultRoute struct {
+	InterfaceName  string
+	InterfaceIndex int
+	Gateway        net.IP // can be nil for the point-to-point interfaces
+	IsIPv6         bool
+	// Metric of the route: lower value means higher priority.
+	// macOS does not use route metrics: the value reflects the order of the routes in the routing table.
+	Metric int
+	// IsScoped is 'true' when the route is in use only for the traffic bound to the interface
+	// (macOS: RTF_IFSCOPE route; Linux: route in a policy routing table other than 'main')
+	IsScoped bool
+}
+
+// DefaultRoutes - returns all default routes (e.g. when there are a few uplinks: Ethernet + Wi-Fi).
+// The routes are sorted by priority: non-scoped routes first, then by metric.
+func DefaultRoutes() ([]DefaultRoute, error) {
+	// method should be implemented in platform-specific file
+	routes, err := doDefaultRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].IsScoped != routes[j].IsScoped {
+			return !routes[i].IsScoped
+		}
+		return routes[i].Metric < routes[j].Metric
+	})
+	return routes, nil
+}
+
+// PrimaryDefaultRoute - returns the default route which is in use by the system for IPv4 or IPv6 traffic
+// (the non-scoped route with the lowest metric)
+func PrimaryDefaultRoute(isIPv6 bool) (*DefaultRoute, error) {
+	routes, err := DefaultRoutes()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range routes {
+		if r.IsIPv6 == isIPv6 && !r.IsScoped {
+			return &r, nil
+		}
+	}
+
+	if isIPv6 {
+		return nil, fmt.Errorf("IPv6 default route not found")
+	}
+	return nil, fmt.Errorf("IPv4 default route not found")
+}
+
+// DefaultGatewayIPv6 - returns: default IPv6 gateway IP
+// Note: the IPv6 gateway is usually a link-local address, so it is usable only together with the interface
+// (use PrimaryDefaultRoute() to get the interface info)
+func DefaultGatewayIPv6() (net.IP, error) {
+	r, err := PrimaryDefaultRoute(true)
+	if err != nil {
+		return nil, err
+	}
+	if r.Gateway == nil {
+		return nil, fmt.Errorf("IPv6 default route has no gateway (interface %q)", r.InterfaceName)
+	}
+	return r.Gateway, nil
+}
+
 func GetOutboundIP(isIPv6 bool) (net.IP, error) {
 	if isIPv6 {
 		return GetOutboundIPEx(net.ParseIP("2a00:1450:400d:80a::200e"))
@@ -103,6 +170,16 @@ func InterfaceByIPAddr(localAddr net.IP) (*net.Interface, error) {
 	return nil, errors.New("not found network interface with address:" + localAddr.String())
 }
 
+// InterfaceTrafficBytes - returns number of bytes received/sent by the network interface
+// Note: the counters can overflow (e.g. on Windows they are 32-bit values)
+func InterfaceTrafficBytes(iface *net.Interface) (rx, tx uint64, err error) {
+	if iface == nil {
+		return 0, 0, fmt.Errorf("network interface not defined")
+	}
+	// method should be implemented in platform-specific file
+	return doInterfaceTrafficBytes(iface)
+}
+
 // GetFreePort - get unused local port
 // Note there is no guarantee that port will not be in use right after finding it
 func GetFreePort(isTCP bool) (int, error) {
@@ -27,7 +27,11 @@ import (
 	"net"
 	"os/exec"
 	"regexp"
+	"strconv"
 	"strings"
+	"syscall"
+
+	routesocket "golang.org/x/net/route"
 )
 
 // IsDefaultRoutingInterface - Get active routing interface
@@ -118,3 +122,71 @@ func doGetDefaultRoutes(getAllDefRoutes bool) (routes []route, err error) {
 
 	return routes, nil
 }
+
+// doDefaultRoutes - returns all default routes (the routing table is read using sysctl)
+func doDefaultRoutes() ([]DefaultRoute, error) {
+	rib, err := routesocket.FetchRIB(syscall.AF_UNSPEC, routesocket.RIBTypeRoute, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read routing table: %w", err)
+	}
+	messages, err := routesocket.ParseRIB(routesocket.RIBTypeRoute, rib)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse routing table: %w", err)
+	}
+
+	ret := make([]DefaultRoute, 0, 4)
+	for _, msg := range messages {
+		rmsg, ok := msg.(*routesocket.RouteMessage)
+		if !ok || rmsg.Flags&syscall.RTF_UP == 0 || !isDefaultRouteMessage(rmsg) {
+			continue
+		}
+
+		r := DefaultRoute{
+			InterfaceIndex: rmsg.Index,
+			IsIPv6:         isIPv6Addr(rmsg.Addrs[syscall.RTAX_DST]),
+			Metric:         len(ret),
+			IsScoped:       rmsg.Flags&syscall.RTF_IFSCOPE != 0,
+		}
+		if len(rmsg.Addrs) > syscall.RTAX_GATEWAY && rmsg.Flags&syscall.RTF_GATEWAY != 0 {
+			r.Gateway = addrToIP(rmsg.Addrs[syscall.RTAX_GATEWAY])
+		}
+		if iface, err := net.InterfaceByIndex(rmsg.Index); err == nil {
+			r.InterfaceName = iface.Name
+		}
+
+		ret = append(ret, r)
+	}
+
+	return ret, nil
+}
+
+// doInterfaceTrafficBytes - returns number of bytes received/sent by the network interface
+func doInterfaceTrafficBytes(iface *net.Interface) (rx, tx uint64, err error) {
+	// Expected output of "netstat -ibn -I utun4" command:
+	//	Name  Mtu   Network       Address    Ipkts Ierrs  Ibytes Opkts Oerrs  Obytes Coll
+	//	utun4 1380  <Link#20>                   10     0    1234    12     0    2345    0
+	//	utun4 1380  10.0.0.1/32   10.0.0.1      10     -    1234    12     -    2345    -
+	// (the 'Address' column can be empty, so the values are taken from the end of line)
+
+	cmd := exec.Command("/usr/sbin/netstat", "-ibn", "-I", iface.Name)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to get interface statistics: %w", err)
+	}
+
+	for _, line := range strings.Split(string(out), "\n") {
+		cols := strings.Fields(line)
+		if len(cols) < 10 || cols[0] != iface.Name || !strings.HasPrefix(cols[2], "<Link#") {
+			continue
+		}
+		if rx, err = strconv.ParseUint(cols[len(cols)-5], 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("failed to parse interface statistics: %w", err)
+		}
+		if tx, err = strconv.ParseUint(cols[len(cols)-2], 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("failed to parse interface statistics: %w", err)
+		}
+		return rx, tx, nil
+	}
+
+	return 0, 0, fmt.Errorf("failed to get interface statistics (no data for '%s')", iface.Name)
+}
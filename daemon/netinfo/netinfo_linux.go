@@ -25,12 +25,116 @@ package netinfo
 import (
 	"fmt"
 	"net"
+	"os"
+	"path/filepath"
 	"regexp"
 	"strconv"
+	"strings"
+	"syscall"
+	"unsafe"
 
 	"github.com/ivpn/desktop-app/daemon/shell"
 )
 
+// Public IP address which is in use to detect the interface of the internet traffic
+// (only the route lookup is performed: no traffic is sent to this address)
+var internetRouteCheckIP = net.IPv4(1, 1, 1, 1)
+
+// IsDefaultRoutingInterface returns 'true' when the internet traffic is routed over the interface.
+// The route lookup takes into account the policy routing rules (e.g. WireGuard connection configured by 'wg-quick').
+func IsDefaultRoutingInterface(interfaceName string) (bool, error) {
+	// Expected output of "/sbin/ip route get 1.1.1.1" command:
+	//
+	// 1.1.1.1 dev wgivpn table 51820 src 172.27.1.2 uid 0
+	//     cache
+	devRegexp := regexp.MustCompile(`\sdev\s+(\S+)`)
+
+	routeInterface := ""
+	outParse := func(text string, isError bool) {
+		if isError || len(routeInterface) > 0 {
+			return
+		}
+		if columns := devRegexp.FindStringSubmatch(text); len(columns) > 1 {
+			routeInterface = columns[1]
+		}
+	}
+
+	if err := shell.ExecAndProcessOutput(nil, outParse, "", "/sbin/ip", "route", "get", internetRouteCheckIP.String()); err != nil {
+		return false, fmt.Errorf("failed to get route: %w", err)
+	}
+	if len(routeInterface) == 0 {
+		return false, fmt.Errorf("failed to get route: no interface for %s", internetRouteCheckIP)
+	}
+
+	return routeInterface == interfaceName, nil
+}
+
+// doDefaultRoutes - returns all default routes (the routing tables are read using RTNETLINK)
+func doDefaultRoutes() ([]DefaultRoute, error) {
+	rib, err := syscall.NetlinkRIB(syscall.RTM_GETROUTE, syscall.AF_UNSPEC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read routing table: %w", err)
+	}
+	messages, err := syscall.ParseNetlinkMessage(rib)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse routing table: %w", err)
+	}
+
+	ret := make([]DefaultRoute, 0, 4)
+	for _, m := range messages {
+		if m.Header.Type != syscall.RTM_NEWROUTE || len(m.Data) < syscall.SizeofRtMsg {
+			continue
+		}
+
+		rtmsg := (*syscall.RtMsg)(unsafe.Pointer(&m.Data[0]))
+		if rtmsg.Dst_len != 0 || rtmsg.Type != syscall.RTN_UNICAST || rtmsg.Table == syscall.RT_TABLE_LOCAL {
+			continue
+		}
+
+		attrs, err := syscall.ParseNetlinkRouteAttr(&m)
+		if err != nil {
+			continue
+		}
+
+		table := uint32(rtmsg.Table)
+		r := DefaultRoute{IsIPv6: rtmsg.Family == syscall.AF_INET6}
+		for _, a := range attrs {
+			switch a.Attr.Type {
+			case syscall.RTA_OIF:
+				r.InterfaceIndex = int(netlinkAttrUint32(a.Value))
+			case syscall.RTA_GATEWAY:
+				r.Gateway = net.IP(a.Value)
+			case syscall.RTA_PRIORITY:
+				r.Metric = int(netlinkAttrUint32(a.Value))
+			case syscall.RTA_TABLE:
+				if v := netlinkAttrUint32(a.Value); v != 0 {
+					table = v
+				}
+			}
+		}
+		if r.InterfaceIndex == 0 {
+			continue // multipath routes are not supported
+		}
+
+		r.IsScoped = table != syscall.RT_TABLE_MAIN
+		if iface, err := net.InterfaceByIndex(r.InterfaceIndex); err == nil {
+			r.InterfaceName = iface.Name
+		}
+
+		ret = append(ret, r)
+	}
+
+	return ret, nil
+}
+
+// netlinkAttrUint32 returns the value of netlink attribute (native byte order)
+func netlinkAttrUint32(value []byte) uint32 {
+	if len(value) < 4 {
+		return 0
+	}
+	return *(*uint32)(unsafe.Pointer(&value[0]))
+}
+
 // doDefaultGatewayIP - returns: default gateway
 func doDefaultGatewayIP() (defGatewayIP net.IP, err error) {
 	defGatewayIP = nil
@@ -78,3 +182,22 @@ func doDefaultGatewayIP() (defGatewayIP net.IP, err error) {
 
 	return defGatewayIP, retErr
 }
+
+// doInterfaceTrafficBytes - returns number of bytes received/sent by the network interface
+func doInterfaceTrafficBytes(iface *net.Interface) (rx, tx uint64, err error) {
+	readCounter := func(name string) (uint64, error) {
+		data, err := os.ReadFile(filepath.Join("/sys/class/net", iface.Name, "statistics", name))
+		if err != nil {
+			return 0, err
+		}
+		return strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	}
+
+	if rx, err = readCounter("rx_bytes"); err != nil {
+		return 0, 0, fmt.Errorf("failed to get interface statistics: %w", err)
+	}
+	if tx, err = readCounter("tx_bytes"); err != nil {
+		return 0, 0, fmt.Errorf("failed to get interface statistics: %w", err)
+	}
+	return rx, tx, nil
+}
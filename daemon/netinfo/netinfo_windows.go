@@ -26,6 +26,13 @@ import (
 	"bytes"
 	"fmt"
 	"net"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ivpn/desktop-app/daemon/oshelpers/windows/iphlpapi"
+	"github.com/ivpn/desktop-app/daemon/service/platform"
+	"github.com/ivpn/desktop-app/daemon/shell"
 )
 
 // doDefaultGatewayIP - returns: default gateway IP
@@ -52,3 +59,97 @@ func doDefaultGatewayIP() (defGatewayIP net.IP, err error) {
 
 	return nil, fmt.Errorf("failed to determine default route")
 }
+
+// doDefaultRoutes - returns all default routes
+func doDefaultRoutes() ([]DefaultRoute, error) {
+	routes, err := getWindowsIPv4Routes()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get routes: %w", err)
+	}
+
+	ret := make([]DefaultRoute, 0, 4)
+	zeroBytes := []byte{0, 0, 0, 0}
+	for _, route := range routes {
+		if !bytes.Equal(route.DwForwardDest[:], zeroBytes) || !bytes.Equal(route.DwForwardMask[:], zeroBytes) {
+			continue
+		}
+
+		r := DefaultRoute{
+			InterfaceIndex: int(route.DwForwardIfIndex),
+			Gateway:        net.IPv4(route.DwForwardNextHop[0], route.DwForwardNextHop[1], route.DwForwardNextHop[2], route.DwForwardNextHop[3]),
+			Metric:         int(route.DwForwardMetric1),
+		}
+		if iface, err := net.InterfaceByIndex(r.InterfaceIndex); err == nil {
+			r.InterfaceName = iface.Name
+		}
+
+		ret = append(ret, r)
+	}
+
+	routesV6, err := getWindowsIPv6DefaultRoutes()
+	if err != nil {
+		log.Warning(fmt.Sprintf("Failed to get IPv6 default routes: %v", err))
+	}
+	ret = append(ret, routesV6...)
+
+	return ret, nil
+}
+
+func getWindowsIPv6DefaultRoutes() ([]DefaultRoute, error) {
+	routeCmd := platform.RouteCommand()
+	if len(routeCmd) == 0 {
+		return nil, fmt.Errorf("route command not available")
+	}
+
+	// Expected output of "route print -6 ::/0" command:
+	//	===========================================================================
+	//	Active Routes:
+	//	 If Metric Network Destination      Gateway
+	//	 12    281 ::/0                     fe80::1
+	//	 15    256 ::/0                     On-link
+	//	===========================================================================
+	//	Persistent Routes:
+	//	  None
+	routeRegexp := regexp.MustCompile(`^\s*([0-9]+)\s+([0-9]+)\s+::/0\s+(\S+)\s*$`)
+
+	ret := make([]DefaultRoute, 0, 2)
+	isPersistentRoutes := false
+	outParse := func(text string, isError bool) {
+		if isError || isPersistentRoutes {
+			return
+		}
+		if strings.HasPrefix(strings.TrimSpace(text), "Persistent Routes") {
+			isPersistentRoutes = true
+			return
+		}
+
+		columns := routeRegexp.FindStringSubmatch(text)
+		if len(columns) != 4 {
+			return
+		}
+
+		r := DefaultRoute{IsIPv6: true}
+		r.InterfaceIndex, _ = strconv.Atoi(columns[1])
+		r.Metric, _ = strconv.Atoi(columns[2])
+		r.Gateway = net.ParseIP(columns[3]) // nil for "On-link"
+		if iface, err := net.InterfaceByIndex(r.InterfaceIndex); err == nil {
+			r.InterfaceName = iface.Name
+		}
+		ret = append(ret, r)
+	}
+
+	if err := shell.ExecAndProcessOutput(nil, outParse, "", routeCmd, "print", "-6", "::/0"); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// doInterfaceTrafficBytes - returns number of bytes received/sent by the network interface
+// Note: the counters are 32-bit values on Windows
+func doInterfaceTrafficBytes(iface *net.Interface) (rx, tx uint64, err error) {
+	row := iphlpapi.MibIfRow{DwIndex: uint32(iface.Index)}
+	if err := iphlpapi.GetIfEntry(&row); err != nil {
+		return 0, 0, fmt.Errorf("failed to get interface statistics: %w", err)
+	}
+	return uint64(row.DwInOctets), uint64(row.DwOutOctets), nil
+}
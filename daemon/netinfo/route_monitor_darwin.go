@@ -0,0 +1,147 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package netinfo
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"syscall"
+
+	routesocket "golang.org/x/net/route"
+)
+
+// RouteMonitor listens to the routing socket (PF_ROUTE) and notifies about changes of the default route
+type RouteMonitor struct {
+	mutex  sync.Mutex
+	socket int
+}
+
+// StartRouteMonitor starts listening to the routing socket.
+// The 'onDefaultRouteChanged' is called (from the monitor routine) each time the default route is added, changed or deleted.
+// Note: one change of the network configuration usually produces a few notifications.
+func StartRouteMonitor(onDefaultRouteChanged func()) (*RouteMonitor, error) {
+	if onDefaultRouteChanged == nil {
+		return nil, fmt.Errorf("route change handler not defined")
+	}
+
+	sock, err := syscall.Socket(syscall.AF_ROUTE, syscall.SOCK_RAW, syscall.AF_UNSPEC)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open routing socket: %w", err)
+	}
+
+	m := &RouteMonitor{socket: sock}
+	go m.run(sock, onDefaultRouteChanged)
+	return m, nil
+}
+
+// Stop stops the monitor
+func (m *RouteMonitor) Stop() {
+	if m == nil {
+		return
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	s := m.socket
+	m.socket = 0
+	if s != 0 {
+		syscall.Close(s)
+	}
+}
+
+func (m *RouteMonitor) isActive(sock int) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.socket == sock
+}
+
+func (m *RouteMonitor) run(sock int, onDefaultRouteChanged func()) {
+	defer m.Stop()
+
+	b := make([]byte, os.Getpagesize())
+	for {
+		nr, err := syscall.Read(sock, b)
+		if !m.isActive(sock) {
+			return
+		}
+		if err != nil {
+			if err == syscall.EINTR {
+				continue
+			}
+			return
+		}
+
+		messages, err := routesocket.ParseRIB(0, b[:nr])
+		if err != nil {
+			continue
+		}
+
+		for _, msg := range messages {
+			rmsg, ok := msg.(*routesocket.RouteMessage)
+			if !ok {
+				continue
+			}
+			switch rmsg.Type {
+			case syscall.RTM_ADD, syscall.RTM_CHANGE, syscall.RTM_DELETE:
+				if isDefaultRouteMessage(rmsg) {
+					onDefaultRouteChanged()
+				}
+			}
+		}
+	}
+}
+
+// isDefaultRouteMessage returns 'true' when the message is related to the default route (0.0.0.0/0 or ::/0).
+// The routes like '0.0.0.0/1' (in use by the VPN connection) are not default routes.
+func isDefaultRouteMessage(rmsg *routesocket.RouteMessage) bool {
+	if len(rmsg.Addrs) <= syscall.RTAX_DST || !isUnspecifiedAddr(rmsg.Addrs[syscall.RTAX_DST]) {
+		return false
+	}
+	if len(rmsg.Addrs) <= syscall.RTAX_NETMASK || rmsg.Addrs[syscall.RTAX_NETMASK] == nil {
+		return true
+	}
+	return isUnspecifiedAddr(rmsg.Addrs[syscall.RTAX_NETMASK])
+}
+
+func isUnspecifiedAddr(addr routesocket.Addr) bool {
+	ip := addrToIP(addr)
+	return ip != nil && ip.IsUnspecified()
+}
+
+func isIPv6Addr(addr routesocket.Addr) bool {
+	_, ok := addr.(*routesocket.Inet6Addr)
+	return ok
+}
+
+func addrToIP(addr routesocket.Addr) net.IP {
+	switch a := addr.(type) {
+	case *routesocket.Inet4Addr:
+		return net.IP(a.IP[:])
+	case *routesocket.Inet6Addr:
+		return net.IP(a.IP[:])
+	}
+	return nil
+}
@@ -66,9 +66,52 @@ const (
 	Obfs4IatOnParanoid Obfs4IatMode = 2
 )
 
+// Obfs4Bridge - user-defined parameters of the obfs4 bridge (server side of the obfs4 connection).
+// Empty values mean that the parameters from the servers list are in use.
+type Obfs4Bridge struct {
+	// public certificate of the obfs4 bridge ("cert=..." parameter of the bridge line)
+	Cert string `json:",omitempty"`
+	// port of the obfs4 bridge (applicable only for Single-Hop connections)
+	Port int `json:",omitempty"`
+}
+
+// IsDefined returns 'true' when any of the bridge parameters defined
+func (b Obfs4Bridge) IsDefined() bool {
+	return len(b.Cert) > 0 || b.Port > 0
+}
+
+var obfs4CertRegexp = regexp.MustCompile(`^[A-Za-z0-9+/]+={0,2}$`)
+
+// Validate checks the bridge parameters
+func (b Obfs4Bridge) Validate() error {
+	if len(b.Cert) > 0 && !obfs4CertRegexp.MatchString(b.Cert) {
+		return fmt.Errorf("bad obfs4 bridge certificate (base64 string expected)")
+	}
+	if b.Port < 0 || b.Port > 65535 {
+		return fmt.Errorf("bad obfs4 bridge port (%d)", b.Port)
+	}
+	return nil
+}
+
 type Config struct {
 	Version  ObfsProxyVersion
 	Obfs4Iat Obfs4IatMode
+	// (obfs4 only) user-defined bridge parameters
+	Obfs4Bridge Obfs4Bridge
+}
+
+// Validate checks the configuration
+func (c Config) Validate() error {
+	if !c.IsObfsproxy() {
+		return nil
+	}
+	if c.Obfs4Iat < Obfs4IatOff || c.Obfs4Iat > Obfs4IatOnParanoid {
+		return fmt.Errorf("unsupported obfs4 IAT mode (%d)", c.Obfs4Iat)
+	}
+	if c.Version != OBFS4 && c.Obfs4Bridge.IsDefined() {
+		return fmt.Errorf("bridge parameters are applicable only for obfs4")
+	}
+	return c.Obfs4Bridge.Validate()
 }
 
 // IsObfsproxy returns 'true' when enabled
@@ -88,7 +131,7 @@ func (c Config) Equals(b Config) bool {
 	if c.Version == b.Version && c.Version == OBFS3 {
 		return true
 	}
-	return c.Version == b.Version && c.Obfs4Iat == b.Obfs4Iat
+	return c.Version == b.Version && c.Obfs4Iat == b.Obfs4Iat && c.Obfs4Bridge == b.Obfs4Bridge
 }
 
 func (c Config) ToString() string {
@@ -96,6 +139,9 @@ func (c Config) ToString() string {
 		return "disabled"
 	}
 	if c.Version == OBFS4 {
+		if c.Obfs4Bridge.IsDefined() {
+			return fmt.Sprintf("obfs%d, IAT%d, custom bridge", c.Version, c.Obfs4Iat)
+		}
 		return fmt.Sprintf("obfs%d, IAT%d", c.Version, c.Obfs4Iat)
 	}
 	return fmt.Sprintf("obfs%d", c.Version)
@@ -0,0 +1,210 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package operations implements the generic mechanism for long-running (asynchronous) operations.
+// Each operation has an unique ID. The progress of an operation is reported by status notifications
+// and a running operation can be cancelled by its ID.
+package operations
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Known operation types
+const (
+	// TypeDiagnostics - collecting diagnostics info (logs and system info)
+	TypeDiagnostics = "Diagnostics"
+	// TypeServersUpdate - updating servers list from the backend
+	TypeServersUpdate = "ServersUpdate"
+	// TypeSpeedTest - measuring the download/upload throughput through the active VPN tunnel
+	TypeSpeedTest = "SpeedTest"
+)
+
+// State - state of the operation
+type State string
+
+const (
+	Running   State = "Running"
+	Finished  State = "Finished"
+	Failed    State = "Failed"
+	Cancelled State = "Cancelled"
+)
+
+// Status - information about the operation
+type Status struct {
+	Id   uint64
+	Type string
+	// Running, Finished, Failed or Cancelled
+	State State
+	// Progress in percents (0-100)
+	Progress int
+	// Description of the current step of the operation
+	Info string `json:",omitempty"`
+	// Error description (when State == Failed)
+	Error string `json:",omitempty"`
+	// Result of the operation (when State == Finished; can be nil)
+	Result interface{} `json:",omitempty"`
+}
+
+// IsDone returns 'true' when the operation is not running anymore
+func (s Status) IsDone() bool {
+	return s.State != Running
+}
+
+// ProgressFunc - the function to report progress of the operation
+type ProgressFunc func(progress int, info string)
+
+// Func - the function which implements the operation.
+// The implementation must check 'ctx' to stop as soon as possible when the operation cancelled.
+type Func func(ctx context.Context, progress ProgressFunc) (result interface{}, err error)
+
+type operation struct {
+	status Status
+	cancel context.CancelFunc
+}
+
+// Manager - keeps track of running operations
+type Manager struct {
+	mutex    sync.Mutex
+	lastId   uint64
+	running  map[uint64]*operation
+	onStatus func(Status)
+}
+
+// CreateManager - constructor for Manager object
+// 'onStatus' is called on each change of operation status (the function must not block).
+func CreateManager(onStatus func(Status)) *Manager {
+	return &Manager{running: make(map[uint64]*operation), onStatus: onStatus}
+}
+
+// Start starts the operation in separate routine and returns its status.
+// Only one operation of the same type can run at the same time:
+// if the operation of the same type is already running - the status of the existing operation is returned.
+func (m *Manager) Start(opType string, f Func) Status {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	for _, op := range m.running {
+		if op.status.Type == opType {
+			return op.status
+		}
+	}
+
+	m.lastId++
+	ctx, cancel := context.WithCancel(context.Background())
+	op := &operation{
+		status: Status{Id: m.lastId, Type: opType, State: Running},
+		cancel: cancel,
+	}
+	m.running[op.status.Id] = op
+
+	go m.run(ctx, op.status.Id, f)
+
+	return op.status
+}
+
+// Cancel stops the running operation. The operation is marked as 'Cancelled' immediately,
+// the result of the operation (if it will be received later) is ignored.
+func (m *Manager) Cancel(id uint64) (Status, error) {
+	m.mutex.Lock()
+	op, ok := m.running[id]
+	if !ok {
+		m.mutex.Unlock()
+		return Status{}, fmt.Errorf("operation %d not found (or already finished)", id)
+	}
+	op.cancel()
+	op.status.State = Cancelled
+	delete(m.running, id)
+	status := op.status
+	m.mutex.Unlock()
+
+	m.notify(status)
+	return status, nil
+}
+
+// Running returns status of all running operations
+func (m *Manager) Running() []Status {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	ret := make([]Status, 0, len(m.running))
+	for _, op := range m.running {
+		ret = append(ret, op.status)
+	}
+	return ret
+}
+
+func (m *Manager) run(ctx context.Context, id uint64, f Func) {
+	progress := func(progress int, info string) {
+		if status, ok := m.update(id, func(s *Status) {
+			s.Progress = progress
+			s.Info = info
+		}); ok {
+			m.notify(status)
+		}
+	}
+
+	result, err := f(ctx, progress)
+
+	m.mutex.Lock()
+	op, ok := m.running[id]
+	if !ok {
+		// operation was cancelled
+		m.mutex.Unlock()
+		return
+	}
+	op.cancel()
+	delete(m.running, id)
+	if err != nil {
+		op.status.State = Failed
+		op.status.Error = err.Error()
+	} else {
+		op.status.State = Finished
+		op.status.Progress = 100
+		op.status.Info = ""
+		op.status.Result = result
+	}
+	status := op.status
+	m.mutex.Unlock()
+
+	m.notify(status)
+}
+
+func (m *Manager) update(id uint64, f func(s *Status)) (Status, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	op, ok := m.running[id]
+	if !ok {
+		return Status{}, false
+	}
+	f(&op.status)
+	return op.status, true
+}
+
+func (m *Manager) notify(status Status) {
+	if m.onStatus != nil {
+		m.onStatus(status)
+	}
+}
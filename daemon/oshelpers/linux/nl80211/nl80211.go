@@ -0,0 +1,575 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+//go:build linux
+// +build linux
+
+// Package nl80211 is a minimal client for the Linux nl80211 (cfg80211) generic netlink interface.
+// It provides information about the Wi-Fi network the host is associated with
+// without requiring 'libiw' or the (deprecated) wireless extensions support in the driver.
+package nl80211
+
+import (
+	"encoding/binary"
+	"fmt"
+	"net"
+	"sync"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+const (
+	solNetlink = 270 // SOL_NETLINK (not defined in 'syscall' package)
+
+	// generic netlink controller
+	genlIDCtrl           = 0x10
+	ctrlCmdGetFamily     = 3
+	ctrlAttrFamilyID     = 1
+	ctrlAttrFamilyName   = 2
+	ctrlAttrMcastGroups  = 7
+	ctrlAttrMcastGrpName = 1
+	ctrlAttrMcastGrpID   = 2
+
+	// netlink message layout
+	genlHeaderLen = 4
+	nlaHeaderLen  = 4
+	nlaTypeMask   = 0x3fff
+
+	familyName     = "nl80211"
+	mcastGroupMlme = "mlme"
+	requestTimeout = 5 * time.Second
+	recvBufferSize = 64 * 1024
+
+	// nl80211 commands and attributes (see linux/nl80211.h)
+	cmdGetInterface        = 5
+	cmdGetScan             = 32
+	cmdConnect             = 46
+	cmdRoam                = 47
+	cmdDisconnect          = 48
+	attrIfindex            = 3
+	attrIfname             = 4
+	attrIftype             = 5
+	attrBss                = 47
+	attrSsid               = 52
+	bssBssid               = 1
+	bssCapability          = 5
+	bssInformationElements = 6
+	bssStatus              = 9
+	bssBeaconIes           = 11
+	bssStatusAssociated    = 1
+	iftypeStation          = 2
+	capabilityPrivacy      = 1 << 4
+
+	// IEEE 802.11 information elements
+	ieSSID           = 0
+	ieRSN            = 48
+	ieVendorSpecific = 221
+)
+
+// Interface - wireless interface in station (client) mode
+type Interface struct {
+	Index int
+	Name  string
+	// SSID of the network the interface is connected to (empty when not connected)
+	SSID string
+}
+
+// BSS - information about the access point (basic service set) found by the last scan
+type BSS struct {
+	BSSID        string
+	SSID         string
+	IsAssociated bool
+	// IsInsecure - true for open networks and for networks which are using WEP
+	IsInsecure bool
+}
+
+// Network - the Wi-Fi network the host is currently associated with
+type Network struct {
+	InterfaceName string
+	SSID          string
+	BSSID         string
+	IsInsecure    bool
+}
+
+type attribute struct {
+	typ  uint16
+	data []byte
+}
+
+// Client - connection to the nl80211 generic netlink family
+type Client struct {
+	mutex       sync.Mutex
+	fd          int
+	seq         uint32
+	familyID    uint16
+	mcastGroups map[string]uint32
+}
+
+// nativeEndian - netlink headers and attributes are in the host byte order
+var nativeEndian binary.ByteOrder = func() binary.ByteOrder {
+	v := uint16(1)
+	if *(*byte)(unsafe.Pointer(&v)) == 1 {
+		return binary.LittleEndian
+	}
+	return binary.BigEndian
+}()
+
+// New creates new nl80211 client.
+// Returns an error when the nl80211 family is not available (e.g. no cfg80211 module loaded)
+func New() (*Client, error) {
+	fd, err := syscall.Socket(syscall.AF_NETLINK, syscall.SOCK_RAW|syscall.SOCK_CLOEXEC, syscall.NETLINK_GENERIC)
+	if err != nil {
+		return nil, fmt.Errorf("netlink socket initialization error: %w", err)
+	}
+	if err := syscall.Bind(fd, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}); err != nil {
+		syscall.Close(fd)
+		return nil, fmt.Errorf("netlink socket binding error: %w", err)
+	}
+	tv := syscall.NsecToTimeval(requestTimeout.Nanoseconds())
+	if err := syscall.SetsockoptTimeval(fd, syscall.SOL_SOCKET, syscall.SO_RCVTIMEO, &tv); err != nil {
+		syscall.Close(fd)
+		return nil, fmt.Errorf("netlink socket configuration error: %w", err)
+	}
+
+	c := &Client{fd: fd}
+	if err := c.resolveFamily(); err != nil {
+		syscall.Close(fd)
+		return nil, err
+	}
+	return c, nil
+}
+
+// Close closes the netlink socket
+func (c *Client) Close() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.fd < 0 {
+		return nil
+	}
+	err := syscall.Close(c.fd)
+	c.fd = -1
+	return err
+}
+
+// Interfaces returns wireless interfaces in station mode
+func (c *Client) Interfaces() ([]Interface, error) {
+	msgs, err := c.request(c.familyID, cmdGetInterface, syscall.NLM_F_DUMP, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wireless interfaces: %w", err)
+	}
+
+	ret := make([]Interface, 0, len(msgs))
+	for _, m := range msgs {
+		var (
+			ifc       Interface
+			isStation bool
+		)
+		for _, a := range parseAttributes(m) {
+			switch a.typ {
+			case attrIfindex:
+				ifc.Index = int(attrUint32(a.data))
+			case attrIfname:
+				ifc.Name = attrString(a.data)
+			case attrIftype:
+				isStation = attrUint32(a.data) == iftypeStation
+			case attrSsid:
+				ifc.SSID = string(a.data)
+			}
+		}
+		if isStation && ifc.Index > 0 {
+			ret = append(ret, ifc)
+		}
+	}
+	return ret, nil
+}
+
+// ScanResults returns the access points known to the kernel from the latest scan on the interface.
+// No new scan is triggered: the results are updated by the system's Wi-Fi supplicant.
+func (c *Client) ScanResults(ifIndex int) ([]BSS, error) {
+	msgs, err := c.request(c.familyID, cmdGetScan, syscall.NLM_F_DUMP, []attribute{{typ: attrIfindex, data: uint32Bytes(uint32(ifIndex))}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scan results: %w", err)
+	}
+
+	ret := make([]BSS, 0, len(msgs))
+	for _, m := range msgs {
+		for _, a := range parseAttributes(m) {
+			if a.typ != attrBss {
+				continue
+			}
+			if bss, ok := parseBSS(a.data); ok {
+				ret = append(ret, bss)
+			}
+		}
+	}
+	return ret, nil
+}
+
+// CurrentNetwork returns the Wi-Fi network the host is associated with (nil when not connected to any)
+func (c *Client) CurrentNetwork() (*Network, error) {
+	interfaces, err := c.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, ifc := range interfaces {
+		bssList, err := c.ScanResults(ifc.Index)
+		if err != nil {
+			return nil, err
+		}
+		for _, bss := range bssList {
+			if !bss.IsAssociated {
+				continue
+			}
+			ssid := bss.SSID
+			if ssid == "" {
+				ssid = ifc.SSID // hidden network
+			}
+			return &Network{InterfaceName: ifc.Name, SSID: ssid, BSSID: bss.BSSID, IsInsecure: bss.IsInsecure}, nil
+		}
+		if ifc.SSID != "" {
+			// associated, but the BSS is not in the scan cache (yet)
+			return &Network{InterfaceName: ifc.Name, SSID: ifc.SSID}, nil
+		}
+	}
+	return nil, nil
+}
+
+// AvailableSSIDs returns the names of the Wi-Fi networks found by the latest scan
+func (c *Client) AvailableSSIDs() ([]string, error) {
+	interfaces, err := c.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+
+	ret := make([]string, 0)
+	known := make(map[string]struct{})
+	for _, ifc := range interfaces {
+		bssList, err := c.ScanResults(ifc.Index)
+		if err != nil {
+			return nil, err
+		}
+		for _, bss := range bssList {
+			if bss.SSID == "" {
+				continue
+			}
+			if _, ok := known[bss.SSID]; ok {
+				continue
+			}
+			known[bss.SSID] = struct{}{}
+			ret = append(ret, bss.SSID)
+		}
+	}
+	return ret, nil
+}
+
+// ListenConnectionEvents notifies 'onEvent' channel each time a Wi-Fi interface
+// connects, disconnects or roams to another access point.
+// The notification is non-blocking: if the channel is full, the event is skipped.
+func ListenConnectionEvents(onEvent chan<- struct{}) error {
+	c, err := New()
+	if err != nil {
+		return err
+	}
+
+	grp, ok := c.mcastGroups[mcastGroupMlme]
+	if !ok {
+		c.Close()
+		return fmt.Errorf("nl80211 multicast group '%s' not available", mcastGroupMlme)
+	}
+	if err := syscall.SetsockoptInt(c.fd, solNetlink, syscall.NETLINK_ADD_MEMBERSHIP, int(grp)); err != nil {
+		c.Close()
+		return fmt.Errorf("failed to join nl80211 multicast group: %w", err)
+	}
+	// events are waited for without timeout
+	tv := syscall.Timeval{}
+	if err := syscall.SetsockoptTimeval(c.fd, syscall.SOL_SOCKET, syscall.SO_RCVTIMEO, &tv); err != nil {
+		c.Close()
+		return fmt.Errorf("netlink socket configuration error: %w", err)
+	}
+
+	go func() {
+		defer c.Close()
+
+		buf := make([]byte, recvBufferSize)
+		for {
+			n, _, err := syscall.Recvfrom(c.fd, buf, 0)
+			if err != nil {
+				if err == syscall.EINTR || err == syscall.ENOBUFS {
+					// ENOBUFS: some events were lost; notify anyway
+					if err == syscall.ENOBUFS {
+						notify(onEvent)
+					}
+					continue
+				}
+				return
+			}
+			msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+			if err != nil {
+				continue
+			}
+			for _, m := range msgs {
+				if m.Header.Type != c.familyID || len(m.Data) < genlHeaderLen {
+					continue
+				}
+				switch m.Data[0] {
+				case cmdConnect, cmdDisconnect, cmdRoam:
+					notify(onEvent)
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+func notify(ch chan<- struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
+
+func (c *Client) resolveFamily() error {
+	msgs, err := c.request(genlIDCtrl, ctrlCmdGetFamily, 0, []attribute{{typ: ctrlAttrFamilyName, data: append([]byte(familyName), 0)}})
+	if err != nil {
+		return fmt.Errorf("nl80211 is not available: %w", err)
+	}
+
+	c.mcastGroups = make(map[string]uint32)
+	for _, m := range msgs {
+		for _, a := range parseAttributes(m) {
+			switch a.typ {
+			case ctrlAttrFamilyID:
+				if len(a.data) >= 2 {
+					c.familyID = nativeEndian.Uint16(a.data)
+				}
+			case ctrlAttrMcastGroups:
+				for _, grp := range parseAttributeList(a.data) {
+					var (
+						name string
+						id   uint32
+					)
+					for _, ga := range parseAttributeList(grp.data) {
+						switch ga.typ {
+						case ctrlAttrMcastGrpName:
+							name = attrString(ga.data)
+						case ctrlAttrMcastGrpID:
+							id = attrUint32(ga.data)
+						}
+					}
+					if name != "" {
+						c.mcastGroups[name] = id
+					}
+				}
+			}
+		}
+	}
+
+	if c.familyID == 0 {
+		return fmt.Errorf("nl80211 is not available: family ID not resolved")
+	}
+	return nil
+}
+
+// request sends generic netlink request and returns the payload (genl header excluded) of all reply messages
+func (c *Client) request(family uint16, cmd uint8, flags uint16, attrs []attribute) ([]syscall.NetlinkMessage, error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.fd < 0 {
+		return nil, fmt.Errorf("netlink socket closed")
+	}
+
+	c.seq++
+	seq := c.seq
+
+	payload := []byte{cmd, 1, 0, 0} // genlmsghdr: cmd, version, reserved
+	for _, a := range attrs {
+		payload = append(payload, encodeAttribute(a)...)
+	}
+
+	msg := make([]byte, syscall.NLMSG_HDRLEN, syscall.NLMSG_HDRLEN+len(payload))
+	nativeEndian.PutUint32(msg[0:4], uint32(syscall.NLMSG_HDRLEN+len(payload)))
+	nativeEndian.PutUint16(msg[4:6], family)
+	nativeEndian.PutUint16(msg[6:8], flags|syscall.NLM_F_REQUEST|syscall.NLM_F_ACK)
+	nativeEndian.PutUint32(msg[8:12], seq)
+	msg = append(msg, payload...)
+
+	if err := syscall.Sendto(c.fd, msg, 0, &syscall.SockaddrNetlink{Family: syscall.AF_NETLINK}); err != nil {
+		return nil, err
+	}
+
+	var ret []syscall.NetlinkMessage
+	buf := make([]byte, recvBufferSize)
+	for {
+		n, _, err := syscall.Recvfrom(c.fd, buf, 0)
+		if err != nil {
+			if err == syscall.EINTR {
+				continue
+			}
+			return nil, err
+		}
+		msgs, err := syscall.ParseNetlinkMessage(buf[:n])
+		if err != nil {
+			return nil, err
+		}
+		for _, m := range msgs {
+			if m.Header.Seq != seq {
+				continue
+			}
+			switch m.Header.Type {
+			case syscall.NLMSG_DONE:
+				return ret, nil
+			case syscall.NLMSG_ERROR:
+				if len(m.Data) < 4 {
+					return nil, fmt.Errorf("malformed netlink error message")
+				}
+				if errno := int32(nativeEndian.Uint32(m.Data[0:4])); errno != 0 {
+					return nil, syscall.Errno(-errno)
+				}
+				return ret, nil // ACK
+			default:
+				if len(m.Data) >= genlHeaderLen {
+					ret = append(ret, syscall.NetlinkMessage{Header: m.Header, Data: append([]byte(nil), m.Data[genlHeaderLen:]...)})
+				}
+			}
+		}
+	}
+}
+
+func parseBSS(data []byte) (bss BSS, ok bool) {
+	var (
+		capability uint16
+		ies        []byte
+		beaconIes  []byte
+	)
+	for _, a := range parseAttributeList(data) {
+		switch a.typ {
+		case bssBssid:
+			if len(a.data) == 6 {
+				bss.BSSID = net.HardwareAddr(a.data).String()
+			}
+		case bssCapability:
+			if len(a.data) >= 2 {
+				capability = nativeEndian.Uint16(a.data)
+			}
+		case bssInformationElements:
+			ies = a.data
+		case bssBeaconIes:
+			beaconIes = a.data
+		case bssStatus:
+			bss.IsAssociated = attrUint32(a.data) == bssStatusAssociated
+		}
+	}
+	if bss.BSSID == "" {
+		return bss, false
+	}
+	if len(ies) == 0 {
+		ies = beaconIes
+	}
+
+	isRSN, isWPA := false, false
+	for len(ies) >= 2 {
+		id, l := ies[0], int(ies[1])
+		if len(ies) < 2+l {
+			break
+		}
+		val := ies[2 : 2+l]
+		switch id {
+		case ieSSID:
+			bss.SSID = string(val)
+		case ieRSN:
+			isRSN = true
+		case ieVendorSpecific:
+			// Microsoft OUI (00:50:F2), type 1: WPA
+			if l >= 4 && val[0] == 0x00 && val[1] == 0x50 && val[2] == 0xF2 && val[3] == 0x01 {
+				isWPA = true
+			}
+		}
+		ies = ies[2+l:]
+	}
+
+	// open network (no privacy) or WEP (privacy without RSN/WPA)
+	bss.IsInsecure = (capability&capabilityPrivacy) == 0 || (!isRSN && !isWPA)
+	return bss, true
+}
+
+// parseAttributes parses attributes of the generic netlink message payload
+func parseAttributes(m syscall.NetlinkMessage) []attribute {
+	return parseAttributeList(m.Data)
+}
+
+func parseAttributeList(b []byte) []attribute {
+	var ret []attribute
+	for len(b) >= nlaHeaderLen {
+		l := int(nativeEndian.Uint16(b[0:2]))
+		typ := nativeEndian.Uint16(b[2:4]) & nlaTypeMask
+		if l < nlaHeaderLen || l > len(b) {
+			break
+		}
+		ret = append(ret, attribute{typ: typ, data: b[nlaHeaderLen:l]})
+		aligned := nlaAlign(l)
+		if aligned >= len(b) {
+			break
+		}
+		b = b[aligned:]
+	}
+	return ret
+}
+
+func encodeAttribute(a attribute) []byte {
+	l := nlaHeaderLen + len(a.data)
+	b := make([]byte, nlaAlign(l))
+	nativeEndian.PutUint16(b[0:2], uint16(l))
+	nativeEndian.PutUint16(b[2:4], a.typ)
+	copy(b[nlaHeaderLen:], a.data)
+	return b
+}
+
+func nlaAlign(l int) int {
+	return (l + 3) &^ 3
+}
+
+func attrUint32(b []byte) uint32 {
+	if len(b) < 4 {
+		return 0
+	}
+	return nativeEndian.Uint32(b)
+}
+
+func attrString(b []byte) string {
+	for i, c := range b {
+		if c == 0 {
+			return string(b[:i])
+		}
+	}
+	return string(b)
+}
+
+func uint32Bytes(v uint32) []byte {
+	b := make([]byte, 4)
+	nativeEndian.PutUint32(b, v)
+	return b
+}
@@ -0,0 +1,178 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+//go:build windows
+// +build windows
+
+package iphlpapi
+
+import (
+	"fmt"
+	"runtime"
+	"syscall"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// DNS_INTERFACE_SETTINGS versions
+const (
+	DnsInterfaceSettingsVersion1 uint32 = 1
+	DnsInterfaceSettingsVersion3 uint32 = 3
+)
+
+// DNS_INTERFACE_SETTINGS flags (the fields of the structure to be set/read)
+const (
+	DnsSettingIPv6       uint64 = 0x0001
+	DnsSettingNameServer uint64 = 0x0002
+	DnsSettingSearchList uint64 = 0x0004
+	DnsSettingDomain     uint64 = 0x0020
+	DnsSettingDoh        uint64 = 0x1000
+)
+
+// DNS_SERVER_PROPERTY version and type
+const (
+	DnsServerPropertyVersion1 uint32 = 1
+	DnsServerDohProperty      int32  = 1
+)
+
+// DNS_DOH_SERVER_SETTINGS flags
+const (
+	DnsDohServerSettingsEnableAuto uint64 = 0x0001 // the template is loaded from the system list of the known DoH servers
+	DnsDohServerSettingsEnable     uint64 = 0x0002
+)
+
+// DnsDohServerSettings - DNS_DOH_SERVER_SETTINGS structure
+// https://learn.microsoft.com/en-us/windows/win32/api/netioapi/ns-netioapi-dns_doh_server_settings
+type DnsDohServerSettings struct {
+	Template *uint16
+	_        [8 - unsafe.Sizeof(uintptr(0))]byte // 'Flags' is 8-byte aligned on 32-bit platforms too
+	Flags    uint64
+}
+
+// DnsServerProperty - DNS_SERVER_PROPERTY structure
+// https://learn.microsoft.com/en-us/windows/win32/api/netioapi/ns-netioapi-dns_server_property
+type DnsServerProperty struct {
+	Version     uint32
+	ServerIndex uint32
+	Type        int32
+	DohSettings *DnsDohServerSettings // union DNS_SERVER_PROPERTY_TYPES (only DoH settings are supported by the OS)
+}
+
+// DnsInterfaceSettings3 - DNS_INTERFACE_SETTINGS3 structure
+// (the beginning of the structure is equal to DNS_INTERFACE_SETTINGS, so it can be used with Version = DnsInterfaceSettingsVersion1)
+// https://learn.microsoft.com/en-us/windows/win32/api/netioapi/ns-netioapi-dns_interface_settings3
+type DnsInterfaceSettings3 struct {
+	Version                     uint32
+	_                           [4]byte
+	Flags                       uint64
+	Domain                      *uint16
+	NameServer                  *uint16
+	SearchList                  *uint16
+	RegistrationEnabled         uint32
+	RegisterAdapterName         uint32
+	EnableLLMNR                 uint32
+	QueryAdapterName            uint32
+	ProfileNameServer           *uint16
+	DisableUnconstrainedQueries uint32
+	SupplementalSearchList      *uint16
+	CServerProperties           uint32
+	ServerProperties            *DnsServerProperty
+	CProfileServerProperties    uint32
+	ProfileServerProperties     *DnsServerProperty
+}
+
+// GetServerProperties returns the slice of server properties
+// (the data is owned by the structure; for data received by APIGetInterfaceDnsSettings it is valid until APIFreeInterfaceDnsSettings call)
+func (s *DnsInterfaceSettings3) GetServerProperties() []DnsServerProperty {
+	if s.CServerProperties == 0 || s.ServerProperties == nil {
+		return nil
+	}
+	return unsafe.Slice(s.ServerProperties, s.CServerProperties)
+}
+
+var (
+	_fGetInterfaceDnsSettings  = _dll.NewProc("GetInterfaceDnsSettings")
+	_fSetInterfaceDnsSettings  = _dll.NewProc("SetInterfaceDnsSettings")
+	_fFreeInterfaceDnsSettings = _dll.NewProc("FreeInterfaceDnsSettings")
+)
+
+// IsInterfaceDnsSettingsAPIAvailable returns 'true' when the OS supports the DNS interface settings API (Windows 10 and newer)
+func IsInterfaceDnsSettingsAPIAvailable() bool {
+	return _fGetInterfaceDnsSettings.Find() == nil && _fSetInterfaceDnsSettings.Find() == nil && _fFreeInterfaceDnsSettings.Find() == nil
+}
+
+// APIGetInterfaceDnsSettings - retrieves the DNS settings of the interface
+// The 'settings.Version' must be initialized by the caller. The received data must be released by APIFreeInterfaceDnsSettings()
+// https://learn.microsoft.com/en-us/windows/win32/api/netioapi/nf-netioapi-getinterfacednssettings
+func APIGetInterfaceDnsSettings(ifcGUID windows.GUID, settings *DnsInterfaceSettings3) (err error) {
+	defer catchPanic(&err)
+	return callWithGUID(_fGetInterfaceDnsSettings, ifcGUID, settings)
+}
+
+// APISetInterfaceDnsSettings - sets the DNS settings of the interface
+// https://learn.microsoft.com/en-us/windows/win32/api/netioapi/nf-netioapi-setinterfacednssettings
+func APISetInterfaceDnsSettings(ifcGUID windows.GUID, settings *DnsInterfaceSettings3) (err error) {
+	defer catchPanic(&err)
+	return callWithGUID(_fSetInterfaceDnsSettings, ifcGUID, settings)
+}
+
+// APIFreeInterfaceDnsSettings - releases the data received by APIGetInterfaceDnsSettings()
+// https://learn.microsoft.com/en-us/windows/win32/api/netioapi/nf-netioapi-freeinterfacednssettings
+func APIFreeInterfaceDnsSettings(settings *DnsInterfaceSettings3) (err error) {
+	defer catchPanic(&err)
+	if err := _fFreeInterfaceDnsSettings.Find(); err != nil {
+		return err
+	}
+	_fFreeInterfaceDnsSettings.Call(uintptr(unsafe.Pointer(settings)))
+	return nil
+}
+
+// callWithGUID calls the function with signature: DWORD Func(GUID Interface, DNS_INTERFACE_SETTINGS* Settings)
+// The way of passing a 16-byte structure by value depends on the platform calling convention:
+//   - amd64: passed by reference (pointer to the copy of the structure)
+//   - arm64: passed in two 64-bit registers
+//   - 386/arm: passed as four 32-bit values
+func callWithGUID(proc *syscall.LazyProc, guid windows.GUID, settings *DnsInterfaceSettings3) error {
+	if err := proc.Find(); err != nil {
+		return fmt.Errorf("function '%s' is not available: %w", proc.Name, err)
+	}
+
+	var retval uintptr
+	switch runtime.GOARCH {
+	case "amd64":
+		guidCopy := new(windows.GUID)
+		*guidCopy = guid
+		retval, _, _ = proc.Call(uintptr(unsafe.Pointer(guidCopy)), uintptr(unsafe.Pointer(settings)))
+	case "arm64":
+		qwords := *(*[2]uint64)(unsafe.Pointer(&guid))
+		retval, _, _ = proc.Call(uintptr(qwords[0]), uintptr(qwords[1]), uintptr(unsafe.Pointer(settings)))
+	default:
+		dwords := *(*[4]uint32)(unsafe.Pointer(&guid))
+		retval, _, _ = proc.Call(uintptr(dwords[0]), uintptr(dwords[1]), uintptr(dwords[2]), uintptr(dwords[3]), uintptr(unsafe.Pointer(settings)))
+	}
+
+	if retval != 0 {
+		return fmt.Errorf("%s: %w", proc.Name, syscall.Errno(retval))
+	}
+	return nil
+}
@@ -46,6 +46,11 @@ var (
 	_fGetBestRoute         = _dll.NewProc("GetBestRoute")
 	_fGetIPForwardTable    = _dll.NewProc("GetIpForwardTable")
 	_fGetExtendedTcpTable  = _dll.NewProc("GetExtendedTcpTable")
+	_fGetIfEntry           = _dll.NewProc("GetIfEntry")
+
+	_fNotifyRouteChange2      = _dll.NewProc("NotifyRouteChange2")
+	_fNotifyIpInterfaceChange = _dll.NewProc("NotifyIpInterfaceChange")
+	_fCancelMibChangeNotify2  = _dll.NewProc("CancelMibChangeNotify2")
 )
 
 // APINotifyRouteChange - The GetBestRoute function retrieves the best route to the specified destination IP address.
@@ -128,3 +133,13 @@ func GetExtendedTCPTable(tcpTable []byte, pdwSize *uint32, order bool, afType in
 
 	return syscall.Errno(retval), nil
 }
+
+// GetIfEntry - The GetIfEntry function retrieves information for the specified interface on the local computer.
+// (the interface index must be defined in 'pIfRow.DwIndex')
+// https://docs.microsoft.com/en-us/windows/win32/api/iphlpapi/nf-iphlpapi-getifentry
+func GetIfEntry(pIfRow *MibIfRow) (err error) {
+	defer catchPanic(&err)
+
+	retval, _, err := _fGetIfEntry.Call(uintptr(unsafe.Pointer(pIfRow)))
+	return checkDefaultAPIResp(retval, err)
+}
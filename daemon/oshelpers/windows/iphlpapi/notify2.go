@@ -0,0 +1,130 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+//go:build windows
+// +build windows
+
+package iphlpapi
+
+import (
+	"fmt"
+	"sync"
+	"syscall"
+	"unsafe"
+)
+
+// MibChangeHandler - the handler of network configuration change notifications.
+// Note: it is called from a system thread-pool thread
+type MibChangeHandler func()
+
+var (
+	mibChangeMutex    sync.Mutex
+	mibChangeHandlers = map[uintptr]MibChangeHandler{} // caller context -> handler
+	mibChangeContexts = map[syscall.Handle]uintptr{}   // notification handle -> caller context
+	mibChangeLastCtx  uintptr
+
+	// The number of callbacks created by syscall.NewCallback() is limited (and they are never released),
+	// so the single callback is in use for all notifications
+	mibChangeCallbackOnce sync.Once
+	mibChangeCallback     uintptr
+)
+
+func getMibChangeCallback() uintptr {
+	mibChangeCallbackOnce.Do(func() {
+		// Signature of PIPFORWARD_CHANGE_CALLBACK and PIPINTERFACE_CHANGE_CALLBACK:
+		// VOID Callback(PVOID CallerContext, PMIB_IPFORWARD_ROW2/PMIB_IPINTERFACE_ROW Row, MIB_NOTIFICATION_TYPE NotificationType)
+		mibChangeCallback = syscall.NewCallback(func(callerContext, row, notificationType uintptr) uintptr {
+			mibChangeMutex.Lock()
+			handler := mibChangeHandlers[callerContext]
+			mibChangeMutex.Unlock()
+
+			if handler != nil {
+				handler()
+			}
+			return 0
+		})
+	})
+	return mibChangeCallback
+}
+
+// APINotifyRouteChange2 - registers to be notified for changes to IPv4 and IPv6 route entries.
+// The notification must be cancelled by APICancelMibChangeNotify2()
+// https://docs.microsoft.com/en-us/windows/win32/api/netioapi/nf-netioapi-notifyroutechange2
+func APINotifyRouteChange2(handler MibChangeHandler) (syscall.Handle, error) {
+	return notifyMibChange(_fNotifyRouteChange2, handler)
+}
+
+// APINotifyIPInterfaceChange - registers to be notified for changes to all IP interfaces (IPv4 and IPv6).
+// The notification must be cancelled by APICancelMibChangeNotify2()
+// https://docs.microsoft.com/en-us/windows/win32/api/netioapi/nf-netioapi-notifyipinterfacechange
+func APINotifyIPInterfaceChange(handler MibChangeHandler) (syscall.Handle, error) {
+	return notifyMibChange(_fNotifyIpInterfaceChange, handler)
+}
+
+// APICancelMibChangeNotify2 - deregisters for change notifications for IP interface changes, IP address changes, IP route changes ...
+// Note: the function waits until all running notification handlers are finished (do not call it from the handler)
+// https://docs.microsoft.com/en-us/windows/win32/api/netioapi/nf-netioapi-cancelmibchangenotify2
+func APICancelMibChangeNotify2(handle syscall.Handle) (err error) {
+	defer catchPanic(&err)
+
+	retval, _, _ := _fCancelMibChangeNotify2.Call(uintptr(handle))
+
+	mibChangeMutex.Lock()
+	if ctx, ok := mibChangeContexts[handle]; ok {
+		delete(mibChangeHandlers, ctx)
+		delete(mibChangeContexts, handle)
+	}
+	mibChangeMutex.Unlock()
+
+	if retval != 0 {
+		return fmt.Errorf("CancelMibChangeNotify2 error: 0x%X", retval)
+	}
+	return nil
+}
+
+func notifyMibChange(proc *syscall.LazyProc, handler MibChangeHandler) (handle syscall.Handle, err error) {
+	defer catchPanic(&err)
+
+	if handler == nil {
+		return 0, fmt.Errorf("notification handler not defined")
+	}
+	callback := getMibChangeCallback()
+
+	mibChangeMutex.Lock()
+	mibChangeLastCtx++
+	ctx := mibChangeLastCtx
+	mibChangeHandlers[ctx] = handler
+	mibChangeMutex.Unlock()
+
+	const initialNotification = 0 // FALSE
+	retval, _, _ := proc.Call(uintptr(syscall.AF_UNSPEC), callback, ctx, initialNotification, uintptr(unsafe.Pointer(&handle)))
+
+	mibChangeMutex.Lock()
+	defer mibChangeMutex.Unlock()
+	if retval != 0 {
+		delete(mibChangeHandlers, ctx)
+		return 0, fmt.Errorf("%s error: 0x%X", proc.Name, retval)
+	}
+	mibChangeContexts[handle] = ctx
+
+	return handle, nil
+}
@@ -68,3 +68,32 @@ type MibTCPRowOwnerPid struct {
 	DwRemotePort [4]byte //uint32
 	DwOwningPid  uint32
 }
+
+// MibIfRow - The MIB_IFROW structure stores information about a particular interface.
+// https://docs.microsoft.com/en-us/windows/win32/api/ifmib/ns-ifmib-mib_ifrow
+type MibIfRow struct {
+	WszName           [256]uint16
+	DwIndex           uint32
+	DwType            uint32
+	DwMtu             uint32
+	DwSpeed           uint32
+	DwPhysAddrLen     uint32
+	BPhysAddr         [8]byte
+	DwAdminStatus     uint32
+	DwOperStatus      uint32
+	DwLastChange      uint32
+	DwInOctets        uint32
+	DwInUcastPkts     uint32
+	DwInNUcastPkts    uint32
+	DwInDiscards      uint32
+	DwInErrors        uint32
+	DwInUnknownProtos uint32
+	DwOutOctets       uint32
+	DwOutUcastPkts    uint32
+	DwOutNUcastPkts   uint32
+	DwOutDiscards     uint32
+	DwOutErrors       uint32
+	DwOutQLen         uint32
+	DwDescrLen        uint32
+	BDescr            [256]byte
+}
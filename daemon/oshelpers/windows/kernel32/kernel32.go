@@ -26,6 +26,7 @@ package kernel32
 
 import (
 	"syscall"
+	"unsafe"
 
 	"golang.org/x/sys/windows"
 )
@@ -33,6 +34,8 @@ import (
 var (
 	_dll       = windows.NewLazySystemDLL("kernel32.dll")
 	_fSetEvent = _dll.NewProc("SetEvent")
+
+	_fGetNamedPipeClientProcessId = _dll.NewProc("GetNamedPipeClientProcessId")
 )
 
 // SetEvent - Sets the specified event object to the signaled state.
@@ -44,3 +47,13 @@ func SetEvent(evt syscall.Handle) (bool, error) {
 	}
 	return retval != 0, nil
 }
+
+// GetNamedPipeClientProcessId - Retrieves the client process identifier for the specified named pipe.
+// https://docs.microsoft.com/en-us/windows/win32/api/winbase/nf-winbase-getnamedpipeclientprocessid
+func GetNamedPipeClientProcessId(pipe syscall.Handle, clientProcessId *uint32) error {
+	retval, _, err := _fGetNamedPipeClientProcessId.Call(uintptr(pipe), uintptr(unsafe.Pointer(clientProcessId)))
+	if retval == 0 {
+		return err
+	}
+	return nil
+}
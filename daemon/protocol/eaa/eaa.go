@@ -32,13 +32,33 @@ import (
 	"time"
 
 	"github.com/ivpn/desktop-app/daemon/helpers"
+	"github.com/ivpn/desktop-app/daemon/keyprotect"
+	"github.com/ivpn/desktop-app/daemon/logger"
 )
 
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("eaa")
+}
+
+// If the hardware-backed key protection is available (TPM 2.0 or Secure Enclave),
+// the secret is protected by a hardware-bound key and the EAA file starts with this header.
+// The file without this header contains the secret as is
+// (the hardware protection is not available or the file was saved by the previous version).
+const hwProtectedHeader = "hwprotected\n"
+
 // Enhanced App Authentication
 type Eaa struct {
 	mutex              sync.Mutex
 	secretFile         string
 	lastFailedAttempts []time.Time
+	isMigrationChecked bool
+
+	// the secret hash restored from the EAA file
+	// (restoring the hardware-protected secret is expensive, so it is cached until the file changed)
+	cachedHash     []byte
+	cachedFileInfo os.FileInfo
 }
 
 func Init(secretFile string) *Eaa {
@@ -81,6 +101,7 @@ func (e *Eaa) doGetSecretHash() (retSecretHash []byte, retErr error) {
 	if err != nil {
 		if os.IsNotExist(err) {
 			e.lastFailedAttempts = nil
+			e.resetCache()
 			return nil, nil // paranoid mode disabled
 		}
 		return nil, fmt.Errorf("the EAA file open error : %w", err)
@@ -92,6 +113,11 @@ func (e *Eaa) doGetSecretHash() (retSecretHash []byte, retErr error) {
 		return nil, fmt.Errorf("the EAA file status check error : %w", err)
 	}
 
+	if e.cachedFileInfo != nil && e.cachedFileInfo.Size() == stat.Size() && e.cachedFileInfo.ModTime().Equal(stat.ModTime()) {
+		return e.cachedHash, nil
+	}
+	e.resetCache()
+
 	// check file access rights
 	//mode := stat.Mode()
 	//expectedMode := os.FileMode(0600) // read only for privilaged user
@@ -110,12 +136,77 @@ func (e *Eaa) doGetSecretHash() (retSecretHash []byte, retErr error) {
 		return nil, fmt.Errorf("failed to read EAA file: %w", err)
 	}
 
+	if bytes.HasPrefix(buff, []byte(hwProtectedHeader)) {
+		secret, err := keyprotect.Unprotect(string(buff[len(hwProtectedHeader):]))
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore hardware-protected EAA secret: %w", err)
+		}
+		e.cachedHash, e.cachedFileInfo = []byte(secret), stat
+		return e.cachedHash, nil
+	}
+
+	if len(buff) > 0 && !e.isMigrationChecked {
+		e.isMigrationChecked = true
+		e.doMigrateToHwProtection(string(buff))
+		return buff, nil // the file is re-saved: do not cache the old file info
+	}
+	e.cachedHash, e.cachedFileInfo = buff, stat
 	return buff, nil
 }
 
+func (e *Eaa) resetCache() {
+	e.cachedHash, e.cachedFileInfo = nil, nil
+}
+
+// doMigrateToHwProtection re-saves the unprotected secret (e.g. saved by the previous version) protected by a hardware-bound key
+func (e *Eaa) doMigrateToHwProtection(secret string) {
+	data := storableSecret(secret)
+	if !bytes.HasPrefix(data, []byte(hwProtectedHeader)) {
+		return // hardware protection is not available
+	}
+
+	if err := helpers.WriteFile(e.secretFile, data, 0600); err != nil {
+		log.Warning(fmt.Sprintf("failed to migrate EAA secret to hardware-protected storage: %s", err))
+		// restore the original file content
+		if err := helpers.WriteFile(e.secretFile, []byte(secret), 0600); err != nil {
+			log.Error(fmt.Sprintf("failed to restore EAA secret: %s", err))
+		}
+		return
+	}
+	log.Info("EAA secret migrated to hardware-protected storage")
+}
+
+// storableSecret returns the data to be saved to the EAA file.
+// The secret is protected by a hardware-bound key (if available); otherwise, it is kept as is.
+func storableSecret(secret string) []byte {
+	if err := keyprotect.GetFuncNotAvailableError(); err != nil {
+		return []byte(secret)
+	}
+
+	protected, err := keyprotect.Protect(secret)
+	if err == nil {
+		// ensure the secret can be restored (otherwise, EAA will not work after saving)
+		var restored string
+		if restored, err = keyprotect.Unprotect(protected); err == nil && restored != secret {
+			err = fmt.Errorf("restored secret does not match")
+		}
+	}
+	if err != nil {
+		log.Warning(fmt.Sprintf("EAA secret will be saved without hardware protection: %s", err))
+		return []byte(secret)
+	}
+	return []byte(hwProtectedHeader + protected)
+}
+
+// doIsEnabled returns 'true' when EAA is enabled.
+// When the EAA file exists but can not be read, EAA is considered as enabled (all secrets are rejected; see doCheckSecret()).
 func (e *Eaa) doIsEnabled() bool {
 	secretHash, err := e.doGetSecretHash()
-	return err == nil && len(secretHash) > 0
+	if err != nil {
+		log.Error(err)
+		return true
+	}
+	return len(secretHash) > 0
 }
 
 func (e *Eaa) doForceDisable() error {
@@ -139,6 +230,7 @@ func (e *Eaa) doForceDisable() error {
 		return fmt.Errorf("failed to disable EAA: %w", removeErr)
 	}
 	e.lastFailedAttempts = nil
+	e.resetCache()
 	return nil
 }
 
@@ -175,7 +267,8 @@ func (e *Eaa) doSetSecret(oldSecret, newSecret string) error {
 	}
 
 	// save data
-	if err := helpers.WriteFile(file, []byte(newSecret), 0600); err != nil {
+	e.resetCache()
+	if err := helpers.WriteFile(file, storableSecret(newSecret), 0600); err != nil {
 		e.doForceDisable()
 		return fmt.Errorf("failed to enable EAA (FileWrite error): %w", err)
 	}
@@ -206,9 +299,12 @@ func (e *Eaa) doCheckSecret(secretToCheck string) (retVal bool, err error) {
 
 	// read secretHash
 	secretHash, err := e.doGetSecretHash()
-	isPModeEnabled := err == nil && len(secretHash) > 0
-	if !isPModeEnabled {
-		return true, nil
+	if err != nil {
+		// the EAA file exists but can not be read: reject all secrets
+		return false, fmt.Errorf("failed to check EAA secret: %w", err)
+	}
+	if len(secretHash) == 0 {
+		return true, nil // paranoid mode disabled
 	}
 
 	// some protection from brute force attack
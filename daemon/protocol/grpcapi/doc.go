@@ -0,0 +1,39 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package grpcapi contains the protobuf definitions of the daemon gRPC API (ivpn.proto) and the generated Go code.
+//
+// The gRPC API is an optional (disabled by default) typed alternative to the JSON protocol of the daemon
+// for the third-party integrations: each request of the daemon protocol is available as the method of the 'Daemon' service;
+// the daemon events are available via the server-streaming method 'Events'.
+// The API is available on the localhost TCP port (see preferences.GrpcApiParams);
+// the access token must be passed in the 'authorization' metadata ('Bearer <token>').
+// The server supports the gRPC reflection, so tools like 'grpcurl' can be used without the .proto file:
+//
+//	grpcurl -plaintext -H "authorization: Bearer <token>" 127.0.0.1:<port> ivpn.daemon.v1.Daemon/GetVPNState
+//
+// The ivpn.proto is generated from the types of the daemon protocol (daemon/protocol/types),
+// so it must be regenerated on every change of the protocol types ('go generate'; requires 'protoc' with the Go plugins).
+package grpcapi
+
+//go:generate go run ./gen
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative ivpn.proto
@@ -0,0 +1,1107 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// The program generates the protobuf definitions of the daemon gRPC API (ivpn.proto)
+// from the request/response types of the daemon protocol (daemon/protocol/types).
+//
+// The messages mirror the JSON representation of the protocol types: the field names are the JSON keys,
+// so the JSON mapping of the messages (e.g. 'grpcurl' output) is the same as the daemon protocol.
+// The numbers of the existing fields are preserved: the file is regenerated using the numbers
+// from the previous version of ivpn.proto (removed fields are marked as 'reserved').
+//
+// Usage (from the 'grpcapi' directory; see doc.go):
+//
+//	go run ./gen [-out ivpn.proto]
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+)
+
+const (
+	modulePath   = "github.com/ivpn/desktop-app/daemon"
+	protoPackage = "ivpn.daemon.v1"
+	goPackage    = modulePath + "/protocol/grpcapi"
+	typeValue    = "google.protobuf.Value"
+
+	// name of the message which contains all the possible responses (and events) of the daemon
+	responseMessage = "Response"
+	// name of the server-streaming method which sends the daemon events
+	eventsMethod = "Events"
+)
+
+// EventsRequest - request to stream the daemon events
+// (the same notifications which are sent to the clients of the daemon protocol).
+type EventsRequest struct {
+	// List of the event types to receive (e.g. 'VpnStateResp', 'ConnectionStatsResp'); empty - all the events
+	Events []string
+	// Stream the daemon log messages (LogMessageResp; only when the logging is enabled; not available for read-only clients)
+	IsLogsRequested bool
+}
+
+// package path of the generator ("main"; it differs when the package is built for tests)
+var genPkgPath = reflect.TypeOf(EventsRequest{}).PkgPath()
+
+// Request types of the daemon protocol (the type name is the name of the command).
+// IMPORTANT! The list must be updated when new request type is added (the generator fails when a type is missing).
+var requests = []interface{}{
+	types.EmptyReq{},
+	types.Hello{},
+	types.SetNotificationsFilter{},
+	types.ParanoidModeSetPasswordReq{},
+	types.GetVPNState{},
+	types.GetServers{},
+	types.PingServers{},
+	types.APIRequest{},
+	types.WiFiAvailableNetworks{},
+	types.KillSwitchGetStatus{},
+	types.KillSwitchSetEnabled{},
+	types.KillSwitchSetAllowLANMulticast{},
+	types.KillSwitchSetAllowLAN{},
+	types.KillSwitchSetAllowLANServices{},
+	types.KillSwitchSetAllowedLANHosts{},
+	types.KillSwitchSetLANInboundPorts{},
+	types.KillSwitchSetUserExceptions{},
+	types.KillSwitchSetIsPersistent{},
+	types.KillSwitchSetAllowApiServers{},
+	types.SetPreference{},
+	types.SetObfsProxy{},
+	types.SetV2RayProxy{},
+	types.SetApiProxy{},
+	types.SetApiHostOverride{},
+	types.SetOpenVpnExtraParameters{},
+	types.SetOpenVpnCryptoPolicy{},
+	types.RestApiGet{},
+	types.SetRestApi{},
+	types.GrpcApiGet{},
+	types.SetGrpcApi{},
+	types.SetLogRotation{},
+	types.LogLevelsGet{},
+	types.SetLogLevel{},
+	types.ClientTokensGet{},
+	types.ClientTokenAdd{},
+	types.ClientTokenRemove{},
+	types.SettingsExport{},
+	types.SettingsImport{},
+	types.SetShadowsocksProxy{},
+	types.SetAmneziaWG{},
+	types.SetUserPreferences{},
+	types.SplitTunnelGetStatus{},
+	types.SplitTunnelSetConfig{},
+	types.SplitTunnelSetDestinations{},
+	types.SplitTunnelSetContainers{},
+	types.SplitTunnelSetUsers{},
+	types.SplitTunnelAddApp{},
+	types.SplitTunnelRemoveApp{},
+	types.SplitTunnelAddedPidInfo{},
+	types.PortForwardingGetStatus{},
+	types.PortForwardingRequest{},
+	types.PortForwardingRelease{},
+	types.CaptivePortalCheck{},
+	types.CaptivePortalAllowLogin{},
+	types.CaptivePortalReLock{},
+	types.SetAlternateDns{},
+	types.GetDnsPredefinedConfigs{},
+	types.SessionNew{},
+	types.SessionDelete{},
+	types.DevicesList{},
+	types.DeviceLogout{},
+	types.AccountsGet{},
+	types.AccountSwitch{},
+	types.AccountRemove{},
+	types.AccountStatus{},
+	types.WireGuardGenerateNewKeys{},
+	types.WireGuardSetKeysRotationInterval{},
+	types.GetAppIcon{},
+	types.GetInstalledApps{},
+	types.WiFiCurrentNetwork{},
+	types.WiFiSettings{},
+	types.ScheduleSettings{},
+	types.Disconnect{},
+	types.ConnectSettingsGet{},
+	types.ConnectSettings{},
+	types.Connect{},
+	types.ConnectionHistoryGet{},
+	types.AuditLogGet{},
+	types.LogStreamStart{},
+	types.LogStreamStop{},
+	types.ShellCommandsGet{},
+	types.CrashReportsGet{},
+	types.CrashReportsClear{},
+	types.GetSubsystemStatus{},
+	types.OperationStart{},
+	types.SpeedTestStart{},
+	types.OperationCancel{},
+	types.OperationsGet{},
+	types.HostsHealthGet{},
+	types.ConnectionHistoryClear{},
+	types.ConnectionHistoryConnect{},
+	types.ConnectionStatsHistoryGet{},
+	types.ConnectionStatsHistoryClear{},
+	types.ThroughputHistoryGet{},
+	types.ConnectionQualityHistoryGet{},
+	types.ConnectionProfilesGet{},
+	types.ConnectionProfileSave{},
+	types.ConnectionProfileRemove{},
+	types.ConnectionProfileConnect{},
+}
+
+// Requests which have no parameters (there is no dedicated type for them in the daemon protocol)
+var requestsWithoutParameters = []string{
+	"GenerateDiagnostics",
+	"PauseConnection",
+	"ResumeConnection",
+}
+
+// Response (and event) types of the daemon protocol.
+// IMPORTANT! The list must be updated when new response type is added (the generator fails when a type is missing).
+var responses = []interface{}{
+	types.ErrorResp{},
+	types.ErrorRespDelayed{},
+	types.EmptyResp{},
+	types.ServiceExitingResp{},
+	types.SettingsResp{},
+	types.HelloResp{},
+	types.SessionNewResp{},
+	types.AccountsResp{},
+	types.DevicesResp{},
+	types.AccountStatusResp{},
+	types.KillSwitchStatusResp{},
+	types.KillSwitchGetIsPestistentResp{},
+	types.DiagnosticsGeneratedResp{},
+	types.SetAlternateDNSResp{},
+	types.DnsPredefinedConfigsResp{},
+	types.ConnectedResp{},
+	types.DisconnectedResp{},
+	types.ConnectionHistoryResp{},
+	types.ConnectionStatsHistoryResp{},
+	types.ThroughputHistoryResp{},
+	types.AuditLogResp{},
+	types.SubsystemStatusResp{},
+	types.PortForwardingStatusResp{},
+	types.DataUsageAlertResp{},
+	types.ConnectionQualityResp{},
+	types.ConnectionQualityHistoryResp{},
+	types.CaptivePortalStatusResp{},
+	types.ReloginRequiredResp{},
+	types.ClockSkewResp{},
+	types.ShellCommandsResp{},
+	types.CrashReportsResp{},
+	types.CrashRecoveredResp{},
+	types.OperationStatusResp{},
+	types.OperationsListResp{},
+	types.ConnectionProfilesResp{},
+	types.RestApiResp{},
+	types.GrpcApiResp{},
+	types.LogLevelsResp{},
+	types.ClientTokensResp{},
+	types.SettingsExportResp{},
+	types.SettingsImportResp{},
+	types.HostsHealthResp{},
+	types.VpnStateResp{},
+	types.ConnectionStatsResp{},
+	types.LogMessageResp{},
+	types.ServerListResp{},
+	types.PingServersResp{},
+	types.WiFiAvailableNetworksResp{},
+	types.WiFiCurrentNetworkResp{},
+	types.APIResponse{},
+	types.InstalledAppsResp{},
+	types.AppIconResp{},
+	types.SplitTunnelStatus{},
+	types.SplitTunnelAddAppCmdResp{},
+	types.ConnectSettings{},
+}
+
+func main() {
+	out := flag.String("out", "ivpn.proto", "output file")
+	flag.Parse()
+
+	if err := run(*out); err != nil {
+		fmt.Fprintln(os.Stderr, "ERROR:", err)
+		os.Exit(1)
+	}
+}
+
+func run(outFile string) error {
+	root, err := moduleRoot()
+	if err != nil {
+		return err
+	}
+
+	prev, err := os.ReadFile(outFile)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	data, err := generate(root, prev)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(outFile, data, 0644)
+}
+
+// moduleRoot returns the root directory of the daemon module (the nearest parent directory which contains go.mod)
+func moduleRoot() (string, error) {
+	dir, err := os.Getwd()
+	if err != nil {
+		return "", err
+	}
+	for {
+		if _, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil {
+			return dir, nil
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", fmt.Errorf("go.mod not found")
+		}
+		dir = parent
+	}
+}
+
+// generate returns the content of ivpn.proto
+// ('prev' - content of the previous version of the file; the numbers of the existing fields are taken from it)
+func generate(root string, prev []byte) ([]byte, error) {
+	supported, err := supportedRequests(root)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkTypesListed(root); err != nil {
+		return nil, err
+	}
+
+	g := &generator{
+		docs:     &docs{root: root, types: map[string]string{}, fields: map[string]string{}, parsed: map[string]bool{}},
+		byType:   map[reflect.Type]string{},
+		messages: map[string]*message{},
+		prev:     parseProto(prev),
+	}
+
+	requestTypes := map[string]reflect.Type{}
+	for _, r := range requests {
+		requestTypes[types.GetTypeName(r)] = reflect.TypeOf(r)
+	}
+	noParams := map[string]bool{}
+	for _, r := range requestsWithoutParameters {
+		noParams[r] = true
+	}
+
+	// service methods
+	var methods []string
+	for _, name := range supported {
+		t, ok := requestTypes[name]
+		switch {
+		case ok:
+			if _, err := g.messageFor(t, ""); err != nil {
+				return nil, err
+			}
+		case noParams[name]:
+			if _, err := g.messageFor(reflect.TypeOf(types.RequestBase{}), name); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("request '%s' is not defined in the 'requests' list of the generator", name)
+		}
+		methods = append(methods, name)
+	}
+	if _, err := g.messageFor(reflect.TypeOf(EventsRequest{}), ""); err != nil {
+		return nil, err
+	}
+
+	// responses
+	respMsg := g.newMessage(responseMessage, reflect.TypeOf(nil),
+		"Response of the daemon (one of the response types of the daemon protocol; the field name is the response type name).\n"+
+			"Note: the errors of requests processing are returned as 'ErrorResp'.")
+	for _, r := range responses {
+		name, err := g.messageFor(reflect.TypeOf(r), "")
+		if err != nil {
+			return nil, err
+		}
+		respMsg.fields = append(respMsg.fields, &field{name: name, typ: "." + protoPackage + "." + name})
+	}
+
+	for _, m := range g.messages {
+		g.assignNumbers(m)
+	}
+
+	return g.render(methods), nil
+}
+
+// supportedRequests returns the list of the requests supported by the daemon (the 'supportedRequests' variable of the protocol package)
+func supportedRequests(root string) ([]string, error) {
+	file := filepath.Join(root, "protocol", "protocol_capabilities.go")
+	f, err := parser.ParseFile(token.NewFileSet(), file, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var ret []string
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || spec.Names[0].Name != "supportedRequests" || len(spec.Values) != 1 {
+			return true
+		}
+		if lit, ok := spec.Values[0].(*ast.CompositeLit); ok {
+			for _, e := range lit.Elts {
+				if bl, ok := e.(*ast.BasicLit); ok && bl.Kind == token.STRING {
+					if s, err := strconv.Unquote(bl.Value); err == nil {
+						ret = append(ret, s)
+					}
+				}
+			}
+		}
+		return false
+	})
+
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("'supportedRequests' not found in %s", file)
+	}
+	return ret, nil
+}
+
+// checkTypesListed ensures that all the types of the daemon protocol (structures which embed 'CommandBase' or 'RequestBase')
+// are defined in the 'requests' or 'responses' lists of the generator
+func checkTypesListed(root string) error {
+	listed := map[string]bool{}
+	for _, r := range append(append([]interface{}{}, requests...), responses...) {
+		listed[types.GetTypeName(r)] = true
+	}
+
+	fset := token.NewFileSet()
+	files, err := filepath.Glob(filepath.Join(root, "protocol", "types", "*.go"))
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return err
+		}
+		for _, d := range f.Decls {
+			gd, ok := d.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, s := range gd.Specs {
+				ts := s.(*ast.TypeSpec)
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok || ts.Name.Name == "RequestBase" {
+					continue
+				}
+				for _, fld := range st.Fields.List {
+					if id, ok := fld.Type.(*ast.Ident); ok && len(fld.Names) == 0 && (id.Name == "CommandBase" || id.Name == "RequestBase") {
+						if !listed[ts.Name.Name] {
+							missing = append(missing, ts.Name.Name)
+						}
+						break
+					}
+				}
+			}
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf("types are not defined in the 'requests' or 'responses' lists of the generator: %s", strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+type message struct {
+	name     string
+	doc      string
+	fields   []*field
+	reserved []int
+}
+
+type field struct {
+	name     string
+	jsonName string // empty - the default JSON name
+	typ      string // including the label (e.g. 'repeated string')
+	doc      string
+	number   int
+}
+
+type generator struct {
+	docs     *docs
+	byType   map[reflect.Type]string
+	messages map[string]*message
+	order    []string
+	prev     map[string]*prevMessage
+	isValue  bool // google.protobuf.Value is in use
+}
+
+func (g *generator) newMessage(name string, t reflect.Type, doc string) *message {
+	m := &message{name: name, doc: doc}
+	g.messages[name] = m
+	g.order = append(g.order, name)
+	if t != nil {
+		g.byType[t] = name
+	}
+	return m
+}
+
+// messageFor returns the name of the message for the structure type (the message is created if not exists yet)
+// 'name' - the message name (empty - the default name for the type)
+func (g *generator) messageFor(t reflect.Type, name string) (string, error) {
+	if len(name) == 0 {
+		if n, ok := g.byType[t]; ok {
+			return n, nil
+		}
+		name = messageName(t)
+	}
+	if !isIdentifier(name) {
+		return "", fmt.Errorf("unable to define message name for the type '%s'", t)
+	}
+	if _, exists := g.messages[name]; exists {
+		return "", fmt.Errorf("message name conflict '%s' (type '%s')", name, t)
+	}
+
+	doc, registeredType := g.docs.typeDoc(t), t
+	if t == reflect.TypeOf(types.RequestBase{}) {
+		// request without parameters (the type is shared by few messages)
+		doc, registeredType = fmt.Sprintf("%s request (has no parameters)", name), nil
+	}
+	m := g.newMessage(name, registeredType, doc)
+
+	fields, err := jsonFields(t)
+	if err != nil {
+		return "", fmt.Errorf("%s: %w", name, err)
+	}
+	for _, f := range fields {
+		typ, err := g.fieldType(f.t, name+f.goName, true, f.isString)
+		if err != nil {
+			return "", fmt.Errorf("%s.%s: %w", name, f.goName, err)
+		}
+
+		fld := &field{name: f.name, typ: typ, doc: g.docs.fieldDoc(f.owner, f.goName)}
+		if !isIdentifier(fld.name) {
+			fld.name = strings.Map(func(r rune) rune {
+				if r == '_' || r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+					return r
+				}
+				return '_'
+			}, fld.name)
+			if !isIdentifier(fld.name) {
+				fld.name = "_" + fld.name
+			}
+		}
+		if defaultJSONName(fld.name) != f.name {
+			fld.jsonName = f.name
+		}
+		// the type name can not be used as a field name in the same scope
+		if strings.HasSuffix(typ, " "+fld.name) || typ == fld.name {
+			fld.typ = strings.TrimSuffix(typ, fld.name) + "." + protoPackage + "." + fld.name
+		}
+		m.fields = append(m.fields, fld)
+	}
+	return name, nil
+}
+
+var (
+	typeTime          = reflect.TypeOf(time.Time{})
+	typeJSONMarshaler = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	typeTextMarshaler = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+func implements(t, iface reflect.Type) bool {
+	return t.Implements(iface) || t.Kind() != reflect.Ptr && reflect.PtrTo(t).Implements(iface)
+}
+
+// fieldType returns the protobuf type of the field (according to the JSON encoding of the Go type).
+// 'isTop' - the type of the field itself (not an element of a list or a map): labels are allowed.
+// 'isString' - the field has the ',string' JSON option.
+func (g *generator) fieldType(t reflect.Type, nameForAnonymous string, isTop bool, isString bool) (string, error) {
+	switch {
+	case t == typeTime:
+		return "string", nil
+	case implements(t, typeJSONMarshaler):
+		g.isValue = true
+		return typeValue, nil
+	case implements(t, typeTextMarshaler):
+		return "string", nil
+	}
+
+	if isString {
+		switch t.Kind() {
+		case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+			reflect.Float32, reflect.Float64, reflect.String:
+			return "string", nil
+		}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "bool", nil
+	case reflect.Int, reflect.Int64:
+		return "int64", nil
+	case reflect.Int8, reflect.Int16, reflect.Int32:
+		return "int32", nil
+	case reflect.Uint, reflect.Uint64, reflect.Uintptr:
+		return "uint64", nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return "uint32", nil
+	case reflect.Float32:
+		return "float", nil
+	case reflect.Float64:
+		return "double", nil
+	case reflect.String:
+		return "string", nil
+	case reflect.Interface:
+		g.isValue = true
+		return typeValue, nil
+
+	case reflect.Ptr:
+		elem := t.Elem()
+		typ, err := g.fieldType(elem, nameForAnonymous, isTop, isString)
+		if err != nil {
+			return "", err
+		}
+		// pointer to a scalar value: the presence of the value is important
+		if isTop && isScalar(typ) {
+			return "optional " + typ, nil
+		}
+		return typ, nil
+
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 && !implements(t.Elem(), typeJSONMarshaler) && !implements(t.Elem(), typeTextMarshaler) {
+			return "bytes", nil
+		}
+		if !isTop {
+			g.isValue = true
+			return typeValue, nil
+		}
+		typ, err := g.fieldType(t.Elem(), nameForAnonymous, false, false)
+		if err != nil {
+			return "", err
+		}
+		return "repeated " + typ, nil
+
+	case reflect.Map:
+		var keyType string
+		switch kt := t.Key(); {
+		case kt.Kind() == reflect.String:
+			keyType = "string"
+		case implements(kt, typeTextMarshaler):
+			keyType = "string"
+		default:
+			var err error
+			if keyType, err = g.fieldType(kt, nameForAnonymous, false, false); err != nil || !isScalar(keyType) || keyType == "bool" || keyType == "bytes" || keyType == "float" || keyType == "double" {
+				return "", fmt.Errorf("unsupported map key type '%s'", kt)
+			}
+		}
+		if !isTop {
+			g.isValue = true
+			return typeValue, nil
+		}
+		typ, err := g.fieldType(t.Elem(), nameForAnonymous, false, false)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("map<%s, %s>", keyType, typ), nil
+
+	case reflect.Struct:
+		if len(t.Name()) == 0 {
+			// anonymous structure
+			if n, ok := g.byType[t]; ok {
+				return n, nil
+			}
+			return g.messageFor(t, nameForAnonymous)
+		}
+		return g.messageFor(t, "")
+	}
+
+	return "", fmt.Errorf("unsupported type '%s'", t)
+}
+
+func isScalar(typ string) bool {
+	switch typ {
+	case "bool", "int32", "int64", "uint32", "uint64", "float", "double", "string", "bytes":
+		return true
+	}
+	return false
+}
+
+// messageName returns the message name for the named structure type.
+// The types of the daemon protocol have the same names; other types have the package name prefix
+// (e.g. 'PreferencesGrpcApiParams'; the 'types' packages are prefixed by the parent package name: 'ApiTypesServerInfo').
+func messageName(t reflect.Type) string {
+	pkg := t.PkgPath()
+	if pkg == modulePath+"/protocol/types" || pkg == genPkgPath {
+		return t.Name()
+	}
+	prefix := path.Base(pkg)
+	if prefix == "types" {
+		prefix = path.Base(path.Dir(pkg)) + "Types"
+	}
+	return capitalize(prefix) + t.Name()
+}
+
+func capitalize(s string) string {
+	if len(s) == 0 {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+var identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+func isIdentifier(s string) bool {
+	return identifierRegexp.MatchString(s)
+}
+
+// defaultJSONName returns the default JSON name of the protobuf field (lowerCamelCase of the field name)
+func defaultJSONName(name string) string {
+	var b strings.Builder
+	isUpper := false
+	for _, r := range name {
+		if r == '_' {
+			isUpper = true
+			continue
+		}
+		if isUpper && r >= 'a' && r <= 'z' {
+			r = unicode.ToUpper(r)
+		}
+		isUpper = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// jsonField - field of the JSON representation of a structure
+type jsonField struct {
+	name     string       // JSON key
+	goName   string       // name of the Go field
+	owner    reflect.Type // structure which declares the field
+	t        reflect.Type
+	isString bool // ',string' option
+	isTagged bool
+	depth    int
+}
+
+// jsonFields returns the fields of the JSON representation of the structure (according to the rules of 'encoding/json')
+// The fields of 'CommandBase' (command name and index) are skipped: they are the part of the protocol, not of the message.
+func jsonFields(t reflect.Type) ([]jsonField, error) {
+	if t == nil {
+		return nil, nil
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("structure expected ('%s')", t)
+	}
+
+	var all []jsonField
+	var walk func(t reflect.Type, depth int, visited map[reflect.Type]bool)
+	walk = func(t reflect.Type, depth int, visited map[reflect.Type]bool) {
+		if visited[t] || t == reflect.TypeOf(types.CommandBase{}) {
+			return
+		}
+		visited[t] = true
+		defer delete(visited, t)
+
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			ft := f.Type
+			if f.Anonymous && ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if f.Anonymous {
+				if !f.IsExported() && ft.Kind() != reflect.Struct {
+					continue
+				}
+			} else if !f.IsExported() {
+				continue
+			}
+
+			tag := f.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, opts, _ := strings.Cut(tag, ",")
+			if f.Anonymous && len(name) == 0 && ft.Kind() == reflect.Struct {
+				walk(ft, depth+1, visited)
+				continue
+			}
+
+			isTagged := len(name) > 0
+			if !isTagged {
+				name = f.Name
+			}
+			all = append(all, jsonField{
+				name:     name,
+				goName:   f.Name,
+				owner:    t,
+				t:        f.Type,
+				isString: hasOption(opts, "string"),
+				isTagged: isTagged,
+				depth:    depth,
+			})
+		}
+	}
+	walk(t, 0, map[reflect.Type]bool{})
+
+	// dominant fields (the field with the shortest depth; the tagged one when there are few of them)
+	byName := map[string][]jsonField{}
+	for _, f := range all {
+		byName[f.name] = append(byName[f.name], f)
+	}
+	var ret []jsonField
+	for _, f := range all {
+		candidates := byName[f.name]
+		if len(candidates) == 0 {
+			continue // already processed
+		}
+		delete(byName, f.name)
+
+		sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].depth < candidates[j].depth })
+		var dominant []jsonField
+		for _, c := range candidates {
+			if c.depth == candidates[0].depth {
+				dominant = append(dominant, c)
+			}
+		}
+		if len(dominant) > 1 {
+			var tagged []jsonField
+			for _, c := range dominant {
+				if c.isTagged {
+					tagged = append(tagged, c)
+				}
+			}
+			dominant = tagged
+		}
+		if len(dominant) == 1 {
+			ret = append(ret, dominant[0])
+		}
+	}
+	return ret, nil
+}
+
+func hasOption(opts string, opt string) bool {
+	for _, o := range strings.Split(opts, ",") {
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// docs - documentation comments of the Go types (from the source code of the daemon module)
+type docs struct {
+	root   string
+	parsed map[string]bool   // package path -> is parsed
+	types  map[string]string // "<package path>.<type>" -> comment
+	fields map[string]string // "<package path>.<type>.<field>" -> comment
+}
+
+func (d *docs) typeDoc(t reflect.Type) string {
+	if t == nil {
+		return ""
+	}
+	d.parse(t.PkgPath())
+	return d.types[t.PkgPath()+"."+t.Name()]
+}
+
+func (d *docs) fieldDoc(owner reflect.Type, fieldName string) string {
+	if owner == nil || len(owner.Name()) == 0 {
+		return ""
+	}
+	d.parse(owner.PkgPath())
+	return d.fields[owner.PkgPath()+"."+owner.Name()+"."+fieldName]
+}
+
+func (d *docs) parse(pkgPath string) {
+	if d.parsed[pkgPath] {
+		return
+	}
+	d.parsed[pkgPath] = true
+
+	var dir string
+	switch {
+	case pkgPath == genPkgPath:
+		dir = filepath.Join(d.root, "protocol", "grpcapi", "gen")
+	case strings.HasPrefix(pkgPath, modulePath+"/"):
+		dir = filepath.Join(d.root, filepath.FromSlash(strings.TrimPrefix(pkgPath, modulePath+"/")))
+	default:
+		return
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.go"))
+	sort.Strings(files)
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, parser.ParseComments)
+		if err != nil {
+			continue
+		}
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.TYPE {
+				continue
+			}
+			for _, s := range gd.Specs {
+				ts := s.(*ast.TypeSpec)
+				key := pkgPath + "." + ts.Name.Name
+				if _, exists := d.types[key]; exists {
+					continue // the type is defined for few platforms: the first definition is in use
+				}
+				doc := ts.Doc
+				if doc == nil && len(gd.Specs) == 1 {
+					doc = gd.Doc
+				}
+				d.types[key] = commentText(doc)
+
+				st, ok := ts.Type.(*ast.StructType)
+				if !ok {
+					continue
+				}
+				for _, fld := range st.Fields.List {
+					text := commentText(fld.Doc)
+					if len(text) == 0 {
+						text = commentText(fld.Comment)
+					}
+					for _, n := range fld.Names {
+						d.fields[key+"."+n.Name] = text
+					}
+				}
+			}
+		}
+	}
+}
+
+func commentText(cg *ast.CommentGroup) string {
+	if cg == nil {
+		return ""
+	}
+	var lines []string
+	for _, l := range strings.Split(cg.Text(), "\n") {
+		lines = append(lines, strings.TrimRightFunc(l, unicode.IsSpace))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+// prevMessage - message definition from the previous version of ivpn.proto
+type prevMessage struct {
+	fields   map[string]prevField // field name -> field
+	reserved []int
+}
+
+type prevField struct {
+	typ    string
+	number int
+}
+
+var (
+	protoMessageRegexp  = regexp.MustCompile(`^message (\w+) \{`)
+	protoFieldRegexp    = regexp.MustCompile(`^\s*((?:repeated |optional )?(?:map<[^>]+>|[\w.]+)) (\w+) = (\d+)`)
+	protoReservedRegexp = regexp.MustCompile(`^\s*reserved ([\d, ]+);`)
+)
+
+// parseProto parses the messages of the previous version of ivpn.proto (only the format produced by this generator is supported)
+func parseProto(data []byte) map[string]*prevMessage {
+	ret := map[string]*prevMessage{}
+	var cur *prevMessage
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if m := protoMessageRegexp.FindStringSubmatch(line); m != nil {
+			cur = &prevMessage{fields: map[string]prevField{}}
+			ret[m[1]] = cur
+			continue
+		}
+		if line == "}" {
+			cur = nil
+			continue
+		}
+		if cur == nil {
+			continue
+		}
+		if m := protoFieldRegexp.FindStringSubmatch(line); m != nil {
+			n, _ := strconv.Atoi(m[3])
+			cur.fields[m[2]] = prevField{typ: m[1], number: n}
+		} else if m := protoReservedRegexp.FindStringSubmatch(line); m != nil {
+			for _, s := range strings.Split(m[1], ",") {
+				if n, err := strconv.Atoi(strings.TrimSpace(s)); err == nil {
+					cur.reserved = append(cur.reserved, n)
+				}
+			}
+		}
+	}
+	return ret
+}
+
+// assignNumbers assigns the numbers to the message fields: the numbers of the existing fields are preserved;
+// the numbers of the removed fields (or fields with changed type) are reserved; new fields get the next free numbers
+func (g *generator) assignNumbers(m *message) {
+	prev := g.prev[m.name]
+	if prev == nil {
+		prev = &prevMessage{fields: map[string]prevField{}}
+	}
+
+	used := map[int]bool{}
+	maxNumber := 0
+	for _, n := range prev.reserved {
+		used[n] = true
+		if n > maxNumber {
+			maxNumber = n
+		}
+	}
+	for _, f := range prev.fields {
+		used[f.number] = true
+		if f.number > maxNumber {
+			maxNumber = f.number
+		}
+	}
+
+	kept := map[int]bool{}
+	for _, f := range m.fields {
+		if pf, ok := prev.fields[f.name]; ok && pf.typ == f.typ {
+			f.number = pf.number
+			kept[pf.number] = true
+		}
+	}
+	for _, f := range m.fields {
+		if f.number == 0 {
+			maxNumber++
+			f.number = maxNumber
+		}
+	}
+
+	m.reserved = nil
+	for n := range used {
+		if !kept[n] {
+			m.reserved = append(m.reserved, n)
+		}
+	}
+	sort.Ints(m.reserved)
+}
+
+// ---------------------------------------------------------------------------------------------------------------------
+
+func (g *generator) render(methods []string) []byte {
+	var b bytes.Buffer
+	w := func(format string, args ...interface{}) { fmt.Fprintf(&b, format, args...) }
+
+	w("// Code generated by \"go run ./gen\"; DO NOT EDIT.\n")
+	w("//\n")
+	w("// The gRPC API of the Daemon for IVPN Client Desktop.\n")
+	w("// The messages mirror the requests and responses of the daemon protocol (daemon/protocol/types):\n")
+	w("// the field names are the same as the JSON keys of the daemon protocol.\n")
+	w("\n")
+	w("syntax = \"proto3\";\n\n")
+	w("package %s;\n\n", protoPackage)
+	if g.isValue {
+		w("import \"google/protobuf/struct.proto\";\n\n")
+	}
+	w("option go_package = %q;\n\n", goPackage)
+
+	w("// Daemon - the daemon requests (the method name is the request name of the daemon protocol).\n")
+	w("// The access token must be passed in the 'authorization' metadata: 'Bearer <token>'.\n")
+	w("service Daemon {\n")
+	for _, name := range methods {
+		// the request message has the same name as the method: the fully-qualified name is required
+		w("  rpc %s(.%s.%s) returns (%s);\n", name, protoPackage, name, responseMessage)
+	}
+	w("\n")
+	writeComment(&b, "  ", "Events - stream of the daemon events (the same notifications which are sent to the clients of the daemon protocol).\n"+
+		"Additional events: ConnectionStatsResp (periodically, when connected); LogMessageResp (when requested).")
+	w("  rpc %s(EventsRequest) returns (stream %s);\n", eventsMethod, responseMessage)
+	w("}\n")
+
+	for _, name := range g.order {
+		m := g.messages[name]
+		w("\n")
+		writeComment(&b, "", m.doc)
+		w("message %s {\n", m.name)
+		if len(m.reserved) > 0 {
+			var nums []string
+			for _, n := range m.reserved {
+				nums = append(nums, strconv.Itoa(n))
+			}
+			w("  reserved %s;\n", strings.Join(nums, ", "))
+		}
+		indent := "  "
+		if m.name == responseMessage {
+			w("  oneof response {\n")
+			indent = "    "
+		}
+		for _, f := range m.fields {
+			writeComment(&b, indent, f.doc)
+			options := ""
+			if len(f.jsonName) > 0 {
+				options = fmt.Sprintf(" [json_name = %q]", f.jsonName)
+			}
+			w("%s%s %s = %d%s;\n", indent, f.typ, f.name, f.number, options)
+		}
+		if m.name == responseMessage {
+			w("  }\n")
+		}
+		w("}\n")
+	}
+	return b.Bytes()
+}
+
+func writeComment(b *bytes.Buffer, indent string, text string) {
+	if len(text) == 0 {
+		return
+	}
+	for _, l := range strings.Split(text, "\n") {
+		if len(l) == 0 {
+			fmt.Fprintf(b, "%s//\n", indent)
+		} else {
+			fmt.Fprintf(b, "%s// %s\n", indent, l)
+		}
+	}
+}
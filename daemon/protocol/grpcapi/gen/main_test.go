@@ -0,0 +1,81 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestProtoIsUpToDate checks that ivpn.proto corresponds to the current types of the daemon protocol
+// (run 'go generate' in the 'grpcapi' directory when it fails)
+func TestProtoIsUpToDate(t *testing.T) {
+	root, err := moduleRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	protoFile := filepath.Join(root, "protocol", "grpcapi", "ivpn.proto")
+	prev, err := os.ReadFile(protoFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := generate(root, prev)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != string(prev) {
+		t.Errorf("%s is not up to date: run 'go generate' in the 'grpcapi' directory", protoFile)
+	}
+}
+
+func TestAssignNumbers(t *testing.T) {
+	prev := parseProto([]byte(`
+message Test {
+  reserved 2;
+  string A = 1;
+  int64 B = 3;
+  repeated string C = 4;
+}
+`))
+	g := &generator{prev: prev}
+	m := &message{name: "Test", fields: []*field{
+		{name: "C", typ: "repeated string"}, // not changed
+		{name: "B", typ: "string"},          // type changed
+		{name: "D", typ: "bool"},            // new
+	}}
+	g.assignNumbers(m)
+
+	numbers := map[string]int{}
+	for _, f := range m.fields {
+		numbers[f.name] = f.number
+	}
+	if expected := map[string]int{"C": 4, "B": 5, "D": 6}; !reflect.DeepEqual(numbers, expected) {
+		t.Errorf("unexpected field numbers: %v (expected %v)", numbers, expected)
+	}
+	if expected := []int{1, 2, 3}; !reflect.DeepEqual(m.reserved, expected) {
+		t.Errorf("unexpected reserved numbers: %v (expected %v)", m.reserved, expected)
+	}
+}
@@ -0,0 +1,23854 @@
+// Code generated by "go run ./gen"; DO NOT EDIT.
+//
+// The gRPC API of the Daemon for IVPN Client Desktop.
+// The messages mirror the requests and responses of the daemon protocol (daemon/protocol/types):
+// the field names are the same as the JSON keys of the daemon protocol.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        (unknown)
+// source: ivpn.proto
+
+package grpcapi
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type EmptyReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *EmptyReq) Reset() {
+	*x = EmptyReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyReq) ProtoMessage() {}
+
+func (x *EmptyReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyReq.ProtoReflect.Descriptor instead.
+func (*EmptyReq) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *EmptyReq) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// Hello is an initial request
+type Hello struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// connected client type
+	ClientType int64 `protobuf:"varint,2,opt,name=ClientType,proto3" json:"ClientType,omitempty"`
+	// connected client version
+	Version string `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	// client protocol version (types.ProtocolVersion); 0 - for clients which does not support versioning
+	ProtocolVersion int64  `protobuf:"varint,4,opt,name=ProtocolVersion,proto3" json:"ProtocolVersion,omitempty"`
+	Secret          uint64 `protobuf:"varint,5,opt,name=Secret,proto3" json:"Secret,omitempty"`
+	// client access token (preferences.ClientToken); when defined - the 'Secret' is not required
+	// and the access level of the client is defined by the token
+	AccessToken string `protobuf:"bytes,6,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	// when 'true' - send HelloResp to all connected clients
+	SendResponseToAllClients bool `protobuf:"varint,7,opt,name=SendResponseToAllClients,proto3" json:"SendResponseToAllClients,omitempty"`
+	// GetServersList == true - client requests to send back info about all servers
+	GetServersList bool `protobuf:"varint,8,opt,name=GetServersList,proto3" json:"GetServersList,omitempty"`
+	// GetStatus == true - client requests current status (Vpn connection, Firewal... etc.)
+	GetStatus bool `protobuf:"varint,9,opt,name=GetStatus,proto3" json:"GetStatus,omitempty"`
+	// GetSplitTunnelStatus == true - client requests configuration of SplitTunnelling
+	GetSplitTunnelStatus bool `protobuf:"varint,10,opt,name=GetSplitTunnelStatus,proto3" json:"GetSplitTunnelStatus,omitempty"`
+	// GetWiFiCurrentState == true - client requests info about current WiFi
+	GetWiFiCurrentState bool `protobuf:"varint,11,opt,name=GetWiFiCurrentState,proto3" json:"GetWiFiCurrentState,omitempty"`
+	// NotificationsFilter - the notification types which the client wants to receive (see SetNotificationsFilter)
+	NotificationsFilter []string `protobuf:"bytes,12,rep,name=NotificationsFilter,proto3" json:"NotificationsFilter,omitempty"`
+}
+
+func (x *Hello) Reset() {
+	*x = Hello{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Hello) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Hello) ProtoMessage() {}
+
+func (x *Hello) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Hello.ProtoReflect.Descriptor instead.
+func (*Hello) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Hello) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *Hello) GetClientType() int64 {
+	if x != nil {
+		return x.ClientType
+	}
+	return 0
+}
+
+func (x *Hello) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *Hello) GetProtocolVersion() int64 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *Hello) GetSecret() uint64 {
+	if x != nil {
+		return x.Secret
+	}
+	return 0
+}
+
+func (x *Hello) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *Hello) GetSendResponseToAllClients() bool {
+	if x != nil {
+		return x.SendResponseToAllClients
+	}
+	return false
+}
+
+func (x *Hello) GetGetServersList() bool {
+	if x != nil {
+		return x.GetServersList
+	}
+	return false
+}
+
+func (x *Hello) GetGetStatus() bool {
+	if x != nil {
+		return x.GetStatus
+	}
+	return false
+}
+
+func (x *Hello) GetGetSplitTunnelStatus() bool {
+	if x != nil {
+		return x.GetSplitTunnelStatus
+	}
+	return false
+}
+
+func (x *Hello) GetGetWiFiCurrentState() bool {
+	if x != nil {
+		return x.GetWiFiCurrentState
+	}
+	return false
+}
+
+func (x *Hello) GetNotificationsFilter() []string {
+	if x != nil {
+		return x.NotificationsFilter
+	}
+	return nil
+}
+
+// SetNotificationsFilter subscribes the client connection only to specific notification types.
+// 'Notifications' contains the names of the responses (e.g. "VpnStateResp", "KillSwitchStatusResp").
+// Empty list - all notifications are sent to the client (default).
+// Note: it does not affect the responses to the requests of the client.
+type SetNotificationsFilter struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string   `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Notifications  []string `protobuf:"bytes,2,rep,name=Notifications,proto3" json:"Notifications,omitempty"`
+}
+
+func (x *SetNotificationsFilter) Reset() {
+	*x = SetNotificationsFilter{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetNotificationsFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNotificationsFilter) ProtoMessage() {}
+
+func (x *SetNotificationsFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNotificationsFilter.ProtoReflect.Descriptor instead.
+func (*SetNotificationsFilter) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetNotificationsFilter) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetNotificationsFilter) GetNotifications() []string {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+type ParanoidModeSetPasswordReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	NewSecret      string `protobuf:"bytes,2,opt,name=NewSecret,proto3" json:"NewSecret,omitempty"`
+}
+
+func (x *ParanoidModeSetPasswordReq) Reset() {
+	*x = ParanoidModeSetPasswordReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParanoidModeSetPasswordReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParanoidModeSetPasswordReq) ProtoMessage() {}
+
+func (x *ParanoidModeSetPasswordReq) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParanoidModeSetPasswordReq.ProtoReflect.Descriptor instead.
+func (*ParanoidModeSetPasswordReq) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ParanoidModeSetPasswordReq) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ParanoidModeSetPasswordReq) GetNewSecret() string {
+	if x != nil {
+		return x.NewSecret
+	}
+	return ""
+}
+
+// GetVPNState request daemon to provive current VPN connection state
+type GetVPNState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *GetVPNState) Reset() {
+	*x = GetVPNState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVPNState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVPNState) ProtoMessage() {}
+
+func (x *GetVPNState) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVPNState.ProtoReflect.Descriptor instead.
+func (*GetVPNState) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetVPNState) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// GetServers request servers list
+type GetServers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// Force to update servers from the backend (locations, hosts and hosts load)
+	RequestServersUpdate bool `protobuf:"varint,2,opt,name=RequestServersUpdate,proto3" json:"RequestServersUpdate,omitempty"`
+}
+
+func (x *GetServers) Reset() {
+	*x = GetServers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetServers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServers) ProtoMessage() {}
+
+func (x *GetServers) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServers.ProtoReflect.Descriptor instead.
+func (*GetServers) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetServers) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *GetServers) GetRequestServersUpdate() bool {
+	if x != nil {
+		return x.RequestServersUpdate
+	}
+	return false
+}
+
+// PingServers request to ping servers
+//
+// Pinging operation separated on few phases:
+//  1. Fast ping:  ping one host for each nearest location (locations only for specified VPN type when VpnTypePrioritization==true)
+//     Operation ends after 'TimeOutMs'. Then daemon sends response PingServersResp with all data were collected.
+//  2. Full ping: Pinging all hosts for all locations. There is no time limit for this operation. It runs in background.
+//     2.1) Ping all hosts only for specified VPN type (when VpnTypePrioritization==true) or for all VPN types (when VpnTypePrioritization==false)
+//     2.1.1) Ping one host for all locations (for prioritized VPN protocol)
+//     2.1.2) Ping the rest hosts for all locations (for prioritized VPN protocol)
+//     2.2) (when VpnTypePrioritization==true) Ping all hosts for the rest protocols
+//
+// If PingAllHostsOnFirstPhase==true - daemon will ping all hosts for nearest locations on the phase (1)
+// If SkipSecondPhase==true - phase (2) will be skipped
+type PingServers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	TimeOutMs      int64  `protobuf:"varint,2,opt,name=TimeOutMs,proto3" json:"TimeOutMs,omitempty"`
+	// hosts for this VPN type will be pinged first (only if VpnTypePrioritization == true)
+	VpnTypePrioritized       int64 `protobuf:"varint,3,opt,name=VpnTypePrioritized,proto3" json:"VpnTypePrioritized,omitempty"`
+	VpnTypePrioritization    bool  `protobuf:"varint,4,opt,name=VpnTypePrioritization,proto3" json:"VpnTypePrioritization,omitempty"`
+	PingAllHostsOnFirstPhase bool  `protobuf:"varint,5,opt,name=PingAllHostsOnFirstPhase,proto3" json:"PingAllHostsOnFirstPhase,omitempty"`
+	SkipSecondPhase          bool  `protobuf:"varint,6,opt,name=SkipSecondPhase,proto3" json:"SkipSecondPhase,omitempty"`
+}
+
+func (x *PingServers) Reset() {
+	*x = PingServers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingServers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingServers) ProtoMessage() {}
+
+func (x *PingServers) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingServers.ProtoReflect.Descriptor instead.
+func (*PingServers) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PingServers) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *PingServers) GetTimeOutMs() int64 {
+	if x != nil {
+		return x.TimeOutMs
+	}
+	return 0
+}
+
+func (x *PingServers) GetVpnTypePrioritized() int64 {
+	if x != nil {
+		return x.VpnTypePrioritized
+	}
+	return 0
+}
+
+func (x *PingServers) GetVpnTypePrioritization() bool {
+	if x != nil {
+		return x.VpnTypePrioritization
+	}
+	return false
+}
+
+func (x *PingServers) GetPingAllHostsOnFirstPhase() bool {
+	if x != nil {
+		return x.PingAllHostsOnFirstPhase
+	}
+	return false
+}
+
+func (x *PingServers) GetSkipSecondPhase() bool {
+	if x != nil {
+		return x.SkipSecondPhase
+	}
+	return false
+}
+
+// APIRequest do custom request to API
+type APIRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret     string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	APIPath            string `protobuf:"bytes,2,opt,name=APIPath,proto3" json:"APIPath,omitempty"`
+	IPProtocolRequired int64  `protobuf:"varint,3,opt,name=IPProtocolRequired,proto3" json:"IPProtocolRequired,omitempty"`
+}
+
+func (x *APIRequest) Reset() {
+	*x = APIRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIRequest) ProtoMessage() {}
+
+func (x *APIRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIRequest.ProtoReflect.Descriptor instead.
+func (*APIRequest) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *APIRequest) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *APIRequest) GetAPIPath() string {
+	if x != nil {
+		return x.APIPath
+	}
+	return ""
+}
+
+func (x *APIRequest) GetIPProtocolRequired() int64 {
+	if x != nil {
+		return x.IPProtocolRequired
+	}
+	return 0
+}
+
+// WiFiAvailableNetworks - get list of available WIFI networks
+type WiFiAvailableNetworks struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *WiFiAvailableNetworks) Reset() {
+	*x = WiFiAvailableNetworks{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WiFiAvailableNetworks) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WiFiAvailableNetworks) ProtoMessage() {}
+
+func (x *WiFiAvailableNetworks) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WiFiAvailableNetworks.ProtoReflect.Descriptor instead.
+func (*WiFiAvailableNetworks) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WiFiAvailableNetworks) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// KillSwitchGetStatus get full killswitch status
+type KillSwitchGetStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *KillSwitchGetStatus) Reset() {
+	*x = KillSwitchGetStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchGetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchGetStatus) ProtoMessage() {}
+
+func (x *KillSwitchGetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchGetStatus.ProtoReflect.Descriptor instead.
+func (*KillSwitchGetStatus) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *KillSwitchGetStatus) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// KillSwitchSetEnabled request to enable\disable kill-switch
+type KillSwitchSetEnabled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	IsEnabled      bool   `protobuf:"varint,2,opt,name=IsEnabled,proto3" json:"IsEnabled,omitempty"`
+}
+
+func (x *KillSwitchSetEnabled) Reset() {
+	*x = KillSwitchSetEnabled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchSetEnabled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchSetEnabled) ProtoMessage() {}
+
+func (x *KillSwitchSetEnabled) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchSetEnabled.ProtoReflect.Descriptor instead.
+func (*KillSwitchSetEnabled) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *KillSwitchSetEnabled) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *KillSwitchSetEnabled) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+// KillSwitchSetAllowLANMulticast enable\disable LAN multicast acces for kill-switch
+type KillSwitchSetAllowLANMulticast struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret    string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	AllowLANMulticast bool   `protobuf:"varint,2,opt,name=AllowLANMulticast,proto3" json:"AllowLANMulticast,omitempty"`
+}
+
+func (x *KillSwitchSetAllowLANMulticast) Reset() {
+	*x = KillSwitchSetAllowLANMulticast{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchSetAllowLANMulticast) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchSetAllowLANMulticast) ProtoMessage() {}
+
+func (x *KillSwitchSetAllowLANMulticast) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchSetAllowLANMulticast.ProtoReflect.Descriptor instead.
+func (*KillSwitchSetAllowLANMulticast) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *KillSwitchSetAllowLANMulticast) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *KillSwitchSetAllowLANMulticast) GetAllowLANMulticast() bool {
+	if x != nil {
+		return x.AllowLANMulticast
+	}
+	return false
+}
+
+// KillSwitchSetAllowLAN enable\disable LAN acces for kill-switch
+type KillSwitchSetAllowLAN struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	AllowLAN       bool   `protobuf:"varint,2,opt,name=AllowLAN,proto3" json:"AllowLAN,omitempty"`
+}
+
+func (x *KillSwitchSetAllowLAN) Reset() {
+	*x = KillSwitchSetAllowLAN{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchSetAllowLAN) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchSetAllowLAN) ProtoMessage() {}
+
+func (x *KillSwitchSetAllowLAN) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchSetAllowLAN.ProtoReflect.Descriptor instead.
+func (*KillSwitchSetAllowLAN) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *KillSwitchSetAllowLAN) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *KillSwitchSetAllowLAN) GetAllowLAN() bool {
+	if x != nil {
+		return x.AllowLAN
+	}
+	return false
+}
+
+// KillSwitchSetAllowLANServices set the LAN services allowed by kill-switch individually (when LAN communication is blocked)
+type KillSwitchSetAllowLANServices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string          `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Services       *LansvcServices `protobuf:"bytes,2,opt,name=Services,proto3" json:"Services,omitempty"`
+}
+
+func (x *KillSwitchSetAllowLANServices) Reset() {
+	*x = KillSwitchSetAllowLANServices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchSetAllowLANServices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchSetAllowLANServices) ProtoMessage() {}
+
+func (x *KillSwitchSetAllowLANServices) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchSetAllowLANServices.ProtoReflect.Descriptor instead.
+func (*KillSwitchSetAllowLANServices) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *KillSwitchSetAllowLANServices) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *KillSwitchSetAllowLANServices) GetServices() *LansvcServices {
+	if x != nil {
+		return x.Services
+	}
+	return nil
+}
+
+// Services - LAN services which are allowed by the firewall individually
+// (applicable when the firewall is enabled and the communication with the whole LAN is blocked)
+type LansvcServices struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Multicast DNS (UDP 5353): discovery of AirPrint/AirPlay/Chromecast devices
+	MDNS bool `protobuf:"varint,1,opt,name=MDNS,proto3" json:"MDNS,omitempty"`
+	// Simple Service Discovery Protocol (UDP 1900): UPnP/DLNA discovery
+	SSDP bool `protobuf:"varint,2,opt,name=SSDP,proto3" json:"SSDP,omitempty"`
+	// DHCP (UDP 67/68) and DHCPv6 (UDP 546/547) to the servers in LAN (including unicast lease renewals)
+	DHCP bool `protobuf:"varint,3,opt,name=DHCP,proto3" json:"DHCP,omitempty"`
+	// Network printers in LAN: IPP (TCP 631), LPD (TCP 515), raw printing (TCP 9100)
+	Printing bool `protobuf:"varint,4,opt,name=Printing,proto3" json:"Printing,omitempty"`
+}
+
+func (x *LansvcServices) Reset() {
+	*x = LansvcServices{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LansvcServices) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LansvcServices) ProtoMessage() {}
+
+func (x *LansvcServices) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LansvcServices.ProtoReflect.Descriptor instead.
+func (*LansvcServices) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LansvcServices) GetMDNS() bool {
+	if x != nil {
+		return x.MDNS
+	}
+	return false
+}
+
+func (x *LansvcServices) GetSSDP() bool {
+	if x != nil {
+		return x.SSDP
+	}
+	return false
+}
+
+func (x *LansvcServices) GetDHCP() bool {
+	if x != nil {
+		return x.DHCP
+	}
+	return false
+}
+
+func (x *LansvcServices) GetPrinting() bool {
+	if x != nil {
+		return x.Printing
+	}
+	return false
+}
+
+// KillSwitchSetAllowedLANHosts set the LAN hosts allowed by kill-switch (when LAN communication is blocked)
+type KillSwitchSetAllowedLANHosts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// comma separated list of local IP addresses (e.g. NAS, printer)
+	Hosts string `protobuf:"bytes,2,opt,name=Hosts,proto3" json:"Hosts,omitempty"`
+}
+
+func (x *KillSwitchSetAllowedLANHosts) Reset() {
+	*x = KillSwitchSetAllowedLANHosts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchSetAllowedLANHosts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchSetAllowedLANHosts) ProtoMessage() {}
+
+func (x *KillSwitchSetAllowedLANHosts) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchSetAllowedLANHosts.ProtoReflect.Descriptor instead.
+func (*KillSwitchSetAllowedLANHosts) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *KillSwitchSetAllowedLANHosts) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *KillSwitchSetAllowedLANHosts) GetHosts() string {
+	if x != nil {
+		return x.Hosts
+	}
+	return ""
+}
+
+// KillSwitchSetLANInboundPorts set the local ports which accept incoming connections from LAN
+// while the kill-switch is blocking the inbound traffic (e.g. SSH server, local web server)
+type KillSwitchSetLANInboundPorts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string               `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Ports          []*LansvcInboundPort `protobuf:"bytes,2,rep,name=Ports,proto3" json:"Ports,omitempty"`
+}
+
+func (x *KillSwitchSetLANInboundPorts) Reset() {
+	*x = KillSwitchSetLANInboundPorts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchSetLANInboundPorts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchSetLANInboundPorts) ProtoMessage() {}
+
+func (x *KillSwitchSetLANInboundPorts) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchSetLANInboundPorts.ProtoReflect.Descriptor instead.
+func (*KillSwitchSetLANInboundPorts) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *KillSwitchSetLANInboundPorts) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *KillSwitchSetLANInboundPorts) GetPorts() []*LansvcInboundPort {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+// InboundPort - local port which accepts incoming connections from LAN
+// (e.g. SSH server or local web development server on this machine)
+type LansvcInboundPort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port uint32 `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
+	// "tcp" or "udp"
+	Protocol string `protobuf:"bytes,2,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+}
+
+func (x *LansvcInboundPort) Reset() {
+	*x = LansvcInboundPort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LansvcInboundPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LansvcInboundPort) ProtoMessage() {}
+
+func (x *LansvcInboundPort) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LansvcInboundPort.ProtoReflect.Descriptor instead.
+func (*LansvcInboundPort) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LansvcInboundPort) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *LansvcInboundPort) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+// KillSwitchSetUserExceptions set ip masks to exclude from firewall blocking rules
+type KillSwitchSetUserExceptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Firewall exceptions: comma separated list of IP addresses (masks) in format: x.x.x.x[/xx]
+	UserExceptions     string `protobuf:"bytes,1,opt,name=UserExceptions,proto3" json:"UserExceptions,omitempty"`
+	FailOnParsingError bool   `protobuf:"varint,2,opt,name=FailOnParsingError,proto3" json:"FailOnParsingError,omitempty"`
+}
+
+func (x *KillSwitchSetUserExceptions) Reset() {
+	*x = KillSwitchSetUserExceptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchSetUserExceptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchSetUserExceptions) ProtoMessage() {}
+
+func (x *KillSwitchSetUserExceptions) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchSetUserExceptions.ProtoReflect.Descriptor instead.
+func (*KillSwitchSetUserExceptions) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *KillSwitchSetUserExceptions) GetUserExceptions() string {
+	if x != nil {
+		return x.UserExceptions
+	}
+	return ""
+}
+
+func (x *KillSwitchSetUserExceptions) GetFailOnParsingError() bool {
+	if x != nil {
+		return x.FailOnParsingError
+	}
+	return false
+}
+
+// KillSwitchSetIsPersistent request to mark kill-switch persistant
+type KillSwitchSetIsPersistent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	IsPersistent   bool   `protobuf:"varint,2,opt,name=IsPersistent,proto3" json:"IsPersistent,omitempty"`
+}
+
+func (x *KillSwitchSetIsPersistent) Reset() {
+	*x = KillSwitchSetIsPersistent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchSetIsPersistent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchSetIsPersistent) ProtoMessage() {}
+
+func (x *KillSwitchSetIsPersistent) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchSetIsPersistent.ProtoReflect.Descriptor instead.
+func (*KillSwitchSetIsPersistent) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *KillSwitchSetIsPersistent) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *KillSwitchSetIsPersistent) GetIsPersistent() bool {
+	if x != nil {
+		return x.IsPersistent
+	}
+	return false
+}
+
+type KillSwitchSetAllowApiServers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret    string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	IsAllowApiServers bool   `protobuf:"varint,2,opt,name=IsAllowApiServers,proto3" json:"IsAllowApiServers,omitempty"`
+}
+
+func (x *KillSwitchSetAllowApiServers) Reset() {
+	*x = KillSwitchSetAllowApiServers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchSetAllowApiServers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchSetAllowApiServers) ProtoMessage() {}
+
+func (x *KillSwitchSetAllowApiServers) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchSetAllowApiServers.ProtoReflect.Descriptor instead.
+func (*KillSwitchSetAllowApiServers) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *KillSwitchSetAllowApiServers) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *KillSwitchSetAllowApiServers) GetIsAllowApiServers() bool {
+	if x != nil {
+		return x.IsAllowApiServers
+	}
+	return false
+}
+
+// SetPreference sets daemon configuration parameter
+// (This is an old implementation. It is necessary to use 'SetUserPreferences/SettingsResp' for future extensions)
+type SetPreference struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Key            string `protobuf:"bytes,2,opt,name=Key,proto3" json:"Key,omitempty"`
+	Value          string `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+}
+
+func (x *SetPreference) Reset() {
+	*x = SetPreference{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPreference) ProtoMessage() {}
+
+func (x *SetPreference) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPreference.ProtoReflect.Descriptor instead.
+func (*SetPreference) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetPreference) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetPreference) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SetPreference) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// SetObfsProxy sets obfsproxy configuration
+type SetObfsProxy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret  string           `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	ObfsproxyConfig *ObfsproxyConfig `protobuf:"bytes,2,opt,name=ObfsproxyConfig,proto3" json:"ObfsproxyConfig,omitempty"`
+}
+
+func (x *SetObfsProxy) Reset() {
+	*x = SetObfsProxy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetObfsProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetObfsProxy) ProtoMessage() {}
+
+func (x *SetObfsProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetObfsProxy.ProtoReflect.Descriptor instead.
+func (*SetObfsProxy) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SetObfsProxy) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetObfsProxy) GetObfsproxyConfig() *ObfsproxyConfig {
+	if x != nil {
+		return x.ObfsproxyConfig
+	}
+	return nil
+}
+
+type ObfsproxyConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version  int64 `protobuf:"varint,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	Obfs4Iat int64 `protobuf:"varint,2,opt,name=Obfs4Iat,proto3" json:"Obfs4Iat,omitempty"`
+	// (obfs4 only) user-defined bridge parameters
+	Obfs4Bridge *ObfsproxyObfs4Bridge `protobuf:"bytes,3,opt,name=Obfs4Bridge,proto3" json:"Obfs4Bridge,omitempty"`
+}
+
+func (x *ObfsproxyConfig) Reset() {
+	*x = ObfsproxyConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObfsproxyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObfsproxyConfig) ProtoMessage() {}
+
+func (x *ObfsproxyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObfsproxyConfig.ProtoReflect.Descriptor instead.
+func (*ObfsproxyConfig) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ObfsproxyConfig) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *ObfsproxyConfig) GetObfs4Iat() int64 {
+	if x != nil {
+		return x.Obfs4Iat
+	}
+	return 0
+}
+
+func (x *ObfsproxyConfig) GetObfs4Bridge() *ObfsproxyObfs4Bridge {
+	if x != nil {
+		return x.Obfs4Bridge
+	}
+	return nil
+}
+
+// Obfs4Bridge - user-defined parameters of the obfs4 bridge (server side of the obfs4 connection).
+// Empty values mean that the parameters from the servers list are in use.
+type ObfsproxyObfs4Bridge struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// public certificate of the obfs4 bridge ("cert=..." parameter of the bridge line)
+	Cert string `protobuf:"bytes,1,opt,name=Cert,proto3" json:"Cert,omitempty"`
+	// port of the obfs4 bridge (applicable only for Single-Hop connections)
+	Port int64 `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+}
+
+func (x *ObfsproxyObfs4Bridge) Reset() {
+	*x = ObfsproxyObfs4Bridge{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ObfsproxyObfs4Bridge) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ObfsproxyObfs4Bridge) ProtoMessage() {}
+
+func (x *ObfsproxyObfs4Bridge) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ObfsproxyObfs4Bridge.ProtoReflect.Descriptor instead.
+func (*ObfsproxyObfs4Bridge) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ObfsproxyObfs4Bridge) GetCert() string {
+	if x != nil {
+		return x.Cert
+	}
+	return ""
+}
+
+func (x *ObfsproxyObfs4Bridge) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// SetV2RayProxy sets V2Ray transport for VPN connections (v2r.None - disable V2Ray)
+type SetV2RayProxy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	V2RayProxy     int64  `protobuf:"varint,2,opt,name=V2RayProxy,proto3" json:"V2RayProxy,omitempty"`
+}
+
+func (x *SetV2RayProxy) Reset() {
+	*x = SetV2RayProxy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetV2RayProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetV2RayProxy) ProtoMessage() {}
+
+func (x *SetV2RayProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetV2RayProxy.ProtoReflect.Descriptor instead.
+func (*SetV2RayProxy) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetV2RayProxy) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetV2RayProxy) GetV2RayProxy() int64 {
+	if x != nil {
+		return x.V2RayProxy
+	}
+	return 0
+}
+
+// SetApiProxy sets proxy server for the daemon API requests (empty configuration - direct connection)
+type SetApiProxy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string               `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Config         *ApiTypesProxyConfig `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
+}
+
+func (x *SetApiProxy) Reset() {
+	*x = SetApiProxy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetApiProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetApiProxy) ProtoMessage() {}
+
+func (x *SetApiProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetApiProxy.ProtoReflect.Descriptor instead.
+func (*SetApiProxy) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetApiProxy) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetApiProxy) GetConfig() *ApiTypesProxyConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// ProxyConfig - proxy server for the daemon API requests
+// (for networks where direct access to the API server is blocked, e.g. corporate networks)
+type ApiTypesProxyConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ProxyHTTP or ProxySOCKS5 (empty - direct connection)
+	Type string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	// proxy server address in format "host:port"
+	Address string `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	// (optional) proxy authentication
+	Username string `protobuf:"bytes,3,opt,name=Username,proto3" json:"Username,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=Password,proto3" json:"Password,omitempty"`
+}
+
+func (x *ApiTypesProxyConfig) Reset() {
+	*x = ApiTypesProxyConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesProxyConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesProxyConfig) ProtoMessage() {}
+
+func (x *ApiTypesProxyConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesProxyConfig.ProtoReflect.Descriptor instead.
+func (*ApiTypesProxyConfig) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ApiTypesProxyConfig) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ApiTypesProxyConfig) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ApiTypesProxyConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ApiTypesProxyConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// SetApiHostOverride sets the alternative API server for the daemon API requests (empty configuration - the default IVPN API server)
+// NOTE: the alternative server is fully trusted (it defines the VPN servers the daemon connects to); use only the servers under your control.
+type SetApiHostOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                   `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Config         *ApiTypesAPIHostOverride `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
+}
+
+func (x *SetApiHostOverride) Reset() {
+	*x = SetApiHostOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetApiHostOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetApiHostOverride) ProtoMessage() {}
+
+func (x *SetApiHostOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetApiHostOverride.ProtoReflect.Descriptor instead.
+func (*SetApiHostOverride) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetApiHostOverride) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetApiHostOverride) GetConfig() *ApiTypesAPIHostOverride {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// APIHostOverride - alternative API server for the daemon API requests
+// (e.g. staging or mirrored infrastructure) instead of the default IVPN API server
+type ApiTypesAPIHostOverride struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// base URL of the API server in format "https://HOST[:PORT][/PATH]" (empty - the default IVPN API server)
+	BaseURL string `protobuf:"bytes,1,opt,name=BaseURL,proto3" json:"BaseURL,omitempty"`
+	// base64-encoded SHA256 hashes of the server certificate public keys (certificate key pinning).
+	// At least one hash is required: the server is authenticated by the pinned key only
+	// (so staging servers with self-signed or private CA certificates can be used)
+	CertHashes []string `protobuf:"bytes,2,rep,name=CertHashes,proto3" json:"CertHashes,omitempty"`
+}
+
+func (x *ApiTypesAPIHostOverride) Reset() {
+	*x = ApiTypesAPIHostOverride{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesAPIHostOverride) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesAPIHostOverride) ProtoMessage() {}
+
+func (x *ApiTypesAPIHostOverride) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesAPIHostOverride.ProtoReflect.Descriptor instead.
+func (*ApiTypesAPIHostOverride) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ApiTypesAPIHostOverride) GetBaseURL() string {
+	if x != nil {
+		return x.BaseURL
+	}
+	return ""
+}
+
+func (x *ApiTypesAPIHostOverride) GetCertHashes() []string {
+	if x != nil {
+		return x.CertHashes
+	}
+	return nil
+}
+
+// SetOpenVpnExtraParameters sets the additional OpenVPN directives, one per line (empty string - no additional directives).
+// Only the safe directives are accepted (e.g. 'script-security', 'up', 'down', 'plugin' are rejected)
+type SetOpenVpnExtraParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Params         string `protobuf:"bytes,2,opt,name=Params,proto3" json:"Params,omitempty"`
+}
+
+func (x *SetOpenVpnExtraParameters) Reset() {
+	*x = SetOpenVpnExtraParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOpenVpnExtraParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOpenVpnExtraParameters) ProtoMessage() {}
+
+func (x *SetOpenVpnExtraParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOpenVpnExtraParameters.ProtoReflect.Descriptor instead.
+func (*SetOpenVpnExtraParameters) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetOpenVpnExtraParameters) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetOpenVpnExtraParameters) GetParams() string {
+	if x != nil {
+		return x.Params
+	}
+	return ""
+}
+
+// SetOpenVpnCryptoPolicy sets the TLS/cipher policy for OpenVPN connections (empty policy - default parameters)
+type SetOpenVpnCryptoPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                  `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Policy         *VpnOpenVpnCryptoPolicy `protobuf:"bytes,2,opt,name=Policy,proto3" json:"Policy,omitempty"`
+}
+
+func (x *SetOpenVpnCryptoPolicy) Reset() {
+	*x = SetOpenVpnCryptoPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetOpenVpnCryptoPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetOpenVpnCryptoPolicy) ProtoMessage() {}
+
+func (x *SetOpenVpnCryptoPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetOpenVpnCryptoPolicy.ProtoReflect.Descriptor instead.
+func (*SetOpenVpnCryptoPolicy) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SetOpenVpnCryptoPolicy) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetOpenVpnCryptoPolicy) GetPolicy() *VpnOpenVpnCryptoPolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+// OpenVpnCryptoPolicy - TLS/cipher policy for OpenVPN connections (e.g. for users with compliance requirements).
+// Empty values - the defaults of the daemon (or OpenVPN) are in use.
+type VpnOpenVpnCryptoPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// OpenVPN 'tls-version-min' (e.g. "1.2")
+	TlsVersionMin string `protobuf:"bytes,1,opt,name=TlsVersionMin,proto3" json:"TlsVersionMin,omitempty"`
+	// OpenVPN 'data-ciphers' in order of preference (e.g. ["AES-256-GCM", "CHACHA20-POLY1305"])
+	DataCiphers []string `protobuf:"bytes,2,rep,name=DataCiphers,proto3" json:"DataCiphers,omitempty"`
+	// OpenVPN 'tls-cert-profile' (e.g. "preferred")
+	TlsCertProfile string `protobuf:"bytes,3,opt,name=TlsCertProfile,proto3" json:"TlsCertProfile,omitempty"`
+}
+
+func (x *VpnOpenVpnCryptoPolicy) Reset() {
+	*x = VpnOpenVpnCryptoPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VpnOpenVpnCryptoPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VpnOpenVpnCryptoPolicy) ProtoMessage() {}
+
+func (x *VpnOpenVpnCryptoPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VpnOpenVpnCryptoPolicy.ProtoReflect.Descriptor instead.
+func (*VpnOpenVpnCryptoPolicy) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *VpnOpenVpnCryptoPolicy) GetTlsVersionMin() string {
+	if x != nil {
+		return x.TlsVersionMin
+	}
+	return ""
+}
+
+func (x *VpnOpenVpnCryptoPolicy) GetDataCiphers() []string {
+	if x != nil {
+		return x.DataCiphers
+	}
+	return nil
+}
+
+func (x *VpnOpenVpnCryptoPolicy) GetTlsCertProfile() string {
+	if x != nil {
+		return x.TlsCertProfile
+	}
+	return ""
+}
+
+// RestApiGet requests the configuration of the local REST API (including the access token)
+type RestApiGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *RestApiGet) Reset() {
+	*x = RestApiGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestApiGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestApiGet) ProtoMessage() {}
+
+func (x *RestApiGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestApiGet.ProtoReflect.Descriptor instead.
+func (*RestApiGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RestApiGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// SetRestApi enables/disables the local REST API ('Port' = 0 - keep the current port; 'ResetToken' - generate new access token)
+type SetRestApi struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	IsEnabled      bool   `protobuf:"varint,2,opt,name=IsEnabled,proto3" json:"IsEnabled,omitempty"`
+	Port           int64  `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
+	ResetToken     bool   `protobuf:"varint,4,opt,name=ResetToken,proto3" json:"ResetToken,omitempty"`
+	// enables/disables the Prometheus metrics endpoint ('/metrics') of the REST API
+	IsMetricsEnabled bool `protobuf:"varint,5,opt,name=IsMetricsEnabled,proto3" json:"IsMetricsEnabled,omitempty"`
+}
+
+func (x *SetRestApi) Reset() {
+	*x = SetRestApi{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetRestApi) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRestApi) ProtoMessage() {}
+
+func (x *SetRestApi) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRestApi.ProtoReflect.Descriptor instead.
+func (*SetRestApi) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetRestApi) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetRestApi) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *SetRestApi) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SetRestApi) GetResetToken() bool {
+	if x != nil {
+		return x.ResetToken
+	}
+	return false
+}
+
+func (x *SetRestApi) GetIsMetricsEnabled() bool {
+	if x != nil {
+		return x.IsMetricsEnabled
+	}
+	return false
+}
+
+// GrpcApiGet requests the configuration of the local gRPC API (including the access token)
+type GrpcApiGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *GrpcApiGet) Reset() {
+	*x = GrpcApiGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrpcApiGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrpcApiGet) ProtoMessage() {}
+
+func (x *GrpcApiGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrpcApiGet.ProtoReflect.Descriptor instead.
+func (*GrpcApiGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GrpcApiGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// SetGrpcApi enables/disables the local gRPC API ('Port' = 0 - keep the current port; 'ResetToken' - generate new access token)
+type SetGrpcApi struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	IsEnabled      bool   `protobuf:"varint,2,opt,name=IsEnabled,proto3" json:"IsEnabled,omitempty"`
+	Port           int64  `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
+	ResetToken     bool   `protobuf:"varint,4,opt,name=ResetToken,proto3" json:"ResetToken,omitempty"`
+}
+
+func (x *SetGrpcApi) Reset() {
+	*x = SetGrpcApi{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetGrpcApi) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetGrpcApi) ProtoMessage() {}
+
+func (x *SetGrpcApi) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetGrpcApi.ProtoReflect.Descriptor instead.
+func (*SetGrpcApi) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SetGrpcApi) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetGrpcApi) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *SetGrpcApi) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SetGrpcApi) GetResetToken() bool {
+	if x != nil {
+		return x.ResetToken
+	}
+	return false
+}
+
+// SetLogRotation sets the configuration of the daemon log files rotation
+type SetLogRotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Config         *LoggerRotationConfig `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
+}
+
+func (x *SetLogRotation) Reset() {
+	*x = SetLogRotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogRotation) ProtoMessage() {}
+
+func (x *SetLogRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogRotation.ProtoReflect.Descriptor instead.
+func (*SetLogRotation) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SetLogRotation) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetLogRotation) GetConfig() *LoggerRotationConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// RotationConfig - configuration of the log files rotation.
+// The active log file is rotated when it reaches 'MaxSizeMB' (and on each daemon start).
+// Rotated files: '<log>.0' (the newest one; never compressed), '<log>.1[.gz]' ... '<log>.<MaxFiles-1>[.gz]'.
+type LoggerRotationConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Max size of the active log file (MB); 0 - default (RotationDefaultMaxSizeMB)
+	MaxSizeMB int64 `protobuf:"varint,1,opt,name=MaxSizeMB,proto3" json:"MaxSizeMB,omitempty"`
+	// Number of rotated log files to keep; 0 - default (RotationDefaultMaxFiles)
+	MaxFiles int64 `protobuf:"varint,2,opt,name=MaxFiles,proto3" json:"MaxFiles,omitempty"`
+	// Max age of the rotated log files (days); the older files are removed. 0 - not limited
+	MaxAgeDays int64 `protobuf:"varint,3,opt,name=MaxAgeDays,proto3" json:"MaxAgeDays,omitempty"`
+	// If true - the rotated log files (except the newest one) are compressed (gzip)
+	Compress bool `protobuf:"varint,4,opt,name=Compress,proto3" json:"Compress,omitempty"`
+}
+
+func (x *LoggerRotationConfig) Reset() {
+	*x = LoggerRotationConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoggerRotationConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoggerRotationConfig) ProtoMessage() {}
+
+func (x *LoggerRotationConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoggerRotationConfig.ProtoReflect.Descriptor instead.
+func (*LoggerRotationConfig) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LoggerRotationConfig) GetMaxSizeMB() int64 {
+	if x != nil {
+		return x.MaxSizeMB
+	}
+	return 0
+}
+
+func (x *LoggerRotationConfig) GetMaxFiles() int64 {
+	if x != nil {
+		return x.MaxFiles
+	}
+	return 0
+}
+
+func (x *LoggerRotationConfig) GetMaxAgeDays() int64 {
+	if x != nil {
+		return x.MaxAgeDays
+	}
+	return 0
+}
+
+func (x *LoggerRotationConfig) GetCompress() bool {
+	if x != nil {
+		return x.Compress
+	}
+	return false
+}
+
+// LogLevelsGet requests the current log levels of the daemon modules
+type LogLevelsGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *LogLevelsGet) Reset() {
+	*x = LogLevelsGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLevelsGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLevelsGet) ProtoMessage() {}
+
+func (x *LogLevelsGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLevelsGet.ProtoReflect.Descriptor instead.
+func (*LogLevelsGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LogLevelsGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// SetLogLevel changes the log level at runtime (not saved over the daemon restart).
+// 'Module' - name of the logger module (e.g. "dns"); empty - change the default level for all modules.
+// 'Level' - "debug", "info", "warning" or "error"; empty - remove the module-specific level (the default level is in use).
+type SetLogLevel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Module         string `protobuf:"bytes,2,opt,name=Module,proto3" json:"Module,omitempty"`
+	Level          string `protobuf:"bytes,3,opt,name=Level,proto3" json:"Level,omitempty"`
+}
+
+func (x *SetLogLevel) Reset() {
+	*x = SetLogLevel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevel) ProtoMessage() {}
+
+func (x *SetLogLevel) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevel.ProtoReflect.Descriptor instead.
+func (*SetLogLevel) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *SetLogLevel) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetLogLevel) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *SetLogLevel) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// ClientTokensGet requests the list of the client access tokens
+type ClientTokensGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *ClientTokensGet) Reset() {
+	*x = ClientTokensGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientTokensGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientTokensGet) ProtoMessage() {}
+
+func (x *ClientTokensGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientTokensGet.ProtoReflect.Descriptor instead.
+func (*ClientTokensGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ClientTokensGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// ClientTokenAdd creates new client access token ('Scope': "read" - read-only access; "full" - full control)
+type ClientTokenAdd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Scope          string `protobuf:"bytes,3,opt,name=Scope,proto3" json:"Scope,omitempty"`
+}
+
+func (x *ClientTokenAdd) Reset() {
+	*x = ClientTokenAdd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientTokenAdd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientTokenAdd) ProtoMessage() {}
+
+func (x *ClientTokenAdd) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientTokenAdd.ProtoReflect.Descriptor instead.
+func (*ClientTokenAdd) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ClientTokenAdd) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ClientTokenAdd) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ClientTokenAdd) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// ClientTokenRemove removes the client access token by name
+type ClientTokenRemove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *ClientTokenRemove) Reset() {
+	*x = ClientTokenRemove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientTokenRemove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientTokenRemove) ProtoMessage() {}
+
+func (x *ClientTokenRemove) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientTokenRemove.ProtoReflect.Descriptor instead.
+func (*ClientTokenRemove) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *ClientTokenRemove) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ClientTokenRemove) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// SettingsExport requests the daemon configuration which can be imported on another machine (SettingsExportResp).
+// Secrets (passwords, user-defined VPN configurations) are included only when 'IncludeSecrets' is true.
+type SettingsExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	IncludeSecrets bool   `protobuf:"varint,2,opt,name=IncludeSecrets,proto3" json:"IncludeSecrets,omitempty"`
+}
+
+func (x *SettingsExport) Reset() {
+	*x = SettingsExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettingsExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingsExport) ProtoMessage() {}
+
+func (x *SettingsExport) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingsExport.ProtoReflect.Descriptor instead.
+func (*SettingsExport) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SettingsExport) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SettingsExport) GetIncludeSecrets() bool {
+	if x != nil {
+		return x.IncludeSecrets
+	}
+	return false
+}
+
+// SettingsImport applies the configuration exported by SettingsExport request (SettingsImportResp)
+type SettingsImport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                       `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Settings       *PreferencesExportedSettings `protobuf:"bytes,2,opt,name=Settings,proto3" json:"Settings,omitempty"`
+}
+
+func (x *SettingsImport) Reset() {
+	*x = SettingsImport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettingsImport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingsImport) ProtoMessage() {}
+
+func (x *SettingsImport) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingsImport.ProtoReflect.Descriptor instead.
+func (*SettingsImport) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SettingsImport) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SettingsImport) GetSettings() *PreferencesExportedSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// ExportedSettings - the daemon configuration which can be transferred to another machine
+// (fleet provisioning, reinstallation).
+// It contains no account/session data, client access tokens or machine-specific settings.
+type PreferencesExportedSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FormatVersion int64  `protobuf:"varint,1,opt,name=FormatVersion,proto3" json:"FormatVersion,omitempty"`
+	ExportedAt    string `protobuf:"bytes,2,opt,name=ExportedAt,proto3" json:"ExportedAt,omitempty"`
+	// When 'false' - passwords and user-defined VPN configurations (which can contain private keys) are not included
+	IsSecretsIncluded bool                                 `protobuf:"varint,3,opt,name=IsSecretsIncluded,proto3" json:"IsSecretsIncluded,omitempty"`
+	Firewall          *PreferencesExportedFirewallSettings `protobuf:"bytes,4,opt,name=Firewall,proto3" json:"Firewall,omitempty"`
+	// The last connection parameters (servers, protocol, port, DNS settings ...)
+	ConnectionParams   *ServiceTypesConnectionParams   `protobuf:"bytes,5,opt,name=ConnectionParams,proto3" json:"ConnectionParams,omitempty"`
+	ConnectionProfiles []*PreferencesConnectionProfile `protobuf:"bytes,6,rep,name=ConnectionProfiles,proto3" json:"ConnectionProfiles,omitempty"`
+	// Trusted WiFi networks and the related actions
+	WiFiControl      *PreferencesWiFiParams `protobuf:"bytes,7,opt,name=WiFiControl,proto3" json:"WiFiControl,omitempty"`
+	Obfs4Proxy       *ObfsproxyConfig       `protobuf:"bytes,8,opt,name=Obfs4proxy,proto3" json:"Obfs4proxy,omitempty"`
+	V2RayProxy       int64                  `protobuf:"varint,9,opt,name=V2RayProxy,proto3" json:"V2RayProxy,omitempty"`
+	ShadowsocksProxy *ShadowsocksConfig     `protobuf:"bytes,10,opt,name=ShadowsocksProxy,proto3" json:"ShadowsocksProxy,omitempty"`
+	AmneziaWG        *AwgConfig             `protobuf:"bytes,11,opt,name=AmneziaWG,proto3" json:"AmneziaWG,omitempty"`
+	ApiProxy         *ApiTypesProxyConfig   `protobuf:"bytes,12,opt,name=ApiProxy,proto3" json:"ApiProxy,omitempty"`
+	// TLS/cipher policy for OpenVPN connections
+	OpenVpnCryptoPolicy *VpnOpenVpnCryptoPolicy `protobuf:"bytes,13,opt,name=OpenVpnCryptoPolicy,proto3" json:"OpenVpnCryptoPolicy,omitempty"`
+}
+
+func (x *PreferencesExportedSettings) Reset() {
+	*x = PreferencesExportedSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesExportedSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesExportedSettings) ProtoMessage() {}
+
+func (x *PreferencesExportedSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesExportedSettings.ProtoReflect.Descriptor instead.
+func (*PreferencesExportedSettings) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PreferencesExportedSettings) GetFormatVersion() int64 {
+	if x != nil {
+		return x.FormatVersion
+	}
+	return 0
+}
+
+func (x *PreferencesExportedSettings) GetExportedAt() string {
+	if x != nil {
+		return x.ExportedAt
+	}
+	return ""
+}
+
+func (x *PreferencesExportedSettings) GetIsSecretsIncluded() bool {
+	if x != nil {
+		return x.IsSecretsIncluded
+	}
+	return false
+}
+
+func (x *PreferencesExportedSettings) GetFirewall() *PreferencesExportedFirewallSettings {
+	if x != nil {
+		return x.Firewall
+	}
+	return nil
+}
+
+func (x *PreferencesExportedSettings) GetConnectionParams() *ServiceTypesConnectionParams {
+	if x != nil {
+		return x.ConnectionParams
+	}
+	return nil
+}
+
+func (x *PreferencesExportedSettings) GetConnectionProfiles() []*PreferencesConnectionProfile {
+	if x != nil {
+		return x.ConnectionProfiles
+	}
+	return nil
+}
+
+func (x *PreferencesExportedSettings) GetWiFiControl() *PreferencesWiFiParams {
+	if x != nil {
+		return x.WiFiControl
+	}
+	return nil
+}
+
+func (x *PreferencesExportedSettings) GetObfs4Proxy() *ObfsproxyConfig {
+	if x != nil {
+		return x.Obfs4Proxy
+	}
+	return nil
+}
+
+func (x *PreferencesExportedSettings) GetV2RayProxy() int64 {
+	if x != nil {
+		return x.V2RayProxy
+	}
+	return 0
+}
+
+func (x *PreferencesExportedSettings) GetShadowsocksProxy() *ShadowsocksConfig {
+	if x != nil {
+		return x.ShadowsocksProxy
+	}
+	return nil
+}
+
+func (x *PreferencesExportedSettings) GetAmneziaWG() *AwgConfig {
+	if x != nil {
+		return x.AmneziaWG
+	}
+	return nil
+}
+
+func (x *PreferencesExportedSettings) GetApiProxy() *ApiTypesProxyConfig {
+	if x != nil {
+		return x.ApiProxy
+	}
+	return nil
+}
+
+func (x *PreferencesExportedSettings) GetOpenVpnCryptoPolicy() *VpnOpenVpnCryptoPolicy {
+	if x != nil {
+		return x.OpenVpnCryptoPolicy
+	}
+	return nil
+}
+
+// ExportedFirewallSettings - firewall configuration (part of ExportedSettings)
+type PreferencesExportedFirewallSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsPersistent        bool                 `protobuf:"varint,1,opt,name=IsPersistent,proto3" json:"IsPersistent,omitempty"`
+	IsAllowLAN          bool                 `protobuf:"varint,2,opt,name=IsAllowLAN,proto3" json:"IsAllowLAN,omitempty"`
+	IsAllowLANMulticast bool                 `protobuf:"varint,3,opt,name=IsAllowLANMulticast,proto3" json:"IsAllowLANMulticast,omitempty"`
+	AllowLANServices    *LansvcServices      `protobuf:"bytes,4,opt,name=AllowLANServices,proto3" json:"AllowLANServices,omitempty"`
+	AllowedLANHosts     string               `protobuf:"bytes,5,opt,name=AllowedLANHosts,proto3" json:"AllowedLANHosts,omitempty"`
+	LANInboundPorts     []*LansvcInboundPort `protobuf:"bytes,6,rep,name=LANInboundPorts,proto3" json:"LANInboundPorts,omitempty"`
+	IsAllowApiServers   bool                 `protobuf:"varint,7,opt,name=IsAllowApiServers,proto3" json:"IsAllowApiServers,omitempty"`
+	UserExceptions      string               `protobuf:"bytes,8,opt,name=UserExceptions,proto3" json:"UserExceptions,omitempty"`
+}
+
+func (x *PreferencesExportedFirewallSettings) Reset() {
+	*x = PreferencesExportedFirewallSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesExportedFirewallSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesExportedFirewallSettings) ProtoMessage() {}
+
+func (x *PreferencesExportedFirewallSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesExportedFirewallSettings.ProtoReflect.Descriptor instead.
+func (*PreferencesExportedFirewallSettings) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PreferencesExportedFirewallSettings) GetIsPersistent() bool {
+	if x != nil {
+		return x.IsPersistent
+	}
+	return false
+}
+
+func (x *PreferencesExportedFirewallSettings) GetIsAllowLAN() bool {
+	if x != nil {
+		return x.IsAllowLAN
+	}
+	return false
+}
+
+func (x *PreferencesExportedFirewallSettings) GetIsAllowLANMulticast() bool {
+	if x != nil {
+		return x.IsAllowLANMulticast
+	}
+	return false
+}
+
+func (x *PreferencesExportedFirewallSettings) GetAllowLANServices() *LansvcServices {
+	if x != nil {
+		return x.AllowLANServices
+	}
+	return nil
+}
+
+func (x *PreferencesExportedFirewallSettings) GetAllowedLANHosts() string {
+	if x != nil {
+		return x.AllowedLANHosts
+	}
+	return ""
+}
+
+func (x *PreferencesExportedFirewallSettings) GetLANInboundPorts() []*LansvcInboundPort {
+	if x != nil {
+		return x.LANInboundPorts
+	}
+	return nil
+}
+
+func (x *PreferencesExportedFirewallSettings) GetIsAllowApiServers() bool {
+	if x != nil {
+		return x.IsAllowApiServers
+	}
+	return false
+}
+
+func (x *PreferencesExportedFirewallSettings) GetUserExceptions() string {
+	if x != nil {
+		return x.UserExceptions
+	}
+	return ""
+}
+
+// Connect request to establish new VPN connection
+type ServiceTypesConnectionParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Metadata *ServiceTypesConnectMetadata `protobuf:"bytes,1,opt,name=Metadata,proto3" json:"Metadata,omitempty"`
+	// Can use IPv6 connection inside tunnel
+	// The hosts which support IPv6 have higher priority,
+	// but if there are no IPv6 hosts - we will use the IPv4 host.
+	IPv6 bool `protobuf:"varint,2,opt,name=IPv6,proto3" json:"IPv6,omitempty"`
+	// Use ONLY IPv6 hosts (ignored when IPv6!=true)
+	IPv6Only bool `protobuf:"varint,3,opt,name=IPv6Only,proto3" json:"IPv6Only,omitempty"`
+	// Which IP protocols have to be tunneled (WireGuard connections only).
+	// TunnelIPv4Only - IPv6 is not tunneled even if the server supports it ('IPv6' is ignored);
+	// TunnelIPv6Only - only IPv6 is tunneled (only IPv6-capable hosts are in use)
+	TunnelIPMode int64           `protobuf:"varint,4,opt,name=TunnelIPMode,proto3" json:"TunnelIPMode,omitempty"`
+	VpnType      int64           `protobuf:"varint,5,opt,name=VpnType,proto3" json:"VpnType,omitempty"`
+	ManualDNS    *DnsDnsSettings `protobuf:"bytes,6,opt,name=ManualDNS,proto3" json:"ManualDNS,omitempty"`
+	// Enable firewall before connection
+	// (if true - the parameter 'firewallDuringConnection' will be ignored)
+	FirewallOn bool `protobuf:"varint,7,opt,name=FirewallOn,proto3" json:"FirewallOn,omitempty"`
+	// Enable firewall before connection and disable after disconnection
+	// (has effect only if Firewall not enabled before)
+	FirewallOnDuringConnection bool                                             `protobuf:"varint,8,opt,name=FirewallOnDuringConnection,proto3" json:"FirewallOnDuringConnection,omitempty"`
+	WireGuardParameters        *ServiceTypesConnectionParamsWireGuardParameters `protobuf:"bytes,9,opt,name=WireGuardParameters,proto3" json:"WireGuardParameters,omitempty"`
+	// User-defined VPN configuration ("bring your own config").
+	// When defined - the servers info (hosts, ports, Multi-Hop ...) is ignored
+	CustomConfig      *ServiceTypesCustomConfig                      `protobuf:"bytes,10,opt,name=CustomConfig,proto3" json:"CustomConfig,omitempty"`
+	OpenVpnParameters *ServiceTypesConnectionParamsOpenVpnParameters `protobuf:"bytes,11,opt,name=OpenVpnParameters,proto3" json:"OpenVpnParameters,omitempty"`
+}
+
+func (x *ServiceTypesConnectionParams) Reset() {
+	*x = ServiceTypesConnectionParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesConnectionParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesConnectionParams) ProtoMessage() {}
+
+func (x *ServiceTypesConnectionParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesConnectionParams.ProtoReflect.Descriptor instead.
+func (*ServiceTypesConnectionParams) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ServiceTypesConnectionParams) GetMetadata() *ServiceTypesConnectMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectionParams) GetIPv6() bool {
+	if x != nil {
+		return x.IPv6
+	}
+	return false
+}
+
+func (x *ServiceTypesConnectionParams) GetIPv6Only() bool {
+	if x != nil {
+		return x.IPv6Only
+	}
+	return false
+}
+
+func (x *ServiceTypesConnectionParams) GetTunnelIPMode() int64 {
+	if x != nil {
+		return x.TunnelIPMode
+	}
+	return 0
+}
+
+func (x *ServiceTypesConnectionParams) GetVpnType() int64 {
+	if x != nil {
+		return x.VpnType
+	}
+	return 0
+}
+
+func (x *ServiceTypesConnectionParams) GetManualDNS() *DnsDnsSettings {
+	if x != nil {
+		return x.ManualDNS
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectionParams) GetFirewallOn() bool {
+	if x != nil {
+		return x.FirewallOn
+	}
+	return false
+}
+
+func (x *ServiceTypesConnectionParams) GetFirewallOnDuringConnection() bool {
+	if x != nil {
+		return x.FirewallOnDuringConnection
+	}
+	return false
+}
+
+func (x *ServiceTypesConnectionParams) GetWireGuardParameters() *ServiceTypesConnectionParamsWireGuardParameters {
+	if x != nil {
+		return x.WireGuardParameters
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectionParams) GetCustomConfig() *ServiceTypesCustomConfig {
+	if x != nil {
+		return x.CustomConfig
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectionParams) GetOpenVpnParameters() *ServiceTypesConnectionParamsOpenVpnParameters {
+	if x != nil {
+		return x.OpenVpnParameters
+	}
+	return nil
+}
+
+type ServiceTypesConnectMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// How the entry server was chosen
+	ServerSelectionEntry int64 `protobuf:"varint,1,opt,name=ServerSelectionEntry,proto3" json:"ServerSelectionEntry,omitempty"`
+	// How the exit server was chosen ('Fastest' is not applicable for 'Exit' server)
+	ServerSelectionExit int64                            `protobuf:"varint,2,opt,name=ServerSelectionExit,proto3" json:"ServerSelectionExit,omitempty"`
+	AntiTracker         *ServiceTypesAntiTrackerMetadata `protobuf:"bytes,3,opt,name=AntiTracker,proto3" json:"AntiTracker,omitempty"`
+	// (only if Fastest server in use) List of fastest servers which must be ignored (only gateway ID in use: e.g."us-tx.wg.ivpn.net" => "us-tx")
+	FastestGatewaysExcludeList []string `protobuf:"bytes,4,rep,name=FastestGatewaysExcludeList,proto3" json:"FastestGatewaysExcludeList,omitempty"`
+	// (only if Fastest server in use) Criteria for the fastest server selection
+	FastestCriteria *ServiceTypesFastestServerCriteria `protobuf:"bytes,5,opt,name=FastestCriteria,proto3" json:"FastestCriteria,omitempty"`
+}
+
+func (x *ServiceTypesConnectMetadata) Reset() {
+	*x = ServiceTypesConnectMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesConnectMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesConnectMetadata) ProtoMessage() {}
+
+func (x *ServiceTypesConnectMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesConnectMetadata.ProtoReflect.Descriptor instead.
+func (*ServiceTypesConnectMetadata) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ServiceTypesConnectMetadata) GetServerSelectionEntry() int64 {
+	if x != nil {
+		return x.ServerSelectionEntry
+	}
+	return 0
+}
+
+func (x *ServiceTypesConnectMetadata) GetServerSelectionExit() int64 {
+	if x != nil {
+		return x.ServerSelectionExit
+	}
+	return 0
+}
+
+func (x *ServiceTypesConnectMetadata) GetAntiTracker() *ServiceTypesAntiTrackerMetadata {
+	if x != nil {
+		return x.AntiTracker
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectMetadata) GetFastestGatewaysExcludeList() []string {
+	if x != nil {
+		return x.FastestGatewaysExcludeList
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectMetadata) GetFastestCriteria() *ServiceTypesFastestServerCriteria {
+	if x != nil {
+		return x.FastestCriteria
+	}
+	return nil
+}
+
+type ServiceTypesAntiTrackerMetadata struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enabled  bool `protobuf:"varint,1,opt,name=Enabled,proto3" json:"Enabled,omitempty"`
+	Hardcore bool `protobuf:"varint,2,opt,name=Hardcore,proto3" json:"Hardcore,omitempty"`
+}
+
+func (x *ServiceTypesAntiTrackerMetadata) Reset() {
+	*x = ServiceTypesAntiTrackerMetadata{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesAntiTrackerMetadata) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesAntiTrackerMetadata) ProtoMessage() {}
+
+func (x *ServiceTypesAntiTrackerMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesAntiTrackerMetadata.ProtoReflect.Descriptor instead.
+func (*ServiceTypesAntiTrackerMetadata) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ServiceTypesAntiTrackerMetadata) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ServiceTypesAntiTrackerMetadata) GetHardcore() bool {
+	if x != nil {
+		return x.Hardcore
+	}
+	return false
+}
+
+// FastestServerCriteria - criteria for the fastest server selection
+type ServiceTypesFastestServerCriteria struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// When true - the daemon chooses the fastest entry server on connect (using its own ping results).
+	// The entry server hosts defined by the client are ignored in this case.
+	ResolveOnConnect bool `protobuf:"varint,1,opt,name=ResolveOnConnect,proto3" json:"ResolveOnConnect,omitempty"`
+	// Locations allowed for selection: country code (e.g. "US"), gateway ID (e.g. "us-tx") or city name.
+	// Empty list - all locations are allowed
+	Locations []string `protobuf:"bytes,2,rep,name=Locations,proto3" json:"Locations,omitempty"`
+	// When true - the server load is taken into account (servers with lower load have higher priority)
+	PreferLowLoad bool `protobuf:"varint,3,opt,name=PreferLowLoad,proto3" json:"PreferLowLoad,omitempty"`
+}
+
+func (x *ServiceTypesFastestServerCriteria) Reset() {
+	*x = ServiceTypesFastestServerCriteria{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesFastestServerCriteria) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesFastestServerCriteria) ProtoMessage() {}
+
+func (x *ServiceTypesFastestServerCriteria) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesFastestServerCriteria.ProtoReflect.Descriptor instead.
+func (*ServiceTypesFastestServerCriteria) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ServiceTypesFastestServerCriteria) GetResolveOnConnect() bool {
+	if x != nil {
+		return x.ResolveOnConnect
+	}
+	return false
+}
+
+func (x *ServiceTypesFastestServerCriteria) GetLocations() []string {
+	if x != nil {
+		return x.Locations
+	}
+	return nil
+}
+
+func (x *ServiceTypesFastestServerCriteria) GetPreferLowLoad() bool {
+	if x != nil {
+		return x.PreferLowLoad
+	}
+	return false
+}
+
+type DnsDnsSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// DNS host IP address
+	DnsHost    string `protobuf:"bytes,1,opt,name=DnsHost,proto3" json:"DnsHost,omitempty"`
+	Encryption int64  `protobuf:"varint,2,opt,name=Encryption,proto3" json:"Encryption,omitempty"`
+	// DoH/DoT template URI (for Encryption = DnsOverHttps or Encryption = DnsOverTls)
+	DohTemplate string `protobuf:"bytes,3,opt,name=DohTemplate,proto3" json:"DohTemplate,omitempty"`
+	// DNS suffixes (search list) for the VPN interface; the first one is used as the connection-specific DNS suffix
+	// (e.g. corporate domains resolvable by internal DNS servers; currently, applicable only for Windows)
+	SearchDomains []string `protobuf:"bytes,4,rep,name=SearchDomains,proto3" json:"SearchDomains,omitempty"`
+}
+
+func (x *DnsDnsSettings) Reset() {
+	*x = DnsDnsSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DnsDnsSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DnsDnsSettings) ProtoMessage() {}
+
+func (x *DnsDnsSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DnsDnsSettings.ProtoReflect.Descriptor instead.
+func (*DnsDnsSettings) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DnsDnsSettings) GetDnsHost() string {
+	if x != nil {
+		return x.DnsHost
+	}
+	return ""
+}
+
+func (x *DnsDnsSettings) GetEncryption() int64 {
+	if x != nil {
+		return x.Encryption
+	}
+	return 0
+}
+
+func (x *DnsDnsSettings) GetDohTemplate() string {
+	if x != nil {
+		return x.DohTemplate
+	}
+	return ""
+}
+
+func (x *DnsDnsSettings) GetSearchDomains() []string {
+	if x != nil {
+		return x.SearchDomains
+	}
+	return nil
+}
+
+type ServiceTypesConnectionParamsWireGuardParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port               *ServiceTypesConnectionParamsWireGuardParametersPort           `protobuf:"bytes,1,opt,name=Port,proto3" json:"Port,omitempty"`
+	EntryVpnServer     *ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer `protobuf:"bytes,2,opt,name=EntryVpnServer,proto3" json:"EntryVpnServer,omitempty"`
+	MultihopExitServer *ServiceTypesMultiHopExitServer_WireGuard                      `protobuf:"bytes,3,opt,name=MultihopExitServer,proto3" json:"MultihopExitServer,omitempty"`
+	Mtu                int64                                                          `protobuf:"varint,4,opt,name=Mtu,proto3" json:"Mtu,omitempty"`
+	TcpEncapsulation   bool                                                           `protobuf:"varint,5,opt,name=TcpEncapsulation,proto3" json:"TcpEncapsulation,omitempty"`
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParameters) Reset() {
+	*x = ServiceTypesConnectionParamsWireGuardParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesConnectionParamsWireGuardParameters) ProtoMessage() {}
+
+func (x *ServiceTypesConnectionParamsWireGuardParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesConnectionParamsWireGuardParameters.ProtoReflect.Descriptor instead.
+func (*ServiceTypesConnectionParamsWireGuardParameters) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParameters) GetPort() *ServiceTypesConnectionParamsWireGuardParametersPort {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParameters) GetEntryVpnServer() *ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer {
+	if x != nil {
+		return x.EntryVpnServer
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParameters) GetMultihopExitServer() *ServiceTypesMultiHopExitServer_WireGuard {
+	if x != nil {
+		return x.MultihopExitServer
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParameters) GetMtu() int64 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParameters) GetTcpEncapsulation() bool {
+	if x != nil {
+		return x.TcpEncapsulation
+	}
+	return false
+}
+
+type ServiceTypesConnectionParamsWireGuardParametersPort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port int64 `protobuf:"varint,1,opt,name=Port,proto3" json:"Port,omitempty"`
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParametersPort) Reset() {
+	*x = ServiceTypesConnectionParamsWireGuardParametersPort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParametersPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesConnectionParamsWireGuardParametersPort) ProtoMessage() {}
+
+func (x *ServiceTypesConnectionParamsWireGuardParametersPort) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesConnectionParamsWireGuardParametersPort.ProtoReflect.Descriptor instead.
+func (*ServiceTypesConnectionParamsWireGuardParametersPort) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParametersPort) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+type ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hosts []*ApiTypesWireGuardServerHostInfo `protobuf:"bytes,1,rep,name=Hosts,proto3" json:"Hosts,omitempty"`
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer) Reset() {
+	*x = ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer) ProtoMessage() {}
+
+func (x *ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer.ProtoReflect.Descriptor instead.
+func (*ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer) GetHosts() []*ApiTypesWireGuardServerHostInfo {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+// WireGuardServerHostInfo contains info about WG server host
+type ApiTypesWireGuardServerHostInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname     string  `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Host         string  `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	DnsName      string  `protobuf:"bytes,3,opt,name=dns_name,proto3" json:"dns_name,omitempty"`
+	MultihopPort int64   `protobuf:"varint,4,opt,name=multihop_port,proto3" json:"multihop_port,omitempty"`
+	Load         float32 `protobuf:"fixed32,5,opt,name=load,proto3" json:"load,omitempty"`
+	// IP address of the V2Ray server (used when the connection is wrapped by V2Ray)
+	V2Ray     string                               `protobuf:"bytes,6,opt,name=v2ray,proto3" json:"v2ray,omitempty"`
+	PublicKey string                               `protobuf:"bytes,7,opt,name=public_key,proto3" json:"public_key,omitempty"`
+	LocalIp   string                               `protobuf:"bytes,8,opt,name=local_ip,proto3" json:"local_ip,omitempty"`
+	Ipv6      *ApiTypesWireGuardServerHostInfoIPv6 `protobuf:"bytes,9,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
+}
+
+func (x *ApiTypesWireGuardServerHostInfo) Reset() {
+	*x = ApiTypesWireGuardServerHostInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesWireGuardServerHostInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesWireGuardServerHostInfo) ProtoMessage() {}
+
+func (x *ApiTypesWireGuardServerHostInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesWireGuardServerHostInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesWireGuardServerHostInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ApiTypesWireGuardServerHostInfo) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerHostInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerHostInfo) GetDnsName() string {
+	if x != nil {
+		return x.DnsName
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerHostInfo) GetMultihopPort() int64 {
+	if x != nil {
+		return x.MultihopPort
+	}
+	return 0
+}
+
+func (x *ApiTypesWireGuardServerHostInfo) GetLoad() float32 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+func (x *ApiTypesWireGuardServerHostInfo) GetV2Ray() string {
+	if x != nil {
+		return x.V2Ray
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerHostInfo) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerHostInfo) GetLocalIp() string {
+	if x != nil {
+		return x.LocalIp
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerHostInfo) GetIpv6() *ApiTypesWireGuardServerHostInfoIPv6 {
+	if x != nil {
+		return x.Ipv6
+	}
+	return nil
+}
+
+type ApiTypesWireGuardServerHostInfoIPv6 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host    string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	LocalIp string `protobuf:"bytes,2,opt,name=local_ip,proto3" json:"local_ip,omitempty"`
+}
+
+func (x *ApiTypesWireGuardServerHostInfoIPv6) Reset() {
+	*x = ApiTypesWireGuardServerHostInfoIPv6{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesWireGuardServerHostInfoIPv6) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesWireGuardServerHostInfoIPv6) ProtoMessage() {}
+
+func (x *ApiTypesWireGuardServerHostInfoIPv6) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesWireGuardServerHostInfoIPv6.ProtoReflect.Descriptor instead.
+func (*ApiTypesWireGuardServerHostInfoIPv6) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ApiTypesWireGuardServerHostInfoIPv6) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerHostInfoIPv6) GetLocalIp() string {
+	if x != nil {
+		return x.LocalIp
+	}
+	return ""
+}
+
+type ServiceTypesMultiHopExitServer_WireGuard struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ExitSrvID (geteway ID) just in use to keep clients notified about connected MH exit server
+	// Example: "gateway":"zz.wg.ivpn.net" => "zz"
+	ExitSrvID string `protobuf:"bytes,1,opt,name=ExitSrvID,proto3" json:"ExitSrvID,omitempty"`
+	// Hosts of the exit server (the daemon validates them against the servers list).
+	// To connect to the specific exit host - define only this host.
+	Hosts []*ApiTypesWireGuardServerHostInfo `protobuf:"bytes,2,rep,name=Hosts,proto3" json:"Hosts,omitempty"`
+	// (optional) Multihop port of the exit host: only the exit hosts with this 'multihop_port' are in use (0 - any host)
+	Port int64 `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
+}
+
+func (x *ServiceTypesMultiHopExitServer_WireGuard) Reset() {
+	*x = ServiceTypesMultiHopExitServer_WireGuard{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesMultiHopExitServer_WireGuard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesMultiHopExitServer_WireGuard) ProtoMessage() {}
+
+func (x *ServiceTypesMultiHopExitServer_WireGuard) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesMultiHopExitServer_WireGuard.ProtoReflect.Descriptor instead.
+func (*ServiceTypesMultiHopExitServer_WireGuard) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ServiceTypesMultiHopExitServer_WireGuard) GetExitSrvID() string {
+	if x != nil {
+		return x.ExitSrvID
+	}
+	return ""
+}
+
+func (x *ServiceTypesMultiHopExitServer_WireGuard) GetHosts() []*ApiTypesWireGuardServerHostInfo {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *ServiceTypesMultiHopExitServer_WireGuard) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// CustomConfig - user-defined VPN configuration ("bring your own config")
+type ServiceTypesCustomConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Configuration text: WireGuard ('wg-quick' format) or OpenVPN (.ovpn).
+	// The type of configuration is defined by ConnectionParams.VpnType
+	Text string `protobuf:"bytes,1,opt,name=Text,proto3" json:"Text,omitempty"`
+	// (OpenVPN) user credentials; required when the configuration contains 'auth-user-pass'
+	Username string `protobuf:"bytes,2,opt,name=Username,proto3" json:"Username,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=Password,proto3" json:"Password,omitempty"`
+}
+
+func (x *ServiceTypesCustomConfig) Reset() {
+	*x = ServiceTypesCustomConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesCustomConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesCustomConfig) ProtoMessage() {}
+
+func (x *ServiceTypesCustomConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesCustomConfig.ProtoReflect.Descriptor instead.
+func (*ServiceTypesCustomConfig) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ServiceTypesCustomConfig) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *ServiceTypesCustomConfig) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ServiceTypesCustomConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ServiceTypesConnectionParamsOpenVpnParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EntryVpnServer     *ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer `protobuf:"bytes,1,opt,name=EntryVpnServer,proto3" json:"EntryVpnServer,omitempty"`
+	MultihopExitServer *ServiceTypesMultiHopExitServer_OpenVpn                      `protobuf:"bytes,2,opt,name=MultihopExitServer,proto3" json:"MultihopExitServer,omitempty"`
+	Proxy              *ServiceTypesOpenVpnProxy                                    `protobuf:"bytes,3,opt,name=Proxy,proto3" json:"Proxy,omitempty"`
+	Port               *ServiceTypesConnectionParamsOpenVpnParametersPort           `protobuf:"bytes,4,opt,name=Port,proto3" json:"Port,omitempty"`
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParameters) Reset() {
+	*x = ServiceTypesConnectionParamsOpenVpnParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesConnectionParamsOpenVpnParameters) ProtoMessage() {}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParameters) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesConnectionParamsOpenVpnParameters.ProtoReflect.Descriptor instead.
+func (*ServiceTypesConnectionParamsOpenVpnParameters) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParameters) GetEntryVpnServer() *ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer {
+	if x != nil {
+		return x.EntryVpnServer
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParameters) GetMultihopExitServer() *ServiceTypesMultiHopExitServer_OpenVpn {
+	if x != nil {
+		return x.MultihopExitServer
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParameters) GetProxy() *ServiceTypesOpenVpnProxy {
+	if x != nil {
+		return x.Proxy
+	}
+	return nil
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParameters) GetPort() *ServiceTypesConnectionParamsOpenVpnParametersPort {
+	if x != nil {
+		return x.Port
+	}
+	return nil
+}
+
+type ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hosts []*ApiTypesOpenVPNServerHostInfo `protobuf:"bytes,1,rep,name=Hosts,proto3" json:"Hosts,omitempty"`
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer) Reset() {
+	*x = ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer) ProtoMessage() {}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer.ProtoReflect.Descriptor instead.
+func (*ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer) GetHosts() []*ApiTypesOpenVPNServerHostInfo {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+// OpenVPNServerHostInfo contains info about OpenVPN server host
+type ApiTypesOpenVPNServerHostInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname     string  `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`
+	Host         string  `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	DnsName      string  `protobuf:"bytes,3,opt,name=dns_name,proto3" json:"dns_name,omitempty"`
+	MultihopPort int64   `protobuf:"varint,4,opt,name=multihop_port,proto3" json:"multihop_port,omitempty"`
+	Load         float32 `protobuf:"fixed32,5,opt,name=load,proto3" json:"load,omitempty"`
+	// IP address of the V2Ray server (used when the connection is wrapped by V2Ray)
+	V2Ray string                             `protobuf:"bytes,6,opt,name=v2ray,proto3" json:"v2ray,omitempty"`
+	Obfs  *ApiTypesObfsParams                `protobuf:"bytes,7,opt,name=obfs,proto3" json:"obfs,omitempty"`
+	Ipv6  *ApiTypesOpenVPNServerHostInfoIPv6 `protobuf:"bytes,8,opt,name=ipv6,proto3" json:"ipv6,omitempty"`
+}
+
+func (x *ApiTypesOpenVPNServerHostInfo) Reset() {
+	*x = ApiTypesOpenVPNServerHostInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesOpenVPNServerHostInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesOpenVPNServerHostInfo) ProtoMessage() {}
+
+func (x *ApiTypesOpenVPNServerHostInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesOpenVPNServerHostInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesOpenVPNServerHostInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ApiTypesOpenVPNServerHostInfo) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *ApiTypesOpenVPNServerHostInfo) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ApiTypesOpenVPNServerHostInfo) GetDnsName() string {
+	if x != nil {
+		return x.DnsName
+	}
+	return ""
+}
+
+func (x *ApiTypesOpenVPNServerHostInfo) GetMultihopPort() int64 {
+	if x != nil {
+		return x.MultihopPort
+	}
+	return 0
+}
+
+func (x *ApiTypesOpenVPNServerHostInfo) GetLoad() float32 {
+	if x != nil {
+		return x.Load
+	}
+	return 0
+}
+
+func (x *ApiTypesOpenVPNServerHostInfo) GetV2Ray() string {
+	if x != nil {
+		return x.V2Ray
+	}
+	return ""
+}
+
+func (x *ApiTypesOpenVPNServerHostInfo) GetObfs() *ApiTypesObfsParams {
+	if x != nil {
+		return x.Obfs
+	}
+	return nil
+}
+
+func (x *ApiTypesOpenVPNServerHostInfo) GetIpv6() *ApiTypesOpenVPNServerHostInfoIPv6 {
+	if x != nil {
+		return x.Ipv6
+	}
+	return nil
+}
+
+type ApiTypesObfsParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Obfs3MultihopPort int64  `protobuf:"varint,1,opt,name=obfs3_multihop_port,proto3" json:"obfs3_multihop_port,omitempty"`
+	Obfs4MultihopPort int64  `protobuf:"varint,2,opt,name=obfs4_multihop_port,proto3" json:"obfs4_multihop_port,omitempty"`
+	Obfs4Key          string `protobuf:"bytes,3,opt,name=obfs4_key,proto3" json:"obfs4_key,omitempty"`
+}
+
+func (x *ApiTypesObfsParams) Reset() {
+	*x = ApiTypesObfsParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesObfsParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesObfsParams) ProtoMessage() {}
+
+func (x *ApiTypesObfsParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesObfsParams.ProtoReflect.Descriptor instead.
+func (*ApiTypesObfsParams) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ApiTypesObfsParams) GetObfs3MultihopPort() int64 {
+	if x != nil {
+		return x.Obfs3MultihopPort
+	}
+	return 0
+}
+
+func (x *ApiTypesObfsParams) GetObfs4MultihopPort() int64 {
+	if x != nil {
+		return x.Obfs4MultihopPort
+	}
+	return 0
+}
+
+func (x *ApiTypesObfsParams) GetObfs4Key() string {
+	if x != nil {
+		return x.Obfs4Key
+	}
+	return ""
+}
+
+type ApiTypesOpenVPNServerHostInfoIPv6 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *ApiTypesOpenVPNServerHostInfoIPv6) Reset() {
+	*x = ApiTypesOpenVPNServerHostInfoIPv6{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesOpenVPNServerHostInfoIPv6) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesOpenVPNServerHostInfoIPv6) ProtoMessage() {}
+
+func (x *ApiTypesOpenVPNServerHostInfoIPv6) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesOpenVPNServerHostInfoIPv6.ProtoReflect.Descriptor instead.
+func (*ApiTypesOpenVPNServerHostInfoIPv6) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ApiTypesOpenVPNServerHostInfoIPv6) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+type ServiceTypesMultiHopExitServer_OpenVpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ExitSrvID (gateway ID) just in use to keep clients notified about connected MH exit server
+	// Example: "gateway":"zz.wg.ivpn.net" => "zz"
+	ExitSrvID string `protobuf:"bytes,1,opt,name=ExitSrvID,proto3" json:"ExitSrvID,omitempty"`
+	// Hosts of the exit server (the daemon validates them against the servers list).
+	// To connect to the specific exit host - define only this host.
+	Hosts []*ApiTypesOpenVPNServerHostInfo `protobuf:"bytes,2,rep,name=Hosts,proto3" json:"Hosts,omitempty"`
+	// (optional) Multihop port of the exit host: only the exit hosts with this 'multihop_port' are in use (0 - any host)
+	Port int64 `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
+}
+
+func (x *ServiceTypesMultiHopExitServer_OpenVpn) Reset() {
+	*x = ServiceTypesMultiHopExitServer_OpenVpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesMultiHopExitServer_OpenVpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesMultiHopExitServer_OpenVpn) ProtoMessage() {}
+
+func (x *ServiceTypesMultiHopExitServer_OpenVpn) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesMultiHopExitServer_OpenVpn.ProtoReflect.Descriptor instead.
+func (*ServiceTypesMultiHopExitServer_OpenVpn) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ServiceTypesMultiHopExitServer_OpenVpn) GetExitSrvID() string {
+	if x != nil {
+		return x.ExitSrvID
+	}
+	return ""
+}
+
+func (x *ServiceTypesMultiHopExitServer_OpenVpn) GetHosts() []*ApiTypesOpenVPNServerHostInfo {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+func (x *ServiceTypesMultiHopExitServer_OpenVpn) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// OpenVpnProxy - upstream proxy for OpenVPN connections (the OpenVPN traffic goes through this proxy)
+type ServiceTypesOpenVpnProxy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "http", "socks" (SOCKS5); empty - proxy not in use
+	Type string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	// IP address of the proxy server
+	Address string `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	Port    int64  `protobuf:"varint,3,opt,name=Port,proto3" json:"Port,omitempty"`
+	// (optional) proxy authentication
+	Username string `protobuf:"bytes,4,opt,name=Username,proto3" json:"Username,omitempty"`
+	Password string `protobuf:"bytes,5,opt,name=Password,proto3" json:"Password,omitempty"`
+}
+
+func (x *ServiceTypesOpenVpnProxy) Reset() {
+	*x = ServiceTypesOpenVpnProxy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesOpenVpnProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesOpenVpnProxy) ProtoMessage() {}
+
+func (x *ServiceTypesOpenVpnProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesOpenVpnProxy.ProtoReflect.Descriptor instead.
+func (*ServiceTypesOpenVpnProxy) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ServiceTypesOpenVpnProxy) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ServiceTypesOpenVpnProxy) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ServiceTypesOpenVpnProxy) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ServiceTypesOpenVpnProxy) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ServiceTypesOpenVpnProxy) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ServiceTypesConnectionParamsOpenVpnParametersPort struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Protocol int64 `protobuf:"varint,1,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	Port     int64 `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParametersPort) Reset() {
+	*x = ServiceTypesConnectionParamsOpenVpnParametersPort{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParametersPort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceTypesConnectionParamsOpenVpnParametersPort) ProtoMessage() {}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParametersPort) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceTypesConnectionParamsOpenVpnParametersPort.ProtoReflect.Descriptor instead.
+func (*ServiceTypesConnectionParamsOpenVpnParametersPort) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParametersPort) GetProtocol() int64 {
+	if x != nil {
+		return x.Protocol
+	}
+	return 0
+}
+
+func (x *ServiceTypesConnectionParamsOpenVpnParametersPort) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// ConnectionProfile - named connection configuration
+// (server, protocol, port, DNS, firewall options ... and obfuscation settings)
+type PreferencesConnectionProfile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string                        `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Params *ServiceTypesConnectionParams `protobuf:"bytes,2,opt,name=Params,proto3" json:"Params,omitempty"`
+	// obfsproxy configuration (applicable only for OpenVPN connections)
+	Obfs4Proxy *ObfsproxyConfig `protobuf:"bytes,3,opt,name=Obfs4proxy,proto3" json:"Obfs4proxy,omitempty"`
+}
+
+func (x *PreferencesConnectionProfile) Reset() {
+	*x = PreferencesConnectionProfile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesConnectionProfile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesConnectionProfile) ProtoMessage() {}
+
+func (x *PreferencesConnectionProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesConnectionProfile.ProtoReflect.Descriptor instead.
+func (*PreferencesConnectionProfile) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PreferencesConnectionProfile) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PreferencesConnectionProfile) GetParams() *ServiceTypesConnectionParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *PreferencesConnectionProfile) GetObfs4Proxy() *ObfsproxyConfig {
+	if x != nil {
+		return x.Obfs4Proxy
+	}
+	return nil
+}
+
+type PreferencesWiFiParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// CanApplyInBackground:
+	// 	false - means the daemon applies actions in background
+	// 	true - VPN connection and Firewall status can be changed ONLY when UI client is connected to the daemon (UI app is running)
+	CanApplyInBackground        bool `protobuf:"varint,1,opt,name=canApplyInBackground,proto3" json:"canApplyInBackground,omitempty"`
+	ConnectVPNOnInsecureNetwork bool `protobuf:"varint,2,opt,name=connectVPNOnInsecureNetwork,proto3" json:"connectVPNOnInsecureNetwork,omitempty"`
+	TrustedNetworksControl      bool `protobuf:"varint,3,opt,name=trustedNetworksControl,proto3" json:"trustedNetworksControl,omitempty"`
+	// nil - no trust action
+	DefaultTrustStatusTrusted *bool                     `protobuf:"varint,4,opt,name=defaultTrustStatusTrusted,proto3,oneof" json:"defaultTrustStatusTrusted,omitempty"`
+	Networks                  []*PreferencesWiFiNetwork `protobuf:"bytes,5,rep,name=networks,proto3" json:"networks,omitempty"`
+	Actions                   *PreferencesWiFiActions   `protobuf:"bytes,6,opt,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *PreferencesWiFiParams) Reset() {
+	*x = PreferencesWiFiParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesWiFiParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesWiFiParams) ProtoMessage() {}
+
+func (x *PreferencesWiFiParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesWiFiParams.ProtoReflect.Descriptor instead.
+func (*PreferencesWiFiParams) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PreferencesWiFiParams) GetCanApplyInBackground() bool {
+	if x != nil {
+		return x.CanApplyInBackground
+	}
+	return false
+}
+
+func (x *PreferencesWiFiParams) GetConnectVPNOnInsecureNetwork() bool {
+	if x != nil {
+		return x.ConnectVPNOnInsecureNetwork
+	}
+	return false
+}
+
+func (x *PreferencesWiFiParams) GetTrustedNetworksControl() bool {
+	if x != nil {
+		return x.TrustedNetworksControl
+	}
+	return false
+}
+
+func (x *PreferencesWiFiParams) GetDefaultTrustStatusTrusted() bool {
+	if x != nil && x.DefaultTrustStatusTrusted != nil {
+		return *x.DefaultTrustStatusTrusted
+	}
+	return false
+}
+
+func (x *PreferencesWiFiParams) GetNetworks() []*PreferencesWiFiNetwork {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+func (x *PreferencesWiFiParams) GetActions() *PreferencesWiFiActions {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type PreferencesWiFiNetwork struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ssid string `protobuf:"bytes,1,opt,name=ssid,proto3" json:"ssid,omitempty"`
+	// BSSID (MAC address of the access point; e.g. "aa:bb:cc:dd:ee:ff")
+	// If defined - the configuration is applicable only for the specific access point
+	Bssid     string `protobuf:"bytes,2,opt,name=bssid,proto3" json:"bssid,omitempty"`
+	IsTrusted bool   `protobuf:"varint,3,opt,name=isTrusted,proto3" json:"isTrusted,omitempty"`
+	// Actions for this network (nil - the default actions are in use: WiFiParams.Actions)
+	Actions *PreferencesWiFiActions `protobuf:"bytes,4,opt,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *PreferencesWiFiNetwork) Reset() {
+	*x = PreferencesWiFiNetwork{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesWiFiNetwork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesWiFiNetwork) ProtoMessage() {}
+
+func (x *PreferencesWiFiNetwork) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesWiFiNetwork.ProtoReflect.Descriptor instead.
+func (*PreferencesWiFiNetwork) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PreferencesWiFiNetwork) GetSsid() string {
+	if x != nil {
+		return x.Ssid
+	}
+	return ""
+}
+
+func (x *PreferencesWiFiNetwork) GetBssid() string {
+	if x != nil {
+		return x.Bssid
+	}
+	return ""
+}
+
+func (x *PreferencesWiFiNetwork) GetIsTrusted() bool {
+	if x != nil {
+		return x.IsTrusted
+	}
+	return false
+}
+
+func (x *PreferencesWiFiNetwork) GetActions() *PreferencesWiFiActions {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+// WiFiActions - actions to apply when joining trusted/untrusted network
+type PreferencesWiFiActions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnTrustedConnectVpn     bool `protobuf:"varint,1,opt,name=unTrustedConnectVpn,proto3" json:"unTrustedConnectVpn,omitempty"`
+	UnTrustedEnableFirewall bool `protobuf:"varint,2,opt,name=unTrustedEnableFirewall,proto3" json:"unTrustedEnableFirewall,omitempty"`
+	TrustedDisconnectVpn    bool `protobuf:"varint,3,opt,name=trustedDisconnectVpn,proto3" json:"trustedDisconnectVpn,omitempty"`
+	TrustedDisableFirewall  bool `protobuf:"varint,4,opt,name=trustedDisableFirewall,proto3" json:"trustedDisableFirewall,omitempty"`
+}
+
+func (x *PreferencesWiFiActions) Reset() {
+	*x = PreferencesWiFiActions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesWiFiActions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesWiFiActions) ProtoMessage() {}
+
+func (x *PreferencesWiFiActions) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesWiFiActions.ProtoReflect.Descriptor instead.
+func (*PreferencesWiFiActions) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *PreferencesWiFiActions) GetUnTrustedConnectVpn() bool {
+	if x != nil {
+		return x.UnTrustedConnectVpn
+	}
+	return false
+}
+
+func (x *PreferencesWiFiActions) GetUnTrustedEnableFirewall() bool {
+	if x != nil {
+		return x.UnTrustedEnableFirewall
+	}
+	return false
+}
+
+func (x *PreferencesWiFiActions) GetTrustedDisconnectVpn() bool {
+	if x != nil {
+		return x.TrustedDisconnectVpn
+	}
+	return false
+}
+
+func (x *PreferencesWiFiActions) GetTrustedDisableFirewall() bool {
+	if x != nil {
+		return x.TrustedDisableFirewall
+	}
+	return false
+}
+
+// Config - user-defined Shadowsocks server configuration
+type ShadowsocksConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IPv4 address of the Shadowsocks server (empty - Shadowsocks is not in use)
+	Server string `protobuf:"bytes,1,opt,name=Server,proto3" json:"Server,omitempty"`
+	Port   int64  `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+	// encryption method (see SupportedMethods)
+	Method   string `protobuf:"bytes,3,opt,name=Method,proto3" json:"Method,omitempty"`
+	Password string `protobuf:"bytes,4,opt,name=Password,proto3" json:"Password,omitempty"`
+}
+
+func (x *ShadowsocksConfig) Reset() {
+	*x = ShadowsocksConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShadowsocksConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShadowsocksConfig) ProtoMessage() {}
+
+func (x *ShadowsocksConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShadowsocksConfig.ProtoReflect.Descriptor instead.
+func (*ShadowsocksConfig) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ShadowsocksConfig) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *ShadowsocksConfig) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ShadowsocksConfig) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *ShadowsocksConfig) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// Config - AmneziaWG obfuscation parameters.
+// Jc, Jmin, Jmax are applied to the client side only.
+// S1, S2 and H1-H4 must be the same as on the server side.
+// Empty configuration - AmneziaWG is not in use (vanilla WireGuard).
+type AwgConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of junk packets sent before the handshake
+	Jc int64 `protobuf:"varint,1,opt,name=Jc,proto3" json:"Jc,omitempty"`
+	// min size of the junk packet
+	Jmin int64 `protobuf:"varint,2,opt,name=Jmin,proto3" json:"Jmin,omitempty"`
+	// max size of the junk packet
+	Jmax int64 `protobuf:"varint,3,opt,name=Jmax,proto3" json:"Jmax,omitempty"`
+	// size of the random padding of the handshake initiation message
+	S1 int64 `protobuf:"varint,4,opt,name=S1,proto3" json:"S1,omitempty"`
+	// size of the random padding of the handshake response message
+	S2 int64 `protobuf:"varint,5,opt,name=S2,proto3" json:"S2,omitempty"`
+	// Headers of the handshake initiation, handshake response, cookie and transport messages (0 - default header)
+	H1 uint32 `protobuf:"varint,6,opt,name=H1,proto3" json:"H1,omitempty"`
+	// Headers of the handshake initiation, handshake response, cookie and transport messages (0 - default header)
+	H2 uint32 `protobuf:"varint,7,opt,name=H2,proto3" json:"H2,omitempty"`
+	// Headers of the handshake initiation, handshake response, cookie and transport messages (0 - default header)
+	H3 uint32 `protobuf:"varint,8,opt,name=H3,proto3" json:"H3,omitempty"`
+	// Headers of the handshake initiation, handshake response, cookie and transport messages (0 - default header)
+	H4 uint32 `protobuf:"varint,9,opt,name=H4,proto3" json:"H4,omitempty"`
+}
+
+func (x *AwgConfig) Reset() {
+	*x = AwgConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AwgConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AwgConfig) ProtoMessage() {}
+
+func (x *AwgConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AwgConfig.ProtoReflect.Descriptor instead.
+func (*AwgConfig) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *AwgConfig) GetJc() int64 {
+	if x != nil {
+		return x.Jc
+	}
+	return 0
+}
+
+func (x *AwgConfig) GetJmin() int64 {
+	if x != nil {
+		return x.Jmin
+	}
+	return 0
+}
+
+func (x *AwgConfig) GetJmax() int64 {
+	if x != nil {
+		return x.Jmax
+	}
+	return 0
+}
+
+func (x *AwgConfig) GetS1() int64 {
+	if x != nil {
+		return x.S1
+	}
+	return 0
+}
+
+func (x *AwgConfig) GetS2() int64 {
+	if x != nil {
+		return x.S2
+	}
+	return 0
+}
+
+func (x *AwgConfig) GetH1() uint32 {
+	if x != nil {
+		return x.H1
+	}
+	return 0
+}
+
+func (x *AwgConfig) GetH2() uint32 {
+	if x != nil {
+		return x.H2
+	}
+	return 0
+}
+
+func (x *AwgConfig) GetH3() uint32 {
+	if x != nil {
+		return x.H3
+	}
+	return 0
+}
+
+func (x *AwgConfig) GetH4() uint32 {
+	if x != nil {
+		return x.H4
+	}
+	return 0
+}
+
+// SetShadowsocksProxy sets user-defined Shadowsocks server to chain VPN connections through (empty configuration - disable Shadowsocks)
+type SetShadowsocksProxy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string             `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Config         *ShadowsocksConfig `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
+}
+
+func (x *SetShadowsocksProxy) Reset() {
+	*x = SetShadowsocksProxy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetShadowsocksProxy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShadowsocksProxy) ProtoMessage() {}
+
+func (x *SetShadowsocksProxy) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShadowsocksProxy.ProtoReflect.Descriptor instead.
+func (*SetShadowsocksProxy) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *SetShadowsocksProxy) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetShadowsocksProxy) GetConfig() *ShadowsocksConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// SetAmneziaWG sets AmneziaWG obfuscation parameters for WireGuard connections (empty configuration - vanilla WireGuard)
+type SetAmneziaWG struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string     `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Config         *AwgConfig `protobuf:"bytes,2,opt,name=Config,proto3" json:"Config,omitempty"`
+}
+
+func (x *SetAmneziaWG) Reset() {
+	*x = SetAmneziaWG{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAmneziaWG) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAmneziaWG) ProtoMessage() {}
+
+func (x *SetAmneziaWG) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAmneziaWG.ProtoReflect.Descriptor instead.
+func (*SetAmneziaWG) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetAmneziaWG) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetAmneziaWG) GetConfig() *AwgConfig {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// SetUserPreferences sets daemon configuration parameters (the 'SettingsResp' is in use to send this settings to client)
+type SetUserPreferences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                      `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	UserPrefs      *PreferencesUserPreferences `protobuf:"bytes,2,opt,name=UserPrefs,proto3" json:"UserPrefs,omitempty"`
+}
+
+func (x *SetUserPreferences) Reset() {
+	*x = SetUserPreferences{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetUserPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserPreferences) ProtoMessage() {}
+
+func (x *SetUserPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserPreferences.ProtoReflect.Descriptor instead.
+func (*SetUserPreferences) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SetUserPreferences) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetUserPreferences) GetUserPrefs() *PreferencesUserPreferences {
+	if x != nil {
+		return x.UserPrefs
+	}
+	return nil
+}
+
+// UserPreferences - IVPN service preferences which can be exposed to client
+type PreferencesUserPreferences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The platform-specific preferences
+	Linux *PreferencesLinuxSpecificUserPrefs `protobuf:"bytes,1,opt,name=Linux,proto3" json:"Linux,omitempty"`
+}
+
+func (x *PreferencesUserPreferences) Reset() {
+	*x = PreferencesUserPreferences{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesUserPreferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesUserPreferences) ProtoMessage() {}
+
+func (x *PreferencesUserPreferences) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesUserPreferences.ProtoReflect.Descriptor instead.
+func (*PreferencesUserPreferences) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *PreferencesUserPreferences) GetLinux() *PreferencesLinuxSpecificUserPrefs {
+	if x != nil {
+		return x.Linux
+	}
+	return nil
+}
+
+type PreferencesLinuxSpecificUserPrefs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If true - use old style DNS management mechanism
+	// by direct modifying file '/etc/resolv.conf'
+	IsDnsMgmtOldStyle bool `protobuf:"varint,1,opt,name=IsDnsMgmtOldStyle,proto3" json:"IsDnsMgmtOldStyle,omitempty"`
+}
+
+func (x *PreferencesLinuxSpecificUserPrefs) Reset() {
+	*x = PreferencesLinuxSpecificUserPrefs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesLinuxSpecificUserPrefs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesLinuxSpecificUserPrefs) ProtoMessage() {}
+
+func (x *PreferencesLinuxSpecificUserPrefs) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesLinuxSpecificUserPrefs.ProtoReflect.Descriptor instead.
+func (*PreferencesLinuxSpecificUserPrefs) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *PreferencesLinuxSpecificUserPrefs) GetIsDnsMgmtOldStyle() bool {
+	if x != nil {
+		return x.IsDnsMgmtOldStyle
+	}
+	return false
+}
+
+// GetSplitTunnelStatus (request) requests the Split-Tunnelling configuration
+type SplitTunnelGetStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *SplitTunnelGetStatus) Reset() {
+	*x = SplitTunnelGetStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnelGetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelGetStatus) ProtoMessage() {}
+
+func (x *SplitTunnelGetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelGetStatus.ProtoReflect.Descriptor instead.
+func (*SplitTunnelGetStatus) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *SplitTunnelGetStatus) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// SplitTunnelSet (request) sets the split-tunnelling configuration
+type SplitTunnelSetConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// is ST enabled
+	IsEnabled bool `protobuf:"varint,2,opt,name=IsEnabled,proto3" json:"IsEnabled,omitempty"`
+	// disable ST and erase all ST config
+	Reset_ bool `protobuf:"varint,3,opt,name=Reset,proto3" json:"Reset,omitempty"`
+}
+
+func (x *SplitTunnelSetConfig) Reset() {
+	*x = SplitTunnelSetConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnelSetConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelSetConfig) ProtoMessage() {}
+
+func (x *SplitTunnelSetConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelSetConfig.ProtoReflect.Descriptor instead.
+func (*SplitTunnelSetConfig) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *SplitTunnelSetConfig) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SplitTunnelSetConfig) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *SplitTunnelSetConfig) GetReset_() bool {
+	if x != nil {
+		return x.Reset_
+	}
+	return false
+}
+
+// SplitTunnelSetDestinations (request) sets destinations (IP addresses, networks, domain names)
+// to be excluded from (or included into) the VPN tunnel. The previous destinations are replaced.
+// Expected response: SplitTunnelStatus (all clients are notified about configuration change)
+type SplitTunnelSetDestinations struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                 `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Destinations   []*SplittunDestination `protobuf:"bytes,2,rep,name=Destinations,proto3" json:"Destinations,omitempty"`
+}
+
+func (x *SplitTunnelSetDestinations) Reset() {
+	*x = SplitTunnelSetDestinations{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnelSetDestinations) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelSetDestinations) ProtoMessage() {}
+
+func (x *SplitTunnelSetDestinations) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelSetDestinations.ProtoReflect.Descriptor instead.
+func (*SplitTunnelSetDestinations) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *SplitTunnelSetDestinations) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SplitTunnelSetDestinations) GetDestinations() []*SplittunDestination {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+// Destination - destination rule for Split Tunneling
+type SplittunDestination struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IP address (e.g. "1.1.1.1"), network in CIDR notation (e.g. "1.1.1.0/24") or domain name (e.g. "example.com")
+	Address string `protobuf:"bytes,1,opt,name=Address,proto3" json:"Address,omitempty"`
+	// false - the traffic to the destination bypasses the VPN tunnel (excluded)
+	// true  - the traffic to the destination is forced into the VPN tunnel (even for excluded applications)
+	IsInclude bool `protobuf:"varint,2,opt,name=IsInclude,proto3" json:"IsInclude,omitempty"`
+}
+
+func (x *SplittunDestination) Reset() {
+	*x = SplittunDestination{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplittunDestination) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplittunDestination) ProtoMessage() {}
+
+func (x *SplittunDestination) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplittunDestination.ProtoReflect.Descriptor instead.
+func (*SplittunDestination) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *SplittunDestination) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *SplittunDestination) GetIsInclude() bool {
+	if x != nil {
+		return x.IsInclude
+	}
+	return false
+}
+
+// SplitTunnelSetContainers (request) sets containers (Docker container names/IDs or cgroup-v2 paths)
+// to be excluded from (or included into) the VPN tunnel. The previous containers are replaced.
+// Expected response: SplitTunnelStatus (all clients are notified about configuration change)
+type SplitTunnelSetContainers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string               `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Containers     []*SplittunContainer `protobuf:"bytes,2,rep,name=Containers,proto3" json:"Containers,omitempty"`
+}
+
+func (x *SplitTunnelSetContainers) Reset() {
+	*x = SplitTunnelSetContainers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnelSetContainers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelSetContainers) ProtoMessage() {}
+
+func (x *SplitTunnelSetContainers) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelSetContainers.ProtoReflect.Descriptor instead.
+func (*SplitTunnelSetContainers) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *SplitTunnelSetContainers) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SplitTunnelSetContainers) GetContainers() []*SplittunContainer {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+// Container - container rule for Split Tunneling (applicable for Linux with cgroup-v2)
+type SplittunContainer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Container name or ID (e.g. "my_container"; resolved by Docker)
+	// or cgroup-v2 path of the container (e.g. "/system.slice/docker-<ID>.scope")
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// false - the traffic of the container bypasses the VPN tunnel (excluded)
+	// true  - the traffic of the container is forced into the VPN tunnel (even to excluded destinations)
+	IsInclude bool `protobuf:"varint,2,opt,name=IsInclude,proto3" json:"IsInclude,omitempty"`
+}
+
+func (x *SplittunContainer) Reset() {
+	*x = SplittunContainer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplittunContainer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplittunContainer) ProtoMessage() {}
+
+func (x *SplittunContainer) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplittunContainer.ProtoReflect.Descriptor instead.
+func (*SplittunContainer) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *SplittunContainer) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SplittunContainer) GetIsInclude() bool {
+	if x != nil {
+		return x.IsInclude
+	}
+	return false
+}
+
+// SplitTunnelSetUsers (request) sets users (user names or UIDs) which traffic
+// to be excluded from (or included into) the VPN tunnel. The previous users are replaced.
+// Expected response: SplitTunnelStatus (all clients are notified about configuration change)
+type SplitTunnelSetUsers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string          `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Users          []*SplittunUser `protobuf:"bytes,2,rep,name=Users,proto3" json:"Users,omitempty"`
+}
+
+func (x *SplitTunnelSetUsers) Reset() {
+	*x = SplitTunnelSetUsers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnelSetUsers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelSetUsers) ProtoMessage() {}
+
+func (x *SplitTunnelSetUsers) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelSetUsers.ProtoReflect.Descriptor instead.
+func (*SplitTunnelSetUsers) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *SplitTunnelSetUsers) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SplitTunnelSetUsers) GetUsers() []*SplittunUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// User - user rule for Split Tunneling (applicable for Linux)
+type SplittunUser struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// User name (e.g. "transmission") or UID (e.g. "1001")
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// false - the traffic of the user's processes bypasses the VPN tunnel (excluded)
+	// true  - the traffic of the user's processes is forced into the VPN tunnel (even to excluded destinations)
+	IsInclude bool `protobuf:"varint,2,opt,name=IsInclude,proto3" json:"IsInclude,omitempty"`
+}
+
+func (x *SplittunUser) Reset() {
+	*x = SplittunUser{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplittunUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplittunUser) ProtoMessage() {}
+
+func (x *SplittunUser) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplittunUser.ProtoReflect.Descriptor instead.
+func (*SplittunUser) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SplittunUser) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SplittunUser) GetIsInclude() bool {
+	if x != nil {
+		return x.IsInclude
+	}
+	return false
+}
+
+// SplitTunnelAddApp (request) add application to SplitTunneling
+// Expected response:
+//
+//			Windows	- types.EmptyResp (success)
+//	 	Linux	- SplitTunnelAddAppCmdResp -> contains shell command which have to be executed in user space environment
+//
+// Description of Split Tunneling commands sequence to run the application:
+//
+//		[client]					[daemon]
+//		SplitTunnelAddApp		->
+//								<-	windows:	types.EmptyResp (success)
+//								<-	linux:		types.SplitTunnelAddAppCmdResp (some operations required on client side)
+//		<windows: done>
+//		<execute shell command: types.SplitTunnelAddAppCmdResp.CmdToExecute and get PID>
+//	 SplitTunnelAddedPidInfo	->
+//								<-	types.EmptyResp (success)
+type SplitTunnelAddApp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// Windows: full path to the app binary
+	// Linux: command to be executed in ST environment (e.g. binary + arguments)
+	Exec string `protobuf:"bytes,2,opt,name=Exec,proto3" json:"Exec,omitempty"`
+}
+
+func (x *SplitTunnelAddApp) Reset() {
+	*x = SplitTunnelAddApp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnelAddApp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelAddApp) ProtoMessage() {}
+
+func (x *SplitTunnelAddApp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelAddApp.ProtoReflect.Descriptor instead.
+func (*SplitTunnelAddApp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *SplitTunnelAddApp) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SplitTunnelAddApp) GetExec() string {
+	if x != nil {
+		return x.Exec
+	}
+	return ""
+}
+
+type SplitTunnelRemoveApp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// (applicable for Linux) PID of the running process in ST environment
+	Pid int64 `protobuf:"varint,2,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	// (applicable for Windows) full path to the app binary to be excluded from ST
+	Exec string `protobuf:"bytes,3,opt,name=Exec,proto3" json:"Exec,omitempty"`
+}
+
+func (x *SplitTunnelRemoveApp) Reset() {
+	*x = SplitTunnelRemoveApp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnelRemoveApp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelRemoveApp) ProtoMessage() {}
+
+func (x *SplitTunnelRemoveApp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelRemoveApp.ProtoReflect.Descriptor instead.
+func (*SplitTunnelRemoveApp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *SplitTunnelRemoveApp) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SplitTunnelRemoveApp) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *SplitTunnelRemoveApp) GetExec() string {
+	if x != nil {
+		return x.Exec
+	}
+	return ""
+}
+
+// SplitTunnelAddedPidInfo (request) informs the daemon about started process in ST environment
+// (not in use for Windows platform)
+type SplitTunnelAddedPidInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Pid            int64  `protobuf:"varint,2,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	// Command will be executed in ST environment (e.g. binary + arguments)
+	// (identical to SplitTunnelAddApp.Exec and SplitTunnelAddAppCmdResp.Exec)
+	Exec string `protobuf:"bytes,3,opt,name=Exec,proto3" json:"Exec,omitempty"`
+	// Shell command used to perform this operation
+	CmdToExecute string `protobuf:"bytes,4,opt,name=CmdToExecute,proto3" json:"CmdToExecute,omitempty"`
+}
+
+func (x *SplitTunnelAddedPidInfo) Reset() {
+	*x = SplitTunnelAddedPidInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnelAddedPidInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelAddedPidInfo) ProtoMessage() {}
+
+func (x *SplitTunnelAddedPidInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelAddedPidInfo.ProtoReflect.Descriptor instead.
+func (*SplitTunnelAddedPidInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SplitTunnelAddedPidInfo) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SplitTunnelAddedPidInfo) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *SplitTunnelAddedPidInfo) GetExec() string {
+	if x != nil {
+		return x.Exec
+	}
+	return ""
+}
+
+func (x *SplitTunnelAddedPidInfo) GetCmdToExecute() string {
+	if x != nil {
+		return x.CmdToExecute
+	}
+	return ""
+}
+
+// GenerateDiagnostics request (has no parameters)
+type GenerateDiagnostics struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *GenerateDiagnostics) Reset() {
+	*x = GenerateDiagnostics{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateDiagnostics) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateDiagnostics) ProtoMessage() {}
+
+func (x *GenerateDiagnostics) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateDiagnostics.ProtoReflect.Descriptor instead.
+func (*GenerateDiagnostics) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *GenerateDiagnostics) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// PortForwardingGetStatus request to get the state of the forwarded port (PortForwardingStatusResp)
+type PortForwardingGetStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *PortForwardingGetStatus) Reset() {
+	*x = PortForwardingGetStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortForwardingGetStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortForwardingGetStatus) ProtoMessage() {}
+
+func (x *PortForwardingGetStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortForwardingGetStatus.ProtoReflect.Descriptor instead.
+func (*PortForwardingGetStatus) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *PortForwardingGetStatus) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// PortForwardingRequest request to get the forwarded port for the current VPN connection (PortForwardingStatusResp).
+// The port is renewed by the daemon until it is released or VPN disconnected.
+type PortForwardingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *PortForwardingRequest) Reset() {
+	*x = PortForwardingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortForwardingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortForwardingRequest) ProtoMessage() {}
+
+func (x *PortForwardingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortForwardingRequest.ProtoReflect.Descriptor instead.
+func (*PortForwardingRequest) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *PortForwardingRequest) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// PortForwardingRelease request to release the forwarded port
+type PortForwardingRelease struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *PortForwardingRelease) Reset() {
+	*x = PortForwardingRelease{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortForwardingRelease) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortForwardingRelease) ProtoMessage() {}
+
+func (x *PortForwardingRelease) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortForwardingRelease.ProtoReflect.Descriptor instead.
+func (*PortForwardingRelease) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *PortForwardingRelease) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// CaptivePortalCheck request to check if the internet access is restricted by a captive portal (CaptivePortalStatusResp)
+type CaptivePortalCheck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *CaptivePortalCheck) Reset() {
+	*x = CaptivePortalCheck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptivePortalCheck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptivePortalCheck) ProtoMessage() {}
+
+func (x *CaptivePortalCheck) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptivePortalCheck.ProtoReflect.Descriptor instead.
+func (*CaptivePortalCheck) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *CaptivePortalCheck) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// CaptivePortalAllowLogin request to disable the firewall temporarily for the captive portal login (CaptivePortalStatusResp).
+// The firewall is enabled back automatically when the time elapsed. The VPN must be disconnected.
+type CaptivePortalAllowLogin struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// time (seconds) while the firewall is disabled (0 - default time)
+	DurationSec int64 `protobuf:"varint,2,opt,name=DurationSec,proto3" json:"DurationSec,omitempty"`
+}
+
+func (x *CaptivePortalAllowLogin) Reset() {
+	*x = CaptivePortalAllowLogin{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptivePortalAllowLogin) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptivePortalAllowLogin) ProtoMessage() {}
+
+func (x *CaptivePortalAllowLogin) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptivePortalAllowLogin.ProtoReflect.Descriptor instead.
+func (*CaptivePortalAllowLogin) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *CaptivePortalAllowLogin) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *CaptivePortalAllowLogin) GetDurationSec() int64 {
+	if x != nil {
+		return x.DurationSec
+	}
+	return 0
+}
+
+// CaptivePortalReLock request to enable the firewall back after the captive portal login (CaptivePortalStatusResp)
+type CaptivePortalReLock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *CaptivePortalReLock) Reset() {
+	*x = CaptivePortalReLock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptivePortalReLock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptivePortalReLock) ProtoMessage() {}
+
+func (x *CaptivePortalReLock) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptivePortalReLock.ProtoReflect.Descriptor instead.
+func (*CaptivePortalReLock) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *CaptivePortalReLock) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// SetAlternateDns request to set custom DNS
+type SetAlternateDns struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                           `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	AntiTracker    *ServiceTypesAntiTrackerMetadata `protobuf:"bytes,2,opt,name=AntiTracker,proto3" json:"AntiTracker,omitempty"`
+	// If 'AntiTracker' is enabled - his parameter will be ignored
+	Dns *DnsDnsSettings `protobuf:"bytes,3,opt,name=Dns,proto3" json:"Dns,omitempty"`
+}
+
+func (x *SetAlternateDns) Reset() {
+	*x = SetAlternateDns{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAlternateDns) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAlternateDns) ProtoMessage() {}
+
+func (x *SetAlternateDns) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAlternateDns.ProtoReflect.Descriptor instead.
+func (*SetAlternateDns) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *SetAlternateDns) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SetAlternateDns) GetAntiTracker() *ServiceTypesAntiTrackerMetadata {
+	if x != nil {
+		return x.AntiTracker
+	}
+	return nil
+}
+
+func (x *SetAlternateDns) GetDns() *DnsDnsSettings {
+	if x != nil {
+		return x.Dns
+	}
+	return nil
+}
+
+// GetDnsPredefinedConfigs request to get list of predefined DoH/DoT configurations (if exists)
+type GetDnsPredefinedConfigs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *GetDnsPredefinedConfigs) Reset() {
+	*x = GetDnsPredefinedConfigs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDnsPredefinedConfigs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDnsPredefinedConfigs) ProtoMessage() {}
+
+func (x *GetDnsPredefinedConfigs) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDnsPredefinedConfigs.ProtoReflect.Descriptor instead.
+func (*GetDnsPredefinedConfigs) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *GetDnsPredefinedConfigs) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// PauseConnection request (has no parameters)
+type PauseConnection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *PauseConnection) Reset() {
+	*x = PauseConnection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauseConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseConnection) ProtoMessage() {}
+
+func (x *PauseConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseConnection.ProtoReflect.Descriptor instead.
+func (*PauseConnection) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *PauseConnection) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// ResumeConnection request (has no parameters)
+type ResumeConnection struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *ResumeConnection) Reset() {
+	*x = ResumeConnection{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResumeConnection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeConnection) ProtoMessage() {}
+
+func (x *ResumeConnection) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeConnection.ProtoReflect.Descriptor instead.
+func (*ResumeConnection) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *ResumeConnection) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// SessionNew - create new session
+//
+// When force is set to true - all active sessions will be deleted prior to creating a new one if user reached session limit.
+// Initial call to /sessin/new should always be performed with force set to false, to display special form, when sessions limit is reached.
+// IVPN client apps have to set force to true only when customer clicks Log all other clients button.
+//
+// If the login requires additional confirmation (see SessionNewResp.Is2FARequired, SessionNewResp.IsCaptchaRequired),
+// the request has to be repeated with the same AccountID and the required fields:
+// Confirmation2FA - the TOTP token; CaptchaID and Captcha - the ID of the received captcha and the user's solution.
+// Both captcha and 2FA token can be required at the same time.
+type SessionNew struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	AccountID      string `protobuf:"bytes,2,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	ForceLogin     bool   `protobuf:"varint,3,opt,name=ForceLogin,proto3" json:"ForceLogin,omitempty"`
+	// Keep the active session of another account logged-in (it is possible to switch back to it: see AccountSwitch)
+	KeepCurrentAccount bool   `protobuf:"varint,4,opt,name=KeepCurrentAccount,proto3" json:"KeepCurrentAccount,omitempty"`
+	CaptchaID          string `protobuf:"bytes,5,opt,name=CaptchaID,proto3" json:"CaptchaID,omitempty"`
+	Captcha            string `protobuf:"bytes,6,opt,name=Captcha,proto3" json:"Captcha,omitempty"`
+	Confirmation2FA    string `protobuf:"bytes,7,opt,name=Confirmation2FA,proto3" json:"Confirmation2FA,omitempty"`
+}
+
+func (x *SessionNew) Reset() {
+	*x = SessionNew{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionNew) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionNew) ProtoMessage() {}
+
+func (x *SessionNew) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionNew.ProtoReflect.Descriptor instead.
+func (*SessionNew) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SessionNew) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SessionNew) GetAccountID() string {
+	if x != nil {
+		return x.AccountID
+	}
+	return ""
+}
+
+func (x *SessionNew) GetForceLogin() bool {
+	if x != nil {
+		return x.ForceLogin
+	}
+	return false
+}
+
+func (x *SessionNew) GetKeepCurrentAccount() bool {
+	if x != nil {
+		return x.KeepCurrentAccount
+	}
+	return false
+}
+
+func (x *SessionNew) GetCaptchaID() string {
+	if x != nil {
+		return x.CaptchaID
+	}
+	return ""
+}
+
+func (x *SessionNew) GetCaptcha() string {
+	if x != nil {
+		return x.Captcha
+	}
+	return ""
+}
+
+func (x *SessionNew) GetConfirmation2FA() string {
+	if x != nil {
+		return x.Confirmation2FA
+	}
+	return ""
+}
+
+// SessionDelete logout from current device
+type SessionDelete struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret        string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	NeedToResetSettings   bool   `protobuf:"varint,2,opt,name=NeedToResetSettings,proto3" json:"NeedToResetSettings,omitempty"`
+	NeedToDisableFirewall bool   `protobuf:"varint,3,opt,name=NeedToDisableFirewall,proto3" json:"NeedToDisableFirewall,omitempty"`
+	// If IsCanDeleteSessionLocally==true: the account will be logged out
+	// even if there is no connectivity to API server
+	IsCanDeleteSessionLocally bool `protobuf:"varint,4,opt,name=IsCanDeleteSessionLocally,proto3" json:"IsCanDeleteSessionLocally,omitempty"`
+}
+
+func (x *SessionDelete) Reset() {
+	*x = SessionDelete{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionDelete) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionDelete) ProtoMessage() {}
+
+func (x *SessionDelete) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionDelete.ProtoReflect.Descriptor instead.
+func (*SessionDelete) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SessionDelete) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SessionDelete) GetNeedToResetSettings() bool {
+	if x != nil {
+		return x.NeedToResetSettings
+	}
+	return false
+}
+
+func (x *SessionDelete) GetNeedToDisableFirewall() bool {
+	if x != nil {
+		return x.NeedToDisableFirewall
+	}
+	return false
+}
+
+func (x *SessionDelete) GetIsCanDeleteSessionLocally() bool {
+	if x != nil {
+		return x.IsCanDeleteSessionLocally
+	}
+	return false
+}
+
+// DevicesList - get the devices (active sessions) of the account
+// If AccountID is empty - the current session is used for authentication (the user must be logged in).
+// Otherwise, the AccountID (and Confirmation2FA, if 2FA is enabled for the account) is used
+// (e.g. to resolve the 'too many devices' error during login)
+type DevicesList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret  string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	AccountID       string `protobuf:"bytes,2,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	Confirmation2FA string `protobuf:"bytes,3,opt,name=Confirmation2FA,proto3" json:"Confirmation2FA,omitempty"`
+}
+
+func (x *DevicesList) Reset() {
+	*x = DevicesList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DevicesList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DevicesList) ProtoMessage() {}
+
+func (x *DevicesList) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DevicesList.ProtoReflect.Descriptor instead.
+func (*DevicesList) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *DevicesList) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *DevicesList) GetAccountID() string {
+	if x != nil {
+		return x.AccountID
+	}
+	return ""
+}
+
+func (x *DevicesList) GetConfirmation2FA() string {
+	if x != nil {
+		return x.Confirmation2FA
+	}
+	return ""
+}
+
+// DeviceLogout - log out the device of the account (authentication: the same as for DevicesList)
+type DeviceLogout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret  string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	AccountID       string `protobuf:"bytes,2,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	Confirmation2FA string `protobuf:"bytes,3,opt,name=Confirmation2FA,proto3" json:"Confirmation2FA,omitempty"`
+	DeviceID        string `protobuf:"bytes,4,opt,name=DeviceID,proto3" json:"DeviceID,omitempty"`
+}
+
+func (x *DeviceLogout) Reset() {
+	*x = DeviceLogout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceLogout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceLogout) ProtoMessage() {}
+
+func (x *DeviceLogout) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceLogout.ProtoReflect.Descriptor instead.
+func (*DeviceLogout) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *DeviceLogout) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *DeviceLogout) GetAccountID() string {
+	if x != nil {
+		return x.AccountID
+	}
+	return ""
+}
+
+func (x *DeviceLogout) GetConfirmation2FA() string {
+	if x != nil {
+		return x.Confirmation2FA
+	}
+	return ""
+}
+
+func (x *DeviceLogout) GetDeviceID() string {
+	if x != nil {
+		return x.DeviceID
+	}
+	return ""
+}
+
+// AccountsGet requests the list of logged-in accounts (AccountsResp)
+type AccountsGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *AccountsGet) Reset() {
+	*x = AccountsGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountsGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountsGet) ProtoMessage() {}
+
+func (x *AccountsGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountsGet.ProtoReflect.Descriptor instead.
+func (*AccountsGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *AccountsGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// AccountSwitch makes the logged-in (but not active) account active without logout/login
+type AccountSwitch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	AccountID      string `protobuf:"bytes,2,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+}
+
+func (x *AccountSwitch) Reset() {
+	*x = AccountSwitch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountSwitch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountSwitch) ProtoMessage() {}
+
+func (x *AccountSwitch) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountSwitch.ProtoReflect.Descriptor instead.
+func (*AccountSwitch) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *AccountSwitch) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *AccountSwitch) GetAccountID() string {
+	if x != nil {
+		return x.AccountID
+	}
+	return ""
+}
+
+// AccountRemove logs out the logged-in account which is not active
+type AccountRemove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	AccountID      string `protobuf:"bytes,2,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+}
+
+func (x *AccountRemove) Reset() {
+	*x = AccountRemove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountRemove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRemove) ProtoMessage() {}
+
+func (x *AccountRemove) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRemove.ProtoReflect.Descriptor instead.
+func (*AccountRemove) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *AccountRemove) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *AccountRemove) GetAccountID() string {
+	if x != nil {
+		return x.AccountID
+	}
+	return ""
+}
+
+// AccountStatus get account status
+type AccountStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *AccountStatus) Reset() {
+	*x = AccountStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStatus) ProtoMessage() {}
+
+func (x *AccountStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStatus.ProtoReflect.Descriptor instead.
+func (*AccountStatus) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *AccountStatus) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// WireGuardGenerateNewKeys - generate WG keys
+type WireGuardGenerateNewKeys struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret        string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	OnlyUpdateIfNecessary bool   `protobuf:"varint,2,opt,name=OnlyUpdateIfNecessary,proto3" json:"OnlyUpdateIfNecessary,omitempty"`
+}
+
+func (x *WireGuardGenerateNewKeys) Reset() {
+	*x = WireGuardGenerateNewKeys{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WireGuardGenerateNewKeys) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireGuardGenerateNewKeys) ProtoMessage() {}
+
+func (x *WireGuardGenerateNewKeys) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireGuardGenerateNewKeys.ProtoReflect.Descriptor instead.
+func (*WireGuardGenerateNewKeys) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *WireGuardGenerateNewKeys) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *WireGuardGenerateNewKeys) GetOnlyUpdateIfNecessary() bool {
+	if x != nil {
+		return x.OnlyUpdateIfNecessary
+	}
+	return false
+}
+
+// WireGuardSetKeysRotationInterval -  change WG keys rotation interval
+type WireGuardSetKeysRotationInterval struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Interval       int64  `protobuf:"varint,2,opt,name=Interval,proto3" json:"Interval,omitempty"`
+}
+
+func (x *WireGuardSetKeysRotationInterval) Reset() {
+	*x = WireGuardSetKeysRotationInterval{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WireGuardSetKeysRotationInterval) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WireGuardSetKeysRotationInterval) ProtoMessage() {}
+
+func (x *WireGuardSetKeysRotationInterval) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WireGuardSetKeysRotationInterval.ProtoReflect.Descriptor instead.
+func (*WireGuardSetKeysRotationInterval) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *WireGuardSetKeysRotationInterval) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *WireGuardSetKeysRotationInterval) GetInterval() int64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+// GetAppIcon (request) requests shell icon for binary file (application)
+// Note: ensure if SplitTunnelStatus.IsCanGetAppIconForBinary is active
+type GetAppIcon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	AppBinaryPath  string `protobuf:"bytes,2,opt,name=AppBinaryPath,proto3" json:"AppBinaryPath,omitempty"`
+}
+
+func (x *GetAppIcon) Reset() {
+	*x = GetAppIcon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAppIcon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAppIcon) ProtoMessage() {}
+
+func (x *GetAppIcon) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAppIcon.ProtoReflect.Descriptor instead.
+func (*GetAppIcon) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *GetAppIcon) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *GetAppIcon) GetAppBinaryPath() string {
+	if x != nil {
+		return x.AppBinaryPath
+	}
+	return ""
+}
+
+// GetInstalledApps (request) requests information about installed applications on the system
+type GetInstalledApps struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// (optional) Platform-depended: extra parameters (in JSON)
+	// For Windows:
+	// 			WindowsEnvAppdata 	string
+	// 				Applicable only for Windows: APPDATA environment variable
+	// 				Needed to know path of current user's (not root) StartMenu folder location
+	// For Linux:
+	// 			EnvVar_XDG_CURRENT_DESKTOP string
+	// 			EnvVar_XDG_DATA_DIRS       string
+	// 			EnvVar_HOME                string
+	// 			IconsTheme                 string
+	ExtraArgsJSON string `protobuf:"bytes,2,opt,name=ExtraArgsJSON,proto3" json:"ExtraArgsJSON,omitempty"`
+}
+
+func (x *GetInstalledApps) Reset() {
+	*x = GetInstalledApps{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInstalledApps) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInstalledApps) ProtoMessage() {}
+
+func (x *GetInstalledApps) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInstalledApps.ProtoReflect.Descriptor instead.
+func (*GetInstalledApps) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetInstalledApps) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *GetInstalledApps) GetExtraArgsJSON() string {
+	if x != nil {
+		return x.ExtraArgsJSON
+	}
+	return ""
+}
+
+// WiFiCurrentNetwork - request info about connected WIFI
+type WiFiCurrentNetwork struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *WiFiCurrentNetwork) Reset() {
+	*x = WiFiCurrentNetwork{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WiFiCurrentNetwork) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WiFiCurrentNetwork) ProtoMessage() {}
+
+func (x *WiFiCurrentNetwork) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WiFiCurrentNetwork.ProtoReflect.Descriptor instead.
+func (*WiFiCurrentNetwork) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *WiFiCurrentNetwork) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// WiFiSettings - set wifi configuration
+type WiFiSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                 `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Params         *PreferencesWiFiParams `protobuf:"bytes,2,opt,name=Params,proto3" json:"Params,omitempty"`
+}
+
+func (x *WiFiSettings) Reset() {
+	*x = WiFiSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WiFiSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WiFiSettings) ProtoMessage() {}
+
+func (x *WiFiSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WiFiSettings.ProtoReflect.Descriptor instead.
+func (*WiFiSettings) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *WiFiSettings) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *WiFiSettings) GetParams() *PreferencesWiFiParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// ScheduleSettings - set configuration of the connection scheduler
+type ScheduleSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                     `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Params         *PreferencesScheduleParams `protobuf:"bytes,2,opt,name=Params,proto3" json:"Params,omitempty"`
+}
+
+func (x *ScheduleSettings) Reset() {
+	*x = ScheduleSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ScheduleSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleSettings) ProtoMessage() {}
+
+func (x *ScheduleSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleSettings.ProtoReflect.Descriptor instead.
+func (*ScheduleSettings) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *ScheduleSettings) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ScheduleSettings) GetParams() *PreferencesScheduleParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// ScheduleParams - configuration of the connection scheduler
+type PreferencesScheduleParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsEnabled bool                       `protobuf:"varint,1,opt,name=isEnabled,proto3" json:"isEnabled,omitempty"`
+	Rules     []*PreferencesScheduleRule `protobuf:"bytes,2,rep,name=rules,proto3" json:"rules,omitempty"`
+}
+
+func (x *PreferencesScheduleParams) Reset() {
+	*x = PreferencesScheduleParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesScheduleParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesScheduleParams) ProtoMessage() {}
+
+func (x *PreferencesScheduleParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesScheduleParams.ProtoReflect.Descriptor instead.
+func (*PreferencesScheduleParams) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *PreferencesScheduleParams) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *PreferencesScheduleParams) GetRules() []*PreferencesScheduleRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+// ScheduleRule - the rule of the connection scheduler.
+// The rule is triggered at the defined time of the day (Time) or after the inactivity period (InactivityMinutes).
+type PreferencesScheduleRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	// Time of the day (local time) in format "HH:MM" (e.g. "23:30")
+	Time string `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Days of the week when the rule is active (0 - Sunday, 1 - Monday ... 6 - Saturday). Empty - every day.
+	Days []int64 `protobuf:"varint,3,rep,packed,name=days,proto3" json:"days,omitempty"`
+	// Inactivity period in minutes (applicable only for 'disconnect' action when Time is empty).
+	// The VPN is disconnected when there was no traffic through the VPN tunnel during this period.
+	InactivityMinutes int64 `protobuf:"varint,4,opt,name=inactivityMinutes,proto3" json:"inactivityMinutes,omitempty"`
+}
+
+func (x *PreferencesScheduleRule) Reset() {
+	*x = PreferencesScheduleRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesScheduleRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesScheduleRule) ProtoMessage() {}
+
+func (x *PreferencesScheduleRule) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesScheduleRule.ProtoReflect.Descriptor instead.
+func (*PreferencesScheduleRule) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *PreferencesScheduleRule) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *PreferencesScheduleRule) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *PreferencesScheduleRule) GetDays() []int64 {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+func (x *PreferencesScheduleRule) GetInactivityMinutes() int64 {
+	if x != nil {
+		return x.InactivityMinutes
+	}
+	return 0
+}
+
+// Disconnect disconnect active VPN connection
+type Disconnect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *Disconnect) Reset() {
+	*x = Disconnect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Disconnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Disconnect) ProtoMessage() {}
+
+func (x *Disconnect) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Disconnect.ProtoReflect.Descriptor instead.
+func (*Disconnect) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *Disconnect) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// ConnectSettingsGet request default connection settings
+type ConnectSettingsGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *ConnectSettingsGet) Reset() {
+	*x = ConnectSettingsGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectSettingsGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectSettingsGet) ProtoMessage() {}
+
+func (x *ConnectSettingsGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectSettingsGet.ProtoReflect.Descriptor instead.
+func (*ConnectSettingsGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{119}
+}
+
+func (x *ConnectSettingsGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// ConnectSettings contains same data as 'Connect' request but this command not start the connection.
+// UI/CLI client have to notify daemon about changes in connection settings.
+// It is required:
+// - for automatic connection on daemon's side (e.g. 'Auto-connect on Launch' or 'Trusted WiFi' functionality)
+// - for default configuration storage (e.g for CLI)
+// Note: this command can be sent to the client from the daemon also (as a response to ConnectSettingsGet)
+type ConnectSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                        `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Params         *ServiceTypesConnectionParams `protobuf:"bytes,2,opt,name=Params,proto3" json:"Params,omitempty"`
+}
+
+func (x *ConnectSettings) Reset() {
+	*x = ConnectSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectSettings) ProtoMessage() {}
+
+func (x *ConnectSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectSettings.ProtoReflect.Descriptor instead.
+func (*ConnectSettings) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{120}
+}
+
+func (x *ConnectSettings) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ConnectSettings) GetParams() *ServiceTypesConnectionParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// Connect request to establish new VPN connection
+type Connect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                        `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Params         *ServiceTypesConnectionParams `protobuf:"bytes,2,opt,name=Params,proto3" json:"Params,omitempty"`
+}
+
+func (x *Connect) Reset() {
+	*x = Connect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Connect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Connect) ProtoMessage() {}
+
+func (x *Connect) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Connect.ProtoReflect.Descriptor instead.
+func (*Connect) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *Connect) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *Connect) GetParams() *ServiceTypesConnectionParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// ConnectionHistoryGet request the list of recently used connection parameters (ConnectionHistoryResp)
+type ConnectionHistoryGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *ConnectionHistoryGet) Reset() {
+	*x = ConnectionHistoryGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionHistoryGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionHistoryGet) ProtoMessage() {}
+
+func (x *ConnectionHistoryGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionHistoryGet.ProtoReflect.Descriptor instead.
+func (*ConnectionHistoryGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *ConnectionHistoryGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// AuditLogGet request the records of the audit log (AuditLogResp)
+type AuditLogGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// max number of the most recent records to return (0 - all records)
+	MaxCount int64 `protobuf:"varint,2,opt,name=MaxCount,proto3" json:"MaxCount,omitempty"`
+}
+
+func (x *AuditLogGet) Reset() {
+	*x = AuditLogGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogGet) ProtoMessage() {}
+
+func (x *AuditLogGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogGet.ProtoReflect.Descriptor instead.
+func (*AuditLogGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{123}
+}
+
+func (x *AuditLogGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *AuditLogGet) GetMaxCount() int64 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+// LogStreamStart starts streaming of the daemon log messages to the client (LogMessageResp notifications).
+// The stream is stopped by LogStreamStop or when the client disconnects.
+// Note: the messages are available only when the logging is enabled.
+type LogStreamStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// min level of the messages: "debug", "info", "warning" or "error" (empty - all messages)
+	Level string `protobuf:"bytes,2,opt,name=Level,proto3" json:"Level,omitempty"`
+	// names of the logger modules (e.g. "dns"); empty - all modules
+	Modules []string `protobuf:"bytes,3,rep,name=Modules,proto3" json:"Modules,omitempty"`
+}
+
+func (x *LogStreamStart) Reset() {
+	*x = LogStreamStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogStreamStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStreamStart) ProtoMessage() {}
+
+func (x *LogStreamStart) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStreamStart.ProtoReflect.Descriptor instead.
+func (*LogStreamStart) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *LogStreamStart) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *LogStreamStart) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogStreamStart) GetModules() []string {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+// LogStreamStop stops streaming of the daemon log messages to the client
+type LogStreamStop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *LogStreamStop) Reset() {
+	*x = LogStreamStop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogStreamStop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogStreamStop) ProtoMessage() {}
+
+func (x *LogStreamStop) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogStreamStop.ProtoReflect.Descriptor instead.
+func (*LogStreamStop) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *LogStreamStop) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// ShellCommandsGet request the history of the external commands executed by the daemon (ShellCommandsResp)
+type ShellCommandsGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// max number of the most recent records to return (0 - all records)
+	MaxCount int64 `protobuf:"varint,2,opt,name=MaxCount,proto3" json:"MaxCount,omitempty"`
+}
+
+func (x *ShellCommandsGet) Reset() {
+	*x = ShellCommandsGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShellCommandsGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShellCommandsGet) ProtoMessage() {}
+
+func (x *ShellCommandsGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShellCommandsGet.ProtoReflect.Descriptor instead.
+func (*ShellCommandsGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ShellCommandsGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ShellCommandsGet) GetMaxCount() int64 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+// CrashReportsGet request the saved crash reports of the daemon (CrashReportsResp)
+type CrashReportsGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// true - include the stack traces into the response
+	WithStack bool `protobuf:"varint,2,opt,name=WithStack,proto3" json:"WithStack,omitempty"`
+}
+
+func (x *CrashReportsGet) Reset() {
+	*x = CrashReportsGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrashReportsGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashReportsGet) ProtoMessage() {}
+
+func (x *CrashReportsGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashReportsGet.ProtoReflect.Descriptor instead.
+func (*CrashReportsGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *CrashReportsGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *CrashReportsGet) GetWithStack() bool {
+	if x != nil {
+		return x.WithStack
+	}
+	return false
+}
+
+// CrashReportsClear removes all the saved crash reports
+type CrashReportsClear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *CrashReportsClear) Reset() {
+	*x = CrashReportsClear{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrashReportsClear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashReportsClear) ProtoMessage() {}
+
+func (x *CrashReportsClear) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashReportsClear.ProtoReflect.Descriptor instead.
+func (*CrashReportsClear) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *CrashReportsClear) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// GetSubsystemStatus request the initialization status of the daemon subsystems (SubsystemStatusResp)
+type GetSubsystemStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *GetSubsystemStatus) Reset() {
+	*x = GetSubsystemStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSubsystemStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSubsystemStatus) ProtoMessage() {}
+
+func (x *GetSubsystemStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSubsystemStatus.ProtoReflect.Descriptor instead.
+func (*GetSubsystemStatus) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *GetSubsystemStatus) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// OperationStart starts the long-running operation (e.g. "Diagnostics", "ServersUpdate").
+// Expected response: OperationStatusResp (contains the operation ID).
+// The progress and the result of the operation are sent to all clients (OperationStatusResp).
+type OperationStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Type           string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+}
+
+func (x *OperationStart) Reset() {
+	*x = OperationStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationStart) ProtoMessage() {}
+
+func (x *OperationStart) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationStart.ProtoReflect.Descriptor instead.
+func (*OperationStart) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{130}
+}
+
+func (x *OperationStart) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *OperationStart) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+// SpeedTestStart starts the speed test through the active VPN tunnel (the long-running operation of type "SpeedTest").
+// Expected response: OperationStatusResp (contains the operation ID).
+// The progress and the result of the test (speedtest.Result) are sent to all clients (OperationStatusResp).
+type SpeedTestStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string           `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Params         *SpeedtestParams `protobuf:"bytes,2,opt,name=Params,proto3" json:"Params,omitempty"`
+}
+
+func (x *SpeedTestStart) Reset() {
+	*x = SpeedTestStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpeedTestStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeedTestStart) ProtoMessage() {}
+
+func (x *SpeedTestStart) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeedTestStart.ProtoReflect.Descriptor instead.
+func (*SpeedTestStart) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *SpeedTestStart) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *SpeedTestStart) GetParams() *SpeedtestParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// Params - parameters of the speed test
+type SpeedtestParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Download/upload endpoints (HTTP or HTTPS); empty - IVPN-hosted endpoints (DefaultDownloadURL, DefaultUploadURL)
+	DownloadURL string `protobuf:"bytes,1,opt,name=DownloadURL,proto3" json:"DownloadURL,omitempty"`
+	UploadURL   string `protobuf:"bytes,2,opt,name=UploadURL,proto3" json:"UploadURL,omitempty"`
+	// Duration of each phase of the test (seconds); 0 - DefaultDurationSec
+	DurationSec int64 `protobuf:"varint,3,opt,name=DurationSec,proto3" json:"DurationSec,omitempty"`
+	// If true - only the download throughput is measured
+	IsSkipUpload bool `protobuf:"varint,4,opt,name=IsSkipUpload,proto3" json:"IsSkipUpload,omitempty"`
+}
+
+func (x *SpeedtestParams) Reset() {
+	*x = SpeedtestParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SpeedtestParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpeedtestParams) ProtoMessage() {}
+
+func (x *SpeedtestParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpeedtestParams.ProtoReflect.Descriptor instead.
+func (*SpeedtestParams) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *SpeedtestParams) GetDownloadURL() string {
+	if x != nil {
+		return x.DownloadURL
+	}
+	return ""
+}
+
+func (x *SpeedtestParams) GetUploadURL() string {
+	if x != nil {
+		return x.UploadURL
+	}
+	return ""
+}
+
+func (x *SpeedtestParams) GetDurationSec() int64 {
+	if x != nil {
+		return x.DurationSec
+	}
+	return 0
+}
+
+func (x *SpeedtestParams) GetIsSkipUpload() bool {
+	if x != nil {
+		return x.IsSkipUpload
+	}
+	return false
+}
+
+// OperationCancel cancels the running operation
+// Expected response: OperationStatusResp
+type OperationCancel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	OperationId    uint64 `protobuf:"varint,2,opt,name=OperationId,proto3" json:"OperationId,omitempty"`
+}
+
+func (x *OperationCancel) Reset() {
+	*x = OperationCancel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationCancel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationCancel) ProtoMessage() {}
+
+func (x *OperationCancel) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationCancel.ProtoReflect.Descriptor instead.
+func (*OperationCancel) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{133}
+}
+
+func (x *OperationCancel) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *OperationCancel) GetOperationId() uint64 {
+	if x != nil {
+		return x.OperationId
+	}
+	return 0
+}
+
+// OperationsGet request the list of running operations (OperationsListResp)
+type OperationsGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *OperationsGet) Reset() {
+	*x = OperationsGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationsGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationsGet) ProtoMessage() {}
+
+func (x *OperationsGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationsGet.ProtoReflect.Descriptor instead.
+func (*OperationsGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *OperationsGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// HostsHealthGet request the health information for the VPN hosts (HostsHealthResp)
+type HostsHealthGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *HostsHealthGet) Reset() {
+	*x = HostsHealthGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostsHealthGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostsHealthGet) ProtoMessage() {}
+
+func (x *HostsHealthGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostsHealthGet.ProtoReflect.Descriptor instead.
+func (*HostsHealthGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *HostsHealthGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// ConnectionHistoryClear erase the connection history
+type ConnectionHistoryClear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *ConnectionHistoryClear) Reset() {
+	*x = ConnectionHistoryClear{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionHistoryClear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionHistoryClear) ProtoMessage() {}
+
+func (x *ConnectionHistoryClear) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionHistoryClear.ProtoReflect.Descriptor instead.
+func (*ConnectionHistoryClear) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{136}
+}
+
+func (x *ConnectionHistoryClear) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// ConnectionHistoryConnect request to establish new VPN connection using parameters from the connection history
+type ConnectionHistoryConnect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	// index of the item in the connection history (0 - the most recent)
+	Index int64 `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+}
+
+func (x *ConnectionHistoryConnect) Reset() {
+	*x = ConnectionHistoryConnect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionHistoryConnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionHistoryConnect) ProtoMessage() {}
+
+func (x *ConnectionHistoryConnect) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionHistoryConnect.ProtoReflect.Descriptor instead.
+func (*ConnectionHistoryConnect) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *ConnectionHistoryConnect) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ConnectionHistoryConnect) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+// ConnectionStatsHistoryGet request the connection statistics history (ConnectionStatsHistoryResp).
+// 'SessionsLimit', 'DaysLimit' - max number of the records to return (0 - all records)
+type ConnectionStatsHistoryGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	SessionsLimit  int64  `protobuf:"varint,2,opt,name=SessionsLimit,proto3" json:"SessionsLimit,omitempty"`
+	DaysLimit      int64  `protobuf:"varint,3,opt,name=DaysLimit,proto3" json:"DaysLimit,omitempty"`
+}
+
+func (x *ConnectionStatsHistoryGet) Reset() {
+	*x = ConnectionStatsHistoryGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionStatsHistoryGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStatsHistoryGet) ProtoMessage() {}
+
+func (x *ConnectionStatsHistoryGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStatsHistoryGet.ProtoReflect.Descriptor instead.
+func (*ConnectionStatsHistoryGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *ConnectionStatsHistoryGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ConnectionStatsHistoryGet) GetSessionsLimit() int64 {
+	if x != nil {
+		return x.SessionsLimit
+	}
+	return 0
+}
+
+func (x *ConnectionStatsHistoryGet) GetDaysLimit() int64 {
+	if x != nil {
+		return x.DaysLimit
+	}
+	return 0
+}
+
+// ConnectionStatsHistoryClear erase the connection statistics history
+type ConnectionStatsHistoryClear struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *ConnectionStatsHistoryClear) Reset() {
+	*x = ConnectionStatsHistoryClear{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionStatsHistoryClear) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStatsHistoryClear) ProtoMessage() {}
+
+func (x *ConnectionStatsHistoryClear) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStatsHistoryClear.ProtoReflect.Descriptor instead.
+func (*ConnectionStatsHistoryClear) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *ConnectionStatsHistoryClear) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// ThroughputHistoryGet request the recent traffic rates of the active connection (ThroughputHistoryResp).
+// 'MaxItems' - max number of the most recent samples to return (0 - all samples)
+type ThroughputHistoryGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	MaxItems       int64  `protobuf:"varint,2,opt,name=MaxItems,proto3" json:"MaxItems,omitempty"`
+}
+
+func (x *ThroughputHistoryGet) Reset() {
+	*x = ThroughputHistoryGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThroughputHistoryGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThroughputHistoryGet) ProtoMessage() {}
+
+func (x *ThroughputHistoryGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThroughputHistoryGet.ProtoReflect.Descriptor instead.
+func (*ThroughputHistoryGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *ThroughputHistoryGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ThroughputHistoryGet) GetMaxItems() int64 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+// ConnectionQualityHistoryGet request the recent quality reports of the active connection (ConnectionQualityHistoryResp).
+// 'MaxItems' - max number of the most recent reports to return (0 - all reports)
+type ConnectionQualityHistoryGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	MaxItems       int64  `protobuf:"varint,2,opt,name=MaxItems,proto3" json:"MaxItems,omitempty"`
+}
+
+func (x *ConnectionQualityHistoryGet) Reset() {
+	*x = ConnectionQualityHistoryGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionQualityHistoryGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionQualityHistoryGet) ProtoMessage() {}
+
+func (x *ConnectionQualityHistoryGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionQualityHistoryGet.ProtoReflect.Descriptor instead.
+func (*ConnectionQualityHistoryGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ConnectionQualityHistoryGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ConnectionQualityHistoryGet) GetMaxItems() int64 {
+	if x != nil {
+		return x.MaxItems
+	}
+	return 0
+}
+
+// ConnectionProfilesGet request the list of saved connection profiles (ConnectionProfilesResp)
+type ConnectionProfilesGet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+}
+
+func (x *ConnectionProfilesGet) Reset() {
+	*x = ConnectionProfilesGet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionProfilesGet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionProfilesGet) ProtoMessage() {}
+
+func (x *ConnectionProfilesGet) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionProfilesGet.ProtoReflect.Descriptor instead.
+func (*ConnectionProfilesGet) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *ConnectionProfilesGet) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+// ConnectionProfileSave save the connection profile (the profile with the same name is replaced)
+type ConnectionProfileSave struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string                        `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Profile        *PreferencesConnectionProfile `protobuf:"bytes,2,opt,name=Profile,proto3" json:"Profile,omitempty"`
+}
+
+func (x *ConnectionProfileSave) Reset() {
+	*x = ConnectionProfileSave{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionProfileSave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionProfileSave) ProtoMessage() {}
+
+func (x *ConnectionProfileSave) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionProfileSave.ProtoReflect.Descriptor instead.
+func (*ConnectionProfileSave) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *ConnectionProfileSave) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ConnectionProfileSave) GetProfile() *PreferencesConnectionProfile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// ConnectionProfileRemove remove the connection profile
+type ConnectionProfileRemove struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *ConnectionProfileRemove) Reset() {
+	*x = ConnectionProfileRemove{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionProfileRemove) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionProfileRemove) ProtoMessage() {}
+
+func (x *ConnectionProfileRemove) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionProfileRemove.ProtoReflect.Descriptor instead.
+func (*ConnectionProfileRemove) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *ConnectionProfileRemove) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ConnectionProfileRemove) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// ConnectionProfileConnect request to establish new VPN connection using the connection profile
+type ConnectionProfileConnect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProtocolSecret string `protobuf:"bytes,1,opt,name=ProtocolSecret,proto3" json:"ProtocolSecret,omitempty"`
+	Name           string `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+}
+
+func (x *ConnectionProfileConnect) Reset() {
+	*x = ConnectionProfileConnect{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionProfileConnect) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionProfileConnect) ProtoMessage() {}
+
+func (x *ConnectionProfileConnect) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionProfileConnect.ProtoReflect.Descriptor instead.
+func (*ConnectionProfileConnect) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *ConnectionProfileConnect) GetProtocolSecret() string {
+	if x != nil {
+		return x.ProtocolSecret
+	}
+	return ""
+}
+
+func (x *ConnectionProfileConnect) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// EventsRequest - request to stream the daemon events
+// (the same notifications which are sent to the clients of the daemon protocol).
+type EventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// List of the event types to receive (e.g. 'VpnStateResp', 'ConnectionStatsResp'); empty - all the events
+	Events []string `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"`
+	// Stream the daemon log messages (LogMessageResp; only when the logging is enabled; not available for read-only clients)
+	IsLogsRequested bool `protobuf:"varint,2,opt,name=IsLogsRequested,proto3" json:"IsLogsRequested,omitempty"`
+}
+
+func (x *EventsRequest) Reset() {
+	*x = EventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventsRequest) ProtoMessage() {}
+
+func (x *EventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventsRequest.ProtoReflect.Descriptor instead.
+func (*EventsRequest) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *EventsRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *EventsRequest) GetIsLogsRequested() bool {
+	if x != nil {
+		return x.IsLogsRequested
+	}
+	return false
+}
+
+// Response of the daemon (one of the response types of the daemon protocol; the field name is the response type name).
+// Note: the errors of requests processing are returned as 'ErrorResp'.
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Response:
+	//	*Response_ErrorResp
+	//	*Response_ErrorRespDelayed
+	//	*Response_EmptyResp
+	//	*Response_ServiceExitingResp
+	//	*Response_SettingsResp
+	//	*Response_HelloResp
+	//	*Response_SessionNewResp
+	//	*Response_AccountsResp
+	//	*Response_DevicesResp
+	//	*Response_AccountStatusResp
+	//	*Response_KillSwitchStatusResp
+	//	*Response_KillSwitchGetIsPestistentResp
+	//	*Response_DiagnosticsGeneratedResp
+	//	*Response_SetAlternateDNSResp
+	//	*Response_DnsPredefinedConfigsResp
+	//	*Response_ConnectedResp
+	//	*Response_DisconnectedResp
+	//	*Response_ConnectionHistoryResp
+	//	*Response_ConnectionStatsHistoryResp
+	//	*Response_ThroughputHistoryResp
+	//	*Response_AuditLogResp
+	//	*Response_SubsystemStatusResp
+	//	*Response_PortForwardingStatusResp
+	//	*Response_DataUsageAlertResp
+	//	*Response_ConnectionQualityResp
+	//	*Response_ConnectionQualityHistoryResp
+	//	*Response_CaptivePortalStatusResp
+	//	*Response_ReloginRequiredResp
+	//	*Response_ClockSkewResp
+	//	*Response_ShellCommandsResp
+	//	*Response_CrashReportsResp
+	//	*Response_CrashRecoveredResp
+	//	*Response_OperationStatusResp
+	//	*Response_OperationsListResp
+	//	*Response_ConnectionProfilesResp
+	//	*Response_RestApiResp
+	//	*Response_GrpcApiResp
+	//	*Response_LogLevelsResp
+	//	*Response_ClientTokensResp
+	//	*Response_SettingsExportResp
+	//	*Response_SettingsImportResp
+	//	*Response_HostsHealthResp
+	//	*Response_VpnStateResp
+	//	*Response_ConnectionStatsResp
+	//	*Response_LogMessageResp
+	//	*Response_ServerListResp
+	//	*Response_PingServersResp
+	//	*Response_WiFiAvailableNetworksResp
+	//	*Response_WiFiCurrentNetworkResp
+	//	*Response_APIResponse
+	//	*Response_InstalledAppsResp
+	//	*Response_AppIconResp
+	//	*Response_SplitTunnelStatus
+	//	*Response_SplitTunnelAddAppCmdResp
+	//	*Response_ConnectSettings
+	Response isResponse_Response `protobuf_oneof:"response"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{147}
+}
+
+func (m *Response) GetResponse() isResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (x *Response) GetErrorResp() *ErrorResp {
+	if x, ok := x.GetResponse().(*Response_ErrorResp); ok {
+		return x.ErrorResp
+	}
+	return nil
+}
+
+func (x *Response) GetErrorRespDelayed() *ErrorRespDelayed {
+	if x, ok := x.GetResponse().(*Response_ErrorRespDelayed); ok {
+		return x.ErrorRespDelayed
+	}
+	return nil
+}
+
+func (x *Response) GetEmptyResp() *EmptyResp {
+	if x, ok := x.GetResponse().(*Response_EmptyResp); ok {
+		return x.EmptyResp
+	}
+	return nil
+}
+
+func (x *Response) GetServiceExitingResp() *ServiceExitingResp {
+	if x, ok := x.GetResponse().(*Response_ServiceExitingResp); ok {
+		return x.ServiceExitingResp
+	}
+	return nil
+}
+
+func (x *Response) GetSettingsResp() *SettingsResp {
+	if x, ok := x.GetResponse().(*Response_SettingsResp); ok {
+		return x.SettingsResp
+	}
+	return nil
+}
+
+func (x *Response) GetHelloResp() *HelloResp {
+	if x, ok := x.GetResponse().(*Response_HelloResp); ok {
+		return x.HelloResp
+	}
+	return nil
+}
+
+func (x *Response) GetSessionNewResp() *SessionNewResp {
+	if x, ok := x.GetResponse().(*Response_SessionNewResp); ok {
+		return x.SessionNewResp
+	}
+	return nil
+}
+
+func (x *Response) GetAccountsResp() *AccountsResp {
+	if x, ok := x.GetResponse().(*Response_AccountsResp); ok {
+		return x.AccountsResp
+	}
+	return nil
+}
+
+func (x *Response) GetDevicesResp() *DevicesResp {
+	if x, ok := x.GetResponse().(*Response_DevicesResp); ok {
+		return x.DevicesResp
+	}
+	return nil
+}
+
+func (x *Response) GetAccountStatusResp() *AccountStatusResp {
+	if x, ok := x.GetResponse().(*Response_AccountStatusResp); ok {
+		return x.AccountStatusResp
+	}
+	return nil
+}
+
+func (x *Response) GetKillSwitchStatusResp() *KillSwitchStatusResp {
+	if x, ok := x.GetResponse().(*Response_KillSwitchStatusResp); ok {
+		return x.KillSwitchStatusResp
+	}
+	return nil
+}
+
+func (x *Response) GetKillSwitchGetIsPestistentResp() *KillSwitchGetIsPestistentResp {
+	if x, ok := x.GetResponse().(*Response_KillSwitchGetIsPestistentResp); ok {
+		return x.KillSwitchGetIsPestistentResp
+	}
+	return nil
+}
+
+func (x *Response) GetDiagnosticsGeneratedResp() *DiagnosticsGeneratedResp {
+	if x, ok := x.GetResponse().(*Response_DiagnosticsGeneratedResp); ok {
+		return x.DiagnosticsGeneratedResp
+	}
+	return nil
+}
+
+func (x *Response) GetSetAlternateDNSResp() *SetAlternateDNSResp {
+	if x, ok := x.GetResponse().(*Response_SetAlternateDNSResp); ok {
+		return x.SetAlternateDNSResp
+	}
+	return nil
+}
+
+func (x *Response) GetDnsPredefinedConfigsResp() *DnsPredefinedConfigsResp {
+	if x, ok := x.GetResponse().(*Response_DnsPredefinedConfigsResp); ok {
+		return x.DnsPredefinedConfigsResp
+	}
+	return nil
+}
+
+func (x *Response) GetConnectedResp() *ConnectedResp {
+	if x, ok := x.GetResponse().(*Response_ConnectedResp); ok {
+		return x.ConnectedResp
+	}
+	return nil
+}
+
+func (x *Response) GetDisconnectedResp() *DisconnectedResp {
+	if x, ok := x.GetResponse().(*Response_DisconnectedResp); ok {
+		return x.DisconnectedResp
+	}
+	return nil
+}
+
+func (x *Response) GetConnectionHistoryResp() *ConnectionHistoryResp {
+	if x, ok := x.GetResponse().(*Response_ConnectionHistoryResp); ok {
+		return x.ConnectionHistoryResp
+	}
+	return nil
+}
+
+func (x *Response) GetConnectionStatsHistoryResp() *ConnectionStatsHistoryResp {
+	if x, ok := x.GetResponse().(*Response_ConnectionStatsHistoryResp); ok {
+		return x.ConnectionStatsHistoryResp
+	}
+	return nil
+}
+
+func (x *Response) GetThroughputHistoryResp() *ThroughputHistoryResp {
+	if x, ok := x.GetResponse().(*Response_ThroughputHistoryResp); ok {
+		return x.ThroughputHistoryResp
+	}
+	return nil
+}
+
+func (x *Response) GetAuditLogResp() *AuditLogResp {
+	if x, ok := x.GetResponse().(*Response_AuditLogResp); ok {
+		return x.AuditLogResp
+	}
+	return nil
+}
+
+func (x *Response) GetSubsystemStatusResp() *SubsystemStatusResp {
+	if x, ok := x.GetResponse().(*Response_SubsystemStatusResp); ok {
+		return x.SubsystemStatusResp
+	}
+	return nil
+}
+
+func (x *Response) GetPortForwardingStatusResp() *PortForwardingStatusResp {
+	if x, ok := x.GetResponse().(*Response_PortForwardingStatusResp); ok {
+		return x.PortForwardingStatusResp
+	}
+	return nil
+}
+
+func (x *Response) GetDataUsageAlertResp() *DataUsageAlertResp {
+	if x, ok := x.GetResponse().(*Response_DataUsageAlertResp); ok {
+		return x.DataUsageAlertResp
+	}
+	return nil
+}
+
+func (x *Response) GetConnectionQualityResp() *ConnectionQualityResp {
+	if x, ok := x.GetResponse().(*Response_ConnectionQualityResp); ok {
+		return x.ConnectionQualityResp
+	}
+	return nil
+}
+
+func (x *Response) GetConnectionQualityHistoryResp() *ConnectionQualityHistoryResp {
+	if x, ok := x.GetResponse().(*Response_ConnectionQualityHistoryResp); ok {
+		return x.ConnectionQualityHistoryResp
+	}
+	return nil
+}
+
+func (x *Response) GetCaptivePortalStatusResp() *CaptivePortalStatusResp {
+	if x, ok := x.GetResponse().(*Response_CaptivePortalStatusResp); ok {
+		return x.CaptivePortalStatusResp
+	}
+	return nil
+}
+
+func (x *Response) GetReloginRequiredResp() *ReloginRequiredResp {
+	if x, ok := x.GetResponse().(*Response_ReloginRequiredResp); ok {
+		return x.ReloginRequiredResp
+	}
+	return nil
+}
+
+func (x *Response) GetClockSkewResp() *ClockSkewResp {
+	if x, ok := x.GetResponse().(*Response_ClockSkewResp); ok {
+		return x.ClockSkewResp
+	}
+	return nil
+}
+
+func (x *Response) GetShellCommandsResp() *ShellCommandsResp {
+	if x, ok := x.GetResponse().(*Response_ShellCommandsResp); ok {
+		return x.ShellCommandsResp
+	}
+	return nil
+}
+
+func (x *Response) GetCrashReportsResp() *CrashReportsResp {
+	if x, ok := x.GetResponse().(*Response_CrashReportsResp); ok {
+		return x.CrashReportsResp
+	}
+	return nil
+}
+
+func (x *Response) GetCrashRecoveredResp() *CrashRecoveredResp {
+	if x, ok := x.GetResponse().(*Response_CrashRecoveredResp); ok {
+		return x.CrashRecoveredResp
+	}
+	return nil
+}
+
+func (x *Response) GetOperationStatusResp() *OperationStatusResp {
+	if x, ok := x.GetResponse().(*Response_OperationStatusResp); ok {
+		return x.OperationStatusResp
+	}
+	return nil
+}
+
+func (x *Response) GetOperationsListResp() *OperationsListResp {
+	if x, ok := x.GetResponse().(*Response_OperationsListResp); ok {
+		return x.OperationsListResp
+	}
+	return nil
+}
+
+func (x *Response) GetConnectionProfilesResp() *ConnectionProfilesResp {
+	if x, ok := x.GetResponse().(*Response_ConnectionProfilesResp); ok {
+		return x.ConnectionProfilesResp
+	}
+	return nil
+}
+
+func (x *Response) GetRestApiResp() *RestApiResp {
+	if x, ok := x.GetResponse().(*Response_RestApiResp); ok {
+		return x.RestApiResp
+	}
+	return nil
+}
+
+func (x *Response) GetGrpcApiResp() *GrpcApiResp {
+	if x, ok := x.GetResponse().(*Response_GrpcApiResp); ok {
+		return x.GrpcApiResp
+	}
+	return nil
+}
+
+func (x *Response) GetLogLevelsResp() *LogLevelsResp {
+	if x, ok := x.GetResponse().(*Response_LogLevelsResp); ok {
+		return x.LogLevelsResp
+	}
+	return nil
+}
+
+func (x *Response) GetClientTokensResp() *ClientTokensResp {
+	if x, ok := x.GetResponse().(*Response_ClientTokensResp); ok {
+		return x.ClientTokensResp
+	}
+	return nil
+}
+
+func (x *Response) GetSettingsExportResp() *SettingsExportResp {
+	if x, ok := x.GetResponse().(*Response_SettingsExportResp); ok {
+		return x.SettingsExportResp
+	}
+	return nil
+}
+
+func (x *Response) GetSettingsImportResp() *SettingsImportResp {
+	if x, ok := x.GetResponse().(*Response_SettingsImportResp); ok {
+		return x.SettingsImportResp
+	}
+	return nil
+}
+
+func (x *Response) GetHostsHealthResp() *HostsHealthResp {
+	if x, ok := x.GetResponse().(*Response_HostsHealthResp); ok {
+		return x.HostsHealthResp
+	}
+	return nil
+}
+
+func (x *Response) GetVpnStateResp() *VpnStateResp {
+	if x, ok := x.GetResponse().(*Response_VpnStateResp); ok {
+		return x.VpnStateResp
+	}
+	return nil
+}
+
+func (x *Response) GetConnectionStatsResp() *ConnectionStatsResp {
+	if x, ok := x.GetResponse().(*Response_ConnectionStatsResp); ok {
+		return x.ConnectionStatsResp
+	}
+	return nil
+}
+
+func (x *Response) GetLogMessageResp() *LogMessageResp {
+	if x, ok := x.GetResponse().(*Response_LogMessageResp); ok {
+		return x.LogMessageResp
+	}
+	return nil
+}
+
+func (x *Response) GetServerListResp() *ServerListResp {
+	if x, ok := x.GetResponse().(*Response_ServerListResp); ok {
+		return x.ServerListResp
+	}
+	return nil
+}
+
+func (x *Response) GetPingServersResp() *PingServersResp {
+	if x, ok := x.GetResponse().(*Response_PingServersResp); ok {
+		return x.PingServersResp
+	}
+	return nil
+}
+
+func (x *Response) GetWiFiAvailableNetworksResp() *WiFiAvailableNetworksResp {
+	if x, ok := x.GetResponse().(*Response_WiFiAvailableNetworksResp); ok {
+		return x.WiFiAvailableNetworksResp
+	}
+	return nil
+}
+
+func (x *Response) GetWiFiCurrentNetworkResp() *WiFiCurrentNetworkResp {
+	if x, ok := x.GetResponse().(*Response_WiFiCurrentNetworkResp); ok {
+		return x.WiFiCurrentNetworkResp
+	}
+	return nil
+}
+
+func (x *Response) GetAPIResponse() *APIResponse {
+	if x, ok := x.GetResponse().(*Response_APIResponse); ok {
+		return x.APIResponse
+	}
+	return nil
+}
+
+func (x *Response) GetInstalledAppsResp() *InstalledAppsResp {
+	if x, ok := x.GetResponse().(*Response_InstalledAppsResp); ok {
+		return x.InstalledAppsResp
+	}
+	return nil
+}
+
+func (x *Response) GetAppIconResp() *AppIconResp {
+	if x, ok := x.GetResponse().(*Response_AppIconResp); ok {
+		return x.AppIconResp
+	}
+	return nil
+}
+
+func (x *Response) GetSplitTunnelStatus() *SplitTunnelStatus {
+	if x, ok := x.GetResponse().(*Response_SplitTunnelStatus); ok {
+		return x.SplitTunnelStatus
+	}
+	return nil
+}
+
+func (x *Response) GetSplitTunnelAddAppCmdResp() *SplitTunnelAddAppCmdResp {
+	if x, ok := x.GetResponse().(*Response_SplitTunnelAddAppCmdResp); ok {
+		return x.SplitTunnelAddAppCmdResp
+	}
+	return nil
+}
+
+func (x *Response) GetConnectSettings() *ConnectSettings {
+	if x, ok := x.GetResponse().(*Response_ConnectSettings); ok {
+		return x.ConnectSettings
+	}
+	return nil
+}
+
+type isResponse_Response interface {
+	isResponse_Response()
+}
+
+type Response_ErrorResp struct {
+	ErrorResp *ErrorResp `protobuf:"bytes,1,opt,name=ErrorResp,proto3,oneof"`
+}
+
+type Response_ErrorRespDelayed struct {
+	ErrorRespDelayed *ErrorRespDelayed `protobuf:"bytes,2,opt,name=ErrorRespDelayed,proto3,oneof"`
+}
+
+type Response_EmptyResp struct {
+	EmptyResp *EmptyResp `protobuf:"bytes,3,opt,name=EmptyResp,proto3,oneof"`
+}
+
+type Response_ServiceExitingResp struct {
+	ServiceExitingResp *ServiceExitingResp `protobuf:"bytes,4,opt,name=ServiceExitingResp,proto3,oneof"`
+}
+
+type Response_SettingsResp struct {
+	SettingsResp *SettingsResp `protobuf:"bytes,5,opt,name=SettingsResp,proto3,oneof"`
+}
+
+type Response_HelloResp struct {
+	HelloResp *HelloResp `protobuf:"bytes,6,opt,name=HelloResp,proto3,oneof"`
+}
+
+type Response_SessionNewResp struct {
+	SessionNewResp *SessionNewResp `protobuf:"bytes,7,opt,name=SessionNewResp,proto3,oneof"`
+}
+
+type Response_AccountsResp struct {
+	AccountsResp *AccountsResp `protobuf:"bytes,8,opt,name=AccountsResp,proto3,oneof"`
+}
+
+type Response_DevicesResp struct {
+	DevicesResp *DevicesResp `protobuf:"bytes,9,opt,name=DevicesResp,proto3,oneof"`
+}
+
+type Response_AccountStatusResp struct {
+	AccountStatusResp *AccountStatusResp `protobuf:"bytes,10,opt,name=AccountStatusResp,proto3,oneof"`
+}
+
+type Response_KillSwitchStatusResp struct {
+	KillSwitchStatusResp *KillSwitchStatusResp `protobuf:"bytes,11,opt,name=KillSwitchStatusResp,proto3,oneof"`
+}
+
+type Response_KillSwitchGetIsPestistentResp struct {
+	KillSwitchGetIsPestistentResp *KillSwitchGetIsPestistentResp `protobuf:"bytes,12,opt,name=KillSwitchGetIsPestistentResp,proto3,oneof"`
+}
+
+type Response_DiagnosticsGeneratedResp struct {
+	DiagnosticsGeneratedResp *DiagnosticsGeneratedResp `protobuf:"bytes,13,opt,name=DiagnosticsGeneratedResp,proto3,oneof"`
+}
+
+type Response_SetAlternateDNSResp struct {
+	SetAlternateDNSResp *SetAlternateDNSResp `protobuf:"bytes,14,opt,name=SetAlternateDNSResp,proto3,oneof"`
+}
+
+type Response_DnsPredefinedConfigsResp struct {
+	DnsPredefinedConfigsResp *DnsPredefinedConfigsResp `protobuf:"bytes,15,opt,name=DnsPredefinedConfigsResp,proto3,oneof"`
+}
+
+type Response_ConnectedResp struct {
+	ConnectedResp *ConnectedResp `protobuf:"bytes,16,opt,name=ConnectedResp,proto3,oneof"`
+}
+
+type Response_DisconnectedResp struct {
+	DisconnectedResp *DisconnectedResp `protobuf:"bytes,17,opt,name=DisconnectedResp,proto3,oneof"`
+}
+
+type Response_ConnectionHistoryResp struct {
+	ConnectionHistoryResp *ConnectionHistoryResp `protobuf:"bytes,18,opt,name=ConnectionHistoryResp,proto3,oneof"`
+}
+
+type Response_ConnectionStatsHistoryResp struct {
+	ConnectionStatsHistoryResp *ConnectionStatsHistoryResp `protobuf:"bytes,19,opt,name=ConnectionStatsHistoryResp,proto3,oneof"`
+}
+
+type Response_ThroughputHistoryResp struct {
+	ThroughputHistoryResp *ThroughputHistoryResp `protobuf:"bytes,20,opt,name=ThroughputHistoryResp,proto3,oneof"`
+}
+
+type Response_AuditLogResp struct {
+	AuditLogResp *AuditLogResp `protobuf:"bytes,21,opt,name=AuditLogResp,proto3,oneof"`
+}
+
+type Response_SubsystemStatusResp struct {
+	SubsystemStatusResp *SubsystemStatusResp `protobuf:"bytes,22,opt,name=SubsystemStatusResp,proto3,oneof"`
+}
+
+type Response_PortForwardingStatusResp struct {
+	PortForwardingStatusResp *PortForwardingStatusResp `protobuf:"bytes,23,opt,name=PortForwardingStatusResp,proto3,oneof"`
+}
+
+type Response_DataUsageAlertResp struct {
+	DataUsageAlertResp *DataUsageAlertResp `protobuf:"bytes,24,opt,name=DataUsageAlertResp,proto3,oneof"`
+}
+
+type Response_ConnectionQualityResp struct {
+	ConnectionQualityResp *ConnectionQualityResp `protobuf:"bytes,25,opt,name=ConnectionQualityResp,proto3,oneof"`
+}
+
+type Response_ConnectionQualityHistoryResp struct {
+	ConnectionQualityHistoryResp *ConnectionQualityHistoryResp `protobuf:"bytes,26,opt,name=ConnectionQualityHistoryResp,proto3,oneof"`
+}
+
+type Response_CaptivePortalStatusResp struct {
+	CaptivePortalStatusResp *CaptivePortalStatusResp `protobuf:"bytes,27,opt,name=CaptivePortalStatusResp,proto3,oneof"`
+}
+
+type Response_ReloginRequiredResp struct {
+	ReloginRequiredResp *ReloginRequiredResp `protobuf:"bytes,28,opt,name=ReloginRequiredResp,proto3,oneof"`
+}
+
+type Response_ClockSkewResp struct {
+	ClockSkewResp *ClockSkewResp `protobuf:"bytes,29,opt,name=ClockSkewResp,proto3,oneof"`
+}
+
+type Response_ShellCommandsResp struct {
+	ShellCommandsResp *ShellCommandsResp `protobuf:"bytes,30,opt,name=ShellCommandsResp,proto3,oneof"`
+}
+
+type Response_CrashReportsResp struct {
+	CrashReportsResp *CrashReportsResp `protobuf:"bytes,31,opt,name=CrashReportsResp,proto3,oneof"`
+}
+
+type Response_CrashRecoveredResp struct {
+	CrashRecoveredResp *CrashRecoveredResp `protobuf:"bytes,32,opt,name=CrashRecoveredResp,proto3,oneof"`
+}
+
+type Response_OperationStatusResp struct {
+	OperationStatusResp *OperationStatusResp `protobuf:"bytes,33,opt,name=OperationStatusResp,proto3,oneof"`
+}
+
+type Response_OperationsListResp struct {
+	OperationsListResp *OperationsListResp `protobuf:"bytes,34,opt,name=OperationsListResp,proto3,oneof"`
+}
+
+type Response_ConnectionProfilesResp struct {
+	ConnectionProfilesResp *ConnectionProfilesResp `protobuf:"bytes,35,opt,name=ConnectionProfilesResp,proto3,oneof"`
+}
+
+type Response_RestApiResp struct {
+	RestApiResp *RestApiResp `protobuf:"bytes,36,opt,name=RestApiResp,proto3,oneof"`
+}
+
+type Response_GrpcApiResp struct {
+	GrpcApiResp *GrpcApiResp `protobuf:"bytes,37,opt,name=GrpcApiResp,proto3,oneof"`
+}
+
+type Response_LogLevelsResp struct {
+	LogLevelsResp *LogLevelsResp `protobuf:"bytes,38,opt,name=LogLevelsResp,proto3,oneof"`
+}
+
+type Response_ClientTokensResp struct {
+	ClientTokensResp *ClientTokensResp `protobuf:"bytes,39,opt,name=ClientTokensResp,proto3,oneof"`
+}
+
+type Response_SettingsExportResp struct {
+	SettingsExportResp *SettingsExportResp `protobuf:"bytes,40,opt,name=SettingsExportResp,proto3,oneof"`
+}
+
+type Response_SettingsImportResp struct {
+	SettingsImportResp *SettingsImportResp `protobuf:"bytes,41,opt,name=SettingsImportResp,proto3,oneof"`
+}
+
+type Response_HostsHealthResp struct {
+	HostsHealthResp *HostsHealthResp `protobuf:"bytes,42,opt,name=HostsHealthResp,proto3,oneof"`
+}
+
+type Response_VpnStateResp struct {
+	VpnStateResp *VpnStateResp `protobuf:"bytes,43,opt,name=VpnStateResp,proto3,oneof"`
+}
+
+type Response_ConnectionStatsResp struct {
+	ConnectionStatsResp *ConnectionStatsResp `protobuf:"bytes,44,opt,name=ConnectionStatsResp,proto3,oneof"`
+}
+
+type Response_LogMessageResp struct {
+	LogMessageResp *LogMessageResp `protobuf:"bytes,45,opt,name=LogMessageResp,proto3,oneof"`
+}
+
+type Response_ServerListResp struct {
+	ServerListResp *ServerListResp `protobuf:"bytes,46,opt,name=ServerListResp,proto3,oneof"`
+}
+
+type Response_PingServersResp struct {
+	PingServersResp *PingServersResp `protobuf:"bytes,47,opt,name=PingServersResp,proto3,oneof"`
+}
+
+type Response_WiFiAvailableNetworksResp struct {
+	WiFiAvailableNetworksResp *WiFiAvailableNetworksResp `protobuf:"bytes,48,opt,name=WiFiAvailableNetworksResp,proto3,oneof"`
+}
+
+type Response_WiFiCurrentNetworkResp struct {
+	WiFiCurrentNetworkResp *WiFiCurrentNetworkResp `protobuf:"bytes,49,opt,name=WiFiCurrentNetworkResp,proto3,oneof"`
+}
+
+type Response_APIResponse struct {
+	APIResponse *APIResponse `protobuf:"bytes,50,opt,name=APIResponse,proto3,oneof"`
+}
+
+type Response_InstalledAppsResp struct {
+	InstalledAppsResp *InstalledAppsResp `protobuf:"bytes,51,opt,name=InstalledAppsResp,proto3,oneof"`
+}
+
+type Response_AppIconResp struct {
+	AppIconResp *AppIconResp `protobuf:"bytes,52,opt,name=AppIconResp,proto3,oneof"`
+}
+
+type Response_SplitTunnelStatus struct {
+	SplitTunnelStatus *SplitTunnelStatus `protobuf:"bytes,53,opt,name=SplitTunnelStatus,proto3,oneof"`
+}
+
+type Response_SplitTunnelAddAppCmdResp struct {
+	SplitTunnelAddAppCmdResp *SplitTunnelAddAppCmdResp `protobuf:"bytes,54,opt,name=SplitTunnelAddAppCmdResp,proto3,oneof"`
+}
+
+type Response_ConnectSettings struct {
+	ConnectSettings *ConnectSettings `protobuf:"bytes,55,opt,name=ConnectSettings,proto3,oneof"`
+}
+
+func (*Response_ErrorResp) isResponse_Response() {}
+
+func (*Response_ErrorRespDelayed) isResponse_Response() {}
+
+func (*Response_EmptyResp) isResponse_Response() {}
+
+func (*Response_ServiceExitingResp) isResponse_Response() {}
+
+func (*Response_SettingsResp) isResponse_Response() {}
+
+func (*Response_HelloResp) isResponse_Response() {}
+
+func (*Response_SessionNewResp) isResponse_Response() {}
+
+func (*Response_AccountsResp) isResponse_Response() {}
+
+func (*Response_DevicesResp) isResponse_Response() {}
+
+func (*Response_AccountStatusResp) isResponse_Response() {}
+
+func (*Response_KillSwitchStatusResp) isResponse_Response() {}
+
+func (*Response_KillSwitchGetIsPestistentResp) isResponse_Response() {}
+
+func (*Response_DiagnosticsGeneratedResp) isResponse_Response() {}
+
+func (*Response_SetAlternateDNSResp) isResponse_Response() {}
+
+func (*Response_DnsPredefinedConfigsResp) isResponse_Response() {}
+
+func (*Response_ConnectedResp) isResponse_Response() {}
+
+func (*Response_DisconnectedResp) isResponse_Response() {}
+
+func (*Response_ConnectionHistoryResp) isResponse_Response() {}
+
+func (*Response_ConnectionStatsHistoryResp) isResponse_Response() {}
+
+func (*Response_ThroughputHistoryResp) isResponse_Response() {}
+
+func (*Response_AuditLogResp) isResponse_Response() {}
+
+func (*Response_SubsystemStatusResp) isResponse_Response() {}
+
+func (*Response_PortForwardingStatusResp) isResponse_Response() {}
+
+func (*Response_DataUsageAlertResp) isResponse_Response() {}
+
+func (*Response_ConnectionQualityResp) isResponse_Response() {}
+
+func (*Response_ConnectionQualityHistoryResp) isResponse_Response() {}
+
+func (*Response_CaptivePortalStatusResp) isResponse_Response() {}
+
+func (*Response_ReloginRequiredResp) isResponse_Response() {}
+
+func (*Response_ClockSkewResp) isResponse_Response() {}
+
+func (*Response_ShellCommandsResp) isResponse_Response() {}
+
+func (*Response_CrashReportsResp) isResponse_Response() {}
+
+func (*Response_CrashRecoveredResp) isResponse_Response() {}
+
+func (*Response_OperationStatusResp) isResponse_Response() {}
+
+func (*Response_OperationsListResp) isResponse_Response() {}
+
+func (*Response_ConnectionProfilesResp) isResponse_Response() {}
+
+func (*Response_RestApiResp) isResponse_Response() {}
+
+func (*Response_GrpcApiResp) isResponse_Response() {}
+
+func (*Response_LogLevelsResp) isResponse_Response() {}
+
+func (*Response_ClientTokensResp) isResponse_Response() {}
+
+func (*Response_SettingsExportResp) isResponse_Response() {}
+
+func (*Response_SettingsImportResp) isResponse_Response() {}
+
+func (*Response_HostsHealthResp) isResponse_Response() {}
+
+func (*Response_VpnStateResp) isResponse_Response() {}
+
+func (*Response_ConnectionStatsResp) isResponse_Response() {}
+
+func (*Response_LogMessageResp) isResponse_Response() {}
+
+func (*Response_ServerListResp) isResponse_Response() {}
+
+func (*Response_PingServersResp) isResponse_Response() {}
+
+func (*Response_WiFiAvailableNetworksResp) isResponse_Response() {}
+
+func (*Response_WiFiCurrentNetworkResp) isResponse_Response() {}
+
+func (*Response_APIResponse) isResponse_Response() {}
+
+func (*Response_InstalledAppsResp) isResponse_Response() {}
+
+func (*Response_AppIconResp) isResponse_Response() {}
+
+func (*Response_SplitTunnelStatus) isResponse_Response() {}
+
+func (*Response_SplitTunnelAddAppCmdResp) isResponse_Response() {}
+
+func (*Response_ConnectSettings) isResponse_Response() {}
+
+// ErrorResp response of error
+type ErrorResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage string `protobuf:"bytes,1,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
+	ErrorTitle   string `protobuf:"bytes,2,opt,name=ErrorTitle,proto3" json:"ErrorTitle,omitempty"`
+	ErrorType    int64  `protobuf:"varint,3,opt,name=ErrorType,proto3" json:"ErrorType,omitempty"`
+}
+
+func (x *ErrorResp) Reset() {
+	*x = ErrorResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorResp) ProtoMessage() {}
+
+func (x *ErrorResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorResp.ProtoReflect.Descriptor instead.
+func (*ErrorResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *ErrorResp) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ErrorResp) GetErrorTitle() string {
+	if x != nil {
+		return x.ErrorTitle
+	}
+	return ""
+}
+
+func (x *ErrorResp) GetErrorType() int64 {
+	if x != nil {
+		return x.ErrorType
+	}
+	return 0
+}
+
+// ErrorRespDelayed - error info which had happened in the past
+type ErrorRespDelayed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ErrorMessage string `protobuf:"bytes,1,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
+	ErrorTitle   string `protobuf:"bytes,2,opt,name=ErrorTitle,proto3" json:"ErrorTitle,omitempty"`
+	ErrorType    int64  `protobuf:"varint,3,opt,name=ErrorType,proto3" json:"ErrorType,omitempty"`
+}
+
+func (x *ErrorRespDelayed) Reset() {
+	*x = ErrorRespDelayed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ErrorRespDelayed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorRespDelayed) ProtoMessage() {}
+
+func (x *ErrorRespDelayed) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorRespDelayed.ProtoReflect.Descriptor instead.
+func (*ErrorRespDelayed) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ErrorRespDelayed) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *ErrorRespDelayed) GetErrorTitle() string {
+	if x != nil {
+		return x.ErrorTitle
+	}
+	return ""
+}
+
+func (x *ErrorRespDelayed) GetErrorType() int64 {
+	if x != nil {
+		return x.ErrorType
+	}
+	return 0
+}
+
+// EmptyResp empty response on request
+type EmptyResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *EmptyResp) Reset() {
+	*x = EmptyResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EmptyResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EmptyResp) ProtoMessage() {}
+
+func (x *EmptyResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EmptyResp.ProtoReflect.Descriptor instead.
+func (*EmptyResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{150}
+}
+
+// ServiceExitingResp service is going to exit response
+type ServiceExitingResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ServiceExitingResp) Reset() {
+	*x = ServiceExitingResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceExitingResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceExitingResp) ProtoMessage() {}
+
+func (x *ServiceExitingResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceExitingResp.ProtoReflect.Descriptor instead.
+func (*ServiceExitingResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{151}
+}
+
+type SettingsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsAutoconnectOnLaunch       bool   `protobuf:"varint,1,opt,name=IsAutoconnectOnLaunch,proto3" json:"IsAutoconnectOnLaunch,omitempty"`
+	IsAutoconnectOnLaunchDaemon bool   `protobuf:"varint,2,opt,name=IsAutoconnectOnLaunchDaemon,proto3" json:"IsAutoconnectOnLaunchDaemon,omitempty"`
+	UserDefinedOvpnFile         string `protobuf:"bytes,3,opt,name=UserDefinedOvpnFile,proto3" json:"UserDefinedOvpnFile,omitempty"`
+	// (for OpenVPN connections)
+	ObfsproxyConfig             *ObfsproxyConfig                 `protobuf:"bytes,4,opt,name=ObfsproxyConfig,proto3" json:"ObfsproxyConfig,omitempty"`
+	V2RayProxy                  int64                            `protobuf:"varint,5,opt,name=V2RayProxy,proto3" json:"V2RayProxy,omitempty"`
+	ShadowsocksProxy            *ShadowsocksConfig               `protobuf:"bytes,6,opt,name=ShadowsocksProxy,proto3" json:"ShadowsocksProxy,omitempty"`
+	AmneziaWG                   *AwgConfig                       `protobuf:"bytes,7,opt,name=AmneziaWG,proto3" json:"AmneziaWG,omitempty"`
+	UserPrefs                   *PreferencesUserPreferences      `protobuf:"bytes,8,opt,name=UserPrefs,proto3" json:"UserPrefs,omitempty"`
+	WiFi                        *PreferencesWiFiParams           `protobuf:"bytes,9,opt,name=WiFi,proto3" json:"WiFi,omitempty"`
+	Schedule                    *PreferencesScheduleParams       `protobuf:"bytes,10,opt,name=Schedule,proto3" json:"Schedule,omitempty"`
+	IsConnectionHistoryDisabled bool                             `protobuf:"varint,11,opt,name=IsConnectionHistoryDisabled,proto3" json:"IsConnectionHistoryDisabled,omitempty"`
+	IsConnectionStatsDisabled   bool                             `protobuf:"varint,12,opt,name=IsConnectionStatsDisabled,proto3" json:"IsConnectionStatsDisabled,omitempty"`
+	DataUsageAlert              *PreferencesDataUsageAlertParams `protobuf:"bytes,13,opt,name=DataUsageAlert,proto3" json:"DataUsageAlert,omitempty"`
+	LatencyProbeMode            string                           `protobuf:"bytes,14,opt,name=LatencyProbeMode,proto3" json:"LatencyProbeMode,omitempty"`
+	FwMssClamp                  int64                            `protobuf:"varint,15,opt,name=FwMssClamp,proto3" json:"FwMssClamp,omitempty"`
+	IsWGKeyHwProtection         bool                             `protobuf:"varint,16,opt,name=IsWGKeyHwProtection,proto3" json:"IsWGKeyHwProtection,omitempty"`
+	IsWgFallbackToOpenVPN       bool                             `protobuf:"varint,17,opt,name=IsWgFallbackToOpenVPN,proto3" json:"IsWgFallbackToOpenVPN,omitempty"`
+	IsWgLanBypass               bool                             `protobuf:"varint,18,opt,name=IsWgLanBypass,proto3" json:"IsWgLanBypass,omitempty"`
+	IsWgPeerFailover            bool                             `protobuf:"varint,19,opt,name=IsWgPeerFailover,proto3" json:"IsWgPeerFailover,omitempty"`
+	WgPortHoppingMinutes        int64                            `protobuf:"varint,20,opt,name=WgPortHoppingMinutes,proto3" json:"WgPortHoppingMinutes,omitempty"`
+	IsWgQuantumResistance       bool                             `protobuf:"varint,21,opt,name=IsWgQuantumResistance,proto3" json:"IsWgQuantumResistance,omitempty"`
+	WgKemAlgorithm              string                           `protobuf:"bytes,22,opt,name=WgKemAlgorithm,proto3" json:"WgKemAlgorithm,omitempty"`
+	IsApiTimeHintAllowed        bool                             `protobuf:"varint,23,opt,name=IsApiTimeHintAllowed,proto3" json:"IsApiTimeHintAllowed,omitempty"`
+	IsCaptivePortalCheck        bool                             `protobuf:"varint,24,opt,name=IsCaptivePortalCheck,proto3" json:"IsCaptivePortalCheck,omitempty"`
+	IsSessionAutoRenew          bool                             `protobuf:"varint,25,opt,name=IsSessionAutoRenew,proto3" json:"IsSessionAutoRenew,omitempty"`
+	IsSettingsEncryption        bool                             `protobuf:"varint,26,opt,name=IsSettingsEncryption,proto3" json:"IsSettingsEncryption,omitempty"`
+	ApiProxy                    *ApiTypesProxyConfig             `protobuf:"bytes,27,opt,name=ApiProxy,proto3" json:"ApiProxy,omitempty"`
+	ApiHostOverride             *ApiTypesAPIHostOverride         `protobuf:"bytes,28,opt,name=ApiHostOverride,proto3" json:"ApiHostOverride,omitempty"`
+	OpenVpnExtraParameters      string                           `protobuf:"bytes,29,opt,name=OpenVpnExtraParameters,proto3" json:"OpenVpnExtraParameters,omitempty"`
+	IsOpenVpnDcoDisabled        bool                             `protobuf:"varint,30,opt,name=IsOpenVpnDcoDisabled,proto3" json:"IsOpenVpnDcoDisabled,omitempty"`
+	OpenVpnCryptoPolicy         *VpnOpenVpnCryptoPolicy          `protobuf:"bytes,31,opt,name=OpenVpnCryptoPolicy,proto3" json:"OpenVpnCryptoPolicy,omitempty"`
+	IsLogJSONFormat             bool                             `protobuf:"varint,32,opt,name=IsLogJSONFormat,proto3" json:"IsLogJSONFormat,omitempty"`
+	LogRotation                 *LoggerRotationConfig            `protobuf:"bytes,33,opt,name=LogRotation,proto3" json:"LogRotation,omitempty"`
+	LogOutput                   string                           `protobuf:"bytes,34,opt,name=LogOutput,proto3" json:"LogOutput,omitempty"`
+	IsLogPrivacyMode            bool                             `protobuf:"varint,35,opt,name=IsLogPrivacyMode,proto3" json:"IsLogPrivacyMode,omitempty"`
+}
+
+func (x *SettingsResp) Reset() {
+	*x = SettingsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettingsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingsResp) ProtoMessage() {}
+
+func (x *SettingsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingsResp.ProtoReflect.Descriptor instead.
+func (*SettingsResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *SettingsResp) GetIsAutoconnectOnLaunch() bool {
+	if x != nil {
+		return x.IsAutoconnectOnLaunch
+	}
+	return false
+}
+
+func (x *SettingsResp) GetIsAutoconnectOnLaunchDaemon() bool {
+	if x != nil {
+		return x.IsAutoconnectOnLaunchDaemon
+	}
+	return false
+}
+
+func (x *SettingsResp) GetUserDefinedOvpnFile() string {
+	if x != nil {
+		return x.UserDefinedOvpnFile
+	}
+	return ""
+}
+
+func (x *SettingsResp) GetObfsproxyConfig() *ObfsproxyConfig {
+	if x != nil {
+		return x.ObfsproxyConfig
+	}
+	return nil
+}
+
+func (x *SettingsResp) GetV2RayProxy() int64 {
+	if x != nil {
+		return x.V2RayProxy
+	}
+	return 0
+}
+
+func (x *SettingsResp) GetShadowsocksProxy() *ShadowsocksConfig {
+	if x != nil {
+		return x.ShadowsocksProxy
+	}
+	return nil
+}
+
+func (x *SettingsResp) GetAmneziaWG() *AwgConfig {
+	if x != nil {
+		return x.AmneziaWG
+	}
+	return nil
+}
+
+func (x *SettingsResp) GetUserPrefs() *PreferencesUserPreferences {
+	if x != nil {
+		return x.UserPrefs
+	}
+	return nil
+}
+
+func (x *SettingsResp) GetWiFi() *PreferencesWiFiParams {
+	if x != nil {
+		return x.WiFi
+	}
+	return nil
+}
+
+func (x *SettingsResp) GetSchedule() *PreferencesScheduleParams {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *SettingsResp) GetIsConnectionHistoryDisabled() bool {
+	if x != nil {
+		return x.IsConnectionHistoryDisabled
+	}
+	return false
+}
+
+func (x *SettingsResp) GetIsConnectionStatsDisabled() bool {
+	if x != nil {
+		return x.IsConnectionStatsDisabled
+	}
+	return false
+}
+
+func (x *SettingsResp) GetDataUsageAlert() *PreferencesDataUsageAlertParams {
+	if x != nil {
+		return x.DataUsageAlert
+	}
+	return nil
+}
+
+func (x *SettingsResp) GetLatencyProbeMode() string {
+	if x != nil {
+		return x.LatencyProbeMode
+	}
+	return ""
+}
+
+func (x *SettingsResp) GetFwMssClamp() int64 {
+	if x != nil {
+		return x.FwMssClamp
+	}
+	return 0
+}
+
+func (x *SettingsResp) GetIsWGKeyHwProtection() bool {
+	if x != nil {
+		return x.IsWGKeyHwProtection
+	}
+	return false
+}
+
+func (x *SettingsResp) GetIsWgFallbackToOpenVPN() bool {
+	if x != nil {
+		return x.IsWgFallbackToOpenVPN
+	}
+	return false
+}
+
+func (x *SettingsResp) GetIsWgLanBypass() bool {
+	if x != nil {
+		return x.IsWgLanBypass
+	}
+	return false
+}
+
+func (x *SettingsResp) GetIsWgPeerFailover() bool {
+	if x != nil {
+		return x.IsWgPeerFailover
+	}
+	return false
+}
+
+func (x *SettingsResp) GetWgPortHoppingMinutes() int64 {
+	if x != nil {
+		return x.WgPortHoppingMinutes
+	}
+	return 0
+}
+
+func (x *SettingsResp) GetIsWgQuantumResistance() bool {
+	if x != nil {
+		return x.IsWgQuantumResistance
+	}
+	return false
+}
+
+func (x *SettingsResp) GetWgKemAlgorithm() string {
+	if x != nil {
+		return x.WgKemAlgorithm
+	}
+	return ""
+}
+
+func (x *SettingsResp) GetIsApiTimeHintAllowed() bool {
+	if x != nil {
+		return x.IsApiTimeHintAllowed
+	}
+	return false
+}
+
+func (x *SettingsResp) GetIsCaptivePortalCheck() bool {
+	if x != nil {
+		return x.IsCaptivePortalCheck
+	}
+	return false
+}
+
+func (x *SettingsResp) GetIsSessionAutoRenew() bool {
+	if x != nil {
+		return x.IsSessionAutoRenew
+	}
+	return false
+}
+
+func (x *SettingsResp) GetIsSettingsEncryption() bool {
+	if x != nil {
+		return x.IsSettingsEncryption
+	}
+	return false
+}
+
+func (x *SettingsResp) GetApiProxy() *ApiTypesProxyConfig {
+	if x != nil {
+		return x.ApiProxy
+	}
+	return nil
+}
+
+func (x *SettingsResp) GetApiHostOverride() *ApiTypesAPIHostOverride {
+	if x != nil {
+		return x.ApiHostOverride
+	}
+	return nil
+}
+
+func (x *SettingsResp) GetOpenVpnExtraParameters() string {
+	if x != nil {
+		return x.OpenVpnExtraParameters
+	}
+	return ""
+}
+
+func (x *SettingsResp) GetIsOpenVpnDcoDisabled() bool {
+	if x != nil {
+		return x.IsOpenVpnDcoDisabled
+	}
+	return false
+}
+
+func (x *SettingsResp) GetOpenVpnCryptoPolicy() *VpnOpenVpnCryptoPolicy {
+	if x != nil {
+		return x.OpenVpnCryptoPolicy
+	}
+	return nil
+}
+
+func (x *SettingsResp) GetIsLogJSONFormat() bool {
+	if x != nil {
+		return x.IsLogJSONFormat
+	}
+	return false
+}
+
+func (x *SettingsResp) GetLogRotation() *LoggerRotationConfig {
+	if x != nil {
+		return x.LogRotation
+	}
+	return nil
+}
+
+func (x *SettingsResp) GetLogOutput() string {
+	if x != nil {
+		return x.LogOutput
+	}
+	return ""
+}
+
+func (x *SettingsResp) GetIsLogPrivacyMode() bool {
+	if x != nil {
+		return x.IsLogPrivacyMode
+	}
+	return false
+}
+
+// DataUsageAlertParams - thresholds of the traffic (received + sent) of the VPN connection.
+// The clients are notified when the threshold is exceeded (0 - the alert is disabled).
+type PreferencesDataUsageAlertParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Traffic of the current connection session (MB)
+	SessionThresholdMB uint64 `protobuf:"varint,1,opt,name=sessionThresholdMB,proto3" json:"sessionThresholdMB,omitempty"`
+	// Traffic of all the connection sessions during the current day (MB; local time)
+	DayThresholdMB uint64 `protobuf:"varint,2,opt,name=dayThresholdMB,proto3" json:"dayThresholdMB,omitempty"`
+}
+
+func (x *PreferencesDataUsageAlertParams) Reset() {
+	*x = PreferencesDataUsageAlertParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesDataUsageAlertParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesDataUsageAlertParams) ProtoMessage() {}
+
+func (x *PreferencesDataUsageAlertParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesDataUsageAlertParams.ProtoReflect.Descriptor instead.
+func (*PreferencesDataUsageAlertParams) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *PreferencesDataUsageAlertParams) GetSessionThresholdMB() uint64 {
+	if x != nil {
+		return x.SessionThresholdMB
+	}
+	return 0
+}
+
+func (x *PreferencesDataUsageAlertParams) GetDayThresholdMB() uint64 {
+	if x != nil {
+		return x.DayThresholdMB
+	}
+	return 0
+}
+
+// HelloResp response on initial request
+type HelloResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version           string                    `protobuf:"bytes,1,opt,name=Version,proto3" json:"Version,omitempty"`
+	ProtocolVersion   int64                     `protobuf:"varint,2,opt,name=ProtocolVersion,proto3" json:"ProtocolVersion,omitempty"`
+	ProcessorArch     string                    `protobuf:"bytes,3,opt,name=ProcessorArch,proto3" json:"ProcessorArch,omitempty"`
+	Session           *SessionResp              `protobuf:"bytes,4,opt,name=Session,proto3" json:"Session,omitempty"`
+	Account           *PreferencesAccountStatus `protobuf:"bytes,5,opt,name=Account,proto3" json:"Account,omitempty"`
+	DisabledFunctions *DisabledFunctionality    `protobuf:"bytes,6,opt,name=DisabledFunctions,proto3" json:"DisabledFunctions,omitempty"`
+	Dns               *DnsAbilities             `protobuf:"bytes,7,opt,name=Dns,proto3" json:"Dns,omitempty"`
+	// SettingsSessionUUID is unique for Preferences object
+	// It allow to detect situations when settings was erased (created new Preferences object)
+	SettingsSessionUUID string              `protobuf:"bytes,8,opt,name=SettingsSessionUUID,proto3" json:"SettingsSessionUUID,omitempty"`
+	ParanoidMode        *ParanoidModeStatus `protobuf:"bytes,9,opt,name=ParanoidMode,proto3" json:"ParanoidMode,omitempty"`
+	DaemonSettings      *SettingsResp       `protobuf:"bytes,10,opt,name=DaemonSettings,proto3" json:"DaemonSettings,omitempty"`
+	// SupportedRequests - list of request types which are supported by the daemon.
+	// Clients can use it to check if the daemon supports a specific command before sending it.
+	SupportedRequests []string `protobuf:"bytes,11,rep,name=SupportedRequests,proto3" json:"SupportedRequests,omitempty"`
+}
+
+func (x *HelloResp) Reset() {
+	*x = HelloResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[154]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HelloResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HelloResp) ProtoMessage() {}
+
+func (x *HelloResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[154]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HelloResp.ProtoReflect.Descriptor instead.
+func (*HelloResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{154}
+}
+
+func (x *HelloResp) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HelloResp) GetProtocolVersion() int64 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *HelloResp) GetProcessorArch() string {
+	if x != nil {
+		return x.ProcessorArch
+	}
+	return ""
+}
+
+func (x *HelloResp) GetSession() *SessionResp {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *HelloResp) GetAccount() *PreferencesAccountStatus {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *HelloResp) GetDisabledFunctions() *DisabledFunctionality {
+	if x != nil {
+		return x.DisabledFunctions
+	}
+	return nil
+}
+
+func (x *HelloResp) GetDns() *DnsAbilities {
+	if x != nil {
+		return x.Dns
+	}
+	return nil
+}
+
+func (x *HelloResp) GetSettingsSessionUUID() string {
+	if x != nil {
+		return x.SettingsSessionUUID
+	}
+	return ""
+}
+
+func (x *HelloResp) GetParanoidMode() *ParanoidModeStatus {
+	if x != nil {
+		return x.ParanoidMode
+	}
+	return nil
+}
+
+func (x *HelloResp) GetDaemonSettings() *SettingsResp {
+	if x != nil {
+		return x.DaemonSettings
+	}
+	return nil
+}
+
+func (x *HelloResp) GetSupportedRequests() []string {
+	if x != nil {
+		return x.SupportedRequests
+	}
+	return nil
+}
+
+// SessionResp information about session
+type SessionResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountID   string `protobuf:"bytes,1,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	Session     string `protobuf:"bytes,2,opt,name=Session,proto3" json:"Session,omitempty"`
+	WgPublicKey string `protobuf:"bytes,3,opt,name=WgPublicKey,proto3" json:"WgPublicKey,omitempty"`
+	WgLocalIP   string `protobuf:"bytes,4,opt,name=WgLocalIP,proto3" json:"WgLocalIP,omitempty"`
+	// Unix time
+	WgKeyGenerated int64 `protobuf:"varint,5,opt,name=WgKeyGenerated,proto3" json:"WgKeyGenerated,omitempty"`
+	// seconds
+	WgKeysRegenInerval int64 `protobuf:"varint,6,opt,name=WgKeysRegenInerval,proto3" json:"WgKeysRegenInerval,omitempty"`
+}
+
+func (x *SessionResp) Reset() {
+	*x = SessionResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[155]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionResp) ProtoMessage() {}
+
+func (x *SessionResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[155]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionResp.ProtoReflect.Descriptor instead.
+func (*SessionResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *SessionResp) GetAccountID() string {
+	if x != nil {
+		return x.AccountID
+	}
+	return ""
+}
+
+func (x *SessionResp) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+func (x *SessionResp) GetWgPublicKey() string {
+	if x != nil {
+		return x.WgPublicKey
+	}
+	return ""
+}
+
+func (x *SessionResp) GetWgLocalIP() string {
+	if x != nil {
+		return x.WgLocalIP
+	}
+	return ""
+}
+
+func (x *SessionResp) GetWgKeyGenerated() int64 {
+	if x != nil {
+		return x.WgKeyGenerated
+	}
+	return 0
+}
+
+func (x *SessionResp) GetWgKeysRegenInerval() int64 {
+	if x != nil {
+		return x.WgKeysRegenInerval
+	}
+	return 0
+}
+
+// AccountStatus contains information about current account
+type PreferencesAccountStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Active         bool     `protobuf:"varint,1,opt,name=Active,proto3" json:"Active,omitempty"`
+	ActiveUntil    int64    `protobuf:"varint,2,opt,name=ActiveUntil,proto3" json:"ActiveUntil,omitempty"`
+	CurrentPlan    string   `protobuf:"bytes,3,opt,name=CurrentPlan,proto3" json:"CurrentPlan,omitempty"`
+	PaymentMethod  string   `protobuf:"bytes,4,opt,name=PaymentMethod,proto3" json:"PaymentMethod,omitempty"`
+	IsRenewable    bool     `protobuf:"varint,5,opt,name=IsRenewable,proto3" json:"IsRenewable,omitempty"`
+	WillAutoRebill bool     `protobuf:"varint,6,opt,name=WillAutoRebill,proto3" json:"WillAutoRebill,omitempty"`
+	IsFreeTrial    bool     `protobuf:"varint,7,opt,name=IsFreeTrial,proto3" json:"IsFreeTrial,omitempty"`
+	Capabilities   []string `protobuf:"bytes,8,rep,name=Capabilities,proto3" json:"Capabilities,omitempty"`
+	Upgradable     bool     `protobuf:"varint,9,opt,name=Upgradable,proto3" json:"Upgradable,omitempty"`
+	UpgradeToPlan  string   `protobuf:"bytes,10,opt,name=UpgradeToPlan,proto3" json:"UpgradeToPlan,omitempty"`
+	UpgradeToURL   string   `protobuf:"bytes,11,opt,name=UpgradeToURL,proto3" json:"UpgradeToURL,omitempty"`
+	Limit          int64    `protobuf:"varint,12,opt,name=Limit,proto3" json:"Limit,omitempty"`
+}
+
+func (x *PreferencesAccountStatus) Reset() {
+	*x = PreferencesAccountStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[156]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesAccountStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesAccountStatus) ProtoMessage() {}
+
+func (x *PreferencesAccountStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[156]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesAccountStatus.ProtoReflect.Descriptor instead.
+func (*PreferencesAccountStatus) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{156}
+}
+
+func (x *PreferencesAccountStatus) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *PreferencesAccountStatus) GetActiveUntil() int64 {
+	if x != nil {
+		return x.ActiveUntil
+	}
+	return 0
+}
+
+func (x *PreferencesAccountStatus) GetCurrentPlan() string {
+	if x != nil {
+		return x.CurrentPlan
+	}
+	return ""
+}
+
+func (x *PreferencesAccountStatus) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *PreferencesAccountStatus) GetIsRenewable() bool {
+	if x != nil {
+		return x.IsRenewable
+	}
+	return false
+}
+
+func (x *PreferencesAccountStatus) GetWillAutoRebill() bool {
+	if x != nil {
+		return x.WillAutoRebill
+	}
+	return false
+}
+
+func (x *PreferencesAccountStatus) GetIsFreeTrial() bool {
+	if x != nil {
+		return x.IsFreeTrial
+	}
+	return false
+}
+
+func (x *PreferencesAccountStatus) GetCapabilities() []string {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
+func (x *PreferencesAccountStatus) GetUpgradable() bool {
+	if x != nil {
+		return x.Upgradable
+	}
+	return false
+}
+
+func (x *PreferencesAccountStatus) GetUpgradeToPlan() string {
+	if x != nil {
+		return x.UpgradeToPlan
+	}
+	return ""
+}
+
+func (x *PreferencesAccountStatus) GetUpgradeToURL() string {
+	if x != nil {
+		return x.UpgradeToURL
+	}
+	return ""
+}
+
+func (x *PreferencesAccountStatus) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// DisabledFunctionality Some functionality can be not accessible
+// It can happen, for example, if some external binaries not installed
+// (e.g. obfsproxy or WireGaurd on Linux)
+type DisabledFunctionality struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WireGuardError   string `protobuf:"bytes,1,opt,name=WireGuardError,proto3" json:"WireGuardError,omitempty"`
+	AmneziaWGError   string `protobuf:"bytes,2,opt,name=AmneziaWGError,proto3" json:"AmneziaWGError,omitempty"`
+	OpenVPNError     string `protobuf:"bytes,3,opt,name=OpenVPNError,proto3" json:"OpenVPNError,omitempty"`
+	ObfsproxyError   string `protobuf:"bytes,4,opt,name=ObfsproxyError,proto3" json:"ObfsproxyError,omitempty"`
+	V2RayError       string `protobuf:"bytes,5,opt,name=V2RayError,proto3" json:"V2RayError,omitempty"`
+	ShadowsocksError string `protobuf:"bytes,6,opt,name=ShadowsocksError,proto3" json:"ShadowsocksError,omitempty"`
+	SplitTunnelError string `protobuf:"bytes,7,opt,name=SplitTunnelError,proto3" json:"SplitTunnelError,omitempty"`
+	// If not empty - it is not possible to protect WireGuard private key by hardware-bound key (TPM 2.0 / Secure Enclave)
+	WGKeyHwProtectionError string `protobuf:"bytes,8,opt,name=WGKeyHwProtectionError,proto3" json:"WGKeyHwProtectionError,omitempty"`
+	// If not empty - the post-quantum preshared key negotiation for WireGuard is not available (KEM helper binary not found)
+	WgQuantumResistanceError string `protobuf:"bytes,9,opt,name=WgQuantumResistanceError,proto3" json:"WgQuantumResistanceError,omitempty"`
+	// Linux specific functionality which is disabled
+	Platform *DisabledFunctionalityForPlatform `protobuf:"bytes,10,opt,name=Platform,proto3" json:"Platform,omitempty"`
+}
+
+func (x *DisabledFunctionality) Reset() {
+	*x = DisabledFunctionality{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[157]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisabledFunctionality) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisabledFunctionality) ProtoMessage() {}
+
+func (x *DisabledFunctionality) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[157]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisabledFunctionality.ProtoReflect.Descriptor instead.
+func (*DisabledFunctionality) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{157}
+}
+
+func (x *DisabledFunctionality) GetWireGuardError() string {
+	if x != nil {
+		return x.WireGuardError
+	}
+	return ""
+}
+
+func (x *DisabledFunctionality) GetAmneziaWGError() string {
+	if x != nil {
+		return x.AmneziaWGError
+	}
+	return ""
+}
+
+func (x *DisabledFunctionality) GetOpenVPNError() string {
+	if x != nil {
+		return x.OpenVPNError
+	}
+	return ""
+}
+
+func (x *DisabledFunctionality) GetObfsproxyError() string {
+	if x != nil {
+		return x.ObfsproxyError
+	}
+	return ""
+}
+
+func (x *DisabledFunctionality) GetV2RayError() string {
+	if x != nil {
+		return x.V2RayError
+	}
+	return ""
+}
+
+func (x *DisabledFunctionality) GetShadowsocksError() string {
+	if x != nil {
+		return x.ShadowsocksError
+	}
+	return ""
+}
+
+func (x *DisabledFunctionality) GetSplitTunnelError() string {
+	if x != nil {
+		return x.SplitTunnelError
+	}
+	return ""
+}
+
+func (x *DisabledFunctionality) GetWGKeyHwProtectionError() string {
+	if x != nil {
+		return x.WGKeyHwProtectionError
+	}
+	return ""
+}
+
+func (x *DisabledFunctionality) GetWgQuantumResistanceError() string {
+	if x != nil {
+		return x.WgQuantumResistanceError
+	}
+	return ""
+}
+
+func (x *DisabledFunctionality) GetPlatform() *DisabledFunctionalityForPlatform {
+	if x != nil {
+		return x.Platform
+	}
+	return nil
+}
+
+type DisabledFunctionalityForPlatform struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Linux specific functionality which is disabled
+	Linux *DisabledFunctionalityLinux `protobuf:"bytes,1,opt,name=Linux,proto3" json:"Linux,omitempty"`
+}
+
+func (x *DisabledFunctionalityForPlatform) Reset() {
+	*x = DisabledFunctionalityForPlatform{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[158]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisabledFunctionalityForPlatform) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisabledFunctionalityForPlatform) ProtoMessage() {}
+
+func (x *DisabledFunctionalityForPlatform) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[158]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisabledFunctionalityForPlatform.ProtoReflect.Descriptor instead.
+func (*DisabledFunctionalityForPlatform) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{158}
+}
+
+func (x *DisabledFunctionalityForPlatform) GetLinux() *DisabledFunctionalityLinux {
+	if x != nil {
+		return x.Linux
+	}
+	return nil
+}
+
+type DisabledFunctionalityLinux struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// If not empty - it is not possible to use the old way of DNS management
+	// (which is based on a direct change of '/etc/resolv.conf')
+	// For example: It could be because of snap environment (it does not allow to modify '/etc/resolv.conf')
+	DnsMgmtOldResolvconfError string `protobuf:"bytes,1,opt,name=DnsMgmtOldResolvconfError,proto3" json:"DnsMgmtOldResolvconfError,omitempty"`
+	// If not empty - it is not possible to use modern way of DNS management
+	// (based on communicationd with 'resolved' using 'resolvectl')
+	// There could be different reasons of it:
+	// 	- there is no 'resolvectl' binary on target system
+	// 	- 'resolvectl' initialisation try was failed
+	DnsMgmtNewResolvectlError string `protobuf:"bytes,2,opt,name=DnsMgmtNewResolvectlError,proto3" json:"DnsMgmtNewResolvectlError,omitempty"`
+}
+
+func (x *DisabledFunctionalityLinux) Reset() {
+	*x = DisabledFunctionalityLinux{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[159]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisabledFunctionalityLinux) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisabledFunctionalityLinux) ProtoMessage() {}
+
+func (x *DisabledFunctionalityLinux) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[159]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisabledFunctionalityLinux.ProtoReflect.Descriptor instead.
+func (*DisabledFunctionalityLinux) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{159}
+}
+
+func (x *DisabledFunctionalityLinux) GetDnsMgmtOldResolvconfError() string {
+	if x != nil {
+		return x.DnsMgmtOldResolvconfError
+	}
+	return ""
+}
+
+func (x *DisabledFunctionalityLinux) GetDnsMgmtNewResolvectlError() string {
+	if x != nil {
+		return x.DnsMgmtNewResolvectlError
+	}
+	return ""
+}
+
+type DnsAbilities struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CanUseDnsOverTls   bool `protobuf:"varint,1,opt,name=CanUseDnsOverTls,proto3" json:"CanUseDnsOverTls,omitempty"`
+	CanUseDnsOverHttps bool `protobuf:"varint,2,opt,name=CanUseDnsOverHttps,proto3" json:"CanUseDnsOverHttps,omitempty"`
+}
+
+func (x *DnsAbilities) Reset() {
+	*x = DnsAbilities{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[160]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DnsAbilities) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DnsAbilities) ProtoMessage() {}
+
+func (x *DnsAbilities) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[160]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DnsAbilities.ProtoReflect.Descriptor instead.
+func (*DnsAbilities) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{160}
+}
+
+func (x *DnsAbilities) GetCanUseDnsOverTls() bool {
+	if x != nil {
+		return x.CanUseDnsOverTls
+	}
+	return false
+}
+
+func (x *DnsAbilities) GetCanUseDnsOverHttps() bool {
+	if x != nil {
+		return x.CanUseDnsOverHttps
+	}
+	return false
+}
+
+type ParanoidModeStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsEnabled bool `protobuf:"varint,1,opt,name=IsEnabled,proto3" json:"IsEnabled,omitempty"`
+}
+
+func (x *ParanoidModeStatus) Reset() {
+	*x = ParanoidModeStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[161]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ParanoidModeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ParanoidModeStatus) ProtoMessage() {}
+
+func (x *ParanoidModeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[161]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ParanoidModeStatus.ProtoReflect.Descriptor instead.
+func (*ParanoidModeStatus) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{161}
+}
+
+func (x *ParanoidModeStatus) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+// SessionNewResp - information about created session (or error info)
+type SessionNewResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	APIStatus       int64                     `protobuf:"varint,1,opt,name=APIStatus,proto3" json:"APIStatus,omitempty"`
+	APIErrorMessage string                    `protobuf:"bytes,2,opt,name=APIErrorMessage,proto3" json:"APIErrorMessage,omitempty"`
+	Session         *SessionResp              `protobuf:"bytes,3,opt,name=Session,proto3" json:"Session,omitempty"`
+	Account         *PreferencesAccountStatus `protobuf:"bytes,4,opt,name=Account,proto3" json:"Account,omitempty"`
+	RawResponse     string                    `protobuf:"bytes,5,opt,name=RawResponse,proto3" json:"RawResponse,omitempty"`
+	// Login requires additional confirmation: the SessionNew request has to be repeated with the required fields
+	Is2FARequired bool `protobuf:"varint,6,opt,name=Is2FARequired,proto3" json:"Is2FARequired,omitempty"`
+	// captcha required (or the specified captcha solution is not valid)
+	IsCaptchaRequired bool `protobuf:"varint,7,opt,name=IsCaptchaRequired,proto3" json:"IsCaptchaRequired,omitempty"`
+	// the captcha to be solved (when IsCaptchaRequired)
+	Captcha *ApiTypesCaptchaInfo `protobuf:"bytes,8,opt,name=Captcha,proto3" json:"Captcha,omitempty"`
+}
+
+func (x *SessionNewResp) Reset() {
+	*x = SessionNewResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[162]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SessionNewResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SessionNewResp) ProtoMessage() {}
+
+func (x *SessionNewResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[162]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SessionNewResp.ProtoReflect.Descriptor instead.
+func (*SessionNewResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{162}
+}
+
+func (x *SessionNewResp) GetAPIStatus() int64 {
+	if x != nil {
+		return x.APIStatus
+	}
+	return 0
+}
+
+func (x *SessionNewResp) GetAPIErrorMessage() string {
+	if x != nil {
+		return x.APIErrorMessage
+	}
+	return ""
+}
+
+func (x *SessionNewResp) GetSession() *SessionResp {
+	if x != nil {
+		return x.Session
+	}
+	return nil
+}
+
+func (x *SessionNewResp) GetAccount() *PreferencesAccountStatus {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *SessionNewResp) GetRawResponse() string {
+	if x != nil {
+		return x.RawResponse
+	}
+	return ""
+}
+
+func (x *SessionNewResp) GetIs2FARequired() bool {
+	if x != nil {
+		return x.Is2FARequired
+	}
+	return false
+}
+
+func (x *SessionNewResp) GetIsCaptchaRequired() bool {
+	if x != nil {
+		return x.IsCaptchaRequired
+	}
+	return false
+}
+
+func (x *SessionNewResp) GetCaptcha() *ApiTypesCaptchaInfo {
+	if x != nil {
+		return x.Captcha
+	}
+	return nil
+}
+
+// CaptchaInfo - the captcha to be solved by the user (login requires captcha)
+type ApiTypesCaptchaInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CaptchaId string `protobuf:"bytes,1,opt,name=captcha_id,proto3" json:"captcha_id,omitempty"`
+	// base64-encoded image (can be in 'data URL' format)
+	CaptchaImage string `protobuf:"bytes,2,opt,name=captcha_image,proto3" json:"captcha_image,omitempty"`
+}
+
+func (x *ApiTypesCaptchaInfo) Reset() {
+	*x = ApiTypesCaptchaInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[163]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesCaptchaInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesCaptchaInfo) ProtoMessage() {}
+
+func (x *ApiTypesCaptchaInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[163]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesCaptchaInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesCaptchaInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *ApiTypesCaptchaInfo) GetCaptchaId() string {
+	if x != nil {
+		return x.CaptchaId
+	}
+	return ""
+}
+
+func (x *ApiTypesCaptchaInfo) GetCaptchaImage() string {
+	if x != nil {
+		return x.CaptchaImage
+	}
+	return ""
+}
+
+// AccountsResp - the list of logged-in accounts (the active account first)
+type AccountsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Accounts []*AccountInfo `protobuf:"bytes,1,rep,name=Accounts,proto3" json:"Accounts,omitempty"`
+}
+
+func (x *AccountsResp) Reset() {
+	*x = AccountsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[164]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountsResp) ProtoMessage() {}
+
+func (x *AccountsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[164]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountsResp.ProtoReflect.Descriptor instead.
+func (*AccountsResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{164}
+}
+
+func (x *AccountsResp) GetAccounts() []*AccountInfo {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+// AccountInfo - the logged-in account (part of AccountsResp)
+type AccountInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountID string `protobuf:"bytes,1,opt,name=AccountID,proto3" json:"AccountID,omitempty"`
+	IsActive  bool   `protobuf:"varint,2,opt,name=IsActive,proto3" json:"IsActive,omitempty"`
+	// Last known account status
+	Account *PreferencesAccountStatus `protobuf:"bytes,3,opt,name=Account,proto3" json:"Account,omitempty"`
+}
+
+func (x *AccountInfo) Reset() {
+	*x = AccountInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[165]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountInfo) ProtoMessage() {}
+
+func (x *AccountInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[165]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
+func (*AccountInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{165}
+}
+
+func (x *AccountInfo) GetAccountID() string {
+	if x != nil {
+		return x.AccountID
+	}
+	return ""
+}
+
+func (x *AccountInfo) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *AccountInfo) GetAccount() *PreferencesAccountStatus {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// DevicesResp - devices (active sessions) of the account (or API error info)
+// Response for 'DevicesList' and 'DeviceLogout' requests (for 'DeviceLogout' the Devices field is empty)
+type DevicesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 - no API error
+	APIStatus       int64                 `protobuf:"varint,1,opt,name=APIStatus,proto3" json:"APIStatus,omitempty"`
+	APIErrorMessage string                `protobuf:"bytes,2,opt,name=APIErrorMessage,proto3" json:"APIErrorMessage,omitempty"`
+	Devices         []*ApiTypesDeviceInfo `protobuf:"bytes,3,rep,name=Devices,proto3" json:"Devices,omitempty"`
+}
+
+func (x *DevicesResp) Reset() {
+	*x = DevicesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[166]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DevicesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DevicesResp) ProtoMessage() {}
+
+func (x *DevicesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[166]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DevicesResp.ProtoReflect.Descriptor instead.
+func (*DevicesResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{166}
+}
+
+func (x *DevicesResp) GetAPIStatus() int64 {
+	if x != nil {
+		return x.APIStatus
+	}
+	return 0
+}
+
+func (x *DevicesResp) GetAPIErrorMessage() string {
+	if x != nil {
+		return x.APIErrorMessage
+	}
+	return ""
+}
+
+func (x *DevicesResp) GetDevices() []*ApiTypesDeviceInfo {
+	if x != nil {
+		return x.Devices
+	}
+	return nil
+}
+
+// DeviceInfo - device (active session) of the account
+type ApiTypesDeviceInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DeviceName string `protobuf:"bytes,2,opt,name=device_name,proto3" json:"device_name,omitempty"`
+	Platform   string `protobuf:"bytes,3,opt,name=platform,proto3" json:"platform,omitempty"`
+	// Unix time
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at,proto3" json:"created_at,omitempty"`
+	// Unix time
+	LastSeen int64 `protobuf:"varint,5,opt,name=last_seen,proto3" json:"last_seen,omitempty"`
+	// the session of the requestor (only when authenticated by the session token)
+	IsCurrent bool `protobuf:"varint,6,opt,name=is_current,proto3" json:"is_current,omitempty"`
+}
+
+func (x *ApiTypesDeviceInfo) Reset() {
+	*x = ApiTypesDeviceInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[167]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesDeviceInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesDeviceInfo) ProtoMessage() {}
+
+func (x *ApiTypesDeviceInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[167]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesDeviceInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesDeviceInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{167}
+}
+
+func (x *ApiTypesDeviceInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ApiTypesDeviceInfo) GetDeviceName() string {
+	if x != nil {
+		return x.DeviceName
+	}
+	return ""
+}
+
+func (x *ApiTypesDeviceInfo) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *ApiTypesDeviceInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ApiTypesDeviceInfo) GetLastSeen() int64 {
+	if x != nil {
+		return x.LastSeen
+	}
+	return 0
+}
+
+func (x *ApiTypesDeviceInfo) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+// AccountStatusResp - information about account status (or error info)
+type AccountStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	APIStatus       int64                     `protobuf:"varint,1,opt,name=APIStatus,proto3" json:"APIStatus,omitempty"`
+	APIErrorMessage string                    `protobuf:"bytes,2,opt,name=APIErrorMessage,proto3" json:"APIErrorMessage,omitempty"`
+	SessionToken    string                    `protobuf:"bytes,3,opt,name=SessionToken,proto3" json:"SessionToken,omitempty"`
+	Account         *PreferencesAccountStatus `protobuf:"bytes,4,opt,name=Account,proto3" json:"Account,omitempty"`
+}
+
+func (x *AccountStatusResp) Reset() {
+	*x = AccountStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[168]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountStatusResp) ProtoMessage() {}
+
+func (x *AccountStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[168]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountStatusResp.ProtoReflect.Descriptor instead.
+func (*AccountStatusResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{168}
+}
+
+func (x *AccountStatusResp) GetAPIStatus() int64 {
+	if x != nil {
+		return x.APIStatus
+	}
+	return 0
+}
+
+func (x *AccountStatusResp) GetAPIErrorMessage() string {
+	if x != nil {
+		return x.APIErrorMessage
+	}
+	return ""
+}
+
+func (x *AccountStatusResp) GetSessionToken() string {
+	if x != nil {
+		return x.SessionToken
+	}
+	return ""
+}
+
+func (x *AccountStatusResp) GetAccount() *PreferencesAccountStatus {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+// KillSwitchStatusResp returns kill-switch status
+type KillSwitchStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsEnabled         bool `protobuf:"varint,1,opt,name=IsEnabled,proto3" json:"IsEnabled,omitempty"`
+	IsPersistent      bool `protobuf:"varint,2,opt,name=IsPersistent,proto3" json:"IsPersistent,omitempty"`
+	IsAllowLAN        bool `protobuf:"varint,3,opt,name=IsAllowLAN,proto3" json:"IsAllowLAN,omitempty"`
+	IsAllowMulticast  bool `protobuf:"varint,4,opt,name=IsAllowMulticast,proto3" json:"IsAllowMulticast,omitempty"`
+	IsAllowApiServers bool `protobuf:"varint,5,opt,name=IsAllowApiServers,proto3" json:"IsAllowApiServers,omitempty"`
+	// Firewall exceptions: comma separated list of IP addresses (masks) in format: x.x.x.x[/xx]
+	UserExceptions string `protobuf:"bytes,6,opt,name=UserExceptions,proto3" json:"UserExceptions,omitempty"`
+	// LAN services allowed individually (when LAN communication is blocked)
+	AllowLANServices *LansvcServices `protobuf:"bytes,7,opt,name=AllowLANServices,proto3" json:"AllowLANServices,omitempty"`
+	// LAN hosts allowed when LAN communication is blocked: comma separated list of IP addresses
+	AllowedLANHosts string `protobuf:"bytes,8,opt,name=AllowedLANHosts,proto3" json:"AllowedLANHosts,omitempty"`
+	// local ports which accept incoming connections from LAN (when LAN communication is blocked)
+	LANInboundPorts []*LansvcInboundPort `protobuf:"bytes,9,rep,name=LANInboundPorts,proto3" json:"LANInboundPorts,omitempty"`
+}
+
+func (x *KillSwitchStatusResp) Reset() {
+	*x = KillSwitchStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[169]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchStatusResp) ProtoMessage() {}
+
+func (x *KillSwitchStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[169]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchStatusResp.ProtoReflect.Descriptor instead.
+func (*KillSwitchStatusResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *KillSwitchStatusResp) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *KillSwitchStatusResp) GetIsPersistent() bool {
+	if x != nil {
+		return x.IsPersistent
+	}
+	return false
+}
+
+func (x *KillSwitchStatusResp) GetIsAllowLAN() bool {
+	if x != nil {
+		return x.IsAllowLAN
+	}
+	return false
+}
+
+func (x *KillSwitchStatusResp) GetIsAllowMulticast() bool {
+	if x != nil {
+		return x.IsAllowMulticast
+	}
+	return false
+}
+
+func (x *KillSwitchStatusResp) GetIsAllowApiServers() bool {
+	if x != nil {
+		return x.IsAllowApiServers
+	}
+	return false
+}
+
+func (x *KillSwitchStatusResp) GetUserExceptions() string {
+	if x != nil {
+		return x.UserExceptions
+	}
+	return ""
+}
+
+func (x *KillSwitchStatusResp) GetAllowLANServices() *LansvcServices {
+	if x != nil {
+		return x.AllowLANServices
+	}
+	return nil
+}
+
+func (x *KillSwitchStatusResp) GetAllowedLANHosts() string {
+	if x != nil {
+		return x.AllowedLANHosts
+	}
+	return ""
+}
+
+func (x *KillSwitchStatusResp) GetLANInboundPorts() []*LansvcInboundPort {
+	if x != nil {
+		return x.LANInboundPorts
+	}
+	return nil
+}
+
+// KillSwitchGetIsPestistentResp returns kill-switch persistance status
+type KillSwitchGetIsPestistentResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsPersistent bool `protobuf:"varint,1,opt,name=IsPersistent,proto3" json:"IsPersistent,omitempty"`
+}
+
+func (x *KillSwitchGetIsPestistentResp) Reset() {
+	*x = KillSwitchGetIsPestistentResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[170]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KillSwitchGetIsPestistentResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillSwitchGetIsPestistentResp) ProtoMessage() {}
+
+func (x *KillSwitchGetIsPestistentResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[170]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillSwitchGetIsPestistentResp.ProtoReflect.Descriptor instead.
+func (*KillSwitchGetIsPestistentResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{170}
+}
+
+func (x *KillSwitchGetIsPestistentResp) GetIsPersistent() bool {
+	if x != nil {
+		return x.IsPersistent
+	}
+	return false
+}
+
+// DiagnosticsGeneratedResp returns info from daemon logs
+type DiagnosticsGeneratedResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// previous daemon session log
+	Log0_Old string `protobuf:"bytes,1,opt,name=Log0_Old,proto3" json:"Log0_Old,omitempty"`
+	// active daemon log
+	Log1_Active string `protobuf:"bytes,2,opt,name=Log1_Active,proto3" json:"Log1_Active,omitempty"`
+	// Extra info for logging (e.g. ifconfig, netstat -nr ... etc.)
+	ExtraInfo string `protobuf:"bytes,3,opt,name=ExtraInfo,proto3" json:"ExtraInfo,omitempty"`
+}
+
+func (x *DiagnosticsGeneratedResp) Reset() {
+	*x = DiagnosticsGeneratedResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[171]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiagnosticsGeneratedResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticsGeneratedResp) ProtoMessage() {}
+
+func (x *DiagnosticsGeneratedResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[171]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticsGeneratedResp.ProtoReflect.Descriptor instead.
+func (*DiagnosticsGeneratedResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *DiagnosticsGeneratedResp) GetLog0_Old() string {
+	if x != nil {
+		return x.Log0_Old
+	}
+	return ""
+}
+
+func (x *DiagnosticsGeneratedResp) GetLog1_Active() string {
+	if x != nil {
+		return x.Log1_Active
+	}
+	return ""
+}
+
+func (x *DiagnosticsGeneratedResp) GetExtraInfo() string {
+	if x != nil {
+		return x.ExtraInfo
+	}
+	return ""
+}
+
+// SetAlternateDNSResp returns status of changing DNS
+type SetAlternateDNSResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsSuccess    bool            `protobuf:"varint,1,opt,name=IsSuccess,proto3" json:"IsSuccess,omitempty"`
+	ChangedDNS   *DnsDnsSettings `protobuf:"bytes,2,opt,name=ChangedDNS,proto3" json:"ChangedDNS,omitempty"`
+	ErrorMessage string          `protobuf:"bytes,3,opt,name=ErrorMessage,proto3" json:"ErrorMessage,omitempty"`
+}
+
+func (x *SetAlternateDNSResp) Reset() {
+	*x = SetAlternateDNSResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[172]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetAlternateDNSResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAlternateDNSResp) ProtoMessage() {}
+
+func (x *SetAlternateDNSResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[172]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAlternateDNSResp.ProtoReflect.Descriptor instead.
+func (*SetAlternateDNSResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{172}
+}
+
+func (x *SetAlternateDNSResp) GetIsSuccess() bool {
+	if x != nil {
+		return x.IsSuccess
+	}
+	return false
+}
+
+func (x *SetAlternateDNSResp) GetChangedDNS() *DnsDnsSettings {
+	if x != nil {
+		return x.ChangedDNS
+	}
+	return nil
+}
+
+func (x *SetAlternateDNSResp) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// DnsPredefinedConfigsResp list of predefined DoH/DoT configurations (if exists)
+type DnsPredefinedConfigsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DnsConfigs []*DnsDnsSettings `protobuf:"bytes,1,rep,name=DnsConfigs,proto3" json:"DnsConfigs,omitempty"`
+}
+
+func (x *DnsPredefinedConfigsResp) Reset() {
+	*x = DnsPredefinedConfigsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[173]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DnsPredefinedConfigsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DnsPredefinedConfigsResp) ProtoMessage() {}
+
+func (x *DnsPredefinedConfigsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[173]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DnsPredefinedConfigsResp.ProtoReflect.Descriptor instead.
+func (*DnsPredefinedConfigsResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{173}
+}
+
+func (x *DnsPredefinedConfigsResp) GetDnsConfigs() []*DnsDnsSettings {
+	if x != nil {
+		return x.DnsConfigs
+	}
+	return nil
+}
+
+// ConnectedResp notifying about established connection
+type ConnectedResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VpnType         int64  `protobuf:"varint,1,opt,name=VpnType,proto3" json:"VpnType,omitempty"`
+	TimeSecFrom1970 int64  `protobuf:"varint,2,opt,name=TimeSecFrom1970,proto3" json:"TimeSecFrom1970,omitempty"`
+	ClientIP        string `protobuf:"bytes,3,opt,name=ClientIP,proto3" json:"ClientIP,omitempty"`
+	ClientIPv6      string `protobuf:"bytes,4,opt,name=ClientIPv6,proto3" json:"ClientIPv6,omitempty"`
+	ServerIP        string `protobuf:"bytes,5,opt,name=ServerIP,proto3" json:"ServerIP,omitempty"`
+	ServerPort      int64  `protobuf:"varint,6,opt,name=ServerPort,proto3" json:"ServerPort,omitempty"`
+	// multi-hop exit hostname (e.g. "us-tx1.wg.ivpn.net")
+	ExitHostname string          `protobuf:"bytes,7,opt,name=ExitHostname,proto3" json:"ExitHostname,omitempty"`
+	ManualDNS    *DnsDnsSettings `protobuf:"bytes,8,opt,name=ManualDNS,proto3" json:"ManualDNS,omitempty"`
+	IsCanPause   bool            `protobuf:"varint,9,opt,name=IsCanPause,proto3" json:"IsCanPause,omitempty"`
+	IsTCP        bool            `protobuf:"varint,10,opt,name=IsTCP,proto3" json:"IsTCP,omitempty"`
+	// (for WireGuard connections)
+	Mtu int64 `protobuf:"varint,11,opt,name=Mtu,proto3" json:"Mtu,omitempty"`
+	// V2Ray transport in use
+	V2RayProxy int64 `protobuf:"varint,12,opt,name=V2RayProxy,proto3" json:"V2RayProxy,omitempty"`
+	// connection is chained through Shadowsocks server
+	IsShadowsocks bool `protobuf:"varint,13,opt,name=IsShadowsocks,proto3" json:"IsShadowsocks,omitempty"`
+	// connection is established using the user-defined configuration
+	IsCustomConfig bool `protobuf:"varint,14,opt,name=IsCustomConfig,proto3" json:"IsCustomConfig,omitempty"`
+	// the average round-trip time to the VPN server from the latest connection quality report (0 - unknown).
+	// The connection quality reports are sent periodically (ConnectionQualityResp)
+	LatencyMs float64 `protobuf:"fixed64,15,opt,name=LatencyMs,proto3" json:"LatencyMs,omitempty"`
+}
+
+func (x *ConnectedResp) Reset() {
+	*x = ConnectedResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[174]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectedResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectedResp) ProtoMessage() {}
+
+func (x *ConnectedResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[174]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectedResp.ProtoReflect.Descriptor instead.
+func (*ConnectedResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{174}
+}
+
+func (x *ConnectedResp) GetVpnType() int64 {
+	if x != nil {
+		return x.VpnType
+	}
+	return 0
+}
+
+func (x *ConnectedResp) GetTimeSecFrom1970() int64 {
+	if x != nil {
+		return x.TimeSecFrom1970
+	}
+	return 0
+}
+
+func (x *ConnectedResp) GetClientIP() string {
+	if x != nil {
+		return x.ClientIP
+	}
+	return ""
+}
+
+func (x *ConnectedResp) GetClientIPv6() string {
+	if x != nil {
+		return x.ClientIPv6
+	}
+	return ""
+}
+
+func (x *ConnectedResp) GetServerIP() string {
+	if x != nil {
+		return x.ServerIP
+	}
+	return ""
+}
+
+func (x *ConnectedResp) GetServerPort() int64 {
+	if x != nil {
+		return x.ServerPort
+	}
+	return 0
+}
+
+func (x *ConnectedResp) GetExitHostname() string {
+	if x != nil {
+		return x.ExitHostname
+	}
+	return ""
+}
+
+func (x *ConnectedResp) GetManualDNS() *DnsDnsSettings {
+	if x != nil {
+		return x.ManualDNS
+	}
+	return nil
+}
+
+func (x *ConnectedResp) GetIsCanPause() bool {
+	if x != nil {
+		return x.IsCanPause
+	}
+	return false
+}
+
+func (x *ConnectedResp) GetIsTCP() bool {
+	if x != nil {
+		return x.IsTCP
+	}
+	return false
+}
+
+func (x *ConnectedResp) GetMtu() int64 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *ConnectedResp) GetV2RayProxy() int64 {
+	if x != nil {
+		return x.V2RayProxy
+	}
+	return 0
+}
+
+func (x *ConnectedResp) GetIsShadowsocks() bool {
+	if x != nil {
+		return x.IsShadowsocks
+	}
+	return false
+}
+
+func (x *ConnectedResp) GetIsCustomConfig() bool {
+	if x != nil {
+		return x.IsCustomConfig
+	}
+	return false
+}
+
+func (x *ConnectedResp) GetLatencyMs() float64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+// DisconnectedResp notifying about stopped connetion
+type DisconnectedResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Failure bool `protobuf:"varint,1,opt,name=Failure,proto3" json:"Failure,omitempty"`
+	// int
+	Reason            int64  `protobuf:"varint,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	ReasonDescription string `protobuf:"bytes,3,opt,name=ReasonDescription,proto3" json:"ReasonDescription,omitempty"`
+}
+
+func (x *DisconnectedResp) Reset() {
+	*x = DisconnectedResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[175]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectedResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectedResp) ProtoMessage() {}
+
+func (x *DisconnectedResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[175]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectedResp.ProtoReflect.Descriptor instead.
+func (*DisconnectedResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{175}
+}
+
+func (x *DisconnectedResp) GetFailure() bool {
+	if x != nil {
+		return x.Failure
+	}
+	return false
+}
+
+func (x *DisconnectedResp) GetReason() int64 {
+	if x != nil {
+		return x.Reason
+	}
+	return 0
+}
+
+func (x *DisconnectedResp) GetReasonDescription() string {
+	if x != nil {
+		return x.ReasonDescription
+	}
+	return ""
+}
+
+// ConnectionHistoryResp contains recently used connection parameters (the most recent first)
+type ConnectionHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsDisabled bool                                `protobuf:"varint,1,opt,name=IsDisabled,proto3" json:"IsDisabled,omitempty"`
+	Items      []*PreferencesConnectionHistoryItem `protobuf:"bytes,2,rep,name=Items,proto3" json:"Items,omitempty"`
+}
+
+func (x *ConnectionHistoryResp) Reset() {
+	*x = ConnectionHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[176]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionHistoryResp) ProtoMessage() {}
+
+func (x *ConnectionHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[176]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionHistoryResp.ProtoReflect.Descriptor instead.
+func (*ConnectionHistoryResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{176}
+}
+
+func (x *ConnectionHistoryResp) GetIsDisabled() bool {
+	if x != nil {
+		return x.IsDisabled
+	}
+	return false
+}
+
+func (x *ConnectionHistoryResp) GetItems() []*PreferencesConnectionHistoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ConnectionHistoryItem - connection parameters which were in use for connection
+type PreferencesConnectionHistoryItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// unix time (seconds) of the last connection with this parameters
+	Time   int64                         `protobuf:"varint,1,opt,name=Time,proto3" json:"Time,omitempty"`
+	Params *ServiceTypesConnectionParams `protobuf:"bytes,2,opt,name=Params,proto3" json:"Params,omitempty"`
+}
+
+func (x *PreferencesConnectionHistoryItem) Reset() {
+	*x = PreferencesConnectionHistoryItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[177]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesConnectionHistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesConnectionHistoryItem) ProtoMessage() {}
+
+func (x *PreferencesConnectionHistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[177]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesConnectionHistoryItem.ProtoReflect.Descriptor instead.
+func (*PreferencesConnectionHistoryItem) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{177}
+}
+
+func (x *PreferencesConnectionHistoryItem) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *PreferencesConnectionHistoryItem) GetParams() *ServiceTypesConnectionParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// ConnectionStatsHistoryResp contains the statistics of the recent connection sessions and the daily aggregates (the most recent first)
+type ConnectionStatsHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsDisabled bool                      `protobuf:"varint,1,opt,name=IsDisabled,proto3" json:"IsDisabled,omitempty"`
+	Sessions   []*ConnstatsSessionRecord `protobuf:"bytes,2,rep,name=Sessions,proto3" json:"Sessions,omitempty"`
+	Days       []*ConnstatsDayRecord     `protobuf:"bytes,3,rep,name=Days,proto3" json:"Days,omitempty"`
+}
+
+func (x *ConnectionStatsHistoryResp) Reset() {
+	*x = ConnectionStatsHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[178]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionStatsHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStatsHistoryResp) ProtoMessage() {}
+
+func (x *ConnectionStatsHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[178]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStatsHistoryResp.ProtoReflect.Descriptor instead.
+func (*ConnectionStatsHistoryResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{178}
+}
+
+func (x *ConnectionStatsHistoryResp) GetIsDisabled() bool {
+	if x != nil {
+		return x.IsDisabled
+	}
+	return false
+}
+
+func (x *ConnectionStatsHistoryResp) GetSessions() []*ConnstatsSessionRecord {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *ConnectionStatsHistoryResp) GetDays() []*ConnstatsDayRecord {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// SessionRecord - statistics of the connection session
+type ConnstatsSessionRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// hostname of the (entry) server
+	Server string `protobuf:"bytes,1,opt,name=Server,proto3" json:"Server,omitempty"`
+	// hostname of the Multi-Hop exit server
+	ExitServer string `protobuf:"bytes,2,opt,name=ExitServer,proto3" json:"ExitServer,omitempty"`
+	// "WireGuard", "OpenVPN"
+	VpnType string `protobuf:"bytes,3,opt,name=VpnType,proto3" json:"VpnType,omitempty"`
+	// e.g. "UDP", "TCP"
+	Protocol string `protobuf:"bytes,4,opt,name=Protocol,proto3" json:"Protocol,omitempty"`
+	Started  string `protobuf:"bytes,5,opt,name=Started,proto3" json:"Started,omitempty"`
+	Duration int64  `protobuf:"varint,6,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	RxBytes  uint64 `protobuf:"varint,7,opt,name=RxBytes,proto3" json:"RxBytes,omitempty"`
+	TxBytes  uint64 `protobuf:"varint,8,opt,name=TxBytes,proto3" json:"TxBytes,omitempty"`
+	// 'true' when the session is active (not finished yet)
+	IsActive bool `protobuf:"varint,9,opt,name=IsActive,proto3" json:"IsActive,omitempty"`
+}
+
+func (x *ConnstatsSessionRecord) Reset() {
+	*x = ConnstatsSessionRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[179]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnstatsSessionRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnstatsSessionRecord) ProtoMessage() {}
+
+func (x *ConnstatsSessionRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[179]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnstatsSessionRecord.ProtoReflect.Descriptor instead.
+func (*ConnstatsSessionRecord) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{179}
+}
+
+func (x *ConnstatsSessionRecord) GetServer() string {
+	if x != nil {
+		return x.Server
+	}
+	return ""
+}
+
+func (x *ConnstatsSessionRecord) GetExitServer() string {
+	if x != nil {
+		return x.ExitServer
+	}
+	return ""
+}
+
+func (x *ConnstatsSessionRecord) GetVpnType() string {
+	if x != nil {
+		return x.VpnType
+	}
+	return ""
+}
+
+func (x *ConnstatsSessionRecord) GetProtocol() string {
+	if x != nil {
+		return x.Protocol
+	}
+	return ""
+}
+
+func (x *ConnstatsSessionRecord) GetStarted() string {
+	if x != nil {
+		return x.Started
+	}
+	return ""
+}
+
+func (x *ConnstatsSessionRecord) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ConnstatsSessionRecord) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *ConnstatsSessionRecord) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+func (x *ConnstatsSessionRecord) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+// DayRecord - aggregated statistics of all connection sessions for a day (local time)
+type ConnstatsDayRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// format: "2006-01-02"
+	Date     string `protobuf:"bytes,1,opt,name=Date,proto3" json:"Date,omitempty"`
+	Sessions int64  `protobuf:"varint,2,opt,name=Sessions,proto3" json:"Sessions,omitempty"`
+	Duration int64  `protobuf:"varint,3,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	RxBytes  uint64 `protobuf:"varint,4,opt,name=RxBytes,proto3" json:"RxBytes,omitempty"`
+	TxBytes  uint64 `protobuf:"varint,5,opt,name=TxBytes,proto3" json:"TxBytes,omitempty"`
+}
+
+func (x *ConnstatsDayRecord) Reset() {
+	*x = ConnstatsDayRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[180]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnstatsDayRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnstatsDayRecord) ProtoMessage() {}
+
+func (x *ConnstatsDayRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[180]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnstatsDayRecord.ProtoReflect.Descriptor instead.
+func (*ConnstatsDayRecord) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *ConnstatsDayRecord) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *ConnstatsDayRecord) GetSessions() int64 {
+	if x != nil {
+		return x.Sessions
+	}
+	return 0
+}
+
+func (x *ConnstatsDayRecord) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ConnstatsDayRecord) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *ConnstatsDayRecord) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+// ThroughputHistoryResp contains the recent traffic rates of the active connection (the oldest first).
+// The samples are taken every 'IntervalSec' seconds; the history is empty when disconnected.
+type ThroughputHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IntervalSec int64                        `protobuf:"varint,1,opt,name=IntervalSec,proto3" json:"IntervalSec,omitempty"`
+	Samples     []*ConnstatsThroughputSample `protobuf:"bytes,2,rep,name=Samples,proto3" json:"Samples,omitempty"`
+}
+
+func (x *ThroughputHistoryResp) Reset() {
+	*x = ThroughputHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[181]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThroughputHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThroughputHistoryResp) ProtoMessage() {}
+
+func (x *ThroughputHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[181]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThroughputHistoryResp.ProtoReflect.Descriptor instead.
+func (*ThroughputHistoryResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{181}
+}
+
+func (x *ThroughputHistoryResp) GetIntervalSec() int64 {
+	if x != nil {
+		return x.IntervalSec
+	}
+	return 0
+}
+
+func (x *ThroughputHistoryResp) GetSamples() []*ConnstatsThroughputSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+// ThroughputSample - the average traffic rate over a sampling interval
+type ConnstatsThroughputSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix time (seconds) when the sample was taken
+	Time int64 `protobuf:"varint,1,opt,name=Time,proto3" json:"Time,omitempty"`
+	// bytes per second received
+	RxBps uint64 `protobuf:"varint,2,opt,name=RxBps,proto3" json:"RxBps,omitempty"`
+	// bytes per second sent
+	TxBps uint64 `protobuf:"varint,3,opt,name=TxBps,proto3" json:"TxBps,omitempty"`
+}
+
+func (x *ConnstatsThroughputSample) Reset() {
+	*x = ConnstatsThroughputSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[182]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnstatsThroughputSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnstatsThroughputSample) ProtoMessage() {}
+
+func (x *ConnstatsThroughputSample) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[182]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnstatsThroughputSample.ProtoReflect.Descriptor instead.
+func (*ConnstatsThroughputSample) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{182}
+}
+
+func (x *ConnstatsThroughputSample) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ConnstatsThroughputSample) GetRxBps() uint64 {
+	if x != nil {
+		return x.RxBps
+	}
+	return 0
+}
+
+func (x *ConnstatsThroughputSample) GetTxBps() uint64 {
+	if x != nil {
+		return x.TxBps
+	}
+	return 0
+}
+
+// AuditLogResp contains records from the audit log (the most recent last)
+type AuditLogResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Events []*AuditlogEvent `protobuf:"bytes,1,rep,name=Events,proto3" json:"Events,omitempty"`
+}
+
+func (x *AuditLogResp) Reset() {
+	*x = AuditLogResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[183]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditLogResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditLogResp) ProtoMessage() {}
+
+func (x *AuditLogResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[183]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditLogResp.ProtoReflect.Descriptor instead.
+func (*AuditLogResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{183}
+}
+
+func (x *AuditLogResp) GetEvents() []*AuditlogEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// Event - audit log record
+type AuditlogEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time    string         `protobuf:"bytes,1,opt,name=Time,proto3" json:"Time,omitempty"`
+	Event   string         `protobuf:"bytes,2,opt,name=Event,proto3" json:"Event,omitempty"`
+	Details string         `protobuf:"bytes,3,opt,name=Details,proto3" json:"Details,omitempty"`
+	Actor   *AuditlogActor `protobuf:"bytes,4,opt,name=Actor,proto3" json:"Actor,omitempty"`
+}
+
+func (x *AuditlogEvent) Reset() {
+	*x = AuditlogEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[184]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditlogEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditlogEvent) ProtoMessage() {}
+
+func (x *AuditlogEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[184]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditlogEvent.ProtoReflect.Descriptor instead.
+func (*AuditlogEvent) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{184}
+}
+
+func (x *AuditlogEvent) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *AuditlogEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *AuditlogEvent) GetDetails() string {
+	if x != nil {
+		return x.Details
+	}
+	return ""
+}
+
+func (x *AuditlogEvent) GetActor() *AuditlogActor {
+	if x != nil {
+		return x.Actor
+	}
+	return nil
+}
+
+// Actor - information about the initiator of an action
+type AuditlogActor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Type of the initiator: ActorDaemon, ActorUI, ActorCLI, ActorDBus, ActorRest or ActorGrpc
+	Type string `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"`
+	// Process ID of the client (0 - unknown)
+	Pid int64 `protobuf:"varint,2,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	// User ID of the client process (-1 - unknown; not available on Windows)
+	Uid int64 `protobuf:"varint,3,opt,name=Uid,proto3" json:"Uid,omitempty"`
+}
+
+func (x *AuditlogActor) Reset() {
+	*x = AuditlogActor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[185]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuditlogActor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditlogActor) ProtoMessage() {}
+
+func (x *AuditlogActor) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[185]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditlogActor.ProtoReflect.Descriptor instead.
+func (*AuditlogActor) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{185}
+}
+
+func (x *AuditlogActor) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AuditlogActor) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *AuditlogActor) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+// SubsystemStatusResp contains the initialization status of the daemon subsystems (in initialization order)
+type SubsystemStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subsystems []*SubsystemsStatus `protobuf:"bytes,1,rep,name=Subsystems,proto3" json:"Subsystems,omitempty"`
+}
+
+func (x *SubsystemStatusResp) Reset() {
+	*x = SubsystemStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[186]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubsystemStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubsystemStatusResp) ProtoMessage() {}
+
+func (x *SubsystemStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[186]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubsystemStatusResp.ProtoReflect.Descriptor instead.
+func (*SubsystemStatusResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{186}
+}
+
+func (x *SubsystemStatusResp) GetSubsystems() []*SubsystemsStatus {
+	if x != nil {
+		return x.Subsystems
+	}
+	return nil
+}
+
+// Status - initialization status of the subsystem
+type SubsystemsStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	DependsOn []string `protobuf:"bytes,2,rep,name=DependsOn,proto3" json:"DependsOn,omitempty"`
+	Critical  bool     `protobuf:"varint,3,opt,name=Critical,proto3" json:"Critical,omitempty"`
+	State     int64    `protobuf:"varint,4,opt,name=State,proto3" json:"State,omitempty"`
+	Warnings  []string `protobuf:"bytes,5,rep,name=Warnings,proto3" json:"Warnings,omitempty"`
+	Error     string   `protobuf:"bytes,6,opt,name=Error,proto3" json:"Error,omitempty"`
+	// initialization duration (milliseconds)
+	InitTimeMs int64 `protobuf:"varint,7,opt,name=InitTimeMs,proto3" json:"InitTimeMs,omitempty"`
+}
+
+func (x *SubsystemsStatus) Reset() {
+	*x = SubsystemsStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[187]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubsystemsStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubsystemsStatus) ProtoMessage() {}
+
+func (x *SubsystemsStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[187]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubsystemsStatus.ProtoReflect.Descriptor instead.
+func (*SubsystemsStatus) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{187}
+}
+
+func (x *SubsystemsStatus) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SubsystemsStatus) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *SubsystemsStatus) GetCritical() bool {
+	if x != nil {
+		return x.Critical
+	}
+	return false
+}
+
+func (x *SubsystemsStatus) GetState() int64 {
+	if x != nil {
+		return x.State
+	}
+	return 0
+}
+
+func (x *SubsystemsStatus) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+func (x *SubsystemsStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *SubsystemsStatus) GetInitTimeMs() int64 {
+	if x != nil {
+		return x.InitTimeMs
+	}
+	return 0
+}
+
+// PortForwardingStatusResp - the state of the forwarded port
+// (response to PortForwardingGetStatus/PortForwardingRequest requests; notification when the state changed)
+type PortForwardingStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State *PortforwardingState `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
+}
+
+func (x *PortForwardingStatusResp) Reset() {
+	*x = PortForwardingStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[188]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortForwardingStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortForwardingStatusResp) ProtoMessage() {}
+
+func (x *PortForwardingStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[188]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortForwardingStatusResp.ProtoReflect.Descriptor instead.
+func (*PortForwardingStatusResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{188}
+}
+
+func (x *PortForwardingStatusResp) GetState() *PortforwardingState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+// State - the forwarded port state
+type PortforwardingState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsActive bool  `protobuf:"varint,1,opt,name=IsActive,proto3" json:"IsActive,omitempty"`
+	Port     int64 `protobuf:"varint,2,opt,name=Port,proto3" json:"Port,omitempty"`
+	// (Unix time) when the port expires if not renewed
+	ExpiresAt int64 `protobuf:"varint,3,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	// the last error (e.g. the renewal failed)
+	Error string `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *PortforwardingState) Reset() {
+	*x = PortforwardingState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[189]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PortforwardingState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PortforwardingState) ProtoMessage() {}
+
+func (x *PortforwardingState) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[189]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PortforwardingState.ProtoReflect.Descriptor instead.
+func (*PortforwardingState) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{189}
+}
+
+func (x *PortforwardingState) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *PortforwardingState) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *PortforwardingState) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *PortforwardingState) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// DataUsageAlertResp - notification: the traffic of the VPN connection exceeded the threshold defined in preferences (see SettingsResp.DataUsageAlert)
+type DataUsageAlertResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Alert *ConnstatsDataUsageAlert `protobuf:"bytes,1,opt,name=Alert,proto3" json:"Alert,omitempty"`
+}
+
+func (x *DataUsageAlertResp) Reset() {
+	*x = DataUsageAlertResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[190]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DataUsageAlertResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DataUsageAlertResp) ProtoMessage() {}
+
+func (x *DataUsageAlertResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[190]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DataUsageAlertResp.ProtoReflect.Descriptor instead.
+func (*DataUsageAlertResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *DataUsageAlertResp) GetAlert() *ConnstatsDataUsageAlert {
+	if x != nil {
+		return x.Alert
+	}
+	return nil
+}
+
+// DataUsageAlert - the traffic of the VPN connection exceeded the user-defined threshold
+type ConnstatsDataUsageAlert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Period         string `protobuf:"bytes,1,opt,name=Period,proto3" json:"Period,omitempty"`
+	ThresholdBytes uint64 `protobuf:"varint,2,opt,name=ThresholdBytes,proto3" json:"ThresholdBytes,omitempty"`
+	// bytes received + sent during the period
+	UsedBytes uint64 `protobuf:"varint,3,opt,name=UsedBytes,proto3" json:"UsedBytes,omitempty"`
+}
+
+func (x *ConnstatsDataUsageAlert) Reset() {
+	*x = ConnstatsDataUsageAlert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[191]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnstatsDataUsageAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnstatsDataUsageAlert) ProtoMessage() {}
+
+func (x *ConnstatsDataUsageAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[191]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnstatsDataUsageAlert.ProtoReflect.Descriptor instead.
+func (*ConnstatsDataUsageAlert) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{191}
+}
+
+func (x *ConnstatsDataUsageAlert) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ConnstatsDataUsageAlert) GetThresholdBytes() uint64 {
+	if x != nil {
+		return x.ThresholdBytes
+	}
+	return 0
+}
+
+func (x *ConnstatsDataUsageAlert) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+// ConnectionQualityResp - notification: the quality report of the active connection (sent periodically while connected)
+type ConnectionQualityResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *ConnstatsQualityReport `protobuf:"bytes,1,opt,name=Report,proto3" json:"Report,omitempty"`
+}
+
+func (x *ConnectionQualityResp) Reset() {
+	*x = ConnectionQualityResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[192]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionQualityResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionQualityResp) ProtoMessage() {}
+
+func (x *ConnectionQualityResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[192]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionQualityResp.ProtoReflect.Descriptor instead.
+func (*ConnectionQualityResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{192}
+}
+
+func (x *ConnectionQualityResp) GetReport() *ConnstatsQualityReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// QualityReport - the quality of the active connection measured by the probes (ICMP echo) during the report interval
+type ConnstatsQualityReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Unix time (seconds) when the report was created
+	Time int64 `protobuf:"varint,1,opt,name=Time,proto3" json:"Time,omitempty"`
+	// the probed host
+	Target string `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty"`
+	// the probes were sent through the tunnel or outside the tunnel
+	ProbeMode   string  `protobuf:"bytes,3,opt,name=ProbeMode,proto3" json:"ProbeMode,omitempty"`
+	ProbesSent  int64   `protobuf:"varint,4,opt,name=ProbesSent,proto3" json:"ProbesSent,omitempty"`
+	ProbesLost  int64   `protobuf:"varint,5,opt,name=ProbesLost,proto3" json:"ProbesLost,omitempty"`
+	LossPercent float64 `protobuf:"fixed64,6,opt,name=LossPercent,proto3" json:"LossPercent,omitempty"`
+	// round-trip time of the received probes (milliseconds); 0 - no probes received
+	RttAvgMs float64 `protobuf:"fixed64,7,opt,name=RttAvgMs,proto3" json:"RttAvgMs,omitempty"`
+	RttMinMs float64 `protobuf:"fixed64,8,opt,name=RttMinMs,proto3" json:"RttMinMs,omitempty"`
+	RttMaxMs float64 `protobuf:"fixed64,9,opt,name=RttMaxMs,proto3" json:"RttMaxMs,omitempty"`
+	// mean difference between the round-trip times of the consecutive probes (milliseconds)
+	JitterMs float64 `protobuf:"fixed64,10,opt,name=JitterMs,proto3" json:"JitterMs,omitempty"`
+}
+
+func (x *ConnstatsQualityReport) Reset() {
+	*x = ConnstatsQualityReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[193]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnstatsQualityReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnstatsQualityReport) ProtoMessage() {}
+
+func (x *ConnstatsQualityReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[193]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnstatsQualityReport.ProtoReflect.Descriptor instead.
+func (*ConnstatsQualityReport) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{193}
+}
+
+func (x *ConnstatsQualityReport) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ConnstatsQualityReport) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *ConnstatsQualityReport) GetProbeMode() string {
+	if x != nil {
+		return x.ProbeMode
+	}
+	return ""
+}
+
+func (x *ConnstatsQualityReport) GetProbesSent() int64 {
+	if x != nil {
+		return x.ProbesSent
+	}
+	return 0
+}
+
+func (x *ConnstatsQualityReport) GetProbesLost() int64 {
+	if x != nil {
+		return x.ProbesLost
+	}
+	return 0
+}
+
+func (x *ConnstatsQualityReport) GetLossPercent() float64 {
+	if x != nil {
+		return x.LossPercent
+	}
+	return 0
+}
+
+func (x *ConnstatsQualityReport) GetRttAvgMs() float64 {
+	if x != nil {
+		return x.RttAvgMs
+	}
+	return 0
+}
+
+func (x *ConnstatsQualityReport) GetRttMinMs() float64 {
+	if x != nil {
+		return x.RttMinMs
+	}
+	return 0
+}
+
+func (x *ConnstatsQualityReport) GetRttMaxMs() float64 {
+	if x != nil {
+		return x.RttMaxMs
+	}
+	return 0
+}
+
+func (x *ConnstatsQualityReport) GetJitterMs() float64 {
+	if x != nil {
+		return x.JitterMs
+	}
+	return 0
+}
+
+// ConnectionQualityHistoryResp contains the recent quality reports of the active connection (the oldest first; empty when disconnected)
+type ConnectionQualityHistoryResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reports []*ConnstatsQualityReport `protobuf:"bytes,1,rep,name=Reports,proto3" json:"Reports,omitempty"`
+}
+
+func (x *ConnectionQualityHistoryResp) Reset() {
+	*x = ConnectionQualityHistoryResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[194]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionQualityHistoryResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionQualityHistoryResp) ProtoMessage() {}
+
+func (x *ConnectionQualityHistoryResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[194]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionQualityHistoryResp.ProtoReflect.Descriptor instead.
+func (*ConnectionQualityHistoryResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{194}
+}
+
+func (x *ConnectionQualityHistoryResp) GetReports() []*ConnstatsQualityReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+// CaptivePortalStatusResp - the captive portal status
+// (response to CaptivePortalCheck/CaptivePortalAllowLogin/CaptivePortalReLock requests; notification when a captive portal detected or the status changed)
+type CaptivePortalStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status *CaptiveportalStatus `protobuf:"bytes,1,opt,name=Status,proto3" json:"Status,omitempty"`
+}
+
+func (x *CaptivePortalStatusResp) Reset() {
+	*x = CaptivePortalStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[195]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptivePortalStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptivePortalStatusResp) ProtoMessage() {}
+
+func (x *CaptivePortalStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[195]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptivePortalStatusResp.ProtoReflect.Descriptor instead.
+func (*CaptivePortalStatusResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{195}
+}
+
+func (x *CaptivePortalStatusResp) GetStatus() *CaptiveportalStatus {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+// Status - the captive portal status
+type CaptiveportalStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsDetected bool `protobuf:"varint,1,opt,name=IsDetected,proto3" json:"IsDetected,omitempty"`
+	// URL of the portal login page (the redirect location received in response to the probe; can be empty)
+	PortalURL string `protobuf:"bytes,2,opt,name=PortalURL,proto3" json:"PortalURL,omitempty"`
+	// (Unix time) when the last check was performed (0 - not checked)
+	CheckedAt int64 `protobuf:"varint,3,opt,name=CheckedAt,proto3" json:"CheckedAt,omitempty"`
+	// the last check failed (e.g. no connectivity): the captive portal status is unknown
+	Error string `protobuf:"bytes,4,opt,name=Error,proto3" json:"Error,omitempty"`
+	// (Unix time) the firewall is temporarily disabled for the portal login until this time (0 - firewall is not relaxed)
+	LoginAllowedUntil int64 `protobuf:"varint,5,opt,name=LoginAllowedUntil,proto3" json:"LoginAllowedUntil,omitempty"`
+}
+
+func (x *CaptiveportalStatus) Reset() {
+	*x = CaptiveportalStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[196]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CaptiveportalStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CaptiveportalStatus) ProtoMessage() {}
+
+func (x *CaptiveportalStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[196]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CaptiveportalStatus.ProtoReflect.Descriptor instead.
+func (*CaptiveportalStatus) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{196}
+}
+
+func (x *CaptiveportalStatus) GetIsDetected() bool {
+	if x != nil {
+		return x.IsDetected
+	}
+	return false
+}
+
+func (x *CaptiveportalStatus) GetPortalURL() string {
+	if x != nil {
+		return x.PortalURL
+	}
+	return ""
+}
+
+func (x *CaptiveportalStatus) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+func (x *CaptiveportalStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CaptiveportalStatus) GetLoginAllowedUntil() int64 {
+	if x != nil {
+		return x.LoginAllowedUntil
+	}
+	return 0
+}
+
+// ReloginRequiredResp - notification: the session is not valid anymore and it was not renewed silently.
+// The daemon is logged out; the user has to log in again.
+// APIStatus/APIErrorMessage - the error of the session renewal attempt (0 - the renewal was not attempted)
+type ReloginRequiredResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	APIStatus       int64  `protobuf:"varint,1,opt,name=APIStatus,proto3" json:"APIStatus,omitempty"`
+	APIErrorMessage string `protobuf:"bytes,2,opt,name=APIErrorMessage,proto3" json:"APIErrorMessage,omitempty"`
+}
+
+func (x *ReloginRequiredResp) Reset() {
+	*x = ReloginRequiredResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[197]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReloginRequiredResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReloginRequiredResp) ProtoMessage() {}
+
+func (x *ReloginRequiredResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[197]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReloginRequiredResp.ProtoReflect.Descriptor instead.
+func (*ReloginRequiredResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{197}
+}
+
+func (x *ReloginRequiredResp) GetAPIStatus() int64 {
+	if x != nil {
+		return x.APIStatus
+	}
+	return 0
+}
+
+func (x *ReloginRequiredResp) GetAPIErrorMessage() string {
+	if x != nil {
+		return x.APIErrorMessage
+	}
+	return ""
+}
+
+// ClockSkewResp - notification: large difference between the local time and the API server time detected.
+// The wrong local time can break the API requests (TLS certificate validation) and WireGuard handshakes.
+type ClockSkewResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// API server time minus local time (seconds)
+	OffsetSec int64 `protobuf:"varint,1,opt,name=OffsetSec,proto3" json:"OffsetSec,omitempty"`
+	// true - the API server time is in use for TLS certificate validation (see Prefs_IsApiTimeHintAllowed)
+	IsTimeHintAllowed bool `protobuf:"varint,2,opt,name=IsTimeHintAllowed,proto3" json:"IsTimeHintAllowed,omitempty"`
+}
+
+func (x *ClockSkewResp) Reset() {
+	*x = ClockSkewResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[198]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClockSkewResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClockSkewResp) ProtoMessage() {}
+
+func (x *ClockSkewResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[198]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClockSkewResp.ProtoReflect.Descriptor instead.
+func (*ClockSkewResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{198}
+}
+
+func (x *ClockSkewResp) GetOffsetSec() int64 {
+	if x != nil {
+		return x.OffsetSec
+	}
+	return 0
+}
+
+func (x *ClockSkewResp) GetIsTimeHintAllowed() bool {
+	if x != nil {
+		return x.IsTimeHintAllowed
+	}
+	return false
+}
+
+// ShellCommandsResp contains the history of the external commands executed by the daemon (the most recent last)
+type ShellCommandsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Commands []*ShellCommandRecord `protobuf:"bytes,1,rep,name=Commands,proto3" json:"Commands,omitempty"`
+}
+
+func (x *ShellCommandsResp) Reset() {
+	*x = ShellCommandsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[199]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShellCommandsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShellCommandsResp) ProtoMessage() {}
+
+func (x *ShellCommandsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[199]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShellCommandsResp.ProtoReflect.Descriptor instead.
+func (*ShellCommandsResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{199}
+}
+
+func (x *ShellCommandsResp) GetCommands() []*ShellCommandRecord {
+	if x != nil {
+		return x.Commands
+	}
+	return nil
+}
+
+// CommandRecord - information about the external command invocation
+type ShellCommandRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time when the command started
+	Time       string   `protobuf:"bytes,1,opt,name=Time,proto3" json:"Time,omitempty"`
+	Binary     string   `protobuf:"bytes,2,opt,name=Binary,proto3" json:"Binary,omitempty"`
+	Args       []string `protobuf:"bytes,3,rep,name=Args,proto3" json:"Args,omitempty"`
+	DurationMs int64    `protobuf:"varint,4,opt,name=DurationMs,proto3" json:"DurationMs,omitempty"`
+	// exit code of the process (-1 - the process was not started or it was killed)
+	ExitCode int64  `protobuf:"varint,5,opt,name=ExitCode,proto3" json:"ExitCode,omitempty"`
+	Error    string `protobuf:"bytes,6,opt,name=Error,proto3" json:"Error,omitempty"`
+}
+
+func (x *ShellCommandRecord) Reset() {
+	*x = ShellCommandRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[200]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ShellCommandRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShellCommandRecord) ProtoMessage() {}
+
+func (x *ShellCommandRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[200]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShellCommandRecord.ProtoReflect.Descriptor instead.
+func (*ShellCommandRecord) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{200}
+}
+
+func (x *ShellCommandRecord) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *ShellCommandRecord) GetBinary() string {
+	if x != nil {
+		return x.Binary
+	}
+	return ""
+}
+
+func (x *ShellCommandRecord) GetArgs() []string {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *ShellCommandRecord) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+func (x *ShellCommandRecord) GetExitCode() int64 {
+	if x != nil {
+		return x.ExitCode
+	}
+	return 0
+}
+
+func (x *ShellCommandRecord) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// CrashReportsResp contains the saved crash reports (the most recent last)
+type CrashReportsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reports []*CrashreportReport `protobuf:"bytes,1,rep,name=Reports,proto3" json:"Reports,omitempty"`
+}
+
+func (x *CrashReportsResp) Reset() {
+	*x = CrashReportsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[201]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrashReportsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashReportsResp) ProtoMessage() {}
+
+func (x *CrashReportsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[201]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashReportsResp.ProtoReflect.Descriptor instead.
+func (*CrashReportsResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{201}
+}
+
+func (x *CrashReportsResp) GetReports() []*CrashreportReport {
+	if x != nil {
+		return x.Reports
+	}
+	return nil
+}
+
+// Report - information about the recovered panic
+type CrashreportReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time string `protobuf:"bytes,1,opt,name=Time,proto3" json:"Time,omitempty"`
+	// Name of the daemon routine where the panic happened (e.g. "processRequest")
+	Source  string `protobuf:"bytes,2,opt,name=Source,proto3" json:"Source,omitempty"`
+	Panic   string `protobuf:"bytes,3,opt,name=Panic,proto3" json:"Panic,omitempty"`
+	Stack   string `protobuf:"bytes,4,opt,name=Stack,proto3" json:"Stack,omitempty"`
+	Version string `protobuf:"bytes,5,opt,name=Version,proto3" json:"Version,omitempty"`
+}
+
+func (x *CrashreportReport) Reset() {
+	*x = CrashreportReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[202]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrashreportReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashreportReport) ProtoMessage() {}
+
+func (x *CrashreportReport) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[202]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashreportReport.ProtoReflect.Descriptor instead.
+func (*CrashreportReport) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{202}
+}
+
+func (x *CrashreportReport) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *CrashreportReport) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CrashreportReport) GetPanic() string {
+	if x != nil {
+		return x.Panic
+	}
+	return ""
+}
+
+func (x *CrashreportReport) GetStack() string {
+	if x != nil {
+		return x.Stack
+	}
+	return ""
+}
+
+func (x *CrashreportReport) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+// CrashRecoveredResp - notification: the daemon recovered from a crash (panic) in one of its routines.
+// The crash report is saved and can be requested by CrashReportsGet.
+type CrashRecoveredResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Report *CrashreportReport `protobuf:"bytes,1,opt,name=Report,proto3" json:"Report,omitempty"`
+}
+
+func (x *CrashRecoveredResp) Reset() {
+	*x = CrashRecoveredResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[203]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CrashRecoveredResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CrashRecoveredResp) ProtoMessage() {}
+
+func (x *CrashRecoveredResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[203]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CrashRecoveredResp.ProtoReflect.Descriptor instead.
+func (*CrashRecoveredResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{203}
+}
+
+func (x *CrashRecoveredResp) GetReport() *CrashreportReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// OperationStatusResp - status of the long-running operation.
+// It is the response on OperationStart/OperationCancel requests.
+// Also, it is sent to all clients on each change of the operation status (progress, finish, error, cancellation).
+type OperationStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operation *OperationsStatus `protobuf:"bytes,1,opt,name=Operation,proto3" json:"Operation,omitempty"`
+}
+
+func (x *OperationStatusResp) Reset() {
+	*x = OperationStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[204]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationStatusResp) ProtoMessage() {}
+
+func (x *OperationStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[204]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationStatusResp.ProtoReflect.Descriptor instead.
+func (*OperationStatusResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{204}
+}
+
+func (x *OperationStatusResp) GetOperation() *OperationsStatus {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+// Status - information about the operation
+type OperationsStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id   uint64 `protobuf:"varint,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Type string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	// Running, Finished, Failed or Cancelled
+	State string `protobuf:"bytes,3,opt,name=State,proto3" json:"State,omitempty"`
+	// Progress in percents (0-100)
+	Progress int64 `protobuf:"varint,4,opt,name=Progress,proto3" json:"Progress,omitempty"`
+	// Description of the current step of the operation
+	Info string `protobuf:"bytes,5,opt,name=Info,proto3" json:"Info,omitempty"`
+	// Error description (when State == Failed)
+	Error string `protobuf:"bytes,6,opt,name=Error,proto3" json:"Error,omitempty"`
+	// Result of the operation (when State == Finished; can be nil)
+	Result *structpb.Value `protobuf:"bytes,7,opt,name=Result,proto3" json:"Result,omitempty"`
+}
+
+func (x *OperationsStatus) Reset() {
+	*x = OperationsStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[205]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationsStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationsStatus) ProtoMessage() {}
+
+func (x *OperationsStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[205]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationsStatus.ProtoReflect.Descriptor instead.
+func (*OperationsStatus) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{205}
+}
+
+func (x *OperationsStatus) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OperationsStatus) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *OperationsStatus) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *OperationsStatus) GetProgress() int64 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *OperationsStatus) GetInfo() string {
+	if x != nil {
+		return x.Info
+	}
+	return ""
+}
+
+func (x *OperationsStatus) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *OperationsStatus) GetResult() *structpb.Value {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+// OperationsListResp - list of running operations
+type OperationsListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Operations []*OperationsStatus `protobuf:"bytes,1,rep,name=Operations,proto3" json:"Operations,omitempty"`
+}
+
+func (x *OperationsListResp) Reset() {
+	*x = OperationsListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[206]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OperationsListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OperationsListResp) ProtoMessage() {}
+
+func (x *OperationsListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[206]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OperationsListResp.ProtoReflect.Descriptor instead.
+func (*OperationsListResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{206}
+}
+
+func (x *OperationsListResp) GetOperations() []*OperationsStatus {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// ConnectionProfilesResp contains the list of saved connection profiles
+type ConnectionProfilesResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiles []*PreferencesConnectionProfile `protobuf:"bytes,1,rep,name=Profiles,proto3" json:"Profiles,omitempty"`
+}
+
+func (x *ConnectionProfilesResp) Reset() {
+	*x = ConnectionProfilesResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[207]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionProfilesResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionProfilesResp) ProtoMessage() {}
+
+func (x *ConnectionProfilesResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[207]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionProfilesResp.ProtoReflect.Descriptor instead.
+func (*ConnectionProfilesResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{207}
+}
+
+func (x *ConnectionProfilesResp) GetProfiles() []*PreferencesConnectionProfile {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+// RestApiResp contains the configuration of the local REST API (including the access token)
+type RestApiResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params *PreferencesRestApiParams `protobuf:"bytes,1,opt,name=Params,proto3" json:"Params,omitempty"`
+}
+
+func (x *RestApiResp) Reset() {
+	*x = RestApiResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[208]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestApiResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestApiResp) ProtoMessage() {}
+
+func (x *RestApiResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[208]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestApiResp.ProtoReflect.Descriptor instead.
+func (*RestApiResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{208}
+}
+
+func (x *RestApiResp) GetParams() *PreferencesRestApiParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// RestApiParams - configuration of the local REST API of the daemon (disabled by default).
+// The API is listening only on the loopback interface (127.0.0.1).
+type PreferencesRestApiParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsEnabled bool  `protobuf:"varint,1,opt,name=isEnabled,proto3" json:"isEnabled,omitempty"`
+	Port      int64 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Access token. Each request must contain the header: 'Authorization: Bearer <Token>'
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	// If true - the metrics in Prometheus format are available on the '/metrics' endpoint of the REST API
+	IsMetricsEnabled bool `protobuf:"varint,4,opt,name=isMetricsEnabled,proto3" json:"isMetricsEnabled,omitempty"`
+}
+
+func (x *PreferencesRestApiParams) Reset() {
+	*x = PreferencesRestApiParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[209]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesRestApiParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesRestApiParams) ProtoMessage() {}
+
+func (x *PreferencesRestApiParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[209]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesRestApiParams.ProtoReflect.Descriptor instead.
+func (*PreferencesRestApiParams) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{209}
+}
+
+func (x *PreferencesRestApiParams) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *PreferencesRestApiParams) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *PreferencesRestApiParams) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PreferencesRestApiParams) GetIsMetricsEnabled() bool {
+	if x != nil {
+		return x.IsMetricsEnabled
+	}
+	return false
+}
+
+// GrpcApiResp contains the configuration of the local gRPC API (including the access token)
+type GrpcApiResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params *PreferencesGrpcApiParams `protobuf:"bytes,1,opt,name=Params,proto3" json:"Params,omitempty"`
+}
+
+func (x *GrpcApiResp) Reset() {
+	*x = GrpcApiResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[210]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GrpcApiResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GrpcApiResp) ProtoMessage() {}
+
+func (x *GrpcApiResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[210]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GrpcApiResp.ProtoReflect.Descriptor instead.
+func (*GrpcApiResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{210}
+}
+
+func (x *GrpcApiResp) GetParams() *PreferencesGrpcApiParams {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+// GrpcApiParams - configuration of the local gRPC API of the daemon (disabled by default).
+// The API is listening only on the loopback interface (127.0.0.1).
+// The service definition: daemon/protocol/grpcapi/ivpn.proto
+type PreferencesGrpcApiParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IsEnabled bool  `protobuf:"varint,1,opt,name=isEnabled,proto3" json:"isEnabled,omitempty"`
+	Port      int64 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// Access token. Each call must contain the metadata: 'authorization: Bearer <Token>'
+	Token string `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *PreferencesGrpcApiParams) Reset() {
+	*x = PreferencesGrpcApiParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[211]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesGrpcApiParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesGrpcApiParams) ProtoMessage() {}
+
+func (x *PreferencesGrpcApiParams) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[211]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesGrpcApiParams.ProtoReflect.Descriptor instead.
+func (*PreferencesGrpcApiParams) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{211}
+}
+
+func (x *PreferencesGrpcApiParams) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *PreferencesGrpcApiParams) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *PreferencesGrpcApiParams) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// LogLevelsResp contains the current log levels of the daemon
+type LogLevelsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DefaultLevel string `protobuf:"bytes,1,opt,name=DefaultLevel,proto3" json:"DefaultLevel,omitempty"`
+	// module-specific levels (module name -> level)
+	Modules map[string]string `protobuf:"bytes,2,rep,name=Modules,proto3" json:"Modules,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// names of all the logger modules
+	KnownModules []string `protobuf:"bytes,3,rep,name=KnownModules,proto3" json:"KnownModules,omitempty"`
+}
+
+func (x *LogLevelsResp) Reset() {
+	*x = LogLevelsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[212]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogLevelsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogLevelsResp) ProtoMessage() {}
+
+func (x *LogLevelsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[212]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogLevelsResp.ProtoReflect.Descriptor instead.
+func (*LogLevelsResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{212}
+}
+
+func (x *LogLevelsResp) GetDefaultLevel() string {
+	if x != nil {
+		return x.DefaultLevel
+	}
+	return ""
+}
+
+func (x *LogLevelsResp) GetModules() map[string]string {
+	if x != nil {
+		return x.Modules
+	}
+	return nil
+}
+
+func (x *LogLevelsResp) GetKnownModules() []string {
+	if x != nil {
+		return x.KnownModules
+	}
+	return nil
+}
+
+// ClientTokensResp contains the list of the client access tokens
+type ClientTokensResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []*PreferencesClientToken `protobuf:"bytes,1,rep,name=Tokens,proto3" json:"Tokens,omitempty"`
+}
+
+func (x *ClientTokensResp) Reset() {
+	*x = ClientTokensResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[213]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClientTokensResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClientTokensResp) ProtoMessage() {}
+
+func (x *ClientTokensResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[213]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClientTokensResp.ProtoReflect.Descriptor instead.
+func (*ClientTokensResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{213}
+}
+
+func (x *ClientTokensResp) GetTokens() []*PreferencesClientToken {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// ClientToken - named access token for the clients of the daemon (daemon protocol and REST API).
+// The client which is authenticated by the token gets the access level defined by 'Scope'.
+type PreferencesClientToken struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Token string `protobuf:"bytes,2,opt,name=Token,proto3" json:"Token,omitempty"`
+	Scope string `protobuf:"bytes,3,opt,name=Scope,proto3" json:"Scope,omitempty"`
+}
+
+func (x *PreferencesClientToken) Reset() {
+	*x = PreferencesClientToken{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[214]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PreferencesClientToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreferencesClientToken) ProtoMessage() {}
+
+func (x *PreferencesClientToken) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[214]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreferencesClientToken.ProtoReflect.Descriptor instead.
+func (*PreferencesClientToken) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{214}
+}
+
+func (x *PreferencesClientToken) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PreferencesClientToken) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *PreferencesClientToken) GetScope() string {
+	if x != nil {
+		return x.Scope
+	}
+	return ""
+}
+
+// SettingsExportResp contains the exported daemon configuration
+type SettingsExportResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Settings *PreferencesExportedSettings `protobuf:"bytes,1,opt,name=Settings,proto3" json:"Settings,omitempty"`
+}
+
+func (x *SettingsExportResp) Reset() {
+	*x = SettingsExportResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[215]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettingsExportResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingsExportResp) ProtoMessage() {}
+
+func (x *SettingsExportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[215]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingsExportResp.ProtoReflect.Descriptor instead.
+func (*SettingsExportResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{215}
+}
+
+func (x *SettingsExportResp) GetSettings() *PreferencesExportedSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+// SettingsImportResp - result of the settings import (the descriptions of the settings which were skipped)
+type SettingsImportResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Warnings []string `protobuf:"bytes,1,rep,name=Warnings,proto3" json:"Warnings,omitempty"`
+}
+
+func (x *SettingsImportResp) Reset() {
+	*x = SettingsImportResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[216]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SettingsImportResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettingsImportResp) ProtoMessage() {}
+
+func (x *SettingsImportResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[216]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettingsImportResp.ProtoReflect.Descriptor instead.
+func (*SettingsImportResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{216}
+}
+
+func (x *SettingsImportResp) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// HostsHealthResp - health information for the VPN hosts which had connection failures
+type HostsHealthResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hosts []*HostshealthHostScore `protobuf:"bytes,1,rep,name=Hosts,proto3" json:"Hosts,omitempty"`
+}
+
+func (x *HostsHealthResp) Reset() {
+	*x = HostsHealthResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[217]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostsHealthResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostsHealthResp) ProtoMessage() {}
+
+func (x *HostsHealthResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[217]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostsHealthResp.ProtoReflect.Descriptor instead.
+func (*HostsHealthResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{217}
+}
+
+func (x *HostsHealthResp) GetHosts() []*HostshealthHostScore {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+// HostScore - health information about the host
+type HostshealthHostScore struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Host IP address
+	Host string `protobuf:"bytes,1,opt,name=Host,proto3" json:"Host,omitempty"`
+	// Failure score: 0 - healthy host; the higher the score the less reliable the host
+	Score float64 `protobuf:"fixed64,2,opt,name=Score,proto3" json:"Score,omitempty"`
+	// Total number of failures
+	Failures int64 `protobuf:"varint,3,opt,name=Failures,proto3" json:"Failures,omitempty"`
+	// Last failure type and time
+	LastFailure     string `protobuf:"bytes,4,opt,name=LastFailure,proto3" json:"LastFailure,omitempty"`
+	LastFailureTime string `protobuf:"bytes,5,opt,name=LastFailureTime,proto3" json:"LastFailureTime,omitempty"`
+	// The host is deprioritized until this time (zero value - host is not blacklisted)
+	BlacklistedUntil string `protobuf:"bytes,6,opt,name=BlacklistedUntil,proto3" json:"BlacklistedUntil,omitempty"`
+}
+
+func (x *HostshealthHostScore) Reset() {
+	*x = HostshealthHostScore{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[218]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostshealthHostScore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostshealthHostScore) ProtoMessage() {}
+
+func (x *HostshealthHostScore) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[218]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostshealthHostScore.ProtoReflect.Descriptor instead.
+func (*HostshealthHostScore) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{218}
+}
+
+func (x *HostshealthHostScore) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *HostshealthHostScore) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *HostshealthHostScore) GetFailures() int64 {
+	if x != nil {
+		return x.Failures
+	}
+	return 0
+}
+
+func (x *HostshealthHostScore) GetLastFailure() string {
+	if x != nil {
+		return x.LastFailure
+	}
+	return ""
+}
+
+func (x *HostshealthHostScore) GetLastFailureTime() string {
+	if x != nil {
+		return x.LastFailureTime
+	}
+	return ""
+}
+
+func (x *HostshealthHostScore) GetBlacklistedUntil() string {
+	if x != nil {
+		return x.BlacklistedUntil
+	}
+	return ""
+}
+
+// VpnStateResp returns VPN connection state
+type VpnStateResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// TODO: remove 'State' field. Use only 'StateVal'
+	State               string `protobuf:"bytes,1,opt,name=State,proto3" json:"State,omitempty"`
+	StateVal            int64  `protobuf:"varint,2,opt,name=StateVal,proto3" json:"StateVal,omitempty"`
+	StateAdditionalInfo string `protobuf:"bytes,3,opt,name=StateAdditionalInfo,proto3" json:"StateAdditionalInfo,omitempty"`
+}
+
+func (x *VpnStateResp) Reset() {
+	*x = VpnStateResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[219]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VpnStateResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VpnStateResp) ProtoMessage() {}
+
+func (x *VpnStateResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[219]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VpnStateResp.ProtoReflect.Descriptor instead.
+func (*VpnStateResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{219}
+}
+
+func (x *VpnStateResp) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *VpnStateResp) GetStateVal() int64 {
+	if x != nil {
+		return x.StateVal
+	}
+	return 0
+}
+
+func (x *VpnStateResp) GetStateAdditionalInfo() string {
+	if x != nil {
+		return x.StateAdditionalInfo
+	}
+	return ""
+}
+
+// ConnectionStatsResp - statistics of the active VPN connection (number of bytes received/sent through the VPN tunnel)
+type ConnectionStatsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RxBytes uint64 `protobuf:"varint,1,opt,name=RxBytes,proto3" json:"RxBytes,omitempty"`
+	TxBytes uint64 `protobuf:"varint,2,opt,name=TxBytes,proto3" json:"TxBytes,omitempty"`
+}
+
+func (x *ConnectionStatsResp) Reset() {
+	*x = ConnectionStatsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[220]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConnectionStatsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectionStatsResp) ProtoMessage() {}
+
+func (x *ConnectionStatsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[220]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectionStatsResp.ProtoReflect.Descriptor instead.
+func (*ConnectionStatsResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{220}
+}
+
+func (x *ConnectionStatsResp) GetRxBytes() uint64 {
+	if x != nil {
+		return x.RxBytes
+	}
+	return 0
+}
+
+func (x *ConnectionStatsResp) GetTxBytes() uint64 {
+	if x != nil {
+		return x.TxBytes
+	}
+	return 0
+}
+
+// LogMessageResp - the message written to the daemon log
+// (REST API events stream; log stream of the client started by LogStreamStart)
+type LogMessageResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message string `protobuf:"bytes,1,opt,name=Message,proto3" json:"Message,omitempty"`
+	Module  string `protobuf:"bytes,2,opt,name=Module,proto3" json:"Module,omitempty"`
+	Level   string `protobuf:"bytes,3,opt,name=Level,proto3" json:"Level,omitempty"`
+	// ID of the connection session (empty - no active session)
+	Session string `protobuf:"bytes,4,opt,name=Session,proto3" json:"Session,omitempty"`
+}
+
+func (x *LogMessageResp) Reset() {
+	*x = LogMessageResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[221]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LogMessageResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMessageResp) ProtoMessage() {}
+
+func (x *LogMessageResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[221]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMessageResp.ProtoReflect.Descriptor instead.
+func (*LogMessageResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{221}
+}
+
+func (x *LogMessageResp) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogMessageResp) GetModule() string {
+	if x != nil {
+		return x.Module
+	}
+	return ""
+}
+
+func (x *LogMessageResp) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *LogMessageResp) GetSession() string {
+	if x != nil {
+		return x.Session
+	}
+	return ""
+}
+
+// ServerListResp returns list of servers
+type ServerListResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VpnServers *ApiTypesServersInfoResponse `protobuf:"bytes,1,opt,name=VpnServers,proto3" json:"VpnServers,omitempty"`
+}
+
+func (x *ServerListResp) Reset() {
+	*x = ServerListResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[222]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServerListResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerListResp) ProtoMessage() {}
+
+func (x *ServerListResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[222]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerListResp.ProtoReflect.Descriptor instead.
+func (*ServerListResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{222}
+}
+
+func (x *ServerListResp) GetVpnServers() *ApiTypesServersInfoResponse {
+	if x != nil {
+		return x.VpnServers
+	}
+	return nil
+}
+
+// ServersInfoResponse all info from servers.json
+type ApiTypesServersInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Wireguard []*ApiTypesWireGuardServerInfo `protobuf:"bytes,1,rep,name=wireguard,proto3" json:"wireguard,omitempty"`
+	Openvpn   []*ApiTypesOpenvpnServerInfo   `protobuf:"bytes,2,rep,name=openvpn,proto3" json:"openvpn,omitempty"`
+	Config    *ApiTypesConfigInfo            `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+}
+
+func (x *ApiTypesServersInfoResponse) Reset() {
+	*x = ApiTypesServersInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[223]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesServersInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesServersInfoResponse) ProtoMessage() {}
+
+func (x *ApiTypesServersInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[223]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesServersInfoResponse.ProtoReflect.Descriptor instead.
+func (*ApiTypesServersInfoResponse) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{223}
+}
+
+func (x *ApiTypesServersInfoResponse) GetWireguard() []*ApiTypesWireGuardServerInfo {
+	if x != nil {
+		return x.Wireguard
+	}
+	return nil
+}
+
+func (x *ApiTypesServersInfoResponse) GetOpenvpn() []*ApiTypesOpenvpnServerInfo {
+	if x != nil {
+		return x.Openvpn
+	}
+	return nil
+}
+
+func (x *ApiTypesServersInfoResponse) GetConfig() *ApiTypesConfigInfo {
+	if x != nil {
+		return x.Config
+	}
+	return nil
+}
+
+// WireGuardServerInfo contains all info about WG server
+type ApiTypesWireGuardServerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gateway     string                             `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	CountryCode string                             `protobuf:"bytes,2,opt,name=country_code,proto3" json:"country_code,omitempty"`
+	Country     string                             `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	City        string                             `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Latitude    float32                            `protobuf:"fixed32,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude   float32                            `protobuf:"fixed32,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Isp         string                             `protobuf:"bytes,7,opt,name=isp,proto3" json:"isp,omitempty"`
+	Hosts       []*ApiTypesWireGuardServerHostInfo `protobuf:"bytes,8,rep,name=hosts,proto3" json:"hosts,omitempty"`
+}
+
+func (x *ApiTypesWireGuardServerInfo) Reset() {
+	*x = ApiTypesWireGuardServerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[224]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesWireGuardServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesWireGuardServerInfo) ProtoMessage() {}
+
+func (x *ApiTypesWireGuardServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[224]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesWireGuardServerInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesWireGuardServerInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{224}
+}
+
+func (x *ApiTypesWireGuardServerInfo) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerInfo) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerInfo) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerInfo) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerInfo) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ApiTypesWireGuardServerInfo) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *ApiTypesWireGuardServerInfo) GetIsp() string {
+	if x != nil {
+		return x.Isp
+	}
+	return ""
+}
+
+func (x *ApiTypesWireGuardServerInfo) GetHosts() []*ApiTypesWireGuardServerHostInfo {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+// OpenvpnServerInfo contains all info about OpenVPN server
+type ApiTypesOpenvpnServerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Gateway     string                           `protobuf:"bytes,1,opt,name=gateway,proto3" json:"gateway,omitempty"`
+	CountryCode string                           `protobuf:"bytes,2,opt,name=country_code,proto3" json:"country_code,omitempty"`
+	Country     string                           `protobuf:"bytes,3,opt,name=country,proto3" json:"country,omitempty"`
+	City        string                           `protobuf:"bytes,4,opt,name=city,proto3" json:"city,omitempty"`
+	Latitude    float32                          `protobuf:"fixed32,5,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude   float32                          `protobuf:"fixed32,6,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Isp         string                           `protobuf:"bytes,7,opt,name=isp,proto3" json:"isp,omitempty"`
+	Hosts       []*ApiTypesOpenVPNServerHostInfo `protobuf:"bytes,8,rep,name=hosts,proto3" json:"hosts,omitempty"`
+}
+
+func (x *ApiTypesOpenvpnServerInfo) Reset() {
+	*x = ApiTypesOpenvpnServerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[225]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesOpenvpnServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesOpenvpnServerInfo) ProtoMessage() {}
+
+func (x *ApiTypesOpenvpnServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[225]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesOpenvpnServerInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesOpenvpnServerInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{225}
+}
+
+func (x *ApiTypesOpenvpnServerInfo) GetGateway() string {
+	if x != nil {
+		return x.Gateway
+	}
+	return ""
+}
+
+func (x *ApiTypesOpenvpnServerInfo) GetCountryCode() string {
+	if x != nil {
+		return x.CountryCode
+	}
+	return ""
+}
+
+func (x *ApiTypesOpenvpnServerInfo) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
+}
+
+func (x *ApiTypesOpenvpnServerInfo) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *ApiTypesOpenvpnServerInfo) GetLatitude() float32 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ApiTypesOpenvpnServerInfo) GetLongitude() float32 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *ApiTypesOpenvpnServerInfo) GetIsp() string {
+	if x != nil {
+		return x.Isp
+	}
+	return ""
+}
+
+func (x *ApiTypesOpenvpnServerInfo) GetHosts() []*ApiTypesOpenVPNServerHostInfo {
+	if x != nil {
+		return x.Hosts
+	}
+	return nil
+}
+
+// ConfigInfo contains different configuration info (Antitracker, API ...)
+type ApiTypesConfigInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Antitracker *ApiTypesAntitrackerInfo `protobuf:"bytes,1,opt,name=antitracker,proto3" json:"antitracker,omitempty"`
+	Api         *ApiTypesInfoAPI         `protobuf:"bytes,2,opt,name=api,proto3" json:"api,omitempty"`
+	Ports       *ApiTypesPortsInfo       `protobuf:"bytes,3,opt,name=ports,proto3" json:"ports,omitempty"`
+	V2Ray       *ApiTypesV2RayInfo       `protobuf:"bytes,4,opt,name=v2ray,proto3" json:"v2ray,omitempty"`
+}
+
+func (x *ApiTypesConfigInfo) Reset() {
+	*x = ApiTypesConfigInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[226]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesConfigInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesConfigInfo) ProtoMessage() {}
+
+func (x *ApiTypesConfigInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[226]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesConfigInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesConfigInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{226}
+}
+
+func (x *ApiTypesConfigInfo) GetAntitracker() *ApiTypesAntitrackerInfo {
+	if x != nil {
+		return x.Antitracker
+	}
+	return nil
+}
+
+func (x *ApiTypesConfigInfo) GetApi() *ApiTypesInfoAPI {
+	if x != nil {
+		return x.Api
+	}
+	return nil
+}
+
+func (x *ApiTypesConfigInfo) GetPorts() *ApiTypesPortsInfo {
+	if x != nil {
+		return x.Ports
+	}
+	return nil
+}
+
+func (x *ApiTypesConfigInfo) GetV2Ray() *ApiTypesV2RayInfo {
+	if x != nil {
+		return x.V2Ray
+	}
+	return nil
+}
+
+// AntitrackerInfo all info about antitracker DNSs
+type ApiTypesAntitrackerInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Default  *ApiTypesDNSInfo `protobuf:"bytes,1,opt,name=default,proto3" json:"default,omitempty"`
+	Hardcore *ApiTypesDNSInfo `protobuf:"bytes,2,opt,name=hardcore,proto3" json:"hardcore,omitempty"`
+}
+
+func (x *ApiTypesAntitrackerInfo) Reset() {
+	*x = ApiTypesAntitrackerInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[227]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesAntitrackerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesAntitrackerInfo) ProtoMessage() {}
+
+func (x *ApiTypesAntitrackerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[227]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesAntitrackerInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesAntitrackerInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{227}
+}
+
+func (x *ApiTypesAntitrackerInfo) GetDefault() *ApiTypesDNSInfo {
+	if x != nil {
+		return x.Default
+	}
+	return nil
+}
+
+func (x *ApiTypesAntitrackerInfo) GetHardcore() *ApiTypesDNSInfo {
+	if x != nil {
+		return x.Hardcore
+	}
+	return nil
+}
+
+// DNSInfo contains info about DNS server
+type ApiTypesDNSInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip string `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+}
+
+func (x *ApiTypesDNSInfo) Reset() {
+	*x = ApiTypesDNSInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[228]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesDNSInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesDNSInfo) ProtoMessage() {}
+
+func (x *ApiTypesDNSInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[228]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesDNSInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesDNSInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{228}
+}
+
+func (x *ApiTypesDNSInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+// InfoAPI contains API IP adresses
+type ApiTypesInfoAPI struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ips   []string `protobuf:"bytes,1,rep,name=ips,proto3" json:"ips,omitempty"`
+	Ipv6S []string `protobuf:"bytes,2,rep,name=ipv6s,proto3" json:"ipv6s,omitempty"`
+}
+
+func (x *ApiTypesInfoAPI) Reset() {
+	*x = ApiTypesInfoAPI{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[229]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesInfoAPI) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesInfoAPI) ProtoMessage() {}
+
+func (x *ApiTypesInfoAPI) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[229]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesInfoAPI.ProtoReflect.Descriptor instead.
+func (*ApiTypesInfoAPI) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{229}
+}
+
+func (x *ApiTypesInfoAPI) GetIps() []string {
+	if x != nil {
+		return x.Ips
+	}
+	return nil
+}
+
+func (x *ApiTypesInfoAPI) GetIpv6S() []string {
+	if x != nil {
+		return x.Ipv6S
+	}
+	return nil
+}
+
+type ApiTypesPortsInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Openvpn   []*ApiTypesPortInfo   `protobuf:"bytes,1,rep,name=openvpn,proto3" json:"openvpn,omitempty"`
+	Wireguard []*ApiTypesPortInfo   `protobuf:"bytes,2,rep,name=wireguard,proto3" json:"wireguard,omitempty"`
+	Obfs3     *ApiTypesObfsPortInfo `protobuf:"bytes,3,opt,name=obfs3,proto3" json:"obfs3,omitempty"`
+	Obfs4     *ApiTypesObfsPortInfo `protobuf:"bytes,4,opt,name=obfs4,proto3" json:"obfs4,omitempty"`
+	V2Ray     []*ApiTypesPortInfo   `protobuf:"bytes,5,rep,name=v2ray,proto3" json:"v2ray,omitempty"`
+	// UDP-over-TCP servers (WireGuard over TCP)
+	Udp2Tcp []*ApiTypesPortInfo `protobuf:"bytes,6,rep,name=udp2tcp,proto3" json:"udp2tcp,omitempty"`
+}
+
+func (x *ApiTypesPortsInfo) Reset() {
+	*x = ApiTypesPortsInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[230]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesPortsInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesPortsInfo) ProtoMessage() {}
+
+func (x *ApiTypesPortsInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[230]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesPortsInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesPortsInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{230}
+}
+
+func (x *ApiTypesPortsInfo) GetOpenvpn() []*ApiTypesPortInfo {
+	if x != nil {
+		return x.Openvpn
+	}
+	return nil
+}
+
+func (x *ApiTypesPortsInfo) GetWireguard() []*ApiTypesPortInfo {
+	if x != nil {
+		return x.Wireguard
+	}
+	return nil
+}
+
+func (x *ApiTypesPortsInfo) GetObfs3() *ApiTypesObfsPortInfo {
+	if x != nil {
+		return x.Obfs3
+	}
+	return nil
+}
+
+func (x *ApiTypesPortsInfo) GetObfs4() *ApiTypesObfsPortInfo {
+	if x != nil {
+		return x.Obfs4
+	}
+	return nil
+}
+
+func (x *ApiTypesPortsInfo) GetV2Ray() []*ApiTypesPortInfo {
+	if x != nil {
+		return x.V2Ray
+	}
+	return nil
+}
+
+func (x *ApiTypesPortsInfo) GetUdp2Tcp() []*ApiTypesPortInfo {
+	if x != nil {
+		return x.Udp2Tcp
+	}
+	return nil
+}
+
+type ApiTypesPortInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// "TCP" or "UDP"
+	Type  string             `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Port  int64              `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	Range *ApiTypesPortRange `protobuf:"bytes,3,opt,name=range,proto3" json:"range,omitempty"`
+}
+
+func (x *ApiTypesPortInfo) Reset() {
+	*x = ApiTypesPortInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[231]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesPortInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesPortInfo) ProtoMessage() {}
+
+func (x *ApiTypesPortInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[231]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesPortInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesPortInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{231}
+}
+
+func (x *ApiTypesPortInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ApiTypesPortInfo) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *ApiTypesPortInfo) GetRange() *ApiTypesPortRange {
+	if x != nil {
+		return x.Range
+	}
+	return nil
+}
+
+type ApiTypesPortRange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min int64 `protobuf:"varint,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max int64 `protobuf:"varint,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *ApiTypesPortRange) Reset() {
+	*x = ApiTypesPortRange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[232]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesPortRange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesPortRange) ProtoMessage() {}
+
+func (x *ApiTypesPortRange) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[232]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesPortRange.ProtoReflect.Descriptor instead.
+func (*ApiTypesPortRange) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{232}
+}
+
+func (x *ApiTypesPortRange) GetMin() int64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *ApiTypesPortRange) GetMax() int64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+type ApiTypesObfsPortInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Port int64 `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty"`
+}
+
+func (x *ApiTypesObfsPortInfo) Reset() {
+	*x = ApiTypesObfsPortInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[233]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesObfsPortInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesObfsPortInfo) ProtoMessage() {}
+
+func (x *ApiTypesObfsPortInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[233]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesObfsPortInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesObfsPortInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{233}
+}
+
+func (x *ApiTypesObfsPortInfo) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+// V2RayInfo contains the V2Ray servers configuration
+type ApiTypesV2RayInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// user ID for VMess/VLESS protocols
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ApiTypesV2RayInfo) Reset() {
+	*x = ApiTypesV2RayInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[234]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApiTypesV2RayInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApiTypesV2RayInfo) ProtoMessage() {}
+
+func (x *ApiTypesV2RayInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[234]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApiTypesV2RayInfo.ProtoReflect.Descriptor instead.
+func (*ApiTypesV2RayInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{234}
+}
+
+func (x *ApiTypesV2RayInfo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PingServersResp returns average ping time for servers
+type PingServersResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PingResults []*PingResultType `protobuf:"bytes,1,rep,name=PingResults,proto3" json:"PingResults,omitempty"`
+}
+
+func (x *PingServersResp) Reset() {
+	*x = PingServersResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[235]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingServersResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingServersResp) ProtoMessage() {}
+
+func (x *PingServersResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[235]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingServersResp.ProtoReflect.Descriptor instead.
+func (*PingServersResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{235}
+}
+
+func (x *PingServersResp) GetPingResults() []*PingResultType {
+	if x != nil {
+		return x.PingResults
+	}
+	return nil
+}
+
+// PingResultType represents information ping TTL for a host (is a part of 'PingServersResp')
+type PingResultType struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host string `protobuf:"bytes,1,opt,name=Host,proto3" json:"Host,omitempty"`
+	Ping int64  `protobuf:"varint,2,opt,name=Ping,proto3" json:"Ping,omitempty"`
+}
+
+func (x *PingResultType) Reset() {
+	*x = PingResultType{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[236]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PingResultType) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PingResultType) ProtoMessage() {}
+
+func (x *PingResultType) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[236]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PingResultType.ProtoReflect.Descriptor instead.
+func (*PingResultType) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{236}
+}
+
+func (x *PingResultType) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *PingResultType) GetPing() int64 {
+	if x != nil {
+		return x.Ping
+	}
+	return 0
+}
+
+// WiFiAvailableNetworksResp - contains information about available WIFI networks
+type WiFiAvailableNetworksResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Networks []*WiFiNetworkInfo `protobuf:"bytes,1,rep,name=Networks,proto3" json:"Networks,omitempty"`
+}
+
+func (x *WiFiAvailableNetworksResp) Reset() {
+	*x = WiFiAvailableNetworksResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[237]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WiFiAvailableNetworksResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WiFiAvailableNetworksResp) ProtoMessage() {}
+
+func (x *WiFiAvailableNetworksResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[237]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WiFiAvailableNetworksResp.ProtoReflect.Descriptor instead.
+func (*WiFiAvailableNetworksResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{237}
+}
+
+func (x *WiFiAvailableNetworksResp) GetNetworks() []*WiFiNetworkInfo {
+	if x != nil {
+		return x.Networks
+	}
+	return nil
+}
+
+// WiFiNetworkInfo - information about WIFI network
+type WiFiNetworkInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SSID string `protobuf:"bytes,1,opt,name=SSID,proto3" json:"SSID,omitempty"`
+}
+
+func (x *WiFiNetworkInfo) Reset() {
+	*x = WiFiNetworkInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[238]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WiFiNetworkInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WiFiNetworkInfo) ProtoMessage() {}
+
+func (x *WiFiNetworkInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[238]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WiFiNetworkInfo.ProtoReflect.Descriptor instead.
+func (*WiFiNetworkInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{238}
+}
+
+func (x *WiFiNetworkInfo) GetSSID() string {
+	if x != nil {
+		return x.SSID
+	}
+	return ""
+}
+
+// WiFiCurrentNetworkResp contains the information about currently connected WIFI
+type WiFiCurrentNetworkResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SSID string `protobuf:"bytes,1,opt,name=SSID,proto3" json:"SSID,omitempty"`
+	// MAC address of the access point (can be empty)
+	BSSID             string `protobuf:"bytes,2,opt,name=BSSID,proto3" json:"BSSID,omitempty"`
+	IsInsecureNetwork bool   `protobuf:"varint,3,opt,name=IsInsecureNetwork,proto3" json:"IsInsecureNetwork,omitempty"`
+}
+
+func (x *WiFiCurrentNetworkResp) Reset() {
+	*x = WiFiCurrentNetworkResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[239]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WiFiCurrentNetworkResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WiFiCurrentNetworkResp) ProtoMessage() {}
+
+func (x *WiFiCurrentNetworkResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[239]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WiFiCurrentNetworkResp.ProtoReflect.Descriptor instead.
+func (*WiFiCurrentNetworkResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{239}
+}
+
+func (x *WiFiCurrentNetworkResp) GetSSID() string {
+	if x != nil {
+		return x.SSID
+	}
+	return ""
+}
+
+func (x *WiFiCurrentNetworkResp) GetBSSID() string {
+	if x != nil {
+		return x.BSSID
+	}
+	return ""
+}
+
+func (x *WiFiCurrentNetworkResp) GetIsInsecureNetwork() bool {
+	if x != nil {
+		return x.IsInsecureNetwork
+	}
+	return false
+}
+
+// APIResponse contains the raw data of response to custom API request
+type APIResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	APIPath      string `protobuf:"bytes,1,opt,name=APIPath,proto3" json:"APIPath,omitempty"`
+	ResponseData string `protobuf:"bytes,2,opt,name=ResponseData,proto3" json:"ResponseData,omitempty"`
+	Error        string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"`
+	// IsStale - the API is not reachable, ResponseData contains the last successful response (received at CachedAt)
+	IsStale bool `protobuf:"varint,4,opt,name=IsStale,proto3" json:"IsStale,omitempty"`
+	// Unix time
+	CachedAt int64 `protobuf:"varint,5,opt,name=CachedAt,proto3" json:"CachedAt,omitempty"`
+}
+
+func (x *APIResponse) Reset() {
+	*x = APIResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[240]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *APIResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*APIResponse) ProtoMessage() {}
+
+func (x *APIResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[240]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use APIResponse.ProtoReflect.Descriptor instead.
+func (*APIResponse) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{240}
+}
+
+func (x *APIResponse) GetAPIPath() string {
+	if x != nil {
+		return x.APIPath
+	}
+	return ""
+}
+
+func (x *APIResponse) GetResponseData() string {
+	if x != nil {
+		return x.ResponseData
+	}
+	return ""
+}
+
+func (x *APIResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *APIResponse) GetIsStale() bool {
+	if x != nil {
+		return x.IsStale
+	}
+	return false
+}
+
+func (x *APIResponse) GetCachedAt() int64 {
+	if x != nil {
+		return x.CachedAt
+	}
+	return 0
+}
+
+// InstalledAppsResp (response) contains information about installed applications on the system
+type InstalledAppsResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Apps []*OshelpersAppInfo `protobuf:"bytes,1,rep,name=Apps,proto3" json:"Apps,omitempty"`
+}
+
+func (x *InstalledAppsResp) Reset() {
+	*x = InstalledAppsResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[241]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InstalledAppsResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InstalledAppsResp) ProtoMessage() {}
+
+func (x *InstalledAppsResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[241]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InstalledAppsResp.ProtoReflect.Descriptor instead.
+func (*InstalledAppsResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{241}
+}
+
+func (x *InstalledAppsResp) GetApps() []*OshelpersAppInfo {
+	if x != nil {
+		return x.Apps
+	}
+	return nil
+}
+
+type OshelpersAppInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Application description: [<AppGroup>/]<AppName>.
+	// Example 1: "Git/Git GUI"
+	// 		AppName  = "Git GUI"
+	// 		AppGroup = "Git"
+	// Example 2: "Firefox"
+	// 		AppName  = "Firefox"
+	// 		AppGroup = null
+	AppName string `protobuf:"bytes,1,opt,name=AppName,proto3" json:"AppName,omitempty"`
+	// optional
+	AppGroup string `protobuf:"bytes,2,opt,name=AppGroup,proto3" json:"AppGroup,omitempty"`
+	// base64 icon of the executable binary
+	AppIcon string `protobuf:"bytes,3,opt,name=AppIcon,proto3" json:"AppIcon,omitempty"`
+	// The unique parameter describing an application
+	// Windows: absolute path to application binary
+	// Linux: program to execute, possibly with arguments.
+	AppBinaryPath string `protobuf:"bytes,4,opt,name=AppBinaryPath,proto3" json:"AppBinaryPath,omitempty"`
+}
+
+func (x *OshelpersAppInfo) Reset() {
+	*x = OshelpersAppInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[242]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OshelpersAppInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OshelpersAppInfo) ProtoMessage() {}
+
+func (x *OshelpersAppInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[242]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OshelpersAppInfo.ProtoReflect.Descriptor instead.
+func (*OshelpersAppInfo) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{242}
+}
+
+func (x *OshelpersAppInfo) GetAppName() string {
+	if x != nil {
+		return x.AppName
+	}
+	return ""
+}
+
+func (x *OshelpersAppInfo) GetAppGroup() string {
+	if x != nil {
+		return x.AppGroup
+	}
+	return ""
+}
+
+func (x *OshelpersAppInfo) GetAppIcon() string {
+	if x != nil {
+		return x.AppIcon
+	}
+	return ""
+}
+
+func (x *OshelpersAppInfo) GetAppBinaryPath() string {
+	if x != nil {
+		return x.AppBinaryPath
+	}
+	return ""
+}
+
+// AppIconResp (response) contains information about shell icon for binary file (application)
+type AppIconResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppBinaryPath string `protobuf:"bytes,1,opt,name=AppBinaryPath,proto3" json:"AppBinaryPath,omitempty"`
+	// base64 png image
+	AppIcon string `protobuf:"bytes,2,opt,name=AppIcon,proto3" json:"AppIcon,omitempty"`
+}
+
+func (x *AppIconResp) Reset() {
+	*x = AppIconResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[243]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AppIconResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppIconResp) ProtoMessage() {}
+
+func (x *AppIconResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[243]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppIconResp.ProtoReflect.Descriptor instead.
+func (*AppIconResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{243}
+}
+
+func (x *AppIconResp) GetAppBinaryPath() string {
+	if x != nil {
+		return x.AppBinaryPath
+	}
+	return ""
+}
+
+func (x *AppIconResp) GetAppIcon() string {
+	if x != nil {
+		return x.AppIcon
+	}
+	return ""
+}
+
+// SplitTunnelStatus (response) returns the split-tunnelling configuration
+type SplitTunnelStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// is ST enabled
+	IsEnabled                   bool `protobuf:"varint,1,opt,name=IsEnabled,proto3" json:"IsEnabled,omitempty"`
+	IsFunctionalityNotAvailable bool `protobuf:"varint,2,opt,name=IsFunctionalityNotAvailable,proto3" json:"IsFunctionalityNotAvailable,omitempty"`
+	// This parameter informs availability of the functionality to get icon for particular binary
+	// (true - if commands GetAppIcon/AppIconResp  applicable for this platform)
+	IsCanGetAppIconForBinary bool `protobuf:"varint,3,opt,name=IsCanGetAppIconForBinary,proto3" json:"IsCanGetAppIconForBinary,omitempty"`
+	// Information about applications added to ST configuration
+	// (applicable for Windows)
+	SplitTunnelApps []string `protobuf:"bytes,4,rep,name=SplitTunnelApps,proto3" json:"SplitTunnelApps,omitempty"`
+	// Information about active applications running in Split-Tunnel environment
+	// (applicable for Linux)
+	RunningApps []*SplittunRunningApp `protobuf:"bytes,5,rep,name=RunningApps,proto3" json:"RunningApps,omitempty"`
+	// true - if Split Tunneling by destinations is applicable for this platform
+	// (applicable for Linux and macOS)
+	IsCanSplitDestinations bool `protobuf:"varint,6,opt,name=IsCanSplitDestinations,proto3" json:"IsCanSplitDestinations,omitempty"`
+	// Destinations (IP addresses, networks, domain names) excluded from (or included into) the VPN tunnel
+	Destinations []*SplittunDestination `protobuf:"bytes,7,rep,name=Destinations,proto3" json:"Destinations,omitempty"`
+	// true - if Split Tunneling for containers is applicable for this platform
+	// (applicable for Linux with cgroup-v2)
+	IsCanSplitContainers bool `protobuf:"varint,8,opt,name=IsCanSplitContainers,proto3" json:"IsCanSplitContainers,omitempty"`
+	// Containers (Docker container names/IDs or cgroup-v2 paths) excluded from (or included into) the VPN tunnel
+	Containers []*SplittunContainer `protobuf:"bytes,9,rep,name=Containers,proto3" json:"Containers,omitempty"`
+	// true - if Split Tunneling for users is applicable for this platform
+	// (applicable for Linux)
+	IsCanSplitUsers bool `protobuf:"varint,10,opt,name=IsCanSplitUsers,proto3" json:"IsCanSplitUsers,omitempty"`
+	// Users (user names or UIDs) which traffic is excluded from (or included into) the VPN tunnel
+	Users []*SplittunUser `protobuf:"bytes,11,rep,name=Users,proto3" json:"Users,omitempty"`
+}
+
+func (x *SplitTunnelStatus) Reset() {
+	*x = SplitTunnelStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[244]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnelStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelStatus) ProtoMessage() {}
+
+func (x *SplitTunnelStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[244]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelStatus.ProtoReflect.Descriptor instead.
+func (*SplitTunnelStatus) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{244}
+}
+
+func (x *SplitTunnelStatus) GetIsEnabled() bool {
+	if x != nil {
+		return x.IsEnabled
+	}
+	return false
+}
+
+func (x *SplitTunnelStatus) GetIsFunctionalityNotAvailable() bool {
+	if x != nil {
+		return x.IsFunctionalityNotAvailable
+	}
+	return false
+}
+
+func (x *SplitTunnelStatus) GetIsCanGetAppIconForBinary() bool {
+	if x != nil {
+		return x.IsCanGetAppIconForBinary
+	}
+	return false
+}
+
+func (x *SplitTunnelStatus) GetSplitTunnelApps() []string {
+	if x != nil {
+		return x.SplitTunnelApps
+	}
+	return nil
+}
+
+func (x *SplitTunnelStatus) GetRunningApps() []*SplittunRunningApp {
+	if x != nil {
+		return x.RunningApps
+	}
+	return nil
+}
+
+func (x *SplitTunnelStatus) GetIsCanSplitDestinations() bool {
+	if x != nil {
+		return x.IsCanSplitDestinations
+	}
+	return false
+}
+
+func (x *SplitTunnelStatus) GetDestinations() []*SplittunDestination {
+	if x != nil {
+		return x.Destinations
+	}
+	return nil
+}
+
+func (x *SplitTunnelStatus) GetIsCanSplitContainers() bool {
+	if x != nil {
+		return x.IsCanSplitContainers
+	}
+	return false
+}
+
+func (x *SplitTunnelStatus) GetContainers() []*SplittunContainer {
+	if x != nil {
+		return x.Containers
+	}
+	return nil
+}
+
+func (x *SplitTunnelStatus) GetIsCanSplitUsers() bool {
+	if x != nil {
+		return x.IsCanSplitUsers
+	}
+	return false
+}
+
+func (x *SplitTunnelStatus) GetUsers() []*SplittunUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// Information about running application
+// https://man7.org/linux/man-pages/man5/proc.5.html
+type SplittunRunningApp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pid int64 `protobuf:"varint,1,opt,name=Pid,proto3" json:"Pid,omitempty"`
+	// The PID of the parent of this process.
+	Ppid    int64  `protobuf:"varint,2,opt,name=Ppid,proto3" json:"Ppid,omitempty"`
+	Cmdline string `protobuf:"bytes,3,opt,name=Cmdline,proto3" json:"Cmdline,omitempty"`
+	// The actual pathname of the executed command
+	Exe string `protobuf:"bytes,4,opt,name=Exe,proto3" json:"Exe,omitempty"`
+	// PID of the known parent process registered by AddPid() function
+	ExtIvpnRootPid     int64  `protobuf:"varint,5,opt,name=ExtIvpnRootPid,proto3" json:"ExtIvpnRootPid,omitempty"`
+	ExtModifiedCmdLine string `protobuf:"bytes,6,opt,name=ExtModifiedCmdLine,proto3" json:"ExtModifiedCmdLine,omitempty"`
+}
+
+func (x *SplittunRunningApp) Reset() {
+	*x = SplittunRunningApp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[245]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplittunRunningApp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplittunRunningApp) ProtoMessage() {}
+
+func (x *SplittunRunningApp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[245]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplittunRunningApp.ProtoReflect.Descriptor instead.
+func (*SplittunRunningApp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{245}
+}
+
+func (x *SplittunRunningApp) GetPid() int64 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *SplittunRunningApp) GetPpid() int64 {
+	if x != nil {
+		return x.Ppid
+	}
+	return 0
+}
+
+func (x *SplittunRunningApp) GetCmdline() string {
+	if x != nil {
+		return x.Cmdline
+	}
+	return ""
+}
+
+func (x *SplittunRunningApp) GetExe() string {
+	if x != nil {
+		return x.Exe
+	}
+	return ""
+}
+
+func (x *SplittunRunningApp) GetExtIvpnRootPid() int64 {
+	if x != nil {
+		return x.ExtIvpnRootPid
+	}
+	return 0
+}
+
+func (x *SplittunRunningApp) GetExtModifiedCmdLine() string {
+	if x != nil {
+		return x.ExtModifiedCmdLine
+	}
+	return ""
+}
+
+// SplitTunnelAddAppCmdResp (response) contains shell command which have to be executed in user space environment
+// (not in use for Windows platform)
+type SplitTunnelAddAppCmdResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Command will be executed in ST environment
+	// (identical to SplitTunnelAddApp.Exec)
+	Exec string `protobuf:"bytes,1,opt,name=Exec,proto3" json:"Exec,omitempty"`
+	// Shell command which have to be executed in user space environment
+	CmdToExecute            string `protobuf:"bytes,2,opt,name=CmdToExecute,proto3" json:"CmdToExecute,omitempty"`
+	IsAlreadyRunning        bool   `protobuf:"varint,3,opt,name=IsAlreadyRunning,proto3" json:"IsAlreadyRunning,omitempty"`
+	IsAlreadyRunningMessage string `protobuf:"bytes,4,opt,name=IsAlreadyRunningMessage,proto3" json:"IsAlreadyRunningMessage,omitempty"`
+}
+
+func (x *SplitTunnelAddAppCmdResp) Reset() {
+	*x = SplitTunnelAddAppCmdResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ivpn_proto_msgTypes[246]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitTunnelAddAppCmdResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitTunnelAddAppCmdResp) ProtoMessage() {}
+
+func (x *SplitTunnelAddAppCmdResp) ProtoReflect() protoreflect.Message {
+	mi := &file_ivpn_proto_msgTypes[246]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitTunnelAddAppCmdResp.ProtoReflect.Descriptor instead.
+func (*SplitTunnelAddAppCmdResp) Descriptor() ([]byte, []int) {
+	return file_ivpn_proto_rawDescGZIP(), []int{246}
+}
+
+func (x *SplitTunnelAddAppCmdResp) GetExec() string {
+	if x != nil {
+		return x.Exec
+	}
+	return ""
+}
+
+func (x *SplitTunnelAddAppCmdResp) GetCmdToExecute() string {
+	if x != nil {
+		return x.CmdToExecute
+	}
+	return ""
+}
+
+func (x *SplitTunnelAddAppCmdResp) GetIsAlreadyRunning() bool {
+	if x != nil {
+		return x.IsAlreadyRunning
+	}
+	return false
+}
+
+func (x *SplitTunnelAddAppCmdResp) GetIsAlreadyRunningMessage() string {
+	if x != nil {
+		return x.IsAlreadyRunningMessage
+	}
+	return ""
+}
+
+var File_ivpn_proto protoreflect.FileDescriptor
+
+var file_ivpn_proto_rawDesc = []byte{
+	0x0a, 0x0a, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x32, 0x0a, 0x08, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xe7,
+	0x03, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x3a,
+	0x0a, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x6f,
+	0x41, 0x6c, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x18, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x54, 0x6f,
+	0x41, 0x6c, 0x6c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x32, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x47, 0x65, 0x74, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x57, 0x69, 0x46, 0x69, 0x43,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x47, 0x65, 0x74, 0x57, 0x69, 0x46, 0x69, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x22, 0x62, 0x0a, 0x1a, 0x50, 0x61, 0x72, 0x61, 0x6e, 0x6f, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65,
+	0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x26,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x65, 0x77, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x22, 0x35, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x56, 0x50, 0x4e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x68, 0x0a, 0x0a, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x32, 0x0a, 0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x14, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x4d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x54, 0x69, 0x6d, 0x65, 0x4f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x56,
+	0x70, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x7a, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x56, 0x70, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x15, 0x56,
+	0x70, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x56, 0x70, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x50, 0x72, 0x69, 0x6f, 0x72, 0x69, 0x74, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x3a, 0x0a, 0x18, 0x50, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x6c, 0x48, 0x6f, 0x73, 0x74,
+	0x73, 0x4f, 0x6e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x18, 0x50, 0x69, 0x6e, 0x67, 0x41, 0x6c, 0x6c, 0x48, 0x6f, 0x73, 0x74,
+	0x73, 0x4f, 0x6e, 0x46, 0x69, 0x72, 0x73, 0x74, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a,
+	0x0f, 0x53, 0x6b, 0x69, 0x70, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x50, 0x68, 0x61, 0x73, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x53, 0x6b, 0x69, 0x70, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x50, 0x68, 0x61, 0x73, 0x65, 0x22, 0x7e, 0x0a, 0x0a, 0x41, 0x50, 0x49, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x41, 0x50, 0x49, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x41, 0x50, 0x49, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x12, 0x49, 0x50, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x12, 0x49, 0x50, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x3f, 0x0a, 0x15, 0x57, 0x69, 0x46, 0x69, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3d, 0x0a, 0x13, 0x4b, 0x69, 0x6c, 0x6c,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x5c, 0x0a, 0x14, 0x4b, 0x69, 0x6c, 0x6c, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x76, 0x0a, 0x1e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x2c, 0x0a, 0x11, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x4d, 0x75, 0x6c, 0x74, 0x69,
+	0x63, 0x61, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4c, 0x41, 0x4e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x22, 0x5b, 0x0a,
+	0x15, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x22, 0x83, 0x01, 0x0a, 0x1d, 0x4b,
+	0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4c, 0x41, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x6e, 0x73, 0x76, 0x63, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x08, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x22, 0x68, 0x0a, 0x0e, 0x4c, 0x61, 0x6e, 0x73, 0x76, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4d, 0x44, 0x4e, 0x53, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x04, 0x4d, 0x44, 0x4e, 0x53, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x53, 0x44, 0x50, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x53, 0x53, 0x44, 0x50, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x48,
+	0x43, 0x50, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x44, 0x48, 0x43, 0x50, 0x12, 0x1a,
+	0x0a, 0x08, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x22, 0x5c, 0x0a, 0x1c, 0x4b, 0x69,
+	0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x4c, 0x41, 0x4e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x7f, 0x0a, 0x1c, 0x4b, 0x69, 0x6c, 0x6c,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x49, 0x6e, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x37, 0x0a, 0x05, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x61, 0x6e, 0x73, 0x76, 0x63, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x05, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x43, 0x0a, 0x11, 0x4c, 0x61, 0x6e,
+	0x73, 0x76, 0x63, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x50, 0x6f,
+	0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0x75,
+	0x0a, 0x1b, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x63, 0x65, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x46, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x50,
+	0x61, 0x72, 0x73, 0x69, 0x6e, 0x67, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x12, 0x46, 0x61, 0x69, 0x6c, 0x4f, 0x6e, 0x50, 0x61, 0x72, 0x73, 0x69, 0x6e, 0x67,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x67, 0x0a, 0x19, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x74,
+	0x0a, 0x1c, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x41, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x70, 0x69, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x22, 0x5f, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x0a,
+	0x03, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x81, 0x01, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4f, 0x62, 0x66,
+	0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x49,
+	0x0a, 0x0f, 0x4f, 0x62, 0x66, 0x73, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x66, 0x73, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x4f, 0x62, 0x66, 0x73, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x8f, 0x01, 0x0a, 0x0f, 0x4f, 0x62,
+	0x66, 0x73, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x4f, 0x62, 0x66, 0x73, 0x34,
+	0x49, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4f, 0x62, 0x66, 0x73, 0x34,
+	0x49, 0x61, 0x74, 0x12, 0x46, 0x0a, 0x0b, 0x4f, 0x62, 0x66, 0x73, 0x34, 0x42, 0x72, 0x69, 0x64,
+	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x66, 0x73, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x4f, 0x62, 0x66, 0x73, 0x34, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x52, 0x0b,
+	0x4f, 0x62, 0x66, 0x73, 0x34, 0x42, 0x72, 0x69, 0x64, 0x67, 0x65, 0x22, 0x3e, 0x0a, 0x14, 0x4f,
+	0x62, 0x66, 0x73, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x4f, 0x62, 0x66, 0x73, 0x34, 0x42, 0x72, 0x69,
+	0x64, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x65, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x43, 0x65, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x57, 0x0a, 0x0d, 0x53,
+	0x65, 0x74, 0x56, 0x32, 0x52, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x26, 0x0a, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x56, 0x32, 0x52, 0x61, 0x79, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x56, 0x32, 0x52, 0x61, 0x79, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x22, 0x72, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x41, 0x70, 0x69, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x7b, 0x0a, 0x13, 0x41, 0x70, 0x69, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0x7d, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x41, 0x70, 0x69, 0x48,
+	0x6f, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x3f, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x41, 0x50, 0x49,
+	0x48, 0x6f, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x06, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0x53, 0x0a, 0x17, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x41, 0x50, 0x49, 0x48, 0x6f, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x42, 0x61, 0x73, 0x65, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x42, 0x61, 0x73, 0x65, 0x55, 0x52, 0x4c, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x65, 0x72,
+	0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x43,
+	0x65, 0x72, 0x74, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x19, 0x53, 0x65, 0x74,
+	0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4f, 0x70,
+	0x65, 0x6e, 0x56, 0x70, 0x6e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x70, 0x6e, 0x4f, 0x70,
+	0x65, 0x6e, 0x56, 0x70, 0x6e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x06, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x88, 0x01, 0x0a, 0x16, 0x56, 0x70,
+	0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x54, 0x6c, 0x73, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x54, 0x6c, 0x73,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x61,
+	0x74, 0x61, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x44, 0x61, 0x74, 0x61, 0x43, 0x69, 0x70, 0x68, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x54, 0x6c, 0x73, 0x43, 0x65, 0x72, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x41, 0x70, 0x69, 0x47,
+	0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x0a, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x74, 0x41, 0x70, 0x69, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50,
+	0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x49,
+	0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22,
+	0x34, 0x0a, 0x0a, 0x47, 0x72, 0x70, 0x63, 0x41, 0x70, 0x69, 0x47, 0x65, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x86, 0x01, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x41, 0x70, 0x69, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x52, 0x65, 0x73, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x76,
+	0x0a, 0x0e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x8c, 0x01, 0x0a, 0x14, 0x4c, 0x6f, 0x67, 0x67, 0x65,
+	0x72, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1c, 0x0a, 0x09, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x4d, 0x61, 0x78, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42, 0x12, 0x1a, 0x0a,
+	0x08, 0x4d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x4d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61, 0x78,
+	0x41, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4d,
+	0x61, 0x78, 0x41, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x43, 0x6f, 0x6d,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x22, 0x36, 0x0a, 0x0c, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x73, 0x47, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x63, 0x0a,
+	0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x22, 0x39, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x47, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x62, 0x0a,
+	0x0e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x12,
+	0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53,
+	0x63, 0x6f, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x70,
+	0x65, 0x22, 0x4f, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x22, 0x60, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e,
+	0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x47, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xe7, 0x06, 0x0a, 0x1b, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64,
+	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2c,
+	0x0a, 0x11, 0x49, 0x73, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x49, 0x73, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x12, 0x4f, 0x0a, 0x08,
+	0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x08, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x12, 0x58, 0x0a,
+	0x10, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x10, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x5c, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x52, 0x12, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x0b, 0x57, 0x69, 0x46, 0x69, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x57, 0x69, 0x46, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x0b, 0x57, 0x69, 0x46, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x3f,
+	0x0a, 0x0a, 0x4f, 0x62, 0x66, 0x73, 0x34, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x66, 0x73, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0a, 0x4f, 0x62, 0x66, 0x73, 0x34, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12,
+	0x1e, 0x0a, 0x0a, 0x56, 0x32, 0x52, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x56, 0x32, 0x52, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12,
+	0x4d, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x64, 0x6f,
+	0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x10, 0x53, 0x68,
+	0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x37,
+	0x0a, 0x09, 0x41, 0x6d, 0x6e, 0x65, 0x7a, 0x69, 0x61, 0x57, 0x47, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x77, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x41, 0x6d,
+	0x6e, 0x65, 0x7a, 0x69, 0x61, 0x57, 0x47, 0x12, 0x3f, 0x0a, 0x08, 0x41, 0x70, 0x69, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08,
+	0x41, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x58, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x6e,
+	0x56, 0x70, 0x6e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x70, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70,
+	0x6e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x13, 0x4f,
+	0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0xb4, 0x03, 0x0a, 0x23, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61,
+	0x6c, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x12, 0x30,
+	0x0a, 0x13, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x49, 0x73, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74,
+	0x12, 0x4a, 0x0a, 0x10, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x6e, 0x73,
+	0x76, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x10, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4c, 0x41, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4c, 0x41, 0x4e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4c, 0x41,
+	0x4e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0f, 0x4c, 0x41, 0x4e, 0x49, 0x6e, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4c, 0x61, 0x6e, 0x73, 0x76, 0x63, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f,
+	0x72, 0x74, 0x52, 0x0f, 0x4c, 0x41, 0x4e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f,
+	0x72, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x70,
+	0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa1, 0x05, 0x0a, 0x1c, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x08, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x49, 0x50, 0x76, 0x36, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x04, 0x49, 0x50, 0x76, 0x36, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x50, 0x76, 0x36, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x50, 0x76, 0x36, 0x4f,
+	0x6e, 0x6c, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x50, 0x4d,
+	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x49, 0x50, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x70, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x56, 0x70, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x3c, 0x0a, 0x09, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x44, 0x4e, 0x53, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6e, 0x73, 0x44, 0x6e, 0x73, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x09, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x44, 0x4e, 0x53, 0x12,
+	0x1e, 0x0a, 0x0a, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x4f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x4f, 0x6e, 0x12,
+	0x3e, 0x0a, 0x1a, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x4f, 0x6e, 0x44, 0x75, 0x72,
+	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x1a, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x4f, 0x6e, 0x44,
+	0x75, 0x72, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x71, 0x0a, 0x13, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75,
+	0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x13, 0x57,
+	0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x4c, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x6b, 0x0a, 0x11, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x52, 0x11, 0x4f, 0x70, 0x65, 0x6e,
+	0x56, 0x70, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xf3, 0x02,
+	0x0a, 0x1b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x32, 0x0a,
+	0x14, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x78, 0x69, 0x74, 0x12, 0x51, 0x0a, 0x0b, 0x41, 0x6e, 0x74, 0x69, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x41, 0x6e, 0x74, 0x69, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65,
+	0x72, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x41, 0x6e, 0x74, 0x69, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x1a, 0x46, 0x61, 0x73, 0x74, 0x65, 0x73,
+	0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1a, 0x46, 0x61, 0x73, 0x74,
+	0x65, 0x73, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x45, 0x78, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x5b, 0x0a, 0x0f, 0x46, 0x61, 0x73, 0x74, 0x65, 0x73,
+	0x74, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72, 0x69, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x46, 0x61, 0x73,
+	0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x52, 0x0f, 0x46, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x43, 0x72, 0x69, 0x74, 0x65,
+	0x72, 0x69, 0x61, 0x22, 0x57, 0x0a, 0x1f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x41, 0x6e, 0x74, 0x69, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x65,
+	0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x48, 0x61, 0x72, 0x64, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x08, 0x48, 0x61, 0x72, 0x64, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x93, 0x01, 0x0a,
+	0x21, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x46, 0x61, 0x73,
+	0x74, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43, 0x72, 0x69, 0x74, 0x65, 0x72,
+	0x69, 0x61, 0x12, 0x2a, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x6e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x4f, 0x6e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x4c, 0x6f, 0x77, 0x4c, 0x6f, 0x61, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x4c, 0x6f, 0x77, 0x4c, 0x6f,
+	0x61, 0x64, 0x22, 0x92, 0x01, 0x0a, 0x0e, 0x44, 0x6e, 0x73, 0x44, 0x6e, 0x73, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x6e, 0x73, 0x48, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x6e, 0x73, 0x48, 0x6f, 0x73, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x20, 0x0a, 0x0b, 0x44, 0x6f, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x44, 0x6f, 0x68, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74,
+	0x65, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x44, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x22, 0xa9, 0x03, 0x0a, 0x2f, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x57, 0x0a, 0x04, 0x50,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x04,
+	0x50, 0x6f, 0x72, 0x74, 0x12, 0x75, 0x0a, 0x0e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x70, 0x6e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4d, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75,
+	0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x56, 0x70, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x0e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x56, 0x70, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x68, 0x0a, 0x12, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x68, 0x6f, 0x70, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x48, 0x6f, 0x70, 0x45, 0x78, 0x69,
+	0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x52, 0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x6f, 0x70, 0x45, 0x78, 0x69, 0x74, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x74, 0x75, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x4d, 0x74, 0x75, 0x12, 0x2a, 0x0a, 0x10, 0x54, 0x63, 0x70, 0x45, 0x6e,
+	0x63, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x10, 0x54, 0x63, 0x70, 0x45, 0x6e, 0x63, 0x61, 0x70, 0x73, 0x75, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x49, 0x0a, 0x33, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x86,
+	0x01, 0x0a, 0x3d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x57,
+	0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x70, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x45, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61,
+	0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xc2, 0x02, 0x0a, 0x1f, 0x41, 0x70, 0x69, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x6e, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x6e, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x68, 0x6f, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x6f, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x6c, 0x6f, 0x61,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x32, 0x72, 0x61, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x32, 0x72, 0x61, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x69, 0x70, 0x12, 0x47, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47,
+	0x75, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x49, 0x50, 0x76, 0x36, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x22, 0x55, 0x0a, 0x23,
+	0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x49,
+	0x50, 0x76, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x69, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x5f, 0x69, 0x70, 0x22, 0xa3, 0x01, 0x0a, 0x28, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x48, 0x6f, 0x70, 0x45, 0x78, 0x69, 0x74,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x69, 0x74, 0x53, 0x72, 0x76, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x69, 0x74, 0x53, 0x72, 0x76, 0x49, 0x44, 0x12, 0x45,
+	0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x66, 0x0a, 0x18, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x65, 0x78, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65,
+	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0xa3, 0x03, 0x0a, 0x2d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x73, 0x0a, 0x0e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x70, 0x6e, 0x53,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x4b, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56,
+	0x70, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x0e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56,
+	0x70, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x66, 0x0a, 0x12, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x68, 0x6f, 0x70, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x36, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x48, 0x6f, 0x70, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x5f, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x52, 0x12, 0x4d, 0x75,
+	0x6c, 0x74, 0x69, 0x68, 0x6f, 0x70, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x3e, 0x0a, 0x05, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x70, 0x65,
+	0x6e, 0x56, 0x70, 0x6e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x52, 0x05, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x12, 0x55, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4f, 0x70, 0x65, 0x6e,
+	0x56, 0x70, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x50, 0x6f, 0x72,
+	0x74, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x3b, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x56, 0x70,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x43, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x4f, 0x70, 0x65, 0x6e, 0x56, 0x50, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x6f, 0x73,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x22, 0xba, 0x02, 0x0a,
+	0x1d, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x50, 0x4e,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a,
+	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x6e, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x64, 0x6e, 0x73, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x68, 0x6f, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x6f, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04,
+	0x6c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x32, 0x72, 0x61, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x32, 0x72, 0x61, 0x79, 0x12, 0x36, 0x0a, 0x04, 0x6f, 0x62,
+	0x66, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x4f, 0x62, 0x66, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x04, 0x6f, 0x62,
+	0x66, 0x73, 0x12, 0x45, 0x0a, 0x04, 0x69, 0x70, 0x76, 0x36, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x31, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x50,
+	0x4e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x49,
+	0x50, 0x76, 0x36, 0x52, 0x04, 0x69, 0x70, 0x76, 0x36, 0x22, 0x96, 0x01, 0x0a, 0x12, 0x41, 0x70,
+	0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x62, 0x66, 0x73, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x30, 0x0a, 0x13, 0x6f, 0x62, 0x66, 0x73, 0x33, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68,
+	0x6f, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x6f,
+	0x62, 0x66, 0x73, 0x33, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x6f, 0x70, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x6f, 0x62, 0x66, 0x73, 0x34, 0x5f, 0x6d, 0x75, 0x6c, 0x74,
+	0x69, 0x68, 0x6f, 0x70, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x13, 0x6f, 0x62, 0x66, 0x73, 0x34, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x68, 0x6f, 0x70, 0x5f,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6f, 0x62, 0x66, 0x73, 0x34, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x62, 0x66, 0x73, 0x34, 0x5f, 0x6b,
+	0x65, 0x79, 0x22, 0x37, 0x0a, 0x21, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x70,
+	0x65, 0x6e, 0x56, 0x50, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x49, 0x50, 0x76, 0x36, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x9f, 0x01, 0x0a, 0x26,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4d, 0x75, 0x6c, 0x74,
+	0x69, 0x48, 0x6f, 0x70, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x4f,
+	0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x69, 0x74, 0x53, 0x72,
+	0x76, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x69, 0x74, 0x53,
+	0x72, 0x76, 0x49, 0x44, 0x12, 0x43, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x70, 0x65,
+	0x6e, 0x56, 0x50, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x94, 0x01,
+	0x0a, 0x18, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x70,
+	0x65, 0x6e, 0x56, 0x70, 0x6e, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x22, 0x63, 0x0a, 0x31, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xb9, 0x01, 0x0a, 0x1c, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x44,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x3f, 0x0a, 0x0a, 0x4f, 0x62, 0x66, 0x73, 0x34, 0x70, 0x72, 0x6f,
+	0x78, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x62, 0x66, 0x73, 0x70, 0x72,
+	0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x4f, 0x62, 0x66, 0x73, 0x34,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x22, 0xac, 0x03, 0x0a, 0x15, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x57, 0x69, 0x46, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x32, 0x0a, 0x14, 0x63, 0x61, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x6e, 0x42, 0x61, 0x63,
+	0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x63,
+	0x61, 0x6e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x49, 0x6e, 0x42, 0x61, 0x63, 0x6b, 0x67, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x40, 0x0a, 0x1b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x56, 0x50,
+	0x4e, 0x4f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x56, 0x50, 0x4e, 0x4f, 0x6e, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x36, 0x0a, 0x16, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x41, 0x0a,
+	0x19, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x48, 0x00, 0x52, 0x19, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01,
+	0x12, 0x42, 0x0a, 0x08, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x57,
+	0x69, 0x46, 0x69, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x08, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x73, 0x12, 0x40, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x57, 0x69, 0x46, 0x69, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x54, 0x72, 0x75, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x54, 0x72, 0x75,
+	0x73, 0x74, 0x65, 0x64, 0x22, 0xa2, 0x01, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x57, 0x69, 0x46, 0x69, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x73, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
+	0x73, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x73, 0x73, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x62, 0x73, 0x73, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x54,
+	0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
+	0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x73, 0x57, 0x69, 0x46, 0x69, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x16, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x57, 0x69, 0x46, 0x69, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x75, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x56, 0x70, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x75, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x56, 0x70, 0x6e, 0x12, 0x38, 0x0a, 0x17, 0x75, 0x6e, 0x54, 0x72, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x75, 0x6e, 0x54, 0x72, 0x75, 0x73, 0x74,
+	0x65, 0x64, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c,
+	0x12, 0x32, 0x0a, 0x14, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x56, 0x70, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x56, 0x70, 0x6e, 0x12, 0x36, 0x0a, 0x16, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x44, 0x69, 0x73,
+	0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x22, 0x73, 0x0a, 0x11,
+	0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x22, 0xa3, 0x01, 0x0a, 0x09, 0x41, 0x77, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x0e, 0x0a, 0x02, 0x4a, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x4a, 0x63, 0x12,
+	0x12, 0x0a, 0x04, 0x4a, 0x6d, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x4a,
+	0x6d, 0x69, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4a, 0x6d, 0x61, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x4a, 0x6d, 0x61, 0x78, 0x12, 0x0e, 0x0a, 0x02, 0x53, 0x31, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x53, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x53, 0x32, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x53, 0x32, 0x12, 0x0e, 0x0a, 0x02, 0x48, 0x31, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x48, 0x31, 0x12, 0x0e, 0x0a, 0x02, 0x48, 0x32, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x48, 0x32, 0x12, 0x0e, 0x0a, 0x02, 0x48, 0x33, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x48, 0x33, 0x12, 0x0e, 0x0a, 0x02, 0x48, 0x34, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x48, 0x34, 0x22, 0x78, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x53, 0x68,
+	0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x26,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f,
+	0x63, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x22, 0x69, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x41, 0x6d, 0x6e, 0x65, 0x7a, 0x69, 0x61, 0x57,
+	0x47, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x77, 0x67, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x86, 0x01, 0x0a,
+	0x12, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x48, 0x0a, 0x09, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x72, 0x65, 0x66, 0x73, 0x22, 0x65, 0x0a, 0x1a, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x4c,
+	0x69, 0x6e, 0x75, 0x78, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x72, 0x65, 0x66, 0x73, 0x52, 0x05, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x22, 0x51, 0x0a, 0x21,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x4c, 0x69, 0x6e, 0x75, 0x78,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66,
+	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x49, 0x73, 0x44, 0x6e, 0x73, 0x4d, 0x67, 0x6d, 0x74, 0x4f, 0x6c,
+	0x64, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x49, 0x73,
+	0x44, 0x6e, 0x73, 0x4d, 0x67, 0x6d, 0x74, 0x4f, 0x6c, 0x64, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x22,
+	0x3e, 0x0a, 0x14, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22,
+	0x72, 0x0a, 0x14, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x22, 0x8d, 0x01, 0x0a, 0x1a, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x47, 0x0a, 0x0c, 0x44, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x75, 0x6e, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x4d, 0x0a, 0x13, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x75, 0x6e, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x18, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e,
+	0x65, 0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x41, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x74, 0x75, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x0a,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x22, 0x45, 0x0a, 0x11, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x74, 0x75, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x22, 0x71, 0x0a, 0x13, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x32, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x75, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x55,
+	0x73, 0x65, 0x72, 0x73, 0x22, 0x40, 0x0a, 0x0c, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x75, 0x6e,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x49, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x49,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x22, 0x4f, 0x0a, 0x11, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x12, 0x26, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x45, 0x78, 0x65, 0x63, 0x22, 0x64, 0x0a, 0x14, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x12,
+	0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x45, 0x78, 0x65,
+	0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x45, 0x78, 0x65, 0x63, 0x22, 0x8b, 0x01,
+	0x0a, 0x17, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x64,
+	0x65, 0x64, 0x50, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x50, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x45, 0x78, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x6d, 0x64, 0x54, 0x6f,
+	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43,
+	0x6d, 0x64, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x22, 0x3d, 0x0a, 0x13, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x41, 0x0a, 0x17, 0x50, 0x6f,
+	0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3f, 0x0a,
+	0x15, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3f,
+	0x0a, 0x15, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22,
+	0x3c, 0x0a, 0x12, 0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x63, 0x0a,
+	0x17, 0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x22, 0x3d, 0x0a, 0x13, 0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x52, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x22, 0xbe, 0x01, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61,
+	0x74, 0x65, 0x44, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x51, 0x0a,
+	0x0b, 0x41, 0x6e, 0x74, 0x69, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x41, 0x6e, 0x74, 0x69, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x61, 0x64,
+	0x61, 0x74, 0x61, 0x52, 0x0b, 0x41, 0x6e, 0x74, 0x69, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x12, 0x30, 0x0a, 0x03, 0x44, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x6e, 0x73, 0x44, 0x6e, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x03, 0x44,
+	0x6e, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x6e, 0x73, 0x50, 0x72, 0x65, 0x64,
+	0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x39, 0x0a, 0x0f, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x22, 0x3a, 0x0a, 0x10, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x84, 0x02, 0x0a,
+	0x0a, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x12, 0x26, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x12, 0x2e, 0x0a, 0x12, 0x4b, 0x65, 0x65, 0x70, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x4b,
+	0x65, 0x65, 0x70, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x46, 0x41, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x32, 0x46, 0x41, 0x22, 0xdd, 0x01, 0x0a, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x30, 0x0a,
+	0x13, 0x4e, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x4e, 0x65, 0x65, 0x64,
+	0x54, 0x6f, 0x52, 0x65, 0x73, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x34, 0x0a, 0x15, 0x4e, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65,
+	0x46, 0x69, 0x72, 0x65, 0x77, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x4e, 0x65, 0x65, 0x64, 0x54, 0x6f, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x69, 0x72,
+	0x65, 0x77, 0x61, 0x6c, 0x6c, 0x12, 0x3c, 0x0a, 0x19, 0x49, 0x73, 0x43, 0x61, 0x6e, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x6c, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x49, 0x73, 0x43, 0x61, 0x6e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x6c, 0x79, 0x22, 0x7d, 0x0a, 0x0b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x46, 0x41, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32,
+	0x46, 0x41, 0x22, 0x9a, 0x01, 0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
+	0x6f, 0x75, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x32, 0x46, 0x41, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x32, 0x46, 0x41, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x49, 0x44, 0x22,
+	0x35, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x47, 0x65, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x55, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x22, 0x55, 0x0a,
+	0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x26,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x49, 0x44, 0x22, 0x37, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x78, 0x0a,
+	0x18, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x12, 0x34, 0x0a, 0x15, 0x4f, 0x6e, 0x6c, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
+	0x66, 0x4e, 0x65, 0x63, 0x65, 0x73, 0x73, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x15, 0x4f, 0x6e, 0x6c, 0x79, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x66, 0x4e, 0x65,
+	0x63, 0x65, 0x73, 0x73, 0x61, 0x72, 0x79, 0x22, 0x66, 0x0a, 0x20, 0x57, 0x69, 0x72, 0x65, 0x47,
+	0x75, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
+	0x5a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x26, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x41, 0x70,
+	0x70, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x22, 0x60, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x70, 0x70, 0x73, 0x12,
+	0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x41, 0x72, 0x67, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x41, 0x72, 0x67, 0x73, 0x4a, 0x53, 0x4f, 0x4e, 0x22, 0x3c, 0x0a,
+	0x12, 0x57, 0x69, 0x46, 0x69, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x75, 0x0a, 0x0c, 0x57,
+	0x69, 0x46, 0x69, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x12, 0x3d, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x57, 0x69, 0x46, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x22, 0x7d, 0x0a, 0x10, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x41,
+	0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x22, 0x78, 0x0a, 0x19, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x05,
+	0x72, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x17,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x53, 0x63, 0x68, 0x65, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x75, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x03, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x11, 0x69, 0x6e, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x4d, 0x69,
+	0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x34, 0x0a, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3c, 0x0a, 0x12, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x47, 0x65,
+	0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x7f, 0x0a, 0x0f, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x26, 0x0a, 0x0e,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x12, 0x44, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x77, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x44, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0x3e, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x22, 0x51, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x47,
+	0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61,
+	0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4d, 0x61,
+	0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x68, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x22, 0x37, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x6f,
+	0x70, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x56, 0x0a, 0x10, 0x53, 0x68, 0x65,
+	0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x47, 0x65, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x57, 0x0a, 0x0f, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x73, 0x47, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x22, 0x3b, 0x0a, 0x11, 0x43, 0x72,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12,
+	0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75,
+	0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x4c, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x71, 0x0a, 0x0e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x37, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x74, 0x65, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x0f, 0x53, 0x70, 0x65, 0x65, 0x64,
+	0x74, 0x65, 0x73, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x6f,
+	0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x1c, 0x0a, 0x09,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x20, 0x0a, 0x0b, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0b, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x12, 0x22, 0x0a, 0x0c,
+	0x49, 0x73, 0x53, 0x6b, 0x69, 0x70, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x53, 0x6b, 0x69, 0x70, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x22, 0x5b, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e,
+	0x63, 0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x22, 0x37, 0x0a,
+	0x0d, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x65, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x38, 0x0a, 0x0e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x22, 0x40, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x22, 0x58, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x26,
+	0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x87, 0x01, 0x0a,
+	0x19, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x61, 0x79, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x44, 0x61, 0x79,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x45, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x5a, 0x0a,
+	0x14, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x47, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x4d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x4d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x61, 0x0a, 0x1b, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x4d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x4d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3f, 0x0a, 0x15,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x47, 0x65, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x87, 0x01,
+	0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x53, 0x61, 0x76, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
+	0x46, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x55, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65,
+	0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x56,
+	0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x51, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x49, 0x73, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x49, 0x73, 0x4c, 0x6f, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x64, 0x22, 0x89, 0x24, 0x0a, 0x08, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x4e, 0x0a, 0x10, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x48, 0x00, 0x52,
+	0x10, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73, 0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65,
+	0x64, 0x12, 0x39, 0x0a, 0x09, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x48,
+	0x00, 0x52, 0x09, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x12,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x45, 0x78, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x12,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x45, 0x78, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x42, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x09, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x65, 0x6c, 0x6c, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x09, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x48, 0x0a, 0x0e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x12, 0x42, 0x0a, 0x0c, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48,
+	0x00, 0x52, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x3f, 0x0a, 0x0b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x48, 0x00, 0x52, 0x0b, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x51, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00,
+	0x52, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x5a, 0x0a, 0x14, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x24, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x14, 0x4b, 0x69, 0x6c, 0x6c, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x75, 0x0a, 0x1d, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74,
+	0x49, 0x73, 0x50, 0x65, 0x73, 0x74, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74,
+	0x63, 0x68, 0x47, 0x65, 0x74, 0x49, 0x73, 0x50, 0x65, 0x73, 0x74, 0x69, 0x73, 0x74, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x1d, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69,
+	0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x49, 0x73, 0x50, 0x65, 0x73, 0x74, 0x69, 0x73, 0x74, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x66, 0x0a, 0x18, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f,
+	0x73, 0x74, 0x69, 0x63, 0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x48, 0x00, 0x52, 0x18, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63,
+	0x73, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57,
+	0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x44, 0x4e,
+	0x53, 0x52, 0x65, 0x73, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x70,
+	0x48, 0x00, 0x52, 0x13, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65,
+	0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x70, 0x12, 0x66, 0x0a, 0x18, 0x44, 0x6e, 0x73, 0x50, 0x72,
+	0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6e, 0x73, 0x50, 0x72,
+	0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x18, 0x44, 0x6e, 0x73, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66,
+	0x69, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x45, 0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x48, 0x00, 0x52, 0x10, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x15,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x6c, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x1a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a, 0x15, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75,
+	0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x15, 0x54, 0x68, 0x72,
+	0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x42, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65,
+	0x73, 0x70, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x13, 0x53, 0x75, 0x62, 0x73,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x66, 0x0a, 0x18, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x17, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x18, 0x50,
+	0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x18, 0x18, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x61, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6c,
+	0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x12, 0x44, 0x61, 0x74, 0x61, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x5d, 0x0a,
+	0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x61, 0x6c, 0x69,
+	0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x18, 0x19, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x72, 0x0a, 0x1c,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74,
+	0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x18, 0x1a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x48, 0x00, 0x52, 0x1c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x63, 0x0a, 0x17, 0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61,
+	0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x17, 0x43, 0x61,
+	0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x6f, 0x67, 0x69, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x1c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69,
+	0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x13, 0x52, 0x65, 0x6c, 0x6f, 0x67,
+	0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x45,
+	0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x18,
+	0x1d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65,
+	0x77, 0x52, 0x65, 0x73, 0x70, 0x12, 0x51, 0x0a, 0x11, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x11, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x10, 0x43, 0x72, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x1f, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x10, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x12, 0x43, 0x72, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x18, 0x20,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x12, 0x43, 0x72, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57,
+	0x0a, 0x13, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x48, 0x00, 0x52, 0x13, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x60, 0x0a,
+	0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x23, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x3f, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x41, 0x70, 0x69, 0x52, 0x65, 0x73, 0x70, 0x18, 0x24,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x41, 0x70, 0x69, 0x52, 0x65, 0x73,
+	0x70, 0x48, 0x00, 0x52, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x41, 0x70, 0x69, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x3f, 0x0a, 0x0b, 0x47, 0x72, 0x70, 0x63, 0x41, 0x70, 0x69, 0x52, 0x65, 0x73, 0x70, 0x18,
+	0x25, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x70, 0x69, 0x52, 0x65,
+	0x73, 0x70, 0x48, 0x00, 0x52, 0x0b, 0x47, 0x72, 0x70, 0x63, 0x41, 0x70, 0x69, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x45, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x18, 0x26, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
+	0x76, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4e, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x27, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x18, 0x28,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x12, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x54,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x18, 0x29, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00,
+	0x52, 0x12, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0f, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x6f, 0x73, 0x74, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00,
+	0x52, 0x0f, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x42, 0x0a, 0x0c, 0x56, 0x70, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x18, 0x2b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x70, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0c, 0x56, 0x70, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x57, 0x0a, 0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x2c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x13, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48,
+	0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x18, 0x2e, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x48, 0x00, 0x52, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x2f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e,
+	0x67, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x0f,
+	0x50, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x69, 0x0a, 0x19, 0x57, 0x69, 0x46, 0x69, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65,
+	0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x18, 0x30, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x46, 0x69, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c,
+	0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52,
+	0x19, 0x57, 0x69, 0x46, 0x69, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x65,
+	0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x60, 0x0a, 0x16, 0x57, 0x69,
+	0x46, 0x69, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x18, 0x31, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x46, 0x69,
+	0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x48, 0x00, 0x52, 0x16, 0x57, 0x69, 0x46, 0x69, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3f, 0x0a, 0x0b,
+	0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00,
+	0x52, 0x0b, 0x41, 0x50, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x51, 0x0a,
+	0x11, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x18, 0x33, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c,
+	0x6c, 0x65, 0x64, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x48, 0x00, 0x52, 0x11, 0x49,
+	0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x70, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x3f, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x18,
+	0x34, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x70, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x48, 0x00, 0x52, 0x0b, 0x41, 0x70, 0x70, 0x49, 0x63, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x51, 0x0a, 0x11, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x35, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48,
+	0x00, 0x52, 0x11, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x66, 0x0a, 0x18, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x43, 0x6d, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x18, 0x36, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x43, 0x6d, 0x64, 0x52, 0x65, 0x73, 0x70,
+	0x48, 0x00, 0x52, 0x18, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41,
+	0x64, 0x64, 0x41, 0x70, 0x70, 0x43, 0x6d, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0f,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18,
+	0x37, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6d, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54,
+	0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x54, 0x79, 0x70, 0x65, 0x22, 0x74, 0x0a, 0x10, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x22, 0x0b, 0x0a, 0x09, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x22, 0x14, 0x0a, 0x12, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x45, 0x78, 0x69, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x22, 0xca, 0x0f,
+	0x0a, 0x0c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34,
+	0x0a, 0x15, 0x49, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4f,
+	0x6e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x49,
+	0x73, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4f, 0x6e, 0x4c, 0x61,
+	0x75, 0x6e, 0x63, 0x68, 0x12, 0x40, 0x0a, 0x1b, 0x49, 0x73, 0x41, 0x75, 0x74, 0x6f, 0x63, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4f, 0x6e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68, 0x44, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x49, 0x73, 0x41, 0x75, 0x74,
+	0x6f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4f, 0x6e, 0x4c, 0x61, 0x75, 0x6e, 0x63, 0x68,
+	0x44, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x30, 0x0a, 0x13, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x66, 0x69, 0x6e, 0x65, 0x64, 0x4f, 0x76, 0x70, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64,
+	0x4f, 0x76, 0x70, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x49, 0x0a, 0x0f, 0x4f, 0x62, 0x66, 0x73,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x62, 0x66, 0x73, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0f, 0x4f, 0x62, 0x66, 0x73, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x56, 0x32, 0x52, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x78,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x56, 0x32, 0x52, 0x61, 0x79, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x12, 0x4d, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f, 0x63,
+	0x6b, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x10, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x41, 0x6d, 0x6e, 0x65, 0x7a, 0x69, 0x61, 0x57, 0x47, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x77, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x09, 0x41, 0x6d, 0x6e, 0x65, 0x7a, 0x69, 0x61, 0x57, 0x47, 0x12, 0x48, 0x0a, 0x09, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x55, 0x73, 0x65, 0x72, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72,
+	0x50, 0x72, 0x65, 0x66, 0x73, 0x12, 0x39, 0x0a, 0x04, 0x57, 0x69, 0x46, 0x69, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x57, 0x69, 0x46, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x04, 0x57, 0x69, 0x46, 0x69,
+	0x12, 0x45, 0x0a, 0x08, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x08, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x1b, 0x49, 0x73, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x49, 0x73,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x3c, 0x0a, 0x19, 0x49, 0x73, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x19, 0x49, 0x73,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x0e, 0x44, 0x61, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x12, 0x2a, 0x0a, 0x10, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65,
+	0x4d, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x46, 0x77, 0x4d, 0x73, 0x73, 0x43, 0x6c, 0x61, 0x6d, 0x70, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0a, 0x46, 0x77, 0x4d, 0x73, 0x73, 0x43, 0x6c, 0x61, 0x6d, 0x70, 0x12, 0x30, 0x0a, 0x13,
+	0x49, 0x73, 0x57, 0x47, 0x4b, 0x65, 0x79, 0x48, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x49, 0x73, 0x57, 0x47, 0x4b,
+	0x65, 0x79, 0x48, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34,
+	0x0a, 0x15, 0x49, 0x73, 0x57, 0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f,
+	0x4f, 0x70, 0x65, 0x6e, 0x56, 0x50, 0x4e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x49,
+	0x73, 0x57, 0x67, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x54, 0x6f, 0x4f, 0x70, 0x65,
+	0x6e, 0x56, 0x50, 0x4e, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x73, 0x57, 0x67, 0x4c, 0x61, 0x6e, 0x42,
+	0x79, 0x70, 0x61, 0x73, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x49, 0x73, 0x57,
+	0x67, 0x4c, 0x61, 0x6e, 0x42, 0x79, 0x70, 0x61, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x73,
+	0x57, 0x67, 0x50, 0x65, 0x65, 0x72, 0x46, 0x61, 0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x13,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x49, 0x73, 0x57, 0x67, 0x50, 0x65, 0x65, 0x72, 0x46, 0x61,
+	0x69, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x57, 0x67, 0x50, 0x6f, 0x72, 0x74,
+	0x48, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x57, 0x67, 0x50, 0x6f, 0x72, 0x74, 0x48, 0x6f, 0x70, 0x70,
+	0x69, 0x6e, 0x67, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x49, 0x73,
+	0x57, 0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x49, 0x73, 0x57, 0x67, 0x51,
+	0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x73, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x26, 0x0a, 0x0e, 0x57, 0x67, 0x4b, 0x65, 0x6d, 0x41, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74,
+	0x68, 0x6d, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x57, 0x67, 0x4b, 0x65, 0x6d, 0x41,
+	0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d, 0x12, 0x32, 0x0a, 0x14, 0x49, 0x73, 0x41, 0x70,
+	0x69, 0x54, 0x69, 0x6d, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x49, 0x73, 0x41, 0x70, 0x69, 0x54, 0x69, 0x6d,
+	0x65, 0x48, 0x69, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x32, 0x0a, 0x14,
+	0x49, 0x73, 0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x49, 0x73, 0x43, 0x61,
+	0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x12, 0x2e, 0x0a, 0x12, 0x49, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74,
+	0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x49, 0x73,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x6e, 0x65, 0x77,
+	0x12, 0x32, 0x0a, 0x14, 0x49, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x49, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x08, 0x41, 0x70, 0x69, 0x50, 0x72, 0x6f, 0x78, 0x79,
+	0x18, 0x1b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x08, 0x41, 0x70, 0x69,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x51, 0x0a, 0x0f, 0x41, 0x70, 0x69, 0x48, 0x6f, 0x73, 0x74,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x41, 0x50, 0x49, 0x48, 0x6f, 0x73, 0x74, 0x4f,
+	0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x52, 0x0f, 0x41, 0x70, 0x69, 0x48, 0x6f, 0x73, 0x74,
+	0x4f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x16, 0x4f, 0x70, 0x65, 0x6e,
+	0x56, 0x70, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70,
+	0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x32, 0x0a, 0x14, 0x49, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x44, 0x63, 0x6f,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14,
+	0x49, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x44, 0x63, 0x6f, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x58, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x43,
+	0x72, 0x79, 0x70, 0x74, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x1f, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x56, 0x70, 0x6e, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x43, 0x72, 0x79,
+	0x70, 0x74, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x13, 0x4f, 0x70, 0x65, 0x6e, 0x56,
+	0x70, 0x6e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x28,
+	0x0a, 0x0f, 0x49, 0x73, 0x4c, 0x6f, 0x67, 0x4a, 0x53, 0x4f, 0x4e, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x49, 0x73, 0x4c, 0x6f, 0x67, 0x4a, 0x53,
+	0x4f, 0x4e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x46, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x21, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c,
+	0x6f, 0x67, 0x67, 0x65, 0x72, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0b, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x22, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x4c, 0x6f, 0x67, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x2a,
+	0x0a, 0x10, 0x49, 0x73, 0x4c, 0x6f, 0x67, 0x50, 0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x4d, 0x6f,
+	0x64, 0x65, 0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x49, 0x73, 0x4c, 0x6f, 0x67, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x63, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x22, 0x79, 0x0a, 0x1f, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2e, 0x0a,
+	0x12, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x4d, 0x42, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4d, 0x42, 0x12, 0x26, 0x0a,
+	0x0e, 0x64, 0x61, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x4d, 0x42, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x64, 0x61, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x4d, 0x42, 0x22, 0xe3, 0x04, 0x0a, 0x09, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a,
+	0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x6f, 0x72, 0x41, 0x72, 0x63, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x6f, 0x72, 0x41, 0x72, 0x63, 0x68, 0x12, 0x35, 0x0a,
+	0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x52, 0x07, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x11, 0x44, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2e, 0x0a,
+	0x03, 0x44, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x6e, 0x73, 0x41,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x52, 0x03, 0x44, 0x6e, 0x73, 0x12, 0x30, 0x0a,
+	0x13, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x55, 0x55, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x55, 0x55, 0x49, 0x44, 0x12,
+	0x46, 0x0a, 0x0c, 0x50, 0x61, 0x72, 0x61, 0x6e, 0x6f, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6e, 0x6f, 0x69, 0x64, 0x4d,
+	0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0c, 0x50, 0x61, 0x72, 0x61, 0x6e,
+	0x6f, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x44, 0x0a, 0x0e, 0x44, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x52, 0x0e, 0x44,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x0a,
+	0x11, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x0b,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x57, 0x67, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x57, 0x67, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x57, 0x67, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x49, 0x50, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x57, 0x67, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x49, 0x50, 0x12, 0x26, 0x0a, 0x0e, 0x57, 0x67, 0x4b, 0x65, 0x79, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x57, 0x67, 0x4b,
+	0x65, 0x79, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x57,
+	0x67, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x65, 0x67, 0x65, 0x6e, 0x49, 0x6e, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x57, 0x67, 0x4b, 0x65, 0x79, 0x73, 0x52,
+	0x65, 0x67, 0x65, 0x6e, 0x49, 0x6e, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0xac, 0x03, 0x0a, 0x18,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x6c, 0x61,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73,
+	0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x49, 0x73, 0x52, 0x65, 0x6e, 0x65, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x26, 0x0a, 0x0e,
+	0x57, 0x69, 0x6c, 0x6c, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65, 0x62, 0x69, 0x6c, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x57, 0x69, 0x6c, 0x6c, 0x41, 0x75, 0x74, 0x6f, 0x52, 0x65,
+	0x62, 0x69, 0x6c, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x46, 0x72, 0x65, 0x65, 0x54, 0x72,
+	0x69, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x46, 0x72, 0x65,
+	0x65, 0x54, 0x72, 0x69, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x61, 0x70, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x6f, 0x50, 0x6c, 0x61, 0x6e,
+	0x12, 0x22, 0x0a, 0x0c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x6f, 0x55, 0x52, 0x4c,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54,
+	0x6f, 0x55, 0x52, 0x4c, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xed, 0x03, 0x0a, 0x15, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0e, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x57, 0x69,
+	0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e,
+	0x41, 0x6d, 0x6e, 0x65, 0x7a, 0x69, 0x61, 0x57, 0x47, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x41, 0x6d, 0x6e, 0x65, 0x7a, 0x69, 0x61, 0x57, 0x47, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x50, 0x4e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x4f, 0x70, 0x65, 0x6e,
+	0x56, 0x50, 0x4e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x4f, 0x62, 0x66, 0x73,
+	0x70, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x4f, 0x62, 0x66, 0x73, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x56, 0x32, 0x52, 0x61, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x56, 0x32, 0x52, 0x61, 0x79, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x2a, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x53, 0x68, 0x61, 0x64,
+	0x6f, 0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x2a, 0x0a, 0x10,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x16, 0x57, 0x47, 0x4b, 0x65,
+	0x79, 0x48, 0x77, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x57, 0x47, 0x4b, 0x65, 0x79, 0x48,
+	0x77, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x3a, 0x0a, 0x18, 0x57, 0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x18, 0x57, 0x67, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x75, 0x6d, 0x52, 0x65, 0x73,
+	0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x4c, 0x0a, 0x08,
+	0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x52, 0x08, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x64, 0x0a, 0x20, 0x44, 0x69,
+	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x46, 0x6f, 0x72, 0x50, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x40,
+	0x0a, 0x05, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44,
+	0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61,
+	0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x52, 0x05, 0x4c, 0x69, 0x6e, 0x75, 0x78,
+	0x22, 0x98, 0x01, 0x0a, 0x1a, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x46, 0x75, 0x6e,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4c, 0x69, 0x6e, 0x75, 0x78, 0x12,
+	0x3c, 0x0a, 0x19, 0x44, 0x6e, 0x73, 0x4d, 0x67, 0x6d, 0x74, 0x4f, 0x6c, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x63, 0x6f, 0x6e, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x19, 0x44, 0x6e, 0x73, 0x4d, 0x67, 0x6d, 0x74, 0x4f, 0x6c, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x6c, 0x76, 0x63, 0x6f, 0x6e, 0x66, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x3c, 0x0a,
+	0x19, 0x44, 0x6e, 0x73, 0x4d, 0x67, 0x6d, 0x74, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x63, 0x74, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x19, 0x44, 0x6e, 0x73, 0x4d, 0x67, 0x6d, 0x74, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x6f,
+	0x6c, 0x76, 0x65, 0x63, 0x74, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x6a, 0x0a, 0x0c, 0x44,
+	0x6e, 0x73, 0x41, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x43,
+	0x61, 0x6e, 0x55, 0x73, 0x65, 0x44, 0x6e, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x43, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x44, 0x6e, 0x73,
+	0x4f, 0x76, 0x65, 0x72, 0x54, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x43, 0x61, 0x6e, 0x55, 0x73,
+	0x65, 0x44, 0x6e, 0x73, 0x4f, 0x76, 0x65, 0x72, 0x48, 0x74, 0x74, 0x70, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x12, 0x43, 0x61, 0x6e, 0x55, 0x73, 0x65, 0x44, 0x6e, 0x73, 0x4f, 0x76,
+	0x65, 0x72, 0x48, 0x74, 0x74, 0x70, 0x73, 0x22, 0x32, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x61, 0x6e,
+	0x6f, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x88, 0x03, 0x0a, 0x0e,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c,
+	0x0a, 0x09, 0x41, 0x50, 0x49, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x41, 0x50, 0x49, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f,
+	0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x35, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x52, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a,
+	0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x52, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x52, 0x61, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x73, 0x32, 0x46, 0x41, 0x52, 0x65, 0x71, 0x75,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x49, 0x73, 0x32, 0x46,
+	0x41, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x49, 0x73, 0x43,
+	0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x49, 0x73, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x43, 0x61, 0x70, 0x74, 0x63,
+	0x68, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x43,
+	0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x22, 0x5b, 0x0a, 0x13, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x43, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x5f, 0x69, 0x64, 0x12, 0x24, 0x0a,
+	0x0d, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x61, 0x70, 0x74, 0x63, 0x68, 0x61, 0x5f, 0x69, 0x6d,
+	0x61, 0x67, 0x65, 0x22, 0x47, 0x0a, 0x0c, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x37, 0x0a, 0x08, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x08, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x22, 0x8b, 0x01, 0x0a,
+	0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x42, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x0b, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x50,
+	0x49, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x41,
+	0x50, 0x49, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x50, 0x49, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x22, 0xc0, 0x01, 0x0a, 0x12, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61,
+	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73,
+	0x65, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x5f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x22, 0xc3, 0x01, 0x0a, 0x11, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x50, 0x49,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x41, 0x50,
+	0x49, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x50, 0x49, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x42, 0x0a, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x07, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbd, 0x03, 0x0a, 0x14, 0x4b, 0x69,
+	0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c,
+	0x41, 0x4e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f,
+	0x77, 0x4c, 0x41, 0x4e, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4d,
+	0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74,
+	0x12, 0x2c, 0x0a, 0x11, 0x49, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x70, 0x69, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x49, 0x73, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x63, 0x65,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x4a, 0x0a, 0x10, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c,
+	0x41, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4c, 0x61, 0x6e, 0x73, 0x76, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x10, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4c, 0x41, 0x4e,
+	0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x4c, 0x41, 0x4e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x4b, 0x0a, 0x0f,
+	0x4c, 0x41, 0x4e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x61, 0x6e, 0x73, 0x76, 0x63, 0x49, 0x6e, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x0f, 0x4c, 0x41, 0x4e, 0x49, 0x6e, 0x62,
+	0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x43, 0x0a, 0x1d, 0x4b, 0x69, 0x6c,
+	0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x49, 0x73, 0x50, 0x65, 0x73, 0x74,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x73,
+	0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0c, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x76,
+	0x0a, 0x18, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x4c, 0x6f,
+	0x67, 0x30, 0x5f, 0x4f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4c, 0x6f,
+	0x67, 0x30, 0x5f, 0x4f, 0x6c, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x31, 0x5f, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x4c, 0x6f, 0x67,
+	0x31, 0x5f, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x97, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x41, 0x6c,
+	0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65, 0x44, 0x4e, 0x53, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c,
+	0x0a, 0x09, 0x49, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x49, 0x73, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x3e, 0x0a, 0x0a,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x6e, 0x73, 0x44, 0x6e, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x0a, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x44, 0x4e, 0x53, 0x12, 0x22, 0x0a, 0x0c,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x5a, 0x0a, 0x18, 0x44, 0x6e, 0x73, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65,
+	0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x0a,
+	0x44, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x44, 0x6e, 0x73, 0x44, 0x6e, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x0a, 0x44, 0x6e, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x22, 0x81, 0x04, 0x0a,
+	0x0d, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x70, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x56, 0x70, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x54, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x63, 0x46, 0x72, 0x6f, 0x6d, 0x31, 0x39, 0x37, 0x30, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x46, 0x72, 0x6f, 0x6d, 0x31, 0x39,
+	0x37, 0x30, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x12, 0x1e,
+	0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x76, 0x36, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x50, 0x76, 0x36, 0x12, 0x1a,
+	0x0a, 0x08, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x50, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x50, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x78,
+	0x69, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x45, 0x78, 0x69, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c,
+	0x0a, 0x09, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x44, 0x4e, 0x53, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x44, 0x6e, 0x73, 0x44, 0x6e, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x09, 0x4d, 0x61, 0x6e, 0x75, 0x61, 0x6c, 0x44, 0x4e, 0x53, 0x12, 0x1e, 0x0a, 0x0a,
+	0x49, 0x73, 0x43, 0x61, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x49, 0x73, 0x43, 0x61, 0x6e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x49, 0x73, 0x54, 0x43, 0x50, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x49, 0x73, 0x54,
+	0x43, 0x50, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x74, 0x75, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x03, 0x4d, 0x74, 0x75, 0x12, 0x1e, 0x0a, 0x0a, 0x56, 0x32, 0x52, 0x61, 0x79, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x56, 0x32, 0x52, 0x61, 0x79, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x12, 0x24, 0x0a, 0x0d, 0x49, 0x73, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77,
+	0x73, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x49, 0x73, 0x53,
+	0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x73,
+	0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0e, 0x49, 0x73, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73,
+	0x22, 0x72, 0x0a, 0x10, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x44, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7f, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1e, 0x0a,
+	0x0a, 0x49, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x49, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x46, 0x0a,
+	0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x7c, 0x0a, 0x20, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x44, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x22, 0xb8, 0x01, 0x0a, 0x1a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x42, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x53,
+	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x04, 0x44, 0x61, 0x79, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x44,
+	0x61, 0x79, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x04, 0x44, 0x61, 0x79, 0x73, 0x22, 0x8c,
+	0x02, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x45, 0x78, 0x69, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x70, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x56, 0x70, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07,
+	0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x78, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54, 0x78, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x22, 0x94, 0x01,
+	0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x44, 0x61, 0x79, 0x52, 0x65,
+	0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x65, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x18, 0x0a, 0x07, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54, 0x78, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x22, 0x7e, 0x0a, 0x15, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70,
+	0x75, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x20, 0x0a,
+	0x0b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x12,
+	0x43, 0x0a, 0x07, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x54, 0x68, 0x72, 0x6f, 0x75,
+	0x67, 0x68, 0x70, 0x75, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07, 0x53, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x73, 0x22, 0x5b, 0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x74, 0x61, 0x74,
+	0x73, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x53, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x52, 0x78, 0x42, 0x70, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x52, 0x78, 0x42, 0x70, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x54,
+	0x78, 0x42, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x54, 0x78, 0x42, 0x70,
+	0x73, 0x22, 0x45, 0x0a, 0x0c, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x35, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x52, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x6c, 0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x33,
+	0x0a, 0x05, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x05, 0x41, 0x63,
+	0x74, 0x6f, 0x72, 0x22, 0x47, 0x0a, 0x0d, 0x41, 0x75, 0x64, 0x69, 0x74, 0x6c, 0x6f, 0x67, 0x41,
+	0x63, 0x74, 0x6f, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x55, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x55, 0x69, 0x64, 0x22, 0x57, 0x0a, 0x13,
+	0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0a, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a, 0x53, 0x75, 0x62, 0x73, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xc8, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x43, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x08, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x49, 0x6e, 0x69, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x4d, 0x73,
+	0x22, 0x55, 0x0a, 0x18, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72,
+	0x74, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x79, 0x0a, 0x13, 0x50, 0x6f, 0x72, 0x74, 0x66,
+	0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x08, 0x49, 0x73, 0x41, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x53, 0x0a, 0x12, 0x44, 0x61, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x41,
+	0x6c, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3d, 0x0a, 0x05, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x74, 0x61,
+	0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x52, 0x05, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x22, 0x77, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x6e, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65,
+	0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x55, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0x57, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x06, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x73,
+	0x74, 0x61, 0x74, 0x73, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x06, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0xb4, 0x02, 0x0a, 0x16, 0x43, 0x6f,
+	0x6e, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x1e,
+	0x0a, 0x0a, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x4c, 0x6f, 0x73, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0a, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x73, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x20,
+	0x0a, 0x0b, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0b, 0x4c, 0x6f, 0x73, 0x73, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x52, 0x74, 0x74, 0x41, 0x76, 0x67, 0x4d, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x52, 0x74, 0x74, 0x41, 0x76, 0x67, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x52, 0x74, 0x74, 0x4d, 0x69, 0x6e, 0x4d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x52, 0x74, 0x74, 0x4d, 0x69, 0x6e, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x74, 0x74, 0x4d,
+	0x61, 0x78, 0x4d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x52, 0x74, 0x74, 0x4d,
+	0x61, 0x78, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x73,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x4a, 0x69, 0x74, 0x74, 0x65, 0x72, 0x4d, 0x73,
+	0x22, 0x60, 0x0a, 0x1c, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x40, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x73, 0x74, 0x61, 0x74, 0x73, 0x51, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x22, 0x56, 0x0a, 0x17, 0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72,
+	0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3b, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xb5, 0x01, 0x0a, 0x13, 0x43,
+	0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x49, 0x73, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x49, 0x73, 0x44, 0x65, 0x74, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x55, 0x52, 0x4c, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x55, 0x52, 0x4c,
+	0x12, 0x1c, 0x0a, 0x09, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x2c, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x11, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x22, 0x5d, 0x0a, 0x13, 0x52, 0x65, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x50, 0x49,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x41, 0x50,
+	0x49, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x41, 0x50, 0x49, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x41, 0x50, 0x49, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x5b, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x52, 0x65,
+	0x73, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x53, 0x65, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x53, 0x65, 0x63,
+	0x12, 0x2c, 0x0a, 0x11, 0x49, 0x73, 0x54, 0x69, 0x6d, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x49, 0x73, 0x54,
+	0x69, 0x6d, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x22, 0x53,
+	0x0a, 0x11, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x12, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x41, 0x72, 0x67, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x41, 0x72, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x45, 0x78,
+	0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x45, 0x78,
+	0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x4f, 0x0a, 0x10,
+	0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x3b, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x72, 0x61, 0x73, 0x68, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x07, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x22, 0x85, 0x01,
+	0x0a, 0x11, 0x43, 0x72, 0x61, 0x73, 0x68, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x50, 0x61, 0x6e, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x50, 0x61, 0x6e, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a, 0x12, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x39, 0x0a, 0x06, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x61,
+	0x73, 0x68, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x06,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x55, 0x0a, 0x13, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a,
+	0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x09, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc2, 0x01,
+	0x0a, 0x10, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x56, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0a, 0x4f, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x0a,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x62, 0x0a, 0x16, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x48, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0x4f,
+	0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x41, 0x70, 0x69, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x74, 0x41, 0x70,
+	0x69, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22,
+	0x8e, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x74, 0x41, 0x70, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x69, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x22, 0x4f, 0x0a, 0x0b, 0x47, 0x72, 0x70, 0x63, 0x41, 0x70, 0x69, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x40, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x47, 0x72, 0x70, 0x63,
+	0x41, 0x70, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x22, 0x62, 0x0a, 0x18, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x47, 0x72, 0x70, 0x63, 0x41, 0x70, 0x69, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0xd9, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x44, 0x0a, 0x07, 0x4d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x2e, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x4b, 0x6e, 0x6f, 0x77, 0x6e, 0x4d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x52, 0x0a, 0x10, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x3e, 0x0a, 0x06, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x73, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x06, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x58, 0x0a, 0x16, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x63, 0x6f,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x63, 0x6f, 0x70, 0x65, 0x22,
+	0x5d, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x47, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x30,
+	0x0a, 0x12, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73,
+	0x22, 0x4d, 0x0a, 0x0f, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x12, 0x3a, 0x0a, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x22,
+	0xd4, 0x01, 0x0a, 0x14, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x48,
+	0x6f, 0x73, 0x74, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x48, 0x6f, 0x73, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x53, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x53, 0x63, 0x6f,
+	0x72, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x12, 0x28, 0x0a, 0x0f, 0x4c, 0x61, 0x73, 0x74, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54,
+	0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x4c, 0x61, 0x73, 0x74, 0x46,
+	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x42, 0x6c,
+	0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x6c, 0x69, 0x73, 0x74, 0x65,
+	0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x72, 0x0a, 0x0c, 0x56, 0x70, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x41, 0x64, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x49, 0x0a, 0x13, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x52, 0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x54,
+	0x78, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x54, 0x78,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x72, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x65, 0x76,
+	0x65, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12,
+	0x18, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a, 0x0e, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x12, 0x4b, 0x0a, 0x0a, 0x56,
+	0x70, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x0a, 0x56, 0x70,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x22, 0xe9, 0x01, 0x0a, 0x1b, 0x41, 0x70, 0x69,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a, 0x09, 0x77, 0x69, 0x72, 0x65,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x77, 0x69, 0x72, 0x65, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x12, 0x43, 0x0a, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x70,
+	0x65, 0x6e, 0x76, 0x70, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x07, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x12, 0x3a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x22, 0x9c, 0x02, 0x0a, 0x1b, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x22,
+	0x0a, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x73,
+	0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x73, 0x70, 0x12, 0x45, 0x0a, 0x05,
+	0x68, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x68, 0x6f,
+	0x73, 0x74, 0x73, 0x22, 0x98, 0x02, 0x0a, 0x19, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73,
+	0x4f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x73, 0x70, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x69, 0x73, 0x70, 0x12, 0x43, 0x0a, 0x05, 0x68, 0x6f, 0x73,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x50, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x48,
+	0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x84,
+	0x02, 0x0a, 0x12, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x49, 0x0a, 0x0b, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x41, 0x6e, 0x74, 0x69, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72,
+	0x12, 0x31, 0x0a, 0x03, 0x61, 0x70, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x41, 0x50, 0x49, 0x52, 0x03,
+	0x61, 0x70, 0x69, 0x12, 0x37, 0x0a, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x74,
+	0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x05,
+	0x76, 0x32, 0x72, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x56, 0x32, 0x52, 0x61, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x76, 0x32, 0x72, 0x61, 0x79, 0x22, 0x91, 0x01, 0x0a, 0x17, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x41, 0x6e, 0x74, 0x69, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x65, 0x72, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x39, 0x0a, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x44, 0x4e, 0x53, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x07, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x12, 0x3b, 0x0a, 0x08,
+	0x68, 0x61, 0x72, 0x64, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x44, 0x4e, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x08, 0x68, 0x61, 0x72, 0x64, 0x63, 0x6f, 0x72, 0x65, 0x22, 0x21, 0x0a, 0x0f, 0x41, 0x70, 0x69,
+	0x54, 0x79, 0x70, 0x65, 0x73, 0x44, 0x4e, 0x53, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x22, 0x39, 0x0a, 0x0f,
+	0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x41, 0x50, 0x49, 0x12,
+	0x10, 0x0a, 0x03, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x70,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x70, 0x76, 0x36, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x69, 0x70, 0x76, 0x36, 0x73, 0x22, 0xfb, 0x02, 0x0a, 0x11, 0x41, 0x70, 0x69, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x3a, 0x0a,
+	0x07, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x07, 0x6f, 0x70, 0x65, 0x6e, 0x76, 0x70, 0x6e, 0x12, 0x3e, 0x0a, 0x09, 0x77, 0x69, 0x72,
+	0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70,
+	0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09,
+	0x77, 0x69, 0x72, 0x65, 0x67, 0x75, 0x61, 0x72, 0x64, 0x12, 0x3a, 0x0a, 0x05, 0x6f, 0x62, 0x66,
+	0x73, 0x33, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x4f, 0x62, 0x66, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05,
+	0x6f, 0x62, 0x66, 0x73, 0x33, 0x12, 0x3a, 0x0a, 0x05, 0x6f, 0x62, 0x66, 0x73, 0x34, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f, 0x62,
+	0x66, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x6f, 0x62, 0x66, 0x73,
+	0x34, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x32, 0x72, 0x61, 0x79, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x20, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x76, 0x32, 0x72, 0x61, 0x79, 0x12, 0x3a, 0x0a, 0x07, 0x75, 0x64, 0x70,
+	0x32, 0x74, 0x63, 0x70, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x70, 0x69, 0x54,
+	0x79, 0x70, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x75, 0x64,
+	0x70, 0x32, 0x74, 0x63, 0x70, 0x22, 0x73, 0x0a, 0x10, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65,
+	0x73, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x37, 0x0a, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x05, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x22, 0x37, 0x0a, 0x11, 0x41, 0x70,
+	0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6d, 0x69,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x6d, 0x61, 0x78, 0x22, 0x2a, 0x0a, 0x14, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x4f,
+	0x62, 0x66, 0x73, 0x50, 0x6f, 0x72, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x22,
+	0x23, 0x0a, 0x11, 0x41, 0x70, 0x69, 0x54, 0x79, 0x70, 0x65, 0x73, 0x56, 0x32, 0x52, 0x61, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x53, 0x0a, 0x0f, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x40, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x50, 0x69,
+	0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x38, 0x0a, 0x0e, 0x50, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x48,
+	0x6f, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x48, 0x6f, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50,
+	0x69, 0x6e, 0x67, 0x22, 0x58, 0x0a, 0x19, 0x57, 0x69, 0x46, 0x69, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x12, 0x3b, 0x0a, 0x08, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x46, 0x69, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x08, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x22, 0x25, 0x0a,
+	0x0f, 0x57, 0x69, 0x46, 0x69, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x12, 0x0a, 0x04, 0x53, 0x53, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x53, 0x53, 0x49, 0x44, 0x22, 0x70, 0x0a, 0x16, 0x57, 0x69, 0x46, 0x69, 0x43, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12,
+	0x0a, 0x04, 0x53, 0x53, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x53, 0x53,
+	0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x42, 0x53, 0x53, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x42, 0x53, 0x53, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x11, 0x49, 0x73, 0x49, 0x6e,
+	0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x11, 0x49, 0x73, 0x49, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x22, 0x97, 0x01, 0x0a, 0x0b, 0x41, 0x50, 0x49, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x50, 0x49, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x50, 0x49, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x49, 0x73,
+	0x53, 0x74, 0x61, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x49, 0x73, 0x53,
+	0x74, 0x61, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x49, 0x0a, 0x11, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x70, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x04, 0x41, 0x70, 0x70, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x73, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x41, 0x70,
+	0x70, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x41, 0x70, 0x70, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x10,
+	0x4f, 0x73, 0x68, 0x65, 0x6c, 0x70, 0x65, 0x72, 0x73, 0x41, 0x70, 0x70, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x18, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x41, 0x70, 0x70, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x70,
+	0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x70,
+	0x70, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x41, 0x70, 0x70, 0x49, 0x63, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x70, 0x70, 0x49, 0x63, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x74,
+	0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x41, 0x70, 0x70, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x22, 0x4d, 0x0a, 0x0b, 0x41, 0x70, 0x70, 0x49, 0x63, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x70, 0x70, 0x42, 0x69, 0x6e, 0x61,
+	0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x41, 0x70,
+	0x70, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x41,
+	0x70, 0x70, 0x49, 0x63, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x41, 0x70,
+	0x70, 0x49, 0x63, 0x6f, 0x6e, 0x22, 0xf5, 0x04, 0x0a, 0x11, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x49,
+	0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x49, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x1b, 0x49, 0x73, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4e, 0x6f, 0x74, 0x41,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b,
+	0x49, 0x73, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x4e,
+	0x6f, 0x74, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3a, 0x0a, 0x18, 0x49,
+	0x73, 0x43, 0x61, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x63, 0x6f, 0x6e, 0x46, 0x6f,
+	0x72, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x49,
+	0x73, 0x43, 0x61, 0x6e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x49, 0x63, 0x6f, 0x6e, 0x46, 0x6f,
+	0x72, 0x42, 0x69, 0x6e, 0x61, 0x72, 0x79, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x70, 0x70, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x70, 0x70,
+	0x73, 0x12, 0x44, 0x0a, 0x0b, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x75, 0x6e,
+	0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x52, 0x0b, 0x52, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x41, 0x70, 0x70, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x49, 0x73, 0x43, 0x61, 0x6e,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x49, 0x73, 0x43, 0x61, 0x6e, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x47, 0x0a, 0x0c, 0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x75, 0x6e, 0x44,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x49, 0x73, 0x43, 0x61,
+	0x6e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x49, 0x73, 0x43, 0x61, 0x6e, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x41, 0x0a, 0x0a,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x75, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x52, 0x0a, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x49, 0x73, 0x43, 0x61, 0x6e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x49, 0x73, 0x43, 0x61, 0x6e, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74,
+	0x75, 0x6e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x22, 0xbe, 0x01,
+	0x0a, 0x12, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x74, 0x75, 0x6e, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x41, 0x70, 0x70, 0x12, 0x10, 0x0a, 0x03, 0x50, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x03, 0x50, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x70, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50, 0x70, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6d,
+	0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6d, 0x64,
+	0x6c, 0x69, 0x6e, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x78, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x45, 0x78, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x49, 0x76, 0x70,
+	0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e,
+	0x45, 0x78, 0x74, 0x49, 0x76, 0x70, 0x6e, 0x52, 0x6f, 0x6f, 0x74, 0x50, 0x69, 0x64, 0x12, 0x2e,
+	0x0a, 0x12, 0x45, 0x78, 0x74, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6d, 0x64,
+	0x4c, 0x69, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x45, 0x78, 0x74, 0x4d,
+	0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x64, 0x43, 0x6d, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x22, 0xb8,
+	0x01, 0x0a, 0x18, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64,
+	0x64, 0x41, 0x70, 0x70, 0x43, 0x6d, 0x64, 0x52, 0x65, 0x73, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x45,
+	0x78, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x45, 0x78, 0x65, 0x63, 0x12,
+	0x22, 0x0a, 0x0c, 0x43, 0x6d, 0x64, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43, 0x6d, 0x64, 0x54, 0x6f, 0x45, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x49, 0x73, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x49,
+	0x73, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x38, 0x0a, 0x17, 0x49, 0x73, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x17, 0x49, 0x73, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x52, 0x75, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x32, 0xcf, 0x42, 0x0a, 0x06, 0x44, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x12, 0x3e, 0x0a, 0x08, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x05, 0x48, 0x65, 0x6c, 0x6c, 0x6f, 0x12, 0x15, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48,
+	0x65, 0x6c, 0x6c, 0x6f, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
+	0x0a, 0x16, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x1a, 0x50, 0x61,
+	0x72, 0x61, 0x6e, 0x6f, 0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x71, 0x12, 0x2a, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6e, 0x6f,
+	0x69, 0x64, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x52, 0x65, 0x71, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x56, 0x50, 0x4e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x56, 0x50, 0x4e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x12, 0x1a, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x1a, 0x18,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67,
+	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73, 0x12, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x0a, 0x41, 0x50, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x50,
+	0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x15, 0x57, 0x69, 0x46, 0x69, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x25, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x46,
+	0x69, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x13,
+	0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x56, 0x0a, 0x14, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x53, 0x65, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x24, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6c, 0x6c,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x1e, 0x4b, 0x69,
+	0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4c, 0x41, 0x4e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x12, 0x2e, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69,
+	0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x4c, 0x41, 0x4e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x63, 0x61, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x15, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x12,
+	0x25, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x41, 0x6c,
+	0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x68, 0x0a, 0x1d, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x12, 0x2d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74,
+	0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x41, 0x4e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x1c, 0x4b, 0x69,
+	0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x4c, 0x41, 0x4e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6c, 0x6c,
+	0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64,
+	0x4c, 0x41, 0x4e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x66, 0x0a, 0x1c, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x53, 0x65, 0x74, 0x4c, 0x41, 0x4e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72,
+	0x74, 0x73, 0x12, 0x2c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65,
+	0x74, 0x4c, 0x41, 0x4e, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x73,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x1b, 0x4b, 0x69,
+	0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x78, 0x63, 0x65, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53,
+	0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x63, 0x65,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x60, 0x0a, 0x19, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65,
+	0x74, 0x49, 0x73, 0x50, 0x65, 0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x29, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4b,
+	0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65, 0x74, 0x49, 0x73, 0x50, 0x65,
+	0x72, 0x73, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x66, 0x0a, 0x1c, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68,
+	0x53, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x73, 0x12, 0x2c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x69, 0x6c, 0x6c, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x53, 0x65,
+	0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x41, 0x70, 0x69, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x73,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x53, 0x65,
+	0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x4f, 0x62, 0x66, 0x73, 0x50,
+	0x72, 0x6f, 0x78, 0x79, 0x12, 0x1c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4f, 0x62, 0x66, 0x73, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d,
+	0x53, 0x65, 0x74, 0x56, 0x32, 0x52, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1d, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x56, 0x32, 0x52, 0x61, 0x79, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x41, 0x70, 0x69,
+	0x50, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x70, 0x69, 0x50, 0x72, 0x6f,
+	0x78, 0x79, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x12,
+	0x53, 0x65, 0x74, 0x41, 0x70, 0x69, 0x48, 0x6f, 0x73, 0x74, 0x4f, 0x76, 0x65, 0x72, 0x72, 0x69,
+	0x64, 0x65, 0x12, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x70, 0x69, 0x48, 0x6f, 0x73, 0x74, 0x4f, 0x76,
+	0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x60, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x29, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5a, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e,
+	0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x26, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65,
+	0x74, 0x4f, 0x70, 0x65, 0x6e, 0x56, 0x70, 0x6e, 0x43, 0x72, 0x79, 0x70, 0x74, 0x6f, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x0a, 0x52, 0x65, 0x73, 0x74, 0x41, 0x70, 0x69, 0x47, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x41, 0x70, 0x69, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x74, 0x41, 0x70, 0x69,
+	0x12, 0x1a, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x74, 0x41, 0x70, 0x69, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x47, 0x72, 0x70, 0x63, 0x41, 0x70,
+	0x69, 0x47, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x41, 0x70, 0x69, 0x47, 0x65, 0x74,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x65,
+	0x74, 0x47, 0x72, 0x70, 0x63, 0x41, 0x70, 0x69, 0x12, 0x1a, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x47, 0x72, 0x70,
+	0x63, 0x41, 0x70, 0x69, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a,
+	0x0a, 0x0e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x73, 0x47, 0x65, 0x74, 0x12, 0x1c, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x4c,
+	0x65, 0x76, 0x65, 0x6c, 0x73, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65,
+	0x6c, 0x12, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x1a, 0x18,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x43, 0x6c, 0x69, 0x65,
+	0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x47, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x12, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x41, 0x64, 0x64, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4a, 0x0a, 0x0e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x49, 0x6d, 0x70, 0x6f,
+	0x72, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f, 0x63, 0x6b, 0x73, 0x50, 0x72,
+	0x6f, 0x78, 0x79, 0x12, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x53, 0x68, 0x61, 0x64, 0x6f, 0x77, 0x73, 0x6f,
+	0x63, 0x6b, 0x73, 0x50, 0x72, 0x6f, 0x78, 0x79, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x53, 0x65, 0x74, 0x41, 0x6d, 0x6e, 0x65, 0x7a, 0x69, 0x61,
+	0x57, 0x47, 0x12, 0x1c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6d, 0x6e, 0x65, 0x7a, 0x69, 0x61, 0x57, 0x47,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x53, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x14, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e,
+	0x6e, 0x65, 0x6c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x14, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54,
+	0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x24,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62,
+	0x0a, 0x1a, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74,
+	0x44, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x44, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5e, 0x0a, 0x18, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x12, 0x28,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x54, 0x0a, 0x13, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74,
+	0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x1a, 0x18,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x11, 0x53, 0x70, 0x6c, 0x69,
+	0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70, 0x12, 0x21, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x41, 0x70, 0x70,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x14, 0x53, 0x70,
+	0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41,
+	0x70, 0x70, 0x12, 0x24, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x41, 0x70, 0x70, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5c, 0x0a, 0x17, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65,
+	0x6c, 0x41, 0x64, 0x64, 0x65, 0x64, 0x50, 0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x54, 0x75, 0x6e, 0x6e, 0x65, 0x6c, 0x41, 0x64, 0x64, 0x65, 0x64, 0x50,
+	0x69, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x54, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x44, 0x69, 0x61, 0x67,
+	0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x61, 0x67, 0x6e, 0x6f, 0x73, 0x74, 0x69, 0x63, 0x73, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x17, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f,
+	0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x27, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e,
+	0x67, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x15, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77,
+	0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58,
+	0x0a, 0x15, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x25, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x46, 0x6f, 0x72,
+	0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x1a, 0x18,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x43, 0x61, 0x70, 0x74,
+	0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x22,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x17,
+	0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x27, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65,
+	0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x67, 0x69, 0x6e,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x13, 0x43, 0x61,
+	0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x4c, 0x6f, 0x63,
+	0x6b, 0x12, 0x23, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x61, 0x70, 0x74, 0x69, 0x76, 0x65, 0x50, 0x6f, 0x72, 0x74, 0x61, 0x6c,
+	0x52, 0x65, 0x4c, 0x6f, 0x63, 0x6b, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x4c, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x65,
+	0x44, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x74, 0x41, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74,
+	0x65, 0x44, 0x6e, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x6e, 0x73, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e,
+	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x27, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x6e,
+	0x73, 0x50, 0x72, 0x65, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x52, 0x65,
+	0x73, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x65, 0x77, 0x12, 0x1a, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x65, 0x77, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48,
+	0x0a, 0x0d, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12,
+	0x1d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x18,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x4c, 0x69, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
+	0x0a, 0x0c, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x12, 0x1c,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x6f, 0x75, 0x74, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x73, 0x47, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x47,
+	0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x12, 0x1d, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x12, 0x1d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x48, 0x0a, 0x0d, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x18, 0x57, 0x69,
+	0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e,
+	0x65, 0x77, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72,
+	0x64, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4e, 0x65, 0x77, 0x4b, 0x65, 0x79, 0x73,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x20, 0x57, 0x69,
+	0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x30,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x57, 0x69, 0x72, 0x65, 0x47, 0x75, 0x61, 0x72, 0x64, 0x53, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x73,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x41, 0x70, 0x70, 0x49, 0x63, 0x6f, 0x6e, 0x12, 0x1a, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70,
+	0x49, 0x63, 0x6f, 0x6e, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e,
+	0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x41, 0x70,
+	0x70, 0x73, 0x12, 0x20, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64,
+	0x41, 0x70, 0x70, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x12, 0x57, 0x69, 0x46, 0x69, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x4e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x12, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x46, 0x69, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x57, 0x69, 0x46, 0x69, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x12, 0x1c, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x69, 0x46, 0x69, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x20,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x1a, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52,
+	0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x47, 0x65, 0x74, 0x12, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x53, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x3c, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x17, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x47, 0x65, 0x74, 0x12, 0x24, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x0b, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x47, 0x65, 0x74, 0x12, 0x1b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x47,
+	0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e,
+	0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x4c, 0x6f, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x1a, 0x18,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x12, 0x1d, 0x2e, 0x69, 0x76, 0x70, 0x6e,
+	0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x6f, 0x70, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4e, 0x0a, 0x10, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x73, 0x47, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x73, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4c, 0x0a, 0x0f, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x73, 0x47, 0x65, 0x74, 0x12, 0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x50, 0x0a, 0x11, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x73,
+	0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x21, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x61, 0x73, 0x68, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x73, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x52, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62, 0x73, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x75, 0x62,
+	0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x54, 0x65, 0x73, 0x74, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x12, 0x1f, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x76, 0x31, 0x2e, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x48, 0x0a, 0x0d,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x65, 0x74, 0x12, 0x1d, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x65, 0x74, 0x12, 0x1e, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x48,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e,
+	0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x5a, 0x0a, 0x16, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12, 0x26, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f,
+	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43,
+	0x6c, 0x65, 0x61, 0x72, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e,
+	0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x28, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x19, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x74, 0x12, 0x29, 0x2e, 0x69, 0x76,
+	0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61,
+	0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x64, 0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x12,
+	0x2b, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x14, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67,
+	0x68, 0x70, 0x75, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x74, 0x12, 0x24,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64,
+	0x0a, 0x1b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x61, 0x6c,
+	0x69, 0x74, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x74, 0x12, 0x2b, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70,
+	0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x47, 0x65, 0x74, 0x12, 0x25, 0x2e,
+	0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x47, 0x65, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58,
+	0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x53, 0x61, 0x76, 0x65, 0x12, 0x25, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64,
+	0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x53, 0x61, 0x76, 0x65, 0x1a, 0x18,
+	0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x12, 0x27, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x12, 0x28, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x1a, 0x18, 0x2e, 0x69,
+	0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x06, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x1d, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76,
+	0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x18, 0x2e, 0x69, 0x76, 0x70, 0x6e, 0x2e, 0x64, 0x61, 0x65, 0x6d, 0x6f, 0x6e, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42, 0x35, 0x5a, 0x33, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x76, 0x70, 0x6e, 0x2f, 0x64,
+	0x65, 0x73, 0x6b, 0x74, 0x6f, 0x70, 0x2d, 0x61, 0x70, 0x70, 0x2f, 0x64, 0x61, 0x65, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x61,
+	0x70, 0x69, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ivpn_proto_rawDescOnce sync.Once
+	file_ivpn_proto_rawDescData = file_ivpn_proto_rawDesc
+)
+
+func file_ivpn_proto_rawDescGZIP() []byte {
+	file_ivpn_proto_rawDescOnce.Do(func() {
+		file_ivpn_proto_rawDescData = protoimpl.X.CompressGZIP(file_ivpn_proto_rawDescData)
+	})
+	return file_ivpn_proto_rawDescData
+}
+
+var file_ivpn_proto_msgTypes = make([]protoimpl.MessageInfo, 248)
+var file_ivpn_proto_goTypes = []interface{}{
+	(*EmptyReq)(nil),                                            // 0: ivpn.daemon.v1.EmptyReq
+	(*Hello)(nil),                                               // 1: ivpn.daemon.v1.Hello
+	(*SetNotificationsFilter)(nil),                              // 2: ivpn.daemon.v1.SetNotificationsFilter
+	(*ParanoidModeSetPasswordReq)(nil),                          // 3: ivpn.daemon.v1.ParanoidModeSetPasswordReq
+	(*GetVPNState)(nil),                                         // 4: ivpn.daemon.v1.GetVPNState
+	(*GetServers)(nil),                                          // 5: ivpn.daemon.v1.GetServers
+	(*PingServers)(nil),                                         // 6: ivpn.daemon.v1.PingServers
+	(*APIRequest)(nil),                                          // 7: ivpn.daemon.v1.APIRequest
+	(*WiFiAvailableNetworks)(nil),                               // 8: ivpn.daemon.v1.WiFiAvailableNetworks
+	(*KillSwitchGetStatus)(nil),                                 // 9: ivpn.daemon.v1.KillSwitchGetStatus
+	(*KillSwitchSetEnabled)(nil),                                // 10: ivpn.daemon.v1.KillSwitchSetEnabled
+	(*KillSwitchSetAllowLANMulticast)(nil),                      // 11: ivpn.daemon.v1.KillSwitchSetAllowLANMulticast
+	(*KillSwitchSetAllowLAN)(nil),                               // 12: ivpn.daemon.v1.KillSwitchSetAllowLAN
+	(*KillSwitchSetAllowLANServices)(nil),                       // 13: ivpn.daemon.v1.KillSwitchSetAllowLANServices
+	(*LansvcServices)(nil),                                      // 14: ivpn.daemon.v1.LansvcServices
+	(*KillSwitchSetAllowedLANHosts)(nil),                        // 15: ivpn.daemon.v1.KillSwitchSetAllowedLANHosts
+	(*KillSwitchSetLANInboundPorts)(nil),                        // 16: ivpn.daemon.v1.KillSwitchSetLANInboundPorts
+	(*LansvcInboundPort)(nil),                                   // 17: ivpn.daemon.v1.LansvcInboundPort
+	(*KillSwitchSetUserExceptions)(nil),                         // 18: ivpn.daemon.v1.KillSwitchSetUserExceptions
+	(*KillSwitchSetIsPersistent)(nil),                           // 19: ivpn.daemon.v1.KillSwitchSetIsPersistent
+	(*KillSwitchSetAllowApiServers)(nil),                        // 20: ivpn.daemon.v1.KillSwitchSetAllowApiServers
+	(*SetPreference)(nil),                                       // 21: ivpn.daemon.v1.SetPreference
+	(*SetObfsProxy)(nil),                                        // 22: ivpn.daemon.v1.SetObfsProxy
+	(*ObfsproxyConfig)(nil),                                     // 23: ivpn.daemon.v1.ObfsproxyConfig
+	(*ObfsproxyObfs4Bridge)(nil),                                // 24: ivpn.daemon.v1.ObfsproxyObfs4Bridge
+	(*SetV2RayProxy)(nil),                                       // 25: ivpn.daemon.v1.SetV2RayProxy
+	(*SetApiProxy)(nil),                                         // 26: ivpn.daemon.v1.SetApiProxy
+	(*ApiTypesProxyConfig)(nil),                                 // 27: ivpn.daemon.v1.ApiTypesProxyConfig
+	(*SetApiHostOverride)(nil),                                  // 28: ivpn.daemon.v1.SetApiHostOverride
+	(*ApiTypesAPIHostOverride)(nil),                             // 29: ivpn.daemon.v1.ApiTypesAPIHostOverride
+	(*SetOpenVpnExtraParameters)(nil),                           // 30: ivpn.daemon.v1.SetOpenVpnExtraParameters
+	(*SetOpenVpnCryptoPolicy)(nil),                              // 31: ivpn.daemon.v1.SetOpenVpnCryptoPolicy
+	(*VpnOpenVpnCryptoPolicy)(nil),                              // 32: ivpn.daemon.v1.VpnOpenVpnCryptoPolicy
+	(*RestApiGet)(nil),                                          // 33: ivpn.daemon.v1.RestApiGet
+	(*SetRestApi)(nil),                                          // 34: ivpn.daemon.v1.SetRestApi
+	(*GrpcApiGet)(nil),                                          // 35: ivpn.daemon.v1.GrpcApiGet
+	(*SetGrpcApi)(nil),                                          // 36: ivpn.daemon.v1.SetGrpcApi
+	(*SetLogRotation)(nil),                                      // 37: ivpn.daemon.v1.SetLogRotation
+	(*LoggerRotationConfig)(nil),                                // 38: ivpn.daemon.v1.LoggerRotationConfig
+	(*LogLevelsGet)(nil),                                        // 39: ivpn.daemon.v1.LogLevelsGet
+	(*SetLogLevel)(nil),                                         // 40: ivpn.daemon.v1.SetLogLevel
+	(*ClientTokensGet)(nil),                                     // 41: ivpn.daemon.v1.ClientTokensGet
+	(*ClientTokenAdd)(nil),                                      // 42: ivpn.daemon.v1.ClientTokenAdd
+	(*ClientTokenRemove)(nil),                                   // 43: ivpn.daemon.v1.ClientTokenRemove
+	(*SettingsExport)(nil),                                      // 44: ivpn.daemon.v1.SettingsExport
+	(*SettingsImport)(nil),                                      // 45: ivpn.daemon.v1.SettingsImport
+	(*PreferencesExportedSettings)(nil),                         // 46: ivpn.daemon.v1.PreferencesExportedSettings
+	(*PreferencesExportedFirewallSettings)(nil),                 // 47: ivpn.daemon.v1.PreferencesExportedFirewallSettings
+	(*ServiceTypesConnectionParams)(nil),                        // 48: ivpn.daemon.v1.ServiceTypesConnectionParams
+	(*ServiceTypesConnectMetadata)(nil),                         // 49: ivpn.daemon.v1.ServiceTypesConnectMetadata
+	(*ServiceTypesAntiTrackerMetadata)(nil),                     // 50: ivpn.daemon.v1.ServiceTypesAntiTrackerMetadata
+	(*ServiceTypesFastestServerCriteria)(nil),                   // 51: ivpn.daemon.v1.ServiceTypesFastestServerCriteria
+	(*DnsDnsSettings)(nil),                                      // 52: ivpn.daemon.v1.DnsDnsSettings
+	(*ServiceTypesConnectionParamsWireGuardParameters)(nil),     // 53: ivpn.daemon.v1.ServiceTypesConnectionParamsWireGuardParameters
+	(*ServiceTypesConnectionParamsWireGuardParametersPort)(nil), // 54: ivpn.daemon.v1.ServiceTypesConnectionParamsWireGuardParametersPort
+	(*ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer)(nil), // 55: ivpn.daemon.v1.ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer
+	(*ApiTypesWireGuardServerHostInfo)(nil),                               // 56: ivpn.daemon.v1.ApiTypesWireGuardServerHostInfo
+	(*ApiTypesWireGuardServerHostInfoIPv6)(nil),                           // 57: ivpn.daemon.v1.ApiTypesWireGuardServerHostInfoIPv6
+	(*ServiceTypesMultiHopExitServer_WireGuard)(nil),                      // 58: ivpn.daemon.v1.ServiceTypesMultiHopExitServer_WireGuard
+	(*ServiceTypesCustomConfig)(nil),                                      // 59: ivpn.daemon.v1.ServiceTypesCustomConfig
+	(*ServiceTypesConnectionParamsOpenVpnParameters)(nil),                 // 60: ivpn.daemon.v1.ServiceTypesConnectionParamsOpenVpnParameters
+	(*ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer)(nil),   // 61: ivpn.daemon.v1.ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer
+	(*ApiTypesOpenVPNServerHostInfo)(nil),                                 // 62: ivpn.daemon.v1.ApiTypesOpenVPNServerHostInfo
+	(*ApiTypesObfsParams)(nil),                                            // 63: ivpn.daemon.v1.ApiTypesObfsParams
+	(*ApiTypesOpenVPNServerHostInfoIPv6)(nil),                             // 64: ivpn.daemon.v1.ApiTypesOpenVPNServerHostInfoIPv6
+	(*ServiceTypesMultiHopExitServer_OpenVpn)(nil),                        // 65: ivpn.daemon.v1.ServiceTypesMultiHopExitServer_OpenVpn
+	(*ServiceTypesOpenVpnProxy)(nil),                                      // 66: ivpn.daemon.v1.ServiceTypesOpenVpnProxy
+	(*ServiceTypesConnectionParamsOpenVpnParametersPort)(nil),             // 67: ivpn.daemon.v1.ServiceTypesConnectionParamsOpenVpnParametersPort
+	(*PreferencesConnectionProfile)(nil),                                  // 68: ivpn.daemon.v1.PreferencesConnectionProfile
+	(*PreferencesWiFiParams)(nil),                                         // 69: ivpn.daemon.v1.PreferencesWiFiParams
+	(*PreferencesWiFiNetwork)(nil),                                        // 70: ivpn.daemon.v1.PreferencesWiFiNetwork
+	(*PreferencesWiFiActions)(nil),                                        // 71: ivpn.daemon.v1.PreferencesWiFiActions
+	(*ShadowsocksConfig)(nil),                                             // 72: ivpn.daemon.v1.ShadowsocksConfig
+	(*AwgConfig)(nil),                                                     // 73: ivpn.daemon.v1.AwgConfig
+	(*SetShadowsocksProxy)(nil),                                           // 74: ivpn.daemon.v1.SetShadowsocksProxy
+	(*SetAmneziaWG)(nil),                                                  // 75: ivpn.daemon.v1.SetAmneziaWG
+	(*SetUserPreferences)(nil),                                            // 76: ivpn.daemon.v1.SetUserPreferences
+	(*PreferencesUserPreferences)(nil),                                    // 77: ivpn.daemon.v1.PreferencesUserPreferences
+	(*PreferencesLinuxSpecificUserPrefs)(nil),                             // 78: ivpn.daemon.v1.PreferencesLinuxSpecificUserPrefs
+	(*SplitTunnelGetStatus)(nil),                                          // 79: ivpn.daemon.v1.SplitTunnelGetStatus
+	(*SplitTunnelSetConfig)(nil),                                          // 80: ivpn.daemon.v1.SplitTunnelSetConfig
+	(*SplitTunnelSetDestinations)(nil),                                    // 81: ivpn.daemon.v1.SplitTunnelSetDestinations
+	(*SplittunDestination)(nil),                                           // 82: ivpn.daemon.v1.SplittunDestination
+	(*SplitTunnelSetContainers)(nil),                                      // 83: ivpn.daemon.v1.SplitTunnelSetContainers
+	(*SplittunContainer)(nil),                                             // 84: ivpn.daemon.v1.SplittunContainer
+	(*SplitTunnelSetUsers)(nil),                                           // 85: ivpn.daemon.v1.SplitTunnelSetUsers
+	(*SplittunUser)(nil),                                                  // 86: ivpn.daemon.v1.SplittunUser
+	(*SplitTunnelAddApp)(nil),                                             // 87: ivpn.daemon.v1.SplitTunnelAddApp
+	(*SplitTunnelRemoveApp)(nil),                                          // 88: ivpn.daemon.v1.SplitTunnelRemoveApp
+	(*SplitTunnelAddedPidInfo)(nil),                                       // 89: ivpn.daemon.v1.SplitTunnelAddedPidInfo
+	(*GenerateDiagnostics)(nil),                                           // 90: ivpn.daemon.v1.GenerateDiagnostics
+	(*PortForwardingGetStatus)(nil),                                       // 91: ivpn.daemon.v1.PortForwardingGetStatus
+	(*PortForwardingRequest)(nil),                                         // 92: ivpn.daemon.v1.PortForwardingRequest
+	(*PortForwardingRelease)(nil),                                         // 93: ivpn.daemon.v1.PortForwardingRelease
+	(*CaptivePortalCheck)(nil),                                            // 94: ivpn.daemon.v1.CaptivePortalCheck
+	(*CaptivePortalAllowLogin)(nil),                                       // 95: ivpn.daemon.v1.CaptivePortalAllowLogin
+	(*CaptivePortalReLock)(nil),                                           // 96: ivpn.daemon.v1.CaptivePortalReLock
+	(*SetAlternateDns)(nil),                                               // 97: ivpn.daemon.v1.SetAlternateDns
+	(*GetDnsPredefinedConfigs)(nil),                                       // 98: ivpn.daemon.v1.GetDnsPredefinedConfigs
+	(*PauseConnection)(nil),                                               // 99: ivpn.daemon.v1.PauseConnection
+	(*ResumeConnection)(nil),                                              // 100: ivpn.daemon.v1.ResumeConnection
+	(*SessionNew)(nil),                                                    // 101: ivpn.daemon.v1.SessionNew
+	(*SessionDelete)(nil),                                                 // 102: ivpn.daemon.v1.SessionDelete
+	(*DevicesList)(nil),                                                   // 103: ivpn.daemon.v1.DevicesList
+	(*DeviceLogout)(nil),                                                  // 104: ivpn.daemon.v1.DeviceLogout
+	(*AccountsGet)(nil),                                                   // 105: ivpn.daemon.v1.AccountsGet
+	(*AccountSwitch)(nil),                                                 // 106: ivpn.daemon.v1.AccountSwitch
+	(*AccountRemove)(nil),                                                 // 107: ivpn.daemon.v1.AccountRemove
+	(*AccountStatus)(nil),                                                 // 108: ivpn.daemon.v1.AccountStatus
+	(*WireGuardGenerateNewKeys)(nil),                                      // 109: ivpn.daemon.v1.WireGuardGenerateNewKeys
+	(*WireGuardSetKeysRotationInterval)(nil),                              // 110: ivpn.daemon.v1.WireGuardSetKeysRotationInterval
+	(*GetAppIcon)(nil),                                                    // 111: ivpn.daemon.v1.GetAppIcon
+	(*GetInstalledApps)(nil),                                              // 112: ivpn.daemon.v1.GetInstalledApps
+	(*WiFiCurrentNetwork)(nil),                                            // 113: ivpn.daemon.v1.WiFiCurrentNetwork
+	(*WiFiSettings)(nil),                                                  // 114: ivpn.daemon.v1.WiFiSettings
+	(*ScheduleSettings)(nil),                                              // 115: ivpn.daemon.v1.ScheduleSettings
+	(*PreferencesScheduleParams)(nil),                                     // 116: ivpn.daemon.v1.PreferencesScheduleParams
+	(*PreferencesScheduleRule)(nil),                                       // 117: ivpn.daemon.v1.PreferencesScheduleRule
+	(*Disconnect)(nil),                                                    // 118: ivpn.daemon.v1.Disconnect
+	(*ConnectSettingsGet)(nil),                                            // 119: ivpn.daemon.v1.ConnectSettingsGet
+	(*ConnectSettings)(nil),                                               // 120: ivpn.daemon.v1.ConnectSettings
+	(*Connect)(nil),                                                       // 121: ivpn.daemon.v1.Connect
+	(*ConnectionHistoryGet)(nil),                                          // 122: ivpn.daemon.v1.ConnectionHistoryGet
+	(*AuditLogGet)(nil),                                                   // 123: ivpn.daemon.v1.AuditLogGet
+	(*LogStreamStart)(nil),                                                // 124: ivpn.daemon.v1.LogStreamStart
+	(*LogStreamStop)(nil),                                                 // 125: ivpn.daemon.v1.LogStreamStop
+	(*ShellCommandsGet)(nil),                                              // 126: ivpn.daemon.v1.ShellCommandsGet
+	(*CrashReportsGet)(nil),                                               // 127: ivpn.daemon.v1.CrashReportsGet
+	(*CrashReportsClear)(nil),                                             // 128: ivpn.daemon.v1.CrashReportsClear
+	(*GetSubsystemStatus)(nil),                                            // 129: ivpn.daemon.v1.GetSubsystemStatus
+	(*OperationStart)(nil),                                                // 130: ivpn.daemon.v1.OperationStart
+	(*SpeedTestStart)(nil),                                                // 131: ivpn.daemon.v1.SpeedTestStart
+	(*SpeedtestParams)(nil),                                               // 132: ivpn.daemon.v1.SpeedtestParams
+	(*OperationCancel)(nil),                                               // 133: ivpn.daemon.v1.OperationCancel
+	(*OperationsGet)(nil),                                                 // 134: ivpn.daemon.v1.OperationsGet
+	(*HostsHealthGet)(nil),                                                // 135: ivpn.daemon.v1.HostsHealthGet
+	(*ConnectionHistoryClear)(nil),                                        // 136: ivpn.daemon.v1.ConnectionHistoryClear
+	(*ConnectionHistoryConnect)(nil),                                      // 137: ivpn.daemon.v1.ConnectionHistoryConnect
+	(*ConnectionStatsHistoryGet)(nil),                                     // 138: ivpn.daemon.v1.ConnectionStatsHistoryGet
+	(*ConnectionStatsHistoryClear)(nil),                                   // 139: ivpn.daemon.v1.ConnectionStatsHistoryClear
+	(*ThroughputHistoryGet)(nil),                                          // 140: ivpn.daemon.v1.ThroughputHistoryGet
+	(*ConnectionQualityHistoryGet)(nil),                                   // 141: ivpn.daemon.v1.ConnectionQualityHistoryGet
+	(*ConnectionProfilesGet)(nil),                                         // 142: ivpn.daemon.v1.ConnectionProfilesGet
+	(*ConnectionProfileSave)(nil),                                         // 143: ivpn.daemon.v1.ConnectionProfileSave
+	(*ConnectionProfileRemove)(nil),                                       // 144: ivpn.daemon.v1.ConnectionProfileRemove
+	(*ConnectionProfileConnect)(nil),                                      // 145: ivpn.daemon.v1.ConnectionProfileConnect
+	(*EventsRequest)(nil),                                                 // 146: ivpn.daemon.v1.EventsRequest
+	(*Response)(nil),                                                      // 147: ivpn.daemon.v1.Response
+	(*ErrorResp)(nil),                                                     // 148: ivpn.daemon.v1.ErrorResp
+	(*ErrorRespDelayed)(nil),                                              // 149: ivpn.daemon.v1.ErrorRespDelayed
+	(*EmptyResp)(nil),                                                     // 150: ivpn.daemon.v1.EmptyResp
+	(*ServiceExitingResp)(nil),                                            // 151: ivpn.daemon.v1.ServiceExitingResp
+	(*SettingsResp)(nil),                                                  // 152: ivpn.daemon.v1.SettingsResp
+	(*PreferencesDataUsageAlertParams)(nil),                               // 153: ivpn.daemon.v1.PreferencesDataUsageAlertParams
+	(*HelloResp)(nil),                                                     // 154: ivpn.daemon.v1.HelloResp
+	(*SessionResp)(nil),                                                   // 155: ivpn.daemon.v1.SessionResp
+	(*PreferencesAccountStatus)(nil),                                      // 156: ivpn.daemon.v1.PreferencesAccountStatus
+	(*DisabledFunctionality)(nil),                                         // 157: ivpn.daemon.v1.DisabledFunctionality
+	(*DisabledFunctionalityForPlatform)(nil),                              // 158: ivpn.daemon.v1.DisabledFunctionalityForPlatform
+	(*DisabledFunctionalityLinux)(nil),                                    // 159: ivpn.daemon.v1.DisabledFunctionalityLinux
+	(*DnsAbilities)(nil),                                                  // 160: ivpn.daemon.v1.DnsAbilities
+	(*ParanoidModeStatus)(nil),                                            // 161: ivpn.daemon.v1.ParanoidModeStatus
+	(*SessionNewResp)(nil),                                                // 162: ivpn.daemon.v1.SessionNewResp
+	(*ApiTypesCaptchaInfo)(nil),                                           // 163: ivpn.daemon.v1.ApiTypesCaptchaInfo
+	(*AccountsResp)(nil),                                                  // 164: ivpn.daemon.v1.AccountsResp
+	(*AccountInfo)(nil),                                                   // 165: ivpn.daemon.v1.AccountInfo
+	(*DevicesResp)(nil),                                                   // 166: ivpn.daemon.v1.DevicesResp
+	(*ApiTypesDeviceInfo)(nil),                                            // 167: ivpn.daemon.v1.ApiTypesDeviceInfo
+	(*AccountStatusResp)(nil),                                             // 168: ivpn.daemon.v1.AccountStatusResp
+	(*KillSwitchStatusResp)(nil),                                          // 169: ivpn.daemon.v1.KillSwitchStatusResp
+	(*KillSwitchGetIsPestistentResp)(nil),                                 // 170: ivpn.daemon.v1.KillSwitchGetIsPestistentResp
+	(*DiagnosticsGeneratedResp)(nil),                                      // 171: ivpn.daemon.v1.DiagnosticsGeneratedResp
+	(*SetAlternateDNSResp)(nil),                                           // 172: ivpn.daemon.v1.SetAlternateDNSResp
+	(*DnsPredefinedConfigsResp)(nil),                                      // 173: ivpn.daemon.v1.DnsPredefinedConfigsResp
+	(*ConnectedResp)(nil),                                                 // 174: ivpn.daemon.v1.ConnectedResp
+	(*DisconnectedResp)(nil),                                              // 175: ivpn.daemon.v1.DisconnectedResp
+	(*ConnectionHistoryResp)(nil),                                         // 176: ivpn.daemon.v1.ConnectionHistoryResp
+	(*PreferencesConnectionHistoryItem)(nil),                              // 177: ivpn.daemon.v1.PreferencesConnectionHistoryItem
+	(*ConnectionStatsHistoryResp)(nil),                                    // 178: ivpn.daemon.v1.ConnectionStatsHistoryResp
+	(*ConnstatsSessionRecord)(nil),                                        // 179: ivpn.daemon.v1.ConnstatsSessionRecord
+	(*ConnstatsDayRecord)(nil),                                            // 180: ivpn.daemon.v1.ConnstatsDayRecord
+	(*ThroughputHistoryResp)(nil),                                         // 181: ivpn.daemon.v1.ThroughputHistoryResp
+	(*ConnstatsThroughputSample)(nil),                                     // 182: ivpn.daemon.v1.ConnstatsThroughputSample
+	(*AuditLogResp)(nil),                                                  // 183: ivpn.daemon.v1.AuditLogResp
+	(*AuditlogEvent)(nil),                                                 // 184: ivpn.daemon.v1.AuditlogEvent
+	(*AuditlogActor)(nil),                                                 // 185: ivpn.daemon.v1.AuditlogActor
+	(*SubsystemStatusResp)(nil),                                           // 186: ivpn.daemon.v1.SubsystemStatusResp
+	(*SubsystemsStatus)(nil),                                              // 187: ivpn.daemon.v1.SubsystemsStatus
+	(*PortForwardingStatusResp)(nil),                                      // 188: ivpn.daemon.v1.PortForwardingStatusResp
+	(*PortforwardingState)(nil),                                           // 189: ivpn.daemon.v1.PortforwardingState
+	(*DataUsageAlertResp)(nil),                                            // 190: ivpn.daemon.v1.DataUsageAlertResp
+	(*ConnstatsDataUsageAlert)(nil),                                       // 191: ivpn.daemon.v1.ConnstatsDataUsageAlert
+	(*ConnectionQualityResp)(nil),                                         // 192: ivpn.daemon.v1.ConnectionQualityResp
+	(*ConnstatsQualityReport)(nil),                                        // 193: ivpn.daemon.v1.ConnstatsQualityReport
+	(*ConnectionQualityHistoryResp)(nil),                                  // 194: ivpn.daemon.v1.ConnectionQualityHistoryResp
+	(*CaptivePortalStatusResp)(nil),                                       // 195: ivpn.daemon.v1.CaptivePortalStatusResp
+	(*CaptiveportalStatus)(nil),                                           // 196: ivpn.daemon.v1.CaptiveportalStatus
+	(*ReloginRequiredResp)(nil),                                           // 197: ivpn.daemon.v1.ReloginRequiredResp
+	(*ClockSkewResp)(nil),                                                 // 198: ivpn.daemon.v1.ClockSkewResp
+	(*ShellCommandsResp)(nil),                                             // 199: ivpn.daemon.v1.ShellCommandsResp
+	(*ShellCommandRecord)(nil),                                            // 200: ivpn.daemon.v1.ShellCommandRecord
+	(*CrashReportsResp)(nil),                                              // 201: ivpn.daemon.v1.CrashReportsResp
+	(*CrashreportReport)(nil),                                             // 202: ivpn.daemon.v1.CrashreportReport
+	(*CrashRecoveredResp)(nil),                                            // 203: ivpn.daemon.v1.CrashRecoveredResp
+	(*OperationStatusResp)(nil),                                           // 204: ivpn.daemon.v1.OperationStatusResp
+	(*OperationsStatus)(nil),                                              // 205: ivpn.daemon.v1.OperationsStatus
+	(*OperationsListResp)(nil),                                            // 206: ivpn.daemon.v1.OperationsListResp
+	(*ConnectionProfilesResp)(nil),                                        // 207: ivpn.daemon.v1.ConnectionProfilesResp
+	(*RestApiResp)(nil),                                                   // 208: ivpn.daemon.v1.RestApiResp
+	(*PreferencesRestApiParams)(nil),                                      // 209: ivpn.daemon.v1.PreferencesRestApiParams
+	(*GrpcApiResp)(nil),                                                   // 210: ivpn.daemon.v1.GrpcApiResp
+	(*PreferencesGrpcApiParams)(nil),                                      // 211: ivpn.daemon.v1.PreferencesGrpcApiParams
+	(*LogLevelsResp)(nil),                                                 // 212: ivpn.daemon.v1.LogLevelsResp
+	(*ClientTokensResp)(nil),                                              // 213: ivpn.daemon.v1.ClientTokensResp
+	(*PreferencesClientToken)(nil),                                        // 214: ivpn.daemon.v1.PreferencesClientToken
+	(*SettingsExportResp)(nil),                                            // 215: ivpn.daemon.v1.SettingsExportResp
+	(*SettingsImportResp)(nil),                                            // 216: ivpn.daemon.v1.SettingsImportResp
+	(*HostsHealthResp)(nil),                                               // 217: ivpn.daemon.v1.HostsHealthResp
+	(*HostshealthHostScore)(nil),                                          // 218: ivpn.daemon.v1.HostshealthHostScore
+	(*VpnStateResp)(nil),                                                  // 219: ivpn.daemon.v1.VpnStateResp
+	(*ConnectionStatsResp)(nil),                                           // 220: ivpn.daemon.v1.ConnectionStatsResp
+	(*LogMessageResp)(nil),                                                // 221: ivpn.daemon.v1.LogMessageResp
+	(*ServerListResp)(nil),                                                // 222: ivpn.daemon.v1.ServerListResp
+	(*ApiTypesServersInfoResponse)(nil),                                   // 223: ivpn.daemon.v1.ApiTypesServersInfoResponse
+	(*ApiTypesWireGuardServerInfo)(nil),                                   // 224: ivpn.daemon.v1.ApiTypesWireGuardServerInfo
+	(*ApiTypesOpenvpnServerInfo)(nil),                                     // 225: ivpn.daemon.v1.ApiTypesOpenvpnServerInfo
+	(*ApiTypesConfigInfo)(nil),                                            // 226: ivpn.daemon.v1.ApiTypesConfigInfo
+	(*ApiTypesAntitrackerInfo)(nil),                                       // 227: ivpn.daemon.v1.ApiTypesAntitrackerInfo
+	(*ApiTypesDNSInfo)(nil),                                               // 228: ivpn.daemon.v1.ApiTypesDNSInfo
+	(*ApiTypesInfoAPI)(nil),                                               // 229: ivpn.daemon.v1.ApiTypesInfoAPI
+	(*ApiTypesPortsInfo)(nil),                                             // 230: ivpn.daemon.v1.ApiTypesPortsInfo
+	(*ApiTypesPortInfo)(nil),                                              // 231: ivpn.daemon.v1.ApiTypesPortInfo
+	(*ApiTypesPortRange)(nil),                                             // 232: ivpn.daemon.v1.ApiTypesPortRange
+	(*ApiTypesObfsPortInfo)(nil),                                          // 233: ivpn.daemon.v1.ApiTypesObfsPortInfo
+	(*ApiTypesV2RayInfo)(nil),                                             // 234: ivpn.daemon.v1.ApiTypesV2RayInfo
+	(*PingServersResp)(nil),                                               // 235: ivpn.daemon.v1.PingServersResp
+	(*PingResultType)(nil),                                                // 236: ivpn.daemon.v1.PingResultType
+	(*WiFiAvailableNetworksResp)(nil),                                     // 237: ivpn.daemon.v1.WiFiAvailableNetworksResp
+	(*WiFiNetworkInfo)(nil),                                               // 238: ivpn.daemon.v1.WiFiNetworkInfo
+	(*WiFiCurrentNetworkResp)(nil),                                        // 239: ivpn.daemon.v1.WiFiCurrentNetworkResp
+	(*APIResponse)(nil),                                                   // 240: ivpn.daemon.v1.APIResponse
+	(*InstalledAppsResp)(nil),                                             // 241: ivpn.daemon.v1.InstalledAppsResp
+	(*OshelpersAppInfo)(nil),                                              // 242: ivpn.daemon.v1.OshelpersAppInfo
+	(*AppIconResp)(nil),                                                   // 243: ivpn.daemon.v1.AppIconResp
+	(*SplitTunnelStatus)(nil),                                             // 244: ivpn.daemon.v1.SplitTunnelStatus
+	(*SplittunRunningApp)(nil),                                            // 245: ivpn.daemon.v1.SplittunRunningApp
+	(*SplitTunnelAddAppCmdResp)(nil),                                      // 246: ivpn.daemon.v1.SplitTunnelAddAppCmdResp
+	nil,                                                                   // 247: ivpn.daemon.v1.LogLevelsResp.ModulesEntry
+	(*structpb.Value)(nil),                                                // 248: google.protobuf.Value
+}
+var file_ivpn_proto_depIdxs = []int32{
+	14,  // 0: ivpn.daemon.v1.KillSwitchSetAllowLANServices.Services:type_name -> ivpn.daemon.v1.LansvcServices
+	17,  // 1: ivpn.daemon.v1.KillSwitchSetLANInboundPorts.Ports:type_name -> ivpn.daemon.v1.LansvcInboundPort
+	23,  // 2: ivpn.daemon.v1.SetObfsProxy.ObfsproxyConfig:type_name -> ivpn.daemon.v1.ObfsproxyConfig
+	24,  // 3: ivpn.daemon.v1.ObfsproxyConfig.Obfs4Bridge:type_name -> ivpn.daemon.v1.ObfsproxyObfs4Bridge
+	27,  // 4: ivpn.daemon.v1.SetApiProxy.Config:type_name -> ivpn.daemon.v1.ApiTypesProxyConfig
+	29,  // 5: ivpn.daemon.v1.SetApiHostOverride.Config:type_name -> ivpn.daemon.v1.ApiTypesAPIHostOverride
+	32,  // 6: ivpn.daemon.v1.SetOpenVpnCryptoPolicy.Policy:type_name -> ivpn.daemon.v1.VpnOpenVpnCryptoPolicy
+	38,  // 7: ivpn.daemon.v1.SetLogRotation.Config:type_name -> ivpn.daemon.v1.LoggerRotationConfig
+	46,  // 8: ivpn.daemon.v1.SettingsImport.Settings:type_name -> ivpn.daemon.v1.PreferencesExportedSettings
+	47,  // 9: ivpn.daemon.v1.PreferencesExportedSettings.Firewall:type_name -> ivpn.daemon.v1.PreferencesExportedFirewallSettings
+	48,  // 10: ivpn.daemon.v1.PreferencesExportedSettings.ConnectionParams:type_name -> ivpn.daemon.v1.ServiceTypesConnectionParams
+	68,  // 11: ivpn.daemon.v1.PreferencesExportedSettings.ConnectionProfiles:type_name -> ivpn.daemon.v1.PreferencesConnectionProfile
+	69,  // 12: ivpn.daemon.v1.PreferencesExportedSettings.WiFiControl:type_name -> ivpn.daemon.v1.PreferencesWiFiParams
+	23,  // 13: ivpn.daemon.v1.PreferencesExportedSettings.Obfs4proxy:type_name -> ivpn.daemon.v1.ObfsproxyConfig
+	72,  // 14: ivpn.daemon.v1.PreferencesExportedSettings.ShadowsocksProxy:type_name -> ivpn.daemon.v1.ShadowsocksConfig
+	73,  // 15: ivpn.daemon.v1.PreferencesExportedSettings.AmneziaWG:type_name -> ivpn.daemon.v1.AwgConfig
+	27,  // 16: ivpn.daemon.v1.PreferencesExportedSettings.ApiProxy:type_name -> ivpn.daemon.v1.ApiTypesProxyConfig
+	32,  // 17: ivpn.daemon.v1.PreferencesExportedSettings.OpenVpnCryptoPolicy:type_name -> ivpn.daemon.v1.VpnOpenVpnCryptoPolicy
+	14,  // 18: ivpn.daemon.v1.PreferencesExportedFirewallSettings.AllowLANServices:type_name -> ivpn.daemon.v1.LansvcServices
+	17,  // 19: ivpn.daemon.v1.PreferencesExportedFirewallSettings.LANInboundPorts:type_name -> ivpn.daemon.v1.LansvcInboundPort
+	49,  // 20: ivpn.daemon.v1.ServiceTypesConnectionParams.Metadata:type_name -> ivpn.daemon.v1.ServiceTypesConnectMetadata
+	52,  // 21: ivpn.daemon.v1.ServiceTypesConnectionParams.ManualDNS:type_name -> ivpn.daemon.v1.DnsDnsSettings
+	53,  // 22: ivpn.daemon.v1.ServiceTypesConnectionParams.WireGuardParameters:type_name -> ivpn.daemon.v1.ServiceTypesConnectionParamsWireGuardParameters
+	59,  // 23: ivpn.daemon.v1.ServiceTypesConnectionParams.CustomConfig:type_name -> ivpn.daemon.v1.ServiceTypesCustomConfig
+	60,  // 24: ivpn.daemon.v1.ServiceTypesConnectionParams.OpenVpnParameters:type_name -> ivpn.daemon.v1.ServiceTypesConnectionParamsOpenVpnParameters
+	50,  // 25: ivpn.daemon.v1.ServiceTypesConnectMetadata.AntiTracker:type_name -> ivpn.daemon.v1.ServiceTypesAntiTrackerMetadata
+	51,  // 26: ivpn.daemon.v1.ServiceTypesConnectMetadata.FastestCriteria:type_name -> ivpn.daemon.v1.ServiceTypesFastestServerCriteria
+	54,  // 27: ivpn.daemon.v1.ServiceTypesConnectionParamsWireGuardParameters.Port:type_name -> ivpn.daemon.v1.ServiceTypesConnectionParamsWireGuardParametersPort
+	55,  // 28: ivpn.daemon.v1.ServiceTypesConnectionParamsWireGuardParameters.EntryVpnServer:type_name -> ivpn.daemon.v1.ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer
+	58,  // 29: ivpn.daemon.v1.ServiceTypesConnectionParamsWireGuardParameters.MultihopExitServer:type_name -> ivpn.daemon.v1.ServiceTypesMultiHopExitServer_WireGuard
+	56,  // 30: ivpn.daemon.v1.ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer.Hosts:type_name -> ivpn.daemon.v1.ApiTypesWireGuardServerHostInfo
+	57,  // 31: ivpn.daemon.v1.ApiTypesWireGuardServerHostInfo.ipv6:type_name -> ivpn.daemon.v1.ApiTypesWireGuardServerHostInfoIPv6
+	56,  // 32: ivpn.daemon.v1.ServiceTypesMultiHopExitServer_WireGuard.Hosts:type_name -> ivpn.daemon.v1.ApiTypesWireGuardServerHostInfo
+	61,  // 33: ivpn.daemon.v1.ServiceTypesConnectionParamsOpenVpnParameters.EntryVpnServer:type_name -> ivpn.daemon.v1.ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer
+	65,  // 34: ivpn.daemon.v1.ServiceTypesConnectionParamsOpenVpnParameters.MultihopExitServer:type_name -> ivpn.daemon.v1.ServiceTypesMultiHopExitServer_OpenVpn
+	66,  // 35: ivpn.daemon.v1.ServiceTypesConnectionParamsOpenVpnParameters.Proxy:type_name -> ivpn.daemon.v1.ServiceTypesOpenVpnProxy
+	67,  // 36: ivpn.daemon.v1.ServiceTypesConnectionParamsOpenVpnParameters.Port:type_name -> ivpn.daemon.v1.ServiceTypesConnectionParamsOpenVpnParametersPort
+	62,  // 37: ivpn.daemon.v1.ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer.Hosts:type_name -> ivpn.daemon.v1.ApiTypesOpenVPNServerHostInfo
+	63,  // 38: ivpn.daemon.v1.ApiTypesOpenVPNServerHostInfo.obfs:type_name -> ivpn.daemon.v1.ApiTypesObfsParams
+	64,  // 39: ivpn.daemon.v1.ApiTypesOpenVPNServerHostInfo.ipv6:type_name -> ivpn.daemon.v1.ApiTypesOpenVPNServerHostInfoIPv6
+	62,  // 40: ivpn.daemon.v1.ServiceTypesMultiHopExitServer_OpenVpn.Hosts:type_name -> ivpn.daemon.v1.ApiTypesOpenVPNServerHostInfo
+	48,  // 41: ivpn.daemon.v1.PreferencesConnectionProfile.Params:type_name -> ivpn.daemon.v1.ServiceTypesConnectionParams
+	23,  // 42: ivpn.daemon.v1.PreferencesConnectionProfile.Obfs4proxy:type_name -> ivpn.daemon.v1.ObfsproxyConfig
+	70,  // 43: ivpn.daemon.v1.PreferencesWiFiParams.networks:type_name -> ivpn.daemon.v1.PreferencesWiFiNetwork
+	71,  // 44: ivpn.daemon.v1.PreferencesWiFiParams.actions:type_name -> ivpn.daemon.v1.PreferencesWiFiActions
+	71,  // 45: ivpn.daemon.v1.PreferencesWiFiNetwork.actions:type_name -> ivpn.daemon.v1.PreferencesWiFiActions
+	72,  // 46: ivpn.daemon.v1.SetShadowsocksProxy.Config:type_name -> ivpn.daemon.v1.ShadowsocksConfig
+	73,  // 47: ivpn.daemon.v1.SetAmneziaWG.Config:type_name -> ivpn.daemon.v1.AwgConfig
+	77,  // 48: ivpn.daemon.v1.SetUserPreferences.UserPrefs:type_name -> ivpn.daemon.v1.PreferencesUserPreferences
+	78,  // 49: ivpn.daemon.v1.PreferencesUserPreferences.Linux:type_name -> ivpn.daemon.v1.PreferencesLinuxSpecificUserPrefs
+	82,  // 50: ivpn.daemon.v1.SplitTunnelSetDestinations.Destinations:type_name -> ivpn.daemon.v1.SplittunDestination
+	84,  // 51: ivpn.daemon.v1.SplitTunnelSetContainers.Containers:type_name -> ivpn.daemon.v1.SplittunContainer
+	86,  // 52: ivpn.daemon.v1.SplitTunnelSetUsers.Users:type_name -> ivpn.daemon.v1.SplittunUser
+	50,  // 53: ivpn.daemon.v1.SetAlternateDns.AntiTracker:type_name -> ivpn.daemon.v1.ServiceTypesAntiTrackerMetadata
+	52,  // 54: ivpn.daemon.v1.SetAlternateDns.Dns:type_name -> ivpn.daemon.v1.DnsDnsSettings
+	69,  // 55: ivpn.daemon.v1.WiFiSettings.Params:type_name -> ivpn.daemon.v1.PreferencesWiFiParams
+	116, // 56: ivpn.daemon.v1.ScheduleSettings.Params:type_name -> ivpn.daemon.v1.PreferencesScheduleParams
+	117, // 57: ivpn.daemon.v1.PreferencesScheduleParams.rules:type_name -> ivpn.daemon.v1.PreferencesScheduleRule
+	48,  // 58: ivpn.daemon.v1.ConnectSettings.Params:type_name -> ivpn.daemon.v1.ServiceTypesConnectionParams
+	48,  // 59: ivpn.daemon.v1.Connect.Params:type_name -> ivpn.daemon.v1.ServiceTypesConnectionParams
+	132, // 60: ivpn.daemon.v1.SpeedTestStart.Params:type_name -> ivpn.daemon.v1.SpeedtestParams
+	68,  // 61: ivpn.daemon.v1.ConnectionProfileSave.Profile:type_name -> ivpn.daemon.v1.PreferencesConnectionProfile
+	148, // 62: ivpn.daemon.v1.Response.ErrorResp:type_name -> ivpn.daemon.v1.ErrorResp
+	149, // 63: ivpn.daemon.v1.Response.ErrorRespDelayed:type_name -> ivpn.daemon.v1.ErrorRespDelayed
+	150, // 64: ivpn.daemon.v1.Response.EmptyResp:type_name -> ivpn.daemon.v1.EmptyResp
+	151, // 65: ivpn.daemon.v1.Response.ServiceExitingResp:type_name -> ivpn.daemon.v1.ServiceExitingResp
+	152, // 66: ivpn.daemon.v1.Response.SettingsResp:type_name -> ivpn.daemon.v1.SettingsResp
+	154, // 67: ivpn.daemon.v1.Response.HelloResp:type_name -> ivpn.daemon.v1.HelloResp
+	162, // 68: ivpn.daemon.v1.Response.SessionNewResp:type_name -> ivpn.daemon.v1.SessionNewResp
+	164, // 69: ivpn.daemon.v1.Response.AccountsResp:type_name -> ivpn.daemon.v1.AccountsResp
+	166, // 70: ivpn.daemon.v1.Response.DevicesResp:type_name -> ivpn.daemon.v1.DevicesResp
+	168, // 71: ivpn.daemon.v1.Response.AccountStatusResp:type_name -> ivpn.daemon.v1.AccountStatusResp
+	169, // 72: ivpn.daemon.v1.Response.KillSwitchStatusResp:type_name -> ivpn.daemon.v1.KillSwitchStatusResp
+	170, // 73: ivpn.daemon.v1.Response.KillSwitchGetIsPestistentResp:type_name -> ivpn.daemon.v1.KillSwitchGetIsPestistentResp
+	171, // 74: ivpn.daemon.v1.Response.DiagnosticsGeneratedResp:type_name -> ivpn.daemon.v1.DiagnosticsGeneratedResp
+	172, // 75: ivpn.daemon.v1.Response.SetAlternateDNSResp:type_name -> ivpn.daemon.v1.SetAlternateDNSResp
+	173, // 76: ivpn.daemon.v1.Response.DnsPredefinedConfigsResp:type_name -> ivpn.daemon.v1.DnsPredefinedConfigsResp
+	174, // 77: ivpn.daemon.v1.Response.ConnectedResp:type_name -> ivpn.daemon.v1.ConnectedResp
+	175, // 78: ivpn.daemon.v1.Response.DisconnectedResp:type_name -> ivpn.daemon.v1.DisconnectedResp
+	176, // 79: ivpn.daemon.v1.Response.ConnectionHistoryResp:type_name -> ivpn.daemon.v1.ConnectionHistoryResp
+	178, // 80: ivpn.daemon.v1.Response.ConnectionStatsHistoryResp:type_name -> ivpn.daemon.v1.ConnectionStatsHistoryResp
+	181, // 81: ivpn.daemon.v1.Response.ThroughputHistoryResp:type_name -> ivpn.daemon.v1.ThroughputHistoryResp
+	183, // 82: ivpn.daemon.v1.Response.AuditLogResp:type_name -> ivpn.daemon.v1.AuditLogResp
+	186, // 83: ivpn.daemon.v1.Response.SubsystemStatusResp:type_name -> ivpn.daemon.v1.SubsystemStatusResp
+	188, // 84: ivpn.daemon.v1.Response.PortForwardingStatusResp:type_name -> ivpn.daemon.v1.PortForwardingStatusResp
+	190, // 85: ivpn.daemon.v1.Response.DataUsageAlertResp:type_name -> ivpn.daemon.v1.DataUsageAlertResp
+	192, // 86: ivpn.daemon.v1.Response.ConnectionQualityResp:type_name -> ivpn.daemon.v1.ConnectionQualityResp
+	194, // 87: ivpn.daemon.v1.Response.ConnectionQualityHistoryResp:type_name -> ivpn.daemon.v1.ConnectionQualityHistoryResp
+	195, // 88: ivpn.daemon.v1.Response.CaptivePortalStatusResp:type_name -> ivpn.daemon.v1.CaptivePortalStatusResp
+	197, // 89: ivpn.daemon.v1.Response.ReloginRequiredResp:type_name -> ivpn.daemon.v1.ReloginRequiredResp
+	198, // 90: ivpn.daemon.v1.Response.ClockSkewResp:type_name -> ivpn.daemon.v1.ClockSkewResp
+	199, // 91: ivpn.daemon.v1.Response.ShellCommandsResp:type_name -> ivpn.daemon.v1.ShellCommandsResp
+	201, // 92: ivpn.daemon.v1.Response.CrashReportsResp:type_name -> ivpn.daemon.v1.CrashReportsResp
+	203, // 93: ivpn.daemon.v1.Response.CrashRecoveredResp:type_name -> ivpn.daemon.v1.CrashRecoveredResp
+	204, // 94: ivpn.daemon.v1.Response.OperationStatusResp:type_name -> ivpn.daemon.v1.OperationStatusResp
+	206, // 95: ivpn.daemon.v1.Response.OperationsListResp:type_name -> ivpn.daemon.v1.OperationsListResp
+	207, // 96: ivpn.daemon.v1.Response.ConnectionProfilesResp:type_name -> ivpn.daemon.v1.ConnectionProfilesResp
+	208, // 97: ivpn.daemon.v1.Response.RestApiResp:type_name -> ivpn.daemon.v1.RestApiResp
+	210, // 98: ivpn.daemon.v1.Response.GrpcApiResp:type_name -> ivpn.daemon.v1.GrpcApiResp
+	212, // 99: ivpn.daemon.v1.Response.LogLevelsResp:type_name -> ivpn.daemon.v1.LogLevelsResp
+	213, // 100: ivpn.daemon.v1.Response.ClientTokensResp:type_name -> ivpn.daemon.v1.ClientTokensResp
+	215, // 101: ivpn.daemon.v1.Response.SettingsExportResp:type_name -> ivpn.daemon.v1.SettingsExportResp
+	216, // 102: ivpn.daemon.v1.Response.SettingsImportResp:type_name -> ivpn.daemon.v1.SettingsImportResp
+	217, // 103: ivpn.daemon.v1.Response.HostsHealthResp:type_name -> ivpn.daemon.v1.HostsHealthResp
+	219, // 104: ivpn.daemon.v1.Response.VpnStateResp:type_name -> ivpn.daemon.v1.VpnStateResp
+	220, // 105: ivpn.daemon.v1.Response.ConnectionStatsResp:type_name -> ivpn.daemon.v1.ConnectionStatsResp
+	221, // 106: ivpn.daemon.v1.Response.LogMessageResp:type_name -> ivpn.daemon.v1.LogMessageResp
+	222, // 107: ivpn.daemon.v1.Response.ServerListResp:type_name -> ivpn.daemon.v1.ServerListResp
+	235, // 108: ivpn.daemon.v1.Response.PingServersResp:type_name -> ivpn.daemon.v1.PingServersResp
+	237, // 109: ivpn.daemon.v1.Response.WiFiAvailableNetworksResp:type_name -> ivpn.daemon.v1.WiFiAvailableNetworksResp
+	239, // 110: ivpn.daemon.v1.Response.WiFiCurrentNetworkResp:type_name -> ivpn.daemon.v1.WiFiCurrentNetworkResp
+	240, // 111: ivpn.daemon.v1.Response.APIResponse:type_name -> ivpn.daemon.v1.APIResponse
+	241, // 112: ivpn.daemon.v1.Response.InstalledAppsResp:type_name -> ivpn.daemon.v1.InstalledAppsResp
+	243, // 113: ivpn.daemon.v1.Response.AppIconResp:type_name -> ivpn.daemon.v1.AppIconResp
+	244, // 114: ivpn.daemon.v1.Response.SplitTunnelStatus:type_name -> ivpn.daemon.v1.SplitTunnelStatus
+	246, // 115: ivpn.daemon.v1.Response.SplitTunnelAddAppCmdResp:type_name -> ivpn.daemon.v1.SplitTunnelAddAppCmdResp
+	120, // 116: ivpn.daemon.v1.Response.ConnectSettings:type_name -> ivpn.daemon.v1.ConnectSettings
+	23,  // 117: ivpn.daemon.v1.SettingsResp.ObfsproxyConfig:type_name -> ivpn.daemon.v1.ObfsproxyConfig
+	72,  // 118: ivpn.daemon.v1.SettingsResp.ShadowsocksProxy:type_name -> ivpn.daemon.v1.ShadowsocksConfig
+	73,  // 119: ivpn.daemon.v1.SettingsResp.AmneziaWG:type_name -> ivpn.daemon.v1.AwgConfig
+	77,  // 120: ivpn.daemon.v1.SettingsResp.UserPrefs:type_name -> ivpn.daemon.v1.PreferencesUserPreferences
+	69,  // 121: ivpn.daemon.v1.SettingsResp.WiFi:type_name -> ivpn.daemon.v1.PreferencesWiFiParams
+	116, // 122: ivpn.daemon.v1.SettingsResp.Schedule:type_name -> ivpn.daemon.v1.PreferencesScheduleParams
+	153, // 123: ivpn.daemon.v1.SettingsResp.DataUsageAlert:type_name -> ivpn.daemon.v1.PreferencesDataUsageAlertParams
+	27,  // 124: ivpn.daemon.v1.SettingsResp.ApiProxy:type_name -> ivpn.daemon.v1.ApiTypesProxyConfig
+	29,  // 125: ivpn.daemon.v1.SettingsResp.ApiHostOverride:type_name -> ivpn.daemon.v1.ApiTypesAPIHostOverride
+	32,  // 126: ivpn.daemon.v1.SettingsResp.OpenVpnCryptoPolicy:type_name -> ivpn.daemon.v1.VpnOpenVpnCryptoPolicy
+	38,  // 127: ivpn.daemon.v1.SettingsResp.LogRotation:type_name -> ivpn.daemon.v1.LoggerRotationConfig
+	155, // 128: ivpn.daemon.v1.HelloResp.Session:type_name -> ivpn.daemon.v1.SessionResp
+	156, // 129: ivpn.daemon.v1.HelloResp.Account:type_name -> ivpn.daemon.v1.PreferencesAccountStatus
+	157, // 130: ivpn.daemon.v1.HelloResp.DisabledFunctions:type_name -> ivpn.daemon.v1.DisabledFunctionality
+	160, // 131: ivpn.daemon.v1.HelloResp.Dns:type_name -> ivpn.daemon.v1.DnsAbilities
+	161, // 132: ivpn.daemon.v1.HelloResp.ParanoidMode:type_name -> ivpn.daemon.v1.ParanoidModeStatus
+	152, // 133: ivpn.daemon.v1.HelloResp.DaemonSettings:type_name -> ivpn.daemon.v1.SettingsResp
+	158, // 134: ivpn.daemon.v1.DisabledFunctionality.Platform:type_name -> ivpn.daemon.v1.DisabledFunctionalityForPlatform
+	159, // 135: ivpn.daemon.v1.DisabledFunctionalityForPlatform.Linux:type_name -> ivpn.daemon.v1.DisabledFunctionalityLinux
+	155, // 136: ivpn.daemon.v1.SessionNewResp.Session:type_name -> ivpn.daemon.v1.SessionResp
+	156, // 137: ivpn.daemon.v1.SessionNewResp.Account:type_name -> ivpn.daemon.v1.PreferencesAccountStatus
+	163, // 138: ivpn.daemon.v1.SessionNewResp.Captcha:type_name -> ivpn.daemon.v1.ApiTypesCaptchaInfo
+	165, // 139: ivpn.daemon.v1.AccountsResp.Accounts:type_name -> ivpn.daemon.v1.AccountInfo
+	156, // 140: ivpn.daemon.v1.AccountInfo.Account:type_name -> ivpn.daemon.v1.PreferencesAccountStatus
+	167, // 141: ivpn.daemon.v1.DevicesResp.Devices:type_name -> ivpn.daemon.v1.ApiTypesDeviceInfo
+	156, // 142: ivpn.daemon.v1.AccountStatusResp.Account:type_name -> ivpn.daemon.v1.PreferencesAccountStatus
+	14,  // 143: ivpn.daemon.v1.KillSwitchStatusResp.AllowLANServices:type_name -> ivpn.daemon.v1.LansvcServices
+	17,  // 144: ivpn.daemon.v1.KillSwitchStatusResp.LANInboundPorts:type_name -> ivpn.daemon.v1.LansvcInboundPort
+	52,  // 145: ivpn.daemon.v1.SetAlternateDNSResp.ChangedDNS:type_name -> ivpn.daemon.v1.DnsDnsSettings
+	52,  // 146: ivpn.daemon.v1.DnsPredefinedConfigsResp.DnsConfigs:type_name -> ivpn.daemon.v1.DnsDnsSettings
+	52,  // 147: ivpn.daemon.v1.ConnectedResp.ManualDNS:type_name -> ivpn.daemon.v1.DnsDnsSettings
+	177, // 148: ivpn.daemon.v1.ConnectionHistoryResp.Items:type_name -> ivpn.daemon.v1.PreferencesConnectionHistoryItem
+	48,  // 149: ivpn.daemon.v1.PreferencesConnectionHistoryItem.Params:type_name -> ivpn.daemon.v1.ServiceTypesConnectionParams
+	179, // 150: ivpn.daemon.v1.ConnectionStatsHistoryResp.Sessions:type_name -> ivpn.daemon.v1.ConnstatsSessionRecord
+	180, // 151: ivpn.daemon.v1.ConnectionStatsHistoryResp.Days:type_name -> ivpn.daemon.v1.ConnstatsDayRecord
+	182, // 152: ivpn.daemon.v1.ThroughputHistoryResp.Samples:type_name -> ivpn.daemon.v1.ConnstatsThroughputSample
+	184, // 153: ivpn.daemon.v1.AuditLogResp.Events:type_name -> ivpn.daemon.v1.AuditlogEvent
+	185, // 154: ivpn.daemon.v1.AuditlogEvent.Actor:type_name -> ivpn.daemon.v1.AuditlogActor
+	187, // 155: ivpn.daemon.v1.SubsystemStatusResp.Subsystems:type_name -> ivpn.daemon.v1.SubsystemsStatus
+	189, // 156: ivpn.daemon.v1.PortForwardingStatusResp.State:type_name -> ivpn.daemon.v1.PortforwardingState
+	191, // 157: ivpn.daemon.v1.DataUsageAlertResp.Alert:type_name -> ivpn.daemon.v1.ConnstatsDataUsageAlert
+	193, // 158: ivpn.daemon.v1.ConnectionQualityResp.Report:type_name -> ivpn.daemon.v1.ConnstatsQualityReport
+	193, // 159: ivpn.daemon.v1.ConnectionQualityHistoryResp.Reports:type_name -> ivpn.daemon.v1.ConnstatsQualityReport
+	196, // 160: ivpn.daemon.v1.CaptivePortalStatusResp.Status:type_name -> ivpn.daemon.v1.CaptiveportalStatus
+	200, // 161: ivpn.daemon.v1.ShellCommandsResp.Commands:type_name -> ivpn.daemon.v1.ShellCommandRecord
+	202, // 162: ivpn.daemon.v1.CrashReportsResp.Reports:type_name -> ivpn.daemon.v1.CrashreportReport
+	202, // 163: ivpn.daemon.v1.CrashRecoveredResp.Report:type_name -> ivpn.daemon.v1.CrashreportReport
+	205, // 164: ivpn.daemon.v1.OperationStatusResp.Operation:type_name -> ivpn.daemon.v1.OperationsStatus
+	248, // 165: ivpn.daemon.v1.OperationsStatus.Result:type_name -> google.protobuf.Value
+	205, // 166: ivpn.daemon.v1.OperationsListResp.Operations:type_name -> ivpn.daemon.v1.OperationsStatus
+	68,  // 167: ivpn.daemon.v1.ConnectionProfilesResp.Profiles:type_name -> ivpn.daemon.v1.PreferencesConnectionProfile
+	209, // 168: ivpn.daemon.v1.RestApiResp.Params:type_name -> ivpn.daemon.v1.PreferencesRestApiParams
+	211, // 169: ivpn.daemon.v1.GrpcApiResp.Params:type_name -> ivpn.daemon.v1.PreferencesGrpcApiParams
+	247, // 170: ivpn.daemon.v1.LogLevelsResp.Modules:type_name -> ivpn.daemon.v1.LogLevelsResp.ModulesEntry
+	214, // 171: ivpn.daemon.v1.ClientTokensResp.Tokens:type_name -> ivpn.daemon.v1.PreferencesClientToken
+	46,  // 172: ivpn.daemon.v1.SettingsExportResp.Settings:type_name -> ivpn.daemon.v1.PreferencesExportedSettings
+	218, // 173: ivpn.daemon.v1.HostsHealthResp.Hosts:type_name -> ivpn.daemon.v1.HostshealthHostScore
+	223, // 174: ivpn.daemon.v1.ServerListResp.VpnServers:type_name -> ivpn.daemon.v1.ApiTypesServersInfoResponse
+	224, // 175: ivpn.daemon.v1.ApiTypesServersInfoResponse.wireguard:type_name -> ivpn.daemon.v1.ApiTypesWireGuardServerInfo
+	225, // 176: ivpn.daemon.v1.ApiTypesServersInfoResponse.openvpn:type_name -> ivpn.daemon.v1.ApiTypesOpenvpnServerInfo
+	226, // 177: ivpn.daemon.v1.ApiTypesServersInfoResponse.config:type_name -> ivpn.daemon.v1.ApiTypesConfigInfo
+	56,  // 178: ivpn.daemon.v1.ApiTypesWireGuardServerInfo.hosts:type_name -> ivpn.daemon.v1.ApiTypesWireGuardServerHostInfo
+	62,  // 179: ivpn.daemon.v1.ApiTypesOpenvpnServerInfo.hosts:type_name -> ivpn.daemon.v1.ApiTypesOpenVPNServerHostInfo
+	227, // 180: ivpn.daemon.v1.ApiTypesConfigInfo.antitracker:type_name -> ivpn.daemon.v1.ApiTypesAntitrackerInfo
+	229, // 181: ivpn.daemon.v1.ApiTypesConfigInfo.api:type_name -> ivpn.daemon.v1.ApiTypesInfoAPI
+	230, // 182: ivpn.daemon.v1.ApiTypesConfigInfo.ports:type_name -> ivpn.daemon.v1.ApiTypesPortsInfo
+	234, // 183: ivpn.daemon.v1.ApiTypesConfigInfo.v2ray:type_name -> ivpn.daemon.v1.ApiTypesV2RayInfo
+	228, // 184: ivpn.daemon.v1.ApiTypesAntitrackerInfo.default:type_name -> ivpn.daemon.v1.ApiTypesDNSInfo
+	228, // 185: ivpn.daemon.v1.ApiTypesAntitrackerInfo.hardcore:type_name -> ivpn.daemon.v1.ApiTypesDNSInfo
+	231, // 186: ivpn.daemon.v1.ApiTypesPortsInfo.openvpn:type_name -> ivpn.daemon.v1.ApiTypesPortInfo
+	231, // 187: ivpn.daemon.v1.ApiTypesPortsInfo.wireguard:type_name -> ivpn.daemon.v1.ApiTypesPortInfo
+	233, // 188: ivpn.daemon.v1.ApiTypesPortsInfo.obfs3:type_name -> ivpn.daemon.v1.ApiTypesObfsPortInfo
+	233, // 189: ivpn.daemon.v1.ApiTypesPortsInfo.obfs4:type_name -> ivpn.daemon.v1.ApiTypesObfsPortInfo
+	231, // 190: ivpn.daemon.v1.ApiTypesPortsInfo.v2ray:type_name -> ivpn.daemon.v1.ApiTypesPortInfo
+	231, // 191: ivpn.daemon.v1.ApiTypesPortsInfo.udp2tcp:type_name -> ivpn.daemon.v1.ApiTypesPortInfo
+	232, // 192: ivpn.daemon.v1.ApiTypesPortInfo.range:type_name -> ivpn.daemon.v1.ApiTypesPortRange
+	236, // 193: ivpn.daemon.v1.PingServersResp.PingResults:type_name -> ivpn.daemon.v1.PingResultType
+	238, // 194: ivpn.daemon.v1.WiFiAvailableNetworksResp.Networks:type_name -> ivpn.daemon.v1.WiFiNetworkInfo
+	242, // 195: ivpn.daemon.v1.InstalledAppsResp.Apps:type_name -> ivpn.daemon.v1.OshelpersAppInfo
+	245, // 196: ivpn.daemon.v1.SplitTunnelStatus.RunningApps:type_name -> ivpn.daemon.v1.SplittunRunningApp
+	82,  // 197: ivpn.daemon.v1.SplitTunnelStatus.Destinations:type_name -> ivpn.daemon.v1.SplittunDestination
+	84,  // 198: ivpn.daemon.v1.SplitTunnelStatus.Containers:type_name -> ivpn.daemon.v1.SplittunContainer
+	86,  // 199: ivpn.daemon.v1.SplitTunnelStatus.Users:type_name -> ivpn.daemon.v1.SplittunUser
+	0,   // 200: ivpn.daemon.v1.Daemon.EmptyReq:input_type -> ivpn.daemon.v1.EmptyReq
+	1,   // 201: ivpn.daemon.v1.Daemon.Hello:input_type -> ivpn.daemon.v1.Hello
+	2,   // 202: ivpn.daemon.v1.Daemon.SetNotificationsFilter:input_type -> ivpn.daemon.v1.SetNotificationsFilter
+	3,   // 203: ivpn.daemon.v1.Daemon.ParanoidModeSetPasswordReq:input_type -> ivpn.daemon.v1.ParanoidModeSetPasswordReq
+	4,   // 204: ivpn.daemon.v1.Daemon.GetVPNState:input_type -> ivpn.daemon.v1.GetVPNState
+	5,   // 205: ivpn.daemon.v1.Daemon.GetServers:input_type -> ivpn.daemon.v1.GetServers
+	6,   // 206: ivpn.daemon.v1.Daemon.PingServers:input_type -> ivpn.daemon.v1.PingServers
+	7,   // 207: ivpn.daemon.v1.Daemon.APIRequest:input_type -> ivpn.daemon.v1.APIRequest
+	8,   // 208: ivpn.daemon.v1.Daemon.WiFiAvailableNetworks:input_type -> ivpn.daemon.v1.WiFiAvailableNetworks
+	9,   // 209: ivpn.daemon.v1.Daemon.KillSwitchGetStatus:input_type -> ivpn.daemon.v1.KillSwitchGetStatus
+	10,  // 210: ivpn.daemon.v1.Daemon.KillSwitchSetEnabled:input_type -> ivpn.daemon.v1.KillSwitchSetEnabled
+	11,  // 211: ivpn.daemon.v1.Daemon.KillSwitchSetAllowLANMulticast:input_type -> ivpn.daemon.v1.KillSwitchSetAllowLANMulticast
+	12,  // 212: ivpn.daemon.v1.Daemon.KillSwitchSetAllowLAN:input_type -> ivpn.daemon.v1.KillSwitchSetAllowLAN
+	13,  // 213: ivpn.daemon.v1.Daemon.KillSwitchSetAllowLANServices:input_type -> ivpn.daemon.v1.KillSwitchSetAllowLANServices
+	15,  // 214: ivpn.daemon.v1.Daemon.KillSwitchSetAllowedLANHosts:input_type -> ivpn.daemon.v1.KillSwitchSetAllowedLANHosts
+	16,  // 215: ivpn.daemon.v1.Daemon.KillSwitchSetLANInboundPorts:input_type -> ivpn.daemon.v1.KillSwitchSetLANInboundPorts
+	18,  // 216: ivpn.daemon.v1.Daemon.KillSwitchSetUserExceptions:input_type -> ivpn.daemon.v1.KillSwitchSetUserExceptions
+	19,  // 217: ivpn.daemon.v1.Daemon.KillSwitchSetIsPersistent:input_type -> ivpn.daemon.v1.KillSwitchSetIsPersistent
+	20,  // 218: ivpn.daemon.v1.Daemon.KillSwitchSetAllowApiServers:input_type -> ivpn.daemon.v1.KillSwitchSetAllowApiServers
+	21,  // 219: ivpn.daemon.v1.Daemon.SetPreference:input_type -> ivpn.daemon.v1.SetPreference
+	22,  // 220: ivpn.daemon.v1.Daemon.SetObfsProxy:input_type -> ivpn.daemon.v1.SetObfsProxy
+	25,  // 221: ivpn.daemon.v1.Daemon.SetV2RayProxy:input_type -> ivpn.daemon.v1.SetV2RayProxy
+	26,  // 222: ivpn.daemon.v1.Daemon.SetApiProxy:input_type -> ivpn.daemon.v1.SetApiProxy
+	28,  // 223: ivpn.daemon.v1.Daemon.SetApiHostOverride:input_type -> ivpn.daemon.v1.SetApiHostOverride
+	30,  // 224: ivpn.daemon.v1.Daemon.SetOpenVpnExtraParameters:input_type -> ivpn.daemon.v1.SetOpenVpnExtraParameters
+	31,  // 225: ivpn.daemon.v1.Daemon.SetOpenVpnCryptoPolicy:input_type -> ivpn.daemon.v1.SetOpenVpnCryptoPolicy
+	33,  // 226: ivpn.daemon.v1.Daemon.RestApiGet:input_type -> ivpn.daemon.v1.RestApiGet
+	34,  // 227: ivpn.daemon.v1.Daemon.SetRestApi:input_type -> ivpn.daemon.v1.SetRestApi
+	35,  // 228: ivpn.daemon.v1.Daemon.GrpcApiGet:input_type -> ivpn.daemon.v1.GrpcApiGet
+	36,  // 229: ivpn.daemon.v1.Daemon.SetGrpcApi:input_type -> ivpn.daemon.v1.SetGrpcApi
+	37,  // 230: ivpn.daemon.v1.Daemon.SetLogRotation:input_type -> ivpn.daemon.v1.SetLogRotation
+	39,  // 231: ivpn.daemon.v1.Daemon.LogLevelsGet:input_type -> ivpn.daemon.v1.LogLevelsGet
+	40,  // 232: ivpn.daemon.v1.Daemon.SetLogLevel:input_type -> ivpn.daemon.v1.SetLogLevel
+	41,  // 233: ivpn.daemon.v1.Daemon.ClientTokensGet:input_type -> ivpn.daemon.v1.ClientTokensGet
+	42,  // 234: ivpn.daemon.v1.Daemon.ClientTokenAdd:input_type -> ivpn.daemon.v1.ClientTokenAdd
+	43,  // 235: ivpn.daemon.v1.Daemon.ClientTokenRemove:input_type -> ivpn.daemon.v1.ClientTokenRemove
+	44,  // 236: ivpn.daemon.v1.Daemon.SettingsExport:input_type -> ivpn.daemon.v1.SettingsExport
+	45,  // 237: ivpn.daemon.v1.Daemon.SettingsImport:input_type -> ivpn.daemon.v1.SettingsImport
+	74,  // 238: ivpn.daemon.v1.Daemon.SetShadowsocksProxy:input_type -> ivpn.daemon.v1.SetShadowsocksProxy
+	75,  // 239: ivpn.daemon.v1.Daemon.SetAmneziaWG:input_type -> ivpn.daemon.v1.SetAmneziaWG
+	76,  // 240: ivpn.daemon.v1.Daemon.SetUserPreferences:input_type -> ivpn.daemon.v1.SetUserPreferences
+	79,  // 241: ivpn.daemon.v1.Daemon.SplitTunnelGetStatus:input_type -> ivpn.daemon.v1.SplitTunnelGetStatus
+	80,  // 242: ivpn.daemon.v1.Daemon.SplitTunnelSetConfig:input_type -> ivpn.daemon.v1.SplitTunnelSetConfig
+	81,  // 243: ivpn.daemon.v1.Daemon.SplitTunnelSetDestinations:input_type -> ivpn.daemon.v1.SplitTunnelSetDestinations
+	83,  // 244: ivpn.daemon.v1.Daemon.SplitTunnelSetContainers:input_type -> ivpn.daemon.v1.SplitTunnelSetContainers
+	85,  // 245: ivpn.daemon.v1.Daemon.SplitTunnelSetUsers:input_type -> ivpn.daemon.v1.SplitTunnelSetUsers
+	87,  // 246: ivpn.daemon.v1.Daemon.SplitTunnelAddApp:input_type -> ivpn.daemon.v1.SplitTunnelAddApp
+	88,  // 247: ivpn.daemon.v1.Daemon.SplitTunnelRemoveApp:input_type -> ivpn.daemon.v1.SplitTunnelRemoveApp
+	89,  // 248: ivpn.daemon.v1.Daemon.SplitTunnelAddedPidInfo:input_type -> ivpn.daemon.v1.SplitTunnelAddedPidInfo
+	90,  // 249: ivpn.daemon.v1.Daemon.GenerateDiagnostics:input_type -> ivpn.daemon.v1.GenerateDiagnostics
+	91,  // 250: ivpn.daemon.v1.Daemon.PortForwardingGetStatus:input_type -> ivpn.daemon.v1.PortForwardingGetStatus
+	92,  // 251: ivpn.daemon.v1.Daemon.PortForwardingRequest:input_type -> ivpn.daemon.v1.PortForwardingRequest
+	93,  // 252: ivpn.daemon.v1.Daemon.PortForwardingRelease:input_type -> ivpn.daemon.v1.PortForwardingRelease
+	94,  // 253: ivpn.daemon.v1.Daemon.CaptivePortalCheck:input_type -> ivpn.daemon.v1.CaptivePortalCheck
+	95,  // 254: ivpn.daemon.v1.Daemon.CaptivePortalAllowLogin:input_type -> ivpn.daemon.v1.CaptivePortalAllowLogin
+	96,  // 255: ivpn.daemon.v1.Daemon.CaptivePortalReLock:input_type -> ivpn.daemon.v1.CaptivePortalReLock
+	97,  // 256: ivpn.daemon.v1.Daemon.SetAlternateDns:input_type -> ivpn.daemon.v1.SetAlternateDns
+	98,  // 257: ivpn.daemon.v1.Daemon.GetDnsPredefinedConfigs:input_type -> ivpn.daemon.v1.GetDnsPredefinedConfigs
+	99,  // 258: ivpn.daemon.v1.Daemon.PauseConnection:input_type -> ivpn.daemon.v1.PauseConnection
+	100, // 259: ivpn.daemon.v1.Daemon.ResumeConnection:input_type -> ivpn.daemon.v1.ResumeConnection
+	101, // 260: ivpn.daemon.v1.Daemon.SessionNew:input_type -> ivpn.daemon.v1.SessionNew
+	102, // 261: ivpn.daemon.v1.Daemon.SessionDelete:input_type -> ivpn.daemon.v1.SessionDelete
+	103, // 262: ivpn.daemon.v1.Daemon.DevicesList:input_type -> ivpn.daemon.v1.DevicesList
+	104, // 263: ivpn.daemon.v1.Daemon.DeviceLogout:input_type -> ivpn.daemon.v1.DeviceLogout
+	105, // 264: ivpn.daemon.v1.Daemon.AccountsGet:input_type -> ivpn.daemon.v1.AccountsGet
+	106, // 265: ivpn.daemon.v1.Daemon.AccountSwitch:input_type -> ivpn.daemon.v1.AccountSwitch
+	107, // 266: ivpn.daemon.v1.Daemon.AccountRemove:input_type -> ivpn.daemon.v1.AccountRemove
+	108, // 267: ivpn.daemon.v1.Daemon.AccountStatus:input_type -> ivpn.daemon.v1.AccountStatus
+	109, // 268: ivpn.daemon.v1.Daemon.WireGuardGenerateNewKeys:input_type -> ivpn.daemon.v1.WireGuardGenerateNewKeys
+	110, // 269: ivpn.daemon.v1.Daemon.WireGuardSetKeysRotationInterval:input_type -> ivpn.daemon.v1.WireGuardSetKeysRotationInterval
+	111, // 270: ivpn.daemon.v1.Daemon.GetAppIcon:input_type -> ivpn.daemon.v1.GetAppIcon
+	112, // 271: ivpn.daemon.v1.Daemon.GetInstalledApps:input_type -> ivpn.daemon.v1.GetInstalledApps
+	113, // 272: ivpn.daemon.v1.Daemon.WiFiCurrentNetwork:input_type -> ivpn.daemon.v1.WiFiCurrentNetwork
+	114, // 273: ivpn.daemon.v1.Daemon.WiFiSettings:input_type -> ivpn.daemon.v1.WiFiSettings
+	115, // 274: ivpn.daemon.v1.Daemon.ScheduleSettings:input_type -> ivpn.daemon.v1.ScheduleSettings
+	118, // 275: ivpn.daemon.v1.Daemon.Disconnect:input_type -> ivpn.daemon.v1.Disconnect
+	119, // 276: ivpn.daemon.v1.Daemon.ConnectSettingsGet:input_type -> ivpn.daemon.v1.ConnectSettingsGet
+	120, // 277: ivpn.daemon.v1.Daemon.ConnectSettings:input_type -> ivpn.daemon.v1.ConnectSettings
+	121, // 278: ivpn.daemon.v1.Daemon.Connect:input_type -> ivpn.daemon.v1.Connect
+	122, // 279: ivpn.daemon.v1.Daemon.ConnectionHistoryGet:input_type -> ivpn.daemon.v1.ConnectionHistoryGet
+	123, // 280: ivpn.daemon.v1.Daemon.AuditLogGet:input_type -> ivpn.daemon.v1.AuditLogGet
+	124, // 281: ivpn.daemon.v1.Daemon.LogStreamStart:input_type -> ivpn.daemon.v1.LogStreamStart
+	125, // 282: ivpn.daemon.v1.Daemon.LogStreamStop:input_type -> ivpn.daemon.v1.LogStreamStop
+	126, // 283: ivpn.daemon.v1.Daemon.ShellCommandsGet:input_type -> ivpn.daemon.v1.ShellCommandsGet
+	127, // 284: ivpn.daemon.v1.Daemon.CrashReportsGet:input_type -> ivpn.daemon.v1.CrashReportsGet
+	128, // 285: ivpn.daemon.v1.Daemon.CrashReportsClear:input_type -> ivpn.daemon.v1.CrashReportsClear
+	129, // 286: ivpn.daemon.v1.Daemon.GetSubsystemStatus:input_type -> ivpn.daemon.v1.GetSubsystemStatus
+	130, // 287: ivpn.daemon.v1.Daemon.OperationStart:input_type -> ivpn.daemon.v1.OperationStart
+	131, // 288: ivpn.daemon.v1.Daemon.SpeedTestStart:input_type -> ivpn.daemon.v1.SpeedTestStart
+	133, // 289: ivpn.daemon.v1.Daemon.OperationCancel:input_type -> ivpn.daemon.v1.OperationCancel
+	134, // 290: ivpn.daemon.v1.Daemon.OperationsGet:input_type -> ivpn.daemon.v1.OperationsGet
+	135, // 291: ivpn.daemon.v1.Daemon.HostsHealthGet:input_type -> ivpn.daemon.v1.HostsHealthGet
+	136, // 292: ivpn.daemon.v1.Daemon.ConnectionHistoryClear:input_type -> ivpn.daemon.v1.ConnectionHistoryClear
+	137, // 293: ivpn.daemon.v1.Daemon.ConnectionHistoryConnect:input_type -> ivpn.daemon.v1.ConnectionHistoryConnect
+	138, // 294: ivpn.daemon.v1.Daemon.ConnectionStatsHistoryGet:input_type -> ivpn.daemon.v1.ConnectionStatsHistoryGet
+	139, // 295: ivpn.daemon.v1.Daemon.ConnectionStatsHistoryClear:input_type -> ivpn.daemon.v1.ConnectionStatsHistoryClear
+	140, // 296: ivpn.daemon.v1.Daemon.ThroughputHistoryGet:input_type -> ivpn.daemon.v1.ThroughputHistoryGet
+	141, // 297: ivpn.daemon.v1.Daemon.ConnectionQualityHistoryGet:input_type -> ivpn.daemon.v1.ConnectionQualityHistoryGet
+	142, // 298: ivpn.daemon.v1.Daemon.ConnectionProfilesGet:input_type -> ivpn.daemon.v1.ConnectionProfilesGet
+	143, // 299: ivpn.daemon.v1.Daemon.ConnectionProfileSave:input_type -> ivpn.daemon.v1.ConnectionProfileSave
+	144, // 300: ivpn.daemon.v1.Daemon.ConnectionProfileRemove:input_type -> ivpn.daemon.v1.ConnectionProfileRemove
+	145, // 301: ivpn.daemon.v1.Daemon.ConnectionProfileConnect:input_type -> ivpn.daemon.v1.ConnectionProfileConnect
+	146, // 302: ivpn.daemon.v1.Daemon.Events:input_type -> ivpn.daemon.v1.EventsRequest
+	147, // 303: ivpn.daemon.v1.Daemon.EmptyReq:output_type -> ivpn.daemon.v1.Response
+	147, // 304: ivpn.daemon.v1.Daemon.Hello:output_type -> ivpn.daemon.v1.Response
+	147, // 305: ivpn.daemon.v1.Daemon.SetNotificationsFilter:output_type -> ivpn.daemon.v1.Response
+	147, // 306: ivpn.daemon.v1.Daemon.ParanoidModeSetPasswordReq:output_type -> ivpn.daemon.v1.Response
+	147, // 307: ivpn.daemon.v1.Daemon.GetVPNState:output_type -> ivpn.daemon.v1.Response
+	147, // 308: ivpn.daemon.v1.Daemon.GetServers:output_type -> ivpn.daemon.v1.Response
+	147, // 309: ivpn.daemon.v1.Daemon.PingServers:output_type -> ivpn.daemon.v1.Response
+	147, // 310: ivpn.daemon.v1.Daemon.APIRequest:output_type -> ivpn.daemon.v1.Response
+	147, // 311: ivpn.daemon.v1.Daemon.WiFiAvailableNetworks:output_type -> ivpn.daemon.v1.Response
+	147, // 312: ivpn.daemon.v1.Daemon.KillSwitchGetStatus:output_type -> ivpn.daemon.v1.Response
+	147, // 313: ivpn.daemon.v1.Daemon.KillSwitchSetEnabled:output_type -> ivpn.daemon.v1.Response
+	147, // 314: ivpn.daemon.v1.Daemon.KillSwitchSetAllowLANMulticast:output_type -> ivpn.daemon.v1.Response
+	147, // 315: ivpn.daemon.v1.Daemon.KillSwitchSetAllowLAN:output_type -> ivpn.daemon.v1.Response
+	147, // 316: ivpn.daemon.v1.Daemon.KillSwitchSetAllowLANServices:output_type -> ivpn.daemon.v1.Response
+	147, // 317: ivpn.daemon.v1.Daemon.KillSwitchSetAllowedLANHosts:output_type -> ivpn.daemon.v1.Response
+	147, // 318: ivpn.daemon.v1.Daemon.KillSwitchSetLANInboundPorts:output_type -> ivpn.daemon.v1.Response
+	147, // 319: ivpn.daemon.v1.Daemon.KillSwitchSetUserExceptions:output_type -> ivpn.daemon.v1.Response
+	147, // 320: ivpn.daemon.v1.Daemon.KillSwitchSetIsPersistent:output_type -> ivpn.daemon.v1.Response
+	147, // 321: ivpn.daemon.v1.Daemon.KillSwitchSetAllowApiServers:output_type -> ivpn.daemon.v1.Response
+	147, // 322: ivpn.daemon.v1.Daemon.SetPreference:output_type -> ivpn.daemon.v1.Response
+	147, // 323: ivpn.daemon.v1.Daemon.SetObfsProxy:output_type -> ivpn.daemon.v1.Response
+	147, // 324: ivpn.daemon.v1.Daemon.SetV2RayProxy:output_type -> ivpn.daemon.v1.Response
+	147, // 325: ivpn.daemon.v1.Daemon.SetApiProxy:output_type -> ivpn.daemon.v1.Response
+	147, // 326: ivpn.daemon.v1.Daemon.SetApiHostOverride:output_type -> ivpn.daemon.v1.Response
+	147, // 327: ivpn.daemon.v1.Daemon.SetOpenVpnExtraParameters:output_type -> ivpn.daemon.v1.Response
+	147, // 328: ivpn.daemon.v1.Daemon.SetOpenVpnCryptoPolicy:output_type -> ivpn.daemon.v1.Response
+	147, // 329: ivpn.daemon.v1.Daemon.RestApiGet:output_type -> ivpn.daemon.v1.Response
+	147, // 330: ivpn.daemon.v1.Daemon.SetRestApi:output_type -> ivpn.daemon.v1.Response
+	147, // 331: ivpn.daemon.v1.Daemon.GrpcApiGet:output_type -> ivpn.daemon.v1.Response
+	147, // 332: ivpn.daemon.v1.Daemon.SetGrpcApi:output_type -> ivpn.daemon.v1.Response
+	147, // 333: ivpn.daemon.v1.Daemon.SetLogRotation:output_type -> ivpn.daemon.v1.Response
+	147, // 334: ivpn.daemon.v1.Daemon.LogLevelsGet:output_type -> ivpn.daemon.v1.Response
+	147, // 335: ivpn.daemon.v1.Daemon.SetLogLevel:output_type -> ivpn.daemon.v1.Response
+	147, // 336: ivpn.daemon.v1.Daemon.ClientTokensGet:output_type -> ivpn.daemon.v1.Response
+	147, // 337: ivpn.daemon.v1.Daemon.ClientTokenAdd:output_type -> ivpn.daemon.v1.Response
+	147, // 338: ivpn.daemon.v1.Daemon.ClientTokenRemove:output_type -> ivpn.daemon.v1.Response
+	147, // 339: ivpn.daemon.v1.Daemon.SettingsExport:output_type -> ivpn.daemon.v1.Response
+	147, // 340: ivpn.daemon.v1.Daemon.SettingsImport:output_type -> ivpn.daemon.v1.Response
+	147, // 341: ivpn.daemon.v1.Daemon.SetShadowsocksProxy:output_type -> ivpn.daemon.v1.Response
+	147, // 342: ivpn.daemon.v1.Daemon.SetAmneziaWG:output_type -> ivpn.daemon.v1.Response
+	147, // 343: ivpn.daemon.v1.Daemon.SetUserPreferences:output_type -> ivpn.daemon.v1.Response
+	147, // 344: ivpn.daemon.v1.Daemon.SplitTunnelGetStatus:output_type -> ivpn.daemon.v1.Response
+	147, // 345: ivpn.daemon.v1.Daemon.SplitTunnelSetConfig:output_type -> ivpn.daemon.v1.Response
+	147, // 346: ivpn.daemon.v1.Daemon.SplitTunnelSetDestinations:output_type -> ivpn.daemon.v1.Response
+	147, // 347: ivpn.daemon.v1.Daemon.SplitTunnelSetContainers:output_type -> ivpn.daemon.v1.Response
+	147, // 348: ivpn.daemon.v1.Daemon.SplitTunnelSetUsers:output_type -> ivpn.daemon.v1.Response
+	147, // 349: ivpn.daemon.v1.Daemon.SplitTunnelAddApp:output_type -> ivpn.daemon.v1.Response
+	147, // 350: ivpn.daemon.v1.Daemon.SplitTunnelRemoveApp:output_type -> ivpn.daemon.v1.Response
+	147, // 351: ivpn.daemon.v1.Daemon.SplitTunnelAddedPidInfo:output_type -> ivpn.daemon.v1.Response
+	147, // 352: ivpn.daemon.v1.Daemon.GenerateDiagnostics:output_type -> ivpn.daemon.v1.Response
+	147, // 353: ivpn.daemon.v1.Daemon.PortForwardingGetStatus:output_type -> ivpn.daemon.v1.Response
+	147, // 354: ivpn.daemon.v1.Daemon.PortForwardingRequest:output_type -> ivpn.daemon.v1.Response
+	147, // 355: ivpn.daemon.v1.Daemon.PortForwardingRelease:output_type -> ivpn.daemon.v1.Response
+	147, // 356: ivpn.daemon.v1.Daemon.CaptivePortalCheck:output_type -> ivpn.daemon.v1.Response
+	147, // 357: ivpn.daemon.v1.Daemon.CaptivePortalAllowLogin:output_type -> ivpn.daemon.v1.Response
+	147, // 358: ivpn.daemon.v1.Daemon.CaptivePortalReLock:output_type -> ivpn.daemon.v1.Response
+	147, // 359: ivpn.daemon.v1.Daemon.SetAlternateDns:output_type -> ivpn.daemon.v1.Response
+	147, // 360: ivpn.daemon.v1.Daemon.GetDnsPredefinedConfigs:output_type -> ivpn.daemon.v1.Response
+	147, // 361: ivpn.daemon.v1.Daemon.PauseConnection:output_type -> ivpn.daemon.v1.Response
+	147, // 362: ivpn.daemon.v1.Daemon.ResumeConnection:output_type -> ivpn.daemon.v1.Response
+	147, // 363: ivpn.daemon.v1.Daemon.SessionNew:output_type -> ivpn.daemon.v1.Response
+	147, // 364: ivpn.daemon.v1.Daemon.SessionDelete:output_type -> ivpn.daemon.v1.Response
+	147, // 365: ivpn.daemon.v1.Daemon.DevicesList:output_type -> ivpn.daemon.v1.Response
+	147, // 366: ivpn.daemon.v1.Daemon.DeviceLogout:output_type -> ivpn.daemon.v1.Response
+	147, // 367: ivpn.daemon.v1.Daemon.AccountsGet:output_type -> ivpn.daemon.v1.Response
+	147, // 368: ivpn.daemon.v1.Daemon.AccountSwitch:output_type -> ivpn.daemon.v1.Response
+	147, // 369: ivpn.daemon.v1.Daemon.AccountRemove:output_type -> ivpn.daemon.v1.Response
+	147, // 370: ivpn.daemon.v1.Daemon.AccountStatus:output_type -> ivpn.daemon.v1.Response
+	147, // 371: ivpn.daemon.v1.Daemon.WireGuardGenerateNewKeys:output_type -> ivpn.daemon.v1.Response
+	147, // 372: ivpn.daemon.v1.Daemon.WireGuardSetKeysRotationInterval:output_type -> ivpn.daemon.v1.Response
+	147, // 373: ivpn.daemon.v1.Daemon.GetAppIcon:output_type -> ivpn.daemon.v1.Response
+	147, // 374: ivpn.daemon.v1.Daemon.GetInstalledApps:output_type -> ivpn.daemon.v1.Response
+	147, // 375: ivpn.daemon.v1.Daemon.WiFiCurrentNetwork:output_type -> ivpn.daemon.v1.Response
+	147, // 376: ivpn.daemon.v1.Daemon.WiFiSettings:output_type -> ivpn.daemon.v1.Response
+	147, // 377: ivpn.daemon.v1.Daemon.ScheduleSettings:output_type -> ivpn.daemon.v1.Response
+	147, // 378: ivpn.daemon.v1.Daemon.Disconnect:output_type -> ivpn.daemon.v1.Response
+	147, // 379: ivpn.daemon.v1.Daemon.ConnectSettingsGet:output_type -> ivpn.daemon.v1.Response
+	147, // 380: ivpn.daemon.v1.Daemon.ConnectSettings:output_type -> ivpn.daemon.v1.Response
+	147, // 381: ivpn.daemon.v1.Daemon.Connect:output_type -> ivpn.daemon.v1.Response
+	147, // 382: ivpn.daemon.v1.Daemon.ConnectionHistoryGet:output_type -> ivpn.daemon.v1.Response
+	147, // 383: ivpn.daemon.v1.Daemon.AuditLogGet:output_type -> ivpn.daemon.v1.Response
+	147, // 384: ivpn.daemon.v1.Daemon.LogStreamStart:output_type -> ivpn.daemon.v1.Response
+	147, // 385: ivpn.daemon.v1.Daemon.LogStreamStop:output_type -> ivpn.daemon.v1.Response
+	147, // 386: ivpn.daemon.v1.Daemon.ShellCommandsGet:output_type -> ivpn.daemon.v1.Response
+	147, // 387: ivpn.daemon.v1.Daemon.CrashReportsGet:output_type -> ivpn.daemon.v1.Response
+	147, // 388: ivpn.daemon.v1.Daemon.CrashReportsClear:output_type -> ivpn.daemon.v1.Response
+	147, // 389: ivpn.daemon.v1.Daemon.GetSubsystemStatus:output_type -> ivpn.daemon.v1.Response
+	147, // 390: ivpn.daemon.v1.Daemon.OperationStart:output_type -> ivpn.daemon.v1.Response
+	147, // 391: ivpn.daemon.v1.Daemon.SpeedTestStart:output_type -> ivpn.daemon.v1.Response
+	147, // 392: ivpn.daemon.v1.Daemon.OperationCancel:output_type -> ivpn.daemon.v1.Response
+	147, // 393: ivpn.daemon.v1.Daemon.OperationsGet:output_type -> ivpn.daemon.v1.Response
+	147, // 394: ivpn.daemon.v1.Daemon.HostsHealthGet:output_type -> ivpn.daemon.v1.Response
+	147, // 395: ivpn.daemon.v1.Daemon.ConnectionHistoryClear:output_type -> ivpn.daemon.v1.Response
+	147, // 396: ivpn.daemon.v1.Daemon.ConnectionHistoryConnect:output_type -> ivpn.daemon.v1.Response
+	147, // 397: ivpn.daemon.v1.Daemon.ConnectionStatsHistoryGet:output_type -> ivpn.daemon.v1.Response
+	147, // 398: ivpn.daemon.v1.Daemon.ConnectionStatsHistoryClear:output_type -> ivpn.daemon.v1.Response
+	147, // 399: ivpn.daemon.v1.Daemon.ThroughputHistoryGet:output_type -> ivpn.daemon.v1.Response
+	147, // 400: ivpn.daemon.v1.Daemon.ConnectionQualityHistoryGet:output_type -> ivpn.daemon.v1.Response
+	147, // 401: ivpn.daemon.v1.Daemon.ConnectionProfilesGet:output_type -> ivpn.daemon.v1.Response
+	147, // 402: ivpn.daemon.v1.Daemon.ConnectionProfileSave:output_type -> ivpn.daemon.v1.Response
+	147, // 403: ivpn.daemon.v1.Daemon.ConnectionProfileRemove:output_type -> ivpn.daemon.v1.Response
+	147, // 404: ivpn.daemon.v1.Daemon.ConnectionProfileConnect:output_type -> ivpn.daemon.v1.Response
+	147, // 405: ivpn.daemon.v1.Daemon.Events:output_type -> ivpn.daemon.v1.Response
+	303, // [303:406] is the sub-list for method output_type
+	200, // [200:303] is the sub-list for method input_type
+	200, // [200:200] is the sub-list for extension type_name
+	200, // [200:200] is the sub-list for extension extendee
+	0,   // [0:200] is the sub-list for field type_name
+}
+
+func init() { file_ivpn_proto_init() }
+func file_ivpn_proto_init() {
+	if File_ivpn_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ivpn_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Hello); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNotificationsFilter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParanoidModeSetPasswordReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVPNState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingServers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WiFiAvailableNetworks); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchGetStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchSetEnabled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchSetAllowLANMulticast); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchSetAllowLAN); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchSetAllowLANServices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LansvcServices); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchSetAllowedLANHosts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchSetLANInboundPorts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LansvcInboundPort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchSetUserExceptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchSetIsPersistent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchSetAllowApiServers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPreference); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetObfsProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObfsproxyConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ObfsproxyObfs4Bridge); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetV2RayProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetApiProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesProxyConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetApiHostOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesAPIHostOverride); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOpenVpnExtraParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetOpenVpnCryptoPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VpnOpenVpnCryptoPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestApiGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetRestApi); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcApiGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetGrpcApi); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogRotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoggerRotationConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLevelsGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientTokensGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientTokenAdd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientTokenRemove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettingsExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettingsImport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesExportedSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesExportedFirewallSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesConnectionParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesConnectMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesAntiTrackerMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesFastestServerCriteria); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DnsDnsSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesConnectionParamsWireGuardParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesConnectionParamsWireGuardParametersPort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesConnectionParamsWireGuardParametersEntryVpnServer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesWireGuardServerHostInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesWireGuardServerHostInfoIPv6); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesMultiHopExitServer_WireGuard); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesCustomConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesConnectionParamsOpenVpnParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesConnectionParamsOpenVpnParametersEntryVpnServer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesOpenVPNServerHostInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesObfsParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesOpenVPNServerHostInfoIPv6); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesMultiHopExitServer_OpenVpn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesOpenVpnProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceTypesConnectionParamsOpenVpnParametersPort); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesConnectionProfile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesWiFiParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesWiFiNetwork); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesWiFiActions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShadowsocksConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AwgConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetShadowsocksProxy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAmneziaWG); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetUserPreferences); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesUserPreferences); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesLinuxSpecificUserPrefs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnelGetStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnelSetConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnelSetDestinations); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplittunDestination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnelSetContainers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplittunContainer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnelSetUsers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplittunUser); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnelAddApp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnelRemoveApp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnelAddedPidInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateDiagnostics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortForwardingGetStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortForwardingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortForwardingRelease); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptivePortalCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptivePortalAllowLogin); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptivePortalReLock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAlternateDns); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDnsPredefinedConfigs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PauseConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResumeConnection); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionNew); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionDelete); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DevicesList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceLogout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountsGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountSwitch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountRemove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WireGuardGenerateNewKeys); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WireGuardSetKeysRotationInterval); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAppIcon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInstalledApps); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WiFiCurrentNetwork); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WiFiSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ScheduleSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesScheduleParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesScheduleRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Disconnect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectSettingsGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Connect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionHistoryGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogStreamStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogStreamStop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellCommandsGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrashReportsGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrashReportsClear); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSubsystemStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpeedTestStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpeedtestParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationCancel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationsGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostsHealthGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionHistoryClear); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionHistoryConnect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionStatsHistoryGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionStatsHistoryClear); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThroughputHistoryGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionQualityHistoryGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionProfilesGet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionProfileSave); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionProfileRemove); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionProfileConnect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Response); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ErrorRespDelayed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmptyResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceExitingResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettingsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesDataUsageAlertParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[154].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HelloResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[155].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[156].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesAccountStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[157].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisabledFunctionality); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[158].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisabledFunctionalityForPlatform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[159].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisabledFunctionalityLinux); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[160].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DnsAbilities); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[161].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ParanoidModeStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[162].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SessionNewResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[163].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesCaptchaInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[164].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[165].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[166].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DevicesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[167].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesDeviceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[168].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[169].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[170].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KillSwitchGetIsPestistentResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[171].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiagnosticsGeneratedResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[172].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetAlternateDNSResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[173].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DnsPredefinedConfigsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[174].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectedResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[175].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DisconnectedResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[176].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[177].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesConnectionHistoryItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[178].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionStatsHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[179].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnstatsSessionRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[180].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnstatsDayRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[181].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThroughputHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[182].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnstatsThroughputSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[183].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditLogResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[184].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditlogEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[185].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuditlogActor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[186].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubsystemStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[187].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubsystemsStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[188].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortForwardingStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[189].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PortforwardingState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[190].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DataUsageAlertResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[191].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnstatsDataUsageAlert); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[192].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionQualityResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[193].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnstatsQualityReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[194].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionQualityHistoryResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[195].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptivePortalStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[196].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CaptiveportalStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[197].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReloginRequiredResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[198].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClockSkewResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[199].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellCommandsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[200].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShellCommandRecord); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[201].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrashReportsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[202].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrashreportReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[203].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CrashRecoveredResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[204].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[205].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationsStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[206].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OperationsListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[207].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionProfilesResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[208].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestApiResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[209].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesRestApiParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[210].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GrpcApiResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[211].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesGrpcApiParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[212].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLevelsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[213].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientTokensResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[214].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PreferencesClientToken); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[215].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettingsExportResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[216].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SettingsImportResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[217].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostsHealthResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[218].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostshealthHostScore); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[219].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VpnStateResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[220].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectionStatsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[221].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogMessageResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[222].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServerListResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[223].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesServersInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[224].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesWireGuardServerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[225].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesOpenvpnServerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[226].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesConfigInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[227].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesAntitrackerInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[228].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesDNSInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[229].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesInfoAPI); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[230].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesPortsInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[231].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesPortInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[232].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesPortRange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[233].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesObfsPortInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[234].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApiTypesV2RayInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[235].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingServersResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[236].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingResultType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[237].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WiFiAvailableNetworksResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[238].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WiFiNetworkInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[239].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WiFiCurrentNetworkResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[240].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*APIResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[241].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InstalledAppsResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[242].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OshelpersAppInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[243].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AppIconResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[244].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnelStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[245].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplittunRunningApp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ivpn_proto_msgTypes[246].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitTunnelAddAppCmdResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_ivpn_proto_msgTypes[69].OneofWrappers = []interface{}{}
+	file_ivpn_proto_msgTypes[147].OneofWrappers = []interface{}{
+		(*Response_ErrorResp)(nil),
+		(*Response_ErrorRespDelayed)(nil),
+		(*Response_EmptyResp)(nil),
+		(*Response_ServiceExitingResp)(nil),
+		(*Response_SettingsResp)(nil),
+		(*Response_HelloResp)(nil),
+		(*Response_SessionNewResp)(nil),
+		(*Response_AccountsResp)(nil),
+		(*Response_DevicesResp)(nil),
+		(*Response_AccountStatusResp)(nil),
+		(*Response_KillSwitchStatusResp)(nil),
+		(*Response_KillSwitchGetIsPestistentResp)(nil),
+		(*Response_DiagnosticsGeneratedResp)(nil),
+		(*Response_SetAlternateDNSResp)(nil),
+		(*Response_DnsPredefinedConfigsResp)(nil),
+		(*Response_ConnectedResp)(nil),
+		(*Response_DisconnectedResp)(nil),
+		(*Response_ConnectionHistoryResp)(nil),
+		(*Response_ConnectionStatsHistoryResp)(nil),
+		(*Response_ThroughputHistoryResp)(nil),
+		(*Response_AuditLogResp)(nil),
+		(*Response_SubsystemStatusResp)(nil),
+		(*Response_PortForwardingStatusResp)(nil),
+		(*Response_DataUsageAlertResp)(nil),
+		(*Response_ConnectionQualityResp)(nil),
+		(*Response_ConnectionQualityHistoryResp)(nil),
+		(*Response_CaptivePortalStatusResp)(nil),
+		(*Response_ReloginRequiredResp)(nil),
+		(*Response_ClockSkewResp)(nil),
+		(*Response_ShellCommandsResp)(nil),
+		(*Response_CrashReportsResp)(nil),
+		(*Response_CrashRecoveredResp)(nil),
+		(*Response_OperationStatusResp)(nil),
+		(*Response_OperationsListResp)(nil),
+		(*Response_ConnectionProfilesResp)(nil),
+		(*Response_RestApiResp)(nil),
+		(*Response_GrpcApiResp)(nil),
+		(*Response_LogLevelsResp)(nil),
+		(*Response_ClientTokensResp)(nil),
+		(*Response_SettingsExportResp)(nil),
+		(*Response_SettingsImportResp)(nil),
+		(*Response_HostsHealthResp)(nil),
+		(*Response_VpnStateResp)(nil),
+		(*Response_ConnectionStatsResp)(nil),
+		(*Response_LogMessageResp)(nil),
+		(*Response_ServerListResp)(nil),
+		(*Response_PingServersResp)(nil),
+		(*Response_WiFiAvailableNetworksResp)(nil),
+		(*Response_WiFiCurrentNetworkResp)(nil),
+		(*Response_APIResponse)(nil),
+		(*Response_InstalledAppsResp)(nil),
+		(*Response_AppIconResp)(nil),
+		(*Response_SplitTunnelStatus)(nil),
+		(*Response_SplitTunnelAddAppCmdResp)(nil),
+		(*Response_ConnectSettings)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ivpn_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   248,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ivpn_proto_goTypes,
+		DependencyIndexes: file_ivpn_proto_depIdxs,
+		MessageInfos:      file_ivpn_proto_msgTypes,
+	}.Build()
+	File_ivpn_proto = out.File
+	file_ivpn_proto_rawDesc = nil
+	file_ivpn_proto_goTypes = nil
+	file_ivpn_proto_depIdxs = nil
+}
@@ -0,0 +1,3921 @@
+// Code generated by "go run ./gen"; DO NOT EDIT.
+//
+// The gRPC API of the Daemon for IVPN Client Desktop.
+// The messages mirror the requests and responses of the daemon protocol (daemon/protocol/types):
+// the field names are the same as the JSON keys of the daemon protocol.
+
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: ivpn.proto
+
+package grpcapi
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Daemon_EmptyReq_FullMethodName                         = "/ivpn.daemon.v1.Daemon/EmptyReq"
+	Daemon_Hello_FullMethodName                            = "/ivpn.daemon.v1.Daemon/Hello"
+	Daemon_SetNotificationsFilter_FullMethodName           = "/ivpn.daemon.v1.Daemon/SetNotificationsFilter"
+	Daemon_ParanoidModeSetPasswordReq_FullMethodName       = "/ivpn.daemon.v1.Daemon/ParanoidModeSetPasswordReq"
+	Daemon_GetVPNState_FullMethodName                      = "/ivpn.daemon.v1.Daemon/GetVPNState"
+	Daemon_GetServers_FullMethodName                       = "/ivpn.daemon.v1.Daemon/GetServers"
+	Daemon_PingServers_FullMethodName                      = "/ivpn.daemon.v1.Daemon/PingServers"
+	Daemon_APIRequest_FullMethodName                       = "/ivpn.daemon.v1.Daemon/APIRequest"
+	Daemon_WiFiAvailableNetworks_FullMethodName            = "/ivpn.daemon.v1.Daemon/WiFiAvailableNetworks"
+	Daemon_KillSwitchGetStatus_FullMethodName              = "/ivpn.daemon.v1.Daemon/KillSwitchGetStatus"
+	Daemon_KillSwitchSetEnabled_FullMethodName             = "/ivpn.daemon.v1.Daemon/KillSwitchSetEnabled"
+	Daemon_KillSwitchSetAllowLANMulticast_FullMethodName   = "/ivpn.daemon.v1.Daemon/KillSwitchSetAllowLANMulticast"
+	Daemon_KillSwitchSetAllowLAN_FullMethodName            = "/ivpn.daemon.v1.Daemon/KillSwitchSetAllowLAN"
+	Daemon_KillSwitchSetAllowLANServices_FullMethodName    = "/ivpn.daemon.v1.Daemon/KillSwitchSetAllowLANServices"
+	Daemon_KillSwitchSetAllowedLANHosts_FullMethodName     = "/ivpn.daemon.v1.Daemon/KillSwitchSetAllowedLANHosts"
+	Daemon_KillSwitchSetLANInboundPorts_FullMethodName     = "/ivpn.daemon.v1.Daemon/KillSwitchSetLANInboundPorts"
+	Daemon_KillSwitchSetUserExceptions_FullMethodName      = "/ivpn.daemon.v1.Daemon/KillSwitchSetUserExceptions"
+	Daemon_KillSwitchSetIsPersistent_FullMethodName        = "/ivpn.daemon.v1.Daemon/KillSwitchSetIsPersistent"
+	Daemon_KillSwitchSetAllowApiServers_FullMethodName     = "/ivpn.daemon.v1.Daemon/KillSwitchSetAllowApiServers"
+	Daemon_SetPreference_FullMethodName                    = "/ivpn.daemon.v1.Daemon/SetPreference"
+	Daemon_SetObfsProxy_FullMethodName                     = "/ivpn.daemon.v1.Daemon/SetObfsProxy"
+	Daemon_SetV2RayProxy_FullMethodName                    = "/ivpn.daemon.v1.Daemon/SetV2RayProxy"
+	Daemon_SetApiProxy_FullMethodName                      = "/ivpn.daemon.v1.Daemon/SetApiProxy"
+	Daemon_SetApiHostOverride_FullMethodName               = "/ivpn.daemon.v1.Daemon/SetApiHostOverride"
+	Daemon_SetOpenVpnExtraParameters_FullMethodName        = "/ivpn.daemon.v1.Daemon/SetOpenVpnExtraParameters"
+	Daemon_SetOpenVpnCryptoPolicy_FullMethodName           = "/ivpn.daemon.v1.Daemon/SetOpenVpnCryptoPolicy"
+	Daemon_RestApiGet_FullMethodName                       = "/ivpn.daemon.v1.Daemon/RestApiGet"
+	Daemon_SetRestApi_FullMethodName                       = "/ivpn.daemon.v1.Daemon/SetRestApi"
+	Daemon_GrpcApiGet_FullMethodName                       = "/ivpn.daemon.v1.Daemon/GrpcApiGet"
+	Daemon_SetGrpcApi_FullMethodName                       = "/ivpn.daemon.v1.Daemon/SetGrpcApi"
+	Daemon_SetLogRotation_FullMethodName                   = "/ivpn.daemon.v1.Daemon/SetLogRotation"
+	Daemon_LogLevelsGet_FullMethodName                     = "/ivpn.daemon.v1.Daemon/LogLevelsGet"
+	Daemon_SetLogLevel_FullMethodName                      = "/ivpn.daemon.v1.Daemon/SetLogLevel"
+	Daemon_ClientTokensGet_FullMethodName                  = "/ivpn.daemon.v1.Daemon/ClientTokensGet"
+	Daemon_ClientTokenAdd_FullMethodName                   = "/ivpn.daemon.v1.Daemon/ClientTokenAdd"
+	Daemon_ClientTokenRemove_FullMethodName                = "/ivpn.daemon.v1.Daemon/ClientTokenRemove"
+	Daemon_SettingsExport_FullMethodName                   = "/ivpn.daemon.v1.Daemon/SettingsExport"
+	Daemon_SettingsImport_FullMethodName                   = "/ivpn.daemon.v1.Daemon/SettingsImport"
+	Daemon_SetShadowsocksProxy_FullMethodName              = "/ivpn.daemon.v1.Daemon/SetShadowsocksProxy"
+	Daemon_SetAmneziaWG_FullMethodName                     = "/ivpn.daemon.v1.Daemon/SetAmneziaWG"
+	Daemon_SetUserPreferences_FullMethodName               = "/ivpn.daemon.v1.Daemon/SetUserPreferences"
+	Daemon_SplitTunnelGetStatus_FullMethodName             = "/ivpn.daemon.v1.Daemon/SplitTunnelGetStatus"
+	Daemon_SplitTunnelSetConfig_FullMethodName             = "/ivpn.daemon.v1.Daemon/SplitTunnelSetConfig"
+	Daemon_SplitTunnelSetDestinations_FullMethodName       = "/ivpn.daemon.v1.Daemon/SplitTunnelSetDestinations"
+	Daemon_SplitTunnelSetContainers_FullMethodName         = "/ivpn.daemon.v1.Daemon/SplitTunnelSetContainers"
+	Daemon_SplitTunnelSetUsers_FullMethodName              = "/ivpn.daemon.v1.Daemon/SplitTunnelSetUsers"
+	Daemon_SplitTunnelAddApp_FullMethodName                = "/ivpn.daemon.v1.Daemon/SplitTunnelAddApp"
+	Daemon_SplitTunnelRemoveApp_FullMethodName             = "/ivpn.daemon.v1.Daemon/SplitTunnelRemoveApp"
+	Daemon_SplitTunnelAddedPidInfo_FullMethodName          = "/ivpn.daemon.v1.Daemon/SplitTunnelAddedPidInfo"
+	Daemon_GenerateDiagnostics_FullMethodName              = "/ivpn.daemon.v1.Daemon/GenerateDiagnostics"
+	Daemon_PortForwardingGetStatus_FullMethodName          = "/ivpn.daemon.v1.Daemon/PortForwardingGetStatus"
+	Daemon_PortForwardingRequest_FullMethodName            = "/ivpn.daemon.v1.Daemon/PortForwardingRequest"
+	Daemon_PortForwardingRelease_FullMethodName            = "/ivpn.daemon.v1.Daemon/PortForwardingRelease"
+	Daemon_CaptivePortalCheck_FullMethodName               = "/ivpn.daemon.v1.Daemon/CaptivePortalCheck"
+	Daemon_CaptivePortalAllowLogin_FullMethodName          = "/ivpn.daemon.v1.Daemon/CaptivePortalAllowLogin"
+	Daemon_CaptivePortalReLock_FullMethodName              = "/ivpn.daemon.v1.Daemon/CaptivePortalReLock"
+	Daemon_SetAlternateDns_FullMethodName                  = "/ivpn.daemon.v1.Daemon/SetAlternateDns"
+	Daemon_GetDnsPredefinedConfigs_FullMethodName          = "/ivpn.daemon.v1.Daemon/GetDnsPredefinedConfigs"
+	Daemon_PauseConnection_FullMethodName                  = "/ivpn.daemon.v1.Daemon/PauseConnection"
+	Daemon_ResumeConnection_FullMethodName                 = "/ivpn.daemon.v1.Daemon/ResumeConnection"
+	Daemon_SessionNew_FullMethodName                       = "/ivpn.daemon.v1.Daemon/SessionNew"
+	Daemon_SessionDelete_FullMethodName                    = "/ivpn.daemon.v1.Daemon/SessionDelete"
+	Daemon_DevicesList_FullMethodName                      = "/ivpn.daemon.v1.Daemon/DevicesList"
+	Daemon_DeviceLogout_FullMethodName                     = "/ivpn.daemon.v1.Daemon/DeviceLogout"
+	Daemon_AccountsGet_FullMethodName                      = "/ivpn.daemon.v1.Daemon/AccountsGet"
+	Daemon_AccountSwitch_FullMethodName                    = "/ivpn.daemon.v1.Daemon/AccountSwitch"
+	Daemon_AccountRemove_FullMethodName                    = "/ivpn.daemon.v1.Daemon/AccountRemove"
+	Daemon_AccountStatus_FullMethodName                    = "/ivpn.daemon.v1.Daemon/AccountStatus"
+	Daemon_WireGuardGenerateNewKeys_FullMethodName         = "/ivpn.daemon.v1.Daemon/WireGuardGenerateNewKeys"
+	Daemon_WireGuardSetKeysRotationInterval_FullMethodName = "/ivpn.daemon.v1.Daemon/WireGuardSetKeysRotationInterval"
+	Daemon_GetAppIcon_FullMethodName                       = "/ivpn.daemon.v1.Daemon/GetAppIcon"
+	Daemon_GetInstalledApps_FullMethodName                 = "/ivpn.daemon.v1.Daemon/GetInstalledApps"
+	Daemon_WiFiCurrentNetwork_FullMethodName               = "/ivpn.daemon.v1.Daemon/WiFiCurrentNetwork"
+	Daemon_WiFiSettings_FullMethodName                     = "/ivpn.daemon.v1.Daemon/WiFiSettings"
+	Daemon_ScheduleSettings_FullMethodName                 = "/ivpn.daemon.v1.Daemon/ScheduleSettings"
+	Daemon_Disconnect_FullMethodName                       = "/ivpn.daemon.v1.Daemon/Disconnect"
+	Daemon_ConnectSettingsGet_FullMethodName               = "/ivpn.daemon.v1.Daemon/ConnectSettingsGet"
+	Daemon_ConnectSettings_FullMethodName                  = "/ivpn.daemon.v1.Daemon/ConnectSettings"
+	Daemon_Connect_FullMethodName                          = "/ivpn.daemon.v1.Daemon/Connect"
+	Daemon_ConnectionHistoryGet_FullMethodName             = "/ivpn.daemon.v1.Daemon/ConnectionHistoryGet"
+	Daemon_AuditLogGet_FullMethodName                      = "/ivpn.daemon.v1.Daemon/AuditLogGet"
+	Daemon_LogStreamStart_FullMethodName                   = "/ivpn.daemon.v1.Daemon/LogStreamStart"
+	Daemon_LogStreamStop_FullMethodName                    = "/ivpn.daemon.v1.Daemon/LogStreamStop"
+	Daemon_ShellCommandsGet_FullMethodName                 = "/ivpn.daemon.v1.Daemon/ShellCommandsGet"
+	Daemon_CrashReportsGet_FullMethodName                  = "/ivpn.daemon.v1.Daemon/CrashReportsGet"
+	Daemon_CrashReportsClear_FullMethodName                = "/ivpn.daemon.v1.Daemon/CrashReportsClear"
+	Daemon_GetSubsystemStatus_FullMethodName               = "/ivpn.daemon.v1.Daemon/GetSubsystemStatus"
+	Daemon_OperationStart_FullMethodName                   = "/ivpn.daemon.v1.Daemon/OperationStart"
+	Daemon_SpeedTestStart_FullMethodName                   = "/ivpn.daemon.v1.Daemon/SpeedTestStart"
+	Daemon_OperationCancel_FullMethodName                  = "/ivpn.daemon.v1.Daemon/OperationCancel"
+	Daemon_OperationsGet_FullMethodName                    = "/ivpn.daemon.v1.Daemon/OperationsGet"
+	Daemon_HostsHealthGet_FullMethodName                   = "/ivpn.daemon.v1.Daemon/HostsHealthGet"
+	Daemon_ConnectionHistoryClear_FullMethodName           = "/ivpn.daemon.v1.Daemon/ConnectionHistoryClear"
+	Daemon_ConnectionHistoryConnect_FullMethodName         = "/ivpn.daemon.v1.Daemon/ConnectionHistoryConnect"
+	Daemon_ConnectionStatsHistoryGet_FullMethodName        = "/ivpn.daemon.v1.Daemon/ConnectionStatsHistoryGet"
+	Daemon_ConnectionStatsHistoryClear_FullMethodName      = "/ivpn.daemon.v1.Daemon/ConnectionStatsHistoryClear"
+	Daemon_ThroughputHistoryGet_FullMethodName             = "/ivpn.daemon.v1.Daemon/ThroughputHistoryGet"
+	Daemon_ConnectionQualityHistoryGet_FullMethodName      = "/ivpn.daemon.v1.Daemon/ConnectionQualityHistoryGet"
+	Daemon_ConnectionProfilesGet_FullMethodName            = "/ivpn.daemon.v1.Daemon/ConnectionProfilesGet"
+	Daemon_ConnectionProfileSave_FullMethodName            = "/ivpn.daemon.v1.Daemon/ConnectionProfileSave"
+	Daemon_ConnectionProfileRemove_FullMethodName          = "/ivpn.daemon.v1.Daemon/ConnectionProfileRemove"
+	Daemon_ConnectionProfileConnect_FullMethodName         = "/ivpn.daemon.v1.Daemon/ConnectionProfileConnect"
+	Daemon_Events_FullMethodName                           = "/ivpn.daemon.v1.Daemon/Events"
+)
+
+// DaemonClient is the client API for Daemon service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DaemonClient interface {
+	EmptyReq(ctx context.Context, in *EmptyReq, opts ...grpc.CallOption) (*Response, error)
+	Hello(ctx context.Context, in *Hello, opts ...grpc.CallOption) (*Response, error)
+	SetNotificationsFilter(ctx context.Context, in *SetNotificationsFilter, opts ...grpc.CallOption) (*Response, error)
+	ParanoidModeSetPasswordReq(ctx context.Context, in *ParanoidModeSetPasswordReq, opts ...grpc.CallOption) (*Response, error)
+	GetVPNState(ctx context.Context, in *GetVPNState, opts ...grpc.CallOption) (*Response, error)
+	GetServers(ctx context.Context, in *GetServers, opts ...grpc.CallOption) (*Response, error)
+	PingServers(ctx context.Context, in *PingServers, opts ...grpc.CallOption) (*Response, error)
+	APIRequest(ctx context.Context, in *APIRequest, opts ...grpc.CallOption) (*Response, error)
+	WiFiAvailableNetworks(ctx context.Context, in *WiFiAvailableNetworks, opts ...grpc.CallOption) (*Response, error)
+	KillSwitchGetStatus(ctx context.Context, in *KillSwitchGetStatus, opts ...grpc.CallOption) (*Response, error)
+	KillSwitchSetEnabled(ctx context.Context, in *KillSwitchSetEnabled, opts ...grpc.CallOption) (*Response, error)
+	KillSwitchSetAllowLANMulticast(ctx context.Context, in *KillSwitchSetAllowLANMulticast, opts ...grpc.CallOption) (*Response, error)
+	KillSwitchSetAllowLAN(ctx context.Context, in *KillSwitchSetAllowLAN, opts ...grpc.CallOption) (*Response, error)
+	KillSwitchSetAllowLANServices(ctx context.Context, in *KillSwitchSetAllowLANServices, opts ...grpc.CallOption) (*Response, error)
+	KillSwitchSetAllowedLANHosts(ctx context.Context, in *KillSwitchSetAllowedLANHosts, opts ...grpc.CallOption) (*Response, error)
+	KillSwitchSetLANInboundPorts(ctx context.Context, in *KillSwitchSetLANInboundPorts, opts ...grpc.CallOption) (*Response, error)
+	KillSwitchSetUserExceptions(ctx context.Context, in *KillSwitchSetUserExceptions, opts ...grpc.CallOption) (*Response, error)
+	KillSwitchSetIsPersistent(ctx context.Context, in *KillSwitchSetIsPersistent, opts ...grpc.CallOption) (*Response, error)
+	KillSwitchSetAllowApiServers(ctx context.Context, in *KillSwitchSetAllowApiServers, opts ...grpc.CallOption) (*Response, error)
+	SetPreference(ctx context.Context, in *SetPreference, opts ...grpc.CallOption) (*Response, error)
+	SetObfsProxy(ctx context.Context, in *SetObfsProxy, opts ...grpc.CallOption) (*Response, error)
+	SetV2RayProxy(ctx context.Context, in *SetV2RayProxy, opts ...grpc.CallOption) (*Response, error)
+	SetApiProxy(ctx context.Context, in *SetApiProxy, opts ...grpc.CallOption) (*Response, error)
+	SetApiHostOverride(ctx context.Context, in *SetApiHostOverride, opts ...grpc.CallOption) (*Response, error)
+	SetOpenVpnExtraParameters(ctx context.Context, in *SetOpenVpnExtraParameters, opts ...grpc.CallOption) (*Response, error)
+	SetOpenVpnCryptoPolicy(ctx context.Context, in *SetOpenVpnCryptoPolicy, opts ...grpc.CallOption) (*Response, error)
+	RestApiGet(ctx context.Context, in *RestApiGet, opts ...grpc.CallOption) (*Response, error)
+	SetRestApi(ctx context.Context, in *SetRestApi, opts ...grpc.CallOption) (*Response, error)
+	GrpcApiGet(ctx context.Context, in *GrpcApiGet, opts ...grpc.CallOption) (*Response, error)
+	SetGrpcApi(ctx context.Context, in *SetGrpcApi, opts ...grpc.CallOption) (*Response, error)
+	SetLogRotation(ctx context.Context, in *SetLogRotation, opts ...grpc.CallOption) (*Response, error)
+	LogLevelsGet(ctx context.Context, in *LogLevelsGet, opts ...grpc.CallOption) (*Response, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevel, opts ...grpc.CallOption) (*Response, error)
+	ClientTokensGet(ctx context.Context, in *ClientTokensGet, opts ...grpc.CallOption) (*Response, error)
+	ClientTokenAdd(ctx context.Context, in *ClientTokenAdd, opts ...grpc.CallOption) (*Response, error)
+	ClientTokenRemove(ctx context.Context, in *ClientTokenRemove, opts ...grpc.CallOption) (*Response, error)
+	SettingsExport(ctx context.Context, in *SettingsExport, opts ...grpc.CallOption) (*Response, error)
+	SettingsImport(ctx context.Context, in *SettingsImport, opts ...grpc.CallOption) (*Response, error)
+	SetShadowsocksProxy(ctx context.Context, in *SetShadowsocksProxy, opts ...grpc.CallOption) (*Response, error)
+	SetAmneziaWG(ctx context.Context, in *SetAmneziaWG, opts ...grpc.CallOption) (*Response, error)
+	SetUserPreferences(ctx context.Context, in *SetUserPreferences, opts ...grpc.CallOption) (*Response, error)
+	SplitTunnelGetStatus(ctx context.Context, in *SplitTunnelGetStatus, opts ...grpc.CallOption) (*Response, error)
+	SplitTunnelSetConfig(ctx context.Context, in *SplitTunnelSetConfig, opts ...grpc.CallOption) (*Response, error)
+	SplitTunnelSetDestinations(ctx context.Context, in *SplitTunnelSetDestinations, opts ...grpc.CallOption) (*Response, error)
+	SplitTunnelSetContainers(ctx context.Context, in *SplitTunnelSetContainers, opts ...grpc.CallOption) (*Response, error)
+	SplitTunnelSetUsers(ctx context.Context, in *SplitTunnelSetUsers, opts ...grpc.CallOption) (*Response, error)
+	SplitTunnelAddApp(ctx context.Context, in *SplitTunnelAddApp, opts ...grpc.CallOption) (*Response, error)
+	SplitTunnelRemoveApp(ctx context.Context, in *SplitTunnelRemoveApp, opts ...grpc.CallOption) (*Response, error)
+	SplitTunnelAddedPidInfo(ctx context.Context, in *SplitTunnelAddedPidInfo, opts ...grpc.CallOption) (*Response, error)
+	GenerateDiagnostics(ctx context.Context, in *GenerateDiagnostics, opts ...grpc.CallOption) (*Response, error)
+	PortForwardingGetStatus(ctx context.Context, in *PortForwardingGetStatus, opts ...grpc.CallOption) (*Response, error)
+	PortForwardingRequest(ctx context.Context, in *PortForwardingRequest, opts ...grpc.CallOption) (*Response, error)
+	PortForwardingRelease(ctx context.Context, in *PortForwardingRelease, opts ...grpc.CallOption) (*Response, error)
+	CaptivePortalCheck(ctx context.Context, in *CaptivePortalCheck, opts ...grpc.CallOption) (*Response, error)
+	CaptivePortalAllowLogin(ctx context.Context, in *CaptivePortalAllowLogin, opts ...grpc.CallOption) (*Response, error)
+	CaptivePortalReLock(ctx context.Context, in *CaptivePortalReLock, opts ...grpc.CallOption) (*Response, error)
+	SetAlternateDns(ctx context.Context, in *SetAlternateDns, opts ...grpc.CallOption) (*Response, error)
+	GetDnsPredefinedConfigs(ctx context.Context, in *GetDnsPredefinedConfigs, opts ...grpc.CallOption) (*Response, error)
+	PauseConnection(ctx context.Context, in *PauseConnection, opts ...grpc.CallOption) (*Response, error)
+	ResumeConnection(ctx context.Context, in *ResumeConnection, opts ...grpc.CallOption) (*Response, error)
+	SessionNew(ctx context.Context, in *SessionNew, opts ...grpc.CallOption) (*Response, error)
+	SessionDelete(ctx context.Context, in *SessionDelete, opts ...grpc.CallOption) (*Response, error)
+	DevicesList(ctx context.Context, in *DevicesList, opts ...grpc.CallOption) (*Response, error)
+	DeviceLogout(ctx context.Context, in *DeviceLogout, opts ...grpc.CallOption) (*Response, error)
+	AccountsGet(ctx context.Context, in *AccountsGet, opts ...grpc.CallOption) (*Response, error)
+	AccountSwitch(ctx context.Context, in *AccountSwitch, opts ...grpc.CallOption) (*Response, error)
+	AccountRemove(ctx context.Context, in *AccountRemove, opts ...grpc.CallOption) (*Response, error)
+	AccountStatus(ctx context.Context, in *AccountStatus, opts ...grpc.CallOption) (*Response, error)
+	WireGuardGenerateNewKeys(ctx context.Context, in *WireGuardGenerateNewKeys, opts ...grpc.CallOption) (*Response, error)
+	WireGuardSetKeysRotationInterval(ctx context.Context, in *WireGuardSetKeysRotationInterval, opts ...grpc.CallOption) (*Response, error)
+	GetAppIcon(ctx context.Context, in *GetAppIcon, opts ...grpc.CallOption) (*Response, error)
+	GetInstalledApps(ctx context.Context, in *GetInstalledApps, opts ...grpc.CallOption) (*Response, error)
+	WiFiCurrentNetwork(ctx context.Context, in *WiFiCurrentNetwork, opts ...grpc.CallOption) (*Response, error)
+	WiFiSettings(ctx context.Context, in *WiFiSettings, opts ...grpc.CallOption) (*Response, error)
+	ScheduleSettings(ctx context.Context, in *ScheduleSettings, opts ...grpc.CallOption) (*Response, error)
+	Disconnect(ctx context.Context, in *Disconnect, opts ...grpc.CallOption) (*Response, error)
+	ConnectSettingsGet(ctx context.Context, in *ConnectSettingsGet, opts ...grpc.CallOption) (*Response, error)
+	ConnectSettings(ctx context.Context, in *ConnectSettings, opts ...grpc.CallOption) (*Response, error)
+	Connect(ctx context.Context, in *Connect, opts ...grpc.CallOption) (*Response, error)
+	ConnectionHistoryGet(ctx context.Context, in *ConnectionHistoryGet, opts ...grpc.CallOption) (*Response, error)
+	AuditLogGet(ctx context.Context, in *AuditLogGet, opts ...grpc.CallOption) (*Response, error)
+	LogStreamStart(ctx context.Context, in *LogStreamStart, opts ...grpc.CallOption) (*Response, error)
+	LogStreamStop(ctx context.Context, in *LogStreamStop, opts ...grpc.CallOption) (*Response, error)
+	ShellCommandsGet(ctx context.Context, in *ShellCommandsGet, opts ...grpc.CallOption) (*Response, error)
+	CrashReportsGet(ctx context.Context, in *CrashReportsGet, opts ...grpc.CallOption) (*Response, error)
+	CrashReportsClear(ctx context.Context, in *CrashReportsClear, opts ...grpc.CallOption) (*Response, error)
+	GetSubsystemStatus(ctx context.Context, in *GetSubsystemStatus, opts ...grpc.CallOption) (*Response, error)
+	OperationStart(ctx context.Context, in *OperationStart, opts ...grpc.CallOption) (*Response, error)
+	SpeedTestStart(ctx context.Context, in *SpeedTestStart, opts ...grpc.CallOption) (*Response, error)
+	OperationCancel(ctx context.Context, in *OperationCancel, opts ...grpc.CallOption) (*Response, error)
+	OperationsGet(ctx context.Context, in *OperationsGet, opts ...grpc.CallOption) (*Response, error)
+	HostsHealthGet(ctx context.Context, in *HostsHealthGet, opts ...grpc.CallOption) (*Response, error)
+	ConnectionHistoryClear(ctx context.Context, in *ConnectionHistoryClear, opts ...grpc.CallOption) (*Response, error)
+	ConnectionHistoryConnect(ctx context.Context, in *ConnectionHistoryConnect, opts ...grpc.CallOption) (*Response, error)
+	ConnectionStatsHistoryGet(ctx context.Context, in *ConnectionStatsHistoryGet, opts ...grpc.CallOption) (*Response, error)
+	ConnectionStatsHistoryClear(ctx context.Context, in *ConnectionStatsHistoryClear, opts ...grpc.CallOption) (*Response, error)
+	ThroughputHistoryGet(ctx context.Context, in *ThroughputHistoryGet, opts ...grpc.CallOption) (*Response, error)
+	ConnectionQualityHistoryGet(ctx context.Context, in *ConnectionQualityHistoryGet, opts ...grpc.CallOption) (*Response, error)
+	ConnectionProfilesGet(ctx context.Context, in *ConnectionProfilesGet, opts ...grpc.CallOption) (*Response, error)
+	ConnectionProfileSave(ctx context.Context, in *ConnectionProfileSave, opts ...grpc.CallOption) (*Response, error)
+	ConnectionProfileRemove(ctx context.Context, in *ConnectionProfileRemove, opts ...grpc.CallOption) (*Response, error)
+	ConnectionProfileConnect(ctx context.Context, in *ConnectionProfileConnect, opts ...grpc.CallOption) (*Response, error)
+	// Events - stream of the daemon events (the same notifications which are sent to the clients of the daemon protocol).
+	// Additional events: ConnectionStatsResp (periodically, when connected); LogMessageResp (when requested).
+	Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Daemon_EventsClient, error)
+}
+
+type daemonClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDaemonClient(cc grpc.ClientConnInterface) DaemonClient {
+	return &daemonClient{cc}
+}
+
+func (c *daemonClient) EmptyReq(ctx context.Context, in *EmptyReq, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_EmptyReq_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Hello(ctx context.Context, in *Hello, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_Hello_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetNotificationsFilter(ctx context.Context, in *SetNotificationsFilter, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetNotificationsFilter_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ParanoidModeSetPasswordReq(ctx context.Context, in *ParanoidModeSetPasswordReq, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ParanoidModeSetPasswordReq_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GetVPNState(ctx context.Context, in *GetVPNState, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_GetVPNState_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GetServers(ctx context.Context, in *GetServers, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_GetServers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) PingServers(ctx context.Context, in *PingServers, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_PingServers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) APIRequest(ctx context.Context, in *APIRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_APIRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) WiFiAvailableNetworks(ctx context.Context, in *WiFiAvailableNetworks, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_WiFiAvailableNetworks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) KillSwitchGetStatus(ctx context.Context, in *KillSwitchGetStatus, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_KillSwitchGetStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) KillSwitchSetEnabled(ctx context.Context, in *KillSwitchSetEnabled, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_KillSwitchSetEnabled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) KillSwitchSetAllowLANMulticast(ctx context.Context, in *KillSwitchSetAllowLANMulticast, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_KillSwitchSetAllowLANMulticast_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) KillSwitchSetAllowLAN(ctx context.Context, in *KillSwitchSetAllowLAN, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_KillSwitchSetAllowLAN_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) KillSwitchSetAllowLANServices(ctx context.Context, in *KillSwitchSetAllowLANServices, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_KillSwitchSetAllowLANServices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) KillSwitchSetAllowedLANHosts(ctx context.Context, in *KillSwitchSetAllowedLANHosts, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_KillSwitchSetAllowedLANHosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) KillSwitchSetLANInboundPorts(ctx context.Context, in *KillSwitchSetLANInboundPorts, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_KillSwitchSetLANInboundPorts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) KillSwitchSetUserExceptions(ctx context.Context, in *KillSwitchSetUserExceptions, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_KillSwitchSetUserExceptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) KillSwitchSetIsPersistent(ctx context.Context, in *KillSwitchSetIsPersistent, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_KillSwitchSetIsPersistent_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) KillSwitchSetAllowApiServers(ctx context.Context, in *KillSwitchSetAllowApiServers, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_KillSwitchSetAllowApiServers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetPreference(ctx context.Context, in *SetPreference, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetPreference_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetObfsProxy(ctx context.Context, in *SetObfsProxy, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetObfsProxy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetV2RayProxy(ctx context.Context, in *SetV2RayProxy, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetV2RayProxy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetApiProxy(ctx context.Context, in *SetApiProxy, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetApiProxy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetApiHostOverride(ctx context.Context, in *SetApiHostOverride, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetApiHostOverride_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetOpenVpnExtraParameters(ctx context.Context, in *SetOpenVpnExtraParameters, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetOpenVpnExtraParameters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetOpenVpnCryptoPolicy(ctx context.Context, in *SetOpenVpnCryptoPolicy, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetOpenVpnCryptoPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) RestApiGet(ctx context.Context, in *RestApiGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_RestApiGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetRestApi(ctx context.Context, in *SetRestApi, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetRestApi_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GrpcApiGet(ctx context.Context, in *GrpcApiGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_GrpcApiGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetGrpcApi(ctx context.Context, in *SetGrpcApi, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetGrpcApi_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetLogRotation(ctx context.Context, in *SetLogRotation, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetLogRotation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) LogLevelsGet(ctx context.Context, in *LogLevelsGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_LogLevelsGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetLogLevel(ctx context.Context, in *SetLogLevel, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ClientTokensGet(ctx context.Context, in *ClientTokensGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ClientTokensGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ClientTokenAdd(ctx context.Context, in *ClientTokenAdd, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ClientTokenAdd_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ClientTokenRemove(ctx context.Context, in *ClientTokenRemove, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ClientTokenRemove_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SettingsExport(ctx context.Context, in *SettingsExport, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SettingsExport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SettingsImport(ctx context.Context, in *SettingsImport, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SettingsImport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetShadowsocksProxy(ctx context.Context, in *SetShadowsocksProxy, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetShadowsocksProxy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetAmneziaWG(ctx context.Context, in *SetAmneziaWG, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetAmneziaWG_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetUserPreferences(ctx context.Context, in *SetUserPreferences, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetUserPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SplitTunnelGetStatus(ctx context.Context, in *SplitTunnelGetStatus, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SplitTunnelGetStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SplitTunnelSetConfig(ctx context.Context, in *SplitTunnelSetConfig, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SplitTunnelSetConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SplitTunnelSetDestinations(ctx context.Context, in *SplitTunnelSetDestinations, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SplitTunnelSetDestinations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SplitTunnelSetContainers(ctx context.Context, in *SplitTunnelSetContainers, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SplitTunnelSetContainers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SplitTunnelSetUsers(ctx context.Context, in *SplitTunnelSetUsers, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SplitTunnelSetUsers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SplitTunnelAddApp(ctx context.Context, in *SplitTunnelAddApp, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SplitTunnelAddApp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SplitTunnelRemoveApp(ctx context.Context, in *SplitTunnelRemoveApp, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SplitTunnelRemoveApp_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SplitTunnelAddedPidInfo(ctx context.Context, in *SplitTunnelAddedPidInfo, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SplitTunnelAddedPidInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GenerateDiagnostics(ctx context.Context, in *GenerateDiagnostics, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_GenerateDiagnostics_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) PortForwardingGetStatus(ctx context.Context, in *PortForwardingGetStatus, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_PortForwardingGetStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) PortForwardingRequest(ctx context.Context, in *PortForwardingRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_PortForwardingRequest_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) PortForwardingRelease(ctx context.Context, in *PortForwardingRelease, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_PortForwardingRelease_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CaptivePortalCheck(ctx context.Context, in *CaptivePortalCheck, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_CaptivePortalCheck_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CaptivePortalAllowLogin(ctx context.Context, in *CaptivePortalAllowLogin, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_CaptivePortalAllowLogin_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CaptivePortalReLock(ctx context.Context, in *CaptivePortalReLock, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_CaptivePortalReLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SetAlternateDns(ctx context.Context, in *SetAlternateDns, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SetAlternateDns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GetDnsPredefinedConfigs(ctx context.Context, in *GetDnsPredefinedConfigs, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_GetDnsPredefinedConfigs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) PauseConnection(ctx context.Context, in *PauseConnection, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_PauseConnection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ResumeConnection(ctx context.Context, in *ResumeConnection, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ResumeConnection_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SessionNew(ctx context.Context, in *SessionNew, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SessionNew_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SessionDelete(ctx context.Context, in *SessionDelete, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SessionDelete_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) DevicesList(ctx context.Context, in *DevicesList, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_DevicesList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) DeviceLogout(ctx context.Context, in *DeviceLogout, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_DeviceLogout_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) AccountsGet(ctx context.Context, in *AccountsGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_AccountsGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) AccountSwitch(ctx context.Context, in *AccountSwitch, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_AccountSwitch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) AccountRemove(ctx context.Context, in *AccountRemove, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_AccountRemove_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) AccountStatus(ctx context.Context, in *AccountStatus, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_AccountStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) WireGuardGenerateNewKeys(ctx context.Context, in *WireGuardGenerateNewKeys, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_WireGuardGenerateNewKeys_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) WireGuardSetKeysRotationInterval(ctx context.Context, in *WireGuardSetKeysRotationInterval, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_WireGuardSetKeysRotationInterval_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GetAppIcon(ctx context.Context, in *GetAppIcon, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_GetAppIcon_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GetInstalledApps(ctx context.Context, in *GetInstalledApps, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_GetInstalledApps_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) WiFiCurrentNetwork(ctx context.Context, in *WiFiCurrentNetwork, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_WiFiCurrentNetwork_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) WiFiSettings(ctx context.Context, in *WiFiSettings, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_WiFiSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ScheduleSettings(ctx context.Context, in *ScheduleSettings, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ScheduleSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Disconnect(ctx context.Context, in *Disconnect, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_Disconnect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectSettingsGet(ctx context.Context, in *ConnectSettingsGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectSettingsGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectSettings(ctx context.Context, in *ConnectSettings, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectSettings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Connect(ctx context.Context, in *Connect, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_Connect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionHistoryGet(ctx context.Context, in *ConnectionHistoryGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectionHistoryGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) AuditLogGet(ctx context.Context, in *AuditLogGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_AuditLogGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) LogStreamStart(ctx context.Context, in *LogStreamStart, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_LogStreamStart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) LogStreamStop(ctx context.Context, in *LogStreamStop, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_LogStreamStop_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ShellCommandsGet(ctx context.Context, in *ShellCommandsGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ShellCommandsGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CrashReportsGet(ctx context.Context, in *CrashReportsGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_CrashReportsGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) CrashReportsClear(ctx context.Context, in *CrashReportsClear, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_CrashReportsClear_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) GetSubsystemStatus(ctx context.Context, in *GetSubsystemStatus, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_GetSubsystemStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) OperationStart(ctx context.Context, in *OperationStart, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_OperationStart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) SpeedTestStart(ctx context.Context, in *SpeedTestStart, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_SpeedTestStart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) OperationCancel(ctx context.Context, in *OperationCancel, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_OperationCancel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) OperationsGet(ctx context.Context, in *OperationsGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_OperationsGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) HostsHealthGet(ctx context.Context, in *HostsHealthGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_HostsHealthGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionHistoryClear(ctx context.Context, in *ConnectionHistoryClear, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectionHistoryClear_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionHistoryConnect(ctx context.Context, in *ConnectionHistoryConnect, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectionHistoryConnect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionStatsHistoryGet(ctx context.Context, in *ConnectionStatsHistoryGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectionStatsHistoryGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionStatsHistoryClear(ctx context.Context, in *ConnectionStatsHistoryClear, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectionStatsHistoryClear_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ThroughputHistoryGet(ctx context.Context, in *ThroughputHistoryGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ThroughputHistoryGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionQualityHistoryGet(ctx context.Context, in *ConnectionQualityHistoryGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectionQualityHistoryGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionProfilesGet(ctx context.Context, in *ConnectionProfilesGet, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectionProfilesGet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionProfileSave(ctx context.Context, in *ConnectionProfileSave, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectionProfileSave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionProfileRemove(ctx context.Context, in *ConnectionProfileRemove, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectionProfileRemove_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) ConnectionProfileConnect(ctx context.Context, in *ConnectionProfileConnect, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Daemon_ConnectionProfileConnect_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *daemonClient) Events(ctx context.Context, in *EventsRequest, opts ...grpc.CallOption) (Daemon_EventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Daemon_ServiceDesc.Streams[0], Daemon_Events_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &daemonEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Daemon_EventsClient interface {
+	Recv() (*Response, error)
+	grpc.ClientStream
+}
+
+type daemonEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *daemonEventsClient) Recv() (*Response, error) {
+	m := new(Response)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// DaemonServer is the server API for Daemon service.
+// All implementations must embed UnimplementedDaemonServer
+// for forward compatibility
+type DaemonServer interface {
+	EmptyReq(context.Context, *EmptyReq) (*Response, error)
+	Hello(context.Context, *Hello) (*Response, error)
+	SetNotificationsFilter(context.Context, *SetNotificationsFilter) (*Response, error)
+	ParanoidModeSetPasswordReq(context.Context, *ParanoidModeSetPasswordReq) (*Response, error)
+	GetVPNState(context.Context, *GetVPNState) (*Response, error)
+	GetServers(context.Context, *GetServers) (*Response, error)
+	PingServers(context.Context, *PingServers) (*Response, error)
+	APIRequest(context.Context, *APIRequest) (*Response, error)
+	WiFiAvailableNetworks(context.Context, *WiFiAvailableNetworks) (*Response, error)
+	KillSwitchGetStatus(context.Context, *KillSwitchGetStatus) (*Response, error)
+	KillSwitchSetEnabled(context.Context, *KillSwitchSetEnabled) (*Response, error)
+	KillSwitchSetAllowLANMulticast(context.Context, *KillSwitchSetAllowLANMulticast) (*Response, error)
+	KillSwitchSetAllowLAN(context.Context, *KillSwitchSetAllowLAN) (*Response, error)
+	KillSwitchSetAllowLANServices(context.Context, *KillSwitchSetAllowLANServices) (*Response, error)
+	KillSwitchSetAllowedLANHosts(context.Context, *KillSwitchSetAllowedLANHosts) (*Response, error)
+	KillSwitchSetLANInboundPorts(context.Context, *KillSwitchSetLANInboundPorts) (*Response, error)
+	KillSwitchSetUserExceptions(context.Context, *KillSwitchSetUserExceptions) (*Response, error)
+	KillSwitchSetIsPersistent(context.Context, *KillSwitchSetIsPersistent) (*Response, error)
+	KillSwitchSetAllowApiServers(context.Context, *KillSwitchSetAllowApiServers) (*Response, error)
+	SetPreference(context.Context, *SetPreference) (*Response, error)
+	SetObfsProxy(context.Context, *SetObfsProxy) (*Response, error)
+	SetV2RayProxy(context.Context, *SetV2RayProxy) (*Response, error)
+	SetApiProxy(context.Context, *SetApiProxy) (*Response, error)
+	SetApiHostOverride(context.Context, *SetApiHostOverride) (*Response, error)
+	SetOpenVpnExtraParameters(context.Context, *SetOpenVpnExtraParameters) (*Response, error)
+	SetOpenVpnCryptoPolicy(context.Context, *SetOpenVpnCryptoPolicy) (*Response, error)
+	RestApiGet(context.Context, *RestApiGet) (*Response, error)
+	SetRestApi(context.Context, *SetRestApi) (*Response, error)
+	GrpcApiGet(context.Context, *GrpcApiGet) (*Response, error)
+	SetGrpcApi(context.Context, *SetGrpcApi) (*Response, error)
+	SetLogRotation(context.Context, *SetLogRotation) (*Response, error)
+	LogLevelsGet(context.Context, *LogLevelsGet) (*Response, error)
+	SetLogLevel(context.Context, *SetLogLevel) (*Response, error)
+	ClientTokensGet(context.Context, *ClientTokensGet) (*Response, error)
+	ClientTokenAdd(context.Context, *ClientTokenAdd) (*Response, error)
+	ClientTokenRemove(context.Context, *ClientTokenRemove) (*Response, error)
+	SettingsExport(context.Context, *SettingsExport) (*Response, error)
+	SettingsImport(context.Context, *SettingsImport) (*Response, error)
+	SetShadowsocksProxy(context.Context, *SetShadowsocksProxy) (*Response, error)
+	SetAmneziaWG(context.Context, *SetAmneziaWG) (*Response, error)
+	SetUserPreferences(context.Context, *SetUserPreferences) (*Response, error)
+	SplitTunnelGetStatus(context.Context, *SplitTunnelGetStatus) (*Response, error)
+	SplitTunnelSetConfig(context.Context, *SplitTunnelSetConfig) (*Response, error)
+	SplitTunnelSetDestinations(context.Context, *SplitTunnelSetDestinations) (*Response, error)
+	SplitTunnelSetContainers(context.Context, *SplitTunnelSetContainers) (*Response, error)
+	SplitTunnelSetUsers(context.Context, *SplitTunnelSetUsers) (*Response, error)
+	SplitTunnelAddApp(context.Context, *SplitTunnelAddApp) (*Response, error)
+	SplitTunnelRemoveApp(context.Context, *SplitTunnelRemoveApp) (*Response, error)
+	SplitTunnelAddedPidInfo(context.Context, *SplitTunnelAddedPidInfo) (*Response, error)
+	GenerateDiagnostics(context.Context, *GenerateDiagnostics) (*Response, error)
+	PortForwardingGetStatus(context.Context, *PortForwardingGetStatus) (*Response, error)
+	PortForwardingRequest(context.Context, *PortForwardingRequest) (*Response, error)
+	PortForwardingRelease(context.Context, *PortForwardingRelease) (*Response, error)
+	CaptivePortalCheck(context.Context, *CaptivePortalCheck) (*Response, error)
+	CaptivePortalAllowLogin(context.Context, *CaptivePortalAllowLogin) (*Response, error)
+	CaptivePortalReLock(context.Context, *CaptivePortalReLock) (*Response, error)
+	SetAlternateDns(context.Context, *SetAlternateDns) (*Response, error)
+	GetDnsPredefinedConfigs(context.Context, *GetDnsPredefinedConfigs) (*Response, error)
+	PauseConnection(context.Context, *PauseConnection) (*Response, error)
+	ResumeConnection(context.Context, *ResumeConnection) (*Response, error)
+	SessionNew(context.Context, *SessionNew) (*Response, error)
+	SessionDelete(context.Context, *SessionDelete) (*Response, error)
+	DevicesList(context.Context, *DevicesList) (*Response, error)
+	DeviceLogout(context.Context, *DeviceLogout) (*Response, error)
+	AccountsGet(context.Context, *AccountsGet) (*Response, error)
+	AccountSwitch(context.Context, *AccountSwitch) (*Response, error)
+	AccountRemove(context.Context, *AccountRemove) (*Response, error)
+	AccountStatus(context.Context, *AccountStatus) (*Response, error)
+	WireGuardGenerateNewKeys(context.Context, *WireGuardGenerateNewKeys) (*Response, error)
+	WireGuardSetKeysRotationInterval(context.Context, *WireGuardSetKeysRotationInterval) (*Response, error)
+	GetAppIcon(context.Context, *GetAppIcon) (*Response, error)
+	GetInstalledApps(context.Context, *GetInstalledApps) (*Response, error)
+	WiFiCurrentNetwork(context.Context, *WiFiCurrentNetwork) (*Response, error)
+	WiFiSettings(context.Context, *WiFiSettings) (*Response, error)
+	ScheduleSettings(context.Context, *ScheduleSettings) (*Response, error)
+	Disconnect(context.Context, *Disconnect) (*Response, error)
+	ConnectSettingsGet(context.Context, *ConnectSettingsGet) (*Response, error)
+	ConnectSettings(context.Context, *ConnectSettings) (*Response, error)
+	Connect(context.Context, *Connect) (*Response, error)
+	ConnectionHistoryGet(context.Context, *ConnectionHistoryGet) (*Response, error)
+	AuditLogGet(context.Context, *AuditLogGet) (*Response, error)
+	LogStreamStart(context.Context, *LogStreamStart) (*Response, error)
+	LogStreamStop(context.Context, *LogStreamStop) (*Response, error)
+	ShellCommandsGet(context.Context, *ShellCommandsGet) (*Response, error)
+	CrashReportsGet(context.Context, *CrashReportsGet) (*Response, error)
+	CrashReportsClear(context.Context, *CrashReportsClear) (*Response, error)
+	GetSubsystemStatus(context.Context, *GetSubsystemStatus) (*Response, error)
+	OperationStart(context.Context, *OperationStart) (*Response, error)
+	SpeedTestStart(context.Context, *SpeedTestStart) (*Response, error)
+	OperationCancel(context.Context, *OperationCancel) (*Response, error)
+	OperationsGet(context.Context, *OperationsGet) (*Response, error)
+	HostsHealthGet(context.Context, *HostsHealthGet) (*Response, error)
+	ConnectionHistoryClear(context.Context, *ConnectionHistoryClear) (*Response, error)
+	ConnectionHistoryConnect(context.Context, *ConnectionHistoryConnect) (*Response, error)
+	ConnectionStatsHistoryGet(context.Context, *ConnectionStatsHistoryGet) (*Response, error)
+	ConnectionStatsHistoryClear(context.Context, *ConnectionStatsHistoryClear) (*Response, error)
+	ThroughputHistoryGet(context.Context, *ThroughputHistoryGet) (*Response, error)
+	ConnectionQualityHistoryGet(context.Context, *ConnectionQualityHistoryGet) (*Response, error)
+	ConnectionProfilesGet(context.Context, *ConnectionProfilesGet) (*Response, error)
+	ConnectionProfileSave(context.Context, *ConnectionProfileSave) (*Response, error)
+	ConnectionProfileRemove(context.Context, *ConnectionProfileRemove) (*Response, error)
+	ConnectionProfileConnect(context.Context, *ConnectionProfileConnect) (*Response, error)
+	// Events - stream of the daemon events (the same notifications which are sent to the clients of the daemon protocol).
+	// Additional events: ConnectionStatsResp (periodically, when connected); LogMessageResp (when requested).
+	Events(*EventsRequest, Daemon_EventsServer) error
+	mustEmbedUnimplementedDaemonServer()
+}
+
+// UnimplementedDaemonServer must be embedded to have forward compatible implementations.
+type UnimplementedDaemonServer struct {
+}
+
+func (UnimplementedDaemonServer) EmptyReq(context.Context, *EmptyReq) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmptyReq not implemented")
+}
+func (UnimplementedDaemonServer) Hello(context.Context, *Hello) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hello not implemented")
+}
+func (UnimplementedDaemonServer) SetNotificationsFilter(context.Context, *SetNotificationsFilter) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNotificationsFilter not implemented")
+}
+func (UnimplementedDaemonServer) ParanoidModeSetPasswordReq(context.Context, *ParanoidModeSetPasswordReq) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ParanoidModeSetPasswordReq not implemented")
+}
+func (UnimplementedDaemonServer) GetVPNState(context.Context, *GetVPNState) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVPNState not implemented")
+}
+func (UnimplementedDaemonServer) GetServers(context.Context, *GetServers) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetServers not implemented")
+}
+func (UnimplementedDaemonServer) PingServers(context.Context, *PingServers) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PingServers not implemented")
+}
+func (UnimplementedDaemonServer) APIRequest(context.Context, *APIRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method APIRequest not implemented")
+}
+func (UnimplementedDaemonServer) WiFiAvailableNetworks(context.Context, *WiFiAvailableNetworks) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WiFiAvailableNetworks not implemented")
+}
+func (UnimplementedDaemonServer) KillSwitchGetStatus(context.Context, *KillSwitchGetStatus) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitchGetStatus not implemented")
+}
+func (UnimplementedDaemonServer) KillSwitchSetEnabled(context.Context, *KillSwitchSetEnabled) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitchSetEnabled not implemented")
+}
+func (UnimplementedDaemonServer) KillSwitchSetAllowLANMulticast(context.Context, *KillSwitchSetAllowLANMulticast) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitchSetAllowLANMulticast not implemented")
+}
+func (UnimplementedDaemonServer) KillSwitchSetAllowLAN(context.Context, *KillSwitchSetAllowLAN) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitchSetAllowLAN not implemented")
+}
+func (UnimplementedDaemonServer) KillSwitchSetAllowLANServices(context.Context, *KillSwitchSetAllowLANServices) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitchSetAllowLANServices not implemented")
+}
+func (UnimplementedDaemonServer) KillSwitchSetAllowedLANHosts(context.Context, *KillSwitchSetAllowedLANHosts) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitchSetAllowedLANHosts not implemented")
+}
+func (UnimplementedDaemonServer) KillSwitchSetLANInboundPorts(context.Context, *KillSwitchSetLANInboundPorts) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitchSetLANInboundPorts not implemented")
+}
+func (UnimplementedDaemonServer) KillSwitchSetUserExceptions(context.Context, *KillSwitchSetUserExceptions) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitchSetUserExceptions not implemented")
+}
+func (UnimplementedDaemonServer) KillSwitchSetIsPersistent(context.Context, *KillSwitchSetIsPersistent) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitchSetIsPersistent not implemented")
+}
+func (UnimplementedDaemonServer) KillSwitchSetAllowApiServers(context.Context, *KillSwitchSetAllowApiServers) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KillSwitchSetAllowApiServers not implemented")
+}
+func (UnimplementedDaemonServer) SetPreference(context.Context, *SetPreference) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPreference not implemented")
+}
+func (UnimplementedDaemonServer) SetObfsProxy(context.Context, *SetObfsProxy) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetObfsProxy not implemented")
+}
+func (UnimplementedDaemonServer) SetV2RayProxy(context.Context, *SetV2RayProxy) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetV2RayProxy not implemented")
+}
+func (UnimplementedDaemonServer) SetApiProxy(context.Context, *SetApiProxy) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetApiProxy not implemented")
+}
+func (UnimplementedDaemonServer) SetApiHostOverride(context.Context, *SetApiHostOverride) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetApiHostOverride not implemented")
+}
+func (UnimplementedDaemonServer) SetOpenVpnExtraParameters(context.Context, *SetOpenVpnExtraParameters) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOpenVpnExtraParameters not implemented")
+}
+func (UnimplementedDaemonServer) SetOpenVpnCryptoPolicy(context.Context, *SetOpenVpnCryptoPolicy) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOpenVpnCryptoPolicy not implemented")
+}
+func (UnimplementedDaemonServer) RestApiGet(context.Context, *RestApiGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestApiGet not implemented")
+}
+func (UnimplementedDaemonServer) SetRestApi(context.Context, *SetRestApi) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRestApi not implemented")
+}
+func (UnimplementedDaemonServer) GrpcApiGet(context.Context, *GrpcApiGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrpcApiGet not implemented")
+}
+func (UnimplementedDaemonServer) SetGrpcApi(context.Context, *SetGrpcApi) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGrpcApi not implemented")
+}
+func (UnimplementedDaemonServer) SetLogRotation(context.Context, *SetLogRotation) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogRotation not implemented")
+}
+func (UnimplementedDaemonServer) LogLevelsGet(context.Context, *LogLevelsGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogLevelsGet not implemented")
+}
+func (UnimplementedDaemonServer) SetLogLevel(context.Context, *SetLogLevel) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedDaemonServer) ClientTokensGet(context.Context, *ClientTokensGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientTokensGet not implemented")
+}
+func (UnimplementedDaemonServer) ClientTokenAdd(context.Context, *ClientTokenAdd) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientTokenAdd not implemented")
+}
+func (UnimplementedDaemonServer) ClientTokenRemove(context.Context, *ClientTokenRemove) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClientTokenRemove not implemented")
+}
+func (UnimplementedDaemonServer) SettingsExport(context.Context, *SettingsExport) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettingsExport not implemented")
+}
+func (UnimplementedDaemonServer) SettingsImport(context.Context, *SettingsImport) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettingsImport not implemented")
+}
+func (UnimplementedDaemonServer) SetShadowsocksProxy(context.Context, *SetShadowsocksProxy) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetShadowsocksProxy not implemented")
+}
+func (UnimplementedDaemonServer) SetAmneziaWG(context.Context, *SetAmneziaWG) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAmneziaWG not implemented")
+}
+func (UnimplementedDaemonServer) SetUserPreferences(context.Context, *SetUserPreferences) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserPreferences not implemented")
+}
+func (UnimplementedDaemonServer) SplitTunnelGetStatus(context.Context, *SplitTunnelGetStatus) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitTunnelGetStatus not implemented")
+}
+func (UnimplementedDaemonServer) SplitTunnelSetConfig(context.Context, *SplitTunnelSetConfig) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitTunnelSetConfig not implemented")
+}
+func (UnimplementedDaemonServer) SplitTunnelSetDestinations(context.Context, *SplitTunnelSetDestinations) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitTunnelSetDestinations not implemented")
+}
+func (UnimplementedDaemonServer) SplitTunnelSetContainers(context.Context, *SplitTunnelSetContainers) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitTunnelSetContainers not implemented")
+}
+func (UnimplementedDaemonServer) SplitTunnelSetUsers(context.Context, *SplitTunnelSetUsers) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitTunnelSetUsers not implemented")
+}
+func (UnimplementedDaemonServer) SplitTunnelAddApp(context.Context, *SplitTunnelAddApp) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitTunnelAddApp not implemented")
+}
+func (UnimplementedDaemonServer) SplitTunnelRemoveApp(context.Context, *SplitTunnelRemoveApp) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitTunnelRemoveApp not implemented")
+}
+func (UnimplementedDaemonServer) SplitTunnelAddedPidInfo(context.Context, *SplitTunnelAddedPidInfo) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitTunnelAddedPidInfo not implemented")
+}
+func (UnimplementedDaemonServer) GenerateDiagnostics(context.Context, *GenerateDiagnostics) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateDiagnostics not implemented")
+}
+func (UnimplementedDaemonServer) PortForwardingGetStatus(context.Context, *PortForwardingGetStatus) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PortForwardingGetStatus not implemented")
+}
+func (UnimplementedDaemonServer) PortForwardingRequest(context.Context, *PortForwardingRequest) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PortForwardingRequest not implemented")
+}
+func (UnimplementedDaemonServer) PortForwardingRelease(context.Context, *PortForwardingRelease) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PortForwardingRelease not implemented")
+}
+func (UnimplementedDaemonServer) CaptivePortalCheck(context.Context, *CaptivePortalCheck) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptivePortalCheck not implemented")
+}
+func (UnimplementedDaemonServer) CaptivePortalAllowLogin(context.Context, *CaptivePortalAllowLogin) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptivePortalAllowLogin not implemented")
+}
+func (UnimplementedDaemonServer) CaptivePortalReLock(context.Context, *CaptivePortalReLock) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CaptivePortalReLock not implemented")
+}
+func (UnimplementedDaemonServer) SetAlternateDns(context.Context, *SetAlternateDns) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAlternateDns not implemented")
+}
+func (UnimplementedDaemonServer) GetDnsPredefinedConfigs(context.Context, *GetDnsPredefinedConfigs) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDnsPredefinedConfigs not implemented")
+}
+func (UnimplementedDaemonServer) PauseConnection(context.Context, *PauseConnection) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseConnection not implemented")
+}
+func (UnimplementedDaemonServer) ResumeConnection(context.Context, *ResumeConnection) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeConnection not implemented")
+}
+func (UnimplementedDaemonServer) SessionNew(context.Context, *SessionNew) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionNew not implemented")
+}
+func (UnimplementedDaemonServer) SessionDelete(context.Context, *SessionDelete) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionDelete not implemented")
+}
+func (UnimplementedDaemonServer) DevicesList(context.Context, *DevicesList) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DevicesList not implemented")
+}
+func (UnimplementedDaemonServer) DeviceLogout(context.Context, *DeviceLogout) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeviceLogout not implemented")
+}
+func (UnimplementedDaemonServer) AccountsGet(context.Context, *AccountsGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountsGet not implemented")
+}
+func (UnimplementedDaemonServer) AccountSwitch(context.Context, *AccountSwitch) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountSwitch not implemented")
+}
+func (UnimplementedDaemonServer) AccountRemove(context.Context, *AccountRemove) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountRemove not implemented")
+}
+func (UnimplementedDaemonServer) AccountStatus(context.Context, *AccountStatus) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountStatus not implemented")
+}
+func (UnimplementedDaemonServer) WireGuardGenerateNewKeys(context.Context, *WireGuardGenerateNewKeys) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WireGuardGenerateNewKeys not implemented")
+}
+func (UnimplementedDaemonServer) WireGuardSetKeysRotationInterval(context.Context, *WireGuardSetKeysRotationInterval) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WireGuardSetKeysRotationInterval not implemented")
+}
+func (UnimplementedDaemonServer) GetAppIcon(context.Context, *GetAppIcon) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAppIcon not implemented")
+}
+func (UnimplementedDaemonServer) GetInstalledApps(context.Context, *GetInstalledApps) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInstalledApps not implemented")
+}
+func (UnimplementedDaemonServer) WiFiCurrentNetwork(context.Context, *WiFiCurrentNetwork) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WiFiCurrentNetwork not implemented")
+}
+func (UnimplementedDaemonServer) WiFiSettings(context.Context, *WiFiSettings) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WiFiSettings not implemented")
+}
+func (UnimplementedDaemonServer) ScheduleSettings(context.Context, *ScheduleSettings) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleSettings not implemented")
+}
+func (UnimplementedDaemonServer) Disconnect(context.Context, *Disconnect) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disconnect not implemented")
+}
+func (UnimplementedDaemonServer) ConnectSettingsGet(context.Context, *ConnectSettingsGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectSettingsGet not implemented")
+}
+func (UnimplementedDaemonServer) ConnectSettings(context.Context, *ConnectSettings) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectSettings not implemented")
+}
+func (UnimplementedDaemonServer) Connect(context.Context, *Connect) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Connect not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionHistoryGet(context.Context, *ConnectionHistoryGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionHistoryGet not implemented")
+}
+func (UnimplementedDaemonServer) AuditLogGet(context.Context, *AuditLogGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuditLogGet not implemented")
+}
+func (UnimplementedDaemonServer) LogStreamStart(context.Context, *LogStreamStart) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogStreamStart not implemented")
+}
+func (UnimplementedDaemonServer) LogStreamStop(context.Context, *LogStreamStop) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogStreamStop not implemented")
+}
+func (UnimplementedDaemonServer) ShellCommandsGet(context.Context, *ShellCommandsGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShellCommandsGet not implemented")
+}
+func (UnimplementedDaemonServer) CrashReportsGet(context.Context, *CrashReportsGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrashReportsGet not implemented")
+}
+func (UnimplementedDaemonServer) CrashReportsClear(context.Context, *CrashReportsClear) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CrashReportsClear not implemented")
+}
+func (UnimplementedDaemonServer) GetSubsystemStatus(context.Context, *GetSubsystemStatus) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystemStatus not implemented")
+}
+func (UnimplementedDaemonServer) OperationStart(context.Context, *OperationStart) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationStart not implemented")
+}
+func (UnimplementedDaemonServer) SpeedTestStart(context.Context, *SpeedTestStart) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SpeedTestStart not implemented")
+}
+func (UnimplementedDaemonServer) OperationCancel(context.Context, *OperationCancel) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationCancel not implemented")
+}
+func (UnimplementedDaemonServer) OperationsGet(context.Context, *OperationsGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperationsGet not implemented")
+}
+func (UnimplementedDaemonServer) HostsHealthGet(context.Context, *HostsHealthGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HostsHealthGet not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionHistoryClear(context.Context, *ConnectionHistoryClear) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionHistoryClear not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionHistoryConnect(context.Context, *ConnectionHistoryConnect) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionHistoryConnect not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionStatsHistoryGet(context.Context, *ConnectionStatsHistoryGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionStatsHistoryGet not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionStatsHistoryClear(context.Context, *ConnectionStatsHistoryClear) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionStatsHistoryClear not implemented")
+}
+func (UnimplementedDaemonServer) ThroughputHistoryGet(context.Context, *ThroughputHistoryGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ThroughputHistoryGet not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionQualityHistoryGet(context.Context, *ConnectionQualityHistoryGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionQualityHistoryGet not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionProfilesGet(context.Context, *ConnectionProfilesGet) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionProfilesGet not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionProfileSave(context.Context, *ConnectionProfileSave) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionProfileSave not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionProfileRemove(context.Context, *ConnectionProfileRemove) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionProfileRemove not implemented")
+}
+func (UnimplementedDaemonServer) ConnectionProfileConnect(context.Context, *ConnectionProfileConnect) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConnectionProfileConnect not implemented")
+}
+func (UnimplementedDaemonServer) Events(*EventsRequest, Daemon_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (UnimplementedDaemonServer) mustEmbedUnimplementedDaemonServer() {}
+
+// UnsafeDaemonServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DaemonServer will
+// result in compilation errors.
+type UnsafeDaemonServer interface {
+	mustEmbedUnimplementedDaemonServer()
+}
+
+func RegisterDaemonServer(s grpc.ServiceRegistrar, srv DaemonServer) {
+	s.RegisterService(&Daemon_ServiceDesc, srv)
+}
+
+func _Daemon_EmptyReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).EmptyReq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_EmptyReq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).EmptyReq(ctx, req.(*EmptyReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Hello_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Hello)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Hello(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_Hello_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Hello(ctx, req.(*Hello))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetNotificationsFilter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationsFilter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetNotificationsFilter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetNotificationsFilter_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetNotificationsFilter(ctx, req.(*SetNotificationsFilter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ParanoidModeSetPasswordReq_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParanoidModeSetPasswordReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ParanoidModeSetPasswordReq(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ParanoidModeSetPasswordReq_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ParanoidModeSetPasswordReq(ctx, req.(*ParanoidModeSetPasswordReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_GetVPNState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVPNState)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GetVPNState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_GetVPNState_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GetVPNState(ctx, req.(*GetVPNState))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_GetServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GetServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_GetServers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GetServers(ctx, req.(*GetServers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_PingServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingServers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PingServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_PingServers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PingServers(ctx, req.(*PingServers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_APIRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(APIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).APIRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_APIRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).APIRequest(ctx, req.(*APIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_WiFiAvailableNetworks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WiFiAvailableNetworks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).WiFiAvailableNetworks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_WiFiAvailableNetworks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).WiFiAvailableNetworks(ctx, req.(*WiFiAvailableNetworks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_KillSwitchGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSwitchGetStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).KillSwitchGetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_KillSwitchGetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).KillSwitchGetStatus(ctx, req.(*KillSwitchGetStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_KillSwitchSetEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSwitchSetEnabled)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).KillSwitchSetEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_KillSwitchSetEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).KillSwitchSetEnabled(ctx, req.(*KillSwitchSetEnabled))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_KillSwitchSetAllowLANMulticast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSwitchSetAllowLANMulticast)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).KillSwitchSetAllowLANMulticast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_KillSwitchSetAllowLANMulticast_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).KillSwitchSetAllowLANMulticast(ctx, req.(*KillSwitchSetAllowLANMulticast))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_KillSwitchSetAllowLAN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSwitchSetAllowLAN)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).KillSwitchSetAllowLAN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_KillSwitchSetAllowLAN_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).KillSwitchSetAllowLAN(ctx, req.(*KillSwitchSetAllowLAN))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_KillSwitchSetAllowLANServices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSwitchSetAllowLANServices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).KillSwitchSetAllowLANServices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_KillSwitchSetAllowLANServices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).KillSwitchSetAllowLANServices(ctx, req.(*KillSwitchSetAllowLANServices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_KillSwitchSetAllowedLANHosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSwitchSetAllowedLANHosts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).KillSwitchSetAllowedLANHosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_KillSwitchSetAllowedLANHosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).KillSwitchSetAllowedLANHosts(ctx, req.(*KillSwitchSetAllowedLANHosts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_KillSwitchSetLANInboundPorts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSwitchSetLANInboundPorts)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).KillSwitchSetLANInboundPorts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_KillSwitchSetLANInboundPorts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).KillSwitchSetLANInboundPorts(ctx, req.(*KillSwitchSetLANInboundPorts))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_KillSwitchSetUserExceptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSwitchSetUserExceptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).KillSwitchSetUserExceptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_KillSwitchSetUserExceptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).KillSwitchSetUserExceptions(ctx, req.(*KillSwitchSetUserExceptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_KillSwitchSetIsPersistent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSwitchSetIsPersistent)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).KillSwitchSetIsPersistent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_KillSwitchSetIsPersistent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).KillSwitchSetIsPersistent(ctx, req.(*KillSwitchSetIsPersistent))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_KillSwitchSetAllowApiServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillSwitchSetAllowApiServers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).KillSwitchSetAllowApiServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_KillSwitchSetAllowApiServers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).KillSwitchSetAllowApiServers(ctx, req.(*KillSwitchSetAllowApiServers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPreference)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetPreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetPreference(ctx, req.(*SetPreference))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetObfsProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetObfsProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetObfsProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetObfsProxy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetObfsProxy(ctx, req.(*SetObfsProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetV2RayProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetV2RayProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetV2RayProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetV2RayProxy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetV2RayProxy(ctx, req.(*SetV2RayProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetApiProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApiProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetApiProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetApiProxy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetApiProxy(ctx, req.(*SetApiProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetApiHostOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetApiHostOverride)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetApiHostOverride(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetApiHostOverride_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetApiHostOverride(ctx, req.(*SetApiHostOverride))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetOpenVpnExtraParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOpenVpnExtraParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetOpenVpnExtraParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetOpenVpnExtraParameters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetOpenVpnExtraParameters(ctx, req.(*SetOpenVpnExtraParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetOpenVpnCryptoPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOpenVpnCryptoPolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetOpenVpnCryptoPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetOpenVpnCryptoPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetOpenVpnCryptoPolicy(ctx, req.(*SetOpenVpnCryptoPolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_RestApiGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestApiGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).RestApiGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_RestApiGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).RestApiGet(ctx, req.(*RestApiGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetRestApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRestApi)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetRestApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetRestApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetRestApi(ctx, req.(*SetRestApi))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_GrpcApiGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrpcApiGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GrpcApiGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_GrpcApiGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GrpcApiGet(ctx, req.(*GrpcApiGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetGrpcApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGrpcApi)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetGrpcApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetGrpcApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetGrpcApi(ctx, req.(*SetGrpcApi))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetLogRotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogRotation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetLogRotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetLogRotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetLogRotation(ctx, req.(*SetLogRotation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_LogLevelsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogLevelsGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).LogLevelsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_LogLevelsGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).LogLevelsGet(ctx, req.(*LogLevelsGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetLogLevel(ctx, req.(*SetLogLevel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ClientTokensGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientTokensGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ClientTokensGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ClientTokensGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ClientTokensGet(ctx, req.(*ClientTokensGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ClientTokenAdd_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientTokenAdd)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ClientTokenAdd(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ClientTokenAdd_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ClientTokenAdd(ctx, req.(*ClientTokenAdd))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ClientTokenRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClientTokenRemove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ClientTokenRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ClientTokenRemove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ClientTokenRemove(ctx, req.(*ClientTokenRemove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SettingsExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettingsExport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SettingsExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SettingsExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SettingsExport(ctx, req.(*SettingsExport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SettingsImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettingsImport)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SettingsImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SettingsImport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SettingsImport(ctx, req.(*SettingsImport))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetShadowsocksProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetShadowsocksProxy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetShadowsocksProxy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetShadowsocksProxy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetShadowsocksProxy(ctx, req.(*SetShadowsocksProxy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetAmneziaWG_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAmneziaWG)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetAmneziaWG(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetAmneziaWG_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetAmneziaWG(ctx, req.(*SetAmneziaWG))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetUserPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserPreferences)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetUserPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetUserPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetUserPreferences(ctx, req.(*SetUserPreferences))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SplitTunnelGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitTunnelGetStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SplitTunnelGetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SplitTunnelGetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SplitTunnelGetStatus(ctx, req.(*SplitTunnelGetStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SplitTunnelSetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitTunnelSetConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SplitTunnelSetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SplitTunnelSetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SplitTunnelSetConfig(ctx, req.(*SplitTunnelSetConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SplitTunnelSetDestinations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitTunnelSetDestinations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SplitTunnelSetDestinations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SplitTunnelSetDestinations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SplitTunnelSetDestinations(ctx, req.(*SplitTunnelSetDestinations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SplitTunnelSetContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitTunnelSetContainers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SplitTunnelSetContainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SplitTunnelSetContainers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SplitTunnelSetContainers(ctx, req.(*SplitTunnelSetContainers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SplitTunnelSetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitTunnelSetUsers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SplitTunnelSetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SplitTunnelSetUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SplitTunnelSetUsers(ctx, req.(*SplitTunnelSetUsers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SplitTunnelAddApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitTunnelAddApp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SplitTunnelAddApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SplitTunnelAddApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SplitTunnelAddApp(ctx, req.(*SplitTunnelAddApp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SplitTunnelRemoveApp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitTunnelRemoveApp)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SplitTunnelRemoveApp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SplitTunnelRemoveApp_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SplitTunnelRemoveApp(ctx, req.(*SplitTunnelRemoveApp))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SplitTunnelAddedPidInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitTunnelAddedPidInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SplitTunnelAddedPidInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SplitTunnelAddedPidInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SplitTunnelAddedPidInfo(ctx, req.(*SplitTunnelAddedPidInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_GenerateDiagnostics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateDiagnostics)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GenerateDiagnostics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_GenerateDiagnostics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GenerateDiagnostics(ctx, req.(*GenerateDiagnostics))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_PortForwardingGetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortForwardingGetStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PortForwardingGetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_PortForwardingGetStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PortForwardingGetStatus(ctx, req.(*PortForwardingGetStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_PortForwardingRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortForwardingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PortForwardingRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_PortForwardingRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PortForwardingRequest(ctx, req.(*PortForwardingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_PortForwardingRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortForwardingRelease)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PortForwardingRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_PortForwardingRelease_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PortForwardingRelease(ctx, req.(*PortForwardingRelease))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CaptivePortalCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptivePortalCheck)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CaptivePortalCheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_CaptivePortalCheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CaptivePortalCheck(ctx, req.(*CaptivePortalCheck))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CaptivePortalAllowLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptivePortalAllowLogin)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CaptivePortalAllowLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_CaptivePortalAllowLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CaptivePortalAllowLogin(ctx, req.(*CaptivePortalAllowLogin))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CaptivePortalReLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CaptivePortalReLock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CaptivePortalReLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_CaptivePortalReLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CaptivePortalReLock(ctx, req.(*CaptivePortalReLock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SetAlternateDns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAlternateDns)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SetAlternateDns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SetAlternateDns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SetAlternateDns(ctx, req.(*SetAlternateDns))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_GetDnsPredefinedConfigs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDnsPredefinedConfigs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GetDnsPredefinedConfigs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_GetDnsPredefinedConfigs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GetDnsPredefinedConfigs(ctx, req.(*GetDnsPredefinedConfigs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_PauseConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseConnection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).PauseConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_PauseConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).PauseConnection(ctx, req.(*PauseConnection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ResumeConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeConnection)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ResumeConnection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ResumeConnection_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ResumeConnection(ctx, req.(*ResumeConnection))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SessionNew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionNew)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SessionNew(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SessionNew_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SessionNew(ctx, req.(*SessionNew))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SessionDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionDelete)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SessionDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SessionDelete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SessionDelete(ctx, req.(*SessionDelete))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_DevicesList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DevicesList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).DevicesList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_DevicesList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).DevicesList(ctx, req.(*DevicesList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_DeviceLogout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeviceLogout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).DeviceLogout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_DeviceLogout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).DeviceLogout(ctx, req.(*DeviceLogout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_AccountsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountsGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AccountsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_AccountsGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AccountsGet(ctx, req.(*AccountsGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_AccountSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountSwitch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AccountSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_AccountSwitch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AccountSwitch(ctx, req.(*AccountSwitch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_AccountRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountRemove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AccountRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_AccountRemove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AccountRemove(ctx, req.(*AccountRemove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_AccountStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AccountStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_AccountStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AccountStatus(ctx, req.(*AccountStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_WireGuardGenerateNewKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WireGuardGenerateNewKeys)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).WireGuardGenerateNewKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_WireGuardGenerateNewKeys_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).WireGuardGenerateNewKeys(ctx, req.(*WireGuardGenerateNewKeys))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_WireGuardSetKeysRotationInterval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WireGuardSetKeysRotationInterval)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).WireGuardSetKeysRotationInterval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_WireGuardSetKeysRotationInterval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).WireGuardSetKeysRotationInterval(ctx, req.(*WireGuardSetKeysRotationInterval))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_GetAppIcon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAppIcon)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GetAppIcon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_GetAppIcon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GetAppIcon(ctx, req.(*GetAppIcon))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_GetInstalledApps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstalledApps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GetInstalledApps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_GetInstalledApps_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GetInstalledApps(ctx, req.(*GetInstalledApps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_WiFiCurrentNetwork_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WiFiCurrentNetwork)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).WiFiCurrentNetwork(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_WiFiCurrentNetwork_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).WiFiCurrentNetwork(ctx, req.(*WiFiCurrentNetwork))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_WiFiSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WiFiSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).WiFiSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_WiFiSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).WiFiSettings(ctx, req.(*WiFiSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ScheduleSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ScheduleSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ScheduleSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ScheduleSettings(ctx, req.(*ScheduleSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Disconnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Disconnect)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Disconnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_Disconnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Disconnect(ctx, req.(*Disconnect))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectSettingsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectSettingsGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectSettingsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectSettingsGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectSettingsGet(ctx, req.(*ConnectSettingsGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectSettings)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectSettings(ctx, req.(*ConnectSettings))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Connect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Connect)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).Connect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_Connect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).Connect(ctx, req.(*Connect))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionHistoryGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionHistoryGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionHistoryGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectionHistoryGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionHistoryGet(ctx, req.(*ConnectionHistoryGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_AuditLogGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuditLogGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).AuditLogGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_AuditLogGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).AuditLogGet(ctx, req.(*AuditLogGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_LogStreamStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogStreamStart)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).LogStreamStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_LogStreamStart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).LogStreamStart(ctx, req.(*LogStreamStart))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_LogStreamStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogStreamStop)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).LogStreamStop(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_LogStreamStop_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).LogStreamStop(ctx, req.(*LogStreamStop))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ShellCommandsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShellCommandsGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ShellCommandsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ShellCommandsGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ShellCommandsGet(ctx, req.(*ShellCommandsGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CrashReportsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrashReportsGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CrashReportsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_CrashReportsGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CrashReportsGet(ctx, req.(*CrashReportsGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_CrashReportsClear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CrashReportsClear)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).CrashReportsClear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_CrashReportsClear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).CrashReportsClear(ctx, req.(*CrashReportsClear))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_GetSubsystemStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubsystemStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).GetSubsystemStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_GetSubsystemStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).GetSubsystemStatus(ctx, req.(*GetSubsystemStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_OperationStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationStart)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).OperationStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_OperationStart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).OperationStart(ctx, req.(*OperationStart))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_SpeedTestStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpeedTestStart)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).SpeedTestStart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_SpeedTestStart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).SpeedTestStart(ctx, req.(*SpeedTestStart))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_OperationCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationCancel)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).OperationCancel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_OperationCancel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).OperationCancel(ctx, req.(*OperationCancel))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_OperationsGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperationsGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).OperationsGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_OperationsGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).OperationsGet(ctx, req.(*OperationsGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_HostsHealthGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HostsHealthGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).HostsHealthGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_HostsHealthGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).HostsHealthGet(ctx, req.(*HostsHealthGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionHistoryClear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionHistoryClear)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionHistoryClear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectionHistoryClear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionHistoryClear(ctx, req.(*ConnectionHistoryClear))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionHistoryConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionHistoryConnect)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionHistoryConnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectionHistoryConnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionHistoryConnect(ctx, req.(*ConnectionHistoryConnect))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionStatsHistoryGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionStatsHistoryGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionStatsHistoryGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectionStatsHistoryGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionStatsHistoryGet(ctx, req.(*ConnectionStatsHistoryGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionStatsHistoryClear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionStatsHistoryClear)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionStatsHistoryClear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectionStatsHistoryClear_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionStatsHistoryClear(ctx, req.(*ConnectionStatsHistoryClear))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ThroughputHistoryGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ThroughputHistoryGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ThroughputHistoryGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ThroughputHistoryGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ThroughputHistoryGet(ctx, req.(*ThroughputHistoryGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionQualityHistoryGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionQualityHistoryGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionQualityHistoryGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectionQualityHistoryGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionQualityHistoryGet(ctx, req.(*ConnectionQualityHistoryGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionProfilesGet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionProfilesGet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionProfilesGet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectionProfilesGet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionProfilesGet(ctx, req.(*ConnectionProfilesGet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionProfileSave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionProfileSave)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionProfileSave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectionProfileSave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionProfileSave(ctx, req.(*ConnectionProfileSave))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionProfileRemove_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionProfileRemove)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionProfileRemove(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectionProfileRemove_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionProfileRemove(ctx, req.(*ConnectionProfileRemove))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_ConnectionProfileConnect_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConnectionProfileConnect)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DaemonServer).ConnectionProfileConnect(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Daemon_ConnectionProfileConnect_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DaemonServer).ConnectionProfileConnect(ctx, req.(*ConnectionProfileConnect))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Daemon_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DaemonServer).Events(m, &daemonEventsServer{stream})
+}
+
+type Daemon_EventsServer interface {
+	Send(*Response) error
+	grpc.ServerStream
+}
+
+type daemonEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *daemonEventsServer) Send(m *Response) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// Daemon_ServiceDesc is the grpc.ServiceDesc for Daemon service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Daemon_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ivpn.daemon.v1.Daemon",
+	HandlerType: (*DaemonServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EmptyReq",
+			Handler:    _Daemon_EmptyReq_Handler,
+		},
+		{
+			MethodName: "Hello",
+			Handler:    _Daemon_Hello_Handler,
+		},
+		{
+			MethodName: "SetNotificationsFilter",
+			Handler:    _Daemon_SetNotificationsFilter_Handler,
+		},
+		{
+			MethodName: "ParanoidModeSetPasswordReq",
+			Handler:    _Daemon_ParanoidModeSetPasswordReq_Handler,
+		},
+		{
+			MethodName: "GetVPNState",
+			Handler:    _Daemon_GetVPNState_Handler,
+		},
+		{
+			MethodName: "GetServers",
+			Handler:    _Daemon_GetServers_Handler,
+		},
+		{
+			MethodName: "PingServers",
+			Handler:    _Daemon_PingServers_Handler,
+		},
+		{
+			MethodName: "APIRequest",
+			Handler:    _Daemon_APIRequest_Handler,
+		},
+		{
+			MethodName: "WiFiAvailableNetworks",
+			Handler:    _Daemon_WiFiAvailableNetworks_Handler,
+		},
+		{
+			MethodName: "KillSwitchGetStatus",
+			Handler:    _Daemon_KillSwitchGetStatus_Handler,
+		},
+		{
+			MethodName: "KillSwitchSetEnabled",
+			Handler:    _Daemon_KillSwitchSetEnabled_Handler,
+		},
+		{
+			MethodName: "KillSwitchSetAllowLANMulticast",
+			Handler:    _Daemon_KillSwitchSetAllowLANMulticast_Handler,
+		},
+		{
+			MethodName: "KillSwitchSetAllowLAN",
+			Handler:    _Daemon_KillSwitchSetAllowLAN_Handler,
+		},
+		{
+			MethodName: "KillSwitchSetAllowLANServices",
+			Handler:    _Daemon_KillSwitchSetAllowLANServices_Handler,
+		},
+		{
+			MethodName: "KillSwitchSetAllowedLANHosts",
+			Handler:    _Daemon_KillSwitchSetAllowedLANHosts_Handler,
+		},
+		{
+			MethodName: "KillSwitchSetLANInboundPorts",
+			Handler:    _Daemon_KillSwitchSetLANInboundPorts_Handler,
+		},
+		{
+			MethodName: "KillSwitchSetUserExceptions",
+			Handler:    _Daemon_KillSwitchSetUserExceptions_Handler,
+		},
+		{
+			MethodName: "KillSwitchSetIsPersistent",
+			Handler:    _Daemon_KillSwitchSetIsPersistent_Handler,
+		},
+		{
+			MethodName: "KillSwitchSetAllowApiServers",
+			Handler:    _Daemon_KillSwitchSetAllowApiServers_Handler,
+		},
+		{
+			MethodName: "SetPreference",
+			Handler:    _Daemon_SetPreference_Handler,
+		},
+		{
+			MethodName: "SetObfsProxy",
+			Handler:    _Daemon_SetObfsProxy_Handler,
+		},
+		{
+			MethodName: "SetV2RayProxy",
+			Handler:    _Daemon_SetV2RayProxy_Handler,
+		},
+		{
+			MethodName: "SetApiProxy",
+			Handler:    _Daemon_SetApiProxy_Handler,
+		},
+		{
+			MethodName: "SetApiHostOverride",
+			Handler:    _Daemon_SetApiHostOverride_Handler,
+		},
+		{
+			MethodName: "SetOpenVpnExtraParameters",
+			Handler:    _Daemon_SetOpenVpnExtraParameters_Handler,
+		},
+		{
+			MethodName: "SetOpenVpnCryptoPolicy",
+			Handler:    _Daemon_SetOpenVpnCryptoPolicy_Handler,
+		},
+		{
+			MethodName: "RestApiGet",
+			Handler:    _Daemon_RestApiGet_Handler,
+		},
+		{
+			MethodName: "SetRestApi",
+			Handler:    _Daemon_SetRestApi_Handler,
+		},
+		{
+			MethodName: "GrpcApiGet",
+			Handler:    _Daemon_GrpcApiGet_Handler,
+		},
+		{
+			MethodName: "SetGrpcApi",
+			Handler:    _Daemon_SetGrpcApi_Handler,
+		},
+		{
+			MethodName: "SetLogRotation",
+			Handler:    _Daemon_SetLogRotation_Handler,
+		},
+		{
+			MethodName: "LogLevelsGet",
+			Handler:    _Daemon_LogLevelsGet_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Daemon_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "ClientTokensGet",
+			Handler:    _Daemon_ClientTokensGet_Handler,
+		},
+		{
+			MethodName: "ClientTokenAdd",
+			Handler:    _Daemon_ClientTokenAdd_Handler,
+		},
+		{
+			MethodName: "ClientTokenRemove",
+			Handler:    _Daemon_ClientTokenRemove_Handler,
+		},
+		{
+			MethodName: "SettingsExport",
+			Handler:    _Daemon_SettingsExport_Handler,
+		},
+		{
+			MethodName: "SettingsImport",
+			Handler:    _Daemon_SettingsImport_Handler,
+		},
+		{
+			MethodName: "SetShadowsocksProxy",
+			Handler:    _Daemon_SetShadowsocksProxy_Handler,
+		},
+		{
+			MethodName: "SetAmneziaWG",
+			Handler:    _Daemon_SetAmneziaWG_Handler,
+		},
+		{
+			MethodName: "SetUserPreferences",
+			Handler:    _Daemon_SetUserPreferences_Handler,
+		},
+		{
+			MethodName: "SplitTunnelGetStatus",
+			Handler:    _Daemon_SplitTunnelGetStatus_Handler,
+		},
+		{
+			MethodName: "SplitTunnelSetConfig",
+			Handler:    _Daemon_SplitTunnelSetConfig_Handler,
+		},
+		{
+			MethodName: "SplitTunnelSetDestinations",
+			Handler:    _Daemon_SplitTunnelSetDestinations_Handler,
+		},
+		{
+			MethodName: "SplitTunnelSetContainers",
+			Handler:    _Daemon_SplitTunnelSetContainers_Handler,
+		},
+		{
+			MethodName: "SplitTunnelSetUsers",
+			Handler:    _Daemon_SplitTunnelSetUsers_Handler,
+		},
+		{
+			MethodName: "SplitTunnelAddApp",
+			Handler:    _Daemon_SplitTunnelAddApp_Handler,
+		},
+		{
+			MethodName: "SplitTunnelRemoveApp",
+			Handler:    _Daemon_SplitTunnelRemoveApp_Handler,
+		},
+		{
+			MethodName: "SplitTunnelAddedPidInfo",
+			Handler:    _Daemon_SplitTunnelAddedPidInfo_Handler,
+		},
+		{
+			MethodName: "GenerateDiagnostics",
+			Handler:    _Daemon_GenerateDiagnostics_Handler,
+		},
+		{
+			MethodName: "PortForwardingGetStatus",
+			Handler:    _Daemon_PortForwardingGetStatus_Handler,
+		},
+		{
+			MethodName: "PortForwardingRequest",
+			Handler:    _Daemon_PortForwardingRequest_Handler,
+		},
+		{
+			MethodName: "PortForwardingRelease",
+			Handler:    _Daemon_PortForwardingRelease_Handler,
+		},
+		{
+			MethodName: "CaptivePortalCheck",
+			Handler:    _Daemon_CaptivePortalCheck_Handler,
+		},
+		{
+			MethodName: "CaptivePortalAllowLogin",
+			Handler:    _Daemon_CaptivePortalAllowLogin_Handler,
+		},
+		{
+			MethodName: "CaptivePortalReLock",
+			Handler:    _Daemon_CaptivePortalReLock_Handler,
+		},
+		{
+			MethodName: "SetAlternateDns",
+			Handler:    _Daemon_SetAlternateDns_Handler,
+		},
+		{
+			MethodName: "GetDnsPredefinedConfigs",
+			Handler:    _Daemon_GetDnsPredefinedConfigs_Handler,
+		},
+		{
+			MethodName: "PauseConnection",
+			Handler:    _Daemon_PauseConnection_Handler,
+		},
+		{
+			MethodName: "ResumeConnection",
+			Handler:    _Daemon_ResumeConnection_Handler,
+		},
+		{
+			MethodName: "SessionNew",
+			Handler:    _Daemon_SessionNew_Handler,
+		},
+		{
+			MethodName: "SessionDelete",
+			Handler:    _Daemon_SessionDelete_Handler,
+		},
+		{
+			MethodName: "DevicesList",
+			Handler:    _Daemon_DevicesList_Handler,
+		},
+		{
+			MethodName: "DeviceLogout",
+			Handler:    _Daemon_DeviceLogout_Handler,
+		},
+		{
+			MethodName: "AccountsGet",
+			Handler:    _Daemon_AccountsGet_Handler,
+		},
+		{
+			MethodName: "AccountSwitch",
+			Handler:    _Daemon_AccountSwitch_Handler,
+		},
+		{
+			MethodName: "AccountRemove",
+			Handler:    _Daemon_AccountRemove_Handler,
+		},
+		{
+			MethodName: "AccountStatus",
+			Handler:    _Daemon_AccountStatus_Handler,
+		},
+		{
+			MethodName: "WireGuardGenerateNewKeys",
+			Handler:    _Daemon_WireGuardGenerateNewKeys_Handler,
+		},
+		{
+			MethodName: "WireGuardSetKeysRotationInterval",
+			Handler:    _Daemon_WireGuardSetKeysRotationInterval_Handler,
+		},
+		{
+			MethodName: "GetAppIcon",
+			Handler:    _Daemon_GetAppIcon_Handler,
+		},
+		{
+			MethodName: "GetInstalledApps",
+			Handler:    _Daemon_GetInstalledApps_Handler,
+		},
+		{
+			MethodName: "WiFiCurrentNetwork",
+			Handler:    _Daemon_WiFiCurrentNetwork_Handler,
+		},
+		{
+			MethodName: "WiFiSettings",
+			Handler:    _Daemon_WiFiSettings_Handler,
+		},
+		{
+			MethodName: "ScheduleSettings",
+			Handler:    _Daemon_ScheduleSettings_Handler,
+		},
+		{
+			MethodName: "Disconnect",
+			Handler:    _Daemon_Disconnect_Handler,
+		},
+		{
+			MethodName: "ConnectSettingsGet",
+			Handler:    _Daemon_ConnectSettingsGet_Handler,
+		},
+		{
+			MethodName: "ConnectSettings",
+			Handler:    _Daemon_ConnectSettings_Handler,
+		},
+		{
+			MethodName: "Connect",
+			Handler:    _Daemon_Connect_Handler,
+		},
+		{
+			MethodName: "ConnectionHistoryGet",
+			Handler:    _Daemon_ConnectionHistoryGet_Handler,
+		},
+		{
+			MethodName: "AuditLogGet",
+			Handler:    _Daemon_AuditLogGet_Handler,
+		},
+		{
+			MethodName: "LogStreamStart",
+			Handler:    _Daemon_LogStreamStart_Handler,
+		},
+		{
+			MethodName: "LogStreamStop",
+			Handler:    _Daemon_LogStreamStop_Handler,
+		},
+		{
+			MethodName: "ShellCommandsGet",
+			Handler:    _Daemon_ShellCommandsGet_Handler,
+		},
+		{
+			MethodName: "CrashReportsGet",
+			Handler:    _Daemon_CrashReportsGet_Handler,
+		},
+		{
+			MethodName: "CrashReportsClear",
+			Handler:    _Daemon_CrashReportsClear_Handler,
+		},
+		{
+			MethodName: "GetSubsystemStatus",
+			Handler:    _Daemon_GetSubsystemStatus_Handler,
+		},
+		{
+			MethodName: "OperationStart",
+			Handler:    _Daemon_OperationStart_Handler,
+		},
+		{
+			MethodName: "SpeedTestStart",
+			Handler:    _Daemon_SpeedTestStart_Handler,
+		},
+		{
+			MethodName: "OperationCancel",
+			Handler:    _Daemon_OperationCancel_Handler,
+		},
+		{
+			MethodName: "OperationsGet",
+			Handler:    _Daemon_OperationsGet_Handler,
+		},
+		{
+			MethodName: "HostsHealthGet",
+			Handler:    _Daemon_HostsHealthGet_Handler,
+		},
+		{
+			MethodName: "ConnectionHistoryClear",
+			Handler:    _Daemon_ConnectionHistoryClear_Handler,
+		},
+		{
+			MethodName: "ConnectionHistoryConnect",
+			Handler:    _Daemon_ConnectionHistoryConnect_Handler,
+		},
+		{
+			MethodName: "ConnectionStatsHistoryGet",
+			Handler:    _Daemon_ConnectionStatsHistoryGet_Handler,
+		},
+		{
+			MethodName: "ConnectionStatsHistoryClear",
+			Handler:    _Daemon_ConnectionStatsHistoryClear_Handler,
+		},
+		{
+			MethodName: "ThroughputHistoryGet",
+			Handler:    _Daemon_ThroughputHistoryGet_Handler,
+		},
+		{
+			MethodName: "ConnectionQualityHistoryGet",
+			Handler:    _Daemon_ConnectionQualityHistoryGet_Handler,
+		},
+		{
+			MethodName: "ConnectionProfilesGet",
+			Handler:    _Daemon_ConnectionProfilesGet_Handler,
+		},
+		{
+			MethodName: "ConnectionProfileSave",
+			Handler:    _Daemon_ConnectionProfileSave_Handler,
+		},
+		{
+			MethodName: "ConnectionProfileRemove",
+			Handler:    _Daemon_ConnectionProfileRemove_Handler,
+		},
+		{
+			MethodName: "ConnectionProfileConnect",
+			Handler:    _Daemon_ConnectionProfileConnect_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Events",
+			Handler:       _Daemon_Events_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "ivpn.proto",
+}
@@ -24,28 +24,45 @@ package protocol
 
 import (
 	"bufio"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
 	"net"
-	"regexp"
+	"net/http"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/auditlog"
+	"github.com/ivpn/desktop-app/daemon/awg"
+	"github.com/ivpn/desktop-app/daemon/crashreport"
 	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/obfsproxy"
+	"github.com/ivpn/desktop-app/daemon/operations"
 	"github.com/ivpn/desktop-app/daemon/oshelpers"
 	"github.com/ivpn/desktop-app/daemon/protocol/eaa"
 	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/captiveportal"
+	"github.com/ivpn/desktop-app/daemon/service/connstats"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
+	"github.com/ivpn/desktop-app/daemon/service/hostshealth"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
+	"github.com/ivpn/desktop-app/daemon/service/portforwarding"
 	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"github.com/ivpn/desktop-app/daemon/service/speedtest"
+	"github.com/ivpn/desktop-app/daemon/service/subsystems"
 	service_types "github.com/ivpn/desktop-app/daemon/service/types"
+	"github.com/ivpn/desktop-app/daemon/shadowsocks"
+	"github.com/ivpn/desktop-app/daemon/shell"
+	"github.com/ivpn/desktop-app/daemon/splittun"
+	"github.com/ivpn/desktop-app/daemon/v2r"
 	"github.com/ivpn/desktop-app/daemon/vpn"
+	"google.golang.org/grpc"
 )
 
 var log *logger.Logger
@@ -74,23 +91,30 @@ type Service interface {
 
 	PingServers(timeoutMs int, vpnTypePrioritized vpn.Type, pingAllHostsOnFirstPhase bool, skipSecondPhase bool) (map[string]int, error)
 
-	APIRequest(apiAlias string, ipTypeRequired types.RequiredIPProtocol) (responseData []byte, err error)
+	APIRequest(apiAlias string, ipTypeRequired types.RequiredIPProtocol) (responseData []byte, cachedAt time.Time, err error)
 
 	KillSwitchState() (isEnabled, isPersistant, isAllowLAN, isAllowLanMulticast, isAllowApiServers bool, fwUserExceptions string, err error)
 	SetKillSwitchState(bool) error
 	SetKillSwitchIsPersistent(isPersistant bool) error
 	SetKillSwitchAllowLANMulticast(isAllowLanMulticast bool) error
 	SetKillSwitchAllowLAN(isAllowLan bool) error
+	SetKillSwitchAllowLANServices(services lansvc.Services) error
+	SetKillSwitchAllowedLanHosts(hosts string) error
+	SetKillSwitchLanInboundPorts(ports []lansvc.InboundPort) error
 	SetKillSwitchAllowAPIServers(isAllowAPIServers bool) error
 	SetKillSwitchUserExceptions(exceptions string, ignoreParsingErrors bool) error
 
 	GetConnectionParams() service_types.ConnectionParams
 	SetConnectionParams(params service_types.ConnectionParams) error
 	SetWiFiSettings(params preferences.WiFiParams) error
+	SetScheduleSettings(params preferences.ScheduleParams) error
 
 	SplitTunnelling_SetConfig(isEnabled bool, reset bool) error
+	SplitTunnelling_SetDestinations(destinations []splittun.Destination) error
+	SplitTunnelling_SetContainers(containers []splittun.Container) error
+	SplitTunnelling_SetUsers(users []splittun.User) error
 	SplitTunnelling_GetStatus() (types.SplitTunnelStatus, error)
-	SplitTunnelling_AddApp(exec string) (cmdToExecute string, isAlreadyRunning bool, err error)
+	SplitTunnelling_AddApp(exec string, requesterUid int) (cmdToExecute string, isAlreadyRunning bool, err error)
 	SplitTunnelling_RemoveApp(pid int, exec string) (err error)
 	SplitTunnelling_AddedPidInfo(pid int, exec string, cmdToExecute string) error
 
@@ -100,6 +124,15 @@ type Service interface {
 	Preferences() preferences.Preferences
 	SetPreference(key types.ServicePreference, val string) (isChanged bool, err error)
 	SetObfsProxy(cfg obfsproxy.Config) error
+	SetV2RayProxy(transport v2r.V2RayTransportType) error
+	SetShadowsocksProxy(cfg shadowsocks.Config) error
+	SetAmneziaWG(cfg awg.Config) error
+	SetApiProxy(cfg api_types.ProxyConfig) error
+	SetApiHostOverride(cfg api_types.APIHostOverride) error
+	SetOpenVpnExtraParameters(params string) error
+	SetOpenVpnCryptoPolicy(policy vpn.OpenVpnCryptoPolicy) error
+	SetRestApiParams(isEnabled bool, port int, resetToken bool, isMetricsEnabled bool) (preferences.RestApiParams, error)
+	SetGrpcApiParams(isEnabled bool, port int, resetToken bool) (preferences.GrpcApiParams, error)
 	SetUserPreferences(userPrefs preferences.UserPreferences) (err error)
 	ResetPreferences() error
 
@@ -110,21 +143,58 @@ type Service interface {
 
 	IsCanConnectMultiHop() error
 	Connect(params service_types.ConnectionParams) error
+	// SwitchServer tries to switch the active WireGuard connection to a new server without disconnection.
+	// When 'isSwitched' is false - the regular reconnection required.
+	SwitchServer(params service_types.ConnectionParams) (isSwitched bool, err error)
+
+	ConnectionHistory() []preferences.ConnectionHistoryItem
+	ConnectionHistoryClear() error
+
+	ConnectionStatsHistory(sessionsLimit, daysLimit int) ([]connstats.SessionRecord, []connstats.DayRecord)
+	ConnectionStatsHistoryClear() error
+	ThroughputHistory(limit int) []connstats.ThroughputSample
+	ConnectionQualityHistory(limit int) []connstats.QualityReport
+	ConnectionLatencyMs() float64
+
+	ConnectionProfiles() []preferences.ConnectionProfile
+	ConnectionProfileSave(profile preferences.ConnectionProfile) error
+	ConnectionProfileRemove(name string) error
+	ConnectionProfileApply(name string) (service_types.ConnectionParams, error)
+
+	SetLogRotation(cfg logger.RotationConfig) error
+
+	ClientTokens() []preferences.ClientToken
+	ClientTokenAdd(name string, scope preferences.ClientAccessScope) (preferences.ClientToken, error)
+	ClientTokenRemove(name string) error
+
+	ExportSettings(includeSecrets bool) preferences.ExportedSettings
+	ImportSettings(settings preferences.ExportedSettings) (warnings []string, err error)
+
 	Disconnect() error
 	Connected() bool
+	VpnTrafficStats() (rx, tx uint64, ok bool)
+	VpnLatestHandshake() (t time.Time, ok bool)
+	ApiRequestsStats() (total, failed uint64)
 
 	Pause() error
 	Resume() error
 	IsPaused() bool
 
-	SessionNew(accountID string, forceLogin bool, captchaID string, captcha string, confirmation2FA string) (
+	SessionNew(accountID string, forceLogin bool, keepCurrentAccount bool, captchaID string, captcha string, confirmation2FA string) (
 		apiCode int,
 		apiErrorMsg string,
 		accountInfo preferences.AccountStatus,
+		captchaInfo api_types.CaptchaInfo,
 		rawResponse string,
 		err error)
 
 	SessionDelete(isCanDeleteSessionLocally bool) error
+
+	StoredAccounts() []preferences.StoredAccount
+	AccountSwitch(accountID string) error
+	AccountRemove(accountID string) error
+	DevicesList(accountID string, confirmation2FA string) ([]api_types.DeviceInfo, error)
+	DeviceLogout(accountID string, confirmation2FA string, deviceID string) error
 	RequestSessionStatus() (
 		apiCode int,
 		apiErrorMsg string,
@@ -135,10 +205,26 @@ type Service interface {
 	WireGuardGenerateKeys(updateIfNecessary bool) error
 	WireGuardSetKeysRotationInterval(interval int64)
 
-	GetWiFiCurrentState() (ssid string, isInsecureNetwork bool)
+	PortForwardingStatus() portforwarding.State
+	PortForwardingRequest() (portforwarding.State, error)
+	PortForwardingRelease() error
+
+	CaptivePortalCheck() captiveportal.Status
+	CaptivePortalAllowLogin(duration time.Duration) (captiveportal.Status, error)
+	CaptivePortalReLock()
+	CaptivePortalStatus() captiveportal.Status
+
+	GetWiFiCurrentState() (ssid string, bssid string, isInsecureNetwork bool)
 	GetWiFiAvailableNetworks() []string
 
 	GetDiagnosticLogs() (logActive string, logPrevSession string, extraInfo string, err error)
+
+	OperationStart(opType string) (operations.Status, error)
+	OperationCancel(id uint64) (operations.Status, error)
+	OperationsRunning() []operations.Status
+	SpeedTestStart(params speedtest.Params) (operations.Status, error)
+
+	HostsHealth() []hostshealth.HostScore
 }
 
 // CreateProtocol - Create new protocol object
@@ -153,6 +239,15 @@ func CreateProtocol() (*Protocol, error) {
 type connectionInfo struct {
 	Type            types.ClientTypeEnum // UI or CLI
 	IsAuthenticated bool                 // true when connection fully authenticated (secret is OK and EAA check is passed)
+	Actor           *auditlog.Actor      // info about the client process (detected on first audit event; nil if not detected yet)
+	// notification types which the client is subscribed to (nil - all notifications)
+	NotificationsFilter notificationsFilter
+	// access level of the client
+	Scope preferences.ClientAccessScope
+	// client access token which was used for authentication (empty - the client is not authenticated by a client token)
+	AccessToken string
+	// stops the stream of the log messages to the client (nil - the stream is not started)
+	LogStreamStop func()
 }
 
 // Protocol - TCP interface to communicate with IVPN application
@@ -161,6 +256,15 @@ type Protocol struct {
 
 	// connections listener
 	_connListener *net.TCPListener
+	// local socket listener: unix domain socket or named pipe (nil - not in use)
+	_localListener net.Listener
+	// local REST API server (nil - not in use)
+	_restApiMutex  sync.Mutex
+	_restApiServer *http.Server
+	// local gRPC API server (nil - not in use)
+	_grpcApiMutex  sync.Mutex
+	_grpcApiServer *grpc.Server
+	_grpcApiCancel context.CancelFunc // closes the active events streams of the gRPC API
 
 	_connectionsMutex sync.RWMutex
 	_connections      map[net.Conn]connectionInfo
@@ -174,8 +278,12 @@ type Protocol struct {
 
 	_service Service
 
-	// keep info about last VPN state
-	_lastVPNState vpn.StateInfo
+	// keep info about last VPN state (use lastVPNState()/setLastVPNState() to access it)
+	_lastVPNState      vpn.StateInfo
+	_lastVPNStateMutex sync.RWMutex
+
+	// counters for the metrics (see restApiMetricsPath)
+	_metrics metricsCounters
 
 	_eaa *eaa.Eaa
 
@@ -201,6 +309,10 @@ func (p *Protocol) Stop() {
 		p._isRunning = false
 		// do not accept new incoming connections
 		listener.Close()
+		p.stopLocalSocketListener()
+		p.stopDBusService()
+		p.stopRestApi()
+		p.stopGrpcApi()
 
 		// Do not use any send\receive communications with connected clients after listener stopped
 	}
@@ -214,6 +326,9 @@ func (p *Protocol) Start(secret uint64, startedOnPort chan<- int, service Servic
 	p._service = service
 	p._secret = secret
 
+	// notify clients about recovered panics
+	crashreport.SetNotifier(p.onCrashRecovered)
+
 	p._isRunning = true
 	defer func() {
 		p._isRunning = false
@@ -253,6 +368,26 @@ func (p *Protocol) Start(secret uint64, startedOnPort chan<- int, service Servic
 		log.Info("Listener closed")
 	}()
 
+	// serve the clients over the local socket (if supported on this platform)
+	if err := p.startLocalSocketListener(); err != nil {
+		log.Error(err)
+	}
+	defer p.stopLocalSocketListener()
+
+	// register the D-Bus interface (if supported on this platform)
+	if err := p.startDBusService(); err != nil {
+		log.Warning(err)
+	}
+	defer p.stopDBusService()
+
+	// start the local REST API (if enabled)
+	p.restartRestApi()
+	defer p.stopRestApi()
+
+	// start the local gRPC API (if enabled)
+	p.restartGrpcApi()
+	defer p.stopGrpcApi()
+
 	// Start processing of new connection requests
 	// (connection requests collecting in to chain and processing in order they were received.
 	// See also "RegisterConnectionRequest()" for details)
@@ -282,10 +417,7 @@ func (p *Protocol) processClient(conn net.Conn) {
 
 	defer func() {
 		if r := recover(); r != nil {
-			log.Error("PANIC during client communication!: ", r)
-			if err, ok := r.(error); ok {
-				log.ErrorTrace(err)
-			}
+			crashreport.Save("processClient", r)
 		}
 
 		p.clientDisconnected(conn)
@@ -334,7 +466,24 @@ func (p *Protocol) processClient(conn net.Conn) {
 				p.sendErrorResponse(conn, cmd, fmt.Errorf("connection authentication error: %w", err))
 				return
 			}
-			if hello.Secret != p._secret {
+			scope := preferences.ClientScopeFull
+			if len(hello.AccessToken) > 0 {
+				// the client is authenticated by the client access token: the access level is defined by the token
+				prefs := p._service.Preferences()
+				token, ok := prefs.FindClientToken(hello.AccessToken)
+				if !ok {
+					log.Warning(fmt.Errorf("refusing connection: access token verification error"))
+					p.sendErrorResponse(conn, cmd, fmt.Errorf("access token verification error"))
+					return
+				}
+				scope = token.Scope
+				log.Info(fmt.Sprintf("%sClient authenticated by the access token '%s' (scope: %s)", p.connLogID(conn), token.Name, scope))
+				if scope == preferences.ClientScopeReadOnly {
+					// read-only clients are not considered as UI clients (e.g. they do not allow background actions when EAA enabled)
+					hello.ClientType = types.ClientCli
+				}
+			} else if hello.Secret != p._secret && !isLocalSocketConnection(conn) {
+				// (the clients connected over the local socket are authenticated by the socket permissions)
 				log.Warning(fmt.Errorf("refusing connection: secret verification error"))
 				p.sendErrorResponse(conn, cmd, fmt.Errorf("secret verification error"))
 				return
@@ -342,7 +491,7 @@ func (p *Protocol) processClient(conn net.Conn) {
 
 			// AUTHENTICATED
 			isAuthenticated = true
-			p.clientConnected(conn, hello.ClientType)
+			p.clientConnected(conn, hello.ClientType, scope, hello.AccessToken)
 		}
 
 		// Processing requests from client (in separate routine)
@@ -353,10 +502,7 @@ func (p *Protocol) processClient(conn net.Conn) {
 func (p *Protocol) processRequest(conn net.Conn, message string) {
 	defer func() {
 		if r := recover(); r != nil {
-			log.Error(fmt.Sprintf("%sPANIC during processing request!: ", p.connLogID(conn)), r)
-			if err, ok := r.(error); ok {
-				log.ErrorTrace(err)
-			}
+			crashreport.Save("processRequest", r)
 			log.Info(fmt.Sprintf("%sClosing connection and recovering state", p.connLogID(conn)))
 			conn.Close()
 		}
@@ -376,6 +522,7 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 
 		switch commandName {
 		case "Hello",
+			"SetNotificationsFilter",
 			"GetVPNState",
 			"GetServers",
 			"PingServers",
@@ -392,7 +539,7 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 	}
 
 	sendState := func(reqIdx int, isOnlyIfConnected bool) {
-		vpnState := p._lastVPNState
+		vpnState := p.lastVPNState()
 		if vpnState.State == vpn.CONNECTED {
 			p.sendResponse(conn, p.createConnectedResponse(vpnState), reqIdx)
 		} else if !isOnlyIfConnected {
@@ -404,6 +551,11 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 		}
 	}
 
+	if err := p.checkRequestAccess(conn, reqCmd.Command); err != nil {
+		p.sendErrorResponse(conn, reqCmd, err)
+		return
+	}
+
 	if !p._eaa.IsEnabled() {
 		// EAA is disabled. So, mark connection as authenticated
 		p.clientSetAuthenticated(conn)
@@ -453,11 +605,18 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 			p.sendErrorResponse(conn, reqCmd, err)
 		}
 
-		log.Info(fmt.Sprintf("%sConnected client version: '%s'", p.connLogID(conn), req.Version))
+		if len(req.NotificationsFilter) > 0 {
+			p.clientSetNotificationsFilter(conn, req.NotificationsFilter)
+		}
+
+		log.Info(fmt.Sprintf("%sConnected client version: '%s' (protocol version: %d)", p.connLogID(conn), req.Version, req.ProtocolVersion))
+		if req.ProtocolVersion > types.ProtocolVersion {
+			log.Warning(fmt.Sprintf("%sClient protocol version (%d) is newer than the daemon protocol version (%d)", p.connLogID(conn), req.ProtocolVersion, types.ProtocolVersion))
+		}
 
 		// send back Hello message with account session info
 		helloResponse := p.createHelloResponse()
-		p.sendResponse(conn, helloResponse, req.Idx)
+		p.sendResponse(conn, responseForScope(helloResponse, p.connScope(conn)), req.Idx)
 		if req.SendResponseToAllClients {
 			p.notifyClients(helloResponse)
 		}
@@ -482,7 +641,10 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 						IsAllowLAN:        isAllowLAN,
 						IsAllowMulticast:  isAllowLanMulticast,
 						IsAllowApiServers: isAllowApiServers,
-						UserExceptions:    fwUserExceptions}, reqCmd.Idx)
+						UserExceptions:    fwUserExceptions,
+						AllowLANServices:  p._service.Preferences().FwAllowLanServices,
+						AllowedLANHosts:   p._service.Preferences().FwAllowedLanHosts,
+						LANInboundPorts:   p._service.Preferences().FwLanInboundPorts}, reqCmd.Idx)
 			}
 		}
 
@@ -496,6 +658,15 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 			p.OnWiFiChanged(p._service.GetWiFiCurrentState())
 		}
 
+	case "SetNotificationsFilter":
+		var req types.SetNotificationsFilter
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.clientSetNotificationsFilter(conn, req.Notifications)
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
 	case "ParanoidModeSetPasswordReq":
 		var req types.ParanoidModeSetPasswordReq
 		if err := json.Unmarshal(messageData, &req); err != nil {
@@ -506,6 +677,7 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 		if err := p._eaa.SetSecret(req.ProtocolSecret, req.NewSecret); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 		} else {
+			p.audit(conn, auditlog.EventEaa, fmt.Sprintf("IsEnabled: %t", p._eaa.IsEnabled()))
 			// send 'success' response to the requestor
 			p.notifyClients(p.createHelloResponse())
 			p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
@@ -575,12 +747,17 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 			break
 		}
 
-		data, err := p._service.APIRequest(req.APIPath, req.IPProtocolRequired)
+		data, cachedAt, err := p._service.APIRequest(req.APIPath, req.IPProtocolRequired)
 		if err != nil {
 			p.sendResponse(conn, &types.APIResponse{APIPath: req.APIPath, Error: err.Error()}, req.Idx)
 			break
 		}
-		p.sendResponse(conn, &types.APIResponse{APIPath: req.APIPath, ResponseData: string(data)}, req.Idx)
+		resp := &types.APIResponse{APIPath: req.APIPath, ResponseData: string(data)}
+		if !cachedAt.IsZero() {
+			resp.IsStale = true
+			resp.CachedAt = cachedAt.Unix()
+		}
+		p.sendResponse(conn, resp, req.Idx)
 
 	case "WiFiAvailableNetworks":
 		networks := p._service.GetWiFiAvailableNetworks()
@@ -603,7 +780,10 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 					IsAllowLAN:        isAllowLAN,
 					IsAllowMulticast:  isAllowLanMulticast,
 					IsAllowApiServers: isAllowApiServers,
-					UserExceptions:    fwUserExceptions}, reqCmd.Idx)
+					UserExceptions:    fwUserExceptions,
+					AllowLANServices:  p._service.Preferences().FwAllowLanServices,
+					AllowedLANHosts:   p._service.Preferences().FwAllowedLanHosts,
+					LANInboundPorts:   p._service.Preferences().FwLanInboundPorts}, reqCmd.Idx)
 		}
 
 	case "KillSwitchSetEnabled":
@@ -617,6 +797,7 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
+		p.audit(conn, auditlog.EventKillSwitch, fmt.Sprintf("IsEnabled: %t", req.IsEnabled))
 
 		// send the response to the requestor
 		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
@@ -630,6 +811,7 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 		}
 
 		p._service.SetKillSwitchAllowLANMulticast(req.AllowLANMulticast)
+		p.audit(conn, auditlog.EventKillSwitchAllowLANMulticast, fmt.Sprintf("IsAllowed: %t", req.AllowLANMulticast))
 		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
 		// all clients will be notified in case of successful change by OnKillSwitchStateChanged() handler
 
@@ -641,6 +823,52 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 		}
 
 		p._service.SetKillSwitchAllowLAN(req.AllowLAN)
+		p.audit(conn, auditlog.EventKillSwitchAllowLAN, fmt.Sprintf("IsAllowed: %t", req.AllowLAN))
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
+		// all clients will be notified in case of successful change by OnKillSwitchStateChanged() handler
+
+	case "KillSwitchSetAllowLANServices":
+		var req types.KillSwitchSetAllowLANServices
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+
+		if err := p._service.SetKillSwitchAllowLANServices(req.Services); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.audit(conn, auditlog.EventKillSwitchAllowLANServices, fmt.Sprintf("Services: [%s]", req.Services))
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
+		// all clients will be notified in case of successful change by OnKillSwitchStateChanged() handler
+
+	case "KillSwitchSetAllowedLANHosts":
+		var req types.KillSwitchSetAllowedLANHosts
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+
+		if err := p._service.SetKillSwitchAllowedLanHosts(strings.TrimSpace(req.Hosts)); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.audit(conn, auditlog.EventKillSwitchAllowedLANHosts, fmt.Sprintf("Hosts: '%s'", strings.TrimSpace(req.Hosts)))
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
+		// all clients will be notified in case of successful change by OnKillSwitchStateChanged() handler
+
+	case "KillSwitchSetLANInboundPorts":
+		var req types.KillSwitchSetLANInboundPorts
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+
+		if err := p._service.SetKillSwitchLanInboundPorts(req.Ports); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.audit(conn, auditlog.EventKillSwitchLANInboundPorts, fmt.Sprintf("Ports: [%s]", lansvc.InboundPortsString(req.Ports)))
 		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
 		// all clients will be notified in case of successful change by OnKillSwitchStateChanged() handler
 
@@ -655,6 +883,7 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 		if err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 		} else {
+			p.audit(conn, auditlog.EventKillSwitchExceptions, fmt.Sprintf("Exceptions: '%s'", strings.TrimSpace(req.UserExceptions)))
 			p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
 		}
 		// all clients will be notified in case of successful change by OnKillSwitchStateChanged() handler
@@ -670,6 +899,7 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
+		p.audit(conn, auditlog.EventKillSwitchPersistent, fmt.Sprintf("IsPersistent: %t", req.IsPersistent))
 
 		// send the response to the requestor
 		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
@@ -685,6 +915,7 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
+		p.audit(conn, auditlog.EventKillSwitchAllowApiServers, fmt.Sprintf("IsAllowed: %t", req.IsAllowApiServers))
 
 		// send the response to the requestor
 		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
@@ -728,210 +959,539 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 		// send 'success' response to the requestor
 		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
 
-	case "SetUserPreferences":
-		func() {
-			defer func() {
-				//  notify all connected clients about changed (or not changed!) preferences
-				p.notifyClients(p.createSettingsResponse())
-			}()
-
-			var req types.SetUserPreferences
-			if err := json.Unmarshal(messageData, &req); err != nil {
-				p.sendErrorResponse(conn, reqCmd, err)
-				return
-			}
-
-			if err := p._service.SetUserPreferences(req.UserPrefs); err != nil {
-				p.sendErrorResponse(conn, reqCmd, err)
-				return
-			}
-
-			// notify 'success'
-			p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
-		}()
+	case "SetV2RayProxy":
+		var req types.SetV2RayProxy
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
 
-	case "SplitTunnelGetStatus":
-		status, err := p._service.SplitTunnelling_GetStatus()
-		if err != nil {
+		if err := p._service.SetV2RayProxy(req.V2RayProxy); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
-		p.sendResponse(conn, &status, reqCmd.Idx)
 
-	case "SplitTunnelSetConfig":
-		var req types.SplitTunnelSetConfig
+		// notify all clients about change
+		p.notifyClients(p.createHelloResponse())
+		// send 'success' response to the requestor
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
+
+	case "SetApiProxy":
+		var req types.SetApiProxy
 		if err := json.Unmarshal(messageData, &req); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
-		if err := p._service.SplitTunnelling_SetConfig(req.IsEnabled, req.Reset); err != nil {
+
+		if err := p._service.SetApiProxy(req.Config); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
-		// all clients will be notified about configuration change by service in OnSplitTunnelStatusChanged() handler
+		p.audit(conn, auditlog.EventApiProxy, fmt.Sprintf("Type: '%s'; Address: '%s'", req.Config.Type, req.Config.Address))
 
-	case "SplitTunnelAddApp":
-		var req types.SplitTunnelAddApp
+		// notify all clients about change
+		p.notifyClients(p.createHelloResponse())
+		// send 'success' response to the requestor
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
+
+	case "SetApiHostOverride":
+		var req types.SetApiHostOverride
 		if err := json.Unmarshal(messageData, &req); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
-		// Description of Split Tunneling commands sequence to run the application:
-		//	[client]					[daemon]
-		//	SplitTunnelAddApp		->
-		//							<-	windows:	types.EmptyResp (success)
-		//							<-	linux:		types.SplitTunnelAddAppCmdResp (some operations required on client side)
-		//	<windows: done>
-		// 	<execute shell command: types.SplitTunnelAddAppCmdResp.CmdToExecute and get PID>
-		//  SplitTunnelAddedPidInfo	->
-		// 							<-	types.EmptyResp (success)
-		cmdToExecute, isAlreadyRunning, err := p._service.SplitTunnelling_AddApp(req.Exec)
-		if err != nil {
+
+		if err := p._service.SetApiHostOverride(req.Config); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
-		if len(cmdToExecute) <= 0 {
-			p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
-			return
-		}
+		p.audit(conn, auditlog.EventApiHostOverride, fmt.Sprintf("BaseURL: '%s'; CertHashes: %v", req.Config.BaseURL, req.Config.CertHashes))
 
-		isRunningWarningMes := ""
-		if isAlreadyRunning {
-			isRunningWarningMes = "It appears the application is already running.\nSome applications must be closed before launching them in the Split Tunneling environment or they may not be excluded from the VPN tunnel."
-		}
-		p.sendResponse(conn,
-			&types.SplitTunnelAddAppCmdResp{
-				Exec:                    req.Exec,
-				CmdToExecute:            cmdToExecute,
-				IsAlreadyRunning:        isAlreadyRunning,
-				IsAlreadyRunningMessage: isRunningWarningMes},
-			reqCmd.Idx)
-		// all clients will be notified about configuration change by service in OnSplitTunnelStatusChanged() handler
+		// notify all clients about change
+		p.notifyClients(p.createHelloResponse())
+		// send 'success' response to the requestor
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
 
-	case "SplitTunnelRemoveApp":
-		var req types.SplitTunnelRemoveApp
+	case "SetOpenVpnExtraParameters":
+		var req types.SetOpenVpnExtraParameters
 		if err := json.Unmarshal(messageData, &req); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
-		if err := p._service.SplitTunnelling_RemoveApp(req.Pid, req.Exec); err != nil {
+
+		if err := p._service.SetOpenVpnExtraParameters(req.Params); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
-		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
-		// all clients will be notified about configuration change by service in OnSplitTunnelStatusChanged() handler
+		p.audit(conn, auditlog.EventOpenVpnExtraParameters, fmt.Sprintf("Params: '%s'", strings.ReplaceAll(p._service.Preferences().OpenVpnExtraParameters, "\n", "; ")))
 
-	case "SplitTunnelAddedPidInfo":
-		var req types.SplitTunnelAddedPidInfo
+		// notify all clients about change
+		p.notifyClients(p.createHelloResponse())
+		// send 'success' response to the requestor
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
+
+	case "SetOpenVpnCryptoPolicy":
+		var req types.SetOpenVpnCryptoPolicy
 		if err := json.Unmarshal(messageData, &req); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
 
-		if err := p._service.SplitTunnelling_AddedPidInfo(req.Pid, req.Exec, req.CmdToExecute); err != nil {
+		if err := p._service.SetOpenVpnCryptoPolicy(req.Policy); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
-		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
-
-	case "GenerateDiagnostics":
-		if log, log0, extraInfo, err := p._service.GetDiagnosticLogs(); err != nil {
-			p.sendErrorResponse(conn, reqCmd, err)
-		} else {
-			p.sendResponse(conn, &types.DiagnosticsGeneratedResp{Log1_Active: log, Log0_Old: log0, ExtraInfo: extraInfo}, reqCmd.Idx)
-		}
-
-	case "SetAlternateDns":
-		{
-			var req types.SetAlternateDns
-			if err := json.Unmarshal(messageData, &req); err != nil {
-				p.sendErrorResponse(conn, reqCmd, err)
-				break
-			}
-
-			// wrapper around strings.Fields(). Returns only first field or empty string.
-			getSingleField := func(s string) string {
-				fields := strings.Fields(s)
-				if len(fields) <= 0 {
-					return ""
-				}
-				return fields[0]
-			}
-			req.Dns.DnsHost = getSingleField(req.Dns.DnsHost)
-			req.Dns.DohTemplate = getSingleField(req.Dns.DohTemplate)
+		p.audit(conn, auditlog.EventOpenVpnCryptoPolicy, req.Policy.String())
 
-			changedDns := dns.DnsSettings{}
-			var err error
-			if req.Dns.IsEmpty() && !req.AntiTracker.IsEnabled() {
-				err = p._service.ResetManualDNS()
-			} else {
-				changedDns, err = p._service.SetManualDNS(req.Dns, req.AntiTracker)
+		// notify all clients about change
+		p.notifyClients(p.createHelloResponse())
+		// send 'success' response to the requestor
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
 
-				if err != nil {
-					// DNS set failed. Trying to reset DNS
-					errReset := p._service.ResetManualDNS()
-					if errReset != nil {
-						log.ErrorTrace(errReset)
-					}
-				}
-			}
+	case "RestApiGet":
+		p.sendResponse(conn, &types.RestApiResp{Params: p._service.Preferences().RestApi}, reqCmd.Idx)
 
-			if err != nil {
-				log.ErrorTrace(err)
-				// send the response to the requestor
-				p.sendResponse(conn, &types.SetAlternateDNSResp{IsSuccess: false, ErrorMessage: err.Error()}, req.Idx)
-			} else {
-				// notify all connected clients
-				p.notifyClients(&types.SetAlternateDNSResp{IsSuccess: true, ChangedDNS: changedDns})
-				// send the response to the requestor
-				p.sendResponse(conn, &types.SetAlternateDNSResp{IsSuccess: true, ChangedDNS: changedDns}, req.Idx)
-			}
+	case "SetRestApi":
+		var req types.SetRestApi
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
 		}
-	case "GetDnsPredefinedConfigs":
-		cfgs, err := dns.GetPredefinedDnsConfigurations()
+
+		params, err := p._service.SetRestApiParams(req.IsEnabled, req.Port, req.ResetToken, req.IsMetricsEnabled)
 		if err != nil {
-			log.ErrorTrace(err)
 			p.sendErrorResponse(conn, reqCmd, err)
-		} else {
-			p.sendResponse(conn, &types.DnsPredefinedConfigsResp{DnsConfigs: cfgs}, reqCmd.Idx)
+			break
 		}
+		p.audit(conn, auditlog.EventRestApi, fmt.Sprintf("IsEnabled: %t; Port: %d; ResetToken: %t; IsMetricsEnabled: %t", params.IsEnabled, params.Port, req.ResetToken, params.IsMetricsEnabled))
 
-	case "PauseConnection":
-		if err := p._service.Pause(); err != nil {
+		// send the response to the requestor before restarting the REST API (the request can be received over the REST API)
+		p.sendResponse(conn, &types.RestApiResp{Params: params}, req.Idx)
+		p.restartRestApi()
+
+	case "GrpcApiGet":
+		p.sendResponse(conn, &types.GrpcApiResp{Params: p._service.Preferences().GrpcApi}, reqCmd.Idx)
+
+	case "SetGrpcApi":
+		var req types.SetGrpcApi
+		if err := json.Unmarshal(messageData, &req); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
 
-		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
-
-	case "ResumeConnection":
-		if err := p._service.Resume(); err != nil {
+		params, err := p._service.SetGrpcApiParams(req.IsEnabled, req.Port, req.ResetToken)
+		if err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
 			break
 		}
+		p.audit(conn, auditlog.EventGrpcApi, fmt.Sprintf("IsEnabled: %t; Port: %d; ResetToken: %t", params.IsEnabled, params.Port, req.ResetToken))
 
-		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+		// send the response to the requestor before restarting the gRPC API (the request can be received over the gRPC API)
+		p.sendResponse(conn, &types.GrpcApiResp{Params: params}, req.Idx)
+		p.restartGrpcApi()
 
-	case "SessionNew":
-		var req types.SessionNew
+	case "SetLogRotation":
+		var req types.SetLogRotation
 		if err := json.Unmarshal(messageData, &req); err != nil {
 			p.sendErrorResponse(conn, reqCmd, err)
-			break
+			return
+		}
+		if err := p._service.SetLogRotation(req.Config); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
 		}
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
+		// notify all clients about changed settings
+		p.notifyClients(p.createSettingsResponse())
 
-		// validate AccountID value
-		matched, err := regexp.MatchString("^(i-....-....-....)|(ivpn[a-zA-Z0-9]{7,8})$", req.AccountID)
-		if err != nil {
-			p.sendError(conn, fmt.Sprintf("[daemon] Account ID validation failed: %s", err), reqCmd.Idx)
-			break
+	case "LogLevelsGet":
+		p.sendResponse(conn, p.createLogLevelsResponse(), reqCmd.Idx)
+
+	case "SetLogLevel":
+		var req types.SetLogLevel
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
 		}
-		if !matched {
-			p.sendError(conn, "[daemon] Your account ID has to be in 'i-XXXX-XXXX-XXXX' or 'ivpnXXXXXXXX' format.", reqCmd.Idx)
-			break
+		if err := setLogLevel(req.Module, req.Level); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
 		}
+		log.Info(fmt.Sprintf("Log level changed (module: '%s'; level: '%s')", req.Module, req.Level))
+		p.sendResponse(conn, p.createLogLevelsResponse(), req.Idx)
 
-		var resp types.SessionNewResp
-		apiCode, apiErrMsg, accountInfo, rawResponse, err := p._service.SessionNew(req.AccountID, req.ForceLogin, req.CaptchaID, req.Captcha, req.Confirmation2FA)
+	case "ClientTokensGet":
+		p.sendResponse(conn, &types.ClientTokensResp{Tokens: p._service.ClientTokens()}, reqCmd.Idx)
+
+	case "ClientTokenAdd":
+		var req types.ClientTokenAdd
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		token, err := p._service.ClientTokenAdd(req.Name, req.Scope)
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.audit(conn, auditlog.EventClientTokens, fmt.Sprintf("Added: '%s' (scope: %s)", token.Name, token.Scope))
+		p.sendResponse(conn, &types.ClientTokensResp{Tokens: []preferences.ClientToken{token}}, req.Idx)
+
+	case "ClientTokenRemove":
+		var req types.ClientTokenRemove
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		if err := p._service.ClientTokenRemove(req.Name); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.audit(conn, auditlog.EventClientTokens, fmt.Sprintf("Removed: '%s'", req.Name))
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
+		p.disconnectClientsWithRemovedTokens()
+
+	case "SettingsExport":
+		var req types.SettingsExport
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		if req.IncludeSecrets {
+			p.audit(conn, auditlog.EventSettingsExport, "Secrets included")
+		}
+		p.sendResponse(conn, &types.SettingsExportResp{Settings: p._service.ExportSettings(req.IncludeSecrets)}, req.Idx)
+
+	case "SettingsImport":
+		var req types.SettingsImport
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		warnings, err := p._service.ImportSettings(req.Settings)
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.audit(conn, auditlog.EventSettingsImport, fmt.Sprintf("Exported at: %s; warnings: %d", req.Settings.ExportedAt.Format(time.RFC3339), len(warnings)))
+		p.sendResponse(conn, &types.SettingsImportResp{Warnings: warnings}, req.Idx)
+		// notify all clients about changed settings
+		p.notifyClients(p.createHelloResponse())
+
+	case "SetShadowsocksProxy":
+		var req types.SetShadowsocksProxy
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+
+		if err := p._service.SetShadowsocksProxy(req.Config); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+
+		// notify all clients about change
+		p.notifyClients(p.createHelloResponse())
+		// send 'success' response to the requestor
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
+
+	case "SetAmneziaWG":
+		var req types.SetAmneziaWG
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+
+		if err := p._service.SetAmneziaWG(req.Config); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+
+		// notify all clients about change
+		p.notifyClients(p.createHelloResponse())
+		// send 'success' response to the requestor
+		p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
+
+	case "SetUserPreferences":
+		func() {
+			defer func() {
+				//  notify all connected clients about changed (or not changed!) preferences
+				p.notifyClients(p.createSettingsResponse())
+			}()
+
+			var req types.SetUserPreferences
+			if err := json.Unmarshal(messageData, &req); err != nil {
+				p.sendErrorResponse(conn, reqCmd, err)
+				return
+			}
+
+			if err := p._service.SetUserPreferences(req.UserPrefs); err != nil {
+				p.sendErrorResponse(conn, reqCmd, err)
+				return
+			}
+
+			// notify 'success'
+			p.sendResponse(conn, &types.EmptyResp{}, req.Idx)
+		}()
+
+	case "SplitTunnelGetStatus":
+		status, err := p._service.SplitTunnelling_GetStatus()
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.sendResponse(conn, &status, reqCmd.Idx)
+
+	case "SplitTunnelSetConfig":
+		var req types.SplitTunnelSetConfig
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		if err := p._service.SplitTunnelling_SetConfig(req.IsEnabled, req.Reset); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.audit(conn, auditlog.EventSplitTunnel, fmt.Sprintf("IsEnabled: %t; Reset: %t", req.IsEnabled, req.Reset))
+		// all clients will be notified about configuration change by service in OnSplitTunnelStatusChanged() handler
+
+	case "SplitTunnelSetDestinations":
+		var req types.SplitTunnelSetDestinations
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		if err := p._service.SplitTunnelling_SetDestinations(req.Destinations); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.audit(conn, auditlog.EventSplitTunnelDestinations, fmt.Sprintf("%v", req.Destinations))
+		// all clients will be notified about configuration change by service in OnSplitTunnelStatusChanged() handler
+
+	case "SplitTunnelSetContainers":
+		var req types.SplitTunnelSetContainers
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		if err := p._service.SplitTunnelling_SetContainers(req.Containers); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.audit(conn, auditlog.EventSplitTunnelContainers, fmt.Sprintf("%v", req.Containers))
+		// all clients will be notified about configuration change by service in OnSplitTunnelStatusChanged() handler
+
+	case "SplitTunnelSetUsers":
+		var req types.SplitTunnelSetUsers
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		if err := p._service.SplitTunnelling_SetUsers(req.Users); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.audit(conn, auditlog.EventSplitTunnelUsers, fmt.Sprintf("%v", req.Users))
+		// all clients will be notified about configuration change by service in OnSplitTunnelStatusChanged() handler
+
+	case "SplitTunnelAddApp":
+		var req types.SplitTunnelAddApp
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		// Description of Split Tunneling commands sequence to run the application:
+		//	[client]					[daemon]
+		//	SplitTunnelAddApp		->
+		//							<-	windows:	types.EmptyResp (success)
+		//							<-	macOS:		types.EmptyResp (success; the application started by daemon)
+		//							<-	linux:		types.SplitTunnelAddAppCmdResp (some operations required on client side)
+		//	<windows, macOS: done>
+		// 	<execute shell command: types.SplitTunnelAddAppCmdResp.CmdToExecute and get PID>
+		//  SplitTunnelAddedPidInfo	->
+		// 							<-	types.EmptyResp (success)
+		cmdToExecute, isAlreadyRunning, err := p._service.SplitTunnelling_AddApp(req.Exec, p.connActor(conn).Uid)
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.audit(conn, auditlog.EventSplitTunnelAppAdded, req.Exec)
+		if len(cmdToExecute) <= 0 {
+			p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+			return
+		}
+
+		isRunningWarningMes := ""
+		if isAlreadyRunning {
+			isRunningWarningMes = "It appears the application is already running.\nSome applications must be closed before launching them in the Split Tunneling environment or they may not be excluded from the VPN tunnel."
+		}
+		p.sendResponse(conn,
+			&types.SplitTunnelAddAppCmdResp{
+				Exec:                    req.Exec,
+				CmdToExecute:            cmdToExecute,
+				IsAlreadyRunning:        isAlreadyRunning,
+				IsAlreadyRunningMessage: isRunningWarningMes},
+			reqCmd.Idx)
+		// all clients will be notified about configuration change by service in OnSplitTunnelStatusChanged() handler
+
+	case "SplitTunnelRemoveApp":
+		var req types.SplitTunnelRemoveApp
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		if err := p._service.SplitTunnelling_RemoveApp(req.Pid, req.Exec); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.audit(conn, auditlog.EventSplitTunnelAppRemoved, fmt.Sprintf("PID: %d; %s", req.Pid, req.Exec))
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+		// all clients will be notified about configuration change by service in OnSplitTunnelStatusChanged() handler
+
+	case "SplitTunnelAddedPidInfo":
+		var req types.SplitTunnelAddedPidInfo
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+
+		if err := p._service.SplitTunnelling_AddedPidInfo(req.Pid, req.Exec, req.CmdToExecute); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "GenerateDiagnostics":
+		if log, log0, extraInfo, err := p._service.GetDiagnosticLogs(); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+		} else {
+			p.sendResponse(conn, &types.DiagnosticsGeneratedResp{DiagnosticsInfo: types.DiagnosticsInfo{Log1_Active: log, Log0_Old: log0, ExtraInfo: extraInfo}}, reqCmd.Idx)
+		}
+
+	case "PortForwardingGetStatus":
+		p.sendResponse(conn, &types.PortForwardingStatusResp{State: p._service.PortForwardingStatus()}, reqCmd.Idx)
+
+	case "PortForwardingRequest":
+		state, err := p._service.PortForwardingRequest()
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.sendResponse(conn, &types.PortForwardingStatusResp{State: state}, reqCmd.Idx)
+
+	case "PortForwardingRelease":
+		if err := p._service.PortForwardingRelease(); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "CaptivePortalCheck":
+		p.sendResponse(conn, &types.CaptivePortalStatusResp{Status: p._service.CaptivePortalCheck()}, reqCmd.Idx)
+
+	case "CaptivePortalAllowLogin":
+		var req types.CaptivePortalAllowLogin
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		status, err := p._service.CaptivePortalAllowLogin(time.Duration(req.DurationSec) * time.Second)
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.sendResponse(conn, &types.CaptivePortalStatusResp{Status: status}, reqCmd.Idx)
+
+	case "CaptivePortalReLock":
+		p._service.CaptivePortalReLock()
+		p.sendResponse(conn, &types.CaptivePortalStatusResp{Status: p._service.CaptivePortalStatus()}, reqCmd.Idx)
+
+	case "SetAlternateDns":
+		{
+			var req types.SetAlternateDns
+			if err := json.Unmarshal(messageData, &req); err != nil {
+				p.sendErrorResponse(conn, reqCmd, err)
+				break
+			}
+
+			// wrapper around strings.Fields(). Returns only first field or empty string.
+			getSingleField := func(s string) string {
+				fields := strings.Fields(s)
+				if len(fields) <= 0 {
+					return ""
+				}
+				return fields[0]
+			}
+			req.Dns.DnsHost = getSingleField(req.Dns.DnsHost)
+			req.Dns.DohTemplate = getSingleField(req.Dns.DohTemplate)
+
+			changedDns := dns.DnsSettings{}
+			var err error
+			if req.Dns.IsEmpty() && !req.AntiTracker.IsEnabled() {
+				err = p._service.ResetManualDNS()
+			} else {
+				changedDns, err = p._service.SetManualDNS(req.Dns, req.AntiTracker)
+
+				if err != nil {
+					// DNS set failed. Trying to reset DNS
+					errReset := p._service.ResetManualDNS()
+					if errReset != nil {
+						log.ErrorTrace(errReset)
+					}
+				}
+			}
+
+			if err != nil {
+				log.ErrorTrace(err)
+				// send the response to the requestor
+				p.sendResponse(conn, &types.SetAlternateDNSResp{IsSuccess: false, ErrorMessage: err.Error()}, req.Idx)
+			} else {
+				// notify all connected clients
+				p.notifyClients(&types.SetAlternateDNSResp{IsSuccess: true, ChangedDNS: changedDns})
+				// send the response to the requestor
+				p.sendResponse(conn, &types.SetAlternateDNSResp{IsSuccess: true, ChangedDNS: changedDns}, req.Idx)
+			}
+		}
+	case "GetDnsPredefinedConfigs":
+		cfgs, err := dns.GetPredefinedDnsConfigurations()
+		if err != nil {
+			log.ErrorTrace(err)
+			p.sendErrorResponse(conn, reqCmd, err)
+		} else {
+			p.sendResponse(conn, &types.DnsPredefinedConfigsResp{DnsConfigs: cfgs}, reqCmd.Idx)
+		}
+
+	case "PauseConnection":
+		if err := p._service.Pause(); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "ResumeConnection":
+		if err := p._service.Resume(); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "SessionNew":
+		var req types.SessionNew
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+
+		// validate AccountID value
+		if err := validateAccountID(req.AccountID); err != nil {
+			p.sendError(conn, err.Error(), reqCmd.Idx)
+			break
+		}
+
+		var resp types.SessionNewResp
+		apiCode, apiErrMsg, accountInfo, captcha, rawResponse, err := p._service.SessionNew(req.AccountID, req.ForceLogin, req.KeepCurrentAccount, req.CaptchaID, req.Captcha, req.Confirmation2FA)
 		if err != nil {
 			if apiCode == 0 {
 				// if apiCode == 0 - it is not API error. Sending error response
@@ -944,8 +1504,13 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 				APIErrorMessage: apiErrMsg,
 				Session:         types.SessionResp{}, // empty session info
 				Account:         accountInfo,
-				RawResponse:     rawResponse}
+				RawResponse:     rawResponse,
+
+				Is2FARequired:     apiCode == api_types.The2FARequired || apiCode == api_types.The2FAInvalidToken,
+				IsCaptchaRequired: apiCode == api_types.CaptchaRequired || apiCode == api_types.CaptchaInvalid,
+				Captcha:           captcha}
 		} else {
+			p.audit(conn, auditlog.EventLogin, "")
 			// Success. Sending session info
 			resp = types.SessionNewResp{
 				APIStatus:       apiCode,
@@ -979,6 +1544,8 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 			break
 		}
 
+		p.audit(conn, auditlog.EventLogout, fmt.Sprintf("NeedToDisableFirewall: %t; NeedToResetSettings: %t", req.NeedToDisableFirewall, req.NeedToResetSettings))
+
 		if req.NeedToResetSettings {
 			// disable paranoid mode
 			p._eaa.ForceDisable()
@@ -997,14 +1564,106 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 			// set AllowLan and exceptions according to default values
 			p._service.SetKillSwitchAllowLAN(prefs.IsFwAllowLAN)
 			p._service.SetKillSwitchAllowLANMulticast(prefs.IsFwAllowLANMulticast)
+			p._service.SetKillSwitchAllowLANServices(prefs.FwAllowLanServices)
+			p._service.SetKillSwitchAllowedLanHosts(prefs.FwAllowedLanHosts)
+			p._service.SetKillSwitchLanInboundPorts(prefs.FwLanInboundPorts)
 			p._service.SetKillSwitchUserExceptions(prefs.FwUserExceptions, true)
+
+			// the REST and gRPC APIs are disabled by default
+			p.restartRestApi()
+			p.restartGrpcApi()
+		}
+
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+		// notify all clients about changed session status
+		p.notifyClients(p.createHelloResponse())
+
+	case "AccountsGet":
+		var resp types.AccountsResp
+		prefs := p._service.Preferences()
+		if prefs.Session.IsLoggedIn() {
+			resp.Accounts = append(resp.Accounts, types.AccountInfo{AccountID: prefs.Session.AccountID, IsActive: true, Account: prefs.Account})
 		}
+		for _, a := range p._service.StoredAccounts() {
+			resp.Accounts = append(resp.Accounts, types.AccountInfo{AccountID: a.Session.AccountID, Account: a.Account})
+		}
+		p.sendResponse(conn, &resp, reqCmd.Idx)
 
+	case "AccountSwitch":
+		var req types.AccountSwitch
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		if err := p._service.AccountSwitch(req.AccountID); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.audit(conn, auditlog.EventAccountSwitch, "")
 		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
 
 		// notify all clients about changed session status
 		p.notifyClients(p.createHelloResponse())
 
+	case "AccountRemove":
+		var req types.AccountRemove
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		if err := p._service.AccountRemove(req.AccountID); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.audit(conn, auditlog.EventLogout, "Stored (not active) account")
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "DevicesList":
+		var req types.DevicesList
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		if len(req.AccountID) > 0 {
+			if err := validateAccountID(req.AccountID); err != nil {
+				p.sendError(conn, err.Error(), reqCmd.Idx)
+				break
+			}
+		}
+
+		devices, err := p._service.DevicesList(req.AccountID, req.Confirmation2FA)
+		var apiErr api_types.APIError
+		if err != nil && !errors.As(err, &apiErr) {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		p.sendResponse(conn, &types.DevicesResp{APIStatus: apiErr.ErrorCode, APIErrorMessage: apiErr.Message, Devices: devices}, reqCmd.Idx)
+
+	case "DeviceLogout":
+		var req types.DeviceLogout
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		if len(req.AccountID) > 0 {
+			if err := validateAccountID(req.AccountID); err != nil {
+				p.sendError(conn, err.Error(), reqCmd.Idx)
+				break
+			}
+		}
+
+		err := p._service.DeviceLogout(req.AccountID, req.Confirmation2FA, req.DeviceID)
+		var apiErr api_types.APIError
+		if err != nil && !errors.As(err, &apiErr) {
+			p.sendErrorResponse(conn, reqCmd, err)
+			break
+		}
+		if err == nil {
+			p.audit(conn, auditlog.EventDeviceLogout, fmt.Sprintf("DeviceID: %s", req.DeviceID))
+		}
+		p.sendResponse(conn, &types.DevicesResp{APIStatus: apiErr.ErrorCode, APIErrorMessage: apiErr.Message}, reqCmd.Idx)
+
 	case "AccountStatus":
 		var resp types.AccountStatusResp
 		apiCode, apiErrMsg, sessionToken, accountInfo, err := p._service.RequestSessionStatus()
@@ -1075,9 +1734,10 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 
 	case "WiFiCurrentNetwork":
 		// sending WIFI info
-		ssid, isInsecureNetwork := p._service.GetWiFiCurrentState()
+		ssid, bssid, isInsecureNetwork := p._service.GetWiFiCurrentState()
 		p.sendResponse(conn, &types.WiFiCurrentNetworkResp{
 			SSID:              ssid,
+			BSSID:             bssid,
 			IsInsecureNetwork: isInsecureNetwork}, reqCmd.Idx)
 
 	case "WiFiSettings":
@@ -1095,9 +1755,26 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 		// notify all clients about changed wifi settings
 		p.notifyClients(p.createHelloResponse())
 
+	case "ScheduleSettings":
+		var r types.ScheduleSettings
+		if err := json.Unmarshal(messageData, &r); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		if err := p._service.SetScheduleSettings(r.Params); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.audit(conn, auditlog.EventSchedule, fmt.Sprintf("%+v", r.Params))
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+		// notify all clients about changed scheduler settings
+		p.notifyClients(p.createHelloResponse())
+
 	case "Disconnect":
 		p._disconnectRequested = true
 		p._lastConnectionErrorToNotifyClient = ""
+		p.audit(conn, auditlog.EventDisconnect, "")
 
 		if !p._service.Connected() {
 			p.sendResponse(conn, &types.DisconnectedResp{Reason: types.DisconnectRequested}, reqCmd.Idx)
@@ -1109,7 +1786,7 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 		}
 
 	case "ConnectSettingsGet":
-		p.sendResponse(conn, &types.ConnectSettings{Params: p._service.GetConnectionParams()}, reqCmd.Idx)
+		p.sendResponse(conn, responseForScope(&types.ConnectSettings{Params: p._service.GetConnectionParams()}, p.connScope(conn)), reqCmd.Idx)
 
 	case "ConnectSettings":
 		// Similar data to 'Connect' request but this command not start the connection.
@@ -1139,12 +1816,237 @@ func (p *Protocol) processRequest(conn net.Conn, message string) {
 			return
 		}
 
-		// Save last received connection request. It will be processed in separate routine 'processConnectionRequests()' which is already running
+		p.audit(conn, auditlog.EventConnect, connectRequest.Params.VpnType.String())
 		p.RegisterConnectionRequest(connectRequest.Params)
 
 		// send request confirmation to client
 		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
 
+	case "ConnectionHistoryGet":
+		p.sendResponse(conn, responseForScope(&types.ConnectionHistoryResp{
+			IsDisabled: p._service.Preferences().IsConnectionHistoryDisabled,
+			Items:      p._service.ConnectionHistory()}, p.connScope(conn)), reqCmd.Idx)
+
+	case "AuditLogGet":
+		var req types.AuditLogGet
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		events, err := auditlog.GetEvents(req.MaxCount)
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.AuditLogResp{Events: events}, reqCmd.Idx)
+
+	case "LogStreamStart":
+		var req types.LogStreamStart
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		level := logger.LevelDebug
+		if len(req.Level) > 0 {
+			var err error
+			if level, err = logger.ParseLevel(req.Level); err != nil {
+				p.sendErrorResponse(conn, reqCmd, err)
+				return
+			}
+		}
+		if err := p.logStreamStart(conn, level, req.Modules); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "LogStreamStop":
+		p.logStreamStop(conn)
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "ShellCommandsGet":
+		var req types.ShellCommandsGet
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.ShellCommandsResp{Commands: shell.History(req.MaxCount)}, reqCmd.Idx)
+
+	case "CrashReportsGet":
+		var req types.CrashReportsGet
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		reports, err := crashreport.Reports(req.WithStack)
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.CrashReportsResp{Reports: reports}, reqCmd.Idx)
+
+	case "CrashReportsClear":
+		if err := crashreport.Clear(); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "GetSubsystemStatus":
+		p.sendResponse(conn, &types.SubsystemStatusResp{Subsystems: subsystems.GetAll()}, reqCmd.Idx)
+
+	case "OperationStart":
+		var req types.OperationStart
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		status, err := p._service.OperationStart(req.Type)
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.OperationStatusResp{Operation: status}, reqCmd.Idx)
+
+	case "SpeedTestStart":
+		var req types.SpeedTestStart
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		status, err := p._service.SpeedTestStart(req.Params)
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.OperationStatusResp{Operation: status}, reqCmd.Idx)
+
+	case "OperationCancel":
+		var req types.OperationCancel
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		status, err := p._service.OperationCancel(req.OperationId)
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.OperationStatusResp{Operation: status}, reqCmd.Idx)
+
+	case "OperationsGet":
+		p.sendResponse(conn, responseForScope(&types.OperationsListResp{Operations: p._service.OperationsRunning()}, p.connScope(conn)), reqCmd.Idx)
+
+	case "HostsHealthGet":
+		p.sendResponse(conn, &types.HostsHealthResp{Hosts: p._service.HostsHealth()}, reqCmd.Idx)
+
+	case "ConnectionHistoryClear":
+		if err := p._service.ConnectionHistoryClear(); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "ConnectionStatsHistoryGet":
+		var req types.ConnectionStatsHistoryGet
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		sessions, days := p._service.ConnectionStatsHistory(req.SessionsLimit, req.DaysLimit)
+		p.sendResponse(conn, &types.ConnectionStatsHistoryResp{
+			IsDisabled: p._service.Preferences().IsConnectionStatsDisabled,
+			Sessions:   sessions,
+			Days:       days}, reqCmd.Idx)
+
+	case "ConnectionStatsHistoryClear":
+		if err := p._service.ConnectionStatsHistoryClear(); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "ThroughputHistoryGet":
+		var req types.ThroughputHistoryGet
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.ThroughputHistoryResp{
+			IntervalSec: int(connstats.ThroughputSampleInterval / time.Second),
+			Samples:     p._service.ThroughputHistory(req.MaxItems)}, reqCmd.Idx)
+
+	case "ConnectionQualityHistoryGet":
+		var req types.ConnectionQualityHistoryGet
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.ConnectionQualityHistoryResp{Reports: p._service.ConnectionQualityHistory(req.MaxItems)}, reqCmd.Idx)
+
+	case "ConnectionHistoryConnect":
+		var req types.ConnectionHistoryConnect
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+
+		history := p._service.ConnectionHistory()
+		if req.Index < 0 || req.Index >= len(history) {
+			p.sendErrorResponse(conn, reqCmd, fmt.Errorf("connection history item not found (index %d)", req.Index))
+			return
+		}
+
+		p.RegisterConnectionRequest(history[req.Index].Params)
+
+		// send request confirmation to client
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "ConnectionProfilesGet":
+		p.sendResponse(conn, responseForScope(&types.ConnectionProfilesResp{Profiles: p._service.ConnectionProfiles()}, p.connScope(conn)), reqCmd.Idx)
+
+	case "ConnectionProfileSave":
+		var req types.ConnectionProfileSave
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		if err := p._service.ConnectionProfileSave(req.Profile); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "ConnectionProfileRemove":
+		var req types.ConnectionProfileRemove
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		if err := p._service.ConnectionProfileRemove(req.Name); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
+	case "ConnectionProfileConnect":
+		var req types.ConnectionProfileConnect
+		if err := json.Unmarshal(messageData, &req); err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+
+		params, err := p._service.ConnectionProfileApply(req.Name)
+		if err != nil {
+			p.sendErrorResponse(conn, reqCmd, err)
+			return
+		}
+
+		p.RegisterConnectionRequest(params)
+
+		// send request confirmation to client
+		p.sendResponse(conn, &types.EmptyResp{}, reqCmd.Idx)
+
 	default:
 		log.Warning("!!! Unsupported request type !!! ", reqCmd.Command)
 		log.Debug("Unsupported request:", message)
@@ -1164,6 +2066,7 @@ func (p *Protocol) RegisterConnectionRequest(r service_types.ConnectionParams) e
 	defer p._connRequestReady.Done()
 
 	// synchronized block: only one connection request allowed. Remove previous request (if exists)
+	isSwitched := false
 	func() {
 		p._connRequestMutex.Lock()
 		defer p._connRequestMutex.Unlock()
@@ -1174,11 +2077,28 @@ func (p *Protocol) RegisterConnectionRequest(r service_types.ConnectionParams) e
 		default:
 		}
 
+		// Try to switch the active WireGuard connection to a new server without disconnection ("make-before-break").
+		// The switching is performed by the active connection routine (see Service.SwitchServer());
+		// here it is serialized with other connection requests.
+		if p._service != nil {
+			var err error
+			if isSwitched, err = p._service.SwitchServer(r); err != nil {
+				log.Warning(fmt.Sprintf("Unable to switch server without disconnection (reconnecting): %s", err))
+			}
+		}
+		if isSwitched {
+			return
+		}
+
 		// Add request to chain (it will be processed in 'processConnectionRequests()' routine)
 		// Note: new connection request would not start processing until p._connRequestReady.Done()
 		p._connRequestChan <- r
 	}()
 
+	if isSwitched {
+		return nil
+	}
+
 	// Disconnect active connection (if connected).
 	// "Disconnected" notification will not be sent to the clients in this case (because new connection request is pending).
 	// It is important to call it after new connection request registered
@@ -1207,10 +2127,7 @@ func (p *Protocol) processConnectionRequests() {
 		func() {
 			defer func() {
 				if r := recover(); r != nil {
-					log.Error(fmt.Errorf("PANIC during processing connection request: %v", r))
-					if err, ok := r.(error); ok {
-						log.ErrorTrace(err)
-					}
+					crashreport.Save("processConnectionRequests", r)
 				}
 			}()
 
@@ -1228,8 +2145,7 @@ func (p *Protocol) processConnectionRequests() {
 			defer func() {
 				// Do not send "Disconnected" notification if we are going to establish new connection immediately
 				if len(p._connRequestChan) == 0 || p._disconnectRequested {
-					lastState := p._lastVPNState
-					p._lastVPNState = vpn.NewStateInfo(vpn.DISCONNECTED, "")
+					lastState := p.setLastVPNState(vpn.NewStateInfo(vpn.DISCONNECTED, ""))
 
 					// Sending "Disconnected" only in one place (after VPN process stopped)
 					disconnectionReason := types.Unknown
@@ -1251,6 +2167,7 @@ func (p *Protocol) processConnectionRequests() {
 					}
 					saveLastError(connectionError)
 					p.notifyClients(&types.DisconnectedResp{Failure: connectionError != nil, Reason: disconnectionReason, ReasonDescription: errMsg})
+					p.dbusNotifyVpnState(vpn.DISCONNECTED)
 				}
 			}()
 
@@ -1269,7 +2186,7 @@ func (p *Protocol) processConnectRequest(r service_types.ConnectionParams) (err
 	defer func() {
 		if r := recover(); r != nil {
 			err = errors.New("panic on connect: " + fmt.Sprint(r))
-			log.Error(err)
+			crashreport.Save("processConnectRequest", r)
 		}
 	}()
 
@@ -1284,21 +2201,21 @@ func (p *Protocol) processConnectRequest(r service_types.ConnectionParams) (err
 func (p *Protocol) OnVpnStateChanged(state vpn.StateInfo) {
 	defer func() {
 		if r := recover(); r != nil {
-			log.Error("Panic when notifying VPN status to clients! (recovered)")
-			if err, ok := r.(error); ok {
-				log.ErrorTrace(err)
-			}
+			crashreport.Save("OnVpnStateChanged", r)
 		}
 	}()
 
-	p._lastVPNState = state
+	p.setLastVPNState(state)
+	p._metrics.onVpnStateChanged(state.State)
 
 	switch state.State {
 	case vpn.CONNECTED:
 		p.notifyClients(p.createConnectedResponse(state))
+		p.dbusNotifyVpnState(state.State)
 	case vpn.DISCONNECTED:
 		// suppress DISCONNECTED event. It will be sent to the client only after finishing the synchronous function processConnectRequest().
 	default:
 		p.notifyClients(&types.VpnStateResp{StateVal: state.State, State: state.State.String(), StateAdditionalInfo: state.StateAdditionalInfo})
+		p.dbusNotifyVpnState(state.State)
 	}
 }
@@ -0,0 +1,172 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package protocol
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net"
+
+	"github.com/ivpn/desktop-app/daemon/obfsproxy"
+	"github.com/ivpn/desktop-app/daemon/operations"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+)
+
+// readOnlyRequests - requests which are allowed for the clients with read-only access (preferences.ClientScopeReadOnly).
+// All other requests are rejected for such clients.
+// NOTE: the requests which change the daemon state (including the firewall exceptions) or initiate network traffic
+// (e.g. "PingServers") must not be added here.
+var readOnlyRequests = map[string]struct{}{
+	"EmptyReq":                    {},
+	"Hello":                       {},
+	"SetNotificationsFilter":      {},
+	"GetVPNState":                 {},
+	"GetServers":                  {},
+	"KillSwitchGetStatus":         {},
+	"SplitTunnelGetStatus":        {},
+	"PortForwardingGetStatus":     {},
+	"GetDnsPredefinedConfigs":     {},
+	"WiFiCurrentNetwork":          {},
+	"WiFiAvailableNetworks":       {},
+	"ConnectSettingsGet":          {},
+	"ConnectionHistoryGet":        {},
+	"ConnectionStatsHistoryGet":   {},
+	"ThroughputHistoryGet":        {},
+	"ConnectionQualityHistoryGet": {},
+	"ConnectionProfilesGet":       {},
+	"GetSubsystemStatus":          {},
+	"OperationsGet":               {},
+	"HostsHealthGet":              {},
+}
+
+// connScope returns the access scope of the client connection
+// (read-only for unknown connections: e.g. the connection is already closed)
+func (p *Protocol) connScope(c net.Conn) preferences.ClientAccessScope {
+	p._connectionsMutex.RLock()
+	defer p._connectionsMutex.RUnlock()
+
+	if cInfo, ok := p._connections[c]; ok && cInfo.Scope == preferences.ClientScopeFull {
+		return preferences.ClientScopeFull
+	}
+	return preferences.ClientScopeReadOnly
+}
+
+// localApiTokenScope checks the access token of the local API request (REST API, gRPC API) and returns the access scope of the request.
+// The token of the API ('apiToken') gives the full access; the client access tokens (preferences.ClientToken) - the access defined by the token
+// ('clientToken' - the client access token which was used for authentication).
+func (p *Protocol) localApiTokenScope(requestToken string, apiToken string) (scope preferences.ClientAccessScope, clientToken string, ok bool) {
+	if len(requestToken) == 0 {
+		return "", "", false
+	}
+	if len(apiToken) > 0 && subtle.ConstantTimeCompare([]byte(requestToken), []byte(apiToken)) == 1 {
+		return preferences.ClientScopeFull, "", true
+	}
+	prefs := p._service.Preferences()
+	if t, ok := prefs.FindClientToken(requestToken); ok {
+		return t.Scope, t.Token, true
+	}
+	return "", "", false
+}
+
+// checkRequestAccess returns error if the request is not allowed for the client connection
+func (p *Protocol) checkRequestAccess(c net.Conn, command string) error {
+	if p.connScope(c) == preferences.ClientScopeFull {
+		return nil
+	}
+	if _, ok := readOnlyRequests[command]; ok {
+		return nil
+	}
+	return fmt.Errorf("access denied: the client has read-only access (request '%s' is not allowed)", command)
+}
+
+// disconnectClientsWithRemovedTokens closes the connections of the clients which were authenticated by the removed access tokens
+func (p *Protocol) disconnectClientsWithRemovedTokens() {
+	prefs := p._service.Preferences()
+
+	p._connectionsMutex.RLock()
+	defer p._connectionsMutex.RUnlock()
+	for conn, cInfo := range p._connections {
+		if len(cInfo.AccessToken) == 0 {
+			continue
+		}
+		if _, ok := prefs.FindClientToken(cInfo.AccessToken); !ok {
+			log.Info(fmt.Sprintf("%sClosing connection (the access token was removed)", p.connLogID(conn)))
+			conn.Close()
+		}
+	}
+}
+
+// responseForScope returns the copy of the response without the sensitive data which must not be available
+// for the client with the specified access scope (account credentials, proxy passwords ...).
+// Returns nil if the response must not be sent to the client at all.
+func responseForScope(cmd types.ICommandBase, scope preferences.ClientAccessScope) types.ICommandBase {
+	if scope == preferences.ClientScopeFull {
+		return cmd
+	}
+
+	switch v := cmd.(type) {
+	case *types.HelloResp:
+		ret := *v
+		ret.Session = types.SessionResp{WgPublicKey: v.Session.WgPublicKey, WgLocalIP: v.Session.WgLocalIP}
+		ret.DaemonSettings = types.SettingsResp{}
+		return &ret
+	case *types.SettingsResp:
+		return nil
+	case *types.ConnectSettings:
+		ret := *v
+		ret.Params = preferences.ConnectionParamsWithoutSecrets(v.Params)
+		return &ret
+	case *types.ConnectionHistoryResp:
+		ret := *v
+		ret.Items = make([]preferences.ConnectionHistoryItem, 0, len(v.Items))
+		for _, item := range v.Items {
+			item.Params = preferences.ConnectionParamsWithoutSecrets(item.Params)
+			ret.Items = append(ret.Items, item)
+		}
+		return &ret
+	case *types.ConnectionProfilesResp:
+		ret := *v
+		ret.Profiles = make([]preferences.ConnectionProfile, 0, len(v.Profiles))
+		for _, profile := range v.Profiles {
+			profile.Params = preferences.ConnectionParamsWithoutSecrets(profile.Params)
+			profile.Obfs4proxy.Obfs4Bridge = obfsproxy.Obfs4Bridge{} // user-defined bridge (its certificate grants access to the bridge)
+			ret.Profiles = append(ret.Profiles, profile)
+		}
+		return &ret
+	case *types.OperationStatusResp:
+		// the result of the operation can contain private data (e.g. the diagnostics logs)
+		ret := *v
+		ret.Operation.Result = nil
+		return &ret
+	case *types.OperationsListResp:
+		ret := *v
+		ret.Operations = make([]operations.Status, 0, len(v.Operations))
+		for _, op := range v.Operations {
+			op.Result = nil
+			ret.Operations = append(ret.Operations, op)
+		}
+		return &ret
+	}
+	return cmd
+}
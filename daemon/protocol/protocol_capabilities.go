@@ -0,0 +1,132 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package protocol
+
+// supportedRequests - list of request types which are processed by this daemon build (see processRequest()).
+// It is sent to clients in HelloResp, so they are able to detect which commands are available
+// before sending them (e.g. newer CLI/UI connected to an older daemon).
+// IMPORTANT! The list must be updated when new request type is added to processRequest().
+var supportedRequests = []string{
+	"EmptyReq",
+	"Hello",
+	"SetNotificationsFilter",
+	"ParanoidModeSetPasswordReq",
+	"GetVPNState",
+	"GetServers",
+	"PingServers",
+	"APIRequest",
+	"WiFiAvailableNetworks",
+	"KillSwitchGetStatus",
+	"KillSwitchSetEnabled",
+	"KillSwitchSetAllowLANMulticast",
+	"KillSwitchSetAllowLAN",
+	"KillSwitchSetAllowLANServices",
+	"KillSwitchSetAllowedLANHosts",
+	"KillSwitchSetLANInboundPorts",
+	"KillSwitchSetUserExceptions",
+	"KillSwitchSetIsPersistent",
+	"KillSwitchSetAllowApiServers",
+	"SetPreference",
+	"SetObfsProxy",
+	"SetV2RayProxy",
+	"SetApiProxy",
+	"SetApiHostOverride",
+	"SetOpenVpnExtraParameters",
+	"SetOpenVpnCryptoPolicy",
+	"RestApiGet",
+	"SetRestApi",
+	"GrpcApiGet",
+	"SetGrpcApi",
+	"SetLogRotation",
+	"LogLevelsGet",
+	"SetLogLevel",
+	"ClientTokensGet",
+	"ClientTokenAdd",
+	"ClientTokenRemove",
+	"SettingsExport",
+	"SettingsImport",
+	"SetShadowsocksProxy",
+	"SetAmneziaWG",
+	"SetUserPreferences",
+	"SplitTunnelGetStatus",
+	"SplitTunnelSetConfig",
+	"SplitTunnelSetDestinations",
+	"SplitTunnelSetContainers",
+	"SplitTunnelSetUsers",
+	"SplitTunnelAddApp",
+	"SplitTunnelRemoveApp",
+	"SplitTunnelAddedPidInfo",
+	"GenerateDiagnostics",
+	"PortForwardingGetStatus",
+	"PortForwardingRequest",
+	"PortForwardingRelease",
+	"CaptivePortalCheck",
+	"CaptivePortalAllowLogin",
+	"CaptivePortalReLock",
+	"SetAlternateDns",
+	"GetDnsPredefinedConfigs",
+	"PauseConnection",
+	"ResumeConnection",
+	"SessionNew",
+	"SessionDelete",
+	"DevicesList",
+	"DeviceLogout",
+	"AccountsGet",
+	"AccountSwitch",
+	"AccountRemove",
+	"AccountStatus",
+	"WireGuardGenerateNewKeys",
+	"WireGuardSetKeysRotationInterval",
+	"GetAppIcon",
+	"GetInstalledApps",
+	"WiFiCurrentNetwork",
+	"WiFiSettings",
+	"ScheduleSettings",
+	"Disconnect",
+	"ConnectSettingsGet",
+	"ConnectSettings",
+	"Connect",
+	"ConnectionHistoryGet",
+	"AuditLogGet",
+	"LogStreamStart",
+	"LogStreamStop",
+	"ShellCommandsGet",
+	"CrashReportsGet",
+	"CrashReportsClear",
+	"GetSubsystemStatus",
+	"OperationStart",
+	"SpeedTestStart",
+	"OperationCancel",
+	"OperationsGet",
+	"HostsHealthGet",
+	"ConnectionHistoryClear",
+	"ConnectionHistoryConnect",
+	"ConnectionStatsHistoryGet",
+	"ConnectionStatsHistoryClear",
+	"ThroughputHistoryGet",
+	"ConnectionQualityHistoryGet",
+	"ConnectionProfilesGet",
+	"ConnectionProfileSave",
+	"ConnectionProfileRemove",
+	"ConnectionProfileConnect",
+}
@@ -0,0 +1,258 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+//go:build linux
+// +build linux
+
+package protocol
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/godbus/dbus/v5"
+	"github.com/godbus/dbus/v5/introspect"
+	"github.com/ivpn/desktop-app/daemon/auditlog"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// D-Bus interface of the daemon (system bus).
+// It allows desktop applets and scripts to control the basic functionality of the daemon
+// without using the TCP protocol.
+// Access to the service is controlled by the D-Bus policy file (net.ivpn.Daemon.conf)
+// and by the daemon itself (the same users as for the unix socket of the daemon: see isLocalUserAllowed()).
+const (
+	dbusServiceName = "net.ivpn.Daemon"
+	dbusObjectPath  = dbus.ObjectPath("/net/ivpn/Daemon")
+	dbusInterface   = "net.ivpn.Daemon1"
+
+	dbusSignalVpnStateChanged      = dbusInterface + ".VpnStateChanged"
+	dbusSignalFirewallStateChanged = dbusInterface + ".FirewallStateChanged"
+
+	dbusErrorFailed         = dbusInterface + ".Error.Failed"
+	dbusErrorNotAuthorized  = dbusInterface + ".Error.NotAuthorized"
+	dbusErrorNotInitialized = dbusInterface + ".Error.NotInitialized"
+)
+
+var (
+	dbusMutex sync.Mutex
+	dbusConn  *dbus.Conn
+)
+
+// dbusObject - object exported to D-Bus. All the exported methods of this type are available for D-Bus clients.
+type dbusObject struct {
+	p *Protocol
+}
+
+// startDBusService registers the daemon on the system D-Bus
+func (p *Protocol) startDBusService() error {
+	conn, err := dbus.ConnectSystemBus()
+	if err != nil {
+		return fmt.Errorf("failed to connect to the system D-Bus: %w", err)
+	}
+
+	obj := &dbusObject{p: p}
+	if err := conn.Export(obj, dbusObjectPath, dbusInterface); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to export D-Bus object: %w", err)
+	}
+
+	node := &introspect.Node{
+		Name: string(dbusObjectPath),
+		Interfaces: []introspect.Interface{
+			introspect.IntrospectData,
+			{
+				Name:    dbusInterface,
+				Methods: introspect.Methods(obj),
+				Signals: []introspect.Signal{
+					{Name: "VpnStateChanged", Args: []introspect.Arg{{Name: "state", Type: "s"}}},
+					{Name: "FirewallStateChanged", Args: []introspect.Arg{{Name: "enabled", Type: "b"}}},
+				},
+			},
+		},
+	}
+	if err := conn.Export(introspect.NewIntrospectable(node), dbusObjectPath, "org.freedesktop.DBus.Introspectable"); err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to export D-Bus introspection data: %w", err)
+	}
+
+	reply, err := conn.RequestName(dbusServiceName, dbus.NameFlagDoNotQueue)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to request D-Bus name '%s': %w", dbusServiceName, err)
+	}
+	if reply != dbus.RequestNameReplyPrimaryOwner {
+		conn.Close()
+		return fmt.Errorf("D-Bus name '%s' is already taken", dbusServiceName)
+	}
+
+	dbusMutex.Lock()
+	dbusConn = conn
+	dbusMutex.Unlock()
+
+	log.Info(fmt.Sprintf("D-Bus service registered: %s", dbusServiceName))
+	return nil
+}
+
+func (p *Protocol) stopDBusService() {
+	dbusMutex.Lock()
+	defer dbusMutex.Unlock()
+
+	if dbusConn != nil {
+		dbusConn.Close()
+		dbusConn = nil
+	}
+}
+
+func dbusEmit(signal string, values ...interface{}) {
+	dbusMutex.Lock()
+	defer dbusMutex.Unlock()
+
+	if dbusConn == nil {
+		return
+	}
+	if err := dbusConn.Emit(dbusObjectPath, signal, values...); err != nil {
+		log.Warning(fmt.Sprintf("failed to emit D-Bus signal '%s': %v", signal, err))
+	}
+}
+
+// dbusNotifyVpnState emits VpnStateChanged signal
+func (p *Protocol) dbusNotifyVpnState(state vpn.State) {
+	dbusEmit(dbusSignalVpnStateChanged, state.String())
+}
+
+// dbusNotifyFirewallState emits FirewallStateChanged signal
+func (p *Protocol) dbusNotifyFirewallState(isEnabled bool) {
+	dbusEmit(dbusSignalFirewallStateChanged, isEnabled)
+}
+
+// checkAccess returns an error when the D-Bus client is not allowed to change the daemon state.
+// When EAA (Enhanced App Authentication) is enabled, all the changes require the EAA password,
+// which can not be passed over D-Bus.
+func (o *dbusObject) checkAccess(sender dbus.Sender) *dbus.Error {
+	if o.p._service == nil {
+		return dbus.NewError(dbusErrorNotInitialized, []interface{}{"service not initialized"})
+	}
+	if actor := o.actor(sender); !isLocalUserAllowed(actor.Uid) {
+		log.Warning(fmt.Sprintf("D-Bus: access denied for %s (uid=%d)", sender, actor.Uid))
+		return dbus.NewError(dbusErrorNotAuthorized, []interface{}{fmt.Sprintf("access denied: the user is not a member of the group '%s'", unixSocketGroupName)})
+	}
+	if o.p._eaa.IsEnabled() {
+		return dbus.NewError(dbusErrorNotAuthorized, []interface{}{"not allowed when Enhanced App Authentication is enabled"})
+	}
+	return nil
+}
+
+// actor returns info about the D-Bus client process
+func (o *dbusObject) actor(sender dbus.Sender) auditlog.Actor {
+	ret := auditlog.Actor{Type: auditlog.ActorDBus, Uid: -1}
+
+	dbusMutex.Lock()
+	conn := dbusConn
+	dbusMutex.Unlock()
+	if conn == nil {
+		return ret
+	}
+
+	var pid, uid uint32
+	if err := conn.BusObject().Call("org.freedesktop.DBus.GetConnectionUnixProcessID", 0, string(sender)).Store(&pid); err != nil {
+		log.Warning(fmt.Sprintf("unable to detect PID of the D-Bus client: %v", err))
+	} else {
+		ret.Pid = int(pid)
+	}
+	if err := conn.BusObject().Call("org.freedesktop.DBus.GetConnectionUnixUser", 0, string(sender)).Store(&uid); err != nil {
+		log.Warning(fmt.Sprintf("unable to detect UID of the D-Bus client: %v", err))
+	} else {
+		ret.Uid = int(uid)
+	}
+	return ret
+}
+
+// Connect - connect VPN using the last connection settings
+func (o *dbusObject) Connect(sender dbus.Sender) *dbus.Error {
+	if err := o.checkAccess(sender); err != nil {
+		return err
+	}
+
+	params := o.p._service.GetConnectionParams()
+	if err := params.CheckIsDefined(); err != nil {
+		return dbus.NewError(dbusErrorFailed, []interface{}{fmt.Sprintf("connection settings are not defined: %v", err)})
+	}
+
+	log.Info(fmt.Sprintf("D-Bus: connection requested by %s", sender))
+	auditlog.Write(o.actor(sender), auditlog.EventConnect, params.VpnType.String())
+	o.p.RegisterConnectionRequest(params)
+	return nil
+}
+
+// Disconnect - disconnect VPN
+func (o *dbusObject) Disconnect(sender dbus.Sender) *dbus.Error {
+	if err := o.checkAccess(sender); err != nil {
+		return err
+	}
+
+	log.Info(fmt.Sprintf("D-Bus: disconnection requested by %s", sender))
+	auditlog.Write(o.actor(sender), auditlog.EventDisconnect, "")
+	o.p._disconnectRequested = true
+	o.p._lastConnectionErrorToNotifyClient = ""
+
+	if !o.p._service.Connected() {
+		return nil
+	}
+	if err := o.p._service.Disconnect(); err != nil {
+		return dbus.MakeFailedError(err)
+	}
+	return nil
+}
+
+// GetStatus returns the current VPN state and (when connected) the VPN type and the server IP address
+func (o *dbusObject) GetStatus() (state string, vpnType string, serverIP string, dbusErr *dbus.Error) {
+	vpnState := o.p.lastVPNState()
+	if vpnState.State == vpn.CONNECTED {
+		return vpnState.State.String(), vpnState.VpnType.String(), vpnState.ServerIP.String(), nil
+	}
+	return vpnState.State.String(), "", "", nil
+}
+
+// GetFirewallState returns 'true' when the firewall is enabled
+func (o *dbusObject) GetFirewallState() (bool, *dbus.Error) {
+	if o.p._service == nil {
+		return false, dbus.NewError(dbusErrorNotInitialized, []interface{}{"service not initialized"})
+	}
+	isEnabled, _, _, _, _, _, err := o.p._service.KillSwitchState()
+	if err != nil {
+		return false, dbus.MakeFailedError(err)
+	}
+	return isEnabled, nil
+}
+
+// SetFirewallState enables or disables the firewall
+func (o *dbusObject) SetFirewallState(sender dbus.Sender, enabled bool) *dbus.Error {
+	if err := o.checkAccess(sender); err != nil {
+		return err
+	}
+	if err := o.p._service.SetKillSwitchState(enabled); err != nil {
+		return dbus.MakeFailedError(err)
+	}
+	auditlog.Write(o.actor(sender), auditlog.EventKillSwitch, fmt.Sprintf("IsEnabled: %t", enabled))
+	return nil
+}
@@ -0,0 +1,37 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+//go:build !linux
+// +build !linux
+
+package protocol
+
+import "github.com/ivpn/desktop-app/daemon/vpn"
+
+// startDBusService - D-Bus interface is available only on Linux
+func (p *Protocol) startDBusService() error { return nil }
+
+func (p *Protocol) stopDBusService() {}
+
+func (p *Protocol) dbusNotifyVpnState(state vpn.State) {}
+
+func (p *Protocol) dbusNotifyFirewallState(isEnabled bool) {}
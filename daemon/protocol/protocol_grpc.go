@@ -0,0 +1,401 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package protocol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/auditlog"
+	"github.com/ivpn/desktop-app/daemon/protocol/grpcapi"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Local gRPC API of the daemon (opt-in; disabled by default). The service definition: daemon/protocol/grpcapi/ivpn.proto
+//
+// The methods of the 'Daemon' service mirror the requests of the daemon protocol: the request message is converted
+// to the JSON request of the daemon protocol and processed the same way as the REST API request;
+// the JSON response is converted to the 'Response' message.
+// The daemon events are available over the server-streaming method 'Events'.
+const (
+	grpcApiEventsMethod = "Events"
+	// name of the 'oneof' field of the 'Response' message
+	grpcApiResponseOneof = "response"
+	// max time to wait for the active requests to be completed when the server is stopping
+	grpcApiStopTimeout = 5 * time.Second
+)
+
+// grpcApiConn - in-memory connection which is used to pass the gRPC API request to the protocol
+type grpcApiConn struct {
+	net.Conn
+	localAddr  net.Addr
+	remoteAddr net.Addr
+	actor      auditlog.Actor
+}
+
+func (c *grpcApiConn) LocalAddr() net.Addr  { return c.localAddr }
+func (c *grpcApiConn) RemoteAddr() net.Addr { return c.remoteAddr }
+
+// restartGrpcApi starts (or stops) the gRPC API server according to the current preferences
+func (p *Protocol) restartGrpcApi() {
+	p.stopGrpcApi()
+
+	if p._service == nil {
+		return
+	}
+	params := p._service.Preferences().GrpcApi
+	if !params.IsEnabled {
+		return
+	}
+	if err := params.Validate(); err != nil {
+		log.Error(fmt.Errorf("gRPC API not started: %w", err))
+		return
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", params.Port))
+	if err != nil {
+		log.Error(fmt.Errorf("gRPC API not started: %w", err))
+		return
+	}
+
+	serverCtx, cancel := context.WithCancel(context.Background())
+	desc, err := p.grpcApiServiceDesc(serverCtx, listener.Addr())
+	if err != nil {
+		cancel()
+		listener.Close()
+		log.Error(fmt.Errorf("gRPC API not started: %w", err))
+		return
+	}
+
+	server := grpc.NewServer()
+	server.RegisterService(desc, p)
+	// allow to use tools like 'grpcurl' without the .proto file (the service definition is not a secret)
+	reflection.Register(server)
+
+	p._grpcApiMutex.Lock()
+	p._grpcApiServer = server
+	p._grpcApiCancel = cancel
+	p._grpcApiMutex.Unlock()
+
+	log.Info(fmt.Sprintf("gRPC API started: %s", listener.Addr()))
+	go func() {
+		if err := server.Serve(listener); err != nil {
+			log.Error(fmt.Errorf("gRPC API stopped: %w", err))
+		}
+	}()
+}
+
+func (p *Protocol) stopGrpcApi() {
+	p._grpcApiMutex.Lock()
+	server, cancel := p._grpcApiServer, p._grpcApiCancel
+	p._grpcApiServer, p._grpcApiCancel = nil, nil
+	p._grpcApiMutex.Unlock()
+
+	if server != nil {
+		// close the events streams and wait for the active requests to be completed
+		cancel()
+		stopped := make(chan struct{})
+		go func() {
+			server.GracefulStop()
+			close(stopped)
+		}()
+		select {
+		case <-stopped:
+		case <-time.After(grpcApiStopTimeout):
+			server.Stop()
+		}
+		log.Info("gRPC API stopped")
+	}
+}
+
+// grpcApiServiceDesc returns the description of the 'Daemon' service (grpcapi.Daemon_ServiceDesc) with the handlers
+// which pass all the requests to the protocol
+// ('serverCtx' - the context of the server, it is cancelled when the server is stopping; 'localAddr' - the address of the server).
+func (p *Protocol) grpcApiServiceDesc(serverCtx context.Context, localAddr net.Addr) (*grpc.ServiceDesc, error) {
+	sd, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(grpcapi.Daemon_ServiceDesc.ServiceName))
+	if err != nil {
+		return nil, err
+	}
+	service, ok := sd.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("'%s' is not a service", grpcapi.Daemon_ServiceDesc.ServiceName)
+	}
+
+	desc := grpcapi.Daemon_ServiceDesc
+	// the handlers do not use the server implementation ('srv' argument)
+	desc.HandlerType = (*interface{})(nil)
+
+	desc.Methods = nil
+	for _, m := range grpcapi.Daemon_ServiceDesc.Methods {
+		command := m.MethodName
+		md := service.Methods().ByName(protoreflect.Name(command))
+		if md == nil {
+			return nil, fmt.Errorf("method '%s' not found", command)
+		}
+		requestType, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+		if err != nil {
+			return nil, err
+		}
+
+		desc.Methods = append(desc.Methods, grpc.MethodDesc{
+			MethodName: command,
+			Handler: func(_ interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				request := requestType.New().Interface()
+				if err := dec(request); err != nil {
+					return nil, err
+				}
+				return p.grpcApiProcessRequest(ctx, localAddr, command, request)
+			},
+		})
+	}
+
+	desc.Streams = nil
+	for _, s := range grpcapi.Daemon_ServiceDesc.Streams {
+		if s.StreamName != grpcApiEventsMethod {
+			return nil, fmt.Errorf("unexpected stream method '%s'", s.StreamName)
+		}
+		s.Handler = func(_ interface{}, stream grpc.ServerStream) error {
+			return p.grpcApiStreamEvents(serverCtx, stream, localAddr)
+		}
+		desc.Streams = append(desc.Streams, s)
+	}
+
+	return &desc, nil
+}
+
+// grpcApiIsAuthorized checks the access token of the request ('authorization: Bearer <token>' metadata)
+// and returns the access scope of the request (see localApiTokenScope())
+func (p *Protocol) grpcApiIsAuthorized(ctx context.Context) (scope preferences.ClientAccessScope, clientToken string, err error) {
+	const prefix = "Bearer "
+	requestToken := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if auth := md.Get("authorization"); len(auth) > 0 && strings.HasPrefix(auth[0], prefix) {
+			requestToken = strings.TrimPrefix(auth[0], prefix)
+		}
+	}
+
+	scope, clientToken, ok := p.localApiTokenScope(requestToken, p._service.Preferences().GrpcApi.Token)
+	if !ok {
+		remoteAddr := "?"
+		if pr, ok := peer.FromContext(ctx); ok {
+			remoteAddr = pr.Addr.String()
+		}
+		log.Warning(fmt.Sprintf("gRPC API: unauthorized request from %s", remoteAddr))
+		return "", "", status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	return scope, clientToken, nil
+}
+
+// grpcApiNewConn returns the function which wraps the in-memory connection for the gRPC API request (see pipeProcessRequest())
+func grpcApiNewConn(ctx context.Context, localAddr net.Addr) (newConn func(net.Conn) net.Conn, remoteAddr net.Addr, err error) {
+	pr, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, nil, status.Error(codes.Internal, "unknown peer")
+	}
+
+	return func(c net.Conn) net.Conn {
+		conn := &grpcApiConn{Conn: c, localAddr: localAddr, remoteAddr: pr.Addr}
+		conn.actor = auditlog.ConnectionActor(auditlog.ActorGrpc, conn)
+		return conn
+	}, pr.Addr, nil
+}
+
+func (p *Protocol) grpcApiProcessRequest(ctx context.Context, localAddr net.Addr, command string, request proto.Message) (*grpcapi.Response, error) {
+	scope, clientToken, err := p.grpcApiIsAuthorized(ctx)
+	if err != nil {
+		return nil, err
+	}
+	newConn, remoteAddr, err := grpcApiNewConn(ctx, localAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := grpcApiRequestData(command, request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	log.Info(fmt.Sprintf("gRPC API: request '%s' from %s", command, remoteAddr))
+	response, err := p.pipeProcessRequest(newConn, command, data, scope, clientToken)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	ret, err := grpcApiResponse(response)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return ret, nil
+}
+
+func (p *Protocol) grpcApiStreamEvents(serverCtx context.Context, stream grpc.ServerStream, localAddr net.Addr) error {
+	ctx := stream.Context()
+	scope, clientToken, err := p.grpcApiIsAuthorized(ctx)
+	if err != nil {
+		return err
+	}
+	newConn, remoteAddr, err := grpcApiNewConn(ctx, localAddr)
+	if err != nil {
+		return err
+	}
+
+	var request grpcapi.EventsRequest
+	if err := stream.RecvMsg(&request); err != nil {
+		return err
+	}
+
+	log.Info("gRPC API: events stream opened ", remoteAddr)
+	defer log.Info("gRPC API: events stream closed ", remoteAddr)
+
+	send := func(message []byte) error {
+		response, err := grpcApiResponse(message)
+		if err != nil {
+			// the event type is not defined in the service definition
+			// (Note: do not write to the log here: it would produce new log messages for the events stream)
+			return nil
+		}
+		return stream.SendMsg(response)
+	}
+	// the stream is closed when the client is disconnected or when the server is stopping
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		select {
+		case <-ctx.Done():
+		case <-serverCtx.Done():
+		}
+	}()
+
+	p.pipeStreamEvents(newConn, scope, clientToken, request.IsLogsRequested, request.Events, send, closed)
+	return nil
+}
+
+// grpcApiRequestData converts the gRPC request message to the daemon protocol request
+func grpcApiRequestData(command string, request proto.Message) ([]byte, error) {
+	fields, err := grpcApiMessageToJSON(request.ProtoReflect())
+	if err != nil {
+		return nil, err
+	}
+	fields["Command"] = command
+	fields["Idx"] = pipeRequestIdx
+	return json.Marshal(fields)
+}
+
+// grpcApiMessageToJSON converts the message to the object which is serialized to JSON in the format of the daemon protocol.
+// Note: the 'protojson' serialization is not in use because it serializes the 64-bit integers as strings.
+func grpcApiMessageToJSON(m protoreflect.Message) (map[string]interface{}, error) {
+	ret := make(map[string]interface{})
+	var retErr error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		var val interface{}
+		switch {
+		case fd.IsList():
+			list := v.List()
+			items := make([]interface{}, 0, list.Len())
+			for i := 0; i < list.Len(); i++ {
+				item, err := grpcApiValueToJSON(fd, list.Get(i))
+				if err != nil {
+					retErr = err
+					return false
+				}
+				items = append(items, item)
+			}
+			val = items
+		case fd.IsMap():
+			items := make(map[string]interface{})
+			var err error
+			v.Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				items[k.String()], err = grpcApiValueToJSON(fd.MapValue(), v)
+				return err == nil
+			})
+			if err != nil {
+				retErr = err
+				return false
+			}
+			val = items
+		default:
+			var err error
+			if val, err = grpcApiValueToJSON(fd, v); err != nil {
+				retErr = err
+				return false
+			}
+		}
+		ret[fd.JSONName()] = val
+		return true
+	})
+	return ret, retErr
+}
+
+func grpcApiValueToJSON(fd protoreflect.FieldDescriptor, v protoreflect.Value) (interface{}, error) {
+	switch fd.Kind() {
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		if fd.Message().FullName().Parent() == "google.protobuf" {
+			// well-known types (google.protobuf.Value): the JSON representation is the same as the daemon protocol
+			data, err := protojson.Marshal(v.Message().Interface())
+			if err != nil {
+				return nil, err
+			}
+			return json.RawMessage(data), nil
+		}
+		return grpcApiMessageToJSON(v.Message())
+	case protoreflect.EnumKind:
+		return int32(v.Enum()), nil
+	default:
+		// bool, numbers, string, bytes
+		return v.Interface(), nil
+	}
+}
+
+// grpcApiResponse converts the daemon protocol response (JSON) to the gRPC response message
+func grpcApiResponse(data []byte) (*grpcapi.Response, error) {
+	cmd, err := types.GetCommandBase(data)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &grpcapi.Response{}
+	m := response.ProtoReflect()
+	fd := m.Descriptor().Oneofs().ByName(grpcApiResponseOneof).Fields().ByName(protoreflect.Name(cmd.Command))
+	if fd == nil {
+		return nil, fmt.Errorf("unsupported response type '%s'", cmd.Command)
+	}
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, m.Mutable(fd).Message().Interface()); err != nil {
+		return nil, fmt.Errorf("failed to convert the response '%s': %w", cmd.Command, err)
+	}
+	return response, nil
+}
@@ -23,8 +23,15 @@
 package protocol
 
 import (
+	"time"
+
 	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/crashreport"
+	"github.com/ivpn/desktop-app/daemon/operations"
 	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/captiveportal"
+	"github.com/ivpn/desktop-app/daemon/service/connstats"
+	"github.com/ivpn/desktop-app/daemon/service/portforwarding"
 	"github.com/ivpn/desktop-app/daemon/service/preferences"
 )
 
@@ -62,14 +69,19 @@ func (p *Protocol) OnKillSwitchStateChanged() {
 			IsAllowLAN:        isAllowLAN,
 			IsAllowMulticast:  isAllowLanMulticast,
 			IsAllowApiServers: isAllowApiServers,
-			UserExceptions:    fwUserExceptions})
+			UserExceptions:    fwUserExceptions,
+			AllowLANServices:  p._service.Preferences().FwAllowLanServices,
+			AllowedLANHosts:   p._service.Preferences().FwAllowedLanHosts,
+			LANInboundPorts:   p._service.Preferences().FwLanInboundPorts})
+		p.dbusNotifyFirewallState(isEnabled)
 	}
 }
 
 // OnWiFiChanged - handler of WiFi status change. Notifying clients.
-func (p *Protocol) OnWiFiChanged(ssid string, isInsecureNetwork bool) {
+func (p *Protocol) OnWiFiChanged(ssid string, bssid string, isInsecureNetwork bool) {
 	p.notifyClients(&types.WiFiCurrentNetworkResp{
 		SSID:              ssid,
+		BSSID:             bssid,
 		IsInsecureNetwork: isInsecureNetwork})
 }
 
@@ -82,6 +94,50 @@ func (p *Protocol) OnPingStatus(retMap map[string]int) {
 	p.notifyClients(&types.PingServersResp{PingResults: results})
 }
 
+// OnOperationStatus - status of the long-running operation changed. Notifying clients.
+func (p *Protocol) OnOperationStatus(status operations.Status) {
+	p.notifyClients(&types.OperationStatusResp{Operation: status})
+}
+
+// OnClockSkewDetected - the local clock is wrong. Notifying clients.
+func (p *Protocol) OnClockSkewDetected(offset time.Duration, isTimeHintAllowed bool) {
+	p.notifyClients(&types.ClockSkewResp{
+		OffsetSec:         int64(offset / time.Second),
+		IsTimeHintAllowed: isTimeHintAllowed})
+}
+
+// onCrashRecovered - the daemon recovered from a panic. Notifying clients.
+func (p *Protocol) onCrashRecovered(report crashreport.Report) {
+	// the stack trace can be requested by CrashReportsGet
+	report.Stack = ""
+	p.notifyClients(&types.CrashRecoveredResp{Report: report})
+}
+
+// OnCaptivePortalStatus - captive portal detected (or the captive portal status changed). Notifying clients.
+func (p *Protocol) OnCaptivePortalStatus(status captiveportal.Status) {
+	p.notifyClients(&types.CaptivePortalStatusResp{Status: status})
+}
+
+// OnDataUsageAlert - the traffic of the VPN connection exceeded the threshold. Notifying clients.
+func (p *Protocol) OnDataUsageAlert(alert connstats.DataUsageAlert) {
+	p.notifyClients(&types.DataUsageAlertResp{Alert: alert})
+}
+
+// OnConnectionQuality - the quality report of the active connection (packet loss, round-trip time, jitter). Notifying clients.
+func (p *Protocol) OnConnectionQuality(report connstats.QualityReport) {
+	p.notifyClients(&types.ConnectionQualityResp{Report: report})
+}
+
+// OnReloginRequired - the session is not valid anymore (the daemon is logged out). Notifying clients.
+func (p *Protocol) OnReloginRequired(apiStatus int, apiErrorMsg string) {
+	p.notifyClients(&types.ReloginRequiredResp{APIStatus: apiStatus, APIErrorMessage: apiErrorMsg})
+}
+
+// OnPortForwardingChanged - the state of the forwarded port changed. Notifying clients.
+func (p *Protocol) OnPortForwardingChanged(state portforwarding.State) {
+	p.notifyClients(&types.PortForwardingStatusResp{State: state})
+}
+
 func (p *Protocol) OnServersUpdated(serv *api_types.ServersInfoResponse) {
 	if serv == nil {
 		return
@@ -0,0 +1,85 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package protocol
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ivpn/desktop-app/daemon/service/platform"
+)
+
+// startLocalSocketListener starts serving the client protocol over the local socket:
+// unix domain socket (Linux, macOS) or named pipe (Windows).
+//
+// Access to the local socket is controlled by the OS (file permissions or the security descriptor of the pipe),
+// so the clients connected over it are not required to provide the secret.
+func (p *Protocol) startLocalSocketListener() error {
+	socketName := platform.ServiceSocketFile()
+	if len(socketName) == 0 {
+		return nil // not supported on this platform
+	}
+
+	listener, err := listenLocalSocket(socketName)
+	if err != nil {
+		return err
+	}
+
+	p._localListener = listener
+	log.Info(fmt.Sprintf("Listening local socket: %s", socketName))
+
+	go func() {
+		defer func() {
+			listener.Close()
+			log.Info("Local socket listener closed")
+		}()
+
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				if p._isRunning {
+					log.Error("Server: failed to accept incoming local socket connection:", err)
+				}
+				return
+			}
+			go p.processClient(conn)
+		}
+	}()
+
+	return nil
+}
+
+func (p *Protocol) stopLocalSocketListener() {
+	if listener := p._localListener; listener != nil {
+		listener.Close()
+	}
+}
+
+// isLocalSocketConnection returns 'true' for the clients connected over the unix domain socket or named pipe
+func isLocalSocketConnection(conn net.Conn) bool {
+	if _, ok := conn.(*net.UnixConn); ok {
+		return true
+	}
+	addr := conn.LocalAddr()
+	return addr != nil && addr.Network() == "pipe"
+}
@@ -0,0 +1,128 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+//go:build darwin || linux
+// +build darwin linux
+
+package protocol
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"strconv"
+)
+
+// Members of this group have access to the unix domain socket of the daemon
+const unixSocketGroupName = "ivpn"
+
+// listenLocalSocket starts listening the unix domain socket.
+//
+// Access to the socket is controlled by the filesystem permissions: if the group 'ivpn' exists, the socket
+// is accessible only for the members of this group. Otherwise, it is accessible only for root
+// (the local socket clients are not asked for the secret, so the socket must never be accessible for all users;
+// other clients have to use the TCP port and secret saved in the port file).
+func listenLocalSocket(socketFile string) (net.Listener, error) {
+	// remove the socket file remaining after previous run
+	if err := os.Remove(socketFile); err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to remove old socket file: %w", err)
+	}
+
+	listener, err := net.Listen("unix", socketFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start unix socket listener: %w", err)
+	}
+
+	if err := setUnixSocketPermissions(socketFile); err != nil {
+		listener.Close() // the socket file is removed on close
+		return nil, err
+	}
+
+	return listener, nil
+}
+
+func setUnixSocketPermissions(socketFile string) error {
+	group, err := user.LookupGroup(unixSocketGroupName)
+	if err != nil {
+		// no dedicated group: the socket is accessible only for root
+		if err := os.Chown(socketFile, 0, 0); err != nil {
+			return fmt.Errorf("failed to set unix socket owner: %w", err)
+		}
+		if err := os.Chmod(socketFile, 0600); err != nil {
+			return fmt.Errorf("failed to set unix socket permissions: %w", err)
+		}
+		log.Info(fmt.Sprintf("Unix socket is accessible only for root (the group '%s' does not exist)", unixSocketGroupName))
+		return nil
+	}
+
+	gid, err := strconv.Atoi(group.Gid)
+	if err != nil {
+		return fmt.Errorf("failed to parse GID of the group '%s': %w", unixSocketGroupName, err)
+	}
+	if err := os.Chown(socketFile, os.Getuid(), gid); err != nil {
+		return fmt.Errorf("failed to set unix socket owner: %w", err)
+	}
+	if err := os.Chmod(socketFile, 0660); err != nil {
+		return fmt.Errorf("failed to set unix socket permissions: %w", err)
+	}
+	log.Info(fmt.Sprintf("Unix socket is accessible only for members of the group '%s'", unixSocketGroupName))
+	return nil
+}
+
+// isLocalUserAllowed returns 'true' when the local user has the same access as the members of the group 'ivpn'
+// (root; members of the group; when the group does not exist - only root).
+// It is in use for the local transports which are not protected by the socket file permissions (e.g. D-Bus).
+func isLocalUserAllowed(uid int) bool {
+	if uid == 0 {
+		return true
+	}
+	if uid < 0 {
+		return false
+	}
+
+	group, err := user.LookupGroup(unixSocketGroupName)
+	if err != nil {
+		// no dedicated group: only root is allowed
+		return false
+	}
+
+	u, err := user.LookupId(strconv.Itoa(uid))
+	if err != nil {
+		log.Warning(fmt.Sprintf("failed to get info about user (uid=%d): %v", uid, err))
+		return false
+	}
+	if u.Gid == group.Gid {
+		return true
+	}
+	gids, err := u.GroupIds()
+	if err != nil {
+		log.Warning(fmt.Sprintf("failed to get groups of user '%s': %v", u.Username, err))
+		return false
+	}
+	for _, gid := range gids {
+		if gid == group.Gid {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,51 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+//go:build windows
+// +build windows
+
+package protocol
+
+import (
+	"fmt"
+	"net"
+
+	winio "github.com/Microsoft/go-winio"
+)
+
+// Security descriptor of the named pipe:
+//   - deny access for the remote (network) clients;
+//   - full access for LocalSystem and Administrators;
+//   - authenticated users are allowed only to read/write data (FILE_GENERIC_READ | FILE_WRITE_DATA).
+//     They are not allowed to create new instances of the pipe (FILE_CREATE_PIPE_INSTANCE),
+//     so the pipe can not be impersonated by other processes.
+const namedPipeSecurityDescriptor = "D:P(D;;GA;;;NU)(A;;GA;;;SY)(A;;GA;;;BA)(A;;0x12008b;;;AU)"
+
+// listenLocalSocket starts listening the named pipe.
+// Access to the pipe is controlled by its security descriptor.
+func listenLocalSocket(pipeName string) (net.Listener, error) {
+	listener, err := winio.ListenPipe(pipeName, &winio.PipeConfig{SecurityDescriptor: namedPipeSecurityDescriptor})
+	if err != nil {
+		return nil, fmt.Errorf("failed to start named pipe listener: %w", err)
+	}
+	return listener, nil
+}
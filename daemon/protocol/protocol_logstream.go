@@ -0,0 +1,121 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package protocol
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ivpn/desktop-app/daemon/logger"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+)
+
+// max number of log messages waiting to be sent to the client (the messages are skipped when the client is too slow)
+const logStreamQueueSize = 256
+
+func newLogMessageResp(m logger.Message) *types.LogMessageResp {
+	return &types.LogMessageResp{Message: m.Line, Module: m.Module, Level: m.Level.String(), Session: m.Session}
+}
+
+// logStreamStart starts streaming of the daemon log messages to the client (LogMessageResp notifications).
+// 'level' - min level of the messages; 'modules' - names of the logger modules (empty - all modules).
+// The previous stream of the client (if any) is stopped.
+func (p *Protocol) logStreamStart(conn net.Conn, level logger.Level, modules []string) error {
+	modulesFilter := make(map[string]struct{}, len(modules))
+	for _, m := range modules {
+		modulesFilter[m] = struct{}{}
+	}
+
+	messages := make(chan logger.Message, logStreamQueueSize)
+	done := make(chan struct{})
+
+	removeListener := logger.AddListener(func(m logger.Message) {
+		if m.Level < level {
+			return
+		}
+		if len(modulesFilter) > 0 {
+			if _, ok := modulesFilter[m.Module]; !ok {
+				return
+			}
+		}
+		select {
+		case messages <- m:
+		default: // the client is too slow: skip the message
+		}
+	})
+
+	stop := func() {
+		select {
+		case <-done:
+		default:
+			close(done)
+			removeListener()
+		}
+	}
+
+	if !p.clientSetLogStream(conn, stop) {
+		stop()
+		return fmt.Errorf("client not connected")
+	}
+
+	go func() {
+		// Note: the stop function is idempotent (it can be called again on disconnection or on the next LogStreamStart)
+		defer stop()
+		for {
+			select {
+			case <-done:
+				return
+			case m := <-messages:
+				// Note: sendResponse() is not in use here: it writes to the log (it would produce new log messages)
+				if err := types.Send(conn, newLogMessageResp(m), 0); err != nil {
+					return
+				}
+			}
+		}
+	}()
+
+	return nil
+}
+
+// logStreamStop stops streaming of the daemon log messages to the client
+func (p *Protocol) logStreamStop(conn net.Conn) {
+	p.clientSetLogStream(conn, nil)
+}
+
+// clientSetLogStream saves the function which stops the log stream of the client (the previous stream is stopped).
+// Returns false if the client is not connected.
+func (p *Protocol) clientSetLogStream(c net.Conn, stop func()) bool {
+	p._connectionsMutex.Lock()
+	defer p._connectionsMutex.Unlock()
+
+	cInfo, ok := p._connections[c]
+	if !ok {
+		return false
+	}
+	if cInfo.LogStreamStop != nil {
+		cInfo.LogStreamStop()
+	}
+	cInfo.LogStreamStop = stop
+	p._connections[c] = cInfo
+	return true
+}
@@ -0,0 +1,156 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package protocol
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/version"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// Metrics of the daemon in Prometheus text format (opt-in; see preferences.RestApiParams.IsMetricsEnabled).
+// Available on the REST API server:
+//
+//	curl -H "Authorization: Bearer <token>" http://127.0.0.1:<port>/metrics
+//
+// Example of the Prometheus scrape configuration:
+//
+//	scrape_configs:
+//	  - job_name: ivpn
+//	    authorization:
+//	      credentials: <token>
+//	    static_configs:
+//	      - targets: ['127.0.0.1:<port>']
+const restApiMetricsPath = "/metrics"
+
+// metricsCounters - counters of the daemon events which are not available from the service
+type metricsCounters struct {
+	mutex      sync.Mutex
+	reconnects uint64
+}
+
+func (m *metricsCounters) onVpnStateChanged(state vpn.State) {
+	if state != vpn.RECONNECTING {
+		return
+	}
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.reconnects++
+}
+
+func (m *metricsCounters) reconnectsCount() uint64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.reconnects
+}
+
+// escaping of the label values (Prometheus text format)
+var metricsLabelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// metricsWriter - helper to write the metrics in Prometheus text exposition format
+type metricsWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *metricsWriter) metric(name, metricType, help string, value interface{}, labels ...string) {
+	fmt.Fprintf(&w.buf, "# HELP %s %s\n", name, help)
+	fmt.Fprintf(&w.buf, "# TYPE %s %s\n", name, metricType)
+	w.value(name, value, labels...)
+}
+
+// value writes the sample of the metric; 'labels' - the list of label name/value pairs
+func (w *metricsWriter) value(name string, value interface{}, labels ...string) {
+	w.buf.WriteString(name)
+	if len(labels) > 1 {
+		w.buf.WriteString("{")
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				w.buf.WriteString(",")
+			}
+			fmt.Fprintf(&w.buf, "%s=\"%s\"", labels[i], metricsLabelEscaper.Replace(labels[i+1]))
+		}
+		w.buf.WriteString("}")
+	}
+	fmt.Fprintf(&w.buf, " %v\n", value)
+}
+
+func boolMetric(v bool) int {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+func (p *Protocol) restApiMetricsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	w.Write(p.metrics())
+}
+
+// metrics returns the current metrics of the daemon in Prometheus text format
+func (p *Protocol) metrics() []byte {
+	var w metricsWriter
+
+	w.metric("ivpn_daemon_info", "gauge", "Information about the IVPN daemon.", 1, "version", version.Version())
+
+	vpnState := p.lastVPNState()
+	isConnected := vpnState.State == vpn.CONNECTED
+	w.metric("ivpn_vpn_state", "gauge", "Current state of the VPN connection (the value is 1 for the current state).", 1, "state", vpnState.State.String())
+	w.metric("ivpn_vpn_connected", "gauge", "Whether the VPN is connected (1) or not (0).", boolMetric(isConnected))
+	w.metric("ivpn_vpn_reconnects_total", "counter", "Number of automatic reconnections since the daemon start.", p._metrics.reconnectsCount())
+
+	if isConnected {
+		w.metric("ivpn_vpn_connected_since_timestamp_seconds", "gauge", "Time when the current VPN connection was established (unix time).", vpnState.Time, "type", vpnState.VpnType.String())
+
+		if rx, tx, ok := p._service.VpnTrafficStats(); ok {
+			w.metric("ivpn_vpn_receive_bytes_total", "counter", "Number of bytes received through the VPN tunnel.", rx)
+			w.metric("ivpn_vpn_transmit_bytes_total", "counter", "Number of bytes sent through the VPN tunnel.", tx)
+		}
+
+		if vpnState.VpnType == vpn.WireGuard {
+			if t, ok := p._service.VpnLatestHandshake(); ok {
+				w.metric("ivpn_wireguard_latest_handshake_age_seconds", "gauge", "Time since the latest handshake with the WireGuard server.", int64(time.Since(t).Seconds()))
+			}
+		}
+	}
+
+	if isEnabled, _, _, _, _, _, err := p._service.KillSwitchState(); err == nil {
+		w.metric("ivpn_firewall_enabled", "gauge", "Whether the firewall (kill-switch) is enabled (1) or not (0).", boolMetric(isEnabled))
+	}
+
+	apiTotal, apiFailed := p._service.ApiRequestsStats()
+	w.metric("ivpn_api_requests_total", "counter", "Number of requests to the IVPN API since the daemon start.", apiTotal)
+	w.metric("ivpn_api_request_errors_total", "counter", "Number of failed requests to the IVPN API since the daemon start.", apiFailed)
+
+	return w.buf.Bytes()
+}
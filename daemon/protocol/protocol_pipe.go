@@ -0,0 +1,224 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package protocol
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/logger"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// The requests of the local APIs (REST API, gRPC API) are passed to the protocol over the in-memory connection (net.Pipe()):
+// they are processed the same way as the requests of the CLI client.
+const (
+	// max time to wait for the response to the request
+	pipeResponseTimeout = 60 * time.Second
+	// index of the request sent to the protocol (any value > 0; responses to the request have the same index)
+	pipeRequestIdx = 1
+	// how often the connection statistics is sent to the events stream
+	pipeStatsInterval = 2 * time.Second
+	// max number of log messages waiting to be sent to the events stream (the messages are skipped when the client is too slow)
+	pipeLogQueueSize = 256
+)
+
+// pipeProcessRequest passes the request to the protocol (as a request from the CLI client) and waits for the response.
+// 'newConn' - wraps the server side of the in-memory connection (to provide the info about the client: address, audit log actor).
+func (p *Protocol) pipeProcessRequest(newConn func(net.Conn) net.Conn, command string, request []byte, scope preferences.ClientAccessScope, clientToken string) ([]byte, error) {
+	srvConn, cliConn := net.Pipe()
+	conn := newConn(srvConn)
+
+	p.clientConnected(conn, types.ClientCli, scope, clientToken)
+	defer func() {
+		// close the connection before removing it from the list (the notifications to the clients can be blocked on writing to it)
+		cliConn.Close()
+		p.clientDisconnected(conn)
+	}()
+
+	// read all the data sent to the connection (responses and notifications)
+	messages := make(chan []byte)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		defer close(messages)
+		reader := bufio.NewReader(cliConn)
+		for {
+			message, err := reader.ReadBytes('\n')
+			if err != nil {
+				return
+			}
+			select {
+			case messages <- message:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	go p.processRequest(conn, string(request)+"\n")
+
+	timeout := time.After(pipeResponseTimeout)
+	for {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				return nil, fmt.Errorf("no response received")
+			}
+			cmd, err := types.GetCommandBase(message)
+			if err != nil {
+				continue
+			}
+			if cmd.Idx == pipeRequestIdx {
+				return message, nil
+			}
+			// when VPN is connected - there is no direct response to the 'Disconnect' request: all the clients are notified when disconnected
+			if command == "Disconnect" && cmd.Command == types.GetTypeName(types.DisconnectedResp{}) {
+				return message, nil
+			}
+		case <-timeout:
+			return nil, fmt.Errorf("no response received in %v", pipeResponseTimeout)
+		}
+	}
+}
+
+// pipeStreamEvents sends the daemon events to the client of the local API until the client is disconnected ('closed')
+// or the sending failed.
+// The events are the notifications which are sent to the clients of the daemon protocol (filtered by 'events'; empty - all the events)
+// and additional events:
+//   - ConnectionStatsResp - statistics of the VPN connection (periodically, when connected);
+//   - LogMessageResp - the daemon log messages (only when 'isLogsRequested' and the logging is enabled; not available for read-only clients).
+//
+// 'send' - sends the event (JSON message of the daemon protocol) to the client; it is never called concurrently.
+func (p *Protocol) pipeStreamEvents(newConn func(net.Conn) net.Conn, scope preferences.ClientAccessScope, clientToken string, isLogsRequested bool, events []string,
+	send func(message []byte) error, closed <-chan struct{}) {
+
+	done := make(chan struct{})
+	var stopOnce sync.Once
+	stop := func() { stopOnce.Do(func() { close(done) }) }
+	defer stop()
+
+	var sendMutex sync.Mutex
+	sendMessage := func(message []byte) {
+		sendMutex.Lock()
+		defer sendMutex.Unlock()
+		if err := send(message); err != nil {
+			stop()
+		}
+	}
+	filter := newNotificationsFilter(events)
+	sendCmd := func(cmd types.ICommandBase) {
+		if !filter.IsAllowed(types.GetTypeName(cmd)) {
+			return
+		}
+		if message, err := types.Serialize(cmd, 0); err == nil {
+			sendMessage(append(message, '\n'))
+		}
+	}
+
+	// register the connection as a client of the protocol to receive all the notifications
+	srvConn, cliConn := net.Pipe()
+	conn := newConn(srvConn)
+	p.clientConnected(conn, types.ClientCli, scope, clientToken)
+	p.clientSetNotificationsFilter(conn, events)
+	defer func() {
+		// close the connection before removing it from the list (the notifications to the clients can be blocked on writing to it)
+		cliConn.Close()
+		p.clientDisconnected(conn)
+	}()
+
+	// current state
+	vpnState := p.lastVPNState()
+	switch vpnState.State {
+	case vpn.CONNECTED:
+		sendCmd(p.createConnectedResponse(vpnState))
+	case vpn.DISCONNECTED:
+		sendCmd(&types.DisconnectedResp{})
+	default:
+		sendCmd(&types.VpnStateResp{StateVal: vpnState.State, State: vpnState.State.String()})
+	}
+	if isEnabled, isPersistant, isAllowLAN, isAllowLanMulticast, isAllowApiServers, fwUserExceptions, err := p._service.KillSwitchState(); err == nil {
+		sendCmd(&types.KillSwitchStatusResp{
+			IsEnabled:         isEnabled,
+			IsPersistent:      isPersistant,
+			IsAllowLAN:        isAllowLAN,
+			IsAllowMulticast:  isAllowLanMulticast,
+			IsAllowApiServers: isAllowApiServers,
+			UserExceptions:    fwUserExceptions,
+			AllowLANServices:  p._service.Preferences().FwAllowLanServices,
+			AllowedLANHosts:   p._service.Preferences().FwAllowedLanHosts,
+			LANInboundPorts:   p._service.Preferences().FwLanInboundPorts})
+	}
+
+	// notifications
+	go func() {
+		defer stop()
+		reader := bufio.NewReader(cliConn)
+		for {
+			message, err := reader.ReadBytes('\n')
+			if err != nil {
+				return
+			}
+			sendMessage(message)
+		}
+	}()
+
+	// log messages
+	logMessages := make(chan logger.Message, pipeLogQueueSize)
+	if isLogsRequested && scope == preferences.ClientScopeFull {
+		removeListener := logger.AddListener(func(m logger.Message) {
+			select {
+			case logMessages <- m:
+			default: // the client is too slow: skip the message
+			}
+		})
+		defer removeListener()
+	}
+
+	statsTicker := time.NewTicker(pipeStatsInterval)
+	defer statsTicker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-closed:
+			return
+		case m := <-logMessages:
+			// Note: do not write to the log here (it would produce new log messages)
+			sendCmd(newLogMessageResp(m))
+		case <-statsTicker.C:
+			if p.lastVPNState().State != vpn.CONNECTED {
+				continue
+			}
+			if rx, tx, ok := p._service.VpnTrafficStats(); ok {
+				sendCmd(&types.ConnectionStatsResp{RxBytes: rx, TxBytes: tx})
+			}
+		}
+	}
+}
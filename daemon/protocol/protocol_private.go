@@ -25,21 +25,40 @@ package protocol
 import (
 	"fmt"
 	"net"
+	"regexp"
 	"runtime"
 	"strings"
 
+	"github.com/ivpn/desktop-app/daemon/auditlog"
+	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/protocol/types"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
 	"github.com/ivpn/desktop-app/daemon/version"
 	"github.com/ivpn/desktop-app/daemon/vpn"
 )
 
 // ----------------------------------------------------------------------
 func getConnectionName(c net.Conn) string {
+	if isLocalSocketConnection(c) {
+		return fmt.Sprintf("%s:%p", c.LocalAddr().Network(), c)
+	}
 	return strings.TrimSpace(strings.Replace(c.RemoteAddr().String(), "127.0.0.1:", "", 1))
 }
 
+// validateAccountID checks the account ID format
+func validateAccountID(accountID string) error {
+	matched, err := regexp.MatchString("^(i-....-....-....)|(ivpn[a-zA-Z0-9]{7,8})$", accountID)
+	if err != nil {
+		return fmt.Errorf("[daemon] Account ID validation failed: %w", err)
+	}
+	if !matched {
+		return fmt.Errorf("[daemon] Your account ID has to be in 'i-XXXX-XXXX-XXXX' or 'ivpnXXXXXXXX' format.")
+	}
+	return nil
+}
+
 func (p *Protocol) connLogID(c net.Conn) string {
 	if c == nil {
 		return ""
@@ -47,12 +66,108 @@ func (p *Protocol) connLogID(c net.Conn) string {
 	return fmt.Sprintf("%s ", getConnectionName(c))
 }
 
+// -------------- audit log ---------------
+// audit saves security-relevant action (initiated by the client 'c') to the audit log
+func (p *Protocol) audit(c net.Conn, event string, details string) {
+	auditlog.Write(p.connActor(c), event, details)
+}
+
+// lastVPNState returns the last known VPN state
+func (p *Protocol) lastVPNState() vpn.StateInfo {
+	p._lastVPNStateMutex.RLock()
+	defer p._lastVPNStateMutex.RUnlock()
+	return p._lastVPNState
+}
+
+// setLastVPNState saves the VPN state and returns the previous one
+func (p *Protocol) setLastVPNState(state vpn.StateInfo) (prev vpn.StateInfo) {
+	p._lastVPNStateMutex.Lock()
+	defer p._lastVPNStateMutex.Unlock()
+	prev, p._lastVPNState = p._lastVPNState, state
+	return prev
+}
+
+// connActor returns info about the client process.
+// Note: it must be called before sending response to the client (the client can close the connection just after receiving the response)
+func (p *Protocol) connActor(c net.Conn) auditlog.Actor {
+	if rc, ok := c.(*restApiConn); ok {
+		return rc.actor
+	}
+	if gc, ok := c.(*grpcApiConn); ok {
+		return gc.actor
+	}
+
+	p._connectionsMutex.RLock()
+	cInfo, ok := p._connections[c]
+	p._connectionsMutex.RUnlock()
+
+	if ok && cInfo.Actor != nil {
+		return *cInfo.Actor
+	}
+
+	actorType := auditlog.ActorUI
+	if cInfo.Type == types.ClientCli {
+		actorType = auditlog.ActorCLI
+	}
+	actor := auditlog.ConnectionActor(actorType, c)
+
+	if ok {
+		p._connectionsMutex.Lock()
+		defer p._connectionsMutex.Unlock()
+		if cInfo, ok := p._connections[c]; ok {
+			cInfo.Actor = &actor
+			p._connections[c] = cInfo
+		}
+	}
+	return actor
+}
+
 // -------------- send message to all active connections ---------------
 func (p *Protocol) notifyClients(cmd types.ICommandBase) {
+	cmdName := types.GetTypeName(cmd)
+
 	p._connectionsMutex.RLock()
 	defer p._connectionsMutex.RUnlock()
-	for conn := range p._connections {
-		p.sendResponse(conn, cmd, 0)
+	for conn, cInfo := range p._connections {
+		if !cInfo.NotificationsFilter.IsAllowed(cmdName) {
+			continue
+		}
+		if c := responseForScope(cmd, cInfo.Scope); c != nil {
+			p.sendResponse(conn, c, 0)
+		}
+	}
+}
+
+// notificationsFilter - set of notification types which the client is subscribed to (nil - all notifications)
+type notificationsFilter map[string]struct{}
+
+func newNotificationsFilter(notifications []string) notificationsFilter {
+	if len(notifications) == 0 {
+		return nil
+	}
+	filter := make(notificationsFilter, len(notifications))
+	for _, n := range notifications {
+		filter[n] = struct{}{}
+	}
+	return filter
+}
+
+// IsAllowed returns 'true' when the notification of the specified type has to be sent to the client
+func (f notificationsFilter) IsAllowed(notificationName string) bool {
+	if f == nil {
+		return true
+	}
+	_, ok := f[notificationName]
+	return ok
+}
+
+// clientSetNotificationsFilter subscribes the client only to specific notification types (empty list - all notifications)
+func (p *Protocol) clientSetNotificationsFilter(c net.Conn, notifications []string) {
+	p._connectionsMutex.Lock()
+	defer p._connectionsMutex.Unlock()
+	if cInfo, ok := p._connections[c]; ok {
+		cInfo.NotificationsFilter = newNotificationsFilter(notifications)
+		p._connections[c] = cInfo
 	}
 }
 
@@ -85,16 +200,19 @@ func (p *Protocol) IsCanDoBackgroundAction() bool {
 	return true
 }
 
-func (p *Protocol) clientConnected(c net.Conn, cType types.ClientTypeEnum) {
+func (p *Protocol) clientConnected(c net.Conn, cType types.ClientTypeEnum, scope preferences.ClientAccessScope, accessToken string) {
 	p._connectionsMutex.Lock()
 	defer p._connectionsMutex.Unlock()
-	p._connections[c] = connectionInfo{Type: cType}
+	p._connections[c] = connectionInfo{Type: cType, Scope: scope, AccessToken: accessToken}
 }
 
 func (p *Protocol) clientDisconnected(c net.Conn) {
 	p._connectionsMutex.Lock()
 	defer p._connectionsMutex.Unlock()
 
+	if cInfo, ok := p._connections[c]; ok && cInfo.LogStreamStop != nil {
+		cInfo.LogStreamStop()
+	}
 	delete(p._connections, c)
 	c.Close()
 }
@@ -202,12 +320,80 @@ func (p *Protocol) createSettingsResponse() *types.SettingsResp {
 		IsAutoconnectOnLaunchDaemon: prefs.IsAutoconnectOnLaunchDaemon,
 		UserDefinedOvpnFile:         platform.OpenvpnUserParamsFile(),
 		ObfsproxyConfig:             prefs.Obfs4proxy,
+		V2RayProxy:                  prefs.V2RayProxy,
+		ShadowsocksProxy:            prefs.ShadowsocksProxy,
+		AmneziaWG:                   prefs.AmneziaWG,
 		UserPrefs:                   prefs.UserPrefs,
 		WiFi:                        prefs.WiFiControl,
+		Schedule:                    prefs.Schedule,
+		IsConnectionHistoryDisabled: prefs.IsConnectionHistoryDisabled,
+		IsConnectionStatsDisabled:   prefs.IsConnectionStatsDisabled,
+		DataUsageAlert:              prefs.DataUsageAlert,
+		LatencyProbeMode:            prefs.LatencyProbeMode.Normalized(),
+		FwMssClamp:                  prefs.FwMssClamp,
+		IsWGKeyHwProtection:         prefs.IsWGKeyHwProtection,
+		IsWgFallbackToOpenVPN:       prefs.IsWgFallbackToOpenVPN,
+		IsWgLanBypass:               prefs.IsWgLanBypass,
+		IsWgPeerFailover:            prefs.IsWgPeerFailover,
+		WgPortHoppingMinutes:        prefs.WgPortHoppingMinutes,
+		IsWgQuantumResistance:       prefs.IsWgQuantumResistance,
+		WgKemAlgorithm:              prefs.WgKemAlgorithm,
+		IsApiTimeHintAllowed:        prefs.IsApiTimeHintAllowed,
+		IsCaptivePortalCheck:        prefs.IsCaptivePortalCheck,
+		IsSessionAutoRenew:          prefs.IsSessionAutoRenew,
+		IsSettingsEncryption:        prefs.IsSettingsEncryption,
+		IsLogJSONFormat:             prefs.IsLogJSONFormat,
+		LogRotation:                 prefs.LogRotation,
+		LogOutput:                   prefs.LogOutput,
+		IsLogPrivacyMode:            prefs.IsLogPrivacyMode,
+		ApiProxy:                    prefs.ApiProxy,
+		ApiHostOverride:             prefs.ApiHostOverride,
+		OpenVpnExtraParameters:      prefs.OpenVpnExtraParameters,
+		IsOpenVpnDcoDisabled:        prefs.IsOpenVpnDcoDisabled,
+		OpenVpnCryptoPolicy:         prefs.OpenVpnCryptoPolicy,
 		// TODO: implement the rest of daemon settings
 	}
 }
 
+func (p *Protocol) createLogLevelsResponse() *types.LogLevelsResp {
+	defLevel, modules, allModules := logger.Levels()
+
+	ret := &types.LogLevelsResp{
+		DefaultLevel: defLevel.String(),
+		Modules:      make(map[string]string, len(modules)),
+		KnownModules: allModules,
+	}
+	for m, l := range modules {
+		ret.Modules[m] = l.String()
+	}
+	return ret
+}
+
+// setLogLevel changes the runtime log level.
+// Empty 'module' - change the default level; empty 'level' - remove the module-specific level
+func setLogLevel(module, level string) error {
+	module = strings.TrimSpace(module)
+	level = strings.TrimSpace(level)
+
+	if level == "" {
+		if module == "" {
+			return fmt.Errorf("log level not defined")
+		}
+		logger.ResetModuleLevel(module)
+		return nil
+	}
+
+	l, err := logger.ParseLevel(level)
+	if err != nil {
+		return err
+	}
+	if module == "" {
+		logger.SetDefaultLevel(l)
+		return nil
+	}
+	return logger.SetModuleLevel(module, l)
+}
+
 func (p *Protocol) createHelloResponse() *types.HelloResp {
 	prefs := p._service.Preferences()
 
@@ -224,6 +410,7 @@ func (p *Protocol) createHelloResponse() *types.HelloResp {
 	helloResp := types.HelloResp{
 		ParanoidMode:        types.ParanoidModeStatus{IsEnabled: p._eaa.IsEnabled()},
 		Version:             version.Version(),
+		ProtocolVersion:     types.ProtocolVersion,
 		ProcessorArch:       runtime.GOARCH,
 		Session:             types.CreateSessionResp(prefs.Session),
 		Account:             prefs.Account,
@@ -233,7 +420,8 @@ func (p *Protocol) createHelloResponse() *types.HelloResp {
 			CanUseDnsOverTls:   dnsOverTls,
 			CanUseDnsOverHttps: dnsOverHttps,
 		},
-		DaemonSettings: *p.createSettingsResponse(),
+		DaemonSettings:    *p.createSettingsResponse(),
+		SupportedRequests: supportedRequests,
 	}
 	return &helloResp
 }
@@ -254,7 +442,11 @@ func (p *Protocol) createConnectedResponse(state vpn.StateInfo) *types.Connected
 		ManualDNS:       dns.GetLastManualDNS(),
 		IsCanPause:      state.IsCanPause,
 		IsTCP:           state.IsTCP,
-		Mtu:             state.Mtu}
+		Mtu:             state.Mtu,
+		V2RayProxy:      state.V2RayProxy,
+		IsShadowsocks:   state.IsShadowsocks,
+		IsCustomConfig:  state.IsCustomConfig,
+		LatencyMs:       p._service.ConnectionLatencyMs()}
 
 	return ret
 }
@@ -0,0 +1,242 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package protocol
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/auditlog"
+	"github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+)
+
+// Local REST API of the daemon (opt-in; disabled by default).
+//
+// It mirrors the requests of the daemon protocol, so they can be sent by 'curl' (e.g. for home-automation or scripting):
+//
+//	curl -X POST -H "Authorization: Bearer <token>" http://127.0.0.1:<port>/api/v1/<Command> [-d '<JSON request body>']
+//
+// The request body (optional) contains the request fields in the same format as for the daemon protocol
+// (the 'Command' and 'Idx' fields are not required).
+// The response body contains the response to the request, in the same format as for the daemon protocol.
+// The daemon events are available over WebSocket (see 'restApiEventsPath').
+// The metrics in Prometheus format are available when enabled (see 'restApiMetricsPath').
+const (
+	restApiPathPrefix = "/api/v1/"
+	// max size of the request body
+	restApiMaxRequestSize = 1024 * 1024
+)
+
+type restApiConnCtxKey struct{}
+
+// restApiAddr - address of the REST API request
+type restApiAddr string
+
+func (a restApiAddr) Network() string { return "rest" }
+func (a restApiAddr) String() string  { return string(a) }
+
+// restApiConn - in-memory connection which is used to pass the REST API request to the protocol
+type restApiConn struct {
+	net.Conn
+	remoteAddr restApiAddr
+	actor      auditlog.Actor
+}
+
+func (c *restApiConn) LocalAddr() net.Addr  { return restApiAddr("rest") }
+func (c *restApiConn) RemoteAddr() net.Addr { return c.remoteAddr }
+
+// restartRestApi starts (or stops) the REST API server according to the current preferences
+func (p *Protocol) restartRestApi() {
+	p.stopRestApi()
+
+	if p._service == nil {
+		return
+	}
+	params := p._service.Preferences().RestApi
+	if !params.IsEnabled {
+		return
+	}
+	if err := params.Validate(); err != nil {
+		log.Error(fmt.Errorf("REST API not started: %w", err))
+		return
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", params.Port))
+	if err != nil {
+		log.Error(fmt.Errorf("REST API not started: %w", err))
+		return
+	}
+
+	server := &http.Server{
+		Handler:           http.HandlerFunc(p.restApiHandler),
+		ReadHeaderTimeout: 10 * time.Second,
+		// keep the underlying TCP connection in the request context (to detect the client process for the audit log)
+		ConnContext: func(ctx context.Context, c net.Conn) context.Context {
+			return context.WithValue(ctx, restApiConnCtxKey{}, c)
+		},
+	}
+
+	p._restApiMutex.Lock()
+	p._restApiServer = server
+	p._restApiMutex.Unlock()
+
+	log.Info(fmt.Sprintf("REST API started: %s", listener.Addr()))
+	go func() {
+		if err := server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error(fmt.Errorf("REST API stopped: %w", err))
+		}
+	}()
+}
+
+func (p *Protocol) stopRestApi() {
+	p._restApiMutex.Lock()
+	server := p._restApiServer
+	p._restApiServer = nil
+	p._restApiMutex.Unlock()
+
+	if server != nil {
+		// wait for the active requests to be completed
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(ctx); err != nil {
+			server.Close()
+		}
+		log.Info("REST API stopped")
+	}
+}
+
+func (p *Protocol) restApiHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost && r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	isEventsRequest := r.URL.Path == restApiEventsPath
+	if isEventsRequest && r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	scope, clientToken, ok := p.restApiIsAuthorized(r, isEventsRequest)
+	if !ok {
+		log.Warning(fmt.Sprintf("REST API: unauthorized request from %s", r.RemoteAddr))
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	if isEventsRequest {
+		p.restApiEventsHandler(w, r, scope, clientToken)
+		return
+	}
+
+	if r.URL.Path == restApiMetricsPath {
+		if !p._service.Preferences().RestApi.IsMetricsEnabled {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+		p.restApiMetricsHandler(w, r)
+		return
+	}
+
+	command := strings.TrimPrefix(r.URL.Path, restApiPathPrefix)
+	if !strings.HasPrefix(r.URL.Path, restApiPathPrefix) || len(command) == 0 || strings.Contains(command, "/") {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+
+	request, err := restApiRequestData(command, io.LimitReader(r.Body, restApiMaxRequestSize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	actor := auditlog.Actor{Type: auditlog.ActorRest, Uid: -1}
+	if c, ok := r.Context().Value(restApiConnCtxKey{}).(net.Conn); ok {
+		actor = auditlog.ConnectionActor(auditlog.ActorRest, c)
+	}
+
+	remoteAddr := restApiAddr(r.RemoteAddr)
+	log.Info(fmt.Sprintf("REST API: request '%s' from %s", command, remoteAddr))
+	newConn := func(c net.Conn) net.Conn { return &restApiConn{Conn: c, remoteAddr: remoteAddr, actor: actor} }
+	response, err := p.pipeProcessRequest(newConn, command, request, scope, clientToken)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusGatewayTimeout)
+		return
+	}
+
+	status := http.StatusOK
+	if cmd, err := types.GetCommandBase(response); err == nil && cmd.Command == types.GetTypeName(types.ErrorResp{}) {
+		status = http.StatusBadRequest
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	w.Write(response)
+}
+
+// restApiIsAuthorized checks the access token of the request ('Authorization: Bearer <token>') and returns the access scope of the request
+// (see localApiTokenScope()).
+// If 'isQueryTokenAllowed' - the token can be also passed in the 'token' query parameter.
+func (p *Protocol) restApiIsAuthorized(r *http.Request, isQueryTokenAllowed bool) (scope preferences.ClientAccessScope, clientToken string, ok bool) {
+	const prefix = "Bearer "
+	requestToken := ""
+	if auth := r.Header.Get("Authorization"); strings.HasPrefix(auth, prefix) {
+		requestToken = strings.TrimPrefix(auth, prefix)
+	} else if isQueryTokenAllowed {
+		requestToken = r.URL.Query().Get("token")
+	}
+	return p.localApiTokenScope(requestToken, p._service.Preferences().RestApi.Token)
+}
+
+// restApiRequestData converts the body of the REST API request to the daemon protocol request
+func restApiRequestData(command string, body io.Reader) ([]byte, error) {
+	// keep the raw values of the fields (e.g. to not lose precision of large numbers)
+	fields := make(map[string]json.RawMessage)
+
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request: %w", err)
+	}
+	if len(strings.TrimSpace(string(data))) > 0 {
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return nil, fmt.Errorf("failed to parse request (JSON object expected): %w", err)
+		}
+	}
+
+	commandData, err := json.Marshal(command)
+	if err != nil {
+		return nil, err
+	}
+	fields["Command"] = commandData
+	fields["Idx"] = json.RawMessage(fmt.Sprint(pipeRequestIdx))
+
+	return json.Marshal(fields)
+}
@@ -0,0 +1,96 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package protocol
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/ivpn/desktop-app/daemon/auditlog"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"golang.org/x/net/websocket"
+)
+
+// WebSocket endpoint of the REST API which streams the daemon events as JSON messages
+// (the same notifications which are sent to the clients of the daemon protocol):
+//
+//	ws://127.0.0.1:<port>/api/v1/events[?logs=1][&events=<Name1>,<Name2>...]
+//
+// 'events' - comma-separated list of the event types to receive (e.g. 'events=VpnStateResp,ConnectionStatsResp');
+// when not defined - all the events are sent.
+// The access token can be passed in the 'Authorization: Bearer <token>' header
+// or in the 'token' query parameter (web browsers are not able to set headers for WebSocket connections).
+// Additional events:
+//   - ConnectionStatsResp - statistics of the VPN connection (periodically, when connected);
+//   - LogMessageResp - the daemon log messages (only when 'logs=1' and the logging is enabled; not available for read-only clients).
+const restApiEventsPath = restApiPathPrefix + "events"
+
+func (p *Protocol) restApiEventsHandler(w http.ResponseWriter, r *http.Request, scope preferences.ClientAccessScope, clientToken string) {
+	isLogsRequested := r.URL.Query().Get("logs") == "1"
+
+	var events []string
+	if eventsParam := r.URL.Query().Get("events"); len(eventsParam) > 0 {
+		for _, e := range strings.Split(eventsParam, ",") {
+			if e = strings.TrimSpace(e); len(e) > 0 {
+				events = append(events, e)
+			}
+		}
+	}
+
+	actor := auditlog.Actor{Type: auditlog.ActorRest, Uid: -1}
+	if c, ok := r.Context().Value(restApiConnCtxKey{}).(net.Conn); ok {
+		actor = auditlog.ConnectionActor(auditlog.ActorRest, c)
+	}
+	remoteAddr := restApiAddr(r.RemoteAddr)
+
+	server := websocket.Server{
+		// the access is controlled by the token (the 'Origin' header is not checked)
+		Handshake: func(*websocket.Config, *http.Request) error { return nil },
+		Handler: func(ws *websocket.Conn) {
+			p.restApiStreamEvents(ws, remoteAddr, actor, scope, clientToken, isLogsRequested, events)
+		},
+	}
+	server.ServeHTTP(w, r)
+}
+
+func (p *Protocol) restApiStreamEvents(ws *websocket.Conn, remoteAddr restApiAddr, actor auditlog.Actor, scope preferences.ClientAccessScope, clientToken string, isLogsRequested bool, events []string) {
+	log.Info("REST API: events stream opened ", remoteAddr)
+	defer log.Info("REST API: events stream closed ", remoteAddr)
+
+	// the data received from the client is ignored; detect when the connection is closed
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		var data string
+		for {
+			if err := websocket.Message.Receive(ws, &data); err != nil {
+				return
+			}
+		}
+	}()
+
+	newConn := func(c net.Conn) net.Conn { return &restApiConn{Conn: c, remoteAddr: remoteAddr, actor: actor} }
+	send := func(message []byte) error { return websocket.Message.Send(ws, string(message)) }
+	p.pipeStreamEvents(newConn, scope, clientToken, isLogsRequested, events, send, closed)
+}
@@ -23,10 +23,17 @@
 package types
 
 import (
+	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/awg"
+	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/obfsproxy"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
 	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"github.com/ivpn/desktop-app/daemon/service/speedtest"
 	service_types "github.com/ivpn/desktop-app/daemon/service/types"
+	"github.com/ivpn/desktop-app/daemon/shadowsocks"
+	"github.com/ivpn/desktop-app/daemon/v2r"
 	"github.com/ivpn/desktop-app/daemon/vpn"
 )
 
@@ -49,8 +56,13 @@ type Hello struct {
 	ClientType ClientTypeEnum
 	// connected client version
 	Version string
+	// client protocol version (types.ProtocolVersion); 0 - for clients which does not support versioning
+	ProtocolVersion int
 
 	Secret uint64
+	// client access token (preferences.ClientToken); when defined - the 'Secret' is not required
+	// and the access level of the client is defined by the token
+	AccessToken string
 
 	// when 'true' - send HelloResp to all connected clients
 	SendResponseToAllClients bool
@@ -66,6 +78,18 @@ type Hello struct {
 
 	// GetWiFiCurrentState == true - client requests info about current WiFi
 	GetWiFiCurrentState bool
+
+	// NotificationsFilter - the notification types which the client wants to receive (see SetNotificationsFilter)
+	NotificationsFilter []string
+}
+
+// SetNotificationsFilter subscribes the client connection only to specific notification types.
+// 'Notifications' contains the names of the responses (e.g. "VpnStateResp", "KillSwitchStatusResp").
+// Empty list - all notifications are sent to the client (default).
+// Note: it does not affect the responses to the requests of the client.
+type SetNotificationsFilter struct {
+	RequestBase
+	Notifications []string
 }
 
 // GetServers request servers list
@@ -109,6 +133,26 @@ type KillSwitchSetAllowLAN struct {
 	AllowLAN bool
 }
 
+// KillSwitchSetAllowLANServices set the LAN services allowed by kill-switch individually (when LAN communication is blocked)
+type KillSwitchSetAllowLANServices struct {
+	RequestBase
+	Services lansvc.Services
+}
+
+// KillSwitchSetAllowedLANHosts set the LAN hosts allowed by kill-switch (when LAN communication is blocked)
+type KillSwitchSetAllowedLANHosts struct {
+	RequestBase
+	// comma separated list of local IP addresses (e.g. NAS, printer)
+	Hosts string
+}
+
+// KillSwitchSetLANInboundPorts set the local ports which accept incoming connections from LAN
+// while the kill-switch is blocking the inbound traffic (e.g. SSH server, local web server)
+type KillSwitchSetLANInboundPorts struct {
+	RequestBase
+	Ports []lansvc.InboundPort
+}
+
 // KillSwitchSetUserExceptions set ip masks to exclude from firewall blocking rules
 type KillSwitchSetUserExceptions struct {
 	CommandBase
@@ -159,6 +203,129 @@ type SetObfsProxy struct {
 	ObfsproxyConfig obfsproxy.Config
 }
 
+// SetV2RayProxy sets V2Ray transport for VPN connections (v2r.None - disable V2Ray)
+type SetV2RayProxy struct {
+	RequestBase
+	V2RayProxy v2r.V2RayTransportType
+}
+
+// SetApiProxy sets proxy server for the daemon API requests (empty configuration - direct connection)
+type SetApiProxy struct {
+	RequestBase
+	Config api_types.ProxyConfig
+}
+
+// SetApiHostOverride sets the alternative API server for the daemon API requests (empty configuration - the default IVPN API server)
+// NOTE: the alternative server is fully trusted (it defines the VPN servers the daemon connects to); use only the servers under your control.
+type SetApiHostOverride struct {
+	RequestBase
+	Config api_types.APIHostOverride
+}
+
+// SetOpenVpnExtraParameters sets the additional OpenVPN directives, one per line (empty string - no additional directives).
+// Only the safe directives are accepted (e.g. 'script-security', 'up', 'down', 'plugin' are rejected)
+type SetOpenVpnExtraParameters struct {
+	RequestBase
+	Params string
+}
+
+// SetOpenVpnCryptoPolicy sets the TLS/cipher policy for OpenVPN connections (empty policy - default parameters)
+type SetOpenVpnCryptoPolicy struct {
+	RequestBase
+	Policy vpn.OpenVpnCryptoPolicy
+}
+
+// RestApiGet requests the configuration of the local REST API (including the access token)
+type RestApiGet struct {
+	RequestBase
+}
+
+// SetRestApi enables/disables the local REST API ('Port' = 0 - keep the current port; 'ResetToken' - generate new access token)
+type SetRestApi struct {
+	RequestBase
+	IsEnabled  bool
+	Port       int
+	ResetToken bool
+	// enables/disables the Prometheus metrics endpoint ('/metrics') of the REST API
+	IsMetricsEnabled bool
+}
+
+// GrpcApiGet requests the configuration of the local gRPC API (including the access token)
+type GrpcApiGet struct {
+	RequestBase
+}
+
+// SetGrpcApi enables/disables the local gRPC API ('Port' = 0 - keep the current port; 'ResetToken' - generate new access token)
+type SetGrpcApi struct {
+	RequestBase
+	IsEnabled  bool
+	Port       int
+	ResetToken bool
+}
+
+// SetLogRotation sets the configuration of the daemon log files rotation
+type SetLogRotation struct {
+	RequestBase
+	Config logger.RotationConfig
+}
+
+// LogLevelsGet requests the current log levels of the daemon modules
+type LogLevelsGet struct {
+	RequestBase
+}
+
+// SetLogLevel changes the log level at runtime (not saved over the daemon restart).
+// 'Module' - name of the logger module (e.g. "dns"); empty - change the default level for all modules.
+// 'Level' - "debug", "info", "warning" or "error"; empty - remove the module-specific level (the default level is in use).
+type SetLogLevel struct {
+	RequestBase
+	Module string
+	Level  string
+}
+
+// ClientTokensGet requests the list of the client access tokens
+type ClientTokensGet struct {
+	RequestBase
+}
+
+// ClientTokenAdd creates new client access token ('Scope': "read" - read-only access; "full" - full control)
+type ClientTokenAdd struct {
+	RequestBase
+	Name  string
+	Scope preferences.ClientAccessScope
+}
+
+// ClientTokenRemove removes the client access token by name
+type ClientTokenRemove struct {
+	RequestBase
+	Name string
+}
+
+// SettingsExport requests the daemon configuration which can be imported on another machine (SettingsExportResp).
+// Secrets (passwords, user-defined VPN configurations) are included only when 'IncludeSecrets' is true.
+type SettingsExport struct {
+	RequestBase
+	IncludeSecrets bool
+}
+
+// SettingsImport applies the configuration exported by SettingsExport request (SettingsImportResp)
+type SettingsImport struct {
+	RequestBase
+	Settings preferences.ExportedSettings
+}
+
+// SetShadowsocksProxy sets user-defined Shadowsocks server to chain VPN connections through (empty configuration - disable Shadowsocks)
+type SetShadowsocksProxy struct {
+	RequestBase
+	Config shadowsocks.Config
+}
+
+// SetAmneziaWG sets AmneziaWG obfuscation parameters for WireGuard connections (empty configuration - vanilla WireGuard)
+type SetAmneziaWG struct {
+	RequestBase
+	Config awg.Config
+}
+
 // SetAlternateDns request to set custom DNS
 type SetAlternateDns struct {
 	RequestBase
@@ -187,6 +354,12 @@ type WiFiSettings struct {
 	Params preferences.WiFiParams
 }
 
+// ScheduleSettings - set configuration of the connection scheduler
+type ScheduleSettings struct {
+	RequestBase
+	Params preferences.ScheduleParams
+}
+
 // ConnectSettings contains same data as 'Connect' request but this command not start the connection.
 // UI/CLI client have to notify daemon about changes in connection settings.
 // It is required:
@@ -209,6 +382,187 @@ type Connect struct {
 	Params service_types.ConnectionParams
 }
 
+// ConnectionHistoryGet request the list of recently used connection parameters (ConnectionHistoryResp)
+type ConnectionHistoryGet struct {
+	RequestBase
+}
+
+// ConnectionHistoryClear erase the connection history
+type ConnectionHistoryClear struct {
+	RequestBase
+}
+
+// ConnectionStatsHistoryGet request the connection statistics history (ConnectionStatsHistoryResp).
+// 'SessionsLimit', 'DaysLimit' - max number of the records to return (0 - all records)
+type ConnectionStatsHistoryGet struct {
+	RequestBase
+	SessionsLimit int
+	DaysLimit     int
+}
+
+// ConnectionStatsHistoryClear erase the connection statistics history
+type ConnectionStatsHistoryClear struct {
+	RequestBase
+}
+
+// ThroughputHistoryGet request the recent traffic rates of the active connection (ThroughputHistoryResp).
+// 'MaxItems' - max number of the most recent samples to return (0 - all samples)
+type ThroughputHistoryGet struct {
+	RequestBase
+	MaxItems int
+}
+
+// ConnectionQualityHistoryGet request the recent quality reports of the active connection (ConnectionQualityHistoryResp).
+// 'MaxItems' - max number of the most recent reports to return (0 - all reports)
+type ConnectionQualityHistoryGet struct {
+	RequestBase
+	MaxItems int
+}
+
+// ConnectionHistoryConnect request to establish new VPN connection using parameters from the connection history
+type ConnectionHistoryConnect struct {
+	RequestBase
+	// index of the item in the connection history (0 - the most recent)
+	Index int
+}
+
+// OperationStart starts the long-running operation (e.g. "Diagnostics", "ServersUpdate").
+// Expected response: OperationStatusResp (contains the operation ID).
+// The progress and the result of the operation are sent to all clients (OperationStatusResp).
+type OperationStart struct {
+	RequestBase
+	Type string
+}
+
+// SpeedTestStart starts the speed test through the active VPN tunnel (the long-running operation of type "SpeedTest").
+// Expected response: OperationStatusResp (contains the operation ID).
+// The progress and the result of the test (speedtest.Result) are sent to all clients (OperationStatusResp).
+type SpeedTestStart struct {
+	RequestBase
+	Params speedtest.Params
+}
+
+// OperationCancel cancels the running operation
+// Expected response: OperationStatusResp
+type OperationCancel struct {
+	RequestBase
+	OperationId uint64
+}
+
+// OperationsGet request the list of running operations (OperationsListResp)
+type OperationsGet struct {
+	RequestBase
+}
+
+// ConnectionProfilesGet request the list of saved connection profiles (ConnectionProfilesResp)
+type ConnectionProfilesGet struct {
+	RequestBase
+}
+
+// ConnectionProfileSave save the connection profile (the profile with the same name is replaced)
+type ConnectionProfileSave struct {
+	RequestBase
+	Profile preferences.ConnectionProfile
+}
+
+// ConnectionProfileRemove remove the connection profile
+type ConnectionProfileRemove struct {
+	RequestBase
+	Name string
+}
+
+// ConnectionProfileConnect request to establish new VPN connection using the connection profile
+type ConnectionProfileConnect struct {
+	RequestBase
+	Name string
+}
+
+// HostsHealthGet request the health information for the VPN hosts (HostsHealthResp)
+type HostsHealthGet struct {
+	RequestBase
+}
+
+// AuditLogGet request the records of the audit log (AuditLogResp)
+type AuditLogGet struct {
+	RequestBase
+	// max number of the most recent records to return (0 - all records)
+	MaxCount int
+}
+
+// LogStreamStart starts streaming of the daemon log messages to the client (LogMessageResp notifications).
+// The stream is stopped by LogStreamStop or when the client disconnects.
+// Note: the messages are available only when the logging is enabled.
+type LogStreamStart struct {
+	RequestBase
+	// min level of the messages: "debug", "info", "warning" or "error" (empty - all messages)
+	Level string
+	// names of the logger modules (e.g. "dns"); empty - all modules
+	Modules []string
+}
+
+// LogStreamStop stops streaming of the daemon log messages to the client
+type LogStreamStop struct {
+	RequestBase
+}
+
+// ShellCommandsGet request the history of the external commands executed by the daemon (ShellCommandsResp)
+type ShellCommandsGet struct {
+	RequestBase
+	// max number of the most recent records to return (0 - all records)
+	MaxCount int
+}
+
+// CrashReportsGet request the saved crash reports of the daemon (CrashReportsResp)
+type CrashReportsGet struct {
+	RequestBase
+	// true - include the stack traces into the response
+	WithStack bool
+}
+
+// CrashReportsClear removes all the saved crash reports
+type CrashReportsClear struct {
+	RequestBase
+}
+
+// GetSubsystemStatus request the initialization status of the daemon subsystems (SubsystemStatusResp)
+type GetSubsystemStatus struct {
+	RequestBase
+}
+
+// PortForwardingGetStatus request to get the state of the forwarded port (PortForwardingStatusResp)
+type PortForwardingGetStatus struct {
+	RequestBase
+}
+
+// PortForwardingRequest request to get the forwarded port for the current VPN connection (PortForwardingStatusResp).
+// The port is renewed by the daemon until it is released or VPN disconnected.
+type PortForwardingRequest struct {
+	RequestBase
+}
+
+// PortForwardingRelease request to release the forwarded port
+type PortForwardingRelease struct {
+	RequestBase
+}
+
+// CaptivePortalCheck request to check if the internet access is restricted by a captive portal (CaptivePortalStatusResp)
+type CaptivePortalCheck struct {
+	RequestBase
+}
+
+// CaptivePortalAllowLogin request to disable the firewall temporarily for the captive portal login (CaptivePortalStatusResp).
+// The firewall is enabled back automatically when the time elapsed. The VPN must be disconnected.
+type CaptivePortalAllowLogin struct {
+	RequestBase
+	// time (seconds) while the firewall is disabled (0 - default time)
+	DurationSec int
+}
+
+// CaptivePortalReLock request to enable the firewall back after the captive portal login (CaptivePortalStatusResp)
+type CaptivePortalReLock struct {
+	RequestBase
+}
+
 // Disconnect disconnect active VPN connection
 type Disconnect struct {
 	RequestBase
@@ -224,10 +578,17 @@ type GetVPNState struct {
 // When force is set to true - all active sessions will be deleted prior to creating a new one if user reached session limit.
 // Initial call to /sessin/new should always be performed with force set to false, to display special form, when sessions limit is reached.
 // IVPN client apps have to set force to true only when customer clicks Log all other clients button.
+//
+// If the login requires additional confirmation (see SessionNewResp.Is2FARequired, SessionNewResp.IsCaptchaRequired),
+// the request has to be repeated with the same AccountID and the required fields:
+// Confirmation2FA - the TOTP token; CaptchaID and Captcha - the ID of the received captcha and the user's solution.
+// Both captcha and 2FA token can be required at the same time.
 type SessionNew struct {
 	RequestBase
 	AccountID  string
 	ForceLogin bool
+	// Keep the active session of another account logged-in (it is possible to switch back to it: see AccountSwitch)
+	KeepCurrentAccount bool
 
 	CaptchaID       string
 	Captcha         string
@@ -244,6 +605,41 @@ type SessionDelete struct {
 	IsCanDeleteSessionLocally bool
 }
 
+// DevicesList - get the devices (active sessions) of the account
+// If AccountID is empty - the current session is used for authentication (the user must be logged in).
+// Otherwise, the AccountID (and Confirmation2FA, if 2FA is enabled for the account) is used
+// (e.g. to resolve the 'too many devices' error during login)
+type DevicesList struct {
+	RequestBase
+	AccountID       string
+	Confirmation2FA string
+}
+
+// DeviceLogout - log out the device of the account (authentication: the same as for DevicesList)
+type DeviceLogout struct {
+	RequestBase
+	AccountID       string
+	Confirmation2FA string
+	DeviceID        string
+}
+
+// AccountsGet requests the list of logged-in accounts (AccountsResp)
+type AccountsGet struct {
+	RequestBase
+}
+
+// AccountSwitch makes the logged-in (but not active) account active without logout/login
+type AccountSwitch struct {
+	RequestBase
+	AccountID string
+}
+
+// AccountRemove logs out the logged-in account which is not active
+type AccountRemove struct {
+	RequestBase
+	AccountID string
+}
+
 // AccountStatus get account status
 type AccountStatus struct {
 	RequestBase
@@ -26,10 +26,23 @@ import (
 	"fmt"
 
 	"github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/auditlog"
+	"github.com/ivpn/desktop-app/daemon/awg"
+	"github.com/ivpn/desktop-app/daemon/crashreport"
 	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/obfsproxy"
+	"github.com/ivpn/desktop-app/daemon/operations"
+	"github.com/ivpn/desktop-app/daemon/service/captiveportal"
+	"github.com/ivpn/desktop-app/daemon/service/connstats"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
+	"github.com/ivpn/desktop-app/daemon/service/hostshealth"
+	"github.com/ivpn/desktop-app/daemon/service/portforwarding"
 	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"github.com/ivpn/desktop-app/daemon/service/subsystems"
+	"github.com/ivpn/desktop-app/daemon/shadowsocks"
+	"github.com/ivpn/desktop-app/daemon/shell"
+	"github.com/ivpn/desktop-app/daemon/v2r"
 	"github.com/ivpn/desktop-app/daemon/vpn"
 )
 
@@ -101,9 +114,16 @@ type DisabledFunctionalityForPlatform struct {
 // (e.g. obfsproxy or WireGaurd on Linux)
 type DisabledFunctionality struct {
 	WireGuardError   string
+	AmneziaWGError   string
 	OpenVPNError     string
 	ObfsproxyError   string
+	V2RayError       string
+	ShadowsocksError string
 	SplitTunnelError string
+	// If not empty - it is not possible to protect WireGuard private key by hardware-bound key (TPM 2.0 / Secure Enclave)
+	WGKeyHwProtectionError string
+	// If not empty - the post-quantum preshared key negotiation for WireGuard is not available (KEM helper binary not found)
+	WgQuantumResistanceError string
 
 	// Linux specific functionality which is disabled
 	Platform DisabledFunctionalityForPlatform
@@ -125,8 +145,37 @@ type SettingsResp struct {
 	IsAutoconnectOnLaunchDaemon bool
 	UserDefinedOvpnFile         string
 	ObfsproxyConfig             obfsproxy.Config // (for OpenVPN connections)
+	V2RayProxy                  v2r.V2RayTransportType
+	ShadowsocksProxy            shadowsocks.Config
+	AmneziaWG                   awg.Config
 	UserPrefs                   preferences.UserPreferences
 	WiFi                        preferences.WiFiParams
+	Schedule                    preferences.ScheduleParams
+	IsConnectionHistoryDisabled bool
+	IsConnectionStatsDisabled   bool
+	DataUsageAlert              preferences.DataUsageAlertParams
+	LatencyProbeMode            connstats.LatencyProbeMode
+	FwMssClamp                  int
+	IsWGKeyHwProtection         bool
+	IsWgFallbackToOpenVPN       bool
+	IsWgLanBypass               bool
+	IsWgPeerFailover            bool
+	WgPortHoppingMinutes        int
+	IsWgQuantumResistance       bool
+	WgKemAlgorithm              string
+	IsApiTimeHintAllowed        bool
+	IsCaptivePortalCheck        bool
+	IsSessionAutoRenew          bool
+	IsSettingsEncryption        bool
+	ApiProxy                    types.ProxyConfig
+	ApiHostOverride             types.APIHostOverride
+	OpenVpnExtraParameters      string
+	IsOpenVpnDcoDisabled        bool
+	OpenVpnCryptoPolicy         vpn.OpenVpnCryptoPolicy
+	IsLogJSONFormat             bool
+	LogRotation                 logger.RotationConfig
+	LogOutput                   logger.Output
+	IsLogPrivacyMode            bool
 
 	// TODO: implement the rest of daemon settings
 	// IsLogging             bool
@@ -143,6 +192,7 @@ type SettingsResp struct {
 type HelloResp struct {
 	CommandBase
 	Version           string
+	ProtocolVersion   int
 	ProcessorArch     string
 	Session           SessionResp
 	Account           preferences.AccountStatus
@@ -156,6 +206,10 @@ type HelloResp struct {
 	ParanoidMode ParanoidModeStatus
 
 	DaemonSettings SettingsResp
+
+	// SupportedRequests - list of request types which are supported by the daemon.
+	// Clients can use it to check if the daemon supports a specific command before sending it.
+	SupportedRequests []string
 }
 
 // SessionResp information about session
@@ -187,6 +241,34 @@ type SessionNewResp struct {
 	Session         SessionResp
 	Account         preferences.AccountStatus
 	RawResponse     string
+
+	// Login requires additional confirmation: the SessionNew request has to be repeated with the required fields
+	Is2FARequired     bool              // 2FA (TOTP) token required (or the specified token is not valid)
+	IsCaptchaRequired bool              // captcha required (or the specified captcha solution is not valid)
+	Captcha           types.CaptchaInfo // the captcha to be solved (when IsCaptchaRequired)
+}
+
+// AccountInfo - the logged-in account (part of AccountsResp)
+type AccountInfo struct {
+	AccountID string
+	IsActive  bool
+	// Last known account status
+	Account preferences.AccountStatus
+}
+
+// AccountsResp - the list of logged-in accounts (the active account first)
+type AccountsResp struct {
+	CommandBase
+	Accounts []AccountInfo
+}
+
+// DevicesResp - devices (active sessions) of the account (or API error info)
+// Response for 'DevicesList' and 'DeviceLogout' requests (for 'DeviceLogout' the Devices field is empty)
+type DevicesResp struct {
+	CommandBase
+	APIStatus       int // 0 - no API error
+	APIErrorMessage string
+	Devices         []types.DeviceInfo
 }
 
 // AccountStatusResp - information about account status (or error info)
@@ -206,7 +288,10 @@ type KillSwitchStatusResp struct {
 	IsAllowLAN        bool
 	IsAllowMulticast  bool
 	IsAllowApiServers bool
-	UserExceptions    string // Firewall exceptions: comma separated list of IP addresses (masks) in format: x.x.x.x[/xx]
+	UserExceptions    string               // Firewall exceptions: comma separated list of IP addresses (masks) in format: x.x.x.x[/xx]
+	AllowLANServices  lansvc.Services      // LAN services allowed individually (when LAN communication is blocked)
+	AllowedLANHosts   string               // LAN hosts allowed when LAN communication is blocked: comma separated list of IP addresses
+	LANInboundPorts   []lansvc.InboundPort // local ports which accept incoming connections from LAN (when LAN communication is blocked)
 }
 
 // KillSwitchGetIsPestistentResp returns kill-switch persistance status
@@ -215,14 +300,20 @@ type KillSwitchGetIsPestistentResp struct {
 	IsPersistent bool
 }
 
-// DiagnosticsGeneratedResp returns info from daemon logs
-type DiagnosticsGeneratedResp struct {
-	CommandBase
+// DiagnosticsInfo - info from daemon logs
+// (is a part of 'DiagnosticsGeneratedResp'; it is also the result of 'Diagnostics' operation)
+type DiagnosticsInfo struct {
 	Log0_Old    string // previous daemon session log
 	Log1_Active string // active daemon log
 	ExtraInfo   string // Extra info for logging (e.g. ifconfig, netstat -nr ... etc.)
 }
 
+// DiagnosticsGeneratedResp returns info from daemon logs
+type DiagnosticsGeneratedResp struct {
+	CommandBase
+	DiagnosticsInfo
+}
+
 // SetAlternateDNSResp returns status of changing DNS
 type SetAlternateDNSResp struct {
 	CommandBase
@@ -250,7 +341,13 @@ type ConnectedResp struct {
 	ManualDNS       dns.DnsSettings
 	IsCanPause      bool
 	IsTCP           bool
-	Mtu             int // (for WireGuard connections)
+	Mtu             int                    // (for WireGuard connections)
+	V2RayProxy      v2r.V2RayTransportType // V2Ray transport in use
+	IsShadowsocks   bool                   // connection is chained through Shadowsocks server
+	IsCustomConfig  bool                   // connection is established using the user-defined configuration
+	// the average round-trip time to the VPN server from the latest connection quality report (0 - unknown).
+	// The connection quality reports are sent periodically (ConnectionQualityResp)
+	LatencyMs float64
 }
 
 // DisconnectionReason - disconnection reason
@@ -271,6 +368,177 @@ type DisconnectedResp struct {
 	ReasonDescription string
 }
 
+// ConnectionHistoryResp contains recently used connection parameters (the most recent first)
+type ConnectionHistoryResp struct {
+	CommandBase
+	IsDisabled bool
+	Items      []preferences.ConnectionHistoryItem
+}
+
+// ConnectionStatsHistoryResp contains the statistics of the recent connection sessions and the daily aggregates (the most recent first)
+type ConnectionStatsHistoryResp struct {
+	CommandBase
+	IsDisabled bool
+	Sessions   []connstats.SessionRecord
+	Days       []connstats.DayRecord
+}
+
+// ThroughputHistoryResp contains the recent traffic rates of the active connection (the oldest first).
+// The samples are taken every 'IntervalSec' seconds; the history is empty when disconnected.
+type ThroughputHistoryResp struct {
+	CommandBase
+	IntervalSec int
+	Samples     []connstats.ThroughputSample
+}
+
+// AuditLogResp contains records from the audit log (the most recent last)
+type AuditLogResp struct {
+	CommandBase
+	Events []auditlog.Event
+}
+
+// SubsystemStatusResp contains the initialization status of the daemon subsystems (in initialization order)
+type SubsystemStatusResp struct {
+	CommandBase
+	Subsystems []subsystems.Status
+}
+
+// PortForwardingStatusResp - the state of the forwarded port
+// (response to PortForwardingGetStatus/PortForwardingRequest requests; notification when the state changed)
+type PortForwardingStatusResp struct {
+	CommandBase
+	State portforwarding.State
+}
+
+// DataUsageAlertResp - notification: the traffic of the VPN connection exceeded the threshold defined in preferences (see SettingsResp.DataUsageAlert)
+type DataUsageAlertResp struct {
+	CommandBase
+	Alert connstats.DataUsageAlert
+}
+
+// ConnectionQualityResp - notification: the quality report of the active connection (sent periodically while connected)
+type ConnectionQualityResp struct {
+	CommandBase
+	Report connstats.QualityReport
+}
+
+// ConnectionQualityHistoryResp contains the recent quality reports of the active connection (the oldest first; empty when disconnected)
+type ConnectionQualityHistoryResp struct {
+	CommandBase
+	Reports []connstats.QualityReport
+}
+
+// CaptivePortalStatusResp - the captive portal status
+// (response to CaptivePortalCheck/CaptivePortalAllowLogin/CaptivePortalReLock requests; notification when a captive portal detected or the status changed)
+type CaptivePortalStatusResp struct {
+	CommandBase
+	Status captiveportal.Status
+}
+
+// ReloginRequiredResp - notification: the session is not valid anymore and it was not renewed silently.
+// The daemon is logged out; the user has to log in again.
+// APIStatus/APIErrorMessage - the error of the session renewal attempt (0 - the renewal was not attempted)
+type ReloginRequiredResp struct {
+	CommandBase
+	APIStatus       int
+	APIErrorMessage string
+}
+
+// ClockSkewResp - notification: large difference between the local time and the API server time detected.
+// The wrong local time can break the API requests (TLS certificate validation) and WireGuard handshakes.
+type ClockSkewResp struct {
+	CommandBase
+	// API server time minus local time (seconds)
+	OffsetSec int64
+	// true - the API server time is in use for TLS certificate validation (see Prefs_IsApiTimeHintAllowed)
+	IsTimeHintAllowed bool
+}
+
+// ShellCommandsResp contains the history of the external commands executed by the daemon (the most recent last)
+type ShellCommandsResp struct {
+	CommandBase
+	Commands []shell.CommandRecord
+}
+
+// CrashReportsResp contains the saved crash reports (the most recent last)
+type CrashReportsResp struct {
+	CommandBase
+	Reports []crashreport.Report
+}
+
+// CrashRecoveredResp - notification: the daemon recovered from a crash (panic) in one of its routines.
+// The crash report is saved and can be requested by CrashReportsGet.
+type CrashRecoveredResp struct {
+	CommandBase
+	Report crashreport.Report
+}
+
+// OperationStatusResp - status of the long-running operation.
+// It is the response on OperationStart/OperationCancel requests.
+// Also, it is sent to all clients on each change of the operation status (progress, finish, error, cancellation).
+type OperationStatusResp struct {
+	CommandBase
+	Operation operations.Status
+}
+
+// OperationsListResp - list of running operations
+type OperationsListResp struct {
+	CommandBase
+	Operations []operations.Status
+}
+
+// ConnectionProfilesResp contains the list of saved connection profiles
+type ConnectionProfilesResp struct {
+	CommandBase
+	Profiles []preferences.ConnectionProfile
+}
+
+// RestApiResp contains the configuration of the local REST API (including the access token)
+type RestApiResp struct {
+	CommandBase
+	Params preferences.RestApiParams
+}
+
+// GrpcApiResp contains the configuration of the local gRPC API (including the access token)
+type GrpcApiResp struct {
+	CommandBase
+	Params preferences.GrpcApiParams
+}
+
+// LogLevelsResp contains the current log levels of the daemon
+type LogLevelsResp struct {
+	CommandBase
+	DefaultLevel string
+	// module-specific levels (module name -> level)
+	Modules map[string]string
+	// names of all the logger modules
+	KnownModules []string
+}
+
+// ClientTokensResp contains the list of the client access tokens
+type ClientTokensResp struct {
+	CommandBase
+	Tokens []preferences.ClientToken
+}
+
+// SettingsExportResp contains the exported daemon configuration
+type SettingsExportResp struct {
+	CommandBase
+	Settings preferences.ExportedSettings
+}
+
+// SettingsImportResp - result of the settings import (the descriptions of the settings which were skipped)
+type SettingsImportResp struct {
+	CommandBase
+	Warnings []string
+}
+
+// HostsHealthResp - health information for the VPN hosts which had connection failures
+type HostsHealthResp struct {
+	CommandBase
+	Hosts []hostshealth.HostScore
+}
+
 // VpnStateResp returns VPN connection state
 type VpnStateResp struct {
 	CommandBase
@@ -280,6 +548,23 @@ type VpnStateResp struct {
 	StateAdditionalInfo string
 }
 
+// ConnectionStatsResp - statistics of the active VPN connection (number of bytes received/sent through the VPN tunnel)
+type ConnectionStatsResp struct {
+	CommandBase
+	RxBytes uint64
+	TxBytes uint64
+}
+
+// LogMessageResp - the message written to the daemon log
+// (REST API events stream; log stream of the client started by LogStreamStart)
+type LogMessageResp struct {
+	CommandBase
+	Message string
+	Module  string
+	Level   string
+	Session string `json:",omitempty"` // ID of the connection session (empty - no active session)
+}
+
 // ServerListResp returns list of servers
 type ServerListResp struct {
 	CommandBase
@@ -313,6 +598,7 @@ type WiFiAvailableNetworksResp struct {
 type WiFiCurrentNetworkResp struct {
 	CommandBase
 	SSID              string
+	BSSID             string // MAC address of the access point (can be empty)
 	IsInsecureNetwork bool
 }
 
@@ -322,6 +608,9 @@ type APIResponse struct {
 	APIPath      string
 	ResponseData string
 	Error        string
+	// IsStale - the API is not reachable, ResponseData contains the last successful response (received at CachedAt)
+	IsStale  bool
+	CachedAt int64 // Unix time
 }
 
 func (r APIResponse) LogExtraInfo() string {
@@ -90,6 +90,45 @@ type SplitTunnelStatus struct {
 	// Information about active applications running in Split-Tunnel environment
 	// (applicable for Linux)
 	RunningApps []splittun.RunningApp
+	// true - if Split Tunneling by destinations is applicable for this platform
+	// (applicable for Linux and macOS)
+	IsCanSplitDestinations bool
+	// Destinations (IP addresses, networks, domain names) excluded from (or included into) the VPN tunnel
+	Destinations []splittun.Destination
+	// true - if Split Tunneling for containers is applicable for this platform
+	// (applicable for Linux with cgroup-v2)
+	IsCanSplitContainers bool
+	// Containers (Docker container names/IDs or cgroup-v2 paths) excluded from (or included into) the VPN tunnel
+	Containers []splittun.Container
+	// true - if Split Tunneling for users is applicable for this platform
+	// (applicable for Linux)
+	IsCanSplitUsers bool
+	// Users (user names or UIDs) which traffic is excluded from (or included into) the VPN tunnel
+	Users []splittun.User
+}
+
+// SplitTunnelSetDestinations (request) sets destinations (IP addresses, networks, domain names)
+// to be excluded from (or included into) the VPN tunnel. The previous destinations are replaced.
+// Expected response: SplitTunnelStatus (all clients are notified about configuration change)
+type SplitTunnelSetDestinations struct {
+	RequestBase
+	Destinations []splittun.Destination
+}
+
+// SplitTunnelSetContainers (request) sets containers (Docker container names/IDs or cgroup-v2 paths)
+// to be excluded from (or included into) the VPN tunnel. The previous containers are replaced.
+// Expected response: SplitTunnelStatus (all clients are notified about configuration change)
+type SplitTunnelSetContainers struct {
+	RequestBase
+	Containers []splittun.Container
+}
+
+// SplitTunnelSetUsers (request) sets users (user names or UIDs) which traffic
+// to be excluded from (or included into) the VPN tunnel. The previous users are replaced.
+// Expected response: SplitTunnelStatus (all clients are notified about configuration change)
+type SplitTunnelSetUsers struct {
+	RequestBase
+	Users []splittun.User
 }
 
 // SplitTunnelAddApp (request) add application to SplitTunneling
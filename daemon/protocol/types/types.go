@@ -30,6 +30,12 @@ import (
 	"strings"
 )
 
+// ProtocolVersion is the version of the client protocol implemented by this build.
+// It must be incremented on every change of the protocol which is not backward-compatible
+// (e.g. changed meaning of the existing fields).
+// Version 0 means that the peer was built before the protocol versioning was introduced.
+const ProtocolVersion = 1
+
 type ICommandBase interface {
 	LogExtraInfo() string
 }
@@ -60,6 +66,27 @@ const (
 	Prefs_IsEnableLogging              ServicePreference = "enable_logging"
 	Prefs_IsAutoconnectOnLaunch        ServicePreference = "autoconnect_on_launch"
 	Prefs_IsAutoconnectOnLaunch_Daemon ServicePreference = "autoconnect_on_launch_daemon"
+	Prefs_IsConnectionHistoryDisabled  ServicePreference = "connection_history_disabled"
+	Prefs_IsConnectionStatsDisabled    ServicePreference = "connection_stats_disabled"
+	Prefs_DataUsageAlertSessionMB      ServicePreference = "data_usage_alert_session_mb"
+	Prefs_DataUsageAlertDayMB          ServicePreference = "data_usage_alert_day_mb"
+	Prefs_LatencyProbeMode             ServicePreference = "latency_probe_mode"
+	Prefs_FwMssClamp                   ServicePreference = "fw_mss_clamp"
+	Prefs_IsWGKeyHwProtection          ServicePreference = "wg_key_hw_protection"
+	Prefs_IsWgFallbackToOpenVPN        ServicePreference = "wg_fallback_to_openvpn"
+	Prefs_IsApiTimeHintAllowed         ServicePreference = "api_time_hint"
+	Prefs_IsCaptivePortalCheck         ServicePreference = "captive_portal_check"
+	Prefs_IsLogJSONFormat              ServicePreference = "log_json_format"
+	Prefs_LogOutput                    ServicePreference = "log_output"
+	Prefs_IsLogPrivacyMode             ServicePreference = "log_privacy_mode"
+	Prefs_IsSessionAutoRenew           ServicePreference = "session_auto_renew"
+	Prefs_IsSettingsEncryption         ServicePreference = "settings_encryption"
+	Prefs_IsOpenVpnDcoDisabled         ServicePreference = "openvpn_dco_disabled"
+	Prefs_IsWgLanBypass                ServicePreference = "wg_lan_bypass"
+	Prefs_IsWgPeerFailover             ServicePreference = "wg_peer_failover"
+	Prefs_WgPortHoppingMinutes         ServicePreference = "wg_port_hopping_minutes"
+	Prefs_IsWgQuantumResistance        ServicePreference = "wg_quantum_resistance"
+	Prefs_WgKemAlgorithm               ServicePreference = "wg_kem_algorithm"
 )
 
 func (sp ServicePreference) Equals(key string) bool {
@@ -75,7 +102,7 @@ func Send(conn net.Conn, cmd interface{}, idx int) (retErr error) {
 		}
 	}()
 
-	bytesToSend, err := serialize(cmd, idx)
+	bytesToSend, err := Serialize(cmd, idx)
 	if err != nil {
 		return fmt.Errorf("unable to send command: %w", err)
 	}
@@ -131,7 +158,7 @@ func GetTypeName(cmd interface{}) string {
 }
 
 // Serialize initializing 'Command' field and serializing object
-func serialize(cmd interface{}, idx int) (ret []byte, err error) {
+func Serialize(cmd interface{}, idx int) (ret []byte, err error) {
 	if err := initCmdFields(cmd, idx); err != nil {
 		return nil, err
 	}
@@ -0,0 +1,174 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package captiveportal detects captive portals (e.g. hotel or airport Wi-Fi login pages)
+// which block the internet access until the user logs in.
+package captiveportal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/logger"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("cportl")
+}
+
+// ProbeURL - the URL which returns the known content when the internet access is not restricted
+const ProbeURL = "http://detectportal.firefox.com/success.txt"
+
+// expected content of the ProbeURL response
+const probeExpectedContent = "success"
+
+// max number of IP addresses of the probe host to try
+const maxProbeIPs = 4
+
+// Status - the captive portal status
+type Status struct {
+	IsDetected bool
+	// URL of the portal login page (the redirect location received in response to the probe; can be empty)
+	PortalURL string
+	// (Unix time) when the last check was performed (0 - not checked)
+	CheckedAt int64
+	// the last check failed (e.g. no connectivity): the captive portal status is unknown
+	Error string
+	// (Unix time) the firewall is temporarily disabled for the portal login until this time (0 - firewall is not relaxed)
+	LoginAllowedUntil int64
+}
+
+// AllowHostsFunc - function which is called before the probe with the IP addresses of the probe host
+// (e.g. to add them to the firewall exceptions). The returned function is called after the probe.
+type AllowHostsFunc func(IPs []net.IP) (revert func())
+
+// Check performs the captive portal check: requests the ProbeURL (plain HTTP) and checks the response.
+// Note: the returned status contains the check results only (the 'LoginAllowedUntil' field is not defined)
+func Check(timeout time.Duration, allowHosts AllowHostsFunc) (status Status) {
+	status.CheckedAt = time.Now().Unix()
+
+	isDetected, portalURL, err := check(timeout, allowHosts)
+	if err != nil {
+		log.Info("Check failed: ", err)
+		status.Error = err.Error()
+		return status
+	}
+
+	if isDetected {
+		log.Info("Captive portal detected (portal URL: '", portalURL, "')")
+	}
+	status.IsDetected, status.PortalURL = isDetected, portalURL
+	return status
+}
+
+func check(timeout time.Duration, allowHosts AllowHostsFunc) (isDetected bool, portalURL string, err error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	u, err := url.Parse(ProbeURL)
+	if err != nil {
+		return false, "", err
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return false, "", fmt.Errorf("failed to resolve '%s': %w", u.Hostname(), err)
+	}
+	ips := make([]net.IP, 0, maxProbeIPs)
+	for _, a := range addrs {
+		if len(ips) >= maxProbeIPs {
+			break
+		}
+		ips = append(ips, a.IP)
+	}
+	if len(ips) == 0 {
+		return false, "", fmt.Errorf("failed to resolve '%s'", u.Hostname())
+	}
+
+	if allowHosts != nil {
+		if revert := allowHosts(ips); revert != nil {
+			defer revert()
+		}
+	}
+
+	port := u.Port()
+	if len(port) == 0 {
+		port = "80"
+	}
+
+	// connecting only to the resolved IP addresses (they can be allowed by 'allowHosts')
+	dialer := &net.Dialer{}
+	transport := &http.Transport{
+		Proxy:             nil, // direct connection
+		DisableKeepAlives: true,
+		DialContext: func(ctx context.Context, network, _ string) (conn net.Conn, err error) {
+			for _, ip := range ips {
+				if conn, err = dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port)); err == nil {
+					return conn, nil
+				}
+			}
+			return nil, err
+		},
+	}
+	defer transport.CloseIdleConnections()
+
+	client := &http.Client{
+		Transport: transport,
+		// the portal usually redirects to the login page: do not follow redirects
+		CheckRedirect: func(req *http.Request, via []*http.Request) error { return http.ErrUseLastResponse },
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ProbeURL, nil)
+	if err != nil {
+		return false, "", err
+	}
+	req.Header.Set("Cache-Control", "no-cache")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return false, "", fmt.Errorf("probe request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 && resp.StatusCode < 400 {
+		return true, resp.Header.Get("Location"), nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	if err != nil {
+		return false, "", fmt.Errorf("failed to read probe response: %w", err)
+	}
+	if resp.StatusCode == http.StatusOK && strings.TrimSpace(string(body)) == probeExpectedContent {
+		return false, "", nil
+	}
+
+	// unexpected content (e.g. the login page instead of the expected response or HTTP 511 Network Authentication Required)
+	return true, "", nil
+}
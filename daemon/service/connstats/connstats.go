@@ -0,0 +1,243 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package connstats keeps the history of VPN connection statistics:
+// the records of the recent connection sessions and the daily aggregates (bytes received/sent, connection duration).
+// The data is kept in a local file.
+package connstats
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/helpers"
+	"github.com/ivpn/desktop-app/daemon/logger"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("cstats")
+}
+
+const (
+	// SessionsMaxItems - max number of the connection session records
+	SessionsMaxItems = 200
+	// DaysMaxItems - max number of the daily aggregates
+	DaysMaxItems = 366
+
+	dayFormat = "2006-01-02"
+)
+
+// SessionInfo - information about the connection session
+type SessionInfo struct {
+	Server     string // hostname of the (entry) server
+	ExitServer string `json:",omitempty"` // hostname of the Multi-Hop exit server
+	VpnType    string // "WireGuard", "OpenVPN"
+	Protocol   string `json:",omitempty"` // e.g. "UDP", "TCP"
+}
+
+// SessionRecord - statistics of the connection session
+type SessionRecord struct {
+	SessionInfo
+	Started  time.Time
+	Duration time.Duration
+	RxBytes  uint64
+	TxBytes  uint64
+	// 'true' when the session is active (not finished yet)
+	IsActive bool `json:",omitempty"`
+}
+
+// DayRecord - aggregated statistics of all connection sessions for a day (local time)
+type DayRecord struct {
+	Date     string // format: "2006-01-02"
+	Sessions int
+	Duration time.Duration
+	RxBytes  uint64
+	TxBytes  uint64
+}
+
+type storeData struct {
+	Sessions []SessionRecord // the most recent last
+	Days     []DayRecord     // the most recent last
+}
+
+// Store - the connection statistics history
+type Store struct {
+	mutex    sync.Mutex
+	filePath string
+	data     storeData
+	isActive bool // the last session record is active
+}
+
+// CreateStore creates the connection statistics store (the history is loaded from the file, if exists)
+func CreateStore(filePath string) *Store {
+	s := &Store{filePath: filePath}
+
+	if fileData, err := os.ReadFile(filePath); err == nil {
+		if err := json.Unmarshal(fileData, &s.data); err != nil {
+			log.Error(fmt.Sprintf("Failed to parse connection statistics file (the history will be erased): %s", err))
+			s.data = storeData{}
+		}
+	}
+
+	// the daemon was stopped unexpectedly during the connection: mark the session as finished
+	if cnt := len(s.data.Sessions); cnt > 0 {
+		s.data.Sessions[cnt-1].IsActive = false
+	}
+	return s
+}
+
+// Start registers a new connection session
+func (s *Store) Start(info SessionInfo) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.finish()
+
+	now := time.Now()
+	s.data.Sessions = append(s.data.Sessions, SessionRecord{SessionInfo: info, Started: now, IsActive: true})
+	if len(s.data.Sessions) > SessionsMaxItems {
+		s.data.Sessions = s.data.Sessions[len(s.data.Sessions)-SessionsMaxItems:]
+	}
+	s.isActive = true
+
+	s.day(now).Sessions++
+}
+
+// Update adds the statistics to the active connection session:
+// 'elapsed' - the connection time since the previous update; 'rx', 'tx' - the number of bytes received/sent since the previous update.
+func (s *Store) Update(elapsed time.Duration, rx, tx uint64) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.isActive {
+		return
+	}
+
+	session := &s.data.Sessions[len(s.data.Sessions)-1]
+	session.Duration += elapsed
+	session.RxBytes += rx
+	session.TxBytes += tx
+
+	// the statistics is counted for the current day (the session can last for several days)
+	day := s.day(time.Now())
+	day.Duration += elapsed
+	day.RxBytes += rx
+	day.TxBytes += tx
+}
+
+// Finish marks the active connection session as finished and saves the history to the file
+func (s *Store) Finish() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.finish()
+	return s.save()
+}
+
+// Save saves the history to the file
+func (s *Store) Save() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.save()
+}
+
+// Clear erases the history
+func (s *Store) Clear() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.data = storeData{}
+	s.isActive = false
+	if err := os.Remove(s.filePath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Sessions returns the records of the recent connection sessions (the most recent first; limit <= 0 - all records)
+func (s *Store) Sessions(limit int) []SessionRecord {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	items := s.data.Sessions
+	if limit > 0 && len(items) > limit {
+		items = items[len(items)-limit:]
+	}
+	ret := make([]SessionRecord, 0, len(items))
+	for i := len(items) - 1; i >= 0; i-- {
+		ret = append(ret, items[i])
+	}
+	return ret
+}
+
+// Days returns the daily aggregates (the most recent first; limit <= 0 - all records)
+func (s *Store) Days(limit int) []DayRecord {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	items := s.data.Days
+	if limit > 0 && len(items) > limit {
+		items = items[len(items)-limit:]
+	}
+	ret := make([]DayRecord, 0, len(items))
+	for i := len(items) - 1; i >= 0; i-- {
+		ret = append(ret, items[i])
+	}
+	return ret
+}
+
+func (s *Store) finish() {
+	if s.isActive {
+		s.data.Sessions[len(s.data.Sessions)-1].IsActive = false
+		s.isActive = false
+	}
+}
+
+// day returns the daily aggregate for the specified time (the record is created if not exists)
+func (s *Store) day(t time.Time) *DayRecord {
+	date := t.Format(dayFormat)
+	if cnt := len(s.data.Days); cnt > 0 && s.data.Days[cnt-1].Date == date {
+		return &s.data.Days[cnt-1]
+	}
+
+	s.data.Days = append(s.data.Days, DayRecord{Date: date})
+	if len(s.data.Days) > DaysMaxItems {
+		s.data.Days = s.data.Days[len(s.data.Days)-DaysMaxItems:]
+	}
+	return &s.data.Days[len(s.data.Days)-1]
+}
+
+func (s *Store) save() error {
+	if len(s.filePath) == 0 {
+		return nil
+	}
+	data, err := json.Marshal(s.data)
+	if err != nil {
+		return err
+	}
+	return helpers.WriteFile(s.filePath, data, 0600) // read\write only for privileged user
+}
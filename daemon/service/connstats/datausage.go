@@ -0,0 +1,38 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package connstats
+
+// DataUsagePeriod - the period for which the traffic is counted
+type DataUsagePeriod string
+
+const (
+	DataUsageSession DataUsagePeriod = "session" // the current connection session
+	DataUsageDay     DataUsagePeriod = "day"     // all the connection sessions during the current day
+)
+
+// DataUsageAlert - the traffic of the VPN connection exceeded the user-defined threshold
+type DataUsageAlert struct {
+	Period         DataUsagePeriod
+	ThresholdBytes uint64
+	UsedBytes      uint64 // bytes received + sent during the period
+}
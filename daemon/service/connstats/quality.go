@@ -0,0 +1,179 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package connstats
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// QualityHistoryMaxItems - max number of the connection quality reports in the history
+const QualityHistoryMaxItems = 90
+
+// LatencyProbeMode - how the round-trip time to the connected VPN server is measured
+type LatencyProbeMode string
+
+const (
+	// LatencyProbeTunnel - the probes are sent through the tunnel to the internal address of the VPN server (default)
+	LatencyProbeTunnel LatencyProbeMode = "tunnel"
+	// LatencyProbeDirect - the probes are sent outside the tunnel to the public address of the VPN server
+	// (the path to the server via the ISP network)
+	LatencyProbeDirect LatencyProbeMode = "direct"
+)
+
+// Normalized returns the mode with the default value applied
+func (m LatencyProbeMode) Normalized() LatencyProbeMode {
+	if m == "" {
+		return LatencyProbeTunnel
+	}
+	return m
+}
+
+// Validate checks the mode value
+func (m LatencyProbeMode) Validate() error {
+	switch m.Normalized() {
+	case LatencyProbeTunnel, LatencyProbeDirect:
+		return nil
+	}
+	return fmt.Errorf("unknown latency probe mode '%s' (expected: '%s' or '%s')", m, LatencyProbeTunnel, LatencyProbeDirect)
+}
+
+// QualityReport - the quality of the active connection measured by the probes (ICMP echo) during the report interval
+type QualityReport struct {
+	Time        int64            // Unix time (seconds) when the report was created
+	Target      string           // the probed host
+	ProbeMode   LatencyProbeMode // the probes were sent through the tunnel or outside the tunnel
+	ProbesSent  int
+	ProbesLost  int
+	LossPercent float64
+	// round-trip time of the received probes (milliseconds); 0 - no probes received
+	RttAvgMs float64
+	RttMinMs float64
+	RttMaxMs float64
+	// mean difference between the round-trip times of the consecutive probes (milliseconds)
+	JitterMs float64
+}
+
+// QualityHistory - collects the results of the in-tunnel probes and keeps the recent quality reports
+type QualityHistory struct {
+	mutex   sync.Mutex
+	probes  []time.Duration // round-trip times of the probes since the last report (< 0 - the probe is lost)
+	reports []QualityReport // the most recent last
+}
+
+// CreateQualityHistory creates an empty connection quality history
+func CreateQualityHistory() *QualityHistory {
+	return &QualityHistory{}
+}
+
+// AddProbe registers the result of the probe ('isLost' - no reply received)
+func (h *QualityHistory) AddProbe(rtt time.Duration, isLost bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if isLost {
+		rtt = -1
+	}
+	h.probes = append(h.probes, rtt)
+}
+
+// Report creates the quality report from the probes registered since the previous report and adds it to the history.
+// 'ok' is false when there were no probes.
+func (h *QualityHistory) Report(t time.Time, target string, mode LatencyProbeMode) (r QualityReport, ok bool) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if len(h.probes) == 0 {
+		return r, false
+	}
+
+	r = QualityReport{Time: t.Unix(), Target: target, ProbeMode: mode, ProbesSent: len(h.probes)}
+	var sum, jitterSum float64
+	var received, jitterCnt int
+	prev := -1.0
+	for _, p := range h.probes {
+		if p < 0 {
+			r.ProbesLost++
+			continue
+		}
+		ms := float64(p) / float64(time.Millisecond)
+		if received == 0 || ms < r.RttMinMs {
+			r.RttMinMs = ms
+		}
+		if ms > r.RttMaxMs {
+			r.RttMaxMs = ms
+		}
+		sum += ms
+		received++
+		if prev >= 0 {
+			jitterSum += math.Abs(ms - prev)
+			jitterCnt++
+		}
+		prev = ms
+	}
+	r.LossPercent = float64(r.ProbesLost) * 100 / float64(r.ProbesSent)
+	if received > 0 {
+		r.RttAvgMs = sum / float64(received)
+	}
+	if jitterCnt > 0 {
+		r.JitterMs = jitterSum / float64(jitterCnt)
+	}
+
+	h.probes = h.probes[:0]
+	h.reports = append(h.reports, r)
+	if len(h.reports) > QualityHistoryMaxItems {
+		h.reports = h.reports[len(h.reports)-QualityHistoryMaxItems:]
+	}
+	return r, true
+}
+
+// DiscardProbes erases the probes registered since the previous report (e.g. when the probe target changed)
+func (h *QualityHistory) DiscardProbes() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.probes = h.probes[:0]
+}
+
+// Reset erases the probes and the reports
+func (h *QualityHistory) Reset() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.probes = nil
+	h.reports = nil
+}
+
+// Reports returns the most recent quality reports (the oldest first; limit <= 0 - all reports)
+func (h *QualityHistory) Reports(limit int) []QualityReport {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	items := h.reports
+	if limit > 0 && len(items) > limit {
+		items = items[len(items)-limit:]
+	}
+	return append([]QualityReport{}, items...)
+}
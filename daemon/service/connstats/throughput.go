@@ -0,0 +1,102 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package connstats
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// ThroughputSampleInterval - how often the traffic rates of the active connection are sampled
+	ThroughputSampleInterval = time.Second
+	// ThroughputHistoryMaxItems - max number of the samples in the throughput history
+	ThroughputHistoryMaxItems = 600
+)
+
+// ThroughputSample - the average traffic rate over a sampling interval
+type ThroughputSample struct {
+	Time  int64  // Unix time (seconds) when the sample was taken
+	RxBps uint64 // bytes per second received
+	TxBps uint64 // bytes per second sent
+}
+
+// ThroughputHistory - rolling in-memory series of the traffic rates of the active connection
+type ThroughputHistory struct {
+	mutex   sync.Mutex
+	samples []ThroughputSample // ring buffer
+	next    int                // index of the next sample to write
+	count   int                // number of the valid samples
+}
+
+// CreateThroughputHistory creates an empty throughput history
+func CreateThroughputHistory() *ThroughputHistory {
+	return &ThroughputHistory{samples: make([]ThroughputSample, ThroughputHistoryMaxItems)}
+}
+
+// Add adds a sample calculated from the number of bytes received/sent during 'elapsed' time.
+// The oldest sample is overwritten when the history is full.
+func (h *ThroughputHistory) Add(t time.Time, elapsed time.Duration, rx, tx uint64) {
+	if elapsed <= 0 {
+		return
+	}
+	sec := elapsed.Seconds()
+
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.samples[h.next] = ThroughputSample{
+		Time:  t.Unix(),
+		RxBps: uint64(float64(rx) / sec),
+		TxBps: uint64(float64(tx) / sec),
+	}
+	h.next = (h.next + 1) % len(h.samples)
+	if h.count < len(h.samples) {
+		h.count++
+	}
+}
+
+// Reset erases all samples
+func (h *ThroughputHistory) Reset() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	h.next = 0
+	h.count = 0
+}
+
+// Samples returns the most recent samples (the oldest first; limit <= 0 - all samples)
+func (h *ThroughputHistory) Samples(limit int) []ThroughputSample {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	cnt := h.count
+	if limit > 0 && limit < cnt {
+		cnt = limit
+	}
+	ret := make([]ThroughputSample, 0, cnt)
+	for i := cnt; i > 0; i-- {
+		ret = append(ret, h.samples[(h.next-i+len(h.samples))%len(h.samples)])
+	}
+	return ret
+}
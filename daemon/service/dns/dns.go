@@ -28,6 +28,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/ivpn/desktop-app/daemon/crashreport"
 	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/service/dns/dnscryptproxy"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
@@ -94,6 +95,9 @@ type DnsSettings struct {
 	DnsHost     string // DNS host IP address
 	Encryption  DnsEncryption
 	DohTemplate string // DoH/DoT template URI (for Encryption = DnsOverHttps or Encryption = DnsOverTls)
+	// DNS suffixes (search list) for the VPN interface; the first one is used as the connection-specific DNS suffix
+	// (e.g. corporate domains resolvable by internal DNS servers; currently, applicable only for Windows)
+	SearchDomains []string
 }
 
 // create  DnsSettings object with no encryption
@@ -107,7 +111,8 @@ func DnsSettingsCreate(ip net.IP) DnsSettings {
 func (d DnsSettings) Equal(x DnsSettings) bool {
 	if d.Encryption != x.Encryption ||
 		d.DohTemplate != x.DohTemplate ||
-		d.DnsHost != x.DnsHost {
+		d.DnsHost != x.DnsHost ||
+		strings.Join(d.SearchDomains, ",") != strings.Join(x.SearchDomains, ",") {
 		return false
 	}
 	return true
@@ -143,16 +148,37 @@ func (d DnsSettings) InfoString() string {
 	host := strings.TrimSpace(d.DnsHost)
 	template := strings.TrimSpace(d.DohTemplate)
 
+	ret := host
 	switch d.Encryption {
 	case EncryptionDnsOverTls:
-		return host + " (DoT " + template + ")"
+		ret = host + " (DoT " + template + ")"
 	case EncryptionDnsOverHttps:
-		return host + " (DoH " + template + ")"
+		ret = host + " (DoH " + template + ")"
 	case EncryptionNone:
-		return host
 	default:
-		return host + " (UNKNOWN ENCRYPTION)"
+		ret = host + " (UNKNOWN ENCRYPTION)"
 	}
+
+	if len(d.SearchDomains) > 0 {
+		ret += " (search: " + strings.Join(d.SearchDomains, ", ") + ")"
+	}
+	return ret
+}
+
+// ParseSearchDomains converts the comma-separated list of domains into the list suitable for DnsSettings.SearchDomains
+func ParseSearchDomains(list string) ([]string, error) {
+	var ret []string
+	for _, d := range strings.Split(list, ",") {
+		d = strings.Trim(strings.TrimSpace(d), ".")
+		if len(d) == 0 {
+			continue
+		}
+		if strings.ContainsAny(d, " \t;/\\") || strings.Contains(d, "..") {
+			return nil, fmt.Errorf("bad DNS search domain '%s'", d)
+		}
+		ret = append(ret, strings.ToLower(d))
+	}
+	return ret, nil
 }
 
 // Initialize is doing initialization stuff
@@ -261,11 +287,8 @@ func UpdateDnsIfWrongSettings() error {
 func dnscryptProxyProcessStart(dnsCfg DnsSettings) (retErr error) {
 	defer func() {
 		if r := recover(); r != nil {
-			log.Error("PANIC (recovered): ", r)
+			crashreport.Save("dnscryptProxyProcessStart", r)
 			retErr = fmt.Errorf("%v", r)
-			if err, ok := r.(error); ok {
-				log.ErrorTrace(err)
-			}
 		}
 
 		if retErr != nil {
@@ -0,0 +1,104 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package dns
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+	"golang.org/x/sys/windows/registry"
+)
+
+const tcpipInterfacesRegKey = `SYSTEM\CurrentControlSet\Services\Tcpip\Parameters\Interfaces\`
+
+// setInterfaceSearchDomains - set DNS suffixes for the network interface (defined by its local IP address)
+// The first domain is used as the connection-specific DNS suffix; all domains are in the search list of the interface.
+// Empty list - remove the DNS suffixes from the interface.
+func setInterfaceSearchDomains(interfaceLocalAddr net.IP, domains []string) error {
+	guid, err := getInterfaceGuidByLocalIP(interfaceLocalAddr)
+	if err != nil {
+		return err
+	}
+
+	k, err := registry.OpenKey(registry.LOCAL_MACHINE, tcpipInterfacesRegKey+guid.String(), registry.SET_VALUE)
+	if err != nil {
+		return fmt.Errorf("failed to open interface configuration: %w", err)
+	}
+	defer k.Close()
+
+	if len(domains) == 0 {
+		for _, name := range []string{"Domain", "SearchList"} {
+			if err := k.DeleteValue(name); err != nil && !errors.Is(err, registry.ErrNotExist) {
+				return fmt.Errorf("failed to remove DNS suffix configuration: %w", err)
+			}
+		}
+		return nil
+	}
+
+	log.Info(fmt.Sprintf("Setting DNS search domains for interface %s: %s", interfaceLocalAddr, strings.Join(domains, ",")))
+	if err := k.SetStringValue("Domain", domains[0]); err != nil {
+		return fmt.Errorf("failed to set connection-specific DNS suffix: %w", err)
+	}
+	if err := k.SetStringValue("SearchList", strings.Join(domains, ",")); err != nil {
+		return fmt.Errorf("failed to set DNS search list: %w", err)
+	}
+	return nil
+}
+
+// getInterfaceGuidByLocalIP returns the GUID of the network interface which has the local IP address
+func getInterfaceGuidByLocalIP(localAddr net.IP) (windows.GUID, error) {
+	if localAddr == nil {
+		return windows.GUID{}, fmt.Errorf("interface local address not defined")
+	}
+
+	// the required buffer size is unknown: increase it until the data fits
+	size := uint32(15 * 1024)
+	var buf []byte
+	for {
+		buf = make([]byte, size)
+		err := windows.GetAdaptersAddresses(windows.AF_UNSPEC, 0, 0, (*windows.IpAdapterAddresses)(unsafe.Pointer(&buf[0])), &size)
+		if err == nil {
+			break
+		}
+		if err != windows.ERROR_BUFFER_OVERFLOW || size <= uint32(len(buf)) {
+			return windows.GUID{}, fmt.Errorf("failed to get network adapters info: %w", err)
+		}
+	}
+
+	for aa := (*windows.IpAdapterAddresses)(unsafe.Pointer(&buf[0])); aa != nil; aa = aa.Next {
+		for ua := aa.FirstUnicastAddress; ua != nil; ua = ua.Next {
+			if ua.Address.IP().Equal(localAddr) {
+				guid, err := windows.GUIDFromString(windows.BytePtrToString(aa.AdapterName))
+				if err != nil {
+					return windows.GUID{}, fmt.Errorf("failed to parse network interface GUID: %w", err)
+				}
+				return guid, nil
+			}
+		}
+	}
+	return windows.GUID{}, fmt.Errorf("network interface with address %s not found", localAddr)
+}
@@ -27,19 +27,18 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
 	"unsafe"
 
+	"github.com/ivpn/desktop-app/daemon/crashreport"
 	"github.com/ivpn/desktop-app/daemon/netinfo"
+	"github.com/ivpn/desktop-app/daemon/oshelpers/windows/iphlpapi"
 	"github.com/ivpn/desktop-app/daemon/service/dns/dnscryptproxy"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
-)
-
-var (
-	_fSetDNSByLocalIP      *syscall.LazyProc // DWORD _cdecl SetDNSByLocalIP(const char* interfaceLocalAddr, const char* dnsIP, byte operation, byte isDoH, const char* dohTemplateUrl, byte isIpv6)
-	_fIsCanUseDnsOverHttps *syscall.LazyProc // DWORD _cdecl IsCanUseDnsOverHttps()
+	"golang.org/x/sys/windows"
 )
 
 var dnsMutex sync.Mutex
@@ -54,8 +53,25 @@ const (
 	OperationDel Operation = 2
 )
 
+// DoH support implemented since Windows 11 (the DNS interface settings API is available since Windows 10)
+var (
+	_isNativeDohSupported     bool
+	_isNativeDohSupportedOnce sync.Once
+)
+
+// Native helpers DLL: in use only when the DNS interface settings API is not available
+// (Windows 8 and Windows 10 releases before 2004; the DLL uses WMI to change the DNS configuration)
+var (
+	_fSetDNSByLocalIP *syscall.LazyProc // DWORD _cdecl SetDNSByLocalIP(const char* interfaceLocalAddr, const char* dnsIP, byte operation, byte isDoH, const char* dohTemplateUrl, byte isIpv6)
+)
+
 // implInitialize doing initialization stuff (called on application start)
 func implInitialize() error {
+	if iphlpapi.IsInterfaceDnsSettingsAPIAvailable() {
+		return nil
+	}
+
+	log.Info("DNS interface settings API is not available. Using native helpers to change DNS configuration")
 	helpersDllPath := platform.WindowsNativeHelpersDllPath()
 	if len(helpersDllPath) == 0 {
 		return fmt.Errorf("unable to initialize DNS wrapper: helpers dll path not initialized")
@@ -65,8 +81,7 @@ func implInitialize() error {
 	}
 
 	dll := syscall.NewLazyDLL(helpersDllPath)
-	_fSetDNSByLocalIP = dll.NewProc("SetDNSByLocalIP")           // DWORD _cdecl SetDNSByLocalIP(const char* interfaceLocalAddr, const char* dnsIP, byte operation, byte isDoH, const char* dohTemplateUrl, byte isIpv6)
-	_fIsCanUseDnsOverHttps = dll.NewProc("IsCanUseDnsOverHttps") // DWORD _cdecl IsCanUseDnsOverHttps()
+	_fSetDNSByLocalIP = dll.NewProc("SetDNSByLocalIP") // DWORD _cdecl SetDNSByLocalIP(const char* interfaceLocalAddr, const char* dnsIP, byte operation, byte isDoH, const char* dohTemplateUrl, byte isIpv6)
 	return nil
 }
 
@@ -74,18 +89,31 @@ func implApplyUserSettings() error {
 	return nil // nothing to do here for current platfom
 }
 
+// dohServerProperty - DoH configuration of the DNS server (DNS_SERVER_PROPERTY with DNS_DOH_SERVER_SETTINGS)
+type dohServerProperty struct {
+	serverIndex uint32 // index of the corresponding DNS server in the NameServer list
+	template    string
+	flags       uint64
+}
+
+// fSetDNSByLocalIP - change DNS configuration of the interface (defined by its local IP address)
+// Operations:
+//   - OperationSet - set the DNS server as the only one for the interface
+//   - OperationAdd - add the DNS server to the first position (the rest of the interface DNS servers are kept)
+//   - OperationDel - remove the DNS server from the interface configuration
 func fSetDNSByLocalIP(interfaceLocalAddr net.IP, dnsCfg DnsSettings, ipv6 bool, op Operation) error {
-	isDoH := uint32(0)
+	isDoH := false
 	switch dnsCfg.Encryption {
 	case EncryptionDnsOverTls:
 		return fmt.Errorf("DnsOverTls settings not supported by Windows. Please, try to use DnsOverHttps")
 	case EncryptionDnsOverHttps:
-		isDoH = 1
-	default:
-		isDoH = 0
+		isDoH = true
 	}
 
-	dohTemplateUrl := dnsCfg.DohTemplate
+	isDohAPI := fIsCanUseNativeDnsOverHttps()
+	if isDoH && !isDohAPI {
+		return fmt.Errorf("DnsOverHttps settings not supported by current version of the OS")
+	}
 
 	dnsIpString := ""
 	if !dnsCfg.IsEmpty() {
@@ -96,43 +124,198 @@ func fSetDNSByLocalIP(interfaceLocalAddr net.IP, dnsCfg DnsSettings, ipv6 bool,
 		dnsIpString = dnsCfg.Ip().String()
 	}
 
-	isIpv6 := uint32(0)
-	if ipv6 {
-		isIpv6 = 1
+	if interfaceLocalAddr == nil {
+		return fmt.Errorf("unable to apply DNS configuration: interface local address not defined")
+	}
+	if len(dnsIpString) == 0 && (op == OperationAdd || op == OperationDel) {
+		return nil // nothing to add or remove
 	}
 
 	dnsMutex.Lock()
 	defer dnsMutex.Unlock()
 
+	if _fSetDNSByLocalIP != nil {
+		// the DNS interface settings API is not available: using native helpers
+		return fSetDNSByLocalIPNativeHelper(interfaceLocalAddr, dnsIpString, ipv6, op)
+	}
+
+	ifcGUID, err := getInterfaceGuidByLocalIP(interfaceLocalAddr)
+	if err != nil {
+		return err
+	}
+
+	var nameServers []string
+	var serverProps []dohServerProperty
+
+	if op == OperationAdd || op == OperationDel {
+		// We have to keep the rest user-defined settings. Therefore doing changes with current DNS settings
+		curNameServers, curServerProps, err := getInterfaceDnsServers(ifcGUID, isDohAPI)
+		if err != nil {
+			return err
+		}
+
+		// make new NameServer: all configured DNS servers except the current one
+		// (indexes of the servers are changing, so the DoH configuration must be updated according to the new indexes)
+		newIndexes := make(map[uint32]uint32)
+		for i, ns := range curNameServers {
+			if strings.EqualFold(ns, dnsIpString) {
+				continue
+			}
+			newIdx := uint32(len(nameServers))
+			if op == OperationAdd {
+				newIdx++ // the first position is reserved for the new DNS server
+			}
+			newIndexes[uint32(i)] = newIdx
+			nameServers = append(nameServers, ns)
+		}
+		for _, p := range curServerProps {
+			if idx, ok := newIndexes[p.serverIndex]; ok {
+				p.serverIndex = idx
+				serverProps = append(serverProps, p)
+			}
+		}
+	}
+
+	if op == OperationSet || op == OperationAdd {
+		// set new DNS on a first position
+		nameServers = append([]string{dnsIpString}, nameServers...)
+		if isDoH {
+			p := dohServerProperty{serverIndex: 0, template: dnsCfg.DohTemplate, flags: iphlpapi.DnsDohServerSettingsEnable}
+			if len(p.template) == 0 {
+				// load URI template from the system DNS-over-HTTPS list
+				p.flags = iphlpapi.DnsDohServerSettingsEnableAuto
+			}
+			serverProps = append([]dohServerProperty{p}, serverProps...)
+		}
+	}
+
+	return setInterfaceDnsServers(ifcGUID, nameServers, serverProps, isDohAPI, ipv6)
+}
+
+// fSetDNSByLocalIPNativeHelper - change DNS configuration of the interface using native helpers DLL (WMI)
+func fSetDNSByLocalIPNativeHelper(interfaceLocalAddr net.IP, dnsIpString string, ipv6 bool, op Operation) error {
+	isIpv6 := uint32(0)
+	if ipv6 {
+		isIpv6 = 1
+	}
+
 	retval, _, err := _fSetDNSByLocalIP.Call(
 		uintptr(unsafe.Pointer(syscall.StringBytePtr(interfaceLocalAddr.String()))),
 		uintptr(unsafe.Pointer(syscall.StringBytePtr(dnsIpString))),
 		uintptr(op),
-		uintptr(isDoH),
-		uintptr(unsafe.Pointer(syscall.StringBytePtr(dohTemplateUrl))),
+		uintptr(0), // DoH is not supported by the OS versions which do not have the DNS interface settings API
+		uintptr(unsafe.Pointer(syscall.StringBytePtr(""))),
 		uintptr(isIpv6))
 
-	return checkDefaultAPIResp(retval, err)
+	if err != syscall.Errno(0) {
+		return err
+	}
+	if retval != 0 {
+		return fmt.Errorf("DNS change error: 0x%X", retval)
+	}
+	return nil
 }
 
-func fIsCanUseNativeDnsOverHttps() bool {
-	retval, _, err := _fIsCanUseDnsOverHttps.Call()
-	if retval == 0 || err != syscall.Errno(0) {
-		return false
+// getInterfaceDnsServers returns the current DNS servers of the interface (and their DoH configuration)
+func getInterfaceDnsServers(ifcGUID windows.GUID, isDohAPI bool) (nameServers []string, serverProps []dohServerProperty, err error) {
+	cfg := iphlpapi.DnsInterfaceSettings3{Version: iphlpapi.DnsInterfaceSettingsVersion1}
+	if isDohAPI {
+		cfg.Version = iphlpapi.DnsInterfaceSettingsVersion3
+	}
+	if err := iphlpapi.APIGetInterfaceDnsSettings(ifcGUID, &cfg); err != nil {
+		return nil, nil, fmt.Errorf("failed to get interface DNS settings: %w", err)
 	}
-	return true
+	defer iphlpapi.APIFreeInterfaceDnsSettings(&cfg)
+
+	if cfg.NameServer != nil {
+		for _, ns := range strings.Split(windows.UTF16PtrToString(cfg.NameServer), ",") {
+			if ns = strings.TrimSpace(ns); len(ns) > 0 {
+				nameServers = append(nameServers, ns)
+			}
+		}
+	}
+
+	for _, p := range cfg.GetServerProperties() {
+		if p.Type != iphlpapi.DnsServerDohProperty || p.DohSettings == nil {
+			continue
+		}
+		prop := dohServerProperty{serverIndex: p.ServerIndex, flags: p.DohSettings.Flags}
+		if p.DohSettings.Template != nil {
+			prop.template = windows.UTF16PtrToString(p.DohSettings.Template)
+		}
+		serverProps = append(serverProps, prop)
+	}
+	return nameServers, serverProps, nil
 }
 
-func checkDefaultAPIResp(retval uintptr, err error) error {
-	if err != syscall.Errno(0) {
+// setInterfaceDnsServers applies the DNS servers (and their DoH configuration) to the interface
+func setInterfaceDnsServers(ifcGUID windows.GUID, nameServers []string, serverProps []dohServerProperty, isDohAPI bool, ipv6 bool) error {
+	nameServersPtr, err := windows.UTF16PtrFromString(strings.Join(nameServers, ","))
+	if err != nil {
 		return err
 	}
-	if retval != 0 {
-		return fmt.Errorf("DNS change error: 0x%X", retval)
+
+	cfg := iphlpapi.DnsInterfaceSettings3{
+		Version:    iphlpapi.DnsInterfaceSettingsVersion1,
+		Flags:      iphlpapi.DnsSettingNameServer,
+		NameServer: nameServersPtr,
+	}
+
+	if isDohAPI {
+		cfg.Version = iphlpapi.DnsInterfaceSettingsVersion3
+		cfg.Flags |= iphlpapi.DnsSettingDoh
+
+		// Only DNS-over-HTTPS properties are supported, with the additional restriction of at most 1 property for each server specified in the NameServer member
+		props := make([]iphlpapi.DnsServerProperty, 0, len(serverProps))
+		for _, p := range serverProps {
+			doh := &iphlpapi.DnsDohServerSettings{Flags: p.flags}
+			if len(p.template) > 0 {
+				if doh.Template, err = windows.UTF16PtrFromString(p.template); err != nil {
+					return err
+				}
+			}
+			props = append(props, iphlpapi.DnsServerProperty{
+				Version:     iphlpapi.DnsServerPropertyVersion1,
+				ServerIndex: p.serverIndex,
+				Type:        iphlpapi.DnsServerDohProperty,
+				DohSettings: doh,
+			})
+		}
+		if len(props) > 0 {
+			cfg.CServerProperties = uint32(len(props))
+			cfg.ServerProperties = &props[0]
+		}
+	}
+
+	if ipv6 {
+		cfg.Flags |= iphlpapi.DnsSettingIPv6
+	}
+
+	if err := iphlpapi.APISetInterfaceDnsSettings(ifcGUID, &cfg); err != nil {
+		return fmt.Errorf("failed to set interface DNS settings: %w", err)
 	}
 	return nil
 }
 
+func fIsCanUseNativeDnsOverHttps() bool {
+	_isNativeDohSupportedOnce.Do(func() {
+		if !iphlpapi.IsInterfaceDnsSettingsAPIAvailable() {
+			return
+		}
+		// Test if DoH functionality supported by current version of the OS:
+		// just trying to load DNS settings using type DNS_INTERFACE_SETTINGS_VERSION3 for the empty GUID.
+		// In case of success - function must return ERROR_FILE_NOT_FOUND (because of empty GUID)
+		// In case if DoH not supported - function will return ERROR_INVALID_PARAMETER
+		cfg := iphlpapi.DnsInterfaceSettings3{Version: iphlpapi.DnsInterfaceSettingsVersion3}
+		err := iphlpapi.APIGetInterfaceDnsSettings(windows.GUID{}, &cfg)
+		if err == nil { // normally, this should not happen
+			iphlpapi.APIFreeInterfaceDnsSettings(&cfg)
+		}
+		_isNativeDohSupported = !errors.Is(err, windows.ERROR_INVALID_PARAMETER)
+	})
+	return _isNativeDohSupported
+}
+
 // last custom-DNS info which was enabled
 var (
 	_lastDNS DnsSettings
@@ -140,7 +323,7 @@ var (
 
 func catchPanic(err *error) {
 	if r := recover(); r != nil {
-		log.Error("PANIC (recovered): ", r)
+		crashreport.Save("catchPanic", r)
 		if e, ok := r.(error); ok {
 			*err = e
 		} else {
@@ -211,7 +394,7 @@ func implSetManual(dnsCfg DnsSettings, localInterfaceIP net.IP) (dnsInfoForFirew
 			return DnsSettings{}, err
 		}
 		// the local DNS must be configured to the dnscrypt-proxy (localhost)
-		dnsCfg = DnsSettings{DnsHost: "127.0.0.1"}
+		dnsCfg = DnsSettings{DnsHost: "127.0.0.1", SearchDomains: dnsCfg.SearchDomains}
 	} else {
 		// non-VPN interfaces to update (if DNS located in local network)
 		notVpnInterfacesToUpdate, _ = getInterfacesIPsWhichContainsIP(dnsCfg.Ip(), localInterfaceIP)
@@ -242,6 +425,13 @@ func implSetManual(dnsCfg DnsSettings, localInterfaceIP net.IP) (dnsInfoForFirew
 		if err := fSetDNSByLocalIP(localInterfaceIP, dnsCfg, isIpv6, OperationSet); err != nil {
 			return DnsSettings{}, fmt.Errorf("failed to set DNS for local interface: %w", err)
 		}
+		// set DNS suffixes for VPN interface (or remove the suffixes which could be applied before)
+		if err := setInterfaceSearchDomains(localInterfaceIP, dnsCfg.SearchDomains); err != nil {
+			if len(dnsCfg.SearchDomains) > 0 {
+				return DnsSettings{}, fmt.Errorf("failed to set DNS search domains for local interface: %w", err)
+			}
+			log.Warning(fmt.Errorf("failed to reset DNS search domains for local interface: %w", err))
+		}
 	}
 
 	if len(notVpnInterfacesToUpdate) > 0 {
@@ -303,6 +493,12 @@ func implDeleteManual(localInterfaceIP net.IP) (retErr error) {
 		if e := fSetDNSByLocalIP(localInterfaceIP, DnsSettings{}, isIpv6, OperationSet); err != nil {
 			retErr = fmt.Errorf("failed to reset DNS (IPv6=%v) for local interface: %w", isIpv6, e)
 		}
+
+		if len(_lastDNS.SearchDomains) > 0 {
+			if e := setInterfaceSearchDomains(localInterfaceIP, nil); e != nil {
+				log.Error(fmt.Errorf("failed to reset DNS search domains for local interface: %w", e))
+			}
+		}
 	}
 
 	if len(notVpnInterfacesToUpdate) > 0 {
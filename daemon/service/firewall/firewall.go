@@ -31,6 +31,7 @@ import (
 
 	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
 )
 
 var log *logger.Logger
@@ -52,6 +53,22 @@ var (
 
 	// List of IP masks that are allowed for any communication
 	userExceptions []net.IPNet
+	// List of LAN hosts that are allowed for any communication (applicable when LAN communication is blocked)
+	allowedLanHosts []net.IPNet
+
+	// LAN services which are allowed individually (when LAN communication is blocked)
+	allowedLanServices lansvc.Services
+	// Local ports which accept incoming connections from LAN (when LAN communication is blocked)
+	allowedLanInboundPorts []lansvc.InboundPort
+
+	// TCP MSS value for connections through the VPN interface (0 - MSS clamping disabled)
+	mssClampValue int
+)
+
+const (
+	// Allowed range of the TCP MSS clamping value
+	MssClampMin = 536
+	MssClampMax = 1460
 )
 
 // Initialize is doing initialization stuff
@@ -151,6 +168,13 @@ func ClientConnected(clientLocalIPAddress net.IP, clientLocalIPv6Address net.IP,
 	if err != nil {
 		log.Error(err)
 	}
+
+	// MSS clamping rules do not depend on the firewall state
+	if mssClampValue > 0 {
+		if e := implSetMssClamping(clientLocalIPAddress, mssClampValue); e != nil {
+			log.Error("Failed to apply MSS clamping: ", e)
+		}
+	}
 	return err
 }
 
@@ -169,6 +193,11 @@ func ClientDisconnected() error {
 		if err != nil {
 			log.Error(err)
 		}
+		if mssClampValue > 0 {
+			if e := implSetMssClamping(nil, 0); e != nil {
+				log.Error("Failed to remove MSS clamping: ", e)
+			}
+		}
 		return err
 	}
 	return nil
@@ -218,6 +247,54 @@ func AllowLAN(allowLan bool, allowLanMulticast bool) error {
 	return err
 }
 
+// AllowLanServices - allow communication with the individual LAN services (mDNS, SSDP, DHCP, printing)
+// It gives the possibility to use, for example, AirPrint/Chromecast discovery without allowing the whole LAN.
+func AllowLanServices(services lansvc.Services) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if services == allowedLanServices {
+		return nil
+	}
+
+	log.Info(fmt.Sprintf("Allowed LAN services: [%s]", services))
+
+	err := implAllowLanServices(services)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	allowedLanServices = services
+	return nil
+}
+
+// SetLanInboundPorts - allow incoming connections from LAN to the local ports (e.g. SSH server or local web server)
+// while the firewall blocks the inbound traffic
+func SetLanInboundPorts(ports []lansvc.InboundPort) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	for _, p := range ports {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if lansvc.InboundPortsString(ports) == lansvc.InboundPortsString(allowedLanInboundPorts) {
+		return nil
+	}
+
+	log.Info(fmt.Sprintf("Allowed LAN inbound ports: [%s]", lansvc.InboundPortsString(ports)))
+
+	err := implSetLanInboundPorts(ports)
+	if err != nil {
+		log.Error(err)
+		return err
+	}
+	allowedLanInboundPorts = ports
+	return nil
+}
+
 func GetDnsInfo() (dns.DnsSettings, bool) {
 	mutex.Lock()
 	defer mutex.Unlock()
@@ -269,16 +346,105 @@ func OnChangeDNS(newDnsCfg *dns.DnsSettings) error {
 	return err
 }
 
+// MssClampingFuncNotAvailableError returns error when the TCP MSS clamping is not supported on current platform
+func MssClampingFuncNotAvailableError() error {
+	return implMssClampingFuncNotAvailableError()
+}
+
+// SetMssClamping - set the TCP MSS value for connections through the VPN interface
+// (fixes hanging TLS connections when the path MTU is lower than the MTU of the tunnel, e.g. PPPoE links)
+// The rules are applied when VPN connected and removed on disconnection; they do not depend on the firewall state.
+// Parameters:
+//	- mss - MSS value for IPv4 connections (for IPv6 it is 20 bytes less); 0 - MSS clamping disabled
+func SetMssClamping(mss int) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if mss != 0 {
+		if mss < MssClampMin || mss > MssClampMax {
+			return fmt.Errorf("MSS value must be in range %d-%d (or 0 to disable clamping)", MssClampMin, MssClampMax)
+		}
+		if err := implMssClampingFuncNotAvailableError(); err != nil {
+			return err
+		}
+	}
+
+	if mss == mssClampValue {
+		return nil
+	}
+	log.Info(fmt.Sprintf("MSS clamping: %d", mss))
+	mssClampValue = mss
+
+	if connectedClientInterfaceIP == nil {
+		return nil // will be applied on client connection
+	}
+	err := implSetMssClamping(connectedClientInterfaceIP, mss)
+	if err != nil {
+		log.Error(err)
+	}
+	return err
+}
+
 // SetUserExceptions set ip/mask to be excluded from FW block
 // Parameters:
 //	- exceptions - comma separated list of IP addresses in format: x.x.x.x[/xx]
 func SetUserExceptions(exceptions string, ignoreParseErrors bool) error {
-	userExceptions = []net.IPNet{}
+	var err error
+	userExceptions, err = parseIPNetList(exceptions, ignoreParseErrors)
+	if err != nil {
+		return fmt.Errorf("unable to parse firewall exceptions ('%s'): %w", exceptions, err)
+	}
+
+	return implOnUserExceptionsUpdated()
+}
+
+// SetAllowedLanHosts set the LAN hosts which are allowed for any communication (e.g. NAS, printer)
+// It gives the possibility to allow only the enumerated hosts instead of the whole local subnet.
+// Parameters:
+//	- hosts - comma separated list of IP addresses; only local addresses are allowed (private or link-local)
+func SetAllowedLanHosts(hosts string, ignoreParseErrors bool) error {
+	nets, err := parseIPNetList(hosts, ignoreParseErrors)
+	if err != nil {
+		return fmt.Errorf("unable to parse LAN hosts ('%s'): %w", hosts, err)
+	}
+
+	lanHosts := make([]net.IPNet, 0, len(nets))
+	for _, n := range nets {
+		if ones, bits := n.Mask.Size(); ones != bits {
+			err = fmt.Errorf("%s is not a single host address", n.String())
+		} else if !n.IP.IsPrivate() && !n.IP.IsLinkLocalUnicast() {
+			err = fmt.Errorf("%s is not a local network address", n.IP.String())
+		}
+		if err != nil {
+			if !ignoreParseErrors {
+				return err
+			}
+			err = nil
+			continue
+		}
+		lanHosts = append(lanHosts, n)
+	}
+
+	allowedLanHosts = lanHosts
+	return implOnUserExceptionsUpdated()
+}
+
+// getUserExceptionsAll returns user-defined exceptions together with allowed LAN hosts
+func getUserExceptionsAll() []net.IPNet {
+	ret := make([]net.IPNet, 0, len(userExceptions)+len(allowedLanHosts))
+	ret = append(ret, userExceptions...)
+	return append(ret, allowedLanHosts...)
+}
+
+// parseIPNetList parses comma separated list of IP addresses in format: x.x.x.x[/xx]
+// When 'ignoreParseErrors' is false - returns error on the first wrong element (together with the elements parsed before)
+func parseIPNetList(list string, ignoreParseErrors bool) ([]net.IPNet, error) {
+	ret := []net.IPNet{}
 
 	splitFunc := func(c rune) bool {
 		return !unicode.IsLetter(c) && !unicode.IsNumber(c) && c != rune('/') && c != rune('.') && c != rune(':')
 	}
-	exceptionsArr := strings.FieldsFunc(exceptions, splitFunc)
+	exceptionsArr := strings.FieldsFunc(list, splitFunc)
 	for _, exp := range exceptionsArr {
 		exp = strings.TrimSpace(exp)
 
@@ -303,12 +469,12 @@ func SetUserExceptions(exceptions string, ignoreParseErrors bool) error {
 		}
 		if err != nil {
 			if !ignoreParseErrors {
-				return fmt.Errorf("unable to parse firewall exceptions ('%s'): %w", exceptions, err)
+				return ret, err
 			}
 			continue
 		}
-		userExceptions = append(userExceptions, *n)
+		ret = append(ret, *n)
 	}
 
-	return implOnUserExceptionsUpdated()
+	return ret, nil
 }
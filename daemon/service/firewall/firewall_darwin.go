@@ -29,6 +29,7 @@ import (
 	"time"
 
 	"github.com/ivpn/desktop-app/daemon/netinfo"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
 	"github.com/ivpn/desktop-app/daemon/shell"
 )
@@ -88,7 +89,11 @@ func implSetEnabled(isEnabled bool) error {
 		}
 		// To fulfill such flow (example): Connected -> FWDisable -> FWEnable
 		// Here we should restore all exceptions (all hosts which are allowed)
-		return reApplyExceptions()
+		err = reApplyExceptions()
+		if e := applyLanServices(allowedLanServices, allowedLanInboundPorts); e != nil && err == nil {
+			err = e
+		}
+		return err
 	}
 	return shell.Exec(nil, platform.FirewallScript(), "-disable")
 }
@@ -219,6 +224,27 @@ func delayedAllowLAN(isAllowLanMulticast bool) {
 	log.Info("Delayed 'Allow LAN': no LAN interfaces detected")
 }
 
+// implAllowLanServices - allow communication with the individual LAN services
+// (the rules are applied only when the firewall is enabled; on firewall enabling they are re-applied)
+func implAllowLanServices(services lansvc.Services) error {
+	return applyLanServices(services, allowedLanInboundPorts)
+}
+
+// implSetLanInboundPorts - allow incoming connections from LAN to the local ports
+// (the rules are applied together with the LAN services rules)
+func implSetLanInboundPorts(ports []lansvc.InboundPort) error {
+	return applyLanServices(allowedLanServices, ports)
+}
+
+func applyLanServices(services lansvc.Services, inboundPorts []lansvc.InboundPort) error {
+	args := append([]string{"-set_lan_services"}, services.Names()...)
+	for _, p := range inboundPorts {
+		args = append(args, "in:"+p.String())
+	}
+	log.Info(strings.Join(args, " "))
+	return shell.Exec(nil, platform.FirewallScript(), args...)
+}
+
 // implAddHostsToExceptions - allow comminication with this hosts
 // Note: if isPersistent == false -> all added hosts will be removed from exceptions after client disconnection (after call 'ClientDisconnected()')
 // Arguments:
@@ -256,7 +282,7 @@ func implOnChangeDNS(addr net.IP) error {
 // implOnUserExceptionsUpdated() called when 'userExceptions' value were updated. Necessary to update firewall rules.
 func implOnUserExceptionsUpdated() error {
 	var expMasks []string
-	for _, mask := range userExceptions {
+	for _, mask := range getUserExceptionsAll() {
 		expMasks = append(expMasks, mask.String())
 	}
 
@@ -265,6 +291,17 @@ func implOnUserExceptionsUpdated() error {
 
 //---------------------------------------------------------------------
 
+func implMssClampingFuncNotAvailableError() error {
+	return fmt.Errorf("MSS clamping is not supported on this platform")
+}
+
+func implSetMssClamping(clientLocalIP net.IP, mss int) error {
+	if mss > 0 {
+		return implMssClampingFuncNotAvailableError()
+	}
+	return nil
+}
+
 func applySetUserExceptions(hostsIPs []string) error { //
 	ipList := strings.Join(hostsIPs, " ")
 
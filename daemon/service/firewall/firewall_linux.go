@@ -31,6 +31,7 @@ import (
 
 	"github.com/ivpn/desktop-app/daemon/helpers"
 	"github.com/ivpn/desktop-app/daemon/netinfo"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
 	"github.com/ivpn/desktop-app/daemon/oshelpers/linux/netlink"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
 	"github.com/ivpn/desktop-app/daemon/shell"
@@ -111,7 +112,11 @@ func implSetEnabled(isEnabled bool) error {
 
 		// To fulfill such flow (example): Connected -> FWDisable -> FWEnable
 		// Here we should restore all exceptions (all hosts which are allowed)
-		return reApplyExceptions()
+		err = reApplyExceptions()
+		if e := applyLanServices(allowedLanServices, allowedLanInboundPorts); e != nil && err == nil {
+			err = e
+		}
+		return err
 	}
 
 	// disable FW ...
@@ -296,6 +301,27 @@ func doAllowLAN(isAllowLAN, isAllowLanMulticast, isTriggeredByLanChangeMonitor b
 	return addHostsToExceptions(curAllowedLanIPs, persistant, notOnlyForICMP)
 }
 
+// implAllowLanServices - allow communication with the individual LAN services
+// (the rules are applied only when the firewall is enabled; on firewall enabling they are re-applied)
+func implAllowLanServices(services lansvc.Services) error {
+	return applyLanServices(services, allowedLanInboundPorts)
+}
+
+// implSetLanInboundPorts - allow incoming connections from LAN to the local ports
+// (the rules are applied together with the LAN services rules)
+func implSetLanInboundPorts(ports []lansvc.InboundPort) error {
+	return applyLanServices(allowedLanServices, ports)
+}
+
+func applyLanServices(services lansvc.Services, inboundPorts []lansvc.InboundPort) error {
+	args := append([]string{"-set_lan_services"}, services.Names()...)
+	for _, p := range inboundPorts {
+		args = append(args, "in:"+p.String())
+	}
+	log.Info(strings.Join(args, " "))
+	return shell.Exec(nil, platform.FirewallScript(), args...)
+}
+
 // implAddHostsToExceptions - allow communication with this hosts
 // Note: if isPersistent == false -> all added hosts will be removed from exceptions after client disconnection (after call 'ClientDisconnected()')
 // Arguments:
@@ -369,9 +395,46 @@ func implOnUserExceptionsUpdated() error {
 	return err
 }
 
+func implMssClampingFuncNotAvailableError() error {
+	return nil
+}
+
+// implSetMssClamping - add (or remove when mss==0) the rules which are clamping MSS of TCP SYN packets going through the VPN interface
+func implSetMssClamping(clientLocalIP net.IP, mss int) error {
+	if mss <= 0 || clientLocalIP == nil {
+		return shell.Exec(nil, platform.FirewallScript(), "-mss_clamp_disable")
+	}
+
+	inf, err := netinfo.InterfaceByIPAddr(clientLocalIP)
+	if err != nil {
+		return fmt.Errorf("failed to get local interface by IP: %w", err)
+	}
+	// MSS for IPv6 connections is 20 bytes less (IPv6 header is 20 bytes larger than IPv4 header)
+	scriptArgs := fmt.Sprintf("-mss_clamp_enable %s %d %d", inf.Name, mss, mss-20)
+	log.Info(scriptArgs)
+	return shell.Exec(nil, platform.FirewallScript(), scriptArgs)
+}
+
 //---------------------------------------------------------------------
 
 func applyAddHostsToExceptions(hostsIPs []string, isPersistant bool, onlyForICMP bool) error {
+	if !isPersistant {
+		// IPv6 hosts (e.g. the VPN server on IPv6-only network) are allowed by the separate rules
+		var ipv6Hosts []string
+		hostsIPs, ipv6Hosts = splitIPv6Hosts(hostsIPs)
+		if len(ipv6Hosts) > 0 {
+			scriptCommand := "-add_exceptions_ipv6"
+			if onlyForICMP {
+				scriptCommand = "-add_exceptions_icmp_ipv6"
+			}
+			ipList := strings.Join(ipv6Hosts, ",")
+			log.Info(scriptCommand, " ", ipList)
+			if err := shell.Exec(nil, platform.FirewallScript(), scriptCommand, ipList); err != nil {
+				return err
+			}
+		}
+	}
+
 	ipList := strings.Join(hostsIPs, ",")
 
 	if len(ipList) > 0 {
@@ -395,6 +458,22 @@ func applyAddHostsToExceptions(hostsIPs []string, isPersistant bool, onlyForICMP
 }
 
 func applyRemoveHostsFromExceptions(hostsIPs []string, isPersistant bool, onlyForICMP bool) error {
+	if !isPersistant {
+		var ipv6Hosts []string
+		hostsIPs, ipv6Hosts = splitIPv6Hosts(hostsIPs)
+		if len(ipv6Hosts) > 0 {
+			scriptCommand := "-remove_exceptions_ipv6"
+			if onlyForICMP {
+				scriptCommand = "-remove_exceptions_icmp_ipv6"
+			}
+			ipList := strings.Join(ipv6Hosts, ",")
+			log.Info(scriptCommand, " ", ipList)
+			if err := shell.Exec(nil, platform.FirewallScript(), scriptCommand, ipList); err != nil {
+				log.Warning(err)
+			}
+		}
+	}
+
 	ipList := strings.Join(hostsIPs, ",")
 
 	if len(ipList) > 0 {
@@ -417,6 +496,18 @@ func applyRemoveHostsFromExceptions(hostsIPs []string, isPersistant bool, onlyFo
 	return nil
 }
 
+// splitIPv6Hosts splits the list of hosts to IPv4 and IPv6 addresses
+func splitIPv6Hosts(hostsIPs []string) (ipv4Hosts, ipv6Hosts []string) {
+	for _, h := range hostsIPs {
+		if ip := net.ParseIP(h); ip != nil && ip.To4() == nil {
+			ipv6Hosts = append(ipv6Hosts, h)
+		} else {
+			ipv4Hosts = append(ipv4Hosts, h)
+		}
+	}
+	return ipv4Hosts, ipv6Hosts
+}
+
 func reApplyExceptions() error {
 
 	// Allow LAN communication (if necessary)
@@ -598,7 +689,7 @@ func getLanIPs() ([]string, error) {
 
 func getUserExceptions(ipv4, ipv6 bool) []net.IPNet {
 	ret := []net.IPNet{}
-	for _, e := range userExceptions {
+	for _, e := range getUserExceptionsAll() {
 		isIPv6 := e.IP.To4() == nil
 		isIPv4 := !isIPv6
 
@@ -27,11 +27,15 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"path/filepath"
+	"strconv"
 	"syscall"
 
 	"github.com/ivpn/desktop-app/daemon/netinfo"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
 	"github.com/ivpn/desktop-app/daemon/service/firewall/winlib"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
+	"github.com/ivpn/desktop-app/daemon/shell"
 )
 
 var (
@@ -48,6 +52,11 @@ var (
 	isPersistant        bool
 	isAllowLAN          bool
 	isAllowLANMulticast bool
+	lanServices         lansvc.Services
+	lanInboundPorts     []lansvc.InboundPort
+
+	// original MTU of the VPN interface (before it was lowered for the MSS clamping)
+	mssClampOrigMTU int
 )
 
 const (
@@ -201,6 +210,123 @@ func implAllowLAN(allowLan bool, allowLanMulticast bool) error {
 	return reEnable()
 }
 
+// implAllowLanServices - allow communication with the individual LAN services
+func implAllowLanServices(services lansvc.Services) error {
+	lanServices = services
+
+	enabled, err := implGetEnabled()
+	if err != nil {
+		return fmt.Errorf("failed to get info if firewall is on: %w", err)
+	}
+	if !enabled {
+		return nil
+	}
+
+	return reEnable()
+}
+
+// implSetLanInboundPorts - allow incoming connections from LAN to the local ports
+// (the WFP filters are restricted by the protocol of the port: TCP or UDP)
+func implSetLanInboundPorts(ports []lansvc.InboundPort) error {
+	lanInboundPorts = ports
+
+	enabled, err := implGetEnabled()
+	if err != nil {
+		return fmt.Errorf("failed to get info if firewall is on: %w", err)
+	}
+	if !enabled {
+		return nil
+	}
+
+	return reEnable()
+}
+
+// lanServiceRule - the rule to allow communication with the LAN service:
+// remote address is in one of the networks (LAN or multicast group of the service) and the port is equal to the service port
+type lanServiceRule struct {
+	port        uint16
+	isLocalPort bool  // 'true' - the service port is a local port (incoming requests or announcements to the local service)
+	protocol    uint8 // IP protocol (winlib.IPProtoTCP/winlib.IPProtoUDP); 0 - any protocol
+	nets        []string
+}
+
+// local networks (the LAN services are allowed only for these addresses)
+var lanServiceNets = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "169.254.0.0/16", "fe80::/10", "fc00::/7"}
+
+func getLanServiceRules(services lansvc.Services, inboundPorts []lansvc.InboundPort) []lanServiceRule {
+	var rules []lanServiceRule
+	// UDP discovery protocols: outgoing requests to the service port, replies from it and the requests/announcements to the local service port
+	addDiscovery := func(port uint16, groups ...string) {
+		nets := append(append([]string{}, lanServiceNets...), groups...)
+		rules = append(rules,
+			lanServiceRule{port: port, nets: nets},
+			lanServiceRule{port: port, isLocalPort: true, nets: nets})
+	}
+
+	if services.MDNS {
+		addDiscovery(5353, "224.0.0.251/32", "ff02::fb/128")
+	}
+	if services.SSDP {
+		addDiscovery(1900, "239.255.255.250/32", "ff02::c/128")
+	}
+	if services.DHCP {
+		// DHCP client port (68) is allowed by default; here allowing unicast communication with DHCP servers in LAN
+		rules = append(rules,
+			lanServiceRule{port: 67, nets: lanServiceNets},
+			lanServiceRule{port: 547, nets: append(append([]string{}, lanServiceNets...), "ff02::1:2/128")})
+	}
+	if services.Printing {
+		for _, port := range []uint16{631, 515, 9100} {
+			rules = append(rules, lanServiceRule{port: port, nets: lanServiceNets})
+		}
+	}
+	// incoming connections to the local ports (replies are allowed automatically for the accepted connections)
+	for _, p := range inboundPorts {
+		protocol := winlib.IPProtoTCP
+		if p.Protocol == "udp" {
+			protocol = winlib.IPProtoUDP
+		}
+		rules = append(rules, lanServiceRule{port: p.Port, isLocalPort: true, protocol: protocol, nets: lanServiceNets})
+	}
+	return rules
+}
+
+// addLanServicesFilters - add filters for the allowed LAN services into the layer
+func addLanServicesFilters(layer syscall.GUID, isIPv6 bool) error {
+	for _, r := range getLanServiceRules(lanServices, lanInboundPorts) {
+		for _, netStr := range r.nets {
+			_, n, err := net.ParseCIDR(netStr)
+			if err != nil {
+				return err
+			}
+			if (n.IP.To4() == nil) != isIPv6 {
+				continue
+			}
+
+			var f winlib.Filter
+			if isIPv6 {
+				prefixLen, _ := n.Mask.Size()
+				f = winlib.NewFilterAllowRemoteIPV6(providerKey, layer, sublayerKey, filterDName, "", n.IP, byte(prefixLen), isPersistant)
+			} else {
+				f = winlib.NewFilterAllowRemoteIP(providerKey, layer, sublayerKey, filterDName, "", n.IP, net.IP(n.Mask), isPersistant)
+			}
+			if r.isLocalPort {
+				f.AddCondition(&winlib.ConditionIPLocalPort{Match: winlib.FwpMatchEqual, Port: r.port})
+			} else {
+				f.AddCondition(&winlib.ConditionIPRemotePort{Match: winlib.FwpMatchEqual, Port: r.port})
+			}
+			if r.protocol != 0 {
+				f.AddCondition(&winlib.ConditionIPProtocol{Match: winlib.FwpMatchEqual, Protocol: r.protocol})
+			}
+
+			if _, err := manager.AddFilter(f); err != nil {
+				return fmt.Errorf("failed to add filter 'allow LAN service' (%s port %d): %w", netStr, r.port, err)
+			}
+		}
+	}
+	return nil
+}
+
 // OnChangeDNS - must be called on each DNS change (to update firewall rules according to new DNS configuration)
 func implOnChangeDNS(addr net.IP) error {
 	if addr.Equal(customDNS) {
@@ -233,6 +359,56 @@ func implOnUserExceptionsUpdated() error {
 	return reEnable()
 }
 
+func implMssClampingFuncNotAvailableError() error {
+	return nil
+}
+
+// implSetMssClamping - WFP is not able to modify TCP options of the packets.
+// Windows calculates the MSS of TCP connections from the MTU of the interface (MSS = MTU - 40 for IPv4),
+// so the MSS clamping is done by lowering the MTU of the VPN interface (only in the current session; the interface is re-created on each connection).
+func implSetMssClamping(clientLocalIP net.IP, mss int) error {
+	if clientLocalIP == nil {
+		// disconnected: the VPN interface is removed, nothing to restore
+		mssClampOrigMTU = 0
+		return nil
+	}
+
+	inf, err := netinfo.InterfaceByIPAddr(clientLocalIP)
+	if err != nil {
+		return fmt.Errorf("failed to get local interface by IP: %w", err)
+	}
+
+	mtu := mss + 40
+	if mss <= 0 {
+		if mssClampOrigMTU <= 0 {
+			return nil
+		}
+		// restore original MTU
+		mtu = mssClampOrigMTU
+		mssClampOrigMTU = 0
+	} else {
+		if mssClampOrigMTU <= 0 {
+			mssClampOrigMTU = inf.MTU
+		}
+		if mtu > mssClampOrigMTU {
+			mtu = mssClampOrigMTU // the MTU of the interface is already low enough
+		}
+	}
+
+	netsh := filepath.Join(os.Getenv("SYSTEMROOT"), "System32", "netsh.exe")
+	if err := shell.Exec(log, netsh, "interface", "ipv4", "set", "subinterface", inf.Name, "mtu="+strconv.Itoa(mtu), "store=active"); err != nil {
+		return fmt.Errorf("failed to change MTU of the VPN interface: %w", err)
+	}
+	// minimal IPv6 MTU is 1280
+	if mtu < 1280 {
+		mtu = 1280
+	}
+	if err := shell.Exec(log, netsh, "interface", "ipv6", "set", "subinterface", inf.Name, "mtu="+strconv.Itoa(mtu), "store=active"); err != nil {
+		log.Warning("failed to change IPv6 MTU of the VPN interface: ", err)
+	}
+	return nil
+}
+
 func reEnable() (retErr error) {
 	// start / commit transaction
 	if err := manager.TransactionStart(); err != nil {
@@ -352,6 +528,11 @@ func doEnable() (retErr error) {
 			}
 		}
 
+		// LAN services
+		if err = addLanServicesFilters(layer, true); err != nil {
+			return err
+		}
+
 		// user exceptions
 		userExpsNets := getUserExceptions(false, true)
 		for _, n := range userExpsNets {
@@ -416,11 +597,29 @@ func doEnable() (retErr error) {
 		if err != nil {
 			return fmt.Errorf("failed to add filter 'allow application - wireguard': %w", err)
 		}
+		// allow AmneziaWG executable (if installed)
+		if _, err := os.Stat(platform.AwgBinaryPath()); err == nil {
+			_, err = manager.AddFilter(winlib.NewFilterAllowApplication(providerKey, layer, sublayerKey, sublayerDName, "", platform.AwgBinaryPath(), isPersistant))
+			if err != nil {
+				return fmt.Errorf("failed to add filter 'allow application - amneziawg': %w", err)
+			}
+		}
 		// allow obfsproxy
 		_, err = manager.AddFilter(winlib.NewFilterAllowApplication(providerKey, layer, sublayerKey, sublayerDName, "", platform.ObfsproxyStartScript(), isPersistant))
 		if err != nil {
 			return fmt.Errorf("failed to add filter 'allow application - obfsproxy': %w", err)
 		}
+		// allow V2Ray
+		_, err = manager.AddFilter(winlib.NewFilterAllowApplication(providerKey, layer, sublayerKey, sublayerDName, "", platform.V2RayBinaryPath(), isPersistant))
+		if err != nil {
+			return fmt.Errorf("failed to add filter 'allow application - v2ray': %w", err)
+		}
+
+		// allow Shadowsocks client
+		_, err = manager.AddFilter(winlib.NewFilterAllowApplication(providerKey, layer, sublayerKey, sublayerDName, "", platform.ShadowsocksBinaryPath(), isPersistant))
+		if err != nil {
+			return fmt.Errorf("failed to add filter 'allow application - shadowsocks': %w", err)
+		}
 		// allow dnscrypt-proxy
 		dnscryptProxyBin, _, _, _ := platform.DnsCryptProxyInfo()
 		_, err = manager.AddFilter(winlib.NewFilterAllowApplication(providerKey, layer, sublayerKey, sublayerDName, "", dnscryptProxyBin, isPersistant))
@@ -452,6 +651,11 @@ func doEnable() (retErr error) {
 			}
 		}
 
+		// LAN services
+		if err = addLanServicesFilters(layer, false); err != nil {
+			return err
+		}
+
 		// user exceptions
 		userExpsNets := getUserExceptions(true, false)
 		for _, n := range userExpsNets {
@@ -581,7 +785,7 @@ func doRemoveClientIPFilters() (retErr error) {
 
 func getUserExceptions(ipv4, ipv6 bool) []net.IPNet {
 	ret := []net.IPNet{}
-	for _, e := range userExceptions {
+	for _, e := range getUserExceptionsAll() {
 		isIPv6 := e.IP.To4() == nil
 		isIPv4 := !isIPv6
 
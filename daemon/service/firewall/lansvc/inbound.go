@@ -0,0 +1,89 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package lansvc
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// InboundPort - local port which accepts incoming connections from LAN
+// (e.g. SSH server or local web development server on this machine)
+type InboundPort struct {
+	Port     uint16
+	Protocol string // "tcp" or "udp"
+}
+
+// String returns the port in format: PORT/PROTOCOL
+func (p InboundPort) String() string {
+	return fmt.Sprintf("%d/%s", p.Port, p.Protocol)
+}
+
+// Validate checks the port parameters
+func (p InboundPort) Validate() error {
+	if p.Port == 0 {
+		return fmt.Errorf("port number is not defined")
+	}
+	if p.Protocol != "tcp" && p.Protocol != "udp" {
+		return fmt.Errorf("unsupported protocol '%s' for port %d (expected: tcp or udp)", p.Protocol, p.Port)
+	}
+	return nil
+}
+
+// ParseInboundPorts converts the comma-separated list of ports in format PORT[/tcp|/udp] into InboundPort list
+// (when the protocol is not defined - TCP is used)
+func ParseInboundPorts(list string) ([]InboundPort, error) {
+	ret := []InboundPort{}
+	for _, item := range strings.Split(list, ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if len(item) == 0 {
+			continue
+		}
+
+		portStr, protocol := item, "tcp"
+		if idx := strings.Index(item, "/"); idx >= 0 {
+			portStr, protocol = strings.TrimSpace(item[:idx]), strings.TrimSpace(item[idx+1:])
+		}
+		port, err := strconv.ParseUint(portStr, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("bad port number '%s'", portStr)
+		}
+
+		p := InboundPort{Port: uint16(port), Protocol: protocol}
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+		ret = append(ret, p)
+	}
+	return ret, nil
+}
+
+// InboundPortsString returns the comma-separated list of ports in format: PORT/PROTOCOL
+func InboundPortsString(ports []InboundPort) string {
+	strs := make([]string, 0, len(ports))
+	for _, p := range ports {
+		strs = append(strs, p.String())
+	}
+	return strings.Join(strs, ",")
+}
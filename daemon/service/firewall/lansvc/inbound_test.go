@@ -0,0 +1,57 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package lansvc_test
+
+import (
+	"testing"
+
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
+)
+
+func TestParseInboundPorts(t *testing.T) {
+	valid := map[string]string{
+		"":                        "",
+		" , ,":                    "",
+		"22":                      "22/tcp", // TCP by default
+		"22/tcp,53/udp":           "22/tcp,53/udp",
+		" 8080 / TCP , 5353/Udp ": "8080/tcp,5353/udp",
+		"65535/udp":               "65535/udp",
+	}
+	for list, expected := range valid {
+		ports, err := lansvc.ParseInboundPorts(list)
+		if err != nil {
+			t.Errorf("'%s': unexpected error: %v", list, err)
+			continue
+		}
+		if s := lansvc.InboundPortsString(ports); s != expected {
+			t.Errorf("'%s': expected '%s'; got '%s'", list, expected, s)
+		}
+	}
+
+	invalid := []string{"0", "65536", "-1", "ssh", "/tcp", "22/", "22/sctp", "22,abc,80"}
+	for _, list := range invalid {
+		if ports, err := lansvc.ParseInboundPorts(list); err == nil {
+			t.Errorf("'%s': expected error; got %v", list, ports)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package lansvc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Names of the LAN services
+const (
+	NameMDNS     = "mdns"
+	NameSSDP     = "ssdp"
+	NameDHCP     = "dhcp"
+	NamePrinting = "printing"
+)
+
+// Services - LAN services which are allowed by the firewall individually
+// (applicable when the firewall is enabled and the communication with the whole LAN is blocked)
+type Services struct {
+	// Multicast DNS (UDP 5353): discovery of AirPrint/AirPlay/Chromecast devices
+	MDNS bool
+	// Simple Service Discovery Protocol (UDP 1900): UPnP/DLNA discovery
+	SSDP bool
+	// DHCP (UDP 67/68) and DHCPv6 (UDP 546/547) to the servers in LAN (including unicast lease renewals)
+	DHCP bool
+	// Network printers in LAN: IPP (TCP 631), LPD (TCP 515), raw printing (TCP 9100)
+	Printing bool
+}
+
+// AllNames returns the names of all supported LAN services
+func AllNames() []string {
+	return []string{NameMDNS, NameSSDP, NameDHCP, NamePrinting}
+}
+
+// Parse converts the comma-separated list of the service names into Services
+// Empty string (or "none") means no services allowed.
+func Parse(list string) (Services, error) {
+	ret := Services{}
+	for _, name := range strings.Split(list, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "", "none":
+		case NameMDNS:
+			ret.MDNS = true
+		case NameSSDP:
+			ret.SSDP = true
+		case NameDHCP:
+			ret.DHCP = true
+		case NamePrinting:
+			ret.Printing = true
+		default:
+			return Services{}, fmt.Errorf("unknown LAN service '%s' (supported: %s)", name, strings.Join(AllNames(), ", "))
+		}
+	}
+	return ret, nil
+}
+
+// Names returns the names of the allowed services
+func (s Services) Names() []string {
+	ret := make([]string, 0, 4)
+	if s.MDNS {
+		ret = append(ret, NameMDNS)
+	}
+	if s.SSDP {
+		ret = append(ret, NameSSDP)
+	}
+	if s.DHCP {
+		ret = append(ret, NameDHCP)
+	}
+	if s.Printing {
+		ret = append(ret, NamePrinting)
+	}
+	return ret
+}
+
+// IsEmpty returns true when no services allowed
+func (s Services) IsEmpty() bool {
+	return len(s.Names()) == 0
+}
+
+// String returns the comma-separated list of the allowed services
+func (s Services) String() string {
+	return strings.Join(s.Names(), ",")
+}
@@ -33,6 +33,7 @@ var (
 	FwpmConditionIPLocalPort     = syscall.GUID{Data1: 0x0c1ba1af, Data2: 0x5765, Data3: 0x453f, Data4: [8]byte{0xaf, 0x22, 0xa8, 0xf7, 0x91, 0xac, 0x77, 0x5b}}
 	FwpmConditionIPRemoteAddress = syscall.GUID{Data1: 0xb235ae9a, Data2: 0x1d64, Data3: 0x49b8, Data4: [8]byte{0xa4, 0x4c, 0x5f, 0xf3, 0xd9, 0x09, 0x50, 0x45}}
 	FwpmConditionIPRemotePort    = syscall.GUID{Data1: 0xc35a604d, Data2: 0xd22b, Data3: 0x4e1a, Data4: [8]byte{0x91, 0xb4, 0x68, 0xf6, 0x74, 0xee, 0x67, 0x4b}}
+	FwpmConditionIPProtocol      = syscall.GUID{Data1: 0x3971ef2b, Data2: 0x623e, Data3: 0x4f9a, Data4: [8]byte{0x8c, 0xb1, 0x6e, 0x79, 0xb8, 0x06, 0xb9, 0xa7}}
 
 	/*
 		FwpmConditionInterfaceMacAddress             = syscall.GUID{Data1: 0xf6e63dce, Data2: 0x1f4b, Data3: 0x4c6b, Data4: [8]byte{0xb6, 0xef, 0x11, 0x65, 0xe7, 0x1f, 0x8e, 0xe7}}
@@ -92,7 +93,6 @@ var (
 		FwpmConditionInterfaceType                   = syscall.GUID{Data1: 0xdaf8cd14, Data2: 0xe09e, Data3: 0x4c93, Data4: [8]byte{0xa5, 0xae, 0xc5, 0xc1, 0x3b, 0x73, 0xff, 0xca}}
 		FwpmConditionTunnelType                      = syscall.GUID{Data1: 0x77a40437, Data2: 0x8779, Data3: 0x4868, Data4: [8]byte{0xa2, 0x61, 0xf5, 0xa9, 0x02, 0xf1, 0xc0, 0xcd}}
 		FwpmConditionIPForwardInterface              = syscall.GUID{Data1: 0x1076b8a5, Data2: 0x6323, Data3: 0x4c5e, Data4: [8]byte{0x98, 0x10, 0xe8, 0xd3, 0xfc, 0x9e, 0x61, 0x36}}
-		FwpmConditionIPProtocol                      = syscall.GUID{Data1: 0x3971ef2b, Data2: 0x623e, Data3: 0x4f9a, Data4: [8]byte{0x8c, 0xb1, 0x6e, 0x79, 0xb8, 0x06, 0xb9, 0xa7}}
 		FwpmConditionIPLocalPort                     = syscall.GUID{Data1: 0x0c1ba1af, Data2: 0x5765, Data3: 0x453f, Data4: [8]byte{0xaf, 0x22, 0xa8, 0xf7, 0x91, 0xac, 0x77, 0x5b}}
 		FwpmConditionIPRemotePort                    = syscall.GUID{Data1: 0xc35a604d, Data2: 0xd22b, Data3: 0x4e1a, Data4: [8]byte{0x91, 0xb4, 0x68, 0xf6, 0x74, 0xee, 0x67, 0x4b}}
 		FwpmConditionEmbeddedLocalAddressType        = syscall.GUID{Data1: 0x4672a468, Data2: 0x8a0a, Data3: 0x4202, Data4: [8]byte{0xab, 0xb4, 0x84, 0x9e, 0x92, 0xe6, 0x68, 0x09}}
@@ -105,6 +105,28 @@ func (c *ConditionIPRemotePort) Apply(filter syscall.Handle, conditionIndex uint
 
 // ------------------------------------------------------------------------------------------------------
 
+// IP protocol numbers (for ConditionIPProtocol)
+const (
+	IPProtoTCP uint8 = 6
+	IPProtoUDP uint8 = 17
+)
+
+// ConditionIPProtocol - new condition type implementation
+type ConditionIPProtocol struct {
+	Match    FwpMatchType
+	Protocol uint8 // IPProtoTCP, IPProtoUDP ...
+}
+
+// Apply applies the filter
+func (c *ConditionIPProtocol) Apply(filter syscall.Handle, conditionIndex uint32) error {
+	if err := preApply(c.Match, filter, conditionIndex, FwpmConditionIPProtocol); err != nil {
+		return fmt.Errorf("condition pre-apply error: %w", err)
+	}
+	return FWPMFILTERSetConditionUINT8(filter, conditionIndex, c.Protocol)
+}
+
+// ------------------------------------------------------------------------------------------------------
+
 // ConditionIPRemoteAddressV4 - new condition type implementation
 type ConditionIPRemoteAddressV4 struct {
 	Match FwpMatchType
@@ -84,6 +84,7 @@ var (
 	fFWPMFILTERSetConditionV4AddrMask *syscall.LazyProc
 	fFWPMFILTERSetConditionV6AddrMask *syscall.LazyProc
 	fFWPMFILTERSetConditionUINT16     *syscall.LazyProc
+	fFWPMFILTERSetConditionUINT8      *syscall.LazyProc
 	fFWPMFILTERSetConditionBlobString *syscall.LazyProc
 	fFWPMFILTERSetAction              *syscall.LazyProc
 	fFWPMFILTERSetFlags               *syscall.LazyProc
@@ -141,6 +142,7 @@ func Initialize(wfpDllPath string) error {
 	fFWPMFILTERSetConditionV4AddrMask = dll.NewProc("FWPM_FILTER_SetConditionV4AddrMask")
 	fFWPMFILTERSetConditionV6AddrMask = dll.NewProc("FWPM_FILTER_SetConditionV6AddrMask")
 	fFWPMFILTERSetConditionUINT16 = dll.NewProc("FWPM_FILTER_SetConditionUINT16")
+	fFWPMFILTERSetConditionUINT8 = dll.NewProc("FWPM_FILTER_SetConditionUINT8")
 	fFWPMFILTERSetConditionBlobString = dll.NewProc("FWPM_FILTER_SetConditionBlobString")
 	fFWPMFILTERSetAction = dll.NewProc("FWPM_FILTER_SetAction")
 	fFWPMFILTERSetFlags = dll.NewProc("FWPM_FILTER_SetFlags")
@@ -201,6 +201,16 @@ func FWPMFILTERSetConditionUINT16(filter syscall.Handle, conditionIndex uint32,
 	return checkDefaultAPIResp(retval, err)
 }
 
+// FWPMFILTERSetConditionUINT8 sets conditions parameters
+func FWPMFILTERSetConditionUINT8(filter syscall.Handle, conditionIndex uint32, val uint8) (err error) {
+	defer catchPanic(&err)
+
+	retval, _, err := fFWPMFILTERSetConditionUINT8.Call(uintptr(filter),
+		uintptr(conditionIndex),
+		uintptr(val))
+	return checkDefaultAPIResp(retval, err)
+}
+
 // FWPMFILTERSetConditionBlobString sets conditions parameters
 func FWPMFILTERSetConditionBlobString(filter syscall.Handle, conditionIndex uint32, val string) (err error) {
 	defer catchPanic(&err)
@@ -0,0 +1,252 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package hostshealth keeps the history of connection failures for VPN hosts.
+// Each failure increases the "failure score" of the host; the score exponentially decays over time.
+// When the score reaches the threshold - the host is temporarily blacklisted:
+// it must be deprioritized by the host selection logic until the blacklisting expires.
+// Each next blacklisting of the same host (without successful connection in between) lasts twice longer.
+package hostshealth
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FailureType - type of the connection failure
+type FailureType string
+
+const (
+	// FailureHandshake - unable to establish the connection with the host
+	FailureHandshake FailureType = "Handshake"
+	// FailureVerification - the host was reachable but the connection was not verified (e.g. TLS error)
+	FailureVerification FailureType = "Verification"
+	// FailureSessionDrop - the established connection dropped unexpectedly
+	FailureSessionDrop FailureType = "SessionDrop"
+)
+
+// weight of each failure type (how much the failure increases the score)
+var failureWeights = map[FailureType]float64{
+	FailureHandshake:    1.0,
+	FailureVerification: 2.0,
+	FailureSessionDrop:  0.5,
+}
+
+const (
+	// time during which the score decreases by half
+	scoreHalfLife = time.Minute * 30
+	// the host is blacklisted when its score reaches this value (e.g. three handshake failures in a short time)
+	blacklistScoreThreshold = 2.5
+	// duration of the first blacklisting (each next blacklisting lasts twice longer)
+	blacklistBaseDuration = time.Minute * 2
+	// max duration of the blacklisting
+	blacklistMaxDuration = time.Hour
+	// the hosts with the score lower than this value are forgotten
+	scoreForgetThreshold = 0.01
+)
+
+// HostScore - health information about the host
+type HostScore struct {
+	// Host IP address
+	Host string
+	// Failure score: 0 - healthy host; the higher the score the less reliable the host
+	Score float64
+	// Total number of failures
+	Failures int
+	// Last failure type and time
+	LastFailure     FailureType
+	LastFailureTime time.Time
+	// The host is deprioritized until this time (zero value - host is not blacklisted)
+	BlacklistedUntil time.Time
+}
+
+// IsBlacklisted returns 'true' when the host is temporarily blacklisted
+func (h HostScore) IsBlacklisted() bool {
+	return time.Now().Before(h.BlacklistedUntil)
+}
+
+type hostInfo struct {
+	HostScore
+	scoreTime  time.Time // the time when 'Score' was calculated
+	blacklists int       // number of blacklistings without successful connection in between
+}
+
+// current score value (with the decay applied)
+func (h *hostInfo) currentScore(now time.Time) float64 {
+	elapsed := now.Sub(h.scoreTime)
+	if elapsed <= 0 {
+		return h.Score
+	}
+	return h.Score * math.Pow(0.5, float64(elapsed)/float64(scoreHalfLife))
+}
+
+// Tracker - keeps the health information for VPN hosts
+type Tracker struct {
+	mutex sync.Mutex
+	hosts map[string]*hostInfo
+}
+
+// CreateTracker creates new hosts health tracker
+func CreateTracker() *Tracker {
+	return &Tracker{hosts: make(map[string]*hostInfo)}
+}
+
+// OnFailure registers the connection failure for the host.
+// Returns 'true' when the host became blacklisted.
+func (t *Tracker) OnFailure(host string, failure FailureType) (isBlacklisted bool) {
+	if len(host) == 0 {
+		return false
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := time.Now()
+	h, ok := t.hosts[host]
+	if !ok {
+		h = &hostInfo{HostScore: HostScore{Host: host}}
+		t.hosts[host] = h
+	}
+
+	h.Score = h.currentScore(now) + failureWeights[failure]
+	h.scoreTime = now
+	h.Failures++
+	h.LastFailure = failure
+	h.LastFailureTime = now
+
+	if h.Score < blacklistScoreThreshold || h.IsBlacklisted() {
+		return false
+	}
+
+	duration := blacklistBaseDuration * time.Duration(1<<uint(h.blacklists))
+	if duration > blacklistMaxDuration || duration <= 0 {
+		duration = blacklistMaxDuration
+	} else {
+		h.blacklists++
+	}
+	h.BlacklistedUntil = now.Add(duration)
+	return true
+}
+
+// OnSuccess registers successful connection to the host:
+// the host is removed from the blacklist and its score is decreased by half
+func (t *Tracker) OnSuccess(host string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	h, ok := t.hosts[host]
+	if !ok {
+		return
+	}
+
+	now := time.Now()
+	h.Score = h.currentScore(now) / 2
+	h.scoreTime = now
+	h.BlacklistedUntil = time.Time{}
+	h.blacklists = 0
+}
+
+// Score returns current failure score of the host (0 - healthy host)
+func (t *Tracker) Score(host string) float64 {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if h, ok := t.hosts[host]; ok {
+		return h.currentScore(time.Now())
+	}
+	return 0
+}
+
+// IsBlacklisted returns 'true' when the host is temporarily blacklisted
+func (t *Tracker) IsBlacklisted(host string) bool {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if h, ok := t.hosts[host]; ok {
+		return h.IsBlacklisted()
+	}
+	return false
+}
+
+// Scores returns health information for all hosts which had failures (sorted by score: the worst first)
+func (t *Tracker) Scores() []HostScore {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := time.Now()
+	ret := make([]HostScore, 0, len(t.hosts))
+	for key, h := range t.hosts {
+		score := h.currentScore(now)
+		if score < scoreForgetThreshold && !h.IsBlacklisted() {
+			delete(t.hosts, key)
+			continue
+		}
+		s := h.HostScore
+		s.Score = score
+		if !s.IsBlacklisted() {
+			s.BlacklistedUntil = time.Time{}
+		}
+		ret = append(ret, s)
+	}
+
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Score > ret[j].Score })
+	return ret
+}
+
+// Prioritize returns the indexes of the healthy hosts (the hosts are defined by 'getHost' function for indexes [0, count)).
+// Blacklisted hosts are excluded. If all hosts are blacklisted - the hosts with the lowest score are returned
+// (it is better to try an unreliable host than to do nothing).
+func (t *Tracker) Prioritize(count int, getHost func(idx int) string) []int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := time.Now()
+	var healthy []int
+	minScore := math.MaxFloat64
+	scores := make([]float64, count)
+	for i := 0; i < count; i++ {
+		h, ok := t.hosts[getHost(i)]
+		if ok {
+			scores[i] = h.currentScore(now)
+		}
+		if !ok || !h.IsBlacklisted() {
+			healthy = append(healthy, i)
+		}
+		if scores[i] < minScore {
+			minScore = scores[i]
+		}
+	}
+
+	if len(healthy) > 0 {
+		return healthy
+	}
+
+	var ret []int
+	for i := 0; i < count; i++ {
+		if scores[i] <= minScore {
+			ret = append(ret, i)
+		}
+	}
+	return ret
+}
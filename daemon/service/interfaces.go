@@ -27,6 +27,10 @@ import (
 	"time"
 
 	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/operations"
+	"github.com/ivpn/desktop-app/daemon/service/captiveportal"
+	"github.com/ivpn/desktop-app/daemon/service/connstats"
+	"github.com/ivpn/desktop-app/daemon/service/portforwarding"
 	"github.com/ivpn/desktop-app/daemon/service/preferences"
 	service_types "github.com/ivpn/desktop-app/daemon/service/types"
 	"github.com/ivpn/desktop-app/daemon/service/wgkeys"
@@ -72,11 +76,20 @@ type IServiceEventsReceiver interface {
 	OnServiceSessionChanged()
 	OnAccountStatus(sessionToken string, account preferences.AccountStatus)
 	OnKillSwitchStateChanged()
-	OnWiFiChanged(ssid string, isInsecureNetwork bool)
+	OnWiFiChanged(ssid string, bssid string, isInsecureNetwork bool)
 	OnPingStatus(retMap map[string]int)
 	OnServersUpdated(*api_types.ServersInfoResponse)
 	OnSplitTunnelStatusChanged()
 	OnVpnStateChanged(state vpn.StateInfo)
+	OnOperationStatus(status operations.Status)
+	// OnClockSkewDetected - the local clock differs from the API server time ('offset' - API server time minus local time)
+	OnClockSkewDetected(offset time.Duration, isTimeHintAllowed bool)
+	OnPortForwardingChanged(state portforwarding.State)
+	OnCaptivePortalStatus(status captiveportal.Status)
+	OnDataUsageAlert(alert connstats.DataUsageAlert)
+	OnConnectionQuality(report connstats.QualityReport)
+	// OnReloginRequired - the session is not valid anymore and it can not be renewed silently (the daemon is logged out)
+	OnReloginRequired(apiStatus int, apiErrorMsg string)
 
 	// called by a service when new connection is required (e.g. requested by 'trusted-wifi' functionality or 'auto-connect' on launch)
 	RegisterConnectionRequest(params service_types.ConnectionParams) error
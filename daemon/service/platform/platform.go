@@ -45,6 +45,11 @@ var (
 	servicePortFile string
 	serversFile     string
 	logFile         string
+	auditLogFile    string
+
+	// local socket to serve the client protocol: unix domain socket file (Linux, macOS) or named pipe (Windows)
+	// (empty - not supported on this platform)
+	serviceSocketFile string
 
 	openVpnBinaryPath     string
 	openvpnCaKeyFile      string
@@ -57,12 +62,25 @@ var (
 
 	obfsproxyStartScript string
 
+	v2rayBinaryPath string
+	v2rayConfigFile string
+
+	shadowsocksBinaryPath string
+	shadowsocksConfigFile string
+
 	routeCommand string // Example: "/sbin/route" - for macOS, "/sbin/ip route" - for Linux, "C:\\Windows\\System32\\ROUTE.EXE" - for Windows
 
 	wgBinaryPath     string
 	wgToolBinaryPath string
 	wgConfigFilePath string
 
+	// AmneziaWG binaries (WireGuard with obfuscated handshake; see package 'awg')
+	awgBinaryPath     string
+	awgToolBinaryPath string
+
+	// post-quantum KEM helper (negotiation of WireGuard preshared key; see package 'kem')
+	kemHelperBinaryPath string
+
 	dnscryptproxyBinPath        string
 	dnscryptproxyConfigTemplate string
 	dnscryptproxyConfig         string
@@ -138,6 +156,15 @@ func Init() (warnings []string, errors []error, logInfo []string) {
 	if err := checkFileAccessRightsExecutable("obfsproxyStartScript", obfsproxyStartScript); err != nil {
 		warnings = append(warnings, fmt.Errorf("obfsproxy functionality not accessible: %w", err).Error())
 	}
+	// checking availability of V2Ray binaries
+	if err := checkFileAccessRightsExecutable("v2rayBinaryPath", v2rayBinaryPath); err != nil {
+		warnings = append(warnings, fmt.Errorf("V2Ray functionality not accessible: %w", err).Error())
+	}
+
+	// checking availability of Shadowsocks client binaries
+	if err := checkFileAccessRightsExecutable("shadowsocksBinaryPath", shadowsocksBinaryPath); err != nil {
+		warnings = append(warnings, fmt.Errorf("Shadowsocks functionality not accessible: %w", err).Error())
+	}
 	// checling availability of WireGuard binaries
 	if err := checkFileAccessRightsExecutable("wgBinaryPath", wgBinaryPath); err != nil {
 		warnings = append(warnings, fmt.Errorf("WireGuard functionality not accessible: %w", err).Error())
@@ -244,6 +271,11 @@ func ServicePortFile() string {
 	return servicePortFile
 }
 
+// ServiceSocketFile path to the local socket of the service: unix domain socket file or named pipe (empty if not supported on this platform)
+func ServiceSocketFile() string {
+	return serviceSocketFile
+}
+
 // ParanoidModeSecretFile path to a file which contains 'secret' (password) for 'Paranoid mode'
 // If 'paranoid mode' enabled - this 'secret' must be used in each request to a daemon.
 // This file should be accessible to read only for 'privilaged' user
@@ -261,10 +293,25 @@ func LogFile() string {
 	return logFile
 }
 
+// AuditLogFile path to the audit log (security-relevant daemon actions)
+func AuditLogFile() string {
+	return auditLogFile
+}
+
 func LogDir() string {
 	return filepath.Dir(logFile)
 }
 
+// ConnectionStatsFile path to the file with the connection statistics history
+func ConnectionStatsFile() string {
+	return filepath.Join(filepath.Dir(settingsFile), "connection_stats.json")
+}
+
+// CrashReportsDir path to the directory with crash reports (recovered panics)
+func CrashReportsDir() string {
+	return filepath.Join(LogDir(), "crash")
+}
+
 // OpenVpnBinaryPath path to openvpn binary
 func OpenVpnBinaryPath() string {
 	return openVpnBinaryPath
@@ -310,6 +357,26 @@ func ObfsproxyStartScript() string {
 	return obfsproxyStartScript
 }
 
+// V2RayBinaryPath path to V2Ray binary
+func V2RayBinaryPath() string {
+	return v2rayBinaryPath
+}
+
+// V2RayConfigFile path to V2Ray configuration file
+func V2RayConfigFile() string {
+	return v2rayConfigFile
+}
+
+// ShadowsocksBinaryPath path to Shadowsocks client binary (sslocal)
+func ShadowsocksBinaryPath() string {
+	return shadowsocksBinaryPath
+}
+
+// ShadowsocksConfigFile path to Shadowsocks client configuration file
+func ShadowsocksConfigFile() string {
+	return shadowsocksConfigFile
+}
+
 // RouteCommand shell command to update routing table
 // Example: "/sbin/route" - for macOS, "/sbin/ip route" - for Linux, "C:\\Windows\\System32\\ROUTE.EXE" - for Windows
 func RouteCommand() string {
@@ -326,6 +393,21 @@ func WgToolBinaryPath() string {
 	return wgToolBinaryPath
 }
 
+// AwgBinaryPath path to AmneziaWG binary (the same interface as WgBinaryPath)
+func AwgBinaryPath() string {
+	return awgBinaryPath
+}
+
+// AwgToolBinaryPath path to AmneziaWG tools binary (the same interface as WgToolBinaryPath)
+func AwgToolBinaryPath() string {
+	return awgToolBinaryPath
+}
+
+// KemHelperBinaryPath path to the post-quantum KEM helper binary
+func KemHelperBinaryPath() string {
+	return kemHelperBinaryPath
+}
+
 // WGConfigFilePath path to WireGuard configuration file
 func WGConfigFilePath() string {
 	return wgConfigFilePath
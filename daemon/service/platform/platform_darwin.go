@@ -23,22 +23,26 @@
 package platform
 
 import (
+	"fmt"
 	"path"
 )
 
 var (
 	firewallScript string
 	dnsScript      string
+	splitTunScript string
 )
 
 // initialize all constant values (e.g. servicePortFile) which can be used in external projects (IVPN CLI)
 func doInitConstants() {
 	servicePortFile = "/Library/Application Support/IVPN/port.txt"
+	serviceSocketFile = "/Library/Application Support/IVPN/ivpn.sock"
 	openvpnUserParamsFile = "/Library/Application Support/IVPN/OpenVPN/ovpn_extra_params.txt"
 	paranoidModeSecretFile = "/Library/Application Support/IVPN/eaa"
 
 	logDir := "/Library/Logs/"
 	logFile = path.Join(logDir, "IVPN Agent.log")
+	auditLogFile = path.Join(logDir, "IVPN Agent audit.log")
 }
 
 func doOsInit() (warnings []string, errors []error, logInfo []string) {
@@ -56,6 +60,11 @@ func doOsInit() (warnings []string, errors []error, logInfo []string) {
 	if err := checkFileAccessRightsExecutable("dnsScript", dnsScript); err != nil {
 		errors = append(errors, err)
 	}
+	if err := checkFileAccessRightsExecutable("splitTunScript", splitTunScript); err != nil {
+		// not critical: the daemon is able to work without Split-Tunnel functionality
+		splitTunScript = ""
+		warnings = append(warnings, fmt.Errorf("Split-Tunnel functionality not accessible: %w", err).Error())
+	}
 
 	return warnings, errors, logInfo
 }
@@ -69,3 +78,8 @@ func FirewallScript() string {
 func DNSScript() string {
 	return dnsScript
 }
+
+// SplitTunScript returns path to script which control split-tunneling functionality
+func SplitTunScript() string {
+	return splitTunScript
+}
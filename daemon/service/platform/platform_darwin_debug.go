@@ -57,6 +57,7 @@ func doOsInitForBuild() (warnings []string, errors []error) {
 	// macOS-specific variable initialization
 	firewallScript = path.Join(installDir, "References/macOS/etc/firewall.sh")
 	dnsScript = path.Join(installDir, "References/macOS/etc/dns.sh")
+	splitTunScript = path.Join(installDir, "References/macOS/etc/splittun.sh")
 
 	// common variables initialization
 	settingsDir := "/Library/Application Support/IVPN"
@@ -73,9 +74,16 @@ func doOsInitForBuild() (warnings []string, errors []error) {
 	openvpnDownScript = path.Join(installDir, "References/macOS/etc/dns.sh -down")
 
 	obfsproxyStartScript = path.Join(installDir, "References/macOS/_deps/obfs4proxy_inst/obfs4proxy")
+	v2rayBinaryPath = path.Join(installDir, "References/macOS/_deps/v2ray_inst/v2ray")
+	v2rayConfigFile = path.Join(settingsDir, "v2ray.json")
+	shadowsocksBinaryPath = path.Join(installDir, "References/macOS/_deps/shadowsocks_inst/sslocal")
+	shadowsocksConfigFile = path.Join(settingsDir, "shadowsocks.json")
 
 	wgBinaryPath = path.Join(installDir, "References/macOS/_deps/wg_inst/wireguard-go")
 	wgToolBinaryPath = path.Join(installDir, "References/macOS/_deps/wg_inst/wg")
+	awgBinaryPath = path.Join(installDir, "References/macOS/_deps/awg_inst/amneziawg-go")
+	awgToolBinaryPath = path.Join(installDir, "References/macOS/_deps/awg_inst/awg")
+	kemHelperBinaryPath = path.Join(installDir, "References/macOS/_deps/kem-helper_inst/kem-helper")
 
 	dnscryptproxyBinPath = path.Join(installDir, "References/macOS/_deps/dnscryptproxy_inst/dnscrypt-proxy")
 	dnscryptproxyConfigTemplate = path.Join(installDir, "References/common/etc/dnscrypt-proxy-template.toml")
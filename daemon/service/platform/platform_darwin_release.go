@@ -43,6 +43,7 @@ func doOsInitForBuild() (warnings []string, errors []error) {
 	// macOS-specific variable initialization
 	firewallScript = "/Applications/IVPN.app/Contents/Resources/etc/firewall.sh"
 	dnsScript = "/Applications/IVPN.app/Contents/Resources/etc/dns.sh"
+	splitTunScript = "/Applications/IVPN.app/Contents/Resources/etc/splittun.sh"
 
 	// common variables initialization
 	settingsDir := "/Library/Application Support/IVPN"
@@ -59,9 +60,16 @@ func doOsInitForBuild() (warnings []string, errors []error) {
 	openvpnDownScript = "/Applications/IVPN.app/Contents/Resources/etc/dns.sh -down"
 
 	obfsproxyStartScript = "/Applications/IVPN.app/Contents/Resources/obfsproxy/obfs4proxy"
+	v2rayBinaryPath = "/Applications/IVPN.app/Contents/Resources/v2ray/v2ray"
+	v2rayConfigFile = path.Join(settingsDir, "v2ray.json")
+	shadowsocksBinaryPath = "/Applications/IVPN.app/Contents/Resources/shadowsocks/sslocal"
+	shadowsocksConfigFile = path.Join(settingsDir, "shadowsocks.json")
 
 	wgBinaryPath = "/Applications/IVPN.app/Contents/MacOS/WireGuard/wireguard-go"
 	wgToolBinaryPath = "/Applications/IVPN.app/Contents/MacOS/WireGuard/wg"
+	awgBinaryPath = "/Applications/IVPN.app/Contents/MacOS/AmneziaWG/amneziawg-go"
+	awgToolBinaryPath = "/Applications/IVPN.app/Contents/MacOS/AmneziaWG/awg"
+	kemHelperBinaryPath = "/Applications/IVPN.app/Contents/MacOS/kem-helper"
 
 	dnscryptproxyBinPath = "/Applications/IVPN.app/Contents/MacOS/dnscrypt-proxy/dnscrypt-proxy"
 	dnscryptproxyConfigTemplate = "/Applications/IVPN.app/Contents/Resources/etc/dnscrypt-proxy-template.toml"
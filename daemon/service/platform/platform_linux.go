@@ -100,9 +100,11 @@ func doInitConstants() {
 
 	serversFile = path.Join(tmpDir, "servers.json")
 	servicePortFile = path.Join(tmpDir, "port.txt")
+	serviceSocketFile = path.Join(tmpDir, "ivpn.sock")
 	paranoidModeSecretFile = path.Join(tmpDir, "eaa")
 
 	logFile = path.Join(logDir, "IVPN_Agent.log")
+	auditLogFile = path.Join(logDir, "IVPN_Agent_audit.log")
 
 	openvpnUserParamsFile = path.Join(tmpDir, "ovpn_extra_params.txt")
 }
@@ -150,7 +152,9 @@ func doOsInit() (warnings []string, errors []error, logInfo []string) {
 		errors = append(errors, err)
 	}
 	if err := checkFileAccessRightsExecutable("splitTunScript", splitTunScript); err != nil {
-		errors = append(errors, err)
+		// not critical: the daemon is able to work without Split-Tunnel functionality
+		splitTunScript = ""
+		warnings = append(warnings, fmt.Errorf("Split-Tunnel functionality not accessible: %w", err).Error())
 	}
 
 	return warnings, errors, logInfo
@@ -67,9 +67,16 @@ func doOsInitForBuild() (warnings []string, errors []error, logInfo []string) {
 	serversFileBundled = path.Join(etcDirCommon, "servers.json")
 
 	obfsproxyStartScript = path.Join(installDir, "_deps/obfs4proxy_inst/obfs4proxy")
+	v2rayBinaryPath = path.Join(installDir, "_deps/v2ray_inst/v2ray")
+	v2rayConfigFile = path.Join(tmpDir, "v2ray.json")
+	shadowsocksBinaryPath = path.Join(installDir, "_deps/shadowsocks_inst/sslocal")
+	shadowsocksConfigFile = path.Join(tmpDir, "shadowsocks.json")
 
 	wgBinaryPath = path.Join(installDir, "_deps/wireguard-tools_inst/wg-quick")
 	wgToolBinaryPath = path.Join(installDir, "_deps/wireguard-tools_inst/wg")
+	awgBinaryPath = path.Join(installDir, "_deps/amneziawg-tools_inst/awg-quick")
+	awgToolBinaryPath = path.Join(installDir, "_deps/amneziawg-tools_inst/awg")
+	kemHelperBinaryPath = path.Join(installDir, "_deps/kem-helper_inst/kem-helper")
 
 	dnscryptproxyBinPath = path.Join(installDir, "_deps/dnscryptproxy_inst/dnscrypt-proxy")
 	dnscryptproxyConfigTemplate = path.Join(etcDirCommon, "dnscrypt-proxy-template.toml")
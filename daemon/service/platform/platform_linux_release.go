@@ -51,9 +51,16 @@ func doOsInitForBuild() (warnings []string, errors []error, logInfo []string) {
 	serversFileBundled = path.Join(installDir, "etc/servers.json")
 
 	obfsproxyStartScript = path.Join(installDir, "obfsproxy/obfs4proxy")
+	v2rayBinaryPath = path.Join(installDir, "v2ray/v2ray")
+	v2rayConfigFile = path.Join(tmpDir, "v2ray.json")
+	shadowsocksBinaryPath = path.Join(installDir, "shadowsocks/sslocal")
+	shadowsocksConfigFile = path.Join(tmpDir, "shadowsocks.json")
 
 	wgBinaryPath = path.Join(installDir, "wireguard-tools/wg-quick")
 	wgToolBinaryPath = path.Join(installDir, "wireguard-tools/wg")
+	awgBinaryPath = path.Join(installDir, "amneziawg-tools/awg-quick")
+	awgToolBinaryPath = path.Join(installDir, "amneziawg-tools/awg")
+	kemHelperBinaryPath = path.Join(installDir, "kem/kem-helper")
 
 	dnscryptproxyBinPath = path.Join(installDir, "dnscrypt-proxy/dnscrypt-proxy")
 	dnscryptproxyConfigTemplate = path.Join(installDir, "etc/dnscrypt-proxy-template.toml")
@@ -45,8 +45,10 @@ func doInitConstants() {
 		// debug version can have different port file value
 		fmt.Println("!!! WARNING !!! Non-standard service port file: ", servicePortFile)
 	}
+	serviceSocketFile = `\\.\pipe\IVPN_Service`
 
 	logFile = path.Join(installDir, "log/IVPN Agent.log")
+	auditLogFile = path.Join(installDir, "log/IVPN Agent audit.log")
 
 	openvpnUserParamsFile = path.Join(installDir, "mutable/ovpn_extra_params.txt")
 	paranoidModeSecretFile = path.Join(installDir, "etc/eaa") // file located in 'etc' will not be removed during app upgrade
@@ -95,6 +97,10 @@ func doOsInit() (warnings []string, errors []error, logInfo []string) {
 	openvpnDownScript = ""
 
 	obfsproxyStartScript = path.Join(_installDir, "OpenVPN", "obfsproxy", "obfs4proxy.exe")
+	v2rayBinaryPath = path.Join(_installDir, "v2ray", "v2ray.exe")
+	v2rayConfigFile = path.Join(_installDir, "v2ray", "v2ray.json")
+	shadowsocksBinaryPath = path.Join(_installDir, "shadowsocks", "sslocal.exe")
+	shadowsocksConfigFile = path.Join(_installDir, "shadowsocks", "shadowsocks.json")
 
 	_wgArchDir := "x86_64"
 	if _, err := os.Stat(path.Join(_installDir, "WireGuard", _wgArchDir, "wireguard.exe")); err != nil {
@@ -105,6 +111,9 @@ func doOsInit() (warnings []string, errors []error, logInfo []string) {
 	}
 	wgBinaryPath = path.Join(_installDir, "WireGuard", _wgArchDir, "wireguard.exe")
 	wgToolBinaryPath = path.Join(_installDir, "WireGuard", _wgArchDir, "wg.exe")
+	awgBinaryPath = path.Join(_installDir, "AmneziaWG", _wgArchDir, "amneziawg.exe")
+	awgToolBinaryPath = path.Join(_installDir, "AmneziaWG", _wgArchDir, "awg.exe")
+	kemHelperBinaryPath = path.Join(_installDir, "kem", "kem-helper.exe")
 
 	dnscryptproxyBinPath = path.Join(_installDir, "dnscrypt-proxy/dnscrypt-proxy.exe")
 	dnscryptproxyConfigTemplate = path.Join(settingsDirCommon, "dnscrypt-proxy-template.toml")
@@ -0,0 +1,283 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package portforwarding manages the port forwarded by the VPN server to the current VPN connection:
+// requests the port from the API, keeps it renewed and releases it.
+//
+// Note: no additional firewall rules are required for the forwarded port.
+// While the VPN is connected, the firewall allows all inbound communication
+// on the VPN interface (see firewall.ClientConnected()).
+package portforwarding
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/logger"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("prtfwd")
+}
+
+const (
+	// maximum interval to check the necessity to renew the port
+	// (we can not trust "time.After()": after the computer wakes up it triggers after [sleep time]+[time])
+	maxCheckInterval = time.Minute
+	// delay before the next try when the renewal failed
+	retryInterval = time.Minute
+)
+
+// State - the forwarded port state
+type State struct {
+	IsActive  bool
+	Port      int
+	ExpiresAt int64 // (Unix time) when the port expires if not renewed
+	// the last error (e.g. the renewal failed)
+	Error string
+}
+
+// IPortForwardingReceiver - the master service
+type IPortForwardingReceiver interface {
+	Connected() bool
+	IsConnectivityBlocked() (err error) // IsConnectivityBlocked - returns nil if connectivity NOT blocked
+	OnPortForwardingChanged(state State)
+	OnSessionNotFound()
+}
+
+// IPortForwardingApi - API requests for the forwarded port
+// (the interface is in use to avoid import cycles: protocol types depend on this package)
+type IPortForwardingApi interface {
+	PortForwardingRequest(session string, port int) (forwardedPort int, lifetime time.Duration, err error)
+	PortForwardingRelease(session string, port int) error
+}
+
+// CreateManager creates port forwarding manager
+func CreateManager(apiObj IPortForwardingApi) *Manager {
+	return &Manager{api: apiObj}
+}
+
+// Manager - port forwarding manager
+type Manager struct {
+	mutex   sync.Mutex
+	service IPortForwardingReceiver
+	api     IPortForwardingApi
+
+	session   string
+	state     State
+	renewAt   time.Time
+	stopRenew chan struct{}
+}
+
+// Init - initialize master service
+func (m *Manager) Init(receiver IPortForwardingReceiver) error {
+	if receiver == nil || m.service != nil {
+		return fmt.Errorf("failed to initialize port forwarding manager")
+	}
+	m.service = receiver
+	return nil
+}
+
+// State returns the current state of the forwarded port
+func (m *Manager) State() State {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.state
+}
+
+// Request requests forwarded port for the current VPN connection and keeps it renewed.
+// If the port is already active - returns its state.
+func (m *Manager) Request(session string) (State, error) {
+	if m.service == nil {
+		return State{}, fmt.Errorf("port forwarding manager not initialized")
+	}
+	if !m.service.Connected() {
+		return State{}, fmt.Errorf("port forwarding is available only when VPN is connected")
+	}
+	if len(session) == 0 {
+		return State{}, fmt.Errorf("not logged in")
+	}
+	if err := m.service.IsConnectivityBlocked(); err != nil {
+		return State{}, err
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.state.IsActive {
+		return m.state, nil
+	}
+
+	port, lifetime, err := m.api.PortForwardingRequest(session, 0)
+	if err != nil {
+		if api_types.IsSessionNotFound(err) {
+			m.service.OnSessionNotFound()
+		}
+		return State{}, fmt.Errorf("failed to request forwarded port: %w", err)
+	}
+
+	log.Info(fmt.Sprintf("Forwarded port: %d (expires in %v)", port, lifetime))
+
+	m.session = session
+	m.setPort(port, lifetime)
+	m.stopRenew = make(chan struct{})
+	go m.renewRoutine(m.stopRenew)
+
+	m.notify()
+	return m.state, nil
+}
+
+// Release releases the forwarded port (if active)
+func (m *Manager) Release() error {
+	m.mutex.Lock()
+	if !m.state.IsActive {
+		m.mutex.Unlock()
+		return nil
+	}
+	session, port := m.session, m.state.Port
+	m.reset()
+	m.mutex.Unlock()
+
+	if err := m.api.PortForwardingRelease(session, port); err != nil {
+		return fmt.Errorf("failed to release forwarded port: %w", err)
+	}
+	log.Info(fmt.Sprintf("Forwarded port %d released", port))
+	return nil
+}
+
+// OnDisconnected must be called when VPN disconnected: the forwarded port is not valid anymore
+func (m *Manager) OnDisconnected() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.state.IsActive {
+		return
+	}
+
+	session, port := m.session, m.state.Port
+	m.reset()
+
+	// inform the API server that the port is not in use anymore (the port will expire anyway)
+	go func() {
+		if err := m.api.PortForwardingRelease(session, port); err != nil {
+			log.Warning(fmt.Errorf("failed to release forwarded port %d: %w", port, err))
+		}
+	}()
+}
+
+// setPort updates the state with the new port info (must be called under locked mutex)
+func (m *Manager) setPort(port int, lifetime time.Duration) {
+	now := time.Now()
+	m.state = State{IsActive: true, Port: port, ExpiresAt: now.Add(lifetime).Unix()}
+	// renew when a half of the port lifetime left
+	m.renewAt = now.Add(lifetime / 2)
+}
+
+// reset stops the renewal and clears the state (must be called under locked mutex)
+func (m *Manager) reset() {
+	if m.stopRenew != nil {
+		close(m.stopRenew)
+		m.stopRenew = nil
+	}
+	m.session = ""
+	m.state = State{}
+	m.notify()
+}
+
+func (m *Manager) notify() {
+	if m.service != nil {
+		go m.service.OnPortForwardingChanged(m.state)
+	}
+}
+
+func (m *Manager) renewRoutine(stop <-chan struct{}) {
+	log.Info("Port renewal started")
+	defer log.Info("Port renewal stopped")
+
+	for {
+		m.mutex.Lock()
+		waitInterval := time.Until(m.renewAt)
+		m.mutex.Unlock()
+
+		if waitInterval > maxCheckInterval {
+			waitInterval = maxCheckInterval
+		}
+		if waitInterval > 0 {
+			select {
+			case <-stop:
+				return
+			case <-time.After(waitInterval):
+			}
+		}
+
+		m.mutex.Lock()
+		if !m.state.IsActive {
+			m.mutex.Unlock()
+			return
+		}
+		if time.Now().Before(m.renewAt) {
+			m.mutex.Unlock()
+			continue // not a time to renew
+		}
+		session, port, expiresAt := m.session, m.state.Port, time.Unix(m.state.ExpiresAt, 0)
+		m.mutex.Unlock()
+
+		newPort, lifetime, err := m.api.PortForwardingRequest(session, port)
+
+		m.mutex.Lock()
+		select {
+		case <-stop: // stopped while the request was in progress
+			m.mutex.Unlock()
+			return
+		default:
+		}
+
+		if err != nil {
+			log.Warning(fmt.Errorf("failed to renew forwarded port %d: %w", port, err))
+			if api_types.IsSessionNotFound(err) {
+				m.service.OnSessionNotFound()
+			}
+			m.state.Error = err.Error()
+			m.renewAt = time.Now().Add(retryInterval)
+			if time.Now().After(expiresAt) {
+				log.Error(fmt.Sprintf("Forwarded port %d expired", port))
+				m.state = State{Error: fmt.Sprintf("forwarded port %d expired (%s)", port, err)}
+				m.session = ""
+				m.stopRenew = nil
+				m.notify()
+				m.mutex.Unlock()
+				return
+			}
+		} else {
+			if newPort != port {
+				log.Warning(fmt.Sprintf("Forwarded port changed: %d -> %d", port, newPort))
+			}
+			m.setPort(newPort, lifetime)
+		}
+		m.notify()
+		m.mutex.Unlock()
+	}
+}
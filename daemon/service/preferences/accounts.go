@@ -0,0 +1,109 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AccountsMaxItems - max number of the logged-in accounts (including the active one)
+const AccountsMaxItems = 10
+
+// StoredAccount - the logged-in account which is not active at the moment.
+// Its session is kept to allow switching between accounts without logout/login.
+type StoredAccount struct {
+	Session SessionStatus
+	Account AccountStatus
+}
+
+// FindStoredAccount returns the stored (not active) account by account ID (case-insensitive)
+func (p *Preferences) FindStoredAccount(accountID string) (StoredAccount, bool) {
+	accountID = strings.TrimSpace(accountID)
+	for _, a := range p.StoredAccounts {
+		if strings.EqualFold(a.Session.AccountID, accountID) {
+			return a, true
+		}
+	}
+	return StoredAccount{}, false
+}
+
+// StashActiveSession moves the active session to the list of stored accounts.
+// The active session becomes empty (logged out locally; the session stays valid on the backend).
+func (p *Preferences) StashActiveSession() error {
+	if !p.Session.IsLoggedIn() {
+		return fmt.Errorf("not logged in")
+	}
+
+	stored := StoredAccount{Session: p.Session, Account: p.Account}
+	accounts := p.storedAccountsExcept(stored.Session.AccountID)
+	if len(accounts)+1 >= AccountsMaxItems {
+		return fmt.Errorf("max number of logged-in accounts reached (%d)", AccountsMaxItems)
+	}
+
+	p.StoredAccounts = append(accounts, stored)
+	p.Account = AccountStatus{}
+	p.setSession("", "", "", "", "", "", "")
+	return nil
+}
+
+// ActivateStoredAccount makes the stored account active.
+// The active session (if any) is moved to the list of stored accounts.
+func (p *Preferences) ActivateStoredAccount(accountID string) error {
+	stored, ok := p.FindStoredAccount(accountID)
+	if !ok {
+		return fmt.Errorf("account '%s' not found", accountID)
+	}
+
+	accounts := p.storedAccountsExcept(stored.Session.AccountID)
+	if p.Session.IsLoggedIn() {
+		accounts = append(accounts, StoredAccount{Session: p.Session, Account: p.Account})
+	}
+
+	p.StoredAccounts = accounts
+	p.Session = stored.Session
+	p.Account = stored.Account
+	return nil
+}
+
+// RemoveStoredAccount removes the stored (not active) account
+func (p *Preferences) RemoveStoredAccount(accountID string) (StoredAccount, error) {
+	stored, ok := p.FindStoredAccount(accountID)
+	if !ok {
+		return StoredAccount{}, fmt.Errorf("account '%s' not found", accountID)
+	}
+	p.StoredAccounts = p.storedAccountsExcept(stored.Session.AccountID)
+	return stored, nil
+}
+
+// storedAccountsExcept returns a new slice of stored accounts without the specified account
+// (do not modify the underlying array which can be shared with copies of Preferences object)
+func (p *Preferences) storedAccountsExcept(accountID string) []StoredAccount {
+	ret := make([]StoredAccount, 0, len(p.StoredAccounts)+1)
+	for _, a := range p.StoredAccounts {
+		if !strings.EqualFold(a.Session.AccountID, accountID) {
+			ret = append(ret, a)
+		}
+	}
+	return ret
+}
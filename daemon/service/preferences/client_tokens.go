@@ -0,0 +1,137 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"strings"
+)
+
+// ClientTokensMaxItems - max number of client access tokens
+const ClientTokensMaxItems = 32
+
+// ClientTokenNameMaxLen - max length of the client access token name
+const ClientTokenNameMaxLen = 64
+
+// ClientAccessScope - access level of the client of the daemon
+type ClientAccessScope string
+
+const (
+	// ClientScopeFull - full control (default for the clients which are authenticated by the daemon secret or by the local socket permissions)
+	ClientScopeFull ClientAccessScope = "full"
+	// ClientScopeReadOnly - the client is able only to read the status (e.g. monitoring clients)
+	ClientScopeReadOnly ClientAccessScope = "read"
+)
+
+// IsValid returns 'true' if the scope is known
+func (s ClientAccessScope) IsValid() bool {
+	return s == ClientScopeFull || s == ClientScopeReadOnly
+}
+
+// ClientToken - named access token for the clients of the daemon (daemon protocol and REST API).
+// The client which is authenticated by the token gets the access level defined by 'Scope'.
+type ClientToken struct {
+	Name  string
+	Token string
+	Scope ClientAccessScope
+}
+
+// Validate checks if the client token can be saved
+func (t ClientToken) Validate() error {
+	name := strings.TrimSpace(t.Name)
+	if len(name) == 0 {
+		return fmt.Errorf("token name is empty")
+	}
+	if len(name) > ClientTokenNameMaxLen {
+		return fmt.Errorf("token name is too long (max %d characters)", ClientTokenNameMaxLen)
+	}
+	if strings.ContainsAny(name, "\n\r\t") {
+		return fmt.Errorf("token name contains unsupported characters")
+	}
+	if !t.Scope.IsValid() {
+		return fmt.Errorf("unknown access scope '%s' (expected '%s' or '%s')", t.Scope, ClientScopeReadOnly, ClientScopeFull)
+	}
+	if len(t.Token) == 0 {
+		return fmt.Errorf("token is empty")
+	}
+	return nil
+}
+
+// GenerateClientToken returns new random value for the client access token
+func GenerateClientToken() (string, error) {
+	return generateAccessToken()
+}
+
+// FindClientToken returns the client token info by the token value
+func (p *Preferences) FindClientToken(token string) (ClientToken, bool) {
+	if len(token) == 0 {
+		return ClientToken{}, false
+	}
+	for _, t := range p.ClientTokens {
+		if subtle.ConstantTimeCompare([]byte(t.Token), []byte(token)) == 1 {
+			return t, true
+		}
+	}
+	return ClientToken{}, false
+}
+
+// AddClientToken adds new client token (the name must be unique, case-insensitive)
+func (p *Preferences) AddClientToken(t ClientToken) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	t.Name = strings.TrimSpace(t.Name)
+
+	for _, ct := range p.ClientTokens {
+		if strings.EqualFold(ct.Name, t.Name) {
+			return fmt.Errorf("token '%s' already exists", ct.Name)
+		}
+	}
+	if len(p.ClientTokens) >= ClientTokensMaxItems {
+		return fmt.Errorf("unable to add token: max number of tokens reached (%d)", ClientTokensMaxItems)
+	}
+
+	// creating new slice (do not modify the underlying array which can be shared with copies of Preferences object)
+	tokens := make([]ClientToken, 0, len(p.ClientTokens)+1)
+	tokens = append(tokens, p.ClientTokens...)
+	p.ClientTokens = append(tokens, t)
+	return nil
+}
+
+// RemoveClientToken removes the client token by name (case-insensitive)
+func (p *Preferences) RemoveClientToken(name string) error {
+	name = strings.TrimSpace(name)
+	tokens := make([]ClientToken, 0, len(p.ClientTokens))
+	for _, t := range p.ClientTokens {
+		if !strings.EqualFold(t.Name, name) {
+			tokens = append(tokens, t)
+		}
+	}
+	if len(tokens) == len(p.ClientTokens) {
+		return fmt.Errorf("token '%s' not found", name)
+	}
+
+	p.ClientTokens = tokens
+	return nil
+}
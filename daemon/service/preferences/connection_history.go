@@ -0,0 +1,69 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"bytes"
+	"encoding/json"
+	"time"
+
+	service_types "github.com/ivpn/desktop-app/daemon/service/types"
+)
+
+// ConnectionHistoryMaxItems - max number of items kept in the connection history
+const ConnectionHistoryMaxItems = 10
+
+// ConnectionHistoryItem - connection parameters which were in use for connection
+type ConnectionHistoryItem struct {
+	Time   int64 // unix time (seconds) of the last connection with this parameters
+	Params service_types.ConnectionParams
+}
+
+// AddConnectionHistory adds connection parameters to the top of the connection history
+// (if the same parameters already exist in the history - they are moved to the top).
+// Nothing happens if the connection history is disabled.
+func (p *Preferences) AddConnectionHistory(params service_types.ConnectionParams) {
+	if p.IsConnectionHistoryDisabled || params.CheckIsDefined() != nil {
+		return
+	}
+
+	newItemData, err := json.Marshal(params)
+	if err != nil {
+		return
+	}
+
+	// creating new slice (do not modify the underlying array which can be shared with copies of Preferences object)
+	history := make([]ConnectionHistoryItem, 0, ConnectionHistoryMaxItems)
+	history = append(history, ConnectionHistoryItem{Time: time.Now().Unix(), Params: params})
+	for _, item := range p.ConnectionHistory {
+		if len(history) >= ConnectionHistoryMaxItems {
+			break
+		}
+		if data, err := json.Marshal(item.Params); err == nil && bytes.Equal(data, newItemData) {
+			continue // duplicate
+		}
+		history = append(history, item)
+	}
+
+	p.ConnectionHistory = history
+}
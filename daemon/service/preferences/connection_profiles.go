@@ -0,0 +1,117 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ivpn/desktop-app/daemon/obfsproxy"
+	service_types "github.com/ivpn/desktop-app/daemon/service/types"
+)
+
+// ConnectionProfilesMaxItems - max number of saved connection profiles
+const ConnectionProfilesMaxItems = 50
+
+// ConnectionProfileNameMaxLen - max length of the connection profile name
+const ConnectionProfileNameMaxLen = 64
+
+// ConnectionProfile - named connection configuration
+// (server, protocol, port, DNS, firewall options ... and obfuscation settings)
+type ConnectionProfile struct {
+	Name   string
+	Params service_types.ConnectionParams
+	// obfsproxy configuration (applicable only for OpenVPN connections)
+	Obfs4proxy obfsproxy.Config
+}
+
+// Validate checks if the profile can be saved
+func (cp ConnectionProfile) Validate() error {
+	name := strings.TrimSpace(cp.Name)
+	if len(name) == 0 {
+		return fmt.Errorf("profile name is empty")
+	}
+	if len(name) > ConnectionProfileNameMaxLen {
+		return fmt.Errorf("profile name is too long (max %d characters)", ConnectionProfileNameMaxLen)
+	}
+	if strings.ContainsAny(name, "\n\r\t") {
+		return fmt.Errorf("profile name contains unsupported characters")
+	}
+	return cp.Params.CheckIsDefined()
+}
+
+// FindConnectionProfile returns the connection profile by name (case-insensitive)
+func (p *Preferences) FindConnectionProfile(name string) (ConnectionProfile, bool) {
+	name = strings.TrimSpace(name)
+	for _, cp := range p.ConnectionProfiles {
+		if strings.EqualFold(cp.Name, name) {
+			return cp, true
+		}
+	}
+	return ConnectionProfile{}, false
+}
+
+// SaveConnectionProfile adds new connection profile or replaces the existing one with the same name
+func (p *Preferences) SaveConnectionProfile(profile ConnectionProfile) error {
+	if err := profile.Validate(); err != nil {
+		return err
+	}
+	profile.Name = strings.TrimSpace(profile.Name)
+
+	// creating new slice (do not modify the underlying array which can be shared with copies of Preferences object)
+	profiles := make([]ConnectionProfile, 0, len(p.ConnectionProfiles)+1)
+	isReplaced := false
+	for _, cp := range p.ConnectionProfiles {
+		if strings.EqualFold(cp.Name, profile.Name) {
+			cp = profile
+			isReplaced = true
+		}
+		profiles = append(profiles, cp)
+	}
+	if !isReplaced {
+		if len(profiles) >= ConnectionProfilesMaxItems {
+			return fmt.Errorf("unable to save profile: max number of profiles reached (%d)", ConnectionProfilesMaxItems)
+		}
+		profiles = append(profiles, profile)
+	}
+
+	p.ConnectionProfiles = profiles
+	return nil
+}
+
+// RemoveConnectionProfile removes the connection profile by name (case-insensitive)
+func (p *Preferences) RemoveConnectionProfile(name string) error {
+	name = strings.TrimSpace(name)
+	profiles := make([]ConnectionProfile, 0, len(p.ConnectionProfiles))
+	for _, cp := range p.ConnectionProfiles {
+		if !strings.EqualFold(cp.Name, name) {
+			profiles = append(profiles, cp)
+		}
+	}
+	if len(profiles) == len(p.ConnectionProfiles) {
+		return fmt.Errorf("profile '%s' not found", name)
+	}
+
+	p.ConnectionProfiles = profiles
+	return nil
+}
@@ -0,0 +1,37 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+// DataUsageAlertParams - thresholds of the traffic (received + sent) of the VPN connection.
+// The clients are notified when the threshold is exceeded (0 - the alert is disabled).
+type DataUsageAlertParams struct {
+	// Traffic of the current connection session (MB)
+	SessionThresholdMB uint64 `json:"sessionThresholdMB"`
+	// Traffic of all the connection sessions during the current day (MB; local time)
+	DayThresholdMB uint64 `json:"dayThresholdMB"`
+}
+
+// IsEnabled returns true when at least one of the thresholds is defined
+func (p DataUsageAlertParams) IsEnabled() bool {
+	return p.SessionThresholdMB > 0 || p.DayThresholdMB > 0
+}
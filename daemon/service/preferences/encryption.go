@@ -0,0 +1,232 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/ivpn/desktop-app/daemon/keyprotect"
+)
+
+// The encrypted preferences file contains only this object.
+// The preferences are encrypted by the data key (AES-256-GCM);
+// the data key is protected by the machine secret: it is kept in the OS secret store or protected by the hardware-bound key (keyprotect).
+type encryptedPreferencesFile struct {
+	EncryptedPreferences *encryptedPreferences
+}
+
+type encryptedPreferences struct {
+	// How the data key is protected: the name of the OS secret store or 'keyprotect'
+	KeyStorage string
+	// The data key protected by the hardware-bound key (only when KeyStorage is 'keyprotect')
+	KeyProtected string `json:",omitempty"`
+	Nonce        []byte
+	Data         []byte
+}
+
+const (
+	keyStorageKeyprotect = "keyprotect"
+	// The key of the data key in the OS secret store
+	dataKeySecretStoreKey = "settings-key"
+)
+
+// The suffix of the backup of the preferences file which can not be decrypted (see readPreferencesFile())
+const undecryptableFileSuffix = ".undecryptable"
+
+// ErrorNotDecrypted - the preferences file is encrypted but it can not be decrypted
+// (e.g. the encryption key is not available in the OS secret store anymore)
+type ErrorNotDecrypted struct {
+	Err error
+	// The copy of the encrypted preferences file (empty - failed to save the copy)
+	BackupFile string
+}
+
+func (e ErrorNotDecrypted) Error() string {
+	return e.Err.Error()
+}
+
+func (e ErrorNotDecrypted) Unwrap() error {
+	return e.Err
+}
+
+// The data key in use (it allows to avoid unnecessary (slow) key store operations each time the preferences are saving)
+var (
+	dataKeyMutex sync.Mutex
+	dataKey      struct {
+		key       []byte
+		storage   string
+		protected string
+	}
+)
+
+// IsEncryptionAvailable returns nil if the preferences file encryption is available on this machine
+func IsEncryptionAvailable() error {
+	if isSecretStoreAvailable() == nil {
+		return nil
+	}
+	if err := keyprotect.GetFuncNotAvailableError(); err != nil {
+		return fmt.Errorf("no machine secret available to protect the encryption key: %w", err)
+	}
+	return nil
+}
+
+// encryptPreferences encrypts the serialized preferences
+func encryptPreferences(data []byte) ([]byte, error) {
+	dataKeyMutex.Lock()
+	defer dataKeyMutex.Unlock()
+
+	if len(dataKey.key) == 0 {
+		if err := newDataKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	gcm, err := newGCM(dataKey.key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(encryptedPreferencesFile{
+		EncryptedPreferences: &encryptedPreferences{
+			KeyStorage:   dataKey.storage,
+			KeyProtected: dataKey.protected,
+			Nonce:        nonce,
+			Data:         gcm.Seal(nil, nonce, data, nil),
+		}})
+}
+
+// decryptPreferences decrypts the data of the preferences file.
+// If the data is not encrypted - it is returned as is (isEncrypted=false).
+func decryptPreferences(fileData []byte) (data []byte, isEncrypted bool, err error) {
+	var file encryptedPreferencesFile
+	if err := json.Unmarshal(fileData, &file); err != nil || file.EncryptedPreferences == nil {
+		return fileData, false, nil
+	}
+	enc := file.EncryptedPreferences
+
+	dataKeyMutex.Lock()
+	defer dataKeyMutex.Unlock()
+
+	key, err := restoreDataKey(enc.KeyStorage, enc.KeyProtected)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to restore the preferences encryption key: %w", err)
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, true, err
+	}
+	if len(enc.Nonce) != gcm.NonceSize() {
+		return nil, true, fmt.Errorf("failed to decrypt preferences: bad nonce")
+	}
+	data, err = gcm.Open(nil, enc.Nonce, enc.Data, nil)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to decrypt preferences: %w", err)
+	}
+
+	dataKey.key = key
+	dataKey.storage = enc.KeyStorage
+	dataKey.protected = enc.KeyProtected
+	return data, true, nil
+}
+
+// removeDataKey removes the data key (when the encryption is disabled)
+func removeDataKey() {
+	dataKeyMutex.Lock()
+	defer dataKeyMutex.Unlock()
+
+	if len(dataKey.key) == 0 {
+		return
+	}
+	if dataKey.storage != keyStorageKeyprotect && _secretStore != nil && dataKey.storage == _secretStore.name() {
+		if err := _secretStore.remove(dataKeySecretStoreKey); err != nil {
+			log.Warning(fmt.Sprintf("Failed to remove the preferences encryption key from %s: %s", dataKey.storage, err))
+		}
+	}
+	dataKey.key = nil
+	dataKey.storage = ""
+	dataKey.protected = ""
+}
+
+// newDataKey generates the new data key and saves it to the OS secret store (or protects it by the hardware-bound key)
+func newDataKey() error {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return err
+	}
+
+	if isSecretStoreAvailable() == nil {
+		if err := _secretStore.set(dataKeySecretStoreKey, key); err != nil {
+			return fmt.Errorf("failed to save the preferences encryption key to %s: %w", _secretStore.name(), err)
+		}
+		dataKey.key, dataKey.storage, dataKey.protected = key, _secretStore.name(), ""
+		return nil
+	}
+
+	protected, err := keyprotect.Protect(base64.StdEncoding.EncodeToString(key))
+	if err != nil {
+		return fmt.Errorf("failed to protect the preferences encryption key: %w", err)
+	}
+	dataKey.key, dataKey.storage, dataKey.protected = key, keyStorageKeyprotect, protected
+	return nil
+}
+
+func restoreDataKey(storage, protected string) ([]byte, error) {
+	if len(dataKey.key) > 0 && dataKey.storage == storage && dataKey.protected == protected {
+		return dataKey.key, nil
+	}
+
+	if storage == keyStorageKeyprotect {
+		keyB64, err := keyprotect.Unprotect(protected)
+		if err != nil {
+			return nil, err
+		}
+		return base64.StdEncoding.DecodeString(keyB64)
+	}
+
+	if err := isSecretStoreAvailable(); err != nil {
+		return nil, err
+	}
+	if storage != _secretStore.name() {
+		return nil, fmt.Errorf("unsupported key storage '%s'", storage)
+	}
+	return _secretStore.get(dataKeySecretStoreKey)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,51 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import "fmt"
+
+// Default TCP port of the local gRPC API
+const GrpcApiDefaultPort = 9561
+
+// GrpcApiParams - configuration of the local gRPC API of the daemon (disabled by default).
+// The API is listening only on the loopback interface (127.0.0.1).
+// The service definition: daemon/protocol/grpcapi/ivpn.proto
+type GrpcApiParams struct {
+	IsEnabled bool `json:"isEnabled"`
+	Port      int  `json:"port"`
+	// Access token. Each call must contain the metadata: 'authorization: Bearer <Token>'
+	Token string `json:"token"`
+}
+
+// Validate checks the gRPC API configuration
+func (p GrpcApiParams) Validate() error {
+	if p.Port < 1024 || p.Port > 65535 {
+		return fmt.Errorf("gRPC API port must be in range 1024-65535")
+	}
+	return nil
+}
+
+// GenerateGrpcApiToken returns new random access token for the gRPC API
+func GenerateGrpcApiToken() (string, error) {
+	return generateAccessToken()
+}
@@ -32,11 +32,20 @@ import (
 
 	"github.com/google/uuid"
 
+	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/awg"
 	"github.com/ivpn/desktop-app/daemon/helpers"
+	"github.com/ivpn/desktop-app/daemon/keyprotect"
 	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/obfsproxy"
+	"github.com/ivpn/desktop-app/daemon/service/connstats"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
 	service_types "github.com/ivpn/desktop-app/daemon/service/types"
+	"github.com/ivpn/desktop-app/daemon/shadowsocks"
+	"github.com/ivpn/desktop-app/daemon/splittun"
+	"github.com/ivpn/desktop-app/daemon/v2r"
+	"github.com/ivpn/desktop-app/daemon/vpn"
 )
 
 var log *logger.Logger
@@ -70,15 +79,51 @@ type UserPreferences struct {
 type Preferences struct {
 	// SettingsSessionUUID is unique for Preferences object
 	// It allow to detect situations when settings was erased (created new Preferences object)
-	SettingsSessionUUID      string
+	SettingsSessionUUID string
+	// Version of the preferences data schema (see SchemaVersion)
+	SchemaVersion int
+	// Log of the schema migrations (the most recent last)
+	SchemaMigrationLog []SchemaMigrationRecord
+
 	IsLogging                bool
 	IsFwPersistant           bool
 	IsFwAllowLAN             bool
 	IsFwAllowLANMulticast    bool
 	IsFwAllowApiServers      bool
-	FwUserExceptions         string // Firewall exceptions: comma separated list of IP addresses (masks) in format: x.x.x.x[/xx]
+	FwUserExceptions         string               // Firewall exceptions: comma separated list of IP addresses (masks) in format: x.x.x.x[/xx]
+	FwMssClamp               int                  // TCP MSS value for connections through the VPN tunnel (0 - MSS clamping disabled)
+	FwAllowLanServices       lansvc.Services      // LAN services allowed by the firewall individually (applicable when LAN communication is blocked)
+	FwAllowedLanHosts        string               // LAN hosts allowed by the firewall (applicable when LAN communication is blocked): comma separated list of local IP addresses
+	FwLanInboundPorts        []lansvc.InboundPort // local ports which accept incoming connections from LAN (applicable when LAN communication is blocked)
 	IsStopOnClientDisconnect bool
 	Obfs4proxy               obfsproxy.Config
+	// V2Ray transport for VPN connections (can not be used together with obfsproxy)
+	V2RayProxy v2r.V2RayTransportType
+	// User-defined Shadowsocks server to chain the VPN connection through (can not be used together with obfsproxy and V2Ray)
+	ShadowsocksProxy shadowsocks.Config
+	// AmneziaWG obfuscation parameters for WireGuard connections (empty - vanilla WireGuard)
+	AmneziaWG awg.Config
+	// If true - the local networks (private and link-local ranges) are excluded from 'AllowedIPs' of WireGuard connections,
+	// so the local traffic never enters the tunnel interface
+	IsWgLanBypass bool
+	// If true - the WireGuard connection is switched (without disconnection) to another host of the same server
+	// when there is no handshake with the active host
+	IsWgPeerFailover bool
+	// If true - the WireGuard preshared key is negotiated using post-quantum KEM (together with the WireGuard keys;
+	// the preshared key is renewed on each rotation of the WireGuard keys)
+	IsWgQuantumResistance bool
+	// The post-quantum KEM algorithm to negotiate the WireGuard preshared key (empty - the default algorithm; see kem.Algorithms())
+	WgKemAlgorithm string
+	// Interval (minutes) to move the WireGuard connection to another port of the server without disconnection (0 - disabled)
+	WgPortHoppingMinutes int
+	// Additional OpenVPN directives (one per line) applied to the OpenVPN connections.
+	// Only the directives allowed by openvpn.ValidateExtraParameters() are accepted.
+	OpenVpnExtraParameters string
+	// If true - the data channel offload (DCO) is not in use for OpenVPN connections
+	// (by default, DCO is in use when it is supported by the platform: OpenVPN 2.6+ and ovpn-dco kernel module/driver)
+	IsOpenVpnDcoDisabled bool
+	// TLS/cipher policy for OpenVPN connections (empty - default parameters)
+	OpenVpnCryptoPolicy vpn.OpenVpnCryptoPolicy
 
 	// IsAutoconnectOnLaunch: if 'true' - daemon will perform automatic connection (see 'IsAutoconnectOnLaunchDaemon' for details)
 	IsAutoconnectOnLaunch bool
@@ -93,6 +138,12 @@ type Preferences struct {
 	// split-tunnelling
 	IsSplitTunnel   bool
 	SplitTunnelApps []string
+	// destinations (IP addresses, networks, domain names) to be excluded from (or included into) the VPN tunnel
+	SplitTunnelDestinations []splittun.Destination
+	// containers (Docker container names/IDs or cgroup-v2 paths) to be excluded from (or included into) the VPN tunnel
+	SplitTunnelContainers []splittun.Container
+	// users (user names or UIDs) which traffic to be excluded from (or included into) the VPN tunnel
+	SplitTunnelUsers []splittun.User
 
 	// last known account status
 	Session SessionStatus
@@ -103,6 +154,74 @@ type Preferences struct {
 
 	LastConnectionParams service_types.ConnectionParams
 	WiFiControl          WiFiParams
+
+	// connection scheduler: connect/disconnect (or enable/disable firewall) at defined time or after inactivity
+	Schedule ScheduleParams
+
+	// If true - WireGuard private key is stored protected by hardware-bound key (TPM 2.0 / Secure Enclave)
+	// If the hardware protection is not available - the key is stored unprotected
+	IsWGKeyHwProtection bool
+
+	// Recently used connection parameters (the most recent first)
+	ConnectionHistory []ConnectionHistoryItem
+	// If true - the connection history is not collected
+	IsConnectionHistoryDisabled bool
+	// If true - the connection statistics history (bytes received/sent, duration) is not collected
+	IsConnectionStatsDisabled bool
+	// Notify clients when the traffic of the VPN connection exceeds the thresholds
+	DataUsageAlert DataUsageAlertParams
+	// The round-trip time to the connected VPN server is measured through the tunnel or outside the tunnel (empty - through the tunnel)
+	LatencyProbeMode connstats.LatencyProbeMode
+
+	// Named connection configurations (shared by all clients)
+	ConnectionProfiles []ConnectionProfile
+
+	// The logged-in accounts which are not active at the moment (see AccountSwitch)
+	StoredAccounts []StoredAccount
+
+	// If true - WireGuard connection falls back to OpenVPN (TCP) when there is no handshake with the server (e.g. UDP is blocked)
+	IsWgFallbackToOpenVPN bool
+
+	// If true - in case of large clock skew, the API server time is in use for TLS certificate validation of the API requests
+	IsApiTimeHintAllowed bool
+
+	// If true - the daemon tries to renew the session silently (using the account ID) when the session is not valid anymore on the backend.
+	// Note: it also re-creates the session which was logged out from another device.
+	IsSessionAutoRenew bool
+
+	// If true - the preferences file is encrypted by the key which is protected by the machine secret
+	// (OS secret store or hardware-bound key). See IsEncryptionAvailable()
+	IsSettingsEncryption bool
+
+	// If true - the captive portal check is performed on connection attempts (the request is sent to a third-party server: see captiveportal.ProbeURL)
+	IsCaptivePortalCheck bool
+
+	// Proxy server for the API requests (for networks where direct access to the API server is blocked)
+	ApiProxy api_types.ProxyConfig
+
+	// Alternative API server (e.g. staging or mirrored infrastructure); not enabled - the default IVPN API server
+	ApiHostOverride api_types.APIHostOverride
+
+	// Local REST API of the daemon (opt-in)
+	RestApi RestApiParams
+	// Local gRPC API of the daemon (opt-in)
+	GrpcApi GrpcApiParams
+
+	// Access tokens with limited permissions for the clients of the daemon (e.g. read-only monitoring clients)
+	ClientTokens []ClientToken
+
+	// If true - the log records are written in structured JSON format (instead of plain text)
+	IsLogJSONFormat bool
+	// Rotation of the log files (max size, number of files, compression ...)
+	LogRotation logger.RotationConfig
+	// Destination of the log records: log file, system log (journald/syslog; Linux only) or both (empty - log file)
+	LogOutput logger.Output
+	// If true - the account IDs, public IP addresses and WireGuard keys are redacted from the logs and diagnostics reports
+	IsLogPrivacyMode bool
+
+	// The fields stored by a newer version of the daemon (unknown for this version).
+	// They are written back on save to not lose them on downgrade.
+	unknownFields map[string]json.RawMessage
 }
 
 func Create() *Preferences {
@@ -111,6 +230,7 @@ func Create() *Preferences {
 		// SettingsSessionUUID is unique for Preferences object
 		// It allow to detect situations when settings was erased (created new Preferences object)
 		SettingsSessionUUID: uuid.New().String(),
+		SchemaVersion:       SchemaVersion,
 		IsFwAllowApiServers: true,
 	}
 }
@@ -144,8 +264,10 @@ func (p *Preferences) UpdateAccountInfo(acc AccountStatus) {
 }
 
 // UpdateWgCredentials save wireguard credentials
-func (p *Preferences) UpdateWgCredentials(wgPublicKey string, wgPrivateKey string, wgLocalIP string) {
+// 'wgPresharedKey' - the preshared key negotiated using post-quantum KEM (empty - not in use)
+func (p *Preferences) UpdateWgCredentials(wgPublicKey string, wgPrivateKey string, wgLocalIP string, wgPresharedKey string) {
 	p.Session.updateWgCredentials(wgPublicKey, wgPrivateKey, wgLocalIP)
+	p.Session.WGPresharedKey = strings.TrimSpace(wgPresharedKey)
 	p.SavePreferences()
 }
 
@@ -154,34 +276,128 @@ func (p *Preferences) SavePreferences() error {
 	mutexRW.Lock()
 	defer mutexRW.Unlock()
 
-	data, err := json.Marshal(p)
+	toSave := *p
+	toSave.Session = p.Session.storable(p.IsWGKeyHwProtection).toSecretStore(sessionSecretsKey)
+
+	usedSecrets := map[string]struct{}{sessionSecretsKey: {}}
+	if len(p.StoredAccounts) > 0 {
+		toSave.StoredAccounts = make([]StoredAccount, 0, len(p.StoredAccounts))
+		for _, a := range p.StoredAccounts {
+			key := storedAccountSecretsKey(a.Session.AccountID)
+			a.Session = a.Session.storable(p.IsWGKeyHwProtection).toSecretStore(key)
+			toSave.StoredAccounts = append(toSave.StoredAccounts, a)
+			usedSecrets[key] = struct{}{}
+		}
+	}
+
+	data, err := marshalWithUnknownFields(toSave)
 	if err != nil {
 		return fmt.Errorf("failed to save preferences file (json marshal error): %w", err)
 	}
 
+	if toSave.IsSettingsEncryption {
+		if data, err = encryptPreferences(data); err != nil {
+			return fmt.Errorf("failed to save preferences file (encryption error): %w", err)
+		}
+	}
+
 	settingsFile := platform.SettingsFile()
 	if err := helpers.WriteFile(settingsFile, data, 0600); err != nil { // read\write only for privileged user
 		return err
 	}
 
+	if !toSave.IsSettingsEncryption {
+		// the encryption key is not required anymore
+		removeDataKey()
+	}
+
+	// the credentials of the removed accounts are not required anymore
+	removeUnusedSecrets(usedSecrets)
+
 	return nil
 }
 
 // LoadPreferences loads preferences
 func (p *Preferences) LoadPreferences() error {
+	isSaveRequired, err := p.loadPreferences()
+	if err != nil {
+		return err
+	}
+
+	if isSaveRequired {
+		return p.SavePreferences()
+	}
+	return nil
+}
+
+// loadPreferences loads preferences.
+// Returns isSaveRequired=true when the stored data is outdated (e.g. WireGuard private key have to be migrated to\from hardware protection)
+func (p *Preferences) loadPreferences() (isSaveRequired bool, err error) {
 	mutexRW.RLock()
 	defer mutexRW.RUnlock()
 
-	data, err := ioutil.ReadFile(platform.SettingsFile())
-
+	fileData, data, err := readPreferencesFile(platform.SettingsFile())
 	if err != nil {
-		return fmt.Errorf("failed to read preferences file: %w", err)
+		return false, err
 	}
 
 	// Parse json onto preferences object
+	// (the files stored before the schema versioning was introduced do not contain 'SchemaVersion')
+	p.SchemaVersion = 0
 	err = json.Unmarshal(data, p)
 	if err != nil {
-		return err
+		return false, err
+	}
+
+	// convert the data stored by other version of the daemon
+	if p.migrateSchema(data, fileData) {
+		isSaveRequired = true
+	}
+
+	// restore the session credentials from the OS secret store
+	isInSecretStore := len(p.Session.SecretStore) > 0
+	if err := p.Session.restoreFromSecretStore(sessionSecretsKey); err != nil {
+		// the credentials are not available anymore: the session is not usable (re-login required)
+		log.Error(fmt.Sprintf("Unable to restore session credentials from the secret store (re-login required): %s", err))
+		p.Session.Session = ""
+		p.Session.OpenVPNPass = ""
+		p.Session.updateWgCredentials("", "", "")
+		p.Session.WGPrivateKeyProtected = ""
+		isSaveRequired = true
+	} else if !isInSecretStore && p.Session.isHasSecrets() && isSecretStoreAvailable() == nil {
+		log.Info("Migrating session credentials to the secret store")
+		isSaveRequired = true
+	}
+
+	// restore hardware-protected WireGuard private key
+	isWgKeyProtected := len(p.Session.WGPrivateKeyProtected) > 0
+	if err := p.Session.restoreProtectedWgKey(); err != nil {
+		// the key is not usable anymore (e.g. hardware was changed): new WireGuard keys will be generated
+		log.Error(fmt.Sprintf("Unable to restore protected WireGuard private key (WireGuard keys will be regenerated): %s", err))
+		p.Session.updateWgCredentials("", "", "")
+		isSaveRequired = true
+	} else if len(p.Session.WGPrivateKey) > 0 && isWgKeyProtected != (p.IsWGKeyHwProtection && keyprotect.GetFuncNotAvailableError() == nil) {
+		log.Info(fmt.Sprintf("Migrating WireGuard private key storage (hardware protection: %v)", p.IsWGKeyHwProtection))
+		isSaveRequired = true
+	}
+
+	// restore the credentials of the stored (not active) accounts
+	if len(p.StoredAccounts) > 0 {
+		storedAccounts := make([]StoredAccount, 0, len(p.StoredAccounts))
+		for _, a := range p.StoredAccounts {
+			if err := a.Session.restoreFromSecretStore(storedAccountSecretsKey(a.Session.AccountID)); err != nil {
+				log.Error(fmt.Sprintf("Unable to restore credentials of the stored account from the secret store (the account removed): %s", err))
+				isSaveRequired = true
+				continue
+			}
+			if err := a.Session.restoreProtectedWgKey(); err != nil {
+				log.Error(fmt.Sprintf("Unable to restore protected WireGuard private key of the stored account (WireGuard keys will be regenerated): %s", err))
+				a.Session.updateWgCredentials("", "", "")
+				isSaveRequired = true
+			}
+			storedAccounts = append(storedAccounts, a)
+		}
+		p.StoredAccounts = storedAccounts
 	}
 
 	// init WG properties
@@ -194,20 +410,34 @@ func (p *Preferences) LoadPreferences() error {
 		log.Info(fmt.Sprintf("default value for preferences: WgKeysRegenIntervalDays=%v", p.Session.WGKeysRegenInerval))
 	}
 
-	// *** Compatibility with old versions ***
+	return isSaveRequired, nil
+}
 
-	// Convert parameters from v3.9.14 (and releases older than 2022-08-16)
-	// If 'IsObfsproxy' is enabled  -> use use obfs3
-	type tmp_type_Settings_v3_9_14 struct {
-		IsObfsproxy bool
-	}
-	var tmp_Settings_v3_9_14 tmp_type_Settings_v3_9_14
-	err = json.Unmarshal(data, &tmp_Settings_v3_9_14)
-	if err == nil && tmp_Settings_v3_9_14.IsObfsproxy {
-		p.Obfs4proxy = obfsproxy.Config{Version: obfsproxy.OBFS3}
+// readPreferencesFile reads the preferences file and decrypts it (if encrypted).
+// When the file can not be decrypted, the copy of the file is saved (ErrorNotDecrypted.BackupFile):
+// the caller is going to save the default preferences over it, and the copy allows to restore the preferences
+// when the encryption key is available again.
+func readPreferencesFile(settingsFile string) (fileData []byte, data []byte, err error) {
+	fileData, err = ioutil.ReadFile(settingsFile)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read preferences file: %w", err)
 	}
 
-	return nil
+	data, isEncrypted, err := decryptPreferences(fileData)
+	if err != nil {
+		if !isEncrypted {
+			return nil, nil, err
+		}
+		backupFile := settingsFile + undecryptableFileSuffix
+		if e := helpers.WriteFile(backupFile, fileData, 0600); e != nil {
+			log.Error(fmt.Sprintf("Failed to save the copy of the encrypted preferences file: %s", e))
+			backupFile = ""
+		} else {
+			log.Warning(fmt.Sprintf("The encrypted preferences file can not be decrypted; the copy saved to '%s'", backupFile))
+		}
+		return nil, nil, ErrorNotDecrypted{Err: err, BackupFile: backupFile}
+	}
+	return fileData, data, nil
 }
 
 func (p *Preferences) setSession(accountID string,
@@ -218,6 +448,7 @@ func (p *Preferences) setSession(accountID string,
 	wgPrivateKey string,
 	wgLocalIP string) {
 
+	prevSession := p.Session
 	p.Session = SessionStatus{
 		AccountID:          strings.TrimSpace(accountID),
 		Session:            strings.TrimSpace(session),
@@ -230,4 +461,8 @@ func (p *Preferences) setSession(accountID string,
 	}
 
 	p.Session.updateWgCredentials(wgPublicKey, wgPrivateKey, wgLocalIP)
+	if len(p.Session.WGPublicKey) > 0 && p.Session.WGPublicKey == prevSession.WGPublicKey {
+		// the same WireGuard key (e.g. the session renewed): keep the preshared key bound to it
+		p.Session.WGPresharedKey = prevSession.WGPresharedKey
+	}
 }
@@ -0,0 +1,87 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadPreferencesFile(t *testing.T) {
+	t.Run("not encrypted", func(t *testing.T) {
+		settingsFile := filepath.Join(t.TempDir(), "settings.json")
+		fileData := []byte(`{"IsLogging":true}`)
+		if err := os.WriteFile(settingsFile, fileData, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		_, data, err := readPreferencesFile(settingsFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, fileData) {
+			t.Errorf("unexpected data: %s", data)
+		}
+		if _, err := os.Stat(settingsFile + undecryptableFileSuffix); !os.IsNotExist(err) {
+			t.Errorf("the copy of the preferences file must not be created")
+		}
+	})
+
+	t.Run("encryption key not available", func(t *testing.T) {
+		settingsFile := filepath.Join(t.TempDir(), "settings.json")
+		fileData := []byte(`{"EncryptedPreferences":{"KeyStorage":"unknown-storage","Nonce":"AAAAAAAAAAAAAAAA","Data":"AAAA"}}`)
+		if err := os.WriteFile(settingsFile, fileData, 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		_, _, err := readPreferencesFile(settingsFile)
+		var errNotDecrypted ErrorNotDecrypted
+		if !errors.As(err, &errNotDecrypted) {
+			t.Fatalf("expected ErrorNotDecrypted; got %v", err)
+		}
+		if errNotDecrypted.BackupFile != settingsFile+undecryptableFileSuffix {
+			t.Fatalf("unexpected backup file: '%s'", errNotDecrypted.BackupFile)
+		}
+		backup, err := os.ReadFile(errNotDecrypted.BackupFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(backup, fileData) {
+			t.Errorf("the copy does not match the encrypted preferences file: %s", backup)
+		}
+	})
+
+	t.Run("file not exists", func(t *testing.T) {
+		_, _, err := readPreferencesFile(filepath.Join(t.TempDir(), "settings.json"))
+		if err == nil {
+			t.Fatal("error expected")
+		}
+		var errNotDecrypted ErrorNotDecrypted
+		if errors.As(err, &errNotDecrypted) {
+			t.Errorf("unexpected ErrorNotDecrypted")
+		}
+	})
+}
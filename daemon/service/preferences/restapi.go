@@ -0,0 +1,64 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+)
+
+// Default TCP port of the local REST API
+const RestApiDefaultPort = 9560
+
+// RestApiParams - configuration of the local REST API of the daemon (disabled by default).
+// The API is listening only on the loopback interface (127.0.0.1).
+type RestApiParams struct {
+	IsEnabled bool `json:"isEnabled"`
+	Port      int  `json:"port"`
+	// Access token. Each request must contain the header: 'Authorization: Bearer <Token>'
+	Token string `json:"token"`
+	// If true - the metrics in Prometheus format are available on the '/metrics' endpoint of the REST API
+	IsMetricsEnabled bool `json:"isMetricsEnabled"`
+}
+
+// Validate checks the REST API configuration
+func (p RestApiParams) Validate() error {
+	if p.Port < 1024 || p.Port > 65535 {
+		return fmt.Errorf("REST API port must be in range 1024-65535")
+	}
+	return nil
+}
+
+// GenerateRestApiToken returns new random access token for the REST API
+func GenerateRestApiToken() (string, error) {
+	return generateAccessToken()
+}
+
+func generateAccessToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("failed to generate access token: %w", err)
+	}
+	return hex.EncodeToString(buf), nil
+}
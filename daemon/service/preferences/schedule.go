@@ -0,0 +1,117 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"fmt"
+	"time"
+)
+
+// ScheduleAction - action to be applied by the connection scheduler
+type ScheduleAction string
+
+const (
+	ScheduleConnect     ScheduleAction = "connect"
+	ScheduleDisconnect  ScheduleAction = "disconnect"
+	ScheduleFirewallOn  ScheduleAction = "firewallOn"
+	ScheduleFirewallOff ScheduleAction = "firewallOff"
+)
+
+// ScheduleRule - the rule of the connection scheduler.
+// The rule is triggered at the defined time of the day (Time) or after the inactivity period (InactivityMinutes).
+type ScheduleRule struct {
+	Action ScheduleAction `json:"action"`
+	// Time of the day (local time) in format "HH:MM" (e.g. "23:30")
+	Time string `json:"time,omitempty"`
+	// Days of the week when the rule is active (0 - Sunday, 1 - Monday ... 6 - Saturday). Empty - every day.
+	Days []time.Weekday `json:"days,omitempty"`
+	// Inactivity period in minutes (applicable only for 'disconnect' action when Time is empty).
+	// The VPN is disconnected when there was no traffic through the VPN tunnel during this period.
+	InactivityMinutes int `json:"inactivityMinutes,omitempty"`
+}
+
+// ScheduleParams - configuration of the connection scheduler
+type ScheduleParams struct {
+	IsEnabled bool           `json:"isEnabled"`
+	Rules     []ScheduleRule `json:"rules"`
+}
+
+// Validate checks the rule configuration
+func (r ScheduleRule) Validate() error {
+	switch r.Action {
+	case ScheduleConnect, ScheduleDisconnect, ScheduleFirewallOn, ScheduleFirewallOff:
+	default:
+		return fmt.Errorf("unknown schedule action '%s'", r.Action)
+	}
+
+	for _, d := range r.Days {
+		if d < time.Sunday || d > time.Saturday {
+			return fmt.Errorf("bad day of the week (%d) in schedule rule", d)
+		}
+	}
+
+	if r.IsInactivityRule() {
+		if len(r.Time) > 0 {
+			return fmt.Errorf("schedule rule can not have both time and inactivity period")
+		}
+		if r.Action != ScheduleDisconnect {
+			return fmt.Errorf("inactivity period is applicable only for '%s' action", ScheduleDisconnect)
+		}
+		return nil
+	}
+
+	if r.InactivityMinutes < 0 {
+		return fmt.Errorf("bad inactivity period (%d) in schedule rule", r.InactivityMinutes)
+	}
+	if _, _, err := r.TimeOfDay(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// IsInactivityRule returns 'true' if the rule is triggered after the inactivity period
+func (r ScheduleRule) IsInactivityRule() bool {
+	return r.InactivityMinutes > 0
+}
+
+// TimeOfDay returns the time of the day when the rule is triggered
+func (r ScheduleRule) TimeOfDay() (hour, min int, err error) {
+	t, err := time.Parse("15:04", r.Time)
+	if err != nil {
+		return 0, 0, fmt.Errorf("bad time '%s' in schedule rule (expected format: HH:MM)", r.Time)
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+// IsActiveOnDay returns 'true' if the rule is applicable for the day of the week
+func (r ScheduleRule) IsActiveOnDay(d time.Weekday) bool {
+	if len(r.Days) == 0 {
+		return true
+	}
+	for _, rd := range r.Days {
+		if rd == d {
+			return true
+		}
+	}
+	return false
+}
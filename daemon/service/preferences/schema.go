@@ -0,0 +1,213 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/helpers"
+	"github.com/ivpn/desktop-app/daemon/obfsproxy"
+	"github.com/ivpn/desktop-app/daemon/service/platform"
+	"github.com/ivpn/desktop-app/daemon/version"
+)
+
+// SchemaVersion - the current version of the preferences data schema.
+// NOTE: increase it (and add a migration to 'schemaMigrations') on each change which requires conversion of the stored data
+// (renamed/removed fields, changed meaning or format of values).
+// The preferences stored before the schema versioning was introduced have version 0.
+const SchemaVersion = 1
+
+// SchemaMigrationLogMaxItems - max number of records in the schema migration log
+const SchemaMigrationLogMaxItems = 20
+
+// schemaMigration - conversion of the stored preferences to the next schema version
+type schemaMigration struct {
+	version     int // the schema version after the migration
+	description string
+	// 'data' is the original (raw) JSON data of the preferences file
+	migrate func(p *Preferences, data []byte) error
+}
+
+// schemaMigrations - ordered list of migrations (one migration for each schema version)
+var schemaMigrations = []schemaMigration{
+	{
+		version:     1,
+		description: "'IsObfsproxy' (v3.9.14 and older) converted to obfsproxy configuration",
+		migrate: func(p *Preferences, data []byte) error {
+			// If 'IsObfsproxy' is enabled  -> use use obfs3
+			var settings_v3_9_14 struct {
+				IsObfsproxy bool
+			}
+			if err := json.Unmarshal(data, &settings_v3_9_14); err != nil {
+				return err
+			}
+			if settings_v3_9_14.IsObfsproxy {
+				p.Obfs4proxy = obfsproxy.Config{Version: obfsproxy.OBFS3}
+			}
+			return nil
+		},
+	},
+}
+
+// SchemaMigrationRecord - record of the preferences schema migration log
+type SchemaMigrationRecord struct {
+	Time        time.Time
+	AppVersion  string
+	FromVersion int
+	ToVersion   int
+	Description string
+	Error       string `json:",omitempty"`
+}
+
+// migrateSchema converts the loaded preferences to the current schema version.
+// 'data' is the original (raw) JSON data of the preferences file.
+// Returns 'true' when the preferences were changed (must be saved).
+//
+// When the preferences were stored by a newer version of the daemon (downgrade), the data is not converted:
+// the fields which are unknown for this version are kept (see 'unknownFields') to not lose them on save.
+func (p *Preferences) migrateSchema(data []byte, fileData []byte) (isChanged bool) {
+	fromVersion := p.SchemaVersion
+	if fromVersion == SchemaVersion {
+		return false
+	}
+
+	if fromVersion > SchemaVersion {
+		// the schema version is not changed: the data stays compatible with the newer version of the daemon
+		p.unknownFields = getUnknownFields(data)
+		if cnt := len(p.SchemaMigrationLog); cnt > 0 && p.SchemaMigrationLog[cnt-1].FromVersion == fromVersion && p.SchemaMigrationLog[cnt-1].ToVersion == SchemaVersion {
+			return false // already detected on previous start
+		}
+		log.Warning(fmt.Sprintf("Preferences were saved by a newer version of the daemon (schema version %d; supported %d). Unknown settings will be kept unchanged.", fromVersion, SchemaVersion))
+		backupPreferencesFile(fileData, fromVersion)
+		p.addSchemaMigrationRecord(SchemaMigrationRecord{
+			FromVersion: fromVersion,
+			ToVersion:   SchemaVersion,
+			Description: fmt.Sprintf("loaded by older version of the daemon; unknown settings kept: %d", len(p.unknownFields)),
+		})
+		return true
+	}
+
+	backupPreferencesFile(fileData, fromVersion)
+
+	for _, m := range schemaMigrations {
+		if m.version <= p.SchemaVersion {
+			continue
+		}
+		rec := SchemaMigrationRecord{FromVersion: p.SchemaVersion, ToVersion: m.version, Description: m.description}
+		if err := m.migrate(p, data); err != nil {
+			// stop here: the migration will be retried on the next start
+			log.Error(fmt.Sprintf("Preferences schema migration %d -> %d failed: %s", rec.FromVersion, rec.ToVersion, err))
+			rec.Error = err.Error()
+			p.addSchemaMigrationRecord(rec)
+			return true
+		}
+		log.Info(fmt.Sprintf("Preferences schema migrated %d -> %d: %s", rec.FromVersion, rec.ToVersion, rec.Description))
+		p.SchemaVersion = m.version
+		p.addSchemaMigrationRecord(rec)
+	}
+	return true
+}
+
+// backupPreferencesFile keeps a copy of the original preferences file (allows to restore the settings manually)
+func backupPreferencesFile(fileData []byte, schemaVersion int) {
+	backupFile := fmt.Sprintf("%s.v%d.bak", platform.SettingsFile(), schemaVersion)
+	if err := helpers.WriteFile(backupFile, fileData, 0600); err != nil {
+		log.Error(fmt.Sprintf("Failed to backup preferences file: %s", err))
+	}
+}
+
+func (p *Preferences) addSchemaMigrationRecord(rec SchemaMigrationRecord) {
+	rec.Time = time.Now()
+	rec.AppVersion = version.Version()
+
+	// creating new slice (do not modify the underlying array which can be shared with copies of Preferences object)
+	items := append(append([]SchemaMigrationRecord{}, p.SchemaMigrationLog...), rec)
+	if len(items) > SchemaMigrationLogMaxItems {
+		items = items[len(items)-SchemaMigrationLogMaxItems:]
+	}
+	p.SchemaMigrationLog = items
+}
+
+// SchemaInfo returns the human-readable information about the preferences schema and the migration log (for diagnostics)
+func (p *Preferences) SchemaInfo() string {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("Version: %d (supported: %d)", p.SchemaVersion, SchemaVersion))
+	if len(p.unknownFields) > 0 {
+		sb.WriteString(fmt.Sprintf("; unknown settings kept: %d", len(p.unknownFields)))
+	}
+	for _, r := range p.SchemaMigrationLog {
+		sb.WriteString(fmt.Sprintf("\n  %s [v%s] %d -> %d: %s", r.Time.Format(time.RFC3339), r.AppVersion, r.FromVersion, r.ToVersion, r.Description))
+		if len(r.Error) > 0 {
+			sb.WriteString(" ERROR: " + r.Error)
+		}
+	}
+	return sb.String()
+}
+
+// getUnknownFields returns the top-level fields of the preferences JSON data which are not known for this version
+// (new fields of the nested structures are not tracked)
+func getUnknownFields(data []byte) map[string]json.RawMessage {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+
+	knownData, err := json.Marshal(Preferences{})
+	if err != nil {
+		return nil
+	}
+	var known map[string]json.RawMessage
+	if err := json.Unmarshal(knownData, &known); err != nil {
+		return nil
+	}
+
+	for k := range known {
+		delete(fields, k)
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	return fields
+}
+
+// marshalWithUnknownFields marshals the preferences and appends the fields unknown for this version
+func marshalWithUnknownFields(p Preferences) ([]byte, error) {
+	data, err := json.Marshal(p)
+	if err != nil || len(p.unknownFields) == 0 {
+		return data, err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	for k, v := range p.unknownFields {
+		if _, exists := fields[k]; !exists {
+			fields[k] = v
+		}
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,139 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"github.com/ivpn/desktop-app/daemon/obfsproxy"
+)
+
+// loadTestPreferences parses the preferences the same way as loadPreferences() does and runs the schema migration
+func loadTestPreferences(t *testing.T, data string) (p *Preferences, isChanged bool) {
+	p = &Preferences{}
+	if err := json.Unmarshal([]byte(data), p); err != nil {
+		t.Fatal(err)
+	}
+	return p, p.migrateSchema([]byte(data), []byte(data))
+}
+
+// chdirToTempDir changes the working directory to the temporary one and returns the function to restore it.
+// The backup of the original preferences file is written next to the settings file
+// (the platform is not initialized in tests: the settings file path is empty, so the backup goes to the working directory).
+func chdirToTempDir(t *testing.T) (restore func()) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	return func() { os.Chdir(wd) }
+}
+
+func TestMigrateSchema(t *testing.T) {
+	defer chdirToTempDir(t)()
+
+	tests := []struct {
+		name             string
+		data             string
+		expectedChanged  bool
+		expectedVersion  int
+		expectedObfs     obfsproxy.ObfsProxyVersion
+		expectedLogItems int
+		expectedUnknown  int
+	}{
+		{"v0: IsObfsproxy enabled", `{"IsObfsproxy":true}`, true, SchemaVersion, obfsproxy.OBFS3, 1, 0},
+		{"v0: IsObfsproxy disabled", `{"IsObfsproxy":false,"IsLogging":true}`, true, SchemaVersion, 0, 1, 0},
+		{"v0: no IsObfsproxy", `{}`, true, SchemaVersion, 0, 1, 0},
+		{"current version", `{"SchemaVersion":1,"IsObfsproxy":true}`, false, SchemaVersion, 0, 0, 0},
+		{"newer version", `{"SchemaVersion":100,"IsLogging":true,"NewField":{"a":1},"NewField2":2}`, true, 100, 0, 1, 2},
+		{"newer version: already detected", `{"SchemaVersion":100,"NewField":1,
+			"SchemaMigrationLog":[{"FromVersion":100,"ToVersion":1}]}`, false, 100, 0, 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, isChanged := loadTestPreferences(t, tt.data)
+			if isChanged != tt.expectedChanged {
+				t.Errorf("isChanged: expected %v; got %v", tt.expectedChanged, isChanged)
+			}
+			if p.SchemaVersion != tt.expectedVersion {
+				t.Errorf("SchemaVersion: expected %d; got %d", tt.expectedVersion, p.SchemaVersion)
+			}
+			if p.Obfs4proxy.Version != tt.expectedObfs {
+				t.Errorf("Obfs4proxy.Version: expected %v; got %v", tt.expectedObfs, p.Obfs4proxy.Version)
+			}
+			if len(p.SchemaMigrationLog) != tt.expectedLogItems {
+				t.Errorf("SchemaMigrationLog: expected %d items; got %d", tt.expectedLogItems, len(p.SchemaMigrationLog))
+			}
+			if len(p.unknownFields) != tt.expectedUnknown {
+				t.Errorf("unknownFields: expected %d; got %d", tt.expectedUnknown, len(p.unknownFields))
+			}
+		})
+	}
+}
+
+func TestMarshalWithUnknownFields(t *testing.T) {
+	defer chdirToTempDir(t)()
+
+	p, _ := loadTestPreferences(t, `{"SchemaVersion":100,"IsLogging":true,"NewField":{"a":[1,2]}}`)
+	p.IsLogging = false
+
+	data, err := marshalWithUnknownFields(*p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if string(fields["NewField"]) != `{"a":[1,2]}` {
+		t.Errorf("unknown field is not kept: %s", fields["NewField"])
+	}
+	if string(fields["IsLogging"]) != `false` {
+		t.Errorf("known field must be saved with the actual value: %s", fields["IsLogging"])
+	}
+	if string(fields["SchemaVersion"]) != `100` {
+		t.Errorf("schema version of newer daemon must be kept: %s", fields["SchemaVersion"])
+	}
+}
+
+func TestAddSchemaMigrationRecord(t *testing.T) {
+	p := &Preferences{}
+	for i := 0; i < SchemaMigrationLogMaxItems+5; i++ {
+		p.addSchemaMigrationRecord(SchemaMigrationRecord{FromVersion: i, ToVersion: i + 1})
+	}
+	if len(p.SchemaMigrationLog) != SchemaMigrationLogMaxItems {
+		t.Fatalf("expected %d items; got %d", SchemaMigrationLogMaxItems, len(p.SchemaMigrationLog))
+	}
+	if last := p.SchemaMigrationLog[len(p.SchemaMigrationLog)-1]; last.FromVersion != SchemaMigrationLogMaxItems+4 {
+		t.Errorf("the most recent record must be the last one; got FromVersion=%d", last.FromVersion)
+	}
+	if first := p.SchemaMigrationLog[0]; first.FromVersion != 5 {
+		t.Errorf("the oldest records must be removed; got FromVersion=%d", first.FromVersion)
+	}
+}
@@ -0,0 +1,205 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+// secretStore - OS secret store for the sensitive data (credentials) which should not be kept in the plaintext preferences file:
+// DPAPI (Windows) or Keychain (macOS).
+// If the secret store is not available - the sensitive data is kept in the preferences file.
+type secretStore interface {
+	// name of the secret store (it is saved in the preferences file to know where the data is stored)
+	name() string
+	// isAvailable returns nil if the secret store is usable
+	isAvailable() error
+	set(key string, secret []byte) error
+	get(key string) ([]byte, error)
+	remove(key string) error
+}
+
+// The key of the active session secrets in the secret store
+const sessionSecretsKey = "session"
+
+// storedAccountSecretsKey returns the key of the secrets of the stored (not active) account session in the secret store
+func storedAccountSecretsKey(accountID string) string {
+	return sessionSecretsKey + "-" + accountID
+}
+
+// sessionSecrets - sensitive data of the session (SessionStatus fields) which are kept in the secret store
+type sessionSecrets struct {
+	Session               string `json:",omitempty"`
+	OpenVPNPass           string `json:",omitempty"`
+	WGPrivateKey          string `json:",omitempty"`
+	WGPrivateKeyProtected string `json:",omitempty"`
+	WGPresharedKey        string `json:",omitempty"`
+}
+
+func (s sessionSecrets) isEmpty() bool {
+	return s == sessionSecrets{}
+}
+
+var (
+	secretStoreMutex sync.Mutex
+	// Last session secrets saved to the secret store (key -> data).
+	// It allows to avoid unnecessary (slow) secret store operations each time the preferences are saving.
+	secretStoreLastSaved = map[string]string{}
+
+	isSecretStoreAvailabilityChecked bool
+	secretStoreAvailabilityErr       error
+)
+
+// isSecretStoreAvailable returns nil if the OS secret store is usable
+func isSecretStoreAvailable() error {
+	secretStoreMutex.Lock()
+	defer secretStoreMutex.Unlock()
+
+	if !isSecretStoreAvailabilityChecked {
+		isSecretStoreAvailabilityChecked = true
+		if _secretStore == nil {
+			secretStoreAvailabilityErr = fmt.Errorf("secret store not implemented for this platform")
+		} else if secretStoreAvailabilityErr = _secretStore.isAvailable(); secretStoreAvailabilityErr != nil {
+			log.Info(fmt.Sprintf("Secret store (%s) is not available: %s", _secretStore.name(), secretStoreAvailabilityErr))
+		} else {
+			log.Info(fmt.Sprintf("Secret store is available (%s)", _secretStore.name()))
+		}
+	}
+	return secretStoreAvailabilityErr
+}
+
+// toSecretStore returns copy of the session object where the sensitive data is moved to the OS secret store (under the 'key').
+// If the secret store is not available - the sensitive data is kept in the object.
+func (s SessionStatus) toSecretStore(key string) SessionStatus {
+	s.SecretStore = ""
+	if isSecretStoreAvailable() != nil {
+		return s
+	}
+
+	secrets := sessionSecrets{
+		Session:               s.Session,
+		OpenVPNPass:           s.OpenVPNPass,
+		WGPrivateKey:          s.WGPrivateKey,
+		WGPrivateKeyProtected: s.WGPrivateKeyProtected,
+		WGPresharedKey:        s.WGPresharedKey}
+
+	data, err := json.Marshal(secrets)
+	if err != nil {
+		log.Warning(fmt.Sprintf("Session credentials will be saved to the preferences file: %s", err))
+		return s
+	}
+
+	secretStoreMutex.Lock()
+	defer secretStoreMutex.Unlock()
+
+	if secrets.isEmpty() {
+		// logged out: nothing to keep in the secret store
+		removeSecretsLocked(key)
+		return s
+	}
+
+	if secretStoreLastSaved[key] != string(data) {
+		if err := _secretStore.set(key, data); err != nil {
+			log.Warning(fmt.Sprintf("Session credentials will be saved to the preferences file (%s error: %s)", _secretStore.name(), err))
+			return s
+		}
+		secretStoreLastSaved[key] = string(data)
+	}
+
+	s.Session = ""
+	s.OpenVPNPass = ""
+	s.WGPrivateKey = ""
+	s.WGPrivateKeyProtected = ""
+	s.WGPresharedKey = ""
+	s.SecretStore = _secretStore.name()
+	return s
+}
+
+// restoreFromSecretStore restores the sensitive data from the OS secret store (if the data was saved there under the 'key')
+func (s *SessionStatus) restoreFromSecretStore(key string) error {
+	storeName := s.SecretStore
+	s.SecretStore = ""
+	if len(storeName) == 0 {
+		return nil
+	}
+
+	if err := isSecretStoreAvailable(); err != nil {
+		return err
+	}
+	if storeName != _secretStore.name() {
+		return fmt.Errorf("unsupported secret store '%s'", storeName)
+	}
+
+	data, err := _secretStore.get(key)
+	if err != nil {
+		return fmt.Errorf("failed to read from %s: %w", storeName, err)
+	}
+
+	var secrets sessionSecrets
+	if err := json.Unmarshal(data, &secrets); err != nil {
+		return fmt.Errorf("failed to parse data from %s: %w", storeName, err)
+	}
+
+	s.Session = secrets.Session
+	s.OpenVPNPass = secrets.OpenVPNPass
+	s.WGPrivateKey = secrets.WGPrivateKey
+	s.WGPrivateKeyProtected = secrets.WGPrivateKeyProtected
+	s.WGPresharedKey = secrets.WGPresharedKey
+
+	secretStoreMutex.Lock()
+	defer secretStoreMutex.Unlock()
+	secretStoreLastSaved[key] = string(data)
+
+	return nil
+}
+
+// removeUnusedSecrets removes the session secrets saved by this process which are not in use anymore
+// (e.g. the secrets of the removed stored accounts)
+func removeUnusedSecrets(usedKeys map[string]struct{}) {
+	secretStoreMutex.Lock()
+	defer secretStoreMutex.Unlock()
+
+	for key := range secretStoreLastSaved {
+		if _, ok := usedKeys[key]; !ok {
+			removeSecretsLocked(key)
+		}
+	}
+}
+
+// removeSecretsLocked removes the session secrets from the secret store (secretStoreMutex must be locked)
+func removeSecretsLocked(key string) {
+	if _, ok := secretStoreLastSaved[key]; !ok {
+		return
+	}
+	if err := _secretStore.remove(key); err != nil {
+		log.Warning(fmt.Sprintf("Failed to remove session credentials from %s: %s", _secretStore.name(), err))
+	}
+	delete(secretStoreLastSaved, key)
+}
+
+// isHasSecrets returns true if the object contains sensitive data (which can be moved to the secret store)
+func (s *SessionStatus) isHasSecrets() bool {
+	return len(s.Session) > 0 || len(s.OpenVPNPass) > 0 || len(s.WGPrivateKey) > 0 || len(s.WGPrivateKeyProtected) > 0 || len(s.WGPresharedKey) > 0
+}
@@ -0,0 +1,183 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+//go:build darwin && cgo
+// +build darwin,cgo
+
+package preferences
+
+/*
+#cgo LDFLAGS: -framework Security -framework CoreFoundation
+
+#include <stdlib.h>
+#include <string.h>
+#include <CoreFoundation/CoreFoundation.h>
+#include <Security/Security.h>
+
+#define KC_SERVICE "net.ivpn.client.daemon"
+
+// The caller is responsible to release the returned object
+static inline CFMutableDictionaryRef kc_query(const char *account) {
+	CFStringRef cfService = CFStringCreateWithCString(kCFAllocatorDefault, KC_SERVICE, kCFStringEncodingUTF8);
+	CFStringRef cfAccount = CFStringCreateWithCString(kCFAllocatorDefault, account, kCFStringEncodingUTF8);
+
+	CFMutableDictionaryRef query = CFDictionaryCreateMutable(kCFAllocatorDefault, 0, &kCFTypeDictionaryKeyCallBacks, &kCFTypeDictionaryValueCallBacks);
+	CFDictionarySetValue(query, kSecClass, kSecClassGenericPassword);
+	CFDictionarySetValue(query, kSecAttrService, cfService);
+	CFDictionarySetValue(query, kSecAttrAccount, cfAccount);
+
+	CFRelease(cfService);
+	CFRelease(cfAccount);
+	return query;
+}
+
+static inline OSStatus kc_set(const char *account, const void *in, int inLen) {
+	CFMutableDictionaryRef query = kc_query(account);
+	CFDataRef data = CFDataCreate(kCFAllocatorDefault, in, inLen);
+
+	CFMutableDictionaryRef attrs = CFDictionaryCreateMutable(kCFAllocatorDefault, 0, &kCFTypeDictionaryKeyCallBacks, &kCFTypeDictionaryValueCallBacks);
+	CFDictionarySetValue(attrs, kSecValueData, data);
+
+	OSStatus status = SecItemUpdate(query, attrs);
+	if (status == errSecItemNotFound) {
+		CFDictionarySetValue(query, kSecValueData, data);
+		status = SecItemAdd(query, NULL);
+	}
+
+	CFRelease(attrs);
+	CFRelease(data);
+	CFRelease(query);
+	return status;
+}
+
+// On success, the caller is responsible to free 'out' buffer
+static inline OSStatus kc_get(const char *account, void **out, int *outLen) {
+	CFMutableDictionaryRef query = kc_query(account);
+	CFDictionarySetValue(query, kSecReturnData, kCFBooleanTrue);
+	CFDictionarySetValue(query, kSecMatchLimit, kSecMatchLimitOne);
+
+	CFTypeRef result = NULL;
+	OSStatus status = SecItemCopyMatching(query, &result);
+	if (status == errSecSuccess) {
+		if (result == NULL || CFGetTypeID(result) != CFDataGetTypeID()) {
+			status = errSecDecode;
+		} else {
+			CFDataRef data = (CFDataRef)result;
+			*outLen = (int)CFDataGetLength(data);
+			*out = malloc(*outLen > 0 ? *outLen : 1);
+			memcpy(*out, CFDataGetBytePtr(data), *outLen);
+		}
+	}
+
+	if (result != NULL) CFRelease(result);
+	CFRelease(query);
+	return status;
+}
+
+static inline OSStatus kc_remove(const char *account) {
+	CFMutableDictionaryRef query = kc_query(account);
+	OSStatus status = SecItemDelete(query);
+	CFRelease(query);
+	return status;
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Keychain: the data is saved as a generic password item in the System keychain (the daemon is running as root).
+var _secretStore secretStore = &keychainStore{}
+
+// The item which is in use to check the Keychain availability (it is never created)
+const keychainTestItem = "availability-check"
+
+type keychainStore struct{}
+
+func (k *keychainStore) name() string {
+	return "keychain"
+}
+
+func (k *keychainStore) isAvailable() error {
+	_, err := k.get(keychainTestItem)
+	if err != nil && !isKeychainItemNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+func (k *keychainStore) set(key string, secret []byte) error {
+	if len(secret) == 0 {
+		return fmt.Errorf("no data")
+	}
+
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+
+	if status := C.kc_set(cKey, unsafe.Pointer(&secret[0]), C.int(len(secret))); status != C.errSecSuccess {
+		return keychainError(status)
+	}
+	return nil
+}
+
+func (k *keychainStore) get(key string) ([]byte, error) {
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+
+	var out unsafe.Pointer
+	var outLen C.int
+	if status := C.kc_get(cKey, &out, &outLen); status != C.errSecSuccess {
+		return nil, keychainError(status)
+	}
+	defer C.free(out)
+
+	return C.GoBytes(out, outLen), nil
+}
+
+func (k *keychainStore) remove(key string) error {
+	cKey := C.CString(key)
+	defer C.free(unsafe.Pointer(cKey))
+
+	if status := C.kc_remove(cKey); status != C.errSecSuccess && status != C.errSecItemNotFound {
+		return keychainError(status)
+	}
+	return nil
+}
+
+type keychainStatusError struct {
+	status C.OSStatus
+}
+
+func (e keychainStatusError) Error() string {
+	return fmt.Sprintf("Keychain error (OSStatus %d)", int(e.status))
+}
+
+func keychainError(status C.OSStatus) error {
+	return keychainStatusError{status: status}
+}
+
+func isKeychainItemNotFound(err error) bool {
+	e, ok := err.(keychainStatusError)
+	return ok && e.status == C.errSecItemNotFound
+}
@@ -0,0 +1,110 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"unsafe"
+
+	"github.com/ivpn/desktop-app/daemon/helpers"
+	"github.com/ivpn/desktop-app/daemon/service/platform"
+	"golang.org/x/sys/windows"
+)
+
+// DPAPI: the data is encrypted by the key of the daemon's user account (LocalSystem) and saved to a file near the preferences file.
+// The data can be decrypted only by processes running under the same account on this machine.
+var _secretStore secretStore = &dpapiStore{}
+
+// Additional entropy for the DPAPI encryption (the data encrypted by other apps can not be decrypted with it and vice versa)
+var dpapiEntropy = []byte("IVPN daemon credentials")
+
+type dpapiStore struct{}
+
+func (d *dpapiStore) name() string {
+	return "dpapi"
+}
+
+func (d *dpapiStore) isAvailable() error {
+	_, err := dpapiProtect([]byte("test"))
+	return err
+}
+
+func (d *dpapiStore) file(key string) string {
+	return filepath.Join(filepath.Dir(platform.SettingsFile()), key+".dpapi")
+}
+
+func (d *dpapiStore) set(key string, secret []byte) error {
+	data, err := dpapiProtect(secret)
+	if err != nil {
+		return err
+	}
+	return helpers.WriteFile(d.file(key), data, 0600) // read\write only for privileged user
+}
+
+func (d *dpapiStore) get(key string) ([]byte, error) {
+	data, err := os.ReadFile(d.file(key))
+	if err != nil {
+		return nil, err
+	}
+	return dpapiUnprotect(data)
+}
+
+func (d *dpapiStore) remove(key string) error {
+	if err := os.Remove(d.file(key)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+func newDataBlob(data []byte) *windows.DataBlob {
+	if len(data) == 0 {
+		return &windows.DataBlob{}
+	}
+	return &windows.DataBlob{Size: uint32(len(data)), Data: &data[0]}
+}
+
+// blobToBytes copies the data from the blob allocated by the system and releases the blob memory
+func blobToBytes(blob *windows.DataBlob) []byte {
+	defer windows.LocalFree(windows.Handle(unsafe.Pointer(blob.Data)))
+	ret := make([]byte, blob.Size)
+	copy(ret, unsafe.Slice(blob.Data, blob.Size))
+	return ret
+}
+
+func dpapiProtect(data []byte) ([]byte, error) {
+	var out windows.DataBlob
+	if err := windows.CryptProtectData(newDataBlob(data), nil, newDataBlob(dpapiEntropy), 0, nil, windows.CRYPTPROTECT_UI_FORBIDDEN, &out); err != nil {
+		return nil, fmt.Errorf("DPAPI encryption failed: %w", err)
+	}
+	return blobToBytes(&out), nil
+}
+
+func dpapiUnprotect(data []byte) ([]byte, error) {
+	var out windows.DataBlob
+	if err := windows.CryptUnprotectData(newDataBlob(data), nil, newDataBlob(dpapiEntropy), 0, nil, windows.CRYPTPROTECT_UI_FORBIDDEN, &out); err != nil {
+		return nil, fmt.Errorf("DPAPI decryption failed: %w", err)
+	}
+	return blobToBytes(&out), nil
+}
@@ -23,22 +23,36 @@
 package preferences
 
 import (
+	"fmt"
 	"net"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/ivpn/desktop-app/daemon/keyprotect"
 )
 
 // SessionStatus contains information about current session
 type SessionStatus struct {
-	AccountID          string
-	Session            string `json:",omitempty"`
-	OpenVPNUser        string `json:",omitempty"`
-	OpenVPNPass        string `json:",omitempty"`
-	WGPublicKey        string
-	WGPrivateKey       string `json:",omitempty"`
-	WGLocalIP          string
-	WGKeyGenerated     time.Time
-	WGKeysRegenInerval time.Duration // syntax error in variable name. Keeping it as is for compatibility with previous versions
+	AccountID    string
+	Session      string `json:",omitempty"`
+	OpenVPNUser  string `json:",omitempty"`
+	OpenVPNPass  string `json:",omitempty"`
+	WGPublicKey  string
+	WGPrivateKey string `json:",omitempty"`
+	// WireGuard private key protected by hardware-bound key (TPM 2.0 / Secure Enclave).
+	// It is in use only when saving\loading preferences (in memory, the key is always kept in 'WGPrivateKey')
+	WGPrivateKeyProtected string `json:",omitempty"`
+	WGLocalIP             string
+	WGKeyGenerated        time.Time
+	WGKeysRegenInerval    time.Duration // syntax error in variable name. Keeping it as is for compatibility with previous versions
+	// WireGuard preshared key negotiated using post-quantum KEM (empty - not in use).
+	// It is bound to the WireGuard key: it is erased when the key changed
+	WGPresharedKey string `json:",omitempty"`
+
+	// Name of the OS secret store which keeps the sensitive data (Session, OpenVPNPass, WGPrivateKey, WGPrivateKeyProtected, WGPresharedKey).
+	// It is in use only when saving\loading preferences (empty - the sensitive data is kept in the preferences file)
+	SecretStore string `json:",omitempty"`
 }
 
 // IsLoggedIn returns 'true' when user logged-in
@@ -62,6 +76,10 @@ func (s *SessionStatus) updateWgCredentials(wgPublicKey string, wgPrivateKey str
 		}
 	}
 
+	if strings.TrimSpace(wgPublicKey) != s.WGPublicKey {
+		// the preshared key is bound to the WireGuard key
+		s.WGPresharedKey = ""
+	}
 	s.WGPublicKey = strings.TrimSpace(wgPublicKey)
 	s.WGPrivateKey = strings.TrimSpace(wgPrivateKey)
 	s.WGLocalIP = strings.TrimSpace(wgLocalIP)
@@ -72,3 +90,68 @@ func (s *SessionStatus) updateWgCredentials(wgPublicKey string, wgPrivateKey str
 		s.WGKeyGenerated = time.Time{}
 	}
 }
+
+// Last protected WireGuard private keys (private key -> protected key).
+// It allows to avoid unnecessary (slow) hardware operations each time the preferences are saving.
+var (
+	protectedWgKeyMutex sync.Mutex
+	protectedWgKeys     = map[string]string{}
+)
+
+// protectedWgKeysMaxItems - max number of cached protected keys (one key per logged-in account)
+const protectedWgKeysMaxItems = AccountsMaxItems * 2
+
+func cacheProtectedWgKeyLocked(key, protected string) {
+	if len(protectedWgKeys) >= protectedWgKeysMaxItems {
+		protectedWgKeys = map[string]string{}
+	}
+	protectedWgKeys[key] = protected
+}
+
+// storable returns copy of the session object prepared to be saved to a disk.
+// If 'isHwProtection' is true - the WireGuard private key is protected by hardware-bound key.
+// If the hardware protection is not available - the key is kept unprotected.
+func (s SessionStatus) storable(isHwProtection bool) SessionStatus {
+	s.WGPrivateKeyProtected = ""
+	if !isHwProtection || len(s.WGPrivateKey) == 0 {
+		return s
+	}
+
+	protectedWgKeyMutex.Lock()
+	defer protectedWgKeyMutex.Unlock()
+
+	protected, ok := protectedWgKeys[s.WGPrivateKey]
+	if !ok {
+		var err error
+		if protected, err = keyprotect.Protect(s.WGPrivateKey); err != nil {
+			log.Warning(fmt.Sprintf("WireGuard private key will be saved without hardware protection: %s", err))
+			return s
+		}
+		cacheProtectedWgKeyLocked(s.WGPrivateKey, protected)
+	}
+
+	s.WGPrivateKey = ""
+	s.WGPrivateKeyProtected = protected
+	return s
+}
+
+// restoreProtectedWgKey restores WireGuard private key from 'WGPrivateKeyProtected'
+func (s *SessionStatus) restoreProtectedWgKey() error {
+	protected := s.WGPrivateKeyProtected
+	s.WGPrivateKeyProtected = ""
+	if len(protected) == 0 {
+		return nil
+	}
+
+	key, err := keyprotect.Unprotect(protected)
+	if err != nil {
+		return err
+	}
+	s.WGPrivateKey = key
+
+	protectedWgKeyMutex.Lock()
+	defer protectedWgKeyMutex.Unlock()
+	cacheProtectedWgKeyLocked(key, protected)
+
+	return nil
+}
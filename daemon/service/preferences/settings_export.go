@@ -0,0 +1,144 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences
+
+import (
+	"fmt"
+	"time"
+
+	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/awg"
+	"github.com/ivpn/desktop-app/daemon/obfsproxy"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
+	service_types "github.com/ivpn/desktop-app/daemon/service/types"
+	"github.com/ivpn/desktop-app/daemon/shadowsocks"
+	"github.com/ivpn/desktop-app/daemon/v2r"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// ExportFormatVersion - version of the exported settings format.
+// Must be increased on incompatible changes of the ExportedSettings structure.
+const ExportFormatVersion = 1
+
+// ExportedSettings - the daemon configuration which can be transferred to another machine
+// (fleet provisioning, reinstallation).
+// It contains no account/session data, client access tokens or machine-specific settings.
+type ExportedSettings struct {
+	FormatVersion int
+	ExportedAt    time.Time
+	// When 'false' - passwords and user-defined VPN configurations (which can contain private keys) are not included
+	IsSecretsIncluded bool
+
+	Firewall ExportedFirewallSettings
+	// The last connection parameters (servers, protocol, port, DNS settings ...)
+	ConnectionParams   service_types.ConnectionParams
+	ConnectionProfiles []ConnectionProfile
+	// Trusted WiFi networks and the related actions
+	WiFiControl WiFiParams
+
+	Obfs4proxy       obfsproxy.Config
+	V2RayProxy       v2r.V2RayTransportType
+	ShadowsocksProxy shadowsocks.Config
+	AmneziaWG        awg.Config
+	ApiProxy         api_types.ProxyConfig
+	// TLS/cipher policy for OpenVPN connections
+	OpenVpnCryptoPolicy vpn.OpenVpnCryptoPolicy
+}
+
+// ExportedFirewallSettings - firewall configuration (part of ExportedSettings)
+type ExportedFirewallSettings struct {
+	IsPersistent        bool
+	IsAllowLAN          bool
+	IsAllowLANMulticast bool
+	AllowLANServices    lansvc.Services
+	AllowedLANHosts     string
+	LANInboundPorts     []lansvc.InboundPort
+	IsAllowApiServers   bool
+	UserExceptions      string
+}
+
+// Export returns the configuration which can be imported on another machine.
+// Secrets (passwords, user-defined VPN configurations) are included only when 'includeSecrets' is true;
+// in this case, connection profiles based on the user-defined configuration are skipped.
+func (p *Preferences) Export(includeSecrets bool) ExportedSettings {
+	ret := ExportedSettings{
+		FormatVersion:     ExportFormatVersion,
+		ExportedAt:        time.Now(),
+		IsSecretsIncluded: includeSecrets,
+		Firewall: ExportedFirewallSettings{
+			IsPersistent:        p.IsFwPersistant,
+			IsAllowLAN:          p.IsFwAllowLAN,
+			IsAllowLANMulticast: p.IsFwAllowLANMulticast,
+			AllowLANServices:    p.FwAllowLanServices,
+			AllowedLANHosts:     p.FwAllowedLanHosts,
+			LANInboundPorts:     p.FwLanInboundPorts,
+			IsAllowApiServers:   p.IsFwAllowApiServers,
+			UserExceptions:      p.FwUserExceptions,
+		},
+		ConnectionParams:    p.LastConnectionParams,
+		WiFiControl:         p.WiFiControl,
+		Obfs4proxy:          p.Obfs4proxy,
+		V2RayProxy:          p.V2RayProxy,
+		ShadowsocksProxy:    p.ShadowsocksProxy,
+		AmneziaWG:           p.AmneziaWG,
+		ApiProxy:            p.ApiProxy,
+		OpenVpnCryptoPolicy: p.OpenVpnCryptoPolicy,
+	}
+
+	for _, cp := range p.ConnectionProfiles {
+		if !includeSecrets {
+			if cp.Params.CustomConfig.IsDefined() {
+				continue
+			}
+			cp.Params = ConnectionParamsWithoutSecrets(cp.Params)
+		}
+		ret.ConnectionProfiles = append(ret.ConnectionProfiles, cp)
+	}
+
+	if !includeSecrets {
+		ret.ConnectionParams = ConnectionParamsWithoutSecrets(ret.ConnectionParams)
+		ret.ShadowsocksProxy.Password = ""
+		ret.ApiProxy.Password = ""
+	}
+
+	return ret
+}
+
+// Validate checks if the exported settings can be imported
+func (s ExportedSettings) Validate() error {
+	if s.FormatVersion <= 0 {
+		return fmt.Errorf("unknown format of the settings data")
+	}
+	if s.FormatVersion > ExportFormatVersion {
+		return fmt.Errorf("unsupported version of the settings format (%d); please, update the application", s.FormatVersion)
+	}
+	return nil
+}
+
+// ConnectionParamsWithoutSecrets returns the copy of the connection parameters without secrets
+// (user-defined VPN configuration and proxy password)
+func ConnectionParamsWithoutSecrets(params service_types.ConnectionParams) service_types.ConnectionParams {
+	params.CustomConfig = service_types.CustomConfig{}
+	params.OpenVpnParameters.Proxy.Password = ""
+	return params
+}
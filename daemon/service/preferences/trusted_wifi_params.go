@@ -22,9 +22,27 @@
 
 package preferences
 
+import (
+	"net"
+	"strings"
+)
+
 type WiFiNetwork struct {
-	SSID      string `json:"ssid"`
+	SSID string `json:"ssid"`
+	// BSSID (MAC address of the access point; e.g. "aa:bb:cc:dd:ee:ff")
+	// If defined - the configuration is applicable only for the specific access point
+	BSSID     string `json:"bssid,omitempty"`
 	IsTrusted bool   `json:"isTrusted"`
+	// Actions for this network (nil - the default actions are in use: WiFiParams.Actions)
+	Actions *WiFiActions `json:"actions,omitempty"`
+}
+
+// WiFiActions - actions to apply when joining trusted/untrusted network
+type WiFiActions struct {
+	UnTrustedConnectVpn     bool `json:"unTrustedConnectVpn"`
+	UnTrustedEnableFirewall bool `json:"unTrustedEnableFirewall"`
+	TrustedDisconnectVpn    bool `json:"trustedDisconnectVpn"`
+	TrustedDisableFirewall  bool `json:"trustedDisableFirewall"`
 }
 
 type WiFiParams struct {
@@ -39,12 +57,7 @@ type WiFiParams struct {
 	DefaultTrustStatusTrusted *bool         `json:"defaultTrustStatusTrusted"` // nil - no trust action
 	Networks                  []WiFiNetwork `json:"networks"`
 
-	Actions struct {
-		UnTrustedConnectVpn     bool `json:"unTrustedConnectVpn"`
-		UnTrustedEnableFirewall bool `json:"unTrustedEnableFirewall"`
-		TrustedDisconnectVpn    bool `json:"trustedDisconnectVpn"`
-		TrustedDisableFirewall  bool `json:"trustedDisableFirewall"`
-	} `json:"actions"`
+	Actions WiFiActions `json:"actions"`
 }
 
 func WiFiParamsCreate() WiFiParams {
@@ -55,3 +68,41 @@ func WiFiParamsCreate() WiFiParams {
 	p.Actions.TrustedDisableFirewall = true
 	return p
 }
+
+// FindNetwork returns configuration for the network (nil - if network is not defined).
+// The configuration for the specific access point (BSSID) has priority over the configuration for the network name (SSID).
+func (p WiFiParams) FindNetwork(ssid, bssid string) *WiFiNetwork {
+	var ret *WiFiNetwork
+	bssid = NormalizeBSSID(bssid)
+	for i, n := range p.Networks {
+		if len(n.BSSID) > 0 {
+			if len(bssid) > 0 && NormalizeBSSID(n.BSSID) == bssid && (len(n.SSID) == 0 || n.SSID == ssid) {
+				return &p.Networks[i]
+			}
+			continue
+		}
+		if ret == nil && len(ssid) > 0 && n.SSID == ssid {
+			ret = &p.Networks[i]
+		}
+	}
+	return ret
+}
+
+// NormalizeBSSID converts the BSSID (MAC address) to the format: "aa:bb:cc:dd:ee:ff".
+// Accepted: any letter case, ':' or '-' separators and octets without leading zero (e.g. "0:1b:2c:3:4:5" on macOS).
+// If the value is not a MAC address - it is returned in lower case.
+func NormalizeBSSID(bssid string) string {
+	bssid = strings.ToLower(strings.TrimSpace(bssid))
+	octets := strings.FieldsFunc(bssid, func(r rune) bool { return r == ':' || r == '-' })
+	if len(octets) == 6 {
+		for i, o := range octets {
+			if len(o) == 1 {
+				octets[i] = "0" + o
+			}
+		}
+		if mac, err := net.ParseMAC(strings.Join(octets, ":")); err == nil {
+			return mac.String()
+		}
+	}
+	return bssid
+}
@@ -0,0 +1,93 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package preferences_test
+
+import (
+	"testing"
+
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+)
+
+func TestFindNetwork(t *testing.T) {
+	p := preferences.WiFiParams{Networks: []preferences.WiFiNetwork{
+		{SSID: "home"},
+		{SSID: "home", BSSID: "AA:BB:CC:DD:EE:01"},
+		{BSSID: "00:1b:2c:03:04:05"}, // access point with any SSID
+		{SSID: "office", BSSID: "aa:bb:cc:dd:ee:02"},
+		{SSID: "home"}, // duplicate: never returned
+	}}
+
+	tests := []struct {
+		name  string
+		ssid  string
+		bssid string
+		index int // expected index in p.Networks (-1 - not found)
+	}{
+		{"SSID", "home", "", 0},
+		{"SSID with unknown BSSID", "home", "aa:bb:cc:dd:ee:99", 0},
+		{"BSSID has priority over SSID", "home", "aa:bb:cc:dd:ee:01", 1},
+		{"BSSID with any SSID", "cafe", "00:1b:2c:03:04:05", 2},
+		{"BSSID without SSID", "", "00:1B:2C:03:04:05", 2},
+		{"BSSID belongs to another SSID", "home", "aa:bb:cc:dd:ee:02", 0},
+		{"SSID and BSSID", "office", "AA:BB:CC:DD:EE:02", 3},
+		{"BSSID with '-' separators", "home", "aa-bb-cc-dd-ee-01", 1},
+		{"BSSID without leading zeros", "", "0:1b:2c:3:4:5", 2},
+		{"unknown network", "cafe", "aa:bb:cc:dd:ee:99", -1},
+		{"not connected", "", "", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := -1
+			if n := p.FindNetwork(tt.ssid, tt.bssid); n != nil {
+				for i := range p.Networks {
+					if n == &p.Networks[i] {
+						index = i
+					}
+				}
+			}
+			if index != tt.index {
+				t.Errorf("expected network #%d; got #%d", tt.index, index)
+			}
+		})
+	}
+}
+
+func TestNormalizeBSSID(t *testing.T) {
+	tests := map[string]string{
+		"aa:bb:cc:dd:ee:ff":   "aa:bb:cc:dd:ee:ff",
+		"AA:BB:CC:DD:EE:FF":   "aa:bb:cc:dd:ee:ff",
+		"aa-bb-cc-dd-ee-ff":   "aa:bb:cc:dd:ee:ff",
+		" 0:1b:2c:3:4:5 ":     "00:1b:2c:03:04:05", // macOS format
+		"":                    "",
+		"Current":             "current",           // not a MAC address
+		"aa:bb:cc:dd:ee":      "aa:bb:cc:dd:ee",    // too short
+		"aa:bb:cc:dd:ee:gg":   "aa:bb:cc:dd:ee:gg", // not hex
+		"aa:bb:cc:dd:ee:ff:0": "aa:bb:cc:dd:ee:ff:0",
+	}
+	for bssid, expected := range tests {
+		if ret := preferences.NormalizeBSSID(bssid); ret != expected {
+			t.Errorf("NormalizeBSSID(%q) = %q; expected %q", bssid, ret, expected)
+		}
+	}
+}
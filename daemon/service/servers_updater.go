@@ -23,6 +23,8 @@
 package service
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -81,14 +83,14 @@ func (s *serversUpdater) GetServers() (*types.ServersInfoResponse, error) {
 		return servers, nil
 	}
 
-	return s.updateServers()
+	return s.updateServers(true)
 }
 
 // GetServersForceUpdate returns servers list info (locations, hosts and host load).
 // The daemon will make request to update servers from the backend.
 // The cached data will be ignored in this case.
 func (s *serversUpdater) GetServersForceUpdate() (*types.ServersInfoResponse, error) {
-	return s.updateServers()
+	return s.updateServers(true)
 }
 
 // Start periodically updating (downloading) servers in background
@@ -97,7 +99,7 @@ func (s *serversUpdater) StartUpdater() error {
 		isFirstIteration := true
 		for {
 			updateDelay := time.Hour
-			if _, err := s.updateServers(); err != nil {
+			if _, err := s.updateServers(false); err != nil {
 				log.Error(err)
 				if isFirstIteration {
 					// The first try to update can be failed because of daemon is starting on OS boot
@@ -115,24 +117,45 @@ func (s *serversUpdater) StartUpdater() error {
 }
 
 // UpdateServers - download servers list
-func (s *serversUpdater) updateServers() (*types.ServersInfoResponse, error) {
-	servers, err := s.api.DownloadServersList()
+// 'isForce' - download the full servers list even if it was not modified since the last download
+// (otherwise, the conditional request is used, and the delta update can be received)
+func (s *serversUpdater) updateServers(isForce bool) (*types.ServersInfoResponse, error) {
+	validators := api.CacheValidators{}
+	var baseData []byte
+	if !isForce && s.servers != nil {
+		validators, baseData = readServersCacheValidators()
+	}
+
+	servers, data, newValidators, err := s.api.DownloadServersListIfModified(validators, baseData)
 	if err != nil {
 		return servers, fmt.Errorf("failed to download servers list: %w", err)
 	}
-
-	if len(servers.Config.Ports.OpenVPN) <= 0 {
-		return servers, fmt.Errorf("no ports info for OpenVPN in servers.json; skipping received data from backend")
+	if servers == nil {
+		log.Info("Servers info is up to date (not modified)")
+		return s.servers, nil
 	}
-	if len(servers.Config.Ports.WireGuard) <= 0 {
+
+	if len(servers.Config.Ports.OpenVPN) <= 0 || len(servers.Config.Ports.WireGuard) <= 0 {
+		if !validators.IsEmpty() {
+			// it could be the result of a wrong delta update: trying to download full servers list
+			log.Warning("No ports info in updated servers.json; downloading full servers list")
+			return s.updateServers(true)
+		}
+		if len(servers.Config.Ports.OpenVPN) <= 0 {
+			return servers, fmt.Errorf("no ports info for OpenVPN in servers.json; skipping received data from backend")
+		}
 		return servers, fmt.Errorf("no ports info for WireGuard in servers.json; skipping received data from backend")
 	}
 
 	log.Info(fmt.Sprintf("Updated servers info (%d OpenVPN; %d WireGuard)\n", len(servers.OpenvpnServers), len(servers.WireguardServers)))
 
 	s.servers = servers
-	if err := writeServersToCache(servers); err != nil {
+	if err := writeServersToCache(data); err != nil {
 		log.Error("failed to save servers cache file: ", err)
+		newValidators = api.CacheValidators{} // the validators are not valid for the cached data
+	}
+	if err := writeServersCacheValidators(newValidators, data); err != nil {
+		log.Error("failed to save servers cache validators: ", err)
 	}
 
 	select {
@@ -184,19 +207,66 @@ func readServersFromCache() (svrs *types.ServersInfoResponse, apiIPsV4 []string,
 	return servers, servers.Config.API.IPAddresses, servers.Config.API.IPv6Addresses, nil
 }
 
-func writeServersToCache(servers *types.ServersInfoResponse) error {
-	if servers == nil {
-		return errors.New("nothing to save. Servers is null")
+// writeServersToCache saves the raw servers list data (as received from the backend; it is the base for the delta updates)
+func writeServersToCache(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("nothing to save. Servers data is empty")
 	}
 
-	data, err := json.Marshal(servers)
+	return os.WriteFile(platform.ServersFile(), data, filerights.DefaultFilePermissionsForConfig())
+}
+
+// serversCacheValidators - HTTP cache validators of the servers cache file.
+// The hash of the servers cache file is saved as well: the validators are not valid
+// when the servers cache file was replaced (e.g. by the installer).
+type serversCacheValidators struct {
+	api.CacheValidators
+	ServersFileHash string `json:"servers_file_hash"`
+}
+
+// serversCacheValidatorsFile - the file with HTTP cache validators of the servers cache file
+func serversCacheValidatorsFile() string {
+	return platform.ServersFile() + ".validators"
+}
+
+func dataHash(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// readServersCacheValidators returns the HTTP cache validators of the servers cache file (empty - not available)
+// and the servers cache file data (which corresponds to the validators)
+func readServersCacheValidators() (api.CacheValidators, []byte) {
+	data, err := os.ReadFile(serversCacheValidatorsFile())
 	if err != nil {
-		return fmt.Errorf("failed to marshal servers into a cache: %w", err)
+		return api.CacheValidators{}, nil
 	}
 
-	if data == nil {
-		return errors.New("failed to serialize servers")
+	var validators serversCacheValidators
+	if err := json.Unmarshal(data, &validators); err != nil {
+		log.Warning("failed to parse servers cache validators: ", err)
+		return api.CacheValidators{}, nil
 	}
 
-	return os.WriteFile(platform.ServersFile(), data, filerights.DefaultFilePermissionsForConfig())
+	serversData, err := os.ReadFile(platform.ServersFile())
+	if err != nil || dataHash(serversData) != validators.ServersFileHash {
+		return api.CacheValidators{}, nil
+	}
+	return validators.CacheValidators, serversData
+}
+
+// writeServersCacheValidators saves the HTTP cache validators of the servers cache file ('serversData' - the data of the servers cache file)
+func writeServersCacheValidators(validators api.CacheValidators, serversData []byte) error {
+	if validators.IsEmpty() {
+		if err := os.Remove(serversCacheValidatorsFile()); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(serversCacheValidators{CacheValidators: validators, ServersFileHash: dataHash(serversData)})
+	if err != nil {
+		return fmt.Errorf("failed to marshal servers cache validators: %w", err)
+	}
+	return os.WriteFile(serversCacheValidatorsFile(), data, filerights.DefaultFilePermissionsForConfig())
 }
@@ -23,6 +23,7 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -35,21 +36,33 @@ import (
 
 	"github.com/ivpn/desktop-app/daemon/api"
 	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/awg"
+	"github.com/ivpn/desktop-app/daemon/kem"
+	"github.com/ivpn/desktop-app/daemon/keyprotect"
 	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/netinfo"
 	"github.com/ivpn/desktop-app/daemon/obfsproxy"
+	"github.com/ivpn/desktop-app/daemon/operations"
 	"github.com/ivpn/desktop-app/daemon/oshelpers"
 	protocolTypes "github.com/ivpn/desktop-app/daemon/protocol/types"
+	"github.com/ivpn/desktop-app/daemon/service/connstats"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
 	"github.com/ivpn/desktop-app/daemon/service/firewall"
+	"github.com/ivpn/desktop-app/daemon/service/firewall/lansvc"
+	"github.com/ivpn/desktop-app/daemon/service/hostshealth"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
 	"github.com/ivpn/desktop-app/daemon/service/platform/filerights"
+	"github.com/ivpn/desktop-app/daemon/service/portforwarding"
 	"github.com/ivpn/desktop-app/daemon/service/preferences"
 	"github.com/ivpn/desktop-app/daemon/service/srverrors"
+	"github.com/ivpn/desktop-app/daemon/service/subsystems"
 	"github.com/ivpn/desktop-app/daemon/service/types"
+	"github.com/ivpn/desktop-app/daemon/shadowsocks"
 	"github.com/ivpn/desktop-app/daemon/shell"
 	"github.com/ivpn/desktop-app/daemon/splittun"
+	"github.com/ivpn/desktop-app/daemon/v2r"
 	"github.com/ivpn/desktop-app/daemon/vpn"
+	"github.com/ivpn/desktop-app/daemon/vpn/openvpn"
 	"github.com/ivpn/desktop-app/daemon/vpn/wireguard"
 
 	syncSemaphore "golang.org/x/sync/semaphore"
@@ -102,6 +115,11 @@ type Service struct {
 	// Note: Disconnect() function will wait until VPN fully disconnects
 	_done chan struct{}
 
+	// receiver of the server switching requests of the active WireGuard connection (nil - no active connection)
+	// Use SwitchServer() to switch the server
+	_switchServerHandler      *switchServerHandler
+	_switchServerHandlerMutex sync.Mutex
+
 	_serversPingProgressSemaphore *syncSemaphore.Weighted
 
 	// nil - when session checker stopped
@@ -114,6 +132,32 @@ type Service struct {
 	_globalEvents <-chan ServiceEventType
 
 	_systemLog chan<- SystemLogMessage
+
+	// request to resolve and apply Split Tunneling destinations
+	_splitTunDestUpdateChan chan struct{}
+
+	// long-running (asynchronous) operations
+	_operations *operations.Manager
+
+	// connection failures history of the VPN hosts
+	_hostsHealth *hostshealth.Tracker
+	// true - when the active connection is stopping intentionally (disconnection or reconnection requested)
+	_isDisconnectRequested bool
+
+	// port forwarded to the current VPN connection
+	_portForwarding *portforwarding.Manager
+
+	// captive portal detection
+	_captivePortal captivePortalState
+
+	// connection statistics history
+	_connStats *connstats.Store
+	// traffic rates of the active connection (for UI graphs)
+	_throughput *connstats.ThroughputHistory
+	// packet loss, round-trip time and jitter of the active connection
+	_quality *connstats.QualityHistory
+	// traffic of the current day (for the data usage alerts)
+	_dataUsageDay dataUsageDayState
 }
 
 // VpnSessionInfo - Additional information about current VPN connection
@@ -142,10 +186,22 @@ func CreateService(evtReceiver IServiceEventsReceiver, api *api.API, updater ISe
 		_serversPingProgressSemaphore: syncSemaphore.NewWeighted(1),
 		_globalEvents:                 globalEvents,
 		_systemLog:                    systemLog,
+		_splitTunDestUpdateChan:       make(chan struct{}, 1),
+		_hostsHealth:                  hostshealth.CreateTracker(),
+		_portForwarding:               portforwarding.CreateManager(api),
+		_connStats:                    connstats.CreateStore(platform.ConnectionStatsFile()),
+		_throughput:                   connstats.CreateThroughputHistory(),
+		_quality:                      connstats.CreateQualityHistory(),
 	}
 
+	serv._operations = operations.CreateManager(func(status operations.Status) {
+		serv._evtReceiver.OnOperationStatus(status)
+	})
+
 	// register the current service as a 'Connectivity checker' for API object
 	serv._api.SetConnectivityChecker(serv)
+	// receive notifications about wrong local time
+	serv._api.SetClockSkewNotifier(serv)
 
 	if err := serv.init(); err != nil {
 		return nil, fmt.Errorf("service initialization error : %w", err)
@@ -186,37 +242,69 @@ func (s *Service) init() error {
 		}
 	}()
 
-	if err := s._preferences.LoadPreferences(); err != nil {
-		log.Error("Failed to load service preferences: ", err)
-
-		log.Warning("Saving default values for preferences")
-		s._preferences.SavePreferences()
-	}
+	// initialize the service subsystems
+	err := subsystems.Initialize(
+		subsystems.Subsystem{Name: subsystems.Preferences, DependsOn: []subsystems.Name{subsystems.Platform}, Init: func() ([]string, error) {
+			if err := s._preferences.LoadPreferences(); err != nil {
+				log.Error("Failed to load service preferences: ", err)
+
+				var errNotDecrypted preferences.ErrorNotDecrypted
+				if errors.As(err, &errNotDecrypted) && len(errNotDecrypted.BackupFile) == 0 {
+					// no copy of the encrypted preferences: do not overwrite them by default values
+					log.Warning("Default values for preferences are not saved (the encrypted preferences file is kept)")
+					return []string{fmt.Sprintf("failed to load preferences (default values in use): %s", err)}, nil
+				}
 
-	// initialize firewall functionality
-	if err := firewall.Initialize(); err != nil {
+				log.Warning("Saving default values for preferences")
+				s._preferences.SavePreferences()
+				return []string{fmt.Sprintf("failed to load preferences (default values in use): %s", err)}, nil
+			}
+			return nil, nil
+		}},
+		// firewall functionality
+		subsystems.Subsystem{Name: subsystems.Firewall, DependsOn: []subsystems.Name{subsystems.Platform}, Critical: true, Init: func() ([]string, error) {
+			return nil, firewall.Initialize()
+		}},
+		// dns functionality
+		subsystems.Subsystem{Name: subsystems.Dns, DependsOn: []subsystems.Name{subsystems.Firewall, subsystems.Preferences}, Init: func() ([]string, error) {
+			funcGetDnsExtraSettings := func() dns.DnsExtraSettings {
+				return dns.DnsExtraSettings{Linux_IsDnsMgmtOldStyle: s._preferences.UserPrefs.Linux.IsDnsMgmtOldStyle}
+			}
+			return nil, dns.Initialize(firewall.OnChangeDNS, funcGetDnsExtraSettings)
+		}},
+		// split-tunnel functionality (initialized asynchronously: see below)
+		subsystems.Subsystem{Name: subsystems.SplitTunnel, DependsOn: []subsystems.Name{subsystems.Platform, subsystems.Firewall}},
+	)
+	if err != nil {
 		return fmt.Errorf("service initialization error : %w", err)
 	}
 
-	// initialize dns functionality
-	funcGetDnsExtraSettings := func() dns.DnsExtraSettings {
-		return dns.DnsExtraSettings{Linux_IsDnsMgmtOldStyle: s._preferences.UserPrefs.Linux.IsDnsMgmtOldStyle}
-	}
-	if err := dns.Initialize(firewall.OnChangeDNS, funcGetDnsExtraSettings); err != nil {
-		log.Error(fmt.Sprintf("failed to initialize DNS : %s", err))
-	}
-
 	// initialize split-tunnel functionality
 	go func() {
 		<-_ipStackInitializationWaiter // Wait for IP stack initialization
-		if err := splittun.Initialize(); err != nil {
+		if subsystems.GetStatus(subsystems.SplitTunnel).State != subsystems.Initializing {
+			return // dependencies not available
+		}
+		err := splittun.Initialize()
+		subsystems.SetStatus(subsystems.SplitTunnel, nil, err)
+		if err != nil {
 			log.Warning(fmt.Errorf("Split-Tunnelling initialization error : %w", err))
 		} else {
+			// start updater of Split Tunneling destinations (resolving domain names)
+			go s.splitTunnelling_DestinationsUpdater()
 			// apply Split Tunneling configuration
 			s.splitTunnelling_ApplyConfig()
 		}
 	}()
 
+	s._api.SetTimeHintAllowed(s._preferences.IsApiTimeHintAllowed)
+	if err := s._api.SetProxy(s._preferences.ApiProxy); err != nil {
+		log.Error(fmt.Errorf("failed to apply API proxy configuration: %w", err))
+	}
+	if err := s._api.SetHostOverride(s._preferences.ApiHostOverride); err != nil {
+		log.Error(fmt.Errorf("failed to apply alternative API server configuration: %w", err))
+	}
+
 	// Logging mus be already initialized (by launcher). Do nothing here.
 	// Init logger (if not initialized before)
 	//logger.Enable(s._preferences.IsLogging)
@@ -231,6 +319,24 @@ func (s *Service) init() error {
 		log.Error("Failed to apply firewall exceptions: ", err)
 	}
 
+	if err := firewall.SetAllowedLanHosts(s._preferences.FwAllowedLanHosts, true); err != nil {
+		log.Error("Failed to apply allowed LAN hosts: ", err)
+	}
+
+	if err := firewall.AllowLanServices(s._preferences.FwAllowLanServices); err != nil {
+		log.Error("Failed to initialize firewall with allowed LAN services: ", err)
+	}
+
+	if err := firewall.SetLanInboundPorts(s._preferences.FwLanInboundPorts); err != nil {
+		log.Error("Failed to initialize firewall with allowed LAN inbound ports: ", err)
+	}
+
+	if s._preferences.FwMssClamp > 0 {
+		if err := firewall.SetMssClamping(s._preferences.FwMssClamp); err != nil {
+			log.Error("Failed to apply MSS clamping: ", err)
+		}
+	}
+
 	if s._preferences.IsFwPersistant {
 		log.Info("Enabling firewal (persistant configuration)")
 		if err := firewall.SetPersistant(true); err != nil {
@@ -238,6 +344,10 @@ func (s *Service) init() error {
 		}
 	}
 
+	if err := s._portForwarding.Init(s); err != nil {
+		log.Error("Failed to initialize port forwarding manager:", err)
+	}
+
 	// start WireGuard keys rotation
 	if err := s._wgKeysMgr.Init(s); err != nil {
 		log.Error("Failed to initialize WG keys rotation:", err)
@@ -291,12 +401,25 @@ func (s *Service) init() error {
 		s.autoConnectIfRequired(OnDaemonStarted, nil)
 	}()
 
+	// connection scheduler: connect/disconnect (or enable/disable firewall) at defined time or after inactivity
+	go s.schedulerRoutine()
+
 	// Start processing power events in separate routine (Windows)
 	s.startProcessingPowerEvents()
 
 	return nil
 }
 
+// OnClockSkewDetected - the local clock differs from the API server time (implementation of api.IClockSkewNotifier)
+func (s *Service) OnClockSkewDetected(offset time.Duration) {
+	isTimeHintAllowed := s._preferences.IsApiTimeHintAllowed
+	msg := fmt.Sprintf("The system time differs from the actual time by %v. Please, synchronize the system clock: the wrong time can break the connection to the IVPN servers.", offset)
+	if !s._evtReceiver.IsClientConnected(false) {
+		s.systemLog(Warning, msg)
+	}
+	s._evtReceiver.OnClockSkewDetected(offset, isTimeHintAllowed)
+}
+
 // IsConnectivityBlocked - returns nil if connectivity NOT blocked
 func (s *Service) IsConnectivityBlocked() error {
 	preferences := s._preferences
@@ -320,6 +443,47 @@ func (s *Service) GetVpnSessionInfo() VpnSessionInfo {
 	return s._vpnSessionInfo
 }
 
+// VpnTrafficStats returns the number of bytes received/sent through the VPN tunnel ('ok' is false when not connected or not available)
+func (s *Service) VpnTrafficStats() (rx, tx uint64, ok bool) {
+	if p, isProvider := s._vpn.(vpn.TrafficStatsProvider); isProvider {
+		rx, tx, err := p.TrafficStats()
+		return rx, tx, err == nil
+	}
+
+	localIP := s.GetVpnSessionInfo().VpnLocalIPv4
+	if localIP == nil {
+		return 0, 0, false
+	}
+	iface, err := netinfo.InterfaceByIPAddr(localIP)
+	if err != nil || iface == nil {
+		return 0, 0, false
+	}
+	rx, tx, err = netinfo.InterfaceTrafficBytes(iface)
+	if err != nil {
+		return 0, 0, false
+	}
+	return rx, tx, true
+}
+
+// VpnLatestHandshake returns the time of the latest handshake with the WireGuard server
+// ('ok' is false when not connected to WireGuard, paused or the info is not available)
+func (s *Service) VpnLatestHandshake() (t time.Time, ok bool) {
+	wgObj, isWg := s._vpn.(*wireguard.WireGuard)
+	if !isWg || wgObj.IsPaused() {
+		return time.Time{}, false
+	}
+	t, err := wgObj.LatestHandshake()
+	if err != nil || t.IsZero() {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// ApiRequestsStats returns the total number of API requests and the number of failed requests (since the daemon start)
+func (s *Service) ApiRequestsStats() (total, failed uint64) {
+	return s._api.RequestsStats()
+}
+
 func (s *Service) SetVpnSessionInfo(i VpnSessionInfo) {
 	s._vpnSessionInfoMutex.Lock()
 	defer s._vpnSessionInfoMutex.Unlock()
@@ -331,10 +495,6 @@ func (s *Service) updateAPIAddrInFWExceptions() {
 
 	ivpnAPIAddr := svrs.Config.API.IPAddresses
 
-	if len(ivpnAPIAddr) <= 0 {
-		return
-	}
-
 	apiAddrs := make([]net.IP, 0, len(ivpnAPIAddr))
 	for _, ipStr := range ivpnAPIAddr {
 		apiIP := net.ParseIP(ipStr)
@@ -343,6 +503,13 @@ func (s *Service) updateAPIAddrInFWExceptions() {
 		}
 	}
 
+	// alternative API server (if in use)
+	overrideIPs, err := s._api.HostOverrideIPs()
+	if err != nil {
+		log.Warning(err)
+	}
+	apiAddrs = append(apiAddrs, overrideIPs...)
+
 	if len(apiAddrs) > 0 {
 		const onlyForICMP = false
 		const isPersistent = true
@@ -384,13 +551,14 @@ func (s *Service) ServersListForceUpdate() (*api_types.ServersInfoResponse, erro
 }
 
 // APIRequest do custom request to API
-func (s *Service) APIRequest(apiAlias string, ipTypeRequired protocolTypes.RequiredIPProtocol) (responseData []byte, err error) {
+// 'cachedAt' is non-zero when the API is not reachable and the cached (stale) response is returned
+func (s *Service) APIRequest(apiAlias string, ipTypeRequired protocolTypes.RequiredIPProtocol) (responseData []byte, cachedAt time.Time, err error) {
 
 	if ipTypeRequired == protocolTypes.IPv6 {
 		// IPV6-LOC-200 - IVPN Apps should request only IPv4 location information when connected  to the gateway, which doesn’t support IPv6
 		vpn := s._vpn
 		if vpn != nil && !vpn.IsPaused() && !vpn.IsIPv6InTunnel() {
-			return nil, fmt.Errorf("no IPv6 support inside tunnel for current connection")
+			return nil, time.Time{}, fmt.Errorf("no IPv6 support inside tunnel for current connection")
 		}
 	}
 
@@ -402,7 +570,7 @@ func (s *Service) APIRequest(apiAlias string, ipTypeRequired protocolTypes.Requi
 // It can happen, for example, if some external binaries not installed
 // (e.g. obfsproxy or WireGuard on Linux)
 func (s *Service) GetDisabledFunctions() protocolTypes.DisabledFunctionality {
-	var ovpnErr, obfspErr, wgErr, splitTunErr error
+	var ovpnErr, obfspErr, v2rayErr, shadowsocksErr, wgErr, awgErr, kemErr, splitTunErr, wgKeyHwProtectionErr error
 
 	if err := filerights.CheckFileAccessRightsExecutable(platform.OpenVpnBinaryPath()); err != nil {
 		ovpnErr = fmt.Errorf("OpenVPN binary: %w", err)
@@ -412,6 +580,14 @@ func (s *Service) GetDisabledFunctions() protocolTypes.DisabledFunctionality {
 		obfspErr = fmt.Errorf("obfsproxy binary: %w", err)
 	}
 
+	if err := filerights.CheckFileAccessRightsExecutable(platform.V2RayBinaryPath()); err != nil {
+		v2rayErr = fmt.Errorf("V2Ray binary: %w", err)
+	}
+
+	if err := filerights.CheckFileAccessRightsExecutable(platform.ShadowsocksBinaryPath()); err != nil {
+		shadowsocksErr = fmt.Errorf("Shadowsocks binary: %w", err)
+	}
+
 	if err := filerights.CheckFileAccessRightsExecutable(platform.WgBinaryPath()); err != nil {
 		wgErr = fmt.Errorf("WireGuard binary: %w", err)
 	} else {
@@ -420,33 +596,72 @@ func (s *Service) GetDisabledFunctions() protocolTypes.DisabledFunctionality {
 		}
 	}
 
+	if err := filerights.CheckFileAccessRightsExecutable(platform.KemHelperBinaryPath()); err != nil {
+		kemErr = fmt.Errorf("KEM helper binary: %w", err)
+	}
+
+	if err := filerights.CheckFileAccessRightsExecutable(platform.AwgBinaryPath()); err != nil {
+		awgErr = fmt.Errorf("AmneziaWG binary: %w", err)
+	} else {
+		if err := filerights.CheckFileAccessRightsExecutable(platform.AwgToolBinaryPath()); err != nil {
+			awgErr = fmt.Errorf("AmneziaWG tools binary: %w", err)
+		}
+	}
+
 	// returns non-nil error object if Split-Tunneling functionality not available
 	splitTunErr = splittun.GetFuncNotAvailableError()
 
+	// returns non-nil error object if hardware-backed key protection not available
+	wgKeyHwProtectionErr = keyprotect.GetFuncNotAvailableError()
+
 	if errors.Is(ovpnErr, os.ErrNotExist) {
 		ovpnErr = fmt.Errorf("%w. Please install OpenVPN", ovpnErr)
 	}
 	if errors.Is(obfspErr, os.ErrNotExist) {
 		obfspErr = fmt.Errorf("%w. Please install obfsproxy binary", obfspErr)
 	}
+	if errors.Is(v2rayErr, os.ErrNotExist) {
+		v2rayErr = fmt.Errorf("%w. Please install V2Ray binary", v2rayErr)
+	}
+	if errors.Is(shadowsocksErr, os.ErrNotExist) {
+		shadowsocksErr = fmt.Errorf("%w. Please install Shadowsocks client (sslocal)", shadowsocksErr)
+	}
 	if errors.Is(wgErr, os.ErrNotExist) {
 		wgErr = fmt.Errorf("%w. Please install WireGuard", wgErr)
 	}
+	if errors.Is(awgErr, os.ErrNotExist) {
+		awgErr = fmt.Errorf("%w. Please install AmneziaWG", awgErr)
+	}
 
 	var ret protocolTypes.DisabledFunctionality
 
 	if wgErr != nil {
 		ret.WireGuardError = wgErr.Error()
 	}
+	if awgErr != nil {
+		ret.AmneziaWGError = awgErr.Error()
+	}
+	if kemErr != nil {
+		ret.WgQuantumResistanceError = kemErr.Error()
+	}
 	if ovpnErr != nil {
 		ret.OpenVPNError = ovpnErr.Error()
 	}
 	if obfspErr != nil {
 		ret.ObfsproxyError = obfspErr.Error()
 	}
+	if v2rayErr != nil {
+		ret.V2RayError = v2rayErr.Error()
+	}
+	if shadowsocksErr != nil {
+		ret.ShadowsocksError = shadowsocksErr.Error()
+	}
 	if splitTunErr != nil {
 		ret.SplitTunnelError = splitTunErr.Error()
 	}
+	if wgKeyHwProtectionErr != nil {
+		ret.WGKeyHwProtectionError = wgKeyHwProtectionErr.Error()
+	}
 
 	ret.Platform = s.implGetDisabledFuncForPlatform()
 
@@ -489,6 +704,9 @@ func (s *Service) disconnect() error {
 	// stop detections for routing changes
 	s._netChangeDetector.Stop()
 
+	// the connection stops intentionally: it is not a failure of the VPN host
+	s._isDisconnectRequested = true
+
 	// stop VPN
 	if err := vpn.Disconnect(); err != nil {
 		return fmt.Errorf("failed to disconnect VPN: %w", err)
@@ -627,6 +845,24 @@ func (s *Service) getAntiTrackerDns(isHardcore bool) (dnsCfg dns.DnsSettings, er
 	return dns.DnsSettings{DnsHost: servers.Config.Antitracker.Default.IP}, nil
 }
 
+// lanBypassKeepInTunnelIPs returns addresses which must be routed to the tunnel when WireGuard LAN bypass enabled
+// (the AntiTracker DNS servers are located in the private network range)
+func (s *Service) lanBypassKeepInTunnelIPs() []net.IP {
+	servers, err := s.ServersList()
+	if err != nil {
+		log.Warning(fmt.Sprintf("failed to determine AntiTracker parameters: %s", err))
+		return nil
+	}
+
+	var ret []net.IP
+	for _, ipStr := range []string{servers.Config.Antitracker.Default.IP, servers.Config.Antitracker.Hardcore.IP} {
+		if ip := net.ParseIP(ipStr); ip != nil {
+			ret = append(ret, ip)
+		}
+	}
+	return ret
+}
+
 // ////////////////////////////////////////////////////////
 // KillSwitch
 // ////////////////////////////////////////////////////////
@@ -648,6 +884,10 @@ func (s *Service) SetKillSwitchState(isEnabled bool) error {
 
 	err := firewall.SetEnabled(isEnabled)
 	if err == nil {
+		// the firewall state is defined by the user now: do not re-enable it after the captive portal login
+		if s.captivePortalLoginCancel() {
+			s._evtReceiver.OnCaptivePortalStatus(s.CaptivePortalStatus())
+		}
 		s.onKillSwitchStateChanged()
 		// If no any clients connected - connection notification will not be passed to user
 		// In this case we are trying to save info message into system log
@@ -705,6 +945,20 @@ func (s *Service) setKillSwitchAllowLAN(isAllowLan bool, isAllowLanMulticast boo
 	return err
 }
 
+// SetKillSwitchAllowLANServices change the list of LAN services allowed by kill-switch individually
+func (s *Service) SetKillSwitchAllowLANServices(services lansvc.Services) error {
+	if err := firewall.AllowLanServices(services); err != nil {
+		return err
+	}
+
+	prefs := s._preferences
+	prefs.FwAllowLanServices = services
+	s.setPreferences(prefs)
+
+	s.onKillSwitchStateChanged()
+	return nil
+}
+
 func (s *Service) SetKillSwitchAllowAPIServers(isAllowAPIServers bool) error {
 	if !isAllowAPIServers {
 		// Do not allow to disable access to IVPN API server if user logged-out
@@ -723,6 +977,37 @@ func (s *Service) SetKillSwitchAllowAPIServers(isAllowAPIServers bool) error {
 	return nil
 }
 
+// SetKillSwitchAllowedLanHosts set the LAN hosts allowed by kill-switch (when LAN communication is blocked)
+// Parameters:
+//   - hosts - comma separated list of local IP addresses (e.g. NAS, printer)
+func (s *Service) SetKillSwitchAllowedLanHosts(hosts string) error {
+	if err := firewall.SetAllowedLanHosts(hosts, false); err != nil {
+		return err
+	}
+
+	prefs := s._preferences
+	prefs.FwAllowedLanHosts = hosts
+	s.setPreferences(prefs)
+
+	s.onKillSwitchStateChanged()
+	return nil
+}
+
+// SetKillSwitchLanInboundPorts set the local ports which accept incoming connections from LAN
+// while the kill-switch is blocking the inbound traffic
+func (s *Service) SetKillSwitchLanInboundPorts(ports []lansvc.InboundPort) error {
+	if err := firewall.SetLanInboundPorts(ports); err != nil {
+		return err
+	}
+
+	prefs := s._preferences
+	prefs.FwLanInboundPorts = ports
+	s.setPreferences(prefs)
+
+	s.onKillSwitchStateChanged()
+	return nil
+}
+
 // SetKillSwitchUserExceptions set ip/mask to be excluded from FW block
 // Parameters:
 //   - exceptions - comma separated list of IP addresses in format: x.x.x.x[/xx]
@@ -755,6 +1040,51 @@ func (s *Service) SetPreference(key protocolTypes.ServicePreference, val string)
 			logger.Enable(val)
 		}
 
+	case protocolTypes.Prefs_IsLogJSONFormat:
+		if val, err := strconv.ParseBool(val); err == nil {
+			isChanged = val != prefs.IsLogJSONFormat
+			prefs.IsLogJSONFormat = val
+			logger.SetJSONFormat(val)
+		}
+
+	case protocolTypes.Prefs_IsSessionAutoRenew:
+		if val, err := strconv.ParseBool(val); err == nil {
+			isChanged = val != prefs.IsSessionAutoRenew
+			prefs.IsSessionAutoRenew = val
+		}
+
+	case protocolTypes.Prefs_IsSettingsEncryption:
+		if val, err := strconv.ParseBool(val); err == nil {
+			if val {
+				if err := preferences.IsEncryptionAvailable(); err != nil {
+					return false, fmt.Errorf("settings encryption is not available: %w", err)
+				}
+			}
+			isChanged = val != prefs.IsSettingsEncryption
+			prefs.IsSettingsEncryption = val
+		}
+
+	case protocolTypes.Prefs_IsCaptivePortalCheck:
+		if val, err := strconv.ParseBool(val); err == nil {
+			isChanged = val != prefs.IsCaptivePortalCheck
+			prefs.IsCaptivePortalCheck = val
+		}
+
+	case protocolTypes.Prefs_IsLogPrivacyMode:
+		if val, err := strconv.ParseBool(val); err == nil {
+			isChanged = val != prefs.IsLogPrivacyMode
+			prefs.IsLogPrivacyMode = val
+			logger.SetPrivacyMode(val)
+		}
+
+	case protocolTypes.Prefs_LogOutput:
+		out := logger.Output(val)
+		if err := logger.SetOutput(out); err != nil {
+			return false, err
+		}
+		isChanged = out != prefs.LogOutput
+		prefs.LogOutput = out
+
 	case protocolTypes.Prefs_IsAutoconnectOnLaunch:
 		if val, err := strconv.ParseBool(val); err == nil {
 			isChanged = val != prefs.IsAutoconnectOnLaunch
@@ -772,6 +1102,132 @@ func (s *Service) SetPreference(key protocolTypes.ServicePreference, val string)
 			prefs.IsAutoconnectOnLaunchDaemon = val
 		}
 
+	case protocolTypes.Prefs_IsConnectionStatsDisabled:
+		if val, err := strconv.ParseBool(val); err == nil {
+			isChanged = val != prefs.IsConnectionStatsDisabled
+			prefs.IsConnectionStatsDisabled = val
+			if val {
+				// erase the connection statistics history
+				if err := s._connStats.Clear(); err != nil {
+					log.Error(fmt.Errorf("failed to erase connection statistics: %w", err))
+				}
+			}
+		}
+
+	case protocolTypes.Prefs_DataUsageAlertSessionMB:
+		if val, err := strconv.ParseUint(val, 10, 64); err == nil {
+			isChanged = val != prefs.DataUsageAlert.SessionThresholdMB
+			prefs.DataUsageAlert.SessionThresholdMB = val
+		}
+
+	case protocolTypes.Prefs_DataUsageAlertDayMB:
+		if val, err := strconv.ParseUint(val, 10, 64); err == nil {
+			isChanged = val != prefs.DataUsageAlert.DayThresholdMB
+			prefs.DataUsageAlert.DayThresholdMB = val
+		}
+
+	case protocolTypes.Prefs_LatencyProbeMode:
+		mode := connstats.LatencyProbeMode(val)
+		if err := mode.Validate(); err != nil {
+			return false, err
+		}
+		isChanged = mode.Normalized() != prefs.LatencyProbeMode.Normalized()
+		prefs.LatencyProbeMode = mode
+
+	case protocolTypes.Prefs_FwMssClamp:
+		mss, err := strconv.Atoi(val)
+		if err != nil {
+			return false, fmt.Errorf("bad MSS value: %w", err)
+		}
+		if err := firewall.SetMssClamping(mss); err != nil {
+			return false, err
+		}
+		isChanged = mss != prefs.FwMssClamp
+		prefs.FwMssClamp = mss
+
+	case protocolTypes.Prefs_IsConnectionHistoryDisabled:
+		if val, err := strconv.ParseBool(val); err == nil {
+			isChanged = val != prefs.IsConnectionHistoryDisabled
+			prefs.IsConnectionHistoryDisabled = val
+			if val {
+				// erase the connection history
+				prefs.ConnectionHistory = nil
+			}
+		}
+
+	case protocolTypes.Prefs_IsWGKeyHwProtection:
+		if val, err := strconv.ParseBool(val); err == nil {
+			if val {
+				if err := keyprotect.GetFuncNotAvailableError(); err != nil {
+					return false, fmt.Errorf("hardware-backed key protection is not available: %w", err)
+				}
+			}
+			isChanged = val != prefs.IsWGKeyHwProtection
+			prefs.IsWGKeyHwProtection = val
+		}
+
+	case protocolTypes.Prefs_IsApiTimeHintAllowed:
+		if val, err := strconv.ParseBool(val); err == nil {
+			isChanged = val != prefs.IsApiTimeHintAllowed
+			prefs.IsApiTimeHintAllowed = val
+			s._api.SetTimeHintAllowed(val)
+		}
+
+	case protocolTypes.Prefs_IsWgFallbackToOpenVPN:
+		if val, err := strconv.ParseBool(val); err == nil {
+			isChanged = val != prefs.IsWgFallbackToOpenVPN
+			prefs.IsWgFallbackToOpenVPN = val
+		}
+
+	case protocolTypes.Prefs_IsOpenVpnDcoDisabled:
+		if val, err := strconv.ParseBool(val); err == nil {
+			isChanged = val != prefs.IsOpenVpnDcoDisabled
+			prefs.IsOpenVpnDcoDisabled = val
+		}
+
+	case protocolTypes.Prefs_IsWgLanBypass:
+		if val, err := strconv.ParseBool(val); err == nil {
+			isChanged = val != prefs.IsWgLanBypass
+			prefs.IsWgLanBypass = val
+		}
+
+	case protocolTypes.Prefs_IsWgPeerFailover:
+		if val, err := strconv.ParseBool(val); err == nil {
+			isChanged = val != prefs.IsWgPeerFailover
+			prefs.IsWgPeerFailover = val
+		}
+
+	case protocolTypes.Prefs_IsWgQuantumResistance:
+		if val, err := strconv.ParseBool(val); err == nil {
+			if val {
+				if err := s.GetDisabledFunctions().WgQuantumResistanceError; len(err) > 0 {
+					return false, fmt.Errorf("post-quantum preshared key is not available: %s", err)
+				}
+			}
+			isChanged = val != prefs.IsWgQuantumResistance
+			prefs.IsWgQuantumResistance = val
+		}
+
+	case protocolTypes.Prefs_WgKemAlgorithm:
+		if len(val) > 0 {
+			if err := kem.ValidateAlgorithm(val); err != nil {
+				return false, err
+			}
+		}
+		isChanged = val != prefs.WgKemAlgorithm
+		prefs.WgKemAlgorithm = val
+
+	case protocolTypes.Prefs_WgPortHoppingMinutes:
+		minutes, err := strconv.Atoi(val)
+		if err != nil {
+			return false, fmt.Errorf("bad port hopping interval: %w", err)
+		}
+		if minutes < 0 || minutes > wgPortHoppingMaxMinutes {
+			return false, fmt.Errorf("port hopping interval should be in range [1-%d] minutes (0 - disabled)", wgPortHoppingMaxMinutes)
+		}
+		isChanged = minutes != prefs.WgPortHoppingMinutes
+		prefs.WgPortHoppingMinutes = minutes
+
 	default:
 		log.Warning(fmt.Sprintf("Preference key '%s' not supported", key))
 	}
@@ -780,14 +1236,264 @@ func (s *Service) SetPreference(key protocolTypes.ServicePreference, val string)
 
 	if isChanged {
 		log.Info(fmt.Sprintf("(prefs '%s' changed) %s", key, val))
+
+		isPskChanged := key == protocolTypes.Prefs_IsWgQuantumResistance ||
+			(key == protocolTypes.Prefs_WgKemAlgorithm && prefs.IsWgQuantumResistance)
+		if isPskChanged && prefs.Session.IsWGCredentialsOk() {
+			// the preshared key is negotiated together with the WireGuard keys: regenerate the keys
+			go func() {
+				if err := s.WireGuardGenerateKeys(false); err != nil {
+					log.Error(fmt.Sprintf("failed to regenerate WireGuard keys: %s", err))
+				}
+			}()
+		}
 	}
 
 	return isChanged, nil
 }
 
 func (s *Service) SetObfsProxy(cfg obfsproxy.Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
 	prefs := s._preferences
 	prefs.Obfs4proxy = cfg
+	if cfg.IsObfsproxy() {
+		// obfsproxy, V2Ray and Shadowsocks can not be used at the same time
+		prefs.V2RayProxy = v2r.None
+		prefs.ShadowsocksProxy = shadowsocks.Config{}
+	}
+	s.setPreferences(prefs)
+	return nil
+}
+
+// SetV2RayProxy sets the V2Ray transport for VPN connections (v2r.None - do not use V2Ray)
+func (s *Service) SetV2RayProxy(transport v2r.V2RayTransportType) error {
+	if transport != v2r.None && !transport.IsEnabled() {
+		return fmt.Errorf("unsupported V2Ray transport type (%d)", transport)
+	}
+	if transport.IsEnabled() {
+		if err := s.GetDisabledFunctions().V2RayError; len(err) > 0 {
+			return fmt.Errorf(err)
+		}
+	}
+
+	prefs := s._preferences
+	prefs.V2RayProxy = transport
+	if transport.IsEnabled() {
+		// obfsproxy, V2Ray and Shadowsocks can not be used at the same time
+		prefs.Obfs4proxy = obfsproxy.Config{}
+		prefs.ShadowsocksProxy = shadowsocks.Config{}
+	}
+	s.setPreferences(prefs)
+	return nil
+}
+
+// SetApiProxy sets the proxy server for API requests (empty configuration - direct connection)
+func (s *Service) SetApiProxy(cfg api_types.ProxyConfig) error {
+	if err := s._api.SetProxy(cfg); err != nil {
+		return err
+	}
+
+	prefs := s._preferences
+	prefs.ApiProxy = cfg
+	s.setPreferences(prefs)
+	return nil
+}
+
+// SetApiHostOverride sets the alternative API server (empty configuration - the default IVPN API server)
+func (s *Service) SetApiHostOverride(cfg api_types.APIHostOverride) error {
+	prevIPs, _ := s._api.HostOverrideIPs()
+
+	if err := s._api.SetHostOverride(cfg); err != nil {
+		return err
+	}
+
+	prefs := s._preferences
+	prefs.ApiHostOverride = cfg
+	s.setPreferences(prefs)
+
+	// update firewall exceptions for the API servers
+	if len(prevIPs) > 0 {
+		const onlyForICMP = false
+		const isPersistent = true
+		firewall.RemoveHostsFromExceptions(prevIPs, onlyForICMP, isPersistent)
+	}
+	s.updateAPIAddrInFWExceptions()
+	return nil
+}
+
+// SetRestApiParams saves the configuration of the local REST API ('port' = 0 - keep the current port).
+// New access token is generated when it is not defined yet or when 'resetToken' is true.
+func (s *Service) SetRestApiParams(isEnabled bool, port int, resetToken bool, isMetricsEnabled bool) (preferences.RestApiParams, error) {
+	prefs := s._preferences
+	params := prefs.RestApi
+
+	params.IsEnabled = isEnabled
+	params.IsMetricsEnabled = isMetricsEnabled
+	if port > 0 {
+		params.Port = port
+	}
+	if params.Port <= 0 {
+		params.Port = preferences.RestApiDefaultPort
+	}
+	if err := params.Validate(); err != nil {
+		return prefs.RestApi, err
+	}
+
+	if resetToken || len(params.Token) == 0 {
+		token, err := preferences.GenerateRestApiToken()
+		if err != nil {
+			return prefs.RestApi, err
+		}
+		params.Token = token
+	}
+
+	prefs.RestApi = params
+	s.setPreferences(prefs)
+	return params, nil
+}
+
+// SetGrpcApiParams saves the configuration of the local gRPC API ('port' = 0 - keep the current port).
+// New access token is generated when it is not defined yet or when 'resetToken' is true.
+func (s *Service) SetGrpcApiParams(isEnabled bool, port int, resetToken bool) (preferences.GrpcApiParams, error) {
+	prefs := s._preferences
+	params := prefs.GrpcApi
+
+	params.IsEnabled = isEnabled
+	if port > 0 {
+		params.Port = port
+	}
+	if params.Port <= 0 {
+		params.Port = preferences.GrpcApiDefaultPort
+	}
+	if err := params.Validate(); err != nil {
+		return prefs.GrpcApi, err
+	}
+
+	if resetToken || len(params.Token) == 0 {
+		token, err := preferences.GenerateGrpcApiToken()
+		if err != nil {
+			return prefs.GrpcApi, err
+		}
+		params.Token = token
+	}
+
+	prefs.GrpcApi = params
+	s.setPreferences(prefs)
+	return params, nil
+}
+
+// SetLogRotation sets the configuration of the log files rotation
+func (s *Service) SetLogRotation(cfg logger.RotationConfig) error {
+	if err := logger.SetRotation(cfg); err != nil {
+		return err
+	}
+
+	prefs := s._preferences
+	prefs.LogRotation = cfg
+	s.setPreferences(prefs)
+	return nil
+}
+
+// ClientTokens returns the list of the client access tokens
+func (s *Service) ClientTokens() []preferences.ClientToken {
+	return s._preferences.ClientTokens
+}
+
+// ClientTokenAdd creates new client access token with the specified access scope
+func (s *Service) ClientTokenAdd(name string, scope preferences.ClientAccessScope) (preferences.ClientToken, error) {
+	token, err := preferences.GenerateClientToken()
+	if err != nil {
+		return preferences.ClientToken{}, err
+	}
+	t := preferences.ClientToken{Name: name, Token: token, Scope: scope}
+
+	prefs := s._preferences
+	if err := prefs.AddClientToken(t); err != nil {
+		return preferences.ClientToken{}, err
+	}
+	s.setPreferences(prefs)
+
+	t, _ = prefs.FindClientToken(token)
+	return t, nil
+}
+
+// ClientTokenRemove removes the client access token by name
+func (s *Service) ClientTokenRemove(name string) error {
+	prefs := s._preferences
+	if err := prefs.RemoveClientToken(name); err != nil {
+		return err
+	}
+	s.setPreferences(prefs)
+	return nil
+}
+
+// SetAmneziaWG sets AmneziaWG obfuscation parameters for WireGuard connections (empty configuration - vanilla WireGuard).
+// The parameters are applied on the next WireGuard connection.
+func (s *Service) SetAmneziaWG(cfg awg.Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if cfg.IsEnabled() {
+		if err := s.GetDisabledFunctions().AmneziaWGError; len(err) > 0 {
+			return fmt.Errorf(err)
+		}
+	}
+
+	prefs := s._preferences
+	prefs.AmneziaWG = cfg
+	s.setPreferences(prefs)
+	return nil
+}
+
+// SetShadowsocksProxy sets the user-defined Shadowsocks server to chain VPN connections through
+// (empty configuration - do not use Shadowsocks)
+func (s *Service) SetShadowsocksProxy(cfg shadowsocks.Config) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+	if cfg.IsEnabled() {
+		if err := s.GetDisabledFunctions().ShadowsocksError; len(err) > 0 {
+			return fmt.Errorf(err)
+		}
+	}
+
+	prefs := s._preferences
+	prefs.ShadowsocksProxy = cfg
+	if cfg.IsEnabled() {
+		// obfsproxy, V2Ray and Shadowsocks can not be used at the same time
+		prefs.Obfs4proxy = obfsproxy.Config{}
+		prefs.V2RayProxy = v2r.None
+	}
+	s.setPreferences(prefs)
+	return nil
+}
+
+// SetOpenVpnExtraParameters sets the additional OpenVPN directives (one per line; empty string - no additional directives).
+// The parameters are applied on the next OpenVPN connection.
+func (s *Service) SetOpenVpnExtraParameters(params string) error {
+	params, err := openvpn.ValidateExtraParameters(params)
+	if err != nil {
+		return err
+	}
+
+	prefs := s._preferences
+	prefs.OpenVpnExtraParameters = params
+	s.setPreferences(prefs)
+	return nil
+}
+
+// SetOpenVpnCryptoPolicy sets the TLS/cipher policy for OpenVPN connections (empty policy - default parameters).
+// The policy is applied on the next OpenVPN connection.
+func (s *Service) SetOpenVpnCryptoPolicy(policy vpn.OpenVpnCryptoPolicy) error {
+	if err := policy.Validate(); err != nil {
+		return err
+	}
+
+	prefs := s._preferences
+	prefs.OpenVpnCryptoPolicy = policy
 	s.setPreferences(prefs)
 	return nil
 }
@@ -812,6 +1518,11 @@ func (s *Service) Preferences() preferences.Preferences {
 }
 
 func (s *Service) ResetPreferences() error {
+	// log out the stored (not active) accounts
+	for _, a := range s._preferences.StoredAccounts {
+		s.AccountRemove(a.Session.AccountID)
+	}
+
 	s._preferences = *preferences.Create()
 
 	// erase ST config
@@ -854,6 +1565,25 @@ func (s *Service) setConnectionParams(params types.ConnectionParams) error {
 	return nil
 }
 
+// ConnectionHistory returns recently used connection parameters (the most recent first)
+func (s *Service) ConnectionHistory() []preferences.ConnectionHistoryItem {
+	return s._preferences.ConnectionHistory
+}
+
+// ConnectionHistoryClear erases the connection history
+func (s *Service) ConnectionHistoryClear() error {
+	prefs := s._preferences
+	prefs.ConnectionHistory = nil
+	s.setPreferences(prefs)
+	return nil
+}
+
+func (s *Service) addConnectionHistory(params types.ConnectionParams) {
+	prefs := s._preferences
+	prefs.AddConnectionHistory(params)
+	s.setPreferences(prefs)
+}
+
 func (s *Service) SetWiFiSettings(params preferences.WiFiParams) error {
 	if params.CanApplyInBackground {
 		prefs := s._preferences
@@ -866,9 +1596,17 @@ func (s *Service) SetWiFiSettings(params preferences.WiFiParams) error {
 	newNets := []preferences.WiFiNetwork{}
 	keys := make(map[string]struct{})
 	for _, n := range params.Networks {
-		if _, exists := keys[n.SSID]; !exists && len(n.SSID) > 0 {
+		if len(n.BSSID) > 0 {
+			mac, err := net.ParseMAC(strings.TrimSpace(n.BSSID))
+			if err != nil || len(mac) != 6 {
+				return fmt.Errorf("bad BSSID '%s' for WiFi network '%s'", n.BSSID, n.SSID)
+			}
+			n.BSSID = mac.String()
+		}
+		key := n.SSID + "/" + n.BSSID
+		if _, exists := keys[key]; !exists && (len(n.SSID) > 0 || len(n.BSSID) > 0) {
 			newNets = append(newNets, n)
-			keys[n.SSID] = struct{}{}
+			keys[key] = struct{}{}
 		}
 	}
 	params.Networks = newNets
@@ -907,7 +1645,13 @@ func (s *Service) SplitTunnelling_GetStatus() (protocolTypes.SplitTunnelStatus,
 		IsEnabled:                   prefs.IsSplitTunnel,
 		IsCanGetAppIconForBinary:    oshelpers.IsCanGetAppIconForBinary(),
 		SplitTunnelApps:             prefs.SplitTunnelApps,
-		RunningApps:                 runningProcesses}
+		RunningApps:                 runningProcesses,
+		IsCanSplitDestinations:      splittun.IsCanSplitDestinations(),
+		Destinations:                prefs.SplitTunnelDestinations,
+		IsCanSplitContainers:        splittun.IsCanSplitContainers(),
+		Containers:                  prefs.SplitTunnelContainers,
+		IsCanSplitUsers:             splittun.IsCanSplitUsers(),
+		Users:                       prefs.SplitTunnelUsers}
 
 	return ret, nil
 }
@@ -927,6 +1671,9 @@ func (s *Service) splitTunnelling_Reset() error {
 	prefs := s._preferences
 	prefs.IsSplitTunnel = false
 	prefs.SplitTunnelApps = make([]string, 0)
+	prefs.SplitTunnelDestinations = nil
+	prefs.SplitTunnelContainers = nil
+	prefs.SplitTunnelUsers = nil
 	s.setPreferences(prefs)
 
 	splittun.Reset()
@@ -951,16 +1698,31 @@ func (s *Service) splitTunnelling_ApplyConfig() error {
 		IPv6Tunnel: sInf.VpnLocalIPv6,
 		IPv6Public: sInf.OutboundIPv6}
 
-	return splittun.ApplyConfig(prefs.IsSplitTunnel, s.Connected(), addressesCfg, prefs.SplitTunnelApps)
+	err := splittun.ApplyConfig(prefs.IsSplitTunnel, s.Connected(), addressesCfg, prefs.SplitTunnelApps)
+
+	if errCont := splittun.ApplyContainers(prefs.SplitTunnelContainers); errCont != nil {
+		log.Error(errCont)
+	}
+	if errUsers := splittun.ApplyUsers(prefs.SplitTunnelUsers); errUsers != nil {
+		log.Error(errUsers)
+	}
+
+	// destinations are resolved and applied asynchronously
+	s.splitTunnelling_UpdateDestinations()
+
+	return err
 }
 
-func (s *Service) SplitTunnelling_AddApp(exec string) (cmdToExecute string, isAlreadyRunning bool, err error) {
+// SplitTunnelling_AddApp adds the application to the Split Tunneling environment.
+// 'requesterUid' - UID of the client process which requested the operation (-1 - unknown);
+// on macOS the application is started by the daemon under the account of this user.
+func (s *Service) SplitTunnelling_AddApp(exec string, requesterUid int) (cmdToExecute string, isAlreadyRunning bool, err error) {
 	if !s._preferences.IsSplitTunnel {
 		return "", false, fmt.Errorf("unable to run application in Split Tunneling environment: Split Tunneling is disabled")
 	}
 	// apply ST configuration after function ends
 	defer s.splitTunnelling_ApplyConfig()
-	return s.implSplitTunnelling_AddApp(exec)
+	return s.implSplitTunnelling_AddApp(exec, requesterUid)
 }
 
 func (s *Service) SplitTunnelling_RemoveApp(pid int, exec string) (err error) {
@@ -1012,11 +1774,14 @@ func (s *Service) setCredentials(accountInfo preferences.AccountStatus, accountI
 	return nil
 }
 
-// SessionNew creates new session
-func (s *Service) SessionNew(accountID string, forceLogin bool, captchaID string, captcha string, confirmation2FA string) (
+// SessionNew creates new session.
+// If 'keepCurrentAccount' is true - the active session of another account is not deleted:
+// it is kept in the list of stored accounts (see AccountSwitch)
+func (s *Service) SessionNew(accountID string, forceLogin bool, keepCurrentAccount bool, captchaID string, captcha string, confirmation2FA string) (
 	apiCode int,
 	apiErrorMsg string,
 	accountInfo preferences.AccountStatus,
+	captchaInfo api_types.CaptchaInfo,
 	rawResponse string,
 	err error) {
 
@@ -1033,16 +1798,29 @@ func (s *Service) SessionNew(accountID string, forceLogin bool, captchaID string
 		}
 	}()
 
-	// delete current session (if exists)
-	isCanDeleteSessionLocally := true
-	if err := s.SessionDelete(isCanDeleteSessionLocally); err != nil {
-		log.Error("Creating new session -> Failed to delete active session: ", err)
+	// delete current session (if exists) or keep it in the list of stored accounts
+	currSession := s.Preferences().Session
+	if keepCurrentAccount && currSession.IsLoggedIn() && !strings.EqualFold(currSession.AccountID, accountID) {
+		if err := s.stashActiveSession(); err != nil {
+			return apiCode, "", accountInfo, captchaInfo, "", err
+		}
+		defer func() {
+			if err != nil {
+				// switch back to the previously active account
+				s.restoreStashedSession(currSession.AccountID)
+			}
+		}()
+	} else {
+		isCanDeleteSessionLocally := true
+		if err := s.SessionDelete(isCanDeleteSessionLocally); err != nil {
+			log.Error("Creating new session -> Failed to delete active session: ", err)
+		}
 	}
 
 	// generate new keys for WireGuard
 	publicKey, privateKey, err := wireguard.GenerateKeys(platform.WgToolBinaryPath())
 	if err != nil {
-		log.Warning("Failed to generate wireguard keys for new session: %s", err)
+		log.Warning(fmt.Sprintf("Failed to generate wireguard keys for new session: %s", err))
 	}
 
 	log.Info("Logging in...")
@@ -1054,7 +1832,7 @@ func (s *Service) SessionNew(accountID string, forceLogin bool, captchaID string
 
 		}
 	}()
-	successResp, errorLimitResp, apiErr, rawRespStr, err := s._api.SessionNew(accountID, publicKey, forceLogin, captchaID, captcha, confirmation2FA)
+	successResp, errorLimitResp, errorCaptchaResp, apiErr, rawRespStr, err := s._api.SessionNew(accountID, publicKey, forceLogin, captchaID, captcha, confirmation2FA)
 	rawResponse = rawRespStr
 
 	apiCode = 0
@@ -1066,20 +1844,26 @@ func (s *Service) SessionNew(accountID string, forceLogin bool, captchaID string
 		// if SessionsLimit response
 		if errorLimitResp != nil {
 			accountInfo = s.createAccountStatus(errorLimitResp.SessionLimitData)
-			return apiCode, apiErr.Message, accountInfo, rawResponse, err
+			return apiCode, apiErr.Message, accountInfo, captchaInfo, rawResponse, err
+		}
+
+		// if captcha required (or captcha is not valid)
+		if errorCaptchaResp != nil {
+			captchaInfo = errorCaptchaResp.CaptchaInfo
+			return apiCode, apiErr.Message, accountInfo, captchaInfo, rawResponse, err
 		}
 
 		// in case of other API error
 		if apiErr != nil {
-			return apiCode, apiErr.Message, accountInfo, rawResponse, err
+			return apiCode, apiErr.Message, accountInfo, captchaInfo, rawResponse, err
 		}
 
 		// not API error
-		return apiCode, "", accountInfo, rawResponse, err
+		return apiCode, "", accountInfo, captchaInfo, rawResponse, err
 	}
 
 	if successResp == nil {
-		return apiCode, "", accountInfo, rawResponse, fmt.Errorf("unexpected error when creating a new session")
+		return apiCode, "", accountInfo, captchaInfo, rawResponse, fmt.Errorf("unexpected error when creating a new session")
 	}
 
 	// get account status info
@@ -1094,7 +1878,14 @@ func (s *Service) SessionNew(accountID string, forceLogin bool, captchaID string
 		privateKey,
 		successResp.WireGuard.IPAddress, 0)
 
-	return apiCode, "", accountInfo, rawResponse, nil
+	// the previous session of this account (if it was stored) is not required anymore
+	if _, ok := s._preferences.FindStoredAccount(accountID); ok {
+		if err := s.AccountRemove(accountID); err != nil {
+			log.Error("Creating new session -> Failed to remove previous session of the account: ", err)
+		}
+	}
+
+	return apiCode, "", accountInfo, captchaInfo, rawResponse, nil
 }
 
 // SessionDelete removes session info
@@ -1160,14 +1951,6 @@ func (s *Service) logOut(sessionNeedToDeleteOnBackend bool, isCanDeleteSessionLo
 	return nil
 }
 
-func (s *Service) OnSessionNotFound() {
-	// Logging out now
-	log.Info("Session not found. Logging out.")
-	needToDeleteOnBackend := false
-	canLogoutOnlyLocally := true
-	s.logOut(needToDeleteOnBackend, canLogoutOnlyLocally)
-}
-
 func (s *Service) OnAccountStatus(sessionToken string, accountInfo preferences.AccountStatus) {
 	// save last known info about account status
 	s._preferences.UpdateAccountInfo(accountInfo)
@@ -1216,7 +1999,8 @@ func (s *Service) RequestSessionStatus() (
 
 		// Session not found - can happens when user forced to logout from another device
 		if apiCode == api_types.SessionNotFound {
-			s.OnSessionNotFound()
+			isConfirmed := true
+			s.onSessionInvalid(session.Session, isConfirmed)
 		}
 
 		// save last account info AND notify clients that account not active
@@ -1319,8 +2103,9 @@ func (s *Service) stopSessionChecker() {
 //////////////////////////////////////////////////////////
 
 // WireGuardSaveNewKeys saves WG keys
-func (s *Service) WireGuardSaveNewKeys(wgPublicKey string, wgPrivateKey string, wgLocalIP string) {
-	s._preferences.UpdateWgCredentials(wgPublicKey, wgPrivateKey, wgLocalIP)
+// 'wgPresharedKey' - the preshared key negotiated using post-quantum KEM (empty - not in use)
+func (s *Service) WireGuardSaveNewKeys(wgPublicKey string, wgPrivateKey string, wgLocalIP string, wgPresharedKey string) {
+	s._preferences.UpdateWgCredentials(wgPublicKey, wgPrivateKey, wgLocalIP, wgPresharedKey)
 
 	// notify clients about session (wg keys) update
 	s._evtReceiver.OnServiceSessionChanged()
@@ -1358,6 +2143,16 @@ func (s *Service) WireGuardSetKeysRotationInterval(interval int64) {
 	s._evtReceiver.OnServiceSessionChanged()
 }
 
+// WireGuardIsQuantumResistance returns 'true' when the WireGuard preshared key has to be negotiated using post-quantum KEM
+// and the KEM algorithm to use
+func (s *Service) WireGuardIsQuantumResistance() (isEnabled bool, kemAlgorithm string) {
+	kemAlgorithm = s._preferences.WgKemAlgorithm
+	if len(kemAlgorithm) == 0 {
+		kemAlgorithm = kem.DefaultAlgorithm
+	}
+	return s._preferences.IsWgQuantumResistance, kemAlgorithm
+}
+
 // WireGuardGetKeys get WG keys
 func (s *Service) WireGuardGetKeys() (session, wgPublicKey, wgPrivateKey, wgLocalIP string, generatedTime time.Time, updateInterval time.Duration) {
 	p := s._preferences
@@ -1394,17 +2189,44 @@ func (s *Service) WireGuardGenerateKeys(updateIfNecessary bool) error {
 // Diagnostic
 // ////////////////////////////////////////////////////////
 func (s *Service) GetDiagnosticLogs() (logActive string, logPrevSession string, extraInfo string, err error) {
-	log, log0, err := logger.GetLogText(1024 * 64)
+	info, err := s.getDiagnosticLogs(context.Background(), nil)
 	if err != nil {
 		return "", "", "", err
 	}
+	return info.Log1_Active, info.Log0_Old, info.ExtraInfo, nil
+}
 
+// getDiagnosticLogs collects diagnostics info.
+// 'progress' function (can be nil) is called before each step
+func (s *Service) getDiagnosticLogs(ctx context.Context, progress operations.ProgressFunc) (protocolTypes.DiagnosticsInfo, error) {
+	if progress != nil {
+		progress(0, "Reading logs")
+	}
+	log, log0, err := logger.GetLogText(1024 * 64)
+	if err != nil {
+		return protocolTypes.DiagnosticsInfo{}, err
+	}
+
+	if err := ctx.Err(); err != nil {
+		return protocolTypes.DiagnosticsInfo{}, err
+	}
+
+	if progress != nil {
+		progress(30, "Collecting system info")
+	}
 	extraInfo, err1 := s.implGetDiagnosticExtraInfo()
 	if err1 != nil {
 		extraInfo = fmt.Sprintf("<failed to obtain extra info> : %s : %s", err1.Error(), extraInfo)
 	}
+	prefs := s.Preferences()
+	extraInfo = fmt.Sprintf("[ Preferences schema ]:\n%s\n\n%s", prefs.SchemaInfo(), extraInfo)
+
+	if logger.IsPrivacyMode() {
+		// the log files can contain records which were written before the privacy mode was enabled
+		log, log0, extraInfo = logger.Scrub(log), logger.Scrub(log0), logger.Scrub(extraInfo)
+	}
 
-	return log, log0, extraInfo, nil
+	return protocolTypes.DiagnosticsInfo{Log1_Active: log, Log0_Old: log0, ExtraInfo: extraInfo}, nil
 }
 
 func (s *Service) diagnosticGetCommandOutput(command string, args ...string) string {
@@ -0,0 +1,118 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+)
+
+// StoredAccounts returns the logged-in accounts which are not active at the moment
+func (s *Service) StoredAccounts() []preferences.StoredAccount {
+	return s._preferences.StoredAccounts
+}
+
+// AccountSwitch makes the stored (logged-in but not active) account active, without logout/login.
+// The currently active session is kept in the list of stored accounts.
+// Active VPN connection is re-established with the credentials of the new account.
+func (s *Service) AccountSwitch(accountID string) error {
+	prefs := s._preferences
+	if err := prefs.ActivateStoredAccount(accountID); err != nil {
+		return err
+	}
+
+	s._wgKeysMgr.StopKeysRotation()
+	s.setPreferences(prefs)
+	log.Info("Switched to another account")
+
+	// notify clients about session update
+	s._evtReceiver.OnServiceSessionChanged()
+
+	s.startSessionChecker()
+	if err := s._wgKeysMgr.StartKeysRotation(); err != nil {
+		log.Error(err)
+	}
+
+	go func() {
+		// reconnect in separate routine (do not block current thread)
+		if !s.Connected() || s.IsPaused() {
+			return
+		}
+		log.Info("Reconnecting with the credentials of the new account...")
+		s.reconnect()
+	}()
+
+	return nil
+}
+
+// AccountRemove logs out the stored (not active) account: the session is deleted on the backend (if possible) and locally
+func (s *Service) AccountRemove(accountID string) error {
+	prefs := s._preferences
+	stored, err := prefs.RemoveStoredAccount(accountID)
+	if err != nil {
+		return err
+	}
+
+	restoreFw := s.allowApiServersTemporary()
+	defer restoreFw()
+
+	log.Info("Logging out the stored account")
+	if err := s._api.SessionDelete(stored.Session.Session); err != nil {
+		// the session is erased locally anyway
+		log.Info("Logging out the stored account error:", err)
+	}
+
+	s.setPreferences(prefs)
+	return nil
+}
+
+// stashActiveSession moves the active session to the list of stored accounts
+// (the session is not deleted on the backend; the user is logged out locally)
+func (s *Service) stashActiveSession() error {
+	prefs := s._preferences
+	if err := prefs.StashActiveSession(); err != nil {
+		return err
+	}
+
+	// Disconnect (if connected)
+	s.Disconnect()
+	// stop session checker (use goroutine to avoid deadlocks)
+	go s.stopSessionChecker()
+	// stop WG keys rotation
+	s._wgKeysMgr.StopKeysRotation()
+
+	s.setPreferences(prefs)
+	log.Info("The active session is kept in the list of stored accounts")
+
+	// notify clients about session update
+	s._evtReceiver.OnServiceSessionChanged()
+	return nil
+}
+
+// restoreStashedSession makes the stashed account active again (e.g. when login to another account failed)
+func (s *Service) restoreStashedSession(accountID string) {
+	if err := s.AccountSwitch(accountID); err != nil {
+		log.Error(fmt.Sprintf("Failed to restore the previously active account: %s", err))
+	}
+}
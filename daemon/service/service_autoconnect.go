@@ -26,6 +26,7 @@ import (
 	"crypto/rand"
 	"fmt"
 	"math/big"
+	"net"
 	"reflect"
 	"strings"
 
@@ -38,6 +39,7 @@ import (
 
 type wifiStatus struct {
 	WifiSsid       string
+	WifiBssid      string
 	WifiIsInsecure bool
 }
 
@@ -116,8 +118,8 @@ func (s *Service) autoConnectIfRequired(reason autoConnectReason, wifiInfoPtr *w
 	var wifiInfo wifiStatus
 	// Check WiFi status (if not defined)
 	if wifiInfoPtr == nil {
-		ssid, isInsecure := s.GetWiFiCurrentState()
-		wifiInfo = wifiStatus{WifiSsid: ssid, WifiIsInsecure: isInsecure}
+		ssid, bssid, isInsecure := s.GetWiFiCurrentState()
+		wifiInfo = wifiStatus{WifiSsid: ssid, WifiBssid: bssid, WifiIsInsecure: isInsecure}
 	} else {
 		wifiInfo = *wifiInfoPtr
 	}
@@ -224,22 +226,7 @@ func (s *Service) autoConnectIfRequired(reason autoConnectReason, wifiInfoPtr *w
 	case On:
 		if !s.Connected() {
 			log.Info("Automatic connection manager: connecting VPN")
-
-			connParams, retErr = s.updateParamsAccordingToMetadata(connParams)
-			if retErr != nil {
-				log.Info("[WARNING] Auto connection: failed updating connection parameters: ", retErr)
-			}
-
-			const canFixParams bool = true
-			if connParams, retErr = s.ValidateConnectionParameters(connParams, canFixParams); retErr != nil {
-				log.Error("Auto connection: error validating connection parameters: ", retErr)
-				return retErr
-			}
-
-			if retErr = s._evtReceiver.RegisterConnectionRequest(connParams); retErr != nil {
-				log.Error("Auto connection: connecting: ", retErr)
-			}
-
+			retErr = s.requestAutomaticConnection(connParams)
 		}
 	default:
 	}
@@ -247,6 +234,29 @@ func (s *Service) autoConnectIfRequired(reason autoConnectReason, wifiInfoPtr *w
 	return retErr
 }
 
+// requestAutomaticConnection - registers the request for new VPN connection (it will be processed by the 'protocol').
+// The 'Fastest'/'Random' servers are resolved according to connection metadata.
+func (s *Service) requestAutomaticConnection(connParams types.ConnectionParams) error {
+	var err error
+	// (when the entry server is resolved on connect - it will be done by Connect())
+	if !connParams.IsEntryServerResolvedOnConnect() {
+		if connParams, err = s.updateParamsAccordingToMetadata(connParams); err != nil {
+			log.Info("[WARNING] Auto connection: failed updating connection parameters: ", err)
+		}
+	}
+
+	const canFixParams bool = true
+	if connParams, err = s.ValidateConnectionParameters(connParams, canFixParams); err != nil {
+		log.Error("Auto connection: error validating connection parameters: ", err)
+		return err
+	}
+
+	if err = s._evtReceiver.RegisterConnectionRequest(connParams); err != nil {
+		log.Error("Auto connection: connecting: ", err)
+	}
+	return err
+}
+
 func (s *Service) isCanApplyWiFiActions() bool {
 	prefs := s.Preferences()
 	const onlyUiClients = true
@@ -268,20 +278,20 @@ func (s *Service) getActionForWifiNetwork(wifiInfo wifiStatus) (retAction automa
 	}
 
 	wifiParams := prefs.WiFiControl
-	if !wifiParams.TrustedNetworksControl || wifiInfo.WifiSsid == "" {
+	if !wifiParams.TrustedNetworksControl || (wifiInfo.WifiSsid == "" && wifiInfo.WifiBssid == "") {
 		return
 	}
 
 	var isNetworkTrusted *bool // nil - no action
+	actions := wifiParams.Actions
 
-	// get config for ssid
-	for _, w := range wifiParams.Networks {
-		if w.SSID != wifiInfo.WifiSsid {
-			continue
-		}
-
+	// get config for the network (the config for the access point BSSID has priority)
+	if w := wifiParams.FindNetwork(wifiInfo.WifiSsid, wifiInfo.WifiBssid); w != nil {
 		isNetworkTrusted = &w.IsTrusted
-		break
+		if w.Actions != nil {
+			// the network has own actions configuration
+			actions = *w.Actions
+		}
 	}
 
 	if isNetworkTrusted == nil {
@@ -295,18 +305,18 @@ func (s *Service) getActionForWifiNetwork(wifiInfo wifiStatus) (retAction automa
 
 	if !*isNetworkTrusted {
 		// UnTrusted
-		if wifiParams.Actions.UnTrustedConnectVpn {
+		if actions.UnTrustedConnectVpn {
 			retAction.Vpn = On
 		}
-		if wifiParams.Actions.UnTrustedEnableFirewall {
+		if actions.UnTrustedEnableFirewall {
 			retAction.Firewall = On
 		}
 	} else {
 		// Trusted
-		if wifiParams.Actions.TrustedDisconnectVpn {
+		if actions.TrustedDisconnectVpn {
 			retAction.Vpn = Off
 		}
-		if wifiParams.Actions.TrustedDisableFirewall {
+		if actions.TrustedDisableFirewall {
 			retAction.Firewall = Off
 		}
 	}
@@ -355,7 +365,7 @@ func (s *Service) updateParamsAccordingToMetadata(params types.ConnectionParams)
 				}
 				params.OpenVpnParameters.EntryVpnServer.Hosts = applicableEntryServers[rndIdx.Int64()].Hosts
 			case types.Fastest: // FASTEST SERVER (OpenVPN)
-				fastestSvr, err := getFastestServer(s, vpn.OpenVPN, applicableEntryServers, params.Metadata.FastestGatewaysExcludeList)
+				fastestSvr, err := getFastestServer(s, vpn.OpenVPN, applicableEntryServers, params.Metadata.FastestCriteria, params.Metadata.FastestGatewaysExcludeList)
 				if err != nil {
 					return params, err
 				}
@@ -384,7 +394,7 @@ func (s *Service) updateParamsAccordingToMetadata(params types.ConnectionParams)
 				}
 				params.WireGuardParameters.EntryVpnServer.Hosts = applicableEntryServers[rndIdx.Int64()].Hosts
 			case types.Fastest: // FASTEST SERVER (WireGuard)
-				fastestSvr, err := getFastestServer(s, vpn.WireGuard, applicableEntryServers, params.Metadata.FastestGatewaysExcludeList)
+				fastestSvr, err := getFastestServer(s, vpn.WireGuard, applicableEntryServers, params.Metadata.FastestCriteria, params.Metadata.FastestGatewaysExcludeList)
 				if err != nil {
 					return params, err
 				}
@@ -508,47 +518,117 @@ func getServerCountryCode[S serverBaseInterface, H hostBaseInterface](service *S
 	return ""
 }
 
-func getFastestServer[S serverBaseInterface](service *Service, vpnTypePrioritized vpn.Type, servers []S, excludedGateways []string) (ret S, err error) {
-	hosts, err := service.PingServers(4000, vpnTypePrioritized, false, true)
-	if err != nil {
-		return ret, err
-	}
-	// looking for IP with minimum ping time
-	minPingTime := -1
-	minPingTimeIp := ""
-	for ip, msTime := range hosts {
-		if minPingTime == -1 || minPingTime > msTime {
-			minPingTime = msTime
-			minPingTimeIp = ip
-		}
-	}
-
+func getFastestServer[S serverBaseInterface](service *Service, vpnTypePrioritized vpn.Type, servers []S, criteria types.FastestServerCriteria, excludedGateways []string) (ret S, err error) {
 	// Remove everything after symbol '.': "us-tx.wg.ivpn.net" => "us-tx"; or "us-tx" => "us-tx"
 	normalizeGwId := func(gwId string) string {
 		return strings.Split(gwId, ".")[0]
 	}
 	// ignored gateways in hashed map
 	excludedGatewaysHashed := make(map[string]struct{})
-	if len(excludedGateways) > 0 {
-		for _, gw := range excludedGateways {
-			excludedGatewaysHashed[normalizeGwId(gw)] = struct{}{}
-		}
+	for _, gw := range excludedGateways {
+		excludedGatewaysHashed[normalizeGwId(gw)] = struct{}{}
 	}
 
-	// looking for server info which contains host with lower ping time
+	// servers applicable for selection
+	candidates := make([]S, 0, len(servers))
 	for _, s := range servers {
-		if len(excludedGatewaysHashed) > 0 {
-			gw := normalizeGwId(s.GetServerInfoBase().Gateway)
-			if _, ok := excludedGatewaysHashed[gw]; ok {
-				continue
+		if _, ok := excludedGatewaysHashed[normalizeGwId(s.GetServerInfoBase().Gateway)]; ok {
+			continue
+		}
+		if !criteria.IsLocationAllowed(s.GetServerInfoBase()) {
+			continue
+		}
+		candidates = append(candidates, s)
+	}
+	if len(candidates) == 0 {
+		return ret, fmt.Errorf("no servers matching the fastest server criteria")
+	}
+
+	var hosts map[string]int
+	if len(criteria.Locations) > 0 {
+		// ping only the servers from the required locations
+		// (one host for each server first; then the rest hosts if there is enough time)
+		var firstHosts, restHosts []net.IP
+		for _, s := range candidates {
+			for i, h := range s.GetHostsInfoBase() {
+				if ip := net.ParseIP(h.Host); ip != nil {
+					if i == 0 {
+						firstHosts = append(firstHosts, ip)
+					} else {
+						restHosts = append(restHosts, ip)
+					}
+				}
+			}
+		}
+		hosts, err = service.pingHosts(append(firstHosts, restHosts...), 4000)
+	} else {
+		hosts, err = service.PingServers(4000, vpnTypePrioritized, false, true)
+	}
+	if err != nil {
+		log.Warning("unable to determine servers latency: ", err)
+	}
+
+	// looking for the server host with minimum ping time
+	// (when 'PreferLowLoad' - the ping time is weighted by the host load)
+	// (hosts temporarily blacklisted due to connection failures are ignored, if there are other hosts)
+	minScore := -1.0
+	for _, isSkipBlacklisted := range []bool{true, false} {
+		for _, s := range candidates {
+			for _, h := range s.GetHostsInfoBase() {
+				msTime, ok := hosts[h.Host]
+				if !ok {
+					continue
+				}
+				if isSkipBlacklisted && service._hostsHealth.IsBlacklisted(h.Host) {
+					continue
+				}
+				score := float64(msTime)
+				if criteria.PreferLowLoad {
+					score *= 1 + float64(h.Load)/100
+				}
+				if minScore < 0 || minScore > score {
+					minScore = score
+					ret = s
+				}
 			}
 		}
+		if minScore >= 0 {
+			return ret, nil
+		}
+	}
 
+	if !criteria.PreferLowLoad {
+		return ret, fmt.Errorf("unable to determine servers latency")
+	}
+
+	// no ping results: choose the server with the lowest load
+	log.Warning("unable to determine servers latency: choosing the server with the lowest load")
+	minLoad := float32(-1)
+	for _, s := range candidates {
 		for _, h := range s.GetHostsInfoBase() {
-			if h.Host == minPingTimeIp {
-				return s, nil
+			if minLoad < 0 || minLoad > h.Load {
+				minLoad = h.Load
+				ret = s
 			}
 		}
 	}
-	return ret, fmt.Errorf("unable to determine servers latency")
+	if minLoad < 0 {
+		return ret, fmt.Errorf("unable to determine servers latency")
+	}
+	return ret, nil
+}
+
+// resolveFastestServerOnConnect chooses the fastest entry server for the connection (according to the connection metadata)
+func (s *Service) resolveFastestServerOnConnect(params types.ConnectionParams) (types.ConnectionParams, error) {
+	// pinging is not possible while connected
+	if err := s.Disconnect(); err != nil {
+		return params, fmt.Errorf("unable to stop active connection: %w", err)
+	}
+
+	log.Info("Choosing the fastest server...")
+	retParams, err := s.updateParamsAccordingToMetadata(params)
+	if err != nil {
+		return params, fmt.Errorf("unable to choose the fastest server: %w", err)
+	}
+	return retParams, nil
 }
@@ -0,0 +1,221 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/crashreport"
+	"github.com/ivpn/desktop-app/daemon/hostroute"
+	"github.com/ivpn/desktop-app/daemon/service/captiveportal"
+	"github.com/ivpn/desktop-app/daemon/service/firewall"
+)
+
+// Max time to wait for the captive portal check result
+const captivePortalCheckTimeout = time.Second * 5
+
+// Min interval between automatic captive portal checks (on connection attempts)
+const captivePortalAutoCheckInterval = time.Second * 30
+
+// Default and max time of the temporarily disabled firewall for the portal login
+const (
+	captivePortalLoginDefaultTime = time.Minute * 5
+	captivePortalLoginMaxTime     = time.Minute * 15
+)
+
+type captivePortalState struct {
+	mutex      sync.Mutex
+	status     captiveportal.Status
+	isChecking bool
+	// re-enables the firewall when the time for the portal login elapsed (nil - firewall is not relaxed)
+	reLockTimer *time.Timer
+}
+
+// CaptivePortalStatus returns the result of the last captive portal check
+func (s *Service) CaptivePortalStatus() captiveportal.Status {
+	s._captivePortal.mutex.Lock()
+	defer s._captivePortal.mutex.Unlock()
+	return s._captivePortal.status
+}
+
+// CaptivePortalCheck checks if the internet access is restricted by a captive portal.
+// The probe is performed outside the VPN tunnel (if VPN is connected or connecting)
+// and the probe host is allowed by the firewall during the check.
+func (s *Service) CaptivePortalCheck() captiveportal.Status {
+	result := captiveportal.Check(captivePortalCheckTimeout, s.captivePortalAllowProbeHosts)
+
+	s._captivePortal.mutex.Lock()
+	prevStatus := s._captivePortal.status
+	result.LoginAllowedUntil = prevStatus.LoginAllowedUntil
+	s._captivePortal.status = result
+	s._captivePortal.mutex.Unlock()
+
+	if result.IsDetected != prevStatus.IsDetected || result.IsDetected {
+		if result.IsDetected && !s._evtReceiver.IsClientConnected(false) {
+			s.systemLog(Warning, "Captive portal detected. Please, log in to the network to access the internet.")
+		}
+		s._evtReceiver.OnCaptivePortalStatus(result)
+	}
+	return result
+}
+
+// captivePortalCheckOnConnecting performs the captive portal check (asynchronously) when the connection is establishing
+// (if enabled by the user: the check sends the request to a third-party server)
+func (s *Service) captivePortalCheckOnConnecting() {
+	if !s._preferences.IsCaptivePortalCheck {
+		return
+	}
+
+	s._captivePortal.mutex.Lock()
+	defer s._captivePortal.mutex.Unlock()
+
+	lastCheck := time.Unix(s._captivePortal.status.CheckedAt, 0)
+	if s._captivePortal.isChecking || time.Since(lastCheck) < captivePortalAutoCheckInterval {
+		return
+	}
+	s._captivePortal.isChecking = true
+
+	crashreport.Go("captive portal check", func() {
+		defer func() {
+			s._captivePortal.mutex.Lock()
+			s._captivePortal.isChecking = false
+			s._captivePortal.mutex.Unlock()
+		}()
+		s.CaptivePortalCheck()
+	})
+}
+
+// captivePortalAllowProbeHosts ensures the probe hosts are accessible outside the VPN tunnel
+// (implementation of captiveportal.AllowHostsFunc)
+func (s *Service) captivePortalAllowProbeHosts(IPs []net.IP) (revert func()) {
+	var routes []*hostroute.Route
+	if s._vpn != nil {
+		for _, ip := range IPs {
+			r, err := hostroute.Add(ip)
+			if err != nil {
+				log.Warning(fmt.Errorf("captive portal check: failed to add route to %s: %w", ip, err))
+				continue
+			}
+			routes = append(routes, r)
+		}
+	}
+
+	const onlyForICMP = false
+	const isPersistent = false
+	isFwException := false
+	if enabled, _ := firewall.GetEnabled(); enabled {
+		if err := firewall.AddHostsToExceptions(IPs, onlyForICMP, isPersistent); err != nil {
+			log.Warning(fmt.Errorf("captive portal check: failed to add firewall exceptions: %w", err))
+		} else {
+			isFwException = true
+		}
+	}
+
+	return func() {
+		if isFwException {
+			firewall.RemoveHostsFromExceptions(IPs, onlyForICMP, isPersistent)
+		}
+		for _, r := range routes {
+			r.Remove()
+		}
+	}
+}
+
+// CaptivePortalAllowLogin temporarily disables the firewall to allow the user to log in to the captive portal.
+// The firewall is enabled back when 'duration' elapsed (0 - default duration) or on CaptivePortalReLock().
+// The VPN must be disconnected.
+func (s *Service) CaptivePortalAllowLogin(duration time.Duration) (captiveportal.Status, error) {
+	if duration <= 0 {
+		duration = captivePortalLoginDefaultTime
+	}
+	if duration > captivePortalLoginMaxTime {
+		duration = captivePortalLoginMaxTime
+	}
+
+	if s.Connected() {
+		return s.CaptivePortalStatus(), fmt.Errorf("unable to disable the firewall for the captive portal login: VPN is connected (please, disconnect first)")
+	}
+
+	s._captivePortal.mutex.Lock()
+	if s._captivePortal.reLockTimer == nil {
+		enabled, err := firewall.GetEnabled()
+		if err != nil {
+			s._captivePortal.mutex.Unlock()
+			return s.CaptivePortalStatus(), err
+		}
+		if !enabled {
+			s._captivePortal.mutex.Unlock()
+			return s.CaptivePortalStatus(), fmt.Errorf("the firewall is already disabled")
+		}
+		log.Info(fmt.Sprintf("Disabling the firewall for the captive portal login (%v)...", duration))
+		if err := firewall.SetEnabled(false); err != nil {
+			s._captivePortal.mutex.Unlock()
+			return s.CaptivePortalStatus(), err
+		}
+		s._captivePortal.reLockTimer = time.AfterFunc(duration, s.CaptivePortalReLock)
+	} else {
+		s._captivePortal.reLockTimer.Reset(duration)
+	}
+	s._captivePortal.status.LoginAllowedUntil = time.Now().Add(duration).Unix()
+	status := s._captivePortal.status
+	s._captivePortal.mutex.Unlock()
+
+	s.onKillSwitchStateChanged()
+	s._evtReceiver.OnCaptivePortalStatus(status)
+	return status, nil
+}
+
+// CaptivePortalReLock enables the firewall back (if it was disabled for the captive portal login)
+func (s *Service) CaptivePortalReLock() {
+	if !s.captivePortalLoginCancel() {
+		return
+	}
+
+	log.Info("Enabling the firewall back after the captive portal login...")
+	if err := firewall.SetEnabled(true); err != nil {
+		log.Error("Failed to enable the firewall after the captive portal login:", err)
+	}
+	s.onKillSwitchStateChanged()
+
+	// notify clients and check if the portal login was successful
+	s._evtReceiver.OnCaptivePortalStatus(s.CaptivePortalStatus())
+	crashreport.Go("captive portal check", func() { s.CaptivePortalCheck() })
+}
+
+// captivePortalLoginCancel stops the timer of the firewall re-lock (e.g. the firewall state was changed by the user).
+// Returns 'true' if the firewall was relaxed for the captive portal login.
+func (s *Service) captivePortalLoginCancel() bool {
+	s._captivePortal.mutex.Lock()
+	defer s._captivePortal.mutex.Unlock()
+
+	if s._captivePortal.reLockTimer == nil {
+		return false
+	}
+	s._captivePortal.reLockTimer.Stop()
+	s._captivePortal.reLockTimer = nil
+	s._captivePortal.status.LoginAllowedUntil = 0
+	return true
+}
@@ -30,16 +30,20 @@ import (
 	"math/big"
 	"net"
 	"os"
+	"runtime"
 	"strings"
 	"sync"
 	"time"
 
 	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/crashreport"
 	"github.com/ivpn/desktop-app/daemon/helpers"
+	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/netinfo"
 	"github.com/ivpn/desktop-app/daemon/obfsproxy"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
 	"github.com/ivpn/desktop-app/daemon/service/firewall"
+	"github.com/ivpn/desktop-app/daemon/service/hostshealth"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
 	"github.com/ivpn/desktop-app/daemon/service/platform/filerights"
 	"github.com/ivpn/desktop-app/daemon/service/srverrors"
@@ -50,9 +54,13 @@ import (
 )
 
 func (s *Service) ValidateConnectionParameters(params types.ConnectionParams, isCanFix bool) (types.ConnectionParams, error) {
+	if err := validateTunnelIPMode(params); err != nil {
+		return params, err
+	}
+
 	if params.VpnType == vpn.WireGuard {
 		// WireGuard connection parameters
-		if len(params.WireGuardParameters.EntryVpnServer.Hosts) <= 0 {
+		if len(params.WireGuardParameters.EntryVpnServer.Hosts) <= 0 && !params.IsEntryServerResolvedOnConnect() {
 			return params, fmt.Errorf("no hosts defined for WireGuard connection")
 		}
 		if len(params.WireGuardParameters.MultihopExitServer.Hosts) > 0 {
@@ -66,9 +74,12 @@ func (s *Service) ValidateConnectionParameters(params types.ConnectionParams, is
 		}
 	} else {
 		// OpenVPN connection parameters
-		if len(params.OpenVpnParameters.EntryVpnServer.Hosts) <= 0 {
+		if len(params.OpenVpnParameters.EntryVpnServer.Hosts) <= 0 && !params.IsEntryServerResolvedOnConnect() {
 			return params, fmt.Errorf("no hosts defined for OpenVPN connection")
 		}
+		if err := params.OpenVpnParameters.Proxy.Validate(params.OpenVpnParameters.Port.Protocol > 0); err != nil {
+			return params, err
+		}
 		if len(params.OpenVpnParameters.MultihopExitServer.Hosts) > 0 {
 			if mhErr := s.IsCanConnectMultiHop(); mhErr != nil {
 				if !isCanFix {
@@ -82,16 +93,59 @@ func (s *Service) ValidateConnectionParameters(params types.ConnectionParams, is
 	return params, nil
 }
 
+// validateTunnelIPMode checks if the requested tunnel IP mode is applicable for the connection parameters
+func validateTunnelIPMode(params types.ConnectionParams) error {
+	switch params.TunnelIPMode {
+	case vpn.TunnelIPAuto, vpn.TunnelIPv4Only:
+		return nil
+	case vpn.TunnelIPv6Only:
+		if params.VpnType != vpn.WireGuard {
+			return fmt.Errorf("IPv6-only tunnel is applicable only for WireGuard connections")
+		}
+		if runtime.GOOS == "windows" {
+			// IPv6 DNS is not supported on Windows: it is not possible to resolve names when IPv4 is not tunneled
+			return fmt.Errorf("IPv6-only tunnel is not supported on this platform")
+		}
+		if !params.ManualDNS.IsEmpty() {
+			if isIPv6, _ := params.ManualDNS.IsIPv6(); !isIPv6 {
+				return fmt.Errorf("IPv6-only tunnel requires IPv6 DNS server (custom DNS '%s' is IPv4)", params.ManualDNS.DnsHost)
+			}
+		}
+		if params.Metadata.AntiTracker.IsEnabled() {
+			return fmt.Errorf("AntiTracker is not applicable for IPv6-only tunnel")
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported tunnel IP mode (%d)", params.TunnelIPMode)
+}
+
 func (s *Service) Connect(params types.ConnectionParams) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
 			err = errors.New("panic on connect: " + fmt.Sprint(r))
-			log.Error(err)
+			crashreport.Save("Connect", r)
 		}
 	}()
 
+	if params.CustomConfig.IsDefined() {
+		// user-defined configuration ("bring your own config"): not related to the IVPN servers and account
+		return s.connectCustomConfig(params)
+	}
+
+	if params.IsEntryServerResolvedOnConnect() {
+		// the daemon chooses the fastest entry server
+		if params, err = s.resolveFastestServerOnConnect(params); err != nil {
+			return err
+		}
+	}
+
 	// keep last used connection params
 	s.setConnectionParams(params)
+	s.addConnectionHistory(params)
+
+	if err := validateTunnelIPMode(params); err != nil {
+		return err
+	}
 
 	prefs := s.Preferences()
 
@@ -111,29 +165,55 @@ func (s *Service) Connect(params types.ConnectionParams) (err error) {
 		}
 	}
 
+	return s.connectByParams(params)
+}
+
+// connectByParams establishes the VPN connection (protocol-specific part of Connect())
+func (s *Service) connectByParams(params types.ConnectionParams) (err error) {
+	// check the client-defined entry/exit hosts against the servers list
+	if params, err = s.resolveConnectionHosts(params); err != nil {
+		return err
+	}
+
 	// Protocol-specific configurations
 	if vpn.Type(params.VpnType) == vpn.OpenVPN {
 		// PARAMETERS VALIDATION
 		// parsing hosts
-		var hosts []net.IP
-		for _, v := range params.OpenVpnParameters.EntryVpnServer.Hosts {
-			hosts = append(hosts, net.ParseIP(v.Host))
+		// (IPv6-only network: the hosts reachable over IPv6 are in use;
+		// the obfsproxy and the proxies are using IPv4 address of the host on dual-stack networks)
+		prefs := s.Preferences()
+		isEndpointProbeAllowed := !prefs.Obfs4proxy.IsObfsproxy() && !prefs.V2RayProxy.IsEnabled() && !prefs.ShadowsocksProxy.IsEnabled() && !params.OpenVpnParameters.Proxy.IsDefined()
+		endpoints := s.newEndpointSelector(isEndpointProbeAllowed)
+		var hosts []api_types.OpenVPNServerHostInfo
+		if hosts, err = filterHostsByEndpoint(endpoints, params.OpenVpnParameters.EntryVpnServer.Hosts); err != nil {
+			return err
 		}
+		// (hosts with recent connection failures are deprioritized)
+		hosts = healthyHosts(s, hosts)
 		if len(hosts) < 1 {
 			return fmt.Errorf("VPN host not defined")
 		}
 		// in case of multiple hosts - take random host from the list
-		host := hosts[0]
+		hostInfo := hosts[0]
 		if len(hosts) > 1 {
 			if rnd, err := rand.Int(rand.Reader, big.NewInt(int64(len(hosts)))); err == nil {
-				host = hosts[rnd.Int64()]
+				hostInfo = hosts[rnd.Int64()]
 			}
 		}
+		host, err := hostEndpointIP(endpoints, hostInfo)
+		if err != nil {
+			return err
+		}
 
-		// nothing from supported proxy types should be in this parameter
-		proxyType := params.OpenVpnParameters.Proxy.Type
-		if len(proxyType) > 0 && proxyType != "http" && proxyType != "socks" {
-			proxyType = ""
+		// upstream proxy
+		proxy := params.OpenVpnParameters.Proxy
+		if err := proxy.Validate(params.OpenVpnParameters.Port.Protocol > 0); err != nil {
+			return err
+		}
+		if proxy.IsDefined() {
+			if prefs.Obfs4proxy.IsObfsproxy() || prefs.V2RayProxy.IsEnabled() || prefs.ShadowsocksProxy.IsEnabled() {
+				return fmt.Errorf("proxy can not be used together with obfsproxy, V2Ray or Shadowsocks")
+			}
 		}
 
 		// Multi-Hop
@@ -148,10 +228,6 @@ func (s *Service) Connect(params types.ConnectionParams) (err error) {
 			}
 		}
 
-		// only one-line parameter is allowed
-		proxyUsername := strings.Split(params.OpenVpnParameters.Proxy.Username, "\n")[0]
-		proxyPassword := strings.Split(params.OpenVpnParameters.Proxy.Password, "\n")[0]
-
 		// CONNECTION
 		// OpenVPN connection parameters
 		var connectionParams openvpn.ConnectionParams
@@ -167,11 +243,11 @@ func (s *Service) Connect(params types.ConnectionParams) (err error) {
 				params.OpenVpnParameters.Port.Protocol > 0, // is TCP
 				exitHostValue.MultihopPort,
 				host,
-				proxyType,
-				net.ParseIP(params.OpenVpnParameters.Proxy.Address),
-				params.OpenVpnParameters.Proxy.Port,
-				proxyUsername,
-				proxyPassword)
+				proxy.Type,
+				net.ParseIP(proxy.Address),
+				proxy.Port,
+				proxy.Username,
+				proxy.Password)
 		} else {
 			// Single-Hop
 			connectionParams = openvpn.CreateConnectionParams(
@@ -179,130 +255,212 @@ func (s *Service) Connect(params types.ConnectionParams) (err error) {
 				params.OpenVpnParameters.Port.Protocol > 0, // is TCP
 				params.OpenVpnParameters.Port.Port,
 				host,
-				proxyType,
-				net.ParseIP(params.OpenVpnParameters.Proxy.Address),
-				params.OpenVpnParameters.Proxy.Port,
-				proxyUsername,
-				proxyPassword)
+				proxy.Type,
+				net.ParseIP(proxy.Address),
+				proxy.Port,
+				proxy.Username,
+				proxy.Password)
 		}
 
+		// IPv6 inside tunnel: requested by user and not disabled by the tunnel IP mode
+		// (the IPv6 configuration is pushed by the server, if supported)
+		connectionParams.SetIPv6InTunnel(params.IPv6 && params.TunnelIPMode != vpn.TunnelIPv4Only)
+
 		return s.connectOpenVPN(connectionParams, params.ManualDNS, params.Metadata.AntiTracker, params.FirewallOn, params.FirewallOnDuringConnection)
 
 	} else if vpn.Type(params.VpnType) == vpn.WireGuard {
-		hosts := params.WireGuardParameters.EntryVpnServer.Hosts
-		multihopExitHosts := params.WireGuardParameters.MultihopExitServer.Hosts
-
-		// filter hosts: use IPv6 hosts
-		if params.IPv6 {
-			ipv6Hosts := append(hosts[0:0], hosts...)
-			n := 0
-			for _, h := range ipv6Hosts {
-				if h.IPv6.LocalIP != "" {
-					ipv6Hosts[n] = h
-					n++
-				}
-			}
-			if n == 0 {
-				if params.IPv6Only {
-					return fmt.Errorf("unable to make IPv6 connection inside tunnel. Server does not support IPv6")
-				}
-			} else {
-				hosts = ipv6Hosts[:n]
-			}
+		connectionParams, err := s.createWireGuardConnectionParams(params)
+		if err != nil {
+			return err
 		}
 
-		// filter exit servers (Multi-Hop connection):
-		// 1) each exit server must have initialized 'multihop_port' field
-		// 2) (in case of IPv6Only) IPv6 local address should be defined
-		if len(multihopExitHosts) > 0 {
-			isHasMHPort := false
-			ipv6ExitHosts := append(multihopExitHosts[0:0], multihopExitHosts...)
-			n := 0
-			for _, h := range ipv6ExitHosts {
-				if h.MultihopPort == 0 {
-					continue
-				}
-				isHasMHPort = true
-				if params.IPv6 && h.IPv6.LocalIP == "" {
-					continue
-				}
+		err = s.connectWireGuard(connectionParams, params.ManualDNS, params.Metadata.AntiTracker, params.FirewallOn, params.FirewallOnDuringConnection)
+
+		// no handshake with the WireGuard server (e.g. UDP is blocked): try to connect using OpenVPN (if enabled)
+		var handshakeErr *vpn.HandshakeTimeoutError
+		if errors.As(err, &handshakeErr) && s._requiredVpnState != Disconnect {
+			return s.connectWireGuardFallback(params, handshakeErr)
+		}
+		return err
+
+	}
+
+	return fmt.Errorf("unexpected VPN type to connect (%v)", params.VpnType)
+}
 
-				ipv6ExitHosts[n] = h
+// createWireGuardConnectionParams creates WireGuard connection parameters
+// (random hosts are selected from the lists defined in 'params')
+func (s *Service) createWireGuardConnectionParams(params types.ConnectionParams) (wireguard.ConnectionParams, error) {
+	hosts := params.WireGuardParameters.EntryVpnServer.Hosts
+	multihopExitHosts := params.WireGuardParameters.MultihopExitServer.Hosts
+
+	// IPv6 inside tunnel: requested by user or forced by the tunnel IP mode
+	isIPv6 := params.IPv6
+	isIPv6Only := params.IPv6 && params.IPv6Only
+	switch params.TunnelIPMode {
+	case vpn.TunnelIPv4Only:
+		isIPv6, isIPv6Only = false, false
+	case vpn.TunnelIPv6Only:
+		isIPv6, isIPv6Only = true, true
+	}
+
+	// filter hosts: use IPv6 hosts
+	if isIPv6 {
+		ipv6Hosts := append(hosts[0:0], hosts...)
+		n := 0
+		for _, h := range ipv6Hosts {
+			if h.IPv6.LocalIP != "" {
+				ipv6Hosts[n] = h
 				n++
 			}
-			if n == 0 {
-				if !isHasMHPort {
-					return fmt.Errorf("unable to make Multi-Hop connection inside tunnel. Exit server does not support Multi-Hop")
-				}
-				if params.IPv6Only {
-					return fmt.Errorf("unable to make IPv6 Multi-Hop connection inside tunnel. Exit server does not support IPv6")
-				}
-			} else {
-				multihopExitHosts = ipv6ExitHosts[:n]
+		}
+		if n == 0 {
+			if isIPv6Only {
+				return wireguard.ConnectionParams{}, fmt.Errorf("unable to make IPv6 connection inside tunnel. Server does not support IPv6")
 			}
+		} else {
+			hosts = ipv6Hosts[:n]
 		}
+	}
 
-		hostValue := hosts[0]
-		if len(hosts) > 1 {
-			if rnd, err := rand.Int(rand.Reader, big.NewInt(int64(len(hosts)))); err == nil {
-				hostValue = hosts[rnd.Int64()]
+	// IPv6-only network: use the hosts reachable over IPv6;
+	// dual-stack network: use IPv6 address of the host when IPv4 is not reachable
+	// (the local proxies and WireGuard-over-TCP are using IPv4 address of the host)
+	prefs := s.Preferences()
+	isEndpointProbeAllowed := !params.WireGuardParameters.TcpEncapsulation && !prefs.V2RayProxy.IsEnabled() && !prefs.ShadowsocksProxy.IsEnabled()
+	endpoints := s.newEndpointSelector(isEndpointProbeAllowed)
+	hosts, err := filterHostsByEndpoint(endpoints, hosts)
+	if err != nil {
+		return wireguard.ConnectionParams{}, err
+	}
+
+	// deprioritize hosts with recent connection failures
+	hosts = healthyHosts(s, hosts)
+
+	// filter exit servers (Multi-Hop connection):
+	// 1) each exit server must have initialized 'multihop_port' field
+	// 2) (in case of IPv6Only) IPv6 local address should be defined
+	if len(multihopExitHosts) > 0 {
+		isHasMHPort := false
+		ipv6ExitHosts := append(multihopExitHosts[0:0], multihopExitHosts...)
+		n := 0
+		for _, h := range ipv6ExitHosts {
+			if h.MultihopPort == 0 {
+				continue
+			}
+			isHasMHPort = true
+			if isIPv6 && h.IPv6.LocalIP == "" {
+				continue
 			}
-		}
 
-		var exitHostValue *api_types.WireGuardServerHostInfo
-		if len(multihopExitHosts) > 0 {
-			exitHostValue = &multihopExitHosts[0]
-			if len(multihopExitHosts) > 1 {
-				if rnd, err := rand.Int(rand.Reader, big.NewInt(int64(len(multihopExitHosts)))); err == nil {
-					exitHostValue = &multihopExitHosts[rnd.Int64()]
-				}
+			ipv6ExitHosts[n] = h
+			n++
+		}
+		if n == 0 {
+			if !isHasMHPort {
+				return wireguard.ConnectionParams{}, fmt.Errorf("unable to make Multi-Hop connection inside tunnel. Exit server does not support Multi-Hop")
+			}
+			if isIPv6Only {
+				return wireguard.ConnectionParams{}, fmt.Errorf("unable to make IPv6 Multi-Hop connection inside tunnel. Exit server does not support IPv6")
 			}
+		} else {
+			multihopExitHosts = ipv6ExitHosts[:n]
 		}
+	}
 
-		// prevent user-defined data injection: ensure that nothing except the base64 public key will be stored in the configuration
-		if !helpers.ValidateBase64(hostValue.PublicKey) {
-			return fmt.Errorf("WG public key is not base64 string")
+	hostValue := hosts[0]
+	if len(hosts) > 1 {
+		if rnd, err := rand.Int(rand.Reader, big.NewInt(int64(len(hosts)))); err == nil {
+			hostValue = hosts[rnd.Int64()]
 		}
+	}
 
-		hostLocalIP := net.ParseIP(strings.Split(hostValue.LocalIP, "/")[0])
-		ipv6Prefix := ""
-		if params.IPv6 {
-			ipv6Prefix = strings.Split(hostValue.IPv6.LocalIP, "/")[0]
+	var exitHostValue *api_types.WireGuardServerHostInfo
+	if len(multihopExitHosts) > 0 {
+		exitHostValue = &multihopExitHosts[0]
+		if len(multihopExitHosts) > 1 {
+			if rnd, err := rand.Int(rand.Reader, big.NewInt(int64(len(multihopExitHosts)))); err == nil {
+				exitHostValue = &multihopExitHosts[rnd.Int64()]
+			}
 		}
+	}
 
-		var connectionParams wireguard.ConnectionParams
-		if exitHostValue != nil {
-			// Check is it allowed to connect multihop
-			if mhErr := s.IsCanConnectMultiHop(); mhErr != nil {
-				return mhErr
-			}
+	// prevent user-defined data injection: ensure that nothing except the base64 public key will be stored in the configuration
+	if !helpers.ValidateBase64(hostValue.PublicKey) {
+		return wireguard.ConnectionParams{}, fmt.Errorf("WG public key is not base64 string")
+	}
 
-			// Multi-Hop
-			connectionParams = wireguard.CreateConnectionParams(
-				exitHostValue.Hostname,
-				exitHostValue.MultihopPort,
-				net.ParseIP(hostValue.Host),
-				exitHostValue.PublicKey,
-				hostLocalIP,
-				ipv6Prefix,
-				params.WireGuardParameters.Mtu)
-		} else {
-			// Single-Hop
-			connectionParams = wireguard.CreateConnectionParams(
-				"",
-				params.WireGuardParameters.Port.Port,
-				net.ParseIP(hostValue.Host),
-				hostValue.PublicKey,
-				hostLocalIP,
-				ipv6Prefix,
-				params.WireGuardParameters.Mtu)
-		}
+	hostIP, err := hostEndpointIP(endpoints, hostValue)
+	if err != nil {
+		return wireguard.ConnectionParams{}, err
+	}
+	hostLocalIP := net.ParseIP(strings.Split(hostValue.LocalIP, "/")[0])
+	ipv6Prefix := ""
+	if isIPv6 {
+		ipv6Prefix = strings.Split(hostValue.IPv6.LocalIP, "/")[0]
+	}
 
-		return s.connectWireGuard(connectionParams, params.ManualDNS, params.Metadata.AntiTracker, params.FirewallOn, params.FirewallOnDuringConnection)
+	var connectionParams wireguard.ConnectionParams
+	if exitHostValue != nil {
+		// Check is it allowed to connect multihop
+		if mhErr := s.IsCanConnectMultiHop(); mhErr != nil {
+			return wireguard.ConnectionParams{}, mhErr
+		}
 
+		// Multi-Hop
+		connectionParams = wireguard.CreateConnectionParams(
+			exitHostValue.Hostname,
+			exitHostValue.MultihopPort,
+			hostIP,
+			exitHostValue.PublicKey,
+			hostLocalIP,
+			ipv6Prefix,
+			params.WireGuardParameters.Mtu)
+	} else {
+		// Single-Hop
+		connectionParams = wireguard.CreateConnectionParams(
+			"",
+			params.WireGuardParameters.Port.Port,
+			hostIP,
+			hostValue.PublicKey,
+			hostLocalIP,
+			ipv6Prefix,
+			params.WireGuardParameters.Mtu)
+	}
+	connectionParams.SetTunnelIPMode(params.TunnelIPMode)
+	connectionParams.SetAmneziaWG(s.Preferences().AmneziaWG)
+	if s.Preferences().IsWgLanBypass {
+		connectionParams.SetLanBypass(true, s.lanBypassKeepInTunnelIPs())
+	}
+	if params.WireGuardParameters.TcpEncapsulation {
+		if exitHostValue != nil {
+			return wireguard.ConnectionParams{}, fmt.Errorf("WireGuard-over-TCP is not applicable for Multi-Hop connections")
+		}
+		if s.Preferences().V2RayProxy.IsEnabled() {
+			return wireguard.ConnectionParams{}, fmt.Errorf("WireGuard-over-TCP can not be used together with V2Ray")
+		}
+		if s.Preferences().ShadowsocksProxy.IsEnabled() {
+			return wireguard.ConnectionParams{}, fmt.Errorf("WireGuard-over-TCP can not be used together with Shadowsocks")
+		}
+		if hostIP.To4() == nil {
+			return wireguard.ConnectionParams{}, fmt.Errorf("WireGuard-over-TCP is not supported on IPv6-only networks")
+		}
+		port, err := s.wireGuardTcpPort()
+		if err != nil {
+			return wireguard.ConnectionParams{}, err
+		}
+		connectionParams.SetTcpEncapsulation(port)
+	}
+	if s.isWireGuardFallbackEnabled() {
+		// detect handshake failures to be able to fall back to OpenVPN
+		connectionParams.SetHandshakeTimeout(wgFallbackHandshakeTimeout)
+	}
+	if prefs.IsWgPeerFailover && !params.WireGuardParameters.TcpEncapsulation && !prefs.V2RayProxy.IsEnabled() && !prefs.ShadowsocksProxy.IsEnabled() {
+		// switch to another host of the same server when the active one is not responding (see wireGuardFailoverMonitor())
+		connectionParams.SetFailoverEndpoints(wireGuardFailoverEndpoints(endpoints, hosts, hostValue, hostIP, isIPv6))
 	}
 
-	return fmt.Errorf("unexpected VPN type to connect (%v)", params.VpnType)
+	return connectionParams, nil
 }
 
 // connectOpenVPN start OpenVPN connection
@@ -320,7 +478,11 @@ func (s *Service) connectOpenVPN(connectionParams openvpn.ConnectionParams, manu
 			return nil, fmt.Errorf(disabledFuncs.ObfsproxyError)
 		}
 
-		connectionParams.SetCredentials(prefs.Session.OpenVPNUser, prefs.Session.OpenVPNPass)
+		if !connectionParams.IsCustomConfig() {
+			connectionParams.SetCredentials(prefs.Session.OpenVPNUser, prefs.Session.OpenVPNPass)
+		}
+		connectionParams.SetDcoAllowed(!prefs.IsOpenVpnDcoDisabled)
+		connectionParams.SetCryptoPolicy(prefs.OpenVpnCryptoPolicy)
 
 		openVpnExtraParameters := ""
 		// read user-defined extra parameters for OpenVPN configuration (if exists)
@@ -359,7 +521,7 @@ func (s *Service) connectOpenVPN(connectionParams openvpn.ConnectionParams, manu
 					}
 
 					if err := scanner.Err(); err != nil {
-						log.Error("Failed to parse '%s': %s", extraParamsFile, err)
+						log.Error(fmt.Sprintf("Failed to parse '%s': %s", extraParamsFile, err))
 						return ""
 					}
 					return allParams.String()
@@ -371,6 +533,16 @@ func (s *Service) connectOpenVPN(connectionParams openvpn.ConnectionParams, manu
 			}
 		}
 
+		// user-defined extra parameters defined via the daemon settings (see SetOpenVpnExtraParameters())
+		if len(prefs.OpenVpnExtraParameters) > 0 {
+			if params, err := openvpn.ValidateExtraParameters(prefs.OpenVpnExtraParameters); err != nil {
+				log.Warning(fmt.Sprintf("User-defined OpenVPN parameters from the settings are ignored: %s", err))
+			} else if len(params) > 0 {
+				openVpnExtraParameters += params + "\n"
+				log.Info("WARNING! User-defined OpenVPN parameters from the settings are in use!")
+			}
+		}
+
 		// initialize obfsproxy parameters
 		obfsParams := openvpn.ObfsParams{}
 		if prefs.Obfs4proxy.IsObfsproxy() {
@@ -393,6 +565,9 @@ func (s *Service) connectOpenVPN(connectionParams openvpn.ConnectionParams, manu
 				case obfsproxy.OBFS4:
 					obfsParams.RemotePort = host.Obfs.Obfs4MultihopPort
 					obfsParams.Obfs4Key = host.Obfs.Obfs4Key
+					if bridge := obfsParams.Config.Obfs4Bridge; len(bridge.Cert) > 0 {
+						obfsParams.Obfs4Key = bridge.Cert
+					}
 				default:
 					return nil, fmt.Errorf("failed to initialize obfsproxy configuration: unsupported obfs version: %d", obfsParams.Config.Version)
 				}
@@ -410,6 +585,16 @@ func (s *Service) connectOpenVPN(connectionParams openvpn.ConnectionParams, manu
 
 						obfsParams.RemotePort = svrs.Config.Ports.Obfs4.Port
 						obfsParams.Obfs4Key = host.Obfs.Obfs4Key
+
+						// user-defined bridge parameters
+						if bridge := obfsParams.Config.Obfs4Bridge; bridge.IsDefined() {
+							if len(bridge.Cert) > 0 {
+								obfsParams.Obfs4Key = bridge.Cert
+							}
+							if bridge.Port > 0 {
+								obfsParams.RemotePort = bridge.Port
+							}
+						}
 					}
 				default:
 					return nil, fmt.Errorf("failed to initialize obfsproxy configuration: unsupported obfs version: %d", obfsParams.Config.Version)
@@ -418,12 +603,22 @@ func (s *Service) connectOpenVPN(connectionParams openvpn.ConnectionParams, manu
 
 		}
 
+		// initialize local proxy transport: V2Ray or Shadowsocks (if enabled)
+		localProxy, err := s.createLocalProxy(vpn.OpenVPN, connectionParams.GetHostIp(), 0)
+		if err != nil {
+			return nil, err
+		}
+		if localProxy != nil && obfsParams.Config.IsObfsproxy() {
+			return nil, fmt.Errorf("%s can not be used together with obfsproxy", localProxy.Name())
+		}
+
 		// creating OpenVPN object
 		vpnObj, err := openvpn.NewOpenVpnObject(
 			platform.OpenVpnBinaryPath(),
 			platform.OpenvpnConfigFile(),
 			"",
 			obfsParams,
+			localProxy,
 			openVpnExtraParameters,
 			connectionParams)
 
@@ -448,9 +643,22 @@ func (s *Service) connectWireGuard(connectionParams wireguard.ConnectionParams,
 	if len(disabledFuncs.WireGuardError) > 0 {
 		return fmt.Errorf(disabledFuncs.WireGuardError)
 	}
+	if connectionParams.AmneziaWG().IsEnabled() && len(disabledFuncs.AmneziaWGError) > 0 {
+		return fmt.Errorf(disabledFuncs.AmneziaWGError)
+	}
 
-	// Update WG keys, if necessary
-	err := s.WireGuardGenerateKeys(true)
+	// Update WG keys, if necessary (the user-defined configuration has its own keys).
+	// The post-quantum preshared key is negotiated together with the WG keys and renewed on each keys rotation.
+	// When quantum resistance is enabled but there is no preshared key yet - the keys are regenerated immediately.
+	var err error
+	isPresharedKeyRequired := false
+	if !connectionParams.IsCustomConfig() {
+		isPresharedKeyRequired = s.Preferences().IsWgQuantumResistance && len(s.Preferences().Session.WGPresharedKey) == 0
+		err = s.WireGuardGenerateKeys(!isPresharedKeyRequired)
+	}
+	if err != nil && isPresharedKeyRequired {
+		return fmt.Errorf("failed to negotiate the post-quantum preshared key: %w", err)
+	}
 	if err != nil {
 		// If new WG keys regeneration failed but we still have active keys - keep connecting
 		// (this could happen, for example, when FW is enabled and we even not tried to make API request)
@@ -465,34 +673,233 @@ func (s *Service) connectWireGuard(connectionParams wireguard.ConnectionParams,
 		}
 	}
 
+	var lastVpnObj *wireguard.WireGuard
 	createVpnObjfunc := func() (vpn.Process, error) {
 		session := s.Preferences().Session
 
-		if !session.IsWGCredentialsOk() {
-			return nil, fmt.Errorf("WireGuard credentials are not defined (please, regenerate WG credentials or re-login)")
+		// The active connection could be switched to another server without disconnection (see SwitchServer()).
+		// In this case, the reconnection must be performed to the actual server.
+		if lastVpnObj != nil {
+			connectionParams = lastVpnObj.ConnectionParams()
+		}
+
+		if !connectionParams.IsCustomConfig() {
+			if !session.IsWGCredentialsOk() {
+				return nil, fmt.Errorf("WireGuard credentials are not defined (please, regenerate WG credentials or re-login)")
+			}
+
+			localip := net.ParseIP(session.WGLocalIP)
+			if localip == nil {
+				return nil, fmt.Errorf("error updating WG connection preferences (failed parsing local IP for WG connection)")
+			}
+			connectionParams.SetCredentials(session.WGPrivateKey, localip)
+			connectionParams.SetPresharedKey(session.WGPresharedKey)
+			if s.Preferences().IsWgQuantumResistance && !connectionParams.IsPresharedKey() {
+				log.Warning("Quantum resistance is enabled but the preshared key is not defined for current WireGuard credentials (regenerate WG credentials to apply)")
+			}
+		}
+
+		// initialize local proxy transport: V2Ray or Shadowsocks (if enabled)
+		localProxy, err := s.createLocalProxy(vpn.WireGuard, connectionParams.HostIP(), connectionParams.HostPort())
+		if err != nil {
+			return nil, err
 		}
 
-		localip := net.ParseIP(session.WGLocalIP)
-		if localip == nil {
-			return nil, fmt.Errorf("error updating WG connection preferences (failed parsing local IP for WG connection)")
+		// AmneziaWG binaries are in use when the obfuscation parameters are defined
+		wgBinaryPath, wgToolBinaryPath := platform.WgBinaryPath(), platform.WgToolBinaryPath()
+		if connectionParams.AmneziaWG().IsEnabled() {
+			wgBinaryPath, wgToolBinaryPath = platform.AwgBinaryPath(), platform.AwgToolBinaryPath()
 		}
-		connectionParams.SetCredentials(session.WGPrivateKey, localip)
 
 		vpnObj, err := wireguard.NewWireGuardObject(
-			platform.WgBinaryPath(),
-			platform.WgToolBinaryPath(),
+			wgBinaryPath,
+			wgToolBinaryPath,
 			platform.WGConfigFilePath(),
-			connectionParams)
+			connectionParams,
+			localProxy)
 
 		if err != nil {
 			return nil, fmt.Errorf("failed to create new WireGuard object: %w", err)
 		}
+		lastVpnObj = vpnObj
 		return vpnObj, nil
 	}
 
 	return s.keepConnection(createVpnObjfunc, manualDNS, antiTracker, firewallOn, firewallDuringConnection)
 }
 
+// switchServerRequest - request to switch the active WireGuard connection to a new server (see SwitchServer())
+type switchServerRequest struct {
+	params types.ConnectionParams
+	result chan switchServerResult
+}
+
+type switchServerResult struct {
+	isSwitched bool
+	err        error
+}
+
+// switchServerHandler - receiver of the server switching requests of the active WireGuard connection
+type switchServerHandler struct {
+	requests chan switchServerRequest
+	stopped  <-chan bool
+}
+
+// SwitchServer tries to switch the active WireGuard connection to a new server without disconnection ("make-before-break").
+// Returns 'isSwitched=false' when switching is not applicable for the current connection (e.g. no active WireGuard connection,
+// connection is paused, tunnel interface parameters have to be changed ...) or failed.
+// In this case, the regular reconnection must be performed by the caller.
+// The switching is performed by the routine of the active connection (see switchServerRequestsHandler()),
+// so it does not interfere with the connection establishing or stopping.
+func (s *Service) SwitchServer(params types.ConnectionParams) (isSwitched bool, err error) {
+	if vpn.Type(params.VpnType) != vpn.WireGuard || params.CustomConfig.IsDefined() {
+		return false, nil
+	}
+	if params.IsEntryServerResolvedOnConnect() {
+		// the fastest server can not be determined while connected (pinging is not possible)
+		return false, nil
+	}
+
+	s._switchServerHandlerMutex.Lock()
+	handler := s._switchServerHandler
+	s._switchServerHandlerMutex.Unlock()
+	if handler == nil {
+		return false, nil // no active WireGuard connection
+	}
+
+	req := switchServerRequest{params: params, result: make(chan switchServerResult, 1)}
+	select {
+	case handler.requests <- req:
+	case <-handler.stopped:
+		return false, nil // the connection is stopping
+	}
+	// the handler always replies to the received request
+	res := <-req.result
+	return res.isSwitched, res.err
+}
+
+// switchServerRequestsHandler processes the server switching requests (see SwitchServer()) for the active WireGuard connection.
+// The function returns when 'stop' channel closed.
+func (s *Service) switchServerRequestsHandler(wg *wireguard.WireGuard, stop <-chan bool) {
+	handler := &switchServerHandler{requests: make(chan switchServerRequest), stopped: stop}
+
+	s._switchServerHandlerMutex.Lock()
+	s._switchServerHandler = handler
+	s._switchServerHandlerMutex.Unlock()
+
+	defer func() {
+		s._switchServerHandlerMutex.Lock()
+		if s._switchServerHandler == handler {
+			s._switchServerHandler = nil
+		}
+		s._switchServerHandlerMutex.Unlock()
+	}()
+
+	for {
+		select {
+		case req := <-handler.requests:
+			isSwitched, err := s.switchServer(wg, req.params)
+			req.result <- switchServerResult{isSwitched: isSwitched, err: err}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// switchServer switches the active WireGuard connection to a new server (see SwitchServer())
+func (s *Service) switchServer(wgObj *wireguard.WireGuard, params types.ConnectionParams) (isSwitched bool, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			isSwitched = false
+			err = errors.New("panic on switching server: " + fmt.Sprint(r))
+			crashreport.Save("SwitchServer", r)
+		}
+	}()
+
+	if s.Preferences().V2RayProxy.IsEnabled() || s.Preferences().ShadowsocksProxy.IsEnabled() {
+		// V2Ray/Shadowsocks is configured for the particular server: the reconnection is required
+		return false, nil
+	}
+	if wgObj.IsPaused() {
+		return false, nil
+	}
+	if activeParams := wgObj.ConnectionParams(); activeParams.IsCustomConfig() {
+		// the active connection uses the user-defined configuration (another keys and local IP)
+		return false, nil
+	}
+	if _, err := s.ValidateConnectionParameters(params, false); err != nil {
+		return false, err
+	}
+	if err := validateTunnelIPMode(params); err != nil {
+		return false, err
+	}
+	// check the client-defined entry/exit hosts against the servers list
+	if params, err = s.resolveConnectionHosts(params); err != nil {
+		return false, err
+	}
+
+	connectionParams, err := s.createWireGuardConnectionParams(params)
+	if err != nil {
+		return false, err
+	}
+
+	session := s.Preferences().Session
+	if !session.IsWGCredentialsOk() {
+		return false, fmt.Errorf("WireGuard credentials are not defined")
+	}
+	connectionParams.SetCredentials(session.WGPrivateKey, net.ParseIP(session.WGLocalIP))
+	connectionParams.SetPresharedKey(session.WGPresharedKey)
+
+	if err := s.switchWireGuardPeer(wgObj, connectionParams); err != nil {
+		return false, err
+	}
+
+	// keep last used connection params
+	s.setConnectionParams(params)
+	s.addConnectionHistory(params)
+
+	// apply DNS configuration for the new connection parameters
+	if params.ManualDNS.IsEmpty() && !params.Metadata.AntiTracker.IsEnabled() {
+		err = s.ResetManualDNS()
+	} else {
+		_, err = s.SetManualDNS(params.ManualDNS, params.Metadata.AntiTracker)
+	}
+	if err != nil {
+		log.Error(fmt.Errorf("failed to set DNS after switching server: %w", err))
+	}
+
+	return true, nil
+}
+
+// switchWireGuardPeer switches the active WireGuard connection to a new server (peer) without disconnection
+// and updates the firewall exceptions for the server address
+func (s *Service) switchWireGuardPeer(wgObj *wireguard.WireGuard, connectionParams wireguard.ConnectionParams) error {
+	oldHostIP := wgObj.DestinationIP()
+	newHostIP := connectionParams.HostIP()
+	if newHostIP == nil {
+		return fmt.Errorf("VPN host not defined")
+	}
+
+	// allow communication with the new server before switching
+	const onlyForICMP = false
+	const isPersistent = false
+	if err := firewall.AddHostsToExceptions([]net.IP{newHostIP}, onlyForICMP, isPersistent); err != nil {
+		return fmt.Errorf("unable to add host to firewall exceptions: %w", err)
+	}
+
+	if err := wgObj.SwitchServer(connectionParams); err != nil {
+		if !newHostIP.Equal(oldHostIP) {
+			firewall.RemoveHostsFromExceptions([]net.IP{newHostIP}, onlyForICMP, isPersistent)
+		}
+		return err
+	}
+
+	if !newHostIP.Equal(oldHostIP) {
+		firewall.RemoveHostsFromExceptions([]net.IP{oldHostIP}, onlyForICMP, isPersistent)
+	}
+	return nil
+}
+
 func (s *Service) keepConnection(createVpnObj func() (vpn.Process, error), manualDNS dns.DnsSettings, antiTracker types.AntiTrackerMetadata, firewallOn bool, firewallDuringConnection bool) (retError error) {
 	prefs := s.Preferences()
 	if !prefs.Session.IsLoggedIn() {
@@ -519,6 +926,8 @@ func (s *Service) keepConnection(createVpnObj func() (vpn.Process, error), manua
 
 	// no delay before first reconnection
 	delayBeforeReconnect := 0 * time.Second
+	// number of consecutive connection attempts failed because of no handshake with the server
+	handshakeFailures := 0
 
 	s._evtReceiver.OnVpnStateChanged(vpn.NewStateInfo(vpn.CONNECTING, "Connecting"))
 	for {
@@ -532,6 +941,19 @@ func (s *Service) keepConnection(createVpnObj func() (vpn.Process, error), manua
 
 		// start connection
 		connErr := s.connect(vpnObj, s._manualDNS, antiTracker, firewallOn, firewallDuringConnection)
+
+		// repeated handshake failures: stop trying (the caller can fall back to another VPN protocol)
+		var handshakeErr *vpn.HandshakeTimeoutError
+		if errors.As(connErr, &handshakeErr) {
+			handshakeFailures++
+			if handshakeFailures >= wgFallbackHandshakeFailures && s._requiredVpnState != Disconnect {
+				log.Error(fmt.Sprintf("Connection error: %s (%d times)", connErr, handshakeFailures))
+				return connErr
+			}
+		} else {
+			handshakeFailures = 0
+		}
+
 		if connErr != nil {
 			log.Error(fmt.Sprintf("Connection error: %s", connErr))
 			if s._requiredVpnState == Connect {
@@ -616,6 +1038,9 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 
 	var err error
 
+	// all the log records of the connection attempt (including the reconnections) are tagged with the session ID
+	logger.StartSession(fmt.Sprintf("%s, %s", vpnProc.Type(), vpnProc.DestinationIP()))
+
 	log.Info("Connecting...")
 
 	// save vpn object
@@ -624,14 +1049,18 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 	internalStateChan := make(chan vpn.StateInfo, 1)
 	stopChannel := make(chan bool, 1)
 
+	// hosts health tracking:
+	// isVpnStarted - the VPN process was started (the connection to the host was initiated)
+	// isConnected - the connection to the host is established
+	// isFailureRegistered - the failure was already registered for the current connection attempt
+	s._isDisconnectRequested = false
+	isVpnStarted, isConnected, isFailureRegistered := false, false, false
+
 	fwInitState := false
 	// finalize everything
 	defer func() {
 		if r := recover(); r != nil {
-			log.Error("Panic on VPN connection: ", r)
-			if err, ok := r.(error); ok {
-				log.ErrorTrace(err)
-			}
+			crashreport.Save("VPN connection", r)
 		}
 
 		// Ensure that routing-change detector is stopped (we do not need it when VPN disconnected)
@@ -640,6 +1069,9 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 		// ensure firewall removed rules for DNS
 		firewall.OnChangeDNS(nil)
 
+		// the forwarded port is not valid anymore
+		s._portForwarding.OnDisconnected()
+
 		// notify firewall that client is disconnected
 		err := firewall.ClientDisconnected()
 		if err != nil {
@@ -665,6 +1097,15 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 
 		connectRoutinesWaiter.Wait()
 
+		// update health info of the host
+		if !s._isDisconnectRequested {
+			if isConnected {
+				s.hostsHealthOnFailure(vpnProc.DestinationIP(), hostshealth.FailureSessionDrop)
+			} else if isVpnStarted && !isFailureRegistered {
+				s.hostsHealthOnFailure(vpnProc.DestinationIP(), hostshealth.FailureHandshake)
+			}
+		}
+
 		// Forget VPN object
 		s._vpn = nil
 
@@ -672,6 +1113,7 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 		s.splitTunnelling_ApplyConfig()
 
 		log.Info("VPN process stopped")
+		logger.EndSession()
 	}()
 
 	// Signaling when the default routing is NOT over the 'interfaceToProtect' anymore
@@ -679,8 +1121,6 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 	// Signaling when there were some routing changes but 'interfaceToProtect' is still is the default route
 	routingUpdateChan := make(chan struct{}, 1)
 
-	destinationHostIP := vpnProc.DestinationIP()
-
 	// goroutine: process + forward VPN state change
 	connectRoutinesWaiter.Add(1)
 	go func() {
@@ -691,6 +1131,8 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 		}()
 
 		var state vpn.StateInfo
+		isIfFlapMonitorStarted, isStatsMonitorStarted, isThroughputMonitorStarted, isDataUsageMonitorStarted, isQualityMonitorStarted := false, false, false, false, false
+		isMtuMonitorStarted, isWgFailoverMonitorStarted, isWgPortHoppingMonitorStarted, isSwitchServerHandlerStarted := false, false, false, false
 		for isRuning := true; isRuning; {
 			select {
 			case state = <-internalStateChan:
@@ -712,12 +1154,27 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 					// Disable routing-change detector when reconnecting
 					s._netChangeDetector.Stop()
 
+					// the connection can fail because of a captive portal
+					s.captivePortalCheckOnConnecting()
+
+					// update health info of the host
+					if state.StateAdditionalInfo == "tls-error" {
+						// TLS handshake or server verification failed (OpenVPN)
+						s.hostsHealthOnFailure(vpnProc.DestinationIP(), hostshealth.FailureVerification)
+						isFailureRegistered = true
+					} else if isConnected && !s._isDisconnectRequested {
+						s.hostsHealthOnFailure(vpnProc.DestinationIP(), hostshealth.FailureSessionDrop)
+						isFailureRegistered = true
+					}
+					isConnected = false
+
 					// Add host IP to firewall exceptions
 					// Some OS-specific implementations (e.g. macOS) can remove server host from firewall rules after connection established
 					// We have to allow it's IP to be able to reconnect
 					const onlyForICMP = false
 					const isPersistent = false
-					err := firewall.AddHostsToExceptions([]net.IP{destinationHostIP}, onlyForICMP, isPersistent)
+					// (note: the destination host can be changed by SwitchServer())
+					err := firewall.AddHostsToExceptions([]net.IP{vpnProc.DestinationIP()}, onlyForICMP, isPersistent)
 					if err != nil {
 						log.Error("Unable to add host to firewall exceptions:", err.Error())
 					}
@@ -728,6 +1185,10 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 						s._requiredVpnState = KeepConnection
 					}
 
+					// (note: the destination host can be changed by SwitchServer())
+					s.hostsHealthOnSuccess(vpnProc.DestinationIP())
+					isConnected, isFailureRegistered = true, false
+
 					// If no any clients connected - connection notification will not be passed to user
 					// In this case we are trying to save info message into system log
 					if !s._evtReceiver.IsClientConnected(false) {
@@ -743,6 +1204,17 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 						s._netChangeDetector.Start(routingChangeChan, routingUpdateChan, netInterface)
 					}
 
+					// detect the physical network interfaces going down/up (re-validate the tunnel after it)
+					if !isIfFlapMonitorStarted {
+						isIfFlapMonitorStarted = true
+						vpnInterface, _ := netinfo.InterfaceByIPAddr(state.ClientIP)
+						connectRoutinesWaiter.Add(1)
+						go func() {
+							defer connectRoutinesWaiter.Done()
+							s.interfaceFlapMonitor(vpnInterface, stopChannel)
+						}()
+					}
+
 					// Inform firewall about client local IP
 					firewall.ClientConnected(
 						state.ClientIP, state.ClientIPv6,
@@ -766,6 +1238,93 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 
 					// Notify Split-Tunneling module about connected VPN status
 					s.splitTunnelling_ApplyConfig()
+
+					// record the connection statistics history
+					if !isStatsMonitorStarted {
+						isStatsMonitorStarted = true
+						connectRoutinesWaiter.Add(1)
+						go func(state vpn.StateInfo) {
+							defer connectRoutinesWaiter.Done()
+							s.connectionStatsMonitor(state, stopChannel)
+						}(state)
+					}
+
+					// sample the traffic rates for the UI graphs
+					if !isThroughputMonitorStarted {
+						isThroughputMonitorStarted = true
+						connectRoutinesWaiter.Add(1)
+						go func(state vpn.StateInfo) {
+							defer connectRoutinesWaiter.Done()
+							s.throughputMonitor(state, stopChannel)
+						}(state)
+					}
+
+					// notify clients when the traffic exceeds the thresholds
+					if !isDataUsageMonitorStarted {
+						isDataUsageMonitorStarted = true
+						connectRoutinesWaiter.Add(1)
+						go func(state vpn.StateInfo) {
+							defer connectRoutinesWaiter.Done()
+							s.dataUsageAlertMonitor(state, stopChannel)
+						}(state)
+					}
+
+					// measure packet loss, round-trip time and jitter of the connection
+					if !isQualityMonitorStarted {
+						isQualityMonitorStarted = true
+						connectRoutinesWaiter.Add(1)
+						go func(localIP net.IP) {
+							defer connectRoutinesWaiter.Done()
+							s.connectionQualityMonitor(vpnProc, localIP, stopChannel)
+						}(state.ClientIP)
+					}
+
+					// lower the MTU of the tunnel on the fly when the PMTU blackhole is detected
+					// (only when the custom MTU is not defined by the user)
+					if wgObj, ok := vpnProc.(*wireguard.WireGuard); ok && !isMtuMonitorStarted {
+						if cp := wgObj.ConnectionParams(); cp.MTU() == 0 {
+							isMtuMonitorStarted = true
+							connectRoutinesWaiter.Add(1)
+							go func(target, localIP net.IP) {
+								defer connectRoutinesWaiter.Done()
+								s.mtuBlackholeMonitor(wgObj, target, localIP, stopChannel)
+							}(vpnProc.DefaultDNS(), state.ClientIP)
+						}
+					}
+
+					// switch to another host of the server when the active one is not responding
+					if wgObj, ok := vpnProc.(*wireguard.WireGuard); ok && !isWgFailoverMonitorStarted {
+						if cp := wgObj.ConnectionParams(); len(cp.FailoverEndpoints()) > 1 {
+							isWgFailoverMonitorStarted = true
+							connectRoutinesWaiter.Add(1)
+							go func() {
+								defer connectRoutinesWaiter.Done()
+								s.wireGuardFailoverMonitor(wgObj, stopChannel)
+							}()
+						}
+					}
+
+					// switch the connection to another server without disconnection (on the client request; see SwitchServer())
+					if wgObj, ok := vpnProc.(*wireguard.WireGuard); ok && !isSwitchServerHandlerStarted {
+						isSwitchServerHandlerStarted = true
+						connectRoutinesWaiter.Add(1)
+						go func() {
+							defer connectRoutinesWaiter.Done()
+							s.switchServerRequestsHandler(wgObj, stopChannel)
+						}()
+					}
+
+					// periodically move the connection to another port of the server (if enabled)
+					if wgObj, ok := vpnProc.(*wireguard.WireGuard); ok && !isWgPortHoppingMonitorStarted {
+						if s.isWireGuardPortHoppingApplicable(wgObj.ConnectionParams()) {
+							isWgPortHoppingMonitorStarted = true
+							connectRoutinesWaiter.Add(1)
+							go func() {
+								defer connectRoutinesWaiter.Done()
+								s.wireGuardPortHoppingMonitor(wgObj, stopChannel)
+							}()
+						}
+					}
 				default:
 				}
 
@@ -792,16 +1351,10 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 				} else {
 					// Disconnect (client will request then reconnection, because of unexpected disconnection)
 					// reconnect in separate routine (do not block current thread)
-					go func() {
-						defer func() {
-							if r := recover(); r != nil {
-								log.Error("PANIC: ", r)
-							}
-						}()
-
+					crashreport.Go("reconnect on route change", func() {
 						log.Info("Route change detected. Reconnecting...")
 						s.reconnect()
-					}()
+					})
 
 					isRuning = false
 				}
@@ -828,6 +1381,9 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 		return fmt.Errorf("failed to initialize VPN object: %w", err)
 	}
 
+	// check if the internet access is restricted by a captive portal (asynchronously; if enabled by the user)
+	s.captivePortalCheckOnConnecting()
+
 	// Split-Tunnelling: Checking default outbound IPs
 	// (note: it is important to call this code after 'vpnProc.Init()')
 	var sInfo VpnSessionInfo
@@ -879,7 +1435,7 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 	// Add host IP to firewall exceptions
 	const onlyForICMP = false
 	const isPersistent = false
-	err = firewall.AddHostsToExceptions([]net.IP{destinationHostIP}, onlyForICMP, isPersistent)
+	err = firewall.AddHostsToExceptions([]net.IP{vpnProc.DestinationIP()}, onlyForICMP, isPersistent)
 	if err != nil {
 		log.Error("Failed to start. Unable to add hosts to firewall exceptions:", err.Error())
 		return err
@@ -906,9 +1462,15 @@ func (s *Service) connect(vpnProc vpn.Process, manualDNS dns.DnsSettings, antiTr
 	}
 
 	log.Info("Starting VPN process")
+	isVpnStarted = true
 	// connect: start VPN process and wait until it finishes
 	err = vpnProc.Connect(internalStateChan)
 	if err != nil {
+		var handshakeErr *vpn.HandshakeTimeoutError
+		if errors.As(err, &handshakeErr) {
+			// the tunnel was up, but the connection with the host was not established
+			isConnected = false
+		}
 		err = fmt.Errorf("connection error: %w", err)
 		log.Error(err.Error())
 		return err
@@ -0,0 +1,159 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	apiTypes "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/service/types"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// resolveConnectionHosts checks the entry/exit hosts defined by the client against the servers list (servers.json)
+// and replaces them with the hosts info from the servers list.
+// It allows clients to select the specific entry/exit hosts (not only gateways) and the exit host multihop port.
+// NOTE: empty hosts list is not checked (e.g. the entry server is resolved by the daemon on connect)
+func (s *Service) resolveConnectionHosts(params types.ConnectionParams) (types.ConnectionParams, error) {
+	servers, err := s.ServersList()
+	if err != nil || servers == nil {
+		log.Warning(fmt.Sprintf("unable to validate connection hosts: servers list not available (%v)", err))
+		return params, nil
+	}
+
+	if vpn.Type(params.VpnType) == vpn.OpenVPN {
+		svrHosts := func(svr apiTypes.OpenvpnServerInfo) []apiTypes.OpenVPNServerHostInfo { return svr.Hosts }
+		p := &params.OpenVpnParameters
+
+		entryHosts, entryGw, err := resolveHosts(p.EntryVpnServer.Hosts, servers.OpenvpnServers, svrHosts, 0)
+		if err != nil {
+			return params, fmt.Errorf("entry server: %w", err)
+		}
+		p.EntryVpnServer.Hosts = entryHosts
+
+		if params.IsMultiHop() {
+			exitHosts, exitGw, err := resolveHosts(p.MultihopExitServer.Hosts, servers.OpenvpnServers, svrHosts, p.MultihopExitServer.Port)
+			if err != nil {
+				return params, fmt.Errorf("exit server: %w", err)
+			}
+			if err := checkMultihopGateways(entryGw, exitGw, p.MultihopExitServer.ExitSrvID); err != nil {
+				return params, err
+			}
+			p.MultihopExitServer.Hosts = exitHosts
+		}
+		return params, nil
+	}
+
+	if vpn.Type(params.VpnType) == vpn.WireGuard {
+		svrHosts := func(svr apiTypes.WireGuardServerInfo) []apiTypes.WireGuardServerHostInfo { return svr.Hosts }
+		p := &params.WireGuardParameters
+
+		entryHosts, entryGw, err := resolveHosts(p.EntryVpnServer.Hosts, servers.WireguardServers, svrHosts, 0)
+		if err != nil {
+			return params, fmt.Errorf("entry server: %w", err)
+		}
+		p.EntryVpnServer.Hosts = entryHosts
+
+		if params.IsMultiHop() {
+			exitHosts, exitGw, err := resolveHosts(p.MultihopExitServer.Hosts, servers.WireguardServers, svrHosts, p.MultihopExitServer.Port)
+			if err != nil {
+				return params, fmt.Errorf("exit server: %w", err)
+			}
+			if err := checkMultihopGateways(entryGw, exitGw, p.MultihopExitServer.ExitSrvID); err != nil {
+				return params, err
+			}
+			p.MultihopExitServer.Hosts = exitHosts
+		}
+		return params, nil
+	}
+
+	return params, nil
+}
+
+// checkMultihopGateways ensures the entry and exit hosts belong to different servers
+// and the exit server ID corresponds to the exit hosts
+func checkMultihopGateways(entryGw, exitGw, exitSrvID string) error {
+	if len(entryGw) == 0 || len(exitGw) == 0 {
+		return nil
+	}
+	if normalizeGatewayID(entryGw) == normalizeGatewayID(exitGw) {
+		return fmt.Errorf("entry and exit hosts must belong to different servers")
+	}
+	if len(exitSrvID) > 0 && normalizeGatewayID(exitSrvID) != normalizeGatewayID(exitGw) {
+		return fmt.Errorf("exit hosts do not belong to the exit server '%s'", exitSrvID)
+	}
+	return nil
+}
+
+// normalizeGatewayID removes everything after symbol '.': "us-tx.wg.ivpn.net" => "us-tx"; or "us-tx" => "us-tx"
+func normalizeGatewayID(gwID string) string {
+	return strings.Split(gwID, ".")[0]
+}
+
+// resolveHosts finds all the 'hosts' (by hostname) in the servers list and returns the hosts info from the servers list.
+// All the hosts must belong to the same server; the gateway of this server is returned.
+// If 'multihopPort' > 0: only the hosts with this multihop port are returned (at least one host is required).
+func resolveHosts[S serverBaseInterface, H hostBaseInterface](hosts []H, servers []S, serverHosts func(S) []H, multihopPort int) (ret []H, gateway string, err error) {
+	if len(hosts) == 0 {
+		return hosts, "", nil
+	}
+
+	for _, h := range hosts {
+		hostname := h.GetHostInfoBase().Hostname
+		if len(hostname) == 0 {
+			return nil, "", fmt.Errorf("host name not defined")
+		}
+
+		var svrHost H
+		svrGateway := ""
+	findLoop:
+		for _, svr := range servers {
+			for _, sh := range serverHosts(svr) {
+				if sh.GetHostInfoBase().Hostname == hostname {
+					svrHost = sh
+					svrGateway = svr.GetServerInfoBase().Gateway
+					break findLoop
+				}
+			}
+		}
+
+		if len(svrGateway) == 0 {
+			return nil, "", fmt.Errorf("host '%s' not found in servers list", hostname)
+		}
+		if len(gateway) > 0 && gateway != svrGateway {
+			return nil, "", fmt.Errorf("host '%s' does not belong to server '%s'", hostname, gateway)
+		}
+		gateway = svrGateway
+
+		if multihopPort > 0 && svrHost.GetHostInfoBase().MultihopPort != multihopPort {
+			continue
+		}
+		ret = append(ret, svrHost)
+	}
+
+	if len(ret) == 0 {
+		return nil, "", fmt.Errorf("no hosts with multihop port %d", multihopPort)
+	}
+	return ret, gateway, nil
+}
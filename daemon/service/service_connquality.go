@@ -0,0 +1,141 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/ping"
+	"github.com/ivpn/desktop-app/daemon/service/connstats"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+const (
+	// How often the in-tunnel probe is sent (while connected)
+	connectionQualityProbeInterval = time.Second * 2
+	// Max time to wait for the reply to the probe (the probe is counted as lost after the timeout)
+	connectionQualityProbeTimeout = time.Second
+	// How often the connection quality report is created (and sent to the clients)
+	connectionQualityReportInterval = time.Second * 10
+)
+
+// ConnectionLatencyMs returns the average round-trip time to the connected VPN server from the latest quality report
+// (0 - unknown)
+func (s *Service) ConnectionLatencyMs() float64 {
+	if reports := s._quality.Reports(1); len(reports) > 0 {
+		return reports[0].RttAvgMs
+	}
+	return 0
+}
+
+// ConnectionQualityHistory returns the recent quality reports of the active connection (the oldest first; limit <= 0 - all reports)
+func (s *Service) ConnectionQualityHistory(limit int) []connstats.QualityReport {
+	return s._quality.Reports(limit)
+}
+
+// connectionQualityMonitor periodically probes (ICMP echo) the connected VPN server
+// and notifies clients with the connection quality reports (packet loss, round-trip time, jitter).
+// Depending on preferences (LatencyProbeMode), the probes are sent:
+//   - through the tunnel: to the internal address of the VPN server (its DNS server); 'localIP' - the local address of the tunnel
+//   - outside the tunnel: to the public address of the VPN server
+//
+// The history is erased when the connection starts and when it stops.
+// The function returns when 'stop' channel closed.
+func (s *Service) connectionQualityMonitor(vpnProc vpn.Process, localIP net.IP, stop <-chan bool) {
+	s._quality.Reset()
+	defer s._quality.Reset()
+
+	var mode connstats.LatencyProbeMode
+	var target net.IP
+
+	// getTarget returns the probe target according to the current preferences
+	// (the destination of the connection can be changed by SwitchServer)
+	getTarget := func() (connstats.LatencyProbeMode, net.IP) {
+		m := s.Preferences().LatencyProbeMode.Normalized()
+		if m == connstats.LatencyProbeDirect {
+			return m, vpnProc.DestinationIP()
+		}
+		if localIP == nil {
+			return m, nil
+		}
+		return m, vpnProc.DefaultDNS()
+	}
+
+	probe := func() {
+		m, t := getTarget()
+		if m != mode || !t.Equal(target) {
+			// the probes to the different target are not comparable
+			s._quality.DiscardProbes()
+			mode, target = m, t
+			if target == nil {
+				log.Info(fmt.Sprintf("Connection quality: the probe target is not defined (mode '%s')", mode))
+			}
+		}
+		if target == nil {
+			return
+		}
+
+		pinger, err := ping.NewPinger(target.String())
+		if err != nil {
+			log.Debug("Connection quality: pinger creation error: ", err)
+			return
+		}
+		pinger.SetPrivileged(true)
+		if mode == connstats.LatencyProbeTunnel {
+			pinger.Source = localIP.String()
+		}
+		pinger.Count = 1
+		pinger.Timeout = connectionQualityProbeTimeout
+		pinger.Run()
+
+		stat := pinger.Statistics()
+		s._quality.AddProbe(stat.AvgRtt, stat.PacketsRecv == 0)
+	}
+
+	probeTicker := time.NewTicker(connectionQualityProbeInterval)
+	defer probeTicker.Stop()
+	reportTicker := time.NewTicker(connectionQualityReportInterval)
+	defer reportTicker.Stop()
+
+	for {
+		select {
+		case <-probeTicker.C:
+			if !s.IsPaused() {
+				probe()
+			}
+
+		case now := <-reportTicker.C:
+			if target == nil {
+				continue
+			}
+			if report, ok := s._quality.Report(now, target.String(), mode); ok {
+				s._evtReceiver.OnConnectionQuality(report)
+			}
+
+		case <-stop:
+			return
+		}
+	}
+}
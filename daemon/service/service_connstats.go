@@ -0,0 +1,235 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"math"
+	"net"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/netinfo"
+	"github.com/ivpn/desktop-app/daemon/service/connstats"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// How often the connection statistics is updated (while connected)
+const connectionStatsCheckInterval = time.Second * 10
+
+// How often the connection statistics history is saved to the disk (while connected)
+const connectionStatsSaveInterval = time.Minute * 5
+
+// ConnectionStatsHistory returns the statistics of the recent connection sessions and the daily aggregates
+// (the most recent first; limit <= 0 - all records)
+func (s *Service) ConnectionStatsHistory(sessionsLimit, daysLimit int) ([]connstats.SessionRecord, []connstats.DayRecord) {
+	return s._connStats.Sessions(sessionsLimit), s._connStats.Days(daysLimit)
+}
+
+// ConnectionStatsHistoryClear erases the connection statistics history
+func (s *Service) ConnectionStatsHistoryClear() error {
+	return s._connStats.Clear()
+}
+
+// ThroughputHistory returns the recent traffic rates of the active connection (the oldest first; limit <= 0 - all samples)
+func (s *Service) ThroughputHistory(limit int) []connstats.ThroughputSample {
+	return s._throughput.Samples(limit)
+}
+
+// connectionStatsMonitor records the statistics of the connection session (duration, bytes received/sent).
+// The function returns when 'stop' channel closed.
+func (s *Service) connectionStatsMonitor(state vpn.StateInfo, stop <-chan bool) {
+	if s.Preferences().IsConnectionStatsDisabled {
+		return
+	}
+
+	getTraffic := s.vpnTrafficCounter(state.ClientIP, "connection statistics")
+
+	protocol := "UDP"
+	if state.IsTCP {
+		protocol = "TCP"
+	}
+	s._connStats.Start(connstats.SessionInfo{
+		Server:     s.connectedEntryHostname(state.ServerIP),
+		ExitServer: state.ExitHostname,
+		VpnType:    state.VpnType.String(),
+		Protocol:   protocol,
+	})
+	defer func() {
+		if err := s._connStats.Finish(); err != nil {
+			log.Error(fmt.Errorf("failed to save connection statistics: %w", err))
+		}
+	}()
+
+	lastTime := time.Now()
+	lastSaveTime := time.Now()
+	lastRx, lastTx, isTrafficOk := getTraffic()
+
+	update := func() {
+		now := time.Now()
+		elapsed := now.Sub(lastTime)
+		if elapsed > connectionStatsCheckInterval*2 {
+			// the computer was sleeping: do not count the sleep time
+			elapsed = connectionStatsCheckInterval
+		}
+		if s.IsPaused() {
+			elapsed = 0
+		}
+		lastTime = now
+
+		var rxDiff, txDiff uint64
+		rx, tx, ok := getTraffic()
+		if ok && isTrafficOk {
+			rxDiff, txDiff = trafficCounterDiff(lastRx, rx), trafficCounterDiff(lastTx, tx)
+		}
+		lastRx, lastTx, isTrafficOk = rx, tx, ok
+
+		s._connStats.Update(elapsed, rxDiff, txDiff)
+	}
+
+	ticker := time.NewTicker(connectionStatsCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			update()
+			if time.Since(lastSaveTime) >= connectionStatsSaveInterval {
+				if err := s._connStats.Save(); err != nil {
+					log.Error(fmt.Errorf("failed to save connection statistics: %w", err))
+				}
+				lastSaveTime = time.Now()
+			}
+
+		case <-stop:
+			update()
+			return
+		}
+	}
+}
+
+// throughputMonitor samples the traffic rates of the active connection into the throughput history.
+// The history is erased when the connection starts and when it stops.
+// The function returns when 'stop' channel closed.
+func (s *Service) throughputMonitor(state vpn.StateInfo, stop <-chan bool) {
+	s._throughput.Reset()
+	defer s._throughput.Reset()
+
+	getTraffic := s.vpnTrafficCounter(state.ClientIP, "throughput history")
+
+	lastTime := time.Now()
+	lastRx, lastTx, isTrafficOk := getTraffic()
+
+	ticker := time.NewTicker(connstats.ThroughputSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			now := time.Now()
+			rx, tx, ok := getTraffic()
+			if ok && isTrafficOk {
+				elapsed := now.Sub(lastTime)
+				if elapsed > connstats.ThroughputSampleInterval*5 {
+					// the computer was sleeping: the average rate is meaningless
+					s._throughput.Add(now, connstats.ThroughputSampleInterval, 0, 0)
+				} else {
+					s._throughput.Add(now, elapsed, trafficCounterDiff(lastRx, rx), trafficCounterDiff(lastTx, tx))
+				}
+			}
+			lastTime, lastRx, lastTx, isTrafficOk = now, rx, tx, ok
+
+		case <-stop:
+			return
+		}
+	}
+}
+
+// vpnTrafficCounter returns the function to get the number of bytes received/sent through the VPN tunnel.
+// The statistics of the VPN object are in use when it is able to report them (e.g. OpenVPN management interface);
+// otherwise - the counters of the VPN network interface.
+func (s *Service) vpnTrafficCounter(localIP net.IP, logPrefix string) func() (rx, tx uint64, ok bool) {
+	if p, isProvider := s._vpn.(vpn.TrafficStatsProvider); isProvider {
+		return func() (rx, tx uint64, ok bool) {
+			rx, tx, err := p.TrafficStats()
+			return rx, tx, err == nil
+		}
+	}
+	return interfaceTrafficCounter(localIP, logPrefix)
+}
+
+// interfaceTrafficCounter returns a function which reads the traffic counters (bytes received/sent) of the network interface
+// with the given local IP address. 'ok' is false when the counters are not available.
+func interfaceTrafficCounter(localIP net.IP, logPrefix string) func() (rx, tx uint64, ok bool) {
+	iface, err := netinfo.InterfaceByIPAddr(localIP)
+	if err != nil {
+		log.Error(fmt.Errorf("%s: unable to count traffic: %w", logPrefix, err))
+	}
+	return func() (rx, tx uint64, ok bool) {
+		if iface == nil {
+			return 0, 0, false
+		}
+		rx, tx, err := netinfo.InterfaceTrafficBytes(iface)
+		if err != nil {
+			return 0, 0, false
+		}
+		return rx, tx, true
+	}
+}
+
+// connectedEntryHostname returns the hostname of the entry server of the current connection (IP address if hostname is unknown)
+func (s *Service) connectedEntryHostname(serverIP net.IP) string {
+	if serverIP == nil {
+		return ""
+	}
+	ip := serverIP.String()
+
+	params := s.Preferences().LastConnectionParams
+	if params.CustomConfig.IsDefined() {
+		return ip
+	}
+	for _, h := range params.WireGuardParameters.EntryVpnServer.Hosts {
+		if h.Host == ip || h.V2RayHost == ip {
+			return strings.TrimSpace(h.Hostname)
+		}
+	}
+	for _, h := range params.OpenVpnParameters.EntryVpnServer.Hosts {
+		if h.Host == ip || h.V2RayHost == ip {
+			return strings.TrimSpace(h.Hostname)
+		}
+	}
+	return ip
+}
+
+// trafficCounterDiff returns difference between two values of the network interface traffic counter
+func trafficCounterDiff(prev, cur uint64) uint64 {
+	if cur >= prev {
+		return cur - prev
+	}
+	if runtime.GOOS == "windows" {
+		// 32-bit counter overflow
+		return cur + (math.MaxUint32 - prev) + 1
+	}
+	return cur // counter was reset
+}
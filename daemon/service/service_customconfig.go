@@ -0,0 +1,79 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/ivpn/desktop-app/daemon/service/types"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+	"github.com/ivpn/desktop-app/daemon/vpn/openvpn"
+	"github.com/ivpn/desktop-app/daemon/vpn/wireguard"
+)
+
+// max size of the user-defined VPN configuration
+const customConfigMaxSize = 64 * 1024
+
+// connectCustomConfig establishes the VPN connection using the user-defined configuration ("bring your own config").
+// The connection is protected the same way as the regular one (firewall, DNS, routing).
+// The IVPN-specific features (AntiTracker, obfsproxy, V2Ray, Shadowsocks ...) are not applicable.
+// NOTE: the connection parameters are not saved as last used parameters (the configuration contains private keys).
+func (s *Service) connectCustomConfig(params types.ConnectionParams) error {
+	cfg := params.CustomConfig
+	if len(cfg.Text) > customConfigMaxSize {
+		return fmt.Errorf("VPN configuration is too big")
+	}
+	if params.Metadata.AntiTracker.IsEnabled() {
+		return fmt.Errorf("AntiTracker is not applicable for custom VPN configuration")
+	}
+	prefs := s.Preferences()
+	if prefs.Obfs4proxy.IsObfsproxy() || prefs.V2RayProxy.IsEnabled() || prefs.ShadowsocksProxy.IsEnabled() {
+		return fmt.Errorf("custom VPN configuration can not be used together with obfsproxy, V2Ray or Shadowsocks")
+	}
+
+	switch vpn.Type(params.VpnType) {
+	case vpn.WireGuard:
+		connectionParams, err := wireguard.ParseCustomConfig(cfg.Text)
+		if err != nil {
+			return fmt.Errorf("bad WireGuard configuration: %w", err)
+		}
+		log.Info("Connecting using custom WireGuard configuration...")
+		return s.connectWireGuard(connectionParams, params.ManualDNS, params.Metadata.AntiTracker, params.FirewallOn, params.FirewallOnDuringConnection)
+
+	case vpn.OpenVPN:
+		connectionParams, err := openvpn.ParseCustomConfig(cfg.Text)
+		if err != nil {
+			return fmt.Errorf("bad OpenVPN configuration: %w", err)
+		}
+		if connectionParams.IsCustomAuthRequired() {
+			if len(cfg.Username) == 0 || len(cfg.Password) == 0 {
+				return fmt.Errorf("OpenVPN configuration requires username and password")
+			}
+			connectionParams.SetCredentials(cfg.Username, cfg.Password)
+		}
+		log.Info("Connecting using custom OpenVPN configuration...")
+		return s.connectOpenVPN(connectionParams, params.ManualDNS, params.Metadata.AntiTracker, params.FirewallOn, params.FirewallOnDuringConnection)
+	}
+
+	return fmt.Errorf("unexpected VPN type to connect (%v)", params.VpnType)
+}
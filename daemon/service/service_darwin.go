@@ -28,10 +28,12 @@ package service
 import (
 	"fmt"
 	"net"
+	"strings"
 
 	protocolTypes "github.com/ivpn/desktop-app/daemon/protocol/types"
 	"github.com/ivpn/desktop-app/daemon/service/firewall"
 	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"github.com/ivpn/desktop-app/daemon/splittun"
 )
 
 func (s *Service) implIsCanApplyUserPreferences(userPrefs preferences.UserPreferences) error {
@@ -53,13 +55,26 @@ func (s *Service) implPingServersStopped(hosts []net.IP) error {
 	return firewall.RemoveHostsFromExceptions(hosts, onlyForICMP, isPersistent)
 }
 
-func (s *Service) implSplitTunnelling_AddApp(binaryFile string) (requiredCmdToExec string, isAlreadyRunning bool, err error) {
-	// Split Tunneling is not implemented for macOS
+func (s *Service) implSplitTunnelling_AddApp(execCmd string, requesterUid int) (requiredCmdToExec string, isAlreadyRunning bool, err error) {
+	execCmd = strings.TrimSpace(execCmd)
+	if len(execCmd) <= 0 {
+		return "", false, nil
+	}
+
+	// ensure ST is initialized
+	if err = s.splitTunnelling_ApplyConfig(); err != nil {
+		return "", false, err
+	}
+
+	// On macOS the application is started by the daemon (no operations required on the client side)
+	// under the account of the user which requested it
+	if _, err = splittun.RunApp(execCmd, requesterUid); err != nil {
+		return "", false, err
+	}
 	return "", false, nil
 }
 func (s *Service) implSplitTunnelling_RemoveApp(pid int, binaryPath string) (err error) {
-	// Split Tunneling is not implemented for macOS
-	return nil
+	return splittun.RemovePid(pid)
 }
 func (s *Service) implSplitTunnelling_AddedPidInfo(pid int, exec string, cmdToExecute string) error {
 	return fmt.Errorf("function not applicable for this platform")
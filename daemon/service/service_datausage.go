@@ -0,0 +1,127 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/service/connstats"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// How often the traffic is checked against the data usage alert thresholds (while connected)
+const dataUsageCheckInterval = time.Second * 10
+
+// dataUsageDayState - the traffic of the current day counted by the daemon since it was started
+// (the connection statistics history is used when it is enabled)
+type dataUsageDayState struct {
+	mutex     sync.Mutex
+	date      string
+	bytes     uint64
+	isAlerted bool
+}
+
+// add adds the traffic to the current day counter and returns the total value
+func (d *dataUsageDayState) add(now time.Time, bytes uint64) uint64 {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if date := now.Format("2006-01-02"); date != d.date {
+		d.date, d.bytes, d.isAlerted = date, 0, false
+	}
+	d.bytes += bytes
+	return d.bytes
+}
+
+// markAlerted returns true when the alert was not sent yet for the current day
+func (d *dataUsageDayState) markAlerted() bool {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.isAlerted {
+		return false
+	}
+	d.isAlerted = true
+	return true
+}
+
+// dataUsageAlertMonitor notifies clients when the traffic of the connection exceeds the thresholds defined in preferences.
+// Each alert is sent only once per period (connection session or day).
+// The function returns when 'stop' channel closed.
+func (s *Service) dataUsageAlertMonitor(state vpn.StateInfo, stop <-chan bool) {
+	getTraffic := s.vpnTrafficCounter(state.ClientIP, "data usage alerts")
+
+	lastRx, lastTx, isTrafficOk := getTraffic()
+	var sessionBytes uint64
+	isSessionAlerted := false
+
+	check := func() {
+		now := time.Now()
+
+		var diff uint64
+		rx, tx, ok := getTraffic()
+		if ok && isTrafficOk {
+			diff = trafficCounterDiff(lastRx, rx) + trafficCounterDiff(lastTx, tx)
+		}
+		lastRx, lastTx, isTrafficOk = rx, tx, ok
+
+		sessionBytes += diff
+		dayBytes := s._dataUsageDay.add(now, diff)
+		// the connection statistics history keeps the traffic of the day also before the daemon restart
+		if days := s._connStats.Days(1); len(days) > 0 && days[0].Date == now.Format("2006-01-02") {
+			if b := days[0].RxBytes + days[0].TxBytes; b > dayBytes {
+				dayBytes = b
+			}
+		}
+
+		cfg := s.Preferences().DataUsageAlert
+		if threshold := cfg.SessionThresholdMB * 1024 * 1024; threshold > 0 && !isSessionAlerted && sessionBytes >= threshold {
+			isSessionAlerted = true
+			s.notifyDataUsageAlert(connstats.DataUsageAlert{Period: connstats.DataUsageSession, ThresholdBytes: threshold, UsedBytes: sessionBytes})
+		}
+		if threshold := cfg.DayThresholdMB * 1024 * 1024; threshold > 0 && dayBytes >= threshold && s._dataUsageDay.markAlerted() {
+			s.notifyDataUsageAlert(connstats.DataUsageAlert{Period: connstats.DataUsageDay, ThresholdBytes: threshold, UsedBytes: dayBytes})
+		}
+	}
+
+	ticker := time.NewTicker(dataUsageCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			check()
+		case <-stop:
+			return
+		}
+	}
+}
+
+func (s *Service) notifyDataUsageAlert(alert connstats.DataUsageAlert) {
+	msg := fmt.Sprintf("VPN data usage alert: %.2f MB used during the %s (threshold: %d MB)", float64(alert.UsedBytes)/(1024*1024), alert.Period, alert.ThresholdBytes/(1024*1024))
+	log.Info(msg)
+	s.systemLog(Warning, msg)
+	s._evtReceiver.OnDataUsageAlert(alert)
+}
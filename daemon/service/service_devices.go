@@ -0,0 +1,112 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/ivpn/desktop-app/daemon/api"
+	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/service/srverrors"
+)
+
+// DevicesList returns the devices (active sessions) of the account.
+// When 'accountID' is empty, the current session is used for authentication (the user must be logged in).
+// Otherwise, the account ID (and the 2FA token, if enabled for the account) is used:
+// it allows to resolve the 'too many devices' error during login.
+func (s *Service) DevicesList(accountID string, confirmation2FA string) ([]api_types.DeviceInfo, error) {
+	session, err := s.devicesAuthSession(accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	restoreFw := s.allowApiServersTemporary()
+	defer restoreFw()
+
+	devices, err := s._api.DevicesList(session, accountID, confirmation2FA)
+	if len(session) > 0 && api_types.IsSessionNotFound(err) {
+		s.OnSessionNotFound()
+	}
+	return devices, err
+}
+
+// DeviceLogout logs out the device (deletes the session) of the account.
+// Authentication: the same as for DevicesList()
+func (s *Service) DeviceLogout(accountID string, confirmation2FA string, deviceID string) error {
+	if len(deviceID) == 0 {
+		return fmt.Errorf("device ID not defined")
+	}
+
+	session, err := s.devicesAuthSession(accountID)
+	if err != nil {
+		return err
+	}
+
+	restoreFw := s.allowApiServersTemporary()
+	defer restoreFw()
+
+	log.Info(fmt.Sprintf("Logging out the device '%s' ...", deviceID))
+	if err := s._api.DeviceDelete(session, accountID, confirmation2FA, deviceID); err != nil {
+		log.Info("Logging out the device - FAILED: ", err)
+		if len(session) > 0 && api_types.IsSessionNotFound(err) {
+			s.OnSessionNotFound()
+		}
+		return err
+	}
+	log.Info("Logging out the device - SUCCESS")
+	return nil
+}
+
+// devicesAuthSession returns the session token to use for the devices requests.
+// Empty result means that the account ID is in use for authentication.
+// NOTE: the functionality is disabled until the IVPN API supports it (see api.IsDevicesManagementSupported).
+func (s *Service) devicesAuthSession(accountID string) (string, error) {
+	if !api.IsDevicesManagementSupported {
+		return "", fmt.Errorf("devices management is not supported")
+	}
+
+	if len(accountID) > 0 {
+		return "", nil
+	}
+
+	session := s.Preferences().Session
+	if !session.IsLoggedIn() {
+		return "", srverrors.ErrorNotLoggedIn{}
+	}
+	return session.Session, nil
+}
+
+// allowApiServersTemporary temporary allows the API servers access (if the firewall is enabled and the access is blocked).
+// Returns the function to restore the previous firewall configuration.
+func (s *Service) allowApiServersTemporary() (restore func()) {
+	fwIsEnabled, _, _, _, fwIsAllowApiServers, _, _ := s.KillSwitchState()
+	if !fwIsEnabled || fwIsAllowApiServers {
+		return func() {}
+	}
+
+	s.SetKillSwitchAllowAPIServers(true)
+	return func() {
+		// restore state for 'AllowAPIServers' configuration (previously, was disabled)
+		s.SetKillSwitchAllowAPIServers(false)
+	}
+}
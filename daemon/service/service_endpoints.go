@@ -0,0 +1,192 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/netinfo"
+	"github.com/ivpn/desktop-app/daemon/ping"
+)
+
+// timeout of the reachability probe of the server addresses (before connection)
+const endpointProbeTimeout = time.Second
+
+// endpointSelector selects the address of the VPN server to connect to,
+// according to the connectivity of the current network.
+//   - IPv6-only networks (no IPv4 default route): the IPv6 address of the server is in use;
+//     when the server has no IPv6 address, the address is synthesized using the NAT64 prefix of the network (if detected).
+//   - dual-stack networks: the IPv4 address is preferred; the IPv6 address is in use when only it is reachable
+//     (both addresses are probed before connection).
+type endpointSelector struct {
+	isIPv6Only  bool
+	isDualStack bool
+	nat64Prefix *net.IPNet
+	// when not nil - the address family is defined by the active connection (no probes possible while connected)
+	isIPv6Required *bool
+	// probe returns the reachable addresses from the list
+	probe func(ips []net.IP) map[string]bool
+}
+
+// newEndpointSelector creates endpointSelector for the current network.
+// 'isProbeAllowed' - when 'false', the reachability of the addresses is not measured (IPv4 is preferred on dual-stack networks)
+func (s *Service) newEndpointSelector(isProbeAllowed bool) endpointSelector {
+	if netinfo.IsIPv6OnlyNetwork() {
+		ret := endpointSelector{isIPv6Only: true}
+		prefix, err := netinfo.NAT64Prefix()
+		if err != nil {
+			log.Info("IPv6-only network detected (NAT64 prefix unknown: ", err, ")")
+		} else {
+			log.Info("IPv6-only network detected (NAT64 prefix: ", prefix, ")")
+			ret.nat64Prefix = prefix
+		}
+		return ret
+	}
+
+	if !isProbeAllowed {
+		return endpointSelector{}
+	}
+	if _, err := netinfo.PrimaryDefaultRoute(true); err != nil {
+		return endpointSelector{} // no IPv6 connectivity
+	}
+
+	ret := endpointSelector{isDualStack: true, probe: s.probeEndpoints}
+	if vpnObj := s._vpn; vpnObj != nil {
+		// connected: keep the address family of the active connection
+		if activeHost := vpnObj.DestinationIP(); activeHost != nil {
+			isIPv6 := activeHost.To4() == nil
+			ret.isIPv6Required = &isIPv6
+		}
+	}
+	return ret
+}
+
+// hostEndpointInterface - the VPN host which can have the public IPv6 address
+type hostEndpointInterface interface {
+	hostBaseInterface
+	GetIPv6Host() string
+}
+
+// filterHostsByEndpoint returns the hosts reachable from the current network.
+// On the IPv6-only network, the hosts with IPv6 address are preferred.
+func filterHostsByEndpoint[H hostEndpointInterface](e endpointSelector, hosts []H) ([]H, error) {
+	if !e.isIPv6Only {
+		return hosts, nil
+	}
+
+	ret := make([]H, 0, len(hosts))
+	for _, h := range hosts {
+		if net.ParseIP(h.GetIPv6Host()) != nil {
+			ret = append(ret, h)
+		}
+	}
+	if len(ret) > 0 {
+		return ret, nil
+	}
+	if e.nat64Prefix != nil {
+		return hosts, nil
+	}
+	return nil, fmt.Errorf("unable to connect from IPv6-only network: the server has no IPv6 address and NAT64 is not detected")
+}
+
+// hostEndpointIP returns the address of the host to connect to
+func hostEndpointIP[H hostEndpointInterface](e endpointSelector, host H) (net.IP, error) {
+	h := host.GetHostInfoBase()
+	ipv4 := net.ParseIP(h.Host)
+	ipv6 := net.ParseIP(host.GetIPv6Host())
+
+	if e.isIPv6Only {
+		if ipv6 != nil {
+			return ipv6, nil
+		}
+		if e.nat64Prefix != nil && ipv4 != nil {
+			return netinfo.SynthesizeNAT64(e.nat64Prefix, ipv4)
+		}
+		return nil, fmt.Errorf("unable to connect from IPv6-only network: the host %s has no IPv6 address", h.Hostname)
+	}
+
+	if !e.isDualStack || ipv6 == nil || ipv4 == nil {
+		return ipv4, nil
+	}
+
+	if e.isIPv6Required != nil {
+		if *e.isIPv6Required {
+			return ipv6, nil
+		}
+		return ipv4, nil
+	}
+
+	reachable := e.probe([]net.IP{ipv4, ipv6})
+	if !reachable[ipv4.String()] && reachable[ipv6.String()] {
+		log.Info(fmt.Sprintf("The IPv4 address of the host %s is not reachable. Using IPv6 address %s", h.Hostname, ipv6))
+		return ipv6, nil
+	}
+	return ipv4, nil
+}
+
+// probeEndpoints pings the addresses (in parallel) and returns the reachable ones
+func (s *Service) probeEndpoints(ips []net.IP) map[string]bool {
+	// OS-specific preparations (e.g. we need to add servers IPs to firewall exceptions list)
+	if err := s.implPingServersStarting(ips); err != nil {
+		log.Error("implPingServersStarting failed: " + err.Error())
+	}
+	defer func() {
+		if err := s.implPingServersStopped(ips); err != nil {
+			log.Error("implPingServersStopped failed: " + err.Error())
+		}
+	}()
+
+	var (
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+	)
+	ret := make(map[string]bool, len(ips))
+	for _, ip := range ips {
+		wg.Add(1)
+		go func(ipStr string) {
+			defer wg.Done()
+
+			pinger, err := ping.NewPinger(ipStr)
+			if err != nil {
+				log.Debug("Pinger creation error: ", err)
+				return
+			}
+			pinger.SetPrivileged(true)
+			pinger.Count = 1
+			pinger.Timeout = endpointProbeTimeout
+			pinger.Run()
+
+			if pinger.Statistics().PacketsRecv > 0 {
+				mutex.Lock()
+				ret[ipStr] = true
+				mutex.Unlock()
+			}
+		}(ip.String())
+	}
+	wg.Wait()
+
+	return ret
+}
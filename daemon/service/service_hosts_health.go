@@ -0,0 +1,74 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ivpn/desktop-app/daemon/service/hostshealth"
+)
+
+// HostsHealth returns health information for the VPN hosts which had connection failures
+func (s *Service) HostsHealth() []hostshealth.HostScore {
+	return s._hostsHealth.Scores()
+}
+
+// healthyHosts returns the hosts which are not temporarily blacklisted because of the connection failures.
+// If all hosts are blacklisted - the hosts with the lowest failure score are returned.
+func healthyHosts[H hostBaseInterface](s *Service, hosts []H) []H {
+	if len(hosts) <= 1 {
+		return hosts
+	}
+
+	indexes := s._hostsHealth.Prioritize(len(hosts), func(idx int) string { return hosts[idx].GetHostInfoBase().Host })
+	if len(indexes) == len(hosts) {
+		return hosts
+	}
+
+	ret := make([]H, 0, len(indexes))
+	for _, i := range indexes {
+		ret = append(ret, hosts[i])
+	}
+	log.Info(fmt.Sprintf("%d of %d hosts are deprioritized due to connection failures", len(hosts)-len(ret), len(hosts)))
+	return ret
+}
+
+// hostsHealthOnFailure registers the connection failure for the VPN host
+func (s *Service) hostsHealthOnFailure(host net.IP, failure hostshealth.FailureType) {
+	if host == nil {
+		return
+	}
+	log.Info(fmt.Sprintf("Connection failure registered for host %s: %s", host, failure))
+	if s._hostsHealth.OnFailure(host.String(), failure) {
+		log.Warning(fmt.Sprintf("Host %s is temporarily blacklisted due to connection failures", host))
+	}
+}
+
+// hostsHealthOnSuccess registers successful connection to the VPN host
+func (s *Service) hostsHealthOnSuccess(host net.IP) {
+	if host == nil {
+		return
+	}
+	s._hostsHealth.OnSuccess(host.String())
+}
@@ -0,0 +1,183 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/crashreport"
+	"github.com/ivpn/desktop-app/daemon/service/dns"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// Interval of checking the state of the physical network interfaces
+const ifFlapCheckInterval = time.Second * 2
+
+// WireGuard REJECT-AFTER-TIME: the session is not usable when there was no handshake during this time
+const wgRejectAfterTime = time.Second * 180
+
+// Time to wait for the WireGuard handshake after the interface flap before checking the tunnel health
+// (the keepalive packets are sent each 25 seconds: it initiates the handshake if the session keys are expired)
+const ifFlapHandshakeGracePeriod = time.Second * 30
+
+// interfaceFlapMonitor detects when the underlying physical network interfaces go down/up
+// (cable pull, Wi-Fi roaming, sleep/wake) while the VPN is connected.
+// On such event, the routes and DNS configuration are re-applied and the tunnel health is verified:
+// the connection is re-established if the tunnel is not alive anymore.
+// The function returns when 'stop' channel closed.
+func (s *Service) interfaceFlapMonitor(vpnInterface *net.Interface, stop <-chan bool) {
+	log.Info("Interface flap monitor started")
+	defer log.Info("Interface flap monitor stopped")
+
+	vpnInterfaceName := ""
+	if vpnInterface != nil {
+		vpnInterfaceName = vpnInterface.Name
+	}
+
+	lastState := physicalInterfacesState(vpnInterfaceName)
+	lastCheckTime := time.Now()
+	isChangePending := false
+	var healthCheckSince time.Time // zero value - tunnel health check is not running
+
+	ticker := time.NewTicker(ifFlapCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+
+		if s.IsPaused() {
+			lastState, isChangePending, healthCheckSince = physicalInterfacesState(vpnInterfaceName), false, time.Time{}
+			lastCheckTime = time.Now()
+			continue
+		}
+
+		isWakeUp := time.Since(lastCheckTime) > ifFlapCheckInterval*3
+		lastCheckTime = time.Now()
+
+		state := physicalInterfacesState(vpnInterfaceName)
+		if state != lastState {
+			// wait until the interfaces state is stable
+			log.Info(fmt.Sprintf("Network interfaces changed: [%s] -> [%s]", lastState, state))
+			lastState, isChangePending = state, true
+			continue
+		}
+
+		if isWakeUp {
+			log.Info("Wake up from sleep detected")
+		}
+		if isWakeUp || (isChangePending && len(state) > 0) {
+			isChangePending = false
+			healthCheckSince = time.Now()
+			s.revalidateTunnelConfiguration()
+		}
+
+		if !healthCheckSince.IsZero() {
+			if isAlive, isDone := s.checkTunnelAliveAfter(healthCheckSince); isDone {
+				healthCheckSince = time.Time{}
+				if !isAlive {
+					log.Info("The VPN tunnel is not alive after the network interface change. Reconnecting...")
+					// reconnect in separate routine (the monitor must be stopped before the connection finished)
+					crashreport.Go("reconnect on interface flap", s.reconnect)
+					return
+				}
+			}
+		}
+	}
+}
+
+// revalidateTunnelConfiguration re-applies the routes and DNS configuration of the VPN connection
+func (s *Service) revalidateTunnelConfiguration() {
+	vpnObj := s._vpn
+	if vpnObj == nil {
+		return
+	}
+
+	log.Info("Re-validating the VPN tunnel configuration...")
+	if err := vpnObj.OnRoutingChanged(); err != nil {
+		log.Warning(fmt.Errorf("failed to update routes: %w", err))
+	}
+	if err := dns.UpdateDnsIfWrongSettings(); err != nil {
+		log.Error(fmt.Errorf("failed to update DNS settings: %w", err))
+	}
+}
+
+// checkTunnelAliveAfter checks the tunnel health after the network change which happened at 'changeTime'.
+// Returns isDone=false when the result is not known yet.
+// Note: only WireGuard connections are checked (OpenVPN detects the dead connection by itself: 'ping-restart')
+func (s *Service) checkTunnelAliveAfter(changeTime time.Time) (isAlive, isDone bool) {
+	if isConnected, vpnType := s.ConnectedType(); !isConnected || vpnType != vpn.WireGuard {
+		return true, true
+	}
+
+	latestHandshake, ok := s.VpnLatestHandshake()
+	if ok && latestHandshake.After(changeTime) {
+		return true, true // new handshake after the change: the tunnel is alive
+	}
+	if time.Since(changeTime) < ifFlapHandshakeGracePeriod {
+		return false, false
+	}
+	if !ok || time.Since(latestHandshake) > wgRejectAfterTime {
+		return false, true
+	}
+	return false, false // the session keys are still valid: waiting for the next handshake
+}
+
+// physicalInterfacesState returns the string which represents the state of active physical network interfaces
+// (names and IP addresses; the loopback and VPN interfaces are ignored)
+func physicalInterfacesState(vpnInterfaceName string) string {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return ""
+	}
+
+	ret := make([]string, 0, len(ifaces))
+	for _, ifc := range ifaces {
+		if ifc.Flags&net.FlagUp == 0 || ifc.Flags&net.FlagLoopback != 0 || ifc.Name == vpnInterfaceName {
+			continue
+		}
+
+		addrs, _ := ifc.Addrs()
+		ips := make([]string, 0, len(addrs))
+		for _, addr := range addrs {
+			if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.IsGlobalUnicast() {
+				ips = append(ips, ipNet.IP.String())
+			}
+		}
+		if len(ips) == 0 {
+			continue
+		}
+		sort.Strings(ips)
+		ret = append(ret, ifc.Name+"="+strings.Join(ips, ","))
+	}
+
+	sort.Strings(ret)
+	return strings.Join(ret, "; ")
+}
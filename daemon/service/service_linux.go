@@ -79,7 +79,7 @@ func (s *Service) implPingServersStopped(hosts []net.IP) error {
 	return firewall.RemoveHostsFromExceptions(hosts, onlyForICMP, isPersistent)
 }
 
-func (s *Service) implSplitTunnelling_AddApp(execCmd string) (requiredCmdToExec string, isAlreadyRunning bool, err error) {
+func (s *Service) implSplitTunnelling_AddApp(execCmd string, requesterUid int) (requiredCmdToExec string, isAlreadyRunning bool, err error) {
 	if !s._preferences.IsSplitTunnel {
 		return "", false, fmt.Errorf("unable to run application in Split Tunneling environment: Split Tunneling is disabled")
 	}
@@ -0,0 +1,129 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/ping"
+	"github.com/ivpn/desktop-app/daemon/vpn/wireguard"
+)
+
+const (
+	// How often the path MTU through the tunnel is checked (while connected)
+	mtuProbeInterval = time.Second * 20
+	// Max time to wait for the reply to the probe
+	mtuProbeTimeout = time.Second * 2
+	// Number of consecutive failed checks (small probe passed, full-size probe lost) to treat the path as the PMTU blackhole
+	mtuBlackholeThreshold = 3
+	// Size of IPv4 + ICMP headers: the payload of full-size probe is 'MTU - mtuProbeHeadersSize'
+	mtuProbeHeadersSize = 20 + 8
+	// Payload size of the small probe
+	mtuSmallProbeSize = 32
+)
+
+// MTU values of the WireGuard interface which are applied one by one when the PMTU blackhole is detected
+var mtuFallbackValues = []int{1380, 1340, 1300, wireguard.MinMTU}
+
+// mtuBlackholeMonitor detects the PMTU blackholes on the path to the WireGuard server
+// (large packets through the tunnel are dropped, e.g. after switching to LTE network)
+// and lowers the MTU of the tunnel interface on the fly (without reconnection).
+// The check: full-size ICMP echo (the size of the packet equals to the interface MTU) is sent to the server through the tunnel;
+// it is considered as failed when the small probe passes but the full-size probe is lost.
+// 'target' - the host which is reachable only through the tunnel (the internal address of the VPN server);
+// 'localIP' - the local address of the tunnel.
+// The function returns when 'stop' channel closed.
+func (s *Service) mtuBlackholeMonitor(wg *wireguard.WireGuard, target net.IP, localIP net.IP, stop <-chan bool) {
+	if target == nil || target.To4() == nil || localIP == nil {
+		return
+	}
+
+	probe := func(size int) bool {
+		pinger, err := ping.NewPinger(target.String())
+		if err != nil {
+			log.Debug("MTU check: pinger creation error: ", err)
+			return false
+		}
+		pinger.SetPrivileged(true)
+		pinger.Source = localIP.String()
+		pinger.Count = 1
+		pinger.Size = size
+		pinger.Timeout = mtuProbeTimeout
+		pinger.Run()
+		return pinger.Statistics().PacketsRecv > 0
+	}
+
+	failures := 0
+	ticker := time.NewTicker(mtuProbeInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+
+		if wg.IsPaused() {
+			failures = 0
+			continue
+		}
+		mtu, err := wg.InterfaceMTU()
+		if err != nil || mtu <= wireguard.MinMTU {
+			continue
+		}
+
+		if probe(mtu-mtuProbeHeadersSize) || !probe(mtuSmallProbeSize) {
+			// full-size packets are passing or the server is not reachable at all (not an MTU problem)
+			failures = 0
+			continue
+		}
+
+		failures++
+		log.Info(fmt.Sprintf("MTU check: full-size packets (MTU %d) are not passing through the tunnel (%d/%d)", mtu, failures, mtuBlackholeThreshold))
+		if failures < mtuBlackholeThreshold {
+			continue
+		}
+		failures = 0
+
+		newMtu := 0
+		for _, v := range mtuFallbackValues {
+			if v < mtu {
+				newMtu = v
+				break
+			}
+		}
+		if newMtu == 0 {
+			continue
+		}
+
+		if err := wg.SetInterfaceMTU(newMtu); err != nil {
+			log.Error(fmt.Sprintf("MTU check: failed to change MTU %d -> %d: %v", mtu, newMtu, err))
+			continue
+		}
+		msg := fmt.Sprintf("Path MTU blackhole detected: the MTU of the VPN interface is lowered %d -> %d", mtu, newMtu)
+		log.Info(msg)
+		s.systemLog(Info, msg)
+	}
+}
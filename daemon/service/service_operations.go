@@ -0,0 +1,112 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ivpn/desktop-app/daemon/operations"
+	"github.com/ivpn/desktop-app/daemon/service/speedtest"
+)
+
+// OperationStart starts the long-running operation of the specified type (e.g. operations.TypeDiagnostics).
+// The progress and the result of the operation are reported by IServiceEventsReceiver.OnOperationStatus()
+func (s *Service) OperationStart(opType string) (operations.Status, error) {
+	var f operations.Func
+	switch opType {
+	case operations.TypeDiagnostics:
+		f = s.operationDiagnostics
+	case operations.TypeServersUpdate:
+		f = s.operationServersUpdate
+	case operations.TypeSpeedTest:
+		// the speed test with default parameters (see SpeedTestStart)
+		return s.SpeedTestStart(speedtest.Params{})
+	default:
+		return operations.Status{}, fmt.Errorf("unknown operation type '%s'", opType)
+	}
+	return s._operations.Start(opType, f), nil
+}
+
+// OperationCancel cancels the running operation
+func (s *Service) OperationCancel(id uint64) (operations.Status, error) {
+	return s._operations.Cancel(id)
+}
+
+// OperationsRunning returns status of all running operations
+func (s *Service) OperationsRunning() []operations.Status {
+	return s._operations.Running()
+}
+
+func (s *Service) operationDiagnostics(ctx context.Context, progress operations.ProgressFunc) (interface{}, error) {
+	return s.getDiagnosticLogs(ctx, progress)
+}
+
+// SpeedTestStart starts the operation which measures the download/upload throughput through the active VPN tunnel.
+// The result of the operation is speedtest.Result.
+func (s *Service) SpeedTestStart(params speedtest.Params) (operations.Status, error) {
+	if err := params.Validate(); err != nil {
+		return operations.Status{}, err
+	}
+	if !s.Connected() {
+		return operations.Status{}, fmt.Errorf("VPN is not connected")
+	}
+	if s.IsPaused() {
+		return operations.Status{}, fmt.Errorf("VPN connection is paused")
+	}
+
+	return s._operations.Start(operations.TypeSpeedTest, func(ctx context.Context, progress operations.ProgressFunc) (interface{}, error) {
+		return s.operationSpeedTest(ctx, progress, params)
+	}), nil
+}
+
+func (s *Service) operationSpeedTest(ctx context.Context, progress operations.ProgressFunc, params speedtest.Params) (interface{}, error) {
+	vpnObj := s._vpn
+	localIP := s.GetVpnSessionInfo().VpnLocalIPv4
+	if vpnObj == nil || localIP == nil {
+		return nil, fmt.Errorf("VPN is not connected")
+	}
+
+	result, err := speedtest.Run(ctx, params, localIP, progress)
+	if err != nil {
+		return nil, err
+	}
+	// the VPN connection could be changed during the test
+	if s._vpn != vpnObj {
+		return nil, fmt.Errorf("VPN connection changed during the speed test")
+	}
+	result.Server = s.connectedEntryHostname(vpnObj.DestinationIP())
+	result.VpnType = vpnObj.Type().String()
+	return result, nil
+}
+
+func (s *Service) operationServersUpdate(ctx context.Context, progress operations.ProgressFunc) (interface{}, error) {
+	// Note: the download of the servers list can not be interrupted.
+	// When the operation cancelled - the servers list still will be updated (but the operation result is ignored).
+	progress(0, "Downloading servers list")
+	if _, err := s.ServersListForceUpdate(); err != nil {
+		return nil, err
+	}
+	// the updated servers list is sent to clients by OnServersUpdated() event
+	return nil, nil
+}
@@ -76,9 +76,11 @@ func (s *Service) PingServers(timeoutMs int, vpnTypePrioritized vpn.Type, pingAl
 
 	var geoLocation *types.GeoLookupResponse = nil
 	if timeoutMs >= 3000 {
-		l, err := s._api.GeoLookup(1500)
+		l, cachedAt, err := s._api.GeoLookup(1500)
 		if err != nil {
 			log.Warning("(pinging) unable to obtain geo-location (fastest server detection could be not accurate):", err)
+		} else if !cachedAt.IsZero() {
+			log.Info(fmt.Sprintf("(pinging) using cached geo-location (received %v ago)", time.Since(cachedAt).Round(time.Second)))
 		}
 		geoLocation = l
 	} else {
@@ -172,6 +174,31 @@ func (s *Service) PingServers(timeoutMs int, vpnTypePrioritized vpn.Type, pingAl
 	return result, nil
 }
 
+// pingHosts pings the specified hosts (one-by-one, in the order defined by the list).
+// Operation ends after 'timeoutMs'. Returns map [host]latency
+func (s *Service) pingHosts(hosts []net.IP, timeoutMs int) (map[string]int, error) {
+	if s._vpn != nil {
+		return nil, fmt.Errorf("servers pinging skipped due to connected state")
+	}
+	// Block pinging when IVPNServersAccess==blocked
+	if err := s.IsConnectivityBlocked(); err != nil {
+		return nil, fmt.Errorf("servers pinging skipped: %v", err)
+	}
+	// do not allow multiple ping request simultaneously
+	if !s._serversPingProgressSemaphore.TryAcquire(1) {
+		return nil, fmt.Errorf("servers pinging skipped: ping already in progress, please try again after some delay")
+	}
+	defer s._serversPingProgressSemaphore.Release(1)
+
+	startTime := time.Now()
+	result := make(map[string]int)
+	timeoutTime := startTime.Add(time.Millisecond * time.Duration(timeoutMs))
+	s.pingIteration(hosts, result, 300, &timeoutTime)
+
+	log.Info(fmt.Sprintf("Hosts ping finished in (%v): %d of %d pinged", time.Since(startTime), len(result), len(hosts)))
+	return result, nil
+}
+
 func (s *Service) pingIteration(hostsToPing []net.IP, pingedResult map[string]int, onePingTimeoutMs int, timeout *time.Time) /* map[string]int*/ {
 	// OS-specific preparations (e.g. we need to add servers IPs to firewall exceptions list)
 	if err := s.implPingServersStarting(hostsToPing); err != nil {
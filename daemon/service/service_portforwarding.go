@@ -0,0 +1,60 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/ivpn/desktop-app/daemon/api"
+	"github.com/ivpn/desktop-app/daemon/service/portforwarding"
+)
+
+// PortForwardingStatus returns the state of the port forwarded to the current VPN connection
+func (s *Service) PortForwardingStatus() portforwarding.State {
+	return s._portForwarding.State()
+}
+
+// PortForwardingRequest requests the forwarded port for the current VPN connection.
+// The port is renewed automatically until it is released or VPN disconnected.
+// NOTE: the functionality is disabled until the IVPN API supports it (see api.IsPortForwardingSupported).
+func (s *Service) PortForwardingRequest() (portforwarding.State, error) {
+	if !api.IsPortForwardingSupported {
+		return portforwarding.State{}, fmt.Errorf("port forwarding is not supported")
+	}
+
+	session := s.Preferences().Session
+	if !session.IsLoggedIn() {
+		return portforwarding.State{}, fmt.Errorf("not logged in")
+	}
+	return s._portForwarding.Request(session.Session)
+}
+
+// PortForwardingRelease releases the forwarded port
+func (s *Service) PortForwardingRelease() error {
+	return s._portForwarding.Release()
+}
+
+// OnPortForwardingChanged - (IPortForwardingReceiver implementation) the forwarded port state changed
+func (s *Service) OnPortForwardingChanged(state portforwarding.State) {
+	s._evtReceiver.OnPortForwardingChanged(state)
+}
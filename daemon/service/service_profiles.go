@@ -0,0 +1,78 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"github.com/ivpn/desktop-app/daemon/service/types"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// ConnectionProfiles returns the list of saved connection profiles
+func (s *Service) ConnectionProfiles() []preferences.ConnectionProfile {
+	return s._preferences.ConnectionProfiles
+}
+
+// ConnectionProfileSave saves the connection profile (the profile with the same name is replaced)
+func (s *Service) ConnectionProfileSave(profile preferences.ConnectionProfile) error {
+	prefs := s._preferences
+	if err := prefs.SaveConnectionProfile(profile); err != nil {
+		return err
+	}
+	s.setPreferences(prefs)
+	return nil
+}
+
+// ConnectionProfileRemove removes the connection profile by name
+func (s *Service) ConnectionProfileRemove(name string) error {
+	prefs := s._preferences
+	if err := prefs.RemoveConnectionProfile(name); err != nil {
+		return err
+	}
+	s.setPreferences(prefs)
+	return nil
+}
+
+// ConnectionProfileApply applies the settings of the connection profile which are not a part of connection parameters
+// (e.g. obfsproxy configuration) and returns the connection parameters of the profile
+func (s *Service) ConnectionProfileApply(name string) (types.ConnectionParams, error) {
+	prefs := s.Preferences()
+	profile, ok := prefs.FindConnectionProfile(name)
+	if !ok {
+		return types.ConnectionParams{}, fmt.Errorf("profile '%s' not found", name)
+	}
+	if err := profile.Params.CheckIsDefined(); err != nil {
+		return types.ConnectionParams{}, fmt.Errorf("profile '%s' is not valid: %w", profile.Name, err)
+	}
+
+	if profile.Params.VpnType == vpn.OpenVPN && !prefs.Obfs4proxy.Equals(profile.Obfs4proxy) {
+		if err := s.SetObfsProxy(profile.Obfs4proxy); err != nil {
+			return types.ConnectionParams{}, err
+		}
+	}
+
+	log.Info(fmt.Sprintf("Applying connection profile '%s'", profile.Name))
+	return profile.Params, nil
+}
@@ -0,0 +1,185 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/auditlog"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+	"github.com/ivpn/desktop-app/daemon/service/srverrors"
+)
+
+// How often the connection scheduler checks the rules
+const schedulerCheckInterval = time.Second * 20
+
+// The traffic through the VPN tunnel (bytes per check interval) which is not considered as an activity
+// (background traffic: keepalive packets, DNS requests etc.)
+const schedulerInactivityTrafficThreshold = 1024 * 10
+
+// SetScheduleSettings sets configuration of the connection scheduler
+func (s *Service) SetScheduleSettings(params preferences.ScheduleParams) error {
+	for _, r := range params.Rules {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if params.IsEnabled {
+		for _, r := range params.Rules {
+			if r.Action != preferences.ScheduleConnect {
+				continue
+			}
+			// the connection will be established in background: the default connection parameters are required
+			if e := s._preferences.LastConnectionParams.CheckIsDefined(); e != nil {
+				return srverrors.ErrorBackgroundConnectionNoParams{}
+			}
+			break
+		}
+	}
+
+	prefs := s._preferences
+	prefs.Schedule = params
+	s.setPreferences(prefs)
+	return nil
+}
+
+// schedulerRoutine checks the rules of the connection scheduler and applies the actions (the function never returns)
+func (s *Service) schedulerRoutine() {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("PANIC in connection scheduler!: ", r)
+			if err, ok := r.(error); ok {
+				log.ErrorTrace(err)
+			}
+		}
+	}()
+
+	log.Info("Connection scheduler started")
+
+	lastProcessedMinute := time.Now().Truncate(time.Minute)
+	lastActivityTime := time.Now()
+	var lastTraffic uint64
+	isLastTrafficKnown := false
+
+	ticker := time.NewTicker(schedulerCheckInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		now := time.Now()
+		isConnected := s.Connected()
+
+		// detect VPN activity
+		if !isConnected {
+			lastActivityTime = now
+			isLastTrafficKnown = false
+		} else {
+			traffic, ok := s.schedulerVpnTraffic()
+			if !ok || !isLastTrafficKnown || traffic < lastTraffic || traffic-lastTraffic > schedulerInactivityTrafficThreshold {
+				lastActivityTime = now
+			}
+			lastTraffic, isLastTrafficKnown = traffic, ok
+		}
+
+		curMinute := now.Truncate(time.Minute)
+		isNewMinute := curMinute.After(lastProcessedMinute)
+		lastProcessedMinute = curMinute
+
+		schedule := s.Preferences().Schedule
+		if !schedule.IsEnabled {
+			continue
+		}
+
+		for _, r := range schedule.Rules {
+			if !r.IsActiveOnDay(now.Weekday()) {
+				continue
+			}
+
+			if r.IsInactivityRule() {
+				if isConnected && now.Sub(lastActivityTime) >= time.Duration(r.InactivityMinutes)*time.Minute {
+					log.Info(fmt.Sprintf("Connection scheduler: no VPN activity for %d minutes", r.InactivityMinutes))
+					s.schedulerApplyAction(r.Action)
+					lastActivityTime = now
+				}
+				continue
+			}
+
+			if !isNewMinute {
+				continue
+			}
+			if hour, min, err := r.TimeOfDay(); err == nil && now.Hour() == hour && now.Minute() == min {
+				log.Info(fmt.Sprintf("Connection scheduler: rule '%s' at %s", r.Action, r.Time))
+				s.schedulerApplyAction(r.Action)
+			}
+		}
+	}
+}
+
+func (s *Service) schedulerApplyAction(action preferences.ScheduleAction) {
+	if !s._evtReceiver.IsCanDoBackgroundAction() {
+		log.Info("Connection scheduler: action skipped (background actions are not allowed)")
+		return
+	}
+
+	var err error
+	switch action {
+	case preferences.ScheduleConnect:
+		if session := s.Preferences().Session; !session.IsLoggedIn() {
+			log.Info("Connection scheduler: unable to connect (not logged in)")
+			return
+		}
+		if s.Connected() {
+			return
+		}
+		log.Info("Connection scheduler: connecting VPN")
+		err = s.requestAutomaticConnection(s.Preferences().LastConnectionParams)
+
+	case preferences.ScheduleDisconnect:
+		if !s.Connected() {
+			return
+		}
+		log.Info("Connection scheduler: disconnecting VPN")
+		err = s.Disconnect()
+
+	case preferences.ScheduleFirewallOn, preferences.ScheduleFirewallOff:
+		isEnabled := action == preferences.ScheduleFirewallOn
+		log.Info(fmt.Sprintf("Connection scheduler: changing Firewall state (IsEnabled: %t)", isEnabled))
+		if err = s.SetKillSwitchState(isEnabled); err == nil {
+			auditlog.Write(auditlog.DaemonActor(), auditlog.EventKillSwitch, fmt.Sprintf("IsEnabled: %t (connection scheduler)", isEnabled))
+		}
+	}
+
+	if err != nil {
+		log.Error(fmt.Errorf("connection scheduler: %w", err))
+	}
+}
+
+// schedulerVpnTraffic returns the number of bytes transferred through the VPN tunnel
+func (s *Service) schedulerVpnTraffic() (uint64, bool) {
+	rx, tx, ok := s.VpnTrafficStats()
+	if !ok {
+		return 0, false
+	}
+	return rx + tx, true
+}
@@ -0,0 +1,150 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/auditlog"
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+)
+
+// The silent session renewal is not performed more often than this interval
+// (protection from the renewal loop when the new session is deleted on the backend again)
+const sessionRenewMinInterval = time.Minute * 10
+
+var (
+	sessionRecoveryMutex sync.Mutex
+	sessionLastRenewTime time.Time
+)
+
+// OnSessionNotFound must be called when the API request fails with 'Session not found' error
+// (e.g. the session expired or the device was logged out from another device)
+func (s *Service) OnSessionNotFound() {
+	isConfirmed := false
+	s.onSessionInvalid(s.Preferences().Session.Session, isConfirmed)
+}
+
+// onSessionInvalid starts the session recovery:
+// the session is renewed silently (if enabled and possible), otherwise the daemon logs out and notifies clients that re-login is required.
+// If 'isConfirmed' == false: the session status is re-checked before the recovery
+// (the failed request could be performed with an outdated session, which is already renewed)
+func (s *Service) onSessionInvalid(sessionToken string, isConfirmed bool) {
+	if len(sessionToken) == 0 {
+		return
+	}
+
+	// The recovery is performed in a separate routine:
+	// the function can be called by the session checker or by the WG keys manager (avoid deadlocks)
+	go func() {
+		sessionRecoveryMutex.Lock()
+		defer sessionRecoveryMutex.Unlock()
+
+		session := s.Preferences().Session
+		if !session.IsLoggedIn() || session.Session != sessionToken {
+			return // the session already changed (renewed or logged out)
+		}
+
+		if !isConfirmed {
+			_, apiErr, err := s._api.SessionStatus(sessionToken)
+			if err == nil || apiErr == nil || apiErr.Status != api_types.SessionNotFound {
+				log.Info("Session recovery skipped: the session is not confirmed as invalid")
+				return
+			}
+		}
+
+		s.recoverSession(session)
+	}()
+}
+
+// recoverSession renews the session silently (if enabled and possible).
+// Otherwise, logs out and notifies clients that re-login is required.
+func (s *Service) recoverSession(session preferences.SessionStatus) {
+	log.Info("Session not found")
+
+	apiStatus, apiErrMsg := 0, ""
+	if s.Preferences().IsSessionAutoRenew && time.Since(sessionLastRenewTime) > sessionRenewMinInterval {
+		sessionLastRenewTime = time.Now()
+
+		var err error
+		if apiStatus, apiErrMsg, err = s.sessionRenew(session); err == nil {
+			return
+		}
+		log.Info("Session renewal failed: ", err)
+	}
+
+	// Logging out now
+	log.Info("Logging out (re-login required)")
+	auditlog.Write(auditlog.DaemonActor(), auditlog.EventLogout, "session not found")
+	needToDeleteOnBackend := false
+	canLogoutOnlyLocally := true
+	s.logOut(needToDeleteOnBackend, canLogoutOnlyLocally)
+
+	s._evtReceiver.OnReloginRequired(apiStatus, apiErrMsg)
+}
+
+// sessionRenew creates a new session for the same account (without user interaction).
+// The WireGuard keys of the current session are reused, so the active WireGuard connection keeps working.
+// It is not possible when the login requires user interaction (2FA, captcha, devices limit reached).
+func (s *Service) sessionRenew(session preferences.SessionStatus) (apiStatus int, apiErrMsg string, err error) {
+	if len(session.AccountID) == 0 {
+		return 0, "", fmt.Errorf("account ID unknown")
+	}
+
+	restoreFw := s.allowApiServersTemporary()
+	defer restoreFw()
+
+	log.Info("Renewing the session...")
+	resp, _, _, apiErr, _, err := s._api.SessionNew(session.AccountID, session.WGPublicKey, false, "", "", "")
+	if err != nil {
+		if apiErr != nil {
+			return apiErr.Status, apiErr.Message, err
+		}
+		return 0, "", err
+	}
+	if resp == nil {
+		return 0, "", fmt.Errorf("unexpected error when renewing the session")
+	}
+
+	s.setCredentials(s.createAccountStatus(resp.ServiceStatus),
+		session.AccountID,
+		resp.Token,
+		resp.VpnUsername,
+		resp.VpnPassword,
+		session.WGPublicKey,
+		session.WGPrivateKey,
+		resp.WireGuard.IPAddress,
+		session.WGKeyGenerated.Unix())
+
+	log.Info("Session renewed")
+	auditlog.Write(auditlog.DaemonActor(), auditlog.EventLogin, "session renewed")
+
+	if resp.WireGuard.IPAddress != session.WGLocalIP {
+		// the WireGuard local IP changed: reconnection required (if connected)
+		s.WireGuardSaveNewKeys(session.WGPublicKey, session.WGPrivateKey, resp.WireGuard.IPAddress, session.WGPresharedKey)
+	}
+	return 0, "", nil
+}
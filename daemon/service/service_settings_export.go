@@ -0,0 +1,158 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/ivpn/desktop-app/daemon/service/preferences"
+)
+
+// ExportSettings returns the daemon configuration which can be imported on another machine
+// (secrets are included only when 'includeSecrets' is true)
+func (s *Service) ExportSettings(includeSecrets bool) preferences.ExportedSettings {
+	prefs := s.Preferences()
+	return prefs.Export(includeSecrets)
+}
+
+// ImportSettings applies the configuration exported by ExportSettings (possibly, on another machine).
+// The settings which can not be applied are skipped; the descriptions of skipped settings are returned as warnings.
+// Connection profiles are merged with the existing ones (profiles with the same name are replaced).
+func (s *Service) ImportSettings(settings preferences.ExportedSettings) ([]string, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+
+	var warnings []string
+	warn := func(format string, a ...interface{}) {
+		msg := fmt.Sprintf(format, a...)
+		log.Warning("Settings import: " + msg)
+		warnings = append(warnings, msg)
+	}
+
+	log.Info(fmt.Sprintf("Importing settings (exported at %s; secrets included: %v) ...", settings.ExportedAt.Format("2006-01-02 15:04:05"), settings.IsSecretsIncluded))
+
+	// firewall
+	fw := settings.Firewall
+	if err := s.SetKillSwitchIsPersistent(fw.IsPersistent); err != nil {
+		warn("firewall persistency: %v", err)
+	}
+	if err := s.SetKillSwitchAllowLAN(fw.IsAllowLAN); err != nil {
+		warn("firewall LAN access: %v", err)
+	}
+	if err := s.SetKillSwitchAllowLANMulticast(fw.IsAllowLANMulticast); err != nil {
+		warn("firewall LAN multicast: %v", err)
+	}
+	if err := s.SetKillSwitchAllowLANServices(fw.AllowLANServices); err != nil {
+		warn("firewall LAN services: %v", err)
+	}
+	if err := s.SetKillSwitchAllowedLanHosts(fw.AllowedLANHosts); err != nil {
+		warn("firewall LAN hosts: %v", err)
+	}
+	if err := s.SetKillSwitchLanInboundPorts(fw.LANInboundPorts); err != nil {
+		warn("firewall LAN inbound ports: %v", err)
+	}
+	if err := s.SetKillSwitchAllowAPIServers(fw.IsAllowApiServers); err != nil {
+		warn("firewall access to API servers: %v", err)
+	}
+	if err := s.SetKillSwitchUserExceptions(fw.UserExceptions, false); err != nil {
+		warn("firewall exceptions: %v", err)
+	}
+
+	// connection parameters (must be applied before WiFi settings: background WiFi actions require them)
+	if err := settings.ConnectionParams.CheckIsDefined(); err != nil {
+		warn("connection parameters skipped: %v", err)
+	} else {
+		if !settings.IsSecretsIncluded && settings.ConnectionParams.OpenVpnParameters.Proxy.IsDefined() && len(settings.ConnectionParams.OpenVpnParameters.Proxy.Username) > 0 {
+			warn("OpenVPN proxy password is not included; please, define it manually")
+		}
+		if err := s.SetConnectionParams(settings.ConnectionParams); err != nil {
+			warn("connection parameters: %v", err)
+		}
+	}
+
+	// connection profiles
+	if len(settings.ConnectionProfiles) > 0 {
+		prefs := s._preferences
+		for _, cp := range settings.ConnectionProfiles {
+			if err := prefs.SaveConnectionProfile(cp); err != nil {
+				warn("connection profile '%s' skipped: %v", cp.Name, err)
+			}
+		}
+		s.setPreferences(prefs)
+	}
+
+	// obfuscation (obfsproxy, V2Ray and Shadowsocks can not be used at the same time)
+	ss := settings.ShadowsocksProxy
+	if ss.IsEnabled() && len(ss.Password) == 0 {
+		// the password is not exported by default: keep the local one when the same server is in use
+		if local := s._preferences.ShadowsocksProxy; local.Server == ss.Server && local.Port == ss.Port {
+			ss.Password = local.Password
+		}
+	}
+	switch {
+	case ss.IsEnabled():
+		if err := s.SetShadowsocksProxy(ss); err != nil {
+			warn("Shadowsocks configuration skipped: %v", err)
+		}
+	case settings.V2RayProxy.IsEnabled():
+		if err := s.SetV2RayProxy(settings.V2RayProxy); err != nil {
+			warn("V2Ray configuration skipped: %v", err)
+		}
+	default:
+		if err := s.SetObfsProxy(settings.Obfs4proxy); err != nil {
+			warn("obfsproxy configuration skipped: %v", err)
+		}
+	}
+
+	// AmneziaWG (WireGuard obfuscation)
+	if err := s.SetAmneziaWG(settings.AmneziaWG); err != nil {
+		warn("AmneziaWG configuration skipped: %v", err)
+	}
+
+	// API proxy
+	apiProxy := settings.ApiProxy
+	if apiProxy.IsEnabled() && len(apiProxy.Username) > 0 && len(apiProxy.Password) == 0 {
+		if local := s._preferences.ApiProxy; local.Address == apiProxy.Address && local.Username == apiProxy.Username {
+			apiProxy.Password = local.Password
+		} else if !settings.IsSecretsIncluded {
+			warn("API proxy password is not included; please, define it manually")
+		}
+	}
+	if err := s.SetApiProxy(apiProxy); err != nil {
+		warn("API proxy configuration skipped: %v", err)
+	}
+
+	// OpenVPN TLS/cipher policy
+	if err := s.SetOpenVpnCryptoPolicy(settings.OpenVpnCryptoPolicy); err != nil {
+		warn("OpenVPN TLS/cipher policy skipped: %v", err)
+	}
+
+	// WiFi control
+	if err := s.SetWiFiSettings(settings.WiFiControl); err != nil {
+		warn("WiFi settings skipped: %v", err)
+	}
+
+	log.Info(fmt.Sprintf("Settings imported (warnings: %d)", len(warnings)))
+	return warnings, nil
+}
@@ -0,0 +1,78 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ivpn/desktop-app/daemon/service/platform"
+	"github.com/ivpn/desktop-app/daemon/shadowsocks"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// createLocalProxy creates the transport (V2Ray or Shadowsocks) for the connection to the VPN server 'hostIP:hostPort'.
+// Returns nil when no local proxy is in use.
+func (s *Service) createLocalProxy(vpnType vpn.Type, hostIP net.IP, hostPort int) (vpn.LocalProxy, error) {
+	// NOTE: the typed nil-pointers must not be returned as a 'vpn.LocalProxy' interface
+	if v2rayProxy, err := s.createV2RayProxy(vpnType, hostIP, hostPort); err != nil || v2rayProxy != nil {
+		if err != nil {
+			return nil, err
+		}
+		return v2rayProxy, nil
+	}
+	if ssClient, err := s.createShadowsocksProxy(vpnType, hostIP, hostPort); err != nil || ssClient != nil {
+		if err != nil {
+			return nil, err
+		}
+		return ssClient, nil
+	}
+	return nil, nil
+}
+
+// createShadowsocksProxy creates Shadowsocks client to chain the connection to the VPN server 'hostIP:hostPort'
+// through the user-defined Shadowsocks server.
+// Returns nil when Shadowsocks is not in use.
+func (s *Service) createShadowsocksProxy(vpnType vpn.Type, hostIP net.IP, hostPort int) (*shadowsocks.Client, error) {
+	cfg := s.Preferences().ShadowsocksProxy
+	if !cfg.IsEnabled() {
+		return nil, nil
+	}
+
+	if err := s.GetDisabledFunctions().ShadowsocksError; len(err) > 0 {
+		return nil, fmt.Errorf(err)
+	}
+
+	settings := shadowsocks.Settings{Config: cfg}
+	if vpnType == vpn.OpenVPN {
+		// OpenVPN connects through local SOCKS proxy
+		settings.Inbound = shadowsocks.InboundSocks
+	} else {
+		// WireGuard UDP traffic is forwarded to the VPN server
+		settings.Inbound = shadowsocks.InboundUDP
+		settings.DestinationIP = hostIP
+		settings.DestinationPort = hostPort
+	}
+
+	return shadowsocks.CreateClient(platform.ShadowsocksBinaryPath(), platform.ShadowsocksConfigFile(), settings)
+}
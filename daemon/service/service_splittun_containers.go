@@ -0,0 +1,57 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ivpn/desktop-app/daemon/splittun"
+)
+
+// SplitTunnelling_SetContainers sets containers (Docker container names/IDs or cgroup-v2 paths)
+// to be excluded from (or included into) the VPN tunnel.
+// The previous containers are replaced.
+func (s *Service) SplitTunnelling_SetContainers(containers []splittun.Container) error {
+	if len(containers) > 0 && !splittun.IsCanSplitContainers() {
+		return fmt.Errorf("Split Tunneling for containers is not applicable for current platform")
+	}
+
+	conts := make([]splittun.Container, 0, len(containers))
+	for _, c := range containers {
+		c.Name = strings.TrimSpace(c.Name)
+		if len(c.Name) == 0 {
+			return fmt.Errorf("bad Split Tunneling container: name is empty")
+		}
+		if strings.ContainsAny(c.Name, " \t\n") || strings.HasPrefix(c.Name, "-") {
+			return fmt.Errorf("bad Split Tunneling container '%s'", c.Name)
+		}
+		conts = append(conts, c)
+	}
+
+	prefs := s._preferences
+	prefs.SplitTunnelContainers = conts
+	s.setPreferences(prefs)
+
+	return s.splitTunnelling_ApplyConfig()
+}
@@ -0,0 +1,189 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/splittun"
+)
+
+const (
+	// interval to re-resolve domain names of Split Tunneling destinations
+	// (IP addresses of the domain can be changed)
+	splitTunDestResolveInterval = time.Minute * 5
+	// timeout to resolve one domain name
+	splitTunDestResolveTimeout = time.Second * 5
+)
+
+var splitTunDomainRegexp = regexp.MustCompile(`^([a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?\.)+[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// SplitTunnelling_SetDestinations sets destinations (IP addresses, networks, domain names)
+// to be excluded from (or included into) the VPN tunnel.
+// The previous destinations are replaced.
+func (s *Service) SplitTunnelling_SetDestinations(destinations []splittun.Destination) error {
+	if len(destinations) > 0 && !splittun.IsCanSplitDestinations() {
+		return fmt.Errorf("Split Tunneling by destinations is not applicable for current platform")
+	}
+
+	dests := make([]splittun.Destination, 0, len(destinations))
+	for _, d := range destinations {
+		d.Address = strings.ToLower(strings.TrimSpace(d.Address))
+		if _, _, err := parseSplitTunDestination(d.Address); err != nil {
+			return err
+		}
+		dests = append(dests, d)
+	}
+
+	prefs := s._preferences
+	prefs.SplitTunnelDestinations = dests
+	s.setPreferences(prefs)
+
+	return s.splitTunnelling_ApplyConfig()
+}
+
+// splitTunnelling_UpdateDestinations requests to resolve destinations and apply them (asynchronously)
+func (s *Service) splitTunnelling_UpdateDestinations() {
+	select {
+	case s._splitTunDestUpdateChan <- struct{}{}:
+	default: // the update is already requested
+	}
+}
+
+// splitTunnelling_DestinationsUpdater resolves destinations and applies them on request (splitTunnelling_UpdateDestinations()).
+// The domain names are re-resolved periodically; the rules are updated when the IP addresses are changed.
+func (s *Service) splitTunnelling_DestinationsUpdater() {
+	var lastExclude, lastInclude []net.IPNet
+
+	ticker := time.NewTicker(splitTunDestResolveInterval)
+	defer ticker.Stop()
+
+	for {
+		isForced := false
+		select {
+		case <-s._splitTunDestUpdateChan:
+			isForced = true
+		case <-ticker.C:
+		}
+
+		prefs := s.Preferences()
+		if !isForced && !prefs.IsSplitTunnel {
+			continue
+		}
+
+		exclude, include, hasDomains := resolveSplitTunDestinations(prefs.SplitTunnelDestinations)
+		if !isForced && (!hasDomains || (reflect.DeepEqual(exclude, lastExclude) && reflect.DeepEqual(include, lastInclude))) {
+			continue
+		}
+
+		if err := splittun.ApplyDestinations(exclude, include); err != nil {
+			log.Error(err)
+			continue
+		}
+		lastExclude, lastInclude = exclude, include
+	}
+}
+
+// resolveSplitTunDestinations converts destinations to IP networks (domain names are resolved)
+func resolveSplitTunDestinations(destinations []splittun.Destination) (exclude []net.IPNet, include []net.IPNet, hasDomains bool) {
+	exclude = make([]net.IPNet, 0, len(destinations))
+	include = make([]net.IPNet, 0)
+
+	for _, d := range destinations {
+		network, isDomain, err := parseSplitTunDestination(d.Address)
+		if err != nil {
+			log.Warning(err)
+			continue
+		}
+
+		var networks []net.IPNet
+		if !isDomain {
+			networks = []net.IPNet{*network}
+		} else {
+			hasDomains = true
+			networks = resolveSplitTunDomain(d.Address)
+		}
+
+		if d.IsInclude {
+			include = append(include, networks...)
+		} else {
+			exclude = append(exclude, networks...)
+		}
+	}
+
+	return exclude, include, hasDomains
+}
+
+func resolveSplitTunDomain(domain string) []net.IPNet {
+	ctx, cancel := context.WithTimeout(context.Background(), splitTunDestResolveTimeout)
+	defer cancel()
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, domain)
+	if err != nil {
+		log.Warning(fmt.Sprintf("Split Tunneling: unable to resolve '%s': %s", domain, err))
+		return nil
+	}
+
+	ret := make([]net.IPNet, 0, len(addrs))
+	for _, a := range addrs {
+		ret = append(ret, ipToNetwork(a.IP))
+	}
+	return ret
+}
+
+// parseSplitTunDestination parses destination address.
+// Returns:
+//   - network - for IP address or network in CIDR notation
+//   - isDomain=true - for domain name
+func parseSplitTunDestination(address string) (network *net.IPNet, isDomain bool, err error) {
+	if strings.Contains(address, "/") {
+		_, n, err := net.ParseCIDR(address)
+		if err != nil {
+			return nil, false, fmt.Errorf("bad Split Tunneling destination '%s': %w", address, err)
+		}
+		return n, false, nil
+	}
+
+	if ip := net.ParseIP(address); ip != nil {
+		n := ipToNetwork(ip)
+		return &n, false, nil
+	}
+
+	if !splitTunDomainRegexp.MatchString(address) {
+		return nil, false, fmt.Errorf("bad Split Tunneling destination '%s': expected IP address, network (CIDR) or domain name", address)
+	}
+	return nil, true, nil
+}
+
+func ipToNetwork(ip net.IP) net.IPNet {
+	if ip4 := ip.To4(); ip4 != nil {
+		return net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+	}
+	return net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
@@ -0,0 +1,57 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ivpn/desktop-app/daemon/splittun"
+)
+
+// SplitTunnelling_SetUsers sets users (user names or UIDs) which traffic
+// to be excluded from (or included into) the VPN tunnel.
+// The previous users are replaced.
+func (s *Service) SplitTunnelling_SetUsers(users []splittun.User) error {
+	if len(users) > 0 && !splittun.IsCanSplitUsers() {
+		return fmt.Errorf("Split Tunneling for users is not applicable for current platform")
+	}
+
+	usrs := make([]splittun.User, 0, len(users))
+	for _, u := range users {
+		u.Name = strings.TrimSpace(u.Name)
+		if len(u.Name) == 0 {
+			return fmt.Errorf("bad Split Tunneling user: name is empty")
+		}
+		if strings.ContainsAny(u.Name, " \t\n") || strings.HasPrefix(u.Name, "-") {
+			return fmt.Errorf("bad Split Tunneling user '%s'", u.Name)
+		}
+		usrs = append(usrs, u)
+	}
+
+	prefs := s._preferences
+	prefs.SplitTunnelUsers = usrs
+	s.setPreferences(prefs)
+
+	return s.splitTunnelling_ApplyConfig()
+}
@@ -0,0 +1,110 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/ivpn/desktop-app/daemon/service/platform"
+	"github.com/ivpn/desktop-app/daemon/v2r"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// createV2RayProxy creates V2Ray transport for the connection to the VPN server 'hostIP:hostPort'.
+// Returns nil when V2Ray is not in use.
+func (s *Service) createV2RayProxy(vpnType vpn.Type, hostIP net.IP, hostPort int) (*v2r.V2RayWrapper, error) {
+	transport := s.Preferences().V2RayProxy
+	if !transport.IsEnabled() {
+		return nil, nil
+	}
+
+	if err := s.GetDisabledFunctions().V2RayError; len(err) > 0 {
+		return nil, fmt.Errorf(err)
+	}
+
+	svrs, err := s.ServersList()
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize V2Ray configuration: %w", err)
+	}
+
+	// V2Ray server info for the VPN host
+	var v2rayHost, tlsServerName string
+	if vpnType == vpn.OpenVPN {
+		v2rayHost, tlsServerName = findV2RayHost(hostIP, svrs.OpenvpnServers)
+	} else {
+		v2rayHost, tlsServerName = findV2RayHost(hostIP, svrs.WireguardServers)
+	}
+	v2rayIP := net.ParseIP(v2rayHost)
+	if v2rayIP == nil {
+		return nil, fmt.Errorf("failed to initialize V2Ray configuration: V2Ray is not supported by the server '%s'", hostIP)
+	}
+
+	// V2Ray server port (TLS over TCP)
+	v2rayPort := 0
+	for _, p := range svrs.Config.Ports.V2Ray {
+		if p.IsTCP() && p.Port > 0 {
+			v2rayPort = p.Port
+			break
+		}
+	}
+	if v2rayPort <= 0 {
+		return nil, fmt.Errorf("failed to initialize V2Ray configuration: V2Ray port not defined")
+	}
+
+	settings := v2r.Settings{
+		Transport:     transport,
+		OutboundIP:    v2rayIP,
+		OutboundPort:  v2rayPort,
+		UserID:        svrs.Config.V2Ray.ID,
+		TLSServerName: tlsServerName,
+	}
+	if vpnType == vpn.OpenVPN {
+		// OpenVPN connects through local SOCKS proxy
+		settings.Inbound = v2r.InboundSocks
+	} else {
+		// WireGuard UDP traffic is forwarded to the VPN server
+		settings.Inbound = v2r.InboundUDP
+		settings.DestinationIP = hostIP
+		settings.DestinationPort = hostPort
+	}
+
+	return v2r.CreateV2RayWrapper(platform.V2RayBinaryPath(), platform.V2RayConfigFile(), settings)
+}
+
+// findV2RayHost returns IP of the V2Ray server and the TLS server name for the VPN host
+func findV2RayHost[S serverBaseInterface](hostIP net.IP, servers []S) (v2rayHost string, tlsServerName string) {
+	for _, svr := range servers {
+		for _, h := range svr.GetHostsInfoBase() {
+			if !hostIP.Equal(net.ParseIP(h.Host)) {
+				continue
+			}
+			tlsServerName = h.DnsName
+			if len(tlsServerName) == 0 {
+				tlsServerName = h.Hostname
+			}
+			return h.V2RayHost, tlsServerName
+		}
+	}
+	return "", ""
+}
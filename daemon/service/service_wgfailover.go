@@ -0,0 +1,138 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/helpers"
+	"github.com/ivpn/desktop-app/daemon/service/hostshealth"
+	"github.com/ivpn/desktop-app/daemon/vpn/wireguard"
+)
+
+const (
+	// How often the handshake with the active endpoint is checked (while connected)
+	wgFailoverCheckInterval = time.Second * 5
+	// Max time to wait for the first handshake with the endpoint.
+	// It is shorter than wgFallbackHandshakeTimeout: the alternative endpoint has to be tried before falling back to another protocol
+	wgFailoverHandshakeTimeout = time.Second * 10
+	// Max age of the latest handshake with the active endpoint. WireGuard renews the session every 2 minutes
+	// (the persistent keepalive is in use), so the older handshake means that the endpoint is not responding
+	wgFailoverHandshakeMaxAge = time.Minute * 3
+)
+
+// wireGuardFailoverEndpoints returns the endpoints of the server (the active one is the first) which can be used
+// without re-initialization of the tunnel interface: the hosts with the same internal addresses and the same address family of the endpoint.
+// Returns nil when there are no alternative endpoints.
+func wireGuardFailoverEndpoints(e endpointSelector, hosts []api_types.WireGuardServerHostInfo, active api_types.WireGuardServerHostInfo, activeIP net.IP, isIPv6 bool) []wireguard.PeerEndpoint {
+	// keep the address family of the active endpoint (no reachability probes required)
+	isIPv6Endpoint := activeIP.To4() == nil
+	e.isIPv6Required = &isIPv6Endpoint
+
+	localIP := func(h api_types.WireGuardServerHostInfo) string {
+		ret := strings.Split(h.LocalIP, "/")[0]
+		if isIPv6 {
+			ret += "|" + strings.Split(h.IPv6.LocalIP, "/")[0]
+		}
+		return ret
+	}
+
+	ret := []wireguard.PeerEndpoint{{HostIP: activeIP, HostPublicKey: active.PublicKey}}
+	for _, h := range hosts {
+		if h.Hostname == active.Hostname || localIP(h) != localIP(active) || !helpers.ValidateBase64(h.PublicKey) {
+			continue
+		}
+		ip, err := hostEndpointIP(e, h)
+		if err != nil || ip == nil || (ip.To4() == nil) != isIPv6Endpoint {
+			continue
+		}
+		ret = append(ret, wireguard.PeerEndpoint{HostIP: ip, HostPublicKey: h.PublicKey})
+	}
+
+	if len(ret) < 2 {
+		return nil
+	}
+	return ret
+}
+
+// wireGuardFailoverMonitor switches the active WireGuard connection to the next endpoint of the server
+// (see wireguard.ConnectionParams.SetFailoverEndpoints()) when there is no handshake with the active endpoint.
+// The switching is performed without disconnection, so the user does not see the reconnection when one of the server hosts is down.
+// The function returns when 'stop' channel closed.
+func (s *Service) wireGuardFailoverMonitor(wg *wireguard.WireGuard, stop <-chan bool) {
+	endpointSince := time.Now()
+	ticker := time.NewTicker(wgFailoverCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+
+		if wg.IsPaused() {
+			endpointSince = time.Now()
+			continue
+		}
+		if time.Since(endpointSince) < wgFailoverHandshakeTimeout {
+			continue
+		}
+
+		// (note: the active endpoint can be changed by SwitchServer())
+		cp := wg.ConnectionParams()
+		endpoints := cp.FailoverEndpoints()
+		if len(endpoints) < 2 {
+			continue
+		}
+
+		t, err := wg.LatestHandshake()
+		if err != nil {
+			log.Debug("WireGuard failover: unable to check handshake: ", err)
+			continue
+		}
+		if !t.IsZero() && time.Since(t) < wgFailoverHandshakeMaxAge {
+			continue
+		}
+
+		// the next endpoint after the active one
+		next := endpoints[0]
+		for i, e := range endpoints {
+			if e.Equals(cp.Endpoint()) {
+				next = endpoints[(i+1)%len(endpoints)]
+				break
+			}
+		}
+
+		log.Warning(fmt.Sprintf("No handshake with the WireGuard server %s. Switching to the endpoint %s ...", cp.HostIP(), next.HostIP))
+		s.hostsHealthOnFailure(cp.HostIP(), hostshealth.FailureHandshake)
+		if err := s.switchWireGuardPeer(wg, cp.WithEndpoint(next)); err != nil {
+			log.Error(fmt.Sprintf("WireGuard failover: failed to switch endpoint: %s", err))
+		}
+		endpointSince = time.Now()
+	}
+}
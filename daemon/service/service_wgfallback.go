@@ -0,0 +1,174 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	api_types "github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/service/types"
+	"github.com/ivpn/desktop-app/daemon/vpn"
+)
+
+// Max time to wait for the first WireGuard handshake (in use only when fallback is enabled)
+const wgFallbackHandshakeTimeout = time.Second * 20
+
+// Number of consecutive WireGuard handshake failures after which the connection falls back to OpenVPN
+const wgFallbackHandshakeFailures = 2
+
+// The port preferred for OpenVPN fallback connection (TCP 443 is rarely blocked)
+const wgFallbackOpenVPNPort = 443
+
+// isWireGuardFallbackEnabled returns 'true' when the WireGuard connection has to fall back to OpenVPN
+// in case of handshake failures (e.g. UDP is blocked)
+func (s *Service) isWireGuardFallbackEnabled() bool {
+	return s.Preferences().IsWgFallbackToOpenVPN
+}
+
+// connectWireGuardFallback connects to the same location using WireGuard-over-TCP (if supported by the server)
+// or using OpenVPN (TCP).
+// It is called when WireGuard connection failed because of repeated handshake timeouts.
+func (s *Service) connectWireGuardFallback(wgParams types.ConnectionParams, reason error) error {
+	if !wgParams.WireGuardParameters.TcpEncapsulation && !wgParams.IsMultiHop() && !s.Preferences().V2RayProxy.IsEnabled() && !s.Preferences().ShadowsocksProxy.IsEnabled() {
+		if port, err := s.wireGuardTcpPort(); err == nil {
+			msg := fmt.Sprintf("WireGuard handshake failed (%s). Probably, UDP traffic is blocked. Trying WireGuard over TCP (port %d)...", reason, port)
+			s.notifyWireGuardFallback(msg)
+
+			params := wgParams
+			params.WireGuardParameters.TcpEncapsulation = true
+			// if it fails again - this function will be called again to fall back to OpenVPN
+			return s.connectByParams(params)
+		}
+	}
+
+	params, err := s.wireGuardFallbackParams(wgParams)
+	if err != nil {
+		return fmt.Errorf("%w (unable to fall back to OpenVPN: %s)", reason, err)
+	}
+
+	if len(s.GetDisabledFunctions().OpenVPNError) > 0 {
+		return fmt.Errorf("%w (unable to fall back to OpenVPN: OpenVPN functionality disabled)", reason)
+	}
+
+	s.notifyWireGuardFallback(fmt.Sprintf("WireGuard handshake failed (%s). Probably, UDP traffic is blocked. Falling back to OpenVPN (TCP %d)...", reason, params.OpenVpnParameters.Port.Port))
+
+	// Note: the fallback parameters are not saved as last connection parameters.
+	// Next connection will try WireGuard again.
+	return s.connectByParams(params)
+}
+
+// notifyWireGuardFallback informs the user about the fallback connection
+func (s *Service) notifyWireGuardFallback(msg string) {
+	log.Info(msg)
+	if !s._evtReceiver.IsClientConnected(false) {
+		s.systemLog(Info, msg)
+	}
+	// inform clients what happened
+	s._evtReceiver.OnVpnStateChanged(vpn.NewStateInfo(vpn.CONNECTING, msg))
+}
+
+// wireGuardTcpPort returns TCP port of the UDP-over-TCP servers (WireGuard-over-TCP)
+func (s *Service) wireGuardTcpPort() (int, error) {
+	servers, err := s.ServersList()
+	if err != nil || servers == nil {
+		return 0, fmt.Errorf("servers list not available")
+	}
+	for _, p := range servers.Config.Ports.Udp2Tcp {
+		if p.IsTCP() && p.Port > 0 {
+			return p.Port, nil
+		}
+	}
+	return 0, fmt.Errorf("WireGuard-over-TCP is not supported by the servers")
+}
+
+// wireGuardFallbackParams converts WireGuard connection parameters to OpenVPN (TCP) parameters for the same location
+func (s *Service) wireGuardFallbackParams(wgParams types.ConnectionParams) (types.ConnectionParams, error) {
+	servers, err := s.ServersList()
+	if err != nil || servers == nil {
+		return types.ConnectionParams{}, fmt.Errorf("servers list not available")
+	}
+
+	// port: TCP 443 (if supported) or any other TCP port
+	var port *api_types.PortInfo
+	for i, p := range servers.Config.Ports.OpenVPN {
+		if !strings.EqualFold(p.Type, "TCP") || p.Port <= 0 {
+			continue
+		}
+		if port == nil || p.Port == wgFallbackOpenVPNPort {
+			port = &servers.Config.Ports.OpenVPN[i]
+		}
+	}
+	if port == nil {
+		return types.ConnectionParams{}, fmt.Errorf("no OpenVPN TCP ports available")
+	}
+
+	// Remove everything after symbol '.': "us-tx.wg.ivpn.net" => "us-tx"
+	normalizeGwId := func(gwId string) string {
+		return strings.Split(gwId, ".")[0]
+	}
+	// returns the OpenVPN server located in the same place as the WireGuard server of the defined host
+	findOpenVpnServer := func(wgHosts []api_types.WireGuardServerHostInfo) *api_types.OpenvpnServerInfo {
+		if len(wgHosts) == 0 {
+			return nil
+		}
+		for _, wgSvr := range servers.WireguardServers {
+			for _, h := range wgSvr.Hosts {
+				if h.Hostname != wgHosts[0].Hostname {
+					continue
+				}
+				for i, ovpnSvr := range servers.OpenvpnServers {
+					if normalizeGwId(ovpnSvr.Gateway) == normalizeGwId(wgSvr.Gateway) {
+						return &servers.OpenvpnServers[i]
+					}
+				}
+				return nil
+			}
+		}
+		return nil
+	}
+
+	entrySvr := findOpenVpnServer(wgParams.WireGuardParameters.EntryVpnServer.Hosts)
+	if entrySvr == nil {
+		return types.ConnectionParams{}, fmt.Errorf("no OpenVPN server found for the location")
+	}
+
+	params := wgParams
+	params.VpnType = vpn.OpenVPN
+	params.TunnelIPMode = vpn.TunnelIPAuto
+	params.OpenVpnParameters.EntryVpnServer.Hosts = entrySvr.Hosts
+	params.OpenVpnParameters.Port.Protocol = 1 // TCP
+	params.OpenVpnParameters.Port.Port = port.Port
+
+	if len(wgParams.WireGuardParameters.MultihopExitServer.Hosts) > 0 {
+		exitSvr := findOpenVpnServer(wgParams.WireGuardParameters.MultihopExitServer.Hosts)
+		if exitSvr == nil {
+			return types.ConnectionParams{}, fmt.Errorf("no OpenVPN server found for the Multi-Hop exit location")
+		}
+		params.OpenVpnParameters.MultihopExitServer.ExitSrvID = wgParams.WireGuardParameters.MultihopExitServer.ExitSrvID
+		params.OpenVpnParameters.MultihopExitServer.Hosts = exitSvr.Hosts
+	}
+
+	return params, nil
+}
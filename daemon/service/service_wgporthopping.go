@@ -0,0 +1,139 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package service
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/vpn/wireguard"
+)
+
+const (
+	// How often the port hopping interval is checked (while connected)
+	wgPortHoppingCheckInterval = time.Second * 10
+	// Max allowed port hopping interval (minutes)
+	wgPortHoppingMaxMinutes = 24 * 60
+)
+
+// isWireGuardPortHoppingApplicable returns 'true' when the port of the active WireGuard connection can be changed:
+// the port of Multi-Hop connection defines the exit server; for the local proxies and WireGuard-over-TCP,
+// the port of the WireGuard server is not visible in the network.
+func (s *Service) isWireGuardPortHoppingApplicable(cp wireguard.ConnectionParams) bool {
+	prefs := s.Preferences()
+	return !cp.IsCustomConfig() && !cp.IsMultiHop() && !cp.IsTcpEncapsulation() && !prefs.V2RayProxy.IsEnabled() && !prefs.ShadowsocksProxy.IsEnabled()
+}
+
+// wireGuardPortHoppingMonitor periodically moves the active WireGuard connection to another port of the server
+// (random port from the allowed WireGuard ports) without disconnection.
+// Long-lived flows are harder to throttle by port-based policies.
+// The interval is defined by preferences (WgPortHoppingMinutes; 0 - port hopping disabled).
+// The function returns when 'stop' channel closed.
+func (s *Service) wireGuardPortHoppingMonitor(wg *wireguard.WireGuard, stop <-chan bool) {
+	lastHop := time.Now()
+	ticker := time.NewTicker(wgPortHoppingCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		}
+
+		interval := time.Duration(s.Preferences().WgPortHoppingMinutes) * time.Minute
+		if interval <= 0 || wg.IsPaused() {
+			lastHop = time.Now()
+			continue
+		}
+		if time.Since(lastHop) < interval {
+			continue
+		}
+		lastHop = time.Now()
+
+		// (note: the active server can be changed by SwitchServer())
+		cp := wg.ConnectionParams()
+		port, err := s.wireGuardRandomPort(cp.HostPort())
+		if err != nil {
+			log.Warning(fmt.Sprintf("WireGuard port hopping: %s", err))
+			continue
+		}
+
+		log.Info(fmt.Sprintf("WireGuard port hopping: %d -> %d", cp.HostPort(), port))
+		if err := s.switchWireGuardPeer(wg, cp.WithPort(port)); err != nil {
+			log.Error(fmt.Sprintf("WireGuard port hopping: failed to change port: %s", err))
+		}
+	}
+}
+
+// wireGuardRandomPort returns random UDP port from the allowed WireGuard ports of the servers (excluding 'currentPort')
+func (s *Service) wireGuardRandomPort(currentPort int) (int, error) {
+	servers, err := s.ServersList()
+	if err != nil || servers == nil {
+		return 0, fmt.Errorf("servers list not available")
+	}
+
+	// all allowed ports: the single ports and the port ranges
+	type portRange struct{ min, max int }
+	var ranges []portRange
+	total := 0
+	for _, p := range servers.Config.Ports.WireGuard {
+		if p.IsTCP() {
+			continue
+		}
+		r := portRange{min: p.Port, max: p.Port}
+		if p.Port <= 0 {
+			if p.Range.Min <= 0 || p.Range.Min > p.Range.Max || p.Range.Max > 65535 {
+				continue
+			}
+			r = portRange{min: p.Range.Min, max: p.Range.Max}
+		}
+		ranges = append(ranges, r)
+		total += r.max - r.min + 1
+	}
+
+	if total <= 1 {
+		return 0, fmt.Errorf("no alternative WireGuard ports available")
+	}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		rnd, err := rand.Int(rand.Reader, big.NewInt(int64(total)))
+		if err != nil {
+			return 0, err
+		}
+		idx := int(rnd.Int64())
+		for _, r := range ranges {
+			if size := r.max - r.min + 1; idx >= size {
+				idx -= size
+				continue
+			}
+			if port := r.min + idx; port != currentPort {
+				return port, nil
+			}
+			break
+		}
+	}
+	return 0, fmt.Errorf("unable to select WireGuard port")
+}
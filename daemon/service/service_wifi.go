@@ -25,6 +25,7 @@ package service
 import (
 	"time"
 
+	"github.com/ivpn/desktop-app/daemon/crashreport"
 	"github.com/ivpn/desktop-app/daemon/wifiNotifier"
 )
 
@@ -35,7 +36,7 @@ const delayBeforeWiFiChangeNotify = time.Second * 1
 func (s *Service) initWiFiFunctionality() (err error) {
 	defer func() {
 		if r := recover(); r != nil {
-			log.Error("initWiFiFunctionality PANIC (recovered): ", r)
+			crashreport.Save("initWiFiFunctionality", r)
 		}
 	}()
 
@@ -43,11 +44,7 @@ func (s *Service) initWiFiFunctionality() (err error) {
 }
 
 func (s *Service) onWiFiChanged(ssid string) {
-	defer func() {
-		if r := recover(); r != nil {
-			log.Error("onWiFiChanged PANIC (recovered): ", r)
-		}
-	}()
+	defer crashreport.Recover("onWiFiChanged")
 
 	// Stop old postponed notifier call
 	oldTimerId := timerDelayedWifiNotify
@@ -58,21 +55,23 @@ func (s *Service) onWiFiChanged(ssid string) {
 
 	// check network encryption
 	isInsecure := wifiNotifier.GetCurrentNetworkIsInsecure()
+	// MAC address of the access point
+	bssid := wifiNotifier.GetCurrentBSSID()
 
 	// Delay before processing wifi change
 	// (same wifi change event can occur several times in short period of time)
 	timerDelayedWifiNotify = time.AfterFunc(delayBeforeWiFiChangeNotify, func() {
 		// notify clients about WiFi change
-		s._evtReceiver.OnWiFiChanged(ssid, isInsecure)
+		s._evtReceiver.OnWiFiChanged(ssid, bssid, isInsecure)
 
 		// 'trusted-wifi' functionality: auto-connect if necessary
-		s.autoConnectIfRequired(OnWifiChanged, &wifiStatus{WifiSsid: ssid, WifiIsInsecure: isInsecure})
+		s.autoConnectIfRequired(OnWifiChanged, &wifiStatus{WifiSsid: ssid, WifiBssid: bssid, WifiIsInsecure: isInsecure})
 	})
 }
 
 // GetWiFiCurrentState returns info about currently connected wifi
-func (s *Service) GetWiFiCurrentState() (ssid string, isInsecureNetwork bool) {
-	return wifiNotifier.GetCurrentSSID(), wifiNotifier.GetCurrentNetworkIsInsecure()
+func (s *Service) GetWiFiCurrentState() (ssid string, bssid string, isInsecureNetwork bool) {
+	return wifiNotifier.GetCurrentSSID(), wifiNotifier.GetCurrentBSSID(), wifiNotifier.GetCurrentNetworkIsInsecure()
 }
 
 // GetWiFiAvailableNetworks returns list of available WIFI networks
@@ -55,7 +55,7 @@ func (s *Service) implPingServersStopped(hosts []net.IP) error {
 	return nil
 }
 
-func (s *Service) implSplitTunnelling_AddApp(binaryFile string) (requiredCmdToExec string, isAlreadyRunning bool, err error) {
+func (s *Service) implSplitTunnelling_AddApp(binaryFile string, requesterUid int) (requiredCmdToExec string, isAlreadyRunning bool, err error) {
 	binaryFile = strings.TrimSpace(binaryFile)
 	if len(binaryFile) <= 0 {
 		return "", false, nil
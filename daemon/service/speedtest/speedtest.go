@@ -0,0 +1,336 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package speedtest measures the achievable download/upload throughput (e.g. through the active VPN tunnel).
+package speedtest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/logger"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("spdtst")
+}
+
+const (
+	// DefaultDownloadURL - IVPN-hosted endpoint which returns a large amount of data
+	DefaultDownloadURL = "https://speedtest.ivpn.net/download"
+	// DefaultUploadURL - IVPN-hosted endpoint which accepts (and discards) the uploaded data
+	DefaultUploadURL = "https://speedtest.ivpn.net/upload"
+
+	// DefaultDurationSec - default duration of each phase (download, upload) of the test
+	DefaultDurationSec = 10
+	// MaxDurationSec - max duration of each phase of the test
+	MaxDurationSec = 60
+
+	// timeout of the response to the upload request (after all the data sent)
+	uploadResponseTimeout = time.Second * 10
+	// how often the progress of the test is reported
+	progressInterval = time.Second
+)
+
+// Params - parameters of the speed test
+type Params struct {
+	// Download/upload endpoints (HTTP or HTTPS); empty - IVPN-hosted endpoints (DefaultDownloadURL, DefaultUploadURL)
+	DownloadURL string `json:",omitempty"`
+	UploadURL   string `json:",omitempty"`
+	// Duration of each phase of the test (seconds); 0 - DefaultDurationSec
+	DurationSec int `json:",omitempty"`
+	// If true - only the download throughput is measured
+	IsSkipUpload bool `json:",omitempty"`
+}
+
+// Normalized returns the parameters with the default values applied
+func (p Params) Normalized() Params {
+	if len(p.DownloadURL) == 0 {
+		p.DownloadURL = DefaultDownloadURL
+	}
+	if len(p.UploadURL) == 0 {
+		p.UploadURL = DefaultUploadURL
+	}
+	if p.DurationSec <= 0 {
+		p.DurationSec = DefaultDurationSec
+	}
+	return p
+}
+
+// Validate checks the parameters of the speed test
+func (p Params) Validate() error {
+	if p.DurationSec < 0 || p.DurationSec > MaxDurationSec {
+		return fmt.Errorf("duration of the speed test must be in range 1-%d seconds", MaxDurationSec)
+	}
+	for _, u := range []string{p.DownloadURL, p.UploadURL} {
+		if len(u) == 0 {
+			continue
+		}
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return fmt.Errorf("bad speed test endpoint '%s': %w", u, err)
+		}
+		if (parsed.Scheme != "http" && parsed.Scheme != "https") || len(parsed.Host) == 0 {
+			return fmt.Errorf("bad speed test endpoint '%s': only HTTP and HTTPS URLs are supported", u)
+		}
+	}
+	return nil
+}
+
+// PhaseResult - the result of the download or upload phase of the test
+type PhaseResult struct {
+	URL         string
+	Bytes       uint64
+	Duration    time.Duration
+	BytesPerSec uint64
+	// the phase failed (the results contain the data transferred before the failure)
+	Error string `json:",omitempty"`
+}
+
+// Mbps returns the throughput in megabits per second
+func (r PhaseResult) Mbps() float64 {
+	return float64(r.BytesPerSec) * 8 / 1000 / 1000
+}
+
+// Result - the result of the speed test
+type Result struct {
+	Started time.Time
+	// the connection which was tested
+	Server  string `json:",omitempty"`
+	VpnType string `json:",omitempty"`
+	// the response time of the download endpoint (it includes the connection establishment)
+	LatencyMs int64
+	Download  PhaseResult
+	Upload    *PhaseResult `json:",omitempty"` // nil - the upload was not tested
+}
+
+// Run performs the speed test. When 'localIP' is defined, the connections are bound to this local address
+// (e.g. the local address of the VPN tunnel). The download failure is an error of the test;
+// the upload failure is reported in the result ('Upload.Error').
+func Run(ctx context.Context, params Params, localIP net.IP, progress func(percent int, info string)) (Result, error) {
+	params = params.Normalized()
+	if err := params.Validate(); err != nil {
+		return Result{}, err
+	}
+
+	dialer := &net.Dialer{Timeout: time.Second * 10}
+	if localIP != nil {
+		dialer.LocalAddr = &net.TCPAddr{IP: localIP}
+	}
+	transport := &http.Transport{
+		Proxy:              nil, // direct connection
+		DialContext:        dialer.DialContext,
+		DisableCompression: true, // the compressed data would distort the results
+	}
+	defer transport.CloseIdleConnections()
+	client := &http.Client{Transport: transport}
+
+	duration := time.Duration(params.DurationSec) * time.Second
+	result := Result{Started: time.Now()}
+
+	downloadProgress := func(percent int, info string) { progress(percent/2, "Download: "+info) }
+	if params.IsSkipUpload {
+		downloadProgress = func(percent int, info string) { progress(percent, "Download: "+info) }
+	}
+
+	log.Info(fmt.Sprintf("Measuring download throughput (%s) ...", params.DownloadURL))
+	var latency time.Duration
+	dl, err := measure(ctx, duration, downloadProgress, func(_, phaseCtx context.Context, counter *uint64) error {
+		return download(phaseCtx, client, params.DownloadURL, counter, &latency)
+	})
+	result.Download, result.LatencyMs = dl, latency.Milliseconds()
+	result.Download.URL = params.DownloadURL
+	if err != nil {
+		return result, fmt.Errorf("download test failed: %w", err)
+	}
+	log.Info(fmt.Sprintf("Download: %.2f Mbps (%d bytes in %s)", result.Download.Mbps(), result.Download.Bytes, result.Download.Duration.Round(time.Millisecond)))
+
+	if params.IsSkipUpload {
+		return result, nil
+	}
+
+	log.Info(fmt.Sprintf("Measuring upload throughput (%s) ...", params.UploadURL))
+	ul, err := measure(ctx, duration, func(percent int, info string) { progress(50+percent/2, "Upload: "+info) }, func(ctx, phaseCtx context.Context, counter *uint64) error {
+		return upload(ctx, phaseCtx, client, params.UploadURL, counter)
+	})
+	ul.URL = params.UploadURL
+	if err != nil {
+		if ctx.Err() != nil {
+			return result, ctx.Err()
+		}
+		log.Warning(fmt.Sprintf("Upload test failed: %s", err))
+		ul.Error = err.Error()
+	} else {
+		log.Info(fmt.Sprintf("Upload: %.2f Mbps (%d bytes in %s)", ul.Mbps(), ul.Bytes, ul.Duration.Round(time.Millisecond)))
+	}
+	result.Upload = &ul
+
+	return result, nil
+}
+
+// transferFunc - transfers the data until 'phaseCtx' is done; the number of transferred bytes is added to 'counter'.
+// 'ctx' is the context of the whole test.
+type transferFunc func(ctx, phaseCtx context.Context, counter *uint64) error
+
+// measure runs the transfer for the specified duration and calculates the throughput
+func measure(ctx context.Context, duration time.Duration, progress func(percent int, info string), transfer transferFunc) (ret PhaseResult, err error) {
+	phaseCtx, cancel := context.WithTimeout(ctx, duration)
+	defer cancel()
+
+	var counter uint64
+	started := time.Now()
+
+	done := make(chan error, 1)
+	go func() { done <- transfer(ctx, phaseCtx, &counter) }()
+
+	ticker := time.NewTicker(progressInterval)
+	defer ticker.Stop()
+	for isRunning := true; isRunning; {
+		select {
+		case err = <-done:
+			isRunning = false
+		case now := <-ticker.C:
+			elapsed := now.Sub(started)
+			progress(int(elapsed*100/duration), fmt.Sprintf("%.2f Mbps", float64(atomic.LoadUint64(&counter))*8/elapsed.Seconds()/1000/1000))
+		}
+	}
+
+	ret.Duration = time.Since(started)
+	ret.Bytes = atomic.LoadUint64(&counter)
+	if ret.Duration > 0 {
+		ret.BytesPerSec = uint64(float64(ret.Bytes) / ret.Duration.Seconds())
+	}
+
+	if ctx.Err() != nil {
+		// the test was cancelled
+		return ret, ctx.Err()
+	}
+	if err != nil && errors.Is(err, context.DeadlineExceeded) && phaseCtx.Err() != nil {
+		// the phase duration expired
+		err = nil
+	}
+	if err == nil && ret.Bytes == 0 {
+		err = fmt.Errorf("no data transferred")
+	}
+	return ret, err
+}
+
+// download requests the endpoint repeatedly (until the context is done) and counts the received bytes.
+// 'latency' is set to the response time of the first request.
+func download(ctx context.Context, client *http.Client, endpoint string, counter *uint64, latency *time.Duration) error {
+	buf := make([]byte, 64*1024)
+	for {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Cache-Control", "no-cache")
+
+		requested := time.Now()
+		resp, err := client.Do(req)
+		if err != nil {
+			return err
+		}
+		if *latency == 0 {
+			*latency = time.Since(requested)
+		}
+		if resp.StatusCode != http.StatusOK {
+			resp.Body.Close()
+			return fmt.Errorf("unexpected response status: %s", resp.Status)
+		}
+
+		for {
+			n, err := resp.Body.Read(buf)
+			atomic.AddUint64(counter, uint64(n))
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				resp.Body.Close()
+				return err
+			}
+		}
+		resp.Body.Close()
+	}
+}
+
+// upload sends the data to the endpoint until 'phaseCtx' is done and counts the sent bytes
+func upload(ctx, phaseCtx context.Context, client *http.Client, endpoint string, counter *uint64) error {
+	// the request body is finished when the phase duration expires; then the response is waited
+	body := &uploadReader{ctx: phaseCtx, counter: counter, buf: make([]byte, 64*1024)}
+	respCtx, cancel := context.WithTimeout(ctx, time.Until(deadline(phaseCtx))+uploadResponseTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(respCtx, http.MethodPost, endpoint, body)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = -1 // chunked transfer encoding
+	req.Header.Set("Content-Type", "application/octet-stream")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		if respCtx.Err() != nil && ctx.Err() == nil {
+			return fmt.Errorf("no response from the upload endpoint")
+		}
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("unexpected response status: %s", resp.Status)
+	}
+	return nil
+}
+
+func deadline(ctx context.Context) time.Time {
+	if d, ok := ctx.Deadline(); ok {
+		return d
+	}
+	return time.Now()
+}
+
+// uploadReader - the body of the upload request: returns the data until the context is done
+type uploadReader struct {
+	ctx     context.Context
+	counter *uint64
+	buf     []byte
+}
+
+func (r *uploadReader) Read(p []byte) (int, error) {
+	if r.ctx.Err() != nil {
+		return 0, io.EOF
+	}
+	n := copy(p, r.buf)
+	atomic.AddUint64(r.counter, uint64(n))
+	return n, nil
+}
@@ -0,0 +1,313 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package subsystems keeps the information about the daemon subsystems (platform, logger, preferences, firewall, dns ...):
+// the dependencies between them, the initialization order and the initialization status of each subsystem.
+// Only the failure of a critical subsystem stops the daemon. When a non-critical subsystem fails (e.g. the split-tunnel
+// helper is missing) - the daemon keeps running without this functionality (the subsystems which depend on it are skipped).
+package subsystems
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/logger"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("subsys")
+}
+
+// Name - the subsystem identifier
+type Name string
+
+// The daemon subsystems
+const (
+	Platform    Name = "platform"
+	Logger      Name = "logger"
+	Preferences Name = "preferences"
+	Firewall    Name = "firewall"
+	Dns         Name = "dns"
+	SplitTunnel Name = "split-tunnel"
+	Api         Name = "api"
+	Protocol    Name = "protocol"
+)
+
+// State - initialization state of the subsystem
+type State int
+
+const (
+	NotInitialized State = iota // initialization not started yet
+	Initializing                // initialization in progress
+	Ready                       // initialized successfully
+	Degraded                    // initialized with warnings (some functionality can be not available)
+	Failed                      // initialization failed
+	Skipped                     // not initialized because of failed dependency
+)
+
+func (s State) String() string {
+	switch s {
+	case NotInitialized:
+		return "NotInitialized"
+	case Initializing:
+		return "Initializing"
+	case Ready:
+		return "Ready"
+	case Degraded:
+		return "Degraded"
+	case Failed:
+		return "Failed"
+	case Skipped:
+		return "Skipped"
+	}
+	return fmt.Sprintf("State(%d)", int(s))
+}
+
+// IsAvailable returns 'true' when the subsystem is initialized and can be used
+func (s State) IsAvailable() bool {
+	return s == Ready || s == Degraded
+}
+
+// Subsystem - description of the subsystem
+type Subsystem struct {
+	Name Name
+	// subsystems which must be available before initialization of this subsystem
+	DependsOn []Name
+	// the daemon can not work without critical subsystem
+	Critical bool
+	// Init initializes the subsystem.
+	// Warnings mean that the subsystem is initialized but some functionality is not available.
+	// When Init is nil - the subsystem is initialized asynchronously and its status must be updated by SetStatus()
+	Init func() (warnings []string, err error)
+}
+
+// Status - initialization status of the subsystem
+type Status struct {
+	Name      Name
+	DependsOn []Name `json:",omitempty"`
+	Critical  bool
+	State     State
+	Warnings  []string `json:",omitempty"`
+	Error     string   `json:",omitempty"`
+	// initialization duration (milliseconds)
+	InitTimeMs int64
+}
+
+var (
+	mutex    sync.Mutex
+	statuses = map[Name]*Status{}
+	order    []Name // subsystem names in initialization order
+)
+
+// Initialize initializes the subsystems according to their dependencies.
+// A dependency must be one of 'subsystems' or a subsystem initialized before.
+// The subsystem is skipped when any of its dependencies is not available.
+// Returns error only when a critical subsystem is not available.
+func Initialize(subsystems ...Subsystem) error {
+	sorted, err := sortByDependencies(subsystems)
+	if err != nil {
+		return err
+	}
+
+	var criticalErrors []string
+	for _, ss := range sorted {
+		st := register(ss)
+
+		if dep, ok := unavailableDependency(ss); !ok {
+			setStatus(st, Skipped, nil, fmt.Errorf("dependency '%s' not available", dep))
+		} else if ss.Init == nil {
+			setStatus(st, Initializing, nil, nil)
+			continue // asynchronous initialization (status will be updated by SetStatus())
+		} else {
+			setStatus(st, Initializing, nil, nil)
+			started := time.Now()
+			warnings, err := ss.Init()
+
+			mutex.Lock()
+			st.InitTimeMs = time.Since(started).Milliseconds()
+			mutex.Unlock()
+
+			if err != nil {
+				setStatus(st, Failed, warnings, err)
+			} else if len(warnings) > 0 {
+				setStatus(st, Degraded, warnings, nil)
+			} else {
+				setStatus(st, Ready, nil, nil)
+			}
+		}
+
+		if s := GetStatus(ss.Name); ss.Critical && !s.State.IsAvailable() {
+			criticalErrors = append(criticalErrors, fmt.Sprintf("%s: %s", ss.Name, s.Error))
+		}
+	}
+
+	if len(criticalErrors) > 0 {
+		return fmt.Errorf("critical subsystems not available (%s)", strings.Join(criticalErrors, "; "))
+	}
+	return nil
+}
+
+// SetStatus updates the status of asynchronously initialized subsystem
+func SetStatus(name Name, warnings []string, err error) {
+	mutex.Lock()
+	st, ok := statuses[name]
+	mutex.Unlock()
+	if !ok {
+		log.Warning(fmt.Sprintf("unknown subsystem '%s'", name))
+		return
+	}
+
+	if err != nil {
+		setStatus(st, Failed, warnings, err)
+	} else if len(warnings) > 0 {
+		setStatus(st, Degraded, warnings, nil)
+	} else {
+		setStatus(st, Ready, nil, nil)
+	}
+}
+
+// GetStatus returns the status of the subsystem
+func GetStatus(name Name) Status {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if st, ok := statuses[name]; ok {
+		return *st
+	}
+	return Status{Name: name, State: NotInitialized}
+}
+
+// IsAvailable returns 'true' when the subsystem is initialized and can be used
+func IsAvailable(name Name) bool {
+	return GetStatus(name).State.IsAvailable()
+}
+
+// GetAll returns statuses of all subsystems (in initialization order)
+func GetAll() []Status {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	ret := make([]Status, 0, len(order))
+	for _, n := range order {
+		ret = append(ret, *statuses[n])
+	}
+	return ret
+}
+
+func register(ss Subsystem) *Status {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	st, ok := statuses[ss.Name]
+	if !ok {
+		st = &Status{}
+		statuses[ss.Name] = st
+		order = append(order, ss.Name)
+	}
+	*st = Status{Name: ss.Name, DependsOn: ss.DependsOn, Critical: ss.Critical, State: NotInitialized}
+	return st
+}
+
+func setStatus(st *Status, state State, warnings []string, err error) {
+	mutex.Lock()
+	st.State = state
+	st.Warnings = warnings
+	st.Error = ""
+	if err != nil {
+		st.Error = err.Error()
+	}
+	mutex.Unlock()
+
+	switch state {
+	case Ready:
+		log.Info(fmt.Sprintf("%s: %s", st.Name, state))
+	case Degraded:
+		log.Warning(fmt.Sprintf("%s: %s (%s)", st.Name, state, strings.Join(warnings, "; ")))
+	case Failed, Skipped:
+		log.Error(fmt.Sprintf("%s: %s (%s)", st.Name, state, st.Error))
+	}
+}
+
+// unavailableDependency returns the first dependency which is not available (ok == false if found)
+func unavailableDependency(ss Subsystem) (dep Name, ok bool) {
+	for _, d := range ss.DependsOn {
+		if !IsAvailable(d) {
+			return d, false
+		}
+	}
+	return "", true
+}
+
+// sortByDependencies returns the subsystems in the order of initialization (dependencies first)
+func sortByDependencies(subsystems []Subsystem) ([]Subsystem, error) {
+	byName := make(map[Name]Subsystem, len(subsystems))
+	for _, ss := range subsystems {
+		if _, exists := byName[ss.Name]; exists {
+			return nil, fmt.Errorf("subsystem '%s' defined twice", ss.Name)
+		}
+		byName[ss.Name] = ss
+	}
+
+	const (
+		notVisited = iota
+		inProgress
+		visited
+	)
+	marks := make(map[Name]int, len(subsystems))
+	ret := make([]Subsystem, 0, len(subsystems))
+
+	var visit func(ss Subsystem) error
+	visit = func(ss Subsystem) error {
+		switch marks[ss.Name] {
+		case visited:
+			return nil
+		case inProgress:
+			return fmt.Errorf("cyclic dependency of subsystem '%s'", ss.Name)
+		}
+		marks[ss.Name] = inProgress
+		for _, d := range ss.DependsOn {
+			if dep, ok := byName[d]; ok {
+				if err := visit(dep); err != nil {
+					return err
+				}
+				continue
+			}
+			if GetStatus(d).State == NotInitialized {
+				return fmt.Errorf("subsystem '%s' depends on unknown subsystem '%s'", ss.Name, d)
+			}
+		}
+		marks[ss.Name] = visited
+		ret = append(ret, ss)
+		return nil
+	}
+
+	for _, ss := range subsystems {
+		if err := visit(ss); err != nil {
+			return nil, err
+		}
+	}
+	return ret, nil
+}
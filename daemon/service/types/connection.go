@@ -24,6 +24,8 @@ package types
 
 import (
 	"fmt"
+	"net"
+	"strings"
 
 	api_types "github.com/ivpn/desktop-app/daemon/api/types"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
@@ -61,6 +63,100 @@ type ConnectMetadata struct {
 
 	// (only if Fastest server in use) List of fastest servers which must be ignored (only gateway ID in use: e.g."us-tx.wg.ivpn.net" => "us-tx")
 	FastestGatewaysExcludeList []string
+
+	// (only if Fastest server in use) Criteria for the fastest server selection
+	FastestCriteria FastestServerCriteria
+}
+
+// FastestServerCriteria - criteria for the fastest server selection
+type FastestServerCriteria struct {
+	// When true - the daemon chooses the fastest entry server on connect (using its own ping results).
+	// The entry server hosts defined by the client are ignored in this case.
+	ResolveOnConnect bool
+	// Locations allowed for selection: country code (e.g. "US"), gateway ID (e.g. "us-tx") or city name.
+	// Empty list - all locations are allowed
+	Locations []string
+	// When true - the server load is taken into account (servers with lower load have higher priority)
+	PreferLowLoad bool
+}
+
+// IsLocationAllowed returns true if the server location matches the criteria
+func (c FastestServerCriteria) IsLocationAllowed(svr api_types.ServerInfoBase) bool {
+	if len(c.Locations) == 0 {
+		return true
+	}
+	gwId := strings.Split(svr.Gateway, ".")[0]
+	for _, l := range c.Locations {
+		l = strings.TrimSpace(l)
+		if strings.EqualFold(l, svr.CountryCode) || strings.EqualFold(l, gwId) || strings.EqualFold(l, svr.City) {
+			return true
+		}
+	}
+	return false
+}
+
+// OpenVpnProxy - upstream proxy for OpenVPN connections (the OpenVPN traffic goes through this proxy)
+type OpenVpnProxy struct {
+	Type     string // "http", "socks" (SOCKS5); empty - proxy not in use
+	Address  string // IP address of the proxy server
+	Port     int
+	Username string // (optional) proxy authentication
+	Password string
+}
+
+// IsDefined returns 'true' when proxy is in use
+func (p OpenVpnProxy) IsDefined() bool {
+	return len(p.Type) > 0
+}
+
+// Validate checks the proxy configuration consistency
+func (p OpenVpnProxy) Validate(isTCP bool) error {
+	if !p.IsDefined() {
+		return nil
+	}
+	switch p.Type {
+	case "socks":
+	case "http":
+		if !isTCP {
+			return fmt.Errorf("HTTP proxy is applicable only for TCP connections")
+		}
+	default:
+		return fmt.Errorf("unsupported proxy type '%s' (supported: 'http', 'socks')", p.Type)
+	}
+	if net.ParseIP(p.Address) == nil {
+		return fmt.Errorf("bad proxy address '%s' (IP address expected)", p.Address)
+	}
+	if p.Port <= 0 || p.Port > 65535 {
+		return fmt.Errorf("bad proxy port (%d)", p.Port)
+	}
+	if len(p.Username) > 0 || len(p.Password) > 0 {
+		if len(p.Username) == 0 || len(p.Password) == 0 {
+			return fmt.Errorf("both proxy username and password must be defined for proxy authentication")
+		}
+		if strings.ContainsAny(p.Username+p.Password, "\r\n") {
+			return fmt.Errorf("proxy credentials must not contain line breaks")
+		}
+		// SOCKS5 username/password authentication (RFC 1929): up to 255 bytes for each field
+		if p.Type == "socks" && (len(p.Username) > 255 || len(p.Password) > 255) {
+			return fmt.Errorf("SOCKS proxy username and password must not exceed 255 bytes")
+		}
+	}
+	return nil
+}
+
+// CustomConfig - user-defined VPN configuration ("bring your own config")
+type CustomConfig struct {
+	// Configuration text: WireGuard ('wg-quick' format) or OpenVPN (.ovpn).
+	// The type of configuration is defined by ConnectionParams.VpnType
+	Text string
+	// (OpenVPN) user credentials; required when the configuration contains 'auth-user-pass'
+	Username string
+	Password string
+}
+
+// IsDefined returns 'true' when the user-defined configuration is in use
+func (c CustomConfig) IsDefined() bool {
+	return len(c.Text) > 0
 }
 
 // Connect request to establish new VPN connection
@@ -72,9 +168,13 @@ type ConnectionParams struct {
 	// but if there are no IPv6 hosts - we will use the IPv4 host.
 	IPv6 bool
 	// Use ONLY IPv6 hosts (ignored when IPv6!=true)
-	IPv6Only  bool
-	VpnType   vpn.Type
-	ManualDNS dns.DnsSettings
+	IPv6Only bool
+	// Which IP protocols have to be tunneled (WireGuard connections only).
+	// TunnelIPv4Only - IPv6 is not tunneled even if the server supports it ('IPv6' is ignored);
+	// TunnelIPv6Only - only IPv6 is tunneled (only IPv6-capable hosts are in use)
+	TunnelIPMode vpn.TunnelIPMode
+	VpnType      vpn.Type
+	ManualDNS    dns.DnsSettings
 
 	// Enable firewall before connection
 	// (if true - the parameter 'firewallDuringConnection' will be ignored)
@@ -96,8 +196,16 @@ type ConnectionParams struct {
 		MultihopExitServer MultiHopExitServer_WireGuard
 
 		Mtu int // Set 0 to use default MTU value
+
+		// WireGuard-over-TCP: the WireGuard traffic is encapsulated into TCP (for networks where UDP is blocked)
+		// Applicable only for Single-Hop connections
+		TcpEncapsulation bool `json:",omitempty"`
 	}
 
+	// User-defined VPN configuration ("bring your own config").
+	// When defined - the servers info (hosts, ports, Multi-Hop ...) is ignored
+	CustomConfig CustomConfig
+
 	OpenVpnParameters struct {
 		EntryVpnServer struct {
 			Hosts []api_types.OpenVPNServerHostInfo
@@ -105,13 +213,7 @@ type ConnectionParams struct {
 
 		MultihopExitServer MultiHopExitServer_OpenVpn
 
-		Proxy struct {
-			Type     string
-			Address  string
-			Port     int
-			Username string
-			Password string
-		}
+		Proxy OpenVpnProxy
 
 		Port struct {
 			Protocol int
@@ -128,7 +230,18 @@ func (p ConnectionParams) IsMultiHop() bool {
 	return len(p.WireGuardParameters.MultihopExitServer.Hosts) > 0
 }
 
+// IsEntryServerResolvedOnConnect returns true when the entry server has to be chosen by the daemon on connect
+func (p ConnectionParams) IsEntryServerResolvedOnConnect() bool {
+	return p.Metadata.ServerSelectionEntry == Fastest && p.Metadata.FastestCriteria.ResolveOnConnect
+}
+
 func (p ConnectionParams) CheckIsDefined() error {
+	if p.CustomConfig.IsDefined() {
+		return nil
+	}
+	if p.IsEntryServerResolvedOnConnect() {
+		return nil // hosts will be defined on connect
+	}
 	if p.VpnType == vpn.WireGuard {
 		if len(p.WireGuardParameters.EntryVpnServer.Hosts) <= 0 {
 			return fmt.Errorf("no hosts defined for WireGuard connection")
@@ -145,12 +258,20 @@ type MultiHopExitServer_WireGuard struct {
 	// ExitSrvID (geteway ID) just in use to keep clients notified about connected MH exit server
 	// Example: "gateway":"zz.wg.ivpn.net" => "zz"
 	ExitSrvID string
-	Hosts     []api_types.WireGuardServerHostInfo
+	// Hosts of the exit server (the daemon validates them against the servers list).
+	// To connect to the specific exit host - define only this host.
+	Hosts []api_types.WireGuardServerHostInfo
+	// (optional) Multihop port of the exit host: only the exit hosts with this 'multihop_port' are in use (0 - any host)
+	Port int `json:",omitempty"`
 }
 
 type MultiHopExitServer_OpenVpn struct {
 	// ExitSrvID (gateway ID) just in use to keep clients notified about connected MH exit server
 	// Example: "gateway":"zz.wg.ivpn.net" => "zz"
 	ExitSrvID string
-	Hosts     []api_types.OpenVPNServerHostInfo
+	// Hosts of the exit server (the daemon validates them against the servers list).
+	// To connect to the specific exit host - define only this host.
+	Hosts []api_types.OpenVPNServerHostInfo
+	// (optional) Multihop port of the exit host: only the exit hosts with this 'multihop_port' are in use (0 - any host)
+	Port int `json:",omitempty"`
 }
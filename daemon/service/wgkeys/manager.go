@@ -23,13 +23,13 @@
 package wgkeys
 
 import (
-	"errors"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/ivpn/desktop-app/daemon/api"
 	"github.com/ivpn/desktop-app/daemon/api/types"
+	"github.com/ivpn/desktop-app/daemon/kem"
 	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/vpn"
 	"github.com/ivpn/desktop-app/daemon/vpn/wireguard"
@@ -45,7 +45,8 @@ func init() {
 
 // IWgKeysChangeReceiver WG key update handler
 type IWgKeysChangeReceiver interface {
-	WireGuardSaveNewKeys(wgPublicKey string, wgPrivateKey string, wgLocalIP string)
+	WireGuardSaveNewKeys(wgPublicKey string, wgPrivateKey string, wgLocalIP string, wgPresharedKey string)
+	WireGuardIsQuantumResistance() (isEnabled bool, kemAlgorithm string) // isEnabled - the preshared key has to be negotiated using post-quantum KEM
 	WireGuardGetKeys() (session, wgPublicKey, wgPrivateKey, wgLocalIP string, generatedTime time.Time, updateInterval time.Duration)
 	FirewallEnabled() (bool, error)
 	Connected() bool
@@ -55,10 +56,11 @@ type IWgKeysChangeReceiver interface {
 }
 
 // CreateKeysManager create WireGuard keys manager
-func CreateKeysManager(apiObj *api.API, wgToolBinPath string) *KeysManager {
+func CreateKeysManager(apiObj *api.API, wgToolBinPath string, kemHelperBinPath string) *KeysManager {
 	return &KeysManager{
 		stopKeysRotation: make(chan struct{}),
 		wgToolBinPath:    wgToolBinPath,
+		kemHelperBinPath: kemHelperBinPath,
 		api:              apiObj}
 }
 
@@ -68,6 +70,7 @@ type KeysManager struct {
 	service          IWgKeysChangeReceiver
 	api              *api.API
 	wgToolBinPath    string
+	kemHelperBinPath string
 	stopKeysRotation chan struct{}
 }
 
@@ -247,6 +250,16 @@ func (m *KeysManager) generateKeys(onlyUpdateIfNecessary bool) (isUpdated bool,
 		return false, err
 	}
 
+	// post-quantum KEM keys to negotiate the preshared key (if enabled)
+	var kemKeys *kem.KeyPair
+	kemAlgorithm, kemPublicKey := "", ""
+	if isQuantumResistance, algorithm := m.service.WireGuardIsQuantumResistance(); isQuantumResistance {
+		if kemKeys, err = kem.GenerateKeys(m.kemHelperBinPath, algorithm); err != nil {
+			return false, fmt.Errorf("failed to generate post-quantum KEM keys: %w", err)
+		}
+		kemAlgorithm, kemPublicKey = kemKeys.Algorithm, kemKeys.PublicKey
+	}
+
 	isVPNConnected, connectedVpnType := m.service.ConnectedType()
 
 	if !isVPNConnected || connectedVpnType != vpn.WireGuard {
@@ -255,34 +268,46 @@ func (m *KeysManager) generateKeys(onlyUpdateIfNecessary bool) (isUpdated bool,
 	}
 
 	// trying to update WG keys with notifying API about current active public key (if it exists)
-	localIP, err := m.api.WireGuardKeySet(session, pub, activePublicKey)
+	localIP, kemCipher, err := m.api.WireGuardKeySet(session, pub, activePublicKey, kemAlgorithm, kemPublicKey)
 	if err != nil {
 		if len(activePublicKey) == 0 {
 			// IMPORTANT! As soon as server receive request with empty 'activePublicKey' - it clears all keys
 			// Therefore, we have to ensure that local keys are not using anymore (we have to clear them independently from we received response or not)
-			m.service.WireGuardSaveNewKeys("", "", "")
+			m.service.WireGuardSaveNewKeys("", "", "", "")
 		}
 		log.Info("WG keys not updated: ", err)
 
-		var e types.APIError
-		if errors.As(err, &e) {
-			if e.ErrorCode == types.SessionNotFound {
-				m.service.OnSessionNotFound()
-				return false, fmt.Errorf("WG keys not updated (session not found)")
-			}
+		if types.IsSessionNotFound(err) {
+			m.service.OnSessionNotFound()
+			return false, fmt.Errorf("WG keys not updated (session not found)")
 		}
 		return false, fmt.Errorf("WG keys not updated. Please check your internet connection")
 	}
 
 	log.Info(fmt.Sprintf("WG keys updated (%s:%s) ", localIP.String(), pub))
 
+	// decapsulate the secret of the preshared key
+	var pskErr error
+	psk := ""
+	if kemKeys != nil {
+		if len(kemCipher) == 0 {
+			log.Warning("Post-quantum preshared key is not supported by the server. The connection will use the WireGuard keys only")
+		} else if psk, pskErr = kemKeys.PresharedKey(m.kemHelperBinPath, kemCipher); pskErr != nil {
+			// The new key is already registered by the server: save it anyway (the old key is not valid anymore).
+			// The error is returned to inform the caller: the keys have to be regenerated to get the working preshared key.
+			pskErr = fmt.Errorf("failed to negotiate post-quantum preshared key: %w", pskErr)
+		} else {
+			log.Info("Post-quantum preshared key negotiated")
+		}
+	}
+
 	// notify service about new keys
-	m.service.WireGuardSaveNewKeys(pub, priv, localIP.String())
+	m.service.WireGuardSaveNewKeys(pub, priv, localIP.String(), psk)
 
 	if isRotationStopped {
 		// If there was no public key defined - start keys rotation
 		m.StartKeysRotation()
 	}
 
-	return true, nil
+	return true, pskErr
 }
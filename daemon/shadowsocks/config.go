@@ -0,0 +1,85 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package shadowsocks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ivpn/desktop-app/daemon/helpers"
+)
+
+// The Shadowsocks client configuration (shadowsocks-rust 'sslocal' JSON format)
+// https://github.com/shadowsocks/shadowsocks-rust#configuration
+
+type config struct {
+	Server     string        `json:"server"`
+	ServerPort int           `json:"server_port"`
+	Method     string        `json:"method"`
+	Password   string        `json:"password"`
+	Locals     []localConfig `json:"locals"`
+}
+
+type localConfig struct {
+	LocalAddress string `json:"local_address"`
+	LocalPort    int    `json:"local_port"`
+	Protocol     string `json:"protocol"`
+	Mode         string `json:"mode"`
+	// "tunnel" protocol only: destination of the forwarded traffic
+	ForwardAddress string `json:"forward_address,omitempty"`
+	ForwardPort    int    `json:"forward_port,omitempty"`
+}
+
+func createConfig(s Settings, localPort int) *config {
+	local := localConfig{LocalAddress: "127.0.0.1", LocalPort: localPort}
+	if s.Inbound == InboundSocks {
+		local.Protocol = "socks"
+		local.Mode = "tcp_and_udp"
+	} else {
+		local.Protocol = "tunnel"
+		local.Mode = "udp_only"
+		local.ForwardAddress = s.DestinationIP.String()
+		local.ForwardPort = s.DestinationPort
+	}
+
+	return &config{
+		Server:     s.Config.Server,
+		ServerPort: s.Config.Port,
+		Method:     s.Config.Method,
+		Password:   s.Config.Password,
+		Locals:     []localConfig{local},
+	}
+}
+
+// WriteToFile saves the configuration into a file
+func (c *config) WriteToFile(filePath string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize Shadowsocks configuration: %w", err)
+	}
+	// the configuration contains the password: read\write only for privileged user
+	if err := helpers.WriteFile(filePath, data, 0600); err != nil {
+		return fmt.Errorf("failed to save Shadowsocks configuration: %w", err)
+	}
+	return nil
+}
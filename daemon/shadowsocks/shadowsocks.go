@@ -0,0 +1,303 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package shadowsocks implements chaining of the VPN connection through the user-defined Shadowsocks server.
+// The daemon starts the local Shadowsocks client (sslocal) and the VPN client connects to its local port.
+package shadowsocks
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/hostroute"
+	"github.com/ivpn/desktop-app/daemon/logger"
+	"github.com/ivpn/desktop-app/daemon/netinfo"
+	"github.com/ivpn/desktop-app/daemon/shell"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("shdsck")
+}
+
+// SupportedMethods - encryption methods supported for the Shadowsocks server
+var SupportedMethods = []string{
+	"aes-128-gcm",
+	"aes-256-gcm",
+	"chacha20-ietf-poly1305",
+	"2022-blake3-aes-128-gcm",
+	"2022-blake3-aes-256-gcm",
+	"2022-blake3-chacha20-poly1305",
+}
+
+// Config - user-defined Shadowsocks server configuration
+type Config struct {
+	Server   string // IPv4 address of the Shadowsocks server (empty - Shadowsocks is not in use)
+	Port     int
+	Method   string // encryption method (see SupportedMethods)
+	Password string
+}
+
+// IsEnabled returns 'true' when Shadowsocks server is defined
+func (c Config) IsEnabled() bool {
+	return len(c.Server) > 0
+}
+
+// Equals returns 'true' when configurations are the same
+func (c Config) Equals(b Config) bool {
+	return c == b
+}
+
+// Validate checks the configuration consistency
+func (c Config) Validate() error {
+	if !c.IsEnabled() {
+		return nil
+	}
+	if ip := net.ParseIP(c.Server); ip == nil || ip.To4() == nil {
+		return fmt.Errorf("bad Shadowsocks server address '%s' (IPv4 address expected)", c.Server)
+	}
+	if c.Port <= 0 || c.Port > 65535 {
+		return fmt.Errorf("bad Shadowsocks server port (%d)", c.Port)
+	}
+	isMethodSupported := false
+	for _, m := range SupportedMethods {
+		if m == c.Method {
+			isMethodSupported = true
+			break
+		}
+	}
+	if !isMethodSupported {
+		return fmt.Errorf("unsupported Shadowsocks encryption method '%s' (supported: %s)", c.Method, strings.Join(SupportedMethods, ", "))
+	}
+	if len(c.Password) == 0 {
+		return fmt.Errorf("Shadowsocks password not defined")
+	}
+	return nil
+}
+
+// InboundType - the way the VPN client communicates with local Shadowsocks client
+type InboundType int
+
+const (
+	// InboundUDP - local UDP port forwarded to the VPN server (e.g. for WireGuard)
+	InboundUDP InboundType = iota
+	// InboundSocks - local SOCKS5 proxy (e.g. for OpenVPN)
+	InboundSocks InboundType = iota
+)
+
+// Settings - configuration of the Shadowsocks tunnel
+type Settings struct {
+	Config  Config
+	Inbound InboundType
+
+	// VPN server address (destination of the forwarded traffic; not applicable for InboundSocks)
+	DestinationIP   net.IP
+	DestinationPort int
+}
+
+// Validate checks the settings consistency
+func (s Settings) Validate() error {
+	if !s.Config.IsEnabled() {
+		return fmt.Errorf("Shadowsocks server not defined")
+	}
+	if err := s.Config.Validate(); err != nil {
+		return err
+	}
+	if s.Inbound == InboundUDP && (s.DestinationIP == nil || s.DestinationPort <= 0) {
+		return fmt.Errorf("VPN server address not defined")
+	}
+	return nil
+}
+
+// Client - manages the local Shadowsocks client process
+type Client struct {
+	binaryPath string
+	configPath string
+	settings   Settings
+
+	mutex     sync.Mutex
+	command   *exec.Cmd
+	stopped   chan struct{}
+	localPort int
+	route     *hostroute.Route
+}
+
+// CreateClient creates new Shadowsocks client object
+func CreateClient(binaryPath string, configPath string, settings Settings) (*Client, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+	return &Client{binaryPath: binaryPath, configPath: configPath, settings: settings}, nil
+}
+
+// Name returns the name of the transport
+func (c *Client) Name() string {
+	return "Shadowsocks"
+}
+
+// RemoteIP returns IP address of the Shadowsocks server
+// (the only remote host the Shadowsocks client communicates with)
+func (c *Client) RemoteIP() net.IP {
+	return net.ParseIP(c.settings.Config.Server)
+}
+
+// LocalPort returns the local port of the Shadowsocks client (0 - not started)
+func (c *Client) LocalPort() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.localPort
+}
+
+// Start - starts Shadowsocks client and waits until it ready to use.
+// The route to the Shadowsocks server is configured to go outside the VPN tunnel.
+func (c *Client) Start() (err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.command != nil {
+		return fmt.Errorf("Shadowsocks client already started")
+	}
+
+	log.Info(fmt.Sprintf("Starting Shadowsocks client [%s:%d]", c.settings.Config.Server, c.settings.Config.Port))
+	defer func() {
+		if err != nil {
+			log.Error(err)
+			c.stop()
+		}
+	}()
+
+	isTCP := c.settings.Inbound == InboundSocks
+	localPort, err := netinfo.GetFreePort(isTCP)
+	if err != nil {
+		return fmt.Errorf("unable to obtain free local port: %w", err)
+	}
+
+	cfg := createConfig(c.settings, localPort)
+	if err := cfg.WriteToFile(c.configPath); err != nil {
+		return err
+	}
+
+	// the communication with Shadowsocks server must not go through the VPN tunnel
+	route, err := hostroute.Add(c.RemoteIP())
+	if err != nil {
+		return fmt.Errorf("failed to configure route to Shadowsocks server: %w", err)
+	}
+	c.route = route
+
+	cmd := exec.Command(c.binaryPath, "-c", c.configPath)
+
+	isStarted := false
+	outputParseFunc := func(text string, isError bool) {
+		if isError {
+			log.Info("[ERR] ", text)
+		} else {
+			log.Info("[OUT] ", text)
+		}
+		// output example: "shadowsocks socks TCP listening on 127.0.0.1:1080"
+		if strings.Contains(text, "listening on") {
+			isStarted = true
+		}
+	}
+	if err := shell.StartConsoleReaders(cmd, outputParseFunc); err != nil {
+		return fmt.Errorf("failed to init Shadowsocks command: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start Shadowsocks client: %w", err)
+	}
+	c.command = cmd
+
+	stoppedChan := make(chan struct{})
+	c.stopped = stoppedChan
+	go func() {
+		if err := cmd.Wait(); err != nil {
+			log.Info("Shadowsocks client stopped: ", err)
+		} else {
+			log.Info("Shadowsocks client stopped")
+		}
+		close(stoppedChan)
+	}()
+
+	started := time.Now()
+	for !isStarted && shell.IsRunning(cmd) {
+		time.Sleep(time.Millisecond * 10)
+		// timeout limit to start Shadowsocks client = 10 seconds
+		if time.Since(started) > time.Second*10 {
+			return errors.New("Shadowsocks client start timeout")
+		}
+	}
+	if !isStarted {
+		return errors.New("Shadowsocks client stopped unexpectedly")
+	}
+
+	c.localPort = localPort
+	log.Info(fmt.Sprintf("Started on port %d", localPort))
+	return nil
+}
+
+// Wait - waits until Shadowsocks client stopped
+func (c *Client) Wait() {
+	c.mutex.Lock()
+	stopped := c.stopped
+	c.mutex.Unlock()
+
+	if stopped == nil {
+		return
+	}
+	<-stopped
+}
+
+// Stop - stops Shadowsocks client and restores the routing configuration
+func (c *Client) Stop() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.stop()
+}
+
+func (c *Client) stop() {
+	if c.command != nil {
+		log.Info("Stopping Shadowsocks client...")
+		if err := shell.Kill(c.command); err != nil {
+			log.Error(err)
+		}
+		c.command = nil
+	}
+	c.localPort = 0
+
+	if c.route != nil {
+		if err := c.route.Remove(); err != nil {
+			log.Error(fmt.Errorf("failed to remove route to Shadowsocks server: %w", err))
+		}
+		c.route = nil
+	}
+
+	if err := os.Remove(c.configPath); err != nil && !os.IsNotExist(err) {
+		log.Warning(fmt.Sprintf("failed to remove Shadowsocks configuration: %s", err))
+	}
+}
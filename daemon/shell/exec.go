@@ -24,22 +24,96 @@ package shell
 
 import (
 	"bufio"
+	"bytes"
+	"context"
 	"fmt"
+	"io"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ivpn/desktop-app/daemon/logger"
 )
 
+// DefaultTimeout - max execution time of the external process (when no specific timeout defined for the command).
+// The process is killed when the timeout is reached.
+const DefaultTimeout = 2 * time.Minute
+
+var (
+	timeoutsMutex   sync.RWMutex
+	defaultTimeout  = DefaultTimeout
+	commandTimeouts = make(map[string]time.Duration)
+)
+
+// SetDefaultTimeout sets the max execution time for the commands which have no specific timeout (0 - no timeout)
+func SetDefaultTimeout(timeout time.Duration) {
+	timeoutsMutex.Lock()
+	defer timeoutsMutex.Unlock()
+	defaultTimeout = timeout
+}
+
+// SetCommandTimeout sets the max execution time of the specific command (0 - no timeout; <0 - use default timeout).
+// 'name' - name of the binary (e.g. "route") or its full path.
+func SetCommandTimeout(name string, timeout time.Duration) {
+	timeoutsMutex.Lock()
+	defer timeoutsMutex.Unlock()
+	if timeout < 0 {
+		delete(commandTimeouts, filepath.Base(name))
+		return
+	}
+	commandTimeouts[filepath.Base(name)] = timeout
+}
+
+// CommandTimeout returns the max execution time of the command (0 - no timeout)
+func CommandTimeout(name string) time.Duration {
+	timeoutsMutex.RLock()
+	defer timeoutsMutex.RUnlock()
+	if t, ok := commandTimeouts[filepath.Base(name)]; ok {
+		return t
+	}
+	return defaultTimeout
+}
+
+// newContext returns the context which is limited by the timeout of the command
+func newContext(name string) (context.Context, context.CancelFunc) {
+	if timeout := CommandTimeout(name); timeout > 0 {
+		return context.WithTimeout(context.Background(), timeout)
+	}
+	return context.WithCancel(context.Background())
+}
+
+// contextError returns the reason of the process interruption (timeout or cancellation) or the original error
+func contextError(ctx context.Context, name string, err error) error {
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		if ctxErr == context.DeadlineExceeded {
+			return fmt.Errorf("'%s' killed (timeout): %w", name, ctxErr)
+		}
+		return fmt.Errorf("'%s' killed (cancelled): %w", name, ctxErr)
+	}
+	return err
+}
+
 // Exec - execute external process
-// Synchronous operation. Waits until process finished
+// Synchronous operation. Waits until process finished (or until the command timeout is reached)
 func Exec(logger *logger.Logger, name string, args ...string) error {
+	ctx, cancel := newContext(name)
+	defer cancel()
+	return ExecCtx(ctx, logger, name, args...)
+}
+
+// ExecCtx - execute external process
+// Synchronous operation. Waits until process finished. The process is killed when the context is done.
+func ExecCtx(ctx context.Context, logger *logger.Logger, name string, args ...string) (retErr error) {
 	if logger != nil {
 		logger.Info("Shell exec: ", append([]string{name}, args...))
 	}
 
-	cmd := exec.Command(name, args...)
+	started := time.Now()
+	defer func() { addHistory(started, "", name, args, retErr) }()
+
+	cmd := exec.CommandContext(ctx, name, args...)
 
 	if err := cmd.Start(); err != nil {
 		if logger != nil {
@@ -49,6 +123,7 @@ func Exec(logger *logger.Logger, name string, args ...string) error {
 	}
 
 	if err := cmd.Wait(); err != nil {
+		err = contextError(ctx, name, err)
 		if logger != nil {
 			logger.Error("Shell exec: ", err)
 		}
@@ -79,8 +154,20 @@ func GetCmdExitCode(err error) (retCode int, retErr error) {
 }
 
 // ExecAndProcessOutput - execute external process
-// Synchronous operation. Waits until process finished
+// Synchronous operation. Waits until process finished (or until the command timeout is reached)
 func ExecAndProcessOutput(logger *logger.Logger, outProcessFunc func(text string, isError bool), textToHideInLog string, name string, args ...string) error {
+	ctx, cancel := newContext(name)
+	defer cancel()
+	return ExecAndProcessOutputCtx(ctx, logger, outProcessFunc, textToHideInLog, name, args...)
+}
+
+// ExecAndProcessOutputCtx - execute external process
+// Synchronous operation. Waits until process finished. The process is killed when the context is done.
+func ExecAndProcessOutputCtx(ctx context.Context, logger *logger.Logger, outProcessFunc func(text string, isError bool), textToHideInLog string, name string, args ...string) error {
+	return execAndProcessOutput(ctx, logger, nil, outProcessFunc, textToHideInLog, name, args...)
+}
+
+func execAndProcessOutput(ctx context.Context, logger *logger.Logger, input io.Reader, outProcessFunc func(text string, isError bool), textToHideInLog string, name string, args ...string) error {
 	outChan := make(chan string, 1)
 	errChan := make(chan string, 1)
 	var wg sync.WaitGroup
@@ -119,14 +206,37 @@ func ExecAndProcessOutput(logger *logger.Logger, outProcessFunc func(text string
 
 	}()
 
-	err := ExecEx(logger, outChan, errChan, textToHideInLog, name, args...)
+	err := execEx(ctx, logger, input, outChan, errChan, textToHideInLog, name, args...)
 	wg.Wait()
 
 	return err
 }
 
 // ExecAndGetOutput - execute external process and return it's console output
+// (the process is killed when the command timeout is reached)
 func ExecAndGetOutput(logger *logger.Logger, maxRetBuffSize int, textToHideInLog string, name string, args ...string) (outText string, outErrText string, exitCode int, isBufferTooSmall bool, err error) {
+	ctx, cancel := newContext(name)
+	defer cancel()
+	return ExecAndGetOutputCtx(ctx, logger, maxRetBuffSize, textToHideInLog, name, args...)
+}
+
+// ExecAndGetOutputCtx - execute external process and return it's console output
+// (the process is killed when the context is done)
+func ExecAndGetOutputCtx(ctx context.Context, logger *logger.Logger, maxRetBuffSize int, textToHideInLog string, name string, args ...string) (outText string, outErrText string, exitCode int, isBufferTooSmall bool, err error) {
+	return execAndGetOutput(ctx, logger, nil, maxRetBuffSize, textToHideInLog, name, args...)
+}
+
+// ExecAndGetOutputWithInput - execute external process and return it's console output.
+// The 'input' data is written to the stdin of the process: in use to pass the sensitive data
+// (the command line arguments of the process are visible for all users).
+// (the process is killed when the command timeout is reached)
+func ExecAndGetOutputWithInput(logger *logger.Logger, maxRetBuffSize int, input []byte, textToHideInLog string, name string, args ...string) (outText string, outErrText string, exitCode int, isBufferTooSmall bool, err error) {
+	ctx, cancel := newContext(name)
+	defer cancel()
+	return execAndGetOutput(ctx, logger, bytes.NewReader(input), maxRetBuffSize, textToHideInLog, name, args...)
+}
+
+func execAndGetOutput(ctx context.Context, logger *logger.Logger, input io.Reader, maxRetBuffSize int, textToHideInLog string, name string, args ...string) (outText string, outErrText string, exitCode int, isBufferTooSmall bool, err error) {
 	strOut := strings.Builder{}
 	strErr := strings.Builder{}
 	isBufferTooSmall = false
@@ -149,7 +259,7 @@ func ExecAndGetOutput(logger *logger.Logger, maxRetBuffSize int, textToHideInLog
 		}
 	}
 
-	retErr := ExecAndProcessOutput(logger, outProcessFunc, textToHideInLog, name, args...)
+	retErr := execAndProcessOutput(ctx, logger, input, outProcessFunc, textToHideInLog, name, args...)
 
 	retExitCode := 0
 	if retErr != nil {
@@ -162,8 +272,20 @@ func ExecAndGetOutput(logger *logger.Logger, maxRetBuffSize int, textToHideInLog
 }
 
 // ExecEx - execute external process
-// Synchronous operation. Waits until process finished
+// Synchronous operation. Waits until process finished (or until the command timeout is reached)
 func ExecEx(logger *logger.Logger, outChan chan<- string, errChan chan<- string, textToHideInLog string, name string, args ...string) error {
+	ctx, cancel := newContext(name)
+	defer cancel()
+	return ExecExCtx(ctx, logger, outChan, errChan, textToHideInLog, name, args...)
+}
+
+// ExecExCtx - execute external process
+// Synchronous operation. Waits until process finished. The process is killed when the context is done.
+func ExecExCtx(ctx context.Context, logger *logger.Logger, outChan chan<- string, errChan chan<- string, textToHideInLog string, name string, args ...string) error {
+	return execEx(ctx, logger, nil, outChan, errChan, textToHideInLog, name, args...)
+}
+
+func execEx(ctx context.Context, logger *logger.Logger, input io.Reader, outChan chan<- string, errChan chan<- string, textToHideInLog string, name string, args ...string) (retErr error) {
 	if logger != nil {
 		logtext := strings.Join(append([]string{name}, args...), " ")
 		if len(textToHideInLog) > 0 {
@@ -172,9 +294,16 @@ func ExecEx(logger *logger.Logger, outChan chan<- string, errChan chan<- string,
 		logger.Info("Shell exec: ", logtext)
 	}
 
-	cmd := exec.Command(name, args...)
+	started := time.Now()
+	defer func() { addHistory(started, textToHideInLog, name, args, retErr) }()
+
+	cmd := exec.CommandContext(ctx, name, args...)
+	if input != nil {
+		cmd.Stdin = input
+	}
 
 	var wg sync.WaitGroup
+	var pipes []io.Closer
 
 	if outChan != nil {
 		outPipe, err := cmd.StdoutPipe()
@@ -184,6 +313,7 @@ func ExecEx(logger *logger.Logger, outChan chan<- string, errChan chan<- string,
 			}
 			return err
 		}
+		pipes = append(pipes, outPipe)
 		outPipeScanner := bufio.NewScanner(outPipe)
 		wg.Add(1)
 		go func() {
@@ -203,6 +333,7 @@ func ExecEx(logger *logger.Logger, outChan chan<- string, errChan chan<- string,
 			}
 			return err
 		}
+		pipes = append(pipes, errPipe)
 		errPipeScanner := bufio.NewScanner(errPipe)
 		wg.Add(1)
 		go func() {
@@ -221,8 +352,23 @@ func ExecEx(logger *logger.Logger, outChan chan<- string, errChan chan<- string,
 		return err
 	}
 
-	wg.Wait()
+	readersDone := make(chan struct{})
+	go func() {
+		wg.Wait()
+		close(readersDone)
+	}()
+	select {
+	case <-readersDone:
+	case <-ctx.Done():
+		// the process is killed, but its output pipes can still be kept open by the child processes
+		for _, p := range pipes {
+			p.Close()
+		}
+		<-readersDone
+	}
+
 	if err := cmd.Wait(); err != nil {
+		err = contextError(ctx, name, err)
 		if logger != nil {
 			logger.Error("Shell exec: ", err)
 		}
@@ -0,0 +1,106 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package shell
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/logger"
+)
+
+// max number of the external command invocations kept in the history (the oldest records are removed)
+const historyMaxSize = 256
+
+// CommandRecord - information about the external command invocation
+type CommandRecord struct {
+	Time       time.Time // time when the command started
+	Binary     string
+	Args       []string
+	DurationMs int64
+	// exit code of the process (-1 - the process was not started or it was killed)
+	ExitCode int
+	Error    string `json:",omitempty"`
+}
+
+var (
+	historyMutex sync.Mutex
+	history      = make([]CommandRecord, 0, historyMaxSize)
+	historyNext  int // index of the next record (when the buffer is full)
+)
+
+// History returns the last 'maxCount' external command invocations (0 - all records; the most recent last)
+func History(maxCount int) []CommandRecord {
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+
+	ret := make([]CommandRecord, 0, len(history))
+	ret = append(ret, history[historyNext:]...)
+	ret = append(ret, history[:historyNext]...)
+
+	if maxCount > 0 && len(ret) > maxCount {
+		ret = ret[len(ret)-maxCount:]
+	}
+	return ret
+}
+
+// addHistory saves the command invocation into the history.
+// 'textToHide' - sensitive text which must not be saved (e.g. a key passed as an argument)
+func addHistory(started time.Time, textToHide string, name string, args []string, err error) {
+	r := CommandRecord{
+		Time:       started,
+		Binary:     name,
+		Args:       make([]string, len(args)),
+		DurationMs: time.Since(started).Milliseconds(),
+	}
+
+	isPrivacyMode := logger.IsPrivacyMode()
+	for i, a := range args {
+		if len(textToHide) > 0 {
+			a = strings.ReplaceAll(a, textToHide, "***")
+		}
+		if isPrivacyMode {
+			a = logger.Scrub(a)
+		}
+		r.Args[i] = a
+	}
+
+	if err != nil {
+		r.Error = err.Error()
+		if isPrivacyMode {
+			r.Error = logger.Scrub(r.Error)
+		}
+		r.ExitCode, _ = GetCmdExitCode(err)
+	}
+
+	historyMutex.Lock()
+	defer historyMutex.Unlock()
+
+	if len(history) < historyMaxSize {
+		history = append(history, r)
+		return
+	}
+	history[historyNext] = r
+	historyNext = (historyNext + 1) % historyMaxSize
+}
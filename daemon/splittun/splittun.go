@@ -46,6 +46,34 @@ type ConfigAddresses struct {
 	IPv6Tunnel net.IP // VpnLocalIPv6
 }
 
+// Destination - destination rule for Split Tunneling
+type Destination struct {
+	// IP address (e.g. "1.1.1.1"), network in CIDR notation (e.g. "1.1.1.0/24") or domain name (e.g. "example.com")
+	Address string
+	// false - the traffic to the destination bypasses the VPN tunnel (excluded)
+	// true  - the traffic to the destination is forced into the VPN tunnel (even for excluded applications)
+	IsInclude bool
+}
+
+// Container - container rule for Split Tunneling (applicable for Linux with cgroup-v2)
+type Container struct {
+	// Container name or ID (e.g. "my_container"; resolved by Docker)
+	// or cgroup-v2 path of the container (e.g. "/system.slice/docker-<ID>.scope")
+	Name string
+	// false - the traffic of the container bypasses the VPN tunnel (excluded)
+	// true  - the traffic of the container is forced into the VPN tunnel (even to excluded destinations)
+	IsInclude bool
+}
+
+// User - user rule for Split Tunneling (applicable for Linux)
+type User struct {
+	// User name (e.g. "transmission") or UID (e.g. "1001")
+	Name string
+	// false - the traffic of the user's processes bypasses the VPN tunnel (excluded)
+	// true  - the traffic of the user's processes is forced into the VPN tunnel (even to excluded destinations)
+	IsInclude bool
+}
+
 // Information about running application
 // https://man7.org/linux/man-pages/man5/proc.5.html
 type RunningApp struct {
@@ -99,6 +127,54 @@ func ApplyConfig(isStEnabled bool, isVpnEnabled bool, addrConfig ConfigAddresses
 	return implApplyConfig(isStEnabled, isVpnEnabled, addrConfig, splitTunnelApps)
 }
 
+// ApplyDestinations updates Split Tunneling rules for destinations
+// (domain names must be already resolved to IP addresses).
+// The rules are active only when Split Tunneling is enabled.
+// (applicable for Linux and macOS)
+func ApplyDestinations(exclude []net.IPNet, include []net.IPNet) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return implApplyDestinations(exclude, include)
+}
+
+// IsCanSplitDestinations returns 'true' if Split Tunneling by destinations (IP addresses, networks, domains)
+// is applicable for current platform
+func IsCanSplitDestinations() bool {
+	return implIsCanSplitDestinations()
+}
+
+// ApplyContainers updates Split Tunneling rules for containers.
+// The containers are re-resolved periodically (container can be restarted with new cgroup or IP address).
+// The rules are active only when Split Tunneling is enabled.
+// (applicable for Linux)
+func ApplyContainers(containers []Container) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return implApplyContainers(containers)
+}
+
+// IsCanSplitContainers returns 'true' if Split Tunneling for containers is applicable for current platform
+func IsCanSplitContainers() bool {
+	return implIsCanSplitContainers()
+}
+
+// ApplyUsers updates Split Tunneling rules for users (the rules are based on UID of the traffic owner).
+// The rules are active only when Split Tunneling is enabled.
+// (applicable for Linux)
+func ApplyUsers(users []User) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return implApplyUsers(users)
+}
+
+// IsCanSplitUsers returns 'true' if Split Tunneling for users is applicable for current platform
+func IsCanSplitUsers() bool {
+	return implIsCanSplitUsers()
+}
+
 // AddPid add process to Split-Tunnel environment
 // (applicable for Linux)
 func AddPid(pid int, commandToExecute string) error {
@@ -106,13 +182,23 @@ func AddPid(pid int, commandToExecute string) error {
 }
 
 // RemovePid remove process to Split-Tunnel environment
-// (applicable for Linux)
+// (applicable for Linux and macOS; on macOS the process will be terminated)
 func RemovePid(pid int) error {
 	return implRemovePid(pid)
 }
 
+// RunApp start command in Split-Tunnel environment
+// (applicable for macOS)
+// 'uid' - the user which requested to start the application (the application is started under the account of this user)
+func RunApp(commandToExecute string, uid int) (pid int, err error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	return implRunApp(commandToExecute, uid)
+}
+
 // Get information about active applications running in Split-Tunnel environment
-// (applicable for Linux)
+// (applicable for Linux and macOS)
 func GetRunningApps() (allProcesses []RunningApp, err error) {
 	return implGetRunningApps()
 }
@@ -0,0 +1,269 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+//go:build linux
+// +build linux
+
+package splittun
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/shell"
+)
+
+// Containers can be restarted (new cgroup path, new IP address), so the rules are re-resolved periodically
+const containersUpdateInterval = time.Second * 30
+
+var (
+	containers []Container
+	// arguments of the last applied 'cont-set' command (to avoid re-applying the same rules)
+	containersLastArgs []string
+	// cgroup-v2 mount point (e.g. "/sys/fs/cgroup" or "/sys/fs/cgroup/unified"); empty when cgroup-v2 not available
+	cgroup2Root        string
+	containersInitOnce sync.Once
+)
+
+func implApplyContainers(c []Container) error {
+	containersInitOnce.Do(func() {
+		cgroup2Root = findCgroup2Root()
+		go containersMonitor()
+	})
+
+	if len(c) > 0 && len(cgroup2Root) == 0 {
+		return fmt.Errorf("Split Tunneling for containers is not applicable: cgroup-v2 is not available")
+	}
+
+	containers = c
+	if !isActive {
+		// the rules will be applied when ST enabled
+		return nil
+	}
+	return applyContainers(false)
+}
+
+func implIsCanSplitContainers() bool {
+	return funcNotAvailableError == nil && len(findCgroup2Root()) > 0
+}
+
+// containersMonitor periodically re-resolves containers and updates the rules when required
+func containersMonitor() {
+	ticker := time.NewTicker(containersUpdateInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		func() {
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			if !isActive || len(containers) == 0 {
+				return
+			}
+			if err := applyContainers(false); err != nil {
+				log.Error(err)
+			}
+		}()
+	}
+}
+
+// applyContainers resolves containers and applies the rules
+// (isForce=false - the rules are applied only if they differ from the last applied rules)
+func applyContainers(isForce bool) error {
+	args := []string{"cont-set"}
+	for _, c := range containers {
+		cgroupPath, addresses, err := resolveContainer(c.Name)
+		if err != nil {
+			log.Warning(fmt.Sprintf("Split Tunneling: unable to resolve container '%s': %s", c.Name, err))
+			continue
+		}
+
+		if c.IsInclude {
+			args = append(args, "-i", cgroupPath)
+			continue
+		}
+		args = append(args, "-e", cgroupPath)
+		for _, a := range addresses {
+			args = append(args, "-E", a.String())
+		}
+	}
+
+	if !isForce && reflect.DeepEqual(args, containersLastArgs) {
+		return nil
+	}
+
+	containersLastArgs = nil
+	if err := shell.Exec(nil, stScriptPath, args...); err != nil {
+		return fmt.Errorf("failed to apply Split Tunneling containers: %w", err)
+	}
+	containersLastArgs = args
+	return nil
+}
+
+// resolveContainer returns cgroup-v2 path of the container (relative to cgroup-v2 root)
+// and IP addresses of the container (only if the container has own network namespace)
+func resolveContainer(name string) (cgroupPath string, addresses []net.IP, err error) {
+	if len(cgroup2Root) == 0 {
+		return "", nil, fmt.Errorf("cgroup-v2 is not available")
+	}
+
+	var pid int
+	if strings.HasPrefix(name, "/") {
+		// cgroup path
+		cgroupPath = strings.TrimPrefix(filepath.Clean(name), "/")
+		if len(cgroupPath) == 0 {
+			return "", nil, fmt.Errorf("the root cgroup is not allowed")
+		}
+		procs, err := os.ReadFile(filepath.Join(cgroup2Root, cgroupPath, "cgroup.procs"))
+		if err != nil {
+			return "", nil, err
+		}
+		if fields := strings.Fields(string(procs)); len(fields) > 0 {
+			pid, _ = strconv.Atoi(fields[0])
+		}
+	} else {
+		// container name or ID
+		outText, outErrText, _, _, err := shell.ExecAndGetOutput(nil, 1024, "", "docker", "inspect", "--format", "{{.State.Pid}}", name)
+		if err != nil {
+			return "", nil, fmt.Errorf("docker inspect failed: %w (%s)", err, strings.TrimSpace(outErrText))
+		}
+		pid, err = strconv.Atoi(strings.TrimSpace(outText))
+		if err != nil || pid <= 0 {
+			return "", nil, fmt.Errorf("container is not running")
+		}
+		if cgroupPath, err = getProcessCgroup2Path(pid); err != nil {
+			return "", nil, err
+		}
+	}
+
+	if pid > 0 && !isInHostNetNamespace(pid) {
+		addresses = getNetNamespaceAddresses(pid)
+	}
+	return cgroupPath, addresses, nil
+}
+
+// findCgroup2Root returns cgroup-v2 mount point (empty string if not mounted)
+func findCgroup2Root() string {
+	file, err := os.Open("/proc/mounts")
+	if err != nil {
+		return ""
+	}
+	defer file.Close()
+
+	// Format: <device> <mount point> <fstype> <options> ...
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 3 && fields[2] == "cgroup2" {
+			return fields[1]
+		}
+	}
+	return ""
+}
+
+// getProcessCgroup2Path returns cgroup-v2 path of the process (relative to cgroup-v2 root)
+func getProcessCgroup2Path(pid int) (string, error) {
+	data, err := os.ReadFile(fmt.Sprintf("/proc/%d/cgroup", pid))
+	if err != nil {
+		return "", err
+	}
+	// cgroup-v2 entry has format: "0::<path>"
+	for _, line := range strings.Split(string(data), "\n") {
+		if path := strings.TrimPrefix(line, "0::"); path != line {
+			if path = strings.TrimPrefix(path, "/"); len(path) == 0 {
+				return "", fmt.Errorf("PID %d belongs to the root cgroup", pid)
+			}
+			return path, nil
+		}
+	}
+	return "", fmt.Errorf("cgroup-v2 path not found for PID %d", pid)
+}
+
+func isInHostNetNamespace(pid int) bool {
+	hostNs, err := os.Readlink("/proc/1/ns/net")
+	if err != nil {
+		return true
+	}
+	procNs, err := os.Readlink(fmt.Sprintf("/proc/%d/ns/net", pid))
+	if err != nil {
+		return true
+	}
+	return hostNs == procNs
+}
+
+// getNetNamespaceAddresses returns non-loopback IP addresses from the network namespace of the process
+func getNetNamespaceAddresses(pid int) []net.IP {
+	var ret []net.IP
+
+	// IPv4: local addresses from FIB trie
+	//		|-- 172.17.0.2
+	//		   /32 host LOCAL
+	if data, err := os.ReadFile(fmt.Sprintf("/proc/%d/net/fib_trie", pid)); err == nil {
+		lines := strings.Split(string(data), "\n")
+		known := make(map[string]struct{})
+		for i := 1; i < len(lines); i++ {
+			if !strings.Contains(lines[i], "/32 host LOCAL") {
+				continue
+			}
+			addr := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(lines[i-1]), "|--"))
+			ip := net.ParseIP(addr)
+			if ip == nil || ip.IsLoopback() {
+				continue
+			}
+			if _, ok := known[addr]; ok {
+				continue
+			}
+			known[addr] = struct{}{}
+			ret = append(ret, ip)
+		}
+	}
+
+	// IPv6: <address> <ifindex> <prefix len> <scope> <flags> <interface>
+	if data, err := os.ReadFile(fmt.Sprintf("/proc/%d/net/if_inet6", pid)); err == nil {
+		for _, line := range strings.Split(string(data), "\n") {
+			fields := strings.Fields(line)
+			if len(fields) < 6 || fields[3] != "00" || len(fields[0]) != 32 { // global scope only
+				continue
+			}
+			var addr strings.Builder
+			for i := 0; i < 32; i += 4 {
+				if i > 0 {
+					addr.WriteString(":")
+				}
+				addr.WriteString(fields[0][i : i+4])
+			}
+			if ip := net.ParseIP(addr.String()); ip != nil {
+				ret = append(ret, ip)
+			}
+		}
+	}
+
+	return ret
+}
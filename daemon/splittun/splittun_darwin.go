@@ -20,36 +20,537 @@
 //  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
 //
 
+//go:build darwin
+// +build darwin
+
 package splittun
 
 import (
 	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/service/platform"
+	"github.com/ivpn/desktop-app/daemon/shell"
+	"golang.org/x/net/route"
+)
+
+// Split Tunneling on macOS:
+// All processes started in the Split-Tunneling environment have the real group ID 'ivpn-exclude'
+// (child processes inherit it). The PF rules (see 'splittun.sh') route the traffic of this group
+// directly to the default (non-VPN) interface.
+// Note: it is not possible to change the group of the running process,
+// so the application has to be started by the daemon (RunApp()).
+
+var (
+	// error describing details if functionality not available
+	funcNotAvailableError error
+	stScriptPath          string
+	stGroupId             int
+	isActive              bool
+
+	// destinations (IP networks) to be excluded from (or included into) the VPN tunnel
+	destExclude []net.IPNet
+	destInclude []net.IPNet
 )
 
+// Information about processes started in the ST (by implRunApp())
+// (map[<PID>]<command>)
+var _addedRootProcesses map[int]string = map[int]string{}
+
+// _addedRootProcessesMutex protects _addedRootProcesses
+// (GetRunningApps() and RemovePid() are not synchronized by the package mutex)
+var _addedRootProcessesMutex sync.Mutex
+
 func implInitialize() error {
-	return fmt.Errorf("Split-Tunnelling is not implemented for macOS")
+	funcNotAvailableError = nil
+
+	stScriptPath = platform.SplitTunScript()
+	if len(stScriptPath) <= 0 {
+		funcNotAvailableError = fmt.Errorf("Split-Tunnelling script is not defined")
+		return funcNotAvailableError
+	}
+
+	// check if ST functionality accessible
+	outProcessFunc := func(text string, isError bool) {
+		if isError {
+			log.Error("Split Tunneling test: " + text)
+		} else {
+			log.Info("Split Tunneling test: " + text)
+		}
+	}
+	if err := shell.ExecAndProcessOutput(nil, outProcessFunc, "", stScriptPath, "test"); err != nil {
+		funcNotAvailableError = err
+		return funcNotAvailableError
+	}
+
+	// get (create if not exists) the ST group
+	outText, outErrText, _, _, err := shell.ExecAndGetOutput(nil, 1024, "", stScriptPath, "groupid")
+	if err != nil {
+		funcNotAvailableError = fmt.Errorf("failed to initialize Split Tunneling group: %w (%s)", err, strings.TrimSpace(outErrText))
+		return funcNotAvailableError
+	}
+	stGroupId, err = strconv.Atoi(strings.TrimSpace(outText))
+	if err != nil || stGroupId <= 0 {
+		funcNotAvailableError = fmt.Errorf("failed to initialize Split Tunneling group: unexpected GID '%s'", strings.TrimSpace(outText))
+		return funcNotAvailableError
+	}
+
+	// Ensure that ST is disable on daemon startup
+	enable(false)
+
+	// The PF rules are bound to the default interface and gateway.
+	// Therefore, we must monitor changes in routing table and update ST rules (e.g. when switching WiFi network).
+	go routeChangesMonitor()
+
+	return funcNotAvailableError
 }
 
 func implFuncNotAvailableError() error {
-	return fmt.Errorf("Split-Tunnelling is not implemented for macOS")
+	return funcNotAvailableError
 }
 
 func implReset() error {
-	return fmt.Errorf("Split-Tunnelling is not implemented for macOS")
+	log.Info("Resetting Split Tunneling")
+	isActive = false
+	return shell.Exec(nil, stScriptPath, "reset")
 }
 
 func implApplyConfig(isStEnabled bool, isVpnEnabled bool, addrConfig ConfigAddresses, splitTunnelApps []string) error {
-	return fmt.Errorf("Split-Tunnelling is not implemented for macOS")
+	err := enable(isStEnabled)
+	if err != nil {
+		log.Error(err)
+	}
+	return err
+}
+
+func implApplyDestinations(exclude []net.IPNet, include []net.IPNet) error {
+	destExclude = exclude
+	destInclude = include
+
+	if !isActive {
+		// the rules will be applied when ST enabled
+		return nil
+	}
+	return applyDestinations()
+}
+
+func implIsCanSplitDestinations() bool {
+	return true
+}
+
+// applyDestinations updates PF tables of the ST anchor
+// Note: the tables are erased each time when the ST rules reloaded, so this function must be called after it
+func applyDestinations() error {
+	args := []string{"dest-set"}
+	for _, n := range destInclude {
+		args = append(args, "-i", n.String())
+	}
+	for _, n := range destExclude {
+		args = append(args, "-e", n.String())
+	}
+
+	if err := shell.Exec(nil, stScriptPath, args...); err != nil {
+		return fmt.Errorf("failed to apply Split Tunneling destinations: %w", err)
+	}
+	return nil
+}
+
+func implApplyContainers(containers []Container) error {
+	if len(containers) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Split Tunneling for containers is not applicable for current platform")
+}
+
+func implIsCanSplitContainers() bool {
+	return false
+}
+
+func implApplyUsers(users []User) error {
+	if len(users) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Split Tunneling for users is not applicable for current platform")
+}
+
+func implIsCanSplitUsers() bool {
+	return false
 }
 
 func implAddPid(pid int, commandToExecute string) error {
-	return fmt.Errorf("Split-Tunnelling is not implemented for macOS")
+	return fmt.Errorf("operation not applicable for current platform")
 }
 
+// implRemovePid terminates the process and all its child processes.
+// (it is not possible to change the group of the running process on macOS)
 func implRemovePid(pid int) error {
-	return fmt.Errorf("Split-Tunnelling is not implemented for macOS")
+	if pid <= 0 {
+		return fmt.Errorf("PID is not defined")
+	}
+
+	runningApps, err := implGetRunningApps()
+	if err != nil {
+		return err
+	}
+
+	pids := make(map[int]struct{})
+	pids[pid] = struct{}{}
+	allPids := make(map[int]RunningApp, len(runningApps))
+	for _, app := range runningApps {
+		allPids[app.Pid] = app
+	}
+	for _, app := range runningApps {
+		if isChildOf(app, pid, allPids) {
+			pids[app.Pid] = struct{}{}
+		}
+	}
+
+	var retErr error
+	for pidToRemove := range pids {
+		if _, ok := allPids[pidToRemove]; !ok {
+			continue // process not in ST environment
+		}
+		log.Info(fmt.Sprintf("Terminating PID:%d", pidToRemove))
+		if err := syscall.Kill(pidToRemove, syscall.SIGTERM); err != nil && retErr == nil {
+			retErr = err
+		}
+		_addedRootProcessesMutex.Lock()
+		delete(_addedRootProcesses, pidToRemove)
+		_addedRootProcessesMutex.Unlock()
+	}
+	return retErr
+}
+
+func implRunApp(commandToExecute string, uid int) (int, error) {
+	args := strings.Fields(commandToExecute)
+	if len(args) <= 0 {
+		return 0, fmt.Errorf("command is not defined")
+	}
+	if !isActive {
+		return 0, fmt.Errorf("the Split Tunneling is disabled")
+	}
+
+	binary, err := getBinaryPath(args[0])
+	if err != nil {
+		return 0, err
+	}
+
+	// The application is starting under the account of the user which requested it.
+	// The request from root (e.g. 'sudo ivpn exclude ...') starts the application for the user which is logged-in (console owner).
+	if uid < 0 {
+		return 0, fmt.Errorf("unable to detect the user which requested to start the application")
+	}
+	if uid == 0 {
+		if uid, err = getConsoleUser(); err != nil {
+			return 0, fmt.Errorf("unable to detect the active user: %w", err)
+		}
+	}
+	usr, err := lookupUser(uid)
+	if err != nil {
+		return 0, err
+	}
+
+	cmd := exec.Command(binary, args[1:]...)
+	cmd.Dir = usr.home
+	cmd.Env = []string{
+		"HOME=" + usr.home,
+		"USER=" + usr.name,
+		"LOGNAME=" + usr.name,
+		"PATH=/usr/local/bin:/usr/bin:/bin:/usr/sbin:/sbin",
+	}
+	cmd.SysProcAttr = &syscall.SysProcAttr{
+		Credential: &syscall.Credential{Uid: uint32(uid), Gid: uint32(stGroupId), Groups: usr.groups},
+		Setsid:     true,
+	}
+
+	log.Info(fmt.Sprintf("Starting in Split Tunneling environment: %s", commandToExecute))
+	if err := cmd.Start(); err != nil {
+		return 0, fmt.Errorf("failed to start command: %w", err)
+	}
+	pid := cmd.Process.Pid
+	_addedRootProcessesMutex.Lock()
+	_addedRootProcesses[pid] = commandToExecute
+	_addedRootProcessesMutex.Unlock()
+
+	// avoid zombie processes
+	go cmd.Wait()
+
+	return pid, nil
+}
+
+func implGetRunningApps() (allProcesses []RunningApp, err error) {
+	if funcNotAvailableError != nil {
+		return nil, funcNotAvailableError
+	}
+
+	outText, _, _, _, err := shell.ExecAndGetOutput(nil, 1024*1024, "", "/bin/ps", "-ax", "-o", "pid=,ppid=,rgid=,args=")
+	if err != nil {
+		return nil, err
+	}
+
+	retMapAll := make(map[int]RunningApp)
+	for _, line := range strings.Split(outText, "\n") {
+		cols := strings.Fields(line)
+		if len(cols) < 4 {
+			continue
+		}
+		rgid, err := strconv.Atoi(cols[2])
+		if err != nil || rgid != stGroupId {
+			continue
+		}
+		pid, err := strconv.Atoi(cols[0])
+		if err != nil {
+			continue
+		}
+		ppid, _ := strconv.Atoi(cols[1])
+
+		retMapAll[pid] = RunningApp{
+			Pid:     pid,
+			Ppid:    ppid,
+			Cmdline: strings.Join(cols[3:], " "),
+			Exe:     cols[3]}
+	}
+
+	_addedRootProcessesMutex.Lock()
+	defer _addedRootProcessesMutex.Unlock()
+
+	// remove from _addedRootProcesses PIDs which are not exists anymore
+	for rootPid := range _addedRootProcesses {
+		if _, ok := retMapAll[rootPid]; !ok {
+			delete(_addedRootProcesses, rootPid)
+		}
+	}
+
+	retAll := make([]RunningApp, 0, len(retMapAll))
+	for _, value := range retMapAll {
+		if rootPid, isKnown := getRootPid(value, retMapAll); isKnown {
+			value.ExtIvpnRootPid = rootPid
+		}
+		// for known root processes - replace command by the original command used to run process
+		if cmdLine, ok := _addedRootProcesses[value.Pid]; ok {
+			value.ExtModifiedCmdLine = cmdLine
+		}
+		retAll = append(retAll, value)
+	}
+	return retAll, nil
 }
 
-func implGetRunningApps() ([]RunningApp, error) {
-	return nil, fmt.Errorf("Split-Tunnelling is not implemented for macOS")
+func isEnabled() (bool, error) {
+	err := shell.Exec(nil, stScriptPath, "status")
+	if err != nil {
+		return false, nil
+	}
+	return true, nil
+}
+
+func enable(isEnable bool) error {
+	if !isEnable {
+		enabled, err := isEnabled()
+		if err == nil && !enabled {
+			isActive = false
+			return nil
+		}
+		err = shell.Exec(nil, stScriptPath, "stop")
+		if err != nil {
+			return fmt.Errorf("failed to disable Split Tunneling: %w", err)
+		}
+		log.Info("Split Tunneling disabled")
+	} else {
+		enabled, err := isEnabled()
+		if err != nil {
+			return fmt.Errorf("failed to enable Split Tunneling (unable to obtain ST status): %w", err)
+		}
+
+		if !enabled {
+			_, outErrText, _, _, err := shell.ExecAndGetOutput(nil, 1024, "", stScriptPath, "start")
+			if err != nil {
+				if len(outErrText) > 0 {
+					err = fmt.Errorf("(%w) %s", err, outErrText)
+				}
+				// if ST start failed - clean everything (by command 'stop')
+				shell.Exec(nil, stScriptPath, "stop")
+
+				return fmt.Errorf("failed to enable Split Tunneling: %w", err)
+			}
+			log.Info("Split Tunneling enabled")
+		}
+
+		if err := applyDestinations(); err != nil {
+			log.Error(err)
+		}
+	}
+
+	isActive = isEnable
+	return nil
+}
+
+// routeChangesMonitor listens for routing table changes (PF_ROUTE socket)
+// and updates ST rules when required
+func routeChangesMonitor() {
+	sock, err := syscall.Socket(syscall.AF_ROUTE, syscall.SOCK_RAW, syscall.AF_UNSPEC)
+	if err != nil {
+		log.Error("Split Tunneling: failed to start route change monitor: ", err)
+		return
+	}
+	defer syscall.Close(sock)
+
+	var timerDelay *time.Timer
+	b := make([]byte, os.Getpagesize())
+	for {
+		nr, err := syscall.Read(sock, b)
+		if err != nil {
+			log.Error("Split Tunneling: route change monitor stopped: ", err)
+			return
+		}
+		mutex.Lock()
+		isStActive := isActive
+		mutex.Unlock()
+		if !isStActive {
+			continue
+		}
+
+		messages, err := route.ParseRIB(0, b[:nr])
+		if err != nil {
+			continue
+		}
+		for _, msg := range messages {
+			rmsg, ok := msg.(*route.RouteMessage)
+			if !ok || (rmsg.Type != syscall.RTM_ADD && rmsg.Type != syscall.RTM_CHANGE && rmsg.Type != syscall.RTM_DELETE) {
+				continue
+			}
+			if timerDelay != nil {
+				timerDelay.Stop()
+			}
+			// We can receive many route change events in a short period of time
+			// but we update rules not more often than once per 2 seconds.
+			timerDelay = time.AfterFunc(time.Second*2, func() {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				if err := shell.Exec(nil, stScriptPath, "update-routes"); err != nil {
+					log.Error("failed to update routes for SplitTunneling functionality")
+				}
+				if err := applyDestinations(); err != nil {
+					log.Error(err)
+				}
+			})
+			break
+		}
+	}
+}
+
+type userInfo struct {
+	name   string
+	home   string
+	groups []uint32 // all groups of the user (the primary group and the supplementary groups)
+}
+
+// getConsoleUser returns UID of the user which is logged-in (owner of '/dev/console')
+func getConsoleUser() (uid int, err error) {
+	fi, err := os.Stat("/dev/console")
+	if err != nil {
+		return 0, err
+	}
+	stat, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return 0, fmt.Errorf("unable to read '/dev/console' owner")
+	}
+	if stat.Uid == 0 {
+		return 0, fmt.Errorf("no user logged-in")
+	}
+	return int(stat.Uid), nil
+}
+
+// lookupUser returns user name, home directory and groups ('dscl' is used because '/etc/passwd' does not contain macOS users)
+func lookupUser(uid int) (userInfo, error) {
+	outText, _, _, _, err := shell.ExecAndGetOutput(nil, 1024*64, "", "/usr/bin/dscl", ".", "-search", "/Users", "UniqueID", strconv.Itoa(uid))
+	if err != nil {
+		return userInfo{}, fmt.Errorf("unable to get user info (UID=%d): %w", uid, err)
+	}
+	fields := strings.Fields(outText)
+	if len(fields) <= 0 {
+		return userInfo{}, fmt.Errorf("user not found (UID=%d)", uid)
+	}
+	name := fields[0]
+	home := filepath.Join("/Users", name)
+
+	outText, _, _, _, err = shell.ExecAndGetOutput(nil, 1024, "", "/usr/bin/dscl", ".", "-read", filepath.Join("/Users", name), "NFSHomeDirectory")
+	if err == nil {
+		if cols := strings.SplitN(strings.TrimSpace(outText), ":", 2); len(cols) == 2 && len(strings.TrimSpace(cols[1])) > 0 {
+			home = strings.TrimSpace(cols[1])
+		}
+	}
+
+	outText, _, _, _, err = shell.ExecAndGetOutput(nil, 1024*5, "", "/usr/bin/id", "-G", name)
+	if err != nil {
+		return userInfo{}, fmt.Errorf("unable to get groups of user '%s': %w", name, err)
+	}
+	groups := make([]uint32, 0, 16)
+	for _, g := range strings.Fields(outText) {
+		gid, err := strconv.ParseUint(g, 10, 32)
+		if err != nil {
+			return userInfo{}, fmt.Errorf("unable to get groups of user '%s': unexpected GID '%s'", name, g)
+		}
+		groups = append(groups, uint32(gid))
+	}
+	if len(groups) == 0 {
+		return userInfo{}, fmt.Errorf("unable to get groups of user '%s'", name)
+	}
+
+	return userInfo{name: name, home: home, groups: groups}, nil
+}
+
+// getBinaryPath returns path to the binary to execute.
+// For application bundles ('*.app') - path to the bundle executable.
+// (The application must not be started by 'open' command, otherwise it will be started by 'launchd' and will not belong to ST group)
+func getBinaryPath(binary string) (string, error) {
+	if !strings.HasSuffix(strings.TrimRight(binary, "/"), ".app") {
+		return exec.LookPath(binary)
+	}
+
+	bundle := strings.TrimRight(binary, "/")
+	outText, _, _, _, err := shell.ExecAndGetOutput(nil, 1024, "", "/usr/bin/defaults", "read", filepath.Join(bundle, "Contents", "Info"), "CFBundleExecutable")
+	if err != nil {
+		return "", fmt.Errorf("unable to get executable of application bundle '%s': %w", bundle, err)
+	}
+	return filepath.Join(bundle, "Contents", "MacOS", strings.TrimSpace(outText)), nil
+}
+
+func getRootPid(p RunningApp, allPids map[int]RunningApp) (rootPid int, isKnownRoot bool) {
+	if _, ok := _addedRootProcesses[p.Ppid]; ok {
+		return p.Ppid, true
+	}
+	if _, ok := _addedRootProcesses[p.Pid]; ok {
+		return p.Pid, true
+	}
+
+	if parentProc, ok := allPids[p.Ppid]; ok {
+		if p.Ppid <= parentProc.Ppid {
+			return 0, false //just to ensure there is no infinite recursion
+		}
+		return getRootPid(parentProc, allPids)
+	}
+	return p.Ppid, false
+}
+
+func isChildOf(p RunningApp, parentPid int, allPids map[int]RunningApp) bool {
+	if p.Ppid == parentPid {
+		return true
+	}
+
+	if parentProc, ok := allPids[p.Ppid]; ok {
+		if p.Ppid <= parentProc.Ppid {
+			return false //just to ensure there is no infinite recursion
+		}
+		return isChildOf(parentProc, parentPid, allPids)
+	}
+	return false
 }
@@ -27,6 +27,7 @@ package splittun
 
 import (
 	"fmt"
+	"net"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -44,6 +45,10 @@ var (
 	funcNotAvailableError error
 	stScriptPath          string
 	isActive              bool
+
+	// destinations (IP networks) to be excluded from (or included into) the VPN tunnel
+	destExclude []net.IPNet
+	destInclude []net.IPNet
 )
 
 // Information about added running process to the ST (by implAddPid())
@@ -136,6 +141,36 @@ func implApplyConfig(isStEnabled bool, isVpnEnabled bool, addrConfig ConfigAddre
 	return err
 }
 
+func implApplyDestinations(exclude []net.IPNet, include []net.IPNet) error {
+	destExclude = exclude
+	destInclude = include
+
+	if !isActive {
+		// the rules will be applied when ST enabled
+		return nil
+	}
+	return applyDestinations()
+}
+
+func implIsCanSplitDestinations() bool {
+	return true
+}
+
+func applyDestinations() error {
+	args := []string{"dest-set"}
+	for _, n := range destInclude {
+		args = append(args, "-i", n.String())
+	}
+	for _, n := range destExclude {
+		args = append(args, "-e", n.String())
+	}
+
+	if err := shell.Exec(nil, stScriptPath, args...); err != nil {
+		return fmt.Errorf("failed to apply Split Tunneling destinations: %w", err)
+	}
+	return nil
+}
+
 func implAddPid(pid int, commandToExecute string) error {
 	if pid <= 0 {
 		return fmt.Errorf("PID is not defined")
@@ -197,6 +232,10 @@ func implRemovePid(pid int) error {
 	return retErr
 }
 
+func implRunApp(commandToExecute string, uid int) (int, error) {
+	return 0, fmt.Errorf("operation not applicable for current platform")
+}
+
 func implGetRunningApps() (allProcesses []RunningApp, err error) {
 	// https://man7.org/linux/man-pages/man5/proc.5.html
 
@@ -370,6 +409,8 @@ func enable(isEnable bool) error {
 		if err != nil {
 			return fmt.Errorf("failed to disable Split Tunneling: %w", err)
 		}
+		containersLastArgs = nil
+		usersLastArgs = nil
 		log.Info("Split Tunneling disabled")
 	} else {
 		enabled, err := isEnabled()
@@ -392,6 +433,16 @@ func enable(isEnable bool) error {
 			return fmt.Errorf("failed to enable Split Tunneling: %w", err)
 		}
 		log.Info("Split Tunneling enabled")
+
+		if err := applyDestinations(); err != nil {
+			log.Error(err)
+		}
+		if err := applyContainers(true); err != nil {
+			log.Error(err)
+		}
+		if err := applyUsers(true); err != nil {
+			log.Error(err)
+		}
 	}
 
 	isActive = isEnable
@@ -445,7 +496,7 @@ func readProcEnvVarIvpnId(pid int) (int, error) {
 	}
 
 	id := 0
-	vars := strings.Split(string(bytes), string(0))
+	vars := strings.Split(string(bytes), string(rune(0)))
 	for _, line := range vars {
 		cols := strings.Split(line, "=")
 		if len(cols) != 2 {
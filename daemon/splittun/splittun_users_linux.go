@@ -0,0 +1,108 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+//go:build linux
+// +build linux
+
+package splittun
+
+import (
+	"fmt"
+	"os/user"
+	"reflect"
+	"strconv"
+
+	"github.com/ivpn/desktop-app/daemon/shell"
+)
+
+var (
+	users []User
+	// arguments of the last applied 'user-set' command (to avoid re-applying the same rules)
+	usersLastArgs []string
+)
+
+func implApplyUsers(u []User) error {
+	if len(u) > 0 && funcNotAvailableError != nil {
+		return fmt.Errorf("Split Tunneling for users is not applicable: %w", funcNotAvailableError)
+	}
+
+	for _, usr := range u {
+		if uid, err := resolveUserID(usr.Name); err == nil && uid == 0 && !usr.IsInclude {
+			return fmt.Errorf("the root user can not be excluded from the VPN tunnel")
+		}
+	}
+
+	users = u
+	if !isActive {
+		// the rules will be applied when ST enabled
+		return nil
+	}
+	return applyUsers(false)
+}
+
+func implIsCanSplitUsers() bool {
+	return funcNotAvailableError == nil
+}
+
+// applyUsers resolves users and applies the rules
+// (isForce=false - the rules are applied only if they differ from the last applied rules)
+func applyUsers(isForce bool) error {
+	args := []string{"user-set"}
+	for _, u := range users {
+		uid, err := resolveUserID(u.Name)
+		if err != nil {
+			log.Warning(fmt.Sprintf("Split Tunneling: unable to resolve user '%s': %s", u.Name, err))
+			continue
+		}
+		if u.IsInclude {
+			args = append(args, "-i", strconv.Itoa(uid))
+		} else if uid != 0 {
+			// the daemon itself is running under root: its traffic must not bypass the tunnel
+			args = append(args, "-e", strconv.Itoa(uid))
+		}
+	}
+
+	if !isForce && reflect.DeepEqual(args, usersLastArgs) {
+		return nil
+	}
+
+	usersLastArgs = nil
+	if err := shell.Exec(nil, stScriptPath, args...); err != nil {
+		return fmt.Errorf("failed to apply Split Tunneling users: %w", err)
+	}
+	usersLastArgs = args
+	return nil
+}
+
+// resolveUserID returns UID of the user (the 'name' can be a user name or UID)
+func resolveUserID(name string) (int, error) {
+	var u *user.User
+	var err error
+	if _, errConv := strconv.Atoi(name); errConv == nil {
+		u, err = user.LookupId(name)
+	} else {
+		u, err = user.Lookup(name)
+	}
+	if err != nil {
+		return -1, err
+	}
+	return strconv.Atoi(u.Uid)
+}
@@ -27,10 +27,12 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"syscall"
 	"unsafe"
 
+	"github.com/ivpn/desktop-app/daemon/crashreport"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
 )
 
@@ -169,6 +171,40 @@ func implApplyConfig(isStEnabled bool, isVpnEnabled bool, addrConfig ConfigAddre
 	return nil
 }
 
+func implApplyDestinations(exclude []net.IPNet, include []net.IPNet) error {
+	if len(exclude) == 0 && len(include) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Split Tunneling by destinations is not applicable for current platform")
+}
+
+func implIsCanSplitDestinations() bool {
+	// the Split Tunneling driver supports only applications
+	return false
+}
+
+func implApplyContainers(containers []Container) error {
+	if len(containers) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Split Tunneling for containers is not applicable for current platform")
+}
+
+func implIsCanSplitContainers() bool {
+	return false
+}
+
+func implApplyUsers(users []User) error {
+	if len(users) == 0 {
+		return nil
+	}
+	return fmt.Errorf("Split Tunneling for users is not applicable for current platform")
+}
+
+func implIsCanSplitUsers() bool {
+	return false
+}
+
 func implAddPid(pid int, commandToExecute string) error {
 	return fmt.Errorf("operation not applicable for current platform")
 }
@@ -177,13 +213,17 @@ func implRemovePid(pid int) error {
 	return fmt.Errorf("operation not applicable for current platform")
 }
 
+func implRunApp(commandToExecute string, uid int) (int, error) {
+	return 0, fmt.Errorf("operation not applicable for current platform")
+}
+
 func implGetRunningApps() ([]RunningApp, error) {
 	return nil, fmt.Errorf("operation not applicable for current platform")
 }
 
 func catchPanic(err *error) {
 	if r := recover(); r != nil {
-		log.Error("PANIC (recovered): ", r)
+		crashreport.Save("catchPanic", r)
 		if e, ok := r.(error); ok {
 			*err = e
 		} else {
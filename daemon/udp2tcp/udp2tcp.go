@@ -0,0 +1,242 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package udp2tcp implements "UDP over TCP" encapsulation (e.g. WireGuard over TCP).
+// It is useful for the networks where all UDP traffic is blocked.
+//
+// The shim listens on local UDP port and forwards all datagrams to the remote server over a single TCP connection.
+// Each datagram is prefixed by its length (2 bytes, big-endian). The server side does the reverse conversion.
+package udp2tcp
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/hostroute"
+	"github.com/ivpn/desktop-app/daemon/logger"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("udptcp")
+}
+
+const (
+	dialTimeout     = time.Second * 10
+	maxDatagramSize = 65535
+)
+
+// Shim - UDP-over-TCP forwarder
+type Shim struct {
+	remoteIP   net.IP
+	remotePort int
+	// when 'true' - the shim configures the route to the server via default gateway
+	isRouteRequired bool
+
+	mutex   sync.Mutex
+	udpConn *net.UDPConn
+	tcpConn net.Conn
+	route   *hostroute.Route
+	stopped chan struct{}
+}
+
+// CreateShim creates new UDP-over-TCP shim to the server 'remoteIP:remotePort' (TCP)
+// 'isRouteRequired' - the route to the server via default gateway must be configured by the shim
+// (set 'false' if the route is already configured by the VPN)
+func CreateShim(remoteIP net.IP, remotePort int, isRouteRequired bool) (*Shim, error) {
+	if remoteIP == nil || remoteIP.To4() == nil || remotePort <= 0 || remotePort > 65535 {
+		return nil, fmt.Errorf("bad UDP-over-TCP server address")
+	}
+	return &Shim{remoteIP: remoteIP, remotePort: remotePort, isRouteRequired: isRouteRequired}, nil
+}
+
+// RemoteIP returns IP address of the server
+func (s *Shim) RemoteIP() net.IP {
+	return s.remoteIP
+}
+
+// LocalPort returns the local UDP port (0 - the shim is not started)
+func (s *Shim) LocalPort() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.udpConn == nil {
+		return 0
+	}
+	return s.udpConn.LocalAddr().(*net.UDPAddr).Port
+}
+
+// Start connects to the server and starts forwarding.
+// The route to the server is configured to go outside the VPN tunnel.
+func (s *Shim) Start() (err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.stopped != nil {
+		return fmt.Errorf("UDP-over-TCP shim already started")
+	}
+
+	remote := net.JoinHostPort(s.remoteIP.String(), strconv.Itoa(s.remotePort))
+	log.Info("Starting UDP-over-TCP shim to ", remote)
+	defer func() {
+		if err != nil {
+			log.Error(err)
+			s.stop()
+		}
+	}()
+
+	// the communication with the server must not go through the VPN tunnel
+	if s.isRouteRequired {
+		route, err := hostroute.Add(s.remoteIP)
+		if err != nil {
+			return fmt.Errorf("failed to configure route to UDP-over-TCP server: %w", err)
+		}
+		s.route = route
+	}
+
+	tcpConn, err := net.DialTimeout("tcp", remote, dialTimeout)
+	if err != nil {
+		return fmt.Errorf("failed to connect to UDP-over-TCP server: %w", err)
+	}
+	s.tcpConn = tcpConn
+
+	udpConn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 0})
+	if err != nil {
+		return fmt.Errorf("failed to start local UDP listener: %w", err)
+	}
+	s.udpConn = udpConn
+
+	stopped := make(chan struct{})
+	s.stopped = stopped
+
+	var stopOnce sync.Once
+	onStop := func(reason error) {
+		stopOnce.Do(func() {
+			log.Info("UDP-over-TCP shim stopped: ", reason)
+			// unblock the second forwarding routine
+			tcpConn.Close()
+			udpConn.Close()
+			close(stopped)
+		})
+	}
+
+	// the address of the local UDP client (e.g. WireGuard)
+	var clientAddr *net.UDPAddr
+	var clientAddrMutex sync.Mutex
+
+	// UDP -> TCP
+	go func() {
+		buff := make([]byte, 2+maxDatagramSize)
+		for {
+			n, addr, err := udpConn.ReadFromUDP(buff[2:])
+			if err != nil {
+				onStop(err)
+				return
+			}
+			if !addr.IP.IsLoopback() {
+				continue
+			}
+			clientAddrMutex.Lock()
+			clientAddr = addr
+			clientAddrMutex.Unlock()
+
+			binary.BigEndian.PutUint16(buff[:2], uint16(n))
+			if _, err := tcpConn.Write(buff[:2+n]); err != nil {
+				onStop(err)
+				return
+			}
+		}
+	}()
+
+	// TCP -> UDP
+	go func() {
+		buff := make([]byte, maxDatagramSize)
+		header := make([]byte, 2)
+		for {
+			if _, err := io.ReadFull(tcpConn, header); err != nil {
+				onStop(err)
+				return
+			}
+			size := int(binary.BigEndian.Uint16(header))
+			if _, err := io.ReadFull(tcpConn, buff[:size]); err != nil {
+				onStop(err)
+				return
+			}
+
+			clientAddrMutex.Lock()
+			addr := clientAddr
+			clientAddrMutex.Unlock()
+			if addr == nil {
+				continue // no local client yet
+			}
+			if _, err := udpConn.WriteToUDP(buff[:size], addr); err != nil {
+				onStop(err)
+				return
+			}
+		}
+	}()
+
+	log.Info(fmt.Sprintf("Started on port %d", udpConn.LocalAddr().(*net.UDPAddr).Port))
+	return nil
+}
+
+// Wait - waits until the shim stopped (e.g. the TCP connection is closed by the server)
+func (s *Shim) Wait() {
+	s.mutex.Lock()
+	stopped := s.stopped
+	s.mutex.Unlock()
+
+	if stopped == nil {
+		return
+	}
+	<-stopped
+}
+
+// Stop - stops forwarding and restores the routing configuration
+func (s *Shim) Stop() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.stop()
+}
+
+func (s *Shim) stop() {
+	if s.tcpConn != nil {
+		s.tcpConn.Close()
+		s.tcpConn = nil
+	}
+	if s.udpConn != nil {
+		s.udpConn.Close()
+		s.udpConn = nil
+	}
+
+	if s.route != nil {
+		if err := s.route.Remove(); err != nil {
+			log.Error(fmt.Errorf("failed to remove route to UDP-over-TCP server: %w", err))
+		}
+		s.route = nil
+	}
+}
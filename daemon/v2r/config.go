@@ -0,0 +1,153 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package v2r
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/ivpn/desktop-app/daemon/helpers"
+)
+
+// The V2Ray configuration (JSON format)
+// https://www.v2fly.org/en_US/v5/config/overview.html
+
+type config struct {
+	Log       logConfig        `json:"log"`
+	Inbounds  []inboundConfig  `json:"inbounds"`
+	Outbounds []outboundConfig `json:"outbounds"`
+}
+
+type logConfig struct {
+	Loglevel string `json:"loglevel"`
+}
+
+type inboundConfig struct {
+	Tag      string      `json:"tag"`
+	Listen   string      `json:"listen"`
+	Port     int         `json:"port"`
+	Protocol string      `json:"protocol"`
+	Settings interface{} `json:"settings"`
+}
+
+// "dokodemo-door" inbound: forwards the traffic to the fixed destination
+type dokodemoSettings struct {
+	Address string `json:"address"`
+	Port    int    `json:"port"`
+	Network string `json:"network"`
+}
+
+// "socks" inbound: local SOCKS5 proxy
+type socksSettings struct {
+	Auth string `json:"auth"`
+	UDP  bool   `json:"udp"`
+}
+
+type outboundConfig struct {
+	Tag            string         `json:"tag"`
+	Protocol       string         `json:"protocol"`
+	Settings       vnextSettings  `json:"settings"`
+	StreamSettings streamSettings `json:"streamSettings"`
+}
+
+type vnextSettings struct {
+	Vnext []vnextServer `json:"vnext"`
+}
+
+type vnextServer struct {
+	Address string      `json:"address"`
+	Port    int         `json:"port"`
+	Users   []vnextUser `json:"users"`
+}
+
+type vnextUser struct {
+	ID         string `json:"id"`
+	AlterID    *int   `json:"alterId,omitempty"`    // VMess only
+	Security   string `json:"security,omitempty"`   // VMess only
+	Encryption string `json:"encryption,omitempty"` // VLESS only
+}
+
+type streamSettings struct {
+	Network     string       `json:"network"`
+	Security    string       `json:"security"`
+	TLSSettings *tlsSettings `json:"tlsSettings,omitempty"`
+}
+
+type tlsSettings struct {
+	ServerName string `json:"serverName,omitempty"`
+}
+
+func createConfig(s Settings, localPort int) (*config, error) {
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	inbound := inboundConfig{Tag: "vpn", Listen: "127.0.0.1", Port: localPort}
+	switch s.Inbound {
+	case InboundUDP:
+		inbound.Protocol = "dokodemo-door"
+		inbound.Settings = dokodemoSettings{Address: s.DestinationIP.String(), Port: s.DestinationPort, Network: "udp"}
+	case InboundSocks:
+		inbound.Protocol = "socks"
+		inbound.Settings = socksSettings{Auth: "noauth", UDP: true}
+	default:
+		return nil, fmt.Errorf("unsupported V2Ray inbound type (%d)", s.Inbound)
+	}
+
+	user := vnextUser{ID: s.UserID}
+	outbound := outboundConfig{Tag: "proxy"}
+	switch s.Transport {
+	case VMess:
+		alterID := 0
+		outbound.Protocol = "vmess"
+		user.AlterID = &alterID
+		user.Security = "auto"
+	case VLESS:
+		outbound.Protocol = "vless"
+		user.Encryption = "none"
+	default:
+		return nil, fmt.Errorf("unsupported V2Ray transport type (%d)", s.Transport)
+	}
+	outbound.Settings = vnextSettings{Vnext: []vnextServer{{Address: s.OutboundIP.String(), Port: s.OutboundPort, Users: []vnextUser{user}}}}
+	// TLS over TCP: the traffic looks like a regular HTTPS connection
+	outbound.StreamSettings = streamSettings{Network: "tcp", Security: "tls", TLSSettings: &tlsSettings{ServerName: s.TLSServerName}}
+
+	return &config{
+		Log:       logConfig{Loglevel: "warning"},
+		Inbounds:  []inboundConfig{inbound},
+		Outbounds: []outboundConfig{outbound},
+	}, nil
+}
+
+// WriteToFile saves the configuration into a file
+func (c *config) WriteToFile(filePath string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize V2Ray configuration: %w", err)
+	}
+	// the configuration contains the user ID: read\write only for privileged user
+	if err := helpers.WriteFile(filePath, data, 0600); err != nil {
+		return fmt.Errorf("failed to save V2Ray configuration: %w", err)
+	}
+	return nil
+}
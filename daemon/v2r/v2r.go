@@ -0,0 +1,291 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2023 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+// Package v2r implements the V2Ray transport: the VPN traffic is wrapped by V2Ray (VMess or VLESS protocols over TLS)
+// which makes the connection looking like a regular HTTPS traffic.
+// It is useful for the networks where the VPN protocols (and even obfsproxy) are detected and blocked.
+package v2r
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ivpn/desktop-app/daemon/hostroute"
+	"github.com/ivpn/desktop-app/daemon/logger"
+	"github.com/ivpn/desktop-app/daemon/netinfo"
+	"github.com/ivpn/desktop-app/daemon/shell"
+)
+
+var log *logger.Logger
+
+func init() {
+	log = logger.NewLogger("v2ray")
+}
+
+// V2RayTransportType - protocol in use to communicate with the V2Ray server
+type V2RayTransportType int
+
+const (
+	None  V2RayTransportType = 0 // V2Ray is not in use
+	VMess V2RayTransportType = 1
+	VLESS V2RayTransportType = 2
+)
+
+// IsEnabled returns 'true' when V2Ray transport is in use
+func (t V2RayTransportType) IsEnabled() bool {
+	return t == VMess || t == VLESS
+}
+
+func (t V2RayTransportType) String() string {
+	switch t {
+	case VMess:
+		return "VMess"
+	case VLESS:
+		return "VLESS"
+	default:
+		return "disabled"
+	}
+}
+
+// InboundType - the way the VPN client communicates with local V2Ray instance
+type InboundType int
+
+const (
+	// InboundUDP - local UDP port forwarded to the VPN server (e.g. for WireGuard)
+	InboundUDP InboundType = iota
+	// InboundSocks - local SOCKS5 proxy (e.g. for OpenVPN)
+	InboundSocks InboundType = iota
+)
+
+// Settings - configuration of the V2Ray tunnel
+type Settings struct {
+	Transport V2RayTransportType
+	Inbound   InboundType
+
+	// VPN server address (destination of the forwarded traffic; not applicable for InboundSocks)
+	DestinationIP   net.IP
+	DestinationPort int
+
+	// V2Ray server
+	OutboundIP    net.IP
+	OutboundPort  int
+	UserID        string
+	TLSServerName string
+}
+
+// Validate checks the settings consistency
+func (s Settings) Validate() error {
+	if !s.Transport.IsEnabled() {
+		return fmt.Errorf("V2Ray transport type not defined")
+	}
+	if s.OutboundIP == nil || s.OutboundIP.To4() == nil || s.OutboundPort <= 0 {
+		return fmt.Errorf("V2Ray server address not defined")
+	}
+	if len(s.UserID) == 0 {
+		return fmt.Errorf("V2Ray user ID not defined")
+	}
+	if s.Inbound == InboundUDP && (s.DestinationIP == nil || s.DestinationPort <= 0) {
+		return fmt.Errorf("VPN server address not defined")
+	}
+	return nil
+}
+
+// V2RayWrapper - manages the V2Ray process
+type V2RayWrapper struct {
+	binaryPath string
+	configPath string
+	settings   Settings
+
+	mutex     sync.Mutex
+	command   *exec.Cmd
+	stopped   chan struct{}
+	localPort int
+	route     *hostroute.Route
+}
+
+// CreateV2RayWrapper creates new V2Ray wrapper object
+func CreateV2RayWrapper(binaryPath string, configPath string, settings Settings) (*V2RayWrapper, error) {
+	if err := settings.Validate(); err != nil {
+		return nil, err
+	}
+	return &V2RayWrapper{binaryPath: binaryPath, configPath: configPath, settings: settings}, nil
+}
+
+// Name returns the name of the transport
+func (v *V2RayWrapper) Name() string {
+	return "V2Ray"
+}
+
+// Settings returns the V2Ray tunnel configuration
+func (v *V2RayWrapper) Settings() Settings {
+	return v.settings
+}
+
+// RemoteIP returns IP address of the V2Ray server
+// (the only remote host the V2Ray process communicates with)
+func (v *V2RayWrapper) RemoteIP() net.IP {
+	return v.settings.OutboundIP
+}
+
+// LocalPort returns the local port of the V2Ray inbound (0 - V2Ray is not started)
+func (v *V2RayWrapper) LocalPort() int {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	return v.localPort
+}
+
+// Start - starts V2Ray process and waits until it ready to use.
+// The route to the V2Ray server is configured to go outside the VPN tunnel.
+func (v *V2RayWrapper) Start() (err error) {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if v.command != nil {
+		return fmt.Errorf("V2Ray already started")
+	}
+
+	log.Info(fmt.Sprintf("Starting V2Ray [%s]", v.settings.Transport))
+	defer func() {
+		if err != nil {
+			log.Error(err)
+			v.stop()
+		}
+	}()
+
+	isTCP := v.settings.Inbound == InboundSocks
+	localPort, err := netinfo.GetFreePort(isTCP)
+	if err != nil {
+		return fmt.Errorf("unable to obtain free local port: %w", err)
+	}
+
+	cfg, err := createConfig(v.settings, localPort)
+	if err != nil {
+		return err
+	}
+	if err := cfg.WriteToFile(v.configPath); err != nil {
+		return err
+	}
+
+	// the communication with V2Ray server must not go through the VPN tunnel
+	route, err := hostroute.Add(v.settings.OutboundIP)
+	if err != nil {
+		return fmt.Errorf("failed to configure route to V2Ray server: %w", err)
+	}
+	v.route = route
+
+	cmd := exec.Command(v.binaryPath, "run", "-config", v.configPath)
+
+	isStarted := false
+	outputParseFunc := func(text string, isError bool) {
+		if isError {
+			log.Info("[ERR] ", text)
+		} else {
+			log.Info("[OUT] ", text)
+		}
+		// output example: "V2Ray 5.4.1 started"
+		if strings.Contains(text, "V2Ray") && strings.HasSuffix(strings.TrimSpace(text), "started") {
+			isStarted = true
+		}
+	}
+	if err := shell.StartConsoleReaders(cmd, outputParseFunc); err != nil {
+		return fmt.Errorf("failed to init V2Ray command: %w", err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start V2Ray: %w", err)
+	}
+	v.command = cmd
+
+	stoppedChan := make(chan struct{})
+	v.stopped = stoppedChan
+	go func() {
+		if err := cmd.Wait(); err != nil {
+			log.Info("V2Ray stopped: ", err)
+		} else {
+			log.Info("V2Ray stopped")
+		}
+		close(stoppedChan)
+	}()
+
+	started := time.Now()
+	for !isStarted && shell.IsRunning(cmd) {
+		time.Sleep(time.Millisecond * 10)
+		// timeout limit to start V2Ray process = 10 seconds
+		if time.Since(started) > time.Second*10 {
+			return errors.New("V2Ray start timeout")
+		}
+	}
+	if !isStarted {
+		return errors.New("V2Ray process stopped unexpectedly")
+	}
+
+	v.localPort = localPort
+	log.Info(fmt.Sprintf("Started on port %d", localPort))
+	return nil
+}
+
+// Wait - waits until V2Ray process stopped
+func (v *V2RayWrapper) Wait() {
+	v.mutex.Lock()
+	stopped := v.stopped
+	v.mutex.Unlock()
+
+	if stopped == nil {
+		return
+	}
+	<-stopped
+}
+
+// Stop - stops V2Ray process and restores the routing configuration
+func (v *V2RayWrapper) Stop() {
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	v.stop()
+}
+
+func (v *V2RayWrapper) stop() {
+	if v.command != nil {
+		log.Info("Stopping V2Ray...")
+		if err := shell.Kill(v.command); err != nil {
+			log.Error(err)
+		}
+		v.command = nil
+	}
+	v.localPort = 0
+
+	if v.route != nil {
+		if err := v.route.Remove(); err != nil {
+			log.Error(fmt.Errorf("failed to remove route to V2Ray server: %w", err))
+		}
+		v.route = nil
+	}
+
+	if err := os.Remove(v.configPath); err != nil && !os.IsNotExist(err) {
+		log.Warning(fmt.Sprintf("failed to remove V2Ray configuration: %s", err))
+	}
+}
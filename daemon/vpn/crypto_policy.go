@@ -0,0 +1,82 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package vpn
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Values allowed for the OpenVPN TLS/cipher policy.
+// Only the values which are considered secure are accepted (e.g. TLS 1.0/1.1, CBC ciphers and the 'legacy' profile are not allowed)
+var (
+	OpenVpnTlsVersions     = []string{"1.2", "1.3"}
+	OpenVpnDataCiphers     = []string{"AES-256-GCM", "AES-128-GCM", "CHACHA20-POLY1305"}
+	OpenVpnTlsCertProfiles = []string{"preferred", "suiteb"}
+)
+
+// OpenVpnCryptoPolicy - TLS/cipher policy for OpenVPN connections (e.g. for users with compliance requirements).
+// Empty values - the defaults of the daemon (or OpenVPN) are in use.
+type OpenVpnCryptoPolicy struct {
+	TlsVersionMin  string   // OpenVPN 'tls-version-min' (e.g. "1.2")
+	DataCiphers    []string // OpenVPN 'data-ciphers' in order of preference (e.g. ["AES-256-GCM", "CHACHA20-POLY1305"])
+	TlsCertProfile string   // OpenVPN 'tls-cert-profile' (e.g. "preferred")
+}
+
+func (p OpenVpnCryptoPolicy) IsDefault() bool {
+	return len(p.TlsVersionMin) == 0 && len(p.DataCiphers) == 0 && len(p.TlsCertProfile) == 0
+}
+
+func (p OpenVpnCryptoPolicy) Validate() error {
+	isAllowed := func(allowed []string, v string) bool {
+		for _, a := range allowed {
+			if a == v {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(p.TlsVersionMin) > 0 && !isAllowed(OpenVpnTlsVersions, p.TlsVersionMin) {
+		return fmt.Errorf("unsupported TLS version '%s' (allowed values: %s)", p.TlsVersionMin, strings.Join(OpenVpnTlsVersions, ", "))
+	}
+	for i, c := range p.DataCiphers {
+		if !isAllowed(OpenVpnDataCiphers, c) {
+			return fmt.Errorf("unsupported data cipher '%s' (allowed values: %s)", c, strings.Join(OpenVpnDataCiphers, ", "))
+		}
+		if isAllowed(p.DataCiphers[:i], c) {
+			return fmt.Errorf("duplicate data cipher '%s'", c)
+		}
+	}
+	if len(p.TlsCertProfile) > 0 && !isAllowed(OpenVpnTlsCertProfiles, p.TlsCertProfile) {
+		return fmt.Errorf("unsupported TLS certificate profile '%s' (allowed values: %s)", p.TlsCertProfile, strings.Join(OpenVpnTlsCertProfiles, ", "))
+	}
+	return nil
+}
+
+func (p OpenVpnCryptoPolicy) String() string {
+	if p.IsDefault() {
+		return "default"
+	}
+	return fmt.Sprintf("tls-version-min: '%s'; data-ciphers: '%s'; tls-cert-profile: '%s'", p.TlsVersionMin, strings.Join(p.DataCiphers, ":"), p.TlsCertProfile)
+}
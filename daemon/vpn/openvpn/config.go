@@ -34,6 +34,7 @@ import (
 	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/netinfo"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
+	"github.com/ivpn/desktop-app/daemon/vpn"
 )
 
 // ConnectionParams represents OpenVPN connection parameters
@@ -49,8 +50,19 @@ type ConnectionParams struct {
 	proxyPort            int
 	proxyUsername        string
 	proxyPassword        string
-	proxyAuthFileData    string // required for for obfs4 socks(!) proxy `--socks-proxy server [port] [authfile]`. If this parameter is defined - `proxyUsername` and `proxyPassword`` will be ignored.
+	// IPv6 inside tunnel: the IPv6 configuration pushed by the server is accepted and IPv6 traffic is routed to the tunnel
+	isIPv6InTunnel bool
+	// If true - the data channel offload (DCO) is in use when it is available
+	isDcoAllowed bool
+	// TLS/cipher policy (not applicable for the user-defined configuration)
+	cryptoPolicy      vpn.OpenVpnCryptoPolicy
+	proxyAuthFileData string // required for for obfs4 socks(!) proxy `--socks-proxy server [port] [authfile]`. If this parameter is defined - `proxyUsername` and `proxyPassword`` will be ignored.
 	// (e.g. the obfs4 requires the key to be stored in 'authfile': `cert=E50PjFC...6R7jzP0gYQ;iat-mode=0`)
+
+	// user-defined configuration (see ParseCustomConfig())
+	isCustomConfig       bool
+	isCustomAuthRequired bool
+	customConfig         []string // sanitized directives and inline blocks
 }
 
 func (c *ConnectionParams) IsMultihop() bool {
@@ -65,6 +77,22 @@ func (c *ConnectionParams) GetHostIp() net.IP {
 	return c.hostIP
 }
 
+// SetIPv6InTunnel enables/disables IPv6 inside tunnel
+func (c *ConnectionParams) SetIPv6InTunnel(enable bool) {
+	c.isIPv6InTunnel = enable
+}
+
+// SetDcoAllowed allows/disallows the data channel offload (DCO) for the connection.
+// DCO is in use only when it is supported by the platform and compatible with the connection parameters.
+func (c *ConnectionParams) SetDcoAllowed(allow bool) {
+	c.isDcoAllowed = allow
+}
+
+// SetCryptoPolicy sets the TLS/cipher policy for the connection (not applicable for the user-defined configuration)
+func (c *ConnectionParams) SetCryptoPolicy(policy vpn.OpenVpnCryptoPolicy) {
+	c.cryptoPolicy = policy
+}
+
 // SetCredentials update WG credentials
 func (c *ConnectionParams) SetCredentials(username, password string) {
 	c.password = password
@@ -104,9 +132,10 @@ func (c *ConnectionParams) WriteConfigFile(
 	logFile string,
 	extraParameters string,
 	isCanUseV24Params bool,
+	dco dcoSupport,
 	upDownScriptArgs string) error {
 
-	cfg, err := c.generateConfiguration(localPort, miAddr, miPort, logFile, extraParameters, isCanUseV24Params, upDownScriptArgs)
+	cfg, err := c.generateConfiguration(localPort, miAddr, miPort, logFile, extraParameters, isCanUseV24Params, dco, upDownScriptArgs)
 	if err != nil {
 		return fmt.Errorf("failed to generate openvpn configuration : %w", err)
 	}
@@ -120,7 +149,7 @@ func (c *ConnectionParams) WriteConfigFile(
 
 	log.Info("Configuring OpenVPN...\n",
 		"=====================\n",
-		configText,
+		hideInlineSecrets(cfg),
 		"\n=====================\n")
 
 	return nil
@@ -133,37 +162,49 @@ func (c *ConnectionParams) generateConfiguration(
 	logFile string,
 	extraParameters string,
 	isCanUseV24Params bool,
+	dco dcoSupport,
 	upDownScriptArgs string) (cfg []string, err error) {
 
 	cfg = make([]string, 0, 32)
 
+	// Data channel offload (DCO) is not compatible with compression, non-AEAD ciphers and proxies.
+	// The user-defined configuration is passed as is: OpenVPN disables DCO itself when it is not compatible.
+	isProxy := c.proxyType == "http" || c.proxyType == "socks"
+	isDco := dco == dcoAvailable && c.isDcoAllowed && !c.isCustomConfig && !isProxy
+
 	cfg = append(cfg, "client")
 	cfg = append(cfg, fmt.Sprintf("management %s %d", miAddr, miPort))
 	cfg = append(cfg, "management-client")
 
 	cfg = append(cfg, "management-hold")
-	cfg = append(cfg, "auth-user-pass")
-	cfg = append(cfg, "auth-nocache")
+	if !c.isCustomConfig || c.isCustomAuthRequired {
+		cfg = append(cfg, "auth-user-pass")
+		cfg = append(cfg, "auth-nocache")
 
-	cfg = append(cfg, "management-query-passwords")
+		cfg = append(cfg, "management-query-passwords")
+	}
 
 	cfg = append(cfg, "management-signal")
 
-	// Handshake Window --the TLS - based key exchange must finalize within n seconds of handshake initiation by any peer(default = 60 seconds).
-	// If the handshake fails openvpn will attempt to reset our connection with our peer and try again.
-	cfg = append(cfg, "hand-window 6")
+	if !c.isCustomConfig {
+		// Handshake Window --the TLS - based key exchange must finalize within n seconds of handshake initiation by any peer(default = 60 seconds).
+		// If the handshake fails openvpn will attempt to reset our connection with our peer and try again.
+		cfg = append(cfg, "hand-window 6")
 
-	if isCanUseV24Params {
-		cfg = append(cfg, "compress")
-		cfg = append(cfg, "pull-filter ignore \"ping\"")
-	} else {
-		cfg = append(cfg, "comp-lzo no")
-	}
+		if isCanUseV24Params {
+			if !isDco {
+				cfg = append(cfg, "compress")
+			}
+			cfg = append(cfg, "pull-filter ignore \"ping\"")
+		} else {
+			cfg = append(cfg, "comp-lzo no")
+		}
 
-	// To change default connection-check time:
-	// 	pull-filter ignore "ping"
-	//	keepalive 8 30
-	cfg = append(cfg, "keepalive 8 30")
+		// To change default connection-check time:
+		// 	pull-filter ignore "ping"
+		//	keepalive 8 30
+		cfg = append(cfg, "keepalive 8 30")
+	}
 
 	// proxy
 	if c.proxyType == "http" || c.proxyType == "socks" {
@@ -202,15 +243,27 @@ func (c *ConnectionParams) generateConfiguration(
 
 	cfg = append(cfg, "dev tun")
 
-	if c.tcp {
+	if c.hostIP.IsUnspecified() {
+		return nil, errors.New("unable to connect. Host IP not defined")
+	}
+
+	// IPv6 transport (the server is reachable by IPv6 address)
+	isIPv6Transport := c.hostIP.To4() == nil
+	if isIPv6Transport && (c.proxyType == "http" || c.proxyType == "socks") {
+		return nil, errors.New("unable to connect. Proxy is not supported for the IPv6 address of the server")
+	}
+
+	switch {
+	case c.tcp && isIPv6Transport:
+		cfg = append(cfg, "proto tcp6")
+	case c.tcp:
 		cfg = append(cfg, "proto tcp")
-	} else {
+	case isIPv6Transport:
+		cfg = append(cfg, "proto udp6")
+	default:
 		cfg = append(cfg, "proto udp")
 	}
 
-	if c.hostIP.IsUnspecified() {
-		return nil, errors.New("unable to connect. Host IP not defined")
-	}
 	if c.hostPort < 0 || c.hostPort > 65535 {
 		return nil, errors.New("unable to connect. Invalid port")
 	}
@@ -227,18 +280,76 @@ func (c *ConnectionParams) generateConfiguration(
 	}
 	cfg = append(cfg, "persist-key")
 
-	if _, err := os.Stat(platform.OpenvpnCaKeyFile()); os.IsNotExist(err) {
-		return nil, errors.New("CA certificate not found")
-	}
-	cfg = append(cfg, fmt.Sprintf("ca \"%s\"", platform.OpenvpnCaKeyFile()))
+	if c.isCustomConfig {
+		// user-defined configuration: keys, certificates and crypto parameters
+		cfg = append(cfg, c.customConfig...)
+		// all traffic goes through the tunnel (even if the server does not push the route)
+		cfg = append(cfg, "redirect-gateway def1")
+	} else {
+		if _, err := os.Stat(platform.OpenvpnCaKeyFile()); os.IsNotExist(err) {
+			return nil, errors.New("CA certificate not found")
+		}
+		cfg = append(cfg, fmt.Sprintf("ca \"%s\"", platform.OpenvpnCaKeyFile()))
 
-	if _, err := os.Stat(platform.OpenvpnTaKeyFile()); os.IsNotExist(err) {
-		return nil, errors.New("TLS auth key not found")
+		if _, err := os.Stat(platform.OpenvpnTaKeyFile()); os.IsNotExist(err) {
+			return nil, errors.New("TLS auth key not found")
+		}
+		cfg = append(cfg, fmt.Sprintf("tls-auth \"%s\" 1", platform.OpenvpnTaKeyFile()))
+
+		switch {
+		case len(c.cryptoPolicy.DataCiphers) > 0:
+			// user-defined list of ciphers (all of them are AEAD ciphers, so DCO can be used)
+			ciphers := strings.Join(c.cryptoPolicy.DataCiphers, ":")
+			if dco != dcoNotSupported {
+				cfg = append(cfg, "data-ciphers "+ciphers)
+			} else {
+				// OpenVPN < 2.6 (or unknown version): 'ncp-ciphers' is supported by all versions since 2.4
+				// ('cipher' must be in the list, otherwise it would be added to the list of negotiable ciphers)
+				cfg = append(cfg, "ncp-ciphers "+ciphers)
+				cfg = append(cfg, "cipher "+c.cryptoPolicy.DataCiphers[0])
+			}
+		case isDco:
+			// only AEAD ciphers are supported by DCO ('cipher' would be added to the list of negotiable ciphers)
+			cfg = append(cfg, "data-ciphers AES-256-GCM:AES-128-GCM:CHACHA20-POLY1305")
+			cfg = append(cfg, "data-ciphers-fallback AES-256-CBC")
+		default:
+			cfg = append(cfg, "cipher AES-256-CBC")
+		}
+		if len(c.cryptoPolicy.TlsVersionMin) > 0 {
+			cfg = append(cfg, "tls-version-min "+c.cryptoPolicy.TlsVersionMin)
+		}
+		if len(c.cryptoPolicy.TlsCertProfile) > 0 {
+			if isCanUseV24Params {
+				cfg = append(cfg, "tls-cert-profile "+c.cryptoPolicy.TlsCertProfile)
+			} else {
+				log.Info("TLS certificate profile is not supported by the OpenVPN version")
+			}
+		}
+		cfg = append(cfg, "remote-cert-tls server")
+
+		// IPv6 inside tunnel
+		if isCanUseV24Params {
+			if c.isIPv6InTunnel {
+				// route IPv4 and IPv6 traffic to the tunnel
+				// (OpenVPN adds the route to the server outside the tunnel for both IPv4 and IPv6 server addresses)
+				cfg = append(cfg, "pull-filter ignore \"redirect-gateway\"")
+				cfg = append(cfg, "redirect-gateway def1 ipv6")
+			} else {
+				// do not apply IPv6 configuration pushed by the server
+				cfg = append(cfg, "pull-filter ignore \"ifconfig-ipv6\"")
+				cfg = append(cfg, "pull-filter ignore \"route-ipv6\"")
+			}
+		} else if c.isIPv6InTunnel {
+			log.Info("IPv6 inside tunnel is not supported by the OpenVPN version")
+		}
+	}
+	if isDco {
+		log.Info("Data channel offload (DCO) is in use")
+	} else if dco == dcoAvailable && (!c.isCustomConfig || !c.isDcoAllowed) {
+		// OpenVPN 2.6+ uses DCO by default (when it is available)
+		cfg = append(cfg, "disable-dco")
 	}
-	cfg = append(cfg, fmt.Sprintf("tls-auth \"%s\" 1", platform.OpenvpnTaKeyFile()))
 
-	cfg = append(cfg, "cipher AES-256-CBC")
-	cfg = append(cfg, "remote-cert-tls server")
 	cfg = append(cfg, "verb 4")
 
 	if upCmd := platform.OpenvpnUpScript(); upCmd != "" {
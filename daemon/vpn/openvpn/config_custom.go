@@ -0,0 +1,277 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package openvpn
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// Directives of the user-defined OpenVPN configuration which are passed to OpenVPN as is.
+// The OpenVPN process runs with the privileges of the daemon, therefore everything which can execute
+// external commands, load plugins, read/write files or break the leak protection is not allowed.
+var customConfigAllowedDirectives = map[string]struct{}{
+	"allow-compression": {}, "auth": {}, "auth-nocache": {}, "block-outside-dns": {}, "cipher": {},
+	"comp-lzo": {}, "compress": {}, "connect-retry": {}, "data-ciphers": {}, "data-ciphers-fallback": {},
+	"explicit-exit-notify": {}, "float": {}, "fragment": {}, "hand-window": {}, "keepalive": {},
+	"key-direction": {}, "mssfix": {}, "mute": {}, "mute-replay-warnings": {}, "ncp-ciphers": {},
+	"ns-cert-type": {}, "persist-tun": {}, "ping": {}, "ping-restart": {},
+	"pull": {}, "rcvbuf": {}, "remote-cert-tls": {}, "reneg-sec": {}, "server-poll-timeout": {},
+	"sndbuf": {}, "tls-cipher": {}, "tls-ciphersuites": {}, "tls-client": {}, "tls-groups": {},
+	"tls-version-max": {}, "tls-version-min": {}, "topology": {}, "tun-mtu": {},
+	"verify-x509-name": {},
+}
+
+// Directives of the user-defined OpenVPN configuration which are ignored: they are defined by the daemon
+var customConfigIgnoredDirectives = map[string]struct{}{
+	"client": {}, "dev": {}, "nobind": {}, "persist-key": {}, "resolv-retry": {}, "redirect-gateway": {}, "remote-random": {}, "verb": {},
+}
+
+// Inline blocks (e.g. "<ca> ... </ca>") of the user-defined OpenVPN configuration.
+// The keys and certificates must be defined inline (references to the files are not allowed)
+var customConfigAllowedInlineBlocks = map[string]struct{}{
+	"ca": {}, "cert": {}, "key": {}, "tls-auth": {}, "tls-crypt": {}, "tls-crypt-v2": {}, "extra-certs": {},
+}
+
+// ParseCustomConfig parses the user-defined OpenVPN configuration (.ovpn) and returns the connection parameters
+// ("bring your own config").
+// The first 'remote' is in use (the host name is resolved by the daemon). The user credentials have to be defined
+// by SetCredentials() when the configuration contains 'auth-user-pass' directive (see IsCustomAuthRequired()).
+func ParseCustomConfig(configText string) (ConnectionParams, error) {
+	ret := ConnectionParams{isCustomConfig: true}
+
+	var (
+		remoteHost  string
+		remotePort  = 1194
+		remoteProto string
+		proto       string
+		blockName   string
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(configText))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+
+		// inline block content
+		if len(blockName) > 0 {
+			if strings.EqualFold(line, "</"+blockName+">") {
+				ret.customConfig = append(ret.customConfig, line)
+				blockName = ""
+				continue
+			}
+			if strings.HasPrefix(line, "<") {
+				return ConnectionParams{}, fmt.Errorf("line %d: unexpected tag inside <%s> block", lineNo, blockName)
+			}
+			ret.customConfig = append(ret.customConfig, line)
+			continue
+		}
+
+		if len(line) == 0 || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		// inline block start
+		if strings.HasPrefix(line, "<") {
+			if !strings.HasSuffix(line, ">") || strings.HasPrefix(line, "</") {
+				return ConnectionParams{}, fmt.Errorf("line %d: bad format", lineNo)
+			}
+			name := strings.ToLower(line[1 : len(line)-1])
+			if _, ok := customConfigAllowedInlineBlocks[name]; !ok {
+				return ConnectionParams{}, fmt.Errorf("line %d: inline block <%s> is not allowed", lineNo, name)
+			}
+			blockName = name
+			ret.customConfig = append(ret.customConfig, "<"+name+">")
+			continue
+		}
+
+		fields := strings.Fields(line)
+		directive := strings.ToLower(strings.TrimPrefix(fields[0], "--"))
+		args := fields[1:]
+
+		switch directive {
+		case "remote":
+			if len(remoteHost) > 0 {
+				continue // only the first remote is in use
+			}
+			if len(args) < 1 {
+				return ConnectionParams{}, fmt.Errorf("line %d: remote host not defined", lineNo)
+			}
+			remoteHost = args[0]
+			if len(args) > 1 {
+				p, err := strconv.Atoi(args[1])
+				if err != nil || p <= 0 || p > 65535 {
+					return ConnectionParams{}, fmt.Errorf("line %d: bad remote port", lineNo)
+				}
+				remotePort = p
+			}
+			if len(args) > 2 {
+				remoteProto = strings.ToLower(args[2])
+			}
+			continue
+		case "port":
+			if len(args) != 1 {
+				return ConnectionParams{}, fmt.Errorf("line %d: bad port", lineNo)
+			}
+			p, err := strconv.Atoi(args[0])
+			if err != nil || p <= 0 || p > 65535 {
+				return ConnectionParams{}, fmt.Errorf("line %d: bad port", lineNo)
+			}
+			remotePort = p
+			continue
+		case "proto":
+			if len(args) != 1 {
+				return ConnectionParams{}, fmt.Errorf("line %d: bad proto", lineNo)
+			}
+			proto = strings.ToLower(args[0])
+			continue
+		case "auth-user-pass":
+			if len(args) > 0 {
+				return ConnectionParams{}, fmt.Errorf("line %d: 'auth-user-pass' with credentials file is not allowed", lineNo)
+			}
+			ret.isCustomAuthRequired = true
+			continue
+		case "dev":
+			if len(args) > 0 && !strings.HasPrefix(strings.ToLower(args[0]), "tun") {
+				return ConnectionParams{}, fmt.Errorf("line %d: only 'tun' devices are supported", lineNo)
+			}
+			continue
+		}
+
+		if _, ok := customConfigIgnoredDirectives[directive]; ok {
+			continue
+		}
+		if _, ok := customConfigAllowedInlineBlocks[directive]; ok {
+			return ConnectionParams{}, fmt.Errorf("line %d: '%s' must be defined inline (<%s> ... </%s>)", lineNo, directive, directive, directive)
+		}
+		if _, ok := customConfigAllowedDirectives[directive]; !ok {
+			return ConnectionParams{}, fmt.Errorf("line %d: directive '%s' is not allowed", lineNo, directive)
+		}
+		ret.customConfig = append(ret.customConfig, directive+" "+strings.Join(args, " "))
+	}
+	if err := scanner.Err(); err != nil {
+		return ConnectionParams{}, err
+	}
+	if len(blockName) > 0 {
+		return ConnectionParams{}, fmt.Errorf("inline block <%s> is not closed", blockName)
+	}
+
+	// protocol
+	if len(remoteProto) > 0 {
+		proto = remoteProto
+	}
+	// address family of the remote host (0 - any; 4 - IPv4 only; 6 - IPv6 only)
+	family := 0
+	switch proto {
+	case "", "udp":
+	case "udp4":
+		family = 4
+	case "udp6":
+		family = 6
+	case "tcp", "tcp-client":
+		ret.tcp = true
+	case "tcp4", "tcp4-client":
+		ret.tcp, family = true, 4
+	case "tcp6", "tcp6-client":
+		ret.tcp, family = true, 6
+	default:
+		return ConnectionParams{}, fmt.Errorf("unsupported protocol '%s'", proto)
+	}
+
+	// remote host
+	if len(remoteHost) == 0 {
+		return ConnectionParams{}, errors.New("remote host not defined")
+	}
+	hostIP := net.ParseIP(remoteHost)
+	if hostIP == nil {
+		ips, err := net.LookupIP(remoteHost)
+		if err != nil {
+			return ConnectionParams{}, fmt.Errorf("unable to resolve remote host '%s': %w", remoteHost, err)
+		}
+		// IPv4 address is preferred (if the protocol does not require IPv6)
+		for _, ip := range ips {
+			isIPv4 := ip.To4() != nil
+			if (isIPv4 && family == 6) || (!isIPv4 && family == 4) {
+				continue
+			}
+			if isIPv4 {
+				hostIP = ip
+				break
+			}
+			if hostIP == nil {
+				hostIP = ip
+			}
+		}
+	}
+	if hostIP == nil {
+		return ConnectionParams{}, fmt.Errorf("address of the remote host '%s' not defined", remoteHost)
+	}
+	if ip4 := hostIP.To4(); ip4 != nil {
+		if family == 6 {
+			return ConnectionParams{}, fmt.Errorf("IPv6 address of the remote host '%s' not defined", remoteHost)
+		}
+		hostIP = ip4
+	} else if family == 4 {
+		return ConnectionParams{}, fmt.Errorf("IPv4 address of the remote host '%s' not defined", remoteHost)
+	}
+	ret.hostIP = hostIP
+	ret.hostPort = remotePort
+
+	return ret, nil
+}
+
+// IsCustomConfig returns 'true' when the parameters are loaded from the user-defined configuration
+func (c *ConnectionParams) IsCustomConfig() bool {
+	return c.isCustomConfig
+}
+
+// IsCustomAuthRequired returns 'true' when the user-defined configuration requires user credentials
+func (c *ConnectionParams) IsCustomAuthRequired() bool {
+	return c.isCustomAuthRequired
+}
+
+// hideInlineSecrets returns the configuration text where the content of the inline private keys is hidden
+// (to be able to write it into the log)
+func hideInlineSecrets(cfg []string) string {
+	secretBlocks := map[string]struct{}{"<key>": {}, "<tls-auth>": {}, "<tls-crypt>": {}, "<tls-crypt-v2>": {}}
+
+	var ret strings.Builder
+	isSecret := false
+	for _, line := range cfg {
+		if isSecret {
+			if !strings.HasPrefix(line, "</") {
+				continue
+			}
+			ret.WriteString("***\n")
+			isSecret = false
+		}
+		if _, ok := secretBlocks[line]; ok {
+			isSecret = true
+		}
+		ret.WriteString(line + "\n")
+	}
+	return strings.TrimSuffix(ret.String(), "\n")
+}
@@ -0,0 +1,52 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package openvpn
+
+// dcoSupport - the support of the data channel offload (DCO): the data channel encryption/decryption
+// is performed by the kernel module (Linux: ovpn-dco) or driver (Windows: ovpn-dco-win) instead of the OpenVPN process.
+type dcoSupport int
+
+const (
+	// the OpenVPN binary does not support DCO (OpenVPN version < 2.6)
+	dcoNotSupported dcoSupport = iota
+	// the OpenVPN binary supports DCO but the kernel module (driver) is not available
+	dcoNotAvailable
+	// DCO can be used
+	dcoAvailable
+)
+
+// DCO-capable OpenVPN version
+var dcoMinVersion = []int{2, 6}
+
+// isVersionDcoCapable returns true when the OpenVPN version (see GetOpenVPNVersion()) supports DCO
+func isVersionDcoCapable(verNums []int) bool {
+	for i := range dcoMinVersion {
+		if len(verNums) <= i {
+			return false
+		}
+		if verNums[i] != dcoMinVersion[i] {
+			return verNums[i] > dcoMinVersion[i]
+		}
+	}
+	return true
+}
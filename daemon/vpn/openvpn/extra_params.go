@@ -0,0 +1,69 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package openvpn
+
+import (
+	"bufio"
+	"fmt"
+	"strings"
+)
+
+// MaxExtraParametersLength - max length of the user-defined extra OpenVPN parameters
+const MaxExtraParametersLength = 4096
+
+// ValidateExtraParameters checks the user-defined extra OpenVPN parameters (one directive per line)
+// and returns them in normalized form: comments and empty lines removed, the leading "--" removed,
+// directive names in lower case.
+// Only the directives which are allowed for the user-defined configurations are accepted
+// (see customConfigAllowedDirectives): everything that can execute external commands, load plugins
+// or read/write files (e.g. 'script-security', 'up', 'down', 'plugin') is rejected.
+func ValidateExtraParameters(params string) (string, error) {
+	if len(params) > MaxExtraParametersLength {
+		return "", fmt.Errorf("parameters are too long (max %d characters)", MaxExtraParametersLength)
+	}
+
+	var ret []string
+	scanner := bufio.NewScanner(strings.NewReader(params))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+		if strings.HasPrefix(line, "<") {
+			return "", fmt.Errorf("line %d: inline blocks are not allowed", lineNo)
+		}
+
+		fields := strings.Fields(line)
+		directive := strings.ToLower(strings.TrimPrefix(fields[0], "--"))
+		if _, ok := customConfigAllowedDirectives[directive]; !ok {
+			return "", fmt.Errorf("line %d: directive '%s' is not allowed", lineNo, directive)
+		}
+
+		ret = append(ret, strings.Join(append([]string{directive}, fields[1:]...), " "))
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+
+	return strings.Join(ret, "\n"), nil
+}
@@ -60,8 +60,18 @@ type ManagementInterface struct {
 
 	pushReplyCmds []string
 	pushReplyDNS  net.IP
+
+	// traffic statistics received from OpenVPN ('>BYTECOUNT' notifications)
+	statsMutex          sync.Mutex
+	isBytecountReceived bool
+	bytesIn, bytesOut   uint64 // the values received in the last notification
+	bytesInBase         uint64 // the counters are reset by OpenVPN on restart (e.g. reconnection);
+	bytesOutBase        uint64 // the base values keep the totals of the previous OpenVPN sessions
 }
 
+// bytecountIntervalSec - interval (seconds) of the '>BYTECOUNT' notifications from OpenVPN
+const bytecountIntervalSec = 5
+
 // StartManagementInterface - starts TCP interface to communicate with IVPN application (server to listen incoming connections)
 func StartManagementInterface(miSecret string, username string, password string, stateChan chan<- vpn.StateInfo) (mi *ManagementInterface, err error) {
 	ret := &ManagementInterface{
@@ -140,6 +150,18 @@ func (i *ManagementInterface) SendDisconnect() error {
 	return i.sendResponse("signal SIGTERM")
 }
 
+// TrafficStats returns the number of bytes received/sent through the tunnel (reported by OpenVPN)
+// ('ok' is false when no statistics received from OpenVPN yet)
+func (i *ManagementInterface) TrafficStats() (rx, tx uint64, ok bool) {
+	i.statsMutex.Lock()
+	defer i.statsMutex.Unlock()
+
+	if !i.isBytecountReceived {
+		return 0, 0, false
+	}
+	return i.bytesInBase + i.bytesIn, i.bytesOutBase + i.bytesOut, true
+}
+
 // GetRouteAddCommands - return all detected route-add command
 func (i *ManagementInterface) GetRouteAddCommands() []string {
 	i.routeAddCmdsMutex.Lock()
@@ -176,6 +198,7 @@ func (i *ManagementInterface) start() error {
 			//erase connection properties
 			i.pushReplyDNS = nil
 			i.pushReplyCmds = make([]string, 0)
+			i.resetTrafficStats()
 
 			i.listener.Close()
 			i.log.Info("OpenVPN MI stopped")
@@ -318,7 +341,23 @@ func (i *ManagementInterface) miCommunication() {
 			break
 
 		case "HOLD":
-			i.sendResponse("state on", "log on", "hold off", "hold release")
+			i.sendResponse("state on", "log on", fmt.Sprintf("bytecount %d", bytecountIntervalSec), "hold off", "hold release")
+			break
+
+		case "BYTECOUNT":
+			// >BYTECOUNT:{BYTES_IN},{BYTES_OUT}
+			cols := strings.Split(strings.TrimSpace(msgText), ",")
+			if len(cols) != 2 {
+				i.log.Error("BYTECOUNT format error.")
+				continue
+			}
+			bytesIn, errIn := strconv.ParseUint(cols[0], 10, 64)
+			bytesOut, errOut := strconv.ParseUint(cols[1], 10, 64)
+			if errIn != nil || errOut != nil {
+				i.log.Error("BYTECOUNT format error.")
+				continue
+			}
+			i.onBytecount(bytesIn, bytesOut)
 			break
 
 		case "PASSWORD":
@@ -353,7 +392,9 @@ func (i *ManagementInterface) miCommunication() {
 			//      and EXITING to show the reason for the disconnect),
 			//  (d) optional TUN/TAP local IP address (shown for ASSIGN_IP
 			//      and CONNECTED), and
-			//  (e) optional address of remote server (OpenVPN 2.1 or higher).
+			//  (e) optional address of remote server (OpenVPN 2.1 or higher),
+			//  (f) optional port of remote server, (g) optional local address, (h) optional local port,
+			//  (i) optional TUN/TAP local IPv6 address (OpenVPN 2.4 or higher).
 			params := strings.Split(msgText, ",")
 			if len(params) < 2 {
 				i.log.Error("STATE format error.")
@@ -368,7 +409,9 @@ func (i *ManagementInterface) miCommunication() {
 				i.log.Info("State changed:", state)
 
 				var clientIP net.IP
+				var clientIPv6 net.IP
 				var serverIP net.IP
+				var serverPort int
 				var isAuthError bool
 				var additionalInfo string
 
@@ -380,6 +423,12 @@ func (i *ManagementInterface) miCommunication() {
 					if len(params) > 4 {
 						serverIP = net.ParseIP(strings.TrimSpace(params[4]))
 					}
+					if len(params) > 5 {
+						serverPort, _ = strconv.Atoi(strings.TrimSpace(params[5]))
+					}
+					if len(params) > 8 {
+						clientIPv6 = net.ParseIP(strings.TrimSpace(params[8]))
+					}
 
 				} else if state == vpn.EXITING {
 					//>STATE:1563526742,EXITING,auth-failure,,,,,
@@ -402,7 +451,9 @@ func (i *ManagementInterface) miCommunication() {
 					State:               state,
 					Description:         msgText,
 					ClientIP:            clientIP,
+					ClientIPv6:          clientIPv6,
 					ServerIP:            serverIP,
+					ServerPort:          serverPort,
 					IsAuthError:         isAuthError,
 					StateAdditionalInfo: additionalInfo,
 					IsCanPause:          len(i.GetRouteAddCommands()) > 0}
@@ -420,6 +471,27 @@ func (i *ManagementInterface) miCommunication() {
 
 	}
 }
+func (i *ManagementInterface) onBytecount(bytesIn, bytesOut uint64) {
+	i.statsMutex.Lock()
+	defer i.statsMutex.Unlock()
+
+	// counters are lower than the previous values: OpenVPN was restarted (e.g. reconnection)
+	if bytesIn < i.bytesIn || bytesOut < i.bytesOut {
+		i.bytesInBase += i.bytesIn
+		i.bytesOutBase += i.bytesOut
+	}
+	i.bytesIn, i.bytesOut = bytesIn, bytesOut
+	i.isBytecountReceived = true
+}
+
+func (i *ManagementInterface) resetTrafficStats() {
+	i.statsMutex.Lock()
+	defer i.statsMutex.Unlock()
+
+	i.isBytecountReceived = false
+	i.bytesIn, i.bytesOut, i.bytesInBase, i.bytesOutBase = 0, 0, 0, 0
+}
+
 func (i *ManagementInterface) onPushReplyCommands(cmds []string) {
 	// LOG:1586341059,,PUSH: Received control message: 'PUSH_REPLY,redirect-gateway def1,explicit-exit-notify 3,comp-lzo no,route-gateway 10.34.44.1,topology subnet,ping 10,ping-restart 60,dhcp-option DNS 10.34.44.1,ifconfig 10.34.44.19 255.255.252.0,peer-id 17,cipher AES-256-GCM'
 	var dns net.IP = nil
@@ -23,6 +23,7 @@
 package openvpn
 
 import (
+	"context"
 	"crypto/rand"
 	"encoding/binary"
 	"errors"
@@ -114,11 +115,15 @@ type OpenVPN struct {
 
 	managementInterface *ManagementInterface
 	obfsproxy           *obfsproxy.Obfsproxy
+	// local proxy transport (nil - not in use): OpenVPN connects through local SOCKS proxy (e.g. V2Ray or Shadowsocks)
+	localProxy vpn.LocalProxy
 
 	// current VPN state
-	state     vpn.State
-	clientIP  net.IP // applicable only for 'CONNECTED' state
-	localPort int
+	state    vpn.State
+	clientIP net.IP // applicable only for 'CONNECTED' state
+	// IPv6 address inside tunnel: applicable only for 'CONNECTED' state (nil - IPv6 is not tunneled)
+	clientIPv6 net.IP
+	localPort  int
 
 	// platform-specific properties (for macOS, Windows etc. ...)
 	psProps platformSpecificProperties
@@ -140,11 +145,14 @@ func NewOpenVpnObject(
 	configPath string,
 	logFile string,
 	obfsoroxy ObfsParams,
+	localProxy vpn.LocalProxy,
 	extraParameters string,
 	connectionParams ConnectionParams) (*OpenVPN, error) {
 
-	if len(connectionParams.username) == 0 || len(connectionParams.password) == 0 {
-		return nil, fmt.Errorf("OpenVPN user credentials not defined")
+	if !connectionParams.isCustomConfig || connectionParams.isCustomAuthRequired {
+		if len(connectionParams.username) == 0 || len(connectionParams.password) == 0 {
+			return nil, fmt.Errorf("OpenVPN user credentials not defined")
+		}
 	}
 
 	return &OpenVPN{
@@ -153,6 +161,7 @@ func NewOpenVpnObject(
 			configPath:      configPath,
 			logFile:         logFile,
 			obfsProxyParams: obfsoroxy,
+			localProxy:      localProxy,
 			extraParameters: extraParameters,
 			connectParams:   connectionParams},
 		nil
@@ -161,6 +170,9 @@ func NewOpenVpnObject(
 // DestinationIP -  Get destination IPs (VPN host server or proxy server IP address)
 // This information if required, for example, to allow this address in firewall
 func (o *OpenVPN) DestinationIP() net.IP {
+	if o.localProxy != nil {
+		return o.localProxy.RemoteIP()
+	}
 	if o.connectParams.proxyAddress != nil {
 		return o.connectParams.proxyAddress
 	}
@@ -197,7 +209,7 @@ func (o *OpenVPN) Connect(stateChan chan<- vpn.StateInfo) (retErr error) {
 	// channel will be analyzed for state change. States will be forwarded to channel above ( to 'stateChan')
 	internalStateChan := make(chan vpn.StateInfo, 1)
 
-	// EXIT: stopping everything: Management interface, Obfsproxy
+	// EXIT: stopping everything: Management interface, Obfsproxy, local proxy
 	defer func() {
 
 		if retErr != nil {
@@ -221,6 +233,10 @@ func (o *OpenVPN) Connect(stateChan chan<- vpn.StateInfo) (retErr error) {
 
 		o.obfsproxy = nil
 
+		if o.localProxy != nil {
+			o.localProxy.Stop()
+		}
+
 		if err := o.implOnDisconnected(); err != nil {
 			log.Error(err)
 		}
@@ -247,11 +263,20 @@ func (o *OpenVPN) Connect(stateChan chan<- vpn.StateInfo) (retErr error) {
 
 					// save source and destination port
 					stateInf.ClientPort = o.localPort
-					stateInf.ServerPort = o.connectParams.hostPort
+					if stateInf.ServerPort == 0 || o.obfsproxy != nil || o.localProxy != nil {
+						// the remote port reported by OpenVPN is the local port of the proxy (when the proxy is in use)
+						stateInf.ServerPort = o.connectParams.hostPort
+					}
 					stateInf.IsTCP = o.connectParams.tcp
+					stateInf.IsCustomConfig = o.connectParams.isCustomConfig
 
 					// notify about correct local IP in VPN network
 					o.clientIP = stateInf.ClientIP
+					if !o.connectParams.isIPv6InTunnel && !o.connectParams.isCustomConfig {
+						// IPv6 inside tunnel is not requested (the IPv6 configuration pushed by the server is ignored)
+						stateInf.ClientIPv6 = nil
+					}
+					o.clientIPv6 = stateInf.ClientIPv6
 
 					if o.obfsproxy != nil {
 						// in case of obfsproxy - 'stateInf.ServerIP' returns local IP (IP of obfsproxy 127.0.0.1)
@@ -259,6 +284,11 @@ func (o *OpenVPN) Connect(stateChan chan<- vpn.StateInfo) (retErr error) {
 						stateInf.ServerIP = o.connectParams.hostIP
 						stateInf.Obfsproxy = o.obfsproxy.Config()
 					}
+					if o.localProxy != nil {
+						// in case of local proxy - 'stateInf.ServerIP' returns local IP (IP of SOCKS proxy 127.0.0.1)
+						stateInf.ServerIP = o.connectParams.hostIP
+						stateInf.SetLocalProxyInfo(o.localProxy)
+					}
 
 					// Process "on connected" event (if necessary)
 					// E.g. set custom DNS configuration on Windows
@@ -269,6 +299,7 @@ func (o *OpenVPN) Connect(stateChan chan<- vpn.StateInfo) (retErr error) {
 					}
 				} else {
 					o.clientIP = nil
+					o.clientIPv6 = nil
 				}
 
 				// forward state
@@ -330,6 +361,40 @@ func (o *OpenVPN) Connect(stateChan chan<- vpn.StateInfo) (retErr error) {
 		}()
 	}
 
+	// start local proxy: V2Ray, Shadowsocks (if necessary)
+	if proxy := o.localProxy; proxy != nil {
+		if o.obfsProxyParams.Config.IsObfsproxy() {
+			return fmt.Errorf("unable to initialize OpenVPN: %s can not be used together with obfsproxy", proxy.Name())
+		}
+		if err := proxy.Start(); err != nil {
+			return fmt.Errorf("unable to initialize OpenVPN (%s not started): %w", proxy.Name(), err)
+		}
+
+		// update connection parameters according to local proxy configuration (the proxy works as local SOCKS proxy)
+		//--------------------------------------------------
+		o.connectParams.proxyType = "socks"
+		o.connectParams.proxyAddress = net.IPv4(127, 0, 0, 1) // "127.0.0.1"
+		o.connectParams.proxyPort = proxy.LocalPort()
+		o.connectParams.proxyUsername = ""
+		o.connectParams.proxyPassword = ""
+		o.connectParams.proxyAuthFileData = ""
+		//--------------------------------------------------
+
+		// detect local proxy process stop
+		routinesWaiter.Add(1)
+		go func() {
+			defer routinesWaiter.Done()
+
+			// wait for local proxy stop
+			proxy.Wait()
+			if !o.isDisconnectRequested {
+				// If local proxy stopped unexpectedly - disconnect VPN
+				log.Error(proxy.Name() + " stopped unexpectedly. Disconnecting VPN...")
+				o.doDisconnect()
+			}
+		}()
+	}
+
 	// Generating random secret for MI
 	// This value used to validate that connected MI (to the listening TCP port) is the instance of OpenVPN which we already started
 	// Check procedure:
@@ -388,6 +453,7 @@ func (o *OpenVPN) Connect(stateChan chan<- vpn.StateInfo) (retErr error) {
 		o.logFile,
 		o.extraParameters,
 		o.implIsCanUseParamsV24(),
+		o.implDcoSupport(),
 		o.implGetUpDownScriptArgs())
 
 	if err != nil {
@@ -425,7 +491,8 @@ func (o *OpenVPN) Connect(stateChan chan<- vpn.StateInfo) (retErr error) {
 	}
 
 	// SYNCHRONOUSLY execute openvpn process (wait until it finished)
-	if err = shell.ExecAndProcessOutput(log, outProcessFunc, "", o.binaryPath, "--config", o.configPath); err != nil {
+	// (the process is running during the whole connection: no timeout)
+	if err = shell.ExecAndProcessOutputCtx(context.Background(), log, outProcessFunc, "", o.binaryPath, "--config", o.configPath); err != nil {
 		if strOut.Len() > 0 {
 			log.Info(fmt.Sprintf("OpenVPN start ERROR. Output: %s...", strOut.String()))
 		}
@@ -575,6 +642,20 @@ func (o *OpenVPN) DefaultDNS() net.IP {
 	return nil
 }
 
+// TrafficStats returns the number of bytes received/sent through the tunnel
+// (the statistics are received from OpenVPN through the management interface)
+func (o *OpenVPN) TrafficStats() (rx, tx uint64, err error) {
+	mi := o.managementInterface
+	if mi == nil || !mi.isConnected || o.state != vpn.CONNECTED {
+		return 0, 0, fmt.Errorf("not connected")
+	}
+	rx, tx, ok := mi.TrafficStats()
+	if !ok {
+		return 0, 0, fmt.Errorf("traffic statistics not available yet")
+	}
+	return rx, tx, nil
+}
+
 // SetManualDNS changes DNS to manual IP
 func (o *OpenVPN) SetManualDNS(dnsCfg dns.DnsSettings) error {
 	return o.implOnSetManualDNS(dnsCfg)
@@ -591,5 +672,5 @@ func (o *OpenVPN) OnRoutingChanged() error {
 }
 
 func (o *OpenVPN) IsIPv6InTunnel() bool {
-	return false
+	return o.state == vpn.CONNECTED && o.clientIPv6 != nil
 }
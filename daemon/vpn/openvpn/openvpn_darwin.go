@@ -32,6 +32,7 @@ type platformSpecificProperties struct {
 
 func (o *OpenVPN) implInit() error             { return nil }
 func (o *OpenVPN) implIsCanUseParamsV24() bool { return true }
+func (o *OpenVPN) implDcoSupport() dcoSupport  { return dcoNotSupported }
 
 func (o *OpenVPN) implOnConnected() error {
 	// not in use in macOS implementation
@@ -24,20 +24,27 @@ package openvpn
 
 import (
 	"fmt"
+	"os"
 
 	"github.com/ivpn/desktop-app/daemon/service/dns"
 	"github.com/ivpn/desktop-app/daemon/service/platform"
 	"github.com/ivpn/desktop-app/daemon/service/platform/filerights"
+	"github.com/ivpn/desktop-app/daemon/shell"
 	"github.com/ivpn/desktop-app/daemon/vpn"
 )
 
+// Kernel modules for the data channel offload (DCO): "ovpn-dco-v2" (out-of-tree module) or "ovpn" (mainline kernel)
+var dcoKernelModules = []string{"ovpn_dco_v2", "ovpn"}
+
 type platformSpecificProperties struct {
 	isCanUseParamsV24 bool
+	isDcoCapable      bool // OpenVPN binary supports data channel offload (DCO)
 	manualDNS         dns.DnsSettings
 }
 
 func (o *OpenVPN) implInit() error {
 	o.psProps.isCanUseParamsV24 = true
+	o.psProps.isDcoCapable = false
 
 	if err := filerights.CheckFileAccessRightsExecutable(o.binaryPath); err != nil {
 		return fmt.Errorf("error checking OpenVPN binary file: %w", err)
@@ -58,6 +65,7 @@ func (o *OpenVPN) implInit() error {
 	if len(verNums) >= 2 && verNums[0] == 2 && verNums[1] < 4 {
 		o.psProps.isCanUseParamsV24 = false
 	}
+	o.psProps.isDcoCapable = isVersionDcoCapable(verNums)
 	return nil
 }
 
@@ -65,6 +73,30 @@ func (o *OpenVPN) implIsCanUseParamsV24() bool {
 	return o.psProps.isCanUseParamsV24
 }
 
+func (o *OpenVPN) implDcoSupport() dcoSupport {
+	if !o.psProps.isDcoCapable {
+		return dcoNotSupported
+	}
+
+	isModuleLoaded := func() bool {
+		for _, m := range dcoKernelModules {
+			if _, err := os.Stat("/sys/module/" + m); err == nil {
+				return true
+			}
+		}
+		return false
+	}
+
+	if !isModuleLoaded() {
+		// try to load the kernel module (if installed)
+		if err := shell.Exec(nil, "modprobe", "-q", "ovpn-dco-v2"); err != nil || !isModuleLoaded() {
+			log.Info("Data channel offload (DCO) is not available: kernel module 'ovpn-dco-v2' not loaded")
+			return dcoNotAvailable
+		}
+	}
+	return dcoAvailable
+}
+
 func (o *OpenVPN) implOnConnected() error {
 	// It is not possible to change network interface properties until it not enabled
 	// apply DNS value when VPN connected (interface enabled)
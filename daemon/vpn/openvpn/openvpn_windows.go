@@ -23,17 +23,37 @@
 package openvpn
 
 import (
+	"os"
+	"path/filepath"
+
 	"github.com/ivpn/desktop-app/daemon/service/dns"
 	"github.com/ivpn/desktop-app/daemon/vpn"
 )
 
 type platformSpecificProperties struct {
-	manualDNS dns.DnsSettings
+	isDcoCapable bool // OpenVPN binary supports data channel offload (DCO)
+	manualDNS    dns.DnsSettings
+}
+
+func (o *OpenVPN) implInit() error {
+	o.psProps.isDcoCapable = isVersionDcoCapable(GetOpenVPNVersion(o.binaryPath))
+	return nil
 }
 
-func (o *OpenVPN) implInit() error             { return nil }
 func (o *OpenVPN) implIsCanUseParamsV24() bool { return true }
 
+func (o *OpenVPN) implDcoSupport() dcoSupport {
+	if !o.psProps.isDcoCapable {
+		return dcoNotSupported
+	}
+	// the DCO driver (ovpn-dco-win) is installed together with OpenVPN 2.6+ (if selected by the user)
+	if _, err := os.Stat(filepath.Join(os.Getenv("SystemRoot"), "System32", "drivers", "ovpn-dco.sys")); err != nil {
+		log.Info("Data channel offload (DCO) is not available: 'ovpn-dco' driver not installed")
+		return dcoNotAvailable
+	}
+	return dcoAvailable
+}
+
 func (o *OpenVPN) implOnConnected() error {
 	// on Windows it is not possible to change network interface properties until it not enabled
 	// apply DNS value when VPN connected (TAP interface enabled)
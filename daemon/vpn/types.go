@@ -27,9 +27,12 @@ import (
 	"fmt"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/ivpn/desktop-app/daemon/obfsproxy"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
+	"github.com/ivpn/desktop-app/daemon/shadowsocks"
+	"github.com/ivpn/desktop-app/daemon/v2r"
 )
 
 // Type - VPN type
@@ -51,6 +54,30 @@ func (t Type) String() string {
 	return "<Unknown>"
 }
 
+// TunnelIPMode - which IP protocols have to be tunneled
+type TunnelIPMode int
+
+const (
+	// TunnelIPAuto - default behavior: IPv4 is always tunneled; IPv6 is tunneled only if it is requested and supported by the server
+	TunnelIPAuto TunnelIPMode = iota
+	// TunnelIPv4Only - tunnel only IPv4 traffic; IPv6 is not tunneled (blocked by the firewall)
+	TunnelIPv4Only TunnelIPMode = iota
+	// TunnelIPv6Only - tunnel only IPv6 traffic; IPv4 is not routed to the tunnel (blocked by the firewall)
+	TunnelIPv6Only TunnelIPMode = iota
+)
+
+func (m TunnelIPMode) String() string {
+	switch m {
+	case TunnelIPAuto:
+		return "Auto"
+	case TunnelIPv4Only:
+		return "IPv4Only"
+	case TunnelIPv6Only:
+		return "IPv6Only"
+	}
+	return "<Unknown>"
+}
+
 // State - state of VPN
 type State int
 
@@ -142,6 +169,13 @@ type StateInfo struct {
 	Mtu          int              // applicable only for 'CONNECTED' state (WireGuard)
 	IsAuthError  bool             // applicable only for 'EXITING' state
 
+	// V2Ray transport in use (applicable only for 'CONNECTED' state)
+	V2RayProxy v2r.V2RayTransportType
+	// The connection is chained through the Shadowsocks server (applicable only for 'CONNECTED' state)
+	IsShadowsocks bool
+	// The connection is established using the user-defined configuration (applicable only for 'CONNECTED' state)
+	IsCustomConfig bool
+
 	// TODO: try to avoid using this protocol-specific parameter in future
 	// Currently, in use by OpenVPN connection to inform about "RECONNECTING" reason (e.g. "tls-error", "init_instance"...)
 	// UI client using this info in order to determine is it necessary to try to connect with another port
@@ -204,6 +238,13 @@ type Process interface {
 	OnRoutingChanged() error
 }
 
+// TrafficStatsProvider - VPN object which reports the traffic statistics of the tunnel by itself
+// (e.g. OpenVPN management interface). For the rest VPN objects the counters of the VPN network interface are in use.
+type TrafficStatsProvider interface {
+	// TrafficStats returns the number of bytes received/sent through the tunnel
+	TrafficStats() (rx, tx uint64, err error)
+}
+
 // ReconnectionRequiredError object can be returned by vpn.Process.Connect() function
 // which means that it requesting to do re-connect immediately
 type ReconnectionRequiredError struct {
@@ -220,3 +261,39 @@ func (e *ReconnectionRequiredError) Error() string {
 
 // Unwrap returns inner error
 func (e *ReconnectionRequiredError) Unwrap() error { return e.Err }
+
+// LocalProxy - the transport which wraps the VPN traffic (e.g. V2Ray, Shadowsocks).
+// The VPN client communicates with the local port of the proxy; the proxy communicates with the remote server.
+// The proxy is started/stopped by the VPN object.
+type LocalProxy interface {
+	// Name of the transport (e.g. "V2Ray")
+	Name() string
+	Start() error
+	Stop()
+	// Wait waits until the proxy stopped
+	Wait()
+	LocalPort() int
+	// RemoteIP returns IP address of the server the proxy communicates with
+	RemoteIP() net.IP
+}
+
+// SetLocalProxyInfo updates the info about the local proxy in use (nil - no proxy)
+func (s *StateInfo) SetLocalProxyInfo(proxy LocalProxy) {
+	switch p := proxy.(type) {
+	case *v2r.V2RayWrapper:
+		s.V2RayProxy = p.Settings().Transport
+	case *shadowsocks.Client:
+		s.IsShadowsocks = true
+	}
+}
+
+// HandshakeTimeoutError object can be returned by vpn.Process.Connect() function
+// when there was no handshake with the VPN server during expected time
+// (e.g. the UDP traffic is blocked by the network)
+type HandshakeTimeoutError struct {
+	Timeout time.Duration
+}
+
+func (e *HandshakeTimeoutError) Error() string {
+	return fmt.Sprintf("no handshake with the VPN server during %v", e.Timeout)
+}
@@ -0,0 +1,145 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package wireguard
+
+import (
+	"net"
+	"strings"
+)
+
+// Address ranges which are not routed to the tunnel when LAN bypass is enabled (see ConnectionParams.SetLanBypass())
+var lanBypassRanges = parseCIDRs(
+	"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", // private IPv4 networks (RFC1918)
+	"169.254.0.0/16", // IPv4 link-local
+	"fc00::/7",       // IPv6 unique local addresses
+	"fe80::/10",      // IPv6 link-local
+)
+
+func parseCIDRs(cidrs ...string) []net.IPNet {
+	ret := make([]net.IPNet, 0, len(cidrs))
+	for _, c := range cidrs {
+		_, n, err := net.ParseCIDR(c)
+		if err != nil {
+			panic(err)
+		}
+		ret = append(ret, *n)
+	}
+	return ret
+}
+
+// hostNet returns the single-address network for the IP ("x.x.x.x/32" or "x::x/128")
+func hostNet(ip net.IP) net.IPNet {
+	if ip4 := ip.To4(); ip4 != nil {
+		return net.IPNet{IP: ip4, Mask: net.CIDRMask(32, 32)}
+	}
+	return net.IPNet{IP: ip.To16(), Mask: net.CIDRMask(128, 128)}
+}
+
+// splitSubnet splits the network into two halves (the network must not be a single address)
+func splitSubnet(n net.IPNet) (lo, hi net.IPNet) {
+	ones, bits := n.Mask.Size()
+	mask := net.CIDRMask(ones+1, bits)
+
+	loIP := n.IP.Mask(n.Mask)
+	hiIP := make(net.IP, len(loIP))
+	copy(hiIP, loIP)
+	hiIP[ones/8] |= 0x80 >> (ones % 8)
+
+	return net.IPNet{IP: loIP, Mask: mask}, net.IPNet{IP: hiIP, Mask: mask}
+}
+
+// subtractSubnet returns the list of networks which cover all addresses of 'n' except the addresses of 'exclude'
+func subtractSubnet(n net.IPNet, exclude net.IPNet) []net.IPNet {
+	nOnes, nBits := n.Mask.Size()
+	exOnes, exBits := exclude.Mask.Size()
+	if nBits != exBits {
+		return []net.IPNet{n} // different address families
+	}
+	if exOnes <= nOnes {
+		if exclude.Contains(n.IP) {
+			return nil // the network is fully excluded
+		}
+		return []net.IPNet{n} // no intersection
+	}
+	if !n.Contains(exclude.IP) {
+		return []net.IPNet{n} // no intersection
+	}
+
+	lo, hi := splitSubnet(n)
+	return append(subtractSubnet(lo, exclude), subtractSubnet(hi, exclude)...)
+}
+
+// subtractSubnets returns the list of networks which cover all addresses of 'nets' except the addresses of 'exclude'
+func subtractSubnets(nets []net.IPNet, exclude []net.IPNet) []net.IPNet {
+	for _, ex := range exclude {
+		var ret []net.IPNet
+		for _, n := range nets {
+			ret = append(ret, subtractSubnet(n, ex)...)
+		}
+		nets = ret
+	}
+	return nets
+}
+
+// lanBypassAllowedIPNets returns the networks routed to the tunnel when LAN bypass is enabled:
+// all addresses except the local ranges (see lanBypassRanges), so the local traffic never enters the tunnel interface.
+// The internal addresses of the VPN server (e.g. DNS) are kept in the tunnel even if they belong to the local ranges.
+func (cp ConnectionParams) lanBypassAllowedIPNets() []net.IPNet {
+	isIPv6 := cp.GetIPv6ClientLocalIP() != nil
+
+	var nets []net.IPNet
+	if cp.isIPv4Routed() || !isIPv6 {
+		nets = append(nets, parseCIDRs("0.0.0.0/0")...)
+	}
+	if isIPv6 {
+		nets = append(nets, parseCIDRs("::/0")...)
+	}
+	nets = subtractSubnets(nets, lanBypassRanges)
+
+	keep := append([]net.IP{cp.hostLocalIP, cp.GetIPv6HostLocalIP(), cp.dns}, cp.lanBypassKeepIPs...)
+	for _, ip := range keep {
+		if ip == nil {
+			continue
+		}
+		if isIPv4 := ip.To4() != nil; (isIPv4 && !cp.isIPv4Routed() && isIPv6) || (!isIPv4 && !isIPv6) {
+			continue // the address family is not routed to the tunnel
+		}
+		for _, r := range lanBypassRanges {
+			if r.Contains(ip) {
+				nets = append(nets, hostNet(ip))
+				break
+			}
+		}
+	}
+	return nets
+}
+
+// lanBypassAllowedIPs returns 'AllowedIPs' value for the WireGuard configuration when LAN bypass is enabled
+func (cp ConnectionParams) lanBypassAllowedIPs() string {
+	nets := cp.lanBypassAllowedIPNets()
+	strs := make([]string, 0, len(nets))
+	for _, n := range nets {
+		strs = append(strs, n.String())
+	}
+	return strings.Join(strs, ", ")
+}
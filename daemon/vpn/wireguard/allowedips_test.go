@@ -0,0 +1,111 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2024 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package wireguard
+
+import (
+	"net"
+	"strings"
+	"testing"
+)
+
+func netsString(nets []net.IPNet) string {
+	strs := make([]string, 0, len(nets))
+	for _, n := range nets {
+		strs = append(strs, n.String())
+	}
+	return strings.Join(strs, ", ")
+}
+
+func TestSubtractSubnet(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        string
+		exclude  string
+		expected string
+	}{
+		{"no intersection", "10.0.0.0/8", "192.168.0.0/16", "10.0.0.0/8"},
+		{"fully excluded", "10.1.0.0/16", "10.0.0.0/8", ""},
+		{"same network", "10.0.0.0/8", "10.0.0.0/8", ""},
+		{"lower half", "10.0.0.0/8", "10.0.0.0/9", "10.128.0.0/9"},
+		{"upper half", "10.0.0.0/8", "10.128.0.0/9", "10.0.0.0/9"},
+		{"single address", "192.168.1.0/30", "192.168.1.2/32", "192.168.1.0/31, 192.168.1.3/32"},
+		{"inner network", "10.0.0.0/8", "10.64.0.0/10", "10.0.0.0/10, 10.128.0.0/9"},
+		{"all IPv4 without 128.0.0.0/1", "0.0.0.0/0", "128.0.0.0/1", "0.0.0.0/1"},
+		{"different address families", "0.0.0.0/0", "fc00::/7", "0.0.0.0/0"},
+		{"IPv6", "::/0", "8000::/1", "::/1"},
+		{"IPv6 inner network", "fc00::/6", "fd00::/8", "fc00::/8, fe00::/7"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ret := subtractSubnet(parseCIDRs(tt.n)[0], parseCIDRs(tt.exclude)[0])
+			if s := netsString(ret); s != tt.expected {
+				t.Errorf("expected: '%s'; got: '%s'", tt.expected, s)
+			}
+		})
+	}
+}
+
+func TestSubtractSubnetsLanBypass(t *testing.T) {
+	nets := subtractSubnets(parseCIDRs("0.0.0.0/0", "::/0"), lanBypassRanges)
+
+	tests := []struct {
+		ip         string
+		isInTunnel bool
+	}{
+		{"0.0.0.0", true},
+		{"8.8.8.8", true},
+		{"9.255.255.255", true},
+		{"10.0.0.1", false},
+		{"11.0.0.0", true},
+		{"172.15.255.255", true},
+		{"172.16.0.1", false},
+		{"172.31.255.255", false},
+		{"172.32.0.0", true},
+		{"169.254.10.10", false},
+		{"192.168.100.1", false},
+		{"192.169.0.0", true},
+		{"255.255.255.255", true},
+		{"2a07:b944::2:1", true},
+		{"fd00::1", false},
+		{"fe80::1", false},
+		{"fec0::1", true},
+		{"ffff::1", true},
+	}
+
+	for _, tt := range tests {
+		ip := net.ParseIP(tt.ip)
+		cnt := 0
+		for _, n := range nets {
+			if n.Contains(ip) {
+				cnt++
+			}
+		}
+		if tt.isInTunnel && cnt != 1 {
+			t.Errorf("%s: expected to be covered by exactly one network; covered by %d", tt.ip, cnt)
+		}
+		if !tt.isInTunnel && cnt != 0 {
+			t.Errorf("%s: expected to be excluded", tt.ip)
+		}
+	}
+}
@@ -26,13 +26,22 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/ivpn/desktop-app/daemon/awg"
 	"github.com/ivpn/desktop-app/daemon/helpers"
 	"github.com/ivpn/desktop-app/daemon/logger"
 	"github.com/ivpn/desktop-app/daemon/netinfo"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
+	"github.com/ivpn/desktop-app/daemon/service/platform/filerights"
+	"github.com/ivpn/desktop-app/daemon/shell"
+	"github.com/ivpn/desktop-app/daemon/udp2tcp"
 	"github.com/ivpn/desktop-app/daemon/vpn"
 )
 
@@ -42,6 +51,22 @@ func init() {
 	log = logger.NewLogger("wg")
 }
 
+// MinMTU - the minimal MTU value of the WireGuard interface.
+// According to Windows specification: "... For IPv4 the minimum value is 576 bytes. For IPv6 the minimum is value is 1280 bytes... "
+// Using the same limitations for all platforms
+const MinMTU = 1280
+
+// PeerEndpoint - the endpoint of the WireGuard server (one of the hosts of the server)
+type PeerEndpoint struct {
+	HostIP        net.IP
+	HostPublicKey string
+}
+
+// Equals returns 'true' when the endpoints are the same
+func (e PeerEndpoint) Equals(e2 PeerEndpoint) bool {
+	return e.HostIP.Equal(e2.HostIP) && e.HostPublicKey == e2.HostPublicKey
+}
+
 // ConnectionParams contains all information to make new connection
 type ConnectionParams struct {
 	clientLocalIP        net.IP
@@ -51,29 +76,171 @@ type ConnectionParams struct {
 	hostPublicKey        string
 	hostLocalIP          net.IP
 	ipv6Prefix           string
+	ipMode               vpn.TunnelIPMode
 	multihopExitHostname string // (e.g.: "nl4.wg.ivpn.net") we need it only for informing clients about connection status
 	mtu                  int    // Set 0 to use default MTU value
+
+	// Max time to wait for the first handshake with the server after the tunnel is up (0 - not checked).
+	// If there was no handshake - the connection is stopped with vpn.HandshakeTimeoutError
+	handshakeTimeout time.Duration
+
+	// TCP port of the UDP-over-TCP server (0 - not in use).
+	// When defined - the WireGuard traffic is encapsulated into TCP (see package 'udp2tcp')
+	tcpEncapsulationPort int
+
+	// AmneziaWG obfuscation parameters (empty - vanilla WireGuard).
+	// When defined - the AmneziaWG binaries have to be in use (see NewWireGuardObject())
+	amneziaWG awg.Config
+
+	// If true - the local networks (private and link-local ranges) are excluded from 'AllowedIPs' (not routed to the tunnel).
+	// The 'lanBypassKeepIPs' are routed to the tunnel even if they belong to the local ranges (e.g. DNS servers of the VPN service)
+	isLanBypass      bool
+	lanBypassKeepIPs []net.IP
+
+	// All endpoints of the server (including the active one) which can be used for the connection without re-initialization
+	// of the tunnel interface (see WithEndpoint()). The connection is switched to the next endpoint when the active one is not responding.
+	failoverEndpoints []PeerEndpoint
+
+	// Parameters of the user-defined configuration (see ParseCustomConfig()).
+	// The 'hostLocalIP' is unknown for such configurations, so the DNS server is defined explicitly.
+	isCustomConfig bool
+	presharedKey   string
+	dns            net.IP
+}
+
+// IsCustomConfig returns 'true' when the parameters are loaded from the user-defined configuration
+func (cp *ConnectionParams) IsCustomConfig() bool {
+	return cp.isCustomConfig
 }
 
-func (cp *ConnectionParams) GetIPv6ClientLocalIP() net.IP {
+func (cp ConnectionParams) GetIPv6ClientLocalIP() net.IP {
 	if len(cp.ipv6Prefix) <= 0 {
 		return nil
 	}
 	return net.ParseIP(cp.ipv6Prefix + cp.clientLocalIP.String())
 }
-func (cp *ConnectionParams) GetIPv6HostLocalIP() net.IP {
+func (cp ConnectionParams) GetIPv6HostLocalIP() net.IP {
 	if len(cp.ipv6Prefix) <= 0 {
 		return nil
 	}
 	return net.ParseIP(cp.ipv6Prefix + cp.hostLocalIP.String())
 }
 
+// SetTunnelIPMode defines which IP protocols have to be tunneled.
+// Note: vpn.TunnelIPv6Only requires IPv6 inside the tunnel (ipv6Prefix must be defined)
+func (cp *ConnectionParams) SetTunnelIPMode(mode vpn.TunnelIPMode) {
+	cp.ipMode = mode
+}
+
+// TunnelIPMode returns info which IP protocols have to be tunneled
+func (cp *ConnectionParams) TunnelIPMode() vpn.TunnelIPMode {
+	return cp.ipMode
+}
+
+// isIPv4Routed returns 'true' when IPv4 traffic has to be routed to the tunnel
+func (cp ConnectionParams) isIPv4Routed() bool {
+	return cp.ipMode != vpn.TunnelIPv6Only
+}
+
+// SetHandshakeTimeout defines max time to wait for the first handshake with the server (0 - do not check handshake)
+func (cp *ConnectionParams) SetHandshakeTimeout(timeout time.Duration) {
+	cp.handshakeTimeout = timeout
+}
+
+// SetTcpEncapsulation defines TCP port of the UDP-over-TCP server on the WireGuard host (0 - do not use TCP encapsulation)
+func (cp *ConnectionParams) SetTcpEncapsulation(port int) {
+	cp.tcpEncapsulationPort = port
+}
+
+// IsTcpEncapsulation returns 'true' when the WireGuard traffic is encapsulated into TCP (see SetTcpEncapsulation())
+func (cp *ConnectionParams) IsTcpEncapsulation() bool {
+	return cp.tcpEncapsulationPort > 0
+}
+
+// SetAmneziaWG defines AmneziaWG obfuscation parameters (empty configuration - vanilla WireGuard)
+func (cp *ConnectionParams) SetAmneziaWG(cfg awg.Config) {
+	cp.amneziaWG = cfg
+}
+
+// AmneziaWG returns AmneziaWG obfuscation parameters (empty configuration - vanilla WireGuard)
+func (cp *ConnectionParams) AmneziaWG() awg.Config {
+	return cp.amneziaWG
+}
+
+// SetLanBypass enables/disables exclusion of the local networks from the tunnel ('AllowedIPs' do not contain the
+// private and link-local ranges, so the local traffic never enters the tunnel interface).
+// 'keepInTunnel' - addresses which have to be routed to the tunnel even if they belong to the local ranges
+// (the internal addresses of the VPN server are always routed to the tunnel)
+func (cp *ConnectionParams) SetLanBypass(enable bool, keepInTunnel []net.IP) {
+	cp.isLanBypass = enable
+	cp.lanBypassKeepIPs = keepInTunnel
+}
+
+// SetFailoverEndpoints defines the endpoints of the server to switch to, when the active endpoint is not responding
+// (the endpoints must use the same port and the same internal addresses as the active one)
+func (cp *ConnectionParams) SetFailoverEndpoints(endpoints []PeerEndpoint) {
+	cp.failoverEndpoints = endpoints
+}
+
+// FailoverEndpoints returns the endpoints of the server to switch to, when the active endpoint is not responding
+func (cp *ConnectionParams) FailoverEndpoints() []PeerEndpoint {
+	return cp.failoverEndpoints
+}
+
+// Endpoint returns the active endpoint of the WireGuard server
+func (cp *ConnectionParams) Endpoint() PeerEndpoint {
+	return PeerEndpoint{HostIP: cp.hostIP, HostPublicKey: cp.hostPublicKey}
+}
+
+// WithEndpoint returns copy of the connection parameters with another endpoint of the server
+func (cp ConnectionParams) WithEndpoint(e PeerEndpoint) ConnectionParams {
+	cp.hostIP = e.HostIP
+	cp.hostPublicKey = e.HostPublicKey
+	return cp
+}
+
+// WithPort returns copy of the connection parameters with another port of the server
+func (cp ConnectionParams) WithPort(port int) ConnectionParams {
+	cp.hostPort = port
+	return cp
+}
+
+// IsMultiHop returns 'true' for Multi-Hop connections (the port of the server defines the exit server)
+func (cp *ConnectionParams) IsMultiHop() bool {
+	return len(cp.multihopExitHostname) > 0
+}
+
+// HostIP returns IP address of the WireGuard server (entry server in case of Multi-Hop)
+func (cp *ConnectionParams) HostIP() net.IP {
+	return cp.hostIP
+}
+
+// MTU returns the custom MTU of the WireGuard interface (0 - default value)
+func (cp *ConnectionParams) MTU() int {
+	return cp.mtu
+}
+
+// HostPort returns port of the WireGuard server
+func (cp *ConnectionParams) HostPort() int {
+	return cp.hostPort
+}
+
 // SetCredentials update WG credentials
 func (cp *ConnectionParams) SetCredentials(privateKey string, localIP net.IP) {
 	cp.clientPrivateKey = privateKey
 	cp.clientLocalIP = localIP
 }
 
+// SetPresharedKey defines the preshared key of the peer (e.g. negotiated using post-quantum KEM; empty - not in use)
+func (cp *ConnectionParams) SetPresharedKey(presharedKey string) {
+	cp.presharedKey = presharedKey
+}
+
+// IsPresharedKey returns 'true' when the preshared key is in use
+func (cp *ConnectionParams) IsPresharedKey() bool {
+	return len(cp.presharedKey) > 0
+}
+
 // CreateConnectionParams initializing connection parameters object
 func CreateConnectionParams(
 	multihopExitHostName string,
@@ -100,16 +267,35 @@ type WireGuard struct {
 	binaryPath     string
 	toolBinaryPath string
 	configFilePath string
-	connectParams  ConnectionParams
 	localPort      int
 	isDisconnected bool
 
+	// connection parameters: they can be changed by SwitchServer() while the connection routine is running
+	// (use params() and setParams())
+	connectParams ConnectionParams
+	paramsMutex   sync.RWMutex
+	// serializes SwitchServer() calls
+	switchMutex sync.Mutex
+
+	// handshake monitoring (see ConnectionParams.SetHandshakeTimeout())
+	isHandshakeMonitorStarted bool
+	isHandshakeTimeout        bool
+
+	// local proxy transport (nil - not in use): the WireGuard traffic goes through local proxy (e.g. V2Ray or Shadowsocks)
+	localProxy vpn.LocalProxy
+	// UDP-over-TCP shim (nil - not in use; initialized on Connect() if ConnectionParams.SetTcpEncapsulation() defined)
+	tcpShim *udp2tcp.Shim
+
+	// channel to notify connection state (initialized on Connect())
+	stateChan chan<- vpn.StateInfo
+
 	// Must be implemented (AND USED) in correspond file for concrete platform. Must contain platform-specified properties (or can be empty struct)
 	internals internalVariables
 }
 
 // NewWireGuardObject creates new wireguard structure
-func NewWireGuardObject(wgBinaryPath string, wgToolBinaryPath string, wgConfigFilePath string, connectionParams ConnectionParams) (*WireGuard, error) {
+// 'localProxy' - (optional) transport to wrap the WireGuard traffic (it will be started/stopped by the WireGuard object)
+func NewWireGuardObject(wgBinaryPath string, wgToolBinaryPath string, wgConfigFilePath string, connectionParams ConnectionParams, localProxy vpn.LocalProxy) (*WireGuard, error) {
 	if connectionParams.clientLocalIP == nil || len(connectionParams.clientPrivateKey) == 0 {
 		return nil, fmt.Errorf("WireGuard local credentials not defined")
 	}
@@ -118,20 +304,78 @@ func NewWireGuardObject(wgBinaryPath string, wgToolBinaryPath string, wgConfigFi
 		binaryPath:     wgBinaryPath,
 		toolBinaryPath: wgToolBinaryPath,
 		configFilePath: wgConfigFilePath,
-		connectParams:  connectionParams}, nil
+		connectParams:  connectionParams,
+		localProxy:     localProxy}, nil
+}
+
+// ConnectionParams returns actual connection parameters
+// (they can be changed by SwitchServer())
+func (wg *WireGuard) ConnectionParams() ConnectionParams {
+	return wg.params()
+}
+
+func (wg *WireGuard) params() ConnectionParams {
+	wg.paramsMutex.RLock()
+	defer wg.paramsMutex.RUnlock()
+	return wg.connectParams
+}
+
+func (wg *WireGuard) setParams(p ConnectionParams) {
+	wg.paramsMutex.Lock()
+	defer wg.paramsMutex.Unlock()
+	wg.connectParams = p
+}
+
+// InterfaceMTU returns the current MTU of the WireGuard interface
+func (wg *WireGuard) InterfaceMTU() (int, error) {
+	name := wg.interfaceName()
+	if len(name) == 0 || wg.isDisconnected {
+		return 0, fmt.Errorf("WireGuard interface is not initialized")
+	}
+	iface, err := net.InterfaceByName(name)
+	if err != nil {
+		return 0, err
+	}
+	return iface.MTU, nil
+}
+
+// SetInterfaceMTU changes the MTU of the active WireGuard interface (without reconnection).
+// Note: the original MTU value is applied again when the interface is re-created (e.g. on resume or reconnection)
+func (wg *WireGuard) SetInterfaceMTU(mtu int) error {
+	if mtu < MinMTU || mtu > 65535 {
+		return fmt.Errorf("bad MTU value (acceptable interval is: [%d - 65535])", MinMTU)
+	}
+	if len(wg.interfaceName()) == 0 || wg.isDisconnected {
+		return fmt.Errorf("WireGuard interface is not initialized")
+	}
+	log.Info(fmt.Sprintf("Changing MTU of the interface '%s' to %d ...", wg.interfaceName(), mtu))
+	return wg.setInterfaceMTU(mtu)
 }
 
 // DestinationIP -  Get destination IP (VPN host server or proxy server IP address)
 // This information if required, for example, to allow this address in firewall
 func (wg *WireGuard) DestinationIP() net.IP {
-	return wg.connectParams.hostIP
+	if wg.localProxy != nil {
+		return wg.localProxy.RemoteIP()
+	}
+	return wg.params().hostIP
 }
 func (wg *WireGuard) DefaultDNS() net.IP {
+	cp := wg.params()
+
 	if wg.isDisconnected {
 		return nil
 	}
 
-	return wg.connectParams.hostLocalIP
+	if cp.dns != nil {
+		// DNS server defined by the user-defined configuration
+		return cp.dns
+	}
+	if !cp.isIPv4Routed() {
+		// IPv4 is not tunneled: the DNS server must be accessible over IPv6
+		return cp.GetIPv6HostLocalIP()
+	}
+	return cp.hostLocalIP
 }
 
 // Type just returns VPN type
@@ -147,9 +391,12 @@ func (wg *WireGuard) Init() error {
 
 // Connect - SYNCHRONOUSLY execute openvpn process (wait until it finished)
 func (wg *WireGuard) Connect(stateChan chan<- vpn.StateInfo) error {
+	cp := wg.params()
 
 	disconnectDescription := ""
 	wg.isDisconnected = false
+	wg.isHandshakeMonitorStarted, wg.isHandshakeTimeout = false, false
+	wg.stateChan = stateChan
 	stateChan <- vpn.NewStateInfo(vpn.CONNECTING, "")
 	defer func() {
 		wg.isDisconnected = true
@@ -158,17 +405,62 @@ func (wg *WireGuard) Connect(stateChan chan<- vpn.StateInfo) error {
 
 	err := func() error {
 		// Check custom MTU value
-		if wg.connectParams.mtu > 0 {
-			// According to Windows specification: "... For IPv4 the minimum value is 576 bytes. For IPv6 the minimum is value is 1280 bytes... "
-			// Using the same limitations for all platforms
-			if wg.connectParams.mtu < 1280 || wg.connectParams.mtu > 65535 {
+		if cp.mtu > 0 {
+			if cp.mtu < MinMTU || cp.mtu > 65535 {
 				return fmt.Errorf("bad MTU value (acceptable interval is: [1280 - 65535])")
 			}
 		}
 
+		// start local proxy: V2Ray, Shadowsocks (if necessary)
+		if proxy := wg.localProxy; proxy != nil {
+			if err := proxy.Start(); err != nil {
+				return fmt.Errorf("unable to initialize WireGuard (%s not started): %w", proxy.Name(), err)
+			}
+			defer proxy.Stop()
+
+			// detect local proxy process stop
+			go func() {
+				proxy.Wait()
+				if !wg.isDisconnected {
+					// If local proxy stopped unexpectedly - disconnect VPN
+					log.Error(proxy.Name() + " stopped unexpectedly. Disconnecting VPN...")
+					wg.Disconnect()
+				}
+			}()
+		}
+
+		// start UDP-over-TCP shim (if necessary)
+		wg.tcpShim = nil
+		if port := cp.tcpEncapsulationPort; port > 0 {
+			if wg.localProxy != nil {
+				return fmt.Errorf("TCP encapsulation can not be used together with %s", wg.localProxy.Name())
+			}
+			shim, err := udp2tcp.CreateShim(cp.hostIP, port, !isHostRouteConfigured)
+			if err != nil {
+				return err
+			}
+			if err := shim.Start(); err != nil {
+				return fmt.Errorf("unable to initialize WireGuard (UDP-over-TCP shim not started): %w", err)
+			}
+			defer shim.Stop()
+			wg.tcpShim = shim
+
+			// detect connection to the server is broken
+			go func() {
+				shim.Wait()
+				if !wg.isDisconnected {
+					log.Error("UDP-over-TCP connection closed unexpectedly. Disconnecting VPN...")
+					wg.Disconnect()
+				}
+			}()
+		}
+
 		return wg.connect(stateChan)
 	}()
 
+	if err == nil && wg.isHandshakeTimeout {
+		err = &vpn.HandshakeTimeoutError{Timeout: cp.handshakeTimeout}
+	}
 	if err != nil {
 		disconnectDescription = err.Error()
 	}
@@ -176,6 +468,76 @@ func (wg *WireGuard) Connect(stateChan chan<- vpn.StateInfo) error {
 	return err
 }
 
+// SwitchServer changes the server (peer) of the active connection without disconnection ("make-before-break").
+// The new peer (and the route to it, if required) is configured before the old one is removed,
+// so the traffic-blackout window is limited to the handshake with the new server.
+// Only peer-related parameters can be changed: the configuration of the tunnel interface
+// (local addresses, keys, MTU...) must stay the same. Otherwise, a regular reconnection is required.
+func (wg *WireGuard) SwitchServer(newParams ConnectionParams) error {
+	wg.switchMutex.Lock()
+	defer wg.switchMutex.Unlock()
+
+	if wg.isDisconnected || wg.stateChan == nil || wg.isPaused() {
+		return fmt.Errorf("no active connection")
+	}
+
+	oldParams := wg.params()
+	if err := oldParams.checkIsSwitchable(newParams); err != nil {
+		return err
+	}
+	if wg.localProxy != nil {
+		return fmt.Errorf("not applicable for %s connections", wg.localProxy.Name())
+	}
+	if wg.params().tcpEncapsulationPort > 0 {
+		return fmt.Errorf("not applicable for TCP-encapsulated connections")
+	}
+	// prevent user-defined data injection: ensure that nothing except the base64 public key will be passed to WireGuard
+	if !helpers.ValidateBase64(newParams.hostPublicKey) {
+		return fmt.Errorf("WG public key is not base64 string")
+	}
+
+	log.Info(fmt.Sprintf("Switching server: %s:%d -> %s:%d", oldParams.hostIP, oldParams.hostPort, newParams.hostIP, newParams.hostPort))
+	if err := wg.switchPeer(oldParams, newParams); err != nil {
+		return fmt.Errorf("failed to switch server: %w", err)
+	}
+	wg.setParams(newParams)
+
+	// notify connected to a new server
+	wg.notifyConnectedStat(wg.stateChan)
+	return nil
+}
+
+// checkIsSwitchable returns error if it is not possible to switch from current connection parameters to a new one
+// without re-initialization of the tunnel interface
+func (cp *ConnectionParams) checkIsSwitchable(newParams ConnectionParams) error {
+	if !cp.clientLocalIP.Equal(newParams.clientLocalIP) || cp.clientPrivateKey != newParams.clientPrivateKey {
+		return fmt.Errorf("WireGuard credentials changed")
+	}
+	if !cp.hostLocalIP.Equal(newParams.hostLocalIP) || cp.ipv6Prefix != newParams.ipv6Prefix {
+		return fmt.Errorf("tunnel addresses changed")
+	}
+	if cp.ipMode != newParams.ipMode {
+		return fmt.Errorf("tunnel IP mode changed")
+	}
+	if cp.mtu != newParams.mtu {
+		return fmt.Errorf("MTU changed")
+	}
+	if !cp.amneziaWG.Equals(newParams.amneziaWG) {
+		return fmt.Errorf("AmneziaWG parameters changed")
+	}
+	if cp.isLanBypass != newParams.isLanBypass || (cp.isLanBypass && cp.lanBypassAllowedIPs() != newParams.lanBypassAllowedIPs()) {
+		return fmt.Errorf("LAN bypass configuration changed")
+	}
+	if newParams.hostIP == nil || newParams.hostPort <= 0 {
+		return fmt.Errorf("new server is not defined")
+	}
+	if (cp.hostIP.To4() == nil) != (newParams.hostIP.To4() == nil) {
+		// the route to the server goes over another gateway
+		return fmt.Errorf("address family of the server changed")
+	}
+	return nil
+}
+
 // Disconnect stops the connection
 func (wg *WireGuard) Disconnect() error {
 	return wg.disconnect()
@@ -201,6 +563,15 @@ func (wg *WireGuard) Resume() error {
 
 // SetManualDNS changes DNS to manual IP
 func (wg *WireGuard) SetManualDNS(dnsCfg dns.DnsSettings) error {
+	// the DNS server must be reachable over the protocol which is tunneled
+	if isIPv6, err := dnsCfg.IsIPv6(); err == nil {
+		if isIPv6 && wg.params().ipMode == vpn.TunnelIPv4Only {
+			return fmt.Errorf("IPv6 DNS is not applicable: only IPv4 is tunneled for current connection")
+		}
+		if !isIPv6 && !wg.params().isIPv4Routed() {
+			return fmt.Errorf("IPv4 DNS is not applicable: only IPv6 is tunneled for current connection")
+		}
+	}
 	return wg.setManualDNS(dnsCfg)
 }
 
@@ -225,13 +596,25 @@ func (wg *WireGuard) generateAndSaveConfigFile(cfgFilePath string) error {
 
 	log.Info("WireGuard  configuration:",
 		"\n=====================\n",
-		strings.ReplaceAll(configText, wg.connectParams.clientPrivateKey, "***"),
+		hideKeys(configText, wg.params().clientPrivateKey, wg.params().presharedKey),
 		"\n=====================\n")
 
 	return nil
 }
 
+// hideKeys replaces the secret keys in the configuration text (to be able to write it into the log)
+func hideKeys(configText string, keys ...string) string {
+	for _, k := range keys {
+		if len(k) > 0 {
+			configText = strings.ReplaceAll(configText, k, "***")
+		}
+	}
+	return configText
+}
+
 func (wg *WireGuard) generateConfig() ([]string, error) {
+	cp := wg.params()
+
 	localPort, err := netinfo.GetFreeUDPPort()
 	if err != nil {
 		return nil, fmt.Errorf("unable to obtain free local port: %w", err)
@@ -240,23 +623,36 @@ func (wg *WireGuard) generateConfig() ([]string, error) {
 	wg.localPort = localPort
 
 	// prevent user-defined data injection: ensure that nothing except the base64 public key will be stored in the configuration
-	if !helpers.ValidateBase64(wg.connectParams.hostPublicKey) {
+	if !helpers.ValidateBase64(cp.hostPublicKey) {
 		return nil, fmt.Errorf("WG public key is not base64 string")
 	}
-	if !helpers.ValidateBase64(wg.connectParams.clientPrivateKey) {
+	if !helpers.ValidateBase64(cp.clientPrivateKey) {
 		return nil, fmt.Errorf("WG private key is not base64 string")
 	}
+	if len(cp.presharedKey) > 0 && !helpers.ValidateBase64(cp.presharedKey) {
+		return nil, fmt.Errorf("WG preshared key is not base64 string")
+	}
 
 	interfaceCfg := []string{
 		"[Interface]",
-		"PrivateKey = " + wg.connectParams.clientPrivateKey,
+		"PrivateKey = " + cp.clientPrivateKey,
 		"ListenPort = " + strconv.Itoa(wg.localPort)}
 
+	if cfg := cp.amneziaWG; cfg.IsEnabled() {
+		if err := cfg.Validate(); err != nil {
+			return nil, fmt.Errorf("bad AmneziaWG parameters: %w", err)
+		}
+		interfaceCfg = append(interfaceCfg, cfg.InterfaceConfig()...)
+	}
+
 	peerCfg := []string{
 		"[Peer]",
-		"PublicKey = " + wg.connectParams.hostPublicKey,
-		"Endpoint = " + wg.connectParams.hostIP.String() + ":" + strconv.Itoa(wg.connectParams.hostPort),
+		"PublicKey = " + cp.hostPublicKey,
+		"Endpoint = " + wg.endpoint(),
 		"PersistentKeepalive = 25"}
+	if len(cp.presharedKey) > 0 {
+		peerCfg = append(peerCfg, "PresharedKey = "+cp.presharedKey)
+	}
 
 	// add some OS-specific configurations (if necessary)
 	iCfg, pCgf := wg.getOSSpecificConfigParams()
@@ -266,23 +662,133 @@ func (wg *WireGuard) generateConfig() ([]string, error) {
 	return append(interfaceCfg, peerCfg...), nil
 }
 
+// endpoint returns the peer endpoint ("IP:port") for the WireGuard configuration.
+// When local proxy (or UDP-over-TCP shim) is in use - it is the local port of the proxy (shim).
+func (wg *WireGuard) endpoint() string {
+	if wg.localProxy != nil {
+		return net.JoinHostPort("127.0.0.1", strconv.Itoa(wg.localProxy.LocalPort()))
+	}
+	if wg.tcpShim != nil {
+		return net.JoinHostPort("127.0.0.1", strconv.Itoa(wg.tcpShim.LocalPort()))
+	}
+	return net.JoinHostPort(wg.params().hostIP.String(), strconv.Itoa(wg.params().hostPort))
+}
+
+// setPeer configures the peer of the active interface ('wg set <interface> peer ...')
+func (wg *WireGuard) setPeer(interfaceName string, p ConnectionParams) error {
+	args := []string{
+		"set", interfaceName,
+		"peer", p.hostPublicKey,
+		"endpoint", net.JoinHostPort(p.hostIP.String(), strconv.Itoa(p.hostPort)),
+		"persistent-keepalive", "25",
+		"allowed-ips", strings.ReplaceAll(wg.getAllowedIPs(), " ", "")}
+
+	if len(p.presharedKey) > 0 {
+		if !helpers.ValidateBase64(p.presharedKey) {
+			return fmt.Errorf("WG preshared key is not base64 string")
+		}
+		// the preshared key can be passed to 'wg' only as a file
+		// (os.CreateTemp() creates the file with 0600 permissions; on Windows the ACL has to be set explicitly)
+		f, err := os.CreateTemp(filepath.Dir(wg.configFilePath), "psk*.tmp")
+		if err != nil {
+			return fmt.Errorf("failed to save WG preshared key: %w", err)
+		}
+		defer os.Remove(f.Name())
+		if err = filerights.WindowsChmod(f.Name(), 0600); err == nil { // read\write only for privileged user
+			_, err = f.WriteString(p.presharedKey)
+		}
+		if errClose := f.Close(); err == nil {
+			err = errClose
+		}
+		if err != nil {
+			return fmt.Errorf("failed to save WG preshared key: %w", err)
+		}
+		args = append(args, "preshared-key", f.Name())
+	}
+
+	return shell.Exec(log, wg.toolBinaryPath, args...)
+}
+
 func (wg *WireGuard) notifyConnectedStat(stateChan chan<- vpn.StateInfo) {
-	const isTCP = false
+	cp := wg.params()
+
 	const isCanPause = true
+	isTCP := wg.tcpShim != nil
 
 	si := vpn.NewStateInfoConnected(
 		isTCP,
-		wg.connectParams.clientLocalIP,
-		wg.connectParams.GetIPv6ClientLocalIP(),
+		cp.clientLocalIP,
+		cp.GetIPv6ClientLocalIP(),
 		wg.localPort,
-		wg.connectParams.hostIP,
-		wg.connectParams.hostPort,
+		cp.hostIP,
+		cp.hostPort,
 		isCanPause,
-		wg.connectParams.mtu)
+		cp.mtu)
 
-	si.ExitHostname = wg.connectParams.multihopExitHostname
+	si.ExitHostname = cp.multihopExitHostname
+	si.IsCustomConfig = cp.isCustomConfig
+	si.SetLocalProxyInfo(wg.localProxy)
 
 	stateChan <- si
+
+	if cp.handshakeTimeout > 0 && !wg.isHandshakeMonitorStarted {
+		wg.isHandshakeMonitorStarted = true
+		go wg.handshakeMonitor(cp.handshakeTimeout)
+	}
+}
+
+// handshakeMonitor disconnects the VPN when there was no handshake with the server during 'timeout'
+// (e.g. the UDP traffic is blocked by the network)
+func (wg *WireGuard) handshakeMonitor(timeout time.Duration) {
+	for started := time.Now(); time.Since(started) < timeout; time.Sleep(time.Second * 2) {
+		if wg.isDisconnected || wg.isPaused() {
+			return
+		}
+		if t, err := wg.latestHandshake(); err != nil {
+			log.Warning(fmt.Sprintf("unable to check WireGuard handshake: %s", err))
+			return
+		} else if !t.IsZero() {
+			return
+		}
+	}
+
+	if wg.isDisconnected || wg.isPaused() {
+		return
+	}
+	log.Error(fmt.Sprintf("No handshake with the WireGuard server during %v. Disconnecting...", timeout))
+	wg.isHandshakeTimeout = true
+	if err := wg.Disconnect(); err != nil {
+		log.Error(err)
+	}
+}
+
+// LatestHandshake returns the time of the latest handshake with the server (zero value - there was no handshake)
+func (wg *WireGuard) LatestHandshake() (time.Time, error) {
+	return wg.latestHandshake()
+}
+
+// latestHandshake returns the time of the latest handshake with the peer (zero value - there was no handshake)
+func (wg *WireGuard) latestHandshake() (time.Time, error) {
+	// example command: wg show wgivpn latest-handshakes
+	// output: "<peer public key>\t<unix timestamp>"
+	out, err := exec.Command(wg.toolBinaryPath, "show", wg.interfaceName(), "latest-handshakes").Output()
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	var ret time.Time
+	for _, line := range strings.Split(string(out), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+		if sec, err := strconv.ParseInt(fields[1], 10, 64); err == nil && sec > 0 {
+			if t := time.Unix(sec, 0); t.After(ret) {
+				ret = t
+			}
+		}
+	}
+	return ret, nil
 }
 
 func (wg *WireGuard) OnRoutingChanged() error {
@@ -290,5 +796,5 @@ func (wg *WireGuard) OnRoutingChanged() error {
 }
 
 func (wg *WireGuard) IsIPv6InTunnel() bool {
-	return len(wg.connectParams.GetIPv6ClientLocalIP()) > 0
+	return len(wg.params().GetIPv6ClientLocalIP()) > 0
 }
@@ -0,0 +1,177 @@
+//
+//  Daemon for IVPN Client Desktop
+//  https://github.com/ivpn/desktop-app
+//
+//  Created by Stelnykovych Alexandr.
+//  Copyright (c) 2020 Privatus Limited.
+//
+//  This file is part of the Daemon for IVPN Client Desktop.
+//
+//  The Daemon for IVPN Client Desktop is free software: you can redistribute it and/or
+//  modify it under the terms of the GNU General Public License as published by the Free
+//  Software Foundation, either version 3 of the License, or (at your option) any later version.
+//
+//  The Daemon for IVPN Client Desktop is distributed in the hope that it will be useful,
+//  but WITHOUT ANY WARRANTY; without even the implied warranty of MERCHANTABILITY
+//  or FITNESS FOR A PARTICULAR PURPOSE.  See the GNU General Public License for more
+//  details.
+//
+//  You should have received a copy of the GNU General Public License
+//  along with the Daemon for IVPN Client Desktop. If not, see <https://www.gnu.org/licenses/>.
+//
+
+package wireguard
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/ivpn/desktop-app/daemon/helpers"
+)
+
+// ParseCustomConfig parses the user-defined WireGuard configuration (in 'wg-quick' format)
+// and returns the connection parameters ("bring your own config").
+//
+// Only the parameters required to establish the tunnel are taken from the configuration:
+// the configuration file itself is never passed to WireGuard. The tunnel is always a full tunnel
+// (the 'AllowedIPs' are ignored) and the commands ('PreUp', 'PostUp' ...) are never executed.
+// Only IPv4 inside the tunnel is supported.
+func ParseCustomConfig(configText string) (ConnectionParams, error) {
+	var (
+		section  string
+		peersCnt int
+		endpoint string
+		ret      = ConnectionParams{isCustomConfig: true}
+	)
+
+	scanner := bufio.NewScanner(strings.NewReader(configText))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if idx := strings.Index(line, "#"); idx >= 0 {
+			line = strings.TrimSpace(line[:idx])
+		}
+		if len(line) == 0 {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			section = strings.ToLower(line)
+			switch section {
+			case "[interface]":
+			case "[peer]":
+				peersCnt++
+			default:
+				return ConnectionParams{}, fmt.Errorf("line %d: unsupported section '%s'", lineNo, line)
+			}
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return ConnectionParams{}, fmt.Errorf("line %d: bad format", lineNo)
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch section + key {
+		case "[interface]privatekey":
+			ret.clientPrivateKey = value
+		case "[interface]address":
+			for _, addr := range strings.Split(value, ",") {
+				ip, _, err := net.ParseCIDR(strings.TrimSpace(addr))
+				if err != nil {
+					ip = net.ParseIP(strings.TrimSpace(addr))
+				}
+				if ip == nil {
+					return ConnectionParams{}, fmt.Errorf("line %d: bad address '%s'", lineNo, addr)
+				}
+				if ip.To4() != nil && ret.clientLocalIP == nil {
+					ret.clientLocalIP = ip.To4()
+				}
+			}
+		case "[interface]dns":
+			for _, d := range strings.Split(value, ",") {
+				if ip := net.ParseIP(strings.TrimSpace(d)); ip != nil && ip.To4() != nil && ret.dns == nil {
+					ret.dns = ip.To4()
+				}
+			}
+		case "[interface]mtu":
+			mtu, err := strconv.Atoi(value)
+			if err != nil || mtu < 1280 || mtu > 65535 {
+				return ConnectionParams{}, fmt.Errorf("line %d: bad MTU value '%s'", lineNo, value)
+			}
+			ret.mtu = mtu
+		case "[peer]publickey":
+			ret.hostPublicKey = value
+		case "[peer]presharedkey":
+			ret.presharedKey = value
+		case "[peer]endpoint":
+			endpoint = value
+		default:
+			// all other parameters are ignored ('ListenPort', 'AllowedIPs', 'PostUp' ...)
+			log.Info(fmt.Sprintf("Custom WireGuard configuration: parameter ignored (line %d): %s", lineNo, key))
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return ConnectionParams{}, err
+	}
+
+	if peersCnt != 1 {
+		return ConnectionParams{}, fmt.Errorf("configuration must contain exactly one peer")
+	}
+	if len(ret.clientPrivateKey) == 0 || !helpers.ValidateBase64(ret.clientPrivateKey) {
+		return ConnectionParams{}, fmt.Errorf("bad or missing private key")
+	}
+	if len(ret.hostPublicKey) == 0 || !helpers.ValidateBase64(ret.hostPublicKey) {
+		return ConnectionParams{}, fmt.Errorf("bad or missing peer public key")
+	}
+	if len(ret.presharedKey) > 0 && !helpers.ValidateBase64(ret.presharedKey) {
+		return ConnectionParams{}, fmt.Errorf("bad preshared key")
+	}
+	if ret.clientLocalIP == nil {
+		return ConnectionParams{}, fmt.Errorf("IPv4 address of the interface not defined")
+	}
+	if ret.dns == nil {
+		return ConnectionParams{}, fmt.Errorf("IPv4 DNS server not defined")
+	}
+
+	// endpoint
+	host, portStr, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return ConnectionParams{}, fmt.Errorf("bad or missing peer endpoint: %w", err)
+	}
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 || port > 65535 {
+		return ConnectionParams{}, fmt.Errorf("bad peer endpoint port '%s'", portStr)
+	}
+	hostIP := net.ParseIP(host)
+	if hostIP == nil {
+		ips, err := net.LookupIP(host)
+		if err != nil {
+			return ConnectionParams{}, fmt.Errorf("unable to resolve peer endpoint '%s': %w", host, err)
+		}
+		// IPv4 address is preferred; IPv6 address is in use when the host has no IPv4 address
+		for _, ip := range ips {
+			if ip.To4() != nil {
+				hostIP = ip
+				break
+			}
+			if hostIP == nil {
+				hostIP = ip
+			}
+		}
+	}
+	if hostIP == nil {
+		return ConnectionParams{}, fmt.Errorf("address of the peer endpoint not defined")
+	}
+	if ip4 := hostIP.To4(); ip4 != nil {
+		hostIP = ip4
+	}
+	ret.hostIP = hostIP
+	ret.hostPort = port
+
+	return ret, nil
+}
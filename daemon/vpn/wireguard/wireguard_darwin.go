@@ -51,15 +51,29 @@ const (
 const subnetMask string = "255.0.0.0"
 const subnetMaskPrefixLenIPv6 string = "64"
 
+// The route to the WireGuard server via default gateway is configured on connection (see setRoutes())
+const isHostRouteConfigured = true
+
+// One change of the network configuration usually produces a few routing notifications (e.g. 'delete' + 'add'):
+// they are processed together after this delay
+const defaultRouteChangeDelay = 300 * time.Millisecond
+
 // internalVariables of wireguard implementation for macOS
 type internalVariables struct {
 	// WG running process (shell command)
 	command       *exec.Cmd
 	isGoingToStop bool
-	defGateway    net.IP
+	defGateway    defaultGateway
+	utunName      string
 
 	isPaused      bool
 	omResumedChan chan struct{} // channel for 'On Resume' events
+
+	// routingMutex protects the routes configuration and the route monitor
+	routingMutex sync.Mutex
+	// routeMonitor notifies about default route changes (to update the route to the WireGuard server immediately)
+	routeMonitor           *netinfo.RouteMonitor
+	isRouteMonitorDisabled bool
 }
 
 var logWgOut *logger.Logger
@@ -109,18 +123,20 @@ func (wg *WireGuard) internalConnect(stateChan chan<- vpn.StateInfo) error {
 	}
 
 	// get default Gateway IP
-	defaultGwIP, err := netinfo.DefaultGatewayIP()
+	defaultGw, err := primaryGateway(wg.params().hostIP.To4() == nil)
 	if err != nil {
 		log.Error(fmt.Sprintf("Failed to detect default getway: %s", err))
 		return err
 	}
-	wg.internals.defGateway = defaultGwIP
+	wg.internals.defGateway = defaultGw
 
 	if wg.internals.isGoingToStop {
 		return nil
 	}
 
+	wg.setRouteMonitorDisabled(false)
 	defer func() {
+		wg.setRouteMonitorDisabled(true)
 		wg.removeRoutes()
 		wg.removeDNS()
 
@@ -136,6 +152,8 @@ func (wg *WireGuard) internalConnect(stateChan chan<- vpn.StateInfo) error {
 		return fmt.Errorf("unable to start WireGuard. Failed to obtain free utun interface: %w", err)
 	}
 
+	wg.internals.utunName = utunName
+
 	log.Info("Starting WireGuard in interface ", utunName)
 	// LOG_LEVEL=verbose
 	wg.internals.command = exec.Command(wg.binaryPath, "-f", utunName)
@@ -206,6 +224,7 @@ func (wg *WireGuard) internalConnect(stateChan chan<- vpn.StateInfo) error {
 				isHaveToBeStopped = true
 			} else {
 				log.Info("Started")
+				wg.startRouteMonitor()
 				// CONNECTED
 				wg.notifyConnectedStat(stateChan)
 			}
@@ -263,6 +282,16 @@ func (wg *WireGuard) internalDisconnect() error {
 	return cmd.Process.Kill()
 }
 
+// interfaceName returns the name of WireGuard network interface (e.g. "utun7")
+func (wg *WireGuard) interfaceName() string {
+	return wg.internals.utunName
+}
+
+func (wg *WireGuard) setInterfaceMTU(mtu int) error {
+	// example command: ifconfig utun7 mtu 1380
+	return shell.Exec(log, "/sbin/ifconfig", wg.internals.utunName, "mtu", strconv.Itoa(mtu))
+}
+
 func (wg *WireGuard) isPaused() bool {
 	return wg.internals.isPaused
 }
@@ -318,6 +347,8 @@ func (wg *WireGuard) initialize(utunName string) error {
 }
 
 func (wg *WireGuard) initializeConfiguration(utunName string) error {
+	cp := wg.params()
+
 	log.Info("Configuring ", utunName, " interface...")
 
 	// Configure WireGuard interface
@@ -332,12 +363,12 @@ func (wg *WireGuard) initializeConfiguration(utunName string) error {
 		return err
 	}
 
-	if wg.connectParams.mtu > 0 {
+	if cp.mtu > 0 {
 		// Custom MTU
-		log.Info(fmt.Sprintf("Configuring custom MTU = %d ...", wg.connectParams.mtu))
-		err := shell.Exec(log, "/sbin/ifconfig", utunName, "mtu", strconv.Itoa(wg.connectParams.mtu))
+		log.Info(fmt.Sprintf("Configuring custom MTU = %d ...", cp.mtu))
+		err := shell.Exec(log, "/sbin/ifconfig", utunName, "mtu", strconv.Itoa(cp.mtu))
 		if err != nil {
-			return fmt.Errorf("failed to set custom MTU (%d): %w", wg.connectParams.mtu, err)
+			return fmt.Errorf("failed to set custom MTU (%d): %w", cp.mtu, err)
 		}
 	}
 
@@ -349,12 +380,12 @@ func (wg *WireGuard) initializeConfiguration(utunName string) error {
 // example command: ipconfig set utun7 MANUAL-V6 fd00:4956:504e:ffff::ac1a:704b 96
 func (wg *WireGuard) initializeUnunInterface(utunName string) error {
 	// initialize IPv4 interface for tunnel
-	if err := shell.Exec(log, "/usr/sbin/ipconfig", "set", utunName, "MANUAL", wg.connectParams.clientLocalIP.String(), subnetMask); err != nil {
+	if err := shell.Exec(log, "/usr/sbin/ipconfig", "set", utunName, "MANUAL", wg.params().clientLocalIP.String(), subnetMask); err != nil {
 		return fmt.Errorf("failed to set the IPv4 address for interface: %w", err)
 	}
 
 	// initialize IPv6 interface for tunnel
-	ipv6LocalIP := wg.connectParams.GetIPv6ClientLocalIP()
+	ipv6LocalIP := wg.params().GetIPv6ClientLocalIP()
 	if ipv6LocalIP != nil {
 		if err := shell.Exec(log, "/usr/sbin/ipconfig", "set", utunName, "MANUAL-V6", ipv6LocalIP.String(), subnetMaskPrefixLenIPv6); err != nil {
 			return fmt.Errorf("failed to set the IPv6 address for interface: %w", err)
@@ -406,34 +437,44 @@ func (wg *WireGuard) setWgConfiguration(utunName string) error {
 }
 
 func (wg *WireGuard) setRoutes() error {
+	cp := wg.params()
+
 	log.Info("Modifying routing table...")
 
-	if net.IPv4(127, 0, 0, 1).Equal(wg.connectParams.hostIP) {
+	if net.IPv4(127, 0, 0, 1).Equal(cp.hostIP) {
 		return fmt.Errorf("WG server IP error (unable to use '127.0.0.1' as WG server IP)")
 	}
 
+	if cp.isLanBypass {
+		return wg.setLanBypassRoutes()
+	}
+
+	isIPv4Routed := cp.isIPv4Routed()
+
 	// Update main route
 	// example command:	route	-n	add	-net	0/1			10.0.0.1
 	// 					route	-n	add	-inet	0.0.0.0/1	-interface utun2
-	if err := shell.Exec(log, "/sbin/route", "-n", "add", "-inet", "-net", "0/1", wg.connectParams.hostLocalIP.String()); err != nil {
-		return fmt.Errorf("adding route shell comand error : %w", err)
+	if isIPv4Routed {
+		if err := shell.Exec(log, "/sbin/route", append([]string{"-n", "add", "-inet", "-net", "0/1"}, wg.routeGateway()...)...); err != nil {
+			return fmt.Errorf("adding route shell comand error : %w", err)
+		}
 	}
 
 	// Update routing to remote server (remote_server default_router 255.255.255)
-	// example command:	route	-n	add	-net	145.239.239.55	192.168.1.1	255.255.255.255
-	//					route	-n	add	-inet	51.77.91.106	-gateway	192.168.1.1
-	if err := shell.Exec(log, "/sbin/route", "-n", "add", "-inet", "-net", wg.connectParams.hostIP.String(), wg.internals.defGateway.String(), "255.255.255.255"); err != nil {
-		return fmt.Errorf("adding route shell comand error : %w", err)
+	if err := wg.addHostRoute(cp.hostIP); err != nil {
+		return err
 	}
 
 	// Update routing table
 	// example command:	route	-n	add	-net	128.0.0.0	10.0.0.1	128.0.0.0
 	// 					route	-n	add	-inet	128.0.0.0/1	-interface	utun2
-	if err := shell.Exec(log, "/sbin/route", "-n", "add", "-inet", "-net", "128.0.0.0", wg.connectParams.hostLocalIP.String(), "128.0.0.0"); err != nil {
-		return fmt.Errorf("adding route shell comand error : %w", err)
+	if isIPv4Routed {
+		if err := shell.Exec(log, "/sbin/route", append([]string{"-n", "add", "-inet", "-net", "128.0.0.0/1"}, wg.routeGateway()...)...); err != nil {
+			return fmt.Errorf("adding route shell comand error : %w", err)
+		}
 	}
 
-	ipv6HostLocalIP := wg.connectParams.GetIPv6HostLocalIP()
+	ipv6HostLocalIP := cp.GetIPv6HostLocalIP()
 	if ipv6HostLocalIP != nil {
 		// Using the default gateway (a ::/0 netmask) as two /1 networks: ::/1 and 8000::/1.
 		// Since a more specific route always wins, this forces traffic to be routed via the VPN instead of over the default gateway.
@@ -449,14 +490,89 @@ func (wg *WireGuard) setRoutes() error {
 	return nil
 }
 
+// setLanBypassRoutes configures routing when LAN bypass enabled:
+// only the subnets from 'AllowedIPs' (all addresses except the local networks) are routed to the tunnel
+func (wg *WireGuard) setLanBypassRoutes() error {
+	if err := wg.addHostRoute(wg.params().hostIP); err != nil {
+		return err
+	}
+	for _, n := range wg.params().lanBypassAllowedIPNets() {
+		if err := shell.Exec(log, "/sbin/route", wg.lanBypassRouteArgs("add", n)...); err != nil {
+			return fmt.Errorf("adding route shell comand error : %w", err)
+		}
+	}
+	return nil
+}
+
+func (wg *WireGuard) removeLanBypassRoutes() {
+	for _, n := range wg.params().lanBypassAllowedIPNets() {
+		shell.Exec(log, "/sbin/route", wg.lanBypassRouteArgs("delete", n)...)
+	}
+}
+
+// lanBypassRouteArgs returns arguments for the 'route' command to add/delete the route of the subnet to the tunnel
+func (wg *WireGuard) lanBypassRouteArgs(operation string, n net.IPNet) []string {
+	// example commands:	route	-n	add	-inet	-net	11.0.0.0/8	10.0.0.1
+	// 					route	-n	add	-inet6	-net	2000::/3	fd00:4956:504e:ffff::1
+	if n.IP.To4() != nil {
+		return append([]string{"-n", operation, "-inet", "-net", n.String()}, wg.routeGateway()...)
+	}
+	return []string{"-n", operation, "-inet6", "-net", n.String(), wg.params().GetIPv6HostLocalIP().String()}
+}
+
+// addHostRoute adds the route to the WireGuard server over the default gateway
+func (wg *WireGuard) addHostRoute(hostIP net.IP) error {
+	// example commands:	route	-n	add	-inet	-net	145.239.239.55	192.168.1.1	255.255.255.255
+	//					route	-n	add	-inet6	-host	2a07:b944::2:1	fe80::1%en0
+	//					route	-n	add	-inet6	-host	2a07:b944::2:1	-interface	pdp_ip0
+	var args []string
+	if hostIP.To4() != nil {
+		args = append([]string{"-n", "add", "-inet", "-net", hostIP.String()}, wg.internals.defGateway.routeArgs()...)
+		args = append(args, "255.255.255.255")
+	} else {
+		args = append([]string{"-n", "add", "-inet6", "-host", hostIP.String()}, wg.internals.defGateway.routeArgs()...)
+	}
+
+	if err := shell.Exec(log, "/sbin/route", args...); err != nil {
+		return fmt.Errorf("adding route shell comand error : %w", err)
+	}
+	return nil
+}
+
+// deleteHostRoute removes the route to the WireGuard server
+func (wg *WireGuard) deleteHostRoute(hostIP net.IP) {
+	if hostIP.To4() != nil {
+		shell.Exec(log, "/sbin/route", "-n", "delete", "-inet", "-net", hostIP.String())
+	} else {
+		shell.Exec(log, "/sbin/route", "-n", "delete", "-inet6", "-host", hostIP.String())
+	}
+}
+
+// routeGateway returns the gateway arguments for the IPv4 routes to the tunnel:
+// the host local IP or the tunnel interface (when the host local IP is unknown, e.g. user-defined configuration)
+func (wg *WireGuard) routeGateway() []string {
+	if wg.params().hostLocalIP != nil {
+		return []string{wg.params().hostLocalIP.String()}
+	}
+	return []string{"-interface", wg.internals.utunName}
+}
+
 func (wg *WireGuard) removeRoutes() error {
+	cp := wg.params()
+
 	log.Info("Restoring routing table...")
 
-	shell.Exec(log, "/sbin/route", "-n", "delete", "-inet", "-net", "0/1", wg.connectParams.hostLocalIP.String())
-	shell.Exec(log, "/sbin/route", "-n", "delete", "-inet", "-net", wg.connectParams.hostIP.String())
-	shell.Exec(log, "/sbin/route", "-n", "delete", "-inet", "-net", "128.0.0.0", wg.connectParams.hostLocalIP.String())
+	wg.deleteHostRoute(cp.hostIP)
+	if cp.isLanBypass {
+		wg.removeLanBypassRoutes()
+		return nil
+	}
+	if cp.isIPv4Routed() {
+		shell.Exec(log, "/sbin/route", append([]string{"-n", "delete", "-inet", "-net", "0/1"}, wg.routeGateway()...)...)
+		shell.Exec(log, "/sbin/route", append([]string{"-n", "delete", "-inet", "-net", "128.0.0.0/1"}, wg.routeGateway()...)...)
+	}
 
-	ipv6HostLocalIP := wg.connectParams.GetIPv6HostLocalIP()
+	ipv6HostLocalIP := cp.GetIPv6HostLocalIP()
 	if ipv6HostLocalIP != nil {
 		// Using the default gateway (a ::/0 netmask) as two /1 networks: ::/1 and 8000::/1.
 		// Since a more specific route always wins, this forces traffic to be routed via the VPN instead of over the default gateway.
@@ -468,15 +584,24 @@ func (wg *WireGuard) removeRoutes() error {
 }
 
 func (wg *WireGuard) onRoutingChanged() error {
-	defGatewayIP, err := netinfo.DefaultGatewayIP()
+	wg.internals.routingMutex.Lock()
+	defer wg.internals.routingMutex.Unlock()
+
+	return wg.updateRoutesOnGatewayChange()
+}
+
+// updateRoutesOnGatewayChange updates the route to the WireGuard server when the default gateway changed.
+// Note: the routingMutex must be locked
+func (wg *WireGuard) updateRoutesOnGatewayChange() error {
+	defGateway, err := primaryGateway(wg.params().hostIP.To4() == nil)
 	if err != nil {
 		log.Warning(fmt.Sprintf("onRoutingChanged: %v", err))
 		return err
 	}
 
-	if defGatewayIP.String() != wg.internals.defGateway.String() {
-		log.Info(fmt.Sprintf("Default gateway changed: %s -> %s. Updating routes...", wg.internals.defGateway.String(), defGatewayIP.String()))
-		wg.internals.defGateway = defGatewayIP
+	if defGateway.String() != wg.internals.defGateway.String() {
+		log.Info(fmt.Sprintf("Default gateway changed: %s -> %s. Updating routes...", wg.internals.defGateway.String(), defGateway.String()))
+		wg.internals.defGateway = defGateway
 		wg.removeRoutes()
 		wg.setRoutes()
 	}
@@ -484,6 +609,109 @@ func (wg *WireGuard) onRoutingChanged() error {
 	return nil
 }
 
+// defaultGateway - the gateway of the primary uplink (the route to the WireGuard server goes over it)
+type defaultGateway struct {
+	IP        net.IP // nil for the point-to-point interfaces (e.g. IPv6-only cellular uplink)
+	Interface string
+}
+
+func (g defaultGateway) String() string {
+	if g.IP == nil {
+		return "interface " + g.Interface
+	}
+	return g.IP.String()
+}
+
+// routeArgs returns the gateway arguments for the 'route' command
+func (g defaultGateway) routeArgs() []string {
+	if g.IP == nil {
+		return []string{"-interface", g.Interface}
+	}
+	if g.IP.To4() == nil && g.IP.IsLinkLocalUnicast() && len(g.Interface) > 0 {
+		// IPv6 link-local gateway is usable only together with the interface
+		return []string{g.IP.String() + "%" + g.Interface}
+	}
+	return []string{g.IP.String()}
+}
+
+// primaryGateway returns the gateway of the primary IPv4 (or IPv6) uplink.
+// When there are a few uplinks (e.g. Ethernet + Wi-Fi), the interface-scoped default routes are ignored:
+// the route to the WireGuard server must go over the uplink which is in use by the system.
+func primaryGateway(isIPv6 bool) (defaultGateway, error) {
+	if isIPv6 {
+		// the IPv6 endpoint of the server is in use on IPv6-only networks
+		r, err := netinfo.PrimaryDefaultRoute(true)
+		if err != nil {
+			return defaultGateway{}, err
+		}
+		return defaultGateway{IP: r.Gateway, Interface: r.InterfaceName}, nil
+	}
+
+	routes, err := netinfo.DefaultRoutes()
+	if err != nil {
+		log.Warning(fmt.Sprintf("Failed to get default routes: %v", err))
+		gw, err := netinfo.DefaultGatewayIP()
+		return defaultGateway{IP: gw}, err
+	}
+
+	for _, r := range routes {
+		if !r.IsIPv6 && !r.IsScoped && r.Gateway != nil {
+			return defaultGateway{IP: r.Gateway, Interface: r.InterfaceName}, nil
+		}
+	}
+	gw, err := netinfo.DefaultGatewayIP()
+	return defaultGateway{IP: gw}, err
+}
+
+// startRouteMonitor starts listening to the default route changes.
+// The route to the WireGuard server is updated immediately when the default gateway changes (e.g. switching Wi-Fi networks),
+// without waiting for notifications from the service.
+func (wg *WireGuard) startRouteMonitor() {
+	wg.internals.routingMutex.Lock()
+	defer wg.internals.routingMutex.Unlock()
+
+	if wg.internals.isRouteMonitorDisabled || wg.internals.routeMonitor != nil {
+		return
+	}
+
+	var timer *time.Timer
+	monitor, err := netinfo.StartRouteMonitor(func() {
+		// the handler is called from the single monitor routine
+		if timer == nil {
+			timer = time.AfterFunc(defaultRouteChangeDelay, wg.onDefaultRouteChanged)
+		} else {
+			timer.Reset(defaultRouteChangeDelay)
+		}
+	})
+	if err != nil {
+		log.Warning(fmt.Sprintf("Failed to start route monitor: %v", err))
+		return
+	}
+	wg.internals.routeMonitor = monitor
+}
+
+// setRouteMonitorDisabled stops the route monitor (if running) and forbids/allows starting it
+func (wg *WireGuard) setRouteMonitorDisabled(disabled bool) {
+	wg.internals.routingMutex.Lock()
+	defer wg.internals.routingMutex.Unlock()
+
+	wg.internals.isRouteMonitorDisabled = disabled
+	if disabled && wg.internals.routeMonitor != nil {
+		wg.internals.routeMonitor.Stop()
+		wg.internals.routeMonitor = nil
+	}
+}
+
+func (wg *WireGuard) onDefaultRouteChanged() {
+	wg.internals.routingMutex.Lock()
+	defer wg.internals.routingMutex.Unlock()
+
+	if wg.internals.routeMonitor == nil || wg.internals.isGoingToStop || wg.internals.isPaused {
+		return
+	}
+	wg.updateRoutesOnGatewayChange()
+}
+
 func (wg *WireGuard) setDNS() error {
 	defaultDNS := wg.DefaultDNS()
 	log.Info("Updating DNS server to " + defaultDNS.String() + "...")
@@ -496,7 +724,7 @@ func (wg *WireGuard) setDNS() error {
 
 func (wg *WireGuard) initIPv6DNSResolver(utunName string) error {
 	// required to be able to resolve IPv6 DNS addresses by the default macOS's domain name resolver
-	ipv6LocalIP := wg.connectParams.GetIPv6ClientLocalIP()
+	ipv6LocalIP := wg.params().GetIPv6ClientLocalIP()
 	if ipv6LocalIP != nil && len(utunName) > 0 {
 		err := shell.Exec(log, platform.DNSScript(), "-up_init_ipv6_resolver", ipv6LocalIP.String(), utunName)
 		if err != nil {
@@ -545,11 +773,57 @@ func (wg *WireGuard) getOSSpecificConfigParams() (interfaceCfg []string, peerCfg
 	// We need to disable WireGuard-s firewall because we have our own implementation of firewall.
 	//  For details, refer to WireGuard-windows sources: tunnel\ifaceconfig.go (enableFirewall(...) method)
 
-	if len(wg.connectParams.GetIPv6HostLocalIP()) > 0 {
-		peerCfg = append(peerCfg, "AllowedIPs = 128.0.0.0/1, 0.0.0.0/1, ::/0")
-	} else {
-		peerCfg = append(peerCfg, "AllowedIPs = 128.0.0.0/1, 0.0.0.0/1")
-	}
+	peerCfg = append(peerCfg, "AllowedIPs = "+wg.getAllowedIPs())
 
 	return interfaceCfg, peerCfg
 }
+
+func (wg *WireGuard) getAllowedIPs() string {
+	cp := wg.params()
+
+	if cp.isLanBypass {
+		return cp.lanBypassAllowedIPs()
+	}
+	if len(cp.GetIPv6HostLocalIP()) > 0 {
+		if !cp.isIPv4Routed() {
+			return "::/0"
+		}
+		return "128.0.0.0/1, 0.0.0.0/1, ::/0"
+	}
+	return "128.0.0.0/1, 0.0.0.0/1"
+}
+
+func (wg *WireGuard) switchPeer(oldParams ConnectionParams, newParams ConnectionParams) error {
+	utunName := wg.internals.utunName
+	if len(utunName) <= 0 || wg.internals.isGoingToStop {
+		return fmt.Errorf("WireGuard interface is not initialized")
+	}
+
+	isHostChanged := !oldParams.hostIP.Equal(newParams.hostIP)
+
+	// route to the new server (remote_server default_router 255.255.255)
+	if isHostChanged {
+		if err := wg.addHostRoute(newParams.hostIP); err != nil {
+			return err
+		}
+	}
+
+	// Add new peer. The 'allowed-ips' are moved from the old peer to the new one, so all traffic is going to the new server.
+	if err := wg.setPeer(utunName, newParams); err != nil {
+		if isHostChanged {
+			wg.deleteHostRoute(newParams.hostIP)
+		}
+		return err
+	}
+
+	// remove old peer and the route to the old server
+	if oldParams.hostPublicKey != newParams.hostPublicKey {
+		if err := shell.Exec(log, wg.toolBinaryPath, "set", utunName, "peer", oldParams.hostPublicKey, "remove"); err != nil {
+			log.Warning(fmt.Sprintf("failed to remove old peer: %s", err))
+		}
+	}
+	if isHostChanged {
+		wg.deleteHostRoute(oldParams.hostIP)
+	}
+	return nil
+}
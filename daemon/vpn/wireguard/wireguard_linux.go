@@ -29,9 +29,12 @@ import (
 	"os/exec"
 	"path"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/ivpn/desktop-app/daemon/helpers"
+	"github.com/ivpn/desktop-app/daemon/hostroute"
 	"github.com/ivpn/desktop-app/daemon/service/dns"
 	"github.com/ivpn/desktop-app/daemon/shell"
 	"github.com/ivpn/desktop-app/daemon/vpn"
@@ -44,12 +47,18 @@ const (
 	resume     operation = iota
 )
 
+// The traffic to the peer endpoint is excluded from the tunnel by fwmark;
+// other connections to the WireGuard server (e.g. UDP-over-TCP) require the separate route
+const isHostRouteConfigured = false
+
 // internalVariables of wireguard implementation for Linux
 type internalVariables struct {
 	manualDNS            dns.DnsSettings
 	isRunning            bool
 	isPaused             bool
 	resumeDisconnectChan chan operation // control connection pause\resume or disconnect from paused state
+	// route to the WireGuard server (in use when LAN bypass enabled: see addLanBypassHostRoute())
+	hostRoute *hostroute.Route
 }
 
 func (wg *WireGuard) init() error {
@@ -78,7 +87,6 @@ func (wg *WireGuard) init() error {
 
 // connect - SYNCHRONOUSLY execute openvpn process (wait until it finished)
 func (wg *WireGuard) connect(stateChan chan<- vpn.StateInfo) error {
-
 	wg.internals.isRunning = true
 	defer func() {
 		wg.internals.isRunning = false
@@ -90,6 +98,11 @@ func (wg *WireGuard) connect(stateChan chan<- vpn.StateInfo) error {
 
 	wg.internals.resumeDisconnectChan = make(chan operation, 1)
 
+	if err := wg.addLanBypassHostRoute(wg.params().hostIP); err != nil {
+		return err
+	}
+	defer wg.removeLanBypassHostRoute()
+
 	// loop connection initialization (required for pause\resume functionality)
 	// on 'pause' - we stopping WG interface but not exiting this (connect) method
 	// (method 'connect' is synchronous, must NOT exit on pause)
@@ -103,6 +116,7 @@ func (wg *WireGuard) connect(stateChan chan<- vpn.StateInfo) error {
 		// start WG
 		log.Info("Shell exec: ", wg.binaryPath, " up ", wg.configFilePath)
 		cmd := exec.Command(wg.binaryPath, "up", wg.configFilePath)
+		cmd.Env = wg.awgQuickEnv()
 		outBytes, err := cmd.CombinedOutput()
 		if err != nil {
 			if len(outBytes) > 0 {
@@ -115,19 +129,19 @@ func (wg *WireGuard) connect(stateChan chan<- vpn.StateInfo) error {
 			// do not forget to restore DNS
 			defer func() {
 				// restore DNS configuration
-				if err := dns.DeleteManual(nil, wg.connectParams.clientLocalIP); err != nil {
+				if err := dns.DeleteManual(nil, wg.params().clientLocalIP); err != nil {
 					log.Warning(fmt.Sprintf("failed to restore DNS configuration: %s", err))
 				}
 			}()
 			// update DNS configuration
 
 			if !wg.internals.manualDNS.IsEmpty() {
-				if err := dns.SetManual(wg.internals.manualDNS, wg.connectParams.clientLocalIP); err != nil {
+				if err := dns.SetManual(wg.internals.manualDNS, wg.params().clientLocalIP); err != nil {
 					return fmt.Errorf("failed to set manual DNS: %w", err)
 				}
 			} else {
 				dnsIP := dns.DnsSettingsCreate(wg.DefaultDNS())
-				if err := dns.SetDefault(dnsIP, wg.connectParams.clientLocalIP); err != nil {
+				if err := dns.SetDefault(dnsIP, wg.params().clientLocalIP); err != nil {
 					return fmt.Errorf("failed to set DNS: %w", err)
 				}
 			}
@@ -187,6 +201,19 @@ func (wg *WireGuard) disconnect() error {
 }
 
 func (wg *WireGuard) internalDisconnect() error {
+	if env := wg.awgQuickEnv(); env != nil {
+		log.Info("Shell exec: ", wg.binaryPath, " down ", wg.configFilePath)
+		cmd := exec.Command(wg.binaryPath, "down", wg.configFilePath)
+		cmd.Env = env
+		if outBytes, err := cmd.CombinedOutput(); err != nil {
+			if len(outBytes) > 0 {
+				log.Error(fmt.Sprintf("'%s' error. Output: %s", wg.binaryPath, string(outBytes)))
+			}
+			return fmt.Errorf("failed to stop WireGuard: %w", err)
+		}
+		return nil
+	}
+
 	err := shell.Exec(log, wg.binaryPath, "down", wg.configFilePath)
 	if err != nil {
 		return fmt.Errorf("failed to stop WireGuard: %w", err)
@@ -194,6 +221,27 @@ func (wg *WireGuard) internalDisconnect() error {
 	return nil
 }
 
+// awgQuickEnv returns the environment for 'awg-quick' (nil - AmneziaWG is not in use: the environment of the daemon is inherited).
+// 'awg-quick' calls the 'awg' tool by name and starts the userspace 'amneziawg-go' when the AmneziaWG kernel module is not available.
+// Both binaries are shipped together with 'awg-quick' in the folder which is not in the PATH.
+func (wg *WireGuard) awgQuickEnv() []string {
+	if p := wg.params(); !p.AmneziaWG().IsEnabled() {
+		return nil
+	}
+	binDir := filepath.Dir(wg.toolBinaryPath)
+	env := append(os.Environ(), "PATH="+binDir+string(os.PathListSeparator)+os.Getenv("PATH"))
+	if userspaceBin := filepath.Join(binDir, "amneziawg-go"); helpers.FileExists(userspaceBin) {
+		env = append(env, "WG_QUICK_USERSPACE_IMPLEMENTATION="+userspaceBin)
+	}
+	return env
+}
+
+// interfaceName returns the name of WireGuard network interface (e.g. "wgivpn")
+func (wg *WireGuard) interfaceName() string {
+	name := filepath.Base(wg.configFilePath)
+	return strings.TrimSuffix(name, path.Ext(name))
+}
+
 func (wg *WireGuard) isPaused() bool {
 	return wg.internals.isPaused
 }
@@ -225,7 +273,7 @@ func (wg *WireGuard) setManualDNS(dnsCfg dns.DnsSettings) error {
 	if wg.isPaused() || !wg.internals.isRunning {
 		return nil
 	}
-	return dns.SetManual(dnsCfg, wg.connectParams.clientLocalIP)
+	return dns.SetManual(dnsCfg, wg.params().clientLocalIP)
 }
 
 func (wg *WireGuard) resetManualDNS() error {
@@ -237,30 +285,113 @@ func (wg *WireGuard) resetManualDNS() error {
 
 	if wg.internals.isRunning {
 		// changing DNS to default value for current WireGuard connection
-		return dns.SetDefault(dns.DnsSettingsCreate(wg.DefaultDNS()), wg.connectParams.clientLocalIP)
+		return dns.SetDefault(dns.DnsSettingsCreate(wg.DefaultDNS()), wg.params().clientLocalIP)
 	}
-	return dns.DeleteManual(nil, wg.connectParams.clientLocalIP)
+	return dns.DeleteManual(nil, wg.params().clientLocalIP)
 }
 
 func (wg *WireGuard) getOSSpecificConfigParams() (interfaceCfg []string, peerCfg []string) {
-	ipv6LocalIP := wg.connectParams.GetIPv6ClientLocalIP()
+	cp := wg.params()
+
+	ipv6LocalIP := cp.GetIPv6ClientLocalIP()
 	ipv6LocalIPStr := ""
-	allowedIPsV6 := ""
 	if ipv6LocalIP != nil {
 		ipv6LocalIPStr = ", " + ipv6LocalIP.String()
-		allowedIPsV6 = ", ::/0"
 	}
 
-	if wg.connectParams.mtu > 0 {
-		interfaceCfg = append(interfaceCfg, fmt.Sprintf("MTU = %d", wg.connectParams.mtu))
+	if cp.mtu > 0 {
+		interfaceCfg = append(interfaceCfg, fmt.Sprintf("MTU = %d", cp.mtu))
 	}
-	interfaceCfg = append(interfaceCfg, "Address = "+wg.connectParams.clientLocalIP.String()+"/32"+ipv6LocalIPStr)
+	interfaceCfg = append(interfaceCfg, "Address = "+cp.clientLocalIP.String()+"/32"+ipv6LocalIPStr)
 	interfaceCfg = append(interfaceCfg, "SaveConfig = true")
 
-	peerCfg = append(peerCfg, "AllowedIPs = 0.0.0.0/0"+allowedIPsV6)
+	peerCfg = append(peerCfg, "AllowedIPs = "+wg.getAllowedIPs())
 	return interfaceCfg, peerCfg
 }
 
+func (wg *WireGuard) getAllowedIPs() string {
+	cp := wg.params()
+
+	if cp.isLanBypass {
+		return cp.lanBypassAllowedIPs()
+	}
+	if cp.GetIPv6ClientLocalIP() != nil {
+		if !cp.isIPv4Routed() {
+			return "::/0"
+		}
+		return "0.0.0.0/0, ::/0"
+	}
+	return "0.0.0.0/0"
+}
+
+func (wg *WireGuard) switchPeer(oldParams ConnectionParams, newParams ConnectionParams) error {
+	if !wg.internals.isRunning {
+		return fmt.Errorf("WireGuard is not running")
+	}
+
+	wgInterfaceName := filepath.Base(wg.configFilePath)
+	wgInterfaceName = strings.TrimSuffix(wgInterfaceName, path.Ext(wgInterfaceName))
+
+	// Add new peer. The 'allowed-ips' are moved from the old peer to the new one, so all traffic is going to the new server.
+	// No routing changes required: the traffic to the peer endpoint is excluded from the tunnel by fwmark (wg-quick)
+	// (except LAN bypass mode: the route to the new server is required)
+	oldRoute := wg.internals.hostRoute
+	wg.internals.hostRoute = nil
+	if err := wg.addLanBypassHostRoute(newParams.hostIP); err != nil {
+		wg.internals.hostRoute = oldRoute
+		return err
+	}
+	if err := wg.setPeer(wgInterfaceName, newParams); err != nil {
+		wg.removeLanBypassHostRoute()
+		wg.internals.hostRoute = oldRoute
+		return err
+	}
+	if oldRoute != nil {
+		if err := oldRoute.Remove(); err != nil {
+			log.Warning(fmt.Sprintf("failed to remove route to the old server: %s", err))
+		}
+	}
+
+	// remove old peer
+	if oldParams.hostPublicKey != newParams.hostPublicKey {
+		if err := shell.Exec(log, wg.toolBinaryPath, "set", wgInterfaceName, "peer", oldParams.hostPublicKey, "remove"); err != nil {
+			log.Warning(fmt.Sprintf("failed to remove old peer: %s", err))
+		}
+	}
+	return nil
+}
+
+// addLanBypassHostRoute adds the route to the WireGuard server via default gateway (if LAN bypass enabled).
+// The wg-quick excludes the traffic to the peer endpoint from the tunnel by fwmark only when 'AllowedIPs' contains the default route ("/0").
+// With LAN bypass enabled, 'AllowedIPs' is a list of subnets, so the separate route to the server is required to avoid routing loop.
+// Not required for connections over the local proxy or UDP-over-TCP shim (they are configuring the route by themselves).
+func (wg *WireGuard) addLanBypassHostRoute(host net.IP) error {
+	if !wg.params().isLanBypass || wg.localProxy != nil || wg.tcpShim != nil {
+		return nil
+	}
+	route, err := hostroute.Add(host)
+	if err != nil {
+		return fmt.Errorf("failed to add route to the WireGuard server: %w", err)
+	}
+	wg.internals.hostRoute = route
+	return nil
+}
+
+func (wg *WireGuard) removeLanBypassHostRoute() {
+	if wg.internals.hostRoute == nil {
+		return
+	}
+	if err := wg.internals.hostRoute.Remove(); err != nil {
+		log.Warning(fmt.Sprintf("failed to remove route to the WireGuard server: %s", err))
+	}
+	wg.internals.hostRoute = nil
+}
+
+func (wg *WireGuard) setInterfaceMTU(mtu int) error {
+	// example command: ip link set dev wgivpn mtu 1380
+	return shell.Exec(log, "ip", "link", "set", "dev", wg.interfaceName(), "mtu", strconv.Itoa(mtu))
+}
+
 func (wg *WireGuard) onRoutingChanged() error {
 	// do nothing for Linux
 	return nil
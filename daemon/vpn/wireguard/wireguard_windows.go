@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -52,6 +53,9 @@ const (
 	resume operation = iota
 )
 
+// The route to the WireGuard server is not configured by the WireGuard service
+const isHostRouteConfigured = false
+
 // internalVariables of wireguard implementation for macOS
 type internalVariables struct {
 	// required DNS state (temporary save required DNS value here because it is not possible set DNS when VPN is not connected)
@@ -115,7 +119,7 @@ func (wg *WireGuard) connect(stateChan chan<- vpn.StateInfo) error {
 	err = wg.installService(stateChan)
 	if err != nil {
 		// check is there any custom parameters defined. If so - warn user about potential problem because of them
-		if wg.connectParams.mtu > 0 {
+		if wg.params().mtu > 0 {
 			return fmt.Errorf("failed to install windows service: %w\nThe 'Custom MTU' option may be set incorrectly, either revert to the default or try another value e.g. 1420.", err)
 		}
 		return fmt.Errorf("failed to install windows service: %w", err)
@@ -263,7 +267,7 @@ func (wg *WireGuard) setManualDNS(dnsCfg dns.DnsSettings) error {
 		return err // it is not possible set DNS when VPN is not connected
 	}
 
-	err := dns.SetManual(dnsCfg, wg.connectParams.clientLocalIP)
+	err := dns.SetManual(dnsCfg, wg.params().clientLocalIP)
 	if err == nil {
 		wg.internals.manualDNS = dnsCfg
 	}
@@ -283,7 +287,7 @@ func (wg *WireGuard) resetManualDNS() error {
 		return err // it is not possible set DNS when VPN is not connected
 	}
 
-	err := dns.SetDefault(dns.DnsSettingsCreate(wg.DefaultDNS()), wg.connectParams.clientLocalIP)
+	err := dns.SetDefault(dns.DnsSettingsCreate(wg.DefaultDNS()), wg.params().clientLocalIP)
 	if err == nil {
 		wg.internals.manualDNS = dns.DnsSettings{}
 	}
@@ -295,11 +299,35 @@ func (wg *WireGuard) getTunnelName() string {
 	return strings.TrimSuffix(filepath.Base(wg.configFilePath), filepath.Ext(wg.configFilePath)) // IVPN
 }
 
+// interfaceName returns the name of WireGuard tunnel
+func (wg *WireGuard) interfaceName() string {
+	return wg.getTunnelName()
+}
+
+func (wg *WireGuard) setInterfaceMTU(mtu int) error {
+	// example command: netsh interface ipv4 set subinterface "IVPN" mtu=1380 store=active
+	netsh := filepath.Join(os.Getenv("SYSTEMROOT"), "System32", "netsh.exe")
+	if err := shell.Exec(log, netsh, "interface", "ipv4", "set", "subinterface", wg.getTunnelName(), "mtu="+strconv.Itoa(mtu), "store=active"); err != nil {
+		return err
+	}
+	if wg.params().GetIPv6ClientLocalIP() != nil {
+		if err := shell.Exec(log, netsh, "interface", "ipv6", "set", "subinterface", wg.getTunnelName(), "mtu="+strconv.Itoa(mtu), "store=active"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (wg *WireGuard) getServiceName() string {
+	if wg.params().amneziaWG.IsEnabled() {
+		return "AmneziaWGTunnel$" + wg.getTunnelName() // AmneziaWGTunnel$IVPN (service installed by 'amneziawg.exe')
+	}
 	return "WireGuardTunnel$" + wg.getTunnelName() // WireGuardTunnel$IVPN
 }
 
 func (wg *WireGuard) getOSSpecificConfigParams() (interfaceCfg []string, peerCfg []string) {
+	cp := wg.params()
+
 	manualDNS := wg.internals.manualDNSRequired
 	if !manualDNS.IsEmpty() {
 		if manualDNS.Encryption == dns.EncryptionNone {
@@ -311,33 +339,65 @@ func (wg *WireGuard) getOSSpecificConfigParams() (interfaceCfg []string, peerCfg
 	} else {
 		interfaceCfg = append(interfaceCfg, "DNS = "+wg.DefaultDNS().String())
 	}
-	if wg.connectParams.mtu > 0 {
-		interfaceCfg = append(interfaceCfg, fmt.Sprintf("MTU = %d", wg.connectParams.mtu))
+	if cp.mtu > 0 {
+		interfaceCfg = append(interfaceCfg, fmt.Sprintf("MTU = %d", cp.mtu))
 	}
 
-	ipv6LocalIP := wg.connectParams.GetIPv6ClientLocalIP()
+	ipv6LocalIP := cp.GetIPv6ClientLocalIP()
 	ipv6LocalIPStr := ""
-	allowedIPsV6 := ""
 	if ipv6LocalIP != nil {
 		ipv6LocalIPStr = ", " + ipv6LocalIP.String()
-		// "8000::/1, ::/1" is the same as "::/0" but such type of configuration is disabling internal WireGuard-s Firewall
-		// (which blocks everything except WireGuard traffic)
-		// We need to disable WireGuard-s firewall because we have our own implementation of firewall.
-		// For example, we have to control 'Allow LAN' functionality
-		//  For details, refer to WireGuard-windows sources: https://git.zx2c4.com/wireguard-windows/tree/tunnel/addressconfig.go (enableFirewall(...) method)
-		allowedIPsV6 = ", 8000::/1, ::/1"
 	}
 
-	interfaceCfg = append(interfaceCfg, "Address = "+wg.connectParams.clientLocalIP.String()+ipv6LocalIPStr)
+	interfaceCfg = append(interfaceCfg, "Address = "+cp.clientLocalIP.String()+ipv6LocalIPStr)
+
+	peerCfg = append(peerCfg, "AllowedIPs = "+wg.getAllowedIPs())
+
+	return interfaceCfg, peerCfg
+}
+
+func (wg *WireGuard) getAllowedIPs() string {
+	cp := wg.params()
 
+	if cp.isLanBypass {
+		return cp.lanBypassAllowedIPs()
+	}
 	// "128.0.0.0/1, 0.0.0.0/1" is the same as "0.0.0.0/0" but such type of configuration is disabling internal WireGuard-s Firewall
 	// (which blocks everything except WireGuard traffic)
 	// We need to disable WireGuard-s firewall because we have our own implementation of firewall.
 	// For example, we have to control 'Allow LAN' functionality
 	//  For details, refer to WireGuard-windows sources: https://git.zx2c4.com/wireguard-windows/tree/tunnel/addressconfig.go (enableFirewall(...) method)
-	peerCfg = append(peerCfg, "AllowedIPs = 128.0.0.0/1, 0.0.0.0/1"+allowedIPsV6)
+	// The same for IPv6: "8000::/1, ::/1" is the same as "::/0"
+	if cp.GetIPv6ClientLocalIP() != nil {
+		if !cp.isIPv4Routed() {
+			return "8000::/1, ::/1"
+		}
+		return "128.0.0.0/1, 0.0.0.0/1, 8000::/1, ::/1"
+	}
+	return "128.0.0.0/1, 0.0.0.0/1"
+}
 
-	return interfaceCfg, peerCfg
+func (wg *WireGuard) switchPeer(oldParams ConnectionParams, newParams ConnectionParams) error {
+	if running, err := wg.isServiceRunning(); err != nil || !running {
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("WireGuard service is not running")
+	}
+
+	// Add new peer. The 'allowed-ips' are moved from the old peer to the new one, so all traffic is going to the new server.
+	// No routing changes required: WireGuard-windows binds the tunnel socket to the default interface
+	if err := wg.setPeer(wg.getTunnelName(), newParams); err != nil {
+		return err
+	}
+
+	// remove old peer
+	if oldParams.hostPublicKey != newParams.hostPublicKey {
+		if err := shell.Exec(log, wg.toolBinaryPath, "set", wg.getTunnelName(), "peer", oldParams.hostPublicKey, "remove"); err != nil {
+			log.Warning(fmt.Sprintf("failed to remove old peer: %s", err))
+		}
+	}
+	return nil
 }
 
 func (wg *WireGuard) getServiceStatus(m *mgr.Mgr) (bool, svc.State, error) {
@@ -45,6 +45,14 @@ static inline char * getCurrentSSID(void) {
 	return nsstring2cstring(ssid);
 }
 
+static inline char * getCurrentBSSID(void) {
+	CWInterface * WiFiInterface = getCWInterface();
+	if (WiFiInterface == nil) return nsstring2cstring(NOT_CONNECTED);
+
+	NSString *bssid = [WiFiInterface bssid] ? [WiFiInterface bssid] : NOT_CONNECTED;
+	return nsstring2cstring(bssid);
+}
+
 static inline int getCurrentNetworkSecurity() {
 	CWInterface * WiFiInterface = getCWInterface();
 	if (WiFiInterface == nil) return 0xFFFFFFFF;
@@ -142,6 +150,26 @@ func GetCurrentSSID() string {
 	return goSsid
 }
 
+// GetCurrentBSSID returns MAC address of the access point of current WiFi network (e.g. "aa:bb:cc:dd:ee:ff")
+// Note: macOS does not provide BSSID when the location services are not allowed for the process.
+func GetCurrentBSSID() string {
+	bssid := C.getCurrentBSSID()
+	goBssid := C.GoString(bssid)
+	C.free(unsafe.Pointer(bssid))
+
+	// macOS skips leading zeroes (e.g. "0:1b:2c:3:4:5"): normalizing
+	octets := strings.Split(strings.ToLower(goBssid), ":")
+	if len(octets) != 6 {
+		return ""
+	}
+	for i, o := range octets {
+		if len(o) == 1 {
+			octets[i] = "0" + o
+		}
+	}
+	return strings.Join(octets, ":")
+}
+
 // GetCurrentNetworkIsInsecure returns current security mode
 func GetCurrentNetworkIsInsecure() bool {
 	const (
@@ -0,0 +1,11 @@
+//go:build linux && nowifi
+// +build linux,nowifi
+
+package wifiNotifier
+
+// The build has no wireless extensions (libiw) support: nl80211 is the only source of Wi-Fi info
+
+func wextGetAvailableSSIDs() []string       { return nil }
+func wextGetCurrentSSID() string            { return "" }
+func wextGetCurrentBSSID() string           { return "" }
+func wextGetCurrentNetworkIsInsecure() bool { return false }
@@ -1,5 +1,5 @@
-//go:build nowifi
-// +build nowifi
+//go:build nowifi && !linux
+// +build nowifi,!linux
 
 package wifiNotifier
 
@@ -15,6 +15,11 @@ func GetCurrentSSID() string {
 	return ""
 }
 
+// GetCurrentBSSID returns MAC address of the access point of current WiFi network
+func GetCurrentBSSID() string {
+	return ""
+}
+
 // GetCurrentNetworkIsInsecure returns current security mode
 func GetCurrentNetworkIsInsecure() bool {
 	return false
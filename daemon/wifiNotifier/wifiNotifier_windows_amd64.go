@@ -271,6 +271,69 @@ static inline char* getCurrentSSID(void) {
     return ssid;
 }
 
+// returns MAC address of the access point (format "aa:bb:cc:dd:ee:ff")
+static inline char* getCurrentBSSID(void) {
+    if (initWlanapiDll()) return NULL;
+
+    openHandle();
+
+    char* bssid = (char*) malloc(18);
+    memset(bssid, 0, 18);
+
+    DWORD dwResult = 0;
+    unsigned int i;
+
+    PWLAN_INTERFACE_INFO_LIST pIfList = NULL;
+    PWLAN_INTERFACE_INFO pIfInfo = NULL;
+
+    PWLAN_CONNECTION_ATTRIBUTES pConnectInfo = NULL;
+    DWORD connectInfoSize = sizeof(WLAN_CONNECTION_ATTRIBUTES);
+    WLAN_OPCODE_VALUE_TYPE opCode = wlan_opcode_value_type_invalid;
+
+    dwResult = _f_WlanEnumInterfaces(hClient, NULL, &pIfList);
+    if (dwResult != ERROR_SUCCESS) {
+        wprintf(L"WlanEnumInterfaces failed with error: %u\n", dwResult);
+    }
+    else {
+
+        for (i = 0; i < (int)pIfList->dwNumberOfItems; i++) {
+            pIfInfo = (WLAN_INTERFACE_INFO*)&pIfList->InterfaceInfo[i];
+
+            if (pIfInfo->isState == wlan_interface_state_connected) {
+                dwResult = _f_WlanQueryInterface(hClient,
+                    &pIfInfo->InterfaceGuid,
+                    wlan_intf_opcode_current_connection,
+                    NULL,
+                    &connectInfoSize,
+                    (PVOID*)&pConnectInfo,
+                    &opCode);
+
+                if (dwResult != ERROR_SUCCESS) {
+                    wprintf(L"WlanQueryInterface failed with error: %u\n", dwResult);
+                }
+                else {
+                    UCHAR* mac = pConnectInfo->wlanAssociationAttributes.dot11Bssid;
+                    sprintf_s(bssid, 18, "%02x:%02x:%02x:%02x:%02x:%02x", mac[0], mac[1], mac[2], mac[3], mac[4], mac[5]);
+                    break;
+                }
+            }
+        }
+
+    }
+
+    if (pConnectInfo != NULL) {
+        _f_WlanFreeMemory(pConnectInfo);
+        pConnectInfo = NULL;
+    }
+
+    if (pIfList != NULL) {
+        _f_WlanFreeMemory(pIfList);
+        pIfList = NULL;
+    }
+
+    return bssid;
+}
+
 static inline int getCurrentNetworkSecurity() {
     int retSecurity = 0xFFFFFFFF;
     if (initWlanapiDll()) return retSecurity;
@@ -483,6 +546,14 @@ func GetCurrentSSID() string {
 	return goSsid
 }
 
+// GetCurrentBSSID returns MAC address of the access point of current WiFi network (e.g. "aa:bb:cc:dd:ee:ff")
+func GetCurrentBSSID() string {
+	bssid := C.getCurrentBSSID()
+	goBssid := C.GoString(bssid)
+	C.free(unsafe.Pointer(bssid))
+	return goBssid
+}
+
 // GetCurrentNetworkIsInsecure returns current security mode
 func GetCurrentNetworkIsInsecure() bool {
 	const (
@@ -389,6 +389,8 @@ int uninstall() {
         "/Library/Logs/IVPN Agent.log.0",
         "/Library/Logs/IVPN Agent CrashInfo.log",
         "/Library/Logs/IVPN Agent CrashInfo.log.0",
+        "/Library/Logs/IVPN Agent audit.log",
+        "/Library/Logs/IVPN Agent audit.log.0",
         "/Library/Application Support/net.ivpn.client.Agent/last-btime", // seems, the file created by OS,
         relFile1,
         relFile2
@@ -239,10 +239,26 @@ mkdir -p "${_PATH_UI_COMPILED_IMAGE}/Contents/MacOS/WireGuard"
 cp "${_PATH_ABS_REPO_DAEMON}/References/macOS/_deps/wg_inst/wg" "${_PATH_UI_COMPILED_IMAGE}/Contents/MacOS/WireGuard/wg" || CheckLastResult
 cp "${_PATH_ABS_REPO_DAEMON}/References/macOS/_deps/wg_inst/wireguard-go" "${_PATH_UI_COMPILED_IMAGE}/Contents/MacOS/WireGuard/wireguard-go" || CheckLastResult
 
+echo "[+] Preparing DMG image: Copying 'AmneziaWG' binaries..."
+mkdir -p "${_PATH_UI_COMPILED_IMAGE}/Contents/MacOS/AmneziaWG"
+cp "${_PATH_ABS_REPO_DAEMON}/References/macOS/_deps/awg_inst/awg" "${_PATH_UI_COMPILED_IMAGE}/Contents/MacOS/AmneziaWG/awg" || CheckLastResult
+cp "${_PATH_ABS_REPO_DAEMON}/References/macOS/_deps/awg_inst/amneziawg-go" "${_PATH_UI_COMPILED_IMAGE}/Contents/MacOS/AmneziaWG/amneziawg-go" || CheckLastResult
+
 echo "[+] Preparing DMG image: Copying 'dnscrypt-proxy' binary..."
 mkdir -p "${_PATH_UI_COMPILED_IMAGE}/Contents/MacOS/dnscrypt-proxy"
 cp "${_PATH_ABS_REPO_DAEMON}/References/macOS/_deps/dnscryptproxy_inst/dnscrypt-proxy" "${_PATH_UI_COMPILED_IMAGE}/Contents/MacOS/dnscrypt-proxy/dnscrypt-proxy" || CheckLastResult
 
+echo "[+] Preparing DMG image: Copying 'v2ray' binary..."
+mkdir -p "${_PATH_UI_COMPILED_IMAGE}/Contents/Resources/v2ray"
+cp "${_PATH_ABS_REPO_DAEMON}/References/macOS/_deps/v2ray_inst/v2ray" "${_PATH_UI_COMPILED_IMAGE}/Contents/Resources/v2ray/v2ray" || CheckLastResult
+
+echo "[+] Preparing DMG image: Copying 'shadowsocks' binary..."
+mkdir -p "${_PATH_UI_COMPILED_IMAGE}/Contents/Resources/shadowsocks"
+cp "${_PATH_ABS_REPO_DAEMON}/References/macOS/_deps/shadowsocks_inst/sslocal" "${_PATH_UI_COMPILED_IMAGE}/Contents/Resources/shadowsocks/sslocal" || CheckLastResult
+
+echo "[+] Preparing DMG image: Copying 'kem-helper' binary..."
+cp "${_PATH_ABS_REPO_DAEMON}/References/macOS/_deps/kem-helper_inst/kem-helper" "${_PATH_UI_COMPILED_IMAGE}/Contents/MacOS/kem-helper" || CheckLastResult
+
 echo "[+] Preparing DMG image: Copying daemon..."
 cp -R "${_PATH_ABS_REPO_DAEMON}/IVPN Agent" "${_PATH_UI_COMPILED_IMAGE}/Contents/MacOS" || CheckLastResult
 
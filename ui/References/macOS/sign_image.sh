@@ -82,8 +82,13 @@ ListThirdPartyBinaries=(
 "_image/IVPN.app/Contents/MacOS/openvpn"
 "_image/IVPN.app/Contents/MacOS/WireGuard/wg"
 "_image/IVPN.app/Contents/MacOS/WireGuard/wireguard-go"
+"_image/IVPN.app/Contents/MacOS/AmneziaWG/awg"
+"_image/IVPN.app/Contents/MacOS/AmneziaWG/amneziawg-go"
 "_image/IVPN.app/Contents/Resources/obfsproxy/obfs4proxy"
 "_image/IVPN.app/Contents/MacOS/dnscrypt-proxy/dnscrypt-proxy"
+"_image/IVPN.app/Contents/Resources/v2ray/v2ray"
+"_image/IVPN.app/Contents/Resources/shadowsocks/sslocal"
+"_image/IVPN.app/Contents/MacOS/kem-helper"
 )
 
 echo "[+] Signing compiled libs..."
@@ -45,6 +45,9 @@ const PingServersTimeoutMs = 4000;
 
 const DefaultResponseTimeoutMs = 3 * 60 * 1000;
 
+// Version of the client protocol implemented by this client (daemon: 'types.ProtocolVersion')
+const ProtocolVersion = 1;
+
 // Socket to connect to a daemon
 let socket = new net.Socket();
 // Request number (increasing each new request)
@@ -54,6 +57,16 @@ const waiters = [];
 
 let ParanoidModeSecret = "";
 
+// List of requests supported by the connected daemon (received in HelloResp).
+// 'null' when the daemon does not report it (older daemon versions)
+let daemonSupportedRequests = null;
+
+function isRequestSupported(command) {
+  if (!daemonSupportedRequests || daemonSupportedRequests.length <= 0)
+    return true;
+  return daemonSupportedRequests.includes(command);
+}
+
 const daemonRequests = Object.freeze({
   EmptyReq: "EmptyReq",
 
@@ -209,6 +222,11 @@ function send(request, reqNo) {
       'Unable to send request. Unknown command: "' + request.Command + '"'
     );
   }
+  if (!isRequestSupported(request.Command)) {
+    throw Error(
+      `Unable to send request. The command "${request.Command}" is not supported by the daemon (please, update the daemon)`
+    );
+  }
 
   if (typeof reqNo === "undefined") {
     requestNo += 1;
@@ -275,6 +293,16 @@ function sendRecv(request, waitRespCommandsList, timeoutMs) {
     });
   }
 
+  if (!isRequestSupported(request.Command)) {
+    return new Promise((resolve, reject) => {
+      reject(
+        new Error(
+          `Error: The command "${request.Command}" is not supported by the daemon (please, update the daemon)`
+        )
+      );
+    });
+  }
+
   let promise = addWaiter(waiter, timeoutMs);
 
   // send data
@@ -340,6 +368,7 @@ async function processResponse(response) {
     case daemonResponses.HelloResp:
       store.commit("daemonVersion", obj.Version);
       store.commit("daemonProcessorArch", obj.ProcessorArch);
+      daemonSupportedRequests = obj.SupportedRequests || null;
 
       if (obj.SettingsSessionUUID) {
         const ssID = obj.SettingsSessionUUID;
@@ -637,6 +666,7 @@ function makeHelloRequest(isSimpleConnect) {
     Command: daemonRequests.Hello,
     ClientType: 0, // 0 - UI client; 1 - CLI
     Version: appVersion,
+    ProtocolVersion: ProtocolVersion,
   };
 
   if (isSimpleConnect !== true) {